@@ -0,0 +1,175 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitAlertRule() {
+	api.BaseRoutes.AlertRules.Handle("", api.APISessionRequired(createAlertRule)).Methods("POST")
+	api.BaseRoutes.AlertRules.Handle("", api.APISessionRequired(getAlertRules)).Methods("GET")
+	api.BaseRoutes.AlertRule.Handle("", api.APISessionRequired(getAlertRule)).Methods("GET")
+	api.BaseRoutes.AlertRule.Handle("", api.APISessionRequired(updateAlertRule)).Methods("PUT")
+	api.BaseRoutes.AlertRule.Handle("", api.APISessionRequired(deleteAlertRule)).Methods("DELETE")
+}
+
+func createAlertRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	var rule model.AlertRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rule); jsonErr != nil {
+		c.SetInvalidParam("alert_rule")
+		return
+	}
+	rule.Id = ""
+	rule.CreatorId = c.AppContext.Session().UserId
+	rule.State = ""
+	rule.LastValue = 0
+	rule.LastEvaluatedAt = 0
+	rule.LastNotifiedAt = 0
+
+	auditRec := c.MakeAuditRecord("createAlertRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("metric", rule.Metric)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	saved, err := c.App.CreateAlertRule(&rule)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("alert_rule_id", saved.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getAlertRules(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	rules, err := c.App.GetAlertRules()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rules); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getAlertRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAlertRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	rule, err := c.App.GetAlertRule(c.Params.AlertRuleId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rule); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// updateAlertRule changes the settings of an alert rule. Its state is left to the evaluations.
+func updateAlertRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAlertRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	var update model.AlertRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&update); jsonErr != nil {
+		c.SetInvalidParam("alert_rule")
+		return
+	}
+	if update.Id != c.Params.AlertRuleId {
+		c.SetInvalidParam("id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateAlertRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("alert_rule_id", c.Params.AlertRuleId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	rule, err := c.App.GetAlertRule(c.Params.AlertRuleId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	rule.Name = update.Name
+	rule.Metric = update.Metric
+	rule.Threshold = update.Threshold
+	rule.WindowMinutes = update.WindowMinutes
+	rule.CooldownMinutes = update.CooldownMinutes
+	rule.ChannelId = update.ChannelId
+	rule.DMUserIds = update.DMUserIds
+	rule.Enabled = update.Enabled
+
+	updated, err := c.App.UpdateAlertRule(rule)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteAlertRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAlertRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteAlertRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("alert_rule_id", c.Params.AlertRuleId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if err := c.App.DeleteAlertRule(c.Params.AlertRuleId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestAlertRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newRule := func() *model.AlertRule {
+		return &model.AlertRule{
+			Name:            "Failed logins",
+			Metric:          model.AlertRuleMetricFailedLogins,
+			Threshold:       1,
+			WindowMinutes:   10,
+			CooldownMinutes: 60,
+			DMUserIds:       model.StringArray{th.SystemAdminUser.Id},
+			Enabled:         true,
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateAlertRule(newRule())
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, 501)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAdminAlerts = true })
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.CreateAlertRule(newRule())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetAlertRules()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		rule := newRule()
+		rule.DMUserIds = nil
+		_, resp, err := th.SystemAdminClient.CreateAlertRule(rule)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "model.alert_rule.is_valid.no_target.app_error")
+
+		rule = newRule()
+		rule.DMUserIds = model.StringArray{model.NewId()}
+		_, resp, err = th.SystemAdminClient.CreateAlertRule(rule)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		rule = newRule()
+		rule.ChannelId = th.CreateDmChannel(th.BasicUser2).Id
+		_, resp, err = th.SystemAdminClient.CreateAlertRule(rule)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.alert_rule.invalid_channel.app_error")
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		rule := newRule()
+		rule.State = model.AlertRuleStateTriggered
+		created, _, err := th.SystemAdminClient.CreateAlertRule(rule)
+		require.NoError(t, err)
+		assert.Equal(t, th.SystemAdminUser.Id, created.CreatorId)
+		assert.Equal(t, model.AlertRuleStateOk, created.State)
+
+		rules, _, err := th.SystemAdminClient.GetAlertRules()
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, created.Id, rules[0].Id)
+
+		created.Threshold = 10
+		created.ChannelId = th.BasicChannel.Id
+		updated, _, err := th.SystemAdminClient.UpdateAlertRule(created)
+		require.NoError(t, err)
+		assert.EqualValues(t, 10, updated.Threshold)
+
+		got, _, err := th.SystemAdminClient.GetAlertRule(created.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicChannel.Id, got.ChannelId)
+
+		_, err = th.SystemAdminClient.DeleteAlertRule(created.Id)
+		require.NoError(t, err)
+
+		_, resp, err := th.SystemAdminClient.GetAlertRule(created.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("notifies once within the cooldown", func(t *testing.T) {
+		created, _, err := th.SystemAdminClient.CreateAlertRule(newRule())
+		require.NoError(t, err)
+		defer th.SystemAdminClient.DeleteAlertRule(created.Id)
+
+		client := th.CreateClient()
+		_, _, err = client.Login(th.BasicUser.Email, "wrong password")
+		require.Error(t, err)
+
+		require.Nil(t, th.App.EvaluateAlertRules())
+
+		got, _, err := th.SystemAdminClient.GetAlertRule(created.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.AlertRuleStateTriggered, got.State)
+		assert.NotZero(t, got.LastNotifiedAt)
+
+		systemBot, appErr := th.App.GetSystemBot()
+		require.Nil(t, appErr)
+		dm, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.SystemAdminUser.Id, systemBot.UserId)
+		require.Nil(t, appErr)
+
+		posts, err := th.App.Srv().Store.Post().GetPostsSince(model.GetPostsSinceOptions{ChannelId: dm.Id, Time: created.CreateAt}, false)
+		require.NoError(t, err)
+		require.Len(t, posts.Order, 1)
+		assert.Contains(t, posts.Posts[posts.Order[0]].Message, "Failed logins")
+
+		require.Nil(t, th.App.EvaluateAlertRules())
+
+		posts, err = th.App.Srv().Store.Post().GetPostsSince(model.GetPostsSinceOptions{ChannelId: dm.Id, Time: created.CreateAt}, false)
+		require.NoError(t, err)
+		assert.Len(t, posts.Order, 1)
+	})
+}
@@ -31,6 +31,7 @@ type Routes struct {
 	Teams              *mux.Router // 'api/v4/teams'
 	TeamsForUser       *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams'
 	Team               *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}'
+	TeamRequests       *mux.Router // 'api/v4/teams/requests'
 	TeamForUser        *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/{team_id:[A-Za-z0-9]+}'
 	UserThreads        *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/{team_id:[A-Za-z0-9]+}/threads'
 	UserThread         *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/teams/{team_id:[A-Za-z0-9]+}/threads/{thread_id:[A-Za-z0-9]+}'
@@ -56,6 +57,7 @@ type Routes struct {
 	PostsForChannel *mux.Router // 'api/v4/channels/{channel_id:[A-Za-z0-9]+}/posts'
 	PostsForUser    *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/posts'
 	PostForUser     *mux.Router // 'api/v4/users/{user_id:[A-Za-z0-9]+}/posts/{post_id:[A-Za-z0-9]+}'
+	Thread          *mux.Router // 'api/v4/threads/{thread_id:[A-Za-z0-9]+}'
 
 	Files *mux.Router // 'api/v4/files'
 	File  *mux.Router // 'api/v4/files/{file_id:[A-Za-z0-9]+}'
@@ -134,12 +136,30 @@ type Routes struct {
 	SharedChannels *mux.Router // 'api/v4/sharedchannels'
 
 	Permissions *mux.Router // 'api/v4/permissions'
+
+	APIUsage *mux.Router // 'api/v4/api_usage'
+
+	Impersonations *mux.Router // 'api/v4/impersonations'
+	Impersonation  *mux.Router // 'api/v4/impersonations/{impersonation_id:[A-Za-z0-9]+}'
+
+	EventWebhooks *mux.Router // 'api/v4/event_webhooks'
+	EventWebhook  *mux.Router // 'api/v4/event_webhooks/{event_webhook_id:[A-Za-z0-9]+}'
+
+	AlertRules *mux.Router // 'api/v4/alert_rules'
+	AlertRule  *mux.Router // 'api/v4/alert_rules/{alert_rule_id:[A-Za-z0-9]+}'
+
+	PostModerations *mux.Router // 'api/v4/post_moderations'
+	PostModeration  *mux.Router // 'api/v4/post_moderations/{post_moderation_id:[A-Za-z0-9]+}'
+
+	PostReports *mux.Router // 'api/v4/post_reports'
+	PostReport  *mux.Router // 'api/v4/post_reports/{post_report_id:[A-Za-z0-9]+}'
 }
 
 type API struct {
-	srv        *app.Server
-	schema     *graphql.Schema
-	BaseRoutes *Routes
+	srv           *app.Server
+	schema        *graphql.Schema
+	graphQLBudget *graphQLCostBudget
+	BaseRoutes    *Routes
 }
 
 func Init(srv *app.Server) (*API, error) {
@@ -162,6 +182,8 @@ func Init(srv *app.Server) (*API, error) {
 
 	api.BaseRoutes.Teams = api.BaseRoutes.APIRoot.PathPrefix("/teams").Subrouter()
 	api.BaseRoutes.TeamsForUser = api.BaseRoutes.User.PathPrefix("/teams").Subrouter()
+	// Registered before Team so that "requests" isn't taken for a team id.
+	api.BaseRoutes.TeamRequests = api.BaseRoutes.Teams.PathPrefix("/requests").Subrouter()
 	api.BaseRoutes.Team = api.BaseRoutes.Teams.PathPrefix("/{team_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.TeamForUser = api.BaseRoutes.TeamsForUser.PathPrefix("/{team_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.UserThreads = api.BaseRoutes.TeamForUser.PathPrefix("/threads").Subrouter()
@@ -188,6 +210,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.PostsForChannel = api.BaseRoutes.Channel.PathPrefix("/posts").Subrouter()
 	api.BaseRoutes.PostsForUser = api.BaseRoutes.User.PathPrefix("/posts").Subrouter()
 	api.BaseRoutes.PostForUser = api.BaseRoutes.PostsForUser.PathPrefix("/{post_id:[A-Za-z0-9]+}").Subrouter()
+	api.BaseRoutes.Thread = api.BaseRoutes.APIRoot.PathPrefix("/threads/{thread_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Files = api.BaseRoutes.APIRoot.PathPrefix("/files").Subrouter()
 	api.BaseRoutes.File = api.BaseRoutes.Files.PathPrefix("/{file_id:[A-Za-z0-9]+}").Subrouter()
@@ -255,6 +278,23 @@ func Init(srv *app.Server) (*API, error) {
 
 	api.BaseRoutes.Permissions = api.BaseRoutes.APIRoot.PathPrefix("/permissions").Subrouter()
 
+	api.BaseRoutes.APIUsage = api.BaseRoutes.APIRoot.PathPrefix("/api_usage").Subrouter()
+
+	api.BaseRoutes.Impersonations = api.BaseRoutes.APIRoot.PathPrefix("/impersonations").Subrouter()
+	api.BaseRoutes.Impersonation = api.BaseRoutes.Impersonations.PathPrefix("/{impersonation_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.EventWebhooks = api.BaseRoutes.APIRoot.PathPrefix("/event_webhooks").Subrouter()
+	api.BaseRoutes.EventWebhook = api.BaseRoutes.EventWebhooks.PathPrefix("/{event_webhook_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.AlertRules = api.BaseRoutes.APIRoot.PathPrefix("/alert_rules").Subrouter()
+	api.BaseRoutes.AlertRule = api.BaseRoutes.AlertRules.PathPrefix("/{alert_rule_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.PostModerations = api.BaseRoutes.APIRoot.PathPrefix("/post_moderations").Subrouter()
+	api.BaseRoutes.PostModeration = api.BaseRoutes.PostModerations.PathPrefix("/{post_moderation_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.PostReports = api.BaseRoutes.APIRoot.PathPrefix("/post_reports").Subrouter()
+	api.BaseRoutes.PostReport = api.BaseRoutes.PostReports.PathPrefix("/{post_report_id:[A-Za-z0-9]+}").Subrouter()
+
 	api.InitUser()
 	api.InitBot()
 	api.InitTeam()
@@ -296,6 +336,35 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitSharedChannels()
 	api.InitPermissions()
 	api.InitExport()
+	api.InitAPIUsage()
+	api.InitPostTask()
+	api.InitChannelDigest()
+	api.InitDirectChannelRetention()
+	api.InitPostAcknowledgement()
+	api.InitMutedKeywords()
+	api.InitTeamBanner()
+	api.InitEmailSuppression()
+	api.InitCannedResponse()
+	api.InitCustomStatusTemplate()
+	api.InitRESTProxyBridge()
+	api.InitChannelFeed()
+	api.InitOutstandingMention()
+	api.InitBotState()
+	api.InitTeamRequest()
+	api.InitUserMerge()
+	api.InitTeamDeletion()
+	api.InitChannelArchivePolicy()
+	api.InitTeamAlias()
+	api.InitBotTokenRotation()
+	api.InitChannelCommandOverride()
+	api.InitImpersonation()
+	api.InitCapabilities()
+	api.InitScheduledChannelMessage()
+	api.InitEventWebhook()
+	api.InitAlertRule()
+	api.InitPostModeration()
+	api.InitPostReport()
+	api.InitTeamGroupRole()
 	if err := api.InitGraphQL(); err != nil {
 		return nil, err
 	}
@@ -375,6 +444,9 @@ func InitLocal(srv *app.Server) *API {
 
 	api.BaseRoutes.SAML = api.BaseRoutes.APIRoot.PathPrefix("/saml").Subrouter()
 
+	api.BaseRoutes.Compliance = api.BaseRoutes.APIRoot.PathPrefix("/compliance").Subrouter()
+	api.BaseRoutes.DataRetention = api.BaseRoutes.APIRoot.PathPrefix("/data_retention").Subrouter()
+
 	api.InitUserLocal()
 	api.InitTeamLocal()
 	api.InitChannelLocal()
@@ -394,6 +466,7 @@ func InitLocal(srv *app.Server) *API {
 	api.InitExportLocal()
 	api.InitJobLocal()
 	api.InitSamlLocal()
+	api.InitComplianceLocal()
 
 	srv.LocalRouter.Handle("/api/v4/{anything:.*}", http.HandlerFunc(api.Handle404))
 
@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func (api *API) InitAPIUsage() {
+	api.BaseRoutes.User.Handle("/api_usage", api.APISessionRequired(getUserAPIUsage)).Methods("GET")
+	api.BaseRoutes.APIUsage.Handle("", api.APISessionRequired(getAPIUsageSummaries)).Methods("GET")
+	api.BaseRoutes.APIUsage.Handle("/oauth_apps", api.APISessionRequired(getOAuthAppUsageSummaries)).Methods("GET")
+}
+
+func parseAPIUsageSince(c *Context, r *http.Request) int64 {
+	sinceString := r.URL.Query().Get("since")
+	if sinceString == "" {
+		return 0
+	}
+
+	since, err := strconv.ParseInt(sinceString, 10, 64)
+	if err != nil || since < 0 {
+		c.SetInvalidParam("since")
+		return 0
+	}
+	return since
+}
+
+func getUserAPIUsage(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	since := parseAPIUsageSince(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	usages, appErr := c.App.GetAPIUsageForUser(c.Params.UserId, since)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(usages)
+	if err != nil {
+		c.Err = model.NewAppError("getUserAPIUsage", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
+
+func getAPIUsageSummaries(c *Context, w http.ResponseWriter, r *http.Request) {
+	since := parseAPIUsageSince(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	summaries, appErr := c.App.GetAPIUsageSummaries(since, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(summaries)
+	if err != nil {
+		c.Err = model.NewAppError("getAPIUsageSummaries", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
+
+func getOAuthAppUsageSummaries(c *Context, w http.ResponseWriter, r *http.Request) {
+	since := parseAPIUsageSince(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadIntegrationsIntegrationManagement) {
+		c.SetPermissionError(model.PermissionSysconsoleReadIntegrationsIntegrationManagement)
+		return
+	}
+
+	summaries, appErr := c.App.GetOAuthAppUsageSummaries(since, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(summaries)
+	if err != nil {
+		c.Err = model.NewAppError("getOAuthAppUsageSummaries", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
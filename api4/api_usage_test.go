@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetUserAPIUsage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bucket := model.APIUsageBucket(model.GetMillis())
+	err := th.App.Srv().Store.APIUsage().Increment([]*model.APIUsage{
+		{UserId: th.BasicUser.Id, Route: "getUser", BucketAt: bucket, Count: 4},
+	})
+	require.NoError(t, err)
+
+	t.Run("own usage", func(t *testing.T) {
+		usages, _, err := th.Client.GetUserAPIUsage(th.BasicUser.Id, bucket)
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		assert.Equal(t, "getUser", usages[0].Route)
+		assert.Equal(t, int64(4), usages[0].Count)
+	})
+
+	t.Run("other user's usage", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserAPIUsage(th.BasicUser2.Id, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		usages, _, err := th.SystemAdminClient.GetUserAPIUsage(th.BasicUser.Id, bucket)
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+	})
+}
+
+func TestGetAPIUsageSummaries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bucket := model.APIUsageBucket(model.GetMillis())
+	err := th.App.Srv().Store.APIUsage().Increment([]*model.APIUsage{
+		{UserId: th.BasicUser.Id, Route: "getUser", BucketAt: bucket, Count: 4},
+		{UserId: th.BasicUser.Id, Route: "getPost", BucketAt: bucket, Count: 2},
+	})
+	require.NoError(t, err)
+
+	t.Run("as regular user", func(t *testing.T) {
+		_, resp, err := th.Client.GetAPIUsageSummaries(0, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		summaries, _, err := th.SystemAdminClient.GetAPIUsageSummaries(bucket, 0, 100)
+		require.NoError(t, err)
+
+		var found bool
+		for _, summary := range summaries {
+			if summary.UserId == th.BasicUser.Id && summary.TokenId == "" {
+				found = true
+				assert.Equal(t, int64(6), summary.Count)
+			}
+		}
+		assert.True(t, found)
+	})
+}
+
+func TestGetOAuthAppUsageSummaries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	oauthApp, err := th.App.Srv().Store.OAuth().SaveApp(&model.OAuthApp{
+		Name:            "TestApp" + model.NewId(),
+		Homepage:        "https://nowhere.com",
+		CallbackUrls:    []string{"https://nowhere.com"},
+		CreatorId:       th.SystemAdminUser.Id,
+		RateLimitPerSec: 5,
+	})
+	require.NoError(t, err)
+
+	bucket := model.APIUsageBucket(model.GetMillis())
+	err = th.App.Srv().Store.OAuthAppUsage().Increment([]*model.OAuthAppUsage{
+		{AppId: oauthApp.Id, BucketAt: bucket, Count: 4, LimitedCount: 1},
+	})
+	require.NoError(t, err)
+
+	t.Run("as regular user", func(t *testing.T) {
+		_, resp, err := th.Client.GetOAuthAppUsageSummaries(0, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin", func(t *testing.T) {
+		summaries, _, err := th.SystemAdminClient.GetOAuthAppUsageSummaries(bucket, 0, 100)
+		require.NoError(t, err)
+
+		var found bool
+		for _, summary := range summaries {
+			if summary.AppId == oauthApp.Id {
+				found = true
+				assert.Equal(t, oauthApp.Name, summary.Name)
+				assert.Equal(t, int64(4), summary.Count)
+				assert.Equal(t, int64(1), summary.LimitedCount)
+				assert.Equal(t, 5, summary.RateLimitPerSec)
+			}
+		}
+		assert.True(t, found)
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitBotState() {
+	api.BaseRoutes.Bot.Handle("/state", api.APISessionRequired(getBotStateKeys)).Methods("GET")
+	api.BaseRoutes.Bot.Handle("/state/{state_key:[A-Za-z0-9_.:-]+}", api.APISessionRequired(getBotState)).Methods("GET")
+	api.BaseRoutes.Bot.Handle("/state/{state_key:[A-Za-z0-9_.:-]+}", api.APISessionRequired(setBotState)).Methods("PUT")
+	api.BaseRoutes.Bot.Handle("/state/{state_key:[A-Za-z0-9_.:-]+}", api.APISessionRequired(deleteBotState)).Methods("DELETE")
+}
+
+// botStateScope checks that the session is the bot in the URL, only bots having access to their
+// states, and returns the user and channel the states are scoped to, from the query.
+func botStateScope(c *Context, r *http.Request) (string, string) {
+	if c.AppContext.Session().UserId != c.Params.BotUserId {
+		c.Err = model.NewAppError("botStateScope", "api.bot_state.not_bot.app_error", nil, "", http.StatusForbidden)
+		return "", ""
+	}
+
+	if _, err := c.App.GetBot(c.Params.BotUserId, false); err != nil {
+		c.Err = err
+		return "", ""
+	}
+
+	query := r.URL.Query()
+	userID := query.Get("user_id")
+	channelID := query.Get("channel_id")
+	if err := model.IsValidBotStateScope(userID, channelID); err != nil {
+		c.Err = err
+		return "", ""
+	}
+
+	return userID, channelID
+}
+
+func getBotStateKeys(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+
+	userID, channelID := botStateScope(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	keys, err := c.App.ListBotStateKeys(c.Params.BotUserId, userID, channelID, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(keys); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getBotState(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId().RequireBotStateKey()
+	if c.Err != nil {
+		return
+	}
+
+	userID, channelID := botStateScope(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	state, err := c.App.GetBotState(c.Params.BotUserId, userID, channelID, c.Params.BotStateKey)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func setBotState(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId().RequireBotStateKey()
+	if c.Err != nil {
+		return
+	}
+
+	var stateRequest model.BotStateRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&stateRequest); jsonErr != nil {
+		c.SetInvalidParam("state")
+		return
+	}
+
+	userID, channelID := botStateScope(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	state, err := c.App.SetBotState(c.Params.BotUserId, userID, channelID, c.Params.BotStateKey, stateRequest.Value, stateRequest.ExpireInSeconds)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteBotState(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId().RequireBotStateKey()
+	if c.Err != nil {
+		return
+	}
+
+	userID, channelID := botStateScope(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteBotState(c.Params.BotUserId, userID, channelID, c.Params.BotStateKey); err != nil {
+		c.Err = err
+		return
+	}
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestBotState(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bot := th.CreateBotWithSystemAdminClient()
+	token, appErr := th.App.CreateUserAccessToken(&model.UserAccessToken{UserId: bot.UserId, Description: "state"})
+	require.Nil(t, appErr)
+	botClient := th.CreateClient()
+	botClient.AuthToken = token.Token
+
+	t.Run("set, get, list and delete", func(t *testing.T) {
+		state, _, err := botClient.SetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step", &model.BotStateRequest{Value: json.RawMessage(`{"step":1}`), ExpireInSeconds: 60})
+		require.NoError(t, err)
+		assert.Greater(t, state.ExpireAt, int64(0))
+
+		state, _, err = botClient.GetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"step":1}`, string(state.Value))
+
+		keys, _, err := botClient.GetBotStateKeys(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dialog.step"}, keys)
+
+		keys, _, err = botClient.GetBotStateKeys(bot.UserId, th.BasicUser.Id, "", 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, keys, "states are scoped to their channel")
+
+		_, err = botClient.DeleteBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step")
+		require.NoError(t, err)
+
+		_, resp, err := botClient.GetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, resp, err := botClient.SetBotState(bot.UserId, "", "", "step", &model.BotStateRequest{Value: json.RawMessage(`""`), ExpireInSeconds: -1})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, resp, err := botClient.GetBotStateKeys(bot.UserId, "junk", "", 0, 10)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("only the bot has access to its state", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetBotStateKeys(bot.UserId, "", "", 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.SetBotState(th.BasicUser.Id, "", "", "step", &model.BotStateRequest{Value: json.RawMessage(`1`)})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
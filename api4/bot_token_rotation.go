@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitBotTokenRotation() {
+	api.BaseRoutes.Bots.Handle("/tokens", api.APISessionRequired(getBotTokenAges)).Methods("GET")
+	api.BaseRoutes.Bot.Handle("/token_rotation", api.APISessionRequired(getBotTokenRotation)).Methods("GET")
+	api.BaseRoutes.Bot.Handle("/token_rotation", api.APISessionRequired(updateBotTokenRotation)).Methods("PUT")
+	api.BaseRoutes.Bot.Handle("/token_rotation", api.APISessionRequired(deleteBotTokenRotation)).Methods("DELETE")
+	api.BaseRoutes.Bot.Handle("/token_rotation/rotate", api.APISessionRequired(rotateBotToken)).Methods("POST")
+}
+
+func getBotTokenAges(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadIntegrationsBotAccounts) {
+		c.SetPermissionError(model.PermissionSysconsoleReadIntegrationsBotAccounts)
+		return
+	}
+
+	ages, err := c.App.GetBotTokenAges(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(ages); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getBotTokenRotation(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.SessionHasPermissionToManageBot(*c.AppContext.Session(), c.Params.BotUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	rotation, err := c.App.GetBotTokenRotation(c.Params.BotUserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rotation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateBotTokenRotation(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var rotation model.BotTokenRotation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rotation); jsonErr != nil {
+		c.SetInvalidParam("bot_token_rotation")
+		return
+	}
+	rotation.BotUserId = c.Params.BotUserId
+
+	auditRec := c.MakeAuditRecord("updateBotTokenRotation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("bot_id", c.Params.BotUserId)
+	auditRec.AddMeta("rotation_days", rotation.RotationDays)
+	auditRec.AddMeta("grace_hours", rotation.GraceHours)
+
+	if err := c.App.SessionHasPermissionToManageBot(*c.AppContext.Session(), c.Params.BotUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	saved, err := c.App.SaveBotTokenRotation(&rotation)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteBotTokenRotation(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteBotTokenRotation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("bot_id", c.Params.BotUserId)
+
+	if err := c.App.SessionHasPermissionToManageBot(*c.AppContext.Session(), c.Params.BotUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := c.App.DeleteBotTokenRotation(c.Params.BotUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func rotateBotToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rotateBotToken", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("bot_id", c.Params.BotUserId)
+
+	if err := c.App.SessionHasPermissionToManageBot(*c.AppContext.Session(), c.Params.BotUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	rotation, err := c.App.RotateBotToken(c.Params.BotUserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("token_id", rotation.CurrentTokenId)
+
+	if err := json.NewEncoder(w).Encode(rotation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
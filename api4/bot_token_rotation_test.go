@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestBotTokenRotation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableBotAccountCreation = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.0/8"
+	})
+
+	var failing int32
+	notifications := make(chan *model.BotTokenRotationNotification, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var notification model.BotTokenRotationNotification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+		notifications <- &notification
+	}))
+	defer server.Close()
+
+	bot := th.CreateBotWithSystemAdminClient()
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, _, err := th.Client.GetBotTokenRotation(bot.UserId)
+		require.Error(t, err)
+
+		_, _, err = th.Client.UpdateBotTokenRotation(bot.UserId, &model.BotTokenRotation{RotationDays: 30, WebhookURL: server.URL})
+		require.Error(t, err)
+
+		_, _, err = th.Client.RotateBotToken(bot.UserId)
+		require.Error(t, err)
+
+		_, resp, err := th.Client.GetBotTokenAges(0, 100)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("rotate tokens", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetBotTokenRotation(bot.UserId)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.UpdateBotTokenRotation(bot.UserId, &model.BotTokenRotation{RotationDays: 1, GraceHours: 24, WebhookURL: server.URL})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		rotation, _, err := th.SystemAdminClient.UpdateBotTokenRotation(bot.UserId, &model.BotTokenRotation{RotationDays: 30, WebhookURL: server.URL})
+		require.NoError(t, err)
+		assert.Equal(t, bot.UserId, rotation.BotUserId)
+		assert.Empty(t, rotation.CurrentTokenId)
+		assert.NotZero(t, rotation.NextRotationAt, "a new rotation is due right away")
+
+		rotation, _, err = th.SystemAdminClient.RotateBotToken(bot.UserId)
+		require.NoError(t, err)
+		first := <-notifications
+		assert.Equal(t, rotation.CurrentTokenId, first.TokenId)
+		assert.Empty(t, first.PreviousTokenId)
+		assert.NotZero(t, first.ExpiresAt)
+
+		firstClient := th.CreateClient()
+		firstClient.AuthToken = first.Token
+		firstClient.AuthType = model.HeaderBearer
+		me, _, err := firstClient.GetMe("")
+		require.NoError(t, err)
+		assert.Equal(t, bot.UserId, me.Id)
+
+		// Without a grace period, the previous token stops working right away.
+		rotation, _, err = th.SystemAdminClient.RotateBotToken(bot.UserId)
+		require.NoError(t, err)
+		second := <-notifications
+		assert.Equal(t, first.TokenId, second.PreviousTokenId)
+		assert.Equal(t, rotation.CurrentTokenId, second.TokenId)
+
+		_, resp, err = firstClient.GetMe("")
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+
+		secondClient := th.CreateClient()
+		secondClient.AuthToken = second.Token
+		secondClient.AuthType = model.HeaderBearer
+		_, _, err = secondClient.GetMe("")
+		require.NoError(t, err)
+
+		// The previous token is kept when the new one can't be delivered.
+		atomic.StoreInt32(&failing, 1)
+		_, _, err = th.SystemAdminClient.RotateBotToken(bot.UserId)
+		require.Error(t, err)
+		atomic.StoreInt32(&failing, 0)
+
+		fetched, _, err := th.SystemAdminClient.GetBotTokenRotation(bot.UserId)
+		require.NoError(t, err)
+		assert.Equal(t, second.TokenId, fetched.CurrentTokenId)
+		_, _, err = secondClient.GetMe("")
+		require.NoError(t, err)
+
+		ages, _, err := th.SystemAdminClient.GetBotTokenAges(0, 1000)
+		require.NoError(t, err)
+		var rotated []*model.BotTokenAge
+		for _, age := range ages {
+			if age.BotUserId == bot.UserId && age.Rotated {
+				rotated = append(rotated, age)
+			}
+		}
+		require.Len(t, rotated, 1)
+		assert.Equal(t, second.TokenId, rotated[0].TokenId)
+		assert.Equal(t, bot.Username, rotated[0].BotUsername)
+
+		_, err = th.SystemAdminClient.DeleteBotTokenRotation(bot.UserId)
+		require.NoError(t, err)
+
+		resp, err = th.SystemAdminClient.DeleteBotTokenRotation(bot.UserId)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 )
 
 func (api *API) InitBrand() {
@@ -21,6 +22,13 @@ func (api *API) InitBrand() {
 func getBrandImage(c *Context, w http.ResponseWriter, r *http.Request) {
 	// No permission check required
 
+	if signedURL, err := c.App.GetBrandImageURL(); err != nil {
+		mlog.Warn("Unable to generate signed URL for the brand image", mlog.Err(err))
+	} else if signedURL != "" {
+		http.Redirect(w, r, signedURL, http.StatusFound)
+		return
+	}
+
 	img, err := c.App.GetBrandImage()
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
@@ -0,0 +1,337 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitCannedResponse() {
+	api.BaseRoutes.User.Handle("/canned_responses", api.APISessionRequired(getUserCannedResponses)).Methods("GET")
+	api.BaseRoutes.User.Handle("/canned_responses", api.APISessionRequired(createUserCannedResponse)).Methods("POST")
+	api.BaseRoutes.User.Handle("/canned_responses/autocomplete", api.APISessionRequired(autocompleteCannedResponses)).Methods("GET")
+	api.BaseRoutes.User.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchUserCannedResponse)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteUserCannedResponse)).Methods("DELETE")
+	api.BaseRoutes.User.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}/use", api.APISessionRequired(useUserCannedResponse)).Methods("POST")
+
+	api.BaseRoutes.Team.Handle("/canned_responses", api.APISessionRequired(getTeamCannedResponses)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/canned_responses", api.APISessionRequired(createTeamCannedResponse)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchTeamCannedResponse)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteTeamCannedResponse)).Methods("DELETE")
+	api.BaseRoutes.Team.Handle("/canned_responses/{canned_response_id:[A-Za-z0-9]+}/use", api.APISessionRequired(useTeamCannedResponse)).Methods("POST")
+}
+
+// cannedResponseScope describes whether the canned response routes act on the responses of the
+// user or of the team in the URL.
+type cannedResponseScope struct {
+	requireScopeId func(c *Context)
+	checkRead      func(c *Context) bool
+	checkWrite     func(c *Context) bool
+	belongs        func(c *Context, response *model.CannedResponse) bool
+	auditMeta      func(c *Context, auditRec *audit.Record)
+}
+
+var userCannedResponseScope = cannedResponseScope{
+	requireScopeId: func(c *Context) { c.RequireUserId() },
+	checkRead:      checkCannedResponseUserPermission,
+	checkWrite:     checkCannedResponseUserPermission,
+	belongs: func(c *Context, response *model.CannedResponse) bool {
+		return response.UserId == c.Params.UserId
+	},
+	auditMeta: func(c *Context, auditRec *audit.Record) { auditRec.AddMeta("user_id", c.Params.UserId) },
+}
+
+var teamCannedResponseScope = cannedResponseScope{
+	requireScopeId: func(c *Context) { c.RequireTeamId() },
+	checkRead: func(c *Context) bool {
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+			c.SetPermissionError(model.PermissionViewTeam)
+			return false
+		}
+		return true
+	},
+	checkWrite: func(c *Context) bool {
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+			c.SetPermissionError(model.PermissionManageTeam)
+			return false
+		}
+		return true
+	},
+	belongs: func(c *Context, response *model.CannedResponse) bool {
+		return response.TeamId == c.Params.TeamId
+	},
+	auditMeta: func(c *Context, auditRec *audit.Record) { auditRec.AddMeta("team_id", c.Params.TeamId) },
+}
+
+func checkCannedResponseUserPermission(c *Context) bool {
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return false
+	}
+	return true
+}
+
+// getCannedResponseForScope returns the canned response in the URL, making sure it belongs to
+// the user or team in the URL.
+func getCannedResponseForScope(c *Context, scope cannedResponseScope) *model.CannedResponse {
+	response, err := c.App.GetCannedResponse(c.Params.CannedResponseId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if !scope.belongs(c, response) {
+		c.Err = model.NewAppError("getCannedResponseForScope", "app.canned_response.get.not_found.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return response
+}
+
+func getUserCannedResponses(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkCannedResponseUserPermission(c) {
+		return
+	}
+
+	responses, err := c.App.GetCannedResponsesForUser(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(responses); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamCannedResponses(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !teamCannedResponseScope.checkRead(c) {
+		return
+	}
+
+	responses, err := c.App.GetCannedResponsesForTeam(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(responses); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func autocompleteCannedResponses(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkCannedResponseUserPermission(c) {
+		return
+	}
+
+	teamID := r.URL.Query().Get("team_id")
+	if teamID != "" {
+		if !model.IsValidId(teamID) {
+			c.SetInvalidParam("team_id")
+			return
+		}
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), teamID, model.PermissionViewTeam) {
+			c.SetPermissionError(model.PermissionViewTeam)
+			return
+		}
+	}
+
+	responses, err := c.App.AutocompleteCannedResponses(c.Params.UserId, teamID, r.URL.Query().Get("term"))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(responses); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createUserCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	createCannedResponse(c, w, r, "createUserCannedResponse", userCannedResponseScope)
+}
+
+func createTeamCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	createCannedResponse(c, w, r, "createTeamCannedResponse", teamCannedResponseScope)
+}
+
+func createCannedResponse(c *Context, w http.ResponseWriter, r *http.Request, event string, scope cannedResponseScope) {
+	scope.requireScopeId(c)
+	if c.Err != nil {
+		return
+	}
+
+	var response model.CannedResponse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&response); jsonErr != nil {
+		c.SetInvalidParam("canned_response")
+		return
+	}
+	response.UserId = c.Params.UserId
+	response.TeamId = c.Params.TeamId
+	response.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	created, err := c.App.CreateCannedResponse(&response)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("canned_response_id", created.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchUserCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	patchCannedResponse(c, w, r, "patchUserCannedResponse", userCannedResponseScope)
+}
+
+func patchTeamCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	patchCannedResponse(c, w, r, "patchTeamCannedResponse", teamCannedResponseScope)
+}
+
+func patchCannedResponse(c *Context, w http.ResponseWriter, r *http.Request, event string, scope cannedResponseScope) {
+	scope.requireScopeId(c)
+	c.RequireCannedResponseId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.CannedResponsePatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParam("canned_response")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+	auditRec.AddMeta("canned_response_id", c.Params.CannedResponseId)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	if getCannedResponseForScope(c, scope); c.Err != nil {
+		return
+	}
+
+	patched, err := c.App.PatchCannedResponse(c.Params.CannedResponseId, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteUserCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	deleteCannedResponse(c, w, r, "deleteUserCannedResponse", userCannedResponseScope)
+}
+
+func deleteTeamCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	deleteCannedResponse(c, w, r, "deleteTeamCannedResponse", teamCannedResponseScope)
+}
+
+func deleteCannedResponse(c *Context, w http.ResponseWriter, r *http.Request, event string, scope cannedResponseScope) {
+	scope.requireScopeId(c)
+	c.RequireCannedResponseId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+	auditRec.AddMeta("canned_response_id", c.Params.CannedResponseId)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	if getCannedResponseForScope(c, scope); c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteCannedResponse(c.Params.CannedResponseId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func useUserCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	useCannedResponse(c, w, r, userCannedResponseScope)
+}
+
+func useTeamCannedResponse(c *Context, w http.ResponseWriter, r *http.Request) {
+	useCannedResponse(c, w, r, teamCannedResponseScope)
+}
+
+func useCannedResponse(c *Context, w http.ResponseWriter, r *http.Request, scope cannedResponseScope) {
+	scope.requireScopeId(c)
+	c.RequireCannedResponseId()
+	if c.Err != nil {
+		return
+	}
+
+	var use model.CannedResponseUse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&use); jsonErr != nil {
+		c.SetInvalidParam("canned_response_use")
+		return
+	}
+
+	if !scope.checkRead(c) {
+		return
+	}
+
+	response := getCannedResponseForScope(c, scope)
+	if c.Err != nil {
+		return
+	}
+
+	result := &model.CannedResponseUseResult{Message: c.App.UseCannedResponse(response, use.Variables)}
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
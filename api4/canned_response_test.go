@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestUserCannedResponses(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	response, resp, err := th.Client.CreateCannedResponse(&model.CannedResponse{
+		UserId:   th.BasicUser.Id,
+		Shortcut: "thanks",
+		Content:  "Thanks {{customer}}, ticket {{ticket}} is closed.",
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, response.CreatorId)
+	assert.Empty(t, response.TeamId)
+
+	t.Run("duplicate shortcut", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCannedResponse(&model.CannedResponse{UserId: th.BasicUser.Id, Shortcut: "thanks", Content: "again"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other users cannot see or use them", func(t *testing.T) {
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+
+		_, resp, err := client2.GetUserCannedResponses(th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client2.UseUserCannedResponse(th.BasicUser.Id, response.Id, nil)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client2.UseUserCannedResponse(th.BasicUser2.Id, response.Id, nil)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		patched, _, err := th.Client.PatchUserCannedResponse(th.BasicUser.Id, response.Id, &model.CannedResponsePatch{Content: model.NewString("Thanks {{customer}}!")})
+		require.NoError(t, err)
+		assert.Equal(t, "Thanks {{customer}}!", patched.Content)
+		assert.Equal(t, "thanks", patched.Shortcut)
+	})
+
+	t.Run("use", func(t *testing.T) {
+		result, _, err := th.Client.UseUserCannedResponse(th.BasicUser.Id, response.Id, map[string]string{"customer": "Acme"})
+		require.NoError(t, err)
+		assert.Equal(t, "Thanks Acme!", result.Message)
+
+		responses, _, err := th.Client.GetUserCannedResponses(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		assert.Equal(t, int64(1), responses[0].UseCount)
+		assert.NotZero(t, responses[0].LastUsedAt)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := th.Client.DeleteUserCannedResponse(th.BasicUser.Id, response.Id)
+		require.NoError(t, err)
+
+		resp, err := th.Client.DeleteUserCannedResponse(th.BasicUser.Id, response.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestTeamCannedResponses(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	response, resp, err := th.SystemAdminClient.CreateCannedResponse(&model.CannedResponse{
+		TeamId:   th.BasicTeam.Id,
+		Shortcut: "welcome",
+		Content:  "Welcome to {{team}}!",
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+
+	t.Run("members cannot manage them", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCannedResponse(&model.CannedResponse{TeamId: th.BasicTeam.Id, Shortcut: "mine", Content: "mine"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.PatchTeamCannedResponse(th.BasicTeam.Id, response.Id, &model.CannedResponsePatch{Content: model.NewString("mine")})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteTeamCannedResponse(th.BasicTeam.Id, response.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("members can see and use them", func(t *testing.T) {
+		responses, _, err := th.Client.GetTeamCannedResponses(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		assert.Equal(t, response.Id, responses[0].Id)
+
+		result, _, err := th.Client.UseTeamCannedResponse(th.BasicTeam.Id, response.Id, map[string]string{"team": "Support"})
+		require.NoError(t, err)
+		assert.Equal(t, "Welcome to Support!", result.Message)
+	})
+
+	t.Run("response of another team", func(t *testing.T) {
+		otherTeam := th.CreateTeam()
+		resp, err := th.SystemAdminClient.DeleteTeamCannedResponse(otherTeam.Id, response.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("autocomplete", func(t *testing.T) {
+		own, _, err := th.Client.CreateCannedResponse(&model.CannedResponse{UserId: th.BasicUser.Id, Shortcut: "wave", Content: "o/"})
+		require.NoError(t, err)
+
+		responses, _, err := th.Client.AutocompleteCannedResponses(th.BasicUser.Id, th.BasicTeam.Id, "w")
+		require.NoError(t, err)
+		require.Len(t, responses, 2)
+		assert.Equal(t, response.Id, responses[0].Id)
+		assert.Equal(t, own.Id, responses[1].Id)
+
+		responses, _, err = th.Client.AutocompleteCannedResponses(th.BasicUser.Id, "", "w")
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		assert.Equal(t, own.Id, responses[0].Id)
+
+		otherTeam := th.CreateTeam()
+		_, resp, err := th.Client.AutocompleteCannedResponses(th.BasicUser.Id, otherTeam.Id, "w")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
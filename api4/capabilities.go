@@ -0,0 +1,24 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitCapabilities() {
+	api.BaseRoutes.APIRoot.Handle("/capabilities", api.APIHandler(getCapabilities)).Methods("GET")
+}
+
+// getCapabilities doesn't require a session, so that clients can detect the features of the
+// server before logging in.
+func getCapabilities(c *Context, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(c.App.GetCapabilities()); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetCapabilities(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCustomEmoji = true
+		*cfg.FileSettings.EnableFileAttachments = false
+		*cfg.FileSettings.MaxFileSize = 1024
+	})
+
+	t.Run("without being logged in", func(t *testing.T) {
+		client := th.CreateClient()
+		capabilities, _, err := client.GetCapabilities()
+		require.NoError(t, err)
+
+		assert.Equal(t, model.CurrentVersion, capabilities.Version)
+		assert.True(t, capabilities.CustomEmoji)
+		assert.False(t, capabilities.Files.Attachments)
+		assert.Equal(t, int64(1024), capabilities.Files.MaxFileSize)
+		assert.Equal(t, th.App.MaxPostSize(), capabilities.Posts.MaxPostSize)
+		assert.False(t, capabilities.SharedChannels)
+		assert.False(t, capabilities.Calls)
+	})
+
+	t.Run("follows the configuration", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCustomEmoji = false })
+
+		capabilities, _, err := th.CreateClient().GetCapabilities()
+		require.NoError(t, err)
+		assert.False(t, capabilities.CustomEmoji)
+	})
+}
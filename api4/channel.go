@@ -25,6 +25,7 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.Channels.Handle("/group", api.APISessionRequired(createGroupChannel)).Methods("POST")
 	api.BaseRoutes.Channels.Handle("/members/{user_id:[A-Za-z0-9]+}/view", api.APISessionRequired(viewChannel)).Methods("POST")
 	api.BaseRoutes.Channels.Handle("/{channel_id:[A-Za-z0-9]+}/scheme", api.APISessionRequired(updateChannelScheme)).Methods("PUT")
+	api.BaseRoutes.Channels.Handle("/deactivated_creators", api.APISessionRequired(getChannelsWithDeactivatedCreator)).Methods("GET")
 
 	api.BaseRoutes.ChannelsForTeam.Handle("", api.APISessionRequired(getPublicChannelsForTeam)).Methods("GET")
 	api.BaseRoutes.ChannelsForTeam.Handle("/deleted", api.APISessionRequired(getDeletedChannelsForTeam)).Methods("GET")
@@ -42,6 +43,8 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.ChannelCategories.Handle("", api.APISessionRequired(updateCategoriesForTeamForUser)).Methods("PUT")
 	api.BaseRoutes.ChannelCategories.Handle("/order", api.APISessionRequired(getCategoryOrderForTeamForUser)).Methods("GET")
 	api.BaseRoutes.ChannelCategories.Handle("/order", api.APISessionRequired(updateCategoryOrderForTeamForUser)).Methods("PUT")
+	api.BaseRoutes.ChannelCategories.Handle("/export", api.APISessionRequired(exportCategoriesForTeamForUser)).Methods("GET")
+	api.BaseRoutes.ChannelCategories.Handle("/export", api.APISessionRequired(importCategoriesForTeamForUser)).Methods("PUT")
 	api.BaseRoutes.ChannelCategories.Handle("/{category_id:[A-Za-z0-9_-]+}", api.APISessionRequired(getCategoryForTeamForUser)).Methods("GET")
 	api.BaseRoutes.ChannelCategories.Handle("/{category_id:[A-Za-z0-9_-]+}", api.APISessionRequired(updateCategoryForTeamForUser)).Methods("PUT")
 	api.BaseRoutes.ChannelCategories.Handle("/{category_id:[A-Za-z0-9_-]+}", api.APISessionRequired(deleteCategoryForTeamForUser)).Methods("DELETE")
@@ -50,14 +53,24 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.Channel.Handle("", api.APISessionRequired(updateChannel)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/patch", api.APISessionRequired(patchChannel)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/privacy", api.APISessionRequired(updateChannelPrivacy)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/creator", api.APISessionRequired(transferChannelCreator)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/restore", api.APISessionRequired(restoreChannel)).Methods("POST")
 	api.BaseRoutes.Channel.Handle("", api.APISessionRequired(deleteChannel)).Methods("DELETE")
 	api.BaseRoutes.Channel.Handle("/stats", api.APISessionRequired(getChannelStats)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/pinned", api.APISessionRequired(getPinnedPosts)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/presence", api.APISessionRequired(getChannelPresence)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/integrations", api.APISessionRequired(getChannelIntegrations)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/webhook_identity_overrides", api.APISessionRequired(getWebhookIdentityOverrides)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/thread_settings", api.APISessionRequired(getChannelThreadSettings)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/thread_settings", api.APISessionRequired(updateChannelThreadSettings)).Methods("PUT")
 	api.BaseRoutes.Channel.Handle("/timezones", api.APISessionRequired(getChannelMembersTimezones)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/members_minus_group_members", api.APISessionRequired(channelMembersMinusGroupMembers)).Methods("GET")
 	api.BaseRoutes.Channel.Handle("/move", api.APISessionRequired(moveChannel)).Methods("POST")
 	api.BaseRoutes.Channel.Handle("/member_counts_by_group", api.APISessionRequired(channelMemberCountsByGroup)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/events", api.APISessionRequired(getChannelEvents)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/events/{channel_event_id:[A-Za-z0-9]+}/revert", api.APISessionRequired(revertChannelEvent)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/language_stats", api.APISessionRequired(getChannelLanguageStats)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/stats/timeseries", api.APISessionRequired(getChannelStatsTimeseries)).Methods("GET")
 
 	api.BaseRoutes.ChannelForUser.Handle("/unread", api.APISessionRequired(getChannelUnread)).Methods("GET")
 
@@ -67,6 +80,8 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.ChannelMembers.Handle("", api.APISessionRequired(getChannelMembers)).Methods("GET")
 	api.BaseRoutes.ChannelMembers.Handle("/ids", api.APISessionRequired(getChannelMembersByIds)).Methods("POST")
 	api.BaseRoutes.ChannelMembers.Handle("", api.APISessionRequired(addChannelMember)).Methods("POST")
+	api.BaseRoutes.ChannelMembers.Handle("/bulk", api.APISessionRequired(bulkAddChannelMembers)).Methods("POST")
+	api.BaseRoutes.ChannelMembers.Handle("/bulk/{job_id:[A-Za-z0-9]+}", api.APISessionRequired(getChannelMemberBulkAddReport)).Methods("GET")
 	api.BaseRoutes.ChannelMembersForUser.Handle("", api.APISessionRequired(getChannelMembersForTeamForUser)).Methods("GET")
 	api.BaseRoutes.ChannelMember.Handle("", api.APISessionRequired(getChannelMember)).Methods("GET")
 	api.BaseRoutes.ChannelMember.Handle("", api.APISessionRequired(removeChannelMember)).Methods("DELETE")
@@ -290,6 +305,73 @@ func updateChannelPrivacy(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func transferChannelCreator(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.StringInterfaceFromJSON(r.Body)
+	userID, ok := props["user_id"].(string)
+	if !ok || !model.IsValidId(userID) {
+		c.SetInvalidParam("user_id")
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("transferChannelCreator", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel", channel)
+	auditRec.AddMeta("previous_creator_id", channel.CreatorId)
+	auditRec.AddMeta("new_creator_id", userID)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelRoles) {
+		c.SetPermissionError(model.PermissionManageChannelRoles)
+		return
+	}
+
+	transfer, err := c.App.TransferChannelCreator(channel, userID)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("name=" + transfer.Channel.Name + " creator_id=" + userID)
+
+	if err := json.NewEncoder(w).Encode(transfer); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelsWithDeactivatedCreator(c *Context, w http.ResponseWriter, r *http.Request) {
+	teamID := r.URL.Query().Get("team_id")
+	if teamID != "" && !model.IsValidId(teamID) {
+		c.SetInvalidURLParam("team_id")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementChannels) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementChannels)
+		return
+	}
+
+	channels, err := c.App.GetChannelsWithDeactivatedCreator(teamID, c.Params.Page*c.Params.PerPage, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(channels); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func patchChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId()
 	if c.Err != nil {
@@ -338,6 +420,23 @@ func patchChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The sensitivity tag of a channel decides how much its push notifications disclose, so only
+	// admins can change it.
+	if patch.SensitivityTag != nil && *patch.SensitivityTag != oldChannel.SensitivityTag &&
+		!c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementChannels) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementChannels)
+		return
+	}
+
+	// Whether the incoming webhooks may post under another identity is up to the users managing
+	// them.
+	if ((patch.DisableWebhookUsernameOverride != nil && *patch.DisableWebhookUsernameOverride != oldChannel.DisableWebhookUsernameOverride) ||
+		(patch.DisableWebhookIconOverride != nil && *patch.DisableWebhookIconOverride != oldChannel.DisableWebhookIconOverride)) &&
+		!c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageIncomingWebhooks) {
+		c.SetPermissionError(model.PermissionManageIncomingWebhooks)
+		return
+	}
+
 	if oldChannel.Name == model.DefaultChannelName {
 		if patch.Name != nil && *patch.Name != oldChannel.Name {
 			c.Err = model.NewAppError("patchChannel", "api.channel.update_channel.tried.app_error", map[string]interface{}{"Channel": model.DefaultChannelName}, "", http.StatusBadRequest)
@@ -613,6 +712,147 @@ func getChannelUnread(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getChannelPresence(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	presence, err := c.App.GetChannelPresence(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(presence); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelIntegrations(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	// Listing what can post in a channel is meant for the channel admins.
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelRoles) {
+		c.SetPermissionError(model.PermissionManageChannelRoles)
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	integrations, err := c.App.GetChannelIntegrations(channel)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(integrations); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getWebhookIdentityOverrides(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageIncomingWebhooks) {
+		c.SetPermissionError(model.PermissionManageIncomingWebhooks)
+		return
+	}
+
+	overrides, err := c.App.GetWebhookIdentityOverrides(c.Params.ChannelId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(overrides); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelThreadSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(channel.ThreadSettings()); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateChannelThreadSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var settings model.ChannelThreadSettings
+	if jsonErr := json.NewDecoder(r.Body).Decode(&settings); jsonErr != nil {
+		c.SetInvalidParam("thread_settings")
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateChannelThreadSettings", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel", channel)
+	auditRec.AddMeta("thread_settings", settings)
+
+	permission := model.PermissionManagePublicChannelProperties
+	if channel.Type == model.ChannelTypePrivate {
+		permission = model.PermissionManagePrivateChannelProperties
+	}
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channel.Id, permission) {
+		c.SetPermissionError(permission)
+		return
+	}
+
+	updatedChannel, err := c.App.UpdateChannelThreadSettings(channel.DeepCopy(), &settings)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("name=" + updatedChannel.Name)
+
+	if err := json.NewEncoder(w).Encode(updatedChannel.ThreadSettings()); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getChannelStats(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId()
 	if c.Err != nil {
@@ -1326,7 +1566,15 @@ func getChannelMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	members, err := c.App.GetChannelMembersPage(c.Params.ChannelId, c.Params.Page, c.Params.PerPage)
+	opts := &model.ChannelMembersGetOptions{
+		Sort: r.URL.Query().Get("sort"),
+	}
+	if !model.IsValidChannelMembersSort(opts.Sort) {
+		c.SetInvalidURLParam("sort")
+		return
+	}
+
+	members, err := c.App.GetChannelMembersPageWithOptions(c.Params.ChannelId, c.Params.Page, c.Params.PerPage, opts)
 	if err != nil {
 		c.Err = err
 		return
@@ -1719,6 +1967,104 @@ func addChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// requireChannelMemberBulkAddPermission checks the user can add others to the channel, which
+// must be a public or private one.
+func requireChannelMemberBulkAddPermission(c *Context) *model.Channel {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return nil
+	}
+
+	channel, appErr := c.App.GetChannel(c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	switch channel.Type {
+	case model.ChannelTypeOpen:
+		if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channel.Id, model.PermissionManagePublicChannelMembers) {
+			c.SetPermissionError(model.PermissionManagePublicChannelMembers)
+			return nil
+		}
+	case model.ChannelTypePrivate:
+		if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channel.Id, model.PermissionManagePrivateChannelMembers) {
+			c.SetPermissionError(model.PermissionManagePrivateChannelMembers)
+			return nil
+		}
+	default:
+		c.Err = model.NewAppError("requireChannelMemberBulkAddPermission", "api.channel.add_user_to_channel.type.app_error", nil, "", http.StatusBadRequest)
+		return nil
+	}
+
+	return channel
+}
+
+func bulkAddChannelMembers(c *Context, w http.ResponseWriter, r *http.Request) {
+	channel := requireChannelMemberBulkAddPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	var req model.ChannelMemberBulkAddRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		c.SetInvalidParam("user_ids")
+		return
+	}
+	if appErr := req.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("bulkAddChannelMembers", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel", channel)
+	auditRec.AddMeta("user_count", len(req.UserIds))
+
+	report, appErr := c.App.CreateChannelMemberBulkAddJob(channel, c.AppContext.Session().UserId, req.UserIds)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(report)
+	if err != nil {
+		c.Err = model.NewAppError("bulkAddChannelMembers", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("job_id", report.JobId)
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(js)
+}
+
+func getChannelMemberBulkAddReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireJobId()
+	if c.Err != nil {
+		return
+	}
+
+	channel := requireChannelMemberBulkAddPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	report, appErr := c.App.GetChannelMemberBulkAddReport(channel.Id, c.Params.JobId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(report)
+	if err != nil {
+		c.Err = model.NewAppError("getChannelMemberBulkAddReport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
+
 func removeChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireChannelId().RequireUserId()
 	if c.Err != nil {
@@ -1918,6 +2264,76 @@ func channelMemberCountsByGroup(c *Context, w http.ResponseWriter, r *http.Reque
 	w.Write(b)
 }
 
+func getChannelEvents(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	events, err := c.App.GetChannelEvents(c.Params.ChannelId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	b, marshalErr := json.Marshal(events)
+	if marshalErr != nil {
+		c.Err = model.NewAppError("Api4.getChannelEvents", "api.marshal_error", nil, marshalErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(b)
+}
+
+func revertChannelEvent(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireChannelEventId()
+	if c.Err != nil {
+		return
+	}
+
+	// Reverting a recent conversion only restores the previous type of the channel, so it's
+	// allowed to the channel admins even without the permissions to convert channels.
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelRoles) {
+		c.SetPermissionError(model.PermissionManageChannelRoles)
+		return
+	}
+
+	event, err := c.App.GetChannelEvent(c.Params.ChannelId, c.Params.ChannelEventId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revertChannelEvent", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("channel_event", event)
+
+	user, err := c.App.GetUser(c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	channel, err := c.App.RevertChannelConversion(c.AppContext, event, user)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("name=" + channel.Name)
+
+	if err := json.NewEncoder(w).Encode(channel); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getChannelModerations(c *Context, w http.ResponseWriter, r *http.Request) {
 	if c.App.Channels().License() == nil {
 		c.Err = model.NewAppError("Api4.GetChannelModerations", "api.channel.get_channel_moderations.license.error", nil, "", http.StatusNotImplemented)
@@ -2087,3 +2503,83 @@ func moveChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 		mlog.Warn("Error while writing response", mlog.Err(err))
 	}
 }
+
+func getChannelLanguageStats(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	days := model.ChannelLanguageStatsDefaultDays
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+		days, err = strconv.Atoi(daysStr)
+		if err != nil || days < 1 || days > model.ChannelLanguageStatsMaxDays {
+			c.SetInvalidURLParam("days")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelRoles) {
+		c.SetPermissionError(model.PermissionManageChannelRoles)
+		return
+	}
+
+	stats, err := c.App.GetChannelLanguageStats(c.Params.ChannelId, days)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelStatsTimeseries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	// The range is rounded to whole UTC days, the days the stats are rolled up for.
+	parseDay := func(name string, defaultDay int64) (int64, bool) {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			return defaultDay, true
+		}
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || millis < 0 {
+			return 0, false
+		}
+		return millis - millis%model.TeamStatsDayMillis, true
+	}
+
+	now := model.GetMillis()
+	until, ok := parseDay("until", now-now%model.TeamStatsDayMillis)
+	if !ok {
+		c.SetInvalidURLParam("until")
+		return
+	}
+
+	since, ok := parseDay("since", until-model.ChannelStatsTimeseriesDefaultDays*model.TeamStatsDayMillis)
+	if !ok || since >= until || until-since > model.ChannelStatsTimeseriesMaxDays*model.TeamStatsDayMillis {
+		c.SetInvalidURLParam("since")
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	timeseries, err := c.App.GetChannelStatsTimeseries(c.Params.ChannelId, since, until)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(timeseries); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelArchivePolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+
+	t.Run("requires permission", func(t *testing.T) {
+		th.LinkUserToTeam(th.BasicUser2, team)
+
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, resp, err := client.GetChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.UpdateChannelArchivePolicy(team.Id, &model.ChannelArchivePolicy{InactiveDays: 30})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.DeleteChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateChannelArchivePolicy(team.Id, &model.ChannelArchivePolicy{InactiveDays: model.ChannelArchiveWarningDays})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		created, _, err := th.Client.UpdateChannelArchivePolicy(team.Id, &model.ChannelArchivePolicy{
+			TeamId:       th.BasicTeam.Id,
+			InactiveDays: 30,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, team.Id, created.TeamId, "the team is taken from the URL")
+		assert.Equal(t, 30, created.InactiveDays)
+
+		excludedID := model.NewId()
+		updated, _, err := th.Client.UpdateChannelArchivePolicy(team.Id, &model.ChannelArchivePolicy{
+			InactiveDays:       60,
+			ExcludedChannelIds: model.StringArray{excludedID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, created.CreateAt, updated.CreateAt)
+
+		fetched, _, err := th.Client.GetChannelArchivePolicy(team.Id)
+		require.NoError(t, err)
+		assert.Equal(t, 60, fetched.InactiveDays)
+		assert.Equal(t, model.StringArray{excludedID}, fetched.ExcludedChannelIds)
+
+		_, err = th.Client.DeleteChannelArchivePolicy(team.Id)
+		require.NoError(t, err)
+
+		resp, err = th.Client.DeleteChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -134,6 +134,70 @@ func updateCategoryOrderForTeamForUser(c *Context, w http.ResponseWriter, r *htt
 	w.Write([]byte(model.ArrayToJSON(categoryOrder)))
 }
 
+func exportCategoriesForTeamForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	export, err := c.App.ExportSidebarCategories(c.Params.UserId, c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	exportJSON, jsonErr := json.Marshal(export)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("exportCategoriesForTeamForUser", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(exportJSON)
+}
+
+func importCategoriesForTeamForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("importCategoriesForTeamForUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	var export *model.SidebarCategoriesExport
+	if err := json.NewDecoder(r.Body).Decode(&export); err != nil || export == nil {
+		c.SetInvalidParam("categories")
+		return
+	}
+
+	categories, err := c.App.ImportSidebarCategories(c.Params.UserId, c.Params.TeamId, export)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	categoriesJSON, jsonErr := json.Marshal(categories)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("importCategoriesForTeamForUser", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	w.Write(categoriesJSON)
+}
+
 func getCategoryForTeamForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId().RequireTeamId().RequireCategoryId()
 	if c.Err != nil {
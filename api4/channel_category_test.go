@@ -534,3 +534,74 @@ func setupUserForSubtest(t *testing.T, th *TestHelper) (*model.User, *model.Clie
 
 	return user, client
 }
+
+func TestExportImportCategoriesForTeamForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user, client := setupUserForSubtest(t, th)
+
+	_, _, err := client.CreateSidebarCategoryForTeamForUser(user.Id, th.BasicTeam.Id, &model.SidebarCategoryWithChannels{
+		SidebarCategory: model.SidebarCategory{
+			UserId:      user.Id,
+			TeamId:      th.BasicTeam.Id,
+			DisplayName: "Onboarding",
+		},
+		Channels: []string{th.BasicChannel.Id},
+	})
+	require.NoError(t, err)
+
+	export, _, err := client.ExportSidebarCategoriesForTeamForUser(user.Id, th.BasicTeam.Id)
+	require.NoError(t, err)
+	require.Len(t, export.Categories, 4)
+	assert.Equal(t, "Onboarding", export.Categories[1].DisplayName)
+	assert.Equal(t, []string{th.BasicChannel.Name}, export.Categories[1].ChannelNames)
+
+	t.Run("should apply the layout to another user", func(t *testing.T) {
+		other, otherClient := setupUserForSubtest(t, th)
+
+		categories, _, err := otherClient.ImportSidebarCategoriesForTeamForUser(other.Id, th.BasicTeam.Id, export)
+		require.NoError(t, err)
+		require.Len(t, categories.Categories, 4)
+		assert.Equal(t, "Onboarding", categories.Categories[1].DisplayName)
+		assert.Equal(t, []string{th.BasicChannel.Id}, categories.Categories[1].Channels)
+	})
+
+	t.Run("should reject channels the user is not a member of", func(t *testing.T) {
+		other, otherClient := setupUserForSubtest(t, th)
+		_, err := th.SystemAdminClient.RemoveUserFromChannel(th.BasicChannel.Id, other.Id)
+		require.NoError(t, err)
+
+		_, resp, err := otherClient.ImportSidebarCategoriesForTeamForUser(other.Id, th.BasicTeam.Id, export)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should reject an invalid layout", func(t *testing.T) {
+		invalid := &model.SidebarCategoriesExport{Categories: []*model.SidebarCategoryExport{{Type: model.SidebarCategoryCustom}}}
+
+		_, resp, err := client.ImportSidebarCategoriesForTeamForUser(user.Id, th.BasicTeam.Id, invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should not allow the layout of another user to be read or changed", func(t *testing.T) {
+		other, _ := setupUserForSubtest(t, th)
+
+		_, resp, err := client.ExportSidebarCategoriesForTeamForUser(other.Id, th.BasicTeam.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.ImportSidebarCategoriesForTeamForUser(other.Id, th.BasicTeam.Id, export)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should allow a system admin to apply a layout to a user", func(t *testing.T) {
+		other, _ := setupUserForSubtest(t, th)
+
+		categories, _, err := th.SystemAdminClient.ImportSidebarCategoriesForTeamForUser(other.Id, th.BasicTeam.Id, export)
+		require.NoError(t, err)
+		assert.Equal(t, "Onboarding", categories.Categories[1].DisplayName)
+	})
+}
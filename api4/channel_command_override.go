@@ -0,0 +1,149 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitChannelCommandOverride() {
+	api.BaseRoutes.Channel.Handle("/commands", api.APISessionRequired(listChannelCommands)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/commands/disabled", api.APISessionRequired(getDisabledChannelCommands)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/commands/disabled", api.APISessionRequired(disableChannelCommand)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/commands/disabled/{trigger:[^/]+}", api.APISessionRequired(enableChannelCommand)).Methods("DELETE")
+}
+
+func listChannelCommands(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	// Direct and group channels belong to no team, the commands of the team the user is in
+	// are listed then.
+	teamID := channel.TeamId
+	if teamID == "" {
+		teamID = r.URL.Query().Get("team_id")
+		if teamID != "" && !model.IsValidId(teamID) {
+			c.SetInvalidParam("team_id")
+			return
+		}
+		if teamID != "" && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), teamID, model.PermissionViewTeam) {
+			c.SetPermissionError(model.PermissionViewTeam)
+			return
+		}
+	}
+
+	commands, err := c.App.ListChannelCommands(channel.Id, teamID, c.AppContext.T)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(commands); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getDisabledChannelCommands(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	overrides, err := c.App.GetDisabledChannelCommands(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(overrides); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func disableChannelCommand(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var override model.ChannelCommandOverride
+	if jsonErr := json.NewDecoder(r.Body).Decode(&override); jsonErr != nil {
+		c.SetInvalidParam("channel_command_override")
+		return
+	}
+	override.ChannelId = c.Params.ChannelId
+	override.CreatorId = c.AppContext.Session().UserId
+	override.CreateAt = 0
+
+	auditRec := c.MakeAuditRecord("disableChannelCommand", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("trigger", override.Trigger)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelCommands) {
+		c.SetPermissionError(model.PermissionManageChannelCommands)
+		return
+	}
+
+	saved, err := c.App.DisableChannelCommand(&override)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func enableChannelCommand(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireCommandTrigger()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("enableChannelCommand", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("trigger", c.Params.CommandTrigger)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelCommands) {
+		c.SetPermissionError(model.PermissionManageChannelCommands)
+		return
+	}
+
+	if err := c.App.EnableChannelCommand(c.Params.ChannelId, c.Params.CommandTrigger); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
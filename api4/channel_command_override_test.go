@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func hasCommandTrigger(commands []*model.Command, trigger string) bool {
+	for _, cmd := range commands {
+		if cmd.Trigger == trigger {
+			return true
+		}
+	}
+	return false
+}
+
+func TestChannelCommandOverrides(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.BasicChannel
+	th.MakeUserChannelAdmin(th.BasicUser, channel)
+
+	t.Run("requires permission", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, _, err := client.GetDisabledChannelCommands(channel.Id)
+		require.NoError(t, err)
+
+		_, resp, err := client.DisableChannelCommand(channel.Id, "echo")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.EnableChannelCommand(channel.Id, "echo")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetChannelCommands(th.BasicPrivateChannel2.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("disable and enable", func(t *testing.T) {
+		commands, _, err := th.Client.GetChannelCommands(channel.Id, "")
+		require.NoError(t, err)
+		require.True(t, hasCommandTrigger(commands, "echo"))
+
+		override, resp, err := th.Client.DisableChannelCommand(channel.Id, "/Echo")
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, "echo", override.Trigger)
+		assert.Equal(t, th.BasicUser.Id, override.CreatorId)
+
+		again, _, err := th.Client.DisableChannelCommand(channel.Id, "echo")
+		require.NoError(t, err)
+		assert.Equal(t, override.CreateAt, again.CreateAt)
+
+		overrides, _, err := th.Client.GetDisabledChannelCommands(channel.Id)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, "echo", overrides[0].Trigger)
+
+		commands, _, err = th.Client.GetChannelCommands(channel.Id, "")
+		require.NoError(t, err)
+		assert.False(t, hasCommandTrigger(commands, "echo"))
+
+		_, resp, err = th.Client.ExecuteCommand(channel.Id, "/echo hello")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.Client.ExecuteCommand(th.BasicChannel2.Id, "/echo hello")
+		require.NoError(t, err)
+
+		_, err = th.Client.EnableChannelCommand(channel.Id, "echo")
+		require.NoError(t, err)
+
+		resp, err = th.Client.EnableChannelCommand(channel.Id, "echo")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, _, err = th.Client.ExecuteCommand(channel.Id, "/echo hello")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid trigger", func(t *testing.T) {
+		_, resp, err := th.Client.DisableChannelCommand(channel.Id, "gi phy")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitChannelDigest() {
+	api.BaseRoutes.User.Handle("/channel_digests", api.APISessionRequired(getChannelDigestsForUser)).Methods("GET")
+	api.BaseRoutes.ChannelForUser.Handle("/digest", api.APISessionRequired(saveChannelDigest)).Methods("PUT")
+	api.BaseRoutes.ChannelForUser.Handle("/digest", api.APISessionRequired(deleteChannelDigest)).Methods("DELETE")
+}
+
+func requireChannelDigestsEnabled(c *Context, where string) {
+	if !*c.App.Config().EmailSettings.EnableChannelDigestEmails {
+		c.Err = model.NewAppError(where, "api.channel_digest.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+}
+
+func getChannelDigestsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	requireChannelDigestsEnabled(c, "getChannelDigestsForUser")
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	digests, err := c.App.GetChannelDigestsForUser(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(digests); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func saveChannelDigest(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var digest model.ChannelDigest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&digest); jsonErr != nil || !model.IsValidChannelDigestCadence(digest.Cadence) {
+		c.SetInvalidParam("cadence")
+		return
+	}
+
+	requireChannelDigestsEnabled(c, "saveChannelDigest")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("saveChannelDigest", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	saved, err := c.App.SaveChannelDigest(&model.ChannelDigest{
+		UserId:    c.Params.UserId,
+		ChannelId: c.Params.ChannelId,
+		Cadence:   digest.Cadence,
+	})
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("cadence", saved.Cadence)
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteChannelDigest(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteChannelDigest", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.DeleteChannelDigest(c.Params.UserId, c.Params.ChannelId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
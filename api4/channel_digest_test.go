@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelDigests(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.SaveChannelDigest(th.BasicUser.Id, th.BasicChannel.Id, model.ChannelDigestCadenceDaily)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableChannelDigestEmails = true })
+
+	t.Run("save, list and delete", func(t *testing.T) {
+		digest, _, err := th.Client.SaveChannelDigest(th.BasicUser.Id, th.BasicChannel.Id, model.ChannelDigestCadenceDaily)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelDigestCadenceDaily, digest.Cadence)
+
+		digest, _, err = th.Client.SaveChannelDigest(th.BasicUser.Id, th.BasicChannel.Id, model.ChannelDigestCadenceWeekly)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelDigestCadenceWeekly, digest.Cadence)
+
+		digests, _, err := th.Client.GetChannelDigestsForUser(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, digests, 1)
+		assert.Equal(t, th.BasicChannel.Id, digests[0].ChannelId)
+
+		_, err = th.Client.DeleteChannelDigest(th.BasicUser.Id, th.BasicChannel.Id)
+		require.NoError(t, err)
+
+		resp, err := th.Client.DeleteChannelDigest(th.BasicUser.Id, th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("invalid cadence", func(t *testing.T) {
+		_, resp, err := th.Client.SaveChannelDigest(th.BasicUser.Id, th.BasicChannel.Id, "hourly")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a member of the channel", func(t *testing.T) {
+		channel := th.CreatePrivateChannel()
+		th.RemoveUserFromChannel(th.BasicUser, channel)
+
+		_, resp, err := th.Client.SaveChannelDigest(th.BasicUser.Id, channel.Id, model.ChannelDigestCadenceDaily)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := th.Client.SaveChannelDigest(th.BasicUser2.Id, th.BasicChannel.Id, model.ChannelDigestCadenceDaily)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetChannelDigestsForUser(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.SystemAdminClient.SaveChannelDigest(th.BasicUser2.Id, th.BasicChannel.Id, model.ChannelDigestCadenceDaily)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitChannelFeed() {
+	api.BaseRoutes.Channel.Handle("/feeds", api.APISessionRequired(getChannelFeeds)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/feeds", api.APISessionRequired(createChannelFeed)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/feeds/{feed_id:[A-Za-z0-9]+}", api.APISessionRequired(getChannelFeed)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/feeds/{feed_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchChannelFeed)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/feeds/{feed_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteChannelFeed)).Methods("DELETE")
+}
+
+// checkChannelFeedPermissions makes sure the session can manage the integrations of the team of
+// the channel in the URL, feeds posting to a channel the way incoming webhooks do, and can read
+// the channel when it's private.
+func checkChannelFeedPermissions(c *Context) bool {
+	channel, err := c.App.GetChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), channel.TeamId, model.PermissionManageIncomingWebhooks) {
+		c.SetPermissionError(model.PermissionManageIncomingWebhooks)
+		return false
+	}
+
+	if channel.Type != model.ChannelTypeOpen && !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channel.Id, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return false
+	}
+
+	return true
+}
+
+// getChannelFeedForChannel returns the feed in the URL, making sure it belongs to the channel
+// in the URL.
+func getChannelFeedForChannel(c *Context) *model.ChannelFeed {
+	channelFeed, err := c.App.GetChannelFeed(c.Params.ChannelFeedId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if channelFeed.ChannelId != c.Params.ChannelId {
+		c.Err = model.NewAppError("getChannelFeedForChannel", "app.channel_feed.get.not_found.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return channelFeed
+}
+
+func getChannelFeeds(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkChannelFeedPermissions(c) {
+		return
+	}
+
+	feeds, err := c.App.GetChannelFeedsForChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(feeds); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createChannelFeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var channelFeed model.ChannelFeed
+	if jsonErr := json.NewDecoder(r.Body).Decode(&channelFeed); jsonErr != nil {
+		c.SetInvalidParam("channel_feed")
+		return
+	}
+	channelFeed.Id = ""
+	channelFeed.ChannelId = c.Params.ChannelId
+	channelFeed.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createChannelFeed", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("url", channelFeed.URL)
+
+	if !checkChannelFeedPermissions(c) {
+		return
+	}
+
+	created, err := c.App.CreateChannelFeed(&channelFeed)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("feed_id", created.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelFeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireChannelFeedId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkChannelFeedPermissions(c) {
+		return
+	}
+
+	channelFeed := getChannelFeedForChannel(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(channelFeed); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchChannelFeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireChannelFeedId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.ChannelFeedPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParam("channel_feed")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchChannelFeed", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("feed_id", c.Params.ChannelFeedId)
+
+	if !checkChannelFeedPermissions(c) {
+		return
+	}
+
+	if getChannelFeedForChannel(c); c.Err != nil {
+		return
+	}
+
+	patched, err := c.App.PatchChannelFeed(c.Params.ChannelFeedId, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteChannelFeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireChannelFeedId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteChannelFeed", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("feed_id", c.Params.ChannelFeedId)
+
+	if !checkChannelFeedPermissions(c) {
+		return
+	}
+
+	if getChannelFeedForChannel(c); c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteChannelFeed(c.Params.ChannelFeedId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestChannelFeeds(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newFeed := func(channelID string) *model.ChannelFeed {
+		return &model.ChannelFeed{ChannelId: channelID, URL: "https://example.com/feed.xml"}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateChannelFeed(newFeed(th.BasicChannel.Id))
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, 501)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableChannelFeeds = true })
+
+	defaultRolePermissions := th.SaveDefaultRolePermissions()
+	defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+	th.AddPermissionToRole(model.PermissionManageIncomingWebhooks.Id, model.TeamAdminRoleId)
+	th.RemovePermissionFromRole(model.PermissionManageIncomingWebhooks.Id, model.TeamUserRoleId)
+
+	t.Run("requires the permission to manage integrations", func(t *testing.T) {
+		_, resp, err := th.Client.CreateChannelFeed(newFeed(th.BasicChannel.Id))
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetChannelFeeds(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.AddPermissionToRole(model.PermissionManageIncomingWebhooks.Id, model.TeamUserRoleId)
+
+	t.Run("invalid feeds", func(t *testing.T) {
+		channelFeed := newFeed(th.BasicChannel.Id)
+		channelFeed.URL = "not a url"
+		_, resp, err := th.Client.CreateChannelFeed(channelFeed)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "model.channel_feed.is_valid.url.app_error")
+
+		channelFeed = newFeed(th.BasicChannel.Id)
+		channelFeed.Template = "{{.Title"
+		_, resp, err = th.Client.CreateChannelFeed(channelFeed)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "model.channel_feed.is_valid.template_parse.app_error")
+	})
+
+	t.Run("private channels require membership", func(t *testing.T) {
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		_, resp, err := th.Client.CreateChannelFeed(newFeed(private.Id))
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("crud", func(t *testing.T) {
+		created, resp, err := th.Client.CreateChannelFeed(newFeed(th.BasicChannel.Id))
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.BasicUser.Id, created.CreatorId)
+		assert.Equal(t, th.BasicTeam.Id, created.TeamId)
+		assert.Equal(t, model.ChannelFeedDefaultPollInterval, created.PollIntervalMinutes)
+
+		feeds, _, err := th.Client.GetChannelFeeds(th.BasicChannel.Id)
+		require.NoError(t, err)
+		require.Len(t, feeds, 1)
+		assert.Equal(t, created.Id, feeds[0].Id)
+
+		got, _, err := th.Client.GetChannelFeed(th.BasicChannel.Id, created.Id)
+		require.NoError(t, err)
+		assert.Equal(t, created.URL, got.URL)
+
+		_, resp, err = th.Client.GetChannelFeed(th.BasicChannel2.Id, created.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		patched, _, err := th.Client.PatchChannelFeed(th.BasicChannel.Id, created.Id, &model.ChannelFeedPatch{
+			Template:            model.NewString("{{.Title}}"),
+			PollIntervalMinutes: model.NewInt(60),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "{{.Title}}", patched.Template)
+		assert.Equal(t, 60, patched.PollIntervalMinutes)
+
+		_, err = th.Client.DeleteChannelFeed(th.BasicChannel.Id, created.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetChannelFeed(th.BasicChannel.Id, created.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -2037,6 +2037,90 @@ func TestUpdateChannelPrivacy(t *testing.T) {
 	})
 }
 
+func TestRevertChannelEvent(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	// BasicUser created the channel, so it's one of its admins.
+	channel := th.CreatePublicChannel()
+
+	_, _, err := th.SystemAdminClient.UpdateChannelPrivacy(channel.Id, model.ChannelTypePrivate)
+	require.NoError(t, err)
+
+	events, _, err := th.Client.GetChannelEvents(channel.Id, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	event := events[0]
+	assert.Equal(t, model.ChannelEventTypeConvert, event.Type)
+	assert.Equal(t, string(model.ChannelTypeOpen), event.OldValue)
+	assert.Equal(t, string(model.ChannelTypePrivate), event.NewValue)
+	assert.Equal(t, th.SystemAdminUser.Id, event.UserId)
+
+	t.Run("the system post references the event", func(t *testing.T) {
+		posts, _, err := th.Client.GetPostsForChannel(channel.Id, 0, 1, "", false)
+		require.NoError(t, err)
+		require.Len(t, posts.Order, 1)
+		post := posts.Posts[posts.Order[0]]
+		assert.Equal(t, model.PostTypeChangeChannelPrivacy, post.Type)
+		assert.Equal(t, event.Id, post.GetProp(model.PostPropsChannelEventId))
+		assert.NotNil(t, post.GetProp(model.PostPropsRevertExpireAt))
+	})
+
+	t.Run("only channel admins can revert", func(t *testing.T) {
+		th.AddUserToChannel(th.BasicUser2, channel)
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.RevertChannelEvent(channel.Id, event.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		_, resp, err := th.Client.RevertChannelEvent(channel.Id, model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		reverted, _, err := th.Client.RevertChannelEvent(channel.Id, event.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelTypeOpen, reverted.Type)
+
+		events, _, err := th.Client.GetChannelEvents(channel.Id, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, string(model.ChannelTypeOpen), events[0].NewValue)
+		assert.Equal(t, event.Id, events[1].Id)
+		assert.True(t, events[1].IsReverted())
+		assert.Equal(t, th.BasicUser.Id, events[1].RevertedBy)
+
+		_, resp, err := th.Client.RevertChannelEvent(channel.Id, event.Id)
+		require.Error(t, err)
+		CheckErrorID(t, err, "app.channel_event.revert.already_reverted.app_error")
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("revert window expired", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.ChannelConversionRevertWindowMinutes = 0 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.TeamSettings.ChannelConversionRevertWindowMinutes = model.TeamSettingsDefaultChannelConversionRevertWindow
+		})
+
+		_, _, err := th.SystemAdminClient.UpdateChannelPrivacy(channel.Id, model.ChannelTypePrivate)
+		require.NoError(t, err)
+
+		events, _, err := th.Client.GetChannelEvents(channel.Id, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		_, resp, err := th.Client.RevertChannelEvent(channel.Id, events[0].Id)
+		require.Error(t, err)
+		CheckErrorID(t, err, "app.channel_event.revert.expired.app_error")
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestRestoreChannel(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2231,6 +2315,33 @@ func TestGetChannelMembers(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 }
 
+func TestGetChannelMembersSorted(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.CreateMessagePostWithClient(th.Client, th.BasicChannel, "basic post")
+
+	members, _, err := th.Client.GetChannelMembersSorted(th.BasicChannel.Id, 0, 60, model.ChannelMembersSortByLastPostAt, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, members)
+	require.Equal(t, th.BasicUser.Id, members[0].UserId)
+
+	members, _, err = th.Client.GetChannelMembersSorted(th.BasicChannel.Id, 0, 60, model.ChannelMembersSortByUsername, "")
+	require.NoError(t, err)
+	users, _, err := th.Client.GetUsersByIds([]string{members[0].UserId, members[len(members)-1].UserId})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	first, last := users[0], users[1]
+	if first.Id != members[0].UserId {
+		first, last = last, first
+	}
+	require.LessOrEqual(t, first.Username, last.Username)
+
+	_, resp, err := th.Client.GetChannelMembersSorted(th.BasicChannel.Id, 0, 60, "unknown", "")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestGetChannelMembersByIds(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2495,6 +2606,57 @@ func TestGetChannelUnread(t *testing.T) {
 	CheckNotFoundStatus(t, resp)
 }
 
+func TestGetChannelPresence(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	t.Run("should fail when channel presence is disabled", func(t *testing.T) {
+		_, resp, err := client.GetChannelPresence(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.ShowChannelPresence = true })
+
+	t.Run("should list the users viewing the channel", func(t *testing.T) {
+		presence, _, err := client.GetChannelPresence(th.BasicChannel.Id)
+		require.NoError(t, err)
+		require.Equal(t, th.BasicChannel.Id, presence.ChannelId)
+		require.Empty(t, presence.UserIds)
+
+		appErr := th.App.SetChannelPresence(model.Session{UserId: th.BasicUser2.Id, Token: model.NewId()}, th.BasicChannel.Id)
+		require.Nil(t, appErr)
+
+		presence, _, err = client.GetChannelPresence(th.BasicChannel.Id)
+		require.NoError(t, err)
+		require.Equal(t, []string{th.BasicUser2.Id}, presence.UserIds)
+	})
+
+	t.Run("should not list users who opted out", func(t *testing.T) {
+		appErr := th.App.UpdatePreferences(th.BasicUser.Id, model.Preferences{{
+			UserId:   th.BasicUser.Id,
+			Category: model.PreferenceCategoryPrivacy,
+			Name:     model.PreferenceNameShareChannelPresence,
+			Value:    "false",
+		}})
+		require.Nil(t, appErr)
+
+		appErr = th.App.SetChannelPresence(model.Session{UserId: th.BasicUser.Id, Token: model.NewId()}, th.BasicChannel.Id)
+		require.Nil(t, appErr)
+
+		presence, _, err := client.GetChannelPresence(th.BasicChannel.Id)
+		require.NoError(t, err)
+		require.NotContains(t, presence.UserIds, th.BasicUser.Id)
+	})
+
+	t.Run("should require permission to read the channel", func(t *testing.T) {
+		_, resp, err := client.GetChannelPresence(th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate).Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetChannelStats(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3013,6 +3175,55 @@ func TestAddChannelMember(t *testing.T) {
 	})
 }
 
+func TestBulkAddChannelMembers(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	privateChannel := th.CreatePrivateChannel()
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+
+	t.Run("no permissions", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionManagePrivateChannelMembers.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionManagePrivateChannelMembers.Id, model.ChannelUserRoleId)
+
+		_, resp, err := th.Client.BulkAddChannelMembers(privateChannel.Id, []string{user.Id})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("no users", func(t *testing.T) {
+		_, resp, err := th.Client.BulkAddChannelMembers(privateChannel.Id, []string{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("direct channel", func(t *testing.T) {
+		dm, _, err := th.Client.CreateDirectChannel(th.BasicUser.Id, th.BasicUser2.Id)
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.BulkAddChannelMembers(dm.Id, []string{user.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("queued", func(t *testing.T) {
+		report, resp, err := th.Client.BulkAddChannelMembers(privateChannel.Id, []string{user.Id, th.BasicUser2.Id})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		require.Equal(t, privateChannel.Id, report.ChannelId)
+		require.Equal(t, 2, report.Total)
+
+		fetched, _, err := th.Client.GetChannelMemberBulkAddReport(privateChannel.Id, report.JobId)
+		require.NoError(t, err)
+		require.Equal(t, report.JobId, fetched.JobId)
+
+		_, resp, err = th.Client.GetChannelMemberBulkAddReport(th.BasicChannel.Id, report.JobId)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
 func TestAddChannelMemberFromThread(t *testing.T) {
 	t.Skip("MM-41285")
 	th := Setup(t).InitBasic()
@@ -4536,3 +4747,359 @@ func TestViewChannelWithoutCollapsedThreads(t *testing.T) {
 	require.NoError(t, err)
 	require.Zero(t, threads.TotalUnreadMentions)
 }
+
+func TestGetChannelIntegrations(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableIncomingWebhooks = true
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.EnableCommands = true
+	})
+
+	incoming, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, appErr)
+
+	outgoing, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		CreatorId:    th.BasicUser2.Id,
+		TeamId:       th.BasicTeam.Id,
+		ChannelId:    th.BasicChannel.Id,
+		CallbackURLs: []string{"http://example.com/hook"},
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		CreatorId:    th.BasicUser2.Id,
+		TeamId:       th.BasicTeam.Id,
+		ChannelId:    th.BasicChannel2.Id,
+		CallbackURLs: []string{"http://example.com/other"},
+	})
+	require.Nil(t, appErr)
+
+	command, appErr := th.App.CreateCommand(&model.Command{
+		CreatorId: th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		Trigger:   "deploy",
+		Method:    model.CommandMethodPost,
+		URL:       "http://example.com/command",
+	})
+	require.Nil(t, appErr)
+
+	bot, appErr := th.App.CreateBot(th.Context, &model.Bot{Username: "channelbot", OwnerId: th.BasicUser.Id})
+	require.Nil(t, appErr)
+	botUser, appErr := th.App.GetUser(bot.UserId)
+	require.Nil(t, appErr)
+	_, _, appErr = th.App.AddUserToTeam(th.Context, th.BasicTeam.Id, bot.UserId, "")
+	require.Nil(t, appErr)
+	_, appErr = th.App.AddUserToChannel(botUser, th.BasicChannel, false)
+	require.Nil(t, appErr)
+
+	t.Run("should list the integrations of the channel", func(t *testing.T) {
+		integrations, _, err := th.SystemAdminClient.GetChannelIntegrations(th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicChannel.Id, integrations.ChannelId)
+
+		require.Len(t, integrations.IncomingWebhooks, 1)
+		assert.Equal(t, incoming.Id, integrations.IncomingWebhooks[0].Id)
+
+		require.Len(t, integrations.OutgoingWebhooks, 1)
+		assert.Equal(t, outgoing.Id, integrations.OutgoingWebhooks[0].Id)
+		assert.Empty(t, integrations.OutgoingWebhooks[0].Token)
+
+		require.Len(t, integrations.Commands, 1)
+		assert.Equal(t, command.Id, integrations.Commands[0].Id)
+		assert.Empty(t, integrations.Commands[0].Token)
+
+		require.Len(t, integrations.Bots, 1)
+		assert.Equal(t, bot.UserId, integrations.Bots[0].UserId)
+
+		require.Len(t, integrations.Owners, 2)
+		assert.Equal(t, th.BasicUser.Username, integrations.Owners[th.BasicUser.Id].Username)
+		assert.Equal(t, th.BasicUser2.Username, integrations.Owners[th.BasicUser2.Id].Username)
+		assert.Empty(t, integrations.Owners[th.BasicUser.Id].Password)
+	})
+
+	t.Run("should require to be a channel admin", func(t *testing.T) {
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+
+		_, resp, err := client2.GetChannelIntegrations(th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		th.MakeUserChannelAdmin(th.BasicUser2, th.BasicChannel)
+
+		_, _, err = client2.GetChannelIntegrations(th.BasicChannel.Id)
+		require.NoError(t, err)
+	})
+}
+
+func TestWebhookIdentityOverrides(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableIncomingWebhooks = true
+		*cfg.ServiceSettings.EnablePostUsernameOverride = true
+		*cfg.ServiceSettings.EnablePostIconOverride = true
+	})
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id, Username: "deploybot"})
+	require.Nil(t, appErr)
+
+	t.Run("only the users managing the webhooks can restrict the overrides", func(t *testing.T) {
+		patch := &model.ChannelPatch{DisableWebhookUsernameOverride: model.NewBool(true)}
+
+		_, resp, err := th.Client.PatchChannel(th.BasicChannel.Id, patch)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		channel, _, err := th.SystemAdminClient.PatchChannel(th.BasicChannel.Id, patch)
+		require.NoError(t, err)
+		assert.True(t, channel.DisableWebhookUsernameOverride)
+		assert.False(t, channel.DisableWebhookIconOverride)
+	})
+
+	appErr = th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{Text: "hello", Username: "ceo"})
+	require.Nil(t, appErr)
+
+	t.Run("should list the overrides of the channel", func(t *testing.T) {
+		overrides, _, err := th.SystemAdminClient.GetWebhookIdentityOverrides(th.BasicChannel.Id, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, hook.Id, overrides[0].HookId)
+		assert.Equal(t, th.BasicChannel.Id, overrides[0].ChannelId)
+		assert.Equal(t, "ceo", overrides[0].Username)
+		assert.True(t, overrides[0].UsernameBlocked)
+
+		overrides, _, err = th.SystemAdminClient.GetWebhookIdentityOverrides(th.BasicChannel2.Id, 0, 60)
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+	})
+
+	t.Run("should require the permission to manage the webhooks", func(t *testing.T) {
+		_, resp, err := th.Client.GetWebhookIdentityOverrides(th.BasicChannel.Id, 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestTransferChannelCreator(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	channel := th.CreatePublicChannel()
+	th.AddUserToChannel(th.BasicUser2, channel)
+	incoming, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, channel, &model.IncomingWebhook{ChannelId: channel.Id})
+	require.Nil(t, appErr)
+
+	t.Run("should require to be a channel admin", func(t *testing.T) {
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+
+		_, resp, err := client2.TransferChannelCreator(channel.Id, th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should refuse users not in the channel", func(t *testing.T) {
+		_, resp, err := th.Client.TransferChannelCreator(channel.Id, th.SystemAdminUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should transfer the creator", func(t *testing.T) {
+		transfer, _, err := th.Client.TransferChannelCreator(channel.Id, th.BasicUser2.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser2.Id, transfer.Channel.CreatorId)
+		assert.Equal(t, th.BasicUser.Id, transfer.PreviousCreatorId)
+		require.Len(t, transfer.Integrations.IncomingWebhooks, 1)
+		assert.Equal(t, incoming.Id, transfer.Integrations.IncomingWebhooks[0].Id)
+
+		updated, appErr := th.App.GetChannel(channel.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser2.Id, updated.CreatorId)
+	})
+}
+
+func TestGetChannelsWithDeactivatedCreator(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	creator := th.CreateUser()
+	th.LinkUserToTeam(creator, th.BasicTeam)
+	channel, appErr := th.App.CreateChannelWithUser(th.Context, &model.Channel{
+		TeamId:      th.BasicTeam.Id,
+		DisplayName: "Orphan",
+		Name:        GenerateTestChannelName(),
+		Type:        model.ChannelTypeOpen,
+	}, creator.Id)
+	require.Nil(t, appErr)
+	_, appErr = th.App.UpdateActive(th.Context, creator, false)
+	require.Nil(t, appErr)
+
+	t.Run("should require to read the channels of the system console", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelsWithDeactivatedCreator(th.BasicTeam.Id, 0, 100)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should reject an invalid team id", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetChannelsWithDeactivatedCreator("junk", 0, 100)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should list the channels of deactivated creators", func(t *testing.T) {
+		channels, _, err := th.SystemAdminClient.GetChannelsWithDeactivatedCreator(th.BasicTeam.Id, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, channels, 1)
+		assert.Equal(t, channel.Id, channels[0].Id)
+
+		channels, _, err = th.SystemAdminClient.GetChannelsWithDeactivatedCreator(model.NewId(), 0, 100)
+		require.NoError(t, err)
+		assert.Empty(t, channels)
+	})
+}
+
+func TestGetChannelLanguageStats(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	yesterday := model.GetStartOfDayMillis(time.Now().UTC(), 0) - model.TeamStatsDayMillis
+	post := &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser2.Id,
+		Message:   "yesterday",
+		CreateAt:  yesterday + 1,
+	}
+	post.AddProp(model.PostPropsLanguage, "es")
+	_, err := th.App.Srv().Store.Post().Save(post)
+	require.NoError(t, err)
+	require.NoError(t, th.App.Srv().Store.ChannelLanguageStats().RollupDay(yesterday))
+
+	t.Run("channel members can't see them", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelLanguageStats(th.BasicChannel.Id, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("channel admins can", func(t *testing.T) {
+		th.MakeUserChannelAdmin(th.BasicUser, th.BasicChannel)
+
+		stats, _, err := th.Client.GetChannelLanguageStats(th.BasicChannel.Id, 0)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicChannel.Id, stats.ChannelId)
+		assert.Equal(t, int64(model.ChannelLanguageStatsDefaultDays*model.TeamStatsDayMillis), stats.Until-stats.Since)
+		assert.Equal(t, []*model.ChannelLanguagePostCount{{Language: "es", PostCount: 1}}, stats.Languages)
+
+		_, resp, err := th.Client.GetChannelLanguageStats(th.BasicChannel.Id, model.ChannelLanguageStatsMaxDays+1)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
+func TestGetChannelStatsTimeseries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	today := model.GetStartOfDayMillis(time.Now().UTC(), 0)
+	yesterday := today - model.TeamStatsDayMillis
+	_, err := th.App.Srv().Store.Post().Save(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser2.Id,
+		Message:   "yesterday",
+		CreateAt:  yesterday + 1,
+	})
+	require.NoError(t, err)
+	require.NoError(t, th.App.Srv().Store.TeamStats().RollupDay(yesterday))
+
+	t.Run("default range", func(t *testing.T) {
+		timeseries, _, err := th.Client.GetChannelStatsTimeseries(th.BasicChannel.Id, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicChannel.Id, timeseries.ChannelId)
+		assert.Equal(t, today, timeseries.Until)
+		assert.Equal(t, int64(model.ChannelStatsTimeseriesDefaultDays*model.TeamStatsDayMillis), timeseries.Until-timeseries.Since)
+		require.Len(t, timeseries.Days, model.ChannelStatsTimeseriesDefaultDays)
+		assert.Equal(t, &model.ChannelDailyStats{ChannelId: th.BasicChannel.Id, Day: yesterday, PostCount: 1, ActiveMembers: 1}, timeseries.Days[len(timeseries.Days)-1])
+		assert.Equal(t, int64(0), timeseries.Days[0].PostCount)
+	})
+
+	t.Run("range rounded to days", func(t *testing.T) {
+		timeseries, _, err := th.Client.GetChannelStatsTimeseries(th.BasicChannel.Id, yesterday+5, today+5)
+		require.NoError(t, err)
+		assert.Equal(t, yesterday, timeseries.Since)
+		assert.Equal(t, today, timeseries.Until)
+		require.Len(t, timeseries.Days, 1)
+		assert.Equal(t, int64(1), timeseries.Days[0].PostCount)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		_, resp, err := th.Client.GetChannelStatsTimeseries(th.BasicChannel.Id, today, yesterday)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.GetChannelStatsTimeseries(th.BasicChannel.Id, today-(model.ChannelStatsTimeseriesMaxDays+1)*model.TeamStatsDayMillis, today)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a channel member", func(t *testing.T) {
+		private := th.CreatePrivateChannel()
+		th.RemoveUserFromChannel(th.BasicUser, private)
+
+		_, resp, err := th.Client.GetChannelStatsTimeseries(private.Id, 0, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestChannelThreadSettings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("members can read the settings", func(t *testing.T) {
+		settings, _, err := th.Client.GetChannelThreadSettings(th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Equal(t, &model.ChannelThreadSettings{}, settings)
+
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		_, resp, err := th.Client.GetChannelThreadSettings(private.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("the users managing the channel can update the settings", func(t *testing.T) {
+		settings := &model.ChannelThreadSettings{ForceThreadedReplies: true, AutoFollowThreads: true}
+
+		updated, _, err := th.Client.UpdateChannelThreadSettings(th.BasicChannel.Id, settings)
+		require.NoError(t, err)
+		assert.Equal(t, settings, updated)
+
+		channel, _, err := th.Client.GetChannel(th.BasicChannel.Id, "")
+		require.NoError(t, err)
+		assert.True(t, channel.ForceThreadedReplies)
+		assert.True(t, channel.AutoFollowThreads)
+	})
+
+	t.Run("other users can't update the settings", func(t *testing.T) {
+		defaultRolePermissions := th.SaveDefaultRolePermissions()
+		defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+		th.RemovePermissionFromRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+
+		_, resp, err := th.Client.UpdateChannelThreadSettings(th.BasicChannel.Id, &model.ChannelThreadSettings{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("direct channels have no settings", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+
+		_, resp, err := th.Client.UpdateChannelThreadSettings(dm.Id, &model.ChannelThreadSettings{AutoFollowThreads: true})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -410,6 +410,15 @@ func listCommandAutocompleteSuggestions(c *Context, w http.ResponseWriter, r *ht
 		return
 	}
 
+	// The commands disabled in the channel aren't suggested.
+	if channelID := query.Get("channel_id"); channelID != "" && c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channelID, model.PermissionReadChannel) {
+		commands, err = c.App.FilterDisabledChannelCommands(channelID, commands)
+		if err != nil {
+			c.Err = err
+			return
+		}
+	}
+
 	commandArgs := &model.CommandArgs{
 		ChannelId: query.Get("channel_id"),
 		TeamId:    c.Params.TeamId,
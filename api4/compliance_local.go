@@ -0,0 +1,12 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+func (api *API) InitComplianceLocal() {
+	api.BaseRoutes.Compliance.Handle("/reports", api.APILocal(createComplianceReport)).Methods("POST")
+	api.BaseRoutes.Compliance.Handle("/reports", api.APILocal(getComplianceReports)).Methods("GET")
+	api.BaseRoutes.Compliance.Handle("/reports/{report_id:[A-Za-z0-9]+}", api.APILocal(getComplianceReport)).Methods("GET")
+	api.BaseRoutes.Compliance.Handle("/reports/{report_id:[A-Za-z0-9]+}/download", api.APILocal(downloadComplianceReport)).Methods("GET")
+	api.BaseRoutes.DataRetention.Handle("/dry_run", api.APILocal(dataRetentionDryRun)).Methods("POST")
+}
@@ -33,8 +33,11 @@ func (api *API) InitConfig() {
 	api.BaseRoutes.APIRoot.Handle("/config", api.APISessionRequired(updateConfig)).Methods("PUT")
 	api.BaseRoutes.APIRoot.Handle("/config/patch", api.APISessionRequired(patchConfig)).Methods("PUT")
 	api.BaseRoutes.APIRoot.Handle("/config/reload", api.APISessionRequired(configReload)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/config/reloadable", api.APISessionRequired(getReloadableConfigSections)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/config/client", api.APIHandler(getClientConfig)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/config/environment", api.APISessionRequired(getEnvironmentConfig)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/config/history", api.APISessionRequired(getConfigHistory)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/config/diff", api.APISessionRequired(getConfigDiff)).Methods("GET")
 }
 
 func init() {
@@ -55,6 +58,12 @@ func getConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec := c.MakeAuditRecord("getConfig", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 
+	version, appErr := c.App.GetConfigVersion()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	cfg, err := config.Merge(&model.Config{}, c.App.GetSanitizedConfig(), &utils.MergeConfig{
 		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
 			return readFilter(c, structField)
@@ -67,6 +76,7 @@ func getConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, version)
 	if c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud {
 		js, jsonErr := cfg.ToJSONFiltered(model.ConfigAccessTagType, model.ConfigAccessTagCloudRestrictable)
 		if jsonErr != nil {
@@ -106,6 +116,23 @@ func configReload(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getReloadableConfigSections(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleReadPermissions) {
+		c.SetPermissionError(model.SysconsoleReadPermissions...)
+		return
+	}
+
+	sections := c.App.GetReloadableConfigSections()
+	js, err := json.Marshal(sections)
+	if err != nil {
+		c.Err = model.NewAppError("getReloadableConfigSections", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Write(js)
+}
+
 func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	cfg := model.ConfigFromJSON(r.Body)
 	if cfg == nil {
@@ -152,12 +179,18 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, err := c.App.SaveConfig(cfg, true)
+	oldCfg, newCfg, err := c.App.SaveConfigIfVersion(cfg, r.Header.Get(model.HeaderIfMatch), c.AppContext.Session().UserId)
 	if err != nil {
 		c.Err = err
 		return
 	}
 
+	newVersion, versionErr := config.Version(newCfg)
+	if versionErr != nil {
+		c.Err = model.NewAppError("updateConfig", "app.config.version.app_error", nil, versionErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	diffs, diffErr := config.Diff(oldCfg, newCfg)
 	if diffErr != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.diff.app_error", nil, diffErr.Error(), http.StatusInternalServerError)
@@ -180,6 +213,7 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.LogAudit("updateConfig")
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, newVersion)
 	if c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud {
 		js, jsonErr := cfg.ToJSONFiltered(model.ConfigAccessTagType, model.ConfigAccessTagCloudRestrictable)
 		if jsonErr != nil {
@@ -279,12 +313,18 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, err := c.App.SaveConfig(updatedCfg, true)
+	oldCfg, newCfg, err := c.App.SaveConfigIfVersion(updatedCfg, r.Header.Get(model.HeaderIfMatch), c.AppContext.Session().UserId)
 	if err != nil {
 		c.Err = err
 		return
 	}
 
+	newVersion, versionErr := config.Version(newCfg)
+	if versionErr != nil {
+		c.Err = model.NewAppError("patchConfig", "app.config.version.app_error", nil, versionErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	diffs, diffErr := config.Diff(oldCfg, newCfg)
 	if diffErr != nil {
 		c.Err = model.NewAppError("patchConfig", "api.config.patch_config.diff.app_error", nil, diffErr.Error(), http.StatusInternalServerError)
@@ -306,6 +346,7 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, newVersion)
 	if c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud {
 		js, jsonErr := cfg.ToJSONFiltered(model.ConfigAccessTagType, model.ConfigAccessTagCloudRestrictable)
 		if jsonErr != nil {
@@ -321,6 +362,90 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getConfigHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleReadPermissions) {
+		c.SetPermissionError(model.SysconsoleReadPermissions...)
+		return
+	}
+
+	versions, err := c.App.GetConfigHistory(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(versions); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// getConfigDiff returns the changes made to the configuration between two versions of the config
+// history. The active version is used when no version to compare to is given.
+func getConfigDiff(c *Context, w http.ResponseWriter, r *http.Request) {
+	from := r.URL.Query().Get("from")
+	if len(from) != model.ConfigVersionLength {
+		c.SetInvalidURLParam("from")
+		return
+	}
+	to := r.URL.Query().Get("to")
+	if to != "" && len(to) != model.ConfigVersionLength {
+		c.SetInvalidURLParam("to")
+		return
+	}
+
+	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleReadPermissions) {
+		c.SetPermissionError(model.SysconsoleReadPermissions...)
+		return
+	}
+
+	if to == "" {
+		var appErr *model.AppError
+		if to, appErr = c.App.GetConfigVersion(); appErr != nil {
+			c.Err = appErr
+			return
+		}
+	}
+
+	// Only the settings the session can read are compared.
+	filteredConfig := func(version string) (*model.Config, *model.AppError) {
+		cfg, appErr := c.App.GetConfigForVersion(version)
+		if appErr != nil {
+			return nil, appErr
+		}
+		cfg, err := config.Merge(&model.Config{}, cfg, &utils.MergeConfig{
+			StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
+				return readFilter(c, structField)
+			},
+		})
+		if err != nil {
+			return nil, model.NewAppError("getConfigDiff", "api.config.get_config.restricted_merge.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return cfg, nil
+	}
+
+	fromCfg, appErr := filteredConfig(from)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	toCfg, appErr := filteredConfig(to)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	diffs, err := config.Diff(fromCfg, toCfg)
+	if err != nil {
+		c.Err = model.NewAppError("getConfigDiff", "api.config.get_config_diff.app_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(diffs.Sanitize()); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func makeFilterConfigByPermission(accessType filterType) func(c *Context, structField reflect.StructField) bool {
 	return func(c *Context, structField reflect.StructField) bool {
 		if structField.Type.Kind() == reflect.Struct {
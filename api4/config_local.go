@@ -26,9 +26,15 @@ func (api *API) InitConfigLocal() {
 func localGetConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec := c.MakeAuditRecord("localGetConfig", audit.Fail)
 	defer c.LogAuditRec(auditRec)
+	version, appErr := c.App.GetConfigVersion()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
 	cfg := c.App.GetSanitizedConfig()
 	auditRec.Success()
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, version)
 	if err := json.NewEncoder(w).Encode(cfg); err != nil {
 		mlog.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -62,12 +68,19 @@ func localUpdateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, err := c.App.SaveConfig(cfg, true)
+	// The version is optional in local mode, so scripts can keep replacing the configuration.
+	oldCfg, newCfg, err := c.App.SaveConfigIfVersion(cfg, r.Header.Get(model.HeaderIfMatch), "")
 	if err != nil {
 		c.Err = err
 		return
 	}
 
+	newVersion, versionErr := config.Version(newCfg)
+	if versionErr != nil {
+		c.Err = model.NewAppError("updateConfig", "app.config.version.app_error", nil, versionErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	diffs, diffErr := config.Diff(oldCfg, newCfg)
 	if diffErr != nil {
 		c.Err = model.NewAppError("updateConfig", "api.config.update_config.diff.app_error", nil, diffErr.Error(), http.StatusInternalServerError)
@@ -81,6 +94,7 @@ func localUpdateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.LogAudit("updateConfig")
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, newVersion)
 	if err := json.NewEncoder(w).Encode(newCfg); err != nil {
 		mlog.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -120,12 +134,18 @@ func localPatchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, err := c.App.SaveConfig(updatedCfg, true)
+	oldCfg, newCfg, err := c.App.SaveConfigIfVersion(updatedCfg, r.Header.Get(model.HeaderIfMatch), "")
 	if err != nil {
 		c.Err = err
 		return
 	}
 
+	newVersion, versionErr := config.Version(newCfg)
+	if versionErr != nil {
+		c.Err = model.NewAppError("patchConfig", "app.config.version.app_error", nil, versionErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	diffs, diffErr := config.Diff(oldCfg, newCfg)
 	if diffErr != nil {
 		c.Err = model.NewAppError("patchConfig", "api.config.patch_config.diff.app_error", nil, diffErr.Error(), http.StatusInternalServerError)
@@ -136,6 +156,7 @@ func localPatchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.Success()
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set(model.HeaderEtagServer, newVersion)
 	if err := json.NewEncoder(w).Encode(c.App.GetSanitizedConfig()); err != nil {
 		mlog.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -481,6 +481,24 @@ func TestUpdateConfigDiffInAuditRecord(t *testing.T) {
 			timeoutVal, timeoutVal+1))
 }
 
+func TestGetReloadableConfigSections(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("as system admin", func(t *testing.T) {
+		sections, _, err := th.SystemAdminClient.GetReloadableConfigSections()
+		require.NoError(t, err)
+		assert.Contains(t, sections, "RateLimitSettings")
+		assert.Contains(t, sections, "ImageProxySettings")
+	})
+
+	t.Run("as regular user", func(t *testing.T) {
+		_, resp, err := th.Client.GetReloadableConfigSections()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetEnvironmentConfig(t *testing.T) {
 	os.Setenv("MM_SERVICESETTINGS_SITEURL", "http://example.mattermost.com")
 	os.Setenv("MM_SERVICESETTINGS_ENABLECUSTOMEMOJI", "true")
@@ -766,6 +784,103 @@ func TestPatchConfig(t *testing.T) {
 	})
 }
 
+func TestUpdateConfigVersion(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	cfg, resp, err := th.SystemAdminClient.GetConfig()
+	require.NoError(t, err)
+	etag := resp.Etag
+	require.Len(t, etag, model.ConfigVersionLength)
+
+	siteName := *cfg.TeamSettings.SiteName
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.SiteName = siteName })
+
+	*cfg.TeamSettings.SiteName = "Versioned"
+	_, resp, err = th.SystemAdminClient.UpdateConfigWithEtag(cfg, etag)
+	require.NoError(t, err)
+	newEtag := resp.Etag
+	require.NotEqual(t, etag, newEtag)
+	_, resp, err = th.SystemAdminClient.GetConfig()
+	require.NoError(t, err)
+	require.Equal(t, newEtag, resp.Etag)
+
+	t.Run("stale version is rejected", func(t *testing.T) {
+		*cfg.TeamSettings.SiteName = "Stale"
+		_, resp, err := th.SystemAdminClient.UpdateConfigWithEtag(cfg, etag)
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, http.StatusConflict)
+		CheckErrorID(t, err, "app.save_config.version_mismatch.app_error")
+
+		patch := &model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("Stale")}}
+		_, resp, err = th.SystemAdminClient.PatchConfigWithEtag(patch, etag)
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, http.StatusConflict)
+
+		require.Equal(t, "Versioned", *th.App.Config().TeamSettings.SiteName)
+	})
+
+	var localEtag string
+	t.Run("version is optional in local mode", func(t *testing.T) {
+		patch := &model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("Local")}}
+		_, resp, err := th.LocalClient.PatchConfig(patch)
+		require.NoError(t, err)
+		localEtag = resp.Etag
+		require.NotEqual(t, newEtag, localEtag)
+
+		_, resp, err = th.LocalClient.PatchConfigWithEtag(patch, newEtag)
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, http.StatusConflict)
+	})
+
+	t.Run("history and diff", func(t *testing.T) {
+		_, resp, err := th.Client.GetConfigHistory(0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		versions, _, err := th.SystemAdminClient.GetConfigHistory(0, 10)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(versions), 3)
+		assert.Equal(t, localEtag, versions[0].Version)
+		assert.Empty(t, versions[0].UserId)
+		assert.Equal(t, newEtag, versions[1].Version)
+		assert.Equal(t, th.SystemAdminUser.Id, versions[1].UserId)
+		assert.Empty(t, versions[1].Value)
+
+		diffs, _, err := th.SystemAdminClient.GetConfigDiff(etag, newEtag)
+		require.NoError(t, err)
+		require.Len(t, diffs, 1)
+		assert.Equal(t, "TeamSettings.SiteName", diffs[0].Path)
+		assert.Equal(t, siteName, diffs[0].BaseVal)
+		assert.Equal(t, "Versioned", diffs[0].ActualVal)
+
+		diffs, _, err = th.SystemAdminClient.GetConfigDiff(etag, "")
+		require.NoError(t, err)
+		require.Len(t, diffs, 1)
+		assert.Equal(t, "Local", diffs[0].ActualVal)
+
+		_, resp, err = th.SystemAdminClient.GetConfigDiff(strings.Repeat("0", model.ConfigVersionLength), "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.GetConfigDiff("invalid", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("version is optional", func(t *testing.T) {
+		*cfg.TeamSettings.SiteName = "Unversioned"
+		_, resp, err := th.SystemAdminClient.UpdateConfig(cfg)
+		require.NoError(t, err)
+		require.NotEqual(t, localEtag, resp.Etag)
+
+		patch := &model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("Unversioned patch")}}
+		_, _, err = th.SystemAdminClient.PatchConfig(patch)
+		require.NoError(t, err)
+		require.Equal(t, "Unversioned patch", *th.App.Config().TeamSettings.SiteName)
+	})
+}
+
 func TestMigrateConfig(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
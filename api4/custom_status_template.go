@@ -0,0 +1,288 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitCustomStatusTemplate() {
+	api.BaseRoutes.APIRoot.Handle("/custom_status/templates", api.APISessionRequired(getCustomStatusSuggestions)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/custom_status/templates", api.APISessionRequired(createOrgCustomStatusTemplate)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/custom_status/templates/usage", api.APISessionRequired(getCustomStatusTemplateUsage)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/custom_status/templates/{custom_status_template_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchOrgCustomStatusTemplate)).Methods("PUT")
+	api.BaseRoutes.APIRoot.Handle("/custom_status/templates/{custom_status_template_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteOrgCustomStatusTemplate)).Methods("DELETE")
+
+	api.BaseRoutes.Team.Handle("/custom_status/templates", api.APISessionRequired(getTeamCustomStatusTemplates)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/custom_status/templates", api.APISessionRequired(createTeamCustomStatusTemplate)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/custom_status/templates/{custom_status_template_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchTeamCustomStatusTemplate)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/custom_status/templates/{custom_status_template_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteTeamCustomStatusTemplate)).Methods("DELETE")
+}
+
+// customStatusTemplateScope describes whether the custom status template routes act on the
+// organization-wide templates or on the templates of the team in the URL.
+type customStatusTemplateScope struct {
+	requireScopeId func(c *Context)
+	checkWrite     func(c *Context) bool
+	auditMeta      func(c *Context, auditRec *audit.Record)
+}
+
+var orgCustomStatusTemplateScope = customStatusTemplateScope{
+	requireScopeId: func(c *Context) {},
+	checkWrite: func(c *Context) bool {
+		if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+			c.SetPermissionError(model.PermissionManageSystem)
+			return false
+		}
+		return true
+	},
+	auditMeta: func(c *Context, auditRec *audit.Record) {},
+}
+
+var teamCustomStatusTemplateScope = customStatusTemplateScope{
+	requireScopeId: func(c *Context) { c.RequireTeamId() },
+	checkWrite: func(c *Context) bool {
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+			c.SetPermissionError(model.PermissionManageTeam)
+			return false
+		}
+		return true
+	},
+	auditMeta: func(c *Context, auditRec *audit.Record) { auditRec.AddMeta("team_id", c.Params.TeamId) },
+}
+
+func checkCustomStatusesEnabled(c *Context, where string) bool {
+	if !*c.App.Config().TeamSettings.EnableCustomUserStatuses {
+		c.Err = model.NewAppError(where, "api.custom_status.disabled", nil, "", http.StatusNotImplemented)
+		return false
+	}
+	return true
+}
+
+// getCustomStatusTemplateForScope returns the template in the URL, making sure it is an
+// organization-wide one or belongs to the team in the URL.
+func getCustomStatusTemplateForScope(c *Context) *model.CustomStatusTemplate {
+	template, err := c.App.GetCustomStatusTemplate(c.Params.CustomStatusTemplateId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if template.TeamId != c.Params.TeamId {
+		c.Err = model.NewAppError("getCustomStatusTemplateForScope", "app.custom_status_template.get.not_found.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return template
+}
+
+func getCustomStatusSuggestions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkCustomStatusesEnabled(c, "getCustomStatusSuggestions") {
+		return
+	}
+
+	teamID := r.URL.Query().Get("team_id")
+	if teamID != "" {
+		if !model.IsValidId(teamID) {
+			c.SetInvalidParam("team_id")
+			return
+		}
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), teamID, model.PermissionViewTeam) {
+			c.SetPermissionError(model.PermissionViewTeam)
+			return
+		}
+	}
+
+	templates, err := c.App.GetCustomStatusSuggestions(teamID)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(templates); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamCustomStatusTemplates(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !checkCustomStatusesEnabled(c, "getTeamCustomStatusTemplates") {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	templates, err := c.App.GetCustomStatusTemplatesForTeam(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(templates); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getCustomStatusTemplateUsage(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionGetAnalytics) {
+		c.SetPermissionError(model.PermissionGetAnalytics)
+		return
+	}
+
+	templates, err := c.App.GetCustomStatusTemplateUsage()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(templates); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createOrgCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	createCustomStatusTemplate(c, w, r, "createOrgCustomStatusTemplate", orgCustomStatusTemplateScope)
+}
+
+func createTeamCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	createCustomStatusTemplate(c, w, r, "createTeamCustomStatusTemplate", teamCustomStatusTemplateScope)
+}
+
+func createCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request, event string, scope customStatusTemplateScope) {
+	scope.requireScopeId(c)
+	if c.Err != nil {
+		return
+	}
+
+	if !checkCustomStatusesEnabled(c, event) {
+		return
+	}
+
+	var template model.CustomStatusTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&template); jsonErr != nil {
+		c.SetInvalidParam("custom_status_template")
+		return
+	}
+	template.TeamId = c.Params.TeamId
+	template.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	created, err := c.App.CreateCustomStatusTemplate(&template)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("custom_status_template_id", created.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchOrgCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	patchCustomStatusTemplate(c, w, r, "patchOrgCustomStatusTemplate", orgCustomStatusTemplateScope)
+}
+
+func patchTeamCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	patchCustomStatusTemplate(c, w, r, "patchTeamCustomStatusTemplate", teamCustomStatusTemplateScope)
+}
+
+func patchCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request, event string, scope customStatusTemplateScope) {
+	scope.requireScopeId(c)
+	c.RequireCustomStatusTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.CustomStatusTemplatePatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParam("custom_status_template")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+	auditRec.AddMeta("custom_status_template_id", c.Params.CustomStatusTemplateId)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	if getCustomStatusTemplateForScope(c); c.Err != nil {
+		return
+	}
+
+	patched, err := c.App.PatchCustomStatusTemplate(c.Params.CustomStatusTemplateId, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteOrgCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	deleteCustomStatusTemplate(c, w, r, "deleteOrgCustomStatusTemplate", orgCustomStatusTemplateScope)
+}
+
+func deleteTeamCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	deleteCustomStatusTemplate(c, w, r, "deleteTeamCustomStatusTemplate", teamCustomStatusTemplateScope)
+}
+
+func deleteCustomStatusTemplate(c *Context, w http.ResponseWriter, r *http.Request, event string, scope customStatusTemplateScope) {
+	scope.requireScopeId(c)
+	c.RequireCustomStatusTemplateId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	scope.auditMeta(c, auditRec)
+	auditRec.AddMeta("custom_status_template_id", c.Params.CustomStatusTemplateId)
+
+	if !scope.checkWrite(c) {
+		return
+	}
+
+	if getCustomStatusTemplateForScope(c); c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteCustomStatusTemplate(c.Params.CustomStatusTemplateId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCustomStatusTemplates(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("only system admins can create organization-wide templates", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCustomStatusTemplate(&model.CustomStatusTemplate{Emoji: "sandwich", Text: "Lunch"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	lunch, resp, err := th.SystemAdminClient.CreateCustomStatusTemplate(&model.CustomStatusTemplate{Emoji: "sandwich", Text: "Lunch", Duration: "one_hour"})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.SystemAdminUser.Id, lunch.CreatorId)
+	assert.Empty(t, lunch.TeamId)
+
+	t.Run("only team admins can create team templates", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, Text: "Team lunch"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	override, resp, err := th.SystemAdminClient.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, OverrideId: lunch.Id, Emoji: "pizza", Text: "Team lunch"})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+
+	t.Run("suggestions", func(t *testing.T) {
+		suggestions, _, err := th.Client.GetCustomStatusSuggestions("")
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, lunch.Id, suggestions[0].Id)
+
+		suggestions, _, err = th.Client.GetCustomStatusSuggestions(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, override.Id, suggestions[0].Id)
+
+		templates, _, err := th.Client.GetTeamCustomStatusTemplates(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+	})
+
+	t.Run("suggestions of a team the user is not a member of", func(t *testing.T) {
+		team := th.CreateTeamWithClient(th.SystemAdminClient)
+
+		_, resp, err := th.Client.GetCustomStatusSuggestions(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("custom statuses disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.EnableCustomUserStatuses = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.EnableCustomUserStatuses = true })
+
+		_, resp, err := th.Client.GetCustomStatusSuggestions("")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		_, resp, err := th.Client.PatchCustomStatusTemplate(lunch.Id, &model.CustomStatusTemplatePatch{Text: model.NewString("Lunch break")})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		patched, _, err := th.SystemAdminClient.PatchCustomStatusTemplate(lunch.Id, &model.CustomStatusTemplatePatch{Text: model.NewString("Lunch break")})
+		require.NoError(t, err)
+		assert.Equal(t, "Lunch break", patched.Text)
+		assert.Equal(t, "one_hour", patched.Duration)
+
+		_, resp, err = th.SystemAdminClient.PatchTeamCustomStatusTemplate(th.BasicTeam.Id, lunch.Id, &model.CustomStatusTemplatePatch{Text: model.NewString("Lunch")})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		_, _, err := th.Client.UpdateUserCustomStatus(th.BasicUser.Id, &model.CustomStatus{Emoji: "pizza", Text: "Team lunch"})
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.GetCustomStatusTemplateUsage()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		usage, _, err := th.SystemAdminClient.GetCustomStatusTemplateUsage()
+		require.NoError(t, err)
+		require.Len(t, usage, 2)
+		assert.Equal(t, override.Id, usage[0].Id)
+		assert.Equal(t, int64(1), usage[0].UseCount)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := th.SystemAdminClient.DeleteCustomStatusTemplate(lunch.Id)
+		require.NoError(t, err)
+
+		templates, _, err := th.Client.GetTeamCustomStatusTemplates(th.BasicTeam.Id)
+		require.NoError(t, err)
+		assert.Empty(t, templates)
+
+		resp, err := th.SystemAdminClient.DeleteCustomStatusTemplate(lunch.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
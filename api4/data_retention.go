@@ -6,6 +6,7 @@ package api4
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -15,7 +16,10 @@ func (api *API) InitDataRetention() {
 	api.BaseRoutes.DataRetention.Handle("/policy", api.APISessionRequired(getGlobalPolicy)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies", api.APISessionRequired(getPolicies)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies_count", api.APISessionRequired(getPoliciesCount)).Methods("GET")
+	api.BaseRoutes.DataRetention.Handle("/dry_run", api.APISessionRequired(dataRetentionDryRun)).Methods("POST")
 	api.BaseRoutes.DataRetention.Handle("/policies", api.APISessionRequired(createPolicy)).Methods("POST")
+	api.BaseRoutes.DataRetention.Handle("/policies/preview", api.APISessionRequired(previewPolicy)).Methods("POST")
+	api.BaseRoutes.DataRetention.Handle("/policies/preview/{job_id:[A-Za-z0-9]+}/download", api.APISessionRequiredTrustRequester(downloadPolicyPreview)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(getPolicy)).Methods("GET")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(patchPolicy)).Methods("PATCH")
 	api.BaseRoutes.DataRetention.Handle("/policies/{policy_id:[A-Za-z0-9]+}", api.APISessionRequired(deletePolicy)).Methods("DELETE")
@@ -140,6 +144,59 @@ func createPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func previewPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
+	var policy model.RetentionPolicyWithTeamAndChannelIDs
+	if jsonErr := json.NewDecoder(r.Body).Decode(&policy); jsonErr != nil {
+		c.SetInvalidParam("policy")
+		return
+	}
+	auditRec := c.MakeAuditRecord("previewPolicy", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("policy", policy)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteComplianceDataRetentionPolicy) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteComplianceDataRetentionPolicy)
+		return
+	}
+
+	job, err := c.App.PreviewRetentionPolicy(&policy)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddMeta("job", job)
+	js, jsonErr := json.Marshal(job)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("previewPolicy", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	auditRec.Success()
+	w.WriteHeader(http.StatusCreated)
+	w.Write(js)
+}
+
+func downloadPolicyPreview(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireJobId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceDataRetentionPolicy) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceDataRetentionPolicy)
+		return
+	}
+
+	file, job, err := c.App.GetRetentionPolicyPreviewFile(c.Params.JobId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	defer file.Close()
+
+	writeFileResponse(job.Id+".csv", "text/csv", 0, time.Unix(0, job.LastActivityAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, file, true, w, r)
+}
+
 func patchPolicy(c *Context, w http.ResponseWriter, r *http.Request) {
 	var patch model.RetentionPolicyWithTeamAndChannelIDs
 	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
@@ -438,8 +495,8 @@ func getTeamPoliciesForUser(c *Context, w http.ResponseWriter, r *http.Request)
 	limit := c.Params.PerPage
 	offset := c.Params.Page * limit
 
-	if userID != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
-		c.SetPermissionError(model.PermissionManageSystem)
+	if userID != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceDataRetentionPolicy) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceDataRetentionPolicy)
 		return
 	}
 
@@ -466,8 +523,8 @@ func getChannelPoliciesForUser(c *Context, w http.ResponseWriter, r *http.Reques
 	limit := c.Params.PerPage
 	offset := c.Params.Page * limit
 
-	if userID != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
-		c.SetPermissionError(model.PermissionManageSystem)
+	if userID != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceDataRetentionPolicy) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceDataRetentionPolicy)
 		return
 	}
 
@@ -484,3 +541,28 @@ func getChannelPoliciesForUser(c *Context, w http.ResponseWriter, r *http.Reques
 	}
 	w.Write(js)
 }
+
+func dataRetentionDryRun(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("dataRetentionDryRun", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionCreateDataRetentionJob) {
+		c.SetPermissionError(model.PermissionCreateDataRetentionJob)
+		return
+	}
+
+	dryRun, err := c.App.DataRetentionDryRun()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	js, jsonErr := json.Marshal(dryRun)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("dataRetentionDryRun", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	w.Write(js)
+}
@@ -6,6 +6,8 @@ package api4
 import (
 	"testing"
 
+	"github.com/mattermost/mattermost-server/v6/model"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,3 +19,72 @@ func TestDataRetentionGetPolicy(t *testing.T) {
 	require.Error(t, err)
 	CheckNotImplementedStatus(t, resp)
 }
+
+func TestDataRetentionDryRun(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should require permission", func(t *testing.T) {
+		_, resp, err := th.Client.DataRetentionDryRun()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		_, resp, err := client.DataRetentionDryRun()
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	}, "should require a license")
+}
+
+func TestDataRetentionPreviewPolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	policy := &model.RetentionPolicyWithTeamAndChannelIDs{
+		RetentionPolicy: model.RetentionPolicy{
+			DisplayName:  "Preview",
+			PostDuration: model.NewInt64(30),
+		},
+		TeamIDs: []string{th.BasicTeam.Id},
+	}
+
+	t.Run("should require permission", func(t *testing.T) {
+		_, resp, err := th.Client.PreviewDataRetentionPolicy(policy)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.DownloadDataRetentionPolicyPreview(model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should require a license", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.PreviewDataRetentionPolicy(policy)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	t.Run("should only download finished preview jobs", func(t *testing.T) {
+		job, err := th.App.Srv().Jobs.CreateJob(model.JobTypeDataRetentionPreview, map[string]string{})
+		require.Nil(t, err)
+		defer th.App.Srv().Store.Job().Delete(job.Id)
+
+		_, resp, dErr := th.SystemAdminClient.DownloadDataRetentionPolicyPreview(job.Id)
+		require.Error(t, dErr)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, dErr = th.SystemAdminClient.DownloadDataRetentionPolicyPreview(model.NewId())
+		require.Error(t, dErr)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestComplianceReportsLocal(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	_, resp, err := th.LocalClient.GetComplianceReports(0, 10)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitDirectChannelRetention() {
+	api.BaseRoutes.Channel.Handle("/retention", api.APISessionRequired(getDirectChannelRetention)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/retention", api.APISessionRequired(proposeDirectChannelRetention)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/retention", api.APISessionRequired(removeDirectChannelRetention)).Methods("DELETE")
+	api.BaseRoutes.Channel.Handle("/retention/accept", api.APISessionRequired(acceptDirectChannelRetention)).Methods("POST")
+}
+
+func requireDirectChannelRetentionEnabled(c *Context, where string) {
+	if !*c.App.Config().DataRetentionSettings.EnableDirectChannelRetention {
+		c.Err = model.NewAppError(where, "api.direct_channel_retention.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+	}
+}
+
+func decodeDirectChannelRetentionRequest(c *Context, r *http.Request) *model.DirectChannelRetentionRequest {
+	var request model.DirectChannelRetentionRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&request); jsonErr != nil || !model.IsValidDirectChannelRetentionDays(request.RetentionDays) {
+		c.SetInvalidParam("retention_days")
+		return nil
+	}
+	return &request
+}
+
+func getDirectChannelRetention(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	requireDirectChannelRetentionEnabled(c, "getDirectChannelRetention")
+	if c.Err != nil {
+		return
+	}
+
+	retention, err := c.App.GetDirectChannelRetention(c.AppContext.Session().UserId, c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(retention); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func proposeDirectChannelRetention(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	request := decodeDirectChannelRetentionRequest(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	requireDirectChannelRetentionEnabled(c, "proposeDirectChannelRetention")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("proposeDirectChannelRetention", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("retention_days", request.RetentionDays)
+
+	retention, err := c.App.ProposeDirectChannelRetention(c.AppContext.Session().UserId, c.Params.ChannelId, request.RetentionDays)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(retention); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func acceptDirectChannelRetention(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	request := decodeDirectChannelRetentionRequest(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	requireDirectChannelRetentionEnabled(c, "acceptDirectChannelRetention")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("acceptDirectChannelRetention", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("retention_days", request.RetentionDays)
+
+	retention, err := c.App.AcceptDirectChannelRetention(c.AppContext.Session().UserId, c.Params.ChannelId, request.RetentionDays)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(retention); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func removeDirectChannelRetention(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	requireDirectChannelRetentionEnabled(c, "removeDirectChannelRetention")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("removeDirectChannelRetention", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if err := c.App.RemoveDirectChannelRetention(c.AppContext.Session().UserId, c.Params.ChannelId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestDirectChannelRetention(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dm := th.CreateDmChannel(th.BasicUser2)
+
+	client2 := th.CreateClient()
+	th.LoginBasic2WithClient(client2)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.ProposeDirectChannelRetention(dm.Id, 30)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.DataRetentionSettings.EnableDirectChannelRetention = true })
+
+	t.Run("propose, accept and remove", func(t *testing.T) {
+		retention, _, err := th.Client.ProposeDirectChannelRetention(dm.Id, 30)
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), retention.RetentionDays)
+		assert.Equal(t, th.BasicUser.Id, retention.ProposedBy)
+		assert.False(t, retention.IsActive())
+
+		_, resp, err := th.Client.AcceptDirectChannelRetention(dm.Id, 30)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, _, err = client2.AcceptDirectChannelRetention(dm.Id, 7)
+		CheckErrorID(t, err, "app.direct_channel_retention.days_mismatch.app_error")
+
+		retention, _, err = client2.AcceptDirectChannelRetention(dm.Id, 30)
+		require.NoError(t, err)
+		assert.True(t, retention.IsActive())
+
+		retention, _, err = th.Client.GetDirectChannelRetention(dm.Id)
+		require.NoError(t, err)
+		assert.True(t, retention.IsActive())
+
+		_, err = client2.RemoveDirectChannelRetention(dm.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetDirectChannelRetention(dm.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("invalid retention days", func(t *testing.T) {
+		_, resp, err := th.Client.ProposeDirectChannelRetention(dm.Id, 0)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a direct channel", func(t *testing.T) {
+		_, resp, err := th.Client.ProposeDirectChannelRetention(th.BasicChannel.Id, 30)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a member of the channel", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.ProposeDirectChannelRetention(dm.Id, 30)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const maxEmailProviderWebhookSize = 1024 * 1024
+
+func (api *API) InitEmailSuppression() {
+	api.BaseRoutes.APIRoot.Handle("/email/webhooks/{email_provider:[a-z_]+}", api.APIHandler(handleEmailProviderWebhook)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/email/suppressions", api.APISessionRequired(getEmailSuppressions)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/email/suppressions/{email:.+}", api.APISessionRequired(getEmailSuppression)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/email/suppressions/{email:.+}", api.APISessionRequired(deleteEmailSuppression)).Methods("DELETE")
+}
+
+// handleEmailProviderWebhook receives the bounce and complaint events of the email service
+// provider. It is authenticated by the webhook token in the URL rather than by a session.
+func handleEmailProviderWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxEmailProviderWebhookSize))
+	if err != nil {
+		c.Err = model.NewAppError("handleEmailProviderWebhook", "api.email_suppression.webhook.read_body.app_error", nil, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if appErr := c.App.HandleEmailProviderWebhook(c.Params.EmailProvider, r.URL.Query().Get("token"), body); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
+
+func getEmailSuppressions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadEnvironmentSMTP) {
+		c.SetPermissionError(model.PermissionSysconsoleReadEnvironmentSMTP)
+		return
+	}
+
+	suppressions, err := c.App.GetEmailSuppressions(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(suppressions); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEmailSuppression(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.SanitizeEmail()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadEnvironmentSMTP) {
+		c.SetPermissionError(model.PermissionSysconsoleReadEnvironmentSMTP)
+		return
+	}
+
+	suppression, err := c.App.GetEmailSuppression(c.Params.Email)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(suppression); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteEmailSuppression(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.SanitizeEmail()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteEmailSuppression", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("email", c.Params.Email)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteEnvironmentSMTP) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteEnvironmentSMTP)
+		return
+	}
+
+	if err := c.App.DeleteEmailSuppression(c.Params.Email); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
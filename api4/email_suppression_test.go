@@ -0,0 +1,117 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestHandleEmailProviderWebhook(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EmailProvider = model.EmailProviderSendGrid
+		*cfg.EmailSettings.EmailProviderAPIKey = "apikey"
+		*cfg.EmailSettings.EmailProviderWebhookToken = "secret"
+	})
+
+	events := `[
+		{"email": "bounced@example.com", "event": "bounce", "reason": "550 No such user"},
+		{"email": "delivered@example.com", "event": "delivered"}
+	]`
+
+	t.Run("requires the token", func(t *testing.T) {
+		r, err := th.Client.DoAPIPost("/email/webhooks/sendgrid?token=wrong", events)
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, model.BuildResponse(r))
+	})
+
+	t.Run("must be for the configured provider", func(t *testing.T) {
+		r, err := th.Client.DoAPIPost("/email/webhooks/mailgun?token=secret", events)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, model.BuildResponse(r))
+	})
+
+	t.Run("suppresses bounced addresses", func(t *testing.T) {
+		r, err := th.Client.DoAPIPost("/email/webhooks/sendgrid?token=secret", events)
+		require.NoError(t, err)
+		CheckOKStatus(t, model.BuildResponse(r))
+
+		suppression, err := th.App.Srv().Store.EmailSuppression().Get("bounced@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, model.EmailSuppressionReasonBounce, suppression.Reason)
+		assert.Equal(t, model.EmailProviderSendGrid, suppression.Provider)
+		assert.Equal(t, "550 No such user", suppression.Details)
+
+		_, err = th.App.Srv().Store.EmailSuppression().Get("delivered@example.com")
+		require.Error(t, err)
+
+		require.NoError(t, th.App.Srv().Store.EmailSuppression().Delete("bounced@example.com"))
+	})
+}
+
+func TestEmailSuppressions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, err := th.App.Srv().Store.EmailSuppression().Save(&model.EmailSuppression{
+		Email:    "bounced@example.com",
+		Reason:   model.EmailSuppressionReasonBounce,
+		Provider: model.EmailProviderSendGrid,
+	})
+	require.NoError(t, err)
+
+	t.Run("members cannot manage suppressions", func(t *testing.T) {
+		_, resp, err := th.Client.GetEmailSuppressions(0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetEmailSuppression("bounced@example.com")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteEmailSuppression("bounced@example.com")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invites to suppressed addresses are not sent", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmailInvitations = true })
+
+		invites, _, err := th.SystemAdminClient.InviteUsersToTeamGracefully(th.BasicTeam.Id, []string{"Bounced@example.com"})
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+		require.NotNil(t, invites[0].Error)
+		assert.Equal(t, "api.team.invite_members.email_bounced.app_error", invites[0].Error.Id)
+
+		resp, err := th.SystemAdminClient.InviteUsersToTeam(th.BasicTeam.Id, []string{"bounced@example.com"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "api.team.invite_members.email_suppressed.app_error")
+	})
+
+	t.Run("admins can list and clear suppressions", func(t *testing.T) {
+		suppressions, _, err := th.SystemAdminClient.GetEmailSuppressions(0, 10)
+		require.NoError(t, err)
+		require.Len(t, suppressions, 1)
+		assert.Equal(t, "bounced@example.com", suppressions[0].Email)
+
+		suppression, _, err := th.SystemAdminClient.GetEmailSuppression("bounced@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, model.EmailSuppressionReasonBounce, suppression.Reason)
+
+		_, err = th.SystemAdminClient.DeleteEmailSuppression("bounced@example.com")
+		require.NoError(t, err)
+
+		resp, err := th.SystemAdminClient.DeleteEmailSuppression("bounced@example.com")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitEventWebhook() {
+	api.BaseRoutes.EventWebhooks.Handle("", api.APISessionRequired(createEventWebhook)).Methods("POST")
+	api.BaseRoutes.EventWebhooks.Handle("", api.APISessionRequired(getEventWebhooks)).Methods("GET")
+	api.BaseRoutes.EventWebhook.Handle("", api.APISessionRequired(getEventWebhook)).Methods("GET")
+	api.BaseRoutes.EventWebhook.Handle("", api.APISessionRequired(updateEventWebhook)).Methods("PUT")
+	api.BaseRoutes.EventWebhook.Handle("", api.APISessionRequired(deleteEventWebhook)).Methods("DELETE")
+	api.BaseRoutes.EventWebhook.Handle("/regen_secret", api.APISessionRequired(regenerateEventWebhookSecret)).Methods("POST")
+	api.BaseRoutes.EventWebhook.Handle("/deliveries", api.APISessionRequired(getEventWebhookDeliveries)).Methods("GET")
+}
+
+// createEventWebhook registers an event webhook. The response holds its secret, which is not
+// returned again until it's regenerated.
+func createEventWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
+	var webhook model.EventWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&webhook); jsonErr != nil {
+		c.SetInvalidParam("event_webhook")
+		return
+	}
+	webhook.Id = ""
+	webhook.Secret = ""
+	webhook.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createEventWebhook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("url", webhook.URL)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	saved, err := c.App.CreateEventWebhook(&webhook)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("event_webhook_id", saved.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEventWebhooks(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	webhooks, err := c.App.GetEventWebhooks(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	for _, webhook := range webhooks {
+		webhook.Sanitize()
+	}
+
+	if err := json.NewEncoder(w).Encode(webhooks); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEventWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireEventWebhookId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	webhook, err := c.App.GetEventWebhook(c.Params.EventWebhookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	webhook.Sanitize()
+	if err := json.NewEncoder(w).Encode(webhook); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// updateEventWebhook changes the endpoint and subscriptions of an event webhook. Its secret is
+// only changed by regenerating it.
+func updateEventWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireEventWebhookId()
+	if c.Err != nil {
+		return
+	}
+
+	var update model.EventWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&update); jsonErr != nil {
+		c.SetInvalidParam("event_webhook")
+		return
+	}
+	if update.Id != c.Params.EventWebhookId {
+		c.SetInvalidParam("id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateEventWebhook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("event_webhook_id", c.Params.EventWebhookId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	webhook, err := c.App.GetEventWebhook(c.Params.EventWebhookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	webhook.DisplayName = update.DisplayName
+	webhook.Description = update.Description
+	webhook.URL = update.URL
+	webhook.Events = update.Events
+
+	updated, err := c.App.UpdateEventWebhook(webhook)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	updated.Sanitize()
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteEventWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireEventWebhookId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteEventWebhook", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("event_webhook_id", c.Params.EventWebhookId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if err := c.App.DeleteEventWebhook(c.Params.EventWebhookId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func regenerateEventWebhookSecret(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireEventWebhookId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("regenerateEventWebhookSecret", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("event_webhook_id", c.Params.EventWebhookId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	webhook, err := c.App.RegenerateEventWebhookSecret(c.Params.EventWebhookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(webhook); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getEventWebhookDeliveries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireEventWebhookId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if _, err := c.App.GetEventWebhook(c.Params.EventWebhookId); err != nil {
+		c.Err = err
+		return
+	}
+
+	deliveries, err := c.App.GetEventWebhookDeliveries(c.Params.EventWebhookId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(deliveries); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
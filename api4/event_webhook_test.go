@@ -0,0 +1,187 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestEventWebhooks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	var mut sync.Mutex
+	var requests []received
+	failNext := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mut.Lock()
+		defer mut.Unlock()
+		requests = append(requests, received{header: r.Header, body: body})
+		if failNext {
+			failNext = false
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEventWebhooks = true
+		*cfg.ServiceSettings.EnableInsecureOutgoingConnections = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+	})
+
+	newWebhook := func() *model.EventWebhook {
+		return &model.EventWebhook{
+			DisplayName: "Provisioning",
+			URL:         server.URL + "/hooks",
+			Events:      model.StringArray{model.EventWebhookEventUserCreated},
+		}
+	}
+	lastRequest := func() received {
+		mut.Lock()
+		defer mut.Unlock()
+		return requests[len(requests)-1]
+	}
+	requestCount := func() int {
+		mut.Lock()
+		defer mut.Unlock()
+		return len(requests)
+	}
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.CreateEventWebhook(newWebhook())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetEventWebhooks(0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid webhooks", func(t *testing.T) {
+		invalid := newWebhook()
+		invalid.URL = "http://example.com/hooks"
+		_, resp, err := th.SystemAdminClient.CreateEventWebhook(invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		invalid = newWebhook()
+		invalid.Events = model.StringArray{"post_liked"}
+		_, resp, err = th.SystemAdminClient.CreateEventWebhook(invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		webhook, resp, err := th.SystemAdminClient.CreateEventWebhook(newWebhook())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.SystemAdminUser.Id, webhook.CreatorId)
+		assert.Len(t, webhook.Secret, model.EventWebhookSecretLength)
+
+		fetched, _, err := th.SystemAdminClient.GetEventWebhook(webhook.Id)
+		require.NoError(t, err)
+		assert.Empty(t, fetched.Secret)
+
+		fetched.Events = model.StringArray{model.EventWebhookEventTeamDeleted, model.EventWebhookEventPostFlagged}
+		updated, _, err := th.SystemAdminClient.UpdateEventWebhook(fetched)
+		require.NoError(t, err)
+		assert.Equal(t, fetched.Events, updated.Events)
+
+		regenerated, _, err := th.SystemAdminClient.RegenerateEventWebhookSecret(webhook.Id)
+		require.NoError(t, err)
+		assert.Len(t, regenerated.Secret, model.EventWebhookSecretLength)
+		assert.NotEqual(t, webhook.Secret, regenerated.Secret)
+
+		_, err = th.SystemAdminClient.DeleteEventWebhook(webhook.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.SystemAdminClient.GetEventWebhook(webhook.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("signed deliveries are retried", func(t *testing.T) {
+		webhook, _, err := th.SystemAdminClient.CreateEventWebhook(newWebhook())
+		require.NoError(t, err)
+		defer th.SystemAdminClient.DeleteEventWebhook(webhook.Id)
+
+		user := th.CreateUser()
+		require.Eventually(t, func() bool { return requestCount() > 0 }, 5*time.Second, 50*time.Millisecond)
+
+		request := lastRequest()
+		assert.Equal(t, model.EventWebhookEventUserCreated, request.header.Get(model.EventWebhookHeaderEvent))
+		timestamp, err := strconv.ParseInt(request.header.Get(model.EventWebhookHeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, model.SignEventWebhookPayload(webhook.Secret, timestamp, request.body), request.header.Get(model.EventWebhookHeaderSignature))
+
+		var payload struct {
+			Event string `json:"event"`
+			Data  struct {
+				User *model.User `json:"user"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(request.body, &payload))
+		assert.Equal(t, model.EventWebhookEventUserCreated, payload.Event)
+		assert.Equal(t, user.Id, payload.Data.User.Id)
+		assert.Empty(t, payload.Data.User.Password)
+
+		var deliveries []*model.EventWebhookDelivery
+		require.Eventually(t, func() bool {
+			deliveries, _, err = th.SystemAdminClient.GetEventWebhookDeliveries(webhook.Id, 0, 60)
+			return err == nil && len(deliveries) == 1 && deliveries[0].Status == model.EventWebhookDeliveryStatusSucceeded
+		}, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, request.header.Get(model.EventWebhookHeaderDelivery), deliveries[0].Id)
+
+		mut.Lock()
+		failNext = true
+		mut.Unlock()
+		th.CreateUser()
+		require.Eventually(t, func() bool {
+			deliveries, _, err = th.SystemAdminClient.GetEventWebhookDeliveries(webhook.Id, 0, 60)
+			return err == nil && len(deliveries) == 2 && deliveries[0].Attempts == 1
+		}, 5*time.Second, 50*time.Millisecond)
+		failed := deliveries[0]
+		assert.Equal(t, model.EventWebhookDeliveryStatusPending, failed.Status)
+		assert.Equal(t, http.StatusInternalServerError, failed.ResponseCode)
+
+		// Make the retry due now rather than in a minute.
+		claimed, err := th.App.Srv().Store.EventWebhook().ClaimDelivery(failed.Id, failed.NextAttemptAt, model.GetMillis()-1000)
+		require.NoError(t, err)
+		require.True(t, claimed)
+		require.Nil(t, th.App.RetryEventWebhookDeliveries())
+
+		deliveries, _, err = th.SystemAdminClient.GetEventWebhookDeliveries(webhook.Id, 0, 60)
+		require.NoError(t, err)
+		assert.Equal(t, model.EventWebhookDeliveryStatusSucceeded, deliveries[0].Status)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.Equal(t, failed.Id, lastRequest().header.Get(model.EventWebhookHeaderDelivery))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEventWebhooks = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEventWebhooks = true })
+
+		_, resp, err := th.SystemAdminClient.CreateEventWebhook(newWebhook())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
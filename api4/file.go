@@ -56,6 +56,7 @@ const maxMultipartFormDataBytes = 10 * 1024 // 10Kb
 
 func (api *API) InitFile() {
 	api.BaseRoutes.Files.Handle("", api.APISessionRequired(uploadFileStream)).Methods("POST")
+	api.BaseRoutes.Files.Handle("/external", api.APISessionRequired(attachExternalFile)).Methods("POST")
 	api.BaseRoutes.File.Handle("", api.APISessionRequiredTrustRequester(getFile)).Methods("GET")
 	api.BaseRoutes.File.Handle("/thumbnail", api.APISessionRequiredTrustRequester(getFileThumbnail)).Methods("GET")
 	api.BaseRoutes.File.Handle("/link", api.APISessionRequired(getFileLink)).Methods("GET")
@@ -153,6 +154,44 @@ func uploadFileStream(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// attachExternalFile creates a file for an object hosted in external object storage, which can then
+// be attached to posts like an uploaded file.
+func attachExternalFile(c *Context, w http.ResponseWriter, r *http.Request) {
+	var attachment model.ExternalFileAttachment
+	if err := json.NewDecoder(r.Body).Decode(&attachment); err != nil {
+		c.SetInvalidParam("external_file")
+		return
+	}
+
+	if appErr := attachment.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("attachExternalFile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", attachment.ChannelId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), attachment.ChannelId, model.PermissionUploadFile) {
+		c.SetPermissionError(model.PermissionUploadFile)
+		return
+	}
+
+	info, appErr := c.App.CreateExternalFileInfo(c.AppContext.Session().UserId, &attachment)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("file", info)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(info); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 // uploadFileSimple uploads a file from a simple POST with the file in the request body
 func uploadFileSimple(c *Context, r *http.Request, timestamp time.Time) *model.FileUploadResponse {
 	// Simple POST with the file in the body and all metadata in the args.
@@ -490,6 +529,44 @@ func getFile(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if info.CreatorId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), info.PostId, model.PermissionDownloadFile) {
+		c.SetPermissionError(model.PermissionDownloadFile)
+		return
+	}
+
+	needsWatermark, err := c.App.FileNeedsWatermark(info)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("watermarked", needsWatermark)
+
+	if needsWatermark {
+		user, err := c.App.GetUser(c.AppContext.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		data, err := c.App.GetWatermarkedFile(info, user)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		auditRec.Success()
+
+		writeFileResponse(info.Name, info.MimeType, int64(len(data)), time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, bytes.NewReader(data), forceDownload, w, r)
+		return
+	}
+
+	if info.IsExternal() {
+		if writeExternalFileResponse(c, info, forceDownload, w, r) {
+			auditRec.Success()
+		}
+		return
+	}
+
 	fileReader, err := c.App.FileReader(info.Path)
 	if err != nil {
 		c.Err = err
@@ -521,12 +598,46 @@ func getFileThumbnail(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if info.CreatorId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), info.PostId, model.PermissionDownloadFile) {
+		c.SetPermissionError(model.PermissionDownloadFile)
+		return
+	}
+
 	if info.ThumbnailPath == "" {
 		c.Err = model.NewAppError("getFileThumbnail", "api.file.get_file_thumbnail.no_thumbnail.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
 		return
 	}
 
-	fileReader, err := c.App.FileReader(info.ThumbnailPath)
+	writeFileImageResponse(c, info, info.ThumbnailPath, ThumbnailImageType, forceDownload, w, r)
+}
+
+// writeFileImageResponse writes the thumbnail or the preview of the file, watermarked for the
+// user of the session when the file itself is.
+func writeFileImageResponse(c *Context, info *model.FileInfo, imagePath, contentType string, forceDownload bool, w http.ResponseWriter, r *http.Request) {
+	needsWatermark, err := c.App.FileNeedsWatermark(info)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if needsWatermark {
+		user, err := c.App.GetUser(c.AppContext.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		data, err := c.App.GetWatermarkedImage(info, imagePath, user)
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		writeFileResponse(info.Name, contentType, int64(len(data)), time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, bytes.NewReader(data), forceDownload, w, r)
+		return
+	}
+
+	fileReader, err := c.App.FileReader(imagePath)
 	if err != nil {
 		c.Err = err
 		c.Err.StatusCode = http.StatusNotFound
@@ -534,7 +645,7 @@ func getFileThumbnail(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	defer fileReader.Close()
 
-	writeFileResponse(info.Name, ThumbnailImageType, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
+	writeFileResponse(info.Name, contentType, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
 }
 
 func getFileLink(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -563,11 +674,27 @@ func getFileLink(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if info.CreatorId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), info.PostId, model.PermissionDownloadFile) {
+		c.SetPermissionError(model.PermissionDownloadFile)
+		return
+	}
+
 	if info.PostId == "" {
 		c.Err = model.NewAppError("getPublicLink", "api.file.get_public_link.no_post.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
 		return
 	}
 
+	// A public link would hand out the file without the watermark of whoever downloads it.
+	needsWatermark, err := c.App.FileNeedsWatermark(info)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if needsWatermark {
+		c.Err = model.NewAppError("getPublicLink", "api.file.get_public_link.watermarked.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
+		return
+	}
+
 	resp := make(map[string]string)
 	link := c.App.GeneratePublicLink(c.GetSiteURLHeader(), info)
 	resp["link"] = link
@@ -596,20 +723,17 @@ func getFilePreview(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if info.PreviewPath == "" {
-		c.Err = model.NewAppError("getFilePreview", "api.file.get_file_preview.no_preview.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
+	if info.CreatorId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), info.PostId, model.PermissionDownloadFile) {
+		c.SetPermissionError(model.PermissionDownloadFile)
 		return
 	}
 
-	fileReader, err := c.App.FileReader(info.PreviewPath)
-	if err != nil {
-		c.Err = err
-		c.Err.StatusCode = http.StatusNotFound
+	if info.PreviewPath == "" {
+		c.Err = model.NewAppError("getFilePreview", "api.file.get_file_preview.no_preview.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
 		return
 	}
-	defer fileReader.Close()
 
-	writeFileResponse(info.Name, PreviewImageType, 0, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, forceDownload, w, r)
+	writeFileImageResponse(c, info, info.PreviewPath, PreviewImageType, forceDownload, w, r)
 }
 
 func getFileInfo(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -666,6 +790,18 @@ func getPublicFile(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The links made before the channel was tagged as sensitive stop working.
+	if needsWatermark, err := c.App.FileNeedsWatermark(info); err != nil || needsWatermark {
+		c.Err = model.NewAppError("getPublicFile", "api.file.get_public_link.watermarked.app_error", nil, "file_id="+info.Id, http.StatusBadRequest)
+		utils.RenderWebAppError(c.App.Config(), w, r, c.Err, c.App.AsymmetricSigningKey())
+		return
+	}
+
+	if info.IsExternal() {
+		writeExternalFileResponse(c, info, false, w, r)
+		return
+	}
+
 	fileReader, err := c.App.FileReader(info.Path)
 	if err != nil {
 		c.Err = err
@@ -678,6 +814,32 @@ func getPublicFile(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func writeFileResponse(filename string, contentType string, contentSize int64, lastModification time.Time, webserverMode string, fileReader io.ReadSeeker, forceDownload bool, w http.ResponseWriter, r *http.Request) {
+	filename = setFileResponseHeaders(filename, contentType, contentSize, webserverMode, forceDownload, w)
+
+	http.ServeContent(w, r, filename, lastModification, fileReader)
+}
+
+// writeExternalFileResponse proxies the object behind an external file to the client, returning
+// whether it could be read from its host. The size recorded when the file was attached isn't sent,
+// since the object may have changed since.
+func writeExternalFileResponse(c *Context, info *model.FileInfo, forceDownload bool, w http.ResponseWriter, r *http.Request) bool {
+	fileReader, err := c.App.ExternalFileReader(info)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+	defer fileReader.Close()
+
+	setFileResponseHeaders(info.Name, info.MimeType, 0, *c.App.Config().ServiceSettings.WebserverMode, forceDownload, w)
+
+	if _, copyErr := io.Copy(w, fileReader); copyErr != nil {
+		mlog.Warn("Error while proxying external file", mlog.String("file_id", info.Id), mlog.Err(copyErr))
+	}
+	return true
+}
+
+// setFileResponseHeaders sets the headers of a file download and returns the escaped file name.
+func setFileResponseHeaders(filename string, contentType string, contentSize int64, webserverMode string, forceDownload bool, w http.ResponseWriter) string {
 	w.Header().Set("Cache-Control", "private, no-cache")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
 
@@ -731,7 +893,7 @@ func writeFileResponse(filename string, contentType string, contentSize int64, l
 	w.Header().Set("X-Frame-Options", "DENY")
 	w.Header().Set("Content-Security-Policy", "Frame-ancestors 'none'")
 
-	http.ServeContent(w, r, filename, lastModification, fileReader)
+	return filename
 }
 
 func searchFiles(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -778,9 +940,9 @@ func searchFiles(c *Context, w http.ResponseWriter, r *http.Request) {
 		perPage = *params.PerPage
 	}
 
-	includeDeletedChannels := false
-	if params.IncludeDeletedChannels != nil {
-		includeDeletedChannels = *params.IncludeDeletedChannels
+	includeDeletedChannels := searchIncludeDeletedChannels(c, &params)
+	if c.Err != nil {
+		return
 	}
 
 	startTime := time.Now()
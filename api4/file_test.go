@@ -12,6 +12,7 @@ import (
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/textproto"
 	"net/url"
 	"os"
@@ -764,6 +765,131 @@ func TestGetFile(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetFileDownloadPermission(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	if *th.App.Config().FileSettings.DriverName == "" {
+		t.Skip("skipping because no file driver is enabled")
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnablePublicLink = true })
+
+	sent, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+
+	fileResp, _, err := client.UploadFile(sent, th.BasicChannel.Id, "test.png")
+	require.NoError(t, err)
+	fileId := fileResp.FileInfos[0].Id
+
+	err = th.App.Srv().Store.FileInfo().AttachToPost(fileId, th.BasicPost.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+
+	th.RemovePermissionFromRole(model.PermissionDownloadFile.Id, model.ChannelUserRoleId)
+	defer th.AddPermissionToRole(model.PermissionDownloadFile.Id, model.ChannelUserRoleId)
+
+	// The file can still be viewed in the channel.
+	th.LoginBasic2()
+	_, _, err = client.GetFileInfo(fileId)
+	require.NoError(t, err)
+
+	_, resp, err := client.GetFile(fileId)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.GetFileLink(fileId)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.GetFileThumbnail(fileId)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.GetFilePreview(fileId)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	th.LoginBasic()
+	_, _, err = client.GetFile(fileId)
+	require.NoError(t, err, "the creator of the file can always download it")
+	_, _, err = client.GetFilePreview(fileId)
+	require.NoError(t, err)
+
+	th.AddPermissionToRole(model.PermissionDownloadFile.Id, model.ChannelUserRoleId)
+	th.LoginBasic2()
+	_, _, err = client.GetFile(fileId)
+	require.NoError(t, err)
+}
+
+func TestGetFileWatermark(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	if *th.App.Config().FileSettings.DriverName == "" {
+		t.Skip("skipping because no file driver is enabled")
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.FileSettings.EnablePublicLink = true
+		*cfg.FileSettings.PublicLinkSalt = model.NewRandomString(32)
+		cfg.FileSettings.WatermarkSensitivityTags = []string{"restricted"}
+	})
+
+	sent, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+
+	fileResp, _, err := client.UploadFile(sent, th.BasicChannel.Id, "test.png")
+	require.NoError(t, err)
+	fileId := fileResp.FileInfos[0].Id
+
+	err = th.App.Srv().Store.FileInfo().AttachToPost(fileId, th.BasicPost.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	info, err := th.App.Srv().Store.FileInfo().Get(fileId)
+	require.NoError(t, err)
+	defer th.cleanupTestFile(info)
+	link := th.App.GeneratePublicLink(client.URL, info)
+
+	data, _, err := client.GetFile(fileId)
+	require.NoError(t, err)
+	require.Equal(t, sent, data, "files outside of tagged channels aren't watermarked")
+	preview, _, err := client.GetFilePreview(fileId)
+	require.NoError(t, err)
+
+	th.BasicChannel.SensitivityTag = "restricted"
+	_, appErr := th.App.UpdateChannel(th.BasicChannel)
+	require.Nil(t, appErr)
+
+	watermarked, _, err := client.GetFile(fileId)
+	require.NoError(t, err)
+	require.NotEqual(t, sent, watermarked)
+
+	watermarkedPreview, _, err := client.GetFilePreview(fileId)
+	require.NoError(t, err)
+	require.NotEqual(t, preview, watermarkedPreview, "previews are watermarked along with their file")
+	thumbnail, _, err := client.GetFileThumbnail(fileId)
+	require.NoError(t, err)
+	require.NotEmpty(t, thumbnail)
+
+	again, _, err := client.GetFile(fileId)
+	require.NoError(t, err)
+	require.Equal(t, watermarked, again, "the watermarked file should be cached")
+
+	th.LoginBasic2()
+	other, _, err := client.GetFile(fileId)
+	require.NoError(t, err)
+	require.NotEqual(t, watermarked, other, "the files should be watermarked per user")
+
+	_, resp, err := client.GetFileLink(fileId)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	httpResp, err := http.Get(link)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, httpResp.StatusCode, "public links should stop working once the channel is tagged")
+}
+
 func TestGetFileHeaders(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1246,3 +1372,90 @@ func TestSearchFiles(t *testing.T) {
 	require.Error(t, err)
 	CheckUnauthorizedStatus(t, resp)
 }
+
+func TestAttachExternalFile(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	content := []byte("external file contents")
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("signature") != "valid" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		http.ServeContent(w, r, "report.txt", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	fileURL := server.URL + "/bucket/report%20final.txt?signature=valid"
+
+	t.Run("disabled without allowed hosts", func(t *testing.T) {
+		_, resp, err := th.Client.AttachExternalFile(th.BasicChannel.Id, fileURL, "")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.FileSettings.ExternalFileHosts = []string{serverURL.Hostname()}
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.0/8"
+		*cfg.ServiceSettings.EnableInsecureOutgoingConnections = true
+	})
+
+	t.Run("attach and download", func(t *testing.T) {
+		info, resp, err := th.Client.AttachExternalFile(th.BasicChannel.Id, fileURL, "")
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, "report final.txt", info.Name)
+		assert.Equal(t, "txt", info.Extension)
+		assert.Equal(t, "text/plain", info.MimeType)
+		assert.Equal(t, int64(len(content)), info.Size)
+		assert.Empty(t, info.ExternalURL, "the signed url should not be sent to clients")
+
+		data, _, err := th.Client.GetFile(info.Id)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		post, _, err := th.Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "external", FileIds: []string{info.Id}})
+		require.NoError(t, err)
+		require.Equal(t, model.StringArray{info.Id}, post.FileIds)
+
+		// Only members of the channel can read it through the proxy.
+		th.LoginBasic2()
+		th.RemoveUserFromChannel(th.BasicUser2, th.BasicChannel)
+		_, resp, err = th.Client.GetFile(info.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		th.LoginBasic()
+	})
+
+	t.Run("unreadable object", func(t *testing.T) {
+		_, resp, err := th.Client.AttachExternalFile(th.BasicChannel.Id, server.URL+"/bucket/report.txt?signature=expired", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("host not allowed", func(t *testing.T) {
+		_, resp, err := th.Client.AttachExternalFile(th.BasicChannel.Id, "https://example.com/report.txt", "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		_, resp, err := th.Client.AttachExternalFile(th.BasicChannel.Id, "http://"+serverURL.Host+"/report.txt", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("no permission to upload to the channel", func(t *testing.T) {
+		channel := th.CreatePrivateChannel()
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.AttachExternalFile(channel.Id, fileURL, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
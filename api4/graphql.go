@@ -7,7 +7,11 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"time"
 
 	graphql "github.com/graph-gophers/graphql-go"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
@@ -37,20 +41,17 @@ func (api *API) InitGraphQL() error {
 		graphql.MaxParallelism(5),
 	}
 
+	// The depth of the queries is limited by analyzeGraphQLQuery, which leaves out the
+	// introspection queries.
 	if isProd() {
-		opts = append(opts,
-			// MaxDepth cannot be moved as a general param
-			// because otherwise introspection also doesn't work
-			// with just a depth of 4.
-			graphql.MaxDepth(4),
-			graphql.DisableIntrospection(),
-		)
+		opts = append(opts, graphql.DisableIntrospection())
 	}
 
 	api.schema, err = graphql.ParseSchema(schemaRaw, &resolver{}, opts...)
 	if err != nil {
 		return err
 	}
+	api.graphQLBudget = newGraphQLCostBudget()
 
 	api.BaseRoutes.APIRoot5.Handle("/graphql", api.APIHandlerTrustRequester(graphiQL)).Methods("GET")
 	api.BaseRoutes.APIRoot5.Handle("/graphql", api.APISessionRequired(api.graphQL)).Methods("POST")
@@ -88,6 +89,11 @@ func (api *API) graphQL(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if errs := api.checkGraphQLCost(c, &params); len(errs) > 0 {
+		response = &graphql.Response{Errors: errs}
+		return
+	}
+
 	// Populate the context with required info.
 	reqCtx := r.Context()
 	reqCtx = context.WithValue(reqCtx, ctxKey{}, c)
@@ -112,6 +118,43 @@ func (api *API) graphQL(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// checkGraphQLCost rejects the queries that are too deep or select too many fields, and
+// charges the cost of the other ones to the budget of the session.
+func (api *API) checkGraphQLCost(c *Context, params *graphQLInput) []*gqlerrors.QueryError {
+	settings := c.App.Config().ServiceSettings
+	cost, err := analyzeGraphQLQuery(api.schema.ASTSchema(), params.Query, params.OperationName, params.Variables, *settings.GraphQLMaxNodeCount)
+	switch {
+	case errors.Is(err, errGraphQLMaxNodes):
+		return []*gqlerrors.QueryError{newGraphQLLimitError(graphQLErrorCodeMaxNodes,
+			fmt.Sprintf("query selects more than %d fields", *settings.GraphQLMaxNodeCount),
+			*settings.GraphQLMaxNodeCount, cost.Nodes)}
+	case err != nil:
+		// Report why the schema doesn't accept the query, if it doesn't.
+		if errs := api.schema.ValidateWithVariables(params.Query, params.Variables); len(errs) > 0 {
+			return errs
+		}
+		return []*gqlerrors.QueryError{{
+			Message:    err.Error(),
+			Extensions: map[string]interface{}{"code": graphQLErrorCodeSyntax},
+		}}
+	case cost.Depth > *settings.GraphQLMaxDepth:
+		return []*gqlerrors.QueryError{newGraphQLLimitError(graphQLErrorCodeMaxDepth,
+			fmt.Sprintf("query nests fields deeper than %d", *settings.GraphQLMaxDepth),
+			*settings.GraphQLMaxDepth, cost.Depth)}
+	}
+
+	ok, retryAfter := api.graphQLBudget.spend(c.AppContext.Session().Id, cost.Cost, *settings.GraphQLSessionCostBudget, time.Now())
+	if !ok {
+		qErr := newGraphQLLimitError(graphQLErrorCodeBudgetExceeded,
+			fmt.Sprintf("query cost of %d exceeds what is left of the budget of %d per minute", cost.Cost, *settings.GraphQLSessionCostBudget),
+			*settings.GraphQLSessionCostBudget, cost.Cost)
+		qErr.Extensions["retry_after_seconds"] = int(math.Ceil(retryAfter.Seconds()))
+		return []*gqlerrors.QueryError{qErr}
+	}
+
+	return nil
+}
+
 func graphiQL(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html")
 	w.Write(graphiqlPage)
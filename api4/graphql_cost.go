@@ -0,0 +1,644 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"text/scanner"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/types"
+)
+
+const (
+	// graphQLDefaultListSize is how many items a list field without a "first" argument is
+	// assumed to return.
+	graphQLDefaultListSize = 10
+	graphQLMaxCost         = math.MaxInt32
+
+	graphQLCostBudgetWindow = time.Minute
+)
+
+// Codes set in the extensions of the errors returned when a query exceeds the limits.
+const (
+	graphQLErrorCodeSyntax         = "QUERY_SYNTAX_ERROR"
+	graphQLErrorCodeMaxDepth       = "QUERY_MAX_DEPTH_EXCEEDED"
+	graphQLErrorCodeMaxNodes       = "QUERY_MAX_NODES_EXCEEDED"
+	graphQLErrorCodeBudgetExceeded = "QUERY_COST_BUDGET_EXCEEDED"
+)
+
+var errGraphQLMaxNodes = errors.New("maximum node count exceeded")
+
+// graphQLQueryCost is the static analysis of a query before it is run.
+//
+// Depth is how deeply fields are nested, Nodes is how many fields are selected once the
+// fragments are expanded, and Cost estimates how many objects the resolvers fetch: every
+// field with a selection set costs one per item of the lists it is nested in.
+type graphQLQueryCost struct {
+	Depth int
+	Nodes int
+	Cost  int
+}
+
+func newGraphQLLimitError(code string, message string, limit, value int) *gqlerrors.QueryError {
+	return &gqlerrors.QueryError{
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code":  code,
+			"limit": limit,
+			"value": value,
+		},
+	}
+}
+
+// analyzeGraphQLQuery computes the cost of the operation of the query. The analysis stops
+// as soon as more than maxNodes fields are selected, returning errGraphQLMaxNodes.
+func analyzeGraphQLQuery(schema *types.Schema, query, operationName string, variables map[string]interface{}, maxNodes int) (*graphQLQueryCost, error) {
+	doc, err := parseGraphQLDocument(query)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := doc.operation(operationName)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &graphQLCostAnalyzer{
+		schema:      schema,
+		fragments:   doc.fragments,
+		variables:   variables,
+		varDefaults: op.varDefaults,
+		maxNodes:    maxNodes,
+		visiting:    map[string]bool{},
+	}
+
+	var root types.NamedType
+	if schema != nil {
+		root = schema.EntryPoints[op.kind]
+	}
+	if err := a.walk(op.selections, root, 0, 1); err != nil {
+		return &a.cost, err
+	}
+
+	return &a.cost, nil
+}
+
+type graphQLCostAnalyzer struct {
+	schema      *types.Schema
+	fragments   map[string]*graphQLFragment
+	variables   map[string]interface{}
+	varDefaults map[string]interface{}
+	maxNodes    int
+	visiting    map[string]bool
+	cost        graphQLQueryCost
+}
+
+func (a *graphQLCostAnalyzer) walk(selections []*graphQLSelection, parent types.NamedType, depth, multiplier int) error {
+	for _, sel := range selections {
+		switch {
+		case sel.spread != "":
+			fragment, ok := a.fragments[sel.spread]
+			// Unknown and cyclic fragments are reported by the validation of the query.
+			if !ok || a.visiting[sel.spread] {
+				continue
+			}
+			a.visiting[sel.spread] = true
+			err := a.walk(fragment.selections, a.typeCondition(fragment.typeCondition, parent), depth, multiplier)
+			delete(a.visiting, sel.spread)
+			if err != nil {
+				return err
+			}
+
+		case sel.inline:
+			if err := a.walk(sel.selections, a.typeCondition(sel.typeCondition, parent), depth, multiplier); err != nil {
+				return err
+			}
+
+		default:
+			// Introspection fields are left to the schema, which disables them in production.
+			if strings.HasPrefix(sel.name, "__") {
+				continue
+			}
+
+			a.cost.Nodes++
+			if a.cost.Nodes > a.maxNodes {
+				return errGraphQLMaxNodes
+			}
+			if depth+1 > a.cost.Depth {
+				a.cost.Depth = depth + 1
+			}
+			if len(sel.selections) == 0 {
+				continue
+			}
+
+			a.cost.Cost = addGraphQLCost(a.cost.Cost, multiplier)
+
+			def := graphQLFieldDefinition(parent, sel.name)
+			var fieldType types.NamedType
+			childMultiplier := multiplier
+			if def != nil {
+				var isList bool
+				fieldType, isList = unwrapGraphQLType(def.Type)
+				if isList {
+					childMultiplier = mulGraphQLCost(multiplier, a.listSize(sel, def))
+				}
+			}
+			if err := a.walk(sel.selections, fieldType, depth+1, childMultiplier); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *graphQLCostAnalyzer) typeCondition(name string, parent types.NamedType) types.NamedType {
+	if name == "" || a.schema == nil {
+		return parent
+	}
+	return a.schema.Types[name]
+}
+
+// listSize returns how many items the list field is asked for.
+func (a *graphQLCostAnalyzer) listSize(sel *graphQLSelection, def *types.FieldDefinition) int {
+	if value, ok := sel.args["first"]; ok {
+		if variable, ok := value.(graphQLVariable); ok {
+			if value, ok = a.variables[string(variable)]; !ok {
+				value = a.varDefaults[string(variable)]
+			}
+		}
+		if size, ok := graphQLIntValue(value); ok {
+			return size
+		}
+	}
+
+	if arg := def.Arguments.Get("first"); arg != nil {
+		if value, ok := arg.Default.(*types.PrimitiveValue); ok {
+			if size, err := strconv.Atoi(value.Text); err == nil && size >= 0 {
+				return size
+			}
+		}
+	}
+
+	return graphQLDefaultListSize
+}
+
+func graphQLIntValue(value interface{}) (int, bool) {
+	var size int64
+	switch v := value.(type) {
+	case int64:
+		size = v
+	case int:
+		size = int64(v)
+	case float64:
+		size = int64(v)
+	default:
+		return 0, false
+	}
+	if size < 0 {
+		return 0, false
+	}
+	if size > graphQLMaxCost {
+		return graphQLMaxCost, true
+	}
+	return int(size), true
+}
+
+func graphQLFieldDefinition(parent types.NamedType, name string) *types.FieldDefinition {
+	switch t := parent.(type) {
+	case *types.ObjectTypeDefinition:
+		return t.Fields.Get(name)
+	case *types.InterfaceTypeDefinition:
+		return t.Fields.Get(name)
+	}
+	return nil
+}
+
+func unwrapGraphQLType(t types.Type) (named types.NamedType, isList bool) {
+	for {
+		switch wrapped := t.(type) {
+		case *types.NonNull:
+			t = wrapped.OfType
+		case *types.List:
+			isList = true
+			t = wrapped.OfType
+		case types.NamedType:
+			return wrapped, isList
+		default:
+			return nil, isList
+		}
+	}
+}
+
+func addGraphQLCost(a, b int) int {
+	if a > graphQLMaxCost-b {
+		return graphQLMaxCost
+	}
+	return a + b
+}
+
+func mulGraphQLCost(a, b int) int {
+	if b != 0 && a > graphQLMaxCost/b {
+		return graphQLMaxCost
+	}
+	return a * b
+}
+
+// graphQLCostBudget keeps track of the cost of the queries run by each session, each session
+// being allowed a budget per minute.
+type graphQLCostBudget struct {
+	mut       sync.Mutex
+	windows   map[string]*graphQLCostWindow
+	lastPrune time.Time
+}
+
+type graphQLCostWindow struct {
+	start time.Time
+	spent int
+}
+
+func newGraphQLCostBudget() *graphQLCostBudget {
+	return &graphQLCostBudget{
+		windows: map[string]*graphQLCostWindow{},
+	}
+}
+
+// spend charges the cost of a query to the session, unless it doesn't fit in what is left of
+// the budget of the session for the current minute. In that case, it returns how long until
+// the budget is renewed.
+func (b *graphQLCostBudget) spend(sessionID string, cost, budget int, now time.Time) (bool, time.Duration) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	window, ok := b.windows[sessionID]
+	if !ok || now.Sub(window.start) >= graphQLCostBudgetWindow {
+		if now.Sub(b.lastPrune) >= graphQLCostBudgetWindow {
+			b.prune(now)
+		}
+		window = &graphQLCostWindow{start: now}
+		b.windows[sessionID] = window
+	}
+
+	if window.spent+cost > budget {
+		return false, window.start.Add(graphQLCostBudgetWindow).Sub(now)
+	}
+
+	window.spent += cost
+	return true, 0
+}
+
+// prune forgets the sessions whose budget window is over.
+func (b *graphQLCostBudget) prune(now time.Time) {
+	for sessionID, window := range b.windows {
+		if now.Sub(window.start) >= graphQLCostBudgetWindow {
+			delete(b.windows, sessionID)
+		}
+	}
+	b.lastPrune = now
+}
+
+// The parsing below only keeps what the cost analysis needs from executable documents: the
+// selections, the arguments of the fields and the defaults of the variables. The validation
+// of the query is left to the schema.
+
+type graphQLVariable string
+
+type graphQLSelection struct {
+	name          string
+	args          map[string]interface{}
+	selections    []*graphQLSelection
+	spread        string
+	inline        bool
+	typeCondition string
+}
+
+type graphQLOperation struct {
+	kind        string
+	name        string
+	varDefaults map[string]interface{}
+	selections  []*graphQLSelection
+}
+
+type graphQLFragment struct {
+	typeCondition string
+	selections    []*graphQLSelection
+}
+
+type graphQLDocument struct {
+	operations []*graphQLOperation
+	fragments  map[string]*graphQLFragment
+}
+
+func (d *graphQLDocument) operation(name string) (*graphQLOperation, error) {
+	if len(d.operations) == 0 {
+		return nil, errors.New("no operations in query document")
+	}
+
+	if name == "" {
+		if len(d.operations) != 1 {
+			return nil, errors.New("more than one operation in query document and no operation name given")
+		}
+		return d.operations[0], nil
+	}
+
+	for _, op := range d.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("no operation with name %q", name)
+}
+
+type graphQLParser struct {
+	s   scanner.Scanner
+	tok rune
+	err error
+}
+
+func parseGraphQLDocument(query string) (doc *graphQLDocument, err error) {
+	p := &graphQLParser{}
+	p.s.Init(strings.NewReader(query))
+	p.s.Mode = scanner.ScanIdents | scanner.ScanInts | scanner.ScanFloats | scanner.ScanStrings
+	p.s.Whitespace = 1<<'\t' | 1<<'\n' | 1<<'\r' | 1<<' ' | 1<<','
+	p.s.Error = func(s *scanner.Scanner, msg string) {
+		if p.err == nil {
+			p.err = fmt.Errorf("%s at %s", msg, s.Position)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			perr, ok := r.(graphQLSyntaxError)
+			if !ok {
+				panic(r)
+			}
+			doc, err = nil, perr
+		}
+	}()
+
+	p.next()
+	doc = &graphQLDocument{fragments: map[string]*graphQLFragment{}}
+	for p.tok != scanner.EOF {
+		if p.tok == scanner.Ident && p.tokenText() == "fragment" {
+			p.next()
+			name := p.ident()
+			p.keyword("on")
+			fragment := &graphQLFragment{typeCondition: p.ident()}
+			p.directives()
+			fragment.selections = p.selectionSet()
+			doc.fragments[name] = fragment
+			continue
+		}
+		doc.operations = append(doc.operations, p.operation())
+	}
+
+	return doc, nil
+}
+
+type graphQLSyntaxError struct {
+	error
+}
+
+func (p *graphQLParser) fail(format string, args ...interface{}) {
+	panic(graphQLSyntaxError{fmt.Errorf("syntax error: "+format+" at %s", append(args, p.s.Position)...)})
+}
+
+func (p *graphQLParser) tokenText() string {
+	if p.tok == scanner.EOF {
+		return "EOF"
+	}
+	return p.s.TokenText()
+}
+
+func (p *graphQLParser) next() {
+	p.tok = p.s.Scan()
+	for p.tok == '#' {
+		for r := p.s.Next(); r != '\n' && r != '\r' && r != scanner.EOF; r = p.s.Next() {
+		}
+		p.tok = p.s.Scan()
+	}
+	if p.err != nil {
+		p.fail("%v", p.err)
+	}
+	if p.tok == scanner.String && p.tokenText() == `""` && p.s.Peek() == '"' {
+		p.blockString()
+	}
+}
+
+// blockString skips the rest of a """block string""", whose opening quotes were scanned as an
+// empty string.
+func (p *graphQLParser) blockString() {
+	p.s.Next()
+	quotes := 0
+	for quotes < 3 {
+		switch r := p.s.Next(); r {
+		case scanner.EOF:
+			p.fail("unterminated block string")
+		case '"':
+			quotes++
+		case '\\':
+			quotes = 0
+			if p.s.Peek() == '"' {
+				p.s.Next()
+			}
+		default:
+			quotes = 0
+		}
+	}
+}
+
+func (p *graphQLParser) expect(tok rune) {
+	if p.tok != tok {
+		p.fail("unexpected %q, expecting %q", p.tokenText(), string(tok))
+	}
+	p.next()
+}
+
+func (p *graphQLParser) ident() string {
+	if p.tok != scanner.Ident {
+		p.fail("unexpected %q, expecting name", p.tokenText())
+	}
+	name := p.tokenText()
+	p.next()
+	return name
+}
+
+func (p *graphQLParser) keyword(keyword string) {
+	if name := p.ident(); name != keyword {
+		p.fail("unexpected %q, expecting %q", name, keyword)
+	}
+}
+
+func (p *graphQLParser) spread() bool {
+	if p.tok != '.' {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		p.expect('.')
+	}
+	return true
+}
+
+func (p *graphQLParser) operation() *graphQLOperation {
+	op := &graphQLOperation{kind: "query", varDefaults: map[string]interface{}{}}
+	if p.tok == '{' {
+		op.selections = p.selectionSet()
+		return op
+	}
+
+	op.kind = p.ident()
+	if op.kind != "query" && op.kind != "mutation" && op.kind != "subscription" {
+		p.fail("unexpected %q, expecting an operation", op.kind)
+	}
+	if p.tok == scanner.Ident {
+		op.name = p.ident()
+	}
+	if p.tok == '(' {
+		p.next()
+		for p.tok != ')' {
+			p.expect('$')
+			name := p.ident()
+			p.expect(':')
+			p.typeRef()
+			if p.tok == '=' {
+				p.next()
+				op.varDefaults[name] = p.value()
+			}
+			p.directives()
+		}
+		p.next()
+	}
+	p.directives()
+	op.selections = p.selectionSet()
+	return op
+}
+
+func (p *graphQLParser) typeRef() {
+	if p.tok == '[' {
+		p.next()
+		p.typeRef()
+		p.expect(']')
+	} else {
+		p.ident()
+	}
+	if p.tok == '!' {
+		p.next()
+	}
+}
+
+func (p *graphQLParser) directives() {
+	for p.tok == '@' {
+		p.next()
+		p.ident()
+		if p.tok == '(' {
+			p.arguments()
+		}
+	}
+}
+
+func (p *graphQLParser) arguments() map[string]interface{} {
+	args := map[string]interface{}{}
+	p.expect('(')
+	for p.tok != ')' {
+		name := p.ident()
+		p.expect(':')
+		args[name] = p.value()
+	}
+	p.next()
+	return args
+}
+
+// value parses a value, only keeping the integers and the variables.
+func (p *graphQLParser) value() interface{} {
+	switch p.tok {
+	case '$':
+		p.next()
+		return graphQLVariable(p.ident())
+	case '-':
+		p.next()
+		if p.tok != scanner.Int && p.tok != scanner.Float {
+			p.fail("unexpected %q, expecting number", p.tokenText())
+		}
+		p.next()
+		return nil
+	case scanner.Int:
+		value, err := strconv.ParseInt(p.tokenText(), 10, 64)
+		p.next()
+		if err != nil {
+			return nil
+		}
+		return value
+	case scanner.Float, scanner.String, scanner.Ident:
+		p.next()
+		return nil
+	case '[':
+		p.next()
+		for p.tok != ']' {
+			p.value()
+		}
+		p.next()
+		return nil
+	case '{':
+		p.next()
+		for p.tok != '}' {
+			p.ident()
+			p.expect(':')
+			p.value()
+		}
+		p.next()
+		return nil
+	}
+	p.fail("unexpected %q, expecting value", p.tokenText())
+	return nil
+}
+
+func (p *graphQLParser) selectionSet() []*graphQLSelection {
+	var selections []*graphQLSelection
+	p.expect('{')
+	for p.tok != '}' {
+		selections = append(selections, p.selection())
+	}
+	p.next()
+	return selections
+}
+
+func (p *graphQLParser) selection() *graphQLSelection {
+	if p.spread() {
+		if p.tok == scanner.Ident && p.tokenText() != "on" {
+			sel := &graphQLSelection{spread: p.ident()}
+			p.directives()
+			return sel
+		}
+
+		sel := &graphQLSelection{inline: true}
+		if p.tok == scanner.Ident {
+			p.next()
+			sel.typeCondition = p.ident()
+		}
+		p.directives()
+		sel.selections = p.selectionSet()
+		return sel
+	}
+
+	sel := &graphQLSelection{name: p.ident()}
+	if p.tok == ':' {
+		p.next()
+		sel.name = p.ident()
+	}
+	if p.tok == '(' {
+		sel.args = p.arguments()
+	}
+	p.directives()
+	if p.tok == '{' {
+		sel.selections = p.selectionSet()
+	}
+	return sel
+}
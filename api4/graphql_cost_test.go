@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+	"time"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeGraphQLQuery(t *testing.T) {
+	schema, err := graphql.ParseSchema(schemaRaw, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name      string
+		Query     string
+		Variables map[string]interface{}
+		Expected  graphQLQueryCost
+	}{
+		{
+			Name:     "scalar field",
+			Query:    `query config { config }`,
+			Expected: graphQLQueryCost{Depth: 1, Nodes: 1, Cost: 0},
+		},
+		{
+			Name:     "list with the default page size",
+			Query:    `{ channels(userId: "me") { id team { id } } }`,
+			Expected: graphQLQueryCost{Depth: 3, Nodes: 4, Cost: 61},
+		},
+		{
+			Name:      "list with the page size in a variable",
+			Query:     `query channels($first: Int = 5) { channels(userId: "me", first: $first) { team { id } } }`,
+			Variables: map[string]interface{}{"first": float64(100)},
+			Expected:  graphQLQueryCost{Depth: 3, Nodes: 3, Cost: 101},
+		},
+		{
+			Name:     "list with the page size in a variable default",
+			Query:    `query channels($first: Int = 5) { channels(userId: "me", first: $first) { team { id } } }`,
+			Expected: graphQLQueryCost{Depth: 3, Nodes: 3, Cost: 6},
+		},
+		{
+			Name: "fragments and nested lists",
+			Query: `
+query teamMembers {
+	teamMembers(userId: "me") {
+		...member
+		sidebarCategories { id channelIds }
+	}
+}
+
+# Comments and directives are skipped.
+fragment member on TeamMember {
+	team @include(if: true) { id }
+	user { roles { id } }
+}`,
+			Expected: graphQLQueryCost{Depth: 4, Nodes: 9, Cost: 41},
+		},
+		{
+			Name:     "introspection",
+			Query:    `{ __schema { types { name fields { name } } } }`,
+			Expected: graphQLQueryCost{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cost, err := analyzeGraphQLQuery(schema.ASTSchema(), tc.Query, "", tc.Variables, 500)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, *cost)
+		})
+	}
+
+	t.Run("operation name", func(t *testing.T) {
+		query := `query a { config } query b { channels(userId: "me") { id } }`
+
+		cost, err := analyzeGraphQLQuery(schema.ASTSchema(), query, "b", nil, 500)
+		require.NoError(t, err)
+		assert.Equal(t, graphQLQueryCost{Depth: 2, Nodes: 2, Cost: 1}, *cost)
+
+		_, err = analyzeGraphQLQuery(schema.ASTSchema(), query, "", nil, 500)
+		require.Error(t, err)
+	})
+
+	t.Run("too many nodes", func(t *testing.T) {
+		// Each fragment doubles the fields selected by the previous one.
+		query := `
+{ user(id: "me") { ...f3 } }
+fragment f0 on User { id username }
+fragment f1 on User { ...f0 a: status { status } }
+fragment f2 on User { ...f1 b: customStatus { emoji } ...f1 }
+fragment f3 on User { ...f2 ...f2 }`
+
+		cost, err := analyzeGraphQLQuery(schema.ASTSchema(), query, "", nil, 10)
+		require.ErrorIs(t, err, errGraphQLMaxNodes)
+		assert.Equal(t, 11, cost.Nodes)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := analyzeGraphQLQuery(schema.ASTSchema(), `{ user(id: "me") { id `, "", nil, 500)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "syntax error")
+	})
+}
+
+func TestGraphQLCostBudget(t *testing.T) {
+	budget := newGraphQLCostBudget()
+	now := time.Now()
+
+	ok, _ := budget.spend("session1", 60, 100, now)
+	assert.True(t, ok)
+
+	ok, retryAfter := budget.spend("session1", 60, 100, now.Add(20*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, 40*time.Second, retryAfter)
+
+	ok, _ = budget.spend("session2", 60, 100, now.Add(20*time.Second))
+	assert.True(t, ok, "each session has its own budget")
+
+	ok, _ = budget.spend("session1", 40, 100, now.Add(30*time.Second))
+	assert.True(t, ok)
+
+	ok, _ = budget.spend("session1", 100, 100, now.Add(time.Minute))
+	assert.True(t, ok, "the budget is renewed every minute")
+	assert.Len(t, budget.windows, 2)
+
+	ok, _ = budget.spend("session3", 1, 100, now.Add(2*time.Minute))
+	assert.True(t, ok)
+	assert.Len(t, budget.windows, 1, "the sessions whose budget window is over are forgotten")
+}
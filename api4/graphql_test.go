@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func TestGraphQLPayload(t *testing.T) {
@@ -32,3 +34,70 @@ func TestGraphQLPayload(t *testing.T) {
 	// to not confuse with other errors.
 	require.Contains(t, resp.Errors[0].Message, "request body too large")
 }
+
+func TestGraphQLQueryLimits(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	query := `query channels($userId: String = "") {
+	channels(userId: $userId) {
+		id
+		team {
+			id
+		}
+	}
+}`
+
+	t.Run("max depth", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLMaxDepth = 2 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLMaxDepth = 4 })
+
+		resp, err := th.MakeGraphQLRequest(&graphQLInput{
+			OperationName: "channels",
+			Query:         query,
+			Variables:     map[string]interface{}{"userId": th.BasicUser.Id},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, graphQLErrorCodeMaxDepth, resp.Errors[0].Extensions["code"])
+		require.EqualValues(t, 3, resp.Errors[0].Extensions["value"])
+	})
+
+	t.Run("max node count", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLMaxNodeCount = 2 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLMaxNodeCount = 500 })
+
+		resp, err := th.MakeGraphQLRequest(&graphQLInput{
+			OperationName: "channels",
+			Query:         query,
+			Variables:     map[string]interface{}{"userId": th.BasicUser.Id},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, graphQLErrorCodeMaxNodes, resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("session cost budget", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLSessionCostBudget = 100 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.GraphQLSessionCostBudget = 10000 })
+
+		input := &graphQLInput{
+			OperationName: "channels",
+			Query:         query,
+			Variables:     map[string]interface{}{"userId": th.BasicUser.Id},
+		}
+
+		resp, err := th.MakeGraphQLRequest(input)
+		require.NoError(t, err)
+		require.Empty(t, resp.Errors)
+
+		resp, err = th.MakeGraphQLRequest(input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		require.Equal(t, graphQLErrorCodeBudgetExceeded, resp.Errors[0].Extensions["code"])
+		require.Contains(t, resp.Errors[0].Extensions, "retry_after_seconds")
+	})
+}
@@ -55,6 +55,46 @@ func (api *API) APISessionRequired(h handlerFunc) http.Handler {
 
 }
 
+// APIHandlerIdempotent provides a handler for API endpoints which do not require the user to be logged in, and
+// whose requests made with an Idempotency-Key header are processed at most once.
+func (api *API) APIHandlerIdempotent(h handlerFunc) http.Handler {
+	handler := &web.Handler{
+		Srv:            api.srv,
+		HandleFunc:     h,
+		HandlerName:    web.GetHandlerName(h),
+		RequireSession: false,
+		TrustRequester: false,
+		RequireMfa:     false,
+		IsStatic:       false,
+		IsLocal:        false,
+		Idempotent:     true,
+	}
+	if *api.srv.Config().ServiceSettings.WebserverMode == "gzip" {
+		return gziphandler.GzipHandler(handler)
+	}
+	return handler
+}
+
+// APISessionRequiredIdempotent provides a handler for API endpoints which require the user to be logged in, and
+// whose requests made with an Idempotency-Key header are processed at most once.
+func (api *API) APISessionRequiredIdempotent(h handlerFunc) http.Handler {
+	handler := &web.Handler{
+		Srv:            api.srv,
+		HandleFunc:     h,
+		HandlerName:    web.GetHandlerName(h),
+		RequireSession: true,
+		TrustRequester: false,
+		RequireMfa:     true,
+		IsStatic:       false,
+		IsLocal:        false,
+		Idempotent:     true,
+	}
+	if *api.srv.Config().ServiceSettings.WebserverMode == "gzip" {
+		return gziphandler.GzipHandler(handler)
+	}
+	return handler
+}
+
 // CloudAPIKeyRequired provides a handler for webhook endpoints to access Cloud installations from CWS
 func (api *API) CloudAPIKeyRequired(h handlerFunc) http.Handler {
 	handler := &web.Handler{
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("retries of a team creation replay its response", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasicWithClient(client)
+		client.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: model.NewId()}
+
+		team := &model.Team{Name: GenerateTestUsername(), DisplayName: "Idempotent", Type: model.TeamOpen}
+		created, resp, err := client.CreateTeam(team)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Empty(t, resp.Header.Get(model.HeaderIdempotentReplayed))
+
+		replayed, resp, err := client.CreateTeam(team)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, "true", resp.Header.Get(model.HeaderIdempotentReplayed))
+		assert.Equal(t, created.Id, replayed.Id)
+
+		team.Name = GenerateTestUsername()
+		_, resp, err = client.CreateTeam(team)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		CheckErrorID(t, err, "api.context.idempotency_key.mismatch.app_error")
+	})
+
+	t.Run("keys are scoped to the user", func(t *testing.T) {
+		key := model.NewId()
+		team := &model.Team{Name: GenerateTestUsername(), DisplayName: "Idempotent", Type: model.TeamOpen}
+
+		client := th.CreateClient()
+		th.LoginBasicWithClient(client)
+		client.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: key}
+		created, _, err := client.CreateTeam(team)
+		require.NoError(t, err)
+
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+		client2.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: key}
+		team.Name = GenerateTestUsername()
+		created2, resp, err := client2.CreateTeam(team)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Header.Get(model.HeaderIdempotentReplayed))
+		assert.NotEqual(t, created.Id, created2.Id)
+	})
+
+	t.Run("failed requests can be retried", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasicWithClient(client)
+		client.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: model.NewId()}
+
+		team := &model.Team{Name: th.BasicTeam.Name, DisplayName: "Idempotent", Type: model.TeamOpen}
+		_, _, err := client.CreateTeam(team)
+		require.Error(t, err)
+
+		_, resp, err := client.CreateTeam(team)
+		require.Error(t, err)
+		assert.Empty(t, resp.Header.Get(model.HeaderIdempotentReplayed))
+	})
+
+	t.Run("user creations by an admin", func(t *testing.T) {
+		user := &model.User{Email: th.GenerateTestEmail(), Username: GenerateTestUsername(), Password: "Pa$$word11"}
+		th.SystemAdminClient.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: model.NewId()}
+		defer func() { th.SystemAdminClient.HTTPHeader = nil }()
+
+		created, resp, err := th.SystemAdminClient.CreateUser(user)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+
+		replayed, resp, err := th.SystemAdminClient.CreateUser(user)
+		require.NoError(t, err)
+		assert.Equal(t, "true", resp.Header.Get(model.HeaderIdempotentReplayed))
+		assert.Equal(t, created.Id, replayed.Id)
+	})
+
+	t.Run("keys are ignored without a session", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.EnableOpenServer = true })
+
+		user := &model.User{Email: th.GenerateTestEmail(), Username: GenerateTestUsername(), Password: "Pa$$word11"}
+		client := th.CreateClient()
+		client.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: model.NewId()}
+
+		_, resp, err := client.CreateUser(user)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+
+		// The retry is processed and fails as the user exists.
+		_, _, err = client.CreateUser(user)
+		require.Error(t, err)
+	})
+
+	t.Run("abandoned keys are taken over once their lease expires", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasicWithClient(client)
+		key := model.NewId()
+		client.HTTPHeader = map[string]string{model.HeaderIdempotencyKey: key}
+
+		team := &model.Team{Name: GenerateTestUsername(), DisplayName: "Idempotent", Type: model.TeamOpen}
+		body, err := json.Marshal(team)
+		require.NoError(t, err)
+		requestHash := model.HashIdempotentRequest(http.MethodPost, "/api/v4/teams", body)
+
+		_, err = th.App.Srv().Store.IdempotencyKey().Save(&model.IdempotencyKey{
+			Key:         key,
+			UserId:      th.BasicUser.Id,
+			RequestHash: requestHash,
+		})
+		require.NoError(t, err)
+
+		_, resp, err := client.CreateTeam(team)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		require.NoError(t, th.App.Srv().Store.IdempotencyKey().Delete(th.BasicUser.Id, key))
+		_, err = th.App.Srv().Store.IdempotencyKey().Save(&model.IdempotencyKey{
+			Key:         key,
+			UserId:      th.BasicUser.Id,
+			RequestHash: requestHash,
+			CreateAt:    model.GetMillis() - 10*60*1000,
+		})
+		require.NoError(t, err)
+
+		_, resp, err = client.CreateTeam(team)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+	})
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitImpersonation() {
+	api.BaseRoutes.User.Handle("/impersonations", api.APISessionRequired(createImpersonation)).Methods("POST")
+	api.BaseRoutes.User.Handle("/impersonations", api.APISessionRequired(getImpersonationsForUser)).Methods("GET")
+	api.BaseRoutes.Impersonation.Handle("", api.APISessionRequired(getImpersonation)).Methods("GET")
+	api.BaseRoutes.Impersonation.Handle("/consent", api.APISessionRequired(answerImpersonationConsent)).Methods("POST")
+	api.BaseRoutes.Impersonation.Handle("/start", api.APISessionRequired(startImpersonation)).Methods("POST")
+	api.BaseRoutes.Impersonation.Handle("/end", api.APISessionRequired(endImpersonation)).Methods("POST")
+}
+
+func createImpersonation(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	var impersonation model.Impersonation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&impersonation); jsonErr != nil {
+		c.SetInvalidParam("impersonation")
+		return
+	}
+	impersonation.ImpersonatorId = c.AppContext.Session().UserId
+	impersonation.UserId = c.Params.UserId
+
+	auditRec := c.MakeAuditRecord("createImpersonation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", impersonation.UserId)
+	auditRec.AddMeta("reason", impersonation.Reason)
+	auditRec.AddMeta("duration_minutes", impersonation.DurationMinutes)
+
+	created, err := c.App.CreateImpersonation(c.AppContext, &impersonation)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("impersonation_id", created.Id)
+	auditRec.AddMeta("status", created.Status)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// getImpersonationsForUser returns the impersonation history of a user, to the user themselves
+// or to a system admin.
+func getImpersonationsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	impersonations, err := c.App.GetImpersonationsForUser(c.Params.UserId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(impersonations); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// requireImpersonationAccess returns the impersonation of the request when the session is of
+// the impersonated user, of the admin impersonating them, or of a system admin.
+func requireImpersonationAccess(c *Context) *model.Impersonation {
+	c.RequireImpersonationId()
+	if c.Err != nil {
+		return nil
+	}
+
+	impersonation, appErr := c.App.GetImpersonation(c.Params.ImpersonationId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	userID := c.AppContext.Session().UserId
+	if userID != impersonation.UserId && userID != impersonation.ImpersonatorId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return nil
+	}
+
+	return impersonation
+}
+
+func getImpersonation(c *Context, w http.ResponseWriter, r *http.Request) {
+	impersonation := requireImpersonationAccess(c)
+	if c.Err != nil {
+		return
+	}
+
+	impersonation.Sanitize()
+	if err := json.NewEncoder(w).Encode(impersonation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func answerImpersonationConsent(c *Context, w http.ResponseWriter, r *http.Request) {
+	impersonation := requireImpersonationAccess(c)
+	if c.Err != nil {
+		return
+	}
+
+	// Only the user can consent, and not while being impersonated.
+	if impersonation.UserId != c.AppContext.Session().UserId || c.AppContext.Session().ImpersonatorId() != "" {
+		c.Err = model.NewAppError("answerImpersonationConsent", "api.impersonation.consent.not_user.app_error", nil, "", http.StatusForbidden)
+		return
+	}
+
+	var consent model.ImpersonationConsent
+	if jsonErr := json.NewDecoder(r.Body).Decode(&consent); jsonErr != nil {
+		c.SetInvalidParam("consent")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("answerImpersonationConsent", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("impersonation_id", impersonation.Id)
+	auditRec.AddMeta("approve", consent.Approve)
+
+	answered, err := c.App.AnswerImpersonationConsent(c.AppContext, impersonation.Id, consent.Approve)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(answered); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func startImpersonation(c *Context, w http.ResponseWriter, r *http.Request) {
+	impersonation := requireImpersonationAccess(c)
+	if c.Err != nil {
+		return
+	}
+
+	// Only the admin who asked can start the session.
+	if impersonation.ImpersonatorId != c.AppContext.Session().UserId || !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("startImpersonation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("impersonation_id", impersonation.Id)
+	auditRec.AddMeta("user_id", impersonation.UserId)
+
+	started, err := c.App.StartImpersonation(c.AppContext, impersonation.Id)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("session_id", started.SessionId)
+	auditRec.AddMeta("expires_at", started.ExpiresAt)
+
+	if err := json.NewEncoder(w).Encode(started); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// endImpersonation lets the user, the admin impersonating them or any system admin end the
+// impersonation early.
+func endImpersonation(c *Context, w http.ResponseWriter, r *http.Request) {
+	impersonation := requireImpersonationAccess(c)
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("endImpersonation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("impersonation_id", impersonation.Id)
+	auditRec.AddMeta("user_id", impersonation.UserId)
+
+	ended, err := c.App.EndImpersonation(impersonation.Id)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ended.Sanitize()
+	if err := json.NewEncoder(w).Encode(ended); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
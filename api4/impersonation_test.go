@@ -0,0 +1,248 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestImpersonation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newImpersonation := func() *model.Impersonation {
+		return &model.Impersonation{
+			Reason:          "Reproduce a reported issue.",
+			DurationMinutes: 15,
+		}
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, newImpersonation())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableImpersonation = true
+		*cfg.ServiceSettings.RequireImpersonationConsent = false
+		*cfg.ServiceSettings.MaxImpersonationDurationMinutes = 30
+	})
+
+	t.Run("only system admins can impersonate", func(t *testing.T) {
+		_, resp, err := th.Client.CreateImpersonation(th.BasicUser2.Id, newImpersonation())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, &model.Impersonation{DurationMinutes: 15})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, &model.Impersonation{Reason: "Too long", DurationMinutes: 31})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.CreateImpersonation(th.SystemAdminUser.Id, newImpersonation())
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("without consent the session starts right away", func(t *testing.T) {
+		impersonation, resp, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, newImpersonation())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, model.ImpersonationStatusActive, impersonation.Status)
+		assert.Equal(t, th.SystemAdminUser.Id, impersonation.ImpersonatorId)
+		require.NotEmpty(t, impersonation.Token)
+		assert.InDelta(t, impersonation.StartAt+15*60*1000, impersonation.ExpiresAt, 1)
+
+		impersonated := th.CreateClient()
+		impersonated.SetToken(impersonation.Token)
+		me, _, err := impersonated.GetMe("")
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, me.Id)
+
+		session, appErr := th.App.GetSessionById(impersonation.SessionId)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.SystemAdminUser.Id, session.ImpersonatorId())
+
+		history, _, err := th.Client.GetImpersonationsForUser(model.Me, 0, 60)
+		require.NoError(t, err)
+		require.NotEmpty(t, history)
+		assert.Equal(t, impersonation.Id, history[0].Id)
+		assert.Empty(t, history[0].Token)
+
+		ended, _, err := th.Client.EndImpersonation(impersonation.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.ImpersonationStatusEnded, ended.Status)
+
+		_, resp, err = impersonated.GetMe("")
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.RequireImpersonationConsent = true })
+
+	t.Run("with consent the user approves first", func(t *testing.T) {
+		impersonation, _, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, newImpersonation())
+		require.NoError(t, err)
+		assert.Equal(t, model.ImpersonationStatusPending, impersonation.Status)
+		assert.Empty(t, impersonation.Token)
+
+		_, resp, err := th.SystemAdminClient.StartImpersonation(impersonation.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.AnswerImpersonationConsent(impersonation.Id, true)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		approved, _, err := th.Client.AnswerImpersonationConsent(impersonation.Id, true)
+		require.NoError(t, err)
+		assert.Equal(t, model.ImpersonationStatusApproved, approved.Status)
+
+		_, resp, err = th.Client.StartImpersonation(impersonation.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		started, _, err := th.SystemAdminClient.StartImpersonation(impersonation.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.ImpersonationStatusActive, started.Status)
+		require.NotEmpty(t, started.Token)
+
+		t.Run("the impersonated session can't answer for the user", func(t *testing.T) {
+			other, _, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, newImpersonation())
+			require.NoError(t, err)
+
+			impersonated := th.CreateClient()
+			impersonated.SetToken(started.Token)
+			_, resp, err := impersonated.AnswerImpersonationConsent(other.Id, true)
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+
+			declined, _, err := th.Client.AnswerImpersonationConsent(other.Id, false)
+			require.NoError(t, err)
+			assert.Equal(t, model.ImpersonationStatusDeclined, declined.Status)
+		})
+
+		_, _, err = th.SystemAdminClient.EndImpersonation(impersonation.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("others can't see the impersonations of a user", func(t *testing.T) {
+		_, resp, err := th.Client.GetImpersonationsForUser(th.BasicUser2.Id, 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		history, _, err := th.SystemAdminClient.GetImpersonationsForUser(th.BasicUser.Id, 0, 60)
+		require.NoError(t, err)
+		require.NotEmpty(t, history)
+
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+		_, resp, err = client2.GetImpersonation(history[0].Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestImpersonationForbiddenHandlers(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableImpersonation = true
+		*cfg.ServiceSettings.RequireImpersonationConsent = false
+		*cfg.ServiceSettings.EnableUserAccessTokens = true
+	})
+	th.App.UpdateUserRoles(th.BasicUser.Id, model.SystemUserRoleId+" "+model.SystemUserAccessTokenRoleId, false)
+
+	impersonation, _, err := th.SystemAdminClient.CreateImpersonation(th.BasicUser.Id, &model.Impersonation{Reason: "Reproduce a reported issue.", DurationMinutes: 15})
+	require.NoError(t, err)
+
+	impersonated := th.CreateClient()
+	impersonated.SetToken(impersonation.Token)
+
+	token, _, err := th.Client.CreateUserAccessToken(th.BasicUser.Id, "test token")
+	require.NoError(t, err)
+	_, err = th.Client.DisableUserAccessToken(token.Id)
+	require.NoError(t, err)
+
+	checks := map[string]func() (*model.Response, error){
+		"create user access token": func() (*model.Response, error) {
+			_, resp, err := impersonated.CreateUserAccessToken(th.BasicUser.Id, "test token")
+			return resp, err
+		},
+		"enable user access token": func() (*model.Response, error) {
+			return impersonated.EnableUserAccessToken(token.Id)
+		},
+		"update password": func() (*model.Response, error) {
+			return impersonated.UpdateUserPassword(th.BasicUser.Id, th.BasicUser.Password, "new password")
+		},
+		"reset password": func() (*model.Response, error) {
+			return impersonated.ResetPassword(model.NewRandomString(model.TokenSize), "new password")
+		},
+		"update mfa": func() (*model.Response, error) {
+			return impersonated.UpdateUserMfa(th.BasicUser.Id, "", false)
+		},
+		"generate mfa secret": func() (*model.Response, error) {
+			_, resp, err := impersonated.GenerateMfaSecret(th.BasicUser.Id)
+			return resp, err
+		},
+		"patch email": func() (*model.Response, error) {
+			_, resp, err := impersonated.PatchUser(th.BasicUser.Id, &model.UserPatch{Email: model.NewString(th.GenerateTestEmail())})
+			return resp, err
+		},
+		"patch password": func() (*model.Response, error) {
+			_, resp, err := impersonated.PatchUser(th.BasicUser.Id, &model.UserPatch{Password: model.NewString("new password")})
+			return resp, err
+		},
+		"update email": func() (*model.Response, error) {
+			user := th.BasicUser.DeepCopy()
+			user.Email = th.GenerateTestEmail()
+			_, resp, err := impersonated.UpdateUser(user)
+			return resp, err
+		},
+		"update auth": func() (*model.Response, error) {
+			_, resp, err := impersonated.UpdateUserAuth(th.BasicUser.Id, &model.UserAuth{AuthService: model.UserAuthServiceEmail})
+			return resp, err
+		},
+		"revoke session": func() (*model.Response, error) {
+			return impersonated.RevokeSession(th.BasicUser.Id, th.Client.AuthToken)
+		},
+		"revoke all sessions": func() (*model.Response, error) {
+			return impersonated.RevokeAllSessions(th.BasicUser.Id)
+		},
+		"attach device id": func() (*model.Response, error) {
+			return impersonated.AttachDeviceId("android:" + model.NewId())
+		},
+	}
+
+	for name, check := range checks {
+		t.Run(name, func(t *testing.T) {
+			resp, err := check()
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+			CheckErrorID(t, err, "api.context.impersonation_forbidden.app_error")
+		})
+	}
+
+	t.Run("other fields can still be patched", func(t *testing.T) {
+		ruser, _, err := impersonated.PatchUser(th.BasicUser.Id, &model.UserPatch{Nickname: model.NewString("nickname")})
+		require.NoError(t, err)
+		assert.Equal(t, "nickname", ruser.Nickname)
+	})
+
+	t.Run("the user can still manage their credentials", func(t *testing.T) {
+		_, _, err := th.Client.CreateUserAccessToken(th.BasicUser.Id, "test token")
+		require.NoError(t, err)
+	})
+}
@@ -5,13 +5,18 @@ package api4
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 
+	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func (api *API) InitImport() {
 	api.BaseRoutes.Imports.Handle("", api.APISessionRequired(listImports)).Methods("GET")
+	api.BaseRoutes.Imports.Handle("/slack", api.APISessionRequired(createSlackImport)).Methods("POST")
+	api.BaseRoutes.Imports.Handle("/slack/{job_id:[A-Za-z0-9]+}/resume", api.APISessionRequired(resumeSlackImport)).Methods("POST")
+	api.BaseRoutes.Imports.Handle("/slack/{job_id:[A-Za-z0-9]+}/report", api.APISessionRequired(getSlackImportReport)).Methods("GET")
 }
 
 func listImports(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -34,3 +39,147 @@ func listImports(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write(data)
 }
+
+func createSlackImport(c *Context, w http.ResponseWriter, r *http.Request) {
+	if c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud {
+		c.Err = model.NewAppError("createSlackImport", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if err := r.ParseMultipartForm(MaximumBulkImportSize); err != nil {
+		c.Err = model.NewAppError("createSlackImport", "api.import.create_slack_import.parse.app_error", nil, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.Params.TeamId = r.FormValue("team_id")
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionImportTeam) {
+		c.SetPermissionError(model.PermissionImportTeam)
+		return
+	}
+
+	token := r.FormValue("token")
+	fileInfoArray := r.MultipartForm.File["file"]
+	if (len(fileInfoArray) == 0) == (token == "") {
+		c.Err = model.NewAppError("createSlackImport", "api.import.create_slack_import.source.app_error", nil, "", http.StatusBadRequest)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createSlackImport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	var export io.Reader
+	if len(fileInfoArray) > 0 {
+		fileInfo := fileInfoArray[0]
+		file, err := fileInfo.Open()
+		if err != nil {
+			c.Err = model.NewAppError("createSlackImport", "api.import.create_slack_import.open.app_error", nil, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		export = file
+		auditRec.AddMeta("filename", fileInfo.Filename)
+		auditRec.AddMeta("filesize", fileInfo.Size)
+		auditRec.AddMeta("source", model.SlackImportSourceZip)
+	} else {
+		auditRec.AddMeta("source", model.SlackImportSourceAPI)
+	}
+
+	job, appErr := c.App.CreateSlackImportJob(c.Params.TeamId, export, token)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(job)
+	if err != nil {
+		c.Err = model.NewAppError("createSlackImport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("job_id", job.Id)
+	w.WriteHeader(http.StatusCreated)
+	w.Write(js)
+}
+
+// requireSlackImportPermission checks the user can import to the team of the Slack import.
+func requireSlackImportPermission(c *Context) *model.Job {
+	c.RequireJobId()
+	if c.Err != nil {
+		return nil
+	}
+
+	job, appErr := c.App.GetSlackImportJob(c.Params.JobId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), job.Data["team_id"], model.PermissionImportTeam) {
+		c.SetPermissionError(model.PermissionImportTeam)
+		return nil
+	}
+
+	return job
+}
+
+func resumeSlackImport(c *Context, w http.ResponseWriter, r *http.Request) {
+	if c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud {
+		c.Err = model.NewAppError("resumeSlackImport", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	previous := requireSlackImportPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("resumeSlackImport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", previous.Data["team_id"])
+	auditRec.AddMeta("resumed_job_id", previous.Id)
+
+	job, appErr := c.App.ResumeSlackImportJob(previous.Id)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(job)
+	if err != nil {
+		c.Err = model.NewAppError("resumeSlackImport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("job_id", job.Id)
+	w.WriteHeader(http.StatusCreated)
+	w.Write(js)
+}
+
+func getSlackImportReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	requireSlackImportPermission(c)
+	if c.Err != nil {
+		return
+	}
+
+	report, appErr := c.App.GetSlackImportReport(c.Params.JobId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(report)
+	if err != nil {
+		c.Err = model.NewAppError("getSlackImportReport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
@@ -4,6 +4,10 @@
 package api4
 
 import (
+	"archive/zip"
+	"bytes"
+	"mime/multipart"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
@@ -104,3 +108,84 @@ func TestListImports(t *testing.T) {
 		require.NoError(t, os.RemoveAll(importDir))
 	}, "change import directory")
 }
+
+func TestCreateSlackImport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	w, err := zipWriter.Create("users.json")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`[{"id": "U1", "name": "alice", "profile": {"email": "alice@example.com"}}]`))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+	export := buf.Bytes()
+
+	t.Run("no permissions", func(t *testing.T) {
+		job, resp, err := th.Client.CreateSlackImport(th.BasicTeam.Id, export, "export.zip")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		require.Nil(t, job)
+	})
+
+	t.Run("neither export nor token", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.CreateSlackImportFromToken(th.BasicTeam.Id, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		require.Nil(t, job)
+	})
+
+	t.Run("both export and token", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("team_id", th.BasicTeam.Id))
+		require.NoError(t, writer.WriteField("token", "xoxb-token"))
+		part, err := writer.CreateFormFile("file", "export.zip")
+		require.NoError(t, err)
+		_, err = part.Write(export)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		r, err := th.SystemAdminClient.DoAPIRequestReader(http.MethodPost, th.SystemAdminClient.APIURL+"/imports/slack", body, map[string]string{"Content-Type": writer.FormDataContentType()})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, model.BuildResponse(r))
+	})
+
+	t.Run("invalid team", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.CreateSlackImport("junk", export, "export.zip")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		require.Nil(t, job)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.CreateSlackImport(th.BasicTeam.Id, export, "export.zip")
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		require.Equal(t, model.JobTypeSlackImport, job.Type)
+		require.Equal(t, th.BasicTeam.Id, job.Data["team_id"])
+		require.Equal(t, model.SlackImportSourceZip, job.Data["source"])
+
+		t.Run("report without permissions", func(t *testing.T) {
+			_, resp, err := th.Client.GetSlackImportReport(job.Id)
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+		})
+
+		t.Run("resume without permissions", func(t *testing.T) {
+			_, resp, err := th.Client.ResumeSlackImport(job.Id)
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+		})
+	})
+
+	t.Run("not a Slack import", func(t *testing.T) {
+		job, appErr := th.App.Srv().Jobs.CreateJob(model.JobTypeUserMerge, map[string]string{})
+		require.Nil(t, appErr)
+
+		_, resp, err := th.SystemAdminClient.GetSlackImportReport(job.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -15,6 +15,7 @@ func (api *API) InitAction() {
 
 	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/open", api.APIHandler(openDialog)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/submit", api.APISessionRequired(submitDialog)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/validate", api.APISessionRequired(validateDialogStep)).Methods("POST")
 }
 
 func doPostAction(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -85,33 +86,61 @@ func openDialog(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
-func submitDialog(c *Context, w http.ResponseWriter, r *http.Request) {
+// readDialogRequest decodes the request about a dialog sent by the session user, checking that
+// they can read the channel and team of the dialog.
+func readDialogRequest(c *Context, r *http.Request) *model.SubmitDialogRequest {
 	var submit model.SubmitDialogRequest
 
 	jsonErr := json.NewDecoder(r.Body).Decode(&submit)
 	if jsonErr != nil {
 		c.SetInvalidParam("dialog")
-		return
+		return nil
 	}
 
 	if submit.URL == "" {
 		c.SetInvalidParam("url")
-		return
+		return nil
 	}
 
 	submit.UserId = c.AppContext.Session().UserId
 
 	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), submit.ChannelId, model.PermissionReadChannel) {
 		c.SetPermissionError(model.PermissionReadChannel)
-		return
+		return nil
 	}
 
 	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), submit.TeamId, model.PermissionViewTeam) {
 		c.SetPermissionError(model.PermissionViewTeam)
+		return nil
+	}
+
+	return &submit
+}
+
+func submitDialog(c *Context, w http.ResponseWriter, r *http.Request) {
+	submit := readDialogRequest(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	resp, err := c.App.SubmitInteractiveDialog(c.AppContext, *submit)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	b, _ := json.Marshal(resp)
+
+	w.Write(b)
+}
+
+func validateDialogStep(c *Context, w http.ResponseWriter, r *http.Request) {
+	submit := readDialogRequest(c, r)
+	if c.Err != nil {
 		return
 	}
 
-	resp, err := c.App.SubmitInteractiveDialog(c.AppContext, submit)
+	resp, err := c.App.ValidateInteractiveDialogStep(c.AppContext, *submit)
 	if err != nil {
 		c.Err = err
 		return
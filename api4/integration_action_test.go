@@ -209,6 +209,24 @@ func TestOpenDialog(t *testing.T) {
 	request.Dialog.Elements = []model.DialogElement{}
 	_, err = client.OpenInteractiveDialog(request)
 	require.NoError(t, err)
+
+	// Should pass with steps
+	request.Dialog.Elements = nil
+	request.Dialog.Steps = []model.DialogStep{
+		{Title: "Kind", Elements: []model.DialogElement{{Name: "kind", Type: model.DialogElementTypeRadio}}},
+		{Title: "Details", Elements: []model.DialogElement{
+			{Name: "due", Type: model.DialogElementTypeDate, Default: "2022-05-01"},
+			{Name: "assignee", Type: model.DialogElementTypeUser, ShowIf: &model.DialogCondition{Element: "kind", Values: []string{"task"}}},
+		}},
+	}
+	_, err = client.OpenInteractiveDialog(request)
+	require.NoError(t, err)
+
+	// Should fail on a condition referring to an unknown element
+	request.Dialog.Steps[1].Elements[1].ShowIf.Element = "unknown"
+	resp, err = client.OpenInteractiveDialog(request)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
 }
 
 func TestSubmitDialog(t *testing.T) {
@@ -273,3 +291,51 @@ func TestSubmitDialog(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 	assert.Nil(t, submitResp)
 }
+
+func TestValidateDialogStep(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	submit := model.SubmitDialogRequest{
+		CallbackId: "callbackid",
+		ChannelId:  th.BasicChannel.Id,
+		TeamId:     th.BasicTeam.Id,
+		Submission: map[string]interface{}{"kind": "task"},
+		Step:       1,
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.SubmitDialogRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		assert.Equal(t, model.SubmitDialogRequestTypeValidation, request.Type)
+		assert.Equal(t, 1, request.Step)
+		assert.Equal(t, th.BasicUser.Id, request.UserId)
+
+		json.NewEncoder(w).Encode(&model.SubmitDialogResponse{Errors: map[string]string{"kind": "Unknown kind."}})
+	}))
+	defer ts.Close()
+
+	submit.URL = ts.URL
+
+	validateResp, _, err := client.ValidateInteractiveDialogStep(submit)
+	require.NoError(t, err)
+	assert.Equal(t, "Unknown kind.", validateResp.Errors["kind"])
+
+	submit.Step = model.DialogMaxSteps
+	_, resp, err := client.ValidateInteractiveDialogStep(submit)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	submit.Step = 1
+	submit.ChannelId = model.NewId()
+	_, resp, err = client.ValidateInteractiveDialogStep(submit)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+}
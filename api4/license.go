@@ -10,6 +10,8 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/utils"
@@ -25,6 +27,7 @@ func (api *API) InitLicense() {
 	api.BaseRoutes.APIRoot.Handle("/license", api.APISessionRequired(removeLicense)).Methods("DELETE")
 	api.BaseRoutes.APIRoot.Handle("/license/renewal", api.APISessionRequired(requestRenewalLink)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/license/client", api.APIHandler(getClientLicense)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/license/usage", api.APISessionRequired(getLicenseUsage)).Methods("GET")
 }
 
 func getClientLicense(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -281,3 +284,45 @@ func getPrevTrialLicense(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write([]byte(model.MapToJSON(clientLicense)))
 }
+
+func getLicenseUsage(c *Context, w http.ResponseWriter, r *http.Request) {
+	months := model.LicenseUsageDefaultMonths
+	if value := r.URL.Query().Get("months"); value != "" {
+		var err error
+		if months, err = strconv.Atoi(value); err != nil || months <= 0 || months > model.LicenseUsageMaxMonths {
+			c.SetInvalidURLParam("months")
+			return
+		}
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "csv" {
+		c.SetInvalidURLParam("format")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadLicenseInformation) {
+		c.SetPermissionError(model.PermissionReadLicenseInformation)
+		return
+	}
+
+	usage, appErr := c.App.GetLicenseUsage(months)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err := usage.WriteCSV(&buf); err != nil {
+			c.Err = model.NewAppError("getLicenseUsage", "api.license.usage.csv.app_error", nil, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeFileResponse("license_usage.csv", "text/csv", int64(buf.Len()), time.Now(), *c.App.Config().ServiceSettings.WebserverMode, bytes.NewReader(buf.Bytes()), true, w, r)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(usage); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -262,3 +263,48 @@ func TestRequestTrialLicense(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestGetLicenseUsage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	require.Nil(t, th.App.RollupLicenseUsage())
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetLicenseUsage(0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetLicenseUsageCSV(0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetLicenseUsage(model.LicenseUsageMaxMonths + 1)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		r, err := th.SystemAdminClient.DoAPIGet("/license/usage?format=xml", "")
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, r.StatusCode)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		usage, _, err := th.SystemAdminClient.GetLicenseUsage(0)
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		require.Equal(t, model.LicenseUsageMonth(time.Now().AddDate(0, 0, -1)), usage[0].Month)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		data, resp, err := th.SystemAdminClient.GetLicenseUsageCSV(0)
+		require.NoError(t, err)
+		require.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		require.Len(t, lines, 2)
+		require.True(t, strings.HasPrefix(lines[0], "month,monthly_active_users,"))
+		require.True(t, strings.HasPrefix(lines[1], time.Now().AddDate(0, 0, -1).UTC().Format("2006-01")+","))
+	})
+}
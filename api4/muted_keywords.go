@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitMutedKeywords() {
+	api.BaseRoutes.User.Handle("/muted_keywords", api.APISessionRequired(getMutedKeywords)).Methods("GET")
+	api.BaseRoutes.User.Handle("/muted_keywords", api.APISessionRequired(updateMutedKeywords)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/muted_keywords", api.APISessionRequired(addMutedKeywords)).Methods("POST")
+	api.BaseRoutes.User.Handle("/muted_keywords", api.APISessionRequired(deleteMutedKeywords)).Methods("DELETE")
+}
+
+func getMutedKeywords(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	keywords, err := c.App.GetMutedKeywords(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(keywords); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateMutedKeywords(c *Context, w http.ResponseWriter, r *http.Request) {
+	saveMutedKeywords(c, w, r, "updateMutedKeywords", c.App.UpdateMutedKeywords)
+}
+
+func addMutedKeywords(c *Context, w http.ResponseWriter, r *http.Request) {
+	saveMutedKeywords(c, w, r, "addMutedKeywords", c.App.AddMutedKeywords)
+}
+
+func saveMutedKeywords(c *Context, w http.ResponseWriter, r *http.Request, event string, save func(string, model.MutedKeywords) (model.MutedKeywords, *model.AppError)) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var keywords model.MutedKeywords
+	if jsonErr := json.NewDecoder(r.Body).Decode(&keywords); jsonErr != nil {
+		c.SetInvalidParam("muted_keywords")
+		return
+	}
+
+	saved, err := save(c.Params.UserId, keywords)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteMutedKeywords(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteMutedKeywords", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if err := c.App.DeleteMutedKeywords(c.Params.UserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestMutedKeywords(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("update, add, get and delete", func(t *testing.T) {
+		keywords, _, err := th.Client.UpdateMutedKeywords(th.BasicUser.Id, model.MutedKeywords{"Spoiler"})
+		require.NoError(t, err)
+		assert.Equal(t, model.MutedKeywords{"spoiler"}, keywords)
+
+		keywords, _, err = th.Client.AddMutedKeywords(th.BasicUser.Id, model.MutedKeywords{"release party"})
+		require.NoError(t, err)
+		assert.Equal(t, model.MutedKeywords{"spoiler", "release party"}, keywords)
+
+		keywords, _, err = th.Client.GetMutedKeywords(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.MutedKeywords{"spoiler", "release party"}, keywords)
+
+		_, err = th.Client.DeleteMutedKeywords(th.BasicUser.Id)
+		require.NoError(t, err)
+
+		keywords, _, err = th.Client.GetMutedKeywords(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Empty(t, keywords)
+	})
+
+	t.Run("invalid keywords", func(t *testing.T) {
+		tooMany := make(model.MutedKeywords, model.MutedKeywordsMaxCount+1)
+		for i := range tooMany {
+			tooMany[i] = model.NewId()
+		}
+		_, resp, err := th.Client.UpdateMutedKeywords(th.BasicUser.Id, tooMany)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := th.Client.GetMutedKeywords(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateMutedKeywords(th.BasicUser2.Id, model.MutedKeywords{"spoiler"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("posts are flagged for the user", func(t *testing.T) {
+		_, _, err := th.Client.UpdateMutedKeywords(th.BasicUser.Id, model.MutedKeywords{"spoiler"})
+		require.NoError(t, err)
+
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+		post, _, err := client2.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "spoiler alert"})
+		require.NoError(t, err)
+		assert.True(t, post.Metadata == nil || post.Metadata.MutedKeywords == nil)
+
+		post, _, err = th.Client.GetPost(post.Id, "")
+		require.NoError(t, err)
+		require.NotNil(t, post.Metadata)
+		assert.Equal(t, []string{"spoiler"}, post.Metadata.MutedKeywords)
+
+		list, _, err := th.Client.GetPostsForChannel(th.BasicChannel.Id, 0, 10, "", false)
+		require.NoError(t, err)
+		require.Contains(t, list.Posts, post.Id)
+		assert.Equal(t, []string{"spoiler"}, list.Posts[post.Id].Metadata.MutedKeywords)
+	})
+}
@@ -41,6 +41,8 @@ func createOAuthApp(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		oauthApp.IsTrusted = false
+		oauthApp.RateLimitPerSec = 0
+		oauthApp.RateLimitMaxBurst = 0
 	}
 
 	oauthApp.CreatorId = c.AppContext.Session().UserId
@@ -103,6 +105,8 @@ func updateOAuthApp(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		oauthApp.IsTrusted = oldOAuthApp.IsTrusted
+		oauthApp.RateLimitPerSec = oldOAuthApp.RateLimitPerSec
+		oauthApp.RateLimitMaxBurst = oldOAuthApp.RateLimitMaxBurst
 	}
 
 	updatedOAuthApp, err := c.App.UpdateOAuthApp(oldOAuthApp, &oauthApp)
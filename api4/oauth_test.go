@@ -31,13 +31,15 @@ func TestCreateOAuthApp(t *testing.T) {
 
 	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = true })
 
-	oapp := &model.OAuthApp{Name: GenerateTestAppName(), Homepage: "https://nowhere.com", Description: "test", CallbackUrls: []string{"https://nowhere.com"}, IsTrusted: true}
+	oapp := &model.OAuthApp{Name: GenerateTestAppName(), Homepage: "https://nowhere.com", Description: "test", CallbackUrls: []string{"https://nowhere.com"}, IsTrusted: true, RateLimitPerSec: 10, RateLimitMaxBurst: 20}
 
 	rapp, resp, err := adminClient.CreateOAuthApp(oapp)
 	require.NoError(t, err)
 	CheckCreatedStatus(t, resp)
 	assert.Equal(t, oapp.Name, rapp.Name, "names did not match")
 	assert.Equal(t, oapp.IsTrusted, rapp.IsTrusted, "trusted did no match")
+	assert.Equal(t, oapp.RateLimitPerSec, rapp.RateLimitPerSec, "rate limit did not match")
+	assert.Equal(t, oapp.RateLimitMaxBurst, rapp.RateLimitMaxBurst, "max burst did not match")
 
 	// Revoke permission from regular users.
 	th.RemovePermissionFromRole(model.PermissionManageOAuth.Id, model.SystemUserRoleId)
@@ -53,6 +55,7 @@ func TestCreateOAuthApp(t *testing.T) {
 	CheckCreatedStatus(t, resp)
 
 	assert.False(t, rapp.IsTrusted, "trusted should be false - created by non admin")
+	assert.Zero(t, rapp.RateLimitPerSec, "rate limit should be unset - created by non admin")
 
 	oapp.Name = ""
 	_, resp, err = adminClient.CreateOAuthApp(oapp)
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetOutstandingMentions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.GetOutstandingMentions(model.Me, "", 0, 60)
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, 501)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutstandingMentions = true })
+
+	post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "@" + th.BasicUser2.Username + " ping"}
+	post, _, err := th.Client.CreatePost(post)
+	require.NoError(t, err)
+
+	th.LoginBasic2()
+
+	t.Run("own mentions", func(t *testing.T) {
+		list, _, err := th.Client.GetOutstandingMentions(model.Me, "", 0, 60)
+		require.NoError(t, err)
+		require.Len(t, list.Mentions, 1)
+		assert.Equal(t, post.Id, list.Mentions[0].PostId)
+		assert.Equal(t, int64(1), list.Stats.OutstandingCount)
+
+		list, _, err = th.Client.GetOutstandingMentions(model.Me, th.BasicTeam.Id, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, list.Mentions, 1)
+	})
+
+	t.Run("invalid team", func(t *testing.T) {
+		_, resp, err := th.Client.GetOutstandingMentions(model.Me, "junk", 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.GetOutstandingMentions(model.Me, model.NewId(), 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("other users", func(t *testing.T) {
+		_, resp, err := th.Client.GetOutstandingMentions(th.BasicUser.Id, "", 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		list, _, err := th.SystemAdminClient.GetOutstandingMentions(th.BasicUser2.Id, "", 0, 60)
+		require.NoError(t, err)
+		require.Len(t, list.Mentions, 1)
+	})
+}
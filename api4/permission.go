@@ -6,6 +6,7 @@ package api4
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -13,6 +14,7 @@ import (
 
 func (api *API) InitPermissions() {
 	api.BaseRoutes.Permissions.Handle("/ancillary", api.APISessionRequired(appendAncillaryPermissions)).Methods("GET")
+	api.BaseRoutes.Permissions.Handle("/denials", api.APISessionRequired(searchPermissionDenials)).Methods("GET")
 }
 
 func appendAncillaryPermissions(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -31,3 +33,46 @@ func appendAncillaryPermissions(c *Context, w http.ResponseWriter, r *http.Reque
 	}
 	w.Write(b)
 }
+
+func searchPermissionDenials(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := &model.PermissionDenialSearch{
+		UserId:     query.Get("user_id"),
+		ResourceId: query.Get("resource_id"),
+	}
+	if opts.UserId != "" && !model.IsValidId(opts.UserId) {
+		c.SetInvalidURLParam("user_id")
+		return
+	}
+	if opts.ResourceId != "" && !model.IsValidId(opts.ResourceId) {
+		c.SetInvalidURLParam("resource_id")
+		return
+	}
+	if sinceString := query.Get("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil || since < 0 {
+			c.SetInvalidURLParam("since")
+			return
+		}
+		opts.Since = since
+	}
+
+	denials, appErr := c.App.SearchPermissionDenials(opts, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(denials)
+	if err != nil {
+		c.Err = model.NewAppError("searchPermissionDenials", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSearchPermissionDenials(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	th.LinkUserToTeam(th.BasicUser2, team)
+
+	client := th.CreateClient()
+	th.LoginBasic2WithClient(client)
+
+	t.Run("not recorded by default", func(t *testing.T) {
+		_, resp, err := client.GetChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		denials, _, err := th.SystemAdminClient.SearchPermissionDenials(&model.PermissionDenialSearch{UserId: th.BasicUser2.Id}, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, denials)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePermissionDenialLog = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePermissionDenialLog = false })
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := client.SearchPermissionDenials(&model.PermissionDenialSearch{}, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		_, resp, err := client.GetChannelArchivePolicy(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		denials, _, err := th.SystemAdminClient.SearchPermissionDenials(&model.PermissionDenialSearch{UserId: th.BasicUser2.Id}, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, denials, 2)
+
+		assert.Equal(t, model.PermissionManageTeam.Id, denials[0].Permissions)
+		assert.Equal(t, model.PermissionDenialResourceTeam, denials[0].ResourceType)
+		assert.Equal(t, team.Id, denials[0].ResourceId)
+		assert.Equal(t, "/api/v4/teams/"+team.Id+"/channel_archive_policy", denials[0].Path)
+
+		assert.Equal(t, model.PermissionManageSystem.Id, denials[1].Permissions)
+		assert.Equal(t, model.PermissionDenialResourceSystem, denials[1].ResourceType)
+		assert.Empty(t, denials[1].ResourceId)
+
+		denials, _, err = th.SystemAdminClient.SearchPermissionDenials(&model.PermissionDenialSearch{ResourceId: team.Id}, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, denials, 1)
+
+		_, resp, err = th.SystemAdminClient.SearchPermissionDenials(&model.PermissionDenialSearch{UserId: "junk"}, 0, 10)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
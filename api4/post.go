@@ -12,6 +12,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/app"
 	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 )
 
@@ -19,17 +20,22 @@ func (api *API) InitPost() {
 	api.BaseRoutes.Posts.Handle("", api.APISessionRequired(createPost)).Methods("POST")
 	api.BaseRoutes.Post.Handle("", api.APISessionRequired(getPost)).Methods("GET")
 	api.BaseRoutes.Post.Handle("", api.APISessionRequired(deletePost)).Methods("DELETE")
+	api.BaseRoutes.Post.Handle("/restore", api.APISessionRequired(restorePost)).Methods("POST")
 	api.BaseRoutes.Posts.Handle("/ids", api.APISessionRequired(getPostsByIds)).Methods("POST")
+	api.BaseRoutes.Posts.Handle("/system_messages", api.APISessionRequired(renderSystemPostMessages)).Methods("POST")
 	api.BaseRoutes.Posts.Handle("/ephemeral", api.APISessionRequired(createEphemeralPost)).Methods("POST")
 	api.BaseRoutes.Post.Handle("/thread", api.APISessionRequired(getPostThread)).Methods("GET")
+	api.BaseRoutes.Thread.Handle("/summary", api.APISessionRequired(getThreadSummary)).Methods("GET")
 	api.BaseRoutes.Post.Handle("/files/info", api.APISessionRequired(getFileInfosForPost)).Methods("GET")
 	api.BaseRoutes.PostsForChannel.Handle("", api.APISessionRequired(getPostsForChannel)).Methods("GET")
+	api.BaseRoutes.PostsForChannel.Handle("/deleted", api.APISessionRequired(getDeletedPostsForChannel)).Methods("GET")
 	api.BaseRoutes.PostsForUser.Handle("/flagged", api.APISessionRequired(getFlaggedPostsForUser)).Methods("GET")
 
 	api.BaseRoutes.ChannelForUser.Handle("/posts/unread", api.APISessionRequired(getPostsForChannelAroundLastUnread)).Methods("GET")
 
 	api.BaseRoutes.Team.Handle("/posts/search", api.APISessionRequiredDisableWhenBusy(searchPostsInTeam)).Methods("POST")
 	api.BaseRoutes.Posts.Handle("/search", api.APISessionRequiredDisableWhenBusy(searchPostsInAllTeams)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/search/capabilities", api.APISessionRequired(getSearchCapabilities)).Methods("GET")
 	api.BaseRoutes.Post.Handle("", api.APISessionRequired(updatePost)).Methods("PUT")
 	api.BaseRoutes.Post.Handle("/patch", api.APISessionRequired(patchPost)).Methods("PUT")
 	api.BaseRoutes.PostForUser.Handle("/set_unread", api.APISessionRequired(setPostUnread)).Methods("POST")
@@ -428,20 +434,101 @@ func getPostsByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var posts = []*model.Post{}
-	channelMap := make(map[string]*model.Channel)
+	posts, err := filterReadablePosts(c, postsList)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	posts = c.App.PreparePostsForClient(posts)
+	posts, err = c.App.SanitizePostsMetadataForUser(posts, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	for _, post := range posts {
+		post.StripActionIntegrations()
+	}
+
+	if err := json.NewEncoder(w).Encode(posts); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func renderSystemPostMessages(c *Context, w http.ResponseWriter, r *http.Request) {
+	var request model.SystemPostMessagesRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&request); jsonErr != nil {
+		c.SetInvalidParam("system_post_messages")
+		return
+	}
+
+	if len(request.PostIds) == 0 {
+		c.SetInvalidParam("post_ids")
+		return
+	}
+
+	if len(request.PostIds) > 1000 {
+		c.Err = model.NewAppError("renderSystemPostMessages", "api.post.posts_by_ids.invalid_body.request_error", map[string]interface{}{"MaxLength": 1000}, "", http.StatusBadRequest)
+		return
+	}
+
+	if request.Locale == "" {
+		user, err := c.App.GetUser(c.AppContext.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		request.Locale = user.Locale
+	} else if _, ok := i18n.GetSupportedLocales()[request.Locale]; !ok {
+		c.SetInvalidParam("locale")
+		return
+	}
+
+	postsList, err := c.App.GetPostsByIds(request.PostIds)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	posts, err := filterReadablePosts(c, postsList)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	messages := c.App.RenderSystemPostMessages(posts, request.Locale)
+	if err := json.NewEncoder(w).Encode(messages); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
 
+// filterReadablePosts returns the posts of the channels the session can read, the channels being
+// fetched at once.
+func filterReadablePosts(c *Context, postsList []*model.Post) ([]*model.Post, *model.AppError) {
+	channelIDs := make([]string, 0, len(postsList))
+	seenChannelIDs := make(map[string]bool, len(postsList))
 	for _, post := range postsList {
-		var channel *model.Channel
-		if val, ok := channelMap[post.ChannelId]; ok {
-			channel = val
-		} else {
-			channel, err = c.App.GetChannel(post.ChannelId)
-			if err != nil {
-				c.Err = err
-				return
-			}
-			channelMap[channel.Id] = channel
+		if !seenChannelIDs[post.ChannelId] {
+			seenChannelIDs[post.ChannelId] = true
+			channelIDs = append(channelIDs, post.ChannelId)
+		}
+	}
+
+	channels, err := c.App.GetChannelsByIds(channelIDs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	channelMap := make(map[string]*model.Channel, len(channels))
+	for _, channel := range channels {
+		channelMap[channel.Id] = channel
+	}
+
+	var posts = []*model.Post{}
+	for _, post := range postsList {
+		channel, ok := channelMap[post.ChannelId]
+		if !ok {
+			continue
 		}
 
 		if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), channel.Id, model.PermissionReadChannel) {
@@ -450,14 +537,10 @@ func getPostsByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
-		post = c.App.PreparePostForClient(post, false, false)
-		post.StripActionIntegrations()
 		posts = append(posts, post)
 	}
 
-	if err := json.NewEncoder(w).Encode(posts); err != nil {
-		mlog.Warn("Error while writing response", mlog.Err(err))
-	}
+	return posts, nil
 }
 
 func deletePost(c *Context, w http.ResponseWriter, _ *http.Request) {
@@ -498,6 +581,84 @@ func deletePost(c *Context, w http.ResponseWriter, _ *http.Request) {
 	ReturnStatusOK(w)
 }
 
+// getDeletedPostsForChannel lists the posts of a channel that can still be restored.
+func getDeletedPostsForChannel(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("getDeletedPostsForChannel", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionRestorePosts) {
+		c.SetPermissionError(model.PermissionRestorePosts)
+		return
+	}
+
+	postList, err := c.App.GetDeletedPostsForChannel(c.Params.ChannelId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("post_count", len(postList.Order))
+
+	clientPostList := c.App.PreparePostListForClient(postList)
+	clientPostList, err = c.App.SanitizePostListMetadataForUser(clientPostList, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if err := clientPostList.EncodeJSON(w); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func restorePost(c *Context, w http.ResponseWriter, _ *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("restorePost", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	post, err := c.App.GetSinglePostIncludingDeleted(c.Params.PostId)
+	if err != nil {
+		c.SetPermissionError(model.PermissionRestorePosts)
+		return
+	}
+	auditRec.AddMeta("channel_id", post.ChannelId)
+	auditRec.AddMeta("post", post)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), post.ChannelId, model.PermissionRestorePosts) {
+		c.SetPermissionError(model.PermissionRestorePosts)
+		return
+	}
+
+	restored, err := c.App.RestorePost(c.Params.PostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	restored = c.App.PreparePostForClientWithEmbedsAndImages(restored, false, false)
+	restored, err = c.App.SanitizePostMetadataForUser(restored, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if err := restored.EncodeJSON(w); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequirePostId()
 	if c.Err != nil {
@@ -541,6 +702,60 @@ func getPostThread(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getThreadSummary(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireThreadId()
+	if c.Err != nil {
+		return
+	}
+
+	opts := model.GetThreadSummaryOpts{
+		Replies: model.ThreadSummaryDefaultReplies,
+		Bucket:  model.ThreadSummaryBucketDay,
+	}
+	if value := r.URL.Query().Get("replies"); value != "" {
+		replies, err := strconv.Atoi(value)
+		if err != nil || replies < 0 || replies > model.ThreadSummaryMaxReplies {
+			c.SetInvalidURLParam("replies")
+			return
+		}
+		opts.Replies = replies
+	}
+	if value := r.URL.Query().Get("bucket"); value != "" {
+		if value != model.ThreadSummaryBucketHour && value != model.ThreadSummaryBucketDay {
+			c.SetInvalidURLParam("bucket")
+			return
+		}
+		opts.Bucket = value
+	}
+
+	post, err := c.App.GetPostIfAuthorized(c.Params.ThreadId, c.AppContext.Session())
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if post.RootId != "" {
+		c.SetInvalidURLParam("thread_id")
+		return
+	}
+
+	summary, err := c.App.GetThreadSummary(post.Id, opts, c.IsSystemAdmin())
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	summary.LastReplies = c.App.PreparePostListForClient(summary.LastReplies)
+	summary.LastReplies, err = c.App.SanitizePostListMetadataForUser(summary.LastReplies, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func searchPostsInTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
@@ -592,9 +807,9 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		perPage = *params.PerPage
 	}
 
-	includeDeletedChannels := false
-	if params.IncludeDeletedChannels != nil {
-		includeDeletedChannels = *params.IncludeDeletedChannels
+	includeDeletedChannels := searchIncludeDeletedChannels(c, &params)
+	if c.Err != nil {
+		return
 	}
 
 	startTime := time.Now()
@@ -620,7 +835,9 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		return
 	}
 
+	archivedChannelIDs := results.ArchivedChannelIds
 	results = model.MakePostSearchResults(clientPostList, results.Matches)
+	results.ArchivedChannelIds = archivedChannelIDs
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
 	if err := results.EncodeJSON(w); err != nil {
@@ -628,6 +845,31 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 	}
 }
 
+// searchIncludeDeletedChannels returns whether the search asks for the archived channels to be
+// searched too. Asking for it with include_deleted requires the permission to search them, while
+// the users without the permission asking for it with the legacy include_deleted_channels only
+// search the channels that aren't archived, as their clients did before the permission existed.
+func searchIncludeDeletedChannels(c *Context, params *model.SearchParameter) bool {
+	canSearchArchived := c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSearchArchivedChannels)
+
+	if params.IncludeDeleted != nil {
+		if *params.IncludeDeleted && !canSearchArchived {
+			c.SetPermissionError(model.PermissionSearchArchivedChannels)
+			return false
+		}
+		return *params.IncludeDeleted
+	}
+
+	return params.IncludeDeletedChannels != nil && *params.IncludeDeletedChannels && canSearchArchived
+}
+
+func getSearchCapabilities(c *Context, w http.ResponseWriter, r *http.Request) {
+	capabilities := c.App.GetPostSearchCapabilities()
+	if err := json.NewEncoder(w).Encode(capabilities); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func updatePost(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequirePostId()
 	if c.Err != nil {
@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitPostAcknowledgement() {
+	api.BaseRoutes.PostsForUser.Handle("/acknowledgements", api.APISessionRequired(getPostAcknowledgementSummariesForUser)).Methods("GET")
+	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(acknowledgePost)).Methods("POST")
+	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(unacknowledgePost)).Methods("DELETE")
+}
+
+func requirePostPriorityEnabled(c *Context, where string) {
+	if !*c.App.Config().ServiceSettings.EnablePostPriority {
+		c.Err = model.NewAppError(where, "api.post_priority.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+}
+
+// checkPostAcknowledgementPermissions only lets users acknowledge posts on their own behalf, in
+// channels they can read.
+func checkPostAcknowledgementPermissions(c *Context) {
+	if c.AppContext.Session().UserId != c.Params.UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+	}
+}
+
+func acknowledgePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	requirePostPriorityEnabled(c, "acknowledgePost")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("acknowledgePost", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	checkPostAcknowledgementPermissions(c)
+	if c.Err != nil {
+		return
+	}
+
+	acknowledgement, err := c.App.AcknowledgePost(c.Params.UserId, c.Params.PostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(acknowledgement); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func unacknowledgePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	requirePostPriorityEnabled(c, "unacknowledgePost")
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("unacknowledgePost", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	checkPostAcknowledgementPermissions(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.UnacknowledgePost(c.Params.UserId, c.Params.PostId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getPostAcknowledgementSummariesForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	requirePostPriorityEnabled(c, "getPostAcknowledgementSummariesForUser")
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	summaries, err := c.App.GetPostAcknowledgementSummariesForUser(c.Params.UserId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(summaries); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
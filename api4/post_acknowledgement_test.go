@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPostAcknowledgements(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createPost := func(t *testing.T, priority *model.PostPriority) *model.Post {
+		post, _, err := th.Client.CreatePost(&model.Post{
+			ChannelId: th.BasicChannel.Id,
+			Message:   "please read",
+			Metadata:  &model.PostMetadata{Priority: priority},
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	client2 := th.CreateClient()
+	th.LoginBasic2WithClient(client2)
+
+	t.Run("disabled", func(t *testing.T) {
+		post := createPost(t, &model.PostPriority{Priority: model.PostPriorityUrgent, RequestedAck: true})
+		assert.Nil(t, post.GetPriority())
+
+		_, resp, err := client2.AcknowledgePost(th.BasicUser2.Id, post.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePostPriority = true })
+
+	t.Run("acknowledge and summarize", func(t *testing.T) {
+		post := createPost(t, &model.PostPriority{Priority: model.PostPriorityImportant, RequestedAck: true})
+		require.NotNil(t, post.GetPriority())
+		assert.Equal(t, model.PostPriorityImportant, post.GetPriority().Priority)
+
+		acknowledgement, _, err := client2.AcknowledgePost(th.BasicUser2.Id, post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser2.Id, acknowledgement.UserId)
+
+		fetched, _, err := th.Client.GetPost(post.Id, "")
+		require.NoError(t, err)
+		require.Len(t, fetched.Metadata.Acknowledgements, 1)
+		assert.Equal(t, th.BasicUser2.Id, fetched.Metadata.Acknowledgements[0].UserId)
+
+		summaries, _, err := th.Client.GetPostAcknowledgementSummariesForUser(th.BasicUser.Id, 0, 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, summaries)
+		assert.Equal(t, post.Id, summaries[0].PostId)
+		assert.Equal(t, int64(1), summaries[0].Acknowledged)
+
+		_, resp, err := client2.GetPostAcknowledgementSummariesForUser(th.BasicUser.Id, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, err = client2.UnacknowledgePost(th.BasicUser2.Id, post.Id)
+		require.NoError(t, err)
+
+		resp, err = client2.UnacknowledgePost(th.BasicUser2.Id, post.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("own post", func(t *testing.T) {
+		post := createPost(t, &model.PostPriority{RequestedAck: true})
+
+		_, resp, err := th.Client.AcknowledgePost(th.BasicUser.Id, post.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("post without requested acknowledgements", func(t *testing.T) {
+		post := createPost(t, &model.PostPriority{Priority: model.PostPriorityUrgent})
+
+		_, resp, err := client2.AcknowledgePost(th.BasicUser2.Id, post.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("on behalf of another user", func(t *testing.T) {
+		post := createPost(t, &model.PostPriority{RequestedAck: true})
+
+		_, resp, err := th.SystemAdminClient.AcknowledgePost(th.BasicUser2.Id, post.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("priority on a reply", func(t *testing.T) {
+		root := createPost(t, nil)
+
+		_, resp, err := th.Client.CreatePost(&model.Post{
+			ChannelId: th.BasicChannel.Id,
+			RootId:    root.Id,
+			Message:   "reply",
+			Metadata:  &model.PostMetadata{Priority: &model.PostPriority{Priority: model.PostPriorityUrgent}},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitPostModeration() {
+	api.BaseRoutes.PostModerations.Handle("", api.APISessionRequired(getPostModerations)).Methods("GET")
+	api.BaseRoutes.PostModeration.Handle("", api.APISessionRequired(getPostModeration)).Methods("GET")
+	api.BaseRoutes.PostModeration.Handle("/approve", api.APISessionRequired(approvePostModeration)).Methods("POST")
+	api.BaseRoutes.PostModeration.Handle("/remove", api.APISessionRequired(removePostModeration)).Methods("POST")
+}
+
+func getPostModerations(c *Context, w http.ResponseWriter, r *http.Request) {
+	teamID := r.URL.Query().Get("team_id")
+	if teamID != "" && !model.IsValidId(teamID) {
+		c.SetInvalidParam("team_id")
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !model.IsValidPostModerationStatus(status) {
+		c.SetInvalidParam("status")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceComplianceMonitoring) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceComplianceMonitoring)
+		return
+	}
+
+	moderations, err := c.App.GetPostModerations(teamID, status, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(moderations); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPostModeration(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostModerationId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceComplianceMonitoring) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceComplianceMonitoring)
+		return
+	}
+
+	moderation, err := c.App.GetPostModeration(c.Params.PostModerationId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(moderation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func approvePostModeration(c *Context, w http.ResponseWriter, r *http.Request) {
+	reviewPostModeration(c, w, "approvePostModeration", func(moderationID, reviewerID string) (*model.PostModeration, *model.AppError) {
+		return c.App.ApprovePostModeration(c.AppContext, moderationID, reviewerID)
+	})
+}
+
+func removePostModeration(c *Context, w http.ResponseWriter, r *http.Request) {
+	reviewPostModeration(c, w, "removePostModeration", c.App.RemovePostModeration)
+}
+
+func reviewPostModeration(c *Context, w http.ResponseWriter, event string, review func(moderationID, reviewerID string) (*model.PostModeration, *model.AppError)) {
+	c.RequirePostModerationId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_moderation_id", c.Params.PostModerationId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteComplianceComplianceMonitoring) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteComplianceComplianceMonitoring)
+		return
+	}
+
+	moderation, err := review(c.Params.PostModerationId, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("post_id", moderation.PostId)
+	auditRec.AddMeta("channel_id", moderation.ChannelId)
+
+	if err := json.NewEncoder(w).Encode(moderation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPostModerations(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ModerationSettings.Enable = true
+		cfg.ModerationSettings.Rules = []*model.ModerationRule{
+			{Name: "Flagged", Keywords: []string{"flagme"}, Action: model.PostModerationActionFlag},
+			{Name: "Held", Keywords: []string{"holdme"}, Action: model.PostModerationActionHold},
+		}
+	})
+
+	_, resp, err := th.Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "holdme"})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	flagged, _, err := th.Client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "flagme"})
+	require.NoError(t, err)
+
+	t.Run("only admins can list and review them", func(t *testing.T) {
+		_, resp, err := th.Client.GetPostModerations("", "", 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		moderations, _, err := th.SystemAdminClient.GetPostModerations(th.BasicTeam.Id, "", 0, 60)
+		require.NoError(t, err)
+		require.Len(t, moderations, 2)
+
+		_, resp, err = th.Client.GetPostModeration(moderations[0].Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ApprovePostModeration(moderations[0].Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.RemovePostModeration(moderations[1].Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetPostModerations("", "unknown", 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.GetPostModeration(model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	moderations, _, err := th.SystemAdminClient.GetPostModerations(th.BasicTeam.Id, model.PostModerationStatusPending, 0, 60)
+	require.NoError(t, err)
+	require.Len(t, moderations, 2)
+	held, flaggedModeration := moderations[0], moderations[1]
+	assert.Equal(t, model.PostModerationActionHold, held.Action)
+	assert.Equal(t, flagged.Id, flaggedModeration.PostId)
+
+	t.Run("approve a held post", func(t *testing.T) {
+		approved, _, err := th.SystemAdminClient.ApprovePostModeration(held.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.PostModerationStatusApproved, approved.Status)
+		assert.Equal(t, th.SystemAdminUser.Id, approved.ReviewerId)
+
+		_, resp, err := th.SystemAdminClient.ApprovePostModeration(held.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("remove a flagged post", func(t *testing.T) {
+		removed, _, err := th.SystemAdminClient.RemovePostModeration(flaggedModeration.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.PostModerationStatusRemoved, removed.Status)
+
+		_, resp, err := th.Client.GetPost(flagged.Id, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	pending, _, err := th.SystemAdminClient.GetPostModerations(th.BasicTeam.Id, model.PostModerationStatusPending, 0, 60)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
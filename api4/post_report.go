@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitPostReport() {
+	api.BaseRoutes.Post.Handle("/report", api.APISessionRequired(reportPost)).Methods("POST")
+	api.BaseRoutes.PostReports.Handle("", api.APISessionRequired(getPostReports)).Methods("GET")
+	api.BaseRoutes.PostReport.Handle("", api.APISessionRequired(getPostReport)).Methods("GET")
+	api.BaseRoutes.PostReport.Handle("/resolve", api.APISessionRequired(resolvePostReport)).Methods("POST")
+}
+
+func reportPost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	var report model.PostReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		c.SetInvalidParam("post_report")
+		return
+	}
+	report.Id = ""
+	report.ReporterId = c.AppContext.Session().UserId
+
+	post, err := c.App.GetPostIfAuthorized(c.Params.PostId, c.AppContext.Session())
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	saved, err := c.App.ReportPost(post, &report)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// hasPermissionToManagePostReports checks the permission of the session on the reports of the
+// team, or on the reports of every team, including those of direct messages, when teamID is empty.
+func hasPermissionToManagePostReports(c *Context, teamID string) bool {
+	if teamID == "" {
+		return c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManagePostReports)
+	}
+	return c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), teamID, model.PermissionManagePostReports)
+}
+
+func getPostReports(c *Context, w http.ResponseWriter, r *http.Request) {
+	teamID := r.URL.Query().Get("team_id")
+	if teamID != "" && !model.IsValidId(teamID) {
+		c.SetInvalidParam("team_id")
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !model.IsValidPostReportStatus(status) {
+		c.SetInvalidParam("status")
+		return
+	}
+
+	if !hasPermissionToManagePostReports(c, teamID) {
+		c.SetPermissionError(model.PermissionManagePostReports)
+		return
+	}
+
+	reports, err := c.App.GetPostReports(teamID, status, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	for _, report := range reports {
+		report.Sanitize()
+	}
+
+	if err := json.NewEncoder(w).Encode(reports); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPostReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostReportId()
+	if c.Err != nil {
+		return
+	}
+
+	report, err := c.App.GetPostReport(c.Params.PostReportId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if !hasPermissionToManagePostReports(c, report.TeamId) {
+		c.SetPermissionError(model.PermissionManagePostReports)
+		return
+	}
+
+	report.Sanitize()
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func resolvePostReport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostReportId()
+	if c.Err != nil {
+		return
+	}
+
+	var resolution model.PostReportResolution
+	if jsonErr := json.NewDecoder(r.Body).Decode(&resolution); jsonErr != nil {
+		c.SetInvalidParam("post_report_resolution")
+		return
+	}
+	if !model.IsValidPostReportResolution(resolution.Action) {
+		c.SetInvalidParam("action")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("resolvePostReport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_report_id", c.Params.PostReportId)
+	auditRec.AddMeta("action", resolution.Action)
+
+	report, err := c.App.GetPostReport(c.Params.PostReportId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if !hasPermissionToManagePostReports(c, report.TeamId) {
+		c.SetPermissionError(model.PermissionManagePostReports)
+		return
+	}
+
+	report, err = c.App.ResolvePostReport(c.AppContext, report.Id, c.AppContext.Session().UserId, &resolution)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("post_id", report.PostId)
+
+	report.Sanitize()
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPostReports(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	reporter := th.CreateClient()
+	th.LoginBasic2WithClient(reporter)
+
+	t.Run("invalid reports", func(t *testing.T) {
+		_, resp, err := th.Client.ReportPost(th.BasicPost.Id, &model.PostReport{Reason: model.PostReportReasonSpam})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = reporter.ReportPost(th.BasicPost.Id, &model.PostReport{Reason: "unknown"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = reporter.ReportPost(th.BasicPost.Id, &model.PostReport{Reason: model.PostReportReasonOther})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		privateChannel := th.CreatePrivateChannel()
+		privatePost := th.CreatePostWithClient(th.Client, privateChannel)
+		_, resp, err = reporter.ReportPost(privatePost.Id, &model.PostReport{Reason: model.PostReportReasonSpam})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	anonymous, resp, err := reporter.ReportPost(th.BasicPost.Id, &model.PostReport{Reason: model.PostReportReasonSpam, Anonymous: true})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser2.Id, anonymous.ReporterId)
+	assert.Equal(t, th.BasicTeam.Id, anonymous.TeamId)
+	assert.Equal(t, th.BasicUser.Id, anonymous.PostUserId)
+
+	_, resp, err = reporter.ReportPost(th.BasicPost.Id, &model.PostReport{Reason: model.PostReportReasonHarassment})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	secondPost := th.CreatePost()
+	named, _, err := reporter.ReportPost(secondPost.Id, &model.PostReport{Reason: model.PostReportReasonOther, Comment: "Off topic"})
+	require.NoError(t, err)
+
+	t.Run("only moderators can list and resolve them", func(t *testing.T) {
+		_, resp, err := th.Client.GetPostReports(th.BasicTeam.Id, "", 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetPostReport(named.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ResolvePostReport(named.Id, &model.PostReportResolution{Action: model.PostReportResolutionDismiss})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	_, err = th.SystemAdminClient.UpdateTeamMemberRoles(th.BasicTeam.Id, th.BasicUser.Id, "team_user team_admin")
+	require.NoError(t, err)
+
+	t.Run("team admins see the reports of their team", func(t *testing.T) {
+		reports, _, err := th.Client.GetPostReports(th.BasicTeam.Id, model.PostReportStatusOpen, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		assert.Equal(t, anonymous.Id, reports[0].Id)
+		assert.Empty(t, reports[0].ReporterId, "the reporter of an anonymous report is hidden")
+		assert.Equal(t, th.BasicUser2.Id, reports[1].ReporterId)
+
+		_, resp, err := th.Client.GetPostReports("", "", 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetPostReports("", "unknown", 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("warn the author", func(t *testing.T) {
+		resolved, _, err := th.Client.ResolvePostReport(named.Id, &model.PostReportResolution{Action: model.PostReportResolutionWarnUser, Note: "Stay on topic."})
+		require.NoError(t, err)
+		assert.Equal(t, model.PostReportStatusResolved, resolved.Status)
+		assert.Equal(t, th.BasicUser.Id, resolved.ResolverId)
+
+		_, resp, err := th.Client.ResolvePostReport(named.Id, &model.PostReportResolution{Action: model.PostReportResolutionDismiss})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("delete the post", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.ResolvePostReport(anonymous.Id, &model.PostReportResolution{Action: "ban"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resolved, _, err := th.SystemAdminClient.ResolvePostReport(anonymous.Id, &model.PostReportResolution{Action: model.PostReportResolutionDeletePost})
+		require.NoError(t, err)
+		assert.Empty(t, resolved.ReporterId)
+
+		_, resp, err = th.SystemAdminClient.GetPost(th.BasicPost.Id, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	reports, _, err := th.SystemAdminClient.GetPostReports("", model.PostReportStatusOpen, 0, 60)
+	require.NoError(t, err)
+	for _, report := range reports {
+		assert.NotEqual(t, th.BasicTeam.Id, report.TeamId)
+	}
+}
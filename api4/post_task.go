@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitPostTask() {
+	api.BaseRoutes.Post.Handle("/task", api.APISessionRequired(getPostTask)).Methods("GET")
+	api.BaseRoutes.Post.Handle("/task/patch", api.APISessionRequired(patchPostTask)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/tasks", api.APISessionRequired(getPostTasksForUser)).Methods("GET")
+}
+
+func getPostTask(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	task, err := c.App.GetPostTask(c.Params.PostId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(task); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchPostTask(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.PostTaskPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParam("task")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchPostTask", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("post_id", c.Params.PostId)
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionCreatePost) {
+		c.SetPermissionError(model.PermissionCreatePost)
+		return
+	}
+
+	task, err := c.App.PatchPostTask(c.Params.PostId, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddMeta("task", task)
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(task); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPostTasksForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var statuses []string
+	if statusString := r.URL.Query().Get("status"); statusString != "" {
+		statuses = strings.Split(statusString, ",")
+		for _, status := range statuses {
+			if !model.IsValidPostTaskStatus(status) {
+				c.SetInvalidParam("status")
+				return
+			}
+		}
+	}
+
+	tasks, err := c.App.GetPostTasksForUser(c.Params.UserId, statuses, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	js, jsonErr := json.Marshal(tasks)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getPostTasksForUser", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(js)
+}
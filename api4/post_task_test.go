@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func createTaskPost(t *testing.T, client *model.Client4, channelID, assigneeID string) (*model.Post, *model.Response, error) {
+	t.Helper()
+
+	post := &model.Post{
+		ChannelId: channelID,
+		Message:   "task_" + model.NewId(),
+		Type:      model.PostTypeTask,
+	}
+	post.AddProp(model.PostPropsTaskAssigneeId, assigneeID)
+	post.AddProp(model.PostPropsTaskDueAt, 1000)
+
+	return client.CreatePost(post)
+}
+
+func TestCreateTaskPost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	t.Run("assigned to channel member", func(t *testing.T) {
+		post, _, err := createTaskPost(t, client, th.BasicChannel.Id, th.BasicUser2.Id)
+		require.NoError(t, err)
+
+		task, _, err := client.GetPostTask(post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, post.Id, task.PostId)
+		assert.Equal(t, th.BasicChannel.Id, task.ChannelId)
+		assert.Equal(t, th.BasicUser2.Id, task.AssigneeId)
+		assert.Equal(t, model.PostTaskStatusOpen, task.Status)
+		assert.Equal(t, int64(1000), task.DueAt)
+	})
+
+	t.Run("assigned to non member", func(t *testing.T) {
+		user := th.CreateUser()
+		_, resp, err := createTaskPost(t, client, th.BasicChannel.Id, user.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("regular post has no task", func(t *testing.T) {
+		post := th.CreatePost()
+		_, resp, err := client.GetPostTask(post.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestPatchPostTask(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	post, _, err := createTaskPost(t, client, th.BasicChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+
+	t.Run("update status", func(t *testing.T) {
+		task, _, err := client.PatchPostTask(post.Id, &model.PostTaskPatch{Status: model.NewString(model.PostTaskStatusDone)})
+		require.NoError(t, err)
+		assert.Equal(t, model.PostTaskStatusDone, task.Status)
+		assert.Equal(t, th.BasicUser.Id, task.AssigneeId)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, resp, err := client.PatchPostTask(post.Id, &model.PostTaskPatch{Status: model.NewString("unknown")})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not a channel member", func(t *testing.T) {
+		privatePost, _, err := createTaskPost(t, client, th.BasicPrivateChannel2.Id, "")
+		require.NoError(t, err)
+
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := client.PatchPostTask(privatePost.Id, &model.PostTaskPatch{Status: model.NewString(model.PostTaskStatusDone)})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
+func TestGetPostTasksForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	openPost, _, err := createTaskPost(t, client, th.BasicChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	donePost, _, err := createTaskPost(t, client, th.BasicChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	_, _, err = client.PatchPostTask(donePost.Id, &model.PostTaskPatch{Status: model.NewString(model.PostTaskStatusDone)})
+	require.NoError(t, err)
+
+	t.Run("open tasks", func(t *testing.T) {
+		tasks, _, err := client.GetPostTasksForUser(th.BasicUser.Id, []string{model.PostTaskStatusOpen, model.PostTaskStatusInProgress}, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, openPost.Id, tasks[0].PostId)
+	})
+
+	t.Run("all tasks", func(t *testing.T) {
+		tasks, _, err := client.GetPostTasksForUser(th.BasicUser.Id, nil, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, resp, err := client.GetPostTasksForUser(th.BasicUser.Id, []string{"unknown"}, 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := client.GetPostTasksForUser(th.BasicUser2.Id, nil, 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
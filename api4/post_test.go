@@ -2087,6 +2087,90 @@ func TestDeletePostEvent(t *testing.T) {
 	require.True(t, received)
 }
 
+func TestRestorePost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	post := th.CreatePost()
+	reply, _, err := client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, RootId: post.Id, Message: "reply"})
+	require.NoError(t, err)
+
+	_, err = client.DeletePost(post.Id)
+	require.NoError(t, err)
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := client.GetDeletedPostsForChannel(th.BasicChannel.Id, 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.RestorePost(post.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.RestorePost(model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.MakeUserChannelAdmin(th.BasicUser, th.BasicChannel)
+
+	t.Run("list and restore", func(t *testing.T) {
+		list, _, err := client.GetDeletedPostsForChannel(th.BasicChannel.Id, 0, 60)
+		require.NoError(t, err)
+		require.Equal(t, []string{post.Id}, list.Order)
+
+		restored, _, err := client.RestorePost(post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, post.Id, restored.Id)
+		assert.Zero(t, restored.DeleteAt)
+
+		_, _, err = client.GetPost(reply.Id, "")
+		require.NoError(t, err)
+
+		list, _, err = client.GetDeletedPostsForChannel(th.BasicChannel.Id, 0, 60)
+		require.NoError(t, err)
+		assert.Empty(t, list.Order)
+
+		_, resp, err := client.RestorePost(post.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.post.restore.not_deleted.app_error")
+	})
+
+	t.Run("reply of a deleted post", func(t *testing.T) {
+		_, err := client.DeletePost(reply.Id)
+		require.NoError(t, err)
+		_, err = client.DeletePost(post.Id)
+		require.NoError(t, err)
+
+		_, resp, err := client.RestorePost(reply.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.post.restore.root_deleted.app_error")
+
+		_, _, err = client.RestorePost(post.Id)
+		require.NoError(t, err)
+		_, _, err = client.RestorePost(reply.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("deleted too long ago", func(t *testing.T) {
+		old := th.CreatePost()
+		deleteAt := model.GetMillis() - (model.PostRecycleBinDays+1)*24*60*60*1000
+		require.NoError(t, th.App.Srv().Store.Post().Delete(old.Id, deleteAt, th.BasicUser.Id))
+
+		list, _, err := client.GetDeletedPostsForChannel(th.BasicChannel.Id, 0, 60)
+		require.NoError(t, err)
+		assert.NotContains(t, list.Order, old.Id)
+
+		_, resp, err := client.RestorePost(old.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.post.restore.expired.app_error")
+	})
+}
+
 func TestDeletePostMessage(t *testing.T) {
 	th := Setup(t).InitBasic()
 	th.LinkUserToTeam(th.SystemAdminUser, th.BasicTeam)
@@ -2193,6 +2277,83 @@ func TestGetPostThread(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetThreadSummary(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	root := th.BasicPost
+	reply1, _, err := client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "reply 1", RootId: root.Id})
+	require.NoError(t, err)
+	th.LoginBasic2()
+	reply2, _, err := client.CreatePost(&model.Post{ChannelId: th.BasicChannel.Id, Message: "reply 2", RootId: root.Id})
+	require.NoError(t, err)
+	th.LoginBasic()
+
+	summary, _, err := client.GetThreadSummary(root.Id, model.GetThreadSummaryOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, root.Id, summary.PostId)
+	assert.Equal(t, int64(2), summary.ReplyCount)
+	assert.Equal(t, reply2.CreateAt, summary.LastReplyAt)
+	assert.Equal(t, int64(model.TeamStatsDayMillis), summary.BucketSize)
+
+	require.Len(t, summary.Participants, 2)
+	assert.Equal(t, th.BasicUser2.Id, summary.Participants[0].UserId)
+	require.NotNil(t, summary.Participants[0].User)
+	assert.Equal(t, th.BasicUser2.Username, summary.Participants[0].User.Username)
+	assert.Empty(t, summary.Participants[0].User.Password)
+	assert.Contains(t, summary.Participants[0].AvatarURL, "/users/"+th.BasicUser2.Id+"/image")
+	assert.Equal(t, th.BasicUser.Id, summary.Participants[1].UserId)
+
+	var total int64
+	for _, bucket := range summary.ReplyBuckets {
+		total += bucket.ReplyCount
+	}
+	assert.Equal(t, int64(2), total)
+
+	require.Equal(t, []string{reply2.Id, reply1.Id}, summary.LastReplies.Order)
+	assert.NotNil(t, summary.LastReplies.Posts[reply1.Id].Metadata)
+
+	t.Run("options", func(t *testing.T) {
+		summary, _, err := client.GetThreadSummary(root.Id, model.GetThreadSummaryOpts{Replies: 1, Bucket: model.ThreadSummaryBucketHour})
+		require.NoError(t, err)
+		assert.Equal(t, []string{reply2.Id}, summary.LastReplies.Order)
+		assert.Equal(t, int64(60*60*1000), summary.BucketSize)
+
+		_, resp, err := client.GetThreadSummary(root.Id, model.GetThreadSummaryOpts{Replies: model.ThreadSummaryMaxReplies + 1})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = client.GetThreadSummary(root.Id, model.GetThreadSummaryOpts{Bucket: "week"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("reply is not a thread", func(t *testing.T) {
+		_, resp, err := client.GetThreadSummary(reply1.Id, model.GetThreadSummaryOpts{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("requires access to the channel", func(t *testing.T) {
+		privateChannel := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, privateChannel)
+
+		_, resp, err := client.GetThreadSummary(privatePost.Id, model.GetThreadSummaryOpts{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetThreadSummary(model.NewId(), model.GetThreadSummaryOpts{})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		client.Logout()
+		_, resp, err = client.GetThreadSummary(root.Id, model.GetThreadSummaryOpts{})
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+	})
+}
+
 func TestSearchPosts(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2343,6 +2504,56 @@ func TestSearchPosts(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestSearchPostsIncludeDeleted(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.ExperimentalViewArchivedChannels = true
+	})
+
+	archivedChannel := th.CreatePublicChannel()
+	archivedPost := th.CreateMessagePostWithClient(th.Client, archivedChannel, "archived launch plan")
+	th.CreateMessagePost("current launch plan")
+	appErr := th.App.DeleteChannel(th.Context, archivedChannel, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	terms := "launch"
+	includeDeleted := true
+	params := &model.SearchParameter{Terms: &terms, IncludeDeleted: &includeDeleted}
+
+	t.Run("should search the archived channels and annotate their posts", func(t *testing.T) {
+		results, _, err := th.Client.SearchPostResultsWithParams(th.BasicTeam.Id, params)
+		require.NoError(t, err)
+		require.Len(t, results.Order, 2)
+		assert.Contains(t, results.Posts, archivedPost.Id)
+		assert.Equal(t, []string{archivedChannel.Id}, results.ArchivedChannelIds)
+	})
+
+	t.Run("should not annotate the results of the searches excluding the archived channels", func(t *testing.T) {
+		results, _, err := th.Client.SearchPostResultsWithParams(th.BasicTeam.Id, &model.SearchParameter{Terms: &terms})
+		require.NoError(t, err)
+		require.Len(t, results.Order, 1)
+		assert.Empty(t, results.ArchivedChannelIds)
+	})
+
+	t.Run("should require the permission to search the archived channels", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionSearchArchivedChannels.Id, model.SystemUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionSearchArchivedChannels.Id, model.SystemUserRoleId)
+
+		_, resp, err := th.Client.SearchPostResultsWithParams(th.BasicTeam.Id, params)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		// The legacy flag only leaves the archived channels out.
+		legacyParams := &model.SearchParameter{Terms: &terms, IncludeDeletedChannels: &includeDeleted}
+		results, _, err := th.Client.SearchPostResultsWithParams(th.BasicTeam.Id, legacyParams)
+		require.NoError(t, err)
+		require.Len(t, results.Order, 1)
+		assert.NotContains(t, results.Posts, archivedPost.Id)
+	})
+}
+
 func TestSearchHashtagPosts(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2530,6 +2741,38 @@ func TestSearchPostsWithDateFlags(t *testing.T) {
 	require.Len(t, posts.Order, 1, "wrong number of posts")
 }
 
+func TestSearchPostsWithOperators(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	th.LoginBasic()
+	client := th.Client
+
+	post := th.CreateMessagePost("deployment finished")
+	th.CreateMessagePostWithClient(th.SystemAdminClient, th.BasicChannel, "deployment started")
+
+	t.Run("from:me", func(t *testing.T) {
+		posts, _, err := client.SearchPosts(th.BasicTeam.Id, "deployment from:me", false)
+		require.NoError(t, err)
+		require.Len(t, posts.Order, 1)
+		assert.Equal(t, post.Id, posts.Order[0])
+	})
+
+	t.Run("capabilities of the database backend", func(t *testing.T) {
+		capabilities, _, err := client.GetSearchCapabilities()
+		require.NoError(t, err)
+		assert.Equal(t, model.SearchBackendDatabase, capabilities.Backend)
+		assert.Contains(t, capabilities.Operators, model.SearchOperatorFileType)
+		assert.NotContains(t, capabilities.Operators, model.SearchOperatorRegex)
+	})
+
+	t.Run("regular expressions are opt-in", func(t *testing.T) {
+		_, resp, err := client.SearchPosts(th.BasicTeam.Id, "/deploy(ment)?.fin/", false)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.post.search.unsupported_operator.app_error")
+	})
+}
+
 func TestGetFileInfosForPost(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -2760,6 +3003,109 @@ func TestGetPostsByIds(t *testing.T) {
 	CheckNotFoundStatus(t, response)
 }
 
+func TestRenderSystemPostMessages(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	channel := th.CreatePublicChannel()
+	_, _, err := client.PatchChannel(channel.Id, &model.ChannelPatch{DisplayName: model.NewString("Renamed")})
+	require.NoError(t, err)
+
+	var renamed *model.Post
+	require.Eventually(t, func() bool {
+		postList, _, err := client.GetPostsForChannel(channel.Id, 0, 60, "", false)
+		require.NoError(t, err)
+		for _, post := range postList.Posts {
+			if post.Type == model.PostTypeDisplaynameChange {
+				renamed = post
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 100*time.Millisecond)
+
+	expected := th.BasicUser.Username + " updated the channel display name from: " + channel.DisplayName + " to: Renamed"
+	require.Equal(t, expected, renamed.Message, "the message is rendered in the language of the server")
+
+	privatePost := th.CreatePostWithClient(th.SystemAdminClient, th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate))
+
+	messages, resp, err := client.RenderSystemPostMessages([]string{renamed.Id, th.BasicPost.Id, privatePost.Id}, "")
+	require.NoError(t, err)
+	CheckOKStatus(t, resp)
+	require.Equal(t, map[string]string{renamed.Id: expected}, messages)
+
+	messages, _, err = client.RenderSystemPostMessages([]string{renamed.Id}, "fr")
+	require.NoError(t, err)
+	require.Contains(t, messages[renamed.Id], "Renamed")
+	require.NotEqual(t, expected, messages[renamed.Id])
+
+	_, resp, err = client.RenderSystemPostMessages([]string{renamed.Id}, "xx")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.RenderSystemPostMessages([]string{}, "")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+}
+
+func TestGetPostsByIdsWithMetadata(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	fileResp, _, err := client.UploadFile([]byte("data"), th.BasicChannel.Id, "test")
+	require.NoError(t, err)
+	fileID := fileResp.FileInfos[0].Id
+
+	postWithFile, _, err := client.CreatePost(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		Message:   "with file",
+		FileIds:   []string{fileID},
+	})
+	require.NoError(t, err)
+
+	postWithReaction := th.CreatePost()
+	_, _, err = client.SaveReaction(&model.Reaction{
+		UserId:    th.BasicUser.Id,
+		PostId:    postWithReaction.Id,
+		EmojiName: "smile",
+	})
+	require.NoError(t, err)
+
+	privateChannel := th.CreatePrivateChannel()
+	privatePost := th.CreatePostWithClient(th.Client, privateChannel)
+
+	t.Run("should return the metadata of every post", func(t *testing.T) {
+		posts, _, err := client.GetPostsByIds([]string{postWithFile.Id, postWithReaction.Id})
+		require.NoError(t, err)
+		require.Len(t, posts, 2)
+
+		postsByID := map[string]*model.Post{}
+		for _, post := range posts {
+			postsByID[post.Id] = post
+		}
+
+		require.NotNil(t, postsByID[postWithFile.Id].Metadata)
+		require.Len(t, postsByID[postWithFile.Id].Metadata.Files, 1)
+		assert.Equal(t, fileID, postsByID[postWithFile.Id].Metadata.Files[0].Id)
+
+		require.NotNil(t, postsByID[postWithReaction.Id].Metadata)
+		require.Len(t, postsByID[postWithReaction.Id].Metadata.Reactions, 1)
+		assert.Equal(t, "smile", postsByID[postWithReaction.Id].Metadata.Reactions[0].EmojiName)
+	})
+
+	t.Run("should leave out posts the user cannot read", func(t *testing.T) {
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+
+		posts, _, err := client2.GetPostsByIds([]string{postWithFile.Id, privatePost.Id})
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, postWithFile.Id, posts[0].Id)
+	})
+}
+
 func TestCreatePostNotificationsWithCRT(t *testing.T) {
 	th := Setup(t).InitBasic()
 	rpost := th.CreatePost()
@@ -16,6 +16,7 @@ func (api *API) InitPreference() {
 	api.BaseRoutes.Preferences.Handle("", api.APISessionRequired(getPreferences)).Methods("GET")
 	api.BaseRoutes.Preferences.Handle("", api.APISessionRequired(updatePreferences)).Methods("PUT")
 	api.BaseRoutes.Preferences.Handle("/delete", api.APISessionRequired(deletePreferences)).Methods("POST")
+	api.BaseRoutes.Preferences.Handle("/compare_and_set", api.APISessionRequired(compareAndSetPreferences)).Methods("POST")
 	api.BaseRoutes.Preferences.Handle("/{category:[A-Za-z0-9_]+}", api.APISessionRequired(getPreferencesByCategory)).Methods("GET")
 	api.BaseRoutes.Preferences.Handle("/{category:[A-Za-z0-9_]+}/name/{preference_name:[A-Za-z0-9_]+}", api.APISessionRequired(getPreferenceByCategoryAndName)).Methods("GET")
 }
@@ -106,6 +107,63 @@ func updatePreferences(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	sanitizedPreferences := sanitizePreferences(c, preferences)
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.UpdatePreferences(c.Params.UserId, sanitizedPreferences); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+// compareAndSetPreferences saves preferences only if none of them changed since the versions the
+// client last saw. The conflicting preferences are returned when any did, with nothing saved.
+func compareAndSetPreferences(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("compareAndSetPreferences", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var preferences model.Preferences
+	if jsonErr := json.NewDecoder(r.Body).Decode(&preferences); jsonErr != nil {
+		c.SetInvalidParam("preferences")
+		return
+	}
+
+	sanitizedPreferences := sanitizePreferences(c, preferences)
+	if c.Err != nil {
+		return
+	}
+
+	result, err := c.App.CompareAndSetPreferences(c.Params.UserId, sanitizedPreferences)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("conflicts", len(result.Conflicts))
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// sanitizePreferences checks that the session can read the posts of flagged post preferences.
+func sanitizePreferences(c *Context, preferences model.Preferences) model.Preferences {
 	var sanitizedPreferences model.Preferences
 
 	for _, pref := range preferences {
@@ -113,25 +171,19 @@ func updatePreferences(c *Context, w http.ResponseWriter, r *http.Request) {
 			post, err := c.App.GetSinglePost(pref.Name)
 			if err != nil {
 				c.SetInvalidParam("preference.name")
-				return
+				return nil
 			}
 
 			if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), post.ChannelId, model.PermissionReadChannel) {
 				c.SetPermissionError(model.PermissionReadChannel)
-				return
+				return nil
 			}
 		}
 
 		sanitizedPreferences = append(sanitizedPreferences, pref)
 	}
 
-	if err := c.App.UpdatePreferences(c.Params.UserId, sanitizedPreferences); err != nil {
-		c.Err = err
-		return
-	}
-
-	auditRec.Success()
-	ReturnStatusOK(w)
+	return sanitizedPreferences
 }
 
 func deletePreferences(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -541,6 +541,62 @@ func TestUpdateSidebarPreferences(t *testing.T) {
 	})
 }
 
+func TestCompareAndSetPreferences(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	user := th.BasicUser
+	category := model.NewId()
+	preferences := model.Preferences{
+		{
+			UserId:   user.Id,
+			Category: category,
+			Name:     model.NewId(),
+			Value:    "a",
+		},
+	}
+
+	result, _, err := client.CompareAndSetPreferences(user.Id, preferences)
+	require.NoError(t, err)
+	require.Empty(t, result.Conflicts)
+	require.Len(t, result.Saved, 1)
+	require.EqualValues(t, 1, result.Saved[0].Version)
+
+	// Another device saves the preference in the meantime.
+	_, err = client.UpdatePreferences(user.Id, model.Preferences{{UserId: user.Id, Category: category, Name: preferences[0].Name, Value: "b"}})
+	require.NoError(t, err)
+
+	stale := result.Saved[0]
+	stale.Value = "c"
+	result, _, err = client.CompareAndSetPreferences(user.Id, model.Preferences{stale})
+	require.NoError(t, err)
+	require.Empty(t, result.Saved)
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, "b", result.Conflicts[0].Value)
+	assert.EqualValues(t, 2, result.Conflicts[0].Version)
+
+	merged := result.Conflicts[0]
+	merged.Value = "bc"
+	result, _, err = client.CompareAndSetPreferences(user.Id, model.Preferences{merged})
+	require.NoError(t, err)
+	require.Empty(t, result.Conflicts)
+	assert.EqualValues(t, 3, result.Saved[0].Version)
+
+	pref, _, err := client.GetPreferenceByCategoryAndName(user.Id, category, merged.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "bc", pref.Value)
+	assert.EqualValues(t, 3, pref.Version)
+
+	_, resp, err := client.CompareAndSetPreferences(th.BasicUser2.Id, model.Preferences{{UserId: th.BasicUser2.Id, Category: category, Name: model.NewId()}})
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.CompareAndSetPreferences(user.Id, model.Preferences{{UserId: user.Id, Category: category, Name: model.NewId(), Version: -1}})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestDeletePreferences(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -23,8 +23,10 @@ import (
 type cursorPrefix string
 
 const (
-	channelMemberCursorPrefix cursorPrefix = "channelMember"
-	channelCursorPrefix       cursorPrefix = "channel"
+	channelMemberCursorPrefix     cursorPrefix = "channelMember"
+	channelMemberPageCursorPrefix cursorPrefix = "channelMemberPage"
+	channelCursorPrefix           cursorPrefix = "channel"
+	sidebarSyncCursorPrefix       cursorPrefix = "sidebarSync"
 )
 
 type resolver struct {
@@ -235,7 +237,7 @@ func (*resolver) ChannelMembers(ctx context.Context, args struct {
 			return nil, appErr
 		}
 
-		return []*channelMember{{*member}}, nil
+		return []*channelMember{{ChannelMember: *member}}, nil
 	}
 
 	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), args.UserID) {
@@ -268,7 +270,120 @@ func (*resolver) ChannelMembers(ctx context.Context, args struct {
 
 	res := make([]*channelMember, 0, len(members))
 	for _, cm := range members {
-		res = append(res, &channelMember{cm})
+		res = append(res, &channelMember{ChannelMember: cm})
+	}
+
+	return res, nil
+}
+
+// match with api4.getChannelMembers
+func (*resolver) ChannelMembersInChannel(ctx context.Context, args struct {
+	ChannelID string
+	Sort      string
+	First     int32
+	After     string
+}) ([]*channelMember, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.IsValidId(args.ChannelID) {
+		c.SetInvalidParam("channelId")
+		return nil, c.Err
+	}
+
+	if !model.IsValidChannelMembersSort(args.Sort) {
+		c.SetInvalidParam("sort")
+		return nil, c.Err
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), args.ChannelID, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return nil, c.Err
+	}
+
+	limit := int(args.First)
+	// ensure args.First limit
+	if limit == 0 {
+		limit = web.PerPageDefault
+	} else if limit > web.PerPageMaximum {
+		return nil, fmt.Errorf("first parameter %d higher than allowed maximum of %d", limit, web.PerPageMaximum)
+	}
+
+	// ensure args.After format, and that it comes from the same listing
+	offset := 0
+	if args.After != "" {
+		afterChannel, afterSort, afterOffset, ok := parseChannelMemberPageCursor(args.After)
+		if !ok || afterChannel != args.ChannelID || afterSort != args.Sort {
+			return nil, fmt.Errorf("after cursor not in the correct format: %s", args.After)
+		}
+		offset = afterOffset + 1
+	}
+
+	members, err := c.App.Srv().Store.Channel().GetMembers(args.ChannelID, offset, limit, &model.ChannelMembersGetOptions{Sort: args.Sort})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*channelMember, 0, len(members))
+	for i, cm := range members {
+		res = append(res, &channelMember{
+			ChannelMember: cm,
+			cursor:        channelMemberPageCursor(args.ChannelID, args.Sort, offset+i),
+		})
+	}
+
+	return res, nil
+}
+
+// match with api4.getPostsByIds
+func (*resolver) Posts(ctx context.Context, args struct {
+	IDs []string
+}) ([]*post, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args.IDs) == 0 {
+		c.SetInvalidParam("ids")
+		return nil, c.Err
+	}
+
+	if len(args.IDs) > web.PerPageMaximum {
+		return nil, fmt.Errorf("ids parameter has %d ids, higher than allowed maximum of %d", len(args.IDs), web.PerPageMaximum)
+	}
+
+	for _, id := range args.IDs {
+		if !model.IsValidId(id) {
+			c.SetInvalidParam("ids")
+			return nil, c.Err
+		}
+	}
+
+	postsList, appErr := c.App.GetPostsByIds(args.IDs)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	posts, appErr := filterReadablePosts(c, postsList)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// The channels of the previewed posts are fetched at once to check whether the user can
+	// read them.
+	posts = c.App.PreparePostsForClient(posts)
+	posts, appErr = c.App.SanitizePostsMetadataForUser(posts, c.AppContext.Session().UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	res := make([]*post, 0, len(posts))
+	for _, p := range posts {
+		p.StripActionIntegrations()
+		res = append(res, newGraphQLPost(p))
 	}
 
 	return res, nil
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -16,6 +17,10 @@ import (
 // channelMember is an internal graphQL wrapper struct to add resolver methods.
 type channelMember struct {
 	model.ChannelMember
+
+	// cursor overrides the cursor of the member, for listings that are not sorted by
+	// channel and user.
+	cursor string
 }
 
 // match with api4.getUser
@@ -74,6 +79,10 @@ func (cm *channelMember) Roles_(ctx context.Context) ([]*model.Role, error) {
 }
 
 func (cm *channelMember) Cursor() *string {
+	if cm.cursor != "" {
+		return model.NewString(cm.cursor)
+	}
+
 	cursor := string(channelMemberCursorPrefix) + "-" + cm.ChannelId + "-" + cm.UserId
 	encoded := base64.StdEncoding.EncodeToString([]byte(cursor))
 	return model.NewString(encoded)
@@ -111,3 +120,33 @@ func parseChannelMemberCursor(cursor string) (channelID, userID string, ok bool)
 
 	return parts[1], parts[2], true
 }
+
+// channelMemberPageCursor returns the cursor of the member at offset in the members of the
+// channel, sorted by sort.
+func channelMemberPageCursor(channelID, sort string, offset int) string {
+	cursor := string(channelMemberPageCursorPrefix) + "-" + channelID + "-" + sort + "-" + strconv.Itoa(offset)
+	return base64.StdEncoding.EncodeToString([]byte(cursor))
+}
+
+func parseChannelMemberPageCursor(cursor string) (channelID, sort string, offset int, ok bool) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	parts := strings.Split(string(decoded), "-")
+	if len(parts) != 4 {
+		return "", "", 0, false
+	}
+
+	if cursorPrefix(parts[0]) != channelMemberPageCursorPrefix {
+		return "", "", 0, false
+	}
+
+	offset, err = strconv.Atoi(parts[3])
+	if err != nil || offset < 0 {
+		return "", "", 0, false
+	}
+
+	return parts[1], parts[2], offset, true
+}
@@ -342,6 +342,63 @@ func TestGraphQLChannelMembers(t *testing.T) {
 	})
 }
 
+func TestGraphQLChannelMembersInChannel(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	type members struct {
+		ChannelMembersInChannel []struct {
+			User struct {
+				ID       string `json:"id"`
+				Username string `json:"username"`
+			} `json:"user"`
+			Cursor string `json:"cursor"`
+		} `json:"channelMembersInChannel"`
+	}
+
+	query := func(t *testing.T, first int, after string) members {
+		t.Helper()
+		input := graphQLInput{
+			OperationName: "channelMembersInChannel",
+			Query: `
+	query channelMembersInChannel($channelId: String!, $first: Int, $after: String) {
+	  channelMembersInChannel(channelId: $channelId, sort: "username", first: $first, after: $after) {
+	  	user {
+	  		id
+	  		username
+	  	}
+	  	cursor
+	  }
+	}
+	`,
+			Variables: map[string]interface{}{
+				"channelId": th.BasicChannel.Id,
+				"first":     first,
+				"after":     after,
+			},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		var q members
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		return q
+	}
+
+	all := query(t, 60, "").ChannelMembersInChannel
+	require.Greater(t, len(all), 1)
+	for i := 1; i < len(all); i++ {
+		assert.LessOrEqual(t, all[i-1].User.Username, all[i].User.Username)
+	}
+
+	page := query(t, 1, all[0].Cursor).ChannelMembersInChannel
+	require.Len(t, page, 1)
+	assert.Equal(t, all[1].User.ID, page[0].User.ID)
+}
+
 func TestChannelMemberCursor(t *testing.T) {
 	ch := channelMember{
 		ChannelMember: model.ChannelMember{ChannelId: "testid", UserId: "userid"},
@@ -353,3 +410,20 @@ func TestChannelMemberCursor(t *testing.T) {
 	assert.Equal(t, ch.ChannelId, chId)
 	assert.Equal(t, ch.UserId, userId)
 }
+
+func TestChannelMemberPageCursor(t *testing.T) {
+	ch := channelMember{
+		ChannelMember: model.ChannelMember{ChannelId: "testid", UserId: "userid"},
+		cursor:        channelMemberPageCursor("testid", model.ChannelMembersSortByUsername, 5),
+	}
+	cur := ch.Cursor()
+
+	chId, sort, offset, ok := parseChannelMemberPageCursor(*cur)
+	require.True(t, ok)
+	assert.Equal(t, ch.ChannelId, chId)
+	assert.Equal(t, model.ChannelMembersSortByUsername, sort)
+	assert.Equal(t, 5, offset)
+
+	_, _, ok = parseChannelMemberCursor(*cur)
+	assert.False(t, ok)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// post is an internal graphQL wrapper struct to add resolver methods.
+type post struct {
+	model.Post
+}
+
+// newGraphQLPost wraps a copy of the post, model.Post holding a lock.
+func newGraphQLPost(p *model.Post) *post {
+	res := &post{}
+	// ShallowCopy only fails for a nil destination.
+	p.ShallowCopy(&res.Post)
+	return res
+}
+
+// match with api4.getUser
+func (p *post) User(ctx context.Context) (*user, error) {
+	return getGraphQLUser(ctx, p.UserId)
+}
+
+// PermalinkPreview returns the post previewed by the permalink in the message. The preview has
+// already been removed from the metadata of the post if the user can't read the previewed post.
+func (p *post) PermalinkPreview() *permalinkPreview {
+	if p.Metadata == nil {
+		return nil
+	}
+
+	previewPost := p.GetPreviewPost()
+	if previewPost == nil {
+		return nil
+	}
+
+	return &permalinkPreview{*previewPost}
+}
+
+// permalinkPreview is an internal graphQL wrapper struct to add resolver methods.
+type permalinkPreview struct {
+	model.PreviewPost
+}
+
+func (pp *permalinkPreview) Post_() *post {
+	if pp.PreviewPost.Post == nil {
+		return nil
+	}
+
+	return newGraphQLPost(pp.PreviewPost.Post)
+}
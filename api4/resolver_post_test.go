@@ -0,0 +1,151 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGraphQLPosts(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	siteURL := "http://mattermost.example.com"
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = siteURL
+		*cfg.ServiceSettings.EnablePermalinkPreviews = true
+		cfg.FeatureFlags.PermalinkPreviews = true
+	})
+
+	privateChannel := th.CreatePrivateChannel()
+	publicPost := th.CreateMessagePostWithClient(th.Client, th.BasicChannel, "public post")
+	privatePost := th.CreateMessagePostWithClient(th.Client, privateChannel, "private post")
+
+	permalink := func(p *model.Post) string {
+		return siteURL + "/" + th.BasicTeam.Name + "/pl/" + p.Id
+	}
+	quotingPublic := th.CreateMessagePostWithClient(th.Client, th.BasicChannel, permalink(publicPost))
+	quotingPrivate := th.CreateMessagePostWithClient(th.Client, th.BasicChannel, permalink(privatePost))
+	otherPrivatePost := th.CreateMessagePostWithClient(th.Client, privateChannel, "another private post")
+
+	var q struct {
+		Posts []struct {
+			ID       string  `json:"id"`
+			Message  string  `json:"message"`
+			CreateAt float64 `json:"createAt"`
+			User     struct {
+				ID string `json:"id"`
+			} `json:"user"`
+			PermalinkPreview *struct {
+				PostID             string `json:"postId"`
+				TeamName           string `json:"teamName"`
+				ChannelDisplayName string `json:"channelDisplayName"`
+				Post               struct {
+					ID        string `json:"id"`
+					ChannelID string `json:"channelId"`
+					Message   string `json:"message"`
+				} `json:"post"`
+			} `json:"permalinkPreview"`
+		} `json:"posts"`
+	}
+
+	input := graphQLInput{
+		OperationName: "posts",
+		Query: `
+	query posts($ids: [String!]!) {
+	  posts(ids: $ids) {
+	    id
+	    message
+	    createAt
+	    user {
+	      id
+	    }
+	    permalinkPreview {
+	      postId
+	      teamName
+	      channelDisplayName
+	      post {
+	        id
+	        channelId
+	        message
+	      }
+	    }
+	  }
+	}
+	`,
+		Variables: map[string]interface{}{
+			"ids": []string{quotingPublic.Id, quotingPrivate.Id, otherPrivatePost.Id},
+		},
+	}
+
+	t.Run("member of the previewed channels", func(t *testing.T) {
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Posts, 3)
+
+		byID := make(map[string]int, len(q.Posts))
+		for i, p := range q.Posts {
+			byID[p.ID] = i
+			assert.Equal(t, th.BasicUser.Id, p.User.ID)
+			assert.NotZero(t, p.CreateAt)
+		}
+
+		preview := q.Posts[byID[quotingPublic.Id]].PermalinkPreview
+		require.NotNil(t, preview)
+		assert.Equal(t, publicPost.Id, preview.PostID)
+		assert.Equal(t, th.BasicTeam.Name, preview.TeamName)
+		assert.Equal(t, th.BasicChannel.DisplayName, preview.ChannelDisplayName)
+		assert.Equal(t, "public post", preview.Post.Message)
+
+		preview = q.Posts[byID[quotingPrivate.Id]].PermalinkPreview
+		require.NotNil(t, preview)
+		assert.Equal(t, privateChannel.Id, preview.Post.ChannelID)
+
+		assert.Nil(t, q.Posts[byID[otherPrivatePost.Id]].PermalinkPreview)
+	})
+
+	t.Run("not a member of the private channel", func(t *testing.T) {
+		th.RemoveUserFromChannel(th.BasicUser, privateChannel)
+		defer th.AddUserToChannel(th.BasicUser, privateChannel)
+
+		q.Posts = nil
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		require.Len(t, q.Posts, 2, "the posts of the private channel are left out")
+
+		for _, p := range q.Posts {
+			switch p.ID {
+			case quotingPublic.Id:
+				assert.NotNil(t, p.PermalinkPreview)
+			case quotingPrivate.Id:
+				assert.Nil(t, p.PermalinkPreview, "the preview of a post the user can't read is removed")
+			default:
+				assert.Fail(t, "unexpected post", p.ID)
+			}
+		}
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		resp, err := th.MakeGraphQLRequest(&graphQLInput{
+			OperationName: "posts",
+			Query:         input.Query,
+			Variables:     map[string]interface{}{"ids": []string{"junk"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
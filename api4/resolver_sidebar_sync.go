@@ -0,0 +1,238 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/web"
+)
+
+// sidebarSyncMaxChanges is how many channels, or channel members, a sidebar sync returns at
+// most. Past that, the client is asked to fetch its sidebar again with the paginated queries.
+const sidebarSyncMaxChanges = 1000
+
+// sidebarSync is what changed in the sidebar of a user since the previous sync of the client.
+//
+// The teams, channels and channel members are told apart by their UpdateAt. The team members and
+// the sidebar categories have none, so they are all returned whenever their StateHash changes,
+// along with all the teams of the user.
+type sidebarSync struct {
+	Cursor         string
+	StateHash      string
+	Resync         bool
+	Teams          []*model.Team
+	TeamMembers    []*teamMember
+	Channels       []*channel
+	ChannelsLeft   []string
+	ChannelMembers []*channelMember
+}
+
+// SidebarSync returns what changed in the sidebar of the user since the cursor returned by the
+// previous sync, so that clients don't fetch their whole sidebar on every boot. Without a cursor,
+// the whole sidebar is returned. The changes made while syncing are returned again by the next
+// sync.
+func (*resolver) SidebarSync(ctx context.Context, args struct {
+	UserID    string
+	Cursor    string
+	StateHash string
+}) (*sidebarSync, error) {
+	c, err := getCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.UserID == model.Me {
+		args.UserID = c.AppContext.Session().UserId
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), args.UserID) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return nil, c.Err
+	}
+
+	var since int64
+	if args.Cursor != "" {
+		var ok bool
+		since, ok = parseSidebarSyncCursor(args.Cursor)
+		if !ok {
+			return nil, fmt.Errorf("cursor not in the correct format: %s", args.Cursor)
+		}
+	}
+
+	res := &sidebarSync{
+		Cursor:         sidebarSyncCursor(model.GetMillis()),
+		Teams:          []*model.Team{},
+		TeamMembers:    []*teamMember{},
+		Channels:       []*channel{},
+		ChannelsLeft:   []string{},
+		ChannelMembers: []*channelMember{},
+	}
+
+	if err := syncSidebarTeams(c, args.UserID, since, args.StateHash, res); err != nil {
+		return nil, err
+	}
+
+	if err := syncSidebarChannels(c, args.UserID, since, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// syncSidebarTeams adds the teams changed since the cursor, or all of them along with the team
+// members when the state hash of the client is stale.
+func syncSidebarTeams(c *web.Context, userID string, since int64, stateHash string, res *sidebarSync) error {
+	members, appErr := c.App.GetTeamMembersForUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].TeamId < members[j].TeamId
+	})
+
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	for _, tm := range members {
+		if err := encoder.Encode(tm); err != nil {
+			return err
+		}
+		if tm.DeleteAt != 0 {
+			continue
+		}
+
+		categories, appErr := c.App.GetSidebarCategories(userID, tm.TeamId)
+		if appErr != nil {
+			return appErr
+		}
+		if err := encoder.Encode(categories); err != nil {
+			return err
+		}
+	}
+	res.StateHash = hex.EncodeToString(hash.Sum(nil))
+	stale := res.StateHash != stateHash
+
+	teams, appErr := c.App.GetTeamsForUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	for _, team := range c.App.SanitizeTeams(*c.AppContext.Session(), teams) {
+		if stale || team.UpdateAt >= since {
+			res.Teams = append(res.Teams, team)
+		}
+	}
+
+	if stale {
+		for _, tm := range members {
+			res.TeamMembers = append(res.TeamMembers, &teamMember{*tm})
+		}
+	}
+
+	return nil
+}
+
+// syncSidebarChannels adds the channels and the channel members changed since the cursor, along
+// with the channels the user left. The channels the user joined are returned whether they
+// changed or not.
+func syncSidebarChannels(c *web.Context, userID string, since int64, res *sidebarSync) error {
+	channels, appErr := c.App.GetChannelsForTeamForUserWithCursor("", userID, &model.ChannelSearchOpts{
+		IncludeDeleted: since > 0,
+		LastDeleteAt:   int(since),
+		LastUpdateAt:   int(since),
+		PerPage:        model.NewInt(sidebarSyncMaxChanges + 1),
+	}, "")
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return appErr
+	}
+
+	members, err := c.App.Srv().Store.Channel().GetMembersForUserWithCursor(userID, "", "", sidebarSyncMaxChanges+1, int(since))
+	if err != nil {
+		return err
+	}
+
+	if len(channels) > sidebarSyncMaxChanges || len(members) > sidebarSyncMaxChanges {
+		res.Resync = true
+		return nil
+	}
+
+	changed := make(map[string]bool, len(channels))
+	for _, ch := range channels {
+		changed[ch.Id] = true
+	}
+	var joinedIDs []string
+	for _, cm := range members {
+		if !changed[cm.ChannelId] {
+			joinedIDs = append(joinedIDs, cm.ChannelId)
+		}
+	}
+	if len(joinedIDs) > 0 {
+		joined, err := c.App.Srv().Store.Channel().GetChannelsByIds(joinedIDs, false)
+		if err != nil {
+			return err
+		}
+		channels = append(channels, joined...)
+	}
+
+	if appErr := c.App.FillInChannelsProps(channels); appErr != nil {
+		return appErr
+	}
+
+	res.Channels, err = postProcessChannels(c, channels)
+	if err != nil {
+		return err
+	}
+
+	for _, cm := range members {
+		res.ChannelMembers = append(res.ChannelMembers, &channelMember{ChannelMember: cm})
+	}
+
+	if since > 0 {
+		left, err := c.App.Srv().Store.ChannelMemberHistory().GetChannelsLeftSince(userID, since)
+		if err != nil {
+			return err
+		}
+		res.ChannelsLeft = append(res.ChannelsLeft, left...)
+	}
+
+	return nil
+}
+
+func sidebarSyncCursor(syncedAt int64) string {
+	cursor := string(sidebarSyncCursorPrefix) + "-" + strconv.FormatInt(syncedAt, 10)
+	return base64.StdEncoding.EncodeToString([]byte(cursor))
+}
+
+func parseSidebarSyncCursor(cursor string) (syncedAt int64, ok bool) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+
+	parts := strings.Split(string(decoded), "-")
+	if len(parts) != 2 {
+		return 0, false
+	}
+
+	if cursorPrefix(parts[0]) != sidebarSyncCursorPrefix {
+		return 0, false
+	}
+
+	syncedAt, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || syncedAt <= 0 {
+		return 0, false
+	}
+
+	return syncedAt, true
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGraphQLSidebarSync(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_GRAPHQL", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_GRAPHQL")
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	type sidebarSyncResp struct {
+		SidebarSync struct {
+			Cursor    string `json:"cursor"`
+			StateHash string `json:"stateHash"`
+			Resync    bool   `json:"resync"`
+			Teams     []struct {
+				ID string `json:"id"`
+			} `json:"teams"`
+			TeamMembers []struct {
+				Team struct {
+					ID string `json:"id"`
+				} `json:"team"`
+				SidebarCategories []struct {
+					ID         string   `json:"id"`
+					ChannelIDs []string `json:"channelIds"`
+				} `json:"sidebarCategories"`
+			} `json:"teamMembers"`
+			Channels []struct {
+				ID string `json:"id"`
+			} `json:"channels"`
+			ChannelsLeft   []string `json:"channelsLeft"`
+			ChannelMembers []struct {
+				Channel struct {
+					ID string `json:"id"`
+				} `json:"channel"`
+			} `json:"channelMembers"`
+		} `json:"sidebarSync"`
+	}
+
+	sync := func(t *testing.T, cursor, stateHash string) sidebarSyncResp {
+		t.Helper()
+
+		input := graphQLInput{
+			OperationName: "sidebarSync",
+			Query: `
+	query sidebarSync($cursor: String = "", $stateHash: String = "") {
+	  sidebarSync(userId: "me", cursor: $cursor, stateHash: $stateHash) {
+	    cursor
+	    stateHash
+	    resync
+	    teams {
+	      id
+	    }
+	    teamMembers {
+	      team {
+	        id
+	      }
+	      sidebarCategories {
+	        id
+	        channelIds
+	      }
+	    }
+	    channels {
+	      id
+	    }
+	    channelsLeft
+	    channelMembers {
+	      channel {
+	        id
+	      }
+	    }
+	  }
+	}
+	`,
+			Variables: map[string]interface{}{
+				"cursor":    cursor,
+				"stateHash": stateHash,
+			},
+		}
+
+		resp, err := th.MakeGraphQLRequest(&input)
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 0)
+
+		var q sidebarSyncResp
+		require.NoError(t, json.Unmarshal(resp.Data, &q))
+		return q
+	}
+
+	channelIDs := func(q sidebarSyncResp) []string {
+		ids := make([]string, 0, len(q.SidebarSync.Channels))
+		for _, ch := range q.SidebarSync.Channels {
+			ids = append(ids, ch.ID)
+		}
+		return ids
+	}
+
+	first := sync(t, "", "")
+	require.NotEmpty(t, first.SidebarSync.Cursor)
+	require.NotEmpty(t, first.SidebarSync.StateHash)
+	assert.False(t, first.SidebarSync.Resync)
+	require.Len(t, first.SidebarSync.Teams, 1)
+	assert.Equal(t, th.BasicTeam.Id, first.SidebarSync.Teams[0].ID)
+	require.Len(t, first.SidebarSync.TeamMembers, 1)
+	assert.NotEmpty(t, first.SidebarSync.TeamMembers[0].SidebarCategories)
+	assert.Contains(t, channelIDs(first), th.BasicChannel.Id)
+	assert.Len(t, first.SidebarSync.ChannelMembers, len(first.SidebarSync.Channels))
+	assert.Empty(t, first.SidebarSync.ChannelsLeft)
+
+	t.Run("nothing changed", func(t *testing.T) {
+		q := sync(t, first.SidebarSync.Cursor, first.SidebarSync.StateHash)
+		assert.Equal(t, first.SidebarSync.StateHash, q.SidebarSync.StateHash)
+		assert.Empty(t, q.SidebarSync.TeamMembers)
+		assert.NotContains(t, channelIDs(q), th.BasicChannel.Id)
+	})
+
+	t.Run("stale state hash", func(t *testing.T) {
+		q := sync(t, first.SidebarSync.Cursor, "stale")
+		assert.Len(t, q.SidebarSync.Teams, 1)
+		assert.Len(t, q.SidebarSync.TeamMembers, 1)
+	})
+
+	t.Run("joined and left channels", func(t *testing.T) {
+		joined := th.CreatePublicChannel()
+		_, appErr := th.App.AddUserToChannel(th.BasicUser, joined, false)
+		require.Nil(t, appErr)
+
+		_, err := th.Client.RemoveUserFromChannel(th.BasicChannel2.Id, th.BasicUser.Id)
+		require.NoError(t, err)
+
+		q := sync(t, first.SidebarSync.Cursor, first.SidebarSync.StateHash)
+		assert.Contains(t, channelIDs(q), joined.Id)
+		assert.Contains(t, q.SidebarSync.ChannelsLeft, th.BasicChannel2.Id)
+		// The channel joined is added to the categories.
+		assert.NotEqual(t, first.SidebarSync.StateHash, q.SidebarSync.StateHash)
+		assert.Len(t, q.SidebarSync.TeamMembers, 1)
+
+		memberChannelIDs := make([]string, 0, len(q.SidebarSync.ChannelMembers))
+		for _, cm := range q.SidebarSync.ChannelMembers {
+			memberChannelIDs = append(memberChannelIDs, cm.Channel.ID)
+		}
+		assert.Contains(t, memberChannelIDs, joined.Id)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		resp, err := th.MakeGraphQLRequest(&graphQLInput{
+			OperationName: "sidebarSync",
+			Query: `
+	query sidebarSync {
+	  sidebarSync(userId: "me", cursor: "invalid") {
+	    cursor
+	  }
+	}
+	`,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		resp, err := th.MakeGraphQLRequest(&graphQLInput{
+			OperationName: "sidebarSync",
+			Query: `
+	query sidebarSync($userId: String!) {
+	  sidebarSync(userId: $userId) {
+	    cursor
+	  }
+	}
+	`,
+			Variables: map[string]interface{}{"userId": th.BasicUser2.Id},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+	})
+}
+
+func TestSidebarSyncCursor(t *testing.T) {
+	syncedAt := model.GetMillis()
+	parsed, ok := parseSidebarSyncCursor(sidebarSyncCursor(syncedAt))
+	require.True(t, ok)
+	assert.Equal(t, syncedAt, parsed)
+
+	_, ok = parseSidebarSyncCursor("invalid")
+	assert.False(t, ok)
+
+	_, ok = parseSidebarSyncCursor(base64.StdEncoding.EncodeToString([]byte("channel-1234")))
+	assert.False(t, ok)
+
+	_, ok = parseSidebarSyncCursor(base64.StdEncoding.EncodeToString([]byte("sidebarSync-abc")))
+	assert.False(t, ok)
+}
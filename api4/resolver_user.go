@@ -105,7 +105,12 @@ func (u *user) Status(ctx context.Context) (*model.Status, error) {
 		return nil, err
 	}
 
-	statuses, appErr := c.App.GetUserStatusesByIds([]string{u.Id})
+	userIDs, appErr := c.App.FilterUserIDsForIsolation(c.AppContext.Session().UserId, []string{u.Id})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	statuses, appErr := c.App.GetUserStatusesByIds(userIDs)
 	if appErr != nil {
 		return nil, appErr
 	}
@@ -0,0 +1,35 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitRESTProxyBridge() {
+	api.BaseRoutes.APIRoot.Handle("/rest_proxy_bridge/status", api.APISessionRequired(getRESTProxyBridgeStatus)).Methods("GET")
+}
+
+// getRESTProxyBridgeStatus returns the offset the REST proxy bridge published its outbox up to, and
+// whether it's stuck on a message the message queue didn't take.
+func getRESTProxyBridgeStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadIntegrationsIntegrationManagement) {
+		c.SetPermissionError(model.PermissionSysconsoleReadIntegrationsIntegrationManagement)
+		return
+	}
+
+	status, err := c.App.GetRESTProxyBridgeStatus()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
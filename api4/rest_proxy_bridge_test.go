@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetRESTProxyBridgeStatus(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, resp, err := th.Client.GetRESTProxyBridgeStatus()
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.RESTProxyBridgeSettings.Enable = true
+		*cfg.RESTProxyBridgeSettings.Driver = model.RESTProxyBridgeDriverRabbitMQ
+		*cfg.RESTProxyBridgeSettings.URL = "http://localhost:15672"
+	})
+
+	status, _, err := th.SystemAdminClient.GetRESTProxyBridgeStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, model.RESTProxyBridgeDriverRabbitMQ, status.Driver)
+}
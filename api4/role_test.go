@@ -318,3 +318,55 @@ func TestPatchRole(t *testing.T) {
 		})
 	})
 }
+
+func TestSystemViewerRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense())
+
+	t.Run("roles can be read and assigned", func(t *testing.T) {
+		for _, roleName := range []string{model.SystemBillingViewerRoleId, model.SystemComplianceViewerRoleId} {
+			role, _, err := th.SystemAdminClient.GetRoleByName(roleName)
+			require.NoError(t, err)
+			assert.True(t, role.BuiltIn)
+			assert.False(t, role.SchemeManaged)
+		}
+
+		_, err := th.SystemAdminClient.UpdateUserRoles(th.BasicUser.Id, model.SystemUserRoleId+" "+model.SystemComplianceViewerRoleId)
+		require.NoError(t, err)
+	})
+
+	t.Run("compliance viewer reads audits but cannot edit users", func(t *testing.T) {
+		th.LoginBasic()
+
+		_, _, err := th.Client.GetAudits(0, 10, "")
+		require.NoError(t, err)
+
+		_, _, err = th.Client.GetUserAudits(th.BasicUser2.Id, 0, 10, "")
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.PatchUser(th.BasicUser2.Id, &model.UserPatch{Nickname: model.NewString("viewer")})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetConfig()
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("only system admins patch the viewer roles", func(t *testing.T) {
+		role, _, err := th.SystemAdminClient.GetRoleByName(model.SystemBillingViewerRoleId)
+		require.NoError(t, err)
+
+		patch := &model.RolePatch{Permissions: &[]string{model.PermissionSysconsoleReadBilling.Id}}
+
+		_, resp, err := th.SystemManagerClient.PatchRole(role.Id, patch)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		patched, _, err := th.SystemAdminClient.PatchRole(role.Id, patch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{model.PermissionSysconsoleReadBilling.Id}, patched.Permissions)
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitScheduledChannelMessage() {
+	api.BaseRoutes.Channel.Handle("/scheduled_messages", api.APISessionRequired(getScheduledChannelMessages)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/scheduled_messages", api.APISessionRequired(createScheduledChannelMessage)).Methods("POST")
+	api.BaseRoutes.Channel.Handle("/scheduled_messages/{scheduled_message_id:[A-Za-z0-9]+}", api.APISessionRequired(getScheduledChannelMessage)).Methods("GET")
+	api.BaseRoutes.Channel.Handle("/scheduled_messages/{scheduled_message_id:[A-Za-z0-9]+}", api.APISessionRequired(updateScheduledChannelMessage)).Methods("PUT")
+	api.BaseRoutes.Channel.Handle("/scheduled_messages/{scheduled_message_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteScheduledChannelMessage)).Methods("DELETE")
+}
+
+func getScheduledChannelMessages(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	messages, err := c.App.GetScheduledChannelMessagesForChannel(c.Params.ChannelId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(messages); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createScheduledChannelMessage(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var message model.ScheduledChannelMessage
+	if jsonErr := json.NewDecoder(r.Body).Decode(&message); jsonErr != nil {
+		c.SetInvalidParam("scheduled_message")
+		return
+	}
+	message.Id = ""
+	message.ChannelId = c.Params.ChannelId
+	message.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createScheduledChannelMessage", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelScheduledMessages) {
+		c.SetPermissionError(model.PermissionManageChannelScheduledMessages)
+		return
+	}
+
+	saved, err := c.App.CreateScheduledChannelMessage(&message)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("scheduled_message_id", saved.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getScheduledChannelMessage(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireScheduledMessageId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	message := getScheduledChannelMessageInChannel(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(message); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// updateScheduledChannelMessage changes the message and schedule of a scheduled message. The
+// message is posted as whoever changed it last from then on.
+func updateScheduledChannelMessage(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireScheduledMessageId()
+	if c.Err != nil {
+		return
+	}
+
+	var update model.ScheduledChannelMessage
+	if jsonErr := json.NewDecoder(r.Body).Decode(&update); jsonErr != nil {
+		c.SetInvalidParam("scheduled_message")
+		return
+	}
+	if update.Id != c.Params.ScheduledMessageId {
+		c.SetInvalidParam("id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateScheduledChannelMessage", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("scheduled_message_id", c.Params.ScheduledMessageId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelScheduledMessages) {
+		c.SetPermissionError(model.PermissionManageChannelScheduledMessages)
+		return
+	}
+
+	message := getScheduledChannelMessageInChannel(c)
+	if c.Err != nil {
+		return
+	}
+
+	message.CreatorId = c.AppContext.Session().UserId
+	message.Message = update.Message
+	message.Frequency = update.Frequency
+	message.Weekday = update.Weekday
+	message.DayOfMonth = update.DayOfMonth
+	message.TimeOfDay = update.TimeOfDay
+	message.Timezone = update.Timezone
+	message.SkipWeekends = update.SkipWeekends
+	message.SkipDates = update.SkipDates
+
+	updated, err := c.App.UpdateScheduledChannelMessage(message)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteScheduledChannelMessage(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId().RequireScheduledMessageId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteScheduledChannelMessage", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("channel_id", c.Params.ChannelId)
+	auditRec.AddMeta("scheduled_message_id", c.Params.ScheduledMessageId)
+
+	if !c.App.SessionHasPermissionToChannel(*c.AppContext.Session(), c.Params.ChannelId, model.PermissionManageChannelScheduledMessages) {
+		c.SetPermissionError(model.PermissionManageChannelScheduledMessages)
+		return
+	}
+
+	message := getScheduledChannelMessageInChannel(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteScheduledChannelMessage(message.Id); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+// getScheduledChannelMessageInChannel returns the scheduled message of the request, or sets a
+// not found error when it belongs to another channel than the one of the request.
+func getScheduledChannelMessageInChannel(c *Context) *model.ScheduledChannelMessage {
+	message, err := c.App.GetScheduledChannelMessage(c.Params.ScheduledMessageId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if message.ChannelId != c.Params.ChannelId {
+		c.Err = model.NewAppError("getScheduledChannelMessageInChannel", "app.scheduled_channel_message.get.not_found.app_error", nil, "id="+message.Id, http.StatusNotFound)
+		return nil
+	}
+
+	return message
+}
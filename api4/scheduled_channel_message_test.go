@@ -0,0 +1,136 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestScheduledChannelMessages(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.BasicChannel
+	th.MakeUserChannelAdmin(th.BasicUser, channel)
+
+	newMessage := func() *model.ScheduledChannelMessage {
+		return &model.ScheduledChannelMessage{
+			Message:      "Standup in 10 minutes!",
+			Frequency:    model.ScheduledChannelMessageFrequencyWeekly,
+			Weekday:      int(time.Monday),
+			TimeOfDay:    "09:50",
+			Timezone:     "Europe/Paris",
+			SkipWeekends: true,
+			SkipDates:    model.StringArray{"12-25"},
+		}
+	}
+
+	t.Run("requires permission", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, _, err := client.GetScheduledChannelMessages(channel.Id)
+		require.NoError(t, err)
+
+		_, resp, err := client.CreateScheduledChannelMessage(channel.Id, newMessage())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetScheduledChannelMessages(th.BasicPrivateChannel2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid schedules", func(t *testing.T) {
+		invalid := newMessage()
+		invalid.TimeOfDay = "9am"
+		_, resp, err := th.Client.CreateScheduledChannelMessage(channel.Id, invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		// Every run falls on a skipped weekend.
+		invalid = newMessage()
+		invalid.Weekday = int(time.Saturday)
+		_, resp, err = th.Client.CreateScheduledChannelMessage(channel.Id, invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		message, resp, err := th.Client.CreateScheduledChannelMessage(channel.Id, newMessage())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, channel.Id, message.ChannelId)
+		assert.Equal(t, th.BasicUser.Id, message.CreatorId)
+		assert.Equal(t, message.NextRunAfter(message.CreateAt), message.NextRunAt)
+
+		messages, _, err := th.Client.GetScheduledChannelMessages(channel.Id)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, message.Id, messages[0].Id)
+
+		message.Frequency = model.ScheduledChannelMessageFrequencyDaily
+		message.TimeOfDay = "08:00"
+		updated, _, err := th.SystemAdminClient.UpdateScheduledChannelMessage(message)
+		require.NoError(t, err)
+		assert.Equal(t, model.ScheduledChannelMessageFrequencyDaily, updated.Frequency)
+		assert.Equal(t, th.SystemAdminUser.Id, updated.CreatorId)
+		assert.Equal(t, updated.NextRunAfter(updated.UpdateAt), updated.NextRunAt)
+
+		_, resp, err = th.Client.GetScheduledChannelMessage(th.BasicChannel2.Id, message.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, err = th.Client.DeleteScheduledChannelMessage(channel.Id, message.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetScheduledChannelMessage(channel.Id, message.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("due messages are posted once", func(t *testing.T) {
+		message, _, err := th.Client.CreateScheduledChannelMessage(channel.Id, newMessage())
+		require.NoError(t, err)
+
+		dueAt := model.GetMillis() - 1000
+		_, err = th.App.Srv().Store.ScheduledChannelMessage().UpdateRun(message.Id, message.NextRunAt, 0, dueAt)
+		require.NoError(t, err)
+
+		require.Nil(t, th.App.SendDueScheduledChannelMessages())
+		require.Nil(t, th.App.SendDueScheduledChannelMessages())
+
+		posts, _, err := th.Client.GetPostsSince(channel.Id, dueAt, false)
+		require.NoError(t, err)
+		var sent []*model.Post
+		for _, post := range posts.Posts {
+			if post.GetProp(model.PostPropsScheduledChannelMessageId) == message.Id {
+				sent = append(sent, post)
+			}
+		}
+		require.Len(t, sent, 1)
+		assert.Equal(t, th.BasicUser.Id, sent[0].UserId)
+		assert.Equal(t, "Standup in 10 minutes!", sent[0].Message)
+
+		ran, _, err := th.Client.GetScheduledChannelMessage(channel.Id, message.Id)
+		require.NoError(t, err)
+		assert.NotZero(t, ran.LastRunAt)
+		assert.Equal(t, message.NextRunAfter(ran.LastRunAt), ran.NextRunAt)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledChannelMessages = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableScheduledChannelMessages = true })
+
+		_, resp, err := th.Client.CreateScheduledChannelMessage(channel.Id, newMessage())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
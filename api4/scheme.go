@@ -20,6 +20,9 @@ func (api *API) InitScheme() {
 	api.BaseRoutes.Schemes.Handle("/{scheme_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchScheme)).Methods("PUT")
 	api.BaseRoutes.Schemes.Handle("/{scheme_id:[A-Za-z0-9]+}/teams", api.APISessionRequiredTrustRequester(getTeamsForScheme)).Methods("GET")
 	api.BaseRoutes.Schemes.Handle("/{scheme_id:[A-Za-z0-9]+}/channels", api.APISessionRequiredTrustRequester(getChannelsForScheme)).Methods("GET")
+	api.BaseRoutes.Schemes.Handle("/{scheme_id:[A-Za-z0-9]+}/assign", api.APISessionRequired(assignScheme)).Methods("POST")
+	api.BaseRoutes.Schemes.Handle("/{scheme_id:[A-Za-z0-9]+}/export", api.APISessionRequired(exportScheme)).Methods("GET")
+	api.BaseRoutes.Schemes.Handle("/import", api.APISessionRequired(importScheme)).Methods("POST")
 }
 
 func createScheme(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -252,3 +255,143 @@ func deleteScheme(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	ReturnStatusOK(w)
 }
+
+func assignScheme(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireSchemeId()
+	if c.Err != nil {
+		return
+	}
+
+	var assignment model.SchemeAssignment
+	if jsonErr := json.NewDecoder(r.Body).Decode(&assignment); jsonErr != nil || !assignment.IsValid() {
+		c.SetInvalidParam("assignment")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("assignScheme", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("scheme_id", c.Params.SchemeId)
+	auditRec.AddMeta("scope", assignment.Scope())
+	auditRec.AddMeta("count", len(assignment.Ids()))
+
+	if c.App.Channels().License() == nil {
+		c.Err = model.NewAppError("Api4.AssignScheme", "api.scheme.assign_scheme.license.error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	// Mirror the permissions required to change the scheme of a single team or channel.
+	permission := model.PermissionManageSystem
+	if assignment.Scope() == model.SchemeScopeTeam {
+		permission = model.PermissionSysconsoleWriteUserManagementPermissions
+	}
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), permission) {
+		c.SetPermissionError(permission)
+		return
+	}
+
+	scheme, err := c.App.GetScheme(c.Params.SchemeId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if scheme.Scope != assignment.Scope() {
+		c.Err = model.NewAppError("Api4.AssignScheme", "api.scheme.assign_scheme.scope.error", nil, "", http.StatusBadRequest)
+		return
+	}
+
+	job, err := c.App.AssignScheme(scheme, &assignment)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if job == nil {
+		ReturnStatusOK(w)
+		return
+	}
+
+	auditRec.AddMeta("job_id", job.Id)
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(job); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func exportScheme(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireSchemeId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementPermissions)
+		return
+	}
+
+	scheme, err := c.App.GetScheme(c.Params.SchemeId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	conveyor, err := c.App.ExportScheme(scheme)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Content-Disposition", "attachment;filename=\""+scheme.Name+".json\"")
+	if err := json.NewEncoder(w).Encode(conveyor); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func importScheme(c *Context, w http.ResponseWriter, r *http.Request) {
+	onConflict := r.URL.Query().Get("on_conflict")
+	switch onConflict {
+	case "":
+		onConflict = model.SchemeImportOnConflictFail
+	case model.SchemeImportOnConflictFail, model.SchemeImportOnConflictRename, model.SchemeImportOnConflictOverwrite:
+	default:
+		c.SetInvalidParam("on_conflict")
+		return
+	}
+
+	var conveyor model.SchemeConveyor
+	if jsonErr := json.NewDecoder(r.Body).Decode(&conveyor); jsonErr != nil {
+		c.SetInvalidParam("scheme")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("importScheme", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("scheme_name", conveyor.Name)
+	auditRec.AddMeta("on_conflict", onConflict)
+
+	if c.App.Channels().License() == nil || !*c.App.Channels().License().Features.CustomPermissionsSchemes {
+		c.Err = model.NewAppError("Api4.ImportScheme", "api.scheme.import_scheme.license.error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementPermissions)
+		return
+	}
+
+	scheme, err := c.App.ImportScheme(&conveyor, onConflict)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("scheme", scheme)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(scheme); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -5,6 +5,7 @@ package api4
 
 import (
 	"context"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -808,3 +809,217 @@ func TestUpdateTeamSchemeWithTeamMembers(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestAssignScheme(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense("custom_permissions_schemes"))
+	th.App.SetPhase2PermissionsMigrationStatus(true)
+
+	teamScheme := th.SetupTeamScheme()
+	channelScheme := th.SetupChannelScheme()
+
+	team1 := th.CreateTeam()
+	team2 := th.CreateTeam()
+
+	t.Run("assign teams", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: []string{team1.Id, team2.Id}})
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		assert.Nil(t, job)
+
+		for _, teamID := range []string{team1.Id, team2.Id} {
+			team, appErr := th.App.GetTeam(teamID)
+			require.Nil(t, appErr)
+			require.NotNil(t, team.SchemeId)
+			assert.Equal(t, teamScheme.Id, *team.SchemeId)
+		}
+	})
+
+	t.Run("assign channels", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.AssignScheme(channelScheme.Id, &model.SchemeAssignment{ChannelIds: []string{th.BasicChannel.Id, th.BasicPrivateChannel.Id}})
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		assert.Nil(t, job)
+
+		for _, channelID := range []string{th.BasicChannel.Id, th.BasicPrivateChannel.Id} {
+			channel, appErr := th.App.GetChannel(channelID)
+			require.Nil(t, appErr)
+			require.NotNil(t, channel.SchemeId)
+			assert.Equal(t, channelScheme.Id, *channel.SchemeId)
+		}
+	})
+
+	t.Run("unknown team rolls back", func(t *testing.T) {
+		team3 := th.CreateTeam()
+		_, resp, err := th.SystemAdminClient.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: []string{team3.Id, model.NewId()}})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		team, appErr := th.App.GetTeam(team3.Id)
+		require.Nil(t, appErr)
+		assert.True(t, team.SchemeId == nil || *team.SchemeId == "")
+	})
+
+	t.Run("scope mismatch", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.AssignScheme(channelScheme.Id, &model.SchemeAssignment{TeamIds: []string{team1.Id}})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid assignment", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: []string{team1.Id}, ChannelIds: []string{th.BasicChannel.Id}})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("large assignment runs as a job", func(t *testing.T) {
+		teamIDs := make([]string, model.SchemeAssignmentJobThreshold+1)
+		for i := range teamIDs {
+			teamIDs[i] = model.NewId()
+		}
+
+		job, resp, err := th.SystemAdminClient.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: teamIDs})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		require.NotNil(t, job)
+		assert.Equal(t, model.JobTypeSchemeAssignment, job.Type)
+		assert.Equal(t, teamScheme.Id, job.Data["scheme_id"])
+	})
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: []string{team1.Id}})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("requires license", func(t *testing.T) {
+		th.App.Srv().SetLicense(nil)
+		defer th.App.Srv().SetLicense(model.NewTestLicense("custom_permissions_schemes"))
+
+		_, resp, err := th.SystemAdminClient.AssignScheme(teamScheme.Id, &model.SchemeAssignment{TeamIds: []string{team1.Id}})
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
+
+func TestExportImportScheme(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense("custom_permissions_schemes"))
+	th.App.SetPhase2PermissionsMigrationStatus(true)
+
+	scheme := th.SetupTeamScheme()
+	teamUserRole, appErr := th.App.GetRoleByName(context.Background(), scheme.DefaultTeamUserRole)
+	require.Nil(t, appErr)
+	_, appErr = th.App.PatchRole(teamUserRole, &model.RolePatch{Permissions: &[]string{model.PermissionViewTeam.Id, model.PermissionCreatePublicChannel.Id}})
+	require.Nil(t, appErr)
+
+	t.Run("export requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.ExportScheme(scheme.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	conveyor, _, err := th.SystemAdminClient.ExportScheme(scheme.Id)
+	require.NoError(t, err)
+	assert.Equal(t, scheme.Name, conveyor.Name)
+	assert.Equal(t, scheme.DefaultTeamUserRole, conveyor.TeamUser)
+	require.NotNil(t, conveyor.Role(scheme.DefaultTeamUserRole))
+	assert.ElementsMatch(t, []string{model.PermissionViewTeam.Id, model.PermissionCreatePublicChannel.Id}, conveyor.Role(scheme.DefaultTeamUserRole).Permissions)
+	assert.Empty(t, conveyor.Role(scheme.DefaultTeamUserRole).Id)
+
+	t.Run("import requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.ImportScheme(conveyor, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("name collision fails by default", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.ImportScheme(conveyor, "")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		imported, resp, err := th.SystemAdminClient.ImportScheme(conveyor, model.SchemeImportOnConflictRename)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, scheme.Name+"_2", imported.Name)
+		assert.NotEqual(t, scheme.DefaultTeamUserRole, imported.DefaultTeamUserRole)
+
+		role, appErr := th.App.GetRoleByName(context.Background(), imported.DefaultTeamUserRole)
+		require.Nil(t, appErr)
+		assert.ElementsMatch(t, []string{model.PermissionViewTeam.Id, model.PermissionCreatePublicChannel.Id}, role.Permissions)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		overwriting := *conveyor
+		overwriting.DisplayName = "Promoted"
+		overwriting.Roles = make([]*model.Role, 0, len(conveyor.Roles))
+		for _, role := range conveyor.Roles {
+			copied := *role
+			if role.Name == conveyor.TeamUser {
+				copied.Permissions = []string{model.PermissionViewTeam.Id}
+			}
+			overwriting.Roles = append(overwriting.Roles, &copied)
+		}
+
+		imported, _, err := th.SystemAdminClient.ImportScheme(&overwriting, model.SchemeImportOnConflictOverwrite)
+		require.NoError(t, err)
+		assert.Equal(t, scheme.Id, imported.Id)
+		assert.Equal(t, "Promoted", imported.DisplayName)
+
+		role, appErr := th.App.GetRoleByName(context.Background(), scheme.DefaultTeamUserRole)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{model.PermissionViewTeam.Id}, role.Permissions)
+	})
+
+	t.Run("new scheme", func(t *testing.T) {
+		fresh := *conveyor
+		fresh.Name = "promoted_" + model.NewId()
+		imported, resp, err := th.SystemAdminClient.ImportScheme(&fresh, "")
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, fresh.Name, imported.Name)
+		assert.Equal(t, model.SchemeScopeTeam, imported.Scope)
+	})
+
+	t.Run("invalid documents", func(t *testing.T) {
+		missingRole := *conveyor
+		missingRole.Name = "missing_" + model.NewId()
+		missingRole.Roles = conveyor.Roles[1:]
+		_, resp, err := th.SystemAdminClient.ImportScheme(&missingRole, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		unknownPermission := *conveyor
+		unknownPermission.Name = "unknown_" + model.NewId()
+		unknownPermission.Roles = []*model.Role{}
+		for _, role := range conveyor.Roles {
+			copied := *role
+			copied.Permissions = []string{"not_a_permission"}
+			unknownPermission.Roles = append(unknownPermission.Roles, &copied)
+		}
+		_, resp, err = th.SystemAdminClient.ImportScheme(&unknownPermission, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.ImportScheme(conveyor, "merge")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("import requires license", func(t *testing.T) {
+		th.App.Srv().SetLicense(nil)
+		defer th.App.Srv().SetLicense(model.NewTestLicense("custom_permissions_schemes"))
+
+		fresh := *conveyor
+		fresh.Name = "unlicensed_" + model.NewId()
+		_, resp, err := th.SystemAdminClient.ImportScheme(&fresh, "")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
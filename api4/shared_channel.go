@@ -7,10 +7,16 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func (api *API) InitSharedChannels() {
+	// The invite routes must be registered first, as /invites would otherwise be taken for a team id.
+	api.BaseRoutes.SharedChannels.Handle("/invites", api.APISessionRequired(getSharedChannelInvites)).Methods("GET")
+	api.BaseRoutes.SharedChannels.Handle("/invites/{invite_id:[A-Za-z0-9]+}", api.APISessionRequired(getSharedChannelInvitePreview)).Methods("GET")
+	api.BaseRoutes.SharedChannels.Handle("/invites/{invite_id:[A-Za-z0-9]+}/approve", api.APISessionRequired(approveSharedChannelInvite)).Methods("POST")
+	api.BaseRoutes.SharedChannels.Handle("/invites/{invite_id:[A-Za-z0-9]+}/deny", api.APISessionRequired(denySharedChannelInvite)).Methods("POST")
 	api.BaseRoutes.SharedChannels.Handle("/{team_id:[A-Za-z0-9]+}", api.APISessionRequired(getSharedChannels)).Methods("GET")
 	api.BaseRoutes.SharedChannels.Handle("/remote_info/{remote_id:[A-Za-z0-9]+}", api.APISessionRequired(getRemoteClusterInfo)).Methods("GET")
 }
@@ -85,3 +91,120 @@ func getRemoteClusterInfo(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write(b)
 }
+
+func getSharedChannelInvites(c *Context, w http.ResponseWriter, r *http.Request) {
+	// make sure remote cluster service is enabled.
+	if _, appErr := c.App.GetRemoteClusterService(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSharedChannels) {
+		c.SetPermissionError(model.PermissionManageSharedChannels)
+		return
+	}
+
+	opts := model.SharedChannelInviteFilterOpts{
+		RemoteId: r.URL.Query().Get("remote_id"),
+		Status:   r.URL.Query().Get("status"),
+	}
+	if opts.RemoteId != "" && !model.IsValidId(opts.RemoteId) {
+		c.SetInvalidURLParam("remote_id")
+		return
+	}
+	if opts.Status != "" && !model.IsValidSharedChannelInviteStatus(opts.Status) {
+		c.SetInvalidURLParam("status")
+		return
+	}
+
+	invites, appErr := c.App.GetSharedChannelInvites(opts, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	b, err := json.Marshal(invites)
+	if err != nil {
+		c.SetJSONEncodingError()
+		return
+	}
+	w.Write(b)
+}
+
+func getSharedChannelInvitePreview(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireInviteId()
+	if c.Err != nil {
+		return
+	}
+
+	// make sure remote cluster service is enabled.
+	if _, appErr := c.App.GetRemoteClusterService(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSharedChannels) {
+		c.SetPermissionError(model.PermissionManageSharedChannels)
+		return
+	}
+
+	preview, appErr := c.App.GetSharedChannelInvitePreview(c.Params.InviteId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	b, err := json.Marshal(preview)
+	if err != nil {
+		c.SetJSONEncodingError()
+		return
+	}
+	w.Write(b)
+}
+
+func approveSharedChannelInvite(c *Context, w http.ResponseWriter, r *http.Request) {
+	decideSharedChannelInvite(c, w, "approveSharedChannelInvite", c.App.ApproveSharedChannelInvite)
+}
+
+func denySharedChannelInvite(c *Context, w http.ResponseWriter, r *http.Request) {
+	decideSharedChannelInvite(c, w, "denySharedChannelInvite", c.App.DenySharedChannelInvite)
+}
+
+func decideSharedChannelInvite(c *Context, w http.ResponseWriter, event string, decide func(inviteID, userID string) (*model.SharedChannelInvite, *model.AppError)) {
+	c.RequireInviteId()
+	if c.Err != nil {
+		return
+	}
+
+	// make sure remote cluster service is enabled.
+	if _, appErr := c.App.GetRemoteClusterService(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("invite_id", c.Params.InviteId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSharedChannels) {
+		c.SetPermissionError(model.PermissionManageSharedChannels)
+		return
+	}
+
+	invite, appErr := decide(c.Params.InviteId, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddMeta("remote_id", invite.RemoteId)
+	auditRec.AddMeta("channel_id", invite.ChannelId)
+
+	b, err := json.Marshal(invite)
+	if err != nil {
+		c.SetJSONEncodingError()
+		return
+	}
+
+	auditRec.Success()
+	w.Write(b)
+}
@@ -153,6 +153,208 @@ func TestGetRemoteClusterById(t *testing.T) {
 
 }
 
+func TestRemoteClusterProfilePolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	mockService := app.NewMockRemoteClusterService(nil, app.MockOptionRemoteClusterServiceWithActive(true))
+	th.App.Srv().SetRemoteClusterService(mockService)
+
+	rc := &model.RemoteCluster{
+		RemoteId:     model.NewId(),
+		Name:         "Test1",
+		RemoteTeamId: model.NewId(),
+		SiteURL:      model.NewId(),
+		CreatorId:    model.NewId(),
+	}
+	rc, appErr := th.App.AddRemoteCluster(rc)
+	require.Nil(t, appErr)
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetRemoteClusterProfilePolicy(rc.RemoteId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateRemoteClusterProfilePolicy(rc.RemoteId, &model.RemoteClusterProfilePolicy{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("update and get", func(t *testing.T) {
+		policy, _, err := th.SystemAdminClient.GetRemoteClusterProfilePolicy(rc.RemoteId)
+		require.NoError(t, err)
+		assert.Empty(t, policy.HiddenFields)
+
+		policy, _, err = th.SystemAdminClient.UpdateRemoteClusterProfilePolicy(rc.RemoteId, &model.RemoteClusterProfilePolicy{
+			HiddenFields: []string{model.RemoteProfileFieldEmail, model.RemoteProfileFieldCustomAttributes},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{model.RemoteProfileFieldEmail, model.RemoteProfileFieldCustomAttributes}, policy.HiddenFields)
+
+		updated, appErr := th.App.GetRemoteCluster(rc.RemoteId)
+		require.Nil(t, appErr)
+		assert.True(t, updated.IsProfileFieldHidden(model.RemoteProfileFieldEmail))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateRemoteClusterProfilePolicy(rc.RemoteId, &model.RemoteClusterProfilePolicy{
+			HiddenFields: []string{"password"},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
+func TestRemoteClusterInvitePolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	mockService := app.NewMockRemoteClusterService(nil, app.MockOptionRemoteClusterServiceWithActive(true))
+	th.App.Srv().SetRemoteClusterService(mockService)
+
+	rc := &model.RemoteCluster{
+		RemoteId:     model.NewId(),
+		Name:         "Test1",
+		RemoteTeamId: model.NewId(),
+		SiteURL:      model.NewId(),
+		CreatorId:    model.NewId(),
+	}
+	rc, appErr := th.App.AddRemoteCluster(rc)
+	require.Nil(t, appErr)
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetRemoteClusterInvitePolicy(rc.RemoteId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateRemoteClusterInvitePolicy(rc.RemoteId, &model.RemoteClusterInvitePolicy{Policy: model.RemoteInvitePolicyDeny})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("update and get", func(t *testing.T) {
+		policy, _, err := th.SystemAdminClient.GetRemoteClusterInvitePolicy(rc.RemoteId)
+		require.NoError(t, err)
+		assert.Equal(t, model.RemoteInvitePolicyAccept, policy.Policy)
+
+		policy, _, err = th.SystemAdminClient.UpdateRemoteClusterInvitePolicy(rc.RemoteId, &model.RemoteClusterInvitePolicy{Policy: model.RemoteInvitePolicyRequireApproval})
+		require.NoError(t, err)
+		assert.Equal(t, model.RemoteInvitePolicyRequireApproval, policy.Policy)
+
+		updated, appErr := th.App.GetRemoteCluster(rc.RemoteId)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.RemoteInvitePolicyRequireApproval, updated.InvitePolicy)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateRemoteClusterInvitePolicy(rc.RemoteId, &model.RemoteClusterInvitePolicy{Policy: "maybe"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
+func TestSharedChannelInvites(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetRemoteClusterService(app.NewMockRemoteClusterService(nil, app.MockOptionRemoteClusterServiceWithActive(true)))
+	th.App.Srv().SetSharedChannelSyncService(app.NewMockSharedChannelService(nil, app.MockOptionSharedChannelServiceWithActive(true)))
+
+	rc, appErr := th.App.AddRemoteCluster(&model.RemoteCluster{
+		RemoteId:     model.NewId(),
+		Name:         "remote",
+		DisplayName:  "Remote",
+		RemoteTeamId: model.NewId(),
+		SiteURL:      model.NewId(),
+		CreatorId:    model.NewId(),
+		InvitePolicy: model.RemoteInvitePolicyRequireApproval,
+	})
+	require.Nil(t, appErr)
+
+	saveInvite := func(t *testing.T) *model.SharedChannelInvite {
+		invite, err := th.App.Srv().Store.SharedChannel().SaveInvite(&model.SharedChannelInvite{
+			RemoteId:    rc.RemoteId,
+			ChannelId:   model.NewId(),
+			TeamId:      th.BasicTeam.Id,
+			Type:        model.ChannelTypeOpen,
+			Name:        "shared",
+			DisplayName: "Shared",
+			MemberCount: 12,
+			PostCount:   340,
+		})
+		require.NoError(t, err)
+		return invite
+	}
+
+	t.Run("requires permission", func(t *testing.T) {
+		invite := saveInvite(t)
+
+		_, resp, err := th.Client.GetSharedChannelInvites("", "", 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetSharedChannelInvitePreview(invite.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ApproveSharedChannelInvite(invite.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.DenySharedChannelInvite(invite.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("list and preview", func(t *testing.T) {
+		invite := saveInvite(t)
+
+		invites, _, err := th.SystemAdminClient.GetSharedChannelInvites(rc.RemoteId, model.SharedChannelInviteStatusPending, 0, 100)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(invites))
+		for _, i := range invites {
+			ids = append(ids, i.Id)
+		}
+		assert.Contains(t, ids, invite.Id)
+
+		preview, _, err := th.SystemAdminClient.GetSharedChannelInvitePreview(invite.Id)
+		require.NoError(t, err)
+		assert.Equal(t, invite.Id, preview.Invite.Id)
+		assert.Equal(t, int64(12), preview.Invite.MemberCount)
+		assert.Equal(t, int64(340), preview.Invite.PostCount)
+		assert.Equal(t, "Remote", preview.Remote.DisplayName)
+
+		_, resp, err := th.SystemAdminClient.GetSharedChannelInvitePreview(model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.GetSharedChannelInvites("", "maybe", 0, 10)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		invite := saveInvite(t)
+
+		approved, _, err := th.SystemAdminClient.ApproveSharedChannelInvite(invite.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.SharedChannelInviteStatusApproved, approved.Status)
+		assert.Equal(t, th.SystemAdminUser.Id, approved.DecidedBy)
+
+		_, resp, err := th.SystemAdminClient.DenySharedChannelInvite(invite.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		invite := saveInvite(t)
+
+		denied, _, err := th.SystemAdminClient.DenySharedChannelInvite(invite.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.SharedChannelInviteStatusDenied, denied.Status)
+	})
+}
+
 func TestCreateDirectChannelWithRemoteUser(t *testing.T) {
 	t.Run("creates a local DM channel that is shared", func(t *testing.T) {
 		th := Setup(t).InitBasic()
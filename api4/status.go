@@ -30,9 +30,14 @@ func getUserStatus(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// No permission check required
+	// No permission check required, except for strictly isolated users
+	userIds, err := c.App.FilterUserIDsForIsolation(c.AppContext.Session().UserId, []string{c.Params.UserId})
+	if err != nil {
+		c.Err = err
+		return
+	}
 
-	statusMap, err := c.App.GetUserStatusesByIds([]string{c.Params.UserId})
+	statusMap, err := c.App.GetUserStatusesByIds(userIds)
 	if err != nil {
 		c.Err = err
 		return
@@ -63,7 +68,13 @@ func getUserStatusesByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// No permission check required
+	// No permission check required, except for strictly isolated users
+	userIds, err := c.App.FilterUserIDsForIsolation(c.AppContext.Session().UserId, userIds)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
 	statuses, err := c.App.GetUserStatusesByIds(userIds)
 	if err != nil {
 		c.Err = err
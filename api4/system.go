@@ -36,10 +36,13 @@ var redirectLocationDataCache = cache.NewLRU(cache.LRUOptions{
 
 func (api *API) InitSystem() {
 	api.BaseRoutes.System.Handle("/ping", api.APIHandler(getSystemPing)).Methods("GET")
+	api.BaseRoutes.System.Handle("/health", api.APIHandler(getSystemHealth)).Methods("GET")
 
 	api.BaseRoutes.System.Handle("/timezones", api.APISessionRequired(getSupportedTimezones)).Methods("GET")
 
 	api.BaseRoutes.APIRoot.Handle("/audits", api.APISessionRequired(getAudits)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/audits/integrations", api.APISessionRequired(getIntegrationAudits)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/audits/search", api.APISessionRequired(searchAuditLogs)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/email/test", api.APISessionRequired(testEmail)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/site_url/test", api.APISessionRequired(testSiteURL)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/file/s3_test", api.APISessionRequired(testS3)).Methods("POST")
@@ -193,6 +196,28 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(model.MapToJSON(s)))
 }
 
+// getSystemHealth reports the state of the server, detailing the state of each of its
+// dependencies to the sessions allowed to manage the system. Load balancers should consider the
+// server down when the response is 503, degraded dependencies returning 200.
+func getSystemHealth(c *Context, w http.ResponseWriter, r *http.Request) {
+	health := c.App.GetSystemHealth()
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		health = &model.SystemHealth{Status: health.Status}
+	}
+
+	js, err := json.Marshal(health)
+	if err != nil {
+		c.Err = model.NewAppError("getSystemHealth", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(model.STATUS, health.Status)
+	if health.Status == model.StatusUnhealthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(js)
+}
+
 func testEmail(c *Context, w http.ResponseWriter, r *http.Request) {
 	cfg := model.ConfigFromJSON(r.Body)
 	if cfg == nil {
@@ -269,6 +294,91 @@ func getAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getIntegrationAudits(c *Context, w http.ResponseWriter, r *http.Request) {
+	integrationType := r.URL.Query().Get("integration_type")
+	integrationID := r.URL.Query().Get("integration_id")
+
+	auditRec := c.MakeAuditRecord("getIntegrationAudits", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("integration_type", integrationType)
+	auditRec.AddMeta("integration_id", integrationID)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	audits, err := c.App.GetIntegrationAudits(integrationType, integrationID, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("page", c.Params.Page)
+
+	if err := json.NewEncoder(w).Encode(audits); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// searchAuditLogs returns the audit records kept in the database matching the filters of the
+// query, most recent first.
+func searchAuditLogs(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("searchAuditLogs", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := &model.AuditLogSearch{
+		UserId:    query.Get("user_id"),
+		Event:     query.Get("event"),
+		IpAddress: query.Get("ip_address"),
+		TargetId:  query.Get("target_id"),
+	}
+	if opts.TargetId != "" && !model.IsValidId(opts.TargetId) {
+		c.SetInvalidURLParam("target_id")
+		return
+	}
+	if sinceString := query.Get("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil || since < 0 {
+			c.SetInvalidURLParam("since")
+			return
+		}
+		opts.Since = since
+	}
+	if untilString := query.Get("until"); untilString != "" {
+		until, err := strconv.ParseInt(untilString, 10, 64)
+		if err != nil || until < 0 {
+			c.SetInvalidURLParam("until")
+			return
+		}
+		opts.Until = until
+	}
+	auditRec.AddMeta("search", opts)
+
+	logs, appErr := c.App.SearchAuditLogs(opts, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("page", c.Params.Page)
+
+	js, err := json.Marshal(logs)
+	if err != nil {
+		c.Err = model.NewAppError("searchAuditLogs", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(js)
+}
+
 func databaseRecycle(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionRecycleDatabaseConnections) {
 		c.SetPermissionError(model.PermissionRecycleDatabaseConnections)
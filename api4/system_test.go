@@ -95,6 +95,61 @@ func TestGetPing(t *testing.T) {
 	}, "ping and test push notification")
 }
 
+func TestGetSystemHealth(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("only the status without permission", func(t *testing.T) {
+		health, _, err := th.Client.GetSystemHealth()
+		require.NoError(t, err)
+		assert.Equal(t, model.StatusOk, health.Status)
+		assert.Empty(t, health.Checks)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		t.Run("healthy", func(t *testing.T) {
+			health, _, err := client.GetSystemHealth()
+			require.NoError(t, err)
+			assert.Equal(t, model.StatusOk, health.Status)
+			require.Contains(t, health.Checks, model.HealthProbeDatabase)
+			require.Contains(t, health.Checks, model.HealthProbeFileStore)
+			assert.NotContains(t, health.Checks, model.HealthProbeSMTP)
+		})
+
+		t.Run("degraded when an optional probe fails", func(t *testing.T) {
+			cfg := th.App.Config().Clone()
+			defer th.App.UpdateConfig(func(c *model.Config) { *c = *cfg })
+			th.App.UpdateConfig(func(cfg *model.Config) {
+				*cfg.EmailSettings.SendEmailNotifications = true
+				*cfg.EmailSettings.SMTPServer = "localhost"
+				*cfg.EmailSettings.SMTPPort = "1"
+			})
+
+			health, _, err := client.GetSystemHealth()
+			require.NoError(t, err)
+			assert.Equal(t, model.StatusDegraded, health.Status)
+			require.Contains(t, health.Checks, model.HealthProbeSMTP)
+			assert.Equal(t, model.StatusUnhealthy, health.Checks[model.HealthProbeSMTP].Status)
+		})
+
+		t.Run("unhealthy when a required probe fails", func(t *testing.T) {
+			cfg := th.App.Config().Clone()
+			defer th.App.UpdateConfig(func(c *model.Config) { *c = *cfg })
+			th.App.UpdateConfig(func(cfg *model.Config) {
+				*cfg.EmailSettings.SendEmailNotifications = true
+				*cfg.EmailSettings.SMTPServer = "localhost"
+				*cfg.EmailSettings.SMTPPort = "1"
+				cfg.ServiceSettings.HealthCheckRequiredProbes = []string{model.HealthProbeSMTP}
+			})
+
+			health, resp, err := client.GetSystemHealth()
+			require.Error(t, err)
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+			assert.Equal(t, model.StatusUnhealthy, health.Status)
+		})
+	})
+}
+
 func TestGetAudits(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -125,6 +180,85 @@ func TestGetAudits(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetIntegrationAudits(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	_, _, err := client.ExecuteCommand(th.BasicChannel.Id, "/echo secret")
+	require.NoError(t, err)
+
+	audits, _, err := th.SystemAdminClient.GetIntegrationAudits(model.IntegrationTypeCommand, "", 0, 100)
+	require.NoError(t, err)
+	require.NotEmpty(t, audits)
+	audit := audits[0]
+	require.Equal(t, model.AuditActionCommandExecuted, audit.Action)
+	require.Equal(t, th.BasicUser.Id, audit.UserId)
+	require.Contains(t, audit.ExtraInfo, "channel_id="+th.BasicChannel.Id)
+	require.Contains(t, audit.ExtraInfo, "command=/echo")
+	require.NotContains(t, audit.ExtraInfo, "secret", "only the hash of the payload is recorded")
+
+	audits, _, err = th.SystemAdminClient.GetIntegrationAudits(model.IntegrationTypeIncomingWebhook, model.NewId(), 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, audits)
+
+	_, resp, err := th.SystemAdminClient.GetIntegrationAudits("outgoing_webhook", "", 0, 100)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.GetIntegrationAudits("", "", 0, 100)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+}
+
+func TestSearchAuditLogs(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.DatabaseEnabled = true })
+
+	team := th.CreateTeam()
+	_, err := th.LocalClient.PermanentDeleteTeam(team.Id)
+	require.NoError(t, err)
+
+	var logs []*model.AuditLog
+	require.Eventually(t, func() bool {
+		logs, _, err = th.SystemAdminClient.SearchAuditLogs(&model.AuditLogSearch{TargetId: team.Id}, 0, 100)
+		require.NoError(t, err)
+		return len(logs) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "localDeleteTeam", logs[0].Event)
+	assert.Equal(t, "success", logs[0].Status)
+	assert.Equal(t, "team", logs[0].TargetType)
+
+	logs, _, err = th.SystemAdminClient.SearchAuditLogs(&model.AuditLogSearch{Event: "localDeleteTeam", Until: logs[0].CreateAt - 1, TargetId: team.Id}, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+
+	_, resp, err := th.SystemAdminClient.SearchAuditLogs(&model.AuditLogSearch{TargetId: "junk"}, 0, 100)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.SearchAuditLogs(&model.AuditLogSearch{}, 0, 100)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	t.Run("not kept when disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.DatabaseEnabled = false })
+
+		team := th.CreateTeam()
+		_, err := th.LocalClient.PermanentDeleteTeam(team.Id)
+		require.NoError(t, err)
+
+		time.Sleep(500 * time.Millisecond)
+		logs, _, err := th.SystemAdminClient.SearchAuditLogs(&model.AuditLogSearch{TargetId: team.Id}, 0, 100)
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+}
+
 func TestEmailTest(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
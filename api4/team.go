@@ -14,9 +14,12 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/mattermost/mattermost-server/v6/app"
 	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 )
 
@@ -33,12 +36,14 @@ func init() {
 }
 
 func (api *API) InitTeam() {
-	api.BaseRoutes.Teams.Handle("", api.APISessionRequired(createTeam)).Methods("POST")
+	api.BaseRoutes.Teams.Handle("", api.APISessionRequiredIdempotent(createTeam)).Methods("POST")
 	api.BaseRoutes.Teams.Handle("", api.APISessionRequired(getAllTeams)).Methods("GET")
 	api.BaseRoutes.Teams.Handle("/{team_id:[A-Za-z0-9]+}/scheme", api.APISessionRequired(updateTeamScheme)).Methods("PUT")
 	api.BaseRoutes.Teams.Handle("/search", api.APISessionRequiredDisableWhenBusy(searchTeams)).Methods("POST")
 	api.BaseRoutes.TeamsForUser.Handle("", api.APISessionRequired(getTeamsForUser)).Methods("GET")
 	api.BaseRoutes.TeamsForUser.Handle("/unread", api.APISessionRequired(getTeamsUnreadForUser)).Methods("GET")
+	api.BaseRoutes.TeamsForUser.Handle("/order", api.APISessionRequired(getTeamsOrderForUser)).Methods("GET")
+	api.BaseRoutes.TeamsForUser.Handle("/order", api.APISessionRequired(updateTeamsOrderForUser)).Methods("PUT")
 
 	api.BaseRoutes.Team.Handle("", api.APISessionRequired(getTeam)).Methods("GET")
 	api.BaseRoutes.Team.Handle("", api.APISessionRequired(updateTeam)).Methods("PUT")
@@ -46,8 +51,14 @@ func (api *API) InitTeam() {
 	api.BaseRoutes.Team.Handle("/patch", api.APISessionRequired(patchTeam)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/restore", api.APISessionRequired(restoreTeam)).Methods("POST")
 	api.BaseRoutes.Team.Handle("/privacy", api.APISessionRequired(updateTeamPrivacy)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/data_residency", api.APISessionRequired(setTeamDataResidency)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/strict_isolation", api.APISessionRequired(setTeamStrictIsolation)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/stats", api.APISessionRequired(getTeamStats)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/stats/extended", api.APISessionRequired(getTeamExtendedStats)).Methods("GET")
 	api.BaseRoutes.Team.Handle("/regenerate_invite_id", api.APISessionRequired(regenerateTeamInviteId)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/children", api.APISessionRequired(getChildTeams)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/ancestors", api.APISessionRequired(getTeamAncestors)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/parent", api.APISessionRequired(setParentTeam)).Methods("PUT")
 
 	api.BaseRoutes.Team.Handle("/image", api.APISessionRequiredTrustRequester(getTeamIcon)).Methods("GET")
 	api.BaseRoutes.Team.Handle("/image", api.APISessionRequired(setTeamIcon)).Methods("POST")
@@ -62,6 +73,7 @@ func (api *API) InitTeam() {
 	api.BaseRoutes.TeamMember.Handle("", api.APISessionRequired(removeTeamMember)).Methods("DELETE")
 
 	api.BaseRoutes.TeamForUser.Handle("/unread", api.APISessionRequired(getTeamUnread)).Methods("GET")
+	api.BaseRoutes.TeamForUser.Handle("/order", api.APISessionRequired(moveTeamInOrderForUser)).Methods("PUT")
 
 	api.BaseRoutes.TeamByName.Handle("", api.APISessionRequired(getTeamByName)).Methods("GET")
 	api.BaseRoutes.TeamMember.Handle("", api.APISessionRequired(getTeamMember)).Methods("GET")
@@ -69,8 +81,15 @@ func (api *API) InitTeam() {
 	api.BaseRoutes.TeamMember.Handle("/roles", api.APISessionRequired(updateTeamMemberRoles)).Methods("PUT")
 	api.BaseRoutes.TeamMember.Handle("/schemeRoles", api.APISessionRequired(updateTeamMemberSchemeRoles)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/import", api.APISessionRequired(importTeam)).Methods("POST")
-	api.BaseRoutes.Team.Handle("/invite/email", api.APISessionRequired(inviteUsersToTeam)).Methods("POST")
-	api.BaseRoutes.Team.Handle("/invite-guests/email", api.APISessionRequired(inviteGuestsToChannels)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/invite/email", api.APISessionRequiredIdempotent(inviteUsersToTeam)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/invite/email/preview", api.APISessionRequired(previewInviteEmail)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/invite-guests/email", api.APISessionRequiredIdempotent(inviteGuestsToChannels)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/invite_budget", api.APISessionRequired(getTeamInviteBudget)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/invite_budget/grant", api.APISessionRequired(grantTeamInviteBudget)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/email", api.APISessionRequired(updateTeamEmail)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/email/verify/send", api.APISessionRequired(sendTeamEmailVerification)).Methods("POST")
+	api.BaseRoutes.Teams.Handle("/email/verify", api.APIHandler(verifyTeamEmail)).Methods("POST")
+	api.BaseRoutes.Teams.Handle("/allowed_domains/validate", api.APISessionRequired(validateTeamAllowedDomains)).Methods("POST")
 	api.BaseRoutes.Teams.Handle("/invites/email", api.APISessionRequired(invalidateAllEmailInvites)).Methods("DELETE")
 	api.BaseRoutes.Teams.Handle("/invite/{invite_id:[A-Za-z0-9]+}", api.APIHandler(getInviteInfo)).Methods("GET")
 
@@ -94,6 +113,11 @@ func createTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if team.ParentTeamId != "" && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.ParentTeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
 	rteam, err := c.App.CreateTeamWithUser(c.AppContext, &team, c.AppContext.Session().UserId)
 	if err != nil {
 		c.Err = err
@@ -123,7 +147,7 @@ func getTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if (!team.AllowOpenInvite || team.Type != model.TeamOpen) && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.Id, model.PermissionViewTeam) {
+	if (!team.AllowOpenInvite || team.Type != model.TeamOpen) && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.Id, model.PermissionViewTeam) && !c.App.HasTeamVisibilityThroughParent(c.AppContext.Session().UserId, team) {
 		c.SetPermissionError(model.PermissionViewTeam)
 		return
 	}
@@ -146,7 +170,7 @@ func getTeamByName(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if (!team.AllowOpenInvite || team.Type != model.TeamOpen) && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.Id, model.PermissionViewTeam) {
+	if (!team.AllowOpenInvite || team.Type != model.TeamOpen) && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.Id, model.PermissionViewTeam) && !c.App.HasTeamVisibilityThroughParent(c.AppContext.Session().UserId, team) {
 		c.SetPermissionError(model.PermissionViewTeam)
 		return
 	}
@@ -157,6 +181,111 @@ func getTeamByName(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getChildTeams(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	children, err := c.App.GetChildTeams(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	session := c.AppContext.Session()
+	visible := []*model.Team{}
+	for _, child := range children {
+		if (child.AllowOpenInvite && child.Type == model.TeamOpen) ||
+			c.App.SessionHasPermissionToTeam(*session, child.Id, model.PermissionViewTeam) ||
+			c.App.HasTeamVisibilityThroughParent(session.UserId, child) {
+			visible = append(visible, child)
+		}
+	}
+
+	c.App.SanitizeTeams(*session, visible)
+	if err := json.NewEncoder(w).Encode(visible); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamAncestors(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	team, err := c.App.GetTeam(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if (!team.AllowOpenInvite || team.Type != model.TeamOpen) && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), team.Id, model.PermissionViewTeam) && !c.App.HasTeamVisibilityThroughParent(c.AppContext.Session().UserId, team) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	ancestors, err := c.App.GetTeamAncestors(team.Id)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	c.App.SanitizeTeams(*c.AppContext.Session(), ancestors)
+	if err := json.NewEncoder(w).Encode(ancestors); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func setParentTeam(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	parentTeamID, ok := props["parent_team_id"]
+	if !ok || (parentTeamID != "" && !model.IsValidId(parentTeamID)) {
+		c.SetInvalidParam("parent_team_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setParentTeam", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("parent_team_id", parentTeamID)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	if parentTeamID != "" && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), parentTeamID, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	team, err := c.App.SetParentTeam(c.Params.TeamId, parentTeamID)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("team", team)
+
+	c.App.SanitizeTeam(*c.AppContext.Session(), team)
+	if err := json.NewEncoder(w).Encode(team); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func updateTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
@@ -333,6 +462,93 @@ func updateTeamPrivacy(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func setTeamDataResidency(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.StringInterfaceFromJSON(r.Body)
+	residency, ok := props["data_residency"].(string)
+	if !ok || (residency != "" && !model.IsValidDataResidency(residency)) {
+		c.SetInvalidParam("data_residency")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setTeamDataResidency", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("data_residency", residency)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	migration, appErr := c.App.SetTeamDataResidency(c.Params.TeamId, residency)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(migration)
+	if err != nil {
+		c.Err = model.NewAppError("setTeamDataResidency", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("job_id", migration.JobId)
+	w.WriteHeader(http.StatusAccepted)
+	w.Write(js)
+}
+
+func setTeamStrictIsolation(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	// A null strict_isolation makes the team follow the setting of the server again.
+	props := model.StringInterfaceFromJSON(r.Body)
+	value, ok := props["strict_isolation"]
+	if !ok {
+		c.SetInvalidParam("strict_isolation")
+		return
+	}
+	var isolation *bool
+	if value != nil {
+		isolated, ok := value.(bool)
+		if !ok {
+			c.SetInvalidParam("strict_isolation")
+			return
+		}
+		isolation = model.NewBool(isolated)
+	}
+
+	auditRec := c.MakeAuditRecord("setTeamStrictIsolation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("strict_isolation", value)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	team, appErr := c.App.SetTeamStrictIsolation(c.Params.TeamId, isolation)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.App.SanitizeTeam(*c.AppContext.Session(), team)
+	if err := json.NewEncoder(w).Encode(team); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func regenerateTeamInviteId(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
@@ -389,6 +605,12 @@ func deleteTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		} else {
 			err = model.NewAppError("deleteTeam", "api.user.delete_team.not_enabled.app_error", nil, "teamId="+c.Params.TeamId, http.StatusUnauthorized)
 		}
+	} else if *c.App.Config().TeamSettings.DeletionWindowDays > 0 {
+		// The team is permanently deleted by the team deletion job once the window is over.
+		var deletion *model.TeamDeletion
+		if deletion, err = c.App.ScheduleTeamDeletion(c.AppContext.Session().UserId, c.Params.TeamId); err == nil {
+			auditRec.AddMeta("delete_at", deletion.DeleteAt)
+		}
 	} else {
 		err = c.App.SoftDeleteTeam(c.Params.TeamId)
 	}
@@ -429,6 +651,83 @@ func getTeamsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func getTeamsOrderForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	order, err := c.App.GetTeamsOrder(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Write([]byte(model.ArrayToJSON(order)))
+}
+
+func updateTeamsOrderForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamsOrderForUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	order := model.ArrayFromJSON(r.Body)
+
+	if err := c.App.UpdateTeamsOrder(c.Params.UserId, order); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	w.Write([]byte(model.ArrayToJSON(order)))
+}
+
+func moveTeamInOrderForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var move model.TeamOrderMove
+	if jsonErr := json.NewDecoder(r.Body).Decode(&move); jsonErr != nil {
+		c.SetInvalidParam("index")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("moveTeamInOrderForUser", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("index", move.Index)
+
+	order, err := c.App.MoveTeamInOrder(c.Params.UserId, c.Params.TeamId, move.Index)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	w.Write([]byte(model.ArrayToJSON(order)))
+}
+
 func getTeamsUnreadForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -644,7 +943,8 @@ func addTeamMember(c *Context, w http.ResponseWriter, r *http.Request) {
 			c.SetPermissionError(model.PermissionJoinPublicTeams)
 			return
 		}
-		if !team.AllowOpenInvite && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionJoinPrivateTeams) {
+		// Members of a parent team can join the sub-teams that inherit its membership.
+		if !team.AllowOpenInvite && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionJoinPrivateTeams) && !c.App.HasTeamVisibilityThroughParent(member.UserId, team) {
 			c.SetPermissionError(model.PermissionJoinPrivateTeams)
 			return
 		}
@@ -945,6 +1245,38 @@ func getTeamStats(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getTeamExtendedStats(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	days := model.TeamExtendedStatsDefaultDays
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+		days, err = strconv.Atoi(daysStr)
+		if err != nil || days < 1 || days > model.TeamExtendedStatsMaxDays {
+			c.SetInvalidURLParam("days")
+			return
+		}
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeamExtendedStats) {
+		c.SetPermissionError(model.PermissionViewTeamExtendedStats)
+		return
+	}
+
+	stats, err := c.App.GetTeamExtendedStats(c.Params.TeamId, days)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func updateTeamMemberRoles(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId().RequireUserId()
 	if c.Err != nil {
@@ -1257,6 +1589,39 @@ func importTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(model.MapToJSON(data)))
 }
 
+// previewInviteEmail returns the email inviting users to the team as sent by the current user.
+// With send_test=true, the email is also sent to the current user.
+func previewInviteEmail(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionInviteUser) {
+		c.SetPermissionError(model.PermissionInviteUser)
+		return
+	}
+
+	sendTest := r.URL.Query().Get("send_test") == "true"
+
+	auditRec := c.MakeAuditRecord("previewInviteEmail", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("send_test", sendTest)
+
+	preview, err := c.App.PreviewInviteEmail(c.Params.TeamId, c.AppContext.Session().UserId, sendTest)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	graceful := r.URL.Query().Get("graceful") != ""
 
@@ -1265,6 +1630,14 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The invitations are written in the locale when given, otherwise in the one picked for
+	// each address.
+	locale := r.URL.Query().Get("locale")
+	if _, ok := i18n.GetSupportedLocales()[locale]; locale != "" && !ok {
+		c.SetInvalidParam("locale")
+		return
+	}
+
 	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionInviteUser) {
 		c.SetPermissionError(model.PermissionInviteUser)
 		return
@@ -1292,6 +1665,20 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	auditRec.AddMeta("count", len(emailList))
 	auditRec.AddMeta("emails", emailList)
 
+	invite, appErr := c.App.RunInviteUsersToTeamWillBeSentHook(c.AppContext, &model.TeamEmailInvite{
+		TeamId:   c.Params.TeamId,
+		SenderId: c.AppContext.Session().UserId,
+		Emails:   emailList,
+	})
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	emailList = invite.Emails
+	if len(invite.Metadata) > 0 {
+		auditRec.AddMeta("invite_metadata", invite.Metadata)
+	}
+
 	if graceful {
 		cloudUserLimit := *c.App.Config().ExperimentalSettings.CloudUserLimit
 		var invitesOverLimit []*model.EmailInviteWithError
@@ -1314,7 +1701,7 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		var invitesWithError []*model.EmailInviteWithError
 		var err *model.AppError
 		if emailList != nil {
-			invitesWithError, err = c.App.InviteNewUsersToTeamGracefully(emailList, c.Params.TeamId, c.AppContext.Session().UserId, "")
+			invitesWithError, err = c.App.InviteNewUsersToTeamGracefully(emailList, c.Params.TeamId, c.AppContext.Session().UserId, "", locale)
 		}
 
 		if len(invitesOverLimit) > 0 {
@@ -1331,9 +1718,11 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 			auditRec.AddMeta("errors", errList)
 		}
 		if err != nil {
+			setInviteBudgetRetryAfter(w, err)
 			c.Err = err
 			return
 		}
+		c.App.RunUserInvitedToTeamHook(c.AppContext, invite, model.EmailInviteWithErrorToEmails(invitesWithError))
 
 		// we get the emailList after it has finished checks like the emails over the list
 		scheduledAt := model.GetMillis()
@@ -1342,6 +1731,7 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 			"teamID":      c.Params.TeamId,
 			"senderID":    c.AppContext.Session().UserId,
 			"scheduledAt": strconv.FormatInt(scheduledAt, 10),
+			"locale":      locale,
 		}
 
 		// we then manually schedule the job to send another invite after 48 hours
@@ -1359,11 +1749,13 @@ func inviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 		w.Write(js)
 	} else {
-		err := c.App.InviteNewUsersToTeam(emailList, c.Params.TeamId, c.AppContext.Session().UserId)
+		err := c.App.InviteNewUsersToTeam(emailList, c.Params.TeamId, c.AppContext.Session().UserId, locale)
 		if err != nil {
+			setInviteBudgetRetryAfter(w, err)
 			c.Err = err
 			return
 		}
+		c.App.RunUserInvitedToTeamHook(c.AppContext, invite, emailList)
 		ReturnStatusOK(w)
 	}
 	auditRec.Success()
@@ -1449,6 +1841,7 @@ func inviteGuestsToChannels(c *Context, w http.ResponseWriter, r *http.Request)
 				errList = append(errList, model.EmailInviteWithErrorToString(inv))
 			}
 			auditRec.AddMeta("errors", errList)
+			setInviteBudgetRetryAfter(w, err)
 			c.Err = err
 			return
 		}
@@ -1462,6 +1855,7 @@ func inviteGuestsToChannels(c *Context, w http.ResponseWriter, r *http.Request)
 	} else {
 		err := c.App.InviteGuestsToChannels(c.Params.TeamId, &guestsInvite, c.AppContext.Session().UserId)
 		if err != nil {
+			setInviteBudgetRetryAfter(w, err)
 			c.Err = err
 			return
 		}
@@ -1470,6 +1864,184 @@ func inviteGuestsToChannels(c *Context, w http.ResponseWriter, r *http.Request)
 	auditRec.Success()
 }
 
+// setInviteBudgetRetryAfter tells clients when a team that ran out of invitations for the day
+// can send some again, that is when the next day starts.
+func setInviteBudgetRetryAfter(w http.ResponseWriter, err *model.AppError) {
+	if err.Id != app.TeamInviteBudgetExceededError {
+		return
+	}
+
+	now := model.GetMillis()
+	resetAt := model.TeamInviteUsageDay(now) + int64(model.TeamInviteUsageBucketSize/time.Millisecond)
+	w.Header().Set("Retry-After", strconv.FormatInt((resetAt-now+999)/1000, 10))
+}
+
+func getTeamInviteBudget(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionInviteUser) &&
+		!c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementTeams) {
+		c.SetPermissionError(model.PermissionInviteUser)
+		return
+	}
+
+	budget, err := c.App.GetTeamInviteBudget(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(budget); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func grantTeamInviteBudget(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	var grant model.TeamInviteBudgetGrant
+	if jsonErr := json.NewDecoder(r.Body).Decode(&grant); jsonErr != nil {
+		c.SetInvalidParam("extra_invites")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("grantTeamInviteBudget", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("extra_invites", grant.ExtraInvites)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	budget, err := c.App.GrantTeamInviteBudget(c.Params.TeamId, &grant)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("remaining", budget.Remaining)
+
+	if err := json.NewEncoder(w).Encode(budget); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateTeamEmail(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	email := props["email"]
+	if email == "" {
+		c.SetInvalidParam("email")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamEmail", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	team, err := c.App.UpdateTeamEmail(c.Params.TeamId, email)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("email", team.Email)
+
+	c.App.SanitizeTeam(*c.AppContext.Session(), team)
+	if err := json.NewEncoder(w).Encode(team); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func sendTeamEmailVerification(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("sendTeamEmailVerification", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	team, err := c.App.GetTeam(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := c.App.SendTeamEmailVerification(team); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func verifyTeamEmail(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJSON(r.Body)
+
+	token := props["token"]
+	if len(token) != model.TokenSize {
+		c.SetInvalidParam("token")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("verifyTeamEmail", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	team, err := c.App.VerifyTeamEmailFromToken(token)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("team_id", team.Id)
+
+	ReturnStatusOK(w)
+}
+
+func validateTeamAllowedDomains(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJSON(r.Body)
+
+	allowedDomains := props["allowed_domains"]
+	if len(allowedDomains) > model.TeamAllowedDomainsMaxLength {
+		c.SetInvalidParam("allowed_domains")
+		return
+	}
+
+	validation := c.App.ValidateTeamAllowedDomains(allowedDomains)
+	if err := json.NewEncoder(w).Encode(validation); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getInviteInfo(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireInviteId()
 	if c.Err != nil {
@@ -1538,6 +2110,13 @@ func getTeamIcon(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if signedURL, err := c.App.GetTeamIconURL(team); err != nil {
+		mlog.Warn("Unable to generate signed URL for the team icon", mlog.String("team_id", team.Id), mlog.Err(err))
+	} else if signedURL != "" {
+		http.Redirect(w, r, signedURL, http.StatusFound)
+		return
+	}
+
 	img, err := c.App.GetTeamIcon(team)
 	if err != nil {
 		c.Err = err
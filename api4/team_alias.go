@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitTeamAlias() {
+	api.BaseRoutes.Team.Handle("/aliases", api.APISessionRequired(getTeamAliases)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/aliases", api.APISessionRequired(addTeamAlias)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/aliases/{team_name:[A-Za-z0-9_-]+}", api.APISessionRequired(deleteTeamAlias)).Methods("DELETE")
+	api.BaseRoutes.Team.Handle("/name", api.APISessionRequired(updateTeamName)).Methods("PUT")
+}
+
+func getTeamAliases(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	aliases, err := c.App.GetTeamAliases(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(aliases); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func addTeamAlias(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	var alias model.TeamAlias
+	if jsonErr := json.NewDecoder(r.Body).Decode(&alias); jsonErr != nil {
+		c.SetInvalidParam("team_alias")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("addTeamAlias", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("name", alias.Name)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	saved, err := c.App.AddTeamAlias(c.Params.TeamId, alias.Name)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteTeamAlias(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireTeamName()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteTeamAlias", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("name", c.Params.TeamName)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	if err := c.App.DeleteTeamAlias(c.Params.TeamId, c.Params.TeamName); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func updateTeamName(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	name := props["name"]
+	if name == "" {
+		c.SetInvalidParam("name")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateTeamName", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("name", name)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	team, err := c.App.UpdateTeamName(c.AppContext, c.Params.TeamId, name, c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("name=" + team.Name)
+
+	team.Sanitize()
+	if err := json.NewEncoder(w).Encode(team); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamAliases(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+
+	t.Run("requires permission", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, resp, err := client.GetTeamAliases(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		th.LinkUserToTeam(th.BasicUser2, team)
+
+		_, _, err = client.GetTeamAliases(team.Id)
+		require.NoError(t, err)
+
+		_, resp, err = client.AddTeamAlias(team.Id, "alias-"+model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.DeleteTeamAlias(team.Id, "alias-"+model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("add, resolve and delete", func(t *testing.T) {
+		name := "alias-" + model.NewId()
+
+		alias, resp, err := th.Client.AddTeamAlias(team.Id, name)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, name, alias.Name)
+		assert.Equal(t, team.Id, alias.TeamId)
+
+		aliases, _, err := th.Client.GetTeamAliases(team.Id)
+		require.NoError(t, err)
+		require.Len(t, aliases, 1)
+		assert.Equal(t, name, aliases[0].Name)
+
+		byName, _, err := th.Client.GetTeamByName(name, "")
+		require.NoError(t, err)
+		assert.Equal(t, team.Id, byName.Id)
+
+		_, resp, err = th.Client.AddTeamAlias(th.BasicTeam.Id, name)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.AddTeamAlias(team.Id, th.BasicTeam.Name)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.AddTeamAlias(team.Id, "login")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resp, err = th.Client.DeleteTeamAlias(th.BasicTeam.Id, name)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, err = th.Client.DeleteTeamAlias(team.Id, name)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetTeamByName(name, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("renamed team keeps its previous name", func(t *testing.T) {
+		renamed := th.CreateTeam()
+		oldName := renamed.Name
+		newName := "renamed-" + model.NewId()
+
+		_, appErr := th.App.RenameTeam(renamed, newName, "")
+		require.Nil(t, appErr)
+
+		byName, _, err := th.Client.GetTeamByName(oldName, "")
+		require.NoError(t, err)
+		assert.Equal(t, renamed.Id, byName.Id)
+		assert.Equal(t, newName, byName.Name)
+
+		_, resp, err := th.Client.CreateTeam(&model.Team{Name: oldName, DisplayName: "Taken", Type: model.TeamOpen})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		// Taking back the previous name drops the alias.
+		_, appErr = th.App.RenameTeam(renamed, oldName, "")
+		require.Nil(t, appErr)
+
+		aliases, _, err := th.Client.GetTeamAliases(renamed.Id)
+		require.NoError(t, err)
+		require.Len(t, aliases, 1)
+		assert.Equal(t, newName, aliases[0].Name)
+	})
+}
+
+func TestUpdateTeamName(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	team := th.CreateTeam()
+	oldName := team.Name
+
+	t.Run("requires permission", func(t *testing.T) {
+		th.LinkUserToTeam(th.BasicUser2, team)
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, resp, err := client.UpdateTeamName(team.Id, "renamed-"+model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateTeamName(team.Id, "login")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateTeamName(team.Id, th.BasicTeam.Name)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("rename keeps the previous name redirecting", func(t *testing.T) {
+		newName := "renamed-" + model.NewId()
+
+		renamed, _, err := th.Client.UpdateTeamName(team.Id, newName)
+		require.NoError(t, err)
+		assert.Equal(t, newName, renamed.Name)
+
+		byName, _, err := th.Client.GetTeamByName(oldName, "")
+		require.NoError(t, err)
+		assert.Equal(t, team.Id, byName.Id)
+
+		aliases, _, err := th.Client.GetTeamAliases(team.Id)
+		require.NoError(t, err)
+		require.Len(t, aliases, 1)
+		assert.Equal(t, oldName, aliases[0].Name)
+		assert.Equal(t, aliases[0].CreateAt+int64(model.TeamSettingsDefaultRenameRedirectWindowDays)*24*60*60*1000, aliases[0].ExpireAt)
+
+		_, resp, err := th.Client.CreateTeam(&model.Team{Name: oldName, DisplayName: "Taken", Type: model.TeamOpen})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		townSquare, appErr := th.App.GetChannelByName(model.DefaultChannelName, team.Id, false)
+		require.Nil(t, appErr)
+		postList, _, err := th.Client.GetPostsForChannel(townSquare.Id, 0, 1, "", false)
+		require.NoError(t, err)
+		require.Len(t, postList.Order, 1)
+		post := postList.Posts[postList.Order[0]]
+		assert.Equal(t, model.PostTypeTeamRename, post.Type)
+		assert.Equal(t, oldName, post.GetProp("old_name"))
+		assert.Equal(t, newName, post.GetProp("new_name"))
+	})
+
+	t.Run("previous name is released once the window closes", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.RenameRedirectWindowDays = 0 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.TeamSettings.RenameRedirectWindowDays = model.TeamSettingsDefaultRenameRedirectWindowDays
+		})
+
+		other := th.CreateTeam()
+		otherName := other.Name
+		_, _, err := th.Client.UpdateTeamName(other.Id, "renamed-"+model.NewId())
+		require.NoError(t, err)
+
+		aliases, _, err := th.Client.GetTeamAliases(other.Id)
+		require.NoError(t, err)
+		require.Len(t, aliases, 1)
+		assert.Zero(t, aliases[0].ExpireAt)
+
+		// Expire the alias by hand and check the name can be reused.
+		aliases[0].CreateAt = model.GetMillis() - 2000
+		aliases[0].ExpireAt = model.GetMillis() - 1000
+		require.NoError(t, th.App.Srv().Store.TeamAlias().Delete(otherName))
+		_, err = th.App.Srv().Store.TeamAlias().Save(aliases[0])
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.GetTeamByName(otherName, "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, _, err = th.Client.CreateTeam(&model.Team{Name: otherName, DisplayName: "Reused", Type: model.TeamOpen})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitTeamBanner() {
+	api.BaseRoutes.Team.Handle("/banners", api.APISessionRequired(getTeamBanners)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/banners", api.APISessionRequired(createTeamBanner)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/banners/{banner_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchTeamBanner)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/banners/{banner_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteTeamBanner)).Methods("DELETE")
+	api.BaseRoutes.Team.Handle("/banners/{banner_id:[A-Za-z0-9]+}/dismiss", api.APISessionRequired(dismissTeamBanner)).Methods("POST")
+}
+
+// getTeamBannerForTeam returns the banner in the URL, making sure it belongs to the team in
+// the URL.
+func getTeamBannerForTeam(c *Context) *model.TeamBanner {
+	banner, err := c.App.GetTeamBanner(c.Params.BannerId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if banner.TeamId != c.Params.TeamId {
+		c.Err = model.NewAppError("getTeamBannerForTeam", "app.team_banner.get.not_found.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return banner
+}
+
+func getTeamBanners(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	var banners []*model.TeamBanner
+	var err *model.AppError
+	if r.URL.Query().Get("include_inactive") == "true" {
+		if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+			c.SetPermissionError(model.PermissionManageTeam)
+			return
+		}
+		banners, err = c.App.GetTeamBanners(c.Params.TeamId)
+	} else {
+		banners, err = c.App.GetActiveTeamBannersForUser(c.Params.TeamId, c.AppContext.Session().UserId)
+	}
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(banners); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createTeamBanner(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	var banner model.TeamBanner
+	if jsonErr := json.NewDecoder(r.Body).Decode(&banner); jsonErr != nil {
+		c.SetInvalidParam("banner")
+		return
+	}
+	banner.TeamId = c.Params.TeamId
+	banner.CreatorId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createTeamBanner", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	created, err := c.App.CreateTeamBanner(&banner)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("banner_id", created.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchTeamBanner(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireBannerId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch model.TeamBannerPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParam("banner")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchTeamBanner", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("banner_id", c.Params.BannerId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	if getTeamBannerForTeam(c); c.Err != nil {
+		return
+	}
+
+	patched, err := c.App.PatchTeamBanner(c.Params.BannerId, &patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteTeamBanner(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireBannerId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteTeamBanner", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("banner_id", c.Params.BannerId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	if getTeamBannerForTeam(c); c.Err != nil {
+		return
+	}
+
+	if err := c.App.DeleteTeamBanner(c.Params.BannerId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func dismissTeamBanner(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireBannerId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	if getTeamBannerForTeam(c); c.Err != nil {
+		return
+	}
+
+	if err := c.App.DismissTeamBanner(c.AppContext.Session().UserId, c.Params.BannerId); err != nil {
+		c.Err = err
+		return
+	}
+
+	ReturnStatusOK(w)
+}
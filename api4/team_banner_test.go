@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamBanners(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client2 := th.CreateClient()
+	th.LoginBasic2WithClient(client2)
+
+	banner, resp, err := th.SystemAdminClient.CreateTeamBanner(&model.TeamBanner{
+		TeamId:         th.BasicTeam.Id,
+		Message:        "Maintenance tonight",
+		AllowDismissal: true,
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.SystemAdminUser.Id, banner.CreatorId)
+
+	scheduled, _, err := th.SystemAdminClient.CreateTeamBanner(&model.TeamBanner{
+		TeamId:  th.BasicTeam.Id,
+		Message: "Later",
+		StartAt: model.GetMillis() + 3600000,
+	})
+	require.NoError(t, err)
+
+	t.Run("members cannot manage banners", func(t *testing.T) {
+		_, resp, err := client2.CreateTeamBanner(&model.TeamBanner{TeamId: th.BasicTeam.Id, Message: "mine"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client2.PatchTeamBanner(th.BasicTeam.Id, banner.Id, &model.TeamBannerPatch{Message: model.NewString("mine")})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client2.DeleteTeamBanner(th.BasicTeam.Id, banner.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client2.GetTeamBanners(th.BasicTeam.Id, true)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid banner", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateTeamBanner(&model.TeamBanner{TeamId: th.BasicTeam.Id, Message: "x", BackgroundColor: "red"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("members only see active banners", func(t *testing.T) {
+		banners, _, err := client2.GetTeamBanners(th.BasicTeam.Id, false)
+		require.NoError(t, err)
+		require.Len(t, banners, 1)
+		assert.Equal(t, banner.Id, banners[0].Id)
+
+		banners, _, err = th.SystemAdminClient.GetTeamBanners(th.BasicTeam.Id, true)
+		require.NoError(t, err)
+		require.Len(t, banners, 2)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		patched, _, err := th.SystemAdminClient.PatchTeamBanner(th.BasicTeam.Id, banner.Id, &model.TeamBannerPatch{Message: model.NewString("Maintenance tomorrow")})
+		require.NoError(t, err)
+		assert.Equal(t, "Maintenance tomorrow", patched.Message)
+		assert.True(t, patched.AllowDismissal)
+	})
+
+	t.Run("dismiss", func(t *testing.T) {
+		resp, err := client2.DismissTeamBanner(th.BasicTeam.Id, scheduled.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, err = client2.DismissTeamBanner(th.BasicTeam.Id, banner.Id)
+		require.NoError(t, err)
+
+		banners, _, err := client2.GetTeamBanners(th.BasicTeam.Id, false)
+		require.NoError(t, err)
+		assert.Empty(t, banners)
+
+		banners, _, err = th.Client.GetTeamBanners(th.BasicTeam.Id, false)
+		require.NoError(t, err)
+		assert.Len(t, banners, 1)
+	})
+
+	t.Run("banner of another team", func(t *testing.T) {
+		otherTeam := th.CreateTeam()
+		resp, err := th.SystemAdminClient.DeleteTeamBanner(otherTeam.Id, banner.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := th.SystemAdminClient.DeleteTeamBanner(th.BasicTeam.Id, banner.Id)
+		require.NoError(t, err)
+
+		resp, err := th.SystemAdminClient.DeleteTeamBanner(th.BasicTeam.Id, banner.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
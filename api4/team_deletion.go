@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitTeamDeletion() {
+	api.BaseRoutes.Team.Handle("/deletion", api.APISessionRequired(getTeamDeletion)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/deletion", api.APISessionRequired(cancelTeamDeletion)).Methods("DELETE")
+	api.BaseRoutes.Team.Handle("/deletion/export", api.APISessionRequiredTrustRequester(downloadTeamDeletionExport)).Methods("GET")
+}
+
+func getTeamDeletion(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	deletion, err := c.App.GetTeamDeletion(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(deletion); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func cancelTeamDeletion(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("cancelTeamDeletion", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	if err := c.App.CancelTeamDeletion(c.Params.TeamId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func downloadTeamDeletionExport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("downloadTeamDeletionExport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	deletion, err := c.App.GetTeamDeletion(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if deletion.ExportAt == 0 {
+		c.Err = model.NewAppError("downloadTeamDeletionExport", "api.team.deletion_export.not_ready.app_error", nil, "team_id="+c.Params.TeamId, http.StatusNotFound)
+		return
+	}
+
+	file, err := c.App.FileReader(deletion.ExportPath)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	defer file.Close()
+
+	auditRec.Success()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+filepath.Base(deletion.ExportPath)+"\"")
+	http.ServeContent(w, r, filepath.Base(deletion.ExportPath), time.Time{}, file)
+}
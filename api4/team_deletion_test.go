@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamDeletion(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.DeletionWindowDays = 30
+	})
+
+	team := th.CreateTeam()
+
+	t.Run("requires permission", func(t *testing.T) {
+		th.LinkUserToTeam(th.BasicUser2, team)
+
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		resp, err := client.SoftDeleteTeam(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetTeamDeletion(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.CancelTeamDeletion(team.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.DownloadTeamDeletionExport(team.Id, &bytes.Buffer{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("schedule, export and cancel", func(t *testing.T) {
+		_, resp, err := th.Client.GetTeamDeletion(team.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		_, err = th.Client.SoftDeleteTeam(team.Id)
+		require.NoError(t, err)
+
+		fetched, _, err := th.Client.GetTeam(team.Id, "")
+		require.NoError(t, err)
+		assert.Zero(t, fetched.DeleteAt, "the team stays available during the deletion window")
+
+		deletion, _, err := th.Client.GetTeamDeletion(team.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, deletion.RequesterId)
+		assert.NotZero(t, deletion.DeleteAt)
+
+		resp, err = th.Client.SoftDeleteTeam(team.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.DownloadTeamDeletionExport(team.Id, &bytes.Buffer{})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		appErr := th.App.ProcessTeamDeletions()
+		require.Nil(t, appErr)
+
+		var buf bytes.Buffer
+		_, _, err = th.Client.DownloadTeamDeletionExport(team.Id, &buf)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), team.Name)
+
+		_, err = th.Client.CancelTeamDeletion(team.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetTeamDeletion(team.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitTeamGroupRole() {
+	api.BaseRoutes.Team.Handle("/group_roles", api.APISessionRequired(getTeamGroupRoles)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/group_roles/drift", api.APISessionRequired(getTeamGroupRoleDrift)).Methods("GET")
+	api.BaseRoutes.Team.Handle("/group_roles/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(saveTeamGroupRole)).Methods("PUT")
+	api.BaseRoutes.Team.Handle("/group_roles/{group_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteTeamGroupRole)).Methods("DELETE")
+}
+
+func getTeamGroupRoles(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeamRoles) {
+		c.SetPermissionError(model.PermissionManageTeamRoles)
+		return
+	}
+
+	mappings, err := c.App.GetTeamGroupRoles(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(mappings); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func saveTeamGroupRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	var mapping model.TeamGroupRole
+	if jsonErr := json.NewDecoder(r.Body).Decode(&mapping); jsonErr != nil {
+		c.SetInvalidParam("team_group_role")
+		return
+	}
+	mapping.TeamId = c.Params.TeamId
+	mapping.GroupId = c.Params.GroupId
+	mapping.CreatorId = c.AppContext.Session().UserId
+	mapping.CreateAt = 0
+
+	auditRec := c.MakeAuditRecord("saveTeamGroupRole", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", mapping.TeamId)
+	auditRec.AddMeta("group_id", mapping.GroupId)
+	auditRec.AddMeta("role", mapping.Role)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeamRoles) {
+		c.SetPermissionError(model.PermissionManageTeamRoles)
+		return
+	}
+
+	saved, err := c.App.SaveTeamGroupRole(&mapping)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteTeamGroupRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId().RequireGroupId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteTeamGroupRole", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_id", c.Params.TeamId)
+	auditRec.AddMeta("group_id", c.Params.GroupId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeamRoles) {
+		c.SetPermissionError(model.PermissionManageTeamRoles)
+		return
+	}
+
+	if err := c.App.DeleteTeamGroupRole(c.Params.TeamId, c.Params.GroupId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getTeamGroupRoleDrift(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeamRoles) {
+		c.SetPermissionError(model.PermissionManageTeamRoles)
+		return
+	}
+
+	report, err := c.App.GetTeamGroupRoleDrift(c.Params.TeamId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamGroupRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	group := th.CreateGroup()
+	_, appErr := th.App.UpsertGroupMember(group.Id, th.BasicUser2.Id)
+	require.Nil(t, appErr)
+
+	t.Run("team roles are required", func(t *testing.T) {
+		_, resp, err := th.Client.SaveTeamGroupRole(th.BasicTeam.Id, group.Id, model.TeamAdminRoleId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetTeamGroupRoleDrift(th.BasicTeam.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SaveTeamGroupRole(th.BasicTeam.Id, group.Id, model.SystemAdminRoleId)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("unknown group", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SaveTeamGroupRole(th.BasicTeam.Id, model.NewId(), model.TeamAdminRoleId)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("map, report and unmap", func(t *testing.T) {
+		mapping, _, err := th.SystemAdminClient.SaveTeamGroupRole(th.BasicTeam.Id, group.Id, model.TeamAdminRoleId)
+		require.NoError(t, err)
+		assert.Equal(t, th.SystemAdminUser.Id, mapping.CreatorId)
+
+		member, _, err := th.SystemAdminClient.GetTeamMember(th.BasicTeam.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.True(t, member.SchemeAdmin)
+
+		mappings, _, err := th.SystemAdminClient.GetTeamGroupRoles(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, mappings, 1)
+		assert.Equal(t, group.Id, mappings[0].GroupId)
+
+		report, _, err := th.SystemAdminClient.GetTeamGroupRoleDrift(th.BasicTeam.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicTeam.Id, report.TeamId)
+		for _, drift := range report.Drifts {
+			assert.NotEqual(t, th.BasicUser2.Id, drift.UserId)
+		}
+
+		_, err = th.SystemAdminClient.DeleteTeamGroupRole(th.BasicTeam.Id, group.Id)
+		require.NoError(t, err)
+
+		member, _, err = th.SystemAdminClient.GetTeamMember(th.BasicTeam.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.False(t, member.SchemeAdmin)
+	})
+}
@@ -27,6 +27,7 @@ func (api *API) InitTeamLocal() {
 	api.BaseRoutes.Team.Handle("", api.APILocal(updateTeam)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("", api.APILocal(localDeleteTeam)).Methods("DELETE")
 	api.BaseRoutes.Team.Handle("/invite/email", api.APILocal(localInviteUsersToTeam)).Methods("POST")
+	api.BaseRoutes.Team.Handle("/invite/email/preview", api.APILocal(localPreviewInviteEmail)).Methods("POST")
 	api.BaseRoutes.Team.Handle("/patch", api.APILocal(patchTeam)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/privacy", api.APILocal(updateTeamPrivacy)).Methods("PUT")
 	api.BaseRoutes.Team.Handle("/restore", api.APILocal(restoreTeam)).Methods("POST")
@@ -96,6 +97,19 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 	auditRec.AddMeta("count", len(emailList))
 	auditRec.AddMeta("emails", emailList)
 
+	invite, appErr := c.App.RunInviteUsersToTeamWillBeSentHook(c.AppContext, &model.TeamEmailInvite{
+		TeamId: c.Params.TeamId,
+		Emails: emailList,
+	})
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	emailList = invite.Emails
+	if len(invite.Metadata) > 0 {
+		auditRec.AddMeta("invite_metadata", invite.Metadata)
+	}
+
 	team, nErr := c.App.Srv().Store.Team().Get(c.Params.TeamId)
 	if nErr != nil {
 		var nfErr *store.ErrNotFound
@@ -128,7 +142,7 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 		}
 		auditRec.AddMeta("errors", errList)
 		if len(goodEmails) > 0 {
-			err := c.App.Srv().EmailService.SendInviteEmails(team, "Administrator", "mmctl "+model.NewId(), goodEmails, *c.App.Config().ServiceSettings.SiteURL, nil, false)
+			err := c.App.Srv().EmailService.SendInviteEmails(team, "Administrator", "mmctl "+model.NewId(), goodEmails, *c.App.Config().ServiceSettings.SiteURL, nil, nil, false)
 			if err != nil {
 				switch {
 				case errors.Is(err, email.NoRateLimiterError):
@@ -140,6 +154,7 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 				}
 				return
 			}
+			c.App.RunUserInvitedToTeamHook(c.AppContext, invite, goodEmails)
 		}
 		// in graceful mode we return both the successful ones and the failed ones
 		js, jsonErr := json.Marshal(invitesWithErrors)
@@ -161,7 +176,7 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 			c.Err = model.NewAppError("localInviteUsersToTeam", "api.team.invite_members.invalid_email.app_error", map[string]interface{}{"Addresses": s}, "", http.StatusBadRequest)
 			return
 		}
-		err := c.App.Srv().EmailService.SendInviteEmails(team, "Administrator", "mmctl "+model.NewId(), emailList, *c.App.Config().ServiceSettings.SiteURL, nil, false)
+		err := c.App.Srv().EmailService.SendInviteEmails(team, "Administrator", "mmctl "+model.NewId(), emailList, *c.App.Config().ServiceSettings.SiteURL, nil, nil, false)
 		if err != nil {
 			switch {
 			case errors.Is(err, email.NoRateLimiterError):
@@ -173,20 +188,40 @@ func localInviteUsersToTeam(c *Context, w http.ResponseWriter, r *http.Request)
 			}
 			return
 		}
+		c.App.RunUserInvitedToTeamHook(c.AppContext, invite, emailList)
 		ReturnStatusOK(w)
 	}
 	auditRec.Success()
 }
 
+// localPreviewInviteEmail returns the email sent by localInviteUsersToTeam. It can't be test
+// sent, as there is no user to send it to in local mode.
+func localPreviewInviteEmail(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	preview, err := c.App.PreviewInviteEmail(c.Params.TeamId, "", r.URL.Query().Get("send_test") == "true")
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func isEmailAddressAllowed(email string, allowedDomains []string) bool {
 	for _, restriction := range allowedDomains {
-		domains := normalizeDomains(restriction)
+		domains := model.AllowedDomainsList(restriction)
 		if len(domains) <= 0 {
 			continue
 		}
 		matched := false
 		for _, d := range domains {
-			if strings.HasSuffix(email, "@"+d) {
+			if model.AllowedDomainMatchesEmail(d, email) {
 				matched = true
 				break
 			}
@@ -198,12 +233,6 @@ func isEmailAddressAllowed(email string, allowedDomains []string) bool {
 	return true
 }
 
-func normalizeDomains(domains string) []string {
-	// commas and @ signs are optional
-	// can be in the form of "@corp.mattermost.com, mattermost.com mattermost.org" -> corp.mattermost.com mattermost.com mattermost.org
-	return strings.Fields(strings.TrimSpace(strings.ToLower(strings.Replace(strings.Replace(domains, "@", " ", -1), ",", " ", -1))))
-}
-
 func localCreateTeam(c *Context, w http.ResponseWriter, r *http.Request) {
 	var team model.Team
 	if jsonErr := json.NewDecoder(r.Body).Decode(&team); jsonErr != nil {
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitTeamRequest() {
+	api.BaseRoutes.TeamRequests.Handle("", api.APISessionRequired(createTeamRequest)).Methods("POST")
+	api.BaseRoutes.TeamRequests.Handle("", api.APISessionRequired(getTeamRequests)).Methods("GET")
+	api.BaseRoutes.TeamRequests.Handle("/{team_request_id:[A-Za-z0-9]+}", api.APISessionRequired(getTeamRequest)).Methods("GET")
+	api.BaseRoutes.TeamRequests.Handle("/{team_request_id:[A-Za-z0-9]+}/approve", api.APISessionRequired(approveTeamRequest)).Methods("POST")
+	api.BaseRoutes.TeamRequests.Handle("/{team_request_id:[A-Za-z0-9]+}/deny", api.APISessionRequired(denyTeamRequest)).Methods("POST")
+}
+
+func createTeamRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	var teamRequest model.TeamRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&teamRequest); jsonErr != nil {
+		c.SetInvalidParam("team_request")
+		return
+	}
+	teamRequest.RequesterId = c.AppContext.Session().UserId
+
+	auditRec := c.MakeAuditRecord("createTeamRequest", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_name", teamRequest.Name)
+
+	// Users allowed to create teams don't need anyone's approval.
+	if c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionCreateTeam) {
+		c.Err = model.NewAppError("createTeamRequest", "api.team_request.create.team_creation_allowed.app_error", nil, "", http.StatusBadRequest)
+		return
+	}
+
+	created, err := c.App.CreateTeamRequest(c.AppContext, &teamRequest)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("team_request_id", created.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamRequests(c *Context, w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status != "" && !model.IsValidTeamRequestStatus(status) {
+		c.SetInvalidParam("status")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementTeams)
+		return
+	}
+
+	teamRequests, err := c.App.GetTeamRequests(status, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(teamRequests); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTeamRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamRequestId()
+	if c.Err != nil {
+		return
+	}
+
+	teamRequest, err := c.App.GetTeamRequest(c.Params.TeamRequestId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if teamRequest.RequesterId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementTeams)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(teamRequest); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func approveTeamRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	reviewTeamRequest(c, w, r, "approveTeamRequest", c.App.ApproveTeamRequest)
+}
+
+func denyTeamRequest(c *Context, w http.ResponseWriter, r *http.Request) {
+	reviewTeamRequest(c, w, r, "denyTeamRequest", c.App.DenyTeamRequest)
+}
+
+func reviewTeamRequest(c *Context, w http.ResponseWriter, r *http.Request, event string, review func(c *request.Context, requestID, reviewerID, note string) (*model.TeamRequest, *model.AppError)) {
+	c.RequireTeamRequestId()
+	if c.Err != nil {
+		return
+	}
+
+	var teamRequestReview model.TeamRequestReview
+	if r.ContentLength != 0 {
+		if jsonErr := json.NewDecoder(r.Body).Decode(&teamRequestReview); jsonErr != nil {
+			c.SetInvalidParam("team_request_review")
+			return
+		}
+	}
+
+	auditRec := c.MakeAuditRecord(event, audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("team_request_id", c.Params.TeamRequestId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementTeams) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementTeams)
+		return
+	}
+
+	teamRequest, err := review(c.AppContext, c.Params.TeamRequestId, c.AppContext.Session().UserId, teamRequestReview.Note)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("team_id", teamRequest.TeamId)
+
+	if err := json.NewEncoder(w).Encode(teamRequest); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamRequests(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newTeamRequest := func() *model.TeamRequest {
+		return &model.TeamRequest{
+			Name:          GenerateTestTeamName(),
+			DisplayName:   "Requested team",
+			Justification: "Support needs its own team.",
+		}
+	}
+
+	t.Run("users allowed to create teams don't need to ask", func(t *testing.T) {
+		_, resp, err := th.Client.CreateTeamRequest(newTeamRequest())
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	defaultRolePermissions := th.SaveDefaultRolePermissions()
+	defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+	th.RemovePermissionFromRole(model.PermissionCreateTeam.Id, model.SystemUserRoleId)
+
+	approved, resp, err := th.Client.CreateTeamRequest(newTeamRequest())
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, approved.RequesterId)
+	assert.Equal(t, model.TeamRequestStatusPending, approved.Status)
+
+	denied, _, err := th.Client.CreateTeamRequest(newTeamRequest())
+	require.NoError(t, err)
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, resp, err := th.Client.CreateTeamRequest(&model.TeamRequest{Name: GenerateTestTeamName(), DisplayName: "No reason"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.CreateTeamRequest(&model.TeamRequest{Name: th.BasicTeam.Name, DisplayName: "Taken", Justification: "Taken"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("only admins can list and review them", func(t *testing.T) {
+		_, resp, err := th.Client.GetTeamRequests("", 0, 60)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ApproveTeamRequest(approved.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.DenyTeamRequest(denied.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("requester can see their own request", func(t *testing.T) {
+		got, _, err := th.Client.GetTeamRequest(approved.Id)
+		require.NoError(t, err)
+		assert.Equal(t, approved.Id, got.Id)
+
+		client2 := th.CreateClient()
+		th.LoginBasic2WithClient(client2)
+		_, resp, err := client2.GetTeamRequest(approved.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		requests, _, err := th.SystemAdminClient.GetTeamRequests(model.TeamRequestStatusPending, 0, 60)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, request := range requests {
+			ids = append(ids, request.Id)
+		}
+		assert.Contains(t, ids, approved.Id)
+		assert.Contains(t, ids, denied.Id)
+
+		_, resp, err := th.SystemAdminClient.GetTeamRequests("unknown", 0, 60)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		reviewed, _, err := th.SystemAdminClient.ApproveTeamRequest(approved.Id, "Welcome aboard")
+		require.NoError(t, err)
+		assert.Equal(t, model.TeamRequestStatusApproved, reviewed.Status)
+		assert.Equal(t, th.SystemAdminUser.Id, reviewed.ReviewerId)
+		require.NotEmpty(t, reviewed.TeamId)
+
+		team, _, err := th.Client.GetTeam(reviewed.TeamId, "")
+		require.NoError(t, err)
+		assert.Equal(t, approved.Name, team.Name)
+
+		member, _, err := th.Client.GetTeamMember(reviewed.TeamId, th.BasicUser.Id, "")
+		require.NoError(t, err)
+		assert.True(t, member.SchemeAdmin)
+
+		_, resp, err := th.SystemAdminClient.ApproveTeamRequest(approved.Id, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		reviewed, _, err := th.SystemAdminClient.DenyTeamRequest(denied.Id, "Use the existing team")
+		require.NoError(t, err)
+		assert.Equal(t, model.TeamRequestStatusDenied, reviewed.Status)
+		assert.Equal(t, "Use the existing team", reviewed.ReviewNote)
+		assert.Empty(t, reviewed.TeamId)
+
+		_, resp, err := th.SystemAdminClient.ApproveTeamRequest(denied.Id, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.DenyTeamRequest(model.NewId(), "")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
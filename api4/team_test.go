@@ -12,11 +12,13 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/app"
+	"github.com/mattermost/mattermost-server/v6/app/email"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/mail"
@@ -612,6 +614,85 @@ func TestPatchTeamSanitization(t *testing.T) {
 	})
 }
 
+func TestTeamHierarchy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	createChildTeam := func(inheritParentMembership bool) *model.Team {
+		team, _, err := th.SystemAdminClient.CreateTeam(&model.Team{
+			DisplayName:             "Child Team",
+			Name:                    GenerateTestTeamName(),
+			Email:                   th.GenerateTestEmail(),
+			Type:                    model.TeamInvite,
+			ParentTeamId:            th.BasicTeam.Id,
+			InheritParentMembership: inheritParentMembership,
+		})
+		require.NoError(t, err)
+		return team
+	}
+
+	inheritingChild := createChildTeam(true)
+	privateChild := createChildTeam(false)
+	require.Equal(t, th.BasicTeam.Id, inheritingChild.ParentTeamId)
+
+	t.Run("should only list the sub-teams visible to the user", func(t *testing.T) {
+		children, _, err := client.GetChildTeams(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, children, 1)
+		assert.Equal(t, inheritingChild.Id, children[0].Id)
+
+		children, _, err = th.SystemAdminClient.GetChildTeams(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, children, 2)
+	})
+
+	t.Run("should let the members of the parent team see and join inheriting sub-teams", func(t *testing.T) {
+		_, _, err := client.GetTeam(inheritingChild.Id, "")
+		require.NoError(t, err)
+
+		_, resp, err := client.GetTeam(privateChild.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.AddTeamMember(privateChild.Id, th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = client.AddTeamMember(inheritingChild.Id, th.BasicUser.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("should list the ancestors of a team", func(t *testing.T) {
+		ancestors, _, err := client.GetTeamAncestors(inheritingChild.Id)
+		require.NoError(t, err)
+		require.Len(t, ancestors, 1)
+		assert.Equal(t, th.BasicTeam.Id, ancestors[0].Id)
+	})
+
+	t.Run("should require permission to manage the team to move it", func(t *testing.T) {
+		_, resp, err := client.SetParentTeam(inheritingChild.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should not allow cycles", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SetParentTeam(th.BasicTeam.Id, inheritingChild.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("should move a team to the top of the hierarchy", func(t *testing.T) {
+		team, _, err := th.SystemAdminClient.SetParentTeam(privateChild.Id, "")
+		require.NoError(t, err)
+		assert.Empty(t, team.ParentTeamId)
+
+		children, _, err := th.SystemAdminClient.GetChildTeams(th.BasicTeam.Id)
+		require.NoError(t, err)
+		require.Len(t, children, 1)
+	})
+}
+
 func TestUpdateTeamPrivacy(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -770,6 +851,99 @@ func TestTeamUnicodeNames(t *testing.T) {
 	})
 }
 
+func TestSetTeamDataResidency(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("without permission", func(t *testing.T) {
+		_, resp, err := th.Client.SetTeamDataResidency(th.BasicTeam.Id, "eu")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid residency", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SetTeamDataResidency(th.BasicTeam.Id, "EU West")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("residency without storage", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SetTeamDataResidency(th.BasicTeam.Id, "eu")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "app.team.data_residency.unknown.app_error")
+	})
+}
+
+func TestSetTeamStrictIsolation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	otherTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+	outsider := th.CreateUser()
+	th.LinkUserToTeam(outsider, otherTeam)
+
+	_, _, err := th.Client.GetUser(outsider.Id, "")
+	require.NoError(t, err)
+
+	t.Run("without permission", func(t *testing.T) {
+		_, resp, err := th.Client.SetTeamStrictIsolation(th.BasicTeam.Id, model.NewBool(true))
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	team, _, err := th.SystemAdminClient.SetTeamStrictIsolation(th.BasicTeam.Id, model.NewBool(true))
+	require.NoError(t, err)
+	require.NotNil(t, team.StrictIsolation)
+	require.True(t, *team.StrictIsolation)
+
+	t.Run("isolated users only see the members of their teams", func(t *testing.T) {
+		_, resp, err := th.Client.GetUser(outsider.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.Client.GetUser(th.BasicUser2.Id, "")
+		require.NoError(t, err)
+
+		users, _, err := th.Client.AutocompleteUsers(outsider.Username, model.UserSearchDefaultLimit, "")
+		require.NoError(t, err)
+		require.Empty(t, users.Users)
+
+		_, resp, err = th.Client.CreateDirectChannel(th.BasicUser.Id, outsider.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		statuses, _, err := th.Client.GetUsersStatusesByIds([]string{th.BasicUser2.Id, outsider.Id})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, th.BasicUser2.Id, statuses[0].UserId)
+	})
+
+	t.Run("system admins aren't isolated", func(t *testing.T) {
+		_, _, err := th.SystemAdminClient.GetUser(outsider.Id, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("the team can opt out of the isolation of the server", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = false })
+
+		team, _, err := th.SystemAdminClient.SetTeamStrictIsolation(th.BasicTeam.Id, nil)
+		require.NoError(t, err)
+		require.Nil(t, team.StrictIsolation)
+
+		_, resp, err := th.Client.GetUser(outsider.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.SystemAdminClient.SetTeamStrictIsolation(th.BasicTeam.Id, model.NewBool(false))
+		require.NoError(t, err)
+
+		_, _, err = th.Client.GetUser(outsider.Id, "")
+		require.NoError(t, err)
+	})
+}
+
 func TestRegenerateTeamInviteId(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -2568,6 +2742,52 @@ func TestGetTeamStats(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetTeamExtendedStats(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	yesterday := model.GetStartOfDayMillis(time.Now().UTC(), 0) - model.TeamStatsDayMillis
+	_, err := th.App.Srv().Store.Post().Save(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser2.Id,
+		Message:   "yesterday",
+		CreateAt:  yesterday + 1,
+	})
+	require.NoError(t, err)
+	require.NoError(t, th.App.Srv().Store.TeamStats().RollupDay(yesterday))
+
+	t.Run("team members can't see them", func(t *testing.T) {
+		_, resp, err := th.Client.GetTeamExtendedStats(th.BasicTeam.Id, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("team admins can", func(t *testing.T) {
+		th.UpdateUserToTeamAdmin(th.BasicUser, th.BasicTeam)
+		defer th.UpdateUserToNonTeamAdmin(th.BasicUser, th.BasicTeam)
+
+		stats, _, err := th.Client.GetTeamExtendedStats(th.BasicTeam.Id, 0)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicTeam.Id, stats.TeamId)
+		assert.Equal(t, int64(model.TeamExtendedStatsDefaultDays*model.TeamStatsDayMillis), stats.Until-stats.Since)
+		require.NotEmpty(t, stats.DailyStats)
+		assert.Equal(t, yesterday, stats.DailyStats[len(stats.DailyStats)-1].Day)
+		assert.Equal(t, []*model.TeamChannelPostCount{{ChannelId: th.BasicChannel.Id, PostCount: 1}}, stats.PostsPerChannel)
+		assert.Equal(t, []*model.TeamPosterCount{{UserId: th.BasicUser2.Id, PostCount: 1}}, stats.TopPosters)
+
+		_, resp, err := th.Client.GetTeamExtendedStats(th.BasicTeam.Id, model.TeamExtendedStatsMaxDays+1)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("system admins can", func(t *testing.T) {
+		stats, _, err := th.SystemAdminClient.GetTeamExtendedStats(th.BasicTeam.Id, 1)
+		require.NoError(t, err)
+		require.Len(t, stats.DailyStats, 1)
+		assert.Equal(t, yesterday, stats.DailyStats[0].Day)
+	})
+}
+
 func TestUpdateTeamMemberRoles(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3044,6 +3264,20 @@ func TestInviteUsersToTeam(t *testing.T) {
 			"SiteName":        th.App.ClientConfig()["SiteName"]})
 	checkEmail(t, expectedSubject)
 
+	resp, err := th.SystemAdminClient.InviteUsersToTeamWithLocale(th.BasicTeam.Id, emailList, "xx")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	mail.DeleteMailBox(user1)
+	mail.DeleteMailBox(user2)
+	_, err = th.SystemAdminClient.InviteUsersToTeamWithLocale(th.BasicTeam.Id, emailList, "es")
+	require.NoError(t, err)
+	expectedSubject = i18n.GetUserTranslations("es")("api.templates.invite_subject",
+		map[string]interface{}{"SenderName": th.SystemAdminUser.GetDisplayName(nameFormat),
+			"TeamDisplayName": th.BasicTeam.DisplayName,
+			"SiteName":        th.App.ClientConfig()["SiteName"]})
+	checkEmail(t, expectedSubject)
+
 	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.RestrictCreationToDomains = "@global.com,@common.com" })
 
 	th.TestForAllClients(t, func(t *testing.T, client *model.Client4) {
@@ -3103,6 +3337,123 @@ func TestInviteUsersToTeam(t *testing.T) {
 	}, "rate limits")
 }
 
+func TestTeamInviteBudget(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEmailInvitations = true
+		*cfg.TeamSettings.MaxInvitesPerTeamPerDay = 1
+	})
+
+	t.Run("get the budget", func(t *testing.T) {
+		budget, _, err := th.Client.GetTeamInviteBudget(th.BasicTeam.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicTeam.Id, budget.TeamId)
+		assert.Equal(t, int64(1), budget.DailyLimit)
+		assert.Equal(t, int64(1), budget.Remaining)
+		assert.Greater(t, budget.ResetAt, model.GetMillis())
+
+		_, resp, err := th.Client.GetTeamInviteBudget(model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invitations over the budget", func(t *testing.T) {
+		resp, err := th.Client.InviteUsersToTeam(th.BasicTeam.Id, []string{th.GenerateTestEmail(), th.GenerateTestEmail()})
+		require.Error(t, err)
+		CheckErrorID(t, err, app.TeamInviteBudgetExceededError)
+		checkHTTPStatus(t, resp, http.StatusTooManyRequests)
+		assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+	})
+
+	t.Run("grant extra invitations", func(t *testing.T) {
+		_, resp, err := th.Client.GrantTeamInviteBudget(th.BasicTeam.Id, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.GrantTeamInviteBudget(th.BasicTeam.Id, 0)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		budget, _, err := th.SystemAdminClient.GrantTeamInviteBudget(th.BasicTeam.Id, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), budget.ExtraInvites)
+		assert.Equal(t, int64(11), budget.Remaining)
+
+		_, resp, err = th.SystemAdminClient.GrantTeamInviteBudget(model.NewId(), 10)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestPreviewInviteEmail(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmailInvitations = false })
+	_, resp, err := th.Client.PreviewInviteEmail(th.BasicTeam.Id, false)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmailInvitations = true })
+
+	nameFormat := *th.App.Config().TeamSettings.TeammateNameDisplay
+	preview, _, err := th.Client.PreviewInviteEmail(th.BasicTeam.Id, false)
+	require.NoError(t, err)
+	expectedSubject := i18n.T("api.templates.invite_subject",
+		map[string]interface{}{"SenderName": th.BasicUser.GetDisplayName(nameFormat),
+			"TeamDisplayName": th.BasicTeam.DisplayName,
+			"SiteName":        th.App.ClientConfig()["SiteName"]})
+	assert.Equal(t, expectedSubject, preview.Subject)
+	assert.Contains(t, preview.HTMLBody, th.BasicTeam.DisplayName)
+	assert.Contains(t, preview.TextBody, th.BasicTeam.DisplayName)
+	assert.Contains(t, preview.HTMLBody, "/signup_user_complete/\"")
+	assert.Empty(t, preview.SentTo)
+
+	t.Run("requires permission", func(t *testing.T) {
+		otherTeam := th.CreateTeamWithClient(th.SystemAdminClient)
+		_, resp, err := th.Client.PreviewInviteEmail(otherTeam.Id, false)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("test send", func(t *testing.T) {
+		mail.DeleteMailBox(th.BasicUser.Email)
+
+		preview, _, err := th.Client.PreviewInviteEmail(th.BasicTeam.Id, true)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Email, preview.SentTo)
+
+		var resultsMailbox mail.JSONMessageHeaderInbucket
+		err = mail.RetryInbucket(5, func() error {
+			var err error
+			resultsMailbox, err = mail.GetMailBox(th.BasicUser.Email)
+			return err
+		})
+		if err != nil {
+			t.Log(err)
+			t.Log("No email was received, maybe due load on the server. Disabling this verification")
+		}
+		if err == nil && len(resultsMailbox) > 0 {
+			resultsEmail, err := mail.GetMessageFromMailbox(th.BasicUser.Email, resultsMailbox[len(resultsMailbox)-1].ID)
+			if err == nil {
+				require.Equal(t, expectedSubject, resultsEmail.Subject)
+			}
+		}
+	})
+
+	t.Run("local mode", func(t *testing.T) {
+		preview, _, err := th.LocalClient.PreviewInviteEmail(th.BasicTeam.Id, false)
+		require.NoError(t, err)
+		assert.Contains(t, preview.Subject, "Administrator")
+
+		_, resp, err := th.LocalClient.PreviewInviteEmail(th.BasicTeam.Id, true)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestInviteGuestsToTeam(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3213,7 +3564,7 @@ func TestInviteGuestsToTeam(t *testing.T) {
 	t.Run("guest restrictions should not affect inviting new team members", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.RestrictCreationToDomains = "@guest.com" })
 
-		err := th.App.InviteNewUsersToTeam([]string{"user@global.com"}, th.BasicTeam.Id, th.BasicUser.Id)
+		err := th.App.InviteNewUsersToTeam([]string{"user@global.com"}, th.BasicTeam.Id, th.BasicUser.Id, "")
 		require.Nil(t, err, "non guest user invites should not be affected by the guest domain restrictions")
 	})
 
@@ -3574,3 +3925,129 @@ func TestInvalidateAllEmailInvites(t *testing.T) {
 		CheckOKStatus(t, res)
 	})
 }
+
+func TestTeamEmail(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	teamEmail := th.GenerateTestEmail()
+
+	t.Run("change the email", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateTeamEmail(th.BasicTeam.Id, "not an email")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		th.LoginBasic2()
+		_, resp, err = th.Client.UpdateTeamEmail(th.BasicTeam.Id, teamEmail)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		th.LoginBasic()
+
+		team, _, err := th.SystemAdminClient.UpdateTeamEmail(th.BasicTeam.Id, teamEmail)
+		require.NoError(t, err)
+		assert.Equal(t, teamEmail, team.Email)
+		assert.False(t, team.EmailVerified)
+	})
+
+	t.Run("verify the email", func(t *testing.T) {
+		tokens, err := th.App.Srv().Store.Token().GetAllTokensByType(email.TokenTypeVerifyTeamEmail)
+		require.NoError(t, err)
+		var token string
+		for _, tok := range tokens {
+			if strings.Contains(tok.Extra, th.BasicTeam.Id) {
+				token = tok.Token
+			}
+		}
+		require.NotEmpty(t, token)
+
+		resp, err := th.Client.VerifyTeamEmail(model.NewId())
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, err = th.Client.VerifyTeamEmail(token)
+		require.NoError(t, err)
+
+		team, _, err := th.SystemAdminClient.GetTeam(th.BasicTeam.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, teamEmail, team.Email)
+		assert.True(t, team.EmailVerified)
+	})
+
+	t.Run("send the verification again", func(t *testing.T) {
+		th.LoginBasic2()
+		resp, err := th.Client.SendTeamEmailVerification(th.BasicTeam.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		th.LoginBasic()
+
+		_, err = th.SystemAdminClient.SendTeamEmailVerification(th.BasicTeam.Id)
+		require.NoError(t, err)
+	})
+}
+
+func TestValidateTeamAllowedDomains(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	validation, _, err := th.Client.ValidateTeamAllowedDomains("@Example.com, *.eng.example.com under_score.com")
+	require.NoError(t, err)
+	assert.False(t, validation.Valid)
+	assert.Equal(t, []string{"example.com", "*.eng.example.com"}, validation.Domains)
+	require.Len(t, validation.Results, 3)
+	assert.Equal(t, "under_score.com", validation.Results[2].Input)
+	assert.False(t, validation.Results[2].Valid)
+
+	_, resp, err := th.Client.ValidateTeamAllowedDomains(strings.Repeat("a", model.TeamAllowedDomainsMaxLength+1))
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = th.Client.PatchTeam(th.BasicTeam.Id, &model.TeamPatch{AllowedDomains: model.NewString("under_score.com")})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	th.Client.Logout()
+	_, resp, err = th.Client.ValidateTeamAllowedDomains("example.com")
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
+func TestTeamsOrder(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	otherTeam := th.CreateTeam()
+	th.LinkUserToTeam(th.BasicUser, otherTeam)
+
+	order, _, err := client.GetTeamsOrder(th.BasicUser.Id)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{th.BasicTeam.Id, otherTeam.Id}, order)
+
+	order, _, err = client.UpdateTeamsOrder(th.BasicUser.Id, []string{otherTeam.Id, th.BasicTeam.Id})
+	require.NoError(t, err)
+	assert.Equal(t, []string{otherTeam.Id, th.BasicTeam.Id}, order)
+
+	order, _, err = client.MoveTeamInOrder(th.BasicUser.Id, th.BasicTeam.Id, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{th.BasicTeam.Id, otherTeam.Id}, order)
+
+	order, _, err = client.GetTeamsOrder(th.BasicUser.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{th.BasicTeam.Id, otherTeam.Id}, order)
+
+	_, resp, err := client.UpdateTeamsOrder(th.BasicUser.Id, []string{th.BasicTeam.Id})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.MoveTeamInOrder(th.BasicUser.Id, th.BasicTeam.Id, 5)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.GetTeamsOrder(th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.MoveTeamInOrder(th.BasicUser2.Id, th.BasicTeam.Id, 0)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+}
@@ -23,7 +23,7 @@ import (
 )
 
 func (api *API) InitUser() {
-	api.BaseRoutes.Users.Handle("", api.APIHandler(createUser)).Methods("POST")
+	api.BaseRoutes.Users.Handle("", api.APIHandlerIdempotent(createUser)).Methods("POST")
 	api.BaseRoutes.Users.Handle("", api.APISessionRequired(getUsers)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/ids", api.APISessionRequired(getUsersByIds)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/usernames", api.APISessionRequired(getUsersByNames)).Methods("POST")
@@ -72,6 +72,8 @@ func (api *API) InitUser() {
 	api.BaseRoutes.User.Handle("/sessions", api.APISessionRequired(getSessions)).Methods("GET")
 	api.BaseRoutes.User.Handle("/sessions/revoke", api.APISessionRequired(revokeSession)).Methods("POST")
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods("POST")
+	api.BaseRoutes.User.Handle("/devices", api.APISessionRequired(getSessionDevices)).Methods("GET")
+	api.BaseRoutes.User.Handle("/devices/revoke/others", api.APISessionRequired(revokeOtherSessionDevices)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/device", api.APISessionRequired(attachDeviceId)).Methods("PUT")
 	api.BaseRoutes.User.Handle("/audits", api.APISessionRequired(getUserAudits)).Methods("GET")
@@ -91,7 +93,10 @@ func (api *API) InitUser() {
 	api.BaseRoutes.Users.Handle("/migrate_auth/saml", api.APISessionRequired(migrateAuthToSaml)).Methods("POST")
 
 	api.BaseRoutes.User.Handle("/uploads", api.APISessionRequired(getUploadsForUser)).Methods("GET")
+	api.BaseRoutes.User.Handle("/limits", api.APISessionRequired(getUserLimits)).Methods("GET")
 	api.BaseRoutes.User.Handle("/channel_members", api.APISessionRequired(getChannelMembersForUser)).Methods("GET")
+	api.BaseRoutes.User.Handle("/activity", api.APISessionRequired(getUserActivity)).Methods("GET")
+	api.BaseRoutes.User.Handle("/push_notifications/test", api.APISessionRequired(sendTestPushNotifications)).Methods("POST")
 
 	api.BaseRoutes.Users.Handle("/invalid_emails", api.APISessionRequired(getUsersWithInvalidEmails)).Methods("GET")
 
@@ -406,6 +411,13 @@ func getProfileImage(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if signedURL, err := c.App.GetProfileImageURL(user); err != nil {
+		mlog.Warn("Unable to generate signed URL for the profile image", mlog.String("user_id", user.Id), mlog.Err(err))
+	} else if signedURL != "" {
+		http.Redirect(w, r, signedURL, http.StatusFound)
+		return
+	}
+
 	img, readFailed, err := c.App.GetProfileImage(user)
 	if err != nil {
 		c.Err = err
@@ -903,6 +915,18 @@ func getUsersByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 		options.Since = since
 	}
 
+	var fields []string
+	if fieldsString := r.URL.Query().Get("fields"); fieldsString != "" {
+		for _, field := range strings.Split(fieldsString, ",") {
+			field = strings.TrimSpace(field)
+			if !model.IsValidUserField(field) {
+				c.SetInvalidParam("fields")
+				return
+			}
+			fields = append(fields, field)
+		}
+	}
+
 	restrictions, err := c.App.GetViewUsersRestrictions(c.AppContext.Session().UserId)
 	if err != nil {
 		c.Err = err
@@ -910,13 +934,23 @@ func getUsersByIds(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	options.ViewRestrictions = restrictions
 
-	users, err := c.App.GetUsersByIds(userIds, options)
-	if err != nil {
-		c.Err = err
-		return
+	var js []byte
+	var jsonErr error
+	if len(fields) > 0 {
+		profiles, err := c.App.GetUserFieldsByIds(userIds, fields, options)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		js, jsonErr = json.Marshal(profiles)
+	} else {
+		users, err := c.App.GetUsersByIds(userIds, options)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		js, jsonErr = json.Marshal(users)
 	}
-
-	js, jsonErr := json.Marshal(users)
 	if jsonErr != nil {
 		c.Err = model.NewAppError("getUsersByIds", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
 		return
@@ -1215,6 +1249,14 @@ func updateUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The email of a user can't be changed while impersonating them.
+	if user.Email != "" && ouser.Email != user.Email {
+		c.ImpersonationForbidden()
+		if c.Err != nil {
+			return
+		}
+	}
+
 	// If eMail update is attempted by the currently logged in user, check if correct password was provided
 	if user.Email != "" && ouser.Email != user.Email && c.AppContext.Session().UserId == c.Params.UserId {
 		err = c.App.DoubleCheckPassword(ouser, user.Password)
@@ -1288,6 +1330,14 @@ func patchUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The email and password of a user can't be changed while impersonating them.
+	if (patch.Email != nil && ouser.Email != *patch.Email) || patch.Password != nil {
+		c.ImpersonationForbidden()
+		if c.Err != nil {
+			return
+		}
+	}
+
 	// If eMail update is attempted by the currently logged in user, check if correct password was provided
 	if patch.Email != nil && ouser.Email != *patch.Email && c.AppContext.Session().UserId == c.Params.UserId {
 		if patch.Password == nil {
@@ -1511,6 +1561,11 @@ func updateUserActive(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func updateUserAuth(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
+	if c.Err != nil {
+		return
+	}
+
 	if !c.IsSystemAdmin() {
 		c.SetPermissionError(model.PermissionEditOtherUsers)
 		return
@@ -1555,6 +1610,7 @@ func updateUserAuth(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func updateUserMfa(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -1609,6 +1665,7 @@ func updateUserMfa(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func generateMfaSecret(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -1640,6 +1697,7 @@ func generateMfaSecret(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func updatePassword(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -1704,6 +1762,11 @@ func updatePassword(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func resetPassword(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
+	if c.Err != nil {
+		return
+	}
+
 	props := model.MapFromJSON(r.Body)
 
 	token := props["token"]
@@ -2005,6 +2068,7 @@ func getSessions(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func revokeSession(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -2049,6 +2113,7 @@ func revokeSession(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func revokeAllSessionsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -2074,6 +2139,59 @@ func revokeAllSessionsForUser(c *Context, w http.ResponseWriter, r *http.Request
 	ReturnStatusOK(w)
 }
 
+func getSessionDevices(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	devices, appErr := c.App.GetSessionDevices(c.Params.UserId, c.AppContext.Session().Id)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(devices)
+	if err != nil {
+		c.Err = model.NewAppError("getSessionDevices", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(js)
+}
+
+// revokeOtherSessionDevices logs the user out of all the devices but the one making the request,
+// so it's only available to the user themselves.
+func revokeOtherSessionDevices(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeOtherSessionDevices", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetInvalidURLParam("user_id")
+		return
+	}
+
+	if appErr := c.App.RevokeOtherSessionDevices(c.Params.UserId, c.AppContext.Session().Id); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("")
+
+	ReturnStatusOK(w)
+}
+
 func revokeAllSessionsAllUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		c.SetPermissionError(model.PermissionManageSystem)
@@ -2095,6 +2213,11 @@ func revokeAllSessionsAllUsers(c *Context, w http.ResponseWriter, r *http.Reques
 }
 
 func attachDeviceId(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
+	if c.Err != nil {
+		return
+	}
+
 	props := model.MapFromJSON(r.Body)
 
 	deviceId := props["device_id"]
@@ -2163,7 +2286,8 @@ func getUserAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 		auditRec.AddMeta("user", user)
 	}
 
-	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+	// Compliance viewers can read the audits of any user without being able to edit them.
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
 		c.SetPermissionError(model.PermissionEditOtherUsers)
 		return
 	}
@@ -2286,6 +2410,7 @@ func switchAccountType(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func createUserAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
 	c.RequireUserId()
 	if c.Err != nil {
 		return
@@ -2546,6 +2671,11 @@ func disableUserAccessToken(c *Context, w http.ResponseWriter, r *http.Request)
 }
 
 func enableUserAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
+	if c.Err != nil {
+		return
+	}
+
 	props := model.MapFromJSON(r.Body)
 
 	tokenId := props["token_id"]
@@ -2857,6 +2987,104 @@ func getUploadsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+// getUserActivity returns a page of the activity timeline of the user. The users with access to
+// the user management see the whole timeline, as the user does, while the other users only see
+// the events in the channels they are members of, provided the timelines are shown to them.
+func getUserActivity(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var types []string
+	if typesString := r.URL.Query().Get("types"); typesString != "" {
+		types = strings.Split(typesString, ",")
+	}
+
+	session := c.AppContext.Session()
+	visibleToUserID := ""
+	if c.Params.UserId != session.UserId && !c.App.SessionHasPermissionTo(*session, model.PermissionSysconsoleReadUserManagementUsers) {
+		if !*c.App.Config().PrivacySettings.ShowUserActivityTimeline {
+			c.Err = model.NewAppError("getUserActivity", "api.user.get_user_activity.forbidden.app_error", nil, "", http.StatusForbidden)
+			return
+		}
+
+		canSee, err := c.App.UserCanSeeOtherUser(session.UserId, c.Params.UserId)
+		if err != nil || !canSee {
+			c.SetPermissionError(model.PermissionViewMembers)
+			return
+		}
+		visibleToUserID = session.UserId
+	}
+
+	events, err := c.App.GetUserActivity(c.Params.UserId, visibleToUserID, types, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	js, jsonErr := json.Marshal(events)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getUserActivity", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(js)
+}
+
+func getUserLimits(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	limits, err := c.App.GetUserLimits(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	js, jsonErr := json.Marshal(limits)
+	if jsonErr != nil {
+		c.Err = model.NewAppError("getUserLimits", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(js)
+}
+
+func sendTestPushNotifications(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("sendTestPushNotifications", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	results, err := c.App.SendTestPushNotificationsToUser(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("devices", len(results))
+
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getChannelMembersForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (api *API) InitUserMerge() {
+	api.BaseRoutes.Users.Handle("/merge", api.APISessionRequired(mergeUsers)).Methods("POST")
+	api.BaseRoutes.Users.Handle("/merge/{user_merge_id:[A-Za-z0-9]+}", api.APISessionRequired(getUserMerge)).Methods("GET")
+	api.BaseRoutes.Users.Handle("/merge/{user_merge_id:[A-Za-z0-9]+}/revert", api.APISessionRequired(revertUserMerge)).Methods("POST")
+}
+
+func mergeUsers(c *Context, w http.ResponseWriter, r *http.Request) {
+	var mergeRequest model.UserMergeRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&mergeRequest); jsonErr != nil {
+		c.SetInvalidParam("user_merge")
+		return
+	}
+
+	if !model.IsValidId(mergeRequest.PrimaryUserId) {
+		c.SetInvalidParam("primary_user_id")
+		return
+	}
+	if !model.IsValidId(mergeRequest.DuplicateUserId) {
+		c.SetInvalidParam("duplicate_user_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("mergeUsers", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("primary_user_id", mergeRequest.PrimaryUserId)
+	auditRec.AddMeta("duplicate_user_id", mergeRequest.DuplicateUserId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	merge, err := c.App.MergeUsers(c.AppContext.Session().UserId, &mergeRequest)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("user_merge_id", merge.Id)
+	auditRec.AddMeta("job_id", merge.JobId)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(merge); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getUserMerge(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserMergeId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	merge, err := c.App.GetUserMerge(c.Params.UserMergeId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(merge); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func revertUserMerge(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserMergeId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revertUserMerge", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("user_merge_id", c.Params.UserMergeId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	merge, err := c.App.RevertUserMerge(c.Params.UserMergeId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("primary_user_id", merge.PrimaryUserId)
+	auditRec.AddMeta("duplicate_user_id", merge.DuplicateUserId)
+
+	if err := json.NewEncoder(w).Encode(merge); err != nil {
+		mlog.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestUserMerge(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	duplicate := th.CreateUser()
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.MergeUsers(th.BasicUser.Id, duplicate.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetUserMerge(model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.RevertUserMerge(model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid merges", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.MergeUsers(th.BasicUser.Id, "junk")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.MergeUsers(th.BasicUser.Id, th.BasicUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.MergeUsers(th.BasicUser.Id, model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		merge, resp, err := th.SystemAdminClient.MergeUsers(th.BasicUser.Id, duplicate.Id)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.BasicUser.Id, merge.PrimaryUserId)
+		assert.Equal(t, duplicate.Id, merge.DuplicateUserId)
+		assert.Equal(t, th.SystemAdminUser.Id, merge.RequesterId)
+		assert.NotEmpty(t, merge.JobId)
+
+		job, _, err := th.SystemAdminClient.GetJob(merge.JobId)
+		require.NoError(t, err)
+		assert.Equal(t, model.JobTypeUserMerge, job.Type)
+		assert.Equal(t, merge.Id, job.Data["user_merge_id"])
+
+		got, _, err := th.SystemAdminClient.GetUserMerge(merge.Id)
+		require.NoError(t, err)
+		assert.Equal(t, merge.Id, got.Id)
+	})
+
+	t.Run("only finished merges can be reverted", func(t *testing.T) {
+		merge, err := th.App.Srv().Store.UserMerge().Save(&model.UserMerge{
+			PrimaryUserId:   th.BasicUser.Id,
+			DuplicateUserId: th.BasicUser2.Id,
+			RequesterId:     th.SystemAdminUser.Id,
+		})
+		require.NoError(t, err)
+
+		_, resp, rErr := th.SystemAdminClient.RevertUserMerge(merge.Id)
+		require.Error(t, rErr)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, rErr = th.SystemAdminClient.RevertUserMerge(model.NewId())
+		require.Error(t, rErr)
+		CheckNotFoundStatus(t, resp)
+	})
+}
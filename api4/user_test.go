@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
@@ -1597,6 +1598,48 @@ func TestGetUsersByIdsWithOptions(t *testing.T) {
 		assert.Len(t, users, 1)
 		assert.Equal(t, users[0].Id, user2.Id)
 	})
+
+	t.Run("should only return the selected fields of the users", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		_, appErr := th.App.UpdateUser(&model.User{Id: th.BasicUser2.Id, Username: th.BasicUser2.Username, Email: th.BasicUser2.Email, Nickname: "nick", Position: "engineer", FirstName: "First"}, false)
+		require.Nil(t, appErr)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.ShowFullName = false })
+
+		users, _, err := th.Client.GetUsersByIdsWithOptions([]string{th.BasicUser2.Id}, &model.UserGetByIdsOptions{
+			Fields: []string{"username", "nickname", "position", "first_name"},
+		})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, &model.User{Id: th.BasicUser2.Id, Username: th.BasicUser2.Username, Nickname: "nick", Position: "engineer"}, users[0])
+
+		resp, err := th.Client.DoAPIPost("/users/ids?fields=username", model.ArrayToJSON([]string{th.BasicUser2.Id}))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var profiles []map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&profiles))
+		assert.Equal(t, []map[string]interface{}{{"id": th.BasicUser2.Id, "username": th.BasicUser2.Username}}, profiles)
+
+		users, _, err = th.SystemAdminClient.GetUsersByIdsWithOptions([]string{th.BasicUser2.Id}, &model.UserGetByIdsOptions{
+			Fields: []string{"first_name"},
+		})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "First", users[0].FirstName)
+	})
+
+	t.Run("should fail for an unknown field", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		_, resp, err := th.Client.GetUsersByIdsWithOptions([]string{th.BasicUser2.Id}, &model.UserGetByIdsOptions{
+			Fields: []string{"username", "password"},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
 }
 
 func TestGetUsersByGroupChannelIds(t *testing.T) {
@@ -3181,6 +3224,58 @@ func TestRevokeSessions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSessionDevices(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.LoginLocationHeader = "X-Test-Location" })
+
+	client := th.CreateClient()
+	client.HTTPHeader = map[string]string{"X-Test-Location": "Paris, FR"}
+	_, _, err := client.Login(th.BasicUser.Email, th.BasicUser.Password)
+	require.NoError(t, err)
+
+	devices, _, err := client.GetSessionDevices(model.Me)
+	require.NoError(t, err)
+	var current *model.SessionDevice
+	for _, device := range devices {
+		if device.IsCurrent {
+			current = device
+		}
+	}
+	require.NotNil(t, current)
+	assert.Equal(t, "Paris, FR", current.Location)
+	assert.NotEmpty(t, current.IpAddress)
+	assert.NotEmpty(t, current.Fingerprint)
+
+	t.Run("another user's devices", func(t *testing.T) {
+		_, resp, err := client.GetSessionDevices(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.SystemAdminClient.GetSessionDevices(th.BasicUser.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("revoke others of another user", func(t *testing.T) {
+		resp, err := th.SystemAdminClient.RevokeOtherSessionDevices(th.BasicUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("revoke others", func(t *testing.T) {
+		_, err := th.Client.RevokeOtherSessionDevices(model.Me)
+		require.NoError(t, err)
+
+		_, resp, err := client.GetMe("")
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+
+		_, _, err = th.Client.GetMe("")
+		require.NoError(t, err)
+	})
+}
+
 func TestRevokeAllSessions(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -4183,6 +4278,61 @@ func TestCreateUserAccessToken(t *testing.T) {
 	})
 }
 
+func TestCreateScopedUserAccessToken(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserAccessTokens = true })
+	th.App.UpdateUserRoles(th.BasicUser.Id, model.SystemUserRoleId+" "+model.SystemUserAccessTokenRoleId, false)
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, resp, err := th.Client.CreateScopedUserAccessToken(th.BasicUser.Id, "test token", &model.WebSocketScope{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.CreateScopedUserAccessToken(th.BasicUser.Id, "test token", &model.WebSocketScope{ChannelIds: []string{"junk"}})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("websocket restricted to the scope", func(t *testing.T) {
+		scope := &model.WebSocketScope{ChannelIds: []string{th.BasicChannel.Id}, EventTypes: []string{model.WebsocketEventPosted}}
+		rtoken, _, err := th.Client.CreateScopedUserAccessToken(th.BasicUser.Id, "test token", scope)
+		require.NoError(t, err)
+		assert.Equal(t, scope, rtoken.WebSocketScope)
+		assertToken(t, th, rtoken, th.BasicUser.Id)
+
+		client := th.CreateClient()
+		client.AuthToken = rtoken.Token
+		wsClient, err := th.CreateWebSocketClientWithClient(client)
+		require.NoError(t, err)
+		defer wsClient.Close()
+		wsClient.Listen()
+
+		th.CreatePostWithClient(th.SystemAdminClient, th.BasicChannel2)
+		post := th.CreatePostWithClient(th.SystemAdminClient, th.BasicChannel)
+
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-wsClient.EventChannel:
+				if event.EventType() == model.WebsocketEventHello {
+					continue
+				}
+				require.Equal(t, model.WebsocketEventPosted, event.EventType())
+				require.Equal(t, th.BasicChannel.Id, event.GetBroadcast().ChannelId, "events of other channels aren't streamed")
+
+				var received model.Post
+				require.NoError(t, json.Unmarshal([]byte(event.GetData()["post"].(string)), &received))
+				assert.Equal(t, post.Id, received.Id)
+				return
+			case <-timeout:
+				require.Fail(t, "timed out waiting for the post")
+			}
+		}
+	})
+}
+
 func TestGetUserAccessToken(t *testing.T) {
 	t.Run("get for invalid user id", func(t *testing.T) {
 		th := Setup(t).InitBasic()
@@ -6758,3 +6908,181 @@ func TestUserUpdateEvents(t *testing.T) {
 		})
 	})
 }
+
+func TestGetUserLimits(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.FileSettings.UserDailyUploadQuotaBytes = 10
+		*cfg.FileSettings.UserTotalUploadQuotaBytes = 100
+		cfg.FileSettings.UploadQuotaRoleOverrides = map[string]*model.UploadQuota{}
+	})
+
+	t.Run("reports the headroom of the user", func(t *testing.T) {
+		limits, _, err := th.Client.GetUserLimits(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.NotNil(t, limits.Uploads)
+		assert.Equal(t, *model.NewUploadLimits(10, 0, 100, 0), *limits.Uploads)
+
+		_, _, err = th.Client.UploadFile([]byte("data"), th.BasicChannel.Id, "test")
+		require.NoError(t, err)
+
+		limits, _, err = th.Client.GetUserLimits(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, *model.NewUploadLimits(10, 4, 100, 4), *limits.Uploads)
+	})
+
+	t.Run("rejects uploads over the quota", func(t *testing.T) {
+		_, resp, err := th.Client.UploadFile([]byte("more data"), th.BasicChannel.Id, "test")
+		require.Error(t, err)
+		checkHTTPStatus(t, resp, http.StatusRequestEntityTooLarge)
+		CheckErrorID(t, err, "app.upload_quota.daily_exceeded.app_error")
+	})
+
+	t.Run("applies role overrides", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.FileSettings.UploadQuotaRoleOverrides = map[string]*model.UploadQuota{
+				model.SystemUserRoleId: {DailyBytes: 0, TotalBytes: 50},
+			}
+		})
+
+		limits, _, err := th.Client.GetUserLimits(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, *model.NewUploadLimits(0, 4, 50, 4), *limits.Uploads)
+
+		_, _, err = th.Client.UploadFile([]byte("more data"), th.BasicChannel.Id, "test")
+		require.NoError(t, err)
+	})
+
+	t.Run("another user", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserLimits(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		limits, _, err := th.SystemAdminClient.GetUserLimits(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(13), limits.Uploads.DailyUsedBytes)
+	})
+}
+
+func TestSendTestPushNotifications(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var received []*model.PushNotification
+	pushServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg model.PushNotification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		received = append(received, &msg)
+		json.NewEncoder(w).Encode(model.NewOkPushResponse())
+	}))
+	defer pushServer.Close()
+
+	t.Run("push notifications disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.SendPushNotifications = false })
+
+		_, resp, err := th.Client.SendTestPushNotifications(th.BasicUser.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.SendPushNotifications = true
+		*cfg.EmailSettings.PushNotificationServer = pushServer.URL
+	})
+
+	t.Run("another user", func(t *testing.T) {
+		_, resp, err := th.Client.SendTestPushNotifications(th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("one result per device", func(t *testing.T) {
+		results, _, err := th.Client.SendTestPushNotifications(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Empty(t, results)
+
+		_, err = th.Client.AttachDeviceId(model.PushNotifyAndroidReactNative + ":" + model.NewId())
+		require.NoError(t, err)
+		session, appErr := th.App.GetSession(th.Client.AuthToken)
+		require.Nil(t, appErr)
+
+		results, _, err = th.Client.SendTestPushNotifications(th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, session.Id, results[0].SessionId)
+		assert.Equal(t, model.PushNotifyAndroidReactNative, results[0].Platform)
+		assert.Equal(t, model.PushStatusOk, results[0].Status)
+
+		require.Len(t, received, 1)
+		assert.Equal(t, model.PushTypeTest, received[0].Type)
+		assert.Equal(t, results[0].AckId, received[0].AckId)
+	})
+}
+
+func TestGetUserActivity(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	privatePost := th.CreatePostWithClient(th.Client, th.BasicPrivateChannel)
+
+	t.Run("the user sees their whole timeline", func(t *testing.T) {
+		events, _, err := th.Client.GetUserActivity(th.BasicUser.Id, []string{model.ActivityEventTypePost}, 0, 100)
+		require.NoError(t, err)
+		postIDs := []string{}
+		for _, event := range events {
+			postIDs = append(postIDs, event.PostId)
+		}
+		assert.Contains(t, postIDs, th.BasicPost.Id)
+		assert.Contains(t, postIDs, privatePost.Id)
+	})
+
+	t.Run("the timelines of other users aren't shown by default", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserActivity(th.BasicUser2.Id, nil, 0, 100)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("other users only see the events in their channels", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.ShowUserActivityTimeline = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.ShowUserActivityTimeline = false })
+
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		events, _, err := client.GetUserActivity(th.BasicUser.Id, []string{model.ActivityEventTypePost}, 0, 100)
+		require.NoError(t, err)
+		postIDs := []string{}
+		for _, event := range events {
+			postIDs = append(postIDs, event.PostId)
+		}
+		assert.Contains(t, postIDs, th.BasicPost.Id)
+		assert.NotContains(t, postIDs, privatePost.Id)
+	})
+
+	t.Run("admins see the whole timeline", func(t *testing.T) {
+		events, _, err := th.SystemAdminClient.GetUserActivity(th.BasicUser.Id, []string{model.ActivityEventTypePost}, 0, 100)
+		require.NoError(t, err)
+		postIDs := []string{}
+		for _, event := range events {
+			postIDs = append(postIDs, event.PostId)
+		}
+		assert.Contains(t, postIDs, privatePost.Id)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserActivity(th.BasicUser.Id, []string{"invalid"}, 0, 100)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.EnableUserActivityTimeline = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.EnableUserActivityTimeline = true })
+
+		_, resp, err := th.Client.GetUserActivity(th.BasicUser.Id, nil, 0, 100)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
@@ -417,3 +417,40 @@ func TestWebSocketStatuses(t *testing.T) {
 
 	WebSocketClient.Close()
 }
+
+func TestWebSocketConfigCapabilitiesChanged(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableEmailInvitations = false })
+
+	WebSocketClient, err := th.CreateWebSocketClient()
+	require.NoError(t, err)
+	defer WebSocketClient.Close()
+
+	WebSocketClient.Listen()
+
+	resp := <-WebSocketClient.ResponseChannel
+	require.Equal(t, resp.Status, model.StatusOk, "should have responded OK to authentication challenge")
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEmailInvitations = true
+		*cfg.TeamSettings.SiteName = "Capabilities"
+	})
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-WebSocketClient.EventChannel:
+			if event.EventType() != model.WebsocketEventConfigCapabilitiesChanged {
+				continue
+			}
+			capabilities, ok := event.GetData()["capabilities"].(map[string]interface{})
+			require.True(t, ok)
+			require.Equal(t, map[string]interface{}{"EnableEmailInvitations": true}, capabilities)
+			return
+		case <-timeout:
+			require.FailNow(t, "did not receive config_capabilities_changed event")
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func (a *App) isUserActivityTimelineEnabled() bool {
+	return *a.Config().PrivacySettings.EnableUserActivityTimeline
+}
+
+// saveActivityEvents records the events in the activity timelines of their users. The timelines
+// being informative only, failing to record an event doesn't fail the action it records.
+func (a *App) saveActivityEvents(events ...*model.ActivityEvent) {
+	for _, event := range events {
+		if _, err := a.Srv().Store.ActivityEvent().Save(event); err != nil {
+			mlog.Warn("Failed to record an activity event", mlog.String("user_id", event.UserId), mlog.String("type", event.Type), mlog.Err(err))
+		}
+	}
+}
+
+// recordPostActivity records the post, and each file attached to it, in the activity timeline of
+// its author. The system messages aren't recorded.
+func (a *App) recordPostActivity(post *model.Post, channel *model.Channel) {
+	if !a.isUserActivityTimelineEnabled() || post.IsSystemMessage() {
+		return
+	}
+
+	events := make([]*model.ActivityEvent, 0, len(post.FileIds)+1)
+	events = append(events, &model.ActivityEvent{
+		UserId:    post.UserId,
+		Type:      model.ActivityEventTypePost,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+		PostId:    post.Id,
+		CreateAt:  post.CreateAt,
+	})
+	for _, fileID := range post.FileIds {
+		events = append(events, &model.ActivityEvent{
+			UserId:    post.UserId,
+			Type:      model.ActivityEventTypeFileUpload,
+			TeamId:    channel.TeamId,
+			ChannelId: channel.Id,
+			PostId:    post.Id,
+			FileId:    fileID,
+			CreateAt:  post.CreateAt,
+		})
+	}
+	a.saveActivityEvents(events...)
+}
+
+func (a *App) recordReactionActivity(reaction *model.Reaction, channel *model.Channel) {
+	if !a.isUserActivityTimelineEnabled() {
+		return
+	}
+
+	a.saveActivityEvents(&model.ActivityEvent{
+		UserId:    reaction.UserId,
+		Type:      model.ActivityEventTypeReaction,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+		PostId:    reaction.PostId,
+		EmojiName: reaction.EmojiName,
+		CreateAt:  reaction.CreateAt,
+	})
+}
+
+func (a *App) recordChannelJoinActivity(member *model.ChannelMember, channel *model.Channel) {
+	if !a.isUserActivityTimelineEnabled() {
+		return
+	}
+
+	a.saveActivityEvents(&model.ActivityEvent{
+		UserId:    member.UserId,
+		Type:      model.ActivityEventTypeChannelJoin,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+	})
+}
+
+// deleteReactionActivity drops the reaction from the activity timeline of its user. It's done
+// whether the timelines are enabled or not, so that none of the reactions recorded before they
+// were disabled outlives its removal.
+func (a *App) deleteReactionActivity(reaction *model.Reaction) {
+	if err := a.Srv().Store.ActivityEvent().DeleteReaction(reaction.UserId, reaction.PostId, reaction.EmojiName); err != nil {
+		mlog.Warn("Failed to delete the activity event of a reaction", mlog.String("post_id", reaction.PostId), mlog.Err(err))
+	}
+}
+
+// deletePostActivity drops the post, its files and the reactions to it from the activity
+// timelines.
+func (a *App) deletePostActivity(postID string) {
+	if err := a.Srv().Store.ActivityEvent().DeleteForPost(postID); err != nil {
+		mlog.Warn("Failed to delete the activity events of a post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+}
+
+// GetUserActivity returns a page of the activity timeline of the user, newest first. When
+// visibleToUserID is set, only the events in the channels that user is a member of are returned.
+// The types filter the events returned, all of them being returned when empty.
+func (a *App) GetUserActivity(userID, visibleToUserID string, types []string, page, perPage int) ([]*model.ActivityEvent, *model.AppError) {
+	if !a.isUserActivityTimelineEnabled() {
+		return nil, model.NewAppError("GetUserActivity", "app.activity_event.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	for _, eventType := range types {
+		if !model.IsValidActivityEventType(eventType) {
+			return nil, model.NewAppError("GetUserActivity", "app.activity_event.invalid_type.app_error", nil, "type="+eventType, http.StatusBadRequest)
+		}
+	}
+
+	events, err := a.Srv().Store.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{
+		VisibleToUserId: visibleToUserID,
+		Types:           types,
+		Offset:          page * perPage,
+		Limit:           perPage,
+	})
+	if err != nil {
+		return nil, model.NewAppError("GetUserActivity", "app.activity_event.get_for_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestUserActivity(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.CreateUser()
+	th.LinkUserToTeam(user, th.BasicTeam)
+	th.AddUserToChannel(user, th.BasicChannel)
+
+	post, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    user.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "hello",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	reaction := &model.Reaction{UserId: user.Id, PostId: th.BasicPost.Id, EmojiName: "smile"}
+	_, appErr = th.App.SaveReactionForPost(th.Context, reaction)
+	require.Nil(t, appErr)
+
+	t.Run("records the activity of the user", func(t *testing.T) {
+		events, appErr := th.App.GetUserActivity(user.Id, "", nil, 0, 100)
+		require.Nil(t, appErr)
+
+		types := map[string]*model.ActivityEvent{}
+		for _, event := range events {
+			assert.Equal(t, user.Id, event.UserId)
+			assert.Equal(t, th.BasicTeam.Id, event.TeamId)
+			types[event.Type] = event
+		}
+		require.Contains(t, types, model.ActivityEventTypeChannelJoin)
+		require.Contains(t, types, model.ActivityEventTypePost)
+		require.Contains(t, types, model.ActivityEventTypeReaction)
+		assert.Equal(t, post.Id, types[model.ActivityEventTypePost].PostId)
+		assert.Equal(t, th.BasicPost.Id, types[model.ActivityEventTypeReaction].PostId)
+		assert.Equal(t, "smile", types[model.ActivityEventTypeReaction].EmojiName)
+	})
+
+	t.Run("filters the types", func(t *testing.T) {
+		events, appErr := th.App.GetUserActivity(user.Id, "", []string{model.ActivityEventTypePost}, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, events, 1)
+		assert.Equal(t, post.Id, events[0].PostId)
+
+		_, appErr = th.App.GetUserActivity(user.Id, "", []string{"invalid"}, 0, 100)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("drops the removed reactions and deleted posts", func(t *testing.T) {
+		appErr := th.App.DeleteReactionForPost(th.Context, reaction)
+		require.Nil(t, appErr)
+		_, appErr = th.App.DeletePost(post.Id, user.Id)
+		require.Nil(t, appErr)
+
+		events, appErr := th.App.GetUserActivity(user.Id, "", nil, 0, 100)
+		require.Nil(t, appErr)
+		for _, event := range events {
+			assert.Equal(t, model.ActivityEventTypeChannelJoin, event.Type)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.EnableUserActivityTimeline = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.EnableUserActivityTimeline = true })
+
+		_, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    user.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "unrecorded",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.GetUserActivity(user.Id, "", nil, 0, 100)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PrivacySettings.EnableUserActivityTimeline = true })
+		events, appErr := th.App.GetUserActivity(user.Id, "", []string{model.ActivityEventTypePost}, 0, 100)
+		require.Nil(t, appErr)
+		assert.Empty(t, events)
+	})
+}
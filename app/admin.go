@@ -179,6 +179,8 @@ func (s *Server) InvalidateAllCachesSkipSend() {
 	s.Store.FileInfo().ClearCaches()
 	s.Store.Webhook().ClearCaches()
 	linkCache.Purge()
+	s.postMetadataCache.Purge()
+	s.userIsolationCache.Purge()
 	s.LoadLicense()
 }
 
@@ -208,7 +210,8 @@ func (a *App) TestSiteURL(siteURL string) *model.AppError {
 }
 
 func (a *App) TestEmail(userID string, cfg *model.Config) *model.AppError {
-	if *cfg.EmailSettings.SMTPServer == "" {
+	usesSMTP := cfg.EmailSettings.EmailProvider == nil || *cfg.EmailSettings.EmailProvider == model.EmailProviderSMTP
+	if usesSMTP && *cfg.EmailSettings.SMTPServer == "" {
 		return model.NewAppError("testEmail", "api.admin.test_email.missing_server", nil, i18n.T("api.context.invalid_param.app_error", map[string]interface{}{"Name": "SMTPServer"}), http.StatusBadRequest)
 	}
 
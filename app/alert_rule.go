@@ -0,0 +1,261 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// alertRuleFailedLoginPrefix starts the extra info of the audits recorded for failed logins.
+const alertRuleFailedLoginPrefix = "failure - login_id="
+
+func (a *App) CreateAlertRule(rule *model.AlertRule) (*model.AlertRule, *model.AppError) {
+	if appErr := a.checkAlertRule(rule); appErr != nil {
+		return nil, appErr
+	}
+
+	saved, err := a.Srv().Store.AlertRule().Save(rule)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateAlertRule", "app.alert_rule.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateAlertRule", "app.alert_rule.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetAlertRule(id string) (*model.AlertRule, *model.AppError) {
+	rule, err := a.Srv().Store.AlertRule().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetAlertRule", "app.alert_rule.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetAlertRule", "app.alert_rule.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return rule, nil
+}
+
+func (a *App) GetAlertRules() ([]*model.AlertRule, *model.AppError) {
+	rules, err := a.Srv().Store.AlertRule().GetAll()
+	if err != nil {
+		return nil, model.NewAppError("GetAlertRules", "app.alert_rule.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return rules, nil
+}
+
+func (a *App) UpdateAlertRule(rule *model.AlertRule) (*model.AlertRule, *model.AppError) {
+	if appErr := a.checkAlertRule(rule); appErr != nil {
+		return nil, appErr
+	}
+
+	updated, err := a.Srv().Store.AlertRule().Update(rule)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateAlertRule", "app.alert_rule.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("UpdateAlertRule", "app.alert_rule.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return updated, nil
+}
+
+func (a *App) DeleteAlertRule(id string) *model.AppError {
+	if err := a.Srv().Store.AlertRule().Delete(id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteAlertRule", "app.alert_rule.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteAlertRule", "app.alert_rule.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// checkAlertRule makes sure the rule can be evaluated and its notifications delivered: the
+// channel must be a team channel that isn't archived, the users to DM must exist, and the disk
+// usage can only be watched when the file storage driver reports it.
+func (a *App) checkAlertRule(rule *model.AlertRule) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableAdminAlerts {
+		return model.NewAppError("checkAlertRule", "app.alert_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if rule.Metric == model.AlertRuleMetricDiskUsage {
+		if _, ok := a.FileBackend().(filestore.DiskUsageReporter); !ok {
+			return model.NewAppError("checkAlertRule", "app.alert_rule.disk_usage_unsupported.app_error", nil, "driver="+*a.Config().FileSettings.DriverName, http.StatusBadRequest)
+		}
+	}
+
+	if rule.ChannelId != "" {
+		channel, appErr := a.GetChannel(rule.ChannelId)
+		if appErr != nil {
+			return appErr
+		}
+		if channel.IsGroupOrDirect() || channel.DeleteAt != 0 {
+			return model.NewAppError("checkAlertRule", "app.alert_rule.invalid_channel.app_error", nil, "channel_id="+rule.ChannelId, http.StatusBadRequest)
+		}
+	}
+
+	for _, userID := range rule.DMUserIds {
+		if _, appErr := a.GetUser(userID); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
+
+// EvaluateAlertRules reads the metrics of the enabled alert rules and has the system bot notify
+// about the ones that are triggered, unless they were notified about within their cooldown.
+// A rule staying triggered is thus notified about again once its cooldown has passed.
+func (a *App) EvaluateAlertRules() *model.AppError {
+	rules, err := a.Srv().Store.AlertRule().GetAllEnabled()
+	if err != nil {
+		return model.NewAppError("EvaluateAlertRules", "app.alert_rule.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	c := request.EmptyContext()
+	for _, rule := range rules {
+		now := model.GetMillis()
+		value, appErr := a.getAlertRuleMetric(rule, now)
+		if appErr != nil {
+			mlog.Warn("Failed to evaluate alert rule", mlog.String("alert_rule_id", rule.Id), mlog.Err(appErr))
+			continue
+		}
+
+		rule.LastValue = value
+		rule.LastEvaluatedAt = now
+		rule.State = model.AlertRuleStateOk
+		if rule.IsTriggeredBy(value) {
+			rule.State = model.AlertRuleStateTriggered
+			if rule.CanNotify(now) {
+				a.notifyAlertRule(c, rule)
+				rule.LastNotifiedAt = now
+			}
+		}
+
+		if err := a.Srv().Store.AlertRule().UpdateState(rule); err != nil {
+			return model.NewAppError("EvaluateAlertRules", "app.alert_rule.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) getAlertRuleMetric(rule *model.AlertRule, now int64) (int64, *model.AppError) {
+	since := now - int64(rule.WindowMinutes)*60*1000
+
+	switch rule.Metric {
+	case model.AlertRuleMetricFailedLogins:
+		count, err := a.Srv().Store.Audit().GetCountByExtraInfoPrefix(alertRuleFailedLoginPrefix, since)
+		if err != nil {
+			return 0, model.NewAppError("getAlertRuleMetric", "app.alert_rule.get_metric.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return count, nil
+	case model.AlertRuleMetricJobFailures:
+		count, err := a.Srv().Store.Job().GetCountByStatusSince(model.JobStatusError, since)
+		if err != nil {
+			return 0, model.NewAppError("getAlertRuleMetric", "app.alert_rule.get_metric.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return count, nil
+	case model.AlertRuleMetricDiskUsage:
+		reporter, ok := a.FileBackend().(filestore.DiskUsageReporter)
+		if !ok {
+			return 0, model.NewAppError("getAlertRuleMetric", "app.alert_rule.disk_usage_unsupported.app_error", nil, "driver="+*a.Config().FileSettings.DriverName, http.StatusNotImplemented)
+		}
+		used, total, err := reporter.DiskUsage()
+		if err != nil {
+			return 0, model.NewAppError("getAlertRuleMetric", "app.alert_rule.get_metric.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if total == 0 {
+			return 0, nil
+		}
+		return int64(used * 100 / total), nil
+	}
+
+	return 0, model.NewAppError("getAlertRuleMetric", "model.alert_rule.is_valid.metric.app_error", nil, "metric="+rule.Metric, http.StatusBadRequest)
+}
+
+// notifyAlertRule has the system bot post to the channel of the triggered rule and DM its users,
+// each in their own language. A failed notification doesn't keep the others from being sent.
+func (a *App) notifyAlertRule(c *request.Context, rule *model.AlertRule) {
+	message := func(T i18n.TranslateFunc) string {
+		return T("app.alert_rule.notification."+rule.Metric, map[string]interface{}{
+			"Name":          rule.Name,
+			"Value":         rule.LastValue,
+			"Threshold":     rule.Threshold,
+			"WindowMinutes": rule.WindowMinutes,
+		})
+	}
+
+	if rule.ChannelId != "" {
+		if appErr := a.postAlertRuleToChannel(c, rule.ChannelId, message(i18n.T)); appErr != nil {
+			mlog.Warn("Failed to post alert rule notification", mlog.String("alert_rule_id", rule.Id), mlog.String("channel_id", rule.ChannelId), mlog.Err(appErr))
+		}
+	}
+
+	for _, userID := range rule.DMUserIds {
+		user, appErr := a.GetUser(userID)
+		if appErr != nil {
+			mlog.Warn("Failed to get user to notify of alert rule", mlog.String("alert_rule_id", rule.Id), mlog.String("user_id", userID), mlog.Err(appErr))
+			continue
+		}
+		if user.DeleteAt != 0 {
+			continue
+		}
+
+		if appErr := a.sendSystemBotDirectMessage(c, user.Id, message(i18n.GetUserTranslations(user.Locale))); appErr != nil {
+			mlog.Warn("Failed to send alert rule notification", mlog.String("alert_rule_id", rule.Id), mlog.String("user_id", userID), mlog.Err(appErr))
+		}
+	}
+}
+
+func (a *App) postAlertRuleToChannel(c *request.Context, channelID, message string) *model.AppError {
+	systemBot, appErr := a.GetSystemBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	channel, appErr := a.GetChannel(channelID)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.DeleteAt != 0 {
+		return model.NewAppError("postAlertRuleToChannel", "app.alert_rule.invalid_channel.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+	}
+
+	_, appErr = a.CreatePost(c, &model.Post{
+		UserId:    systemBot.UserId,
+		ChannelId: channel.Id,
+		Message:   message,
+	}, channel, false, true)
+	return appErr
+}
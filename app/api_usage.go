@@ -0,0 +1,233 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	apiUsageFlushInterval = time.Minute
+	apiUsageCleanupBatch  = 1000
+
+	// apiUsageAlertWindow is how far back the usage of a token is averaged before
+	// comparing it against the current bucket.
+	apiUsageAlertWindow = 24 * time.Hour
+
+	// apiUsageAlertMinCount avoids alerting on tokens that are barely used.
+	apiUsageAlertMinCount = 100
+)
+
+type apiUsageKey struct {
+	userID   string
+	tokenID  string
+	route    string
+	bucketAt int64
+}
+
+// apiUsageTracker accumulates sampled API calls in memory until they are flushed
+// to the store.
+type apiUsageTracker struct {
+	mut     sync.Mutex
+	pending map[apiUsageKey]int64
+
+	// alerted records the last bucket an alert was logged for each token. The entries are
+	// dropped once their bucket is no longer being flushed.
+	alerted map[string]int64
+}
+
+func newAPIUsageTracker() *apiUsageTracker {
+	return &apiUsageTracker{
+		pending: make(map[apiUsageKey]int64),
+		alerted: make(map[string]int64),
+	}
+}
+
+func (t *apiUsageTracker) add(key apiUsageKey, count int64) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	t.pending[key] += count
+}
+
+// drain returns the pending usages, resetting them. The alerts logged for the buckets older
+// than any of them are forgotten, since those buckets won't be checked again.
+func (t *apiUsageTracker) drain(now int64) []*model.APIUsage {
+	t.mut.Lock()
+	pending := t.pending
+	t.pending = make(map[apiUsageKey]int64)
+
+	oldestBucketAt := model.APIUsageBucket(now)
+	for key := range pending {
+		if key.bucketAt < oldestBucketAt {
+			oldestBucketAt = key.bucketAt
+		}
+	}
+	for tokenID, bucketAt := range t.alerted {
+		if bucketAt < oldestBucketAt {
+			delete(t.alerted, tokenID)
+		}
+	}
+	t.mut.Unlock()
+
+	usages := make([]*model.APIUsage, 0, len(pending))
+	for key, count := range pending {
+		usages = append(usages, &model.APIUsage{
+			UserId:   key.userID,
+			TokenId:  key.tokenID,
+			Route:    key.route,
+			BucketAt: key.bucketAt,
+			Count:    count,
+		})
+	}
+	return usages
+}
+
+// shouldAlert reports whether an alert still has to be logged for the given token and bucket,
+// marking it as logged.
+func (t *apiUsageTracker) shouldAlert(tokenID string, bucketAt int64) bool {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	if t.alerted[tokenID] == bucketAt {
+		return false
+	}
+	t.alerted[tokenID] = bucketAt
+	return true
+}
+
+// RecordAPIUsage counts a call to the given route made with the given session. Only one in
+// APIUsageSampleRate calls is recorded, weighted so that totals remain approximately correct.
+func (a *App) RecordAPIUsage(session *model.Session, route string) {
+	if !*a.Config().ServiceSettings.EnableAPIUsageTracking || session == nil || session.UserId == "" {
+		return
+	}
+
+	rate := *a.Config().ServiceSettings.APIUsageSampleRate
+	if rate > 1 && rand.Intn(rate) != 0 {
+		return
+	}
+
+	if len(route) > model.APIUsageRouteMaxLength {
+		route = route[:model.APIUsageRouteMaxLength]
+	}
+
+	a.Srv().apiUsage.add(apiUsageKey{
+		userID:   session.UserId,
+		tokenID:  session.Props[model.SessionPropUserAccessTokenId],
+		route:    route,
+		bucketAt: model.APIUsageBucket(model.GetMillis()),
+	}, int64(rate))
+}
+
+func (a *App) GetAPIUsageForUser(userID string, since int64) ([]*model.APIUsage, *model.AppError) {
+	usages, err := a.Srv().Store.APIUsage().GetForUser(userID, since)
+	if err != nil {
+		return nil, model.NewAppError("GetAPIUsageForUser", "app.api_usage.get_for_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return usages, nil
+}
+
+func (a *App) GetAPIUsageSummaries(since int64, page, perPage int) ([]*model.APIUsageSummary, *model.AppError) {
+	summaries, err := a.Srv().Store.APIUsage().GetSummaries(since, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetAPIUsageSummaries", "app.api_usage.get_summaries.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return summaries, nil
+}
+
+func runAPIUsageFlushJob(s *Server) {
+	model.CreateRecurringTask("API Usage Flush", func() {
+		doAPIUsageFlush(s)
+	}, apiUsageFlushInterval)
+}
+
+func runAPIUsageCleanupJob(s *Server) {
+	doAPIUsageCleanup(s)
+	model.CreateRecurringTask("API Usage Cleanup", func() {
+		doAPIUsageCleanup(s)
+	}, time.Hour*24)
+}
+
+func doAPIUsageFlush(s *Server) {
+	usages := s.apiUsage.drain(model.GetMillis())
+	if len(usages) == 0 {
+		return
+	}
+
+	if err := s.Store.APIUsage().Increment(usages); err != nil {
+		mlog.Warn("Failed to store API usage", mlog.Err(err))
+		return
+	}
+
+	checked := make(map[string]bool)
+	for _, usage := range usages {
+		if usage.TokenId == "" || checked[usage.TokenId] {
+			continue
+		}
+		checked[usage.TokenId] = true
+		checkAPIUsageAnomaly(s, usage.UserId, usage.TokenId, usage.BucketAt)
+	}
+}
+
+// checkAPIUsageAnomaly logs a warning when the number of calls made with a token in the given
+// bucket exceeds its average over the previous buckets by more than APIUsageAlertThreshold times.
+func checkAPIUsageAnomaly(s *Server, userID, tokenID string, bucketAt int64) {
+	threshold := int64(*s.Config().ServiceSettings.APIUsageAlertThreshold)
+	if threshold == 0 {
+		return
+	}
+
+	counts, err := s.Store.APIUsage().GetTokenCounts(tokenID, bucketAt-int64(apiUsageAlertWindow/time.Millisecond))
+	if err != nil {
+		mlog.Warn("Failed to get API usage for token", mlog.String("token_id", tokenID), mlog.Err(err))
+		return
+	}
+
+	current := counts[bucketAt]
+	var previousTotal, previousBuckets int64
+	for bucket, count := range counts {
+		if bucket < bucketAt {
+			previousTotal += count
+			previousBuckets++
+		}
+	}
+
+	if previousBuckets == 0 || current < apiUsageAlertMinCount {
+		return
+	}
+
+	average := previousTotal / previousBuckets
+	if current <= average*threshold || !s.apiUsage.shouldAlert(tokenID, bucketAt) {
+		return
+	}
+
+	mlog.Warn("Unusual API usage detected for user access token",
+		mlog.String("user_id", userID),
+		mlog.String("token_id", tokenID),
+		mlog.Int64("count", current),
+		mlog.Int64("average", average),
+	)
+}
+
+func doAPIUsageCleanup(s *Server) {
+	endTime := model.GetMillis() - int64(*s.Config().ServiceSettings.APIUsageRetentionDays)*int64(24*time.Hour/time.Millisecond)
+
+	mlog.Debug("Cleaning up API usage store.")
+
+	for {
+		deleted, err := s.Store.APIUsage().PermanentDeleteBatch(endTime, apiUsageCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up API usage", mlog.Err(err))
+			return
+		}
+		if deleted < apiUsageCleanupBatch {
+			return
+		}
+	}
+}
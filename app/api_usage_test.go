@@ -0,0 +1,46 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestAPIUsageTrackerDrain(t *testing.T) {
+	bucketSize := int64(model.APIUsageBucketSize / time.Millisecond)
+	now := model.APIUsageBucket(model.GetMillis())
+	tracker := newAPIUsageTracker()
+
+	tracker.add(apiUsageKey{userID: "user", tokenID: "token", route: "/api/v4/users/me", bucketAt: now}, 10)
+	require.True(t, tracker.shouldAlert("token", now))
+	require.True(t, tracker.shouldAlert("other_token", now-bucketSize))
+
+	usages := tracker.drain(now)
+	require.Len(t, usages, 1)
+	assert.Equal(t, int64(10), usages[0].Count)
+	assert.Empty(t, tracker.drain(now), "expected the usages to be reset")
+
+	t.Run("the alerts of the current bucket are kept", func(t *testing.T) {
+		assert.False(t, tracker.shouldAlert("token", now))
+	})
+
+	t.Run("the alerts of the buckets still being flushed are kept", func(t *testing.T) {
+		tracker.add(apiUsageKey{userID: "user", tokenID: "token", route: "/api/v4/users/me", bucketAt: now}, 10)
+		tracker.drain(now + bucketSize)
+
+		assert.Contains(t, tracker.alerted, "token")
+	})
+
+	t.Run("the alerts are forgotten once the window rolled over", func(t *testing.T) {
+		tracker.drain(now + bucketSize)
+
+		assert.Empty(t, tracker.alerted)
+	})
+}
@@ -43,18 +43,56 @@ type AppIface interface {
 	ListAutocompleteCommands(teamID string, T i18n.TranslateFunc) ([]*model.Command, *model.AppError)
 	// @openTracingParams teamID, skipSlackParsing
 	CreateCommandPost(c *request.Context, post *model.Post, teamID string, response *model.CommandResponse, skipSlackParsing bool) (*model.Post, *model.AppError)
+	// AcceptDirectChannelRetention gives the consent of the other member to a proposed
+	// retention period, from which point the retention job starts enforcing it. The days
+	// must match the proposal so that a user can't accept a period they were not shown.
+	AcceptDirectChannelRetention(userID, channelID string, days int64) (*model.DirectChannelRetention, *model.AppError)
+	// AcknowledgePost records that the user acknowledged a post that requested it, and lets
+	// the members of the channel know.
+	AcknowledgePost(userID, postID string) (*model.PostAcknowledgement, *model.AppError)
 	// AddChannelMember adds a user to a channel. It is a wrapper over AddUserToChannel.
 	AddChannelMember(c *request.Context, userID string, channel *model.Channel, opts ChannelMemberOpts) (*model.ChannelMember, *model.AppError)
 	// AddCursorIdsForPostList adds NextPostId and PrevPostId as cursor to the PostList.
 	// The conditional blocks ensure that it sets those cursor IDs immediately as afterPost, beforePost or empty,
 	// and only query to database whenever necessary.
 	AddCursorIdsForPostList(originalList *model.PostList, afterPost, beforePost string, since int64, page, perPage int, collapsedThreads bool)
+	// AddMutedKeywords adds keywords to the ones already muted by the user.
+	AddMutedKeywords(userID string, keywords model.MutedKeywords) (model.MutedKeywords, *model.AppError)
 	// AddPublicKey will add plugin public key to the config. Overwrites the previous file
 	AddPublicKey(name string, key io.Reader) *model.AppError
+	// AddTeamAlias makes the team reachable by another name, which must not be taken by a team
+	// or by an alias.
+	AddTeamAlias(teamID, name string) (*model.TeamAlias, *model.AppError)
 	// AddUserToChannel adds a user to a given channel.
 	AddUserToChannel(user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
+	// AnswerImpersonationConsent records whether the user approves being impersonated, and lets
+	// the admin who asked know.
+	AnswerImpersonationConsent(c *request.Context, impersonationID string, approve bool) (*model.Impersonation, *model.AppError)
+	// ApprovePostModeration publishes a held post, applies a held edit, or keeps a flagged post.
+	ApprovePostModeration(c *request.Context, moderationID, reviewerID string) (*model.PostModeration, *model.AppError)
+	// ApproveSharedChannelInvite creates the channel of a pending invite, and tells the remote
+	// cluster which sent it to start sharing the channel.
+	ApproveSharedChannelInvite(inviteID, userID string) (*model.SharedChannelInvite, *model.AppError)
+	// ApproveTeamRequest creates the requested team, with the requester as its admin, and lets the
+	// requester know.
+	ApproveTeamRequest(c *request.Context, requestID, reviewerID, note string) (*model.TeamRequest, *model.AppError)
+	// AssignScheme switches every team or channel listed in the assignment to the
+	// given scheme. Small assignments are applied inline and return a nil job;
+	// larger ones are queued as a scheme assignment job whose progress can be polled.
+	AssignScheme(scheme *model.Scheme, assignment *model.SchemeAssignment) (*model.Job, *model.AppError)
+	// AssignSchemeToChannels sets the scheme of all the given channels in a single
+	// transaction. Either every channel is switched or none is.
+	AssignSchemeToChannels(schemeID string, channelIDs []string) *model.AppError
+	// AssignSchemeToTeams sets the scheme of all the given teams in a single
+	// transaction. Either every team is switched or none is.
+	AssignSchemeToTeams(schemeID string, teamIDs []string) *model.AppError
+	// AutocompleteCannedResponses returns the canned responses of the user, and of the team when
+	// teamID is not empty, whose shortcut starts with term. The most used ones come first.
+	AutocompleteCannedResponses(userID, teamID, term string) ([]*model.CannedResponse, *model.AppError)
 	// Caller must close the first return value
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	// CancelTeamDeletion cancels the scheduled deletion of the team and removes its export.
+	CancelTeamDeletion(teamID string) *model.AppError
 	// ChannelMembersMinusGroupMembers returns the set of users in the given channel minus the set of users in the given
 	// groups.
 	//
@@ -74,27 +112,61 @@ type AppIface interface {
 	CheckProviderAttributes(user *model.User, patch *model.UserPatch) string
 	// ClientConfigWithComputed gets the configuration in a format suitable for sending to the client.
 	ClientConfigWithComputed() map[string]string
+	// CompareAndSetPreferences saves the preferences only if none of them changed since the versions
+	// given with them. Otherwise nothing is saved and the result holds the current values of the
+	// preferences that changed.
+	CompareAndSetPreferences(userID string, preferences model.Preferences) (*model.PreferencesCompareAndSetResult, *model.AppError)
+	// CompleteIdempotencyKey records the response of the request made with the key, to be replayed
+	// to its retries.
+	CompleteIdempotencyKey(userID, key string, statusCode int, response string) *model.AppError
 	// ConvertBotToUser converts a bot to user.
 	ConvertBotToUser(bot *model.Bot, userPatch *model.UserPatch, sysadmin bool) (*model.User, *model.AppError)
 	// ConvertUserToBot converts a user to bot.
 	ConvertUserToBot(user *model.User) (*model.Bot, *model.AppError)
 	// CreateBot creates the given bot and corresponding user.
 	CreateBot(c *request.Context, bot *model.Bot) (*model.Bot, *model.AppError)
+	// CreateChannelMemberBulkAddJob queues the addition of the users to the channel. The list of
+	// users is kept in the file store since it can be too long for the data of the job.
+	CreateChannelMemberBulkAddJob(channel *model.Channel, requesterID string, userIDs []string) (*model.ChannelMemberBulkAddReport, *model.AppError)
 	// CreateChannelScheme creates a new Scheme of scope channel and assigns it to the channel.
 	CreateChannelScheme(channel *model.Channel) (*model.Scheme, *model.AppError)
+	// CreateCustomStatusTemplate saves the template. A template overriding another one must belong
+	// to a team, override an organization-wide template and be the only one of the team to
+	// override it.
+	CreateCustomStatusTemplate(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, *model.AppError)
 	// CreateDefaultMemberships adds users to teams and channels based on their group memberships and how those groups
 	// are configured to sync with teams and channels for group members on or after the given timestamp.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
 	CreateDefaultMemberships(c *request.Context, since int64, includeRemovedMembers bool) error
+	// CreateExternalFileInfo records an object hosted outside of the file store as a file that can be
+	// attached to posts. Its bytes are not copied, they're proxied from the host when downloaded.
+	CreateExternalFileInfo(userID string, attachment *model.ExternalFileAttachment) (*model.FileInfo, *model.AppError)
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(c *request.Context, user *model.User) (*model.User, *model.AppError)
+	// CreateImpersonation records the request of a system admin to impersonate a user. Without
+	// the need for the user's consent, the session is started right away and returned along with
+	// its token. Otherwise the user is asked for their consent by a direct message.
+	CreateImpersonation(c *request.Context, impersonation *model.Impersonation) (*model.Impersonation, *model.AppError)
+	// CreateScheduledChannelMessage schedules a recurring message in a team channel, starting with
+	// its first run from now on.
+	CreateScheduledChannelMessage(message *model.ScheduledChannelMessage) (*model.ScheduledChannelMessage, *model.AppError)
+	// CreateSlackImportJob queues the import of a Slack workspace to the team, read from the
+	// export when given or through the Slack API with the token otherwise. The export and the
+	// token are kept in the file store until the import succeeds.
+	CreateSlackImportJob(teamID string, export io.Reader, token string) (*model.Job, *model.AppError)
+	// CreateTeamRequest records the request of a user to have a team created for them, and lets
+	// the system admins know about it.
+	CreateTeamRequest(c *request.Context, teamRequest *model.TeamRequest) (*model.TeamRequest, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(c *request.Context, user *model.User) (*model.User, *model.AppError)
 	// Creates and stores FileInfos for a post created before the FileInfos table existed.
 	MigrateFilenamesToFileInfos(post *model.Post) []*model.FileInfo
+	// DataRetentionDryRun counts the posts the data retention job would delete if it ran now,
+	// without deleting anything.
+	DataRetentionDryRun() (*model.RetentionPolicyDryRun, *model.AppError)
 	// DefaultChannelNames returns the list of system-wide default channel names.
 	//
 	// By default the list will be (not necessarily in this order):
@@ -104,60 +176,179 @@ type AppIface interface {
 	//	['town-square', 'game-of-thrones', 'wow']
 	//
 	DefaultChannelNames() []string
+	// DeleteBotTokenRotation stops replacing the token of the bot. The tokens made by the rotation
+	// still expire.
+	DeleteBotTokenRotation(botUserID string) *model.AppError
+	// DeleteChannelArchivePolicy turns off the archiving of the inactive channels of the team,
+	// and forgets the channels that were warned about it.
+	DeleteChannelArchivePolicy(teamID string) *model.AppError
 	// DeleteChannelScheme deletes a channels scheme and sets its SchemeId to nil.
 	DeleteChannelScheme(channel *model.Channel) (*model.Channel, *model.AppError)
+	// DeleteCustomStatusTemplate deletes the template along with the team templates overriding it.
+	DeleteCustomStatusTemplate(templateID string) *model.AppError
+	// DeleteEmailSuppression removes the address from the suppression list so that emails are
+	// sent to it again.
+	DeleteEmailSuppression(email string) *model.AppError
 	// DeleteGroupConstrainedMemberships deletes team and channel memberships of users who aren't members of the allowed
 	// groups of all group-constrained teams and channels.
 	DeleteGroupConstrainedMemberships(c *request.Context) error
+	// DeleteMutedKeywords unmutes all the keywords muted by the user.
+	DeleteMutedKeywords(userID string) *model.AppError
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
+	// DeleteTeamGroupRole unmaps the group from the team, revoking the team admin role of the members
+	// who held it only through the group.
+	DeleteTeamGroupRole(teamID, groupID string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his membership's roles from
 	// regular user roles to guest roles.
 	DemoteUserToGuest(user *model.User) *model.AppError
+	// DenySharedChannelInvite tells the remote cluster which sent a pending invite that it was
+	// denied.
+	DenySharedChannelInvite(inviteID, userID string) (*model.SharedChannelInvite, *model.AppError)
+	// DenyTeamRequest closes the team request without creating the team and lets the requester know.
+	DenyTeamRequest(c *request.Context, requestID, reviewerID, note string) (*model.TeamRequest, *model.AppError)
+	// DisableChannelCommand stops the command from being run in the channel. Disabling a command
+	// that already is returns the existing override.
+	DisableChannelCommand(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, *model.AppError)
 	// DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
 	// Notifies cluster peers through config change.
 	DisablePlugin(id string) *model.AppError
+	// DismissTeamBanner hides a banner that allows it from the user, on all of their sessions.
+	DismissTeamBanner(userID, bannerID string) *model.AppError
 	// DoPermissionsMigrations execute all the permissions migrations need by the current version.
 	DoPermissionsMigrations() error
+	// EnableChannelCommand lets the command be run in the channel again.
+	EnableChannelCommand(channelID, trigger string) *model.AppError
 	// EnablePlugin will set the config for an installed plugin to enabled, triggering asynchronous
 	// activation if inactive anywhere in the cluster.
 	// Notifies cluster peers through config change.
 	EnablePlugin(id string) *model.AppError
+	// EndImpersonation ends an impersonation before its session expires, or withdraws it before it
+	// starts, revoking its session.
+	EndImpersonation(impersonationID string) (*model.Impersonation, *model.AppError)
+	// EvaluateAlertRules reads the metrics of the enabled alert rules and has the system bot notify
+	// about the ones that are triggered, unless they were notified about within their cooldown.
+	// A rule staying triggered is thus notified about again once its cooldown has passed.
+	EvaluateAlertRules() *model.AppError
 	// Expand announcements in incoming webhooks from Slack. Those announcements
 	// can be found in the text attribute, or in the pretext, text, title and value
 	// attributes of the attachment structure. The Slack attachment structure is
 	// documented here: https://api.slack.com/docs/attachments
 	ProcessSlackAttachments(attachments []*model.SlackAttachment) []*model.SlackAttachment
+	// ExportScheme returns a portable document describing the scheme and the permissions of its
+	// default roles, that ImportScheme can recreate on another server.
+	ExportScheme(scheme *model.Scheme) (*model.SchemeConveyor, *model.AppError)
+	// ExportSidebarCategories returns the layout of the sidebar of the user on the team, to be
+	// applied to another team or user with ImportSidebarCategories.
+	ExportSidebarCategories(userID, teamID string) (*model.SidebarCategoriesExport, *model.AppError)
+	// ExportTeam writes the team, its channels and their posts in the bulk import format. The
+	// users are left out, as their accounts don't belong to the team.
+	ExportTeam(writer io.Writer, teamID string) *model.AppError
 	// ExtendSessionExpiryIfNeeded extends Session.ExpiresAt based on session lengths in config.
 	// A new ExpiresAt is only written if enough time has elapsed since last update.
 	// Returns true only if the session was extended.
 	ExtendSessionExpiryIfNeeded(session *model.Session) bool
+	// ExternalFileReader opens the object behind an external file for reading. The host is checked
+	// again so that removing it from the allowed hosts stops the proxying of files already attached.
+	ExternalFileReader(info *model.FileInfo) (io.ReadCloser, *model.AppError)
+	// FileNeedsWatermark reports whether the file must be watermarked with the username of whoever
+	// downloads it, which is the case for the images and PDFs posted in the channels tagged with
+	// one of the configured sensitivity tags.
+	FileNeedsWatermark(info *model.FileInfo) (bool, *model.AppError)
 	// FillInPostProps should be invoked before saving posts to fill in properties such as
 	// channel_mentions.
 	//
 	// If channel is nil, FillInPostProps will look up the channel corresponding to the post.
 	FillInPostProps(post *model.Post, channel *model.Channel) *model.AppError
+	// FilterDisabledChannelCommands leaves out of the commands the ones disabled in the channel.
+	FilterDisabledChannelCommands(channelID string, commands []*model.Command) ([]*model.Command, *model.AppError)
 	// FilterNonGroupChannelMembers returns the subset of the given user IDs of the users who are not members of groups
 	// associated to the channel excluding bots
 	FilterNonGroupChannelMembers(userIDs []string, channel *model.Channel) ([]string, error)
 	// FilterNonGroupTeamMembers returns the subset of the given user IDs of the users who are not members of groups
 	// associated to the team excluding bots.
 	FilterNonGroupTeamMembers(userIDs []string, team *model.Team) ([]string, error)
+	// FilterUserIDsForIsolation returns the users the user can see among userIDs when they are
+	// strictly isolated, and userIDs unchanged otherwise.
+	FilterUserIDsForIsolation(userID string, userIDs []string) ([]string, *model.AppError)
+	// GetActiveTeamBannersForUser returns the banners of the team that are scheduled to be
+	// shown right now and that the user did not dismiss.
+	GetActiveTeamBannersForUser(teamID, userID string) ([]*model.TeamBanner, *model.AppError)
 	// GetAllLdapGroupsPage retrieves all LDAP groups under the configured base DN using the default or configured group
 	// filter.
 	GetAllLdapGroupsPage(page int, perPage int, opts model.LdapGroupSearchOpts) ([]*model.Group, int, *model.AppError)
 	// GetBot returns the given bot.
 	GetBot(botUserId string, includeDeleted bool) (*model.Bot, *model.AppError)
+	// GetBotState returns the state of the bot under the key, in the scope of the user and channel,
+	// either of which can be empty.
+	GetBotState(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError)
 	// GetBots returns the requested page of bots.
 	GetBots(options *model.BotGetOptions) (model.BotList, *model.AppError)
+	// GetBrandImageURL returns a signed URL for the brand image, or an empty string if signed URLs
+	// are not available or no brand image was uploaded.
+	GetBrandImageURL() (string, *model.AppError)
+	// GetCannedResponseByShortcut returns the canned response with the given shortcut among the
+	// ones of the user and of the team. The user's own response wins when both have the shortcut.
+	GetCannedResponseByShortcut(userID, teamID, shortcut string) (*model.CannedResponse, *model.AppError)
+	// GetCapabilities returns the features the server has enabled. Features that depend on a
+	// license or a plugin are only reported when they actually run.
+	GetCapabilities() *model.Capabilities
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
+	// GetChannelIntegrations returns the incoming webhooks posting to the channel, the outgoing
+	// webhooks it triggers, the slash commands of its team and the bots that are members of it,
+	// along with the users owning them. Integrations disabled in the config are left out.
+	GetChannelIntegrations(channel *model.Channel) (*model.ChannelIntegrations, *model.AppError)
+	// GetChannelLanguageStats returns the number of posts made in each language in the channel
+	// over the given number of days up to the last rollup.
+	GetChannelLanguageStats(channelID string, days int) (*model.ChannelLanguageStats, *model.AppError)
+	// GetChannelMemberBulkAddJob returns the job of a bulk add of members to the channel.
+	GetChannelMemberBulkAddJob(channelID, jobID string) (*model.Job, *model.AppError)
+	// GetChannelMemberBulkAddReport returns how many users the bulk add of members to the channel
+	// added so far, along with the ones it couldn't add.
+	GetChannelMemberBulkAddReport(channelID, jobID string) (*model.ChannelMemberBulkAddReport, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
 	GetChannelModerationsForChannel(channel *model.Channel) ([]*model.ChannelModeration, *model.AppError)
+	// GetChannelPresence returns the users connected to this server who currently have the
+	// channel open.
+	GetChannelPresence(channelID string) (*model.ChannelPresence, *model.AppError)
+	// GetChannelStatsTimeseries returns the daily stats of the channel for every day between since
+	// and until, both being the start of a UTC day. The days without posts, or not rolled up yet,
+	// have zero stats.
+	GetChannelStatsTimeseries(channelID string, since, until int64) (*model.ChannelStatsTimeseries, *model.AppError)
+	// GetChannelsWithDeactivatedCreator returns the channels not archived whose creator was
+	// deactivated, in the team or in all teams when teamID is empty.
+	GetChannelsWithDeactivatedCreator(teamID string, offset, limit int) (model.ChannelList, *model.AppError)
+	// GetChildTeams returns the direct sub-teams of a team.
+	GetChildTeams(teamID string) ([]*model.Team, *model.AppError)
 	// GetClusterPluginStatuses returns the status for plugins installed anywhere in the cluster.
 	GetClusterPluginStatuses() (model.PluginStatuses, *model.AppError)
 	// GetConfigFile proxies access to the given configuration file to the underlying config store.
 	GetConfigFile(name string) ([]byte, error)
+	// GetConfigForVersion returns the sanitized configuration at the given version. The active
+	// version is always available, while others must have been recorded in the config history.
+	GetConfigForVersion(version string) (*model.Config, *model.AppError)
+	// GetConfigHistory returns a page of the config history, newest first, without the
+	// configurations themselves.
+	GetConfigHistory(page, perPage int) ([]*model.ConfigVersion, *model.AppError)
+	// GetConfigVersion returns the version of the active configuration.
+	GetConfigVersion() (string, *model.AppError)
+	// GetCustomStatusSuggestions returns the templates suggested to the members of the team: the
+	// organization-wide ones, each replaced by the team template overriding it if any, followed by
+	// the other templates of the team. Only the organization-wide templates are returned when
+	// teamID is empty.
+	GetCustomStatusSuggestions(teamID string) ([]*model.CustomStatusTemplate, *model.AppError)
+	// GetCustomStatusTemplateUsage returns the templates of the organization and of every team, the
+	// most used first.
+	GetCustomStatusTemplateUsage() ([]*model.CustomStatusTemplate, *model.AppError)
+	// GetCustomStatusTemplatesForTeam returns the templates of the team, or the organization-wide
+	// ones when teamID is empty.
+	GetCustomStatusTemplatesForTeam(teamID string) ([]*model.CustomStatusTemplate, *model.AppError)
+	// GetDeletedPostsForChannel returns the posts of a channel that were deleted recently enough
+	// to be restored, most recently deleted first.
+	GetDeletedPostsForChannel(channelID string, page, perPage int) (*model.PostList, *model.AppError)
+	// GetDisabledChannelCommands returns the overrides disabling slash commands in the channel.
+	GetDisabledChannelCommands(channelID string) ([]*model.ChannelCommandOverride, *model.AppError)
 	// GetEmojiStaticURL returns a relative static URL for system default emojis,
 	// and the API route for custom ones. Errors if not found or if custom and deleted.
 	GetEmojiStaticURL(emojiName string) (string, *model.AppError)
@@ -168,15 +359,27 @@ type AppIface interface {
 	GetFilteredUsersStats(options *model.UserCountOptions) (*model.UsersStats, *model.AppError)
 	// GetGroupsByTeam returns the paged list and the total count of group associated to the given team.
 	GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.AppError)
+	// GetImpersonationsForUser returns a page of the impersonations of the user, newest first.
+	GetImpersonationsForUser(userID string, page, perPage int) ([]*model.Impersonation, *model.AppError)
+	// GetIntegrationAudits returns a page of the audits of the uses of the integrations of the type,
+	// or of all the integrations when empty, limited to the integration when integrationID is set.
+	GetIntegrationAudits(integrationType, integrationID string, page, perPage int) (model.Audits, *model.AppError)
 	// GetKnownUsers returns the list of user ids of users with any direct
 	// relationship with a user. That means any user sharing any channel, including
 	// direct and group channels.
 	GetKnownUsers(userID string) ([]string, *model.AppError)
 	// GetLdapGroup retrieves a single LDAP group by the given LDAP group id.
 	GetLdapGroup(ldapGroupID string) (*model.Group, *model.AppError)
+	// GetLicenseUsage returns the usage of the given number of months up to the current one, oldest
+	// first. The months that weren't rolled up are left out.
+	GetLicenseUsage(months int) (model.LicenseUsageList, *model.AppError)
 	// GetMarketplacePlugins returns a list of plugins from the marketplace-server,
 	// and plugins that are installed locally.
 	GetMarketplacePlugins(filter *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.AppError)
+	// GetOutstandingMentions returns a page of the mentions the user hasn't answered yet, the newest
+	// first, along with the stats of the mentions of the user. Only the mentions in the team are
+	// returned when teamID is set.
+	GetOutstandingMentions(userID, teamID string, page, perPage int) (*model.OutstandingMentionList, *model.AppError)
 	// GetPluginStatus returns the status for a plugin installed on this server.
 	GetPluginStatus(id string) (*model.PluginStatus, *model.AppError)
 	// GetPluginStatuses returns the status for plugins installed on this server.
@@ -187,35 +390,171 @@ type AppIface interface {
 	// To get the plugins environment when the plugins are disabled, manually acquire the plugins
 	// lock instead.
 	GetPluginsEnvironment() *plugin.Environment
+	// GetPostAcknowledgementSummariesForUser returns, for the posts of the user that requested
+	// acknowledgements, how many were received and how many are still outstanding.
+	GetPostAcknowledgementSummariesForUser(userID string, page, perPage int) ([]*model.PostAcknowledgementSummary, *model.AppError)
+	// GetPostSearchCapabilities returns the backend that post searches run against and the
+	// search operators it supports.
+	GetPostSearchCapabilities() *model.SearchCapabilities
+	// GetPostTasksForUser returns the tasks assigned to a user across all of their channels.
+	GetPostTasksForUser(userID string, statuses []string, page, perPage int) ([]*model.PostTask, *model.AppError)
 	// GetProductNotices is called from the frontend to fetch the product notices that are relevant to the caller
 	GetProductNotices(c *request.Context, userID, teamID string, client model.NoticeClientType, clientVersion string, locale string) (model.NoticeMessages, *model.AppError)
+	// GetProfileImageURL returns a signed URL for the profile image uploaded by the user, or an empty
+	// string if signed URLs are not available or the user has the default generated image.
+	GetProfileImageURL(user *model.User) (string, *model.AppError)
 	// GetPublicKey will return the actual public key saved in the `name` file.
 	GetPublicKey(name string) ([]byte, *model.AppError)
+	// GetRESTProxyBridgeStatus returns how far the REST proxy bridge got in publishing its outbox. The
+	// bridge is unhealthy while the next message to publish has failed to be.
+	GetRESTProxyBridgeStatus() (*model.RESTProxyBridgeStatus, *model.AppError)
+	// GetRemoteClusterHealth reports how well the channels shared with the remote cluster are kept
+	// in sync.
+	GetRemoteClusterHealth(remoteClusterId string) (*model.RemoteClusterHealth, *model.AppError)
+	// GetRetentionPolicyPreviewCounts counts, for each channel the policy applies to, the posts
+	// created before endTime and the files attached to them.
+	GetRetentionPolicyPreviewCounts(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, *model.AppError)
+	// GetRetentionPolicyPreviewFile returns the CSV results of a finished retention policy
+	// preview job. The caller must close the file.
+	GetRetentionPolicyPreviewFile(jobID string) (filestore.ReadCloseSeeker, *model.Job, *model.AppError)
 	// GetSanitizedConfig gets the configuration for a system admin without any secrets.
 	GetSanitizedConfig() *model.Config
 	// GetSchemeRolesForChannel Checks if a channel or its team has an override scheme for channel roles and returns the scheme roles or default channel roles.
 	GetSchemeRolesForChannel(channelID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetSessionDevices returns the devices the user is logged in from, telling which one made the
+	// request. Sessions of personal access tokens aren't devices and are left out.
+	GetSessionDevices(userID, currentSessionID string) ([]*model.SessionDevice, *model.AppError)
 	// GetSessionLengthInMillis returns the session length, in milliseconds,
 	// based on the type of session (Mobile, SSO, Web/LDAP).
 	GetSessionLengthInMillis(session *model.Session) int64
+	// GetSharedChannelInvitePreview returns the invite along with the remote cluster which sent it.
+	GetSharedChannelInvitePreview(inviteID string) (*model.SharedChannelInvitePreview, *model.AppError)
+	// GetSignedFileURL returns a time limited URL from which clients can download the file at the
+	// given path directly, or an empty string if signed URLs are disabled or unsupported by the
+	// configured file backend. CloudFront settings are read on every call so that rotated keys
+	// take effect without a restart.
+	GetSignedFileURL(path string) (string, *model.AppError)
+	// GetSinglePostIncludingDeleted returns a post whether or not it was deleted.
+	GetSinglePostIncludingDeleted(postID string) (*model.Post, *model.AppError)
+	// GetSlackImportJob returns the job of a Slack import, telling which team it imports to.
+	GetSlackImportJob(jobID string) (*model.Job, *model.AppError)
+	// GetSlackImportReport returns how the users and channels of the workspace were mapped by
+	// the Slack import, as of its last checkpoint.
+	GetSlackImportReport(jobID string) (*model.SlackImportReport, *model.AppError)
 	// GetSuggestions returns suggestions for user input.
 	GetSuggestions(c *request.Context, commandArgs *model.CommandArgs, commands []*model.Command, roleID string) []model.AutocompleteSuggestion
+	// GetSystemHealth probes the dependencies of the server concurrently. Probes that don't apply,
+	// such as the SMTP server when email notifications are disabled, are left out. The result is
+	// shared for a few seconds, so that frequent requests don't each probe the dependencies.
+	GetSystemHealth() *model.SystemHealth
+	// GetTeamAncestors returns the teams above a team in the hierarchy, starting with its parent.
+	GetTeamAncestors(teamID string) ([]*model.Team, *model.AppError)
+	// GetTeamBanners returns all the banners of the team, including the ones that are not
+	// scheduled to be shown right now.
+	GetTeamBanners(teamID string) ([]*model.TeamBanner, *model.AppError)
+	// GetTeamByAlias returns the team reachable by the alias.
+	GetTeamByAlias(name string) (*model.Team, *model.AppError)
+	// GetTeamExtendedStats returns the stats of the team over the given number of days up to
+	// the last rollup.
+	GetTeamExtendedStats(teamID string, days int) (*model.TeamExtendedStats, *model.AppError)
+	// GetTeamGroupRoleDrift reports the members of the team whose team admin role differs from the one
+	// given by the groups mapped to roles on the team.
+	GetTeamGroupRoleDrift(teamID string) (*model.TeamGroupRoleDriftReport, *model.AppError)
 	// GetTeamGroupUsers returns the users who are associated to the team via GroupTeams and GroupMembers.
 	GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError)
+	// GetTeamIconURL returns a signed URL for the team icon, or an empty string if signed URLs are
+	// not available or the team has no icon.
+	GetTeamIconURL(team *model.Team) (string, *model.AppError)
+	// GetTeamInviteBudget returns how many invitations the given team can send today and how many
+	// of them it sent.
+	GetTeamInviteBudget(teamID string) (*model.TeamInviteBudget, *model.AppError)
+	// GetTeamRequests returns a page of team requests, oldest first, optionally filtered by status.
+	GetTeamRequests(status string, page, perPage int) ([]*model.TeamRequest, *model.AppError)
 	// GetTeamSchemeChannelRoles Checks if a team has an override scheme and returns the scheme channel role names or default channel role names.
 	GetTeamSchemeChannelRoles(teamID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetTeamsOrder returns the ids of the teams of the user in the order the user sorted them.
+	GetTeamsOrder(userID string) ([]string, *model.AppError)
+	// GetThreadSummary returns the participants, the reply counts over time and the most recent
+	// replies of the thread. The participants come with their sanitized profile and the URL of
+	// their profile image.
+	GetThreadSummary(threadID string, opts model.GetThreadSummaryOpts, asAdmin bool) (*model.ThreadSummary, *model.AppError)
 	// GetTotalUsersStats is used for the DM list total
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
+	// GetUserActivity returns a page of the activity timeline of the user, newest first. When
+	// visibleToUserID is set, only the events in the channels that user is a member of are returned.
+	// The types filter the events returned, all of them being returned when empty.
+	GetUserActivity(userID, visibleToUserID string, types []string, page, perPage int) ([]*model.ActivityEvent, *model.AppError)
+	// GetUserFieldsByIds returns the fields of the users along with their ids, by their JSON name. The
+	// fields hidden by the privacy settings are neither fetched nor returned.
+	GetUserFieldsByIds(userIDs []string, fields []string, options *store.UserGetByIdsOpts) ([]map[string]interface{}, *model.AppError)
+	// GetUserLimits returns the limits applying to the given user, along with how much of them is
+	// used.
+	GetUserLimits(userID string) (*model.UserLimits, *model.AppError)
+	// GetWatermarkedFile returns the contents of the file watermarked with the username of the
+	// user. The watermarked variants are kept next to the file so they are only generated once per
+	// user, except for the external files which may change behind the server's back.
+	GetWatermarkedFile(info *model.FileInfo, user *model.User) ([]byte, *model.AppError)
+	// GetWatermarkedImage returns the thumbnail or the preview at the path of the file watermarked
+	// with the username of the user, so that they can't be used to get around the watermark of the
+	// file.
+	GetWatermarkedImage(info *model.FileInfo, imagePath string, user *model.User) ([]byte, *model.AppError)
+	// GetWebhookIdentityOverrides returns a page of the posts of the incoming webhooks asking for
+	// another identity in the channel, newest first.
+	GetWebhookIdentityOverrides(channelID string, page, perPage int) ([]*model.WebhookIdentityOverride, *model.AppError)
+	// GrantTeamInviteBudget lets the given team send extra invitations today, on top of the daily
+	// invitation budget.
+	GrantTeamInviteBudget(teamID string, grant *model.TeamInviteBudgetGrant) (*model.TeamInviteBudget, *model.AppError)
+	// HandleEmailProviderWebhook adds the addresses that the email service provider reported as
+	// bounced or complained about to the suppression list. The webhook must be for the provider
+	// emails are currently sent with, and carry the configured webhook token.
+	HandleEmailProviderWebhook(provider, token string, body []byte) *model.AppError
 	// HasRemote returns whether a given channelID is present in the channel remotes or not.
 	HasRemote(channelID string, remoteID string) (bool, error)
+	// HasTeamVisibilityThroughParent returns true if the team lets the members of its parent
+	// team see and join it, and the user is one of them.
+	HasTeamVisibilityThroughParent(userID string, team *model.Team) bool
 	// HubRegister registers a connection to a hub.
+	// Connections made with a scoped token are refused when none of the channels of the scope
+	// can be streamed.
 	HubRegister(webConn *WebConn)
 	// HubUnregister unregisters a connection from a hub.
 	HubUnregister(webConn *WebConn)
+	// ImportScheme creates the scheme described by a document made by ExportScheme, giving its
+	// roles the exported permissions. onConflict decides what happens when a scheme with the same
+	// name already exists.
+	ImportScheme(conveyor *model.SchemeConveyor, onConflict string) (*model.Scheme, *model.AppError)
+	// ImportSidebarCategories applies the exported layout to the sidebar of the user on the team.
+	// The system categories are updated and the custom ones are matched by display name, creating
+	// those missing. The categories and channels left out of the layout are kept after the others.
+	// Every channel of the layout must be one the user is a member of on the team.
+	ImportSidebarCategories(userID, teamID string, export *model.SidebarCategoriesExport) (*model.OrderedSidebarCategories, *model.AppError)
 	// InstallPlugin unpacks and installs a plugin but does not enable or activate it.
 	InstallPlugin(pluginFile io.ReadSeeker, replace bool) (*model.Manifest, *model.AppError)
+	// IntrospectOAuthToken describes a token issued to the OAuth app of the client, as per RFC 7662.
+	// Tokens that are unknown, expired, or were issued to another app are reported as inactive.
+	IntrospectOAuthToken(clientID, clientSecret, token, tokenTypeHint string) (*model.OAuthIntrospectionResponse, *model.AppError)
+	// InviteNewUsersToTeam sends the invitations to join the team, written in the locale when set,
+	// otherwise in the locale picked for their address, falling back to the sender's.
+	InviteNewUsersToTeam(emailList []string, teamID, senderId, locale string) *model.AppError
+	// InviteNewUsersToTeamGracefully sends the invitations to join the team, reporting the addresses
+	// that couldn't be invited rather than failing. The invitations are written in the locale when
+	// set, otherwise in the locale picked for their address, falling back to the sender's.
+	InviteNewUsersToTeamGracefully(emailList []string, teamID, senderId string, reminderInterval, locale string) ([]*model.EmailInviteWithError, *model.AppError)
+	// IsChannelPresenceShared returns false if the user opted out of letting other members
+	// see which channel they have open.
+	IsChannelPresenceShared(userID string) bool
+	// IsUserStrictlyIsolated tells whether the user can only see the users they share a team with,
+	// which is the case as soon as one of their teams is strictly isolated. A user without a team
+	// follows the setting of the server, and system admins are never isolated.
+	IsUserStrictlyIsolated(userID string) (bool, *model.AppError)
 	// LimitedClientConfigWithComputed gets the configuration in a format suitable for sending to the client.
 	LimitedClientConfigWithComputed() map[string]string
+	// ListBotStateKeys returns a page of the keys the bot has states under in the scope of the user
+	// and channel, in alphabetical order.
+	ListBotStateKeys(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError)
+	// ListChannelCommands returns the autocomplete commands of the team that can be run in the
+	// channel, leaving out the ones disabled in it.
+	ListChannelCommands(channelID, teamID string, T i18n.TranslateFunc) ([]*model.Command, *model.AppError)
 	// LogAuditRec logs an audit record using default LvlAuditCLI.
 	LogAuditRec(rec *audit.Record, err error)
 	// LogAuditRecWithLevel logs an audit record using specified Level.
@@ -230,13 +569,23 @@ type AppIface interface {
 	// MentionsToTeamMembers returns all the @ mentions found in message that
 	// belong to users in the specified team, linking them to their users
 	MentionsToTeamMembers(message, teamID string) model.UserMentionMap
+	// MergeUsers starts a job moving the posts, files, memberships, preferences and sessions of
+	// a duplicate account into the primary one. The returned merge records what the job moved.
+	MergeUsers(requesterID string, mergeRequest *model.UserMergeRequest) (*model.UserMerge, *model.AppError)
 	// MoveChannel method is prone to data races if someone joins to channel during the move process. However this
 	// function is only exposed to sysadmins and the possibility of this edge case is relatively small.
 	MoveChannel(c *request.Context, team *model.Team, channel *model.Channel, user *model.User) *model.AppError
+	// MoveTeamInOrder moves the team to the index in the order of the teams of the user, shifting the
+	// teams in between, and returns the new order.
+	MoveTeamInOrder(userID, teamID string, index int) ([]string, *model.AppError)
 	// NewWebConn returns a new WebConn instance.
 	NewWebConn(cfg *WebConnConfig) *WebConn
 	// NotifySessionsExpired is called periodically from the job server to notify any mobile sessions that have expired.
 	NotifySessionsExpired() error
+	// OAuthAppRateLimit limits the calls made with a session issued to an OAuth app to the rate
+	// limit of the app, writing the response when the call is denied. Every call is counted towards
+	// the usage of the app, whether it was denied or not.
+	OAuthAppRateLimit(session *model.Session, w http.ResponseWriter) bool
 	// OverrideIconURLIfEmoji changes the post icon override URL prop, if it has an emoji icon,
 	// so that it points to the URL (relative) of the emoji - static if emoji is default, /api if custom.
 	OverrideIconURLIfEmoji(post *model.Post)
@@ -244,27 +593,188 @@ type AppIface interface {
 	PatchBot(botUserId string, botPatch *model.BotPatch) (*model.Bot, *model.AppError)
 	// PatchChannelModerationsForChannel Updates a channels scheme roles based on a given ChannelModerationPatch, if the permissions match the higher scoped role the scheme is deleted.
 	PatchChannelModerationsForChannel(channel *model.Channel, channelModerationsPatch []*model.ChannelModerationPatch) ([]*model.ChannelModeration, *model.AppError)
+	// PatchPostTask updates the state of a task and notifies the members of its channel.
+	PatchPostTask(postID string, patch *model.PostTaskPatch) (*model.PostTask, *model.AppError)
 	// Perform an HTTP POST request to an integration's action endpoint.
 	// Caller must consume and close returned http.Response as necessary.
 	// For internal requests, requests are routed directly to a plugin ServerHTTP hook
 	DoActionRequest(c *request.Context, rawURL string, body []byte) (*http.Response, *model.AppError)
 	// PermanentDeleteBot permanently deletes a bot and its corresponding user.
 	PermanentDeleteBot(botUserId string) *model.AppError
+	// PollChannelFeeds polls the feeds due and posts their new items to their channels. A feed
+	// failing to be polled is attempted again at its next interval, its error kept for the
+	// subscribers to see.
+	PollChannelFeeds() *model.AppError
 	// PopulateWebConnConfig checks if the connection id already exists in the hub,
 	// and if so, accordingly populates the other fields of the webconn.
 	PopulateWebConnConfig(s *model.Session, cfg *WebConnConfig, seqVal string) (*WebConnConfig, error)
+	// PreparePostsForClient does what PreparePostForClientWithEmbedsAndImages does for each of
+	// the posts, but loads the reactions, files, priorities and acknowledgements of all the
+	// posts at once.
+	PreparePostsForClient(originalPosts []*model.Post) []*model.Post
+	// PreviewInviteEmail renders the email inviting users to the team, as sent by the given user.
+	// When sendTest is set, the email is also sent to the address of the sender, for them to check
+	// the email settings. An empty senderID previews the email sent in local mode.
+	PreviewInviteEmail(teamID, senderID string, sendTest bool) (*model.TeamInviteEmailPreview, *model.AppError)
+	// PreviewRetentionPolicy queues a job that counts the posts and files the policy would
+	// delete from each of its channels, without saving the policy or deleting anything.
+	PreviewRetentionPolicy(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.Job, *model.AppError)
+	// ProcessBotTokenRotations replaces the tokens of the bots whose rotation is due, and revokes
+	// the tokens that expired. It returns how many tokens were replaced and revoked.
+	ProcessBotTokenRotations() (int, int, *model.AppError)
+	// ProcessChannelArchivePolicies archives the channels that stayed inactive for a week after
+	// being warned, and warns the channels that are a week away from being archived. It returns
+	// how many channels were archived and warned.
+	ProcessChannelArchivePolicies() (int, int, *model.AppError)
+	// ProcessChannelMemberBulkAdd adds the users of the job to its channel in batches, saving the
+	// report after each batch. Unlike AddChannelMember, no message is posted to the channel and
+	// the plugins aren't told about each new member.
+	ProcessChannelMemberBulkAdd(job *model.Job) *model.AppError
+	// ProcessFileDeduplication hashes the files uploaded before their content was hashed, and points
+	// every file to the content of the oldest file of its team with the same hash, removing the copies
+	// no file references anymore. How many files were hashed and deduplicated, and how many bytes were
+	// reclaimed, are reported in the data of the job.
+	ProcessFileDeduplication(job *model.Job) *model.AppError
+	// ProcessFileResidencyMigration moves the files of the team of the job to the storage of its new
+	// data residency. The files are first copied, then the team is routed to the new storage, and the
+	// files are removed from the previous storage once every server routes the team there. Files
+	// created meanwhile are copied again since they may have been written to the previous storage.
+	ProcessFileResidencyMigration(job *model.Job) *model.AppError
+	// ProcessOutstandingMentions deletes the mentions past their retention, then has the system bot
+	// nudge the users about the mentions they left unanswered for a day, if enabled.
+	ProcessOutstandingMentions() *model.AppError
+	// ProcessSlackImport runs the Slack import of the job, saving a checkpoint along with the
+	// report as it goes so that it can be resumed should it stop.
+	ProcessSlackImport(job *model.Job) *model.AppError
+	// ProcessTeamDeletions exports the teams scheduled for deletion that weren't exported yet,
+	// and permanently deletes those whose deletion window is over.
+	ProcessTeamDeletions() *model.AppError
+	// ProcessUserMerge moves the content of the duplicate account of a merge into the primary one,
+	// one table per transaction. The manifest is saved after every table, so that a merge which
+	// failed half way can be either resumed by running the job again or reverted.
+	ProcessUserMerge(job *model.Job) *model.AppError
 	// PromoteGuestToUser Convert user's roles and all his membership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(c *request.Context, user *model.User, requestorId string) *model.AppError
+	// ProposeDirectChannelRetention proposes a retention period to the other member of a
+	// direct channel. It replaces any existing agreement, which stops being enforced until
+	// the new period is accepted. A user's channel with themselves needs no consent.
+	ProposeDirectChannelRetention(userID, channelID string, days int64) (*model.DirectChannelRetention, *model.AppError)
+	// PublishEventWebhookEvent sends the event to the event webhooks subscribed to it, in the
+	// background. The failed deliveries are retried by the event webhooks job.
+	PublishEventWebhookEvent(event string, data interface{})
+	// PublishRESTProxyBridgeMessages publishes the outbox of the REST proxy bridge to the message
+	// queue, in order, then deletes the messages published before their retention. Publishing
+	// stops at the first failure, to be attempted again after a backoff.
+	PublishRESTProxyBridgeMessages() *model.AppError
+	// PurgeDirectChannelRetentions permanently deletes the posts of every direct channel
+	// that are older than the retention period both of its members agreed on.
+	PurgeDirectChannelRetentions() *model.AppError
+	// ReconcileTeamGroupRoles makes the members of the groups mapped to the team admin role team
+	// admins, and revokes the role of those who were granted it by a group they are no longer in.
+	// Team admins made so by hand are left alone, in a mapped group or not.
+	ReconcileTeamGroupRoles(teamID string) *model.AppError
+	// RecordAPIUsage counts a call to the given route made with the given session. Only one in
+	// APIUsageSampleRate calls is recorded, weighted so that totals remain approximately correct.
+	RecordAPIUsage(session *model.Session, route string)
+	// RecordPermissionDenial keeps track of a request of the session denied for lacking any of
+	// the permissions on the resource, when the permission denial log is enabled.
+	RecordPermissionDenial(session *model.Session, permissions []*model.Permission, resourceType, resourceID, path string)
+	// RegenerateEventWebhookSecret replaces the secret the deliveries of the event webhook are
+	// signed with. The pending deliveries are signed with the new one when retried.
+	RegenerateEventWebhookSecret(id string) (*model.EventWebhook, *model.AppError)
+	// RegisterPluginAuditRecordFilter sets the event prefixes an audit record must match to be
+	// passed to the OnAuditRecord hook of the plugin. No prefixes remove the filter.
+	RegisterPluginAuditRecordFilter(pluginID string, eventPrefixes []string)
+	// ReleaseIdempotencyKey forgets the key, so that the request can be retried with it.
+	ReleaseIdempotencyKey(userID, key string) *model.AppError
+	// RemoveDirectChannelRetention withdraws a proposal or the consent to a retention period.
+	// Either member may do so at any time.
+	RemoveDirectChannelRetention(userID, channelID string) *model.AppError
+	// RemovePostModeration discards a held post or edit, or deletes a flagged post.
+	RemovePostModeration(moderationID, reviewerID string) (*model.PostModeration, *model.AppError)
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// RenderSystemPostMessages renders the messages of the system posts in the locale, by post id.
+	// The posts without a renderable message are left out.
+	RenderSystemPostMessages(posts []*model.Post, locale string) map[string]string
+	// ReportPost files the report of the post for the moderators of its team. A user can only report
+	// a post once.
+	ReportPost(post *model.Post, report *model.PostReport) (*model.PostReport, *model.AppError)
+	// ReserveIdempotencyKey records that the user is making the request with the given key. If the
+	// key was already used, and hasn't expired, its record is returned instead so that the request
+	// isn't processed twice.
+	ReserveIdempotencyKey(userID, key, requestHash string) (*model.IdempotencyKey, *model.AppError)
+	// ResolvePostReport closes an open report, deleting the reported post or warning its author
+	// through the system bot when asked to.
+	ResolvePostReport(c *request.Context, reportID, resolverID string, resolution *model.PostReportResolution) (*model.PostReport, *model.AppError)
+	// RestorePost undoes the deletion of a post deleted within the recycle bin period, along with
+	// the replies and files deleted with it.
+	RestorePost(postID string) (*model.Post, *model.AppError)
+	// ResumeSlackImportJob queues a new job that carries on a Slack import which failed or was
+	// canceled, from the last checkpoint it saved.
+	ResumeSlackImportJob(jobID string) (*model.Job, *model.AppError)
+	// RetryEventWebhookDeliveries attempts again the deliveries whose retry is due, then deletes
+	// the deliveries older than their retention.
+	RetryEventWebhookDeliveries() *model.AppError
+	// RevertChannelConversion converts the channel back to the type it had before the conversion
+	// recorded by the event, provided the revert window hasn't expired and the channel wasn't
+	// converted again since.
+	RevertChannelConversion(c *request.Context, event *model.ChannelEvent, user *model.User) (*model.Channel, *model.AppError)
+	// RevertUserMerge moves the rows listed in the manifest of a finished merge back to the
+	// duplicate account.
+	RevertUserMerge(mergeID string) (*model.UserMerge, *model.AppError)
+	// RevokeOAuthToken revokes a token issued to the OAuth app of the client, as per RFC 7009.
+	// Revoking a refresh token revokes its access token as well. Unknown tokens are ignored.
+	RevokeOAuthToken(clientID, clientSecret, token, tokenTypeHint string) *model.AppError
+	// RevokeOtherSessionDevices logs the user out of all their devices but the one of the current
+	// session.
+	RevokeOtherSessionDevices(userID, currentSessionID string) *model.AppError
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RollupChannelLanguageStats counts the posts made in each language in every channel for
+	// every full day since the last rollup. The first rollup backfills the default stats period.
+	RollupChannelLanguageStats() *model.AppError
+	// RollupLicenseUsage computes the usage of the month containing the last full day, so that the
+	// usage of a month is last computed on the first night of the next month.
+	RollupLicenseUsage() *model.AppError
+	// RollupTeamStats computes the team stats, and the channel stats along with them, of every
+	// full day since the last rollup. The first rollup backfills the stats of the default stats
+	// period.
+	RollupTeamStats() *model.AppError
+	// RotateBotToken replaces the token of the bot right away, without waiting for the rotation
+	// to be due.
+	RotateBotToken(botUserID string) (*model.BotTokenRotation, *model.AppError)
+	// RunInviteUsersToTeamWillBeSentHook lets plugins reject or rewrite email invitations to a team
+	// before they are sent. The returned invite holds the email addresses to invite.
+	RunInviteUsersToTeamWillBeSentHook(c *request.Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, *model.AppError)
+	// RunUserInvitedToTeamHook notifies plugins of the email addresses the invite was sent to.
+	RunUserInvitedToTeamHook(c *request.Context, invite *model.TeamEmailInvite, emails []string)
+	// SaveBotTokenRotation creates the token rotation of the bot, or replaces its settings. A
+	// rotation that never replaced the token of the bot does it on the next run of the job.
+	SaveBotTokenRotation(rotation *model.BotTokenRotation) (*model.BotTokenRotation, *model.AppError)
+	// SaveChannelArchivePolicy creates the policy of the team, or replaces it.
+	SaveChannelArchivePolicy(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, *model.AppError)
+	// SaveChannelDigest subscribes the user to a digest of a team channel they are a member of,
+	// or changes the cadence of an existing subscription.
+	SaveChannelDigest(digest *model.ChannelDigest) (*model.ChannelDigest, *model.AppError)
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
 	SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError)
+	// SaveConfigIfVersion replaces the active configuration and notifies cluster peers, provided the
+	// configuration is still at the given version, as returned by GetConfigVersion. An empty version
+	// replaces the configuration unconditionally. The change is recorded in the config history on
+	// behalf of the given user.
+	SaveConfigIfVersion(newCfg *model.Config, version, userID string) (*model.Config, *model.Config, *model.AppError)
+	// SaveTeamGroupRole maps the group to the role on the team, then reconciles the team admins of
+	// the team right away.
+	SaveTeamGroupRole(mapping *model.TeamGroupRole) (*model.TeamGroupRole, *model.AppError)
+	// ScheduleTeamDeletion schedules the permanent deletion of the team at the end of the
+	// deletion window, and tells the members of the team about it. Until then, the team stays
+	// available and its admins can download its export or cancel the deletion.
+	ScheduleTeamDeletion(requesterID, teamID string) (*model.TeamDeletion, *model.AppError)
 	// SearchAllChannels returns a list of channels, the total count of the results of the search (if the paginate search option is true), and an error.
 	SearchAllChannels(term string, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
@@ -272,14 +782,37 @@ type AppIface interface {
 	// SendAdminUpgradeRequestEmail takes the username of user trying to alert admins and then applies rate limit of n (number of admins) emails per user per day
 	// before sending the emails.
 	SendAdminUpgradeRequestEmail(username string, subscription *model.Subscription, action string) *model.AppError
+	// SendChannelDigests emails every user whose daily or weekly digests are due a summary
+	// of the activity in their muted channels since the digest was last sent.
+	SendChannelDigests() *model.AppError
+	// SendDueScheduledChannelMessages posts the scheduled messages whose next run is due. Each run
+	// is claimed before being posted so that it's posted once, even by concurrent jobs. The runs
+	// missed while the server was down aren't caught up on.
+	SendDueScheduledChannelMessages() *model.AppError
 	// SendNoCardPaymentFailedEmail
 	SendNoCardPaymentFailedEmail() *model.AppError
+	// SendTeamEmailVerification sends a link verifying the email address of the team to that
+	// address. Links sent before stop working.
+	SendTeamEmailVerification(team *model.Team) *model.AppError
+	// SendTestPushNotificationsToUser sends a test push notification to every mobile device the user
+	// is logged in on and reports what the push proxy answered for each of them.
+	SendTestPushNotificationsToUser(userID string) ([]*model.PushNotificationTestResult, *model.AppError)
 	// SessionHasPermissionToManageBot returns nil if the session has access to manage the given bot.
 	// This function deviates from other authorization checks in returning an error instead of just
 	// a boolean, allowing the permission failure to be exposed with more granularity.
 	SessionHasPermissionToManageBot(session model.Session, botUserId string) *model.AppError
 	// SessionIsRegistered determines if a specific session has been registered
 	SessionIsRegistered(session model.Session) bool
+	// SetBotState sets the state of the bot under the key, in the scope of the user and channel. The
+	// state expires expireInSeconds from now, or never when zero.
+	SetBotState(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError)
+	// SetChannelPresence records that the session has the channel open, or that it closed the
+	// channel it had open if channelID is empty. The presence of users who opted out is never
+	// recorded.
+	SetChannelPresence(session model.Session, channelID string) *model.AppError
+	// SetParentTeam moves a team under another one, or to the top of the hierarchy if
+	// parentTeamID is empty. The sub-teams of the team move along with it.
+	SetParentTeam(teamID, parentTeamID string) (*model.Team, *model.AppError)
 	// SetSessionExpireInDays sets the session's expiry the specified number of days
 	// relative to either the session creation date or the current time, depending
 	// on the `ExtendSessionOnActivity` config setting.
@@ -291,6 +824,15 @@ type AppIface interface {
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userID string, activityAt int64)
+	// SetTeamDataResidency queues the move of the files of the team to the storage of the data
+	// residency. The files keep being read from the current storage until all of them are copied.
+	SetTeamDataResidency(teamID, residency string) (*model.FileResidencyMigration, *model.AppError)
+	// SetTeamStrictIsolation overrides the strict user isolation of the server for the team, or
+	// makes the team follow it again when isolation is nil.
+	SetTeamStrictIsolation(teamID string, isolation *bool) (*model.Team, *model.AppError)
+	// StartImpersonation creates the session of an approved impersonation, lasting for its duration,
+	// and lets the user know. The token of the session is only returned here.
+	StartImpersonation(c *request.Context, impersonationID string) (*model.Impersonation, *model.AppError)
 	// SyncLdap starts an LDAP sync job.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
@@ -305,6 +847,10 @@ type AppIface interface {
 	// the member's group memberships and the configuration of those groups to the syncable. This method should only
 	// be invoked on group-synced (aka group-constrained) syncables.
 	SyncSyncableRoles(syncableID string, syncableType model.GroupSyncableType) *model.AppError
+	// SyncTeamGroupRoles reconciles the team admins of every team with groups mapped to roles, or with
+	// roles still granted by groups. A team failing to reconcile doesn't keep the others from being
+	// reconciled.
+	SyncTeamGroupRoles() *model.AppError
 	// TeamMembersMinusGroupMembers returns the set of users on the given team minus the set of users in the given
 	// groups.
 	//
@@ -325,6 +871,11 @@ type AppIface interface {
 	CreateZipFileAndAddFiles(fileBackend filestore.FileBackend, fileDatas []model.FileData, zipFileName, directory string) error
 	// This to be used for places we check the users password when they are already logged in
 	DoubleCheckPassword(user *model.User, password string) *model.AppError
+	// TransferChannelCreator makes the member the creator of the channel. The integrations the
+	// previous creator owns in the channel are returned, for the new creator to take over.
+	TransferChannelCreator(channel *model.Channel, userID string) (*model.ChannelCreatorTransfer, *model.AppError)
+	// UnacknowledgePost withdraws the acknowledgement of a post by the user.
+	UnacknowledgePost(userID, postID string) *model.AppError
 	// UpdateBotActive marks a bot as active or inactive, along with its corresponding user.
 	UpdateBotActive(c *request.Context, botUserId string, active bool) (*model.Bot, *model.AppError)
 	// UpdateBotOwner changes a bot's owner to the given value.
@@ -333,11 +884,36 @@ type AppIface interface {
 	UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppError)
 	// UpdateChannelScheme saves the new SchemeId of the channel passed.
 	UpdateChannelScheme(channel *model.Channel) (*model.Channel, *model.AppError)
+	// UpdateChannelThreadSettings sets the default thread behavior of the channel, which only public
+	// and private channels have.
+	UpdateChannelThreadSettings(channel *model.Channel, settings *model.ChannelThreadSettings) (*model.Channel, *model.AppError)
 	// UpdateDNDStatusOfUsers is a recurring task which is started when server starts
 	// which unsets dnd status of users if needed and saves and broadcasts it
 	UpdateDNDStatusOfUsers()
+	// UpdateMutedKeywords replaces the muted keywords of the user. An empty list unmutes
+	// everything.
+	UpdateMutedKeywords(userID string, keywords model.MutedKeywords) (model.MutedKeywords, *model.AppError)
 	// UpdateProductNotices is called periodically from a scheduled worker to fetch new notices and update the cache
 	UpdateProductNotices() *model.AppError
+	// UpdateRemoteClusterInvitePolicy sets what happens to the shared channel invites received
+	// from the remote cluster.
+	UpdateRemoteClusterInvitePolicy(remoteClusterId string, policy *model.RemoteClusterInvitePolicy) (*model.RemoteCluster, *model.AppError)
+	// UpdateRemoteClusterProfilePolicy sets which user profile fields are masked when users
+	// are synchronized with the remote cluster.
+	UpdateRemoteClusterProfilePolicy(remoteClusterId string, policy *model.RemoteClusterProfilePolicy) (*model.RemoteCluster, *model.AppError)
+	// UpdateScheduledChannelMessage changes the message or schedule of a scheduled message. The next
+	// run is computed again from now on, so that a changed schedule applies right away.
+	UpdateScheduledChannelMessage(message *model.ScheduledChannelMessage) (*model.ScheduledChannelMessage, *model.AppError)
+	// UpdateTeamEmail changes the email address of the team, which stays unverified until the link
+	// sent to the new address is followed.
+	UpdateTeamEmail(teamID, newEmail string) (*model.Team, *model.AppError)
+	// UpdateTeamName renames the team on behalf of the user. The previous name keeps redirecting to
+	// the team, and can't be taken by another team, for the rename redirect window, and the members
+	// are told about the new name in the default channel of the team.
+	UpdateTeamName(c *request.Context, teamID, newName, userID string) (*model.Team, *model.AppError)
+	// UpdateTeamsOrder sorts the teams of the user in the order. It must hold every team of the user,
+	// and only these.
+	UpdateTeamsOrder(userID string, order []string) *model.AppError
 	// UpdateViewedProductNotices is called from the frontend to mark a set of notices as 'viewed' by user
 	UpdateViewedProductNotices(userID string, noticeIds []string) *model.AppError
 	// UpdateViewedProductNoticesForNewUser is called when new user is created to mark all current notices for this
@@ -357,11 +933,23 @@ type AppIface interface {
 	// the same length. clientIds should either not be provided or have the same length as files and filenames.
 	// The provided files should be closed by the caller so that they are not leaked.
 	UploadFiles(c *request.Context, teamID string, channelID string, userID string, files []io.ReadCloser, filenames []string, clientIds []string, now time.Time) (*model.FileUploadResponse, *model.AppError)
+	// UseCannedResponse renders the canned response with the given variable values and counts
+	// the use. Failing to count the use does not prevent the response from being used.
+	UseCannedResponse(response *model.CannedResponse, variables map[string]string) string
 	// UserIsInAdminRoleGroup returns true at least one of the user's groups are configured to set the members as
 	// admins in the given syncable.
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
+	// ValidateInteractiveDialogStep has the integration validate a step of a multi-step dialog
+	// before the next one is shown, the errors of the response being shown on the step.
+	ValidateInteractiveDialogStep(c *request.Context, request model.SubmitDialogRequest) (*model.SubmitDialogResponse, *model.AppError)
+	// ValidateTeamAllowedDomains parses a list of allowed domains the way it would be when saved with
+	// a team, the domains not permitted by TeamSettings.RestrictCreationToDomains being invalid.
+	ValidateTeamAllowedDomains(allowedDomains string) *model.TeamAllowedDomainsValidation
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
 	VerifyPlugin(plugin, signature io.ReadSeeker) *model.AppError
+	// VerifyTeamEmailFromToken marks the email address of a team as verified, as long as it's still
+	// the address the token was sent to.
+	VerifyTeamEmailFromToken(userSuppliedTokenString string) (*model.Team, *model.AppError)
 	//GetUserStatusesByIds used by apiV4
 	GetUserStatusesByIds(userIDs []string) ([]*model.Status, *model.AppError)
 	AccountMigration() einterfaces.AccountMigrationInterface
@@ -443,11 +1031,15 @@ type AppIface interface {
 	Compliance() einterfaces.ComplianceInterface
 	Config() *model.Config
 	CopyFileInfos(userID string, fileIDs []string) ([]string, *model.AppError)
+	CreateAlertRule(rule *model.AlertRule) (*model.AlertRule, *model.AppError)
+	CreateCannedResponse(response *model.CannedResponse) (*model.CannedResponse, *model.AppError)
 	CreateChannel(c *request.Context, channel *model.Channel, addMember bool) (*model.Channel, *model.AppError)
+	CreateChannelFeed(channelFeed *model.ChannelFeed) (*model.ChannelFeed, *model.AppError)
 	CreateChannelWithUser(c *request.Context, channel *model.Channel, userID string) (*model.Channel, *model.AppError)
 	CreateCommand(cmd *model.Command) (*model.Command, *model.AppError)
 	CreateCommandWebhook(commandID string, args *model.CommandArgs) (*model.CommandWebhook, *model.AppError)
 	CreateEmoji(sessionUserId string, emoji *model.Emoji, multiPartImageData *multipart.Form) (*model.Emoji, *model.AppError)
+	CreateEventWebhook(webhook *model.EventWebhook) (*model.EventWebhook, *model.AppError)
 	CreateGroup(group *model.Group) (*model.Group, *model.AppError)
 	CreateGroupChannel(userIDs []string, creatorId string) (*model.Channel, *model.AppError)
 	CreateGroupWithUserIds(group *model.GroupWithUserIds) (*model.Group, *model.AppError)
@@ -467,6 +1059,7 @@ type AppIface interface {
 	CreateSession(session *model.Session) (*model.Session, *model.AppError)
 	CreateSidebarCategory(userID, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError)
 	CreateTeam(c *request.Context, team *model.Team) (*model.Team, *model.AppError)
+	CreateTeamBanner(banner *model.TeamBanner) (*model.TeamBanner, *model.AppError)
 	CreateTeamWithUser(c *request.Context, team *model.Team, userID string) (*model.Team, *model.AppError)
 	CreateTermsOfService(text, userID string) (*model.TermsOfService, *model.AppError)
 	CreateUploadSession(us *model.UploadSession) (*model.UploadSession, *model.AppError)
@@ -482,13 +1075,19 @@ type AppIface interface {
 	DeactivateGuests(c *request.Context) *model.AppError
 	DeactivateMfa(userID string) *model.AppError
 	DeauthorizeOAuthAppForUser(userID, appID string) *model.AppError
+	DeleteAlertRule(id string) *model.AppError
 	DeleteAllExpiredPluginKeys() *model.AppError
 	DeleteAllKeysForPlugin(pluginID string) *model.AppError
+	DeleteBotState(botUserID, userID, channelID, key string) *model.AppError
 	DeleteBrandImage() *model.AppError
+	DeleteCannedResponse(responseID string) *model.AppError
 	DeleteChannel(c *request.Context, channel *model.Channel, userID string) *model.AppError
+	DeleteChannelDigest(userID, channelID string) *model.AppError
+	DeleteChannelFeed(feedID string) *model.AppError
 	DeleteCommand(commandID string) *model.AppError
 	DeleteEmoji(emoji *model.Emoji) *model.AppError
 	DeleteEphemeralPost(userID, postID string)
+	DeleteEventWebhook(id string) *model.AppError
 	DeleteExport(name string) *model.AppError
 	DeleteGroup(groupID string) (*model.Group, *model.AppError)
 	DeleteGroupMember(groupID string, userID string) (*model.GroupMember, *model.AppError)
@@ -503,10 +1102,13 @@ type AppIface interface {
 	DeleteReactionForPost(c *request.Context, reaction *model.Reaction) *model.AppError
 	DeleteRemoteCluster(remoteClusterId string) (bool, *model.AppError)
 	DeleteRetentionPolicy(policyID string) *model.AppError
+	DeleteScheduledChannelMessage(id string) *model.AppError
 	DeleteScheme(schemeId string) (*model.Scheme, *model.AppError)
 	DeleteSharedChannel(channelID string) (bool, error)
 	DeleteSharedChannelRemote(id string) (bool, error)
 	DeleteSidebarCategory(userID, teamID, categoryId string) *model.AppError
+	DeleteTeamAlias(teamID, name string) *model.AppError
+	DeleteTeamBanner(bannerID string) *model.AppError
 	DeleteToken(token *model.Token) *model.AppError
 	DisableAutoResponder(userID string, asAdmin bool) *model.AppError
 	DisableUserAccessToken(token *model.UserAccessToken) *model.AppError
@@ -538,7 +1140,11 @@ type AppIface interface {
 	GenerateMfaSecret(userID string) (*model.MfaSecret, *model.AppError)
 	GeneratePublicLink(siteURL string, info *model.FileInfo) string
 	GenerateSupportPacket() []model.FileData
+	GetAPIUsageForUser(userID string, since int64) ([]*model.APIUsage, *model.AppError)
+	GetAPIUsageSummaries(since int64, page, perPage int) ([]*model.APIUsageSummary, *model.AppError)
 	GetActivePluginManifests() ([]*model.Manifest, *model.AppError)
+	GetAlertRule(id string) (*model.AlertRule, *model.AppError)
+	GetAlertRules() ([]*model.AlertRule, *model.AppError)
 	GetAllChannels(page, perPage int, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, *model.AppError)
 	GetAllChannelsCount(opts model.ChannelSearchOpts) (int64, *model.AppError)
 	GetAllPrivateTeams() ([]*model.Team, *model.AppError)
@@ -554,12 +1160,23 @@ type AppIface interface {
 	GetAuditsPage(userID string, page int, perPage int) (model.Audits, *model.AppError)
 	GetAuthorizationCode(w http.ResponseWriter, r *http.Request, service string, props map[string]string, loginHint string) (string, *model.AppError)
 	GetAuthorizedAppsForUser(userID string, page, perPage int) ([]*model.OAuthApp, *model.AppError)
+	GetBotTokenAges(page, perPage int) ([]*model.BotTokenAge, *model.AppError)
+	GetBotTokenRotation(botUserID string) (*model.BotTokenRotation, *model.AppError)
 	GetBrandImage() ([]byte, *model.AppError)
 	GetBulkReactionsForPosts(postIDs []string) (map[string][]*model.Reaction, *model.AppError)
+	GetCannedResponse(responseID string) (*model.CannedResponse, *model.AppError)
+	GetCannedResponsesForTeam(teamID string) ([]*model.CannedResponse, *model.AppError)
+	GetCannedResponsesForUser(userID string) ([]*model.CannedResponse, *model.AppError)
 	GetChannel(channelID string) (*model.Channel, *model.AppError)
+	GetChannelArchivePolicy(teamID string) (*model.ChannelArchivePolicy, *model.AppError)
 	GetChannelByName(channelName, teamID string, includeDeleted bool) (*model.Channel, *model.AppError)
 	GetChannelByNameForTeamName(channelName, teamName string, includeDeleted bool) (*model.Channel, *model.AppError)
 	GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, *model.AppError)
+	GetChannelDigestsForUser(userID string) ([]*model.ChannelDigest, *model.AppError)
+	GetChannelEvent(channelID, eventID string) (*model.ChannelEvent, *model.AppError)
+	GetChannelEvents(channelID string, page, perPage int) ([]*model.ChannelEvent, *model.AppError)
+	GetChannelFeed(feedID string) (*model.ChannelFeed, *model.AppError)
+	GetChannelFeedsForChannel(channelID string) ([]*model.ChannelFeed, *model.AppError)
 	GetChannelGuestCount(channelID string) (int64, *model.AppError)
 	GetChannelMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, *model.AppError)
 	GetChannelMemberCount(channelID string) (int64, *model.AppError)
@@ -567,11 +1184,13 @@ type AppIface interface {
 	GetChannelMembersForUser(teamID string, userID string) (model.ChannelMembers, *model.AppError)
 	GetChannelMembersForUserWithPagination(userID string, page, perPage int) ([]*model.ChannelMember, *model.AppError)
 	GetChannelMembersPage(channelID string, page, perPage int) (model.ChannelMembers, *model.AppError)
+	GetChannelMembersPageWithOptions(channelID string, page, perPage int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, *model.AppError)
 	GetChannelMembersTimezones(channelID string) ([]string, *model.AppError)
 	GetChannelMembersWithTeamDataForUserWithPagination(userID string, page, perPage int) (model.ChannelMembersWithTeamData, *model.AppError)
 	GetChannelPinnedPostCount(channelID string) (int64, *model.AppError)
 	GetChannelPoliciesForUser(userID string, offset, limit int) (*model.RetentionPolicyForChannelList, *model.AppError)
 	GetChannelUnread(channelID, userID string) (*model.ChannelUnread, *model.AppError)
+	GetChannelsByIds(channelIDs []string, includeDeleted bool) ([]*model.Channel, *model.AppError)
 	GetChannelsByNames(channelNames []string, teamID string) ([]*model.Channel, *model.AppError)
 	GetChannelsForRetentionPolicy(policyID string, offset, limit int) (*model.ChannelsWithCount, *model.AppError)
 	GetChannelsForScheme(scheme *model.Scheme, offset int, limit int) (model.ChannelList, *model.AppError)
@@ -590,13 +1209,20 @@ type AppIface interface {
 	GetComplianceReports(page, perPage int) (model.Compliances, *model.AppError)
 	GetCookieDomain() string
 	GetCustomStatus(userID string) (*model.CustomStatus, *model.AppError)
+	GetCustomStatusTemplate(templateID string) (*model.CustomStatusTemplate, *model.AppError)
 	GetDefaultProfileImage(user *model.User) ([]byte, *model.AppError)
 	GetDeletedChannels(teamID string, offset int, limit int, userID string) (model.ChannelList, *model.AppError)
+	GetDirectChannelRetention(userID, channelID string) (*model.DirectChannelRetention, *model.AppError)
+	GetEmailSuppression(email string) (*model.EmailSuppression, *model.AppError)
+	GetEmailSuppressions(page, perPage int) ([]*model.EmailSuppression, *model.AppError)
 	GetEmoji(emojiId string) (*model.Emoji, *model.AppError)
 	GetEmojiByName(emojiName string) (*model.Emoji, *model.AppError)
 	GetEmojiImage(emojiId string) ([]byte, string, *model.AppError)
 	GetEmojiList(page, perPage int, sort string) ([]*model.Emoji, *model.AppError)
 	GetErrorListForEmailsOverLimit(emailList []string, cloudUserLimit int64) ([]string, []*model.EmailInviteWithError, *model.AppError)
+	GetEventWebhook(id string) (*model.EventWebhook, *model.AppError)
+	GetEventWebhookDeliveries(webhookID string, page, perPage int) ([]*model.EventWebhookDelivery, *model.AppError)
+	GetEventWebhooks(page, perPage int) ([]*model.EventWebhook, *model.AppError)
 	GetFile(fileID string) ([]byte, *model.AppError)
 	GetFileInfo(fileID string) (*model.FileInfo, *model.AppError)
 	GetFileInfos(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError)
@@ -622,6 +1248,7 @@ type AppIface interface {
 	GetGroupsBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError)
 	GetGroupsByUserId(userID string) ([]*model.Group, *model.AppError)
 	GetHubForUserId(userID string) *Hub
+	GetImpersonation(impersonationID string) (*model.Impersonation, *model.AppError)
 	GetIncomingWebhook(hookID string) (*model.IncomingWebhook, *model.AppError)
 	GetIncomingWebhooksForTeamPage(teamID string, page, perPage int) ([]*model.IncomingWebhook, *model.AppError)
 	GetIncomingWebhooksForTeamPageByUser(teamID string, userID string, page, perPage int) ([]*model.IncomingWebhook, *model.AppError)
@@ -641,6 +1268,7 @@ type AppIface interface {
 	GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, *model.AppError)
 	GetMessageForNotification(post *model.Post, translateFunc i18n.TranslateFunc) string
 	GetMultipleEmojiByName(names []string) ([]*model.Emoji, *model.AppError)
+	GetMutedKeywords(userID string) (model.MutedKeywords, *model.AppError)
 	GetNewUsersForTeamPage(teamID string, page, perPage int, asAdmin bool, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError)
 	GetNextPostIdFromPostList(postList *model.PostList, collapsedThreads bool) string
 	GetNotificationNameFormat(user *model.User) string
@@ -648,6 +1276,7 @@ type AppIface interface {
 	GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, code, secret, refreshToken string) (*model.AccessResponse, *model.AppError)
 	GetOAuthAccessTokenForImplicitFlow(userID string, authRequest *model.AuthorizeRequest) (*model.Session, *model.AppError)
 	GetOAuthApp(appID string) (*model.OAuthApp, *model.AppError)
+	GetOAuthAppUsageSummaries(since int64, page, perPage int) ([]*model.OAuthAppUsageSummary, *model.AppError)
 	GetOAuthApps(page, perPage int) ([]*model.OAuthApp, *model.AppError)
 	GetOAuthAppsByCreator(userID string, page, perPage int) ([]*model.OAuthApp, *model.AppError)
 	GetOAuthCodeRedirect(userID string, authRequest *model.AuthorizeRequest) (string, *model.AppError)
@@ -673,6 +1302,11 @@ type AppIface interface {
 	GetPostIdAfterTime(channelID string, time int64, collapsedThreads bool) (string, *model.AppError)
 	GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, *model.AppError)
 	GetPostIfAuthorized(postID string, session *model.Session) (*model.Post, *model.AppError)
+	GetPostModeration(moderationID string) (*model.PostModeration, *model.AppError)
+	GetPostModerations(teamID, status string, page, perPage int) ([]*model.PostModeration, *model.AppError)
+	GetPostReport(reportID string) (*model.PostReport, *model.AppError)
+	GetPostReports(teamID, status string, page, perPage int) ([]*model.PostReport, *model.AppError)
+	GetPostTask(postID string) (*model.PostTask, *model.AppError)
 	GetPostThread(postID string, skipFetchThreads, collapsedThreads, collapsedThreadsExtended bool, userID string) (*model.PostList, *model.AppError)
 	GetPosts(channelID string, offset int, limit int) (*model.PostList, *model.AppError)
 	GetPostsAfterPost(options model.GetPostsOptions) (*model.PostList, *model.AppError)
@@ -694,6 +1328,7 @@ type AppIface interface {
 	GetReactionsForPost(postID string) ([]*model.Reaction, *model.AppError)
 	GetRecentlyActiveUsersForTeam(teamID string) (map[string]*model.User, *model.AppError)
 	GetRecentlyActiveUsersForTeamPage(teamID string, page, perPage int, asAdmin bool, viewRestrictions *model.ViewUsersRestrictions) ([]*model.User, *model.AppError)
+	GetReloadableConfigSections() []string
 	GetRemoteCluster(remoteClusterId string) (*model.RemoteCluster, *model.AppError)
 	GetRemoteClusterForUser(remoteID string, userID string) (*model.RemoteCluster, *model.AppError)
 	GetRemoteClusterService() (remotecluster.RemoteClusterServiceIFace, *model.AppError)
@@ -708,6 +1343,8 @@ type AppIface interface {
 	GetSamlMetadata() (string, *model.AppError)
 	GetSamlMetadataFromIdp(idpMetadataURL string) (*model.SamlMetadataResponse, *model.AppError)
 	GetSanitizeOptions(asAdmin bool) map[string]bool
+	GetScheduledChannelMessage(id string) (*model.ScheduledChannelMessage, *model.AppError)
+	GetScheduledChannelMessagesForChannel(channelID string) ([]*model.ScheduledChannelMessage, *model.AppError)
 	GetScheme(id string) (*model.Scheme, *model.AppError)
 	GetSchemeByName(name string) (*model.Scheme, *model.AppError)
 	GetSchemeRolesForTeam(teamID string) (string, string, string, *model.AppError)
@@ -717,6 +1354,8 @@ type AppIface interface {
 	GetSessionById(sessionID string) (*model.Session, *model.AppError)
 	GetSessions(userID string) ([]*model.Session, *model.AppError)
 	GetSharedChannel(channelID string) (*model.SharedChannel, error)
+	GetSharedChannelInvite(inviteID string) (*model.SharedChannelInvite, *model.AppError)
+	GetSharedChannelInvites(opts model.SharedChannelInviteFilterOpts, page, perPage int) ([]*model.SharedChannelInvite, *model.AppError)
 	GetSharedChannelRemote(id string) (*model.SharedChannelRemote, error)
 	GetSharedChannelRemoteByIds(channelID string, remoteID string) (*model.SharedChannelRemote, error)
 	GetSharedChannelRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error)
@@ -734,8 +1373,12 @@ type AppIface interface {
 	GetSubscriptionStats() (*model.SubscriptionStats, *model.AppError)
 	GetSystemBot() (*model.Bot, *model.AppError)
 	GetTeam(teamID string) (*model.Team, *model.AppError)
+	GetTeamAliases(teamID string) ([]*model.TeamAlias, *model.AppError)
+	GetTeamBanner(bannerID string) (*model.TeamBanner, *model.AppError)
 	GetTeamByInviteId(inviteId string) (*model.Team, *model.AppError)
 	GetTeamByName(name string) (*model.Team, *model.AppError)
+	GetTeamDeletion(teamID string) (*model.TeamDeletion, *model.AppError)
+	GetTeamGroupRoles(teamID string) ([]*model.TeamGroupRole, *model.AppError)
 	GetTeamIcon(team *model.Team) ([]byte, *model.AppError)
 	GetTeamIdFromQuery(query url.Values) (string, *model.AppError)
 	GetTeamMember(teamID, userID string) (*model.TeamMember, *model.AppError)
@@ -744,6 +1387,7 @@ type AppIface interface {
 	GetTeamMembersForUser(userID string) ([]*model.TeamMember, *model.AppError)
 	GetTeamMembersForUserWithPagination(userID string, page, perPage int) ([]*model.TeamMember, *model.AppError)
 	GetTeamPoliciesForUser(userID string, offset, limit int) (*model.RetentionPolicyForTeamList, *model.AppError)
+	GetTeamRequest(requestID string) (*model.TeamRequest, *model.AppError)
 	GetTeamStats(teamID string, restrictions *model.ViewUsersRestrictions) (*model.TeamStats, *model.AppError)
 	GetTeamUnread(teamID, userID string) (*model.TeamUnread, *model.AppError)
 	GetTeamsForRetentionPolicy(policyID string, offset, limit int) (*model.TeamsWithCount, *model.AppError)
@@ -767,6 +1411,7 @@ type AppIface interface {
 	GetUserByEmail(email string) (*model.User, *model.AppError)
 	GetUserByUsername(username string) (*model.User, *model.AppError)
 	GetUserForLogin(id, loginId string) (*model.User, *model.AppError)
+	GetUserMerge(mergeID string) (*model.UserMerge, *model.AppError)
 	GetUserTermsOfService(userID string) (*model.UserTermsOfService, *model.AppError)
 	GetUsers(options *model.UserGetOptions) ([]*model.User, *model.AppError)
 	GetUsersByGroupChannelIds(c *request.Context, channelIDs []string, asAdmin bool) (map[string][]*model.User, *model.AppError)
@@ -821,8 +1466,6 @@ type AppIface interface {
 	InvalidateCacheForUser(userID string)
 	InviteGuestsToChannels(teamID string, guestsInvite *model.GuestsInvite, senderId string) *model.AppError
 	InviteGuestsToChannelsGracefully(teamID string, guestsInvite *model.GuestsInvite, senderId string) ([]*model.EmailInviteWithError, *model.AppError)
-	InviteNewUsersToTeam(emailList []string, teamID, senderId string) *model.AppError
-	InviteNewUsersToTeamGracefully(emailList []string, teamID, senderId string, reminderInterval string) ([]*model.EmailInviteWithError, *model.AppError)
 	IsCRTEnabledForUser(userID string) bool
 	IsFirstUserAccount() bool
 	IsLeader() bool
@@ -862,12 +1505,16 @@ type AppIface interface {
 	NotifySharedChannelUserUpdate(user *model.User)
 	OpenInteractiveDialog(request model.OpenDialogRequest) *model.AppError
 	OriginChecker() func(*http.Request) bool
+	PatchCannedResponse(responseID string, patch *model.CannedResponsePatch) (*model.CannedResponse, *model.AppError)
 	PatchChannel(c *request.Context, channel *model.Channel, patch *model.ChannelPatch, userID string) (*model.Channel, *model.AppError)
+	PatchChannelFeed(feedID string, patch *model.ChannelFeedPatch) (*model.ChannelFeed, *model.AppError)
+	PatchCustomStatusTemplate(templateID string, patch *model.CustomStatusTemplatePatch) (*model.CustomStatusTemplate, *model.AppError)
 	PatchPost(c *request.Context, postID string, patch *model.PostPatch) (*model.Post, *model.AppError)
 	PatchRetentionPolicy(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, *model.AppError)
 	PatchRole(role *model.Role, patch *model.RolePatch) (*model.Role, *model.AppError)
 	PatchScheme(scheme *model.Scheme, patch *model.SchemePatch) (*model.Scheme, *model.AppError)
 	PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *model.AppError)
+	PatchTeamBanner(bannerID string, patch *model.TeamBannerPatch) (*model.TeamBanner, *model.AppError)
 	PatchUser(userID string, patch *model.UserPatch, asAdmin bool) (*model.User, *model.AppError)
 	PermanentDeleteAllUsers(c *request.Context) *model.AppError
 	PermanentDeleteChannel(channel *model.Channel) *model.AppError
@@ -935,6 +1582,7 @@ type AppIface interface {
 	Saml() einterfaces.SamlInterface
 	SanitizePostListMetadataForUser(postList *model.PostList, userID string) (*model.PostList, *model.AppError)
 	SanitizePostMetadataForUser(post *model.Post, userID string) (*model.Post, *model.AppError)
+	SanitizePostsMetadataForUser(posts []*model.Post, userID string) ([]*model.Post, *model.AppError)
 	SanitizeProfile(user *model.User, asAdmin bool)
 	SanitizeTeam(session model.Session, team *model.Team) *model.Team
 	SanitizeTeams(session model.Session, teams []*model.Team) []*model.Team
@@ -947,6 +1595,7 @@ type AppIface interface {
 	SaveUserTermsOfService(userID, termsOfServiceId string, accepted bool) *model.AppError
 	SchemesIterator(scope string, batchSize int) func() []*model.Scheme
 	SearchArchivedChannels(teamID string, term string, userID string) (model.ChannelList, *model.AppError)
+	SearchAuditLogs(opts *model.AuditLogSearch, page, perPage int) ([]*model.AuditLog, *model.AppError)
 	SearchChannels(teamID string, term string) (model.ChannelList, *model.AppError)
 	SearchChannelsForUser(userID, teamID, term string) (model.ChannelList, *model.AppError)
 	SearchChannelsUserNotIn(teamID string, userID string, term string) (model.ChannelList, *model.AppError)
@@ -954,6 +1603,7 @@ type AppIface interface {
 	SearchEngine() *searchengine.Broker
 	SearchFilesInTeamForUser(c *request.Context, terms string, userId string, teamId string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.FileInfoList, *model.AppError)
 	SearchGroupChannels(userID, term string) (model.ChannelList, *model.AppError)
+	SearchPermissionDenials(opts *model.PermissionDenialSearch, page, perPage int) ([]*model.PermissionDenial, *model.AppError)
 	SearchPostsForUser(c *request.Context, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.PostSearchResults, *model.AppError)
 	SearchPostsInTeam(teamID string, paramsList []*model.SearchParams) (*model.PostList, *model.AppError)
 	SearchPrivateTeams(searchOpts *model.TeamSearch) ([]*model.Team, *model.AppError)
@@ -1036,6 +1686,7 @@ type AppIface interface {
 	TriggerWebhook(c *request.Context, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel)
 	UnregisterPluginCommand(pluginID, teamID, trigger string)
 	UpdateActive(c *request.Context, user *model.User, active bool) (*model.User, *model.AppError)
+	UpdateAlertRule(rule *model.AlertRule) (*model.AlertRule, *model.AppError)
 	UpdateChannelMemberNotifyProps(data map[string]string, channelID string, userID string) (*model.ChannelMember, *model.AppError)
 	UpdateChannelMemberRoles(channelID string, userID string, newRoles string) (*model.ChannelMember, *model.AppError)
 	UpdateChannelMemberSchemeRoles(channelID string, userID string, isSchemeGuest bool, isSchemeUser bool, isSchemeAdmin bool) (*model.ChannelMember, *model.AppError)
@@ -1043,6 +1694,7 @@ type AppIface interface {
 	UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command, *model.AppError)
 	UpdateConfig(f func(*model.Config))
 	UpdateEphemeralPost(userID string, post *model.Post) *model.Post
+	UpdateEventWebhook(webhook *model.EventWebhook) (*model.EventWebhook, *model.AppError)
 	UpdateExpiredDNDStatuses() ([]*model.Status, error)
 	UpdateGroup(group *model.Group) (*model.Group, *model.AppError)
 	UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, *model.AppError)
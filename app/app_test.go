@@ -120,6 +120,9 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 		},
 		"channel_admin": {
 			model.PermissionManageChannelRoles.Id,
+			model.PermissionManageChannelCommands.Id,
+			model.PermissionManageChannelScheduledMessages.Id,
+			model.PermissionRestorePosts.Id,
 			model.PermissionUseGroupMentions.Id,
 		},
 		"team_user": {
@@ -156,6 +159,11 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PermissionConvertPrivateChannelToPublic.Id,
 			model.PermissionDeletePost.Id,
 			model.PermissionDeleteOthersPosts.Id,
+			model.PermissionViewTeamExtendedStats.Id,
+			model.PermissionManageChannelCommands.Id,
+			model.PermissionManageChannelScheduledMessages.Id,
+			model.PermissionRestorePosts.Id,
+			model.PermissionManagePostReports.Id,
 		},
 		"system_user": {
 			model.PermissionListPublicTeams.Id,
@@ -168,6 +176,7 @@ func TestDoAdvancedPermissionsMigration(t *testing.T) {
 			model.PermissionEditCustomGroup.Id,
 			model.PermissionDeleteCustomGroup.Id,
 			model.PermissionManageCustomGroupMembers.Id,
+			model.PermissionSearchArchivedChannels.Id,
 		},
 		"system_post_all": {
 			model.PermissionCreatePost.Id,
@@ -227,6 +236,7 @@ func TestDoEmojisPermissionsMigration(t *testing.T) {
 		model.PermissionEditCustomGroup.Id,
 		model.PermissionDeleteCustomGroup.Id,
 		model.PermissionManageCustomGroupMembers.Id,
+		model.PermissionSearchArchivedChannels.Id,
 		model.PermissionListPublicTeams.Id,
 		model.PermissionJoinPublicTeams.Id,
 		model.PermissionCreateDirectChannel.Id,
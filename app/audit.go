@@ -106,6 +106,7 @@ func (a *App) MakeAuditRecord(event string, initialStatus string) *audit.Record
 func (s *Server) configureAudit(adt *audit.Audit, bAllowAdvancedLogging bool) error {
 	adt.OnQueueFull = s.onAuditTargetQueueFull
 	adt.OnError = s.onAuditError
+	adt.OnRecord = s.onAuditRecord
 
 	var logConfigSrc config.LogConfigSrc
 	dsn := *s.Config().ExperimentalAuditSettings.AdvancedLoggingConfig
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const auditLogCleanupBatch = 1000
+
+// onAuditRecord is called with every audit record written to the audit logs.
+func (s *Server) onAuditRecord(rec audit.Record) {
+	s.saveAuditLog(rec)
+	s.passAuditRecordToPlugins(rec)
+}
+
+// saveAuditLog keeps the audit record in the database, when enabled, without holding up the
+// caller.
+func (s *Server) saveAuditLog(rec audit.Record) {
+	if !*s.Config().ExperimentalAuditSettings.DatabaseEnabled || s.Store == nil {
+		return
+	}
+
+	s.Go(func() {
+		log := model.NewAuditLog(newModelAuditRecord(rec))
+		if _, err := s.Store.AuditLog().Save(log); err != nil {
+			mlog.Warn("Failed to save audit record", mlog.String("event", rec.Event), mlog.Err(err))
+		}
+	})
+}
+
+func (a *App) SearchAuditLogs(opts *model.AuditLogSearch, page, perPage int) ([]*model.AuditLog, *model.AppError) {
+	logs, err := a.Srv().Store.AuditLog().Search(opts, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("SearchAuditLogs", "app.audit_log.search.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return logs, nil
+}
+
+func runAuditLogCleanupJob(s *Server) {
+	doAuditLogCleanup(s)
+	model.CreateRecurringTask("Audit Log Cleanup", func() {
+		doAuditLogCleanup(s)
+	}, time.Hour)
+}
+
+func doAuditLogCleanup(s *Server) {
+	retention := time.Duration(*s.Config().ExperimentalAuditSettings.DatabaseRetentionDays) * 24 * time.Hour
+	endTime := model.GetMillisForTime(time.Now().Add(-retention))
+
+	mlog.Debug("Cleaning up audit log store.")
+
+	for {
+		deleted, err := s.Store.AuditLog().PermanentDeleteBatch(endTime, auditLogCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up audit logs", mlog.Err(err))
+			return
+		}
+		if deleted < auditLogCleanupBatch {
+			return
+		}
+	}
+}
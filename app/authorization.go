@@ -54,7 +54,11 @@ func (a *App) SessionHasPermissionToTeam(session model.Session, teamID string, p
 		}
 	}
 
-	return a.RolesGrantPermission(session.GetUserRoles(), permission.Id)
+	if a.RolesGrantPermission(session.GetUserRoles(), permission.Id) {
+		return true
+	}
+
+	return a.sessionHasPermissionToParentTeams(session, teamID, permission)
 }
 
 func (a *App) SessionHasPermissionToChannel(session model.Session, channelID string, permission *model.Permission) bool {
@@ -189,7 +193,10 @@ func (a *App) HasPermissionToTeam(askingUserId string, teamID string, permission
 			return true
 		}
 	}
-	return a.HasPermissionTo(askingUserId, permission)
+	if a.HasPermissionTo(askingUserId, permission) {
+		return true
+	}
+	return a.hasPermissionToParentTeams(askingUserId, teamID, permission)
 }
 
 func (a *App) HasPermissionToChannel(askingUserId string, channelID string, permission *model.Permission) bool {
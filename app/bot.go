@@ -362,6 +362,13 @@ func (a *App) UpdateBotActive(c *request.Context, botUserId string, active bool)
 
 // PermanentDeleteBot permanently deletes a bot and its corresponding user.
 func (a *App) PermanentDeleteBot(botUserId string) *model.AppError {
+	if err := a.Srv().Store.BotTokenRotation().Delete(botUserId); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return model.NewAppError("PermanentDeleteBot", "app.bot_token_rotation.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
 	if err := a.Srv().Store.Bot().PermanentDelete(botUserId); err != nil {
 		var invErr *store.ErrInvalidInput
 		switch {
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	botStateCleanupInterval = time.Hour
+	botStateCleanupBatch    = 1000
+)
+
+// GetBotState returns the state of the bot under the key, in the scope of the user and channel,
+// either of which can be empty.
+func (a *App) GetBotState(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError) {
+	state, err := a.Srv().Store.BotState().Get(botUserID, userID, channelID, key)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetBotState", "app.bot_state.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetBotState", "app.bot_state.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return state, nil
+}
+
+// SetBotState sets the state of the bot under the key, in the scope of the user and channel. The
+// state expires expireInSeconds from now, or never when zero.
+func (a *App) SetBotState(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError) {
+	if expireInSeconds < 0 {
+		return nil, model.NewAppError("SetBotState", "model.bot_state.is_valid.expire_at.app_error", nil, "key="+key, http.StatusBadRequest)
+	}
+
+	state := &model.BotState{
+		BotUserId: botUserID,
+		UserId:    userID,
+		ChannelId: channelID,
+		Key:       key,
+		Value:     json.RawMessage(value),
+	}
+	state.SetExpiry(expireInSeconds)
+
+	if existing, err := a.Srv().Store.BotState().Get(botUserID, userID, channelID, key); err == nil {
+		state.CreateAt = existing.CreateAt
+	}
+
+	saved, err := a.Srv().Store.BotState().Save(state)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SetBotState", "app.bot_state.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) DeleteBotState(botUserID, userID, channelID, key string) *model.AppError {
+	if err := a.Srv().Store.BotState().Delete(botUserID, userID, channelID, key); err != nil {
+		return model.NewAppError("DeleteBotState", "app.bot_state.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+// ListBotStateKeys returns a page of the keys the bot has states under in the scope of the user
+// and channel, in alphabetical order.
+func (a *App) ListBotStateKeys(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError) {
+	keys, err := a.Srv().Store.BotState().GetKeys(botUserID, userID, channelID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("ListBotStateKeys", "app.bot_state.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return keys, nil
+}
+
+func runBotStateCleanupJob(s *Server) {
+	doBotStateCleanup(s)
+	model.CreateRecurringTask("Bot State Cleanup", func() {
+		doBotStateCleanup(s)
+	}, botStateCleanupInterval)
+}
+
+func doBotStateCleanup(s *Server) {
+	mlog.Debug("Cleaning up expired bot states.")
+
+	now := model.GetMillis()
+	for {
+		deleted, err := s.Store.BotState().PermanentDeleteExpiredBatch(now, botStateCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up expired bot states", mlog.Err(err))
+			return
+		}
+		if deleted < botStateCleanupBatch {
+			return
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestBotState(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bot := th.CreateBot()
+
+	created, appErr := th.App.SetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step", []byte(`{"step":1}`), 0)
+	require.Nil(t, appErr)
+	assert.Zero(t, created.ExpireAt)
+
+	updated, appErr := th.App.SetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step", []byte(`{"step":2}`), 60)
+	require.Nil(t, appErr)
+	assert.Equal(t, created.CreateAt, updated.CreateAt, "updates keep the time the state was created at")
+	assert.Greater(t, updated.ExpireAt, model.GetMillis())
+
+	state, appErr := th.App.GetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step")
+	require.Nil(t, appErr)
+	assert.JSONEq(t, `{"step":2}`, string(state.Value))
+
+	keys, appErr := th.App.ListBotStateKeys(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, 0, 10)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{"dialog.step"}, keys)
+
+	_, appErr = th.App.SetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step", []byte(`not json`), 0)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.bot_state.is_valid.value.app_error", appErr.Id)
+
+	_, appErr = th.App.SetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step", []byte(`1`), -1)
+	require.NotNil(t, appErr)
+
+	require.Nil(t, th.App.DeleteBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step"))
+	_, appErr = th.App.GetBotState(bot.UserId, th.BasicUser.Id, th.BasicChannel.Id, "dialog.step")
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+}
+
+func TestBotStateCleanup(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bot := th.CreateBot()
+
+	_, err := th.App.Srv().Store.BotState().Save(&model.BotState{BotUserId: bot.UserId, Key: "expired", Value: []byte(`1`), ExpireAt: model.GetMillis() - 1000})
+	require.NoError(t, err)
+	_, appErr := th.App.SetBotState(bot.UserId, "", "", "live", []byte(`2`), 0)
+	require.Nil(t, appErr)
+
+	doBotStateCleanup(th.Server)
+
+	keys, appErr := th.App.ListBotStateKeys(bot.UserId, "", "", 0, 10)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{"live"}, keys)
+
+	require.Nil(t, th.App.PermanentDeleteBot(bot.UserId))
+	_, appErr = th.App.GetBotState(bot.UserId, "", "", "live")
+	require.NotNil(t, appErr, "the states of a deleted bot are deleted")
+}
+
+func TestPluginAPIBotState(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	api := th.SetupPluginAPI()
+
+	ownBot, appErr := th.App.CreateBot(th.Context, &model.Bot{Username: "bot" + model.NewId(), OwnerId: "pluginid"})
+	require.Nil(t, appErr)
+	otherBot := th.CreateBot()
+
+	_, appErr = api.BotStateSet(ownBot.UserId, th.BasicUser.Id, "", "step", []byte(`"greeting"`), 0)
+	require.Nil(t, appErr)
+
+	state, appErr := api.BotStateGet(ownBot.UserId, th.BasicUser.Id, "", "step")
+	require.Nil(t, appErr)
+	assert.Equal(t, `"greeting"`, string(state.Value))
+
+	keys, appErr := api.BotStateList(ownBot.UserId, th.BasicUser.Id, "", 0, 10)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{"step"}, keys)
+
+	require.Nil(t, api.BotStateDelete(ownBot.UserId, th.BasicUser.Id, "", "step"))
+
+	t.Run("bots of other owners", func(t *testing.T) {
+		_, appErr := api.BotStateSet(otherBot.UserId, th.BasicUser.Id, "", "step", []byte(`1`), 0)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.bot_state.not_owner.app_error", appErr.Id)
+
+		_, appErr = api.BotStateGet(otherBot.UserId, th.BasicUser.Id, "", "step")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+}
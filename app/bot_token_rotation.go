@@ -0,0 +1,274 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	// botTokenRotationBatchSize caps how many bots have their token replaced per run, the
+	// others being handled by the next runs.
+	botTokenRotationBatchSize = 100
+	expiredTokensBatchSize    = 1000
+
+	botTokenRotationDescription = "Rotated token"
+)
+
+func (a *App) GetBotTokenRotation(botUserID string) (*model.BotTokenRotation, *model.AppError) {
+	rotation, err := a.Srv().Store.BotTokenRotation().Get(botUserID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetBotTokenRotation", "app.bot_token_rotation.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetBotTokenRotation", "app.bot_token_rotation.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return rotation, nil
+}
+
+// SaveBotTokenRotation creates the token rotation of the bot, or replaces its settings. A
+// rotation that never replaced the token of the bot does it on the next run of the job.
+func (a *App) SaveBotTokenRotation(rotation *model.BotTokenRotation) (*model.BotTokenRotation, *model.AppError) {
+	if _, appErr := a.GetBot(rotation.BotUserId, false); appErr != nil {
+		return nil, appErr
+	}
+
+	existing, appErr := a.GetBotTokenRotation(rotation.BotUserId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	rotation.CurrentTokenId = ""
+	rotation.LastRotatedAt = 0
+	if existing != nil {
+		rotation.CurrentTokenId = existing.CurrentTokenId
+		rotation.LastRotatedAt = existing.LastRotatedAt
+		rotation.CreateAt = existing.CreateAt
+	}
+	rotation.ScheduleNextRotation(model.GetMillis())
+
+	var saved *model.BotTokenRotation
+	var err error
+	if existing == nil {
+		saved, err = a.Srv().Store.BotTokenRotation().Save(rotation)
+	} else {
+		saved, err = a.Srv().Store.BotTokenRotation().Update(rotation)
+	}
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("SaveBotTokenRotation", "app.bot_token_rotation.save.conflict.app_error", nil, cErr.Error(), http.StatusConflict)
+		default:
+			return nil, model.NewAppError("SaveBotTokenRotation", "app.bot_token_rotation.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+// DeleteBotTokenRotation stops replacing the token of the bot. The tokens made by the rotation
+// still expire.
+func (a *App) DeleteBotTokenRotation(botUserID string) *model.AppError {
+	if err := a.Srv().Store.BotTokenRotation().Delete(botUserID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteBotTokenRotation", "app.bot_token_rotation.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteBotTokenRotation", "app.bot_token_rotation.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// RotateBotToken replaces the token of the bot right away, without waiting for the rotation
+// to be due.
+func (a *App) RotateBotToken(botUserID string) (*model.BotTokenRotation, *model.AppError) {
+	rotation, appErr := a.GetBotTokenRotation(botUserID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if _, appErr := a.GetBot(botUserID, false); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.rotateBotToken(rotation, model.GetMillis())
+}
+
+func (a *App) GetBotTokenAges(page, perPage int) ([]*model.BotTokenAge, *model.AppError) {
+	ages, err := a.Srv().Store.BotTokenRotation().GetTokenAges(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetBotTokenAges", "app.bot_token_rotation.get_token_ages.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return ages, nil
+}
+
+// ProcessBotTokenRotations replaces the tokens of the bots whose rotation is due, and revokes
+// the tokens that expired. It returns how many tokens were replaced and revoked.
+func (a *App) ProcessBotTokenRotations() (int, int, *model.AppError) {
+	now := model.GetMillis()
+
+	rotations, err := a.Srv().Store.BotTokenRotation().GetDue(now, botTokenRotationBatchSize)
+	if err != nil {
+		return 0, 0, model.NewAppError("ProcessBotTokenRotations", "app.bot_token_rotation.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	// A bot whose token fails to be replaced doesn't hold back the others.
+	var lastErr *model.AppError
+	var rotated int
+	for _, rotation := range rotations {
+		bot, appErr := a.GetBot(rotation.BotUserId, true)
+		if appErr != nil {
+			mlog.Warn("Failed to get the bot of a token rotation", mlog.String("bot_user_id", rotation.BotUserId), mlog.Err(appErr))
+			lastErr = appErr
+			continue
+		}
+		if bot.DeleteAt != 0 {
+			continue
+		}
+
+		if _, appErr := a.rotateBotToken(rotation, now); appErr != nil {
+			mlog.Warn("Failed to rotate the token of a bot", mlog.String("bot_user_id", rotation.BotUserId), mlog.Err(appErr))
+			lastErr = appErr
+			continue
+		}
+		rotated++
+	}
+
+	revoked, appErr := a.revokeExpiredUserAccessTokens(now)
+	if appErr != nil {
+		lastErr = appErr
+	}
+
+	return rotated, revoked, lastErr
+}
+
+// rotateBotToken makes a new token for the bot and sends it to the webhook of the rotation.
+// Once sent, the previous token keeps working until the end of the grace period. Should the
+// webhook fail, the new token is dropped and the previous one is kept.
+func (a *App) rotateBotToken(rotation *model.BotTokenRotation, now int64) (*model.BotTokenRotation, *model.AppError) {
+	token, appErr := a.CreateUserAccessToken(&model.UserAccessToken{
+		UserId:      rotation.BotUserId,
+		Description: botTokenRotationDescription,
+		ExpiresAt:   rotation.TokenExpiresAt(now),
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	notification := &model.BotTokenRotationNotification{
+		BotUserId: rotation.BotUserId,
+		TokenId:   token.Id,
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt,
+	}
+
+	var previous *model.UserAccessToken
+	if rotation.CurrentTokenId != "" {
+		var err error
+		previous, err = a.Srv().Store.UserAccessToken().Get(rotation.CurrentTokenId)
+		var nfErr *store.ErrNotFound
+		if err != nil && !errors.As(err, &nfErr) {
+			a.revokeUndeliveredBotToken(token)
+			return nil, model.NewAppError("rotateBotToken", "app.user_access_token.get_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	if previous != nil {
+		notification.PreviousTokenId = previous.Id
+		notification.PreviousTokenExpiresAt = rotation.GraceEnd(now)
+		if previous.ExpiresAt != 0 && previous.ExpiresAt < notification.PreviousTokenExpiresAt {
+			notification.PreviousTokenExpiresAt = previous.ExpiresAt
+		}
+	}
+
+	if appErr := a.sendBotTokenRotationNotification(rotation.WebhookURL, notification); appErr != nil {
+		a.revokeUndeliveredBotToken(token)
+		return nil, appErr
+	}
+
+	if previous != nil && previous.ExpiresAt != notification.PreviousTokenExpiresAt {
+		if err := a.Srv().Store.UserAccessToken().UpdateExpiresAt(previous.Id, notification.PreviousTokenExpiresAt); err != nil {
+			mlog.Warn("Failed to shorten the replaced token of a bot", mlog.String("bot_user_id", rotation.BotUserId), mlog.Err(err))
+		}
+		a.ClearSessionCacheForUser(rotation.BotUserId)
+	}
+
+	rotation.CurrentTokenId = token.Id
+	rotation.LastRotatedAt = now
+	rotation.ScheduleNextRotation(now)
+	updated, err := a.Srv().Store.BotTokenRotation().Update(rotation)
+	if err != nil {
+		return nil, model.NewAppError("rotateBotToken", "app.bot_token_rotation.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return updated, nil
+}
+
+func (a *App) revokeUndeliveredBotToken(token *model.UserAccessToken) {
+	if appErr := a.RevokeUserAccessToken(token); appErr != nil {
+		mlog.Warn("Failed to revoke an undelivered bot token", mlog.String("bot_user_id", token.UserId), mlog.Err(appErr))
+	}
+}
+
+func (a *App) sendBotTokenRotationNotification(webhookURL string, notification *model.BotTokenRotationNotification) *model.AppError {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return model.NewAppError("sendBotTokenRotationNotification", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return model.NewAppError("sendBotTokenRotationNotification", "app.bot_token_rotation.webhook.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.HTTPService().MakeClient(false).Do(req)
+	if err != nil {
+		return model.NewAppError("sendBotTokenRotationNotification", "app.bot_token_rotation.webhook.app_error", nil, err.Error(), http.StatusBadGateway)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return model.NewAppError("sendBotTokenRotationNotification", "app.bot_token_rotation.webhook.app_error", nil, fmt.Sprintf("status_code=%d", resp.StatusCode), http.StatusBadGateway)
+	}
+
+	return nil
+}
+
+// revokeExpiredUserAccessTokens revokes the tokens that expired by now, returning how many.
+func (a *App) revokeExpiredUserAccessTokens(now int64) (int, *model.AppError) {
+	tokens, err := a.Srv().Store.UserAccessToken().GetExpired(now, expiredTokensBatchSize)
+	if err != nil {
+		return 0, model.NewAppError("revokeExpiredUserAccessTokens", "app.user_access_token.get_expired.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var revoked int
+	for _, token := range tokens {
+		if appErr := a.RevokeUserAccessToken(token); appErr != nil {
+			return revoked, appErr
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestProcessBotTokenRotations(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	bot, appErr := th.App.CreateBot(th.Context, &model.Bot{
+		Username: "rotated-bot",
+		OwnerId:  th.BasicUser.Id,
+	})
+	require.Nil(t, appErr)
+	defer th.App.PermanentDeleteBot(bot.UserId)
+
+	_, appErr = th.App.CreateUserAccessToken(&model.UserAccessToken{
+		UserId:      bot.UserId,
+		Description: "expired",
+		ExpiresAt:   model.GetMillis() - 1000,
+	})
+	require.NotNil(t, appErr, "tokens can't be made already expired")
+
+	expired, err := th.App.Srv().Store.UserAccessToken().Save(&model.UserAccessToken{
+		Token:       model.NewId(),
+		UserId:      bot.UserId,
+		Description: "expired",
+		ExpiresAt:   model.GetMillis() - 1000,
+	})
+	require.NoError(t, err)
+
+	_, appErr = th.App.createSessionForUserAccessToken(expired.Token)
+	require.NotNil(t, appErr)
+
+	lasting, appErr := th.App.CreateUserAccessToken(&model.UserAccessToken{
+		UserId:      bot.UserId,
+		Description: "lasting",
+		ExpiresAt:   model.GetMillis() + 60*60*1000,
+	})
+	require.Nil(t, appErr)
+
+	session, appErr := th.App.createSessionForUserAccessToken(lasting.Token)
+	require.Nil(t, appErr)
+	assert.Equal(t, lasting.ExpiresAt, session.ExpiresAt, "the session expires with the token")
+
+	rotated, revoked, appErr := th.App.ProcessBotTokenRotations()
+	require.Nil(t, appErr)
+	assert.Equal(t, 0, rotated)
+	assert.Equal(t, 1, revoked)
+
+	_, appErr = th.App.GetUserAccessToken(expired.Id, false)
+	require.NotNil(t, appErr)
+	_, appErr = th.App.GetUserAccessToken(lasting.Id, false)
+	require.Nil(t, appErr)
+}
@@ -66,6 +66,24 @@ func (a *App) GetBrandImage() ([]byte, *model.AppError) {
 	return img, nil
 }
 
+// GetBrandImageURL returns a signed URL for the brand image, or an empty string if signed URLs
+// are not available or no brand image was uploaded.
+func (a *App) GetBrandImageURL() (string, *model.AppError) {
+	if !*a.Config().FileSettings.EnableSignedURLs {
+		return "", nil
+	}
+
+	fileExists, err := a.FileExists(BrandFilePath + BrandFileName)
+	if err != nil {
+		return "", err
+	}
+	if !fileExists {
+		return "", nil
+	}
+
+	return a.GetSignedFileURL(BrandFilePath + BrandFileName)
+}
+
 func (a *App) DeleteBrandImage() *model.AppError {
 	filePath := BrandFilePath + BrandFileName
 
@@ -0,0 +1,155 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func (a *App) CreateCannedResponse(response *model.CannedResponse) (*model.CannedResponse, *model.AppError) {
+	response, err := a.Srv().Store.CannedResponse().Save(response)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateCannedResponse", "app.canned_response.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("CreateCannedResponse", "app.canned_response.save.shortcut_exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateCannedResponse", "app.canned_response.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return response, nil
+}
+
+func (a *App) GetCannedResponse(responseID string) (*model.CannedResponse, *model.AppError) {
+	response, err := a.Srv().Store.CannedResponse().Get(responseID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetCannedResponse", "app.canned_response.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetCannedResponse", "app.canned_response.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return response, nil
+}
+
+func (a *App) GetCannedResponsesForUser(userID string) ([]*model.CannedResponse, *model.AppError) {
+	responses, err := a.Srv().Store.CannedResponse().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetCannedResponsesForUser", "app.canned_response.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return responses, nil
+}
+
+func (a *App) GetCannedResponsesForTeam(teamID string) ([]*model.CannedResponse, *model.AppError) {
+	responses, err := a.Srv().Store.CannedResponse().GetForTeam(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetCannedResponsesForTeam", "app.canned_response.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return responses, nil
+}
+
+// AutocompleteCannedResponses returns the canned responses of the user, and of the team when
+// teamID is not empty, whose shortcut starts with term. The most used ones come first.
+func (a *App) AutocompleteCannedResponses(userID, teamID, term string) ([]*model.CannedResponse, *model.AppError) {
+	responses, err := a.Srv().Store.CannedResponse().Autocomplete(userID, teamID, term, model.CannedResponseAutocompleteMax)
+	if err != nil {
+		return nil, model.NewAppError("AutocompleteCannedResponses", "app.canned_response.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return responses, nil
+}
+
+// GetCannedResponseByShortcut returns the canned response with the given shortcut among the
+// ones of the user and of the team. The user's own response wins when both have the shortcut.
+func (a *App) GetCannedResponseByShortcut(userID, teamID, shortcut string) (*model.CannedResponse, *model.AppError) {
+	responses, appErr := a.AutocompleteCannedResponses(userID, teamID, shortcut)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var found *model.CannedResponse
+	for _, response := range responses {
+		if response.Shortcut != shortcut {
+			continue
+		}
+		if found == nil || response.UserId != "" {
+			found = response
+		}
+	}
+
+	if found == nil {
+		return nil, model.NewAppError("GetCannedResponseByShortcut", "app.canned_response.get.not_found.app_error", nil, "shortcut="+shortcut, http.StatusNotFound)
+	}
+
+	return found, nil
+}
+
+func (a *App) PatchCannedResponse(responseID string, patch *model.CannedResponsePatch) (*model.CannedResponse, *model.AppError) {
+	response, appErr := a.GetCannedResponse(responseID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	response.Patch(patch)
+
+	response, err := a.Srv().Store.CannedResponse().Update(response)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchCannedResponse", "app.canned_response.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("PatchCannedResponse", "app.canned_response.save.shortcut_exists.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("PatchCannedResponse", "app.canned_response.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return response, nil
+}
+
+func (a *App) DeleteCannedResponse(responseID string) *model.AppError {
+	if err := a.Srv().Store.CannedResponse().Delete(responseID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteCannedResponse", "app.canned_response.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteCannedResponse", "app.canned_response.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// UseCannedResponse renders the canned response with the given variable values and counts
+// the use. Failing to count the use does not prevent the response from being used.
+func (a *App) UseCannedResponse(response *model.CannedResponse, variables map[string]string) string {
+	if err := a.Srv().Store.CannedResponse().IncrementUseCount(response.Id, model.GetMillis()); err != nil {
+		mlog.Warn("Failed to count the use of a canned response", mlog.String("canned_response_id", response.Id), mlog.Err(err))
+	}
+
+	return response.Render(variables)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// GetCapabilities returns the features the server has enabled. Features that depend on a
+// license or a plugin are only reported when they actually run.
+func (a *App) GetCapabilities() *model.Capabilities {
+	cfg := a.Config()
+
+	calls := false
+	if env := a.GetPluginsEnvironment(); env != nil {
+		calls = env.IsActive(model.PluginIdCalls)
+	}
+
+	return &model.Capabilities{
+		Version:        model.CurrentVersion,
+		GraphQL:        cfg.FeatureFlags.GraphQL,
+		SharedChannels: a.Srv().GetSharedChannelSyncService() != nil,
+		Calls:          calls,
+		CustomEmoji:    *cfg.ServiceSettings.EnableCustomEmoji,
+		Files: model.FileCapabilities{
+			Attachments: *cfg.FileSettings.EnableFileAttachments,
+			PublicLinks: *cfg.FileSettings.EnablePublicLink,
+			MaxFileSize: *cfg.FileSettings.MaxFileSize,
+		},
+		Posts: model.PostCapabilities{
+			MaxPostSize: a.MaxPostSize(),
+			Priority:    *cfg.ServiceSettings.EnablePostPriority,
+		},
+	}
+}
@@ -641,6 +641,17 @@ func (a *App) UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppE
 	return channel, nil
 }
 
+// UpdateChannelThreadSettings sets the default thread behavior of the channel, which only public
+// and private channels have.
+func (a *App) UpdateChannelThreadSettings(channel *model.Channel, settings *model.ChannelThreadSettings) (*model.Channel, *model.AppError) {
+	if channel.Type != model.ChannelTypeOpen && channel.Type != model.ChannelTypePrivate {
+		return nil, model.NewAppError("UpdateChannelThreadSettings", "app.channel.update_thread_settings.channel_type.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	channel.SetThreadSettings(settings)
+	return a.UpdateChannel(channel)
+}
+
 // CreateChannelScheme creates a new Scheme of scope channel and assigns it to the channel.
 func (a *App) CreateChannelScheme(channel *model.Channel) (*model.Scheme, *model.AppError) {
 	scheme, err := a.CreateScheme(&model.Scheme{
@@ -683,12 +694,33 @@ func (a *App) UpdateChannelScheme(channel *model.Channel) (*model.Channel, *mode
 }
 
 func (a *App) UpdateChannelPrivacy(c *request.Context, oldChannel *model.Channel, user *model.User) (*model.Channel, *model.AppError) {
+	return a.updateChannelPrivacy(c, oldChannel, user, true)
+}
+
+// updateChannelPrivacy converts the channel to the type it's given with and records the
+// conversion in the channel events. Unless the conversion is itself a revert, the post
+// announcing it lets the channel admins revert it within the configured window.
+func (a *App) updateChannelPrivacy(c *request.Context, oldChannel *model.Channel, user *model.User, revertable bool) (*model.Channel, *model.AppError) {
+	previousChannel, err := a.GetChannel(oldChannel.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	channel, err := a.UpdateChannel(oldChannel)
 	if err != nil {
 		return channel, err
 	}
 
-	if err := a.postChannelPrivacyMessage(c, user, channel); err != nil {
+	var event *model.ChannelEvent
+	var revertExpireAt int64
+	if previousChannel.Type != channel.Type {
+		event = newChannelConversionEvent(channel, previousChannel.Type, user)
+		if revertable {
+			revertExpireAt = a.channelConversionRevertExpireAt(event)
+		}
+	}
+
+	if err := a.postChannelPrivacyMessage(c, user, channel, event, revertExpireAt); err != nil {
 		if channel.Type == model.ChannelTypeOpen {
 			channel.Type = model.ChannelTypePrivate
 		} else {
@@ -699,6 +731,12 @@ func (a *App) UpdateChannelPrivacy(c *request.Context, oldChannel *model.Channel
 		return channel, err
 	}
 
+	if event != nil {
+		if _, err := a.Srv().Store.ChannelEvent().Save(event); err != nil {
+			mlog.Warn("Failed to record the channel conversion", mlog.String("channel_id", channel.Id), mlog.Err(err))
+		}
+	}
+
 	a.invalidateCacheForChannel(channel)
 
 	messageWs := model.NewWebSocketEvent(model.WebsocketEventChannelConverted, channel.TeamId, "", "", nil)
@@ -708,7 +746,7 @@ func (a *App) UpdateChannelPrivacy(c *request.Context, oldChannel *model.Channel
 	return channel, nil
 }
 
-func (a *App) postChannelPrivacyMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
+func (a *App) postChannelPrivacyMessage(c *request.Context, user *model.User, channel *model.Channel, event *model.ChannelEvent, revertExpireAt int64) *model.AppError {
 	var authorId string
 	var authorUsername string
 	if user != nil {
@@ -737,6 +775,12 @@ func (a *App) postChannelPrivacyMessage(c *request.Context, user *model.User, ch
 			"username": authorUsername,
 		},
 	}
+	if event != nil {
+		post.AddProp(model.PostPropsChannelEventId, event.Id)
+	}
+	if revertExpireAt != 0 {
+		post.AddProp(model.PostPropsRevertExpireAt, revertExpireAt)
+	}
 
 	if _, err := a.CreatePost(c, post, channel, false, true); err != nil {
 		return model.NewAppError("postChannelPrivacyMessage", "api.channel.post_channel_privacy_message.error", nil, err.Error(), http.StatusInternalServerError)
@@ -1468,6 +1512,8 @@ func (a *App) addUserToChannel(user *model.User, channel *model.Channel) (*model
 	if nErr := a.Srv().Store.ChannelMemberHistory().LogJoinEvent(user.Id, channel.Id, model.GetMillis()); nErr != nil {
 		return nil, model.NewAppError("AddUserToChannel", "app.channel_member_history.log_join_event.internal_error", nil, nErr.Error(), http.StatusInternalServerError)
 	}
+	a.recordChannelJoinActivity(newMember, channel)
+	a.enqueueChannelMemberRESTProxyBridgeEvent(model.RESTProxyBridgeEventChannelMemberAdded, channel, user.Id, "")
 
 	a.InvalidateCacheForUser(user.Id)
 	a.invalidateCacheForChannelMembers(channel.Id)
@@ -1612,18 +1658,8 @@ func (a *App) PostUpdateChannelHeaderMessage(c *request.Context, userID string,
 		return model.NewAppError("PostUpdateChannelHeaderMessage", "api.channel.post_update_channel_header_message_and_forget.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	var message string
-	if oldChannelHeader == "" {
-		message = fmt.Sprintf(i18n.T("api.channel.post_update_channel_header_message_and_forget.updated_to"), user.Username, newChannelHeader)
-	} else if newChannelHeader == "" {
-		message = fmt.Sprintf(i18n.T("api.channel.post_update_channel_header_message_and_forget.removed"), user.Username, oldChannelHeader)
-	} else {
-		message = fmt.Sprintf(i18n.T("api.channel.post_update_channel_header_message_and_forget.updated_from"), user.Username, oldChannelHeader, newChannelHeader)
-	}
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.PostTypeHeaderChange,
 		UserId:    userID,
 		Props: model.StringInterface{
@@ -1646,18 +1682,8 @@ func (a *App) PostUpdateChannelPurposeMessage(c *request.Context, userID string,
 		return model.NewAppError("PostUpdateChannelPurposeMessage", "app.channel.post_update_channel_purpose_message.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	var message string
-	if oldChannelPurpose == "" {
-		message = fmt.Sprintf(i18n.T("app.channel.post_update_channel_purpose_message.updated_to"), user.Username, newChannelPurpose)
-	} else if newChannelPurpose == "" {
-		message = fmt.Sprintf(i18n.T("app.channel.post_update_channel_purpose_message.removed"), user.Username, oldChannelPurpose)
-	} else {
-		message = fmt.Sprintf(i18n.T("app.channel.post_update_channel_purpose_message.updated_from"), user.Username, oldChannelPurpose, newChannelPurpose)
-	}
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.PostTypePurposeChange,
 		UserId:    userID,
 		Props: model.StringInterface{
@@ -1679,11 +1705,8 @@ func (a *App) PostUpdateChannelDisplayNameMessage(c *request.Context, userID str
 		return model.NewAppError("PostUpdateChannelDisplayNameMessage", "api.channel.post_update_channel_displayname_message_and_forget.retrieve_user.error", nil, err.Error(), http.StatusBadRequest)
 	}
 
-	message := fmt.Sprintf(i18n.T("api.channel.post_update_channel_displayname_message_and_forget.updated_from"), user.Username, oldChannelDisplayName, newChannelDisplayName)
-
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      model.PostTypeDisplaynameChange,
 		UserId:    userID,
 		Props: model.StringInterface{
@@ -1714,6 +1737,14 @@ func (a *App) GetChannel(channelID string) (*model.Channel, *model.AppError) {
 	return channel, nil
 }
 
+func (a *App) GetChannelsByIds(channelIDs []string, includeDeleted bool) ([]*model.Channel, *model.AppError) {
+	channels, err := a.Srv().Store.Channel().GetChannelsByIds(channelIDs, includeDeleted)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelsByIds", "app.channel.get_channels_by_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return channels, nil
+}
+
 func (a *App) GetChannelByName(channelName, teamID string, includeDeleted bool) (*model.Channel, *model.AppError) {
 	var channel *model.Channel
 	var err error
@@ -1934,7 +1965,15 @@ func (a *App) GetChannelMember(ctx context.Context, channelID string, userID str
 }
 
 func (a *App) GetChannelMembersPage(channelID string, page, perPage int) (model.ChannelMembers, *model.AppError) {
-	channelMembers, err := a.Srv().Store.Channel().GetMembers(channelID, page*perPage, perPage)
+	return a.GetChannelMembersPageWithOptions(channelID, page, perPage, nil)
+}
+
+func (a *App) GetChannelMembersPageWithOptions(channelID string, page, perPage int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, *model.AppError) {
+	if opts != nil && !model.IsValidChannelMembersSort(opts.Sort) {
+		return nil, model.NewAppError("GetChannelMembersPageWithOptions", "app.channel.get_members.invalid_sort.app_error", nil, "sort="+opts.Sort, http.StatusBadRequest)
+	}
+
+	channelMembers, err := a.Srv().Store.Channel().GetMembers(channelID, page*perPage, perPage, opts)
 	if err != nil {
 		return nil, model.NewAppError("GetChannelMembersPage", "app.channel.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -2115,17 +2154,13 @@ func (a *App) JoinChannel(c *request.Context, channel *model.Channel, userID str
 }
 
 func (a *App) postJoinChannelMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
-	message := fmt.Sprintf(i18n.T("api.channel.join_channel.post_and_forget"), user.Username)
 	postType := model.PostTypeJoinChannel
-
 	if user.IsGuest() {
-		message = fmt.Sprintf(i18n.T("api.channel.guest_join_channel.post_and_forget"), user.Username)
 		postType = model.PostTypeGuestJoinChannel
 	}
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      postType,
 		UserId:    user.Id,
 		Props: model.StringInterface{
@@ -2143,7 +2178,6 @@ func (a *App) postJoinChannelMessage(c *request.Context, user *model.User, chann
 func (a *App) postJoinTeamMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(i18n.T("api.team.join_team.post_and_forget"), user.Username),
 		Type:      model.PostTypeJoinTeam,
 		UserId:    user.Id,
 		Props: model.StringInterface{
@@ -2237,12 +2271,8 @@ func (a *App) LeaveChannel(c *request.Context, channelID string, userID string)
 func (a *App) postLeaveChannelMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		// Message here embeds `@username`, not just `username`, to ensure that mentions
-		// treat this as a username mention even though the user has now left the channel.
-		// The client renders its own system message, ignoring this value altogether.
-		Message: fmt.Sprintf(i18n.T("api.channel.leave.left"), fmt.Sprintf("@%s", user.Username)),
-		Type:    model.PostTypeLeaveChannel,
-		UserId:  user.Id,
+		Type:      model.PostTypeLeaveChannel,
+		UserId:    user.Id,
 		Props: model.StringInterface{
 			"username": user.Username,
 		},
@@ -2256,17 +2286,13 @@ func (a *App) postLeaveChannelMessage(c *request.Context, user *model.User, chan
 }
 
 func (a *App) PostAddToChannelMessage(c *request.Context, user *model.User, addedUser *model.User, channel *model.Channel, postRootId string) *model.AppError {
-	message := fmt.Sprintf(i18n.T("api.channel.add_member.added"), addedUser.Username, user.Username)
 	postType := model.PostTypeAddToChannel
-
 	if addedUser.IsGuest() {
-		message = fmt.Sprintf(i18n.T("api.channel.add_guest.added"), addedUser.Username, user.Username)
 		postType = model.PostTypeAddGuestToChannel
 	}
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   message,
 		Type:      postType,
 		UserId:    user.Id,
 		RootId:    postRootId,
@@ -2288,7 +2314,6 @@ func (a *App) PostAddToChannelMessage(c *request.Context, user *model.User, adde
 func (a *App) postAddToTeamMessage(c *request.Context, user *model.User, addedUser *model.User, channel *model.Channel, postRootId string) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(i18n.T("api.team.add_user_to_team.added"), addedUser.Username, user.Username),
 		Type:      model.PostTypeAddToTeam,
 		UserId:    user.Id,
 		RootId:    postRootId,
@@ -2320,12 +2345,8 @@ func (a *App) postRemoveFromChannelMessage(c *request.Context, removerUserId str
 
 	post := &model.Post{
 		ChannelId: channel.Id,
-		// Message here embeds `@username`, not just `username`, to ensure that mentions
-		// treat this as a username mention even though the user has now left the channel.
-		// The client renders its own system message, ignoring this value altogether.
-		Message: fmt.Sprintf(i18n.T("api.channel.remove_member.removed"), fmt.Sprintf("@%s", removedUser.Username)),
-		Type:    model.PostTypeRemoveFromChannel,
-		UserId:  messageUserId,
+		Type:      model.PostTypeRemoveFromChannel,
+		UserId:    messageUserId,
 		Props: model.StringInterface{
 			"removedUserId":   removedUser.Id,
 			"removedUsername": removedUser.Username,
@@ -2379,6 +2400,7 @@ func (a *App) removeUserFromChannel(c *request.Context, userIDToRemove string, r
 	if err := a.Srv().Store.ChannelMemberHistory().LogLeaveEvent(userIDToRemove, channel.Id, model.GetMillis()); err != nil {
 		return model.NewAppError("removeUserFromChannel", "app.channel_member_history.log_leave_event.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
+	a.enqueueChannelMemberRESTProxyBridgeEvent(model.RESTProxyBridgeEventChannelMemberRemoved, channel, userIDToRemove, removerUserId)
 
 	if isGuest {
 		currentMembers, err := a.GetChannelMembersForUser(channel.TeamId, userIDToRemove)
@@ -2974,6 +2996,14 @@ func (a *App) PermanentDeleteChannel(channel *model.Channel) *model.AppError {
 		return model.NewAppError("PermanentDeleteChannel", "app.webhooks.permanent_delete_outgoing_by_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
+	if err := a.Srv().Store.ChannelCommandOverride().DeleteForChannel(channel.Id); err != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.channel_command_override.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.ScheduledChannelMessage().DeleteForChannel(channel.Id); err != nil {
+		return model.NewAppError("PermanentDeleteChannel", "app.scheduled_channel_message.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	deleteAt := model.GetMillis()
 
 	if nErr := a.Srv().Store.Channel().PermanentDelete(channel.Id); nErr != nil {
@@ -3323,7 +3353,7 @@ func (a *App) forEachChannelMember(channelID string, f func(model.ChannelMember)
 	page := 0
 
 	for {
-		channelMembers, err := a.Srv().Store.Channel().GetMembers(channelID, page*perPage, perPage)
+		channelMembers, err := a.Srv().Store.Channel().GetMembers(channelID, page*perPage, perPage, nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,218 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// channelAutoArchiveBatchSize caps how many channels of a team are warned per run, the
+// others being warned by the next runs.
+const channelAutoArchiveBatchSize = 500
+
+func (a *App) GetChannelArchivePolicy(teamID string) (*model.ChannelArchivePolicy, *model.AppError) {
+	policy, err := a.Srv().Store.ChannelArchivePolicy().Get(teamID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelArchivePolicy", "app.channel_archive_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetChannelArchivePolicy", "app.channel_archive_policy.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return policy, nil
+}
+
+// SaveChannelArchivePolicy creates the policy of the team, or replaces it.
+func (a *App) SaveChannelArchivePolicy(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, *model.AppError) {
+	existing, appErr := a.GetChannelArchivePolicy(policy.TeamId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	var saved *model.ChannelArchivePolicy
+	var err error
+	if existing == nil {
+		saved, err = a.Srv().Store.ChannelArchivePolicy().Save(policy)
+	} else {
+		policy.CreateAt = existing.CreateAt
+		saved, err = a.Srv().Store.ChannelArchivePolicy().Update(policy)
+	}
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("SaveChannelArchivePolicy", "app.channel_archive_policy.save.conflict.app_error", nil, cErr.Error(), http.StatusConflict)
+		default:
+			return nil, model.NewAppError("SaveChannelArchivePolicy", "app.channel_archive_policy.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+// DeleteChannelArchivePolicy turns off the archiving of the inactive channels of the team,
+// and forgets the channels that were warned about it.
+func (a *App) DeleteChannelArchivePolicy(teamID string) *model.AppError {
+	if err := a.Srv().Store.ChannelArchivePolicy().Delete(teamID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteChannelArchivePolicy", "app.channel_archive_policy.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteChannelArchivePolicy", "app.channel_archive_policy.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// ProcessChannelArchivePolicies archives the channels that stayed inactive for a week after
+// being warned, and warns the channels that are a week away from being archived. It returns
+// how many channels were archived and warned.
+func (a *App) ProcessChannelArchivePolicies() (int, int, *model.AppError) {
+	policies, err := a.Srv().Store.ChannelArchivePolicy().GetAll()
+	if err != nil {
+		return 0, 0, model.NewAppError("ProcessChannelArchivePolicies", "app.channel_archive_policy.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	// A team whose policy fails to be applied doesn't hold back the others.
+	var lastErr *model.AppError
+	var archived, warned int
+	c := request.EmptyContext()
+	for _, policy := range policies {
+		teamArchived, teamWarned, appErr := a.applyChannelArchivePolicy(c, policy, model.GetMillis())
+		archived += teamArchived
+		warned += teamWarned
+		if appErr != nil {
+			mlog.Warn("Failed to apply channel archive policy", mlog.String("team_id", policy.TeamId), mlog.Err(appErr))
+			lastErr = appErr
+		}
+	}
+
+	return archived, warned, lastErr
+}
+
+func (a *App) applyChannelArchivePolicy(c *request.Context, policy *model.ChannelArchivePolicy, now int64) (int, int, *model.AppError) {
+	team, appErr := a.GetTeam(policy.TeamId)
+	if appErr != nil {
+		return 0, 0, appErr
+	}
+	if team.DeleteAt != 0 {
+		return 0, 0, nil
+	}
+
+	archived, appErr := a.archiveWarnedChannels(c, policy, now)
+	if appErr != nil {
+		return archived, 0, appErr
+	}
+
+	channels, err := a.Srv().Store.ChannelArchivePolicy().GetInactiveChannels(policy, policy.WarnBefore(now), channelAutoArchiveBatchSize)
+	if err != nil {
+		return archived, 0, model.NewAppError("applyChannelArchivePolicy", "app.channel_archive_policy.get_inactive_channels.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var warned int
+	for _, channel := range channels {
+		if appErr := a.warnInactiveChannel(c, policy, channel); appErr != nil {
+			mlog.Warn("Failed to warn an inactive channel about being archived", mlog.String("channel_id", channel.Id), mlog.Err(appErr))
+			continue
+		}
+		warned++
+	}
+
+	return archived, warned, nil
+}
+
+// archiveWarnedChannels archives the warned channels that had no posts since the warning and
+// reached the end of their inactivity period. The warnings of the other channels that had
+// posts since are dropped.
+func (a *App) archiveWarnedChannels(c *request.Context, policy *model.ChannelArchivePolicy, now int64) (int, *model.AppError) {
+	warnings, err := a.Srv().Store.ChannelArchivePolicy().GetWarnings(policy.TeamId)
+	if err != nil {
+		return 0, model.NewAppError("archiveWarnedChannels", "app.channel_archive_policy.get_warnings.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var archived int
+	for _, warning := range warnings {
+		channel, appErr := a.GetChannel(warning.ChannelId)
+		if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return archived, appErr
+		}
+
+		active := channel == nil || channel.DeleteAt != 0 || channel.LastPostAt > warning.WarnedAt || policy.IsExcluded(channel.Id)
+		if !active && now < policy.ArchiveAt(warning) {
+			continue
+		}
+
+		if !active {
+			if appErr := a.DeleteChannel(c, channel, ""); appErr != nil {
+				mlog.Warn("Failed to archive an inactive channel", mlog.String("channel_id", channel.Id), mlog.Err(appErr))
+				continue
+			}
+			archived++
+			if a.Metrics() != nil {
+				a.Metrics().IncrementChannelsAutoArchivedCounter()
+			}
+		}
+
+		if err := a.Srv().Store.ChannelArchivePolicy().DeleteWarning(warning.ChannelId); err != nil {
+			return archived, model.NewAppError("archiveWarnedChannels", "app.channel_archive_policy.delete_warning.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return archived, nil
+}
+
+// warnInactiveChannel posts a message to the channel telling when it is going to be archived,
+// unless someone posts to it by then.
+func (a *App) warnInactiveChannel(c *request.Context, policy *model.ChannelArchivePolicy, channel *model.Channel) *model.AppError {
+	systemBot, appErr := a.GetSystemBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	warning := &model.ChannelArchiveWarning{
+		ChannelId:      channel.Id,
+		TeamId:         channel.TeamId,
+		LastActivityAt: channel.LastPostAt,
+		WarnedAt:       model.GetMillis(),
+	}
+	if channel.CreateAt > warning.LastActivityAt {
+		warning.LastActivityAt = channel.CreateAt
+	}
+
+	T := i18n.GetUserTranslations(channel.DefaultLocale)
+	post, appErr := a.CreatePost(c, &model.Post{
+		UserId:    systemBot.UserId,
+		ChannelId: channel.Id,
+		Message: T("app.channel_archive_policy.warning", map[string]interface{}{
+			"InactiveDays": policy.InactiveDays,
+			"ArchiveDate":  model.GetTimeForMillis(policy.ArchiveAt(warning)).UTC().Format("2006-01-02"),
+		}),
+	}, channel, false, true)
+	if appErr != nil {
+		return appErr
+	}
+
+	// The warning post itself doesn't count as activity.
+	warning.WarnedAt = post.CreateAt
+	if err := a.Srv().Store.ChannelArchivePolicy().SaveWarning(warning); err != nil {
+		return model.NewAppError("warnInactiveChannel", "app.channel_archive_policy.save_warning.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
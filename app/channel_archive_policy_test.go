@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestApplyChannelArchivePolicy(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	excluded := th.CreateChannel(th.BasicTeam)
+	policy, appErr := th.App.SaveChannelArchivePolicy(&model.ChannelArchivePolicy{
+		TeamId:             th.BasicTeam.Id,
+		InactiveDays:       30,
+		ExcludedChannelIds: model.StringArray{excluded.Id},
+	})
+	require.Nil(t, appErr)
+
+	start := model.GetMillis()
+	day := (24 * time.Hour).Milliseconds()
+
+	_, warned, appErr := th.App.applyChannelArchivePolicy(th.Context, policy, start+40*day)
+	require.Nil(t, appErr)
+	assert.NotZero(t, warned)
+
+	warnings, err := th.App.Srv().Store.ChannelArchivePolicy().GetWarnings(th.BasicTeam.Id)
+	require.NoError(t, err)
+	warnedIDs := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		warnedIDs = append(warnedIDs, warning.ChannelId)
+	}
+	assert.Contains(t, warnedIDs, th.BasicChannel.Id)
+	assert.NotContains(t, warnedIDs, excluded.Id)
+
+	posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1})
+	require.Nil(t, appErr)
+	require.Len(t, posts.Order, 1)
+	assert.Contains(t, posts.Posts[posts.Order[0]].Message, "will be archived")
+
+	t.Run("not archived before the end of the inactivity period", func(t *testing.T) {
+		archived, _, appErr := th.App.applyChannelArchivePolicy(th.Context, policy, start+20*day)
+		require.Nil(t, appErr)
+		assert.Zero(t, archived)
+	})
+
+	// Channels that had posts since their warning aren't archived.
+	active := th.CreateChannel(th.BasicTeam)
+	_, _, appErr = th.App.applyChannelArchivePolicy(th.Context, policy, start+25*day)
+	require.Nil(t, appErr)
+	th.CreatePost(active)
+
+	archived, _, appErr := th.App.applyChannelArchivePolicy(th.Context, policy, start+40*day)
+	require.Nil(t, appErr)
+	assert.NotZero(t, archived)
+
+	for _, tc := range []struct {
+		channelID string
+		archived  bool
+	}{
+		{th.BasicChannel.Id, true},
+		{excluded.Id, false},
+		{active.Id, false},
+	} {
+		fetched, appErr := th.App.GetChannel(tc.channelID)
+		require.Nil(t, appErr)
+		assert.Equal(t, tc.archived, fetched.DeleteAt != 0, tc.channelID)
+	}
+}
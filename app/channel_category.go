@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
@@ -258,3 +259,168 @@ func (a *App) DeleteSidebarCategory(userID, teamID, categoryId string) *model.Ap
 
 	return nil
 }
+
+// ExportSidebarCategories returns the layout of the sidebar of the user on the team, to be
+// applied to another team or user with ImportSidebarCategories.
+func (a *App) ExportSidebarCategories(userID, teamID string) (*model.SidebarCategoriesExport, *model.AppError) {
+	categories, appErr := a.GetSidebarCategories(userID, teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channels, appErr := a.GetChannelsForTeamForUser(teamID, userID, &model.ChannelSearchOpts{})
+	if appErr != nil {
+		return nil, appErr
+	}
+	channelNames := make(map[string]string, len(channels))
+	for _, channel := range channels {
+		if channel.TeamId == teamID {
+			channelNames[channel.Id] = channel.Name
+		}
+	}
+
+	export := &model.SidebarCategoriesExport{
+		Categories: make([]*model.SidebarCategoryExport, 0, len(categories.Categories)),
+	}
+	for _, category := range categories.Categories {
+		exported := &model.SidebarCategoryExport{
+			Type:         category.Type,
+			DisplayName:  category.DisplayName,
+			Sorting:      category.Sorting,
+			Muted:        category.Muted,
+			Collapsed:    category.Collapsed,
+			ChannelNames: []string{},
+		}
+		if category.Type != model.SidebarCategoryDirectMessages {
+			for _, channelID := range category.Channels {
+				if name, ok := channelNames[channelID]; ok {
+					exported.ChannelNames = append(exported.ChannelNames, name)
+				}
+			}
+		}
+		export.Categories = append(export.Categories, exported)
+	}
+
+	return export, nil
+}
+
+// ImportSidebarCategories applies the exported layout to the sidebar of the user on the team.
+// The system categories are updated and the custom ones are matched by display name, creating
+// those missing. The categories and channels left out of the layout are kept after the others.
+// Every channel of the layout must be one the user is a member of on the team.
+func (a *App) ImportSidebarCategories(userID, teamID string, export *model.SidebarCategoriesExport) (*model.OrderedSidebarCategories, *model.AppError) {
+	if appErr := export.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	channels, appErr := a.GetChannelsForTeamForUser(teamID, userID, &model.ChannelSearchOpts{})
+	if appErr != nil {
+		return nil, appErr
+	}
+	channelIDs := make(map[string]string, len(channels))
+	for _, channel := range channels {
+		if channel.TeamId == teamID {
+			channelIDs[channel.Name] = channel.Id
+		}
+	}
+
+	var inaccessible []string
+	for _, exported := range export.Categories {
+		for _, name := range exported.ChannelNames {
+			if _, ok := channelIDs[name]; !ok {
+				inaccessible = append(inaccessible, name)
+			}
+		}
+	}
+	if len(inaccessible) > 0 {
+		return nil, model.NewAppError("ImportSidebarCategories", "app.channel.sidebar_categories.import.inaccessible_channels.app_error", map[string]interface{}{"Names": strings.Join(inaccessible, ", ")}, "", http.StatusBadRequest)
+	}
+
+	existing, appErr := a.GetSidebarCategories(userID, teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	claimed := map[string]bool{}
+	for _, exported := range export.Categories {
+		for _, name := range exported.ChannelNames {
+			claimed[channelIDs[name]] = true
+		}
+	}
+
+	// Match the exported categories with those of the user, creating the missing ones.
+	matched := make(map[string]*model.SidebarCategoryExport, len(export.Categories))
+	order := make([]string, 0, len(existing.Categories)+len(export.Categories))
+	categories := existing.Categories
+	for _, exported := range export.Categories {
+		var category *model.SidebarCategoryWithChannels
+		for _, c := range categories {
+			if _, ok := matched[c.Id]; ok || c.Type != exported.Type {
+				continue
+			}
+			if c.Type == model.SidebarCategoryCustom && c.DisplayName != exported.DisplayName {
+				continue
+			}
+			category = c
+			break
+		}
+
+		if category == nil {
+			if exported.Type != model.SidebarCategoryCustom {
+				continue
+			}
+			created, appErr := a.CreateSidebarCategory(userID, teamID, &model.SidebarCategoryWithChannels{
+				SidebarCategory: model.SidebarCategory{
+					UserId:      userID,
+					TeamId:      teamID,
+					DisplayName: exported.DisplayName,
+				},
+			})
+			if appErr != nil {
+				return nil, appErr
+			}
+			category = created
+			categories = append(categories, category)
+		}
+
+		matched[category.Id] = exported
+		order = append(order, category.Id)
+	}
+
+	updates := make([]*model.SidebarCategoryWithChannels, 0, len(categories))
+	for _, category := range categories {
+		update := &model.SidebarCategoryWithChannels{
+			SidebarCategory: category.SidebarCategory,
+			Channels:        []string{},
+		}
+
+		if exported, ok := matched[category.Id]; ok {
+			update.Sorting = exported.Sorting
+			update.Muted = exported.Muted
+			update.Collapsed = exported.Collapsed
+			for _, name := range exported.ChannelNames {
+				update.Channels = append(update.Channels, channelIDs[name])
+			}
+		} else {
+			order = append(order, category.Id)
+		}
+
+		// The channels moved by the layout are taken out of the categories they were in.
+		for _, channelID := range category.Channels {
+			if !claimed[channelID] {
+				update.Channels = append(update.Channels, channelID)
+			}
+		}
+
+		updates = append(updates, update)
+	}
+
+	if _, appErr := a.UpdateSidebarCategories(userID, teamID, updates); appErr != nil {
+		return nil, appErr
+	}
+	if appErr := a.UpdateSidebarCategoryOrder(userID, teamID, order); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.GetSidebarCategories(userID, teamID)
+}
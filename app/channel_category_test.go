@@ -138,6 +138,107 @@ func TestGetSidebarCategories(t *testing.T) {
 	})
 }
 
+func TestExportImportSidebarCategories(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	channel := th.CreateChannel(th.BasicTeam)
+	th.AddUserToChannel(th.BasicUser, channel)
+
+	custom, appErr := th.App.CreateSidebarCategory(th.BasicUser.Id, th.BasicTeam.Id, &model.SidebarCategoryWithChannels{
+		SidebarCategory: model.SidebarCategory{
+			UserId:      th.BasicUser.Id,
+			TeamId:      th.BasicTeam.Id,
+			DisplayName: "Projects",
+		},
+		Channels: []string{channel.Id, th.BasicChannel.Id},
+	})
+	require.Nil(t, appErr)
+	custom.Sorting = model.SidebarCategorySortAlphabetical
+	custom.Collapsed = true
+	_, appErr = th.App.UpdateSidebarCategories(th.BasicUser.Id, th.BasicTeam.Id, []*model.SidebarCategoryWithChannels{custom})
+	require.Nil(t, appErr)
+
+	export, appErr := th.App.ExportSidebarCategories(th.BasicUser.Id, th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	require.Len(t, export.Categories, 4)
+	assert.Equal(t, model.SidebarCategoryFavorites, export.Categories[0].Type)
+	assert.Equal(t, model.SidebarCategoryCustom, export.Categories[1].Type)
+	assert.Equal(t, "Projects", export.Categories[1].DisplayName)
+	assert.Equal(t, model.SidebarCategorySortAlphabetical, export.Categories[1].Sorting)
+	assert.True(t, export.Categories[1].Collapsed)
+	assert.Equal(t, []string{channel.Name, th.BasicChannel.Name}, export.Categories[1].ChannelNames)
+	assert.NotContains(t, export.Categories[2].ChannelNames, channel.Name)
+	assert.Empty(t, export.Categories[3].ChannelNames)
+
+	t.Run("apply to another user", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+		for _, category := range export.Categories {
+			for _, name := range category.ChannelNames {
+				c, appErr := th.App.GetChannelByName(name, th.BasicTeam.Id, false)
+				require.Nil(t, appErr)
+				th.AddUserToChannel(user, c)
+			}
+		}
+
+		categories, appErr := th.App.ImportSidebarCategories(user.Id, th.BasicTeam.Id, export)
+		require.Nil(t, appErr)
+		require.Len(t, categories.Categories, 4)
+		require.Len(t, categories.Order, 4)
+
+		imported := categories.Categories[1]
+		assert.Equal(t, model.SidebarCategoryCustom, imported.Type)
+		assert.Equal(t, "Projects", imported.DisplayName)
+		assert.Equal(t, model.SidebarCategorySortAlphabetical, imported.Sorting)
+		assert.True(t, imported.Collapsed)
+		assert.Equal(t, []string{channel.Id, th.BasicChannel.Id}, imported.Channels)
+		assert.NotContains(t, categories.Categories[2].Channels, channel.Id)
+
+		t.Run("applying it again changes nothing", func(t *testing.T) {
+			again, appErr := th.App.ImportSidebarCategories(user.Id, th.BasicTeam.Id, export)
+			require.Nil(t, appErr)
+			assert.Equal(t, categories.Order, again.Order)
+			assert.Equal(t, imported.Channels, again.Categories[1].Channels)
+		})
+	})
+
+	t.Run("apply to another team", func(t *testing.T) {
+		team := th.CreateTeam()
+		th.LinkUserToTeam(th.BasicUser, team)
+		// The channels are found by name on the other team.
+		other := th.CreateChannel(team, func(c *model.Channel) { c.Name = channel.Name })
+		th.AddUserToChannel(th.BasicUser, other)
+		other2 := th.CreateChannel(team, func(c *model.Channel) { c.Name = th.BasicChannel.Name })
+		th.AddUserToChannel(th.BasicUser, other2)
+
+		projects := &model.SidebarCategoriesExport{Categories: []*model.SidebarCategoryExport{export.Categories[1]}}
+		categories, appErr := th.App.ImportSidebarCategories(th.BasicUser.Id, team.Id, projects)
+		require.Nil(t, appErr)
+		require.Len(t, categories.Categories, 4)
+		// The categories left out of the layout are kept after it.
+		assert.Equal(t, "Projects", categories.Categories[0].DisplayName)
+		assert.Equal(t, []string{other.Id, other2.Id}, categories.Categories[0].Channels)
+		assert.Equal(t, model.SidebarCategoryFavorites, categories.Categories[1].Type)
+	})
+
+	t.Run("inaccessible channels", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+		th.AddUserToChannel(user, th.BasicChannel)
+
+		_, appErr := th.App.ImportSidebarCategories(user.Id, th.BasicTeam.Id, export)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel.sidebar_categories.import.inaccessible_channels.app_error", appErr.Id)
+		assert.Contains(t, appErr.Message, channel.Name)
+
+		// Nothing was applied.
+		categories, appErr := th.App.GetSidebarCategories(user.Id, th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.Len(t, categories.Categories, 3)
+	})
+}
+
 func TestUpdateSidebarCategories(t *testing.T) {
 	t.Run("should mute and unmute all channels in a category when it is muted or unmuted", func(t *testing.T) {
 		th := Setup(t).InitBasic()
@@ -0,0 +1,117 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// GetDisabledChannelCommands returns the overrides disabling slash commands in the channel.
+func (a *App) GetDisabledChannelCommands(channelID string) ([]*model.ChannelCommandOverride, *model.AppError) {
+	overrides, err := a.Srv().Store.ChannelCommandOverride().GetForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetDisabledChannelCommands", "app.channel_command_override.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return overrides, nil
+}
+
+// DisableChannelCommand stops the command from being run in the channel. Disabling a command
+// that already is returns the existing override.
+func (a *App) DisableChannelCommand(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, *model.AppError) {
+	saved, err := a.Srv().Store.ChannelCommandOverride().Save(override)
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			existing, err := a.Srv().Store.ChannelCommandOverride().Get(override.ChannelId, override.Trigger)
+			if err != nil {
+				return nil, model.NewAppError("DisableChannelCommand", "app.channel_command_override.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+			return existing, nil
+		default:
+			return nil, model.NewAppError("DisableChannelCommand", "app.channel_command_override.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+// EnableChannelCommand lets the command be run in the channel again.
+func (a *App) EnableChannelCommand(channelID, trigger string) *model.AppError {
+	if err := a.Srv().Store.ChannelCommandOverride().Delete(channelID, model.NormalizeCommandTrigger(trigger)); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("EnableChannelCommand", "app.channel_command_override.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("EnableChannelCommand", "app.channel_command_override.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// ListChannelCommands returns the autocomplete commands of the team that can be run in the
+// channel, leaving out the ones disabled in it.
+func (a *App) ListChannelCommands(channelID, teamID string, T i18n.TranslateFunc) ([]*model.Command, *model.AppError) {
+	commands, appErr := a.ListAutocompleteCommands(teamID, T)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return a.FilterDisabledChannelCommands(channelID, commands)
+}
+
+// FilterDisabledChannelCommands leaves out of the commands the ones disabled in the channel.
+func (a *App) FilterDisabledChannelCommands(channelID string, commands []*model.Command) ([]*model.Command, *model.AppError) {
+	overrides, appErr := a.GetDisabledChannelCommands(channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(overrides) == 0 {
+		return commands, nil
+	}
+
+	disabled := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		disabled[override.Trigger] = true
+	}
+
+	enabled := make([]*model.Command, 0, len(commands))
+	for _, cmd := range commands {
+		if !disabled[model.NormalizeCommandTrigger(cmd.Trigger)] {
+			enabled = append(enabled, cmd)
+		}
+	}
+
+	return enabled, nil
+}
+
+// checkCommandEnabledInChannel fails when the command of the trigger is disabled in the channel.
+func (a *App) checkCommandEnabledInChannel(channelID, trigger string) *model.AppError {
+	if channelID == "" {
+		return nil
+	}
+
+	_, err := a.Srv().Store.ChannelCommandOverride().Get(channelID, trigger)
+	if err == nil {
+		return model.NewAppError("checkCommandEnabledInChannel", "api.command.execute_command.disabled_in_channel.app_error", map[string]interface{}{"Trigger": trigger}, "channel_id="+channelID, http.StatusForbidden)
+	}
+
+	var nfErr *store.ErrNotFound
+	if !errors.As(err, &nfErr) {
+		return model.NewAppError("checkCommandEnabledInChannel", "app.channel_command_override.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
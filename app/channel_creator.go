@@ -0,0 +1,82 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// TransferChannelCreator makes the member the creator of the channel. The integrations the
+// previous creator owns in the channel are returned, for the new creator to take over.
+func (a *App) TransferChannelCreator(channel *model.Channel, userID string) (*model.ChannelCreatorTransfer, *model.AppError) {
+	if channel.IsGroupOrDirect() {
+		return nil, model.NewAppError("TransferChannelCreator", "app.channel.transfer_creator.direct.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("TransferChannelCreator", "app.channel.transfer_creator.archived.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if user.DeleteAt != 0 {
+		return nil, model.NewAppError("TransferChannelCreator", "app.channel.transfer_creator.deactivated.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+	}
+	if user.IsBot {
+		return nil, model.NewAppError("TransferChannelCreator", "app.channel.transfer_creator.bot.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+	}
+	if _, appErr := a.GetChannelMember(context.Background(), channel.Id, userID); appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return nil, model.NewAppError("TransferChannelCreator", "app.channel.transfer_creator.not_member.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+		}
+		return nil, appErr
+	}
+
+	transfer := &model.ChannelCreatorTransfer{
+		Channel:           channel,
+		PreviousCreatorId: channel.CreatorId,
+		Integrations: &model.ChannelIntegrations{
+			ChannelId:        channel.Id,
+			IncomingWebhooks: []*model.IncomingWebhook{},
+			OutgoingWebhooks: []*model.OutgoingWebhook{},
+			Commands:         []*model.Command{},
+			Bots:             []*model.Bot{},
+			Owners:           map[string]*model.User{},
+		},
+	}
+
+	if channel.CreatorId != userID {
+		channel.CreatorId = userID
+		updatedChannel, appErr := a.UpdateChannel(channel)
+		if appErr != nil {
+			return nil, appErr
+		}
+		transfer.Channel = updatedChannel
+	}
+
+	if transfer.PreviousCreatorId != "" && transfer.PreviousCreatorId != userID {
+		integrations, appErr := a.GetChannelIntegrations(channel)
+		if appErr != nil {
+			return nil, appErr
+		}
+		transfer.Integrations = integrations.OwnedBy(transfer.PreviousCreatorId)
+	}
+
+	return transfer, nil
+}
+
+// GetChannelsWithDeactivatedCreator returns the channels not archived whose creator was
+// deactivated, in the team or in all teams when teamID is empty.
+func (a *App) GetChannelsWithDeactivatedCreator(teamID string, offset, limit int) (model.ChannelList, *model.AppError) {
+	channels, err := a.Srv().Store.Channel().GetChannelsWithDeactivatedCreator(teamID, offset, limit)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelsWithDeactivatedCreator", "app.channel.get_channels_with_deactivated_creator.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTransferChannelCreator(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	t.Run("direct channel", func(t *testing.T) {
+		channel := th.CreateDmChannel(th.BasicUser2)
+		_, appErr := th.App.TransferChannelCreator(channel, th.BasicUser2.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel.transfer_creator.direct.app_error", appErr.Id)
+	})
+
+	t.Run("user not in the channel", func(t *testing.T) {
+		channel := th.CreateChannel(th.BasicTeam)
+		_, appErr := th.App.TransferChannelCreator(channel, th.BasicUser2.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel.transfer_creator.not_member.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("deactivated user", func(t *testing.T) {
+		channel := th.CreateChannel(th.BasicTeam)
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+		th.AddUserToChannel(user, channel)
+		_, appErr := th.App.UpdateActive(th.Context, user, false)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.TransferChannelCreator(channel, user.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel.transfer_creator.deactivated.app_error", appErr.Id)
+	})
+
+	t.Run("integrations of the previous creator are returned", func(t *testing.T) {
+		channel := th.CreateChannel(th.BasicTeam)
+		th.AddUserToChannel(th.BasicUser2, channel)
+
+		owned, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, channel, &model.IncomingWebhook{ChannelId: channel.Id})
+		require.Nil(t, appErr)
+		_, appErr = th.App.CreateIncomingWebhookForChannel(th.BasicUser2.Id, channel, &model.IncomingWebhook{ChannelId: channel.Id})
+		require.Nil(t, appErr)
+
+		transfer, appErr := th.App.TransferChannelCreator(channel, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, transfer.PreviousCreatorId)
+		assert.Equal(t, th.BasicUser2.Id, transfer.Channel.CreatorId)
+		require.Len(t, transfer.Integrations.IncomingWebhooks, 1)
+		assert.Equal(t, owned.Id, transfer.Integrations.IncomingWebhooks[0].Id)
+
+		channels, appErr := th.App.GetChannelsWithDeactivatedCreator(th.BasicTeam.Id, 0, 100)
+		require.Nil(t, appErr)
+		for _, c := range channels {
+			assert.NotEqual(t, channel.Id, c.Id)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// GetChannelStatsTimeseries returns the daily stats of the channel for every day between since
+// and until, both being the start of a UTC day. The days without posts, or not rolled up yet,
+// have zero stats.
+func (a *App) GetChannelStatsTimeseries(channelID string, since, until int64) (*model.ChannelStatsTimeseries, *model.AppError) {
+	stats, err := a.Srv().Store.TeamStats().GetChannelDailyStats(channelID, since, until)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelStatsTimeseries", "app.channel_daily_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	byDay := make(map[int64]*model.ChannelDailyStats, len(stats))
+	for _, dayStats := range stats {
+		byDay[dayStats.Day] = dayStats
+	}
+
+	timeseries := &model.ChannelStatsTimeseries{
+		ChannelId: channelID,
+		Since:     since,
+		Until:     until,
+		Days:      make([]*model.ChannelDailyStats, 0, (until-since)/model.TeamStatsDayMillis),
+	}
+	for day := since; day < until; day += model.TeamStatsDayMillis {
+		dayStats, ok := byDay[day]
+		if !ok {
+			dayStats = &model.ChannelDailyStats{ChannelId: channelID, Day: day}
+		}
+		timeseries.Days = append(timeseries.Days, dayStats)
+	}
+
+	return timeseries, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetChannelStatsTimeseries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	today := startOfTodayUTC()
+	yesterday := today - model.TeamStatsDayMillis
+	for _, userID := range []string{th.BasicUser.Id, th.BasicUser.Id, th.BasicUser2.Id} {
+		_, err := th.App.Srv().Store.Post().Save(&model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    userID,
+			Message:   "yesterday",
+			CreateAt:  yesterday + 1,
+		})
+		require.NoError(t, err)
+	}
+
+	// Start from the day before yesterday so that only yesterday is rolled up.
+	require.NoError(t, th.App.Srv().Store.System().SaveOrUpdate(&model.System{
+		Name:  model.SystemTeamStatsLastRollupDay,
+		Value: strconv.FormatInt(yesterday-model.TeamStatsDayMillis, 10),
+	}))
+
+	require.Nil(t, th.App.RollupTeamStats())
+
+	lastRollup, err := th.App.Srv().Store.System().GetByName(model.SystemTeamStatsLastRollupDay)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(yesterday, 10), lastRollup.Value)
+
+	timeseries, appErr := th.App.GetChannelStatsTimeseries(th.BasicChannel.Id, yesterday-model.TeamStatsDayMillis, today)
+	require.Nil(t, appErr)
+	assert.Equal(t, []*model.ChannelDailyStats{
+		{ChannelId: th.BasicChannel.Id, Day: yesterday - model.TeamStatsDayMillis},
+		{ChannelId: th.BasicChannel.Id, Day: yesterday, PostCount: 3, ActiveMembers: 2},
+	}, timeseries.Days)
+}
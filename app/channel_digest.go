@@ -0,0 +1,228 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/email"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const channelDigestBatchSize = 1000
+
+func (a *App) GetChannelDigestsForUser(userID string) ([]*model.ChannelDigest, *model.AppError) {
+	digests, err := a.Srv().Store.ChannelDigest().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelDigestsForUser", "app.channel_digest.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return digests, nil
+}
+
+// SaveChannelDigest subscribes the user to a digest of a team channel they are a member of,
+// or changes the cadence of an existing subscription.
+func (a *App) SaveChannelDigest(digest *model.ChannelDigest) (*model.ChannelDigest, *model.AppError) {
+	channel, appErr := a.GetChannel(digest.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if channel.IsGroupOrDirect() {
+		return nil, model.NewAppError("SaveChannelDigest", "app.channel_digest.team_channel_only.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if _, err := a.Srv().Store.Channel().GetMember(context.Background(), digest.ChannelId, digest.UserId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("SaveChannelDigest", "app.channel_digest.not_member.app_error", nil, nfErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("SaveChannelDigest", "app.channel.get_member.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	saved, err := a.Srv().Store.ChannelDigest().Save(digest)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SaveChannelDigest", "app.channel_digest.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) DeleteChannelDigest(userID, channelID string) *model.AppError {
+	if err := a.Srv().Store.ChannelDigest().Delete(userID, channelID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteChannelDigest", "app.channel_digest.get.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteChannelDigest", "app.channel_digest.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+// SendChannelDigests emails every user whose daily or weekly digests are due a summary
+// of the activity in their muted channels since the digest was last sent.
+func (a *App) SendChannelDigests() *model.AppError {
+	for _, cadence := range []string{model.ChannelDigestCadenceDaily, model.ChannelDigestCadenceWeekly} {
+		now := model.GetMillis()
+		sentBefore := now - model.ChannelDigestPeriod(cadence)
+
+		for {
+			due, err := a.Srv().Store.ChannelDigest().GetDue(cadence, sentBefore, channelDigestBatchSize)
+			if err != nil {
+				return model.NewAppError("SendChannelDigests", "app.channel_digest.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+			if len(due) == 0 {
+				break
+			}
+
+			// Leave the last user of a full batch to the next one so that all of their
+			// channels end up in the same email.
+			if len(due) == channelDigestBatchSize && due[0].UserId != due[len(due)-1].UserId {
+				last := due[len(due)-1].UserId
+				for len(due) > 0 && due[len(due)-1].UserId == last {
+					due = due[:len(due)-1]
+				}
+			}
+
+			start := 0
+			for i := 1; i <= len(due); i++ {
+				if i < len(due) && due[i].UserId == due[start].UserId {
+					continue
+				}
+				if appErr := a.sendChannelDigest(cadence, due[start:i], now); appErr != nil {
+					return appErr
+				}
+				start = i
+			}
+		}
+	}
+
+	return nil
+}
+
+// sendChannelDigest sends a single user the digest of the given channels, then marks
+// them as sent whether or not there was anything to report. Failing to mark them stops the
+// sending, since the same digests would be looked up again otherwise.
+func (a *App) sendChannelDigest(cadence string, digests []*model.ChannelDigest, now int64) *model.AppError {
+	userID := digests[0].UserId
+	channelIDs := make([]string, 0, len(digests))
+	for _, digest := range digests {
+		channelIDs = append(channelIDs, digest.ChannelId)
+	}
+
+	appErr := a.emailChannelDigest(cadence, digests, now)
+
+	if err := a.Srv().Store.ChannelDigest().UpdateLastSentAt(userID, channelIDs, now); err != nil {
+		return model.NewAppError("sendChannelDigest", "app.channel_digest.update_last_sent_at.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return appErr
+}
+
+func (a *App) emailChannelDigest(cadence string, digests []*model.ChannelDigest, now int64) *model.AppError {
+	userID := digests[0].UserId
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		mlog.Warn("Failed to get user for channel digest", mlog.String("user_id", userID), mlog.Err(appErr))
+		return nil
+	}
+	if user.DeleteAt != 0 || user.IsBot {
+		return nil
+	}
+
+	siteURL := a.GetSiteURL()
+	// Users without a locale get the digest in the default locale of the first of their
+	// channels that has one.
+	locale := user.Locale
+	var summaries []*email.ChannelDigestSummary
+	authorIDs := map[string]bool{}
+	for _, digest := range digests {
+		// Channels that are no longer muted get regular notifications instead.
+		member, appErr := a.GetChannelMember(context.Background(), digest.ChannelId, userID)
+		if appErr != nil || !member.IsChannelMuted() {
+			continue
+		}
+
+		channel, appErr := a.GetChannel(digest.ChannelId)
+		if appErr != nil || channel.DeleteAt != 0 {
+			continue
+		}
+
+		team, appErr := a.GetTeam(channel.TeamId)
+		if appErr != nil {
+			continue
+		}
+
+		// Subscriptions never sent only summarize what was posted since they were made.
+		since := digest.LastSentAt
+		if since < digest.CreateAt {
+			since = digest.CreateAt
+		}
+
+		activity, err := a.Srv().Store.ChannelDigest().GetActivity(channel.Id, user.Id, user.Username, since, now)
+		if err != nil {
+			return model.NewAppError("emailChannelDigest", "app.channel_digest.get_activity.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if activity.IsEmpty() {
+			continue
+		}
+
+		for _, post := range append(activity.TopThreads, activity.UnansweredMentions...) {
+			authorIDs[post.UserId] = true
+		}
+
+		if locale == "" {
+			locale = channel.GetNotificationLocale(user.Locale)
+		}
+
+		teamURL := siteURL + "/" + team.Name
+		summaries = append(summaries, &email.ChannelDigestSummary{
+			ChannelDisplayName: channel.DisplayName,
+			ChannelURL:         teamURL + "/channels/" + channel.Name,
+			TeamURL:            teamURL,
+			Activity:           activity,
+		})
+	}
+
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	usernames := map[string]string{}
+	if len(authorIDs) > 0 {
+		ids := make([]string, 0, len(authorIDs))
+		for id := range authorIDs {
+			ids = append(ids, id)
+		}
+		authors, err := a.Srv().Store.User().GetProfileByIds(context.Background(), ids, nil, true)
+		if err != nil {
+			return model.NewAppError("emailChannelDigest", "app.user.get_profiles.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, author := range authors {
+			usernames[author.Id] = author.Username
+		}
+	}
+	for _, summary := range summaries {
+		summary.Usernames = usernames
+	}
+
+	if err := a.Srv().EmailService.SendChannelDigestEmail(user.Email, locale, siteURL, cadence, summaries); err != nil {
+		mlog.Warn("Failed to send channel digest email", mlog.String("user_id", userID), mlog.Err(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/app/email"
+	emailmocks "github.com/mattermost/mattermost-server/v6/app/email/mocks"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestSendChannelDigests(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	setMuted := func(t *testing.T, channelID string, muted bool) {
+		member, appErr := th.App.GetChannelMember(context.Background(), channelID, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		member.SetChannelMuted(muted)
+		_, err := th.App.Srv().Store.Channel().UpdateMember(member)
+		require.NoError(t, err)
+		th.App.Srv().Store.Channel().InvalidateAllChannelMembersForUser(th.BasicUser.Id)
+	}
+
+	subscribe := func(t *testing.T, channelID string) {
+		dayAgo := model.GetMillis() - model.ChannelDigestPeriod(model.ChannelDigestCadenceDaily) - 1000
+		_, err := th.App.Srv().Store.ChannelDigest().Save(&model.ChannelDigest{
+			UserId:     th.BasicUser.Id,
+			ChannelId:  channelID,
+			Cadence:    model.ChannelDigestCadenceDaily,
+			CreateAt:   dayAgo,
+			LastSentAt: dayAgo,
+		})
+		require.NoError(t, err)
+		require.NoError(t, th.App.Srv().Store.ChannelDigest().UpdateLastSentAt(th.BasicUser.Id, []string{channelID}, dayAgo))
+	}
+
+	_, err := th.App.Srv().Store.Post().Save(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser2.Id,
+		Message:   "hello @" + th.BasicUser.Username,
+	})
+	require.NoError(t, err)
+
+	t.Run("sends the digest of a muted channel", func(t *testing.T) {
+		setMuted(t, th.BasicChannel.Id, true)
+		subscribe(t, th.BasicChannel.Id)
+
+		emailServiceMock := emailmocks.ServiceInterface{}
+		emailServiceMock.On("SendChannelDigestEmail",
+			th.BasicUser.Email,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			model.ChannelDigestCadenceDaily,
+			mock.MatchedBy(func(summaries []*email.ChannelDigestSummary) bool {
+				return len(summaries) == 1 &&
+					summaries[0].Activity.ChannelId == th.BasicChannel.Id &&
+					len(summaries[0].Activity.UnansweredMentions) == 1
+			}),
+		).Once().Return(nil)
+		th.App.Srv().EmailService = &emailServiceMock
+
+		require.Nil(t, th.App.SendChannelDigests())
+		emailServiceMock.AssertExpectations(t)
+
+		digest, err := th.App.Srv().Store.ChannelDigest().Get(th.BasicUser.Id, th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Greater(t, digest.LastSentAt, model.GetMillis()-60*1000)
+
+		// Nothing is due anymore.
+		require.Nil(t, th.App.SendChannelDigests())
+		emailServiceMock.AssertNumberOfCalls(t, "SendChannelDigestEmail", 1)
+	})
+
+	t.Run("skips channels that are not muted", func(t *testing.T) {
+		setMuted(t, th.BasicChannel.Id, false)
+		subscribe(t, th.BasicChannel.Id)
+
+		emailServiceMock := emailmocks.ServiceInterface{}
+		th.App.Srv().EmailService = &emailServiceMock
+
+		require.Nil(t, th.App.SendChannelDigests())
+		emailServiceMock.AssertNotCalled(t, "SendChannelDigestEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		digest, err := th.App.Srv().Store.ChannelDigest().Get(th.BasicUser.Id, th.BasicChannel.Id)
+		require.NoError(t, err)
+		assert.Greater(t, digest.LastSentAt, model.GetMillis()-60*1000)
+	})
+
+	t.Run("new subscriptions only summarize what was posted since they were made", func(t *testing.T) {
+		setMuted(t, th.BasicChannel.Id, true)
+		require.NoError(t, th.App.Srv().Store.ChannelDigest().Delete(th.BasicUser.Id, th.BasicChannel.Id))
+		_, err := th.App.Srv().Store.ChannelDigest().Save(&model.ChannelDigest{
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Cadence:   model.ChannelDigestCadenceDaily,
+		})
+		require.NoError(t, err)
+		require.NoError(t, th.App.Srv().Store.ChannelDigest().UpdateLastSentAt(th.BasicUser.Id, []string{th.BasicChannel.Id}, 0))
+
+		emailServiceMock := emailmocks.ServiceInterface{}
+		th.App.Srv().EmailService = &emailServiceMock
+
+		require.Nil(t, th.App.SendChannelDigests())
+		emailServiceMock.AssertNotCalled(t, "SendChannelDigestEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// newChannelConversionEvent returns the event recording the conversion of the channel from the
+// given type to its current one. The event is given its id beforehand, for the post announcing
+// the conversion to reference it.
+func newChannelConversionEvent(channel *model.Channel, oldType model.ChannelType, user *model.User) *model.ChannelEvent {
+	event := &model.ChannelEvent{
+		ChannelId: channel.Id,
+		Type:      model.ChannelEventTypeConvert,
+		OldValue:  string(oldType),
+		NewValue:  string(channel.Type),
+	}
+	if user != nil {
+		event.UserId = user.Id
+	}
+	event.PreSave()
+
+	return event
+}
+
+// channelConversionRevertExpireAt returns the time until which the conversion recorded by the
+// event can be reverted, or 0 when reverting conversions is disabled.
+func (a *App) channelConversionRevertExpireAt(event *model.ChannelEvent) int64 {
+	window := *a.Config().TeamSettings.ChannelConversionRevertWindowMinutes
+	if window <= 0 {
+		return 0
+	}
+	return event.CreateAt + int64(time.Duration(window)*time.Minute/time.Millisecond)
+}
+
+func (a *App) GetChannelEvents(channelID string, page, perPage int) ([]*model.ChannelEvent, *model.AppError) {
+	events, err := a.Srv().Store.ChannelEvent().GetForChannel(channelID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelEvents", "app.channel_event.get_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return events, nil
+}
+
+func (a *App) GetChannelEvent(channelID, eventID string) (*model.ChannelEvent, *model.AppError) {
+	event, err := a.Srv().Store.ChannelEvent().Get(eventID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelEvent", "app.channel_event.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetChannelEvent", "app.channel_event.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if event.ChannelId != channelID {
+		return nil, model.NewAppError("GetChannelEvent", "app.channel_event.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return event, nil
+}
+
+// RevertChannelConversion converts the channel back to the type it had before the conversion
+// recorded by the event, provided the revert window hasn't expired and the channel wasn't
+// converted again since.
+func (a *App) RevertChannelConversion(c *request.Context, event *model.ChannelEvent, user *model.User) (*model.Channel, *model.AppError) {
+	if event.Type != model.ChannelEventTypeConvert {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.type.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if event.IsReverted() {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.already_reverted.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	now := model.GetMillis()
+	if expireAt := a.channelConversionRevertExpireAt(event); expireAt == 0 || now > expireAt {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.expired.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(event.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if string(channel.Type) != event.NewValue {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.outdated.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if channel.Name == model.DefaultChannelName && model.ChannelType(event.OldValue) == model.ChannelTypePrivate {
+		return nil, model.NewAppError("RevertChannelConversion", "api.channel.update_channel_privacy.default_channel_error", nil, "", http.StatusBadRequest)
+	}
+
+	var userID string
+	if user != nil {
+		userID = user.Id
+	}
+
+	reverted, err := a.Srv().Store.ChannelEvent().MarkReverted(event.Id, userID, now)
+	if err != nil {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if !reverted {
+		return nil, model.NewAppError("RevertChannelConversion", "app.channel_event.revert.already_reverted.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	channel.Type = model.ChannelType(event.OldValue)
+	return a.updateChannelPrivacy(c, channel, user, false)
+}
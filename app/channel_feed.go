@@ -0,0 +1,332 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/services/feed"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	channelFeedPollBatchSize = 100
+
+	// channelFeedMaxPostsPerPoll bounds how many items of a feed are posted at once, for a feed
+	// publishing many items at a time not to flood the channel. The older new items are recorded
+	// as seen without being posted.
+	channelFeedMaxPostsPerPoll = 10
+)
+
+func (a *App) CreateChannelFeed(channelFeed *model.ChannelFeed) (*model.ChannelFeed, *model.AppError) {
+	channel, appErr := a.checkChannelFeed(channelFeed)
+	if appErr != nil {
+		return nil, appErr
+	}
+	channelFeed.TeamId = channel.TeamId
+
+	saved, err := a.Srv().Store.ChannelFeed().Save(channelFeed)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateChannelFeed", "app.channel_feed.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateChannelFeed", "app.channel_feed.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetChannelFeed(feedID string) (*model.ChannelFeed, *model.AppError) {
+	channelFeed, err := a.Srv().Store.ChannelFeed().Get(feedID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetChannelFeed", "app.channel_feed.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetChannelFeed", "app.channel_feed.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return channelFeed, nil
+}
+
+func (a *App) GetChannelFeedsForChannel(channelID string) ([]*model.ChannelFeed, *model.AppError) {
+	feeds, err := a.Srv().Store.ChannelFeed().GetForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelFeedsForChannel", "app.channel_feed.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return feeds, nil
+}
+
+func (a *App) PatchChannelFeed(feedID string, patch *model.ChannelFeedPatch) (*model.ChannelFeed, *model.AppError) {
+	channelFeed, appErr := a.GetChannelFeed(feedID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channelFeed.Patch(patch)
+	if _, appErr = a.checkChannelFeed(channelFeed); appErr != nil {
+		return nil, appErr
+	}
+
+	channelFeed, err := a.Srv().Store.ChannelFeed().Update(channelFeed)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchChannelFeed", "app.channel_feed.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("PatchChannelFeed", "app.channel_feed.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return channelFeed, nil
+}
+
+func (a *App) DeleteChannelFeed(feedID string) *model.AppError {
+	if err := a.Srv().Store.ChannelFeed().Delete(feedID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteChannelFeed", "app.channel_feed.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteChannelFeed", "app.channel_feed.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// checkChannelFeed makes sure the items of the feed can be posted: the feeds must be enabled,
+// the channel must be a team channel that isn't archived, and the feed can't be polled more
+// often than the configured minimum.
+func (a *App) checkChannelFeed(channelFeed *model.ChannelFeed) (*model.Channel, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableChannelFeeds {
+		return nil, model.NewAppError("checkChannelFeed", "app.channel_feed.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	channel, appErr := a.GetChannel(channelFeed.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if channel.IsGroupOrDirect() || channel.DeleteAt != 0 {
+		return nil, model.NewAppError("checkChannelFeed", "app.channel_feed.invalid_channel.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	minInterval := *a.Config().ServiceSettings.ChannelFeedMinPollIntervalMinutes
+	if channelFeed.PollIntervalMinutes != 0 && channelFeed.PollIntervalMinutes < minInterval {
+		return nil, model.NewAppError("checkChannelFeed", "app.channel_feed.poll_interval_too_short.app_error", map[string]interface{}{"Min": minInterval}, "", http.StatusBadRequest)
+	}
+
+	return channel, nil
+}
+
+// getFeedBot returns the bot posting the items of the feeds, creating it if needed.
+func (a *App) getFeedBot() (*model.Bot, *model.AppError) {
+	sysAdminList, appErr := a.GetUsers(&model.UserGetOptions{
+		Page:    0,
+		PerPage: 1,
+		Role:    model.SystemAdminRoleId,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(sysAdminList) == 0 {
+		return nil, model.NewAppError("getFeedBot", "app.bot.get_system_bot.empty_admin_list.app_error", nil, "", http.StatusInternalServerError)
+	}
+
+	T := i18n.GetUserTranslations(sysAdminList[0].Locale)
+	return a.getOrCreateBot(&model.Bot{
+		Username:    model.BotFeedBotUsername,
+		DisplayName: T("app.channel_feed.bot_displayname"),
+		Description: T("app.channel_feed.bot_description"),
+		OwnerId:     sysAdminList[0].Id,
+	})
+}
+
+// PollChannelFeeds polls the feeds due and posts their new items to their channels. A feed
+// failing to be polled is attempted again at its next interval, its error kept for the
+// subscribers to see.
+func (a *App) PollChannelFeeds() *model.AppError {
+	bot, appErr := a.getFeedBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	c := request.EmptyContext()
+	client := a.HTTPService().MakeClient(false)
+
+	for {
+		due, err := a.Srv().Store.ChannelFeed().GetDue(model.GetMillis(), channelFeedPollBatchSize)
+		if err != nil {
+			return model.NewAppError("PollChannelFeeds", "app.channel_feed.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, channelFeed := range due {
+			pollErr := a.pollChannelFeed(c, bot, client, channelFeed)
+			if pollErr != nil {
+				mlog.Debug("Failed to poll a channel feed", mlog.String("feed_id", channelFeed.Id), mlog.Err(pollErr))
+				channelFeed.RecordPoll(pollErr.Error(), model.GetMillis())
+			} else {
+				channelFeed.RecordPoll("", model.GetMillis())
+			}
+
+			if err := a.Srv().Store.ChannelFeed().UpdatePollResult(channelFeed); err != nil {
+				return model.NewAppError("PollChannelFeeds", "app.channel_feed.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+		}
+
+		if len(due) < channelFeedPollBatchSize {
+			return nil
+		}
+	}
+}
+
+// pollChannelFeed fetches the feed and posts the items not seen yet, oldest first. Only the
+// newest item is posted the first time the feed is polled, for subscribing to a feed not to
+// post its whole history.
+func (a *App) pollChannelFeed(c *request.Context, bot *model.Bot, client *http.Client, channelFeed *model.ChannelFeed) error {
+	channel, appErr := a.GetChannel(channelFeed.ChannelId)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.DeleteAt != 0 {
+		return errors.New("the channel is archived")
+	}
+
+	tmpl, err := template.New("feed").Parse(channelFeed.GetTemplate())
+	if err != nil {
+		return err
+	}
+
+	fetched, err := feed.Fetch(client, channelFeed.URL)
+	if err != nil {
+		return err
+	}
+
+	newItems, err := a.getNewChannelFeedItems(channelFeed, fetched.Items)
+	if err != nil {
+		return err
+	}
+
+	limit := channelFeedMaxPostsPerPoll
+	if channelFeed.LastPolledAt == 0 {
+		limit = 1
+	}
+
+	seen := make([]*model.ChannelFeedItem, 0, len(newItems))
+	defer func() {
+		if err := a.Srv().Store.ChannelFeed().SaveItems(seen); err != nil {
+			mlog.Warn("Failed to record the items posted from a channel feed", mlog.String("feed_id", channelFeed.Id), mlog.Err(err))
+		}
+	}()
+
+	for i, item := range newItems {
+		seenItem := &model.ChannelFeedItem{
+			FeedId:   channelFeed.Id,
+			ItemGuid: model.ChannelFeedItemGuid(item.GUID),
+		}
+
+		if i >= len(newItems)-limit {
+			message, err := renderChannelFeedItem(tmpl, fetched.Title, item)
+			if err != nil {
+				return err
+			}
+
+			post, appErr := a.CreatePost(c, &model.Post{
+				UserId:    bot.UserId,
+				ChannelId: channel.Id,
+				Message:   message,
+			}, channel, false, true)
+			if appErr != nil {
+				return appErr
+			}
+			seenItem.PostId = post.Id
+		}
+
+		seen = append(seen, seenItem)
+	}
+
+	return nil
+}
+
+// getNewChannelFeedItems returns the items of the feed not seen yet, oldest first. Feeds list
+// their newest items first.
+func (a *App) getNewChannelFeedItems(channelFeed *model.ChannelFeed, items []*feed.Item) ([]*feed.Item, error) {
+	guids := make([]string, 0, len(items))
+	for _, item := range items {
+		guids = append(guids, model.ChannelFeedItemGuid(item.GUID))
+	}
+
+	existing, err := a.Srv().Store.ChannelFeed().GetExistingItemGuids(channelFeed.Id, guids)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool, len(existing))
+	for _, guid := range existing {
+		skip[guid] = true
+	}
+
+	newItems := make([]*feed.Item, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		guid := guids[i]
+		if skip[guid] {
+			continue
+		}
+		// A feed may list an item more than once.
+		skip[guid] = true
+		newItems = append(newItems, items[i])
+	}
+
+	return newItems, nil
+}
+
+func renderChannelFeedItem(tmpl *template.Template, feedTitle string, item *feed.Item) (string, error) {
+	entry := model.ChannelFeedEntry{
+		FeedTitle: feedTitle,
+		Title:     item.Title,
+		Link:      item.Link,
+		Summary:   strings.TrimSpace(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(item.Summary))),
+		Author:    item.Author,
+	}
+	if !item.PublishedAt.IsZero() {
+		entry.Published = item.PublishedAt.UTC().Format(time.RFC1123)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, entry); err != nil {
+		return "", err
+	}
+
+	message := strings.TrimSpace(buf.String())
+	if utf8.RuneCountInString(message) > model.PostMessageMaxRunesV2 {
+		message = string([]rune(message)[:model.PostMessageMaxRunesV2])
+	}
+	return message, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPollChannelFeeds(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var mut sync.Mutex
+	var items []string
+	var fail bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var sb strings.Builder
+		sb.WriteString(`<?xml version="1.0"?><rss version="2.0"><channel><title>Changelog</title>`)
+		// Feeds list their newest items first.
+		for i := len(items) - 1; i >= 0; i-- {
+			fmt.Fprintf(&sb, `<item><guid>%[1]s</guid><title>Item %[1]s</title><link>https://example.com/%[1]s</link><description>&lt;p&gt;About %[1]s&lt;/p&gt;</description></item>`, items[i])
+		}
+		sb.WriteString(`</channel></rss>`)
+		w.Write([]byte(sb.String()))
+	}))
+	defer server.Close()
+
+	setItems := func(newItems ...string) {
+		mut.Lock()
+		defer mut.Unlock()
+		items = newItems
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableChannelFeeds = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	channel := th.CreateChannel(th.BasicTeam)
+	channelFeed, appErr := th.App.CreateChannelFeed(&model.ChannelFeed{
+		ChannelId: channel.Id,
+		CreatorId: th.BasicUser.Id,
+		URL:       server.URL,
+		Template:  "{{.FeedTitle}}: [{{.Title}}]({{.Link}}) {{.Summary}}",
+	})
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicTeam.Id, channelFeed.TeamId)
+
+	pollAndGetMessages := func(t *testing.T) []string {
+		t.Helper()
+
+		// Make the feed due.
+		channelFeed, appErr := th.App.GetChannelFeed(channelFeed.Id)
+		require.Nil(t, appErr)
+		channelFeed.NextPollAt = 0
+		require.NoError(t, th.App.Srv().Store.ChannelFeed().UpdatePollResult(channelFeed))
+
+		since := model.GetMillis() - 1
+		require.Nil(t, th.App.PollChannelFeeds())
+
+		posts, appErr := th.App.GetPostsSince(model.GetPostsSinceOptions{ChannelId: channel.Id, Time: since})
+		require.Nil(t, appErr)
+		messages := []string{}
+		for i := len(posts.Order) - 1; i >= 0; i-- {
+			post := posts.Posts[posts.Order[i]]
+			if post.Type == "" {
+				messages = append(messages, post.Message)
+			}
+		}
+		return messages
+	}
+
+	t.Run("only the newest item is posted on the first poll", func(t *testing.T) {
+		setItems("a", "b", "c")
+		assert.Equal(t, []string{"Changelog: [Item c](https://example.com/c) About c"}, pollAndGetMessages(t))
+
+		bot, appErr := th.App.GetUserByUsername(model.BotFeedBotUsername)
+		require.Nil(t, appErr)
+		assert.True(t, bot.IsBot)
+	})
+
+	t.Run("new items are posted once, oldest first", func(t *testing.T) {
+		setItems("a", "b", "c", "d", "e")
+		assert.Equal(t, []string{
+			"Changelog: [Item d](https://example.com/d) About d",
+			"Changelog: [Item e](https://example.com/e) About e",
+		}, pollAndGetMessages(t))
+
+		assert.Empty(t, pollAndGetMessages(t))
+	})
+
+	t.Run("failures are recorded on the feed", func(t *testing.T) {
+		mut.Lock()
+		fail = true
+		mut.Unlock()
+		defer func() {
+			mut.Lock()
+			fail = false
+			mut.Unlock()
+		}()
+
+		assert.Empty(t, pollAndGetMessages(t))
+
+		channelFeed, appErr := th.App.GetChannelFeed(channelFeed.Id)
+		require.Nil(t, appErr)
+		assert.Contains(t, channelFeed.LastError, "503")
+		assert.Greater(t, channelFeed.NextPollAt, model.GetMillis())
+	})
+
+	t.Run("poll interval can't be shorter than the minimum", func(t *testing.T) {
+		_, appErr := th.App.PatchChannelFeed(channelFeed.Id, &model.ChannelFeedPatch{PollIntervalMinutes: model.NewInt(1)})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_feed.poll_interval_too_short.app_error", appErr.Id)
+
+		patched, appErr := th.App.PatchChannelFeed(channelFeed.Id, &model.ChannelFeedPatch{PollIntervalMinutes: model.NewInt(30)})
+		require.Nil(t, appErr)
+		assert.Equal(t, 30, patched.PollIntervalMinutes)
+	})
+
+	t.Run("direct channels can't subscribe to feeds", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+		_, appErr := th.App.CreateChannelFeed(&model.ChannelFeed{ChannelId: dm.Id, CreatorId: th.BasicUser.Id, URL: server.URL})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_feed.invalid_channel.app_error", appErr.Id)
+	})
+
+	require.Nil(t, th.App.DeleteChannelFeed(channelFeed.Id))
+	_, appErr = th.App.GetChannelFeed(channelFeed.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// channelIntegrationsBotsPerPage is the page size used to collect the bots of a channel.
+const channelIntegrationsBotsPerPage = 200
+
+// GetChannelIntegrations returns the incoming webhooks posting to the channel, the outgoing
+// webhooks it triggers, the slash commands of its team and the bots that are members of it,
+// along with the users owning them. Integrations disabled in the config are left out.
+func (a *App) GetChannelIntegrations(channel *model.Channel) (*model.ChannelIntegrations, *model.AppError) {
+	integrations := &model.ChannelIntegrations{
+		ChannelId:        channel.Id,
+		IncomingWebhooks: []*model.IncomingWebhook{},
+		OutgoingWebhooks: []*model.OutgoingWebhook{},
+		Commands:         []*model.Command{},
+		Bots:             []*model.Bot{},
+		Owners:           map[string]*model.User{},
+	}
+
+	if *a.Config().ServiceSettings.EnableIncomingWebhooks {
+		hooks, err := a.Srv().Store.Webhook().GetIncomingByChannel(channel.Id)
+		if err != nil {
+			return nil, model.NewAppError("GetChannelIntegrations", "app.webhooks.get_incoming_by_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		integrations.IncomingWebhooks = append(integrations.IncomingWebhooks, hooks...)
+	}
+
+	// Outgoing webhooks without a channel are triggered in every public channel of their team.
+	if *a.Config().ServiceSettings.EnableOutgoingWebhooks && channel.TeamId != "" {
+		hooks, err := a.Srv().Store.Webhook().GetOutgoingByTeam(channel.TeamId, -1, -1)
+		if err != nil {
+			return nil, model.NewAppError("GetChannelIntegrations", "app.webhooks.get_outgoing_by_team.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, hook := range hooks {
+			if hook.ChannelId == channel.Id || (hook.ChannelId == "" && channel.Type == model.ChannelTypeOpen) {
+				integrations.OutgoingWebhooks = append(integrations.OutgoingWebhooks, hook)
+			}
+		}
+	}
+
+	if *a.Config().ServiceSettings.EnableCommands && channel.TeamId != "" {
+		commands, appErr := a.ListTeamCommands(channel.TeamId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		integrations.Commands = append(integrations.Commands, commands...)
+	}
+
+	for page := 0; ; page++ {
+		bots, appErr := a.GetBots(&model.BotGetOptions{
+			InChannelId: channel.Id,
+			Page:        page,
+			PerPage:     channelIntegrationsBotsPerPage,
+		})
+		if appErr != nil {
+			return nil, appErr
+		}
+		integrations.Bots = append(integrations.Bots, bots...)
+		if len(bots) < channelIntegrationsBotsPerPage {
+			break
+		}
+	}
+
+	if ownerIds := integrations.OwnerIds(); len(ownerIds) > 0 {
+		owners, err := a.Srv().Store.User().GetProfileByIds(context.Background(), ownerIds, nil, true)
+		if err != nil {
+			return nil, model.NewAppError("GetChannelIntegrations", "app.user.get_profiles.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, owner := range owners {
+			a.SanitizeProfile(owner, false)
+			integrations.Owners[owner.Id] = owner
+		}
+	}
+
+	integrations.Sanitize()
+
+	return integrations, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/langdetect"
+)
+
+// setPostLanguage detects the language of the message of a user post and records it in the
+// post props, removing any language previously recorded when none is detected.
+func (a *App) setPostLanguage(post *model.Post) {
+	if !*a.Config().ServiceSettings.EnablePostLanguageDetection || post.Type != model.PostTypeDefault {
+		return
+	}
+
+	if language := langdetect.Detect(post.Message); language != "" {
+		post.AddProp(model.PostPropsLanguage, language)
+	} else if post.GetProp(model.PostPropsLanguage) != nil {
+		post.DelProp(model.PostPropsLanguage)
+	}
+}
+
+// RollupChannelLanguageStats counts the posts made in each language in every channel for
+// every full day since the last rollup. The first rollup backfills the default stats period.
+func (a *App) RollupChannelLanguageStats() *model.AppError {
+	return a.rollupDays("RollupChannelLanguageStats", model.SystemLanguageStatsLastRollupDay, model.ChannelLanguageStatsDefaultDays, func(day int64) *model.AppError {
+		if err := a.Srv().Store.ChannelLanguageStats().RollupDay(day); err != nil {
+			return model.NewAppError("RollupChannelLanguageStats", "app.channel_language_stats.rollup.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return nil
+	})
+}
+
+// GetChannelLanguageStats returns the number of posts made in each language in the channel
+// over the given number of days up to the last rollup.
+func (a *App) GetChannelLanguageStats(channelID string, days int) (*model.ChannelLanguageStats, *model.AppError) {
+	until := startOfTodayUTC()
+	stats := &model.ChannelLanguageStats{
+		ChannelId: channelID,
+		Since:     until - int64(days)*model.TeamStatsDayMillis,
+		Until:     until,
+	}
+
+	var err error
+	stats.Languages, err = a.Srv().Store.ChannelLanguageStats().GetForChannel(channelID, stats.Since, stats.Until)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelLanguageStats", "app.channel_language_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return stats, nil
+}
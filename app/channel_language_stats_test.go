@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPostLanguageDetection(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createPost := func(message string) *model.Post {
+		post, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   message,
+		}, "", true)
+		require.Nil(t, appErr)
+		return post
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		post := createPost("The release is ready and the notes are with you")
+		assert.Nil(t, post.GetProp(model.PostPropsLanguage))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePostLanguageDetection = true })
+
+	t.Run("detected on create and update", func(t *testing.T) {
+		post := createPost("The release is ready and the notes are with you")
+		assert.Equal(t, "en", post.GetProp(model.PostPropsLanguage))
+
+		post.Message = "La version est prête et les notes sont pour vous"
+		updated, appErr := th.App.UpdatePost(th.Context, post, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, "fr", updated.GetProp(model.PostPropsLanguage))
+
+		updated.Message = "ok"
+		updated, appErr = th.App.UpdatePost(th.Context, updated, true)
+		require.Nil(t, appErr)
+		assert.Nil(t, updated.GetProp(model.PostPropsLanguage))
+	})
+}
+
+func TestRollupChannelLanguageStats(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	yesterday := startOfTodayUTC() - model.TeamStatsDayMillis
+	post := &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser.Id,
+		Message:   "yesterday",
+		CreateAt:  yesterday + 1,
+	}
+	post.AddProp(model.PostPropsLanguage, "de")
+	_, err := th.App.Srv().Store.Post().Save(post)
+	require.NoError(t, err)
+
+	// Start from the day before yesterday so that only yesterday is rolled up.
+	require.NoError(t, th.App.Srv().Store.System().SaveOrUpdate(&model.System{
+		Name:  model.SystemLanguageStatsLastRollupDay,
+		Value: strconv.FormatInt(yesterday-model.TeamStatsDayMillis, 10),
+	}))
+
+	require.Nil(t, th.App.RollupChannelLanguageStats())
+
+	lastRollup, err := th.App.Srv().Store.System().GetByName(model.SystemLanguageStatsLastRollupDay)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(yesterday, 10), lastRollup.Value)
+
+	stats, appErr := th.App.GetChannelLanguageStats(th.BasicChannel.Id, 1)
+	require.Nil(t, appErr)
+	require.Len(t, stats.Languages, 1)
+	assert.Equal(t, "de", stats.Languages[0].Language)
+	assert.Equal(t, int64(1), stats.Languages[0].PostCount)
+}
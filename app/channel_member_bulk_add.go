@@ -0,0 +1,324 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// channelMemberBulkAddDirectory holds the user lists and the reports of the bulk adds of
+// channel members.
+const channelMemberBulkAddDirectory = "channel_member_bulk_adds"
+
+const (
+	// channelMemberBulkAddBatchSize is how many users are looked up and saved at once.
+	channelMemberBulkAddBatchSize = 1000
+	// channelMemberBulkAddEventSize is how many added users a websocket event lists at most.
+	channelMemberBulkAddEventSize = 100
+)
+
+// CreateChannelMemberBulkAddJob queues the addition of the users to the channel. The list of
+// users is kept in the file store since it can be too long for the data of the job.
+func (a *App) CreateChannelMemberBulkAddJob(channel *model.Channel, requesterID string, userIDs []string) (*model.ChannelMemberBulkAddReport, *model.AppError) {
+	if channel.Type != model.ChannelTypeOpen && channel.Type != model.ChannelTypePrivate {
+		return nil, model.NewAppError("CreateChannelMemberBulkAddJob", "api.channel.add_user_to_channel.type.app_error", nil, "", http.StatusBadRequest)
+	}
+	if channel.DeleteAt > 0 {
+		return nil, model.NewAppError("CreateChannelMemberBulkAddJob", "app.channel_member_bulk_add.archived.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	userIDs = model.RemoveDuplicateStrings(userIDs)
+
+	dir := filepath.Join(channelMemberBulkAddDirectory, model.NewId())
+	data := map[string]string{
+		"channel_id":    channel.Id,
+		"requester_id":  requesterID,
+		"total":         strconv.Itoa(len(userIDs)),
+		"user_ids_file": filepath.Join(dir, "user_ids.json"),
+		"report_file":   filepath.Join(dir, "report.json"),
+	}
+
+	js, err := json.Marshal(userIDs)
+	if err != nil {
+		return nil, model.NewAppError("CreateChannelMemberBulkAddJob", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if _, appErr := a.WriteFile(bytes.NewReader(js), data["user_ids_file"]); appErr != nil {
+		return nil, appErr
+	}
+
+	job, appErr := a.Srv().Jobs.CreateJob(model.JobTypeChannelMemberBulkAdd, data)
+	if appErr != nil {
+		a.removeChannelMemberBulkAddFiles(data)
+		return nil, appErr
+	}
+
+	report := &model.ChannelMemberBulkAddReport{
+		JobId:     job.Id,
+		ChannelId: channel.Id,
+		Status:    job.Status,
+		Total:     len(userIDs),
+		Failures:  []*model.ChannelMemberBulkAddFailure{},
+	}
+	if appErr := a.writeChannelMemberBulkAddReport(data["report_file"], report); appErr != nil {
+		return nil, appErr
+	}
+
+	return report, nil
+}
+
+// GetChannelMemberBulkAddJob returns the job of a bulk add of members to the channel.
+func (a *App) GetChannelMemberBulkAddJob(channelID, jobID string) (*model.Job, *model.AppError) {
+	job, appErr := a.GetJob(jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if job.Type != model.JobTypeChannelMemberBulkAdd || job.Data["channel_id"] != channelID {
+		return nil, model.NewAppError("GetChannelMemberBulkAddJob", "app.channel_member_bulk_add.not_found.app_error", nil, "job_id="+jobID, http.StatusNotFound)
+	}
+
+	return job, nil
+}
+
+// GetChannelMemberBulkAddReport returns how many users the bulk add of members to the channel
+// added so far, along with the ones it couldn't add.
+func (a *App) GetChannelMemberBulkAddReport(channelID, jobID string) (*model.ChannelMemberBulkAddReport, *model.AppError) {
+	job, appErr := a.GetChannelMemberBulkAddJob(channelID, jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	report, appErr := a.readChannelMemberBulkAddReport(job.Data["report_file"])
+	if appErr != nil {
+		return nil, appErr
+	}
+	report.Status = job.Status
+
+	return report, nil
+}
+
+// ProcessChannelMemberBulkAdd adds the users of the job to its channel in batches, saving the
+// report after each batch. Unlike AddChannelMember, no message is posted to the channel and
+// the plugins aren't told about each new member.
+func (a *App) ProcessChannelMemberBulkAdd(job *model.Job) *model.AppError {
+	channel, appErr := a.GetChannel(job.Data["channel_id"])
+	if appErr != nil {
+		return appErr
+	}
+	if channel.DeleteAt > 0 {
+		return model.NewAppError("ProcessChannelMemberBulkAdd", "app.channel_member_bulk_add.archived.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	data, appErr := a.ReadFile(job.Data["user_ids_file"])
+	if appErr != nil {
+		return appErr
+	}
+	var userIDs []string
+	if err := json.Unmarshal(data, &userIDs); err != nil {
+		return model.NewAppError("ProcessChannelMemberBulkAdd", "api.unmarshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	permittedAdmins, err := a.Srv().Store.Group().PermittedSyncableAdmins(channel.Id, model.GroupSyncableTypeChannel)
+	if err != nil {
+		return model.NewAppError("ProcessChannelMemberBulkAdd", "app.select_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	admins := make(map[string]bool, len(permittedAdmins))
+	for _, userID := range permittedAdmins {
+		admins[userID] = true
+	}
+
+	var groupUsers map[string]bool
+	if channel.IsGroupConstrained() {
+		users, appErr := a.GetChannelGroupUsers(channel.Id)
+		if appErr != nil {
+			return appErr
+		}
+		groupUsers = make(map[string]bool, len(users))
+		for _, user := range users {
+			groupUsers[user.Id] = true
+		}
+	}
+
+	report := &model.ChannelMemberBulkAddReport{
+		JobId:     job.Id,
+		ChannelId: channel.Id,
+		Total:     len(userIDs),
+		Failures:  []*model.ChannelMemberBulkAddFailure{},
+	}
+
+	for start := 0; start < len(userIDs); start += channelMemberBulkAddBatchSize {
+		end := start + channelMemberBulkAddBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		if appErr := a.addChannelMemberBatch(channel, userIDs[start:end], admins, groupUsers, report); appErr != nil {
+			return appErr
+		}
+		report.Processed = end
+
+		if appErr := a.writeChannelMemberBulkAddReport(job.Data["report_file"], report); appErr != nil {
+			return appErr
+		}
+
+		job.Progress = int64(end * 100 / len(userIDs))
+		job.LastActivityAt = model.GetMillis()
+		updated, err := a.Srv().Store.Job().UpdateOptimistically(job, model.JobStatusInProgress)
+		if err != nil {
+			return model.NewAppError("ProcessChannelMemberBulkAdd", "app.job.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if !updated {
+			return model.NewAppError("ProcessChannelMemberBulkAdd", "app.channel_member_bulk_add.canceled.app_error", nil, "job_id="+job.Id, http.StatusBadRequest)
+		}
+	}
+
+	if appErr := a.RemoveFile(job.Data["user_ids_file"]); appErr != nil {
+		mlog.Warn("Failed to remove the users of a channel member bulk add", mlog.String("job_id", job.Id), mlog.Err(appErr))
+	}
+
+	return nil
+}
+
+// addChannelMemberBatch adds the users who can join the channel among the given ones, saving
+// them at once, and records the others as failures in the report.
+func (a *App) addChannelMemberBatch(channel *model.Channel, userIDs []string, admins, groupUsers map[string]bool, report *model.ChannelMemberBulkAddReport) *model.AppError {
+	fail := func(userID, errorID string) {
+		report.Failures = append(report.Failures, &model.ChannelMemberBulkAddFailure{UserId: userID, Error: errorID})
+	}
+
+	users, err := a.Srv().Store.User().GetProfileByIds(context.Background(), userIDs, nil, false)
+	if err != nil {
+		return model.NewAppError("addChannelMemberBatch", "app.user.get_profiles.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	usersByID := make(map[string]*model.User, len(users))
+	for _, user := range users {
+		usersByID[user.Id] = user
+	}
+
+	teamMembers, err := a.Srv().Store.Team().GetMembersByIds(channel.TeamId, userIDs, nil)
+	if err != nil {
+		return model.NewAppError("addChannelMemberBatch", "app.team.get_members_by_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	onTeam := make(map[string]bool, len(teamMembers))
+	for _, member := range teamMembers {
+		onTeam[member.UserId] = true
+	}
+
+	channelMembers, err := a.Srv().Store.Channel().GetMembersByIds(channel.Id, userIDs)
+	if err != nil {
+		return model.NewAppError("addChannelMemberBatch", "app.channel.get_members_by_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	onChannel := make(map[string]bool, len(channelMembers))
+	for _, member := range channelMembers {
+		onChannel[member.UserId] = true
+	}
+
+	newMembers := []*model.ChannelMember{}
+	for _, userID := range userIDs {
+		user, ok := usersByID[userID]
+		switch {
+		case !ok:
+			fail(userID, "app.user.missing_account.const")
+		case user.DeleteAt > 0:
+			fail(userID, "app.channel_member_bulk_add.deactivated.app_error")
+		case onChannel[userID]:
+			report.AlreadyMembers++
+		case !onTeam[userID]:
+			fail(userID, "app.team.get_member.missing.app_error")
+		case groupUsers != nil && !user.IsBot && !groupUsers[userID]:
+			fail(userID, "api.channel.add_members.user_denied")
+		default:
+			newMembers = append(newMembers, &model.ChannelMember{
+				ChannelId:   channel.Id,
+				UserId:      userID,
+				NotifyProps: model.GetDefaultChannelNotifyProps(),
+				SchemeGuest: user.IsGuest(),
+				SchemeUser:  !user.IsGuest(),
+				SchemeAdmin: !user.IsGuest() && admins[userID],
+			})
+		}
+	}
+
+	if len(newMembers) == 0 {
+		return nil
+	}
+
+	if _, err := a.Srv().Store.Channel().SaveMultipleMembers(newMembers); err != nil {
+		mlog.Warn("Failed to save a batch of channel members", mlog.String("channel_id", channel.Id), mlog.Err(err))
+		for _, member := range newMembers {
+			fail(member.UserId, "api.channel.add_user.to.channel.failed.app_error")
+		}
+		return nil
+	}
+
+	addedIDs := make([]string, 0, len(newMembers))
+	joinedAt := model.GetMillis()
+	for _, member := range newMembers {
+		if err := a.Srv().Store.ChannelMemberHistory().LogJoinEvent(member.UserId, channel.Id, joinedAt); err != nil {
+			mlog.Warn("Failed to log the join of a channel member", mlog.String("channel_id", channel.Id), mlog.String("user_id", member.UserId), mlog.Err(err))
+		}
+		a.InvalidateCacheForUser(member.UserId)
+		addedIDs = append(addedIDs, member.UserId)
+	}
+	a.invalidateCacheForChannelMembers(channel.Id)
+	a.Srv().Store.Channel().InvalidateMemberCount(channel.Id)
+	report.Added += len(addedIDs)
+
+	for start := 0; start < len(addedIDs); start += channelMemberBulkAddEventSize {
+		end := start + channelMemberBulkAddEventSize
+		if end > len(addedIDs) {
+			end = len(addedIDs)
+		}
+
+		message := model.NewWebSocketEvent(model.WebsocketEventUsersAdded, "", channel.Id, "", nil)
+		message.Add("user_ids", model.ArrayToJSON(addedIDs[start:end]))
+		message.Add("team_id", channel.TeamId)
+		a.Publish(message)
+	}
+
+	return nil
+}
+
+func (a *App) readChannelMemberBulkAddReport(path string) (*model.ChannelMemberBulkAddReport, *model.AppError) {
+	data, appErr := a.ReadFile(path)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var report model.ChannelMemberBulkAddReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, model.NewAppError("readChannelMemberBulkAddReport", "api.unmarshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return &report, nil
+}
+
+func (a *App) writeChannelMemberBulkAddReport(path string, report *model.ChannelMemberBulkAddReport) *model.AppError {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return model.NewAppError("writeChannelMemberBulkAddReport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	_, appErr := a.WriteFile(bytes.NewReader(data), path)
+	return appErr
+}
+
+func (a *App) removeChannelMemberBulkAddFiles(data map[string]string) {
+	for _, key := range []string{"user_ids_file", "report_file"} {
+		if data[key] == "" {
+			continue
+		}
+		if appErr := a.RemoveFile(data[key]); appErr != nil {
+			mlog.Warn("Failed to remove a file of a channel member bulk add", mlog.String("path", data[key]), mlog.Err(appErr))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestProcessChannelMemberBulkAdd(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newUser := th.CreateUser()
+	th.LinkUserToTeam(newUser, th.BasicTeam)
+	otherTeamUser := th.CreateUser()
+	deactivated := th.CreateUser()
+	th.LinkUserToTeam(deactivated, th.BasicTeam)
+	_, appErr := th.App.UpdateActive(th.Context, deactivated, false)
+	require.Nil(t, appErr)
+	missingID := model.NewId()
+
+	userIDs := []string{newUser.Id, th.BasicUser2.Id, otherTeamUser.Id, deactivated.Id, missingID}
+
+	dir := filepath.Join(channelMemberBulkAddDirectory, model.NewId())
+	data := map[string]string{
+		"channel_id":    th.BasicChannel.Id,
+		"user_ids_file": filepath.Join(dir, "user_ids.json"),
+		"report_file":   filepath.Join(dir, "report.json"),
+	}
+	js, err := json.Marshal(userIDs)
+	require.NoError(t, err)
+	_, appErr = th.App.WriteFile(bytes.NewReader(js), data["user_ids_file"])
+	require.Nil(t, appErr)
+
+	// The job is saved as already started so that no worker picks it up.
+	job, err := th.App.Srv().Store.Job().Save(&model.Job{
+		Id:       model.NewId(),
+		Type:     model.JobTypeChannelMemberBulkAdd,
+		Status:   model.JobStatusInProgress,
+		CreateAt: model.GetMillis(),
+		Data:     data,
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, th.App.ProcessChannelMemberBulkAdd(job))
+
+	report, appErr := th.App.GetChannelMemberBulkAddReport(th.BasicChannel.Id, job.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, len(userIDs), report.Total)
+	assert.Equal(t, len(userIDs), report.Processed)
+	assert.Equal(t, 1, report.Added)
+	assert.Equal(t, 1, report.AlreadyMembers)
+
+	failures := map[string]string{}
+	for _, failure := range report.Failures {
+		failures[failure.UserId] = failure.Error
+	}
+	assert.Equal(t, map[string]string{
+		otherTeamUser.Id: "app.team.get_member.missing.app_error",
+		deactivated.Id:   "app.channel_member_bulk_add.deactivated.app_error",
+		missingID:        "app.user.missing_account.const",
+	}, failures)
+
+	member, appErr := th.App.GetChannelMember(context.Background(), th.BasicChannel.Id, newUser.Id)
+	require.Nil(t, appErr)
+	assert.True(t, member.SchemeUser)
+	assert.False(t, member.SchemeAdmin)
+
+	job, err = th.App.Srv().Store.Job().Get(job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), job.Progress)
+
+	exists, appErr := th.App.FileExists(data["user_ids_file"])
+	require.Nil(t, appErr)
+	assert.False(t, exists)
+
+	t.Run("report of another channel", func(t *testing.T) {
+		_, appErr := th.App.GetChannelMemberBulkAddReport(th.CreateChannel(th.BasicTeam).Id, job.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
+
+func TestCreateChannelMemberBulkAddJob(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("direct channel", func(t *testing.T) {
+		channel := th.CreateDmChannel(th.BasicUser2)
+		_, appErr := th.App.CreateChannelMemberBulkAddJob(channel, th.BasicUser.Id, []string{th.BasicUser2.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("archived channel", func(t *testing.T) {
+		channel := th.CreateChannel(th.BasicTeam)
+		require.Nil(t, th.App.DeleteChannel(th.Context, channel, th.BasicUser.Id))
+		channel, appErr := th.App.GetChannel(channel.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.CreateChannelMemberBulkAddJob(channel, th.BasicUser.Id, []string{th.BasicUser2.Id})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.channel_member_bulk_add.archived.app_error", appErr.Id)
+	})
+
+	t.Run("duplicates are added once", func(t *testing.T) {
+		report, appErr := th.App.CreateChannelMemberBulkAddJob(th.BasicChannel, th.BasicUser.Id, []string{th.BasicUser2.Id, th.BasicUser2.Id})
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, report.Total)
+		assert.Equal(t, th.BasicChannel.Id, report.ChannelId)
+
+		job, appErr := th.App.GetChannelMemberBulkAddJob(th.BasicChannel.Id, report.JobId)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, job.Data["requester_id"])
+	})
+}
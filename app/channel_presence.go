@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// IsChannelPresenceShared returns false if the user opted out of letting other members
+// see which channel they have open.
+func (a *App) IsChannelPresenceShared(userID string) bool {
+	pref, err := a.GetPreferenceByCategoryAndNameForUser(userID, model.PreferenceCategoryPrivacy, model.PreferenceNameShareChannelPresence)
+	if err != nil {
+		return true
+	}
+	return pref.Value != "false"
+}
+
+// SetChannelPresence records that the session has the channel open, or that it closed the
+// channel it had open if channelID is empty. The presence of users who opted out is never
+// recorded.
+func (a *App) SetChannelPresence(session model.Session, channelID string) *model.AppError {
+	if !*a.Config().PrivacySettings.ShowChannelPresence {
+		return model.NewAppError("SetChannelPresence", "app.channel.presence.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if channelID != "" && !a.IsChannelPresenceShared(session.UserId) {
+		channelID = ""
+	}
+
+	hub := a.GetHubForUserId(session.UserId)
+	if hub == nil {
+		return nil
+	}
+	hub.SetChannelPresence(session.UserId, session.Token, channelID)
+
+	return nil
+}
+
+// GetChannelPresence returns the users connected to this server who currently have the
+// channel open.
+func (a *App) GetChannelPresence(channelID string) (*model.ChannelPresence, *model.AppError) {
+	if !*a.Config().PrivacySettings.ShowChannelPresence {
+		return nil, model.NewAppError("GetChannelPresence", "app.channel.presence.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	userIDs := []string{}
+	for _, hub := range a.Srv().hubs {
+		userIDs = append(userIDs, hub.GetChannelPresence(channelID)...)
+	}
+	sort.Strings(userIDs)
+
+	return &model.ChannelPresence{
+		ChannelId: channelID,
+		UserIds:   userIDs,
+	}, nil
+}
+
+func (a *App) publishChannelPresenceChanges(changes []channelPresenceChange) {
+	for _, change := range changes {
+		event := model.NewWebSocketEvent(model.WebsocketEventChannelPresence, "", change.channelID, "", nil)
+		event.Add("user_id", change.userID)
+		event.Add("viewing", change.viewing)
+		a.Publish(event)
+	}
+}
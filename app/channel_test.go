@@ -2126,8 +2126,8 @@ func TestClearChannelMembersCache(t *testing.T) {
 			ChannelId: "1",
 		})
 	}
-	mockChannelStore.On("GetMembers", "channelID", 0, 100).Return(cms, nil)
-	mockChannelStore.On("GetMembers", "channelID", 100, 100).Return(model.ChannelMembers{
+	mockChannelStore.On("GetMembers", "channelID", 0, 100, mock.Anything).Return(cms, nil)
+	mockChannelStore.On("GetMembers", "channelID", 100, 100, mock.Anything).Return(model.ChannelMembers{
 		model.ChannelMember{
 			ChannelId: "1",
 		}}, nil)
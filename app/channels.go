@@ -56,6 +56,8 @@ type Channels struct {
 
 	pluginCommandsLock     sync.RWMutex
 	pluginCommands         []*PluginCommand
+	pluginAuditFiltersLock sync.RWMutex
+	pluginAuditFilters     map[string][]string
 	pluginsLock            sync.RWMutex
 	pluginsEnvironment     *plugin.Environment
 	pluginConfigListenerID string
@@ -88,6 +90,10 @@ type Channels struct {
 	uploadLockMapMut sync.Mutex
 	uploadLockMap    map[string]bool
 
+	// These serialize pointing deduplicated files to a stored file and removing it, so that a
+	// stored file isn't removed while a file is being pointed to it.
+	fileReferenceMuts [64]sync.Mutex
+
 	imgDecoder *imaging.Decoder
 	imgEncoder *imaging.Encoder
 
@@ -104,11 +110,16 @@ func init() {
 func NewChannels(s *Server, services map[ServiceKey]interface{}) (*Channels, error) {
 
 	ch := &Channels{
-		srv:           s,
-		imageProxy:    imageproxy.MakeImageProxy(s, s.httpService, s.Log),
-		uploadLockMap: map[string]bool{},
+		srv:                s,
+		imageProxy:         imageproxy.MakeImageProxy(s, s.httpService, s.Log),
+		uploadLockMap:      map[string]bool{},
+		pluginAuditFilters: map[string][]string{},
 	}
 
+	s.RegisterConfigReloader(ConfigSectionImageProxy, ConfigReloaderFunc(func(oldCfg, newCfg *model.Config) error {
+		return ch.imageProxy.ReloadConfig(oldCfg, newCfg)
+	}))
+
 	// To get another service:
 	// 1. Prepare the service interface
 	// 2. Add the field to *Channels
@@ -69,6 +69,7 @@ func (s *Server) registerClusterHandlers() {
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForChannelByName, s.clusterInvalidateCacheForChannelByNameHandler)
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForUser, s.clusterInvalidateCacheForUserHandler)
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForUserTeams, s.clusterInvalidateCacheForUserTeamsHandler)
+	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForPostMetadata, s.clusterInvalidateCacheForPostMetadataHandler)
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventBusyStateChanged, s.clusterBusyStateChgHandler)
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventClearSessionCacheForUser, s.clusterClearSessionCacheForUserHandler)
 	s.Cluster.RegisterClusterMessageHandler(model.ClusterEventClearSessionCacheForAllUsers, s.clusterClearSessionCacheForAllUsersHandler)
@@ -111,6 +112,7 @@ func (s *Server) clusterInvalidateCacheForUserHandler(msg *model.ClusterMessage)
 }
 
 func (s *Server) clusterInvalidateCacheForUserTeamsHandler(msg *model.ClusterMessage) {
+	s.userIsolationCache.Remove(string(msg.Data))
 	s.invalidateWebConnSessionCacheForUser(string(msg.Data))
 }
 
@@ -154,6 +156,7 @@ func (s *Server) invalidateCacheForChannelByNameSkipClusterSend(teamID, name str
 
 func (s *Server) invalidateCacheForUserSkipClusterSend(userID string) {
 	s.Store.Channel().InvalidateAllChannelMembersForUser(userID)
+	s.userIsolationCache.Remove(userID)
 	s.invalidateWebConnSessionCacheForUser(userID)
 }
 
@@ -196,6 +196,10 @@ func (a *App) ExecuteCommand(c *request.Context, args *model.CommandArgs) (*mode
 	}
 	trigger = strings.TrimPrefix(trigger, "/")
 
+	if appErr := a.checkCommandEnabledInChannel(args.ChannelId, trigger); appErr != nil {
+		return nil, appErr
+	}
+
 	clientTriggerId, triggerId, appErr := model.GenerateTriggerId(args.UserId, a.AsymmetricSigningKey())
 	if appErr != nil {
 		mlog.Warn("error occurred in generating trigger Id for a user ", mlog.Err(appErr))
@@ -203,6 +207,14 @@ func (a *App) ExecuteCommand(c *request.Context, args *model.CommandArgs) (*mode
 
 	args.TriggerId = triggerId
 
+	// Every execution of a command is audited, whether it succeeded or not
+	var cmd *model.Command
+	defer func() {
+		if cmd != nil {
+			a.auditCommandExecution(c, cmd, args, trigger)
+		}
+	}()
+
 	// Plugins can override built in and custom commands
 	cmd, response, appErr := a.tryExecutePluginCommand(c, args)
 	if appErr != nil {
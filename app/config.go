@@ -20,10 +20,12 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/mattermost/mattermost-server/v6/app/email"
 	"github.com/mattermost/mattermost-server/v6/config"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/mail"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
 	"github.com/mattermost/mattermost-server/v6/utils"
 )
 
@@ -68,8 +70,21 @@ func (w *configWrapper) UpdateConfig(f func(*model.Config)) {
 
 func (w *configWrapper) SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError) {
 	oldCfg, newCfg, err := w.Store.Set(newCfg)
+	return w.configSaved(oldCfg, newCfg, err, sendConfigChangeClusterMessage)
+}
+
+// SaveConfigIfVersion replaces the configuration like SaveConfig, provided the current
+// configuration is still at the given version.
+func (w *configWrapper) SaveConfigIfVersion(newCfg *model.Config, version string, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError) {
+	oldCfg, newCfg, err := w.Store.SetIfVersion(newCfg, version)
+	return w.configSaved(oldCfg, newCfg, err, sendConfigChangeClusterMessage)
+}
+
+func (w *configWrapper) configSaved(oldCfg, newCfg *model.Config, err error, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError) {
 	if errors.Cause(err) == config.ErrReadOnlyConfiguration {
 		return nil, nil, model.NewAppError("saveConfig", "ent.cluster.save_config.error", nil, err.Error(), http.StatusForbidden)
+	} else if errors.Cause(err) == config.ErrVersionMismatch {
+		return nil, nil, model.NewAppError("saveConfig", "app.save_config.version_mismatch.app_error", nil, err.Error(), http.StatusConflict)
 	} else if err != nil {
 		return nil, nil, model.NewAppError("saveConfig", "app.save_config.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -473,6 +488,122 @@ func (a *App) SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bo
 	return a.Srv().SaveConfig(newCfg, sendConfigChangeClusterMessage)
 }
 
+// SaveConfigIfVersion replaces the active configuration and notifies cluster peers, provided the
+// configuration is still at the given version, as returned by GetConfigVersion. An empty version
+// replaces the configuration unconditionally. The change is recorded in the config history on
+// behalf of the given user.
+func (a *App) SaveConfigIfVersion(newCfg *model.Config, version, userID string) (*model.Config, *model.Config, *model.AppError) {
+	var oldCfg *model.Config
+	var appErr *model.AppError
+	if version == "" {
+		oldCfg, newCfg, appErr = a.Srv().configStore.SaveConfig(newCfg, true)
+	} else {
+		oldCfg, newCfg, appErr = a.Srv().configStore.SaveConfigIfVersion(newCfg, version, true)
+	}
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	if err := a.recordConfigHistory(oldCfg, newCfg, userID); err != nil {
+		mlog.Warn("Failed to record the config history", mlog.Err(err))
+	}
+
+	return oldCfg, newCfg, nil
+}
+
+// GetConfigVersion returns the version of the active configuration.
+func (a *App) GetConfigVersion() (string, *model.AppError) {
+	version, err := config.Version(a.Config())
+	if err != nil {
+		return "", model.NewAppError("GetConfigVersion", "app.config.version.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return version, nil
+}
+
+// recordConfigHistory records the new version of the configuration. The previous version is
+// recorded first, without a user, if the history doesn't have it yet, so the first change saved
+// through the API can be diffed too.
+func (a *App) recordConfigHistory(oldCfg, newCfg *model.Config, userID string) error {
+	oldVersion, err := config.Version(oldCfg)
+	if err != nil {
+		return err
+	}
+	newVersion, err := config.Version(newCfg)
+	if err != nil {
+		return err
+	}
+	if oldVersion == newVersion {
+		return nil
+	}
+
+	if _, err = a.Srv().Store.ConfigHistory().GetByVersion(oldVersion); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return err
+		}
+		if err = a.saveConfigVersion(oldCfg, oldVersion, ""); err != nil {
+			return err
+		}
+	}
+
+	return a.saveConfigVersion(newCfg, newVersion, userID)
+}
+
+func (a *App) saveConfigVersion(cfg *model.Config, version, userID string) error {
+	sanitized := cfg.Clone()
+	sanitized.Sanitize()
+	value, err := json.Marshal(sanitized)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.Srv().Store.ConfigHistory().Save(&model.ConfigVersion{
+		Version: version,
+		UserId:  userID,
+		Value:   string(value),
+	})
+	return err
+}
+
+// GetConfigHistory returns a page of the config history, newest first, without the
+// configurations themselves.
+func (a *App) GetConfigHistory(page, perPage int) ([]*model.ConfigVersion, *model.AppError) {
+	versions, err := a.Srv().Store.ConfigHistory().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigHistory", "app.config_history.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return versions, nil
+}
+
+// GetConfigForVersion returns the sanitized configuration at the given version. The active
+// version is always available, while others must have been recorded in the config history.
+func (a *App) GetConfigForVersion(version string) (*model.Config, *model.AppError) {
+	current, appErr := a.GetConfigVersion()
+	if appErr != nil {
+		return nil, appErr
+	}
+	if version == current {
+		return a.GetSanitizedConfig(), nil
+	}
+
+	configVersion, err := a.Srv().Store.ConfigHistory().GetByVersion(version)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetConfigForVersion", "app.config_history.get_by_version.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetConfigForVersion", "app.config_history.get_by_version.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	cfg, err := configVersion.Config()
+	if err != nil {
+		return nil, model.NewAppError("GetConfigForVersion", "app.config_history.get_by_version.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return cfg, nil
+}
+
 func (a *App) HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config) {
 	// If the Message Export feature has been toggled in the System Console, rewrite the ExportFromTimestamp field to an
 	// appropriate value. The rewriting occurs here to ensure it doesn't affect values written to the config file
@@ -507,6 +638,12 @@ func (s *Server) MailServiceConfig() *mail.SMTPConfig {
 		FeedbackName:                      *emailSettings.FeedbackName,
 		FeedbackEmail:                     *emailSettings.FeedbackEmail,
 		ReplyToAddress:                    *emailSettings.ReplyToAddress,
+		Provider:                          *emailSettings.EmailProvider,
+		ProviderAPIKey:                    *emailSettings.EmailProviderAPIKey,
+		ProviderAccessKeyId:               *emailSettings.EmailProviderAccessKeyId,
+		ProviderRegion:                    *emailSettings.EmailProviderRegion,
+		ProviderDomain:                    *emailSettings.EmailProviderDomain,
+		Suppressions:                      &email.SuppressionList{Store: s.Store},
 	}
 	return &cfg
 }
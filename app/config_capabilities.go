@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// clientCapabilityChanges returns the boolean flags of the client config, such as
+// EnableEmailInvitations, whose value differs between the two client configs. A flag missing
+// from one of them counts as disabled.
+func clientCapabilityChanges(oldClientConfig, newClientConfig map[string]string) map[string]bool {
+	changes := map[string]bool{}
+
+	for key, value := range newClientConfig {
+		if !isClientCapabilityValue(value) {
+			continue
+		}
+		oldValue, ok := oldClientConfig[key]
+		if !ok {
+			oldValue = "false"
+		}
+		if oldValue != value {
+			changes[key] = value == "true"
+		}
+	}
+
+	for key, oldValue := range oldClientConfig {
+		if _, ok := newClientConfig[key]; !ok && oldValue == "true" {
+			changes[key] = false
+		}
+	}
+
+	return changes
+}
+
+func isClientCapabilityValue(value string) bool {
+	return value == "true" || value == "false"
+}
+
+// publishClientCapabilityChanges lets the connected clients know which of their capability
+// flags changed with the config, so that they don't have to wait for a refresh. Every node
+// observes the config change on its own, so the event isn't sent to the rest of the cluster.
+func (s *Server) publishClientCapabilityChanges(oldClientConfig, newClientConfig map[string]string) {
+	if oldClientConfig == nil {
+		return
+	}
+
+	changes := clientCapabilityChanges(oldClientConfig, newClientConfig)
+	if len(changes) == 0 {
+		return
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventConfigCapabilitiesChanged, "", "", "", nil)
+	message.Add("capabilities", changes)
+	s.Go(func() {
+		s.PublishSkipClusterSend(message)
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCapabilityChanges(t *testing.T) {
+	oldClientConfig := map[string]string{
+		"EnableEmailInvitations": "false",
+		"EnableCustomEmoji":      "true",
+		"EnableGuestAccounts":    "true",
+		"EnableSignUpWithEmail":  "true",
+		"SiteName":               "Mattermost",
+	}
+	newClientConfig := map[string]string{
+		"EnableEmailInvitations": "true",
+		"EnableCustomEmoji":      "false",
+		"EnableSignUpWithEmail":  "true",
+		"EnableLinkPreviews":     "true",
+		"EnableSVGs":             "false",
+		"SiteName":               "Chat",
+	}
+
+	assert.Equal(t, map[string]bool{
+		"EnableEmailInvitations": true,
+		"EnableCustomEmoji":      false,
+		"EnableGuestAccounts":    false,
+		"EnableLinkPreviews":     true,
+	}, clientCapabilityChanges(oldClientConfig, newClientConfig))
+
+	assert.Empty(t, clientCapabilityChanges(newClientConfig, newClientConfig))
+}
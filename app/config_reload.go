@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// The config sections whose subsystems can be reloaded. They are named after the fields
+// of model.Config.
+const (
+	ConfigSectionRateLimit  = "RateLimitSettings"
+	ConfigSectionCluster    = "ClusterSettings"
+	ConfigSectionImageProxy = "ImageProxySettings"
+)
+
+// ConfigReloader is implemented by the subsystems that apply changes to their section of
+// the configuration at runtime, without a restart of the server.
+type ConfigReloader interface {
+	// ReloadConfig is called with the old and the new configuration every time the
+	// configuration changes. It should return quickly when its section did not change.
+	ReloadConfig(oldCfg, newCfg *model.Config) error
+}
+
+// ConfigReloaderFunc adapts a function to the ConfigReloader interface.
+type ConfigReloaderFunc func(oldCfg, newCfg *model.Config) error
+
+func (f ConfigReloaderFunc) ReloadConfig(oldCfg, newCfg *model.Config) error {
+	return f(oldCfg, newCfg)
+}
+
+// RegisterConfigReloader makes the subsystem reload its section of the configuration
+// whenever the configuration changes.
+func (s *Server) RegisterConfigReloader(section string, reloader ConfigReloader) {
+	s.configReloadersMut.Lock()
+	defer s.configReloadersMut.Unlock()
+
+	if s.configReloaders == nil {
+		s.configReloaders = map[string]string{}
+	}
+	if listenerID, ok := s.configReloaders[section]; ok {
+		s.RemoveConfigListener(listenerID)
+	}
+
+	s.configReloaders[section] = s.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if err := reloader.ReloadConfig(oldCfg, newCfg); err != nil {
+			mlog.Error("Failed to reload configuration section", mlog.String("section", section), mlog.Err(err))
+		}
+	})
+}
+
+// ReloadableConfigSections returns the config sections that are applied at runtime by the
+// subsystems of this server.
+func (s *Server) ReloadableConfigSections() []string {
+	s.configReloadersMut.RLock()
+	defer s.configReloadersMut.RUnlock()
+
+	sections := make([]string, 0, len(s.configReloaders))
+	for section := range s.configReloaders {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+func (a *App) GetReloadableConfigSections() []string {
+	return a.Srv().ReloadableConfigSections()
+}
+
+// registerClusterConfigReloader reloads the cluster settings when the cluster
+// implementation supports it.
+func (s *Server) registerClusterConfigReloader() {
+	if s.Cluster == nil {
+		return
+	}
+	if reloader, ok := s.Cluster.(ConfigReloader); ok {
+		s.RegisterConfigReloader(ConfigSectionCluster, reloader)
+	}
+}
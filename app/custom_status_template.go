@@ -0,0 +1,186 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// CreateCustomStatusTemplate saves the template. A template overriding another one must belong
+// to a team, override an organization-wide template and be the only one of the team to
+// override it.
+func (a *App) CreateCustomStatusTemplate(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, *model.AppError) {
+	if template.OverrideId != "" && template.TeamId != "" {
+		overridden, appErr := a.GetCustomStatusTemplate(template.OverrideId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if overridden.TeamId != "" {
+			return nil, model.NewAppError("CreateCustomStatusTemplate", "app.custom_status_template.save.override_team.app_error", nil, "override_id="+template.OverrideId, http.StatusBadRequest)
+		}
+
+		teamTemplates, appErr := a.GetCustomStatusTemplatesForTeam(template.TeamId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		for _, teamTemplate := range teamTemplates {
+			if teamTemplate.OverrideId == template.OverrideId {
+				return nil, model.NewAppError("CreateCustomStatusTemplate", "app.custom_status_template.save.override_exists.app_error", nil, "override_id="+template.OverrideId, http.StatusBadRequest)
+			}
+		}
+	}
+
+	template, err := a.Srv().Store.CustomStatusTemplate().Save(template)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateCustomStatusTemplate", "app.custom_status_template.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateCustomStatusTemplate", "app.custom_status_template.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return template, nil
+}
+
+func (a *App) GetCustomStatusTemplate(templateID string) (*model.CustomStatusTemplate, *model.AppError) {
+	template, err := a.Srv().Store.CustomStatusTemplate().Get(templateID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetCustomStatusTemplate", "app.custom_status_template.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetCustomStatusTemplate", "app.custom_status_template.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return template, nil
+}
+
+// GetCustomStatusTemplatesForTeam returns the templates of the team, or the organization-wide
+// ones when teamID is empty.
+func (a *App) GetCustomStatusTemplatesForTeam(teamID string) ([]*model.CustomStatusTemplate, *model.AppError) {
+	templates, err := a.Srv().Store.CustomStatusTemplate().GetForTeam(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetCustomStatusTemplatesForTeam", "app.custom_status_template.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return templates, nil
+}
+
+// GetCustomStatusSuggestions returns the templates suggested to the members of the team: the
+// organization-wide ones, each replaced by the team template overriding it if any, followed by
+// the other templates of the team. Only the organization-wide templates are returned when
+// teamID is empty.
+func (a *App) GetCustomStatusSuggestions(teamID string) ([]*model.CustomStatusTemplate, *model.AppError) {
+	templates, appErr := a.GetCustomStatusTemplatesForTeam("")
+	if appErr != nil {
+		return nil, appErr
+	}
+	if teamID == "" {
+		return templates, nil
+	}
+
+	teamTemplates, appErr := a.GetCustomStatusTemplatesForTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	overrides := make(map[string]*model.CustomStatusTemplate, len(teamTemplates))
+	for _, template := range teamTemplates {
+		if template.OverrideId != "" {
+			overrides[template.OverrideId] = template
+		}
+	}
+
+	suggestions := make([]*model.CustomStatusTemplate, 0, len(templates)+len(teamTemplates))
+	for _, template := range templates {
+		if override, ok := overrides[template.Id]; ok {
+			template = override
+		}
+		suggestions = append(suggestions, template)
+	}
+	for _, template := range teamTemplates {
+		if template.OverrideId == "" {
+			suggestions = append(suggestions, template)
+		}
+	}
+
+	return suggestions, nil
+}
+
+// GetCustomStatusTemplateUsage returns the templates of the organization and of every team, the
+// most used first.
+func (a *App) GetCustomStatusTemplateUsage() ([]*model.CustomStatusTemplate, *model.AppError) {
+	templates, err := a.Srv().Store.CustomStatusTemplate().GetMostUsed()
+	if err != nil {
+		return nil, model.NewAppError("GetCustomStatusTemplateUsage", "app.custom_status_template.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return templates, nil
+}
+
+func (a *App) PatchCustomStatusTemplate(templateID string, patch *model.CustomStatusTemplatePatch) (*model.CustomStatusTemplate, *model.AppError) {
+	template, appErr := a.GetCustomStatusTemplate(templateID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	template.Patch(patch)
+
+	template, err := a.Srv().Store.CustomStatusTemplate().Update(template)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchCustomStatusTemplate", "app.custom_status_template.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("PatchCustomStatusTemplate", "app.custom_status_template.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return template, nil
+}
+
+// DeleteCustomStatusTemplate deletes the template along with the team templates overriding it.
+func (a *App) DeleteCustomStatusTemplate(templateID string) *model.AppError {
+	if err := a.Srv().Store.CustomStatusTemplate().Delete(templateID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteCustomStatusTemplate", "app.custom_status_template.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteCustomStatusTemplate", "app.custom_status_template.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// recordCustomStatusTemplateUse counts a use of the templates suggested to the user that the
+// custom status was set from.
+func (a *App) recordCustomStatusTemplateUse(userID string, cs *model.CustomStatus) {
+	teamIDs, err := a.Srv().Store.Team().GetUserTeamIds(userID, true)
+	if err != nil {
+		mlog.Warn("Failed to get the teams of the user to record the custom status template use", mlog.String("user_id", userID), mlog.Err(err))
+		return
+	}
+
+	if err := a.Srv().Store.CustomStatusTemplate().IncrementUseCount(teamIDs, cs.Emoji, cs.Text, model.GetMillis()); err != nil {
+		mlog.Warn("Failed to record the custom status template use", mlog.String("user_id", userID), mlog.Err(err))
+	}
+}
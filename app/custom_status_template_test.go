@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestCustomStatusSuggestions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	lunch, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{CreatorId: th.SystemAdminUser.Id, Emoji: "sandwich", Text: "Lunch", Duration: "one_hour"})
+	require.Nil(t, appErr)
+	vacation, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{CreatorId: th.SystemAdminUser.Id, Emoji: "palm_tree", Text: "On vacation"})
+	require.Nil(t, appErr)
+
+	override, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, OverrideId: lunch.Id, CreatorId: th.SystemAdminUser.Id, Emoji: "pizza", Text: "Team lunch", Duration: "thirty_minutes"})
+	require.Nil(t, appErr)
+	onCall, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, CreatorId: th.SystemAdminUser.Id, Emoji: "pager", Text: "On call"})
+	require.Nil(t, appErr)
+
+	t.Run("organization-wide", func(t *testing.T) {
+		suggestions, appErr := th.App.GetCustomStatusSuggestions("")
+		require.Nil(t, appErr)
+		require.Len(t, suggestions, 2)
+		assert.Equal(t, lunch.Id, suggestions[0].Id)
+		assert.Equal(t, vacation.Id, suggestions[1].Id)
+	})
+
+	t.Run("team overrides", func(t *testing.T) {
+		suggestions, appErr := th.App.GetCustomStatusSuggestions(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		require.Len(t, suggestions, 3)
+		assert.Equal(t, override.Id, suggestions[0].Id)
+		assert.Equal(t, vacation.Id, suggestions[1].Id)
+		assert.Equal(t, onCall.Id, suggestions[2].Id)
+	})
+
+	t.Run("only one override per team", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, OverrideId: lunch.Id, CreatorId: th.SystemAdminUser.Id, Text: "Lunch again"})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.custom_status_template.save.override_exists.app_error", appErr.Id)
+	})
+
+	t.Run("team templates cannot be overridden", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomStatusTemplate(&model.CustomStatusTemplate{TeamId: th.BasicTeam.Id, OverrideId: onCall.Id, CreatorId: th.SystemAdminUser.Id, Text: "Off call"})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.custom_status_template.save.override_team.app_error", appErr.Id)
+	})
+
+	t.Run("setting a custom status counts the template use", func(t *testing.T) {
+		appErr := th.App.SetCustomStatus(th.BasicUser.Id, &model.CustomStatus{Emoji: "pager", Text: "On call"})
+		require.Nil(t, appErr)
+
+		template, appErr := th.App.GetCustomStatusTemplate(onCall.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, int64(1), template.UseCount)
+		assert.NotZero(t, template.LastUsedAt)
+
+		usage, appErr := th.App.GetCustomStatusTemplateUsage()
+		require.Nil(t, appErr)
+		require.Len(t, usage, 4)
+		assert.Equal(t, onCall.Id, usage[0].Id)
+	})
+
+	t.Run("deleting a template deletes its overrides", func(t *testing.T) {
+		require.Nil(t, th.App.DeleteCustomStatusTemplate(lunch.Id))
+
+		_, appErr := th.App.GetCustomStatusTemplate(override.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.custom_status_template.get.not_found.app_error", appErr.Id)
+	})
+}
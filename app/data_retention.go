@@ -5,8 +5,11 @@ package app
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/filestore"
 )
 
 func (a *App) GetGlobalRetentionPolicy() (*model.GlobalRetentionPolicy, *model.AppError) {
@@ -114,6 +117,95 @@ func (a *App) GetChannelPoliciesForUser(userID string, offset, limit int) (*mode
 	return a.DataRetention().GetChannelPoliciesForUser(userID, offset, limit)
 }
 
+// DataRetentionDryRun counts the posts the data retention job would delete if it ran now,
+// without deleting anything.
+func (a *App) DataRetentionDryRun() (*model.RetentionPolicyDryRun, *model.AppError) {
+	if a.DataRetention() == nil {
+		return nil, newLicenseError("DataRetentionDryRun")
+	}
+
+	globalPolicy, appErr := a.DataRetention().GetGlobalPolicy()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var globalPolicyEndTime int64
+	if globalPolicy.MessageDeletionEnabled {
+		globalPolicyEndTime = globalPolicy.MessageRetentionCutoff
+	}
+
+	dryRun, err := a.Srv().Store.Post().CountForRetentionPolicies(model.GetMillis(), globalPolicyEndTime)
+	if err != nil {
+		return nil, model.NewAppError("DataRetentionDryRun", "app.data_retention.dry_run.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return dryRun, nil
+}
+
+// PreviewRetentionPolicy queues a job that counts the posts and files the policy would
+// delete from each of its channels, without saving the policy or deleting anything.
+func (a *App) PreviewRetentionPolicy(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.Job, *model.AppError) {
+	if a.DataRetention() == nil {
+		return nil, newLicenseError("PreviewRetentionPolicy")
+	}
+
+	if policy.PostDuration == nil || *policy.PostDuration <= 0 {
+		return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.post_duration.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(policy.TeamIDs) == 0 && len(policy.ChannelIDs) == 0 {
+		return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.no_teams_or_channels.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, id := range append(append([]string{}, policy.TeamIDs...), policy.ChannelIDs...) {
+		if !model.IsValidId(id) {
+			return nil, model.NewAppError("PreviewRetentionPolicy", "app.data_retention.preview.invalid_id.app_error", nil, "id="+id, http.StatusBadRequest)
+		}
+	}
+
+	return a.Srv().Jobs.CreateJob(model.JobTypeDataRetentionPreview, map[string]string{
+		"policy_id":     policy.ID,
+		"post_duration": strconv.FormatInt(*policy.PostDuration, 10),
+		"team_ids":      strings.Join(policy.TeamIDs, ","),
+		"channel_ids":   strings.Join(policy.ChannelIDs, ","),
+	})
+}
+
+// GetRetentionPolicyPreviewCounts counts, for each channel the policy applies to, the posts
+// created before endTime and the files attached to them.
+func (a *App) GetRetentionPolicyPreviewCounts(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, *model.AppError) {
+	counts, err := a.Srv().Store.RetentionPolicy().CountForPolicyPreview(policy, endTime)
+	if err != nil {
+		return nil, model.NewAppError("GetRetentionPolicyPreviewCounts", "app.data_retention.preview.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return counts, nil
+}
+
+// GetRetentionPolicyPreviewFile returns the CSV results of a finished retention policy
+// preview job. The caller must close the file.
+func (a *App) GetRetentionPolicyPreviewFile(jobID string) (filestore.ReadCloseSeeker, *model.Job, *model.AppError) {
+	job, appErr := a.GetJob(jobID)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	if job.Type != model.JobTypeDataRetentionPreview {
+		return nil, nil, model.NewAppError("GetRetentionPolicyPreviewFile", "app.data_retention.preview.not_found.app_error", nil, "job_id="+jobID, http.StatusNotFound)
+	}
+
+	if job.Status != model.JobStatusSuccess || job.Data["file_path"] == "" {
+		return nil, nil, model.NewAppError("GetRetentionPolicyPreviewFile", "app.data_retention.preview.not_ready.app_error", nil, "job_id="+jobID, http.StatusBadRequest)
+	}
+
+	file, appErr := a.FileReader(job.Data["file_path"])
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	return file, job, nil
+}
+
 func newLicenseError(methodName string) *model.AppError {
 	return model.NewAppError("App."+methodName, "ent.data_retention.generic.license.error",
 		nil, "", http.StatusNotImplemented)
@@ -0,0 +1,237 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const directChannelRetentionBatchSize = 1000
+
+// getDirectChannelForRetention returns the direct channel a retention applies to, making
+// sure the user is one of its two members.
+func (a *App) getDirectChannelForRetention(userID, channelID string) (*model.Channel, *model.AppError) {
+	channel, appErr := a.GetChannel(channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if channel.Type != model.ChannelTypeDirect {
+		return nil, model.NewAppError("getDirectChannelForRetention", "app.direct_channel_retention.direct_only.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !isDirectChannelMember(channel, userID) {
+		return nil, model.NewAppError("getDirectChannelForRetention", "app.direct_channel_retention.not_member.app_error", nil, "", http.StatusForbidden)
+	}
+
+	return channel, nil
+}
+
+func isDirectChannelMember(channel *model.Channel, userID string) bool {
+	for _, id := range strings.Split(channel.Name, "__") {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *App) GetDirectChannelRetention(userID, channelID string) (*model.DirectChannelRetention, *model.AppError) {
+	if _, appErr := a.getDirectChannelForRetention(userID, channelID); appErr != nil {
+		return nil, appErr
+	}
+
+	retention, err := a.Srv().Store.DirectChannelRetention().Get(channelID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetDirectChannelRetention", "app.direct_channel_retention.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetDirectChannelRetention", "app.direct_channel_retention.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return retention, nil
+}
+
+// ProposeDirectChannelRetention proposes a retention period to the other member of a
+// direct channel. It replaces any existing agreement, which stops being enforced until
+// the new period is accepted. A user's channel with themselves needs no consent.
+func (a *App) ProposeDirectChannelRetention(userID, channelID string, days int64) (*model.DirectChannelRetention, *model.AppError) {
+	channel, appErr := a.getDirectChannelForRetention(userID, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	now := model.GetMillis()
+	retention := &model.DirectChannelRetention{
+		ChannelId:     channel.Id,
+		RetentionDays: days,
+		ProposedBy:    userID,
+		ProposedAt:    now,
+	}
+	if channel.GetOtherUserIdForDM(userID) == "" {
+		retention.AcceptedAt = now
+	}
+
+	return a.saveDirectChannelRetention(retention)
+}
+
+// AcceptDirectChannelRetention gives the consent of the other member to a proposed
+// retention period, from which point the retention job starts enforcing it. The days
+// must match the proposal so that a user can't accept a period they were not shown.
+func (a *App) AcceptDirectChannelRetention(userID, channelID string, days int64) (*model.DirectChannelRetention, *model.AppError) {
+	retention, appErr := a.GetDirectChannelRetention(userID, channelID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if retention.IsActive() {
+		return nil, model.NewAppError("AcceptDirectChannelRetention", "app.direct_channel_retention.already_accepted.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if retention.ProposedBy == userID {
+		return nil, model.NewAppError("AcceptDirectChannelRetention", "app.direct_channel_retention.accept_own.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if retention.RetentionDays != days {
+		return nil, model.NewAppError("AcceptDirectChannelRetention", "app.direct_channel_retention.days_mismatch.app_error", nil, "", http.StatusConflict)
+	}
+
+	retention.AcceptedAt = model.GetMillis()
+
+	return a.saveDirectChannelRetention(retention)
+}
+
+func (a *App) saveDirectChannelRetention(retention *model.DirectChannelRetention) (*model.DirectChannelRetention, *model.AppError) {
+	retention, err := a.Srv().Store.DirectChannelRetention().Save(retention)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("saveDirectChannelRetention", "app.direct_channel_retention.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	retentionJSON, jsonErr := json.Marshal(retention)
+	if jsonErr != nil {
+		return nil, model.NewAppError("saveDirectChannelRetention", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventDirectChannelRetentionUpdated, "", retention.ChannelId, "", nil)
+	message.Add("retention", string(retentionJSON))
+	a.Publish(message)
+
+	return retention, nil
+}
+
+// RemoveDirectChannelRetention withdraws a proposal or the consent to a retention period.
+// Either member may do so at any time.
+func (a *App) RemoveDirectChannelRetention(userID, channelID string) *model.AppError {
+	if _, appErr := a.getDirectChannelForRetention(userID, channelID); appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.DirectChannelRetention().Delete(channelID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RemoveDirectChannelRetention", "app.direct_channel_retention.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("RemoveDirectChannelRetention", "app.direct_channel_retention.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventDirectChannelRetentionRemoved, "", channelID, "", nil)
+	message.Add("removed_by", userID)
+	a.Publish(message)
+
+	return nil
+}
+
+// PurgeDirectChannelRetentions permanently deletes the posts of every direct channel
+// that are older than the retention period both of its members agreed on.
+func (a *App) PurgeDirectChannelRetentions() *model.AppError {
+	limit := int64(*a.Config().DataRetentionSettings.BatchSize)
+
+	afterChannelID := ""
+	for {
+		retentions, err := a.Srv().Store.DirectChannelRetention().GetActive(afterChannelID, directChannelRetentionBatchSize)
+		if err != nil {
+			return model.NewAppError("PurgeDirectChannelRetentions", "app.direct_channel_retention.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if len(retentions) == 0 {
+			return nil
+		}
+
+		for _, retention := range retentions {
+			if appErr := a.purgeDirectChannel(retention, limit); appErr != nil {
+				return appErr
+			}
+		}
+
+		afterChannelID = retentions[len(retentions)-1].ChannelId
+	}
+}
+
+// removePurgedFiles removes the stored files of the purged file infos, keeping the ones other
+// files still reference.
+func (a *App) removePurgedFiles(infos []*model.FileInfo) {
+	for _, info := range infos {
+		// External files are only referenced, and were never stored.
+		if info.IsExternal() {
+			continue
+		}
+		for _, path := range []string{info.Path, info.ThumbnailPath, info.PreviewPath} {
+			if path == "" {
+				continue
+			}
+			if _, appErr := a.removeUnreferencedFile(path); appErr != nil {
+				mlog.Warn("Failed to remove a purged file", mlog.String("file_id", info.Id), mlog.String("path", path), mlog.Err(appErr))
+			}
+		}
+	}
+}
+
+func (a *App) purgeDirectChannel(retention *model.DirectChannelRetention, limit int64) *model.AppError {
+	now := model.GetMillis()
+	before := retention.PurgeBefore(now)
+
+	var total int64
+	for {
+		deleted, infos, err := a.Srv().Store.Post().PermanentDeleteBatchForChannel(retention.ChannelId, before, limit)
+		if err != nil {
+			return model.NewAppError("purgeDirectChannel", "app.direct_channel_retention.purge.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		total += deleted
+		a.removePurgedFiles(infos)
+		if deleted < limit {
+			break
+		}
+	}
+
+	if total > 0 {
+		a.invalidateCacheForChannelPosts(retention.ChannelId)
+
+		message := model.NewWebSocketEvent(model.WebsocketEventDirectChannelPostsPurged, "", retention.ChannelId, "", nil)
+		message.Add("before", before)
+		message.Add("count", total)
+		a.Publish(message)
+	}
+
+	if err := a.Srv().Store.DirectChannelRetention().UpdateLastPurgeAt(retention.ChannelId, now); err != nil {
+		mlog.Warn("Failed to record the purge of a direct channel", mlog.String("channel_id", retention.ChannelId), mlog.Err(err))
+	}
+
+	return nil
+}
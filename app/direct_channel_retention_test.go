@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPurgeDirectChannelRetentions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dm := th.CreateDmChannel(th.BasicUser2)
+
+	savePost := func(t *testing.T, createAt int64) *model.Post {
+		post, err := th.App.Srv().Store.Post().Save(&model.Post{
+			ChannelId: dm.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	old := savePost(t, model.GetMillis()-10*24*60*60*1000)
+	recent := savePost(t, model.GetMillis())
+
+	filePath := "data/" + model.NewId() + "/file.txt"
+	_, appErr := th.App.WriteFile(bytes.NewReader([]byte("file")), filePath)
+	require.Nil(t, appErr)
+	_, err := th.App.Srv().Store.FileInfo().Save(&model.FileInfo{
+		PostId:    old.Id,
+		CreatorId: th.BasicUser.Id,
+		Path:      filePath,
+	})
+	require.NoError(t, err)
+
+	_, appErr = th.App.ProposeDirectChannelRetention(th.BasicUser.Id, dm.Id, 7)
+	require.Nil(t, appErr)
+
+	t.Run("not purged before the other member accepts", func(t *testing.T) {
+		require.Nil(t, th.App.PurgeDirectChannelRetentions())
+
+		_, err := th.App.Srv().Store.Post().GetSingle(old.Id, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("purges the posts older than the retention period", func(t *testing.T) {
+		_, appErr := th.App.AcceptDirectChannelRetention(th.BasicUser2.Id, dm.Id, 7)
+		require.Nil(t, appErr)
+
+		require.Nil(t, th.App.PurgeDirectChannelRetentions())
+
+		_, err := th.App.Srv().Store.Post().GetSingle(old.Id, false)
+		require.Error(t, err)
+		_, err = th.App.Srv().Store.Post().GetSingle(recent.Id, false)
+		require.NoError(t, err)
+
+		exists, appErr := th.App.FileExists(filePath)
+		require.Nil(t, appErr)
+		assert.False(t, exists)
+
+		retention, appErr := th.App.GetDirectChannelRetention(th.BasicUser.Id, dm.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, retention.LastPurgeAt)
+	})
+
+	t.Run("a new proposal needs consent again", func(t *testing.T) {
+		retention, appErr := th.App.ProposeDirectChannelRetention(th.BasicUser2.Id, dm.Id, 1)
+		require.Nil(t, appErr)
+		assert.False(t, retention.IsActive())
+
+		require.Nil(t, th.App.PurgeDirectChannelRetentions())
+
+		_, err := th.App.Srv().Store.Post().GetSingle(recent.Id, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("self direct channel is accepted right away", func(t *testing.T) {
+		self, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.BasicUser.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		retention, appErr := th.App.ProposeDirectChannelRetention(th.BasicUser.Id, self.Id, 7)
+		require.Nil(t, appErr)
+		assert.True(t, retention.IsActive())
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+const channelDigestSnippetLength = 150
+
+// ChannelDigestSummary is the activity of one channel to include in a digest email.
+type ChannelDigestSummary struct {
+	ChannelDisplayName string
+	ChannelURL         string
+	// TeamURL is used to build permalinks to the posts listed in the digest.
+	TeamURL  string
+	Activity *model.ChannelDigestActivity
+	// Usernames maps the authors of the listed posts to their username.
+	Usernames map[string]string
+}
+
+type channelDigestEmailPost struct {
+	Author  string
+	Snippet string
+	Detail  string
+	URL     string
+}
+
+type channelDigestEmailChannel struct {
+	Name     string
+	URL      string
+	Summary  string
+	Threads  []channelDigestEmailPost
+	Mentions []channelDigestEmailPost
+}
+
+func (es *Service) SendChannelDigestEmail(email, locale, siteURL, cadence string, summaries []*ChannelDigestSummary) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("app.channel_digest.email.subject."+cadence, map[string]interface{}{"SiteName": es.config().TeamSettings.SiteName})
+
+	channels := make([]channelDigestEmailChannel, 0, len(summaries))
+	for _, summary := range summaries {
+		channel := channelDigestEmailChannel{
+			Name:    summary.ChannelDisplayName,
+			URL:     summary.ChannelURL,
+			Summary: T("app.channel_digest.email.post_count", summary.Activity.PostCount, map[string]interface{}{"Count": summary.Activity.PostCount}),
+		}
+		for _, post := range summary.Activity.TopThreads {
+			item := newChannelDigestEmailPost(summary, post)
+			item.Detail = T("app.channel_digest.email.reply_count", post.ReplyCount, map[string]interface{}{"Count": post.ReplyCount})
+			channel.Threads = append(channel.Threads, item)
+		}
+		for _, post := range summary.Activity.UnansweredMentions {
+			channel.Mentions = append(channel.Mentions, newChannelDigestEmailPost(summary, post))
+		}
+		channels = append(channels, channel)
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("app.channel_digest.email.title." + cadence)
+	data.Props["Info"] = T("app.channel_digest.email.info")
+	data.Props["TopThreads"] = T("app.channel_digest.email.top_threads")
+	data.Props["UnansweredMentions"] = T("app.channel_digest.email.unanswered_mentions")
+	data.Props["Unsubscribe"] = T("app.channel_digest.email.unsubscribe")
+	data.Props["Channels"] = channels
+
+	body, err := es.templatesContainer.RenderToString("channel_digest_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.SendNotificationMail(email, subject, body)
+}
+
+func newChannelDigestEmailPost(summary *ChannelDigestSummary, post *model.ChannelDigestPost) channelDigestEmailPost {
+	snippet := []rune(post.Message)
+	if len(snippet) > channelDigestSnippetLength {
+		snippet = append(snippet[:channelDigestSnippetLength], '…')
+	}
+
+	return channelDigestEmailPost{
+		Author:  "@" + summary.Usernames[post.UserId],
+		Snippet: string(snippet),
+		URL:     summary.TeamURL + "/pl/" + post.PostId,
+	}
+}
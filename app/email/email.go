@@ -14,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jaytaylor/html2text"
+
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/mail"
@@ -368,6 +370,39 @@ func (es *Service) SendUserAccessTokenAddedEmail(email, locale, siteURL string)
 	return nil
 }
 
+// SendNewDeviceLoginEmail tells the user their account was logged in to from a device they
+// didn't use before.
+func (es *Service) SendNewDeviceLoginEmail(email, locale, siteURL string, device *model.SessionDevice) error {
+	T := i18n.GetUserTranslations(locale)
+
+	location := device.Location
+	if location == "" {
+		location = "-"
+	}
+	params := map[string]interface{}{
+		"SiteName":  es.config().TeamSettings.SiteName,
+		"SiteURL":   siteURL,
+		"Browser":   device.Browser,
+		"Os":        device.Os,
+		"IpAddress": device.IpAddress,
+		"Location":  location,
+		"Time":      model.GetTimeForMillis(device.CreateAt).UTC().Format(time.RFC1123),
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.new_device_login_body.title")
+	data.Props["Info"] = T("api.templates.new_device_login_body.info", params)
+	data.Props["Warning"] = T("api.templates.new_device_login_body.warning")
+
+	body, err := es.templatesContainer.RenderToString("password_change_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.sendMail(email, T("api.templates.new_device_login_subject", params), body)
+}
+
 func (es *Service) SendPasswordResetEmail(email string, token *model.Token, locale, siteURL string) (bool, error) {
 	T := i18n.GetUserTranslations(locale)
 
@@ -428,7 +463,34 @@ func (es *Service) SendMfaChangeEmail(email string, activated bool, locale, site
 	return nil
 }
 
-func (es *Service) SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error {
+// InviteLocales are the locales the invitations sent by SendInviteEmails may be written in. An
+// invitation is written in Locale when set, otherwise in the locale mapped to the domain of the
+// address invited, falling back to SenderLocale and then to the default locale of the server.
+type InviteLocales struct {
+	Locale       string
+	SenderLocale string
+}
+
+// inviteLocale returns the locale to write the invitation sent to the address in, or an empty
+// string for the default locale of the server.
+func (es *Service) inviteLocale(invite string, locales *InviteLocales) string {
+	if locales != nil && locales.Locale != "" {
+		return locales.Locale
+	}
+
+	if locale := es.config().LocalizationSettings.InviteLocaleForEmail(invite); locale != "" {
+		return locale
+	}
+
+	if locales != nil {
+		return locales.SenderLocale
+	}
+	return ""
+}
+
+// SendInviteEmails sends the invitations to join the team, each written in the locale picked for
+// its address from locales, which may be nil.
+func (es *Service) SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, locales *InviteLocales, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error {
 	if es.perHourEmailRateLimiter == nil {
 		return NoRateLimiterError
 	}
@@ -445,19 +507,7 @@ func (es *Service) SendInviteEmails(team *model.Team, senderName string, senderU
 
 	for _, invite := range invites {
 		if invite != "" {
-			subject := i18n.T("api.templates.invite_subject",
-				map[string]interface{}{"SenderName": senderName,
-					"TeamDisplayName": team.DisplayName,
-					"SiteName":        es.config().TeamSettings.SiteName})
-
-			data := es.NewEmailTemplateData("")
-			data.Props["SiteURL"] = siteURL
-			data.Props["SubTitle"] = i18n.T("api.templates.invite_body.subTitle")
-			data.Props["Button"] = i18n.T("api.templates.invite_body.button")
-			data.Props["SenderName"] = senderName
-			data.Props["InviteFooterTitle"] = i18n.T("api.templates.invite_body_footer.title")
-			data.Props["InviteFooterInfo"] = i18n.T("api.templates.invite_body_footer.info")
-			data.Props["InviteFooterLearnMore"] = i18n.T("api.templates.invite_body_footer.learn_more")
+			subject, data := es.newInviteEmailTemplateData(team, senderName, siteURL, es.inviteLocale(invite, locales), reminderData)
 
 			token := model.NewToken(
 				TokenTypeTeamInvitation,
@@ -468,16 +518,10 @@ func (es *Service) SendInviteEmails(team *model.Team, senderName string, senderU
 			tokenProps["email"] = invite
 			tokenProps["display_name"] = team.DisplayName
 			tokenProps["name"] = team.Name
-
-			title := i18n.T("api.templates.invite_body.title", map[string]interface{}{"SenderName": senderName, "TeamDisplayName": team.DisplayName})
 			if reminderData != nil {
-				reminder := i18n.T("api.templates.invite_body.title.reminder")
-				title = fmt.Sprintf("%s: %s", reminder, title)
 				tokenProps["reminder_interval"] = reminderData.Interval
 			}
 
-			data.Props["Title"] = title
-
 			tokenData := model.MapToJSON(tokenProps)
 
 			if err := es.store.Token().Save(token); err != nil {
@@ -502,6 +546,83 @@ func (es *Service) SendInviteEmails(team *model.Team, senderName string, senderU
 	return nil
 }
 
+// newInviteEmailTemplateData returns the subject of the email inviting users to the team and
+// the data to render its body with, short of the signup link. An empty locale stands for the
+// default locale of the server.
+func (es *Service) newInviteEmailTemplateData(team *model.Team, senderName, siteURL, locale string, reminderData *model.TeamInviteReminderData) (string, templates.Data) {
+	T := i18n.T
+	if locale != "" {
+		T = i18n.GetUserTranslations(locale)
+	}
+
+	subject := T("api.templates.invite_subject",
+		map[string]interface{}{"SenderName": senderName,
+			"TeamDisplayName": team.DisplayName,
+			"SiteName":        es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["SubTitle"] = T("api.templates.invite_body.subTitle")
+	data.Props["Button"] = T("api.templates.invite_body.button")
+	data.Props["SenderName"] = senderName
+	data.Props["InviteFooterTitle"] = T("api.templates.invite_body_footer.title")
+	data.Props["InviteFooterInfo"] = T("api.templates.invite_body_footer.info")
+	data.Props["InviteFooterLearnMore"] = T("api.templates.invite_body_footer.learn_more")
+
+	title := T("api.templates.invite_body.title", map[string]interface{}{"SenderName": senderName, "TeamDisplayName": team.DisplayName})
+	if reminderData != nil {
+		reminder := T("api.templates.invite_body.title.reminder")
+		title = fmt.Sprintf("%s: %s", reminder, title)
+	}
+	data.Props["Title"] = title
+
+	return subject, data
+}
+
+// RenderInviteEmailPreview renders the email SendInviteEmails sends to invite users to the team,
+// with a signup link that carries no invitation token.
+func (es *Service) RenderInviteEmailPreview(team *model.Team, senderName, siteURL string) (*model.TeamInviteEmailPreview, error) {
+	subject, data := es.newInviteEmailTemplateData(team, senderName, siteURL, "", nil)
+	data.Props["ButtonURL"] = siteURL + "/signup_user_complete/"
+
+	htmlBody, err := es.templatesContainer.RenderToString("invite_body", data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render the invite email")
+	}
+
+	textBody, err := html2text.FromString(htmlBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to convert the invite email to text")
+	}
+
+	return &model.TeamInviteEmailPreview{
+		Subject:  subject,
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	}, nil
+}
+
+// SendInviteEmailPreview sends a rendered invite email to the given address. It counts against
+// the hourly email rate limit of the sender, like the invitations themselves.
+func (es *Service) SendInviteEmailPreview(preview *model.TeamInviteEmailPreview, senderUserId, to string) error {
+	if es.perHourEmailRateLimiter == nil {
+		return NoRateLimiterError
+	}
+	rateLimited, _, err := es.perHourEmailRateLimiter.RateLimit(senderUserId, 1)
+	if err != nil {
+		return SetupRateLimiterError
+	}
+	if rateLimited {
+		return RateLimitExceededError
+	}
+
+	if err := es.sendMail(to, preview.Subject, preview.HTMLBody); err != nil {
+		return errors.Wrap(SendMailError, err.Error())
+	}
+
+	return nil
+}
+
 func (es *Service) SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool) error {
 	if es.perHourEmailRateLimiter == nil {
 		return NoRateLimiterError
@@ -675,6 +796,13 @@ func (es *Service) sendMailWithCC(to, subject, htmlBody string, ccMail string) e
 	license := es.license()
 	mailConfig := es.mailServiceConfig()
 
+	if pool := es.getSMTPPool(); pool != nil {
+		err := pool.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, ccMail)
+		if !errors.Is(err, mail.ErrSMTPPoolClosed) {
+			return err
+		}
+	}
+
 	return mail.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, ccMail)
 }
 
@@ -682,6 +810,13 @@ func (es *Service) SendMailWithEmbeddedFiles(to, subject, htmlBody string, embed
 	license := es.license()
 	mailConfig := es.mailServiceConfig()
 
+	if pool := es.getSMTPPool(); pool != nil {
+		err := pool.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, embeddedFiles, mailConfig, license != nil && *license.Features.Compliance, "")
+		if !errors.Is(err, mail.ErrSMTPPoolClosed) {
+			return err
+		}
+	}
+
 	return mail.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, embeddedFiles, mailConfig, license != nil && *license.Features.Compliance, "")
 }
 
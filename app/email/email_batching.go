@@ -221,6 +221,22 @@ func truncateUserNames(name string, i int) string {
 	return name
 }
 
+// getBatchedEmailLocale returns the locale of the user or, for users without one, the default
+// locale of the channel of the first notification in the batch.
+func (es *Service) getBatchedEmailLocale(user *model.User, notifications []*batchedNotification) string {
+	if user.Locale != "" || len(notifications) == 0 {
+		return user.Locale
+	}
+
+	channel, err := es.store.Channel().Get(notifications[0].post.ChannelId, true)
+	if err != nil {
+		mlog.Warn("Unable to find channel of post for batched email notification", mlog.String("channel_id", notifications[0].post.ChannelId), mlog.Err(err))
+		return user.Locale
+	}
+
+	return channel.GetNotificationLocale(user.Locale)
+}
+
 func (es *Service) sendBatchedEmailNotification(userID string, notifications []*batchedNotification) {
 	user, err := es.userService.GetUser(userID)
 	if err != nil {
@@ -228,7 +244,8 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 		return
 	}
 
-	translateFunc := i18n.GetUserTranslations(user.Locale)
+	locale := es.getBatchedEmailLocale(user, notifications)
+	translateFunc := i18n.GetUserTranslations(locale)
 	displayNameFormat := *es.config().TeamSettings.TeammateNameDisplay
 	siteURL := *es.config().ServiceSettings.SiteURL
 
@@ -326,7 +343,7 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 		"Day":      tm.Day(),
 	})
 
-	data := es.NewEmailTemplateData(user.Locale)
+	data := es.NewEmailTemplateData(locale)
 	data.Props["SiteURL"] = siteURL
 	data.Props["Title"] = translateFunc("api.email_batching.send_batched_email_notification.title", len(notifications)-1)
 	data.Props["SubTitle"] = translateFunc("api.email_batching.send_batched_email_notification.subTitle")
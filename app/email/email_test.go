@@ -76,7 +76,7 @@ func TestSendInviteEmails(t *testing.T) {
 	t.Run("SendInviteEmails", func(t *testing.T) {
 		mail.DeleteMailBox(emailTo)
 
-		err := th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, false)
+		err := th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, nil, false)
 		require.NoError(t, err)
 
 		verifyMailbox(t)
@@ -93,10 +93,10 @@ func TestSendInviteEmails(t *testing.T) {
 			*cfg.EmailSettings.SMTPPort = originalPort
 		})
 
-		err := th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, true)
+		err := th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, nil, true)
 		require.Error(t, err)
 
-		err = th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, false)
+		err = th.service.SendInviteEmails(th.BasicTeam, "test-user", th.BasicUser.Id, []string{emailTo}, "http://testserver", nil, nil, false)
 		require.NoError(t, err)
 	})
 
@@ -182,6 +182,21 @@ func TestSendInviteEmails(t *testing.T) {
 	})
 }
 
+func TestInviteLocale(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.UpdateConfig(func(cfg *model.Config) {
+		cfg.LocalizationSettings.InviteLocalesByDomain = map[string]string{"example.fr": "fr"}
+	})
+
+	require.Equal(t, "", th.service.inviteLocale("user@example.com", nil))
+	require.Equal(t, "fr", th.service.inviteLocale("user@example.fr", nil))
+	require.Equal(t, "de", th.service.inviteLocale("user@example.com", &InviteLocales{SenderLocale: "de"}))
+	require.Equal(t, "fr", th.service.inviteLocale("user@example.fr", &InviteLocales{SenderLocale: "de"}))
+	require.Equal(t, "es", th.service.inviteLocale("user@example.fr", &InviteLocales{Locale: "es", SenderLocale: "de"}))
+}
+
 func TestSendCloudTrialEndWarningEmail(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
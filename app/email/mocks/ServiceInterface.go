@@ -15,6 +15,8 @@ import (
 
 	templates "github.com/mattermost/mattermost-server/v6/shared/templates"
 
+	email "github.com/mattermost/mattermost-server/v6/app/email"
+
 	throttled "github.com/throttled/throttled"
 )
 
@@ -62,6 +64,29 @@ func (_m *ServiceInterface) CreateVerifyEmailToken(userID string, newEmail strin
 	return r0, r1
 }
 
+// CreateVerifyTeamEmailToken provides a mock function with given fields: teamID, _a1
+func (_m *ServiceInterface) CreateVerifyTeamEmailToken(teamID string, _a1 string) (*model.Token, error) {
+	ret := _m.Called(teamID, _a1)
+
+	var r0 *model.Token
+	if rf, ok := ret.Get(0).(func(string, string) *model.Token); ok {
+		r0 = rf(teamID, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Token)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(teamID, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMessageForNotification provides a mock function with given fields: post, translateFunc
 func (_m *ServiceInterface) GetMessageForNotification(post *model.Post, translateFunc i18n.TranslateFunc) string {
 	ret := _m.Called(post, translateFunc)
@@ -111,6 +136,29 @@ func (_m *ServiceInterface) NewEmailTemplateData(locale string) templates.Data {
 	return r0
 }
 
+// RenderInviteEmailPreview provides a mock function with given fields: team, senderName, siteURL
+func (_m *ServiceInterface) RenderInviteEmailPreview(team *model.Team, senderName string, siteURL string) (*model.TeamInviteEmailPreview, error) {
+	ret := _m.Called(team, senderName, siteURL)
+
+	var r0 *model.TeamInviteEmailPreview
+	if rf, ok := ret.Get(0).(func(*model.Team, string, string) *model.TeamInviteEmailPreview); ok {
+		r0 = rf(team, senderName, siteURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamInviteEmailPreview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.Team, string, string) error); ok {
+		r1 = rf(team, senderName, siteURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendAtUserLimitWarningEmail provides a mock function with given fields: _a0, locale, siteURL
 func (_m *ServiceInterface) SendAtUserLimitWarningEmail(_a0 string, locale string, siteURL string) (bool, error) {
 	ret := _m.Called(_a0, locale, siteURL)
@@ -146,6 +194,20 @@ func (_m *ServiceInterface) SendChangeUsernameEmail(newUsername string, _a1 stri
 	return r0
 }
 
+// SendChannelDigestEmail provides a mock function with given fields: _a0, locale, siteURL, cadence, summaries
+func (_m *ServiceInterface) SendChannelDigestEmail(_a0 string, locale string, siteURL string, cadence string, summaries []*email.ChannelDigestSummary) error {
+	ret := _m.Called(_a0, locale, siteURL, cadence, summaries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []*email.ChannelDigestSummary) error); ok {
+		r0 = rf(_a0, locale, siteURL, cadence, summaries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendCloudTrialEndWarningEmail provides a mock function with given fields: userEmail, name, trialEndDate, locale, siteURL
 func (_m *ServiceInterface) SendCloudTrialEndWarningEmail(userEmail string, name string, trialEndDate string, locale string, siteURL string) error {
 	ret := _m.Called(userEmail, name, trialEndDate, locale, siteURL)
@@ -244,13 +306,27 @@ func (_m *ServiceInterface) SendGuestInviteEmails(team *model.Team, channels []*
 	return r0
 }
 
-// SendInviteEmails provides a mock function with given fields: team, senderName, senderUserId, invites, siteURL, reminderData, errorWhenNotSent
-func (_m *ServiceInterface) SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error {
-	ret := _m.Called(team, senderName, senderUserId, invites, siteURL, reminderData, errorWhenNotSent)
+// SendInviteEmailPreview provides a mock function with given fields: preview, senderUserId, to
+func (_m *ServiceInterface) SendInviteEmailPreview(preview *model.TeamInviteEmailPreview, senderUserId string, to string) error {
+	ret := _m.Called(preview, senderUserId, to)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Team, string, string, []string, string, *model.TeamInviteReminderData, bool) error); ok {
-		r0 = rf(team, senderName, senderUserId, invites, siteURL, reminderData, errorWhenNotSent)
+	if rf, ok := ret.Get(0).(func(*model.TeamInviteEmailPreview, string, string) error); ok {
+		r0 = rf(preview, senderUserId, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendInviteEmails provides a mock function with given fields: team, senderName, senderUserId, invites, siteURL, locales, reminderData, errorWhenNotSent
+func (_m *ServiceInterface) SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, locales *email.InviteLocales, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error {
+	ret := _m.Called(team, senderName, senderUserId, invites, siteURL, locales, reminderData, errorWhenNotSent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Team, string, string, []string, string, *email.InviteLocales, *model.TeamInviteReminderData, bool) error); ok {
+		r0 = rf(team, senderName, senderUserId, invites, siteURL, locales, reminderData, errorWhenNotSent)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -314,6 +390,20 @@ func (_m *ServiceInterface) SendMfaChangeEmail(_a0 string, activated bool, local
 	return r0
 }
 
+// SendNewDeviceLoginEmail provides a mock function with given fields: _a0, locale, siteURL, device
+func (_m *ServiceInterface) SendNewDeviceLoginEmail(_a0 string, locale string, siteURL string, device *model.SessionDevice) error {
+	ret := _m.Called(_a0, locale, siteURL, device)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *model.SessionDevice) error); ok {
+		r0 = rf(_a0, locale, siteURL, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendNoCardPaymentFailedEmail provides a mock function with given fields: _a0, locale, siteURL
 func (_m *ServiceInterface) SendNoCardPaymentFailedEmail(_a0 string, locale string, siteURL string) error {
 	ret := _m.Called(_a0, locale, siteURL)
@@ -573,6 +663,48 @@ func (_m *ServiceInterface) SendSuspensionEmailToSupport(_a0 string, installatio
 	return r0, r1
 }
 
+// SendTeamDeletionScheduledEmail provides a mock function with given fields: _a0, locale, siteURL, team, deleteAt
+func (_m *ServiceInterface) SendTeamDeletionScheduledEmail(_a0 string, locale string, siteURL string, team *model.Team, deleteAt int64) error {
+	ret := _m.Called(_a0, locale, siteURL, team, deleteAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *model.Team, int64) error); ok {
+		r0 = rf(_a0, locale, siteURL, team, deleteAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendTeamInviteBudgetExhaustedEmail provides a mock function with given fields: _a0, locale, siteURL, team, budget
+func (_m *ServiceInterface) SendTeamInviteBudgetExhaustedEmail(_a0 string, locale string, siteURL string, team *model.Team, budget *model.TeamInviteBudget) error {
+	ret := _m.Called(_a0, locale, siteURL, team, budget)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *model.Team, *model.TeamInviteBudget) error); ok {
+		r0 = rf(_a0, locale, siteURL, team, budget)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendTeamRequestReviewedEmail provides a mock function with given fields: _a0, locale, siteURL, teamURL, request
+func (_m *ServiceInterface) SendTeamRequestReviewedEmail(_a0 string, locale string, siteURL string, teamURL string, request *model.TeamRequest) error {
+	ret := _m.Called(_a0, locale, siteURL, teamURL, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, *model.TeamRequest) error); ok {
+		r0 = rf(_a0, locale, siteURL, teamURL, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendUpgradeEmail provides a mock function with given fields: user, _a1, locale, siteURL, action
 func (_m *ServiceInterface) SendUpgradeEmail(user string, _a1 string, locale string, siteURL string, action string) (bool, error) {
 	ret := _m.Called(user, _a1, locale, siteURL, action)
@@ -622,6 +754,20 @@ func (_m *ServiceInterface) SendVerifyEmail(userEmail string, locale string, sit
 	return r0
 }
 
+// SendVerifyTeamEmail provides a mock function with given fields: _a0, locale, siteURL, token, team
+func (_m *ServiceInterface) SendVerifyTeamEmail(_a0 string, locale string, siteURL string, token string, team *model.Team) error {
+	ret := _m.Called(_a0, locale, siteURL, token, team)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, *model.Team) error); ok {
+		r0 = rf(_a0, locale, siteURL, token, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendWelcomeEmail provides a mock function with given fields: userID, _a1, verified, disableWelcomeEmail, locale, siteURL, redirect
 func (_m *ServiceInterface) SendWelcomeEmail(userID string, _a1 string, verified bool, disableWelcomeEmail bool, locale string, siteURL string, redirect string) error {
 	ret := _m.Called(userID, _a1, verified, disableWelcomeEmail, locale, siteURL, redirect)
@@ -635,3 +781,8 @@ func (_m *ServiceInterface) SendWelcomeEmail(userID string, _a1 string, verified
 
 	return r0
 }
+
+// Stop provides a mock function with given fields:
+func (_m *ServiceInterface) Stop() {
+	_m.Called()
+}
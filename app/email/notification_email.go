@@ -14,6 +14,10 @@ import (
 )
 
 func (es *Service) GetMessageForNotification(post *model.Post, translateFunc i18n.TranslateFunc) string {
+	if strings.TrimSpace(post.Message) == "" && len(post.FileIds) == 0 && post.GetProp("from_webhook") == "true" {
+		return getMessageForWebhookNotification(post, translateFunc)
+	}
+
 	if strings.TrimSpace(post.Message) != "" || len(post.FileIds) == 0 {
 		return post.Message
 	}
@@ -44,3 +48,21 @@ func (es *Service) GetMessageForNotification(post *model.Post, translateFunc i18
 	}
 	return translateFunc("api.post.get_message_for_notification.files_sent", len(filenames), props)
 }
+
+// getMessageForWebhookNotification describes, in the language of the notification, the posts
+// of webhooks that carry attachments but no message.
+func getMessageForWebhookNotification(post *model.Post, translateFunc i18n.TranslateFunc) string {
+	attachments := post.Attachments()
+	if len(attachments) == 0 {
+		return post.Message
+	}
+
+	webhookName, _ := post.GetProp("webhook_display_name").(string)
+	if webhookName == "" {
+		webhookName = model.DefaultWebhookUsername
+	}
+
+	return translateFunc("api.post.get_message_for_notification.webhook_attachments_sent", len(attachments), map[string]interface{}{
+		"WebhookName": webhookName,
+	})
+}
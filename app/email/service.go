@@ -7,14 +7,17 @@ import (
 	"io"
 	"net/url"
 	"path"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
 	"github.com/throttled/throttled/store/memstore"
 
 	"github.com/mattermost/mattermost-server/v6/app/users"
+	"github.com/mattermost/mattermost-server/v6/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mail"
 	"github.com/mattermost/mattermost-server/v6/shared/templates"
 	"github.com/mattermost/mattermost-server/v6/store"
 )
@@ -44,10 +47,14 @@ type Service struct {
 	config  func() *model.Config
 	goFn    func(f func())
 	license func() *model.License
+	metrics einterfaces.MetricsInterface
 
 	userService *users.UserService
 	store       store.Store
 
+	smtpPoolMut sync.Mutex
+	smtpPool    *mail.SMTPPool
+
 	templatesContainer      *templates.Container
 	perHourEmailRateLimiter *throttled.GCRARateLimiter
 	perDayEmailRateLimiter  *throttled.GCRARateLimiter
@@ -58,6 +65,7 @@ type ServiceConfig struct {
 	ConfigFn  func() *model.Config
 	LicenseFn func() *model.License
 	GoFn      func(f func())
+	Metrics   einterfaces.MetricsInterface
 
 	TemplatesContainer *templates.Container
 	UserService        *users.UserService
@@ -73,6 +81,7 @@ func NewService(config ServiceConfig) (*Service, error) {
 		templatesContainer: config.TemplatesContainer,
 		license:            config.LicenseFn,
 		goFn:               config.GoFn,
+		metrics:            config.Metrics,
 		store:              config.Store,
 		userService:        config.UserService,
 	}
@@ -134,9 +143,12 @@ type ServiceInterface interface {
 	SendCloudWelcomeEmail(userEmail, locale, teamInviteID, workSpaceName, dns, siteURL string) error
 	SendPasswordChangeEmail(email, method, locale, siteURL string) error
 	SendUserAccessTokenAddedEmail(email, locale, siteURL string) error
+	SendNewDeviceLoginEmail(email, locale, siteURL string, device *model.SessionDevice) error
 	SendPasswordResetEmail(email string, token *model.Token, locale, siteURL string) (bool, error)
 	SendMfaChangeEmail(email string, activated bool, locale, siteURL string) error
-	SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error
+	SendInviteEmails(team *model.Team, senderName string, senderUserId string, invites []string, siteURL string, locales *InviteLocales, reminderData *model.TeamInviteReminderData, errorWhenNotSent bool) error
+	RenderInviteEmailPreview(team *model.Team, senderName, siteURL string) (*model.TeamInviteEmailPreview, error)
+	SendInviteEmailPreview(preview *model.TeamInviteEmailPreview, senderUserId, to string) error
 	SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool) error
 	SendDeactivateAccountEmail(email string, locale, siteURL string) error
 	SendNotificationMail(to, subject, htmlBody string) error
@@ -160,6 +172,13 @@ type ServiceInterface interface {
 	SendChangeUsernameEmail(newUsername, email, locale, siteURL string) error
 	CreateVerifyEmailToken(userID string, newEmail string) (*model.Token, error)
 	SendLicenseInactivityEmail(email, name, locale, siteURL string) error
+	SendChannelDigestEmail(email, locale, siteURL, cadence string, summaries []*ChannelDigestSummary) error
+	SendTeamRequestReviewedEmail(email, locale, siteURL, teamURL string, request *model.TeamRequest) error
+	SendTeamDeletionScheduledEmail(email, locale, siteURL string, team *model.Team, deleteAt int64) error
+	CreateVerifyTeamEmailToken(teamID, email string) (*model.Token, error)
+	SendVerifyTeamEmail(email, locale, siteURL, token string, team *model.Team) error
+	SendTeamInviteBudgetExhaustedEmail(email, locale, siteURL string, team *model.Team, budget *model.TeamInviteBudget) error
+	Stop()
 }
 
 func (es *Service) GetPerDayEmailRateLimiter() *throttled.GCRARateLimiter {
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/shared/mail"
+)
+
+// getSMTPPool returns the pool emails are sent through, or nil when pooling is disabled. The
+// pool is replaced when its settings changed, the previous one being closed in the background
+// once the emails it was given are sent.
+func (es *Service) getSMTPPool() *mail.SMTPPool {
+	settings := es.config().EmailSettings
+	maxConnections := *settings.SMTPPoolMaxConnections
+	ttl := time.Duration(*settings.SMTPPoolConnectionTTLSeconds) * time.Second
+
+	es.smtpPoolMut.Lock()
+	defer es.smtpPoolMut.Unlock()
+
+	if es.smtpPool != nil && es.smtpPool.MaxConnections() == maxConnections && es.smtpPool.TTL() == ttl {
+		return es.smtpPool
+	}
+
+	if old := es.smtpPool; old != nil {
+		es.goFn(old.Close)
+		es.smtpPool = nil
+	}
+
+	if maxConnections <= 0 || ttl <= 0 {
+		return nil
+	}
+
+	var metrics mail.PoolMetrics
+	if es.metrics != nil {
+		metrics = es.metrics
+	}
+	es.smtpPool = mail.NewSMTPPool(maxConnections, ttl, metrics)
+
+	return es.smtpPool
+}
+
+// Stop closes the connections kept open to the SMTP server.
+func (es *Service) Stop() {
+	es.smtpPoolMut.Lock()
+	pool := es.smtpPool
+	es.smtpPool = nil
+	es.smtpPoolMut.Unlock()
+
+	if pool != nil {
+		pool.Close()
+	}
+}
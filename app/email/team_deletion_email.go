@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+// SendTeamDeletionScheduledEmail tells a member of a team the date at which the team is
+// going to be deleted.
+func (es *Service) SendTeamDeletionScheduledEmail(email, locale, siteURL string, team *model.Team, deleteAt int64) error {
+	T := i18n.GetUserTranslations(locale)
+
+	params := map[string]interface{}{
+		"SiteName":        es.config().TeamSettings.SiteName,
+		"TeamDisplayName": team.DisplayName,
+		"DeletionDate":    model.GetTimeForMillis(deleteAt).UTC().Format("2006-01-02"),
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("app.team_deletion.email.title", params)
+	data.Props["Info"] = T("app.team_deletion.email.info", params)
+
+	body, err := es.templatesContainer.RenderToString("team_notification_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.SendNotificationMail(email, T("app.team_deletion.email.subject", params), body)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+// TokenTypeVerifyTeamEmail is the type of the tokens sent to verify the email address of a team.
+const TokenTypeVerifyTeamEmail = "verify_team_email"
+
+// CreateVerifyTeamEmailToken creates a token verifying the given email address for the given
+// team, replacing the ones created for the team before.
+func (es *Service) CreateVerifyTeamEmailToken(teamID, email string) (*model.Token, error) {
+	tokenExtra := struct {
+		TeamId string
+		Email  string
+	}{
+		teamID,
+		email,
+	}
+
+	jsonData, err := json.Marshal(tokenExtra)
+	if err != nil {
+		return nil, errors.Wrap(CreateEmailTokenError, err.Error())
+	}
+
+	tokens, err := es.store.Token().GetAllTokensByType(TokenTypeVerifyTeamEmail)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		var extra struct{ TeamId string }
+		if json.Unmarshal([]byte(token.Extra), &extra) != nil || extra.TeamId != teamID {
+			continue
+		}
+		if err := es.store.Token().Delete(token.Token); err != nil {
+			return nil, err
+		}
+	}
+
+	token := model.NewToken(TokenTypeVerifyTeamEmail, string(jsonData))
+	if err := es.store.Token().Save(token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// SendVerifyTeamEmail sends the link verifying the email address of a team to that address.
+func (es *Service) SendVerifyTeamEmail(email, locale, siteURL, token string, team *model.Team) error {
+	T := i18n.GetUserTranslations(locale)
+
+	link := fmt.Sprintf("%s/do_verify_team_email?token=%s&email=%s", siteURL, token, url.QueryEscape(email))
+
+	params := map[string]interface{}{
+		"SiteName":        es.config().TeamSettings.SiteName,
+		"TeamDisplayName": team.DisplayName,
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.verify_body.title")
+	data.Props["SubTitle1"] = T("app.team.email.verify.subTitle1")
+	data.Props["ServerURL"] = T("app.team.email.verify.team", params)
+	data.Props["SubTitle2"] = T("api.templates.verify_body.subTitle2")
+	data.Props["ButtonURL"] = link
+	data.Props["Button"] = T("api.templates.verify_body.button")
+	data.Props["Info"] = T("app.team.email.verify.info", params)
+	data.Props["Info1"] = T("api.templates.verify_body.info1")
+	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
+	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
+
+	body, err := es.templatesContainer.RenderToString("verify_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.sendMail(email, T("app.team.email.verify.subject", params), body)
+}
+
+// SendTeamInviteBudgetExhaustedEmail tells the address of a team that the team sent all the
+// invitations it could send today.
+func (es *Service) SendTeamInviteBudgetExhaustedEmail(email, locale, siteURL string, team *model.Team, budget *model.TeamInviteBudget) error {
+	T := i18n.GetUserTranslations(locale)
+
+	params := map[string]interface{}{
+		"SiteName":        es.config().TeamSettings.SiteName,
+		"TeamDisplayName": team.DisplayName,
+		"Limit":           budget.DailyLimit + budget.ExtraInvites,
+		"ResetTime":       model.GetTimeForMillis(budget.ResetAt).UTC().Format("2006-01-02 15:04 MST"),
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("app.team.invite_budget.email.title", params)
+	data.Props["Info"] = T("app.team.invite_budget.email.info", params)
+
+	body, err := es.templatesContainer.RenderToString("team_notification_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.SendNotificationMail(email, T("app.team.invite_budget.email.subject", params), body)
+}
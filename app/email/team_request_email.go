@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+// SendTeamRequestReviewedEmail tells the requester of a team whether an admin approved or
+// denied their request. teamURL links to the created team and is only used on approval.
+func (es *Service) SendTeamRequestReviewedEmail(email, locale, siteURL, teamURL string, request *model.TeamRequest) error {
+	T := i18n.GetUserTranslations(locale)
+
+	params := map[string]interface{}{
+		"SiteName":        es.config().TeamSettings.SiteName,
+		"TeamDisplayName": request.DisplayName,
+	}
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("app.team_request.email."+request.Status+".title", params)
+	data.Props["Info"] = T("app.team_request.email."+request.Status+".info", params)
+	data.Props["NoteLabel"] = T("app.team_request.email.note")
+	data.Props["Note"] = request.ReviewNote
+	if request.Status == model.TeamRequestStatusApproved {
+		data.Props["TeamURL"] = teamURL
+		data.Props["Button"] = T("app.team_request.email.button")
+	}
+
+	body, err := es.templatesContainer.RenderToString("team_request_body", data)
+	if err != nil {
+		return err
+	}
+
+	return es.SendNotificationMail(email, T("app.team_request.email."+request.Status+".subject", params), body)
+}
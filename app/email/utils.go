@@ -4,10 +4,31 @@
 package email
 
 import (
+	"errors"
+
 	"github.com/mattermost/mattermost-server/v6/shared/mail"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
 	"github.com/mattermost/mattermost-server/v6/utils"
 )
 
+// SuppressionList is the mail.SuppressionList backed by the email suppressions that the
+// email service providers reported through their webhooks.
+type SuppressionList struct {
+	Store store.Store
+}
+
+func (l *SuppressionList) IsSuppressed(address string) bool {
+	if _, err := l.Store.EmailSuppression().Get(address); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			mlog.Warn("Failed to check whether the email address is suppressed", mlog.Err(err))
+		}
+		return false
+	}
+	return true
+}
+
 func (es *Service) mailServiceConfig() *mail.SMTPConfig {
 	emailSettings := es.config().EmailSettings
 	hostname := utils.GetHostnameFromSiteURL(*es.config().ServiceSettings.SiteURL)
@@ -26,6 +47,12 @@ func (es *Service) mailServiceConfig() *mail.SMTPConfig {
 		FeedbackName:                      *emailSettings.FeedbackName,
 		FeedbackEmail:                     *emailSettings.FeedbackEmail,
 		ReplyToAddress:                    *emailSettings.ReplyToAddress,
+		Provider:                          *emailSettings.EmailProvider,
+		ProviderAPIKey:                    *emailSettings.EmailProviderAPIKey,
+		ProviderAccessKeyId:               *emailSettings.EmailProviderAccessKeyId,
+		ProviderRegion:                    *emailSettings.EmailProviderRegion,
+		ProviderDomain:                    *emailSettings.EmailProviderDomain,
+		Suppressions:                      &SuppressionList{Store: es.store},
 	}
 	return &cfg
 }
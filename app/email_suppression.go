@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mail"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// HandleEmailProviderWebhook adds the addresses that the email service provider reported as
+// bounced or complained about to the suppression list. The webhook must be for the provider
+// emails are currently sent with, and carry the configured webhook token.
+func (a *App) HandleEmailProviderWebhook(provider, token string, body []byte) *model.AppError {
+	emailSettings := a.Config().EmailSettings
+	if provider == model.EmailProviderSMTP || provider != *emailSettings.EmailProvider {
+		return model.NewAppError("HandleEmailProviderWebhook", "app.email_suppression.webhook.provider.app_error", nil, "provider="+provider, http.StatusNotFound)
+	}
+
+	expected := *emailSettings.EmailProviderWebhookToken
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return model.NewAppError("HandleEmailProviderWebhook", "app.email_suppression.webhook.token.app_error", nil, "", http.StatusUnauthorized)
+	}
+
+	feedback, err := mail.ParseFeedbackWebhook(provider, body)
+	if err != nil {
+		return model.NewAppError("HandleEmailProviderWebhook", "app.email_suppression.webhook.parse.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+
+	for _, f := range feedback {
+		reason := model.EmailSuppressionReasonBounce
+		if f.Type == mail.FeedbackComplaint {
+			reason = model.EmailSuppressionReasonComplaint
+		}
+
+		suppression := &model.EmailSuppression{
+			Email:    f.Address,
+			Reason:   reason,
+			Provider: provider,
+			Details:  f.Details,
+		}
+		if _, err := a.Srv().Store.EmailSuppression().Save(suppression); err != nil {
+			var appErr *model.AppError
+			if errors.As(err, &appErr) {
+				mlog.Warn("Ignoring invalid email feedback", mlog.String("provider", provider), mlog.Err(appErr))
+				continue
+			}
+			return model.NewAppError("HandleEmailProviderWebhook", "app.email_suppression.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		mlog.Info("Suppressed email address", mlog.String("provider", provider), mlog.String("reason", reason))
+	}
+
+	return nil
+}
+
+func (a *App) GetEmailSuppression(email string) (*model.EmailSuppression, *model.AppError) {
+	suppression, err := a.Srv().Store.EmailSuppression().Get(email)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetEmailSuppression", "app.email_suppression.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetEmailSuppression", "app.email_suppression.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return suppression, nil
+}
+
+func (a *App) GetEmailSuppressions(page, perPage int) ([]*model.EmailSuppression, *model.AppError) {
+	suppressions, err := a.Srv().Store.EmailSuppression().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetEmailSuppressions", "app.email_suppression.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return suppressions, nil
+}
+
+// DeleteEmailSuppression removes the address from the suppression list so that emails are
+// sent to it again.
+func (a *App) DeleteEmailSuppression(email string) *model.AppError {
+	if err := a.Srv().Store.EmailSuppression().Delete(email); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteEmailSuppression", "app.email_suppression.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteEmailSuppression", "app.email_suppression.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// getEmailSuppressionsByEmail returns the suppressions of the given addresses, keyed by the
+// normalized address.
+func (a *App) getEmailSuppressionsByEmail(emails []string) (map[string]*model.EmailSuppression, *model.AppError) {
+	suppressions, err := a.Srv().Store.EmailSuppression().GetForEmails(emails)
+	if err != nil {
+		return nil, model.NewAppError("getEmailSuppressionsByEmail", "app.email_suppression.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	byEmail := make(map[string]*model.EmailSuppression, len(suppressions))
+	for _, suppression := range suppressions {
+		byEmail[suppression.Email] = suppression
+	}
+
+	return byEmail, nil
+}
+
+// emailSuppressedError returns the error telling that an invite was not sent to an address
+// because it bounced or because its owner complained.
+func emailSuppressedError(where string, suppression *model.EmailSuppression) *model.AppError {
+	id := "api.team.invite_members.email_bounced.app_error"
+	if suppression.Reason == model.EmailSuppressionReasonComplaint {
+		id = "api.team.invite_members.email_complained.app_error"
+	}
+
+	params := map[string]interface{}{"Addresses": suppression.Email, "Reason": suppression.Reason}
+	return model.NewAppError(where, id, params, "", http.StatusBadRequest)
+}
+
+// checkEmailsNotSuppressed returns an error listing the addresses that are suppressed, if any.
+func (a *App) checkEmailsNotSuppressed(where string, emails []string) *model.AppError {
+	suppressions, err := a.getEmailSuppressionsByEmail(emails)
+	if err != nil {
+		return err
+	}
+	if len(suppressions) == 0 {
+		return nil
+	}
+
+	suppressed := make([]string, 0, len(suppressions))
+	for email := range suppressions {
+		suppressed = append(suppressed, email)
+	}
+	sort.Strings(suppressed)
+
+	return model.NewAppError(where, "api.team.invite_members.email_suppressed.app_error", map[string]interface{}{"Addresses": strings.Join(suppressed, ", ")}, "", http.StatusBadRequest)
+}
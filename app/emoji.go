@@ -85,6 +85,7 @@ func (a *App) CreateEmoji(sessionUserId string, emoji *model.Emoji, multiPartIma
 	}
 	message.Add("emoji", string(emojiJSON))
 	a.Publish(message)
+	a.purgePostMetadataCache()
 	return emoji, nil
 }
 
@@ -179,6 +180,7 @@ func (a *App) DeleteEmoji(emoji *model.Emoji) *model.AppError {
 
 	a.deleteEmojiImage(emoji.Id)
 	a.deleteReactionsForEmoji(emoji.Name)
+	a.purgePostMetadataCache()
 	return nil
 }
 
@@ -0,0 +1,311 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	eventWebhookDeliveryBatchSize = 100
+	eventWebhookDeleteBatchSize   = 1000
+
+	// eventWebhookDeliveryLease is how long an attempt holds its delivery before another one can
+	// claim it, should the server go down in the middle of the attempt.
+	eventWebhookDeliveryLease = 5 * time.Minute
+)
+
+func (a *App) CreateEventWebhook(webhook *model.EventWebhook) (*model.EventWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableEventWebhooks {
+		return nil, model.NewAppError("CreateEventWebhook", "app.event_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	saved, err := a.Srv().Store.EventWebhook().Save(webhook)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateEventWebhook", "app.event_webhook.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateEventWebhook", "app.event_webhook.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetEventWebhook(id string) (*model.EventWebhook, *model.AppError) {
+	webhook, err := a.Srv().Store.EventWebhook().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetEventWebhook", "app.event_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetEventWebhook", "app.event_webhook.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return webhook, nil
+}
+
+func (a *App) GetEventWebhooks(page, perPage int) ([]*model.EventWebhook, *model.AppError) {
+	webhooks, err := a.Srv().Store.EventWebhook().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetEventWebhooks", "app.event_webhook.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return webhooks, nil
+}
+
+func (a *App) UpdateEventWebhook(webhook *model.EventWebhook) (*model.EventWebhook, *model.AppError) {
+	updated, err := a.Srv().Store.EventWebhook().Update(webhook)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateEventWebhook", "app.event_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("UpdateEventWebhook", "app.event_webhook.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return updated, nil
+}
+
+// RegenerateEventWebhookSecret replaces the secret the deliveries of the event webhook are
+// signed with. The pending deliveries are signed with the new one when retried.
+func (a *App) RegenerateEventWebhookSecret(id string) (*model.EventWebhook, *model.AppError) {
+	webhook, appErr := a.GetEventWebhook(id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	webhook.Secret = model.NewRandomString(model.EventWebhookSecretLength)
+	return a.UpdateEventWebhook(webhook)
+}
+
+func (a *App) DeleteEventWebhook(id string) *model.AppError {
+	if err := a.Srv().Store.EventWebhook().Delete(id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteEventWebhook", "app.event_webhook.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteEventWebhook", "app.event_webhook.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) GetEventWebhookDeliveries(webhookID string, page, perPage int) ([]*model.EventWebhookDelivery, *model.AppError) {
+	deliveries, err := a.Srv().Store.EventWebhook().GetDeliveries(webhookID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetEventWebhookDeliveries", "app.event_webhook.get_deliveries.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return deliveries, nil
+}
+
+// PublishEventWebhookEvent sends the event to the event webhooks subscribed to it, in the
+// background. The failed deliveries are retried by the event webhooks job.
+func (a *App) PublishEventWebhookEvent(event string, data interface{}) {
+	if !*a.Config().ServiceSettings.EnableEventWebhooks {
+		return
+	}
+
+	a.Srv().Go(func() {
+		a.publishEventWebhookEvent(event, data)
+	})
+}
+
+func (a *App) publishEventWebhookEvent(event string, data interface{}) {
+	webhooks, err := a.Srv().Store.EventWebhook().GetForEvent(event)
+	if err != nil {
+		mlog.Warn("Failed to get the event webhooks of an event", mlog.String("event", event), mlog.Err(err))
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(&model.EventWebhookPayload{
+		Event:    event,
+		CreateAt: model.GetMillis(),
+		Data:     data,
+	})
+	if err != nil {
+		mlog.Warn("Failed to encode an event webhook payload", mlog.String("event", event), mlog.Err(err))
+		return
+	}
+
+	for _, webhook := range webhooks {
+		// The delivery is saved already claimed by this first attempt.
+		delivery, err := a.Srv().Store.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{
+			WebhookId:     webhook.Id,
+			Event:         event,
+			Payload:       string(payload),
+			NextAttemptAt: model.GetMillis() + eventWebhookDeliveryLease.Milliseconds(),
+		})
+		if err != nil {
+			mlog.Warn("Failed to save an event webhook delivery", mlog.String("event_webhook_id", webhook.Id), mlog.Err(err))
+			continue
+		}
+
+		a.attemptEventWebhookDelivery(webhook, delivery)
+	}
+}
+
+// publishUserCreatedEvent sends the created user to the event webhooks, stripped of its
+// private fields.
+func (a *App) publishUserCreatedEvent(user *model.User) {
+	sanitized := user.DeepCopy()
+	sanitized.Sanitize(map[string]bool{})
+
+	a.PublishEventWebhookEvent(model.EventWebhookEventUserCreated, map[string]interface{}{
+		"user": sanitized,
+	})
+}
+
+// publishTeamDeletedEvent sends the deleted team to the event webhooks, along with whether it
+// was permanently deleted or can still be restored.
+func (a *App) publishTeamDeletedEvent(team *model.Team, permanent bool) {
+	sanitized := *team
+	sanitized.Sanitize()
+
+	a.PublishEventWebhookEvent(model.EventWebhookEventTeamDeleted, map[string]interface{}{
+		"team":      &sanitized,
+		"permanent": permanent,
+	})
+}
+
+// publishPostFlaggedEvent sends the flagging of the post by the user to the event webhooks.
+func (a *App) publishPostFlaggedEvent(userID, postID string) {
+	if !*a.Config().ServiceSettings.EnableEventWebhooks {
+		return
+	}
+
+	a.Srv().Go(func() {
+		post, err := a.Srv().Store.Post().GetSingle(postID, false)
+		if err != nil {
+			mlog.Warn("Failed to get a flagged post", mlog.String("post_id", postID), mlog.Err(err))
+			return
+		}
+		channel, appErr := a.GetChannel(post.ChannelId)
+		if appErr != nil {
+			mlog.Warn("Failed to get the channel of a flagged post", mlog.String("post_id", postID), mlog.Err(appErr))
+			return
+		}
+
+		a.publishEventWebhookEvent(model.EventWebhookEventPostFlagged, map[string]string{
+			"post_id":    post.Id,
+			"channel_id": channel.Id,
+			"team_id":    channel.TeamId,
+			"user_id":    post.UserId,
+			"flagged_by": userID,
+		})
+	})
+}
+
+// RetryEventWebhookDeliveries attempts again the deliveries whose retry is due, then deletes
+// the deliveries older than their retention.
+func (a *App) RetryEventWebhookDeliveries() *model.AppError {
+	for {
+		now := model.GetMillis()
+		due, err := a.Srv().Store.EventWebhook().GetDueDeliveries(now, eventWebhookDeliveryBatchSize)
+		if err != nil {
+			return model.NewAppError("RetryEventWebhookDeliveries", "app.event_webhook.get_deliveries.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, delivery := range due {
+			claimed, err := a.Srv().Store.EventWebhook().ClaimDelivery(delivery.Id, delivery.NextAttemptAt, now+eventWebhookDeliveryLease.Milliseconds())
+			if err != nil {
+				return model.NewAppError("RetryEventWebhookDeliveries", "app.event_webhook.update_delivery.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+			if !claimed {
+				continue
+			}
+
+			webhook, appErr := a.GetEventWebhook(delivery.WebhookId)
+			if appErr != nil {
+				// The webhook was deleted along with its deliveries in the meantime.
+				mlog.Debug("Failed to get the event webhook of a delivery", mlog.String("event_webhook_delivery_id", delivery.Id), mlog.Err(appErr))
+				continue
+			}
+
+			a.attemptEventWebhookDelivery(webhook, delivery)
+		}
+
+		if len(due) < eventWebhookDeliveryBatchSize {
+			break
+		}
+	}
+
+	endTime := model.GetMillis() - int64(*a.Config().ServiceSettings.EventWebhookDeliveryRetentionDays)*24*time.Hour.Milliseconds()
+	for {
+		deleted, err := a.Srv().Store.EventWebhook().PermanentDeleteDeliveriesBatch(endTime, eventWebhookDeleteBatchSize)
+		if err != nil {
+			return model.NewAppError("RetryEventWebhookDeliveries", "app.event_webhook.delete_deliveries.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if deleted < eventWebhookDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+// attemptEventWebhookDelivery sends the delivery to the event webhook, signed with its secret,
+// and records the outcome.
+func (a *App) attemptEventWebhookDelivery(webhook *model.EventWebhook, delivery *model.EventWebhookDelivery) {
+	responseCode, attemptErr := a.doEventWebhookRequest(webhook, delivery)
+	if attemptErr != nil {
+		delivery.RecordAttempt(responseCode, attemptErr.Error(), model.GetMillis())
+	} else {
+		delivery.RecordAttempt(responseCode, "", model.GetMillis())
+	}
+
+	if _, err := a.Srv().Store.EventWebhook().UpdateDelivery(delivery); err != nil {
+		mlog.Warn("Failed to record an event webhook delivery attempt", mlog.String("event_webhook_delivery_id", delivery.Id), mlog.Err(err))
+	}
+}
+
+func (a *App) doEventWebhookRequest(webhook *model.EventWebhook, delivery *model.EventWebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest("POST", webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(model.EventWebhookHeaderEvent, delivery.Event)
+	req.Header.Set(model.EventWebhookHeaderDelivery, delivery.Id)
+	req.Header.Set(model.EventWebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(model.EventWebhookHeaderSignature, model.SignEventWebhookPayload(webhook.Secret, timestamp, body))
+
+	resp, err := a.HTTPService().MakeClient(false).Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	return resp.StatusCode, nil
+}
@@ -90,7 +90,7 @@ func (a *App) BulkExport(writer io.Writer, outPath string, opts model.BulkExport
 	}
 
 	mlog.Info("Bulk export: exporting posts")
-	attachments, err := a.exportAllPosts(writer, opts.IncludeAttachments)
+	attachments, err := a.exportAllPosts(writer, teamNames, opts.IncludeAttachments)
 	if err != nil {
 		return err
 	}
@@ -134,6 +134,39 @@ func (a *App) BulkExport(writer io.Writer, outPath string, opts model.BulkExport
 	return nil
 }
 
+// ExportTeam writes the team, its channels and their posts in the bulk import format. The
+// users are left out, as their accounts don't belong to the team.
+func (a *App) ExportTeam(writer io.Writer, teamID string) *model.AppError {
+	team, err := a.GetTeam(teamID)
+	if err != nil {
+		return err
+	}
+
+	if err = a.exportVersion(writer); err != nil {
+		return err
+	}
+
+	teamForExport := &model.TeamForExport{Team: *team}
+	if team.SchemeId != nil && *team.SchemeId != "" {
+		scheme, appErr := a.GetScheme(*team.SchemeId)
+		if appErr != nil {
+			return appErr
+		}
+		teamForExport.SchemeName = &scheme.Name
+	}
+	if err = a.exportWriteLine(writer, ImportLineFromTeam(teamForExport)); err != nil {
+		return err
+	}
+
+	teamNames := map[string]bool{team.Name: true}
+	if err = a.exportAllChannels(writer, teamNames); err != nil {
+		return err
+	}
+
+	_, err = a.exportAllPosts(writer, teamNames, false)
+	return err
+}
+
 func (a *App) exportWriteLine(writer io.Writer, line *LineImportData) *model.AppError {
 	b, err := json.Marshal(line)
 	if err != nil {
@@ -377,7 +410,7 @@ func (a *App) buildUserNotifyProps(notifyProps model.StringMap) *UserNotifyProps
 	}
 }
 
-func (a *App) exportAllPosts(writer io.Writer, withAttachments bool) ([]AttachmentImportData, *model.AppError) {
+func (a *App) exportAllPosts(writer io.Writer, teamNames map[string]bool, withAttachments bool) ([]AttachmentImportData, *model.AppError) {
 	var attachments []AttachmentImportData
 	afterId := strings.Repeat("0", 26)
 
@@ -398,6 +431,10 @@ func (a *App) exportAllPosts(writer io.Writer, withAttachments bool) ([]Attachme
 			if post.DeleteAt != 0 {
 				continue
 			}
+			// Skip posts of teams not exported.
+			if ok := teamNames[post.TeamName]; !ok {
+				continue
+			}
 
 			postLine := ImportLineForPost(post)
 
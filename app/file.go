@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"image"
 	"io"
@@ -51,6 +52,36 @@ func (a *App) FileBackend() filestore.FileBackend {
 	return a.ch.filestore
 }
 
+// GetSignedFileURL returns a time limited URL from which clients can download the file at the
+// given path directly, or an empty string if signed URLs are disabled or unsupported by the
+// configured file backend. CloudFront settings are read on every call so that rotated keys
+// take effect without a restart.
+func (a *App) GetSignedFileURL(path string) (string, *model.AppError) {
+	settings := a.Config().FileSettings
+	if !*settings.EnableSignedURLs {
+		return "", nil
+	}
+
+	var signer filestore.URLSigner
+	if *settings.AmazonCloudFrontDomain != "" {
+		cfSigner, err := filestore.NewCloudFrontSigner(*settings.AmazonCloudFrontDomain, *settings.AmazonS3PathPrefix, *settings.AmazonCloudFrontKeyPairId, *settings.AmazonCloudFrontPrivateKey)
+		if err != nil {
+			return "", model.NewAppError("GetSignedFileURL", "api.file.signed_url.cloudfront_key.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		signer = cfSigner
+	} else if backendSigner, ok := a.FileBackend().(filestore.URLSigner); ok {
+		signer = backendSigner
+	} else {
+		return "", nil
+	}
+
+	signedURL, err := signer.SignedURL(path, time.Duration(*settings.SignedURLExpirySeconds)*time.Second)
+	if err != nil {
+		return "", model.NewAppError("GetSignedFileURL", "api.file.signed_url.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return signedURL, nil
+}
+
 func (a *App) CheckMandatoryS3Fields(settings *model.FileSettings) *model.AppError {
 	fileBackendSettings := settings.ToFileBackendSettings(false)
 	err := fileBackendSettings.CheckMandatoryS3Fields()
@@ -669,6 +700,11 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 	if t.ContentLength > t.maxFileSize {
 		return nil, t.newAppError("api.file.upload_file.too_large_detailed.app_error", http.StatusRequestEntityTooLarge, "Length", t.ContentLength, "Limit", t.maxFileSize)
 	}
+	if t.UserId != "" && t.ContentLength > 0 {
+		if aerr := a.checkUploadQuota(t.UserId, t.ContentLength); aerr != nil {
+			return nil, aerr
+		}
+	}
 
 	t.init(a)
 
@@ -680,7 +716,8 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 		}
 	}
 
-	written, aerr := t.writeFile(io.MultiReader(t.buf, t.limitedInput), t.fileinfo.Path)
+	hasher := sha256.New()
+	written, aerr := t.writeFile(io.TeeReader(io.MultiReader(t.buf, t.limitedInput), hasher), t.fileinfo.Path)
 	if aerr != nil {
 		return nil, aerr
 	}
@@ -692,6 +729,16 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 		return nil, t.newAppError("api.file.upload_file.too_large_detailed.app_error", http.StatusRequestEntityTooLarge, "Length", t.ContentLength, "Limit", t.maxFileSize)
 	}
 
+	// Without a content length, the quota can only be checked once the file is written.
+	if t.UserId != "" && t.ContentLength <= 0 {
+		if aerr := a.checkUploadQuota(t.UserId, written); aerr != nil {
+			if fileErr := a.RemoveFile(t.fileinfo.Path); fileErr != nil {
+				mlog.Error("Failed to remove file", mlog.Err(fileErr))
+			}
+			return nil, aerr
+		}
+	}
+
 	t.fileinfo.Size = written
 
 	file, aerr := a.FileReader(t.fileinfo.Path)
@@ -700,7 +747,7 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 	}
 	defer file.Close()
 
-	aerr = a.runPluginsHook(c, t.fileinfo, file)
+	replaced, aerr := a.runPluginsHook(c, t.fileinfo, file)
 	if aerr != nil {
 		return nil, aerr
 	}
@@ -714,6 +761,13 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 		t.postprocessImage(file)
 	}
 
+	// Plugins may have replaced the content of the file, in which case it's hashed again.
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	if replaced {
+		hash = ""
+	}
+	a.hashUploadedFile(t.fileinfo, hash)
+
 	if _, err := t.saveToDatabase(t.fileinfo); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -724,16 +778,14 @@ func (a *App) UploadFileX(c *request.Context, channelID, name string, input io.R
 		}
 	}
 
-	if *a.Config().FileSettings.ExtractContent {
-		infoCopy := *t.fileinfo
-		a.Srv().Go(func() {
-			err := a.ExtractContentFromFileInfo(&infoCopy)
-			if err != nil {
-				mlog.Error("Failed to extract file content", mlog.Err(err), mlog.String("fileInfoId", infoCopy.Id))
-			}
-		})
+	a.deduplicateUploadedFile(t.fileinfo)
+
+	if t.UserId != "" {
+		a.recordUpload(t.UserId, t.fileinfo.Size)
 	}
 
+	a.extractContentInBackground(t.fileinfo)
+
 	return t.fileinfo, nil
 }
 
@@ -964,6 +1016,9 @@ func (a *App) DoUploadFileExpectModification(c *request.Context, now time.Time,
 		return nil, data, err
 	}
 
+	hash := sha256.Sum256(data)
+	a.hashUploadedFile(info, hex.EncodeToString(hash[:]))
+
 	if _, err := a.Srv().Store.FileInfo().Save(info); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -974,15 +1029,8 @@ func (a *App) DoUploadFileExpectModification(c *request.Context, now time.Time,
 		}
 	}
 
-	if *a.Config().FileSettings.ExtractContent {
-		infoCopy := *info
-		a.Srv().Go(func() {
-			err := a.ExtractContentFromFileInfo(&infoCopy)
-			if err != nil {
-				mlog.Error("Failed to extract file content", mlog.Err(err), mlog.String("fileInfoId", infoCopy.Id))
-			}
-		})
-	}
+	a.deduplicateUploadedFile(info)
+	a.extractContentInBackground(info)
 
 	return info, data, nil
 }
@@ -1103,6 +1151,9 @@ func (a *App) generateMiniPreview(fi *model.FileInfo) {
 			mlog.Debug("creating mini preview failed", mlog.Err(err))
 		} else {
 			a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(fi.PostId, false)
+			if fi.PostId != "" {
+				a.invalidatePostMetadataCache(fi.PostId)
+			}
 		}
 	}
 }
@@ -1162,6 +1213,20 @@ func (a *App) GetFile(fileID string) ([]byte, *model.AppError) {
 		return nil, err
 	}
 
+	if info.IsExternal() {
+		file, appErr := a.ExternalFileReader(info)
+		if appErr != nil {
+			return nil, appErr
+		}
+		defer file.Close()
+
+		data, readErr := io.ReadAll(file)
+		if readErr != nil {
+			return nil, model.NewAppError("GetFile", "app.file.external.fetch.app_error", nil, readErr.Error(), http.StatusBadGateway)
+		}
+		return data, nil
+	}
+
 	data, err := a.ReadFile(info.Path)
 	if err != nil {
 		return nil, err
@@ -1271,12 +1336,12 @@ func (a *App) SearchFilesInTeamForUser(c *request.Context, terms string, userId
 		// Don't allow users to search for "*"
 		if params.Terms != "*" {
 			// Convert channel names to channel IDs
-			params.InChannels = a.convertChannelNamesToChannelIds(c, params.InChannels, userId, teamId, includeDeletedChannels)
-			params.ExcludedChannels = a.convertChannelNamesToChannelIds(c, params.ExcludedChannels, userId, teamId, includeDeletedChannels)
+			params.InChannels = a.convertChannelNamesToChannelIds(c, params.InChannels, userId, teamId, includeDeleted)
+			params.ExcludedChannels = a.convertChannelNamesToChannelIds(c, params.ExcludedChannels, userId, teamId, includeDeleted)
 
 			// Convert usernames to user IDs
-			params.FromUsers = a.convertUserNameToUserIds(params.FromUsers)
-			params.ExcludedUsers = a.convertUserNameToUserIds(params.ExcludedUsers)
+			params.FromUsers = a.convertUserNameToUserIds(userId, params.FromUsers)
+			params.ExcludedUsers = a.convertUserNameToUserIds(userId, params.ExcludedUsers)
 
 			finalParamsList = append(finalParamsList, params)
 		}
@@ -1298,10 +1363,53 @@ func (a *App) SearchFilesInTeamForUser(c *request.Context, terms string, userId
 		}
 	}
 
+	a.addFileContentMatches(fileInfoSearchResults, finalParamsList)
+
+	if includeDeleted {
+		channelIDs := make([]string, 0, len(fileInfoSearchResults.FileInfos))
+		for _, info := range fileInfoSearchResults.FileInfos {
+			channelIDs = append(channelIDs, info.ChannelId)
+		}
+		archivedChannelIDs, appErr := a.archivedChannelIds(channelIDs)
+		if appErr != nil {
+			return nil, appErr
+		}
+		fileInfoSearchResults.ArchivedChannelIds = archivedChannelIDs
+	}
+
 	return fileInfoSearchResults, nil
 }
 
+// addFileContentMatches adds to the search results the snippets of the extracted content of
+// the files that matched the search terms.
+func (a *App) addFileContentMatches(results *model.FileInfoList, paramsList []*model.SearchParams) {
+	terms := []string{}
+	for _, params := range paramsList {
+		terms = append(terms, model.FileSearchTerms(params.Terms)...)
+	}
+
+	for id, info := range results.FileInfos {
+		if match := model.NewFileContentMatch(info.Content, terms); match != nil {
+			if results.ContentMatches == nil {
+				results.ContentMatches = map[string]*model.FileContentMatch{}
+			}
+			results.ContentMatches[id] = match
+		}
+	}
+}
+
 func (a *App) ExtractContentFromFileInfo(fileInfo *model.FileInfo) error {
+	// The content of external files isn't fetched from their hosts.
+	if fileInfo.IsExternal() {
+		return nil
+	}
+
+	// Larger files take too long to extract, and would only have their content truncated.
+	if fileInfo.Size > *a.Config().FileSettings.ExtractContentMaxFileSize {
+		mlog.Debug("Skipping the content extraction of a file over the size limit", mlog.String("file_info_id", fileInfo.Id), mlog.Int64("size", fileInfo.Size))
+		return nil
+	}
+
 	file, aerr := a.FileReader(fileInfo.Path)
 	if aerr != nil {
 		return errors.Wrap(aerr, "failed to open file for extract file content")
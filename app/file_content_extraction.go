@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// contentExtractionQueueSize is the number of uploaded files that can wait for their content
+// to be extracted. Files uploaded while the queue is full are left for the extract content job.
+const contentExtractionQueueSize = 1000
+
+// ContentExtractionPool extracts the text of uploaded files with a fixed number of workers, so
+// that a burst of uploads doesn't start as many extractions at once.
+type ContentExtractionPool struct {
+	queue    chan *model.FileInfo
+	app      *App
+	stopChan chan struct{}
+	wg       *sync.WaitGroup
+}
+
+func (s *Server) createContentExtractionPool() {
+	pool := &ContentExtractionPool{
+		queue:    make(chan *model.FileInfo, contentExtractionQueueSize),
+		app:      New(ServerConnector(s.Channels())),
+		stopChan: make(chan struct{}),
+		wg:       new(sync.WaitGroup),
+	}
+	for i := 0; i < *s.Config().FileSettings.ExtractContentWorkers; i++ {
+		pool.wg.Add(1)
+		go pool.worker()
+	}
+	s.contentExtractionPool = pool
+}
+
+func (pool *ContentExtractionPool) worker() {
+	defer pool.wg.Done()
+	for {
+		select {
+		case info := <-pool.queue:
+			if err := pool.app.ExtractContentFromFileInfo(info); err != nil {
+				mlog.Error("Failed to extract file content", mlog.Err(err), mlog.String("fileInfoId", info.Id))
+			}
+		case <-pool.stopChan:
+			return
+		}
+	}
+}
+
+func (pool *ContentExtractionPool) stop() {
+	close(pool.stopChan)
+	pool.wg.Wait()
+}
+
+func (s *Server) stopContentExtractionPool() {
+	if s.contentExtractionPool != nil {
+		s.contentExtractionPool.stop()
+	}
+}
+
+// extractContentInBackground queues the file for its content to be extracted and indexed.
+func (a *App) extractContentInBackground(info *model.FileInfo) {
+	if !*a.Config().FileSettings.ExtractContent {
+		return
+	}
+
+	pool := a.Srv().contentExtractionPool
+	if pool == nil {
+		return
+	}
+
+	infoCopy := *info
+	select {
+	case pool.queue <- &infoCopy:
+	case <-pool.stopChan:
+	default:
+		mlog.Warn("The file content extraction queue is full, skipping the file", mlog.String("fileInfoId", info.Id))
+	}
+}
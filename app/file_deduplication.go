@@ -0,0 +1,283 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	// fileDeduplicationBatchSize is how many files the deduplication job looks at once.
+	fileDeduplicationBatchSize = 200
+
+	// fileDeduplicationMaxCandidates is how many files with the same content are looked at to
+	// find one of the team of a file.
+	fileDeduplicationMaxCandidates = 100
+)
+
+// hashFile returns the content hash of the stored file.
+func (a *App) hashFile(path string) (string, *model.AppError) {
+	file, appErr := a.FileReader(path)
+	if appErr != nil {
+		return "", appErr
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", model.NewAppError("hashFile", "api.file.read_file.reading_local.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// findDuplicateFile returns the oldest file of the team of the file with the same content, stored
+// at another path, if any. Files are only deduplicated within their team so that their content is
+// stored where their team's is, as teams may have a data residency. Only files created before the
+// file are considered, so that duplicates always end up sharing the content of the oldest one.
+func (a *App) findDuplicateFile(info *model.FileInfo) (*model.FileInfo, *model.AppError) {
+	teamID, err := a.Srv().fileTeamID(info.Path)
+	if err != nil {
+		return nil, model.NewAppError("findDuplicateFile", "app.channel.get.find.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if teamID == "" || info.ContentHash == "" {
+		return nil, nil
+	}
+
+	candidates, err := a.Srv().Store.FileInfo().GetByContentHash(info.ContentHash, info.Size, fileDeduplicationMaxCandidates)
+	if err != nil {
+		return nil, model.NewAppError("findDuplicateFile", "app.file_info.get_with_options.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, candidate := range candidates {
+		// Files not saved yet come after all the others.
+		if info.CreateAt != 0 && (candidate.CreateAt > info.CreateAt || (candidate.CreateAt == info.CreateAt && candidate.Id >= info.Id)) {
+			break
+		}
+		if candidate.Path == info.Path || candidate.IsExternal() {
+			continue
+		}
+		if candidateTeamID, err := a.Srv().fileTeamID(candidate.Path); err != nil || candidateTeamID != teamID {
+			continue
+		}
+
+		exists, appErr := a.FileExists(candidate.Path)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if exists {
+			return candidate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// hashUploadedFile records the content hash of a file just uploaded, hashing the stored file when
+// the hash isn't given. Failing to do so only leaves the file without a hash.
+func (a *App) hashUploadedFile(info *model.FileInfo, hash string) {
+	if info.IsExternal() {
+		return
+	}
+
+	if hash == "" {
+		var appErr *model.AppError
+		if hash, appErr = a.hashFile(info.Path); appErr != nil {
+			mlog.Warn("Failed to hash uploaded file", mlog.String("path", info.Path), mlog.Err(appErr))
+			return
+		}
+	}
+	info.ContentHash = hash
+}
+
+// deduplicateUploadedFile points a file just saved to the content of an earlier file of its team
+// with the same hash when deduplication is enabled, and removes its own copy. Failing to do so
+// only leaves the file with its own copy.
+func (a *App) deduplicateUploadedFile(info *model.FileInfo) {
+	if info.IsExternal() || !*a.Config().FileSettings.EnableFileDeduplication {
+		return
+	}
+
+	duplicate, appErr := a.findDuplicateFile(info)
+	if appErr != nil {
+		mlog.Warn("Failed to look up duplicates of uploaded file", mlog.String("path", info.Path), mlog.Err(appErr))
+		return
+	}
+	if duplicate == nil {
+		return
+	}
+
+	referenced, appErr := a.referenceDuplicateFile(info, duplicate)
+	if appErr != nil {
+		mlog.Warn("Failed to deduplicate uploaded file", mlog.String("path", info.Path), mlog.Err(appErr))
+		return
+	}
+	if !referenced {
+		return
+	}
+
+	if appErr := a.RemoveFile(info.Path); appErr != nil {
+		mlog.Warn("Failed to remove deduplicated file", mlog.String("path", info.Path), mlog.Err(appErr))
+	}
+	info.Path = duplicate.Path
+}
+
+// lockFileReferences serializes, on this server, pointing files to the stored file at the path
+// and removing it once no file references it. It returns the function releasing the lock.
+func (a *App) lockFileReferences(path string) func() {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(path))
+	mut := &a.ch.fileReferenceMuts[hasher.Sum32()%uint32(len(a.ch.fileReferenceMuts))]
+	mut.Lock()
+	return mut.Unlock
+}
+
+// referenceDuplicateFile points the saved file to the stored content of its duplicate, returning
+// whether it did. The content is checked again once referenced, since another server may have
+// removed it in between, in which case the file is pointed back to its own copy.
+func (a *App) referenceDuplicateFile(info, duplicate *model.FileInfo) (bool, *model.AppError) {
+	unlock := a.lockFileReferences(duplicate.Path)
+	defer unlock()
+
+	if exists, appErr := a.FileExists(duplicate.Path); appErr != nil || !exists {
+		return false, appErr
+	}
+
+	if err := a.Srv().Store.FileInfo().SetContentHash(info.Id, info.ContentHash, duplicate.Path); err != nil {
+		return false, model.NewAppError("referenceDuplicateFile", "app.file_info.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if info.PostId != "" {
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(info.PostId, info.DeleteAt != 0)
+	}
+
+	if exists, appErr := a.FileExists(duplicate.Path); appErr == nil && exists {
+		return true, nil
+	}
+
+	if err := a.Srv().Store.FileInfo().SetContentHash(info.Id, info.ContentHash, info.Path); err != nil {
+		return false, model.NewAppError("referenceDuplicateFile", "app.file_info.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if info.PostId != "" {
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(info.PostId, info.DeleteAt != 0)
+	}
+	return false, nil
+}
+
+// removeUnreferencedFile removes the stored file at the path along with its watermarked variants,
+// unless files still reference it, returning whether it was removed.
+func (a *App) removeUnreferencedFile(path string) (bool, *model.AppError) {
+	unlock := a.lockFileReferences(path)
+	defer unlock()
+
+	references, err := a.Srv().Store.FileInfo().CountByPath(path)
+	if err != nil {
+		return false, model.NewAppError("removeUnreferencedFile", "app.file_info.get_with_options.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if references > 0 {
+		return false, nil
+	}
+
+	exists, appErr := a.FileExists(path)
+	if appErr != nil || !exists {
+		return false, appErr
+	}
+
+	if appErr := a.RemoveFile(path); appErr != nil {
+		return false, appErr
+	}
+	a.removeWatermarkedFiles(path)
+	return true, nil
+}
+
+// ProcessFileDeduplication hashes the files uploaded before their content was hashed, and points
+// every file to the content of the oldest file of its team with the same hash, removing the copies
+// no file references anymore. How many files were hashed and deduplicated, and how many bytes were
+// reclaimed, are reported in the data of the job.
+func (a *App) ProcessFileDeduplication(job *model.Job) *model.AppError {
+	if !*a.Config().FileSettings.EnableFileDeduplication {
+		return model.NewAppError("ProcessFileDeduplication", "app.file.deduplication.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if job.Data == nil {
+		job.Data = make(model.StringMap)
+	}
+
+	var hashed, deduplicated, reclaimedBytes int64
+	var afterCreateAt int64
+	afterID := ""
+	for {
+		infos, err := a.Srv().Store.FileInfo().GetAfter(afterCreateAt, afterID, fileDeduplicationBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessFileDeduplication", "app.file_info.get_with_options.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, info := range infos {
+			afterCreateAt, afterID = info.CreateAt, info.Id
+			if info.IsExternal() || info.Path == "" {
+				continue
+			}
+
+			hash := info.ContentHash
+			if hash == "" {
+				var appErr *model.AppError
+				if hash, appErr = a.hashFile(info.Path); appErr != nil {
+					mlog.Warn("Failed to hash file", mlog.String("file_id", info.Id), mlog.String("path", info.Path), mlog.Err(appErr))
+					continue
+				}
+				if err := a.Srv().Store.FileInfo().SetContentHash(info.Id, hash, info.Path); err != nil {
+					return model.NewAppError("ProcessFileDeduplication", "app.file_info.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+				}
+				if info.PostId != "" {
+					a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(info.PostId, info.DeleteAt != 0)
+				}
+				hashed++
+			}
+
+			updated := *info
+			updated.ContentHash = hash
+			duplicate, appErr := a.findDuplicateFile(&updated)
+			if appErr != nil {
+				return appErr
+			}
+			if duplicate == nil {
+				continue
+			}
+
+			referenced, appErr := a.referenceDuplicateFile(&updated, duplicate)
+			if appErr != nil {
+				return appErr
+			}
+			if !referenced {
+				continue
+			}
+			deduplicated++
+			removed, appErr := a.removeUnreferencedFile(info.Path)
+			if appErr != nil {
+				mlog.Warn("Failed to remove deduplicated file", mlog.String("path", info.Path), mlog.Err(appErr))
+			}
+			if removed {
+				reclaimedBytes += info.Size
+			}
+		}
+
+		job.Data["hashed_files"] = strconv.FormatInt(hashed, 10)
+		job.Data["deduplicated_files"] = strconv.FormatInt(deduplicated, 10)
+		job.Data["reclaimed_bytes"] = strconv.FormatInt(reclaimedBytes, 10)
+		if appErr := a.Srv().Jobs.UpdateInProgressJobData(job); appErr != nil {
+			return appErr
+		}
+
+		if len(infos) < fileDeduplicationBatchSize {
+			return nil
+		}
+	}
+}
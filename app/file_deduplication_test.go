@@ -0,0 +1,137 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestDeduplicateUploadedFile(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := []byte("the same content")
+	upload := func(t *testing.T, teamID string) *model.FileInfo {
+		t.Helper()
+		info, appErr := th.App.DoUploadFile(th.Context, time.Now(), teamID, th.BasicChannel.Id, th.BasicUser.Id, "file.txt", data)
+		require.Nil(t, appErr)
+		return info
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		info1 := upload(t, th.BasicTeam.Id)
+		info2 := upload(t, th.BasicTeam.Id)
+		assert.NotEqual(t, info1.Path, info2.Path)
+		assert.Len(t, info1.ContentHash, 64)
+		assert.Equal(t, info1.ContentHash, info2.ContentHash)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = true })
+
+	t.Run("files of a team share their content", func(t *testing.T) {
+		info1 := upload(t, th.BasicTeam.Id)
+		info2 := upload(t, th.BasicTeam.Id)
+		assert.Equal(t, info1.Path, info2.Path)
+
+		other := upload(t, model.NewId())
+		assert.NotEqual(t, info1.Path, other.Path, "files aren't shared across teams")
+
+		content, appErr := th.App.GetFile(info2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, data, content)
+
+		saved, err := th.App.Srv().Store.FileInfo().Get(info2.Id)
+		require.NoError(t, err)
+		assert.Equal(t, info1.Path, saved.Path)
+	})
+
+	t.Run("files aren't pointed to content removed meanwhile", func(t *testing.T) {
+		info := upload(t, th.BasicTeam.Id)
+		removed := &model.FileInfo{Id: model.NewId(), Path: "20211201/teams/" + th.BasicTeam.Id + "/" + model.NewId() + "/file.txt"}
+
+		referenced, appErr := th.App.referenceDuplicateFile(info, removed)
+		require.Nil(t, appErr)
+		assert.False(t, referenced)
+
+		saved, err := th.App.Srv().Store.FileInfo().Get(info.Id)
+		require.NoError(t, err)
+		assert.Equal(t, info.Path, saved.Path)
+	})
+
+	t.Run("shared content is removed with its last file", func(t *testing.T) {
+		info1 := upload(t, th.BasicTeam.Id)
+		info2 := upload(t, th.BasicTeam.Id)
+		require.Equal(t, info1.Path, info2.Path)
+
+		require.NoError(t, th.App.Srv().Store.FileInfo().PermanentDelete(info2.Id))
+		removed, appErr := th.App.removeUnreferencedFile(info2.Path)
+		require.Nil(t, appErr)
+		assert.False(t, removed)
+
+		variantPath := watermarkedFilePath(info1.Path, th.BasicUser.Id)
+		_, appErr = th.App.WriteFile(bytes.NewReader(data), variantPath)
+		require.Nil(t, appErr)
+
+		require.NoError(t, th.App.Srv().Store.FileInfo().PermanentDelete(info1.Id))
+		removed, appErr = th.App.removeUnreferencedFile(info1.Path)
+		require.Nil(t, appErr)
+		assert.True(t, removed)
+
+		exists, appErr := th.App.FileExists(variantPath)
+		require.Nil(t, appErr)
+		assert.False(t, exists, "the watermarked variants are removed along with the content")
+	})
+}
+
+func TestProcessFileDeduplication(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	job, appErr := th.App.Srv().Jobs.CreateJob(model.JobTypeFileDeduplication, nil)
+	require.Nil(t, appErr)
+
+	appErr = th.App.ProcessFileDeduplication(job)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.file.deduplication.disabled.app_error", appErr.Id)
+
+	data := []byte("historical content")
+	info1, appErr := th.App.DoUploadFile(th.Context, time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "file.txt", data)
+	require.Nil(t, appErr)
+
+	// A file uploaded before its content was hashed.
+	path := "20211201/teams/" + th.BasicTeam.Id + "/channels/" + th.BasicChannel.Id + "/users/" + th.BasicUser.Id + "/" + model.NewId() + "/file.txt"
+	_, appErr = th.App.WriteFile(bytes.NewReader(data), path)
+	require.Nil(t, appErr)
+	info2, err := th.App.Srv().Store.FileInfo().Save(&model.FileInfo{
+		CreatorId: th.BasicUser.Id,
+		Name:      "file.txt",
+		Path:      path,
+		Size:      int64(len(data)),
+	})
+	require.NoError(t, err)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = true })
+	require.Nil(t, th.App.ProcessFileDeduplication(job))
+
+	info2, err = th.App.Srv().Store.FileInfo().Get(info2.Id)
+	require.NoError(t, err)
+	assert.Equal(t, info1.ContentHash, info2.ContentHash)
+	assert.Equal(t, info1.Path, info2.Path)
+
+	exists, appErr := th.App.FileExists(path)
+	require.Nil(t, appErr)
+	assert.False(t, exists)
+
+	assert.Equal(t, "1", job.Data["hashed_files"])
+	assert.Equal(t, "1", job.Data["deduplicated_files"])
+	assert.Equal(t, strconv.Itoa(len(data)), job.Data["reclaimed_bytes"])
+}
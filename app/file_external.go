@@ -0,0 +1,175 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// externalFileMetadata holds what could be learned about an externally hosted object without
+// downloading it.
+type externalFileMetadata struct {
+	size     int64
+	mimeType string
+}
+
+// checkExternalFileURL returns the parsed URL if it points to one of the hosts files can be
+// attached from.
+func (a *App) checkExternalFileURL(where, rawURL string) (*url.URL, *model.AppError) {
+	if !*a.Config().FileSettings.EnableFileAttachments {
+		return nil, model.NewAppError(where, "api.file.attachments.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+	if len(a.Config().FileSettings.ExternalFileHosts) == 0 {
+		return nil, model.NewAppError(where, "app.file.external.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, model.NewAppError(where, "model.external_file_attachment.is_valid.url.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	if !model.ExternalFileHostAllowed(u.Hostname(), a.Config().FileSettings.ExternalFileHosts) {
+		return nil, model.NewAppError(where, "app.file.external.host_not_allowed.app_error", map[string]interface{}{"Host": u.Hostname()}, "", http.StatusForbidden)
+	}
+	return u, nil
+}
+
+// fetchExternalFileMetadata asks the host of an external object for its size and type. Only the
+// first byte is requested since signed URLs are often only valid for GET requests.
+func (a *App) fetchExternalFileMetadata(u *url.URL) (*externalFileMetadata, *model.AppError) {
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, model.NewAppError("fetchExternalFileMetadata", "app.file.external.fetch.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	req.Header.Set("Range", "bytes=0-0")
+
+	resp, err := a.HTTPService().MakeClient(false).Do(req)
+	if err != nil {
+		return nil, model.NewAppError("fetchExternalFileMetadata", "app.file.external.fetch.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	defer resp.Body.Close()
+
+	metadata := &externalFileMetadata{}
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// Content-Range: bytes 0-0/<total>
+		contentRange := resp.Header.Get("Content-Range")
+		if i := strings.LastIndex(contentRange, "/"); i != -1 {
+			if size, parseErr := strconv.ParseInt(contentRange[i+1:], 10, 64); parseErr == nil {
+				metadata.size = size
+			}
+		}
+	case http.StatusOK:
+		metadata.size = resp.ContentLength
+	default:
+		return nil, model.NewAppError("fetchExternalFileMetadata", "app.file.external.fetch.app_error", nil, "status="+strconv.Itoa(resp.StatusCode), http.StatusBadRequest)
+	}
+
+	if metadata.size < 0 {
+		metadata.size = 0
+	}
+	if metadata.size > *a.Config().FileSettings.MaxFileSize {
+		return nil, model.NewAppError("fetchExternalFileMetadata", "app.file.external.too_large.app_error",
+			map[string]interface{}{"Length": metadata.size, "Limit": *a.Config().FileSettings.MaxFileSize}, "", http.StatusRequestEntityTooLarge)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, parseErr := mime.ParseMediaType(contentType); parseErr == nil {
+			metadata.mimeType = mediaType
+		}
+	}
+
+	return metadata, nil
+}
+
+// externalFileName returns the name to show for an external object, falling back to the last
+// element of its URL path.
+func externalFileName(attachment *model.ExternalFileAttachment, u *url.URL) string {
+	name := attachment.Name
+	if name == "" {
+		name = path.Base(u.EscapedPath())
+		if unescaped, err := url.PathUnescape(name); err == nil {
+			name = unescaped
+		}
+	}
+	if name == "" || name == "." || name == "/" {
+		name = "file"
+	}
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+	if utf8.RuneCountInString(name) > model.ExternalFileNameMaxRunes {
+		name = string([]rune(name)[:model.ExternalFileNameMaxRunes])
+	}
+	return name
+}
+
+// CreateExternalFileInfo records an object hosted outside of the file store as a file that can be
+// attached to posts. Its bytes are not copied, they're proxied from the host when downloaded.
+func (a *App) CreateExternalFileInfo(userID string, attachment *model.ExternalFileAttachment) (*model.FileInfo, *model.AppError) {
+	if appErr := attachment.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	u, appErr := a.checkExternalFileURL("CreateExternalFileInfo", attachment.URL)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	metadata, appErr := a.fetchExternalFileMetadata(u)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	name := externalFileName(attachment, u)
+	extension := strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+	mimeType := metadata.mimeType
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension("." + extension)
+	}
+
+	info := &model.FileInfo{
+		CreatorId:   userID,
+		ChannelId:   attachment.ChannelId,
+		Name:        name,
+		Extension:   extension,
+		Size:        metadata.size,
+		MimeType:    mimeType,
+		ExternalURL: u.String(),
+	}
+
+	info, err := a.Srv().Store.FileInfo().Save(info)
+	if err != nil {
+		return nil, model.NewAppError("CreateExternalFileInfo", "app.file_info.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return info, nil
+}
+
+// ExternalFileReader opens the object behind an external file for reading. The host is checked
+// again so that removing it from the allowed hosts stops the proxying of files already attached.
+func (a *App) ExternalFileReader(info *model.FileInfo) (io.ReadCloser, *model.AppError) {
+	u, appErr := a.checkExternalFileURL("ExternalFileReader", info.ExternalURL)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	resp, err := a.HTTPService().MakeClient(false).Get(u.String())
+	if err != nil {
+		return nil, model.NewAppError("ExternalFileReader", "app.file.external.fetch.app_error", nil, err.Error(), http.StatusBadGateway)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, model.NewAppError("ExternalFileReader", "app.file.external.fetch.app_error", nil, "status="+strconv.Itoa(resp.StatusCode), http.StatusBadGateway)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestExternalFileName(t *testing.T) {
+	for _, tc := range []struct {
+		Description string
+		Name        string
+		URL         string
+		Expected    string
+	}{
+		{"given name", "Quarterly report.pdf", "https://bucket.s3.amazonaws.com/abc", "Quarterly report.pdf"},
+		{"from url path", "", "https://bucket.s3.amazonaws.com/reports/q1%20final.pdf?X-Amz-Signature=abc", "q1 final.pdf"},
+		{"escaped slash in url path", "", "https://bucket.s3.amazonaws.com/a%2Fb.pdf", "a_b.pdf"},
+		{"empty url path", "", "https://bucket.s3.amazonaws.com", "file"},
+		{"too long", "", "https://bucket.s3.amazonaws.com/" + strings.Repeat("a", 300), strings.Repeat("a", model.ExternalFileNameMaxRunes)},
+	} {
+		t.Run(tc.Description, func(t *testing.T) {
+			u, err := url.Parse(tc.URL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, externalFileName(&model.ExternalFileAttachment{Name: tc.Name, URL: tc.URL}, u))
+		})
+	}
+}
+
+func TestCreateExternalFileInfo(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	attachment := &model.ExternalFileAttachment{
+		ChannelId: th.BasicChannel.Id,
+		URL:       "https://bucket.s3.amazonaws.com/report.pdf?X-Amz-Signature=abc",
+	}
+
+	t.Run("no allowed hosts", func(t *testing.T) {
+		_, appErr := th.App.CreateExternalFileInfo(th.BasicUser.Id, attachment)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.file.external.disabled.app_error", appErr.Id)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.FileSettings.ExternalFileHosts = []string{"storage.googleapis.com"}
+	})
+
+	t.Run("host not allowed", func(t *testing.T) {
+		_, appErr := th.App.CreateExternalFileInfo(th.BasicUser.Id, attachment)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.file.external.host_not_allowed.app_error", appErr.Id)
+	})
+
+	t.Run("file attachments disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileAttachments = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileAttachments = true })
+
+		_, appErr := th.App.CreateExternalFileInfo(th.BasicUser.Id, &model.ExternalFileAttachment{
+			ChannelId: th.BasicChannel.Id,
+			URL:       "https://storage.googleapis.com/bucket/report.pdf",
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.file.attachments.disabled.app_error", appErr.Id)
+	})
+}
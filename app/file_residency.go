@@ -0,0 +1,310 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/filestore"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	teamResidencyCacheSize   = 20000
+	teamResidencyCacheName   = "TeamResidency"
+	teamResidencyCacheExpiry = 1 * time.Minute
+
+	// fileResidencyMigrationBatchSize is how many files of the team are looked up at once.
+	fileResidencyMigrationBatchSize = 200
+)
+
+// fileResidencyMigrationSettleTime is how long a migration waits for every server to route
+// the files of the team to its new storage before removing them from the previous one.
+var fileResidencyMigrationSettleTime = teamResidencyCacheExpiry
+
+// newFileBackend returns the backend storing the files. When residency backends are configured,
+// the files of the teams with a data residency are routed to the backend of their residency.
+func (s *Server) newFileBackend() (filestore.FileBackend, error) {
+	license := s.License()
+	enableComplianceFeatures := license != nil && *license.Features.Compliance
+	settings := s.Config().FileSettings
+
+	backend, err := filestore.NewFileBackend(settings.ToFileBackendSettings(enableComplianceFeatures))
+	if err != nil {
+		return nil, err
+	}
+	if len(settings.ResidencyBackends) == 0 {
+		return backend, nil
+	}
+
+	s.residencyFileBackends = make(map[string]filestore.FileBackend, len(settings.ResidencyBackends))
+	for residency, residencySettings := range settings.ResidencyBackends {
+		residencyBackend, err := filestore.NewFileBackend(residencySettings.ToFileBackendSettings(enableComplianceFeatures))
+		if err != nil {
+			return nil, err
+		}
+		s.residencyFileBackends[residency] = residencyBackend
+	}
+
+	return filestore.NewRoutedFileBackend(backend, s.residencyFileBackendForPath), nil
+}
+
+// defaultFileBackend returns the backend of the files of the teams without a data residency.
+func (s *Server) defaultFileBackend() filestore.FileBackend {
+	if routed, ok := s.FileBackend().(*filestore.RoutedFileBackend); ok {
+		return routed.DefaultBackend()
+	}
+	return s.FileBackend()
+}
+
+func (s *Server) testResidencyFileBackends() {
+	for residency, backend := range s.residencyFileBackends {
+		if err := backend.TestConnection(); err != nil {
+			mlog.Error("Problem with the file storage of a data residency", mlog.String("residency", residency), mlog.Err(err))
+		}
+	}
+}
+
+// fileBackendForResidency returns the storage of the files of the teams with the data residency,
+// or nil when no storage is set up for it.
+func (s *Server) fileBackendForResidency(residency string) filestore.FileBackend {
+	if residency == "" {
+		return s.defaultFileBackend()
+	}
+	return s.residencyFileBackends[residency]
+}
+
+// residencyFileBackendForPath returns the storage of the data residency of the team owning the
+// file at the path, or nil for the files stored in the default storage. Files whose residency
+// can't be told aren't routed to the default storage, which could be in the wrong region.
+func (s *Server) residencyFileBackendForPath(path string) (filestore.FileBackend, error) {
+	teamID, err := s.fileTeamID(path)
+	if err != nil || teamID == "" {
+		return nil, err
+	}
+
+	residency, err := s.teamResidency(teamID)
+	if err != nil || residency == "" {
+		return nil, err
+	}
+
+	backend := s.residencyFileBackends[residency]
+	if backend == nil {
+		return nil, errors.Errorf("no file storage is set up for the data residency %s of team %s", residency, teamID)
+	}
+	return backend, nil
+}
+
+// fileTeamID returns the team owning the file at the path. Team icons and attachments are stored
+// under the directory of their team, apart from the files uploaded through upload sessions which
+// are stored under "noteam" and belong to the team of their channel.
+func (s *Server) fileTeamID(path string) (string, error) {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments)-1 && i < 2; i++ {
+		if segments[i] != "teams" {
+			continue
+		}
+
+		teamID := segments[i+1]
+		if teamID != "noteam" {
+			return teamID, nil
+		}
+		if i+3 < len(segments) && segments[i+2] == "channels" {
+			channel, err := s.Store.Channel().Get(segments[i+3], true)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to get the team of channel %s", segments[i+3])
+			}
+			return channel.TeamId, nil
+		}
+		return "", nil
+	}
+	return "", nil
+}
+
+// teamResidency returns the data residency of the team. Since the files of a team are routed
+// on every access, it's cached for a short while.
+func (s *Server) teamResidency(teamID string) (string, error) {
+	var residency string
+	if err := s.teamResidencyCache.Get(teamID, &residency); err == nil {
+		return residency, nil
+	}
+
+	team, err := s.Store.Team().Get(teamID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the data residency of team %s", teamID)
+	}
+	s.teamResidencyCache.SetWithDefaultExpiry(teamID, team.DataResidency)
+
+	return team.DataResidency, nil
+}
+
+// SetTeamDataResidency queues the move of the files of the team to the storage of the data
+// residency. The files keep being read from the current storage until all of them are copied.
+func (a *App) SetTeamDataResidency(teamID, residency string) (*model.FileResidencyMigration, *model.AppError) {
+	if residency != "" && a.Srv().fileBackendForResidency(residency) == nil {
+		return nil, model.NewAppError("SetTeamDataResidency", "app.team.data_residency.unknown.app_error", map[string]interface{}{"Residency": residency}, "", http.StatusBadRequest)
+	}
+
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if team.DataResidency == residency {
+		return nil, model.NewAppError("SetTeamDataResidency", "app.team.data_residency.unchanged.app_error", nil, "team_id="+teamID, http.StatusBadRequest)
+	}
+
+	for _, status := range []string{model.JobStatusPending, model.JobStatusInProgress} {
+		jobs, err := a.Srv().Store.Job().GetAllByTypeAndStatus(model.JobTypeFileResidencyMigration, status)
+		if err != nil {
+			return nil, model.NewAppError("SetTeamDataResidency", "app.job.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		for _, job := range jobs {
+			if job.Data["team_id"] == teamID {
+				return nil, model.NewAppError("SetTeamDataResidency", "app.team.data_residency.migration_running.app_error", nil, "job_id="+job.Id, http.StatusBadRequest)
+			}
+		}
+	}
+
+	job, appErr := a.Srv().Jobs.CreateJob(model.JobTypeFileResidencyMigration, map[string]string{
+		"team_id":        teamID,
+		"from_residency": team.DataResidency,
+		"to_residency":   residency,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.FileResidencyMigration{
+		JobId:         job.Id,
+		TeamId:        teamID,
+		FromResidency: team.DataResidency,
+		ToResidency:   residency,
+		Status:        job.Status,
+	}, nil
+}
+
+// ProcessFileResidencyMigration moves the files of the team of the job to the storage of its new
+// data residency. The files are first copied, then the team is routed to the new storage, and the
+// files are removed from the previous storage once every server routes the team there. Files
+// created meanwhile are copied again since they may have been written to the previous storage.
+func (a *App) ProcessFileResidencyMigration(job *model.Job) *model.AppError {
+	teamID := job.Data["team_id"]
+	from := a.Srv().fileBackendForResidency(job.Data["from_residency"])
+	to := a.Srv().fileBackendForResidency(job.Data["to_residency"])
+	if from == nil || to == nil {
+		return model.NewAppError("ProcessFileResidencyMigration", "app.team.data_residency.unknown.app_error", map[string]interface{}{"Residency": job.Data["to_residency"]}, "job_id="+job.Id, http.StatusInternalServerError)
+	}
+
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return appErr
+	}
+
+	startedAt := model.GetMillis()
+	copied := 0
+	appErr = a.forEachTeamFile(team, func(path string, _ int64) *model.AppError {
+		moved, appErr := copyResidencyFile(from, to, path)
+		if moved {
+			copied++
+		}
+		return appErr
+	})
+	if appErr != nil {
+		return appErr
+	}
+
+	team.DataResidency = job.Data["to_residency"]
+	if _, err := a.Srv().Store.Team().Update(team); err != nil {
+		return model.NewAppError("ProcessFileResidencyMigration", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	a.Srv().teamResidencyCache.Remove(teamID)
+
+	job.Data["copied_files"] = strconv.Itoa(copied)
+	if appErr := a.Srv().Jobs.UpdateInProgressJobData(job); appErr != nil {
+		return appErr
+	}
+
+	time.Sleep(fileResidencyMigrationSettleTime)
+
+	removed := 0
+	appErr = a.forEachTeamFile(team, func(path string, createAt int64) *model.AppError {
+		if createAt >= startedAt {
+			if _, appErr := copyResidencyFile(from, to, path); appErr != nil {
+				return appErr
+			}
+		}
+		if exists, err := from.FileExists(path); err != nil || !exists {
+			return nil
+		}
+		if err := from.RemoveFile(path); err != nil {
+			return model.NewAppError("ProcessFileResidencyMigration", "api.file.remove_file.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		removed++
+		return nil
+	})
+	if appErr != nil {
+		return appErr
+	}
+
+	job.Data["removed_files"] = strconv.Itoa(removed)
+	return a.Srv().Jobs.UpdateInProgressJobData(job)
+}
+
+// forEachTeamFile calls f with the path of every file stored for the team, along with when the
+// file was created.
+func (a *App) forEachTeamFile(team *model.Team, f func(path string, createAt int64) *model.AppError) *model.AppError {
+	if team.LastTeamIconUpdate > 0 {
+		if appErr := f(getTeamIconPath(team.Id), team.LastTeamIconUpdate); appErr != nil {
+			return appErr
+		}
+	}
+
+	var afterCreateAt int64
+	afterID := ""
+	for {
+		infos, err := a.Srv().Store.FileInfo().GetForTeamAfter(team.Id, afterCreateAt, afterID, fileResidencyMigrationBatchSize)
+		if err != nil {
+			return model.NewAppError("forEachTeamFile", "app.file_info.get_with_options.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, info := range infos {
+			for _, path := range []string{info.Path, info.ThumbnailPath, info.PreviewPath} {
+				if path == "" {
+					continue
+				}
+				if appErr := f(path, info.CreateAt); appErr != nil {
+					return appErr
+				}
+			}
+			afterCreateAt, afterID = info.CreateAt, info.Id
+		}
+
+		if len(infos) < fileResidencyMigrationBatchSize {
+			return nil
+		}
+	}
+}
+
+// copyResidencyFile copies the file from a storage to the other, returning false when the
+// previous storage doesn't have it.
+func copyResidencyFile(from, to filestore.FileBackend, path string) (bool, *model.AppError) {
+	exists, err := from.FileExists(path)
+	if err != nil {
+		return false, model.NewAppError("copyResidencyFile", "api.file.file_exists.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	if err := filestore.CopyBetweenBackends(from, to, path, path); err != nil {
+		return false, model.NewAppError("copyResidencyFile", "api.file.move_file.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return true, nil
+}
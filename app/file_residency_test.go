@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/filestore"
+)
+
+func TestFileResidencyMigration(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dir, err := ioutil.TempDir("", "residency")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	euBackend, err := filestore.NewFileBackend(filestore.FileBackendSettings{DriverName: model.ImageDriverLocal, Directory: dir})
+	require.NoError(t, err)
+	defaultBackend := th.Server.FileBackend()
+	th.Server.residencyFileBackends = map[string]filestore.FileBackend{"eu": euBackend}
+	th.Server.filestore = filestore.NewRoutedFileBackend(defaultBackend, th.Server.residencyFileBackendForPath)
+
+	prevSettleTime := fileResidencyMigrationSettleTime
+	fileResidencyMigrationSettleTime = 0
+	defer func() { fileResidencyMigrationSettleTime = prevSettleTime }()
+
+	path := "20211201/teams/" + th.BasicTeam.Id + "/channels/" + th.BasicChannel.Id + "/users/" + th.BasicUser.Id + "/" + model.NewId() + "/file.txt"
+	_, appErr := th.App.WriteFile(bytes.NewReader([]byte("data")), path)
+	require.Nil(t, appErr)
+	_, err = th.App.Srv().Store.FileInfo().Save(&model.FileInfo{
+		CreatorId: th.BasicUser.Id,
+		Name:      "file.txt",
+		Path:      path,
+	})
+	require.NoError(t, err)
+
+	t.Run("unknown residency", func(t *testing.T) {
+		_, appErr := th.App.SetTeamDataResidency(th.BasicTeam.Id, "us")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.data_residency.unknown.app_error", appErr.Id)
+	})
+
+	t.Run("unchanged residency", func(t *testing.T) {
+		_, appErr := th.App.SetTeamDataResidency(th.BasicTeam.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.data_residency.unchanged.app_error", appErr.Id)
+	})
+
+	t.Run("files are moved to the storage of the residency", func(t *testing.T) {
+		migration, appErr := th.App.SetTeamDataResidency(th.BasicTeam.Id, "eu")
+		require.Nil(t, appErr)
+		assert.Equal(t, "", migration.FromResidency)
+		assert.Equal(t, "eu", migration.ToResidency)
+
+		_, appErr = th.App.SetTeamDataResidency(th.BasicTeam.Id, "eu")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.data_residency.migration_running.app_error", appErr.Id)
+
+		job, appErr := th.App.GetJob(migration.JobId)
+		require.Nil(t, appErr)
+		require.Nil(t, th.App.ProcessFileResidencyMigration(job))
+
+		exists, err := defaultBackend.FileExists(path)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		exists, err = euBackend.FileExists(path)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		data, appErr := th.App.ReadFile(path)
+		require.Nil(t, appErr)
+		assert.Equal(t, "data", string(data))
+
+		team, appErr := th.App.GetTeam(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "eu", team.DataResidency)
+	})
+
+	t.Run("files whose residency can't be told aren't stored in the default storage", func(t *testing.T) {
+		team := th.CreateTeam()
+		team.DataResidency = "us"
+		_, err := th.App.Srv().Store.Team().Update(team)
+		require.NoError(t, err)
+
+		for _, path := range []string{
+			"20211201/teams/" + team.Id + "/channels/" + model.NewId() + "/users/" + th.BasicUser.Id + "/" + model.NewId() + "/file.txt",
+			"20211201/teams/noteam/channels/" + model.NewId() + "/users/" + th.BasicUser.Id + "/" + model.NewId() + "/file.txt",
+		} {
+			_, appErr := th.App.WriteFile(bytes.NewReader([]byte("data")), path)
+			require.NotNil(t, appErr)
+			_, appErr = th.App.ReadFile(path)
+			require.NotNil(t, appErr)
+
+			exists, err := defaultBackend.FileExists(path)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		}
+	})
+}
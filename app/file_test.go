@@ -4,11 +4,16 @@
 package app
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"image"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +45,48 @@ func TestGeneratePublicLinkHash(t *testing.T) {
 	assert.NotEqual(t, hash1, hash3, "hashes for the same file with different salts should not be equal")
 }
 
+func TestGetSignedFileURL(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		signedURL, err := th.App.GetSignedFileURL("teams/abc/teamIcon.png")
+		require.Nil(t, err)
+		assert.Empty(t, signedURL)
+	})
+
+	t.Run("unsupported by the local backend", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableSignedURLs = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableSignedURLs = false })
+
+		signedURL, err := th.App.GetSignedFileURL("teams/abc/teamIcon.png")
+		require.Nil(t, err)
+		assert.Empty(t, signedURL)
+	})
+
+	t.Run("cloudfront", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.FileSettings.EnableSignedURLs = true
+			*cfg.FileSettings.AmazonCloudFrontDomain = "cdn.example.com"
+			*cfg.FileSettings.AmazonCloudFrontKeyPairId = "KEYPAIR"
+			*cfg.FileSettings.AmazonCloudFrontPrivateKey = keyPEM
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.FileSettings.EnableSignedURLs = false
+			*cfg.FileSettings.AmazonCloudFrontDomain = ""
+		})
+
+		signedURL, appErr := th.App.GetSignedFileURL("teams/abc/teamIcon.png")
+		require.Nil(t, appErr)
+		assert.True(t, strings.HasPrefix(signedURL, "https://cdn.example.com/teams/abc/teamIcon.png?"))
+		assert.Contains(t, signedURL, "Key-Pair-Id=KEYPAIR")
+	})
+}
+
 func TestDoUploadFile(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -544,4 +591,53 @@ func TestSearchFilesInTeamForUser(t *testing.T) {
 		assert.Equal(t, []string{}, results.Order)
 		es.AssertExpectations(t)
 	})
+
+	t.Run("should return the content matches of the fileInfos", func(t *testing.T) {
+		th, fileInfos := setup(t, false)
+		defer th.TearDown()
+
+		err := th.App.Srv().Store.FileInfo().SetContent(fileInfos[6].Id, "The content of the searchTerm file")
+		require.NoError(t, err)
+
+		results, appErr := th.App.SearchFilesInTeamForUser(th.Context, searchTerm, th.BasicUser.Id, th.BasicTeam.Id, false, false, 0, 0, perPage)
+
+		require.Nil(t, appErr)
+		require.Len(t, results.ContentMatches, 1)
+		require.Contains(t, results.ContentMatches, fileInfos[6].Id)
+		assert.Equal(t, "The content of the searchTerm file", results.ContentMatches[fileInfos[6].Id].Snippet)
+		assert.Equal(t, []string{"searchTerm"}, results.ContentMatches[fileInfos[6].Id].Matches)
+	})
+}
+
+func TestSearchFilesInArchivedChannels(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.ExperimentalViewArchivedChannels = true })
+
+	archivedChannel := th.CreateChannel(th.BasicTeam)
+	archivedPost := th.CreatePost(archivedChannel)
+	for _, post := range []*model.Post{th.BasicPost, archivedPost} {
+		_, err := th.App.Srv().Store.FileInfo().Save(&model.FileInfo{
+			CreatorId: th.BasicUser.Id,
+			PostId:    post.Id,
+			Name:      "roadmap.pdf",
+			Path:      "roadmap.pdf",
+			Extension: "pdf",
+			MimeType:  "application/pdf",
+		})
+		require.NoError(t, err)
+	}
+	appErr := th.App.DeleteChannel(th.Context, archivedChannel, th.BasicUser.Id)
+	require.Nil(t, appErr)
+
+	results, appErr := th.App.SearchFilesInTeamForUser(th.Context, "roadmap", th.BasicUser.Id, th.BasicTeam.Id, false, true, 0, 0, 60)
+	require.Nil(t, appErr)
+	assert.Len(t, results.Order, 2)
+	assert.Equal(t, []string{archivedChannel.Id}, results.ArchivedChannelIds)
+
+	results, appErr = th.App.SearchFilesInTeamForUser(th.Context, "roadmap", th.BasicUser.Id, th.BasicTeam.Id, false, false, 0, 0, 60)
+	require.Nil(t, appErr)
+	assert.Len(t, results.Order, 1)
+	assert.Empty(t, results.ArchivedChannelIds)
 }
@@ -0,0 +1,192 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/freetype"
+	"github.com/golang/freetype/truetype"
+
+	"github.com/mattermost/mattermost-server/v6/app/imaging"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/utils/fileutils"
+)
+
+const (
+	watermarkFont    = "nunito-bold.ttf"
+	watermarkPDFSize = 48
+	watermarkDir     = "watermarks"
+)
+
+// FileNeedsWatermark reports whether the file must be watermarked with the username of whoever
+// downloads it, which is the case for the images and PDFs posted in the channels tagged with
+// one of the configured sensitivity tags.
+func (a *App) FileNeedsWatermark(info *model.FileInfo) (bool, *model.AppError) {
+	tags := a.Config().FileSettings.WatermarkSensitivityTags
+	if len(tags) == 0 || info.PostId == "" || !isWatermarkable(info) {
+		return false, nil
+	}
+
+	post, err := a.GetSinglePostIncludingDeleted(info.PostId)
+	if err != nil {
+		return false, err
+	}
+	channel, err := a.GetChannel(post.ChannelId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, tag := range tags {
+		if channel.SensitivityTag == tag {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isWatermarkable(info *model.FileInfo) bool {
+	switch strings.ToLower(info.Extension) {
+	case "png", "jpg", "jpeg", "pdf":
+		return true
+	}
+	return false
+}
+
+// GetWatermarkedFile returns the contents of the file watermarked with the username of the
+// user. The watermarked variants are kept next to the file so they are only generated once per
+// user, except for the external files which may change behind the server's back.
+func (a *App) GetWatermarkedFile(info *model.FileInfo, user *model.User) ([]byte, *model.AppError) {
+	return a.getWatermarkedFile(info, info.Path, user)
+}
+
+// GetWatermarkedImage returns the thumbnail or the preview at the path of the file watermarked
+// with the username of the user, so that they can't be used to get around the watermark of the
+// file.
+func (a *App) GetWatermarkedImage(info *model.FileInfo, imagePath string, user *model.User) ([]byte, *model.AppError) {
+	return a.getWatermarkedFile(info, imagePath, user)
+}
+
+// watermarkedFilePath returns where the variant of the file at the path watermarked for the user
+// is kept. It's keyed by user id rather than username, since usernames can change and be reused.
+func watermarkedFilePath(filePath, userID string) string {
+	return path.Join(path.Dir(filePath), watermarkDir, userID, path.Base(filePath))
+}
+
+func (a *App) getWatermarkedFile(info *model.FileInfo, filePath string, user *model.User) ([]byte, *model.AppError) {
+	original := filePath == info.Path
+
+	var cachePath string
+	if !info.IsExternal() {
+		cachePath = watermarkedFilePath(filePath, user.Id)
+		if exists, err := a.FileExists(cachePath); err == nil && exists {
+			return a.ReadFile(cachePath)
+		}
+	}
+
+	data, appErr := a.readOriginalFile(info, filePath)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	ttf, err := getWatermarkFont()
+	if err != nil {
+		return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.font.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var watermarked []byte
+	// Thumbnails and previews are images, whatever the type of their file.
+	if original && strings.ToLower(info.Extension) == "pdf" {
+		mask, err := imaging.TextMask(user.Username, ttf, watermarkPDFSize)
+		if err != nil {
+			return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if watermarked, err = imaging.WatermarkPDF(data, mask); err != nil {
+			return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	} else {
+		img, format, err := a.ch.imgDecoder.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		dst, err := imaging.Watermark(img, user.Username, ttf)
+		if err != nil {
+			return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		var buf bytes.Buffer
+		if format == "png" {
+			err = a.ch.imgEncoder.EncodePNG(&buf, dst)
+		} else {
+			err = a.ch.imgEncoder.EncodeJPEG(&buf, dst, 90)
+		}
+		if err != nil {
+			return nil, model.NewAppError("GetWatermarkedFile", "app.file.watermark.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		watermarked = buf.Bytes()
+	}
+
+	if cachePath != "" {
+		if _, appErr := a.WriteFile(bytes.NewReader(watermarked), cachePath); appErr != nil {
+			mlog.Warn("Failed to cache the watermarked file", mlog.String("file_id", info.Id), mlog.Err(appErr))
+		}
+	}
+
+	return watermarked, nil
+}
+
+func (a *App) readOriginalFile(info *model.FileInfo, filePath string) ([]byte, *model.AppError) {
+	if !info.IsExternal() || filePath != info.Path {
+		return a.ReadFile(filePath)
+	}
+
+	file, appErr := a.ExternalFileReader(info)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, model.NewAppError("GetWatermarkedFile", "app.file.external.fetch.app_error", nil, err.Error(), http.StatusBadGateway)
+	}
+	return data, nil
+}
+
+func getWatermarkFont() (*truetype.Font, error) {
+	fontDir, _ := fileutils.FindDir("fonts")
+	fontBytes, err := ioutil.ReadFile(filepath.Join(fontDir, watermarkFont))
+	if err != nil {
+		return nil, err
+	}
+	return freetype.ParseFont(fontBytes)
+}
+
+// removeWatermarkedFiles removes the watermarked variants of the stored files at the paths, such
+// as a file, its thumbnail and its preview. They are generated again when downloaded, if the file
+// still can be.
+func (a *App) removeWatermarkedFiles(filePaths ...string) {
+	removed := map[string]bool{}
+	for _, filePath := range filePaths {
+		if filePath == "" {
+			continue
+		}
+		dir := path.Join(path.Dir(filePath), watermarkDir)
+		if removed[dir] {
+			continue
+		}
+		removed[dir] = true
+
+		if appErr := a.RemoveDirectory(dir); appErr != nil {
+			mlog.Warn("Failed to remove the watermarked variants of a file", mlog.String("path", filePath), mlog.Err(appErr))
+		}
+	}
+}
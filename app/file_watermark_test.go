@@ -0,0 +1,138 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/utils/testutils"
+)
+
+func TestFileNeedsWatermark(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.BasicChannel.SensitivityTag = "restricted"
+	_, appErr := th.App.UpdateChannel(th.BasicChannel)
+	require.Nil(t, appErr)
+
+	post := th.CreatePost(th.BasicChannel)
+	otherPost := th.CreatePost(th.CreateChannel(th.BasicTeam))
+
+	for _, tc := range []struct {
+		Description string
+		Tags        []string
+		Info        *model.FileInfo
+		Expected    bool
+	}{
+		{"no tags configured", []string{}, &model.FileInfo{PostId: post.Id, Extension: "png"}, false},
+		{"tagged channel", []string{"restricted"}, &model.FileInfo{PostId: post.Id, Extension: "png"}, true},
+		{"pdf", []string{"restricted"}, &model.FileInfo{PostId: post.Id, Extension: "pdf"}, true},
+		{"unsupported type", []string{"restricted"}, &model.FileInfo{PostId: post.Id, Extension: "docx"}, false},
+		{"other tag", []string{"secret"}, &model.FileInfo{PostId: post.Id, Extension: "png"}, false},
+		{"untagged channel", []string{"restricted"}, &model.FileInfo{PostId: otherPost.Id, Extension: "png"}, false},
+		{"not posted", []string{"restricted"}, &model.FileInfo{Extension: "png"}, false},
+	} {
+		t.Run(tc.Description, func(t *testing.T) {
+			th.App.UpdateConfig(func(cfg *model.Config) { cfg.FileSettings.WatermarkSensitivityTags = tc.Tags })
+
+			needsWatermark, appErr := th.App.FileNeedsWatermark(tc.Info)
+			require.Nil(t, appErr)
+			assert.Equal(t, tc.Expected, needsWatermark)
+		})
+	}
+}
+
+func TestGetWatermarkedFile(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+
+	info, appErr := th.App.DoUploadFile(th.Context, time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "test.png", data)
+	require.Nil(t, appErr)
+	defer th.App.RemoveFile(info.Path)
+
+	watermarked, appErr := th.App.GetWatermarkedFile(info, th.BasicUser)
+	require.Nil(t, appErr)
+	assert.NotEqual(t, data, watermarked)
+
+	img, format, err := image.Decode(bytes.NewReader(watermarked))
+	require.NoError(t, err)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, info.Width, img.Bounds().Dx())
+	assert.Equal(t, info.Height, img.Bounds().Dy())
+
+	cachePath := watermarkedFilePath(info.Path, th.BasicUser.Id)
+	defer th.App.RemoveFile(cachePath)
+	cached, appErr := th.App.ReadFile(cachePath)
+	require.Nil(t, appErr)
+	assert.Equal(t, watermarked, cached)
+
+	t.Run("watermarked per user", func(t *testing.T) {
+		other, appErr := th.App.GetWatermarkedFile(info, th.BasicUser2)
+		require.Nil(t, appErr)
+		defer th.App.RemoveFile(watermarkedFilePath(info.Path, th.BasicUser2.Id))
+		assert.NotEqual(t, watermarked, other)
+	})
+
+	t.Run("previews", func(t *testing.T) {
+		preview, appErr := th.App.ReadFile(info.PreviewPath)
+		require.Nil(t, appErr)
+
+		watermarked, appErr := th.App.GetWatermarkedImage(info, info.PreviewPath, th.BasicUser)
+		require.Nil(t, appErr)
+		assert.NotEqual(t, preview, watermarked)
+
+		_, format, err := image.Decode(bytes.NewReader(watermarked))
+		require.NoError(t, err)
+		assert.Equal(t, "jpeg", format)
+	})
+
+	t.Run("variants are removed with their file", func(t *testing.T) {
+		th.App.removeWatermarkedFiles(info.Path, info.ThumbnailPath, info.PreviewPath)
+
+		for _, filePath := range []string{watermarkedFilePath(info.Path, th.BasicUser.Id), watermarkedFilePath(info.PreviewPath, th.BasicUser.Id)} {
+			exists, appErr := th.App.FileExists(filePath)
+			require.Nil(t, appErr)
+			assert.False(t, exists)
+		}
+		exists, appErr := th.App.FileExists(info.Path)
+		require.Nil(t, appErr)
+		assert.True(t, exists)
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		data, err := testutils.ReadTestFile("sample-doc.pdf")
+		require.NoError(t, err)
+
+		info, appErr := th.App.DoUploadFile(th.Context, time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "sample-doc.pdf", data)
+		require.Nil(t, appErr)
+		defer th.App.RemoveFile(info.Path)
+		defer th.App.RemoveFile(watermarkedFilePath(info.Path, th.BasicUser.Id))
+
+		watermarked, appErr := th.App.GetWatermarkedFile(info, th.BasicUser)
+		require.Nil(t, appErr)
+		assert.True(t, bytes.HasPrefix(watermarked, data))
+		assert.Greater(t, len(watermarked), len(data))
+	})
+
+	t.Run("corrupted pdf", func(t *testing.T) {
+		info, appErr := th.App.DoUploadFile(th.Context, time.Now(), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "broken.pdf", []byte("not a pdf"))
+		require.Nil(t, appErr)
+		defer th.App.RemoveFile(info.Path)
+
+		_, appErr = th.App.GetWatermarkedFile(info, th.BasicUser)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.file.watermark.app_error", appErr.Id)
+	})
+}
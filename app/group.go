@@ -215,6 +215,10 @@ func (a *App) DeleteGroup(groupID string) (*model.Group, *model.AppError) {
 		}
 	}
 
+	a.Srv().Go(func() {
+		a.reconcileTeamGroupRolesForGroup(groupID)
+	})
+
 	return deletedGroup, nil
 }
 
@@ -274,6 +278,10 @@ func (a *App) UpsertGroupMember(groupID string, userID string) (*model.GroupMemb
 
 	a.publishGroupMemberEvent(model.WebsocketEventGroupMemberAdd, groupMember)
 
+	a.Srv().Go(func() {
+		a.reconcileTeamGroupRolesForGroup(groupID)
+	})
+
 	return groupMember, nil
 }
 
@@ -291,6 +299,10 @@ func (a *App) DeleteGroupMember(groupID string, userID string) (*model.GroupMemb
 
 	a.publishGroupMemberEvent(model.WebsocketEventGroupMemberDelete, groupMember)
 
+	a.Srv().Go(func() {
+		a.reconcileTeamGroupRolesForGroup(groupID)
+	})
+
 	return groupMember, nil
 }
 
@@ -746,6 +758,10 @@ func (a *App) UpsertGroupMembers(groupID string, userIDs []string) ([]*model.Gro
 		a.publishGroupMemberEvent(model.WebsocketEventGroupMemberAdd, groupMember)
 	}
 
+	a.Srv().Go(func() {
+		a.reconcileTeamGroupRolesForGroup(groupID)
+	})
+
 	return members, nil
 }
 
@@ -768,6 +784,10 @@ func (a *App) DeleteGroupMembers(groupID string, userIDs []string) ([]*model.Gro
 		a.publishGroupMemberEvent(model.WebsocketEventGroupMemberDelete, groupMember)
 	}
 
+	a.Srv().Go(func() {
+		a.reconcileTeamGroupRolesForGroup(groupID)
+	})
+
 	return members, nil
 }
 
@@ -0,0 +1,152 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	idempotencyKeyCleanupInterval = time.Hour
+	idempotencyKeyCleanupBatch    = 1000
+
+	// idempotencyKeyReservationLease is how long a request may be processed before its key is
+	// considered abandoned, e.g. by a server that crashed, and can be reserved by a retry.
+	idempotencyKeyReservationLease = 5 * time.Minute
+)
+
+// ReserveIdempotencyKey records that the user is making the request with the given key. If the
+// key was already used, and hasn't expired, its record is returned instead so that the request
+// isn't processed twice. A key whose request has been processed for longer than its lease is
+// taken over by the new request.
+func (a *App) ReserveIdempotencyKey(userID, key, requestHash string) (*model.IdempotencyKey, *model.AppError) {
+	existing, appErr := a.getIdempotencyKey(userID, key)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if existing != nil {
+		if existing.IsCompleted() || existing.RequestHash != requestHash {
+			return existing, nil
+		}
+		reservedBefore := model.GetMillisForTime(time.Now().Add(-idempotencyKeyReservationLease))
+		deleted, err := a.Srv().Store.IdempotencyKey().DeleteStaleReservation(userID, key, reservedBefore)
+		if err != nil {
+			return nil, model.NewAppError("ReserveIdempotencyKey", "app.idempotency_key.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if !deleted {
+			return existing, nil
+		}
+	}
+
+	_, err := a.Srv().Store.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         key,
+		UserId:      userID,
+		RequestHash: requestHash,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			// A concurrent request with the same key won the race.
+			return a.getIdempotencyKey(userID, key)
+		default:
+			return nil, model.NewAppError("ReserveIdempotencyKey", "app.idempotency_key.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil, nil
+}
+
+func (a *App) getIdempotencyKey(userID, key string) (*model.IdempotencyKey, *model.AppError) {
+	existing, err := a.Srv().Store.IdempotencyKey().Get(userID, key)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, nil
+		default:
+			return nil, model.NewAppError("getIdempotencyKey", "app.idempotency_key.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if existing.CreateAt < a.idempotencyKeyExpiry() {
+		if appErr := a.ReleaseIdempotencyKey(userID, key); appErr != nil {
+			return nil, appErr
+		}
+		return nil, nil
+	}
+
+	return existing, nil
+}
+
+// CompleteIdempotencyKey records the response of the request made with the key, to be replayed
+// to its retries.
+func (a *App) CompleteIdempotencyKey(userID, key string, statusCode int, response string) *model.AppError {
+	reserved, err := a.Srv().Store.IdempotencyKey().Get(userID, key)
+	if err != nil {
+		return model.NewAppError("CompleteIdempotencyKey", "app.idempotency_key.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	reserved.StatusCode = statusCode
+	reserved.Response = response
+	if _, err := a.Srv().Store.IdempotencyKey().Update(reserved); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("CompleteIdempotencyKey", "app.idempotency_key.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey forgets the key, so that the request can be retried with it.
+func (a *App) ReleaseIdempotencyKey(userID, key string) *model.AppError {
+	if err := a.Srv().Store.IdempotencyKey().Delete(userID, key); err != nil {
+		return model.NewAppError("ReleaseIdempotencyKey", "app.idempotency_key.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+func (a *App) idempotencyKeyExpiry() int64 {
+	retention := time.Duration(*a.Config().ServiceSettings.IdempotencyKeyRetentionHours) * time.Hour
+	return model.GetMillisForTime(time.Now().Add(-retention))
+}
+
+func runIdempotencyKeyCleanupJob(s *Server) {
+	doIdempotencyKeyCleanup(s)
+	model.CreateRecurringTask("Idempotency Key Cleanup", func() {
+		doIdempotencyKeyCleanup(s)
+	}, idempotencyKeyCleanupInterval)
+}
+
+func doIdempotencyKeyCleanup(s *Server) {
+	retention := time.Duration(*s.Config().ServiceSettings.IdempotencyKeyRetentionHours) * time.Hour
+	endTime := model.GetMillisForTime(time.Now().Add(-retention))
+
+	mlog.Debug("Cleaning up idempotency key store.")
+
+	for {
+		deleted, err := s.Store.IdempotencyKey().PermanentDeleteBatch(endTime, idempotencyKeyCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up idempotency keys", mlog.Err(err))
+			return
+		}
+		if deleted < idempotencyKeyCleanupBatch {
+			return
+		}
+	}
+}
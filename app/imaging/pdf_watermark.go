@@ -0,0 +1,199 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// ErrPDFEncrypted is returned for the encrypted PDFs, which can't be watermarked.
+var ErrPDFEncrypted = errors.New("imaging: cannot watermark an encrypted pdf")
+
+var (
+	pdfRefRegexp       = regexp.MustCompile(`(\d+) (\d+) R`)
+	pdfRootRegexp      = regexp.MustCompile(`/Root (\d+ \d+ R)`)
+	pdfInfoRegexp      = regexp.MustCompile(`/Info (\d+ \d+ R)`)
+	pdfContentsRegexp  = regexp.MustCompile(`/Contents (\[[^\]]*\]|\d+ \d+ R)`)
+	pdfStartXrefRegexp = regexp.MustCompile(`startxref\s+(\d+)\s+%%EOF\s*$`)
+)
+
+type pdfRef struct {
+	id, gen int
+}
+
+func parsePDFRefs(s string) []pdfRef {
+	var refs []pdfRef
+	for _, m := range pdfRefRegexp.FindAllStringSubmatch(s, -1) {
+		id, _ := strconv.Atoi(m[1])
+		gen, _ := strconv.Atoi(m[2])
+		refs = append(refs, pdfRef{id, gen})
+	}
+	return refs
+}
+
+// WatermarkPDF returns the PDF with the mask drawn in light gray diagonally across every page.
+// The watermark is added as an incremental update rewriting the content streams of the pages,
+// so the rest of the document is kept as it is.
+func WatermarkPDF(data []byte, mask *image.Gray) (watermarked []byte, err error) {
+	// The pdf reader panics on malformed documents.
+	defer func() {
+		if r := recover(); r != nil {
+			watermarked = nil
+			err = fmt.Errorf("imaging: failed to read pdf: %v", r)
+		}
+	}()
+
+	r, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("imaging: failed to read pdf: %w", err)
+	}
+
+	trailer := r.Trailer()
+	if !trailer.Key("Encrypt").IsNull() {
+		return nil, ErrPDFEncrypted
+	}
+
+	match := pdfStartXrefRegexp.FindSubmatch(data)
+	if match == nil {
+		return nil, errors.New("imaging: failed to find the pdf cross-reference table")
+	}
+	prevXref := string(match[1])
+
+	root := pdfRootRegexp.FindStringSubmatch(trailer.String())
+	if root == nil {
+		return nil, errors.New("imaging: failed to find the pdf catalog")
+	}
+
+	stencil := encodePDFStencilMask(mask)
+	streams := map[pdfRef][]byte{}
+	for i := 1; i <= r.NumPage(); i++ {
+		page := r.Page(i).V
+		refs := parsePDFRefs(pdfContentsRegexp.FindString(page.String()))
+		// A page without contents is blank, and shares no stream the watermark can be added to.
+		if len(refs) == 0 {
+			continue
+		}
+		if _, ok := streams[refs[0]]; ok {
+			continue
+		}
+
+		contents := page.Key("Contents")
+		streamAt := func(i int) pdf.Value {
+			if contents.Kind() == pdf.Array {
+				return contents.Index(i)
+			}
+			return contents
+		}
+
+		first, err := ioutil.ReadAll(streamAt(0).Reader())
+		if err != nil {
+			return nil, fmt.Errorf("imaging: failed to read pdf contents: %w", err)
+		}
+		streams[refs[0]] = append([]byte("q\n"), first...)
+
+		lastRef := refs[len(refs)-1]
+		last := streams[lastRef]
+		if lastRef != refs[0] {
+			if last, err = ioutil.ReadAll(streamAt(len(refs) - 1).Reader()); err != nil {
+				return nil, fmt.Errorf("imaging: failed to read pdf contents: %w", err)
+			}
+		}
+		last = append(last, "\nQ\n"...)
+		streams[lastRef] = append(last, pdfWatermarkOperators(pdfMediaBox(page), mask.Bounds().Dx(), mask.Bounds().Dy(), stencil)...)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data)
+	if data[len(data)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	refs := make([]pdfRef, 0, len(streams))
+	for ref := range streams {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].id < refs[j].id })
+
+	offsets := make([]int, len(refs))
+	for i, ref := range refs {
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(streams[ref])
+		zw.Close()
+
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d %d obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", ref.id, ref.gen, compressed.Len())
+		buf.Write(compressed.Bytes())
+		buf.WriteString("\nendstream\nendobj\n")
+	}
+
+	xref := buf.Len()
+	buf.WriteString("xref\n")
+	for i, ref := range refs {
+		fmt.Fprintf(&buf, "%d 1\n%010d %05d n\r\n", ref.id, offsets[i], ref.gen)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root %s", trailer.Key("Size").Int64(), root[1])
+	if info := pdfInfoRegexp.FindStringSubmatch(trailer.String()); info != nil {
+		fmt.Fprintf(&buf, " /Info %s", info[1])
+	}
+	fmt.Fprintf(&buf, " /Prev %s >>\nstartxref\n%d\n%%%%EOF\n", prevXref, xref)
+
+	return buf.Bytes(), nil
+}
+
+// pdfMediaBox returns the bounds of the page, which may be inherited from the page tree.
+func pdfMediaBox(page pdf.Value) [4]float64 {
+	for node := page; !node.IsNull(); node = node.Key("Parent") {
+		if box := node.Key("MediaBox"); box.Len() == 4 {
+			return [4]float64{box.Index(0).Float64(), box.Index(1).Float64(), box.Index(2).Float64(), box.Index(3).Float64()}
+		}
+	}
+	// US Letter, the default of most PDF writers.
+	return [4]float64{0, 0, 612, 792}
+}
+
+// pdfWatermarkOperators paints the mask across the center of the page, rotated by 45 degrees
+// and scaled to most of the width of the page.
+func pdfWatermarkOperators(box [4]float64, width, height int, stencil string) string {
+	pageWidth, pageHeight := box[2]-box[0], box[3]-box[1]
+	scale := math.Min(pageWidth, pageHeight) * 0.8 / float64(width)
+	w, h := float64(width)*scale, float64(height)*scale
+	cos, sin := math.Cos(math.Pi/4), math.Sin(math.Pi/4)
+	cx, cy := box[0]+pageWidth/2, box[1]+pageHeight/2
+
+	return fmt.Sprintf("q 0.8 g %.4f %.4f %.4f %.4f %.4f %.4f cm\n%s\nQ\n",
+		w*cos, w*sin, -h*sin, h*cos,
+		cx-(w/2*cos-h/2*sin), cy-(w/2*sin+h/2*cos),
+		stencil)
+}
+
+// encodePDFStencilMask encodes the mask as an inline image mask, painting the dark pixels with
+// the fill color. Inline images need no resources, which would mean rewriting the pages.
+func encodePDFStencilMask(mask *image.Gray) string {
+	bounds := mask.Bounds()
+	rowBytes := (bounds.Dx() + 7) / 8
+	bits := make([]byte, rowBytes*bounds.Dy())
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			// The samples set to 1 are left unpainted.
+			if mask.GrayAt(bounds.Min.X+x, bounds.Min.Y+y).Y >= 128 {
+				bits[y*rowBytes+x/8] |= 0x80 >> uint(x%8)
+			}
+		}
+	}
+
+	return fmt.Sprintf("BI /IM true /W %d /H %d /BPC 1 /F /AHx ID\n%s>\nEI", bounds.Dx(), bounds.Dy(), hex.EncodeToString(bits))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/disintegration/imaging"
+	"github.com/golang/freetype"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+)
+
+// watermarkColor is light enough for the image to stay readable under the watermark.
+var watermarkColor = color.NRGBA{R: 128, G: 128, B: 128, A: 96}
+
+// Watermark returns a copy of the image with the text drawn over it, repeated in staggered rows
+// across the whole image so that cropping it doesn't remove the watermark.
+func Watermark(img image.Image, text string, ttf *truetype.Font) (*image.NRGBA, error) {
+	dst := imaging.Clone(img)
+	bounds := dst.Bounds()
+
+	size := float64(bounds.Dy()) / 12
+	if w := float64(bounds.Dx()) / 12; w < size {
+		size = w
+	}
+	if size < 12 {
+		size = 12
+	}
+
+	textWidth := measureText(text, ttf, size)
+	if textWidth == 0 {
+		return dst, nil
+	}
+	stepX := textWidth + int(size)*2
+	stepY := int(size) * 4
+
+	c := newTextContext(ttf, size, dst, image.NewUniform(watermarkColor))
+	for row, y := 0, bounds.Min.Y+int(size); y < bounds.Max.Y+stepY; row, y = row+1, y+stepY {
+		for x := bounds.Min.X - (row%2)*stepX/2; x < bounds.Max.X; x += stepX {
+			if _, err := c.DrawString(text, freetype.Pt(x, y)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return dst, nil
+}
+
+// TextMask renders the text in black on white, fitting the text tightly, for the watermarks
+// of the documents which can't be drawn on directly.
+func TextMask(text string, ttf *truetype.Font, size float64) (*image.Gray, error) {
+	width := measureText(text, ttf, size)
+	height := int(size * 1.4)
+	if width == 0 {
+		width = 1
+	}
+
+	mask := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(mask, mask.Bounds(), image.White, image.Point{}, draw.Src)
+
+	c := newTextContext(ttf, size, mask, image.Black)
+	if _, err := c.DrawString(text, freetype.Pt(0, int(size*1.1))); err != nil {
+		return nil, err
+	}
+
+	return mask, nil
+}
+
+func newTextContext(ttf *truetype.Font, size float64, dst draw.Image, src image.Image) *freetype.Context {
+	c := freetype.NewContext()
+	c.SetFont(ttf)
+	c.SetFontSize(size)
+	c.SetClip(dst.Bounds())
+	c.SetDst(dst)
+	c.SetSrc(src)
+	return c
+}
+
+func measureText(text string, ttf *truetype.Font, size float64) int {
+	face := truetype.NewFace(ttf, &truetype.Options{Size: size})
+	defer face.Close()
+	return font.MeasureString(face, text).Ceil()
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/freetype"
+	"github.com/golang/freetype/truetype"
+	"github.com/ledongthuc/pdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/utils/fileutils"
+)
+
+func loadTestFont(t *testing.T) *truetype.Font {
+	t.Helper()
+
+	fontDir, ok := fileutils.FindDir("fonts")
+	require.True(t, ok)
+	fontBytes, err := ioutil.ReadFile(filepath.Join(fontDir, "nunito-bold.ttf"))
+	require.NoError(t, err)
+	ttf, err := freetype.ParseFont(fontBytes)
+	require.NoError(t, err)
+	return ttf
+}
+
+func TestWatermark(t *testing.T) {
+	ttf := loadTestFont(t)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 400, 300))
+	for i := range img.Pix {
+		img.Pix[i] = 255
+	}
+
+	watermarked, err := Watermark(img, "someuser", ttf)
+	require.NoError(t, err)
+	require.Equal(t, img.Bounds(), watermarked.Bounds())
+
+	changed := 0
+	for y := 0; y < 300; y++ {
+		for x := 0; x < 400; x++ {
+			if watermarked.NRGBAAt(x, y) != (color.NRGBA{255, 255, 255, 255}) {
+				changed++
+			}
+		}
+	}
+	assert.NotZero(t, changed)
+	// The original is left untouched.
+	assert.Equal(t, color.NRGBA{255, 255, 255, 255}, img.NRGBAAt(200, 150))
+}
+
+func TestTextMask(t *testing.T) {
+	mask, err := TextMask("someuser", loadTestFont(t), 32)
+	require.NoError(t, err)
+	assert.Greater(t, mask.Bounds().Dx(), mask.Bounds().Dy())
+
+	dark := 0
+	for _, y := range mask.Pix {
+		if y < 128 {
+			dark++
+		}
+	}
+	assert.NotZero(t, dark)
+}
+
+func TestWatermarkPDF(t *testing.T) {
+	mask, err := TextMask("someuser", loadTestFont(t), 32)
+	require.NoError(t, err)
+
+	testDir, ok := fileutils.FindDir("tests")
+	require.True(t, ok)
+	data, err := ioutil.ReadFile(filepath.Join(testDir, "sample-doc.pdf"))
+	require.NoError(t, err)
+
+	watermarked, err := WatermarkPDF(data, mask)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(watermarked, data), "the watermark should be an incremental update")
+
+	r, err := pdf.NewReader(bytes.NewReader(watermarked), int64(len(watermarked)))
+	require.NoError(t, err)
+	original, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Equal(t, original.NumPage(), r.NumPage())
+
+	for i := 1; i <= r.NumPage(); i++ {
+		contents := r.Page(i).V.Key("Contents")
+		if contents.Kind() == pdf.Array {
+			contents = contents.Index(contents.Len() - 1)
+		}
+		b, err := ioutil.ReadAll(contents.Reader())
+		require.NoError(t, err)
+		assert.True(t, strings.Contains(string(b), "BI /IM true"), "page %d should be watermarked", i)
+	}
+
+	t.Run("not a pdf", func(t *testing.T) {
+		_, err := WatermarkPDF([]byte("not a pdf"), mask)
+		require.Error(t, err)
+	})
+
+	t.Run("truncated pdf", func(t *testing.T) {
+		_, err := WatermarkPDF(data[:len(data)/2], mask)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// CreateImpersonation records the request of a system admin to impersonate a user. Without
+// the need for the user's consent, the session is started right away and returned along with
+// its token. Otherwise the user is asked for their consent by a direct message.
+func (a *App) CreateImpersonation(c *request.Context, impersonation *model.Impersonation) (*model.Impersonation, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableImpersonation {
+		return nil, model.NewAppError("CreateImpersonation", "app.impersonation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	maxDuration := *a.Config().ServiceSettings.MaxImpersonationDurationMinutes
+	if impersonation.DurationMinutes == 0 {
+		impersonation.DurationMinutes = maxDuration
+	}
+	if impersonation.DurationMinutes > maxDuration {
+		return nil, model.NewAppError("CreateImpersonation", "app.impersonation.create.duration.app_error", map[string]interface{}{"MaxDuration": maxDuration}, "", http.StatusBadRequest)
+	}
+
+	if *a.Config().ServiceSettings.RequireImpersonationConsent {
+		impersonation.RequireConsent = true
+	}
+
+	user, appErr := a.GetUser(impersonation.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	// Other admins, bots and deactivated users can't be impersonated.
+	if user.IsSystemAdmin() || user.IsBot || user.DeleteAt != 0 {
+		return nil, model.NewAppError("CreateImpersonation", "app.impersonation.create.user.app_error", nil, "user_id="+user.Id, http.StatusBadRequest)
+	}
+
+	impersonation, err := a.Srv().Store.Impersonation().Save(impersonation)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateImpersonation", "app.impersonation.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateImpersonation", "app.impersonation.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if impersonation.RequireConsent {
+		a.Srv().Go(func() {
+			if appErr := a.notifyImpersonation(c, impersonation, impersonation.UserId, impersonation.ImpersonatorId, "app.impersonation.notification.requested"); appErr != nil {
+				mlog.Warn("Failed to ask a user for their consent to be impersonated", mlog.String("impersonation_id", impersonation.Id), mlog.Err(appErr))
+			}
+		})
+		return impersonation, nil
+	}
+
+	return a.StartImpersonation(c, impersonation.Id)
+}
+
+func (a *App) GetImpersonation(impersonationID string) (*model.Impersonation, *model.AppError) {
+	impersonation, err := a.Srv().Store.Impersonation().Get(impersonationID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetImpersonation", "app.impersonation.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetImpersonation", "app.impersonation.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return impersonation, nil
+}
+
+// GetImpersonationsForUser returns a page of the impersonations of the user, newest first.
+func (a *App) GetImpersonationsForUser(userID string, page, perPage int) ([]*model.Impersonation, *model.AppError) {
+	impersonations, err := a.Srv().Store.Impersonation().GetForUser(userID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetImpersonationsForUser", "app.impersonation.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, impersonation := range impersonations {
+		impersonation.Sanitize()
+	}
+
+	return impersonations, nil
+}
+
+// AnswerImpersonationConsent records whether the user approves being impersonated, and lets
+// the admin who asked know.
+func (a *App) AnswerImpersonationConsent(c *request.Context, impersonationID string, approve bool) (*model.Impersonation, *model.AppError) {
+	impersonation, appErr := a.GetImpersonation(impersonationID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	messageID := "app.impersonation.notification.declined"
+	impersonation.Status = model.ImpersonationStatusDeclined
+	if approve {
+		messageID = "app.impersonation.notification.approved"
+		impersonation.Status = model.ImpersonationStatusApproved
+	}
+
+	impersonation, appErr = a.updateImpersonationStatus(impersonation, model.ImpersonationStatusPending)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.Srv().Go(func() {
+		if appErr := a.notifyImpersonation(c, impersonation, impersonation.ImpersonatorId, impersonation.UserId, messageID); appErr != nil {
+			mlog.Warn("Failed to notify an admin of the answer to an impersonation", mlog.String("impersonation_id", impersonation.Id), mlog.Err(appErr))
+		}
+	})
+
+	return impersonation, nil
+}
+
+// StartImpersonation creates the session of an approved impersonation, lasting for its duration,
+// and lets the user know. The token of the session is only returned here.
+func (a *App) StartImpersonation(c *request.Context, impersonationID string) (*model.Impersonation, *model.AppError) {
+	impersonation, appErr := a.GetImpersonation(impersonationID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if impersonation.Status != model.ImpersonationStatusApproved {
+		return nil, model.NewAppError("StartImpersonation", "app.impersonation.start.not_approved.app_error", nil, "id="+impersonationID, http.StatusBadRequest)
+	}
+
+	user, appErr := a.GetUser(impersonation.UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	now := model.GetMillis()
+	session, appErr := a.CreateSession(&model.Session{
+		UserId:    user.Id,
+		Roles:     user.GetRawRoles(),
+		ExpiresAt: now + int64(impersonation.DurationMinutes)*60*1000,
+		Props: model.StringMap{
+			model.SessionPropType:            model.SessionTypeImpersonation,
+			model.SessionPropImpersonationId: impersonation.Id,
+			model.SessionPropImpersonatorId:  impersonation.ImpersonatorId,
+		},
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	impersonation.Status = model.ImpersonationStatusActive
+	impersonation.SessionId = session.Id
+	impersonation.StartAt = now
+	impersonation.ExpiresAt = session.ExpiresAt
+
+	impersonation, appErr = a.updateImpersonationStatus(impersonation, model.ImpersonationStatusApproved)
+	if appErr != nil {
+		if revokeErr := a.RevokeSession(session); revokeErr != nil {
+			mlog.Warn("Failed to revoke the session of an impersonation", mlog.String("session_id", session.Id), mlog.Err(revokeErr))
+		}
+		return nil, appErr
+	}
+
+	a.Srv().Go(func() {
+		if appErr := a.notifyImpersonation(c, impersonation, impersonation.UserId, impersonation.ImpersonatorId, "app.impersonation.notification.started"); appErr != nil {
+			mlog.Warn("Failed to notify a user of their impersonation", mlog.String("impersonation_id", impersonation.Id), mlog.Err(appErr))
+		}
+	})
+
+	impersonation.Token = session.Token
+	return impersonation, nil
+}
+
+// EndImpersonation ends an impersonation before its session expires, or withdraws it before it
+// starts, revoking its session.
+func (a *App) EndImpersonation(impersonationID string) (*model.Impersonation, *model.AppError) {
+	impersonation, appErr := a.GetImpersonation(impersonationID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	currentStatus := impersonation.Status
+	switch {
+	case impersonation.IsExpired():
+		return nil, model.NewAppError("EndImpersonation", "app.impersonation.end.expired.app_error", nil, "id="+impersonationID, http.StatusBadRequest)
+	case currentStatus != model.ImpersonationStatusPending && currentStatus != model.ImpersonationStatusApproved && currentStatus != model.ImpersonationStatusActive:
+		return nil, model.NewAppError("EndImpersonation", "app.impersonation.end.not_started.app_error", nil, "id="+impersonationID, http.StatusBadRequest)
+	}
+
+	impersonation.Status = model.ImpersonationStatusEnded
+	impersonation.EndAt = model.GetMillis()
+
+	impersonation, appErr = a.updateImpersonationStatus(impersonation, currentStatus)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if impersonation.SessionId != "" {
+		if appErr := a.RevokeSessionById(impersonation.SessionId); appErr != nil {
+			mlog.Warn("Failed to revoke the session of an impersonation", mlog.String("impersonation_id", impersonation.Id), mlog.Err(appErr))
+		}
+	}
+
+	return impersonation, nil
+}
+
+func (a *App) updateImpersonationStatus(impersonation *model.Impersonation, currentStatus string) (*model.Impersonation, *model.AppError) {
+	impersonation, err := a.Srv().Store.Impersonation().UpdateStatus(impersonation, currentStatus)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("updateImpersonationStatus", "app.impersonation.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("updateImpersonationStatus", "app.impersonation.update.status_changed.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("updateImpersonationStatus", "app.impersonation.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return impersonation, nil
+}
+
+// notifyImpersonation sends a direct message from the system bot about the impersonation to
+// the recipient, naming the other user it involves.
+func (a *App) notifyImpersonation(c *request.Context, impersonation *model.Impersonation, recipientID, otherUserID, messageID string) *model.AppError {
+	recipient, appErr := a.GetUser(recipientID)
+	if appErr != nil {
+		return appErr
+	}
+
+	otherUser, appErr := a.GetUser(otherUserID)
+	if appErr != nil {
+		return appErr
+	}
+
+	T := i18n.GetUserTranslations(recipient.Locale)
+	message := T(messageID, map[string]interface{}{
+		"Username": otherUser.Username,
+		"Duration": impersonation.DurationMinutes,
+		"Reason":   impersonation.Reason,
+	})
+
+	return a.sendSystemBotDirectMessage(c, recipient.Id, message)
+}
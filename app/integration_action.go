@@ -13,7 +13,8 @@
 // 6. If that optional request is made, OpenInteractiveDialog sends a WebSocket event to all connected clients
 // for the relevant user, telling them to display the dialog.
 // 7. The user fills in the dialog and submits it, where SubmitInteractiveDialog will submit it back to the
-// integration for handling.
+// integration for handling. The steps of a multi-step dialog may first be validated by the integration,
+// one after the other, through ValidateInteractiveDialogStep.
 
 package app
 
@@ -586,6 +587,10 @@ func (a *App) OpenInteractiveDialog(request model.OpenDialogRequest) *model.AppE
 		return err
 	}
 
+	if err := request.Dialog.IsValid(); err != nil {
+		return err
+	}
+
 	request.TriggerId = clientTriggerId
 
 	jsonRequest, _ := json.Marshal(request)
@@ -598,9 +603,26 @@ func (a *App) OpenInteractiveDialog(request model.OpenDialogRequest) *model.AppE
 }
 
 func (a *App) SubmitInteractiveDialog(c *request.Context, request model.SubmitDialogRequest) (*model.SubmitDialogResponse, *model.AppError) {
+	request.Type = model.SubmitDialogRequestTypeSubmission
+	return a.doDialogRequest(c, request)
+}
+
+// ValidateInteractiveDialogStep has the integration validate a step of a multi-step dialog
+// before the next one is shown, the errors of the response being shown on the step.
+func (a *App) ValidateInteractiveDialogStep(c *request.Context, request model.SubmitDialogRequest) (*model.SubmitDialogResponse, *model.AppError) {
+	if request.Step < 0 || request.Step >= model.DialogMaxSteps {
+		return nil, model.NewAppError("ValidateInteractiveDialogStep", "app.submit_interactive_dialog.step.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	request.Type = model.SubmitDialogRequestTypeValidation
+	request.Cancelled = false
+	return a.doDialogRequest(c, request)
+}
+
+// doDialogRequest sends the request about a dialog to the URL of the integration.
+func (a *App) doDialogRequest(c *request.Context, request model.SubmitDialogRequest) (*model.SubmitDialogResponse, *model.AppError) {
 	url := request.URL
 	request.URL = ""
-	request.Type = "dialog_submission"
 
 	b, jsonErr := json.Marshal(request)
 	if jsonErr != nil {
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// auditIntegrationUse records the use of an integration by the user. A failure to record it
+// doesn't fail the use of the integration.
+func (a *App) auditIntegrationUse(c *request.Context, userID, action, extraInfo string) {
+	audit := &model.Audit{
+		UserId:    userID,
+		Action:    action,
+		ExtraInfo: extraInfo,
+		IpAddress: c.IPAddress(),
+		SessionId: c.Session().Id,
+	}
+	if err := a.Srv().Store.Audit().Save(audit); err != nil {
+		mlog.Warn("Failed to record the audit of an integration", mlog.String("action", action), mlog.Err(err))
+	}
+}
+
+// auditCommandExecution records the execution of the command, the integration being the plugin
+// that registered it, or the custom command itself. Built-in commands have no integration id.
+func (a *App) auditCommandExecution(c *request.Context, cmd *model.Command, args *model.CommandArgs, trigger string) {
+	integrationID := cmd.Id
+	if cmd.PluginId != "" {
+		integrationID = cmd.PluginId
+	}
+
+	extraInfo := model.IntegrationAuditExtraInfo(integrationID, args.ChannelId, "/"+trigger, []byte(args.Command))
+	a.auditIntegrationUse(c, args.UserId, model.AuditActionCommandExecuted, extraInfo)
+}
+
+// GetIntegrationAudits returns a page of the audits of the uses of the integrations of the type,
+// or of all the integrations when empty, limited to the integration when integrationID is set.
+func (a *App) GetIntegrationAudits(integrationType, integrationID string, page, perPage int) (model.Audits, *model.AppError) {
+	actions := model.IntegrationAuditActions(integrationType)
+	if actions == nil {
+		return nil, model.NewAppError("GetIntegrationAudits", "app.audit.integration_type.app_error", map[string]interface{}{"Type": integrationType}, "", http.StatusBadRequest)
+	}
+
+	prefix := ""
+	if integrationID != "" {
+		prefix = model.IntegrationAuditPrefix(integrationID)
+	}
+
+	audits, err := a.Srv().Store.Audit().GetByActions(actions, prefix, page*perPage, perPage)
+	if err != nil {
+		var outErr *store.ErrOutOfBounds
+		switch {
+		case errors.As(err, &outErr):
+			return nil, model.NewAppError("GetIntegrationAudits", "app.audit.get.limit.app_error", nil, err.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("GetIntegrationAudits", "app.audit.get.finding.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return audits, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestIntegrationAudits(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = true })
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id})
+	require.Nil(t, appErr)
+	defer th.App.DeleteIncomingWebhook(hook.Id)
+
+	args := &model.CommandArgs{
+		TeamId:    th.BasicTeam.Id,
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser.Id,
+		Command:   "/echo hello",
+		T:         func(s string, args ...interface{}) string { return s },
+	}
+	_, appErr = th.App.ExecuteCommand(th.Context, args)
+	require.Nil(t, appErr)
+
+	appErr = th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{Text: "hello"})
+	require.Nil(t, appErr)
+
+	audits, appErr := th.App.GetIntegrationAudits("", "", 0, 100)
+	require.Nil(t, appErr)
+	require.Len(t, audits, 2)
+	assert.Equal(t, model.AuditActionIncomingWebhookPosted, audits[0].Action)
+	assert.Equal(t, model.AuditActionCommandExecuted, audits[1].Action)
+
+	audits, appErr = th.App.GetIntegrationAudits(model.IntegrationTypeCommand, "", 0, 100)
+	require.Nil(t, appErr)
+	require.Len(t, audits, 1)
+	assert.Equal(t, th.BasicUser.Id, audits[0].UserId)
+	assert.Contains(t, audits[0].ExtraInfo, "command=/echo")
+
+	audits, appErr = th.App.GetIntegrationAudits(model.IntegrationTypeIncomingWebhook, hook.Id, 0, 100)
+	require.Nil(t, appErr)
+	require.Len(t, audits, 1)
+	assert.True(t, strings.HasPrefix(audits[0].ExtraInfo, model.IntegrationAuditPrefix(hook.Id)))
+	assert.Contains(t, audits[0].ExtraInfo, "channel_id="+th.BasicChannel.Id)
+
+	audits, appErr = th.App.GetIntegrationAudits(model.IntegrationTypeIncomingWebhook, model.NewId(), 0, 100)
+	require.Nil(t, appErr)
+	assert.Empty(t, audits)
+
+	_, appErr = th.App.GetIntegrationAudits("outgoing_webhook", "", 0, 100)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.audit.integration_type.app_error", appErr.Id)
+}
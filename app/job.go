@@ -76,7 +76,7 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 	switch job.Type {
 	case model.JobTypeBlevePostIndexing:
 		return a.SessionHasPermissionTo(session, model.PermissionCreatePostBleveIndexesJob), model.PermissionCreatePostBleveIndexesJob
-	case model.JobTypeDataRetention:
+	case model.JobTypeDataRetention, model.JobTypeDataRetentionPreview:
 		return a.SessionHasPermissionTo(session, model.PermissionCreateDataRetentionJob), model.PermissionCreateDataRetentionJob
 	case model.JobTypeMessageExport:
 		return a.SessionHasPermissionTo(session, model.PermissionCreateComplianceExportJob), model.PermissionCreateComplianceExportJob
@@ -97,7 +97,27 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeChannelDigest,
+		model.JobTypeDirectChannelRetention,
+		model.JobTypeTeamStatsRollup,
+		model.JobTypeUserMerge,
+		model.JobTypeTeamDeletion,
+		model.JobTypeChannelAutoArchive,
+		model.JobTypeBotTokenRotation,
+		model.JobTypeSlackImport,
+		model.JobTypeScheduledChannelMessages,
+		model.JobTypeEventWebhookDeliveries,
+		model.JobTypeAlertRules,
+		model.JobTypeChannelLanguageStatsRollup,
+		model.JobTypeChannelMemberBulkAdd,
+		model.JobTypeFileResidencyMigration,
+		model.JobTypeLicenseUsageRollup,
+		model.JobTypeRESTProxyBridge,
+		model.JobTypeChannelFeeds,
+		model.JobTypeOutstandingMentions,
+		model.JobTypeFileDeduplication,
+		model.JobTypeTeamGroupRoleSync:
 		return a.SessionHasPermissionTo(session, model.PermissionManageJobs), model.PermissionManageJobs
 	}
 
@@ -106,7 +126,7 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 
 func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType string) (bool, *model.Permission) {
 	switch jobType {
-	case model.JobTypeDataRetention:
+	case model.JobTypeDataRetention, model.JobTypeDataRetentionPreview:
 		return a.SessionHasPermissionTo(session, model.PermissionReadDataRetentionJob), model.PermissionReadDataRetentionJob
 	case model.JobTypeMessageExport:
 		return a.SessionHasPermissionTo(session, model.PermissionReadComplianceExportJob), model.PermissionReadComplianceExportJob
@@ -128,7 +148,28 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeSchemeAssignment,
+		model.JobTypeChannelDigest,
+		model.JobTypeDirectChannelRetention,
+		model.JobTypeTeamStatsRollup,
+		model.JobTypeUserMerge,
+		model.JobTypeTeamDeletion,
+		model.JobTypeChannelAutoArchive,
+		model.JobTypeBotTokenRotation,
+		model.JobTypeSlackImport,
+		model.JobTypeScheduledChannelMessages,
+		model.JobTypeEventWebhookDeliveries,
+		model.JobTypeAlertRules,
+		model.JobTypeChannelLanguageStatsRollup,
+		model.JobTypeChannelMemberBulkAdd,
+		model.JobTypeFileResidencyMigration,
+		model.JobTypeLicenseUsageRollup,
+		model.JobTypeRESTProxyBridge,
+		model.JobTypeChannelFeeds,
+		model.JobTypeOutstandingMentions,
+		model.JobTypeFileDeduplication,
+		model.JobTypeTeamGroupRoleSync:
 		return a.SessionHasPermissionTo(session, model.PermissionReadJobs), model.PermissionReadJobs
 	}
 
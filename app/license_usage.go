@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// RollupLicenseUsage computes the usage of the month containing the last full day, so that the
+// usage of a month is last computed on the first night of the next month.
+func (a *App) RollupLicenseUsage() *model.AppError {
+	month := model.LicenseUsageMonth(time.Now().AddDate(0, 0, -1))
+
+	if _, err := a.Srv().Store.LicenseUsage().RollupMonth(month); err != nil {
+		return model.NewAppError("RollupLicenseUsage", "app.license_usage.rollup.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	mlog.Debug("Rolled up the license usage", mlog.Int64("month", month))
+	return nil
+}
+
+// GetLicenseUsage returns the usage of the given number of months up to the current one, oldest
+// first. The months that weren't rolled up are left out.
+func (a *App) GetLicenseUsage(months int) (model.LicenseUsageList, *model.AppError) {
+	until := model.AddLicenseUsageMonths(model.LicenseUsageMonth(time.Now()), 1)
+	since := model.AddLicenseUsageMonths(until, -months)
+
+	usage, err := a.Srv().Store.LicenseUsage().GetForMonths(since, until)
+	if err != nil {
+		return nil, model.NewAppError("GetLicenseUsage", "app.license_usage.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return usage, nil
+}
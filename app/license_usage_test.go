@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRollupLicenseUsage(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	require.Nil(t, th.App.RollupLicenseUsage())
+
+	usage, appErr := th.App.GetLicenseUsage(2)
+	require.Nil(t, appErr)
+	require.Len(t, usage, 1)
+	assert.Equal(t, model.LicenseUsageMonth(time.Now().AddDate(0, 0, -1)), usage[0].Month)
+	assert.NotZero(t, usage[0].UpdateAt)
+
+	// Months older than the requested ones are left out.
+	_, err := th.App.Srv().Store.LicenseUsage().RollupMonth(model.AddLicenseUsageMonths(usage[0].Month, -3))
+	require.NoError(t, err)
+
+	usage, appErr = th.App.GetLicenseUsage(2)
+	require.Nil(t, appErr)
+	assert.Len(t, usage, 1)
+
+	usage, appErr = th.App.GetLicenseUsage(model.LicenseUsageMaxMonths)
+	require.Nil(t, appErr)
+	assert.Len(t, usage, 2)
+}
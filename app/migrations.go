@@ -17,6 +17,8 @@ const GuestRolesCreationMigrationKey = "GuestRolesCreationMigrationComplete"
 const SystemConsoleRolesCreationMigrationKey = "SystemConsoleRolesCreationMigrationComplete"
 const ContentExtractionConfigDefaultTrueMigrationKey = "ContentExtractionConfigDefaultTrueMigrationComplete"
 const PlaybookRolesCreationMigrationKey = "PlaybookRolesCreationMigrationComplete"
+const SystemViewerRolesCreationMigrationKey = "SystemViewerRolesCreationMigrationComplete"
+const TeamAllowedDomainsCanonicalizationMigrationKey = "TeamAllowedDomainsCanonicalizationMigrationComplete"
 const FirstAdminSetupCompleteKey = model.SystemFirstAdminSetupComplete
 
 // This function migrates the default built in roles from code/config to the database.
@@ -435,6 +437,84 @@ func (s *Server) doPlaybooksRolesCreationMigration() {
 
 }
 
+func (s *Server) doSystemViewerRolesCreationMigration() {
+	// If the migration is already marked as completed, don't do it again.
+	if _, err := s.Store.System().GetByName(SystemViewerRolesCreationMigrationKey); err == nil {
+		return
+	}
+
+	roles := model.MakeDefaultRoles()
+
+	allSucceeded := true
+	for _, roleID := range []string{model.SystemBillingViewerRoleId, model.SystemComplianceViewerRoleId} {
+		if _, err := s.Store.Role().GetByName(context.Background(), roleID); err != nil {
+			if _, err := s.Store.Role().Save(roles[roleID]); err != nil {
+				mlog.Critical("Failed to create new role.", mlog.Err(err), mlog.String("role", roleID))
+				allSucceeded = false
+			}
+		}
+	}
+
+	if !allSucceeded {
+		return
+	}
+
+	system := model.System{
+		Name:  SystemViewerRolesCreationMigrationKey,
+		Value: "true",
+	}
+
+	if err := s.Store.System().Save(&system); err != nil {
+		mlog.Critical("Failed to mark system viewer roles creation migration as completed.", mlog.Err(err))
+	}
+}
+
+// doTeamAllowedDomainsCanonicalizationMigration stores the allowed domains of the teams in their
+// canonical form. Entries that can't be parsed are kept, split the way they used to be, and logged.
+func (s *Server) doTeamAllowedDomainsCanonicalizationMigration() {
+	// If the migration is already marked as completed, don't do it again.
+	if _, err := s.Store.System().GetByName(TeamAllowedDomainsCanonicalizationMigrationKey); err == nil {
+		return
+	}
+
+	teams, err := s.Store.Team().GetAll()
+	if err != nil {
+		mlog.Critical("Failed to get the teams to canonicalize their allowed domains.", mlog.Err(err))
+		return
+	}
+
+	allSucceeded := true
+	for _, team := range teams {
+		canonical := model.CanonicalAllowedDomains(team.AllowedDomains)
+		if canonical == team.AllowedDomains {
+			continue
+		}
+
+		if validation := model.ValidateAllowedDomains(team.AllowedDomains); !validation.Valid {
+			mlog.Warn("Team has allowed domains that can't be parsed, they need to be fixed by an admin.", mlog.String("team_id", team.Id), mlog.String("allowed_domains", team.AllowedDomains))
+		}
+
+		team.AllowedDomains = canonical
+		if _, err := s.Store.Team().Update(team); err != nil {
+			mlog.Critical("Failed to canonicalize the allowed domains of a team.", mlog.String("team_id", team.Id), mlog.Err(err))
+			allSucceeded = false
+		}
+	}
+
+	if !allSucceeded {
+		return
+	}
+
+	system := model.System{
+		Name:  TeamAllowedDomainsCanonicalizationMigrationKey,
+		Value: "true",
+	}
+
+	if err := s.Store.System().Save(&system); err != nil {
+		mlog.Critical("Failed to mark team allowed domains canonicalization migration as completed.", mlog.Err(err))
+	}
+}
+
 // arbitrary choice, though if there is an longstanding installation with less than 10 messages,
 // putting the first admin through onboarding shouldn't be very disruptive.
 const existingInstallationPostsThreshold = 10
@@ -496,5 +576,7 @@ func (s *Server) doAppMigrations() {
 	}
 	s.doContentExtractionConfigDefaultTrueMigration()
 	s.doPlaybooksRolesCreationMigration()
+	s.doSystemViewerRolesCreationMigration()
 	s.doFirstAdminSetupCompleteMigration()
+	s.doTeamAllowedDomainsCanonicalizationMigration()
 }
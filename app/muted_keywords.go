@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (a *App) GetMutedKeywords(userID string) (model.MutedKeywords, *model.AppError) {
+	preferences, err := a.Srv().Store.Preference().GetForUsers([]string{userID}, model.PreferenceCategoryNotifications, model.PreferenceNameMutedKeywords)
+	if err != nil {
+		return nil, model.NewAppError("GetMutedKeywords", "app.preference.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if len(preferences) == 0 {
+		return model.MutedKeywords{}, nil
+	}
+
+	return mutedKeywordsFromPreference(&preferences[0])
+}
+
+func mutedKeywordsFromPreference(preference *model.Preference) (model.MutedKeywords, *model.AppError) {
+	var keywords model.MutedKeywords
+	if err := json.Unmarshal([]byte(preference.Value), &keywords); err != nil {
+		return nil, model.NewAppError("mutedKeywordsFromPreference", "model.preference.is_valid.muted_keywords.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return keywords, nil
+}
+
+// UpdateMutedKeywords replaces the muted keywords of the user. An empty list unmutes
+// everything.
+func (a *App) UpdateMutedKeywords(userID string, keywords model.MutedKeywords) (model.MutedKeywords, *model.AppError) {
+	keywords = keywords.Normalize()
+	if len(keywords) == 0 {
+		if appErr := a.DeleteMutedKeywords(userID); appErr != nil {
+			return nil, appErr
+		}
+		return keywords, nil
+	}
+
+	if appErr := keywords.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	value, err := json.Marshal(keywords)
+	if err != nil {
+		return nil, model.NewAppError("UpdateMutedKeywords", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	preference := model.Preference{
+		UserId:   userID,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameMutedKeywords,
+		Value:    string(value),
+	}
+	if appErr := a.UpdatePreferences(userID, model.Preferences{preference}); appErr != nil {
+		return nil, appErr
+	}
+
+	return keywords, nil
+}
+
+// AddMutedKeywords adds keywords to the ones already muted by the user.
+func (a *App) AddMutedKeywords(userID string, keywords model.MutedKeywords) (model.MutedKeywords, *model.AppError) {
+	existing, appErr := a.GetMutedKeywords(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return a.UpdateMutedKeywords(userID, append(existing, keywords...))
+}
+
+// DeleteMutedKeywords unmutes all the keywords muted by the user.
+func (a *App) DeleteMutedKeywords(userID string) *model.AppError {
+	return a.DeletePreferences(userID, model.Preferences{{
+		UserId:   userID,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameMutedKeywords,
+	}})
+}
+
+// getUsersMutingPost returns which of the given users muted a keyword the post contains.
+func (a *App) getUsersMutingPost(post *model.Post, userIDs []string) map[string]bool {
+	muting := map[string]bool{}
+	if post.Message == "" || len(userIDs) == 0 {
+		return muting
+	}
+
+	preferences, err := a.Srv().Store.Preference().GetForUsers(userIDs, model.PreferenceCategoryNotifications, model.PreferenceNameMutedKeywords)
+	if err != nil {
+		mlog.Warn("Failed to get the muted keywords of the users to notify", mlog.String("post_id", post.Id), mlog.Err(err))
+		return muting
+	}
+
+	for i := range preferences {
+		keywords, appErr := mutedKeywordsFromPreference(&preferences[i])
+		if appErr != nil {
+			mlog.Warn("Failed to decode muted keywords", mlog.String("user_id", preferences[i].UserId), mlog.Err(appErr))
+			continue
+		}
+		if len(keywords.Match(post.Message)) > 0 {
+			muting[preferences[i].UserId] = true
+		}
+	}
+
+	return muting
+}
+
+// getMutedKeywordsForSanitizing returns the muted keywords of a user posts are being
+// prepared for. Failing to get them must not prevent the user from reading posts.
+func (a *App) getMutedKeywordsForSanitizing(userID string) model.MutedKeywords {
+	keywords, appErr := a.GetMutedKeywords(userID)
+	if appErr != nil {
+		mlog.Warn("Failed to get muted keywords", mlog.String("user_id", userID), mlog.Err(appErr))
+		return nil
+	}
+	return keywords
+}
+
+// flagMutedKeywords returns the post with, in its metadata, the muted keywords of the user
+// it contains. The post is copied so that the flag doesn't leak to other users.
+func flagMutedKeywords(post *model.Post, userID string, keywords model.MutedKeywords) *model.Post {
+	if len(keywords) == 0 || post.UserId == userID {
+		return post
+	}
+
+	matched := keywords.Match(post.Message)
+	if len(matched) == 0 {
+		return post
+	}
+
+	post = post.Clone()
+	if post.Metadata == nil {
+		post.Metadata = &model.PostMetadata{}
+	} else {
+		post.Metadata = post.Metadata.Copy()
+	}
+	post.Metadata.MutedKeywords = matched
+	return post
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestMutedKeywords(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	keywords, appErr := th.App.GetMutedKeywords(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Empty(t, keywords)
+
+	keywords, appErr = th.App.UpdateMutedKeywords(th.BasicUser.Id, model.MutedKeywords{"Spoiler ", "spoiler"})
+	require.Nil(t, appErr)
+	assert.Equal(t, model.MutedKeywords{"spoiler"}, keywords)
+
+	keywords, appErr = th.App.AddMutedKeywords(th.BasicUser.Id, model.MutedKeywords{"release party"})
+	require.Nil(t, appErr)
+	assert.Equal(t, model.MutedKeywords{"spoiler", "release party"}, keywords)
+
+	keywords, appErr = th.App.GetMutedKeywords(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.MutedKeywords{"spoiler", "release party"}, keywords)
+
+	muting := th.App.getUsersMutingPost(&model.Post{Message: "Spoiler: it was the butler"}, []string{th.BasicUser.Id, th.BasicUser2.Id})
+	assert.Equal(t, map[string]bool{th.BasicUser.Id: true}, muting)
+
+	require.Nil(t, th.App.DeleteMutedKeywords(th.BasicUser.Id))
+
+	keywords, appErr = th.App.GetMutedKeywords(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Empty(t, keywords)
+}
+
+func TestSanitizePostMetadataForUserMutedKeywords(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, appErr := th.App.UpdateMutedKeywords(th.BasicUser2.Id, model.MutedKeywords{"spoiler"})
+	require.Nil(t, appErr)
+
+	post := th.CreatePost(th.BasicChannel)
+	post.Message = "spoiler alert"
+
+	sanitized, appErr := th.App.SanitizePostMetadataForUser(post, th.BasicUser2.Id)
+	require.Nil(t, appErr)
+	require.NotNil(t, sanitized.Metadata)
+	assert.Equal(t, []string{"spoiler"}, sanitized.Metadata.MutedKeywords)
+	assert.True(t, post.Metadata == nil || post.Metadata.MutedKeywords == nil, "the original post should not be flagged")
+
+	sanitized, appErr = th.App.SanitizePostMetadataForUser(post, th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Same(t, post, sanitized)
+}
@@ -195,6 +195,8 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}
 	}
 
+	a.recordOutstandingMentions(post, channel, mentions)
+
 	mentionedUsersList := make(model.StringArray, 0, len(mentions.Mentions))
 	mentionAutofollowChans := []chan *model.AppError{}
 	threadParticipants := map[string]bool{post.UserId: true}
@@ -218,6 +220,15 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		for id := range mentions.Mentions {
 			threadParticipants[id] = true
 		}
+		// Channels auto-following threads have all of their members follow the thread, save for
+		// those who unfollowed it, which is checked below.
+		if channel.AutoFollowThreads {
+			for id, profile := range profileMap {
+				if !profile.IsBot {
+					threadParticipants[id] = true
+				}
+			}
+		}
 
 		// sema is a counting semaphore to throttle the number of concurrent DB requests.
 		// A concurrency of 8 should be sufficient.
@@ -330,6 +341,14 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}
 	}
 
+	// Users that muted a keyword of the post are neither emailed nor pushed about it
+	notifiedUserIDs := make([]string, 0, len(mentionedUsersList)+len(allActivityPushUserIds)+len(notificationsForCRT.Email)+len(notificationsForCRT.Push))
+	notifiedUserIDs = append(notifiedUserIDs, mentionedUsersList...)
+	notifiedUserIDs = append(notifiedUserIDs, allActivityPushUserIds...)
+	notifiedUserIDs = append(notifiedUserIDs, notificationsForCRT.Email...)
+	notifiedUserIDs = append(notifiedUserIDs, notificationsForCRT.Push...)
+	mutingUserIDs := a.getUsersMutingPost(post, model.RemoveDuplicateStrings(notifiedUserIDs))
+
 	notification := &PostNotification{
 		Post:       post.Clone(),
 		Channel:    channel,
@@ -342,7 +361,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		emailReceipients = model.RemoveDuplicateStrings(emailReceipients)
 
 		for _, id := range emailReceipients {
-			if profileMap[id] == nil {
+			if profileMap[id] == nil || mutingUserIDs[id] {
 				continue
 			}
 
@@ -415,7 +434,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 
 	if sendPushNotifications {
 		for _, id := range mentionedUsersList {
-			if profileMap[id] == nil || notificationsForCRT.Push.Contains(id) {
+			if profileMap[id] == nil || notificationsForCRT.Push.Contains(id) || mutingUserIDs[id] {
 				continue
 			}
 
@@ -424,6 +443,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 			if status, err = a.GetStatus(id); err != nil {
 				status = &model.Status{UserId: id, Status: model.StatusOffline, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
 			}
+			status = a.statusForUrgentPost(post, status)
 
 			if ShouldSendPushNotification(profileMap[id], channelMemberNotifyPropsMap[id], true, status, post) {
 				mentionType := mentions.Mentions[id]
@@ -455,7 +475,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}
 
 		for _, id := range allActivityPushUserIds {
-			if profileMap[id] == nil || notificationsForCRT.Push.Contains(id) {
+			if profileMap[id] == nil || notificationsForCRT.Push.Contains(id) || mutingUserIDs[id] {
 				continue
 			}
 
@@ -488,7 +508,7 @@ func (a *App) SendNotifications(post *model.Post, team *model.Team, channel *mod
 		}
 
 		for _, id := range notificationsForCRT.Push {
-			if profileMap[id] == nil {
+			if profileMap[id] == nil || mutingUserIDs[id] {
 				continue
 			}
 
@@ -66,7 +66,7 @@ func (a *App) sendNotificationEmail(notification *PostNotification, user *model.
 		// fall back to sending a single email if we can't batch it for some reason
 	}
 
-	translateFunc := i18n.GetUserTranslations(user.Locale)
+	translateFunc := i18n.GetUserTranslations(channel.GetNotificationLocale(user.Locale))
 
 	var useMilitaryTime bool
 	if data, err := a.Srv().Store.Preference().Get(user.Id, model.PreferenceCategoryDisplaySettings, model.PreferenceNameUseMilitaryTime); err != nil {
@@ -231,7 +231,7 @@ func (a *App) getNotificationEmailBody(recipient *model.User, post *model.Post,
 		pData.Time = translateFunc("app.notification.body.dm.time", messageTime)
 	}
 
-	data := a.Srv().EmailService.NewEmailTemplateData(recipient.Locale)
+	data := a.Srv().EmailService.NewEmailTemplateData(channel.GetNotificationLocale(recipient.Locale))
 	data.Props["SiteURL"] = a.GetSiteURL()
 	if teamName != "select_team" {
 		data.Props["ButtonURL"] = landingURL + "/pl/" + post.Id
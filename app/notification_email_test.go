@@ -97,6 +97,39 @@ func TestGetNotificationEmailBodyFullNotificationPublicChannel(t *testing.T) {
 	require.Contains(t, body, teamURL, fmt.Sprintf("Expected email text '%s'. Got %s", teamURL, body))
 }
 
+func TestGetNotificationEmailBodyChannelDefaultLocale(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	post := &model.Post{
+		Message: "This is the message",
+	}
+	channel := &model.Channel{
+		DisplayName:   "ChannelName",
+		Type:          model.ChannelTypeOpen,
+		DefaultLocale: "es",
+	}
+	teamURL := "http://localhost:8065/testteam"
+	translateFunc := i18n.GetUserTranslations("en")
+
+	storeMock := th.App.Srv().Store.(*mocks.Store)
+	teamStoreMock := mocks.TeamStore{}
+	teamStoreMock.On("GetByName", "testteam").Return(&model.Team{Name: "testteam"}, nil)
+	storeMock.On("Team").Return(&teamStoreMock)
+
+	t.Run("recipient without a locale gets the channel default locale", func(t *testing.T) {
+		body, err := th.App.getNotificationEmailBody(&model.User{}, post, channel, "ChannelName", "sender", "testteam", teamURL, model.EmailNotificationContentsFull, true, translateFunc, "user-avatar.png")
+		require.NoError(t, err)
+		require.Contains(t, body, i18n.GetUserTranslations("es")("api.templates.email_footer_v2"))
+	})
+
+	t.Run("recipient locale takes precedence", func(t *testing.T) {
+		body, err := th.App.getNotificationEmailBody(&model.User{Locale: "en"}, post, channel, "ChannelName", "sender", "testteam", teamURL, model.EmailNotificationContentsFull, true, translateFunc, "user-avatar.png")
+		require.NoError(t, err)
+		require.Contains(t, body, i18n.GetUserTranslations("en")("api.templates.email_footer_v2"))
+	})
+}
+
 func TestGetNotificationEmailBodyFullNotificationGroupChannel(t *testing.T) {
 	th := SetupWithStoreMock(t)
 	defer th.TearDown()
@@ -934,3 +967,20 @@ func TestMarkdownConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMessageForWebhookNotification(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	post := &model.Post{UserId: model.NewId()}
+	post.AddProp("from_webhook", "true")
+	post.AddProp("webhook_display_name", "Deploys")
+	post.AddProp("attachments", []*model.SlackAttachment{{Text: "deployed"}, {Text: "rolled back"}})
+
+	message := th.App.GetMessageForNotification(post, i18n.GetUserTranslations("en"))
+	require.Equal(t, "Deploys sent 2 attachments", message)
+
+	post.Message = "Deploy finished"
+	message = th.App.GetMessageForNotification(post, i18n.GetUserTranslations("en"))
+	require.Equal(t, "Deploy finished", message)
+}
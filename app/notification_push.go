@@ -58,9 +58,8 @@ type PushNotification struct {
 
 func (a *App) sendPushNotificationSync(post *model.Post, user *model.User, channel *model.Channel, channelName string, senderName string,
 	explicitMention bool, channelWideMention bool, replyToThreadType string) *model.AppError {
-	cfg := a.Config()
 	msg, appErr := a.BuildPushNotificationMessage(
-		*cfg.EmailSettings.PushNotificationContents,
+		a.pushNotificationContentsFor(user, channel),
 		post,
 		user,
 		channel,
@@ -566,6 +565,7 @@ func (a *App) BuildPushNotificationMessage(contentsConfig string, post *model.Po
 		msg = a.buildIdLoadedPushNotificationMessage(channel, post, user)
 	} else {
 		msg = a.buildFullPushNotificationMessage(contentsConfig, post, user, channel, channelName, senderName, explicitMention, channelWideMention, replyToThreadType)
+		a.applyPushNotificationTemplates(msg)
 	}
 
 	unreadCount, err := a.Srv().Store.User().GetUnreadCount(user.Id)
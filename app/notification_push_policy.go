@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// pushNotificationContentsFor returns how much a push notification about a post in the channel
+// may disclose to the user. A contents setting for one of the user's roles replaces the default
+// one, the least disclosing winning when several roles have one, and the setting for the
+// sensitivity tag of the channel can only disclose less.
+func (a *App) pushNotificationContentsFor(user *model.User, channel *model.Channel) string {
+	settings := a.Config().EmailSettings
+	contents := *settings.PushNotificationContents
+
+	roleContents := ""
+	for _, role := range user.GetRoles() {
+		if override, ok := settings.PushNotificationContentsByRole[role]; ok {
+			if roleContents == "" {
+				roleContents = override
+			} else {
+				roleContents = model.LeastDisclosingPushNotificationContents(roleContents, override)
+			}
+		}
+	}
+	if roleContents != "" {
+		contents = roleContents
+	}
+
+	if channel.SensitivityTag != "" {
+		if override, ok := settings.PushNotificationContentsBySensitivityTag[channel.SensitivityTag]; ok {
+			contents = model.LeastDisclosingPushNotificationContents(contents, override)
+		}
+	}
+
+	return contents
+}
+
+// applyPushNotificationTemplates replaces the title and body of a push notification with the
+// configured templates. The placeholders are filled from the notification itself, so a template
+// never discloses more than the contents setting already let through.
+func (a *App) applyPushNotificationTemplates(msg *model.PushNotification) {
+	settings := a.Config().EmailSettings
+	titleTemplate := *settings.PushNotificationTitleTemplate
+	bodyTemplate := *settings.PushNotificationBodyTemplate
+	if titleTemplate == "" && bodyTemplate == "" {
+		return
+	}
+
+	replacer := strings.NewReplacer(
+		"{sender}", msg.SenderName,
+		"{channel}", msg.ChannelName,
+		"{message}", msg.Message,
+	)
+	if titleTemplate != "" {
+		msg.ChannelName = replacer.Replace(titleTemplate)
+	}
+	if bodyTemplate != "" {
+		msg.Message = replacer.Replace(bodyTemplate)
+	}
+}
+
+// SendTestPushNotificationsToUser sends a test push notification to every mobile device the user
+// is logged in on and reports what the push proxy answered for each of them.
+func (a *App) SendTestPushNotificationsToUser(userID string) ([]*model.PushNotificationTestResult, *model.AppError) {
+	if !*a.Config().EmailSettings.SendPushNotifications {
+		return nil, model.NewAppError("SendTestPushNotificationsToUser", "api.push_notification.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	sessions, appErr := a.getMobileAppSessions(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	userLocale := i18n.GetUserTranslations(user.Locale)
+	results := make([]*model.PushNotificationTestResult, 0, len(sessions))
+	for _, session := range sessions {
+		msg := &model.PushNotification{
+			Version:  model.PushMessageV2,
+			Type:     model.PushTypeTest,
+			ServerId: a.TelemetryId(),
+			AckId:    model.NewId(),
+			Badge:    -1,
+			Message:  userLocale("api.push_notification.test.message"),
+		}
+		msg.SetDeviceIdAndPlatform(session.DeviceId)
+
+		result := &model.PushNotificationTestResult{
+			SessionId: session.Id,
+			Platform:  msg.Platform,
+			AckId:     msg.AckId,
+		}
+		results = append(results, result)
+
+		pushResponse, err := a.rawSendToPushProxy(msg)
+		if err != nil {
+			a.NotificationsLog().Error("Notification error",
+				mlog.String("type", msg.Type),
+				mlog.String("userId", userID),
+				mlog.String("status", err.Error()),
+			)
+			result.Status = model.PushStatusFail
+			result.Error = err.Error()
+			continue
+		}
+
+		result.Status = pushResponse[model.PushStatus]
+		switch result.Status {
+		case model.PushStatusRemove:
+			a.AttachDeviceId(session.Id, "", session.ExpiresAt)
+			a.ClearSessionCacheForUser(session.UserId)
+		case model.PushStatusFail:
+			result.Error = pushResponse[model.PushStatusErrorMsg]
+		}
+	}
+
+	return results, nil
+}
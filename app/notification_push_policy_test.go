@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store/storetest/mocks"
+)
+
+func TestPushNotificationContentsFor(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.PushNotificationContents = model.FullNotification
+		cfg.EmailSettings.PushNotificationContentsByRole = map[string]string{
+			model.SystemGuestRoleId: model.GenericNotification,
+			model.SystemAdminRoleId: model.FullNotification,
+			"contractor":            model.IdLoadedNotification,
+		}
+		cfg.EmailSettings.PushNotificationContentsBySensitivityTag = map[string]string{
+			"confidential": model.GenericNoChannelNotification,
+			"internal":     model.GenericNotification,
+		}
+	})
+
+	for name, tc := range map[string]struct {
+		roles          string
+		sensitivityTag string
+		expected       string
+	}{
+		"default contents":                       {roles: model.SystemUserRoleId, expected: model.FullNotification},
+		"role override":                          {roles: model.SystemGuestRoleId, expected: model.GenericNotification},
+		"least disclosing of several roles":      {roles: model.SystemAdminRoleId + " contractor", expected: model.IdLoadedNotification},
+		"role override can disclose more":        {roles: model.SystemAdminRoleId, sensitivityTag: "unknown", expected: model.FullNotification},
+		"sensitivity tag restricts":              {roles: model.SystemUserRoleId, sensitivityTag: "confidential", expected: model.GenericNoChannelNotification},
+		"sensitivity tag cannot disclose more":   {roles: "contractor", sensitivityTag: "internal", expected: model.IdLoadedNotification},
+		"sensitivity tag restricts role setting": {roles: model.SystemGuestRoleId, sensitivityTag: "confidential", expected: model.GenericNoChannelNotification},
+	} {
+		t.Run(name, func(t *testing.T) {
+			user := &model.User{Id: model.NewId(), Roles: tc.roles}
+			channel := &model.Channel{Id: model.NewId(), SensitivityTag: tc.sensitivityTag}
+			assert.Equal(t, tc.expected, th.App.pushNotificationContentsFor(user, channel))
+		})
+	}
+}
+
+func TestBuildPushNotificationMessageTemplates(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	mockStore := th.App.Srv().Store.(*mocks.Store)
+	mockUserStore := mocks.UserStore{}
+	mockUserStore.On("GetUnreadCount", mock.AnythingOfType("string")).Return(int64(1), nil)
+	mockStore.On("User").Return(&mockUserStore)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.PushNotificationTitleTemplate = "[{channel}]"
+		*cfg.EmailSettings.PushNotificationBodyTemplate = "{sender}: {message}"
+	})
+
+	user := &model.User{Id: model.NewId(), Locale: "en"}
+	channel := &model.Channel{Id: model.NewId(), Type: model.ChannelTypeOpen}
+	post := &model.Post{Id: model.NewId(), ChannelId: channel.Id, UserId: model.NewId(), Message: "the launch codes"}
+
+	t.Run("full contents", func(t *testing.T) {
+		msg, appErr := th.App.BuildPushNotificationMessage(model.FullNotification, post, user, channel, "town-square", "alice", false, false, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, "[town-square]", msg.ChannelName)
+		assert.Equal(t, "alice: the launch codes", msg.Message)
+	})
+
+	t.Run("templates cannot disclose more than the contents setting", func(t *testing.T) {
+		msg, appErr := th.App.BuildPushNotificationMessage(model.GenericNoChannelNotification, post, user, channel, "town-square", "alice", false, false, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, "[]", msg.ChannelName)
+		assert.NotContains(t, msg.Message, "the launch codes")
+	})
+}
+
+func TestSendTestPushNotificationsToUser(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	handler := &testPushNotificationHandler{t: t}
+	pushServer := httptest.NewServer(
+		http.HandlerFunc(handler.handleReq),
+	)
+	defer pushServer.Close()
+
+	user := &model.User{Id: model.NewId(), Locale: "en"}
+	sess1 := &model.Session{Id: model.NewId(), UserId: user.Id, DeviceId: "android:device1", ExpiresAt: model.GetMillis() + 100000}
+	sess2 := &model.Session{Id: model.NewId(), UserId: user.Id, DeviceId: "apple:device2", ExpiresAt: model.GetMillis() + 100000}
+
+	mockStore := th.App.Srv().Store.(*mocks.Store)
+	mockUserStore := mocks.UserStore{}
+	mockUserStore.On("Get", mock.Anything, user.Id).Return(user, nil)
+	mockSessionStore := mocks.SessionStore{}
+	mockSessionStore.On("GetSessionsWithActiveDeviceIds", user.Id).Return([]*model.Session{sess1, sess2}, nil)
+	mockSessionStore.On("UpdateDeviceId", sess1.Id, "", sess1.ExpiresAt).Return("", nil)
+	mockStore.On("User").Return(&mockUserStore)
+	mockStore.On("Session").Return(&mockSessionStore)
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.SendPushNotifications = false
+		})
+
+		_, appErr := th.App.SendTestPushNotificationsToUser(user.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+		assert.Equal(t, 0, handler.numReqs())
+	})
+
+	t.Run("one result per device", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.EmailSettings.SendPushNotifications = true
+			*cfg.EmailSettings.PushNotificationServer = pushServer.URL
+		})
+
+		results, appErr := th.App.SendTestPushNotificationsToUser(user.Id)
+		require.Nil(t, appErr)
+		require.Len(t, results, 2)
+
+		// The test handler answers REMOVE to the first request and OK to the second.
+		assert.Equal(t, sess1.Id, results[0].SessionId)
+		assert.Equal(t, "android", results[0].Platform)
+		assert.Equal(t, model.PushStatusRemove, results[0].Status)
+		assert.Equal(t, sess2.Id, results[1].SessionId)
+		assert.Equal(t, "apple", results[1].Platform)
+		assert.Equal(t, model.PushStatusOk, results[1].Status)
+
+		require.Equal(t, 2, handler.numReqs())
+		for i, notification := range handler.notifications() {
+			assert.Equal(t, model.PushTypeTest, notification.Type)
+			assert.Equal(t, results[i].AckId, notification.AckId)
+			assert.NotEmpty(t, notification.Message)
+		}
+		mockSessionStore.AssertCalled(t, "UpdateDeviceId", sess1.Id, "", sess1.ExpiresAt)
+	})
+}
@@ -2821,3 +2821,64 @@ func TestReplyPostNotificationsWithCRT(t *testing.T) {
 		assert.Nil(t, membership)
 	})
 }
+
+func TestSendNotificationsAutoFollowThreads(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.ThreadAutoFollow = true
+		*cfg.ServiceSettings.CollapsedThreads = model.CollapsedThreadsDefaultOn
+	})
+
+	user3 := th.CreateUser()
+	th.LinkUserToTeam(user3, th.BasicTeam)
+	th.AddUserToChannel(user3, th.BasicChannel)
+
+	channel, appErr := th.App.UpdateChannelThreadSettings(th.BasicChannel, &model.ChannelThreadSettings{AutoFollowThreads: true})
+	require.Nil(t, appErr)
+
+	createThread := func() *model.Post {
+		rootPost, appErr := th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: channel.Id,
+			Message:   "root",
+			UserId:    th.BasicUser.Id,
+		}, channel, false, true)
+		require.Nil(t, appErr)
+		return rootPost
+	}
+	reply := func(rootPost *model.Post) {
+		_, appErr := th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: channel.Id,
+			Message:   "reply",
+			UserId:    th.BasicUser.Id,
+			RootId:    rootPost.Id,
+		}, channel, false, true)
+		require.Nil(t, appErr)
+	}
+
+	t.Run("members follow the threads replied to", func(t *testing.T) {
+		rootPost := createThread()
+		reply(rootPost)
+
+		for _, userID := range []string{th.BasicUser2.Id, user3.Id} {
+			membership, appErr := th.App.GetThreadMembershipForUser(userID, rootPost.Id)
+			require.Nil(t, appErr)
+			assert.True(t, membership.Following)
+		}
+	})
+
+	t.Run("members who unfollowed a thread don't follow it again", func(t *testing.T) {
+		rootPost := createThread()
+		reply(rootPost)
+
+		appErr := th.App.UpdateThreadFollowForUser(user3.Id, th.BasicTeam.Id, rootPost.Id, false)
+		require.Nil(t, appErr)
+
+		reply(rootPost)
+
+		membership, appErr := th.App.GetThreadMembershipForUser(user3.Id, rootPost.Id)
+		require.Nil(t, appErr)
+		assert.False(t, membership.Following)
+	})
+}
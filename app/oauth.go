@@ -6,6 +6,7 @@ package app
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
@@ -101,6 +102,8 @@ func (a *App) UpdateOAuthApp(oldApp, updatedApp *model.OAuthApp) (*model.OAuthAp
 		}
 	}
 
+	a.Srv().oauthAppRateLimiter.invalidate(oauthApp.Id)
+
 	return oauthApp, nil
 }
 
@@ -113,6 +116,8 @@ func (a *App) DeleteOAuthApp(appID string) *model.AppError {
 		return model.NewAppError("DeleteOAuthApp", "app.oauth.delete_app.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
+	a.Srv().oauthAppRateLimiter.invalidate(appID)
+
 	if err := a.Srv().InvalidateAllCaches(); err != nil {
 		mlog.Warn("error in invalidating cache", mlog.Err(err))
 	}
@@ -244,7 +249,7 @@ func (a *App) GetOAuthAccessTokenForImplicitFlow(userID string, authRequest *mod
 		return nil, err
 	}
 
-	session, err := a.newSession(oauthApp.Name, user)
+	session, err := a.newSession(oauthApp, user)
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +311,7 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 		if accessData != nil {
 			if accessData.IsExpired() {
 				var access *model.AccessResponse
-				access, err := a.newSessionUpdateToken(oauthApp.Name, accessData, user)
+				access, err := a.newSessionUpdateToken(oauthApp, accessData, user)
 				if err != nil {
 					return nil, err
 				}
@@ -323,7 +328,7 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 		} else {
 			var session *model.Session
 			// Create a new session and return new access token
-			session, err := a.newSession(oauthApp.Name, user)
+			session, err := a.newSession(oauthApp, user)
 			if err != nil {
 				return nil, err
 			}
@@ -357,7 +362,7 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 			return nil, model.NewAppError("GetOAuthAccessToken", "api.oauth.get_access_token.internal_user.app_error", nil, "", http.StatusNotFound)
 		}
 
-		access, err := a.newSessionUpdateToken(oauthApp.Name, accessData, user)
+		access, err := a.newSessionUpdateToken(oauthApp, accessData, user)
 		if err != nil {
 			return nil, err
 		}
@@ -367,14 +372,15 @@ func (a *App) GetOAuthAccessTokenForCodeFlow(clientId, grantType, redirectURI, c
 	return accessRsp, nil
 }
 
-func (a *App) newSession(appName string, user *model.User) (*model.Session, *model.AppError) {
+func (a *App) newSession(oauthApp *model.OAuthApp, user *model.User) (*model.Session, *model.AppError) {
 	// Set new token an session
 	session := &model.Session{UserId: user.Id, Roles: user.Roles, IsOAuth: true}
 	session.GenerateCSRF()
 	a.ch.srv.userService.SetSessionExpireInDays(session, *a.Config().ServiceSettings.SessionLengthSSOInDays)
-	session.AddProp(model.SessionPropPlatform, appName)
+	session.AddProp(model.SessionPropPlatform, oauthApp.Name)
 	session.AddProp(model.SessionPropOs, "OAuth2")
 	session.AddProp(model.SessionPropBrowser, "OAuth2")
+	session.AddProp(model.SessionPropOAuthAppId, oauthApp.Id)
 
 	session, err := a.Srv().Store.Session().Save(session)
 	if err != nil {
@@ -386,13 +392,13 @@ func (a *App) newSession(appName string, user *model.User) (*model.Session, *mod
 	return session, nil
 }
 
-func (a *App) newSessionUpdateToken(appName string, accessData *model.AccessData, user *model.User) (*model.AccessResponse, *model.AppError) {
+func (a *App) newSessionUpdateToken(oauthApp *model.OAuthApp, accessData *model.AccessData, user *model.User) (*model.AccessResponse, *model.AppError) {
 	// Remove the previous session
 	if err := a.Srv().Store.Session().Remove(accessData.Token); err != nil {
 		mlog.Warn("error removing access data token from session", mlog.Err(err))
 	}
 
-	session, err := a.newSession(appName, user)
+	session, err := a.newSession(oauthApp, user)
 	if err != nil {
 		return nil, err
 	}
@@ -534,6 +540,111 @@ func (a *App) RevokeAccessToken(token string) *model.AppError {
 	return nil
 }
 
+// authenticateOAuthClient returns the OAuth app the client credentials belong to.
+func (a *App) authenticateOAuthClient(where, clientID, clientSecret string) (*model.OAuthApp, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOAuthServiceProvider {
+		return nil, model.NewAppError(where, "api.oauth.allow_oauth.turn_off.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if !model.IsValidId(clientID) || clientSecret == "" {
+		return nil, model.NewAppError(where, "api.oauth.authenticate_client.app_error", nil, "", http.StatusUnauthorized)
+	}
+
+	oauthApp, err := a.Srv().Store.OAuth().GetApp(clientID)
+	if err != nil {
+		return nil, model.NewAppError(where, "api.oauth.authenticate_client.app_error", nil, err.Error(), http.StatusUnauthorized)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(oauthApp.ClientSecret), []byte(clientSecret)) != 1 {
+		return nil, model.NewAppError(where, "api.oauth.authenticate_client.app_error", nil, "client_id="+clientID, http.StatusUnauthorized)
+	}
+
+	return oauthApp, nil
+}
+
+// getOAuthAccessDataForToken returns the access data of an access or refresh token, looking the
+// token up as the kind hinted at first. It returns whether the token is a refresh token, and nil
+// when the token isn't known.
+func (a *App) getOAuthAccessDataForToken(token, tokenTypeHint string) (*model.AccessData, bool) {
+	isRefreshToken := tokenTypeHint == model.TokenTypeHintRefreshToken
+	for i := 0; i < 2; i++ {
+		var accessData *model.AccessData
+		var err error
+		if isRefreshToken {
+			accessData, err = a.Srv().Store.OAuth().GetAccessDataByRefreshToken(token)
+		} else {
+			accessData, err = a.Srv().Store.OAuth().GetAccessData(token)
+		}
+		if err == nil {
+			return accessData, isRefreshToken
+		}
+		isRefreshToken = !isRefreshToken
+	}
+
+	return nil, false
+}
+
+// IntrospectOAuthToken describes a token issued to the OAuth app of the client, as per RFC 7662.
+// Tokens that are unknown, expired, or were issued to another app are reported as inactive.
+func (a *App) IntrospectOAuthToken(clientID, clientSecret, token, tokenTypeHint string) (*model.OAuthIntrospectionResponse, *model.AppError) {
+	oauthApp, appErr := a.authenticateOAuthClient("IntrospectOAuthToken", clientID, clientSecret)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	inactive := &model.OAuthIntrospectionResponse{Active: false}
+
+	accessData, isRefreshToken := a.getOAuthAccessDataForToken(token, tokenTypeHint)
+	if accessData == nil || accessData.ClientId != oauthApp.Id {
+		return inactive, nil
+	}
+
+	response := &model.OAuthIntrospectionResponse{
+		Active:   true,
+		Scope:    accessData.Scope,
+		ClientId: accessData.ClientId,
+		Sub:      accessData.UserId,
+	}
+
+	if !isRefreshToken {
+		session, err := a.Srv().Store.Session().Get(context.Background(), accessData.Token)
+		if err != nil || session.IsExpired() {
+			return inactive, nil
+		}
+		response.TokenType = model.AccessTokenType
+		response.Exp = session.ExpiresAt / 1000
+		response.Iat = session.CreateAt / 1000
+	}
+
+	user, err := a.Srv().Store.User().Get(context.Background(), accessData.UserId)
+	if err != nil || user.DeleteAt != 0 {
+		return inactive, nil
+	}
+	response.Username = user.Username
+
+	return response, nil
+}
+
+// RevokeOAuthToken revokes a token issued to the OAuth app of the client, as per RFC 7009.
+// Revoking a refresh token revokes its access token as well. Unknown tokens are ignored.
+func (a *App) RevokeOAuthToken(clientID, clientSecret, token, tokenTypeHint string) *model.AppError {
+	oauthApp, appErr := a.authenticateOAuthClient("RevokeOAuthToken", clientID, clientSecret)
+	if appErr != nil {
+		return appErr
+	}
+
+	accessData, _ := a.getOAuthAccessDataForToken(token, tokenTypeHint)
+	if accessData == nil {
+		return nil
+	}
+
+	if accessData.ClientId != oauthApp.Id {
+		return model.NewAppError("RevokeOAuthToken", "api.oauth.revoke_token.client.app_error", nil, "client_id="+clientID, http.StatusForbidden)
+	}
+
+	return a.RevokeAccessToken(accessData.Token)
+}
+
 func (a *App) CompleteOAuth(c *request.Context, service string, body io.ReadCloser, teamID string, props map[string]string, tokenUser *model.User) (*model.User, *model.AppError) {
 	defer body.Close()
 
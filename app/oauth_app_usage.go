@@ -0,0 +1,222 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/throttled/throttled"
+	"github.com/throttled/throttled/store/memstore"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// oauthAppRateLimitRefresh is how long the rate limit of an OAuth app is used before being read
+// again, so that the changes made on other nodes of a cluster are picked up.
+const oauthAppRateLimitRefresh = time.Minute
+
+type oauthAppLimiter struct {
+	quota     throttled.RateQuota
+	limiter   *throttled.GCRARateLimiter
+	fetchedAt int64
+}
+
+// oauthAppRateLimiter limits the calls made with the tokens of each OAuth app to the rate
+// configured on the app.
+type oauthAppRateLimiter struct {
+	mut      sync.Mutex
+	limiters map[string]*oauthAppLimiter
+}
+
+func newOAuthAppRateLimiter() *oauthAppRateLimiter {
+	return &oauthAppRateLimiter{
+		limiters: make(map[string]*oauthAppLimiter),
+	}
+}
+
+// get returns the rate limiter of the app, or nil when the app isn't limited. The count of the
+// calls made so far is kept as long as the rate limit of the app doesn't change.
+func (l *oauthAppRateLimiter) get(appID string, getApp func(string) (*model.OAuthApp, error)) *throttled.GCRARateLimiter {
+	now := model.GetMillis()
+
+	l.mut.Lock()
+	if entry, ok := l.limiters[appID]; ok && now-entry.fetchedAt < oauthAppRateLimitRefresh.Milliseconds() {
+		l.mut.Unlock()
+		return entry.limiter
+	}
+	l.mut.Unlock()
+
+	var quota throttled.RateQuota
+	oauthApp, err := getApp(appID)
+	if err != nil {
+		mlog.Debug("Failed to get OAuth app to rate limit", mlog.String("app_id", appID), mlog.Err(err))
+	} else if oauthApp.HasRateLimit() {
+		quota = throttled.RateQuota{
+			MaxRate:  throttled.PerSec(oauthApp.RateLimitPerSec),
+			MaxBurst: oauthApp.RateLimitMaxBurst,
+		}
+	}
+
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	if entry, ok := l.limiters[appID]; ok && entry.quota == quota {
+		entry.fetchedAt = now
+		return entry.limiter
+	}
+
+	entry := &oauthAppLimiter{quota: quota, fetchedAt: now}
+	if quota.MaxRate != (throttled.Rate{}) {
+		store, err := memstore.New(1)
+		if err == nil {
+			entry.limiter, err = throttled.NewGCRARateLimiter(store, quota)
+		}
+		if err != nil {
+			mlog.Warn("Failed to create OAuth app rate limiter", mlog.String("app_id", appID), mlog.Err(err))
+		}
+	}
+	l.limiters[appID] = entry
+
+	return entry.limiter
+}
+
+// invalidate has the rate limit of the app read again on its next call.
+func (l *oauthAppRateLimiter) invalidate(appID string) {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+	delete(l.limiters, appID)
+}
+
+type oauthAppUsageKey struct {
+	appID    string
+	bucketAt int64
+}
+
+// oauthAppUsageTracker accumulates the calls made with the tokens of the OAuth apps in memory
+// until they are flushed to the store.
+type oauthAppUsageTracker struct {
+	mut     sync.Mutex
+	pending map[oauthAppUsageKey]*model.OAuthAppUsage
+}
+
+func newOAuthAppUsageTracker() *oauthAppUsageTracker {
+	return &oauthAppUsageTracker{
+		pending: make(map[oauthAppUsageKey]*model.OAuthAppUsage),
+	}
+}
+
+func (t *oauthAppUsageTracker) add(appID string, bucketAt int64, limited bool) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	key := oauthAppUsageKey{appID: appID, bucketAt: bucketAt}
+	usage, ok := t.pending[key]
+	if !ok {
+		usage = &model.OAuthAppUsage{AppId: appID, BucketAt: bucketAt}
+		t.pending[key] = usage
+	}
+	usage.Count++
+	if limited {
+		usage.LimitedCount++
+	}
+}
+
+func (t *oauthAppUsageTracker) drain() []*model.OAuthAppUsage {
+	t.mut.Lock()
+	pending := t.pending
+	t.pending = make(map[oauthAppUsageKey]*model.OAuthAppUsage)
+	t.mut.Unlock()
+
+	usages := make([]*model.OAuthAppUsage, 0, len(pending))
+	for _, usage := range pending {
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// OAuthAppRateLimit limits the calls made with a session issued to an OAuth app to the rate
+// limit of the app, writing the response when the call is denied. Every call is counted towards
+// the usage of the app, whether it was denied or not.
+func (a *App) OAuthAppRateLimit(session *model.Session, w http.ResponseWriter) bool {
+	appID := session.Props[model.SessionPropOAuthAppId]
+	if !session.IsOAuth || appID == "" {
+		return false
+	}
+
+	limited := false
+	if limiter := a.Srv().oauthAppRateLimiter.get(appID, a.Srv().Store.OAuth().GetApp); limiter != nil {
+		var context throttled.RateLimitResult
+		var err error
+		limited, context, err = limiter.RateLimit(appID, 1)
+		if err != nil {
+			mlog.Error("Internal server error when rate limiting OAuth app.", mlog.String("app_id", appID), mlog.Err(err))
+			limited = false
+		} else {
+			setRateLimitHeaders(w, context)
+		}
+	}
+
+	a.Srv().oauthAppUsage.add(appID, model.APIUsageBucket(model.GetMillis()), limited)
+
+	if limited {
+		mlog.Debug("Denied due to OAuth app rate limit code=429", mlog.String("app_id", appID))
+		http.Error(w, "limit exceeded", http.StatusTooManyRequests)
+	}
+
+	return limited
+}
+
+func (a *App) GetOAuthAppUsageSummaries(since int64, page, perPage int) ([]*model.OAuthAppUsageSummary, *model.AppError) {
+	summaries, err := a.Srv().Store.OAuthAppUsage().GetSummaries(since, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetOAuthAppUsageSummaries", "app.oauth_app_usage.get_summaries.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return summaries, nil
+}
+
+func runOAuthAppUsageFlushJob(s *Server) {
+	model.CreateRecurringTask("OAuth App Usage Flush", func() {
+		doOAuthAppUsageFlush(s)
+	}, apiUsageFlushInterval)
+}
+
+func runOAuthAppUsageCleanupJob(s *Server) {
+	doOAuthAppUsageCleanup(s)
+	model.CreateRecurringTask("OAuth App Usage Cleanup", func() {
+		doOAuthAppUsageCleanup(s)
+	}, time.Hour*24)
+}
+
+func doOAuthAppUsageFlush(s *Server) {
+	usages := s.oauthAppUsage.drain()
+	if len(usages) == 0 {
+		return
+	}
+
+	if err := s.Store.OAuthAppUsage().Increment(usages); err != nil {
+		mlog.Warn("Failed to store OAuth app usage", mlog.Err(err))
+	}
+}
+
+// doOAuthAppUsageCleanup deletes the usage of the OAuth apps older than the retention of the API
+// usage.
+func doOAuthAppUsageCleanup(s *Server) {
+	endTime := model.GetMillis() - int64(*s.Config().ServiceSettings.APIUsageRetentionDays)*int64(24*time.Hour/time.Millisecond)
+
+	mlog.Debug("Cleaning up OAuth app usage store.")
+
+	for {
+		deleted, err := s.Store.OAuthAppUsage().PermanentDeleteBatch(endTime, apiUsageCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up OAuth app usage", mlog.Err(err))
+			return
+		}
+		if deleted < apiUsageCleanupBatch {
+			return
+		}
+	}
+}
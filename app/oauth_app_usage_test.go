@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestOAuthAppRateLimiter(t *testing.T) {
+	oauthApp := &model.OAuthApp{Id: model.NewId(), RateLimitPerSec: 1}
+	fetches := 0
+	getApp := func(appID string) (*model.OAuthApp, error) {
+		fetches++
+		return oauthApp, nil
+	}
+
+	l := newOAuthAppRateLimiter()
+
+	limiter := l.get(oauthApp.Id, getApp)
+	require.NotNil(t, limiter)
+
+	limited, _, err := limiter.RateLimit(oauthApp.Id, 1)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	limited, _, err = limiter.RateLimit(oauthApp.Id, 1)
+	require.NoError(t, err)
+	assert.True(t, limited)
+
+	t.Run("the limit is cached", func(t *testing.T) {
+		assert.Same(t, limiter, l.get(oauthApp.Id, getApp))
+		assert.Equal(t, 1, fetches)
+	})
+
+	t.Run("the limiter is kept while the limit doesn't change", func(t *testing.T) {
+		l.limiters[oauthApp.Id].fetchedAt = 0
+		assert.Same(t, limiter, l.get(oauthApp.Id, getApp))
+		assert.Equal(t, 2, fetches)
+	})
+
+	t.Run("apps without a limit aren't limited", func(t *testing.T) {
+		oauthApp.RateLimitPerSec = 0
+		l.invalidate(oauthApp.Id)
+		assert.Nil(t, l.get(oauthApp.Id, getApp))
+	})
+}
+
+func TestOAuthAppUsageTracker(t *testing.T) {
+	tracker := newOAuthAppUsageTracker()
+	appID := model.NewId()
+	bucket := model.APIUsageBucket(model.GetMillis())
+
+	tracker.add(appID, bucket, false)
+	tracker.add(appID, bucket, true)
+	tracker.add(appID, bucket, false)
+
+	usages := tracker.drain()
+	require.Len(t, usages, 1)
+	assert.Equal(t, &model.OAuthAppUsage{AppId: appID, BucketAt: bucket, Count: 3, LimitedCount: 1}, usages[0])
+
+	assert.Empty(t, tracker.drain())
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	outstandingMentionNudgeBatchSize  = 100
+	outstandingMentionDeleteBatchSize = 1000
+
+	// outstandingMentionNudgeAge is how long a mention stays unanswered before its user is nudged
+	// about it.
+	outstandingMentionNudgeAge = 24 * time.Hour
+)
+
+func (a *App) isOutstandingMentionsEnabled() bool {
+	return *a.Config().ServiceSettings.EnableOutstandingMentions
+}
+
+// recordOutstandingMentions tracks the users mentioned by name, or through a group, in the post
+// until they answer. Channel-wide mentions, and the messages of direct channels, aren't tracked.
+func (a *App) recordOutstandingMentions(post *model.Post, channel *model.Channel, mentions *ExplicitMentions) {
+	if !a.isOutstandingMentionsEnabled() || post.IsSystemMessage() {
+		return
+	}
+
+	outstanding := make([]*model.OutstandingMention, 0, len(mentions.Mentions))
+	for userID, mentionType := range mentions.Mentions {
+		if mentionType != KeywordMention && mentionType != GroupMention {
+			continue
+		}
+		outstanding = append(outstanding, &model.OutstandingMention{
+			UserId:      userID,
+			PostId:      post.Id,
+			ChannelId:   channel.Id,
+			TeamId:      channel.TeamId,
+			RootId:      post.RootId,
+			MentionedAt: post.CreateAt,
+		})
+	}
+
+	if err := a.Srv().Store.OutstandingMention().Save(outstanding); err != nil {
+		mlog.Warn("Failed to record outstanding mentions", mlog.String("post_id", post.Id), mlog.Err(err))
+	}
+}
+
+// resolveOutstandingMentionsForPost resolves the mentions the author of the post answers with
+// it: those of the thread of the post, or of the channel outside of any thread.
+func (a *App) resolveOutstandingMentionsForPost(post *model.Post) {
+	if !a.isOutstandingMentionsEnabled() || post.IsSystemMessage() {
+		return
+	}
+
+	if err := a.Srv().Store.OutstandingMention().ResolveForThread(post.UserId, post.ChannelId, post.RootId, post.CreateAt); err != nil {
+		mlog.Warn("Failed to resolve outstanding mentions", mlog.String("post_id", post.Id), mlog.Err(err))
+	}
+}
+
+func (a *App) resolveOutstandingMentionsForReaction(reaction *model.Reaction) {
+	if !a.isOutstandingMentionsEnabled() {
+		return
+	}
+
+	if err := a.Srv().Store.OutstandingMention().ResolveForPost(reaction.UserId, reaction.PostId, reaction.CreateAt); err != nil {
+		mlog.Warn("Failed to resolve outstanding mention", mlog.String("post_id", reaction.PostId), mlog.Err(err))
+	}
+}
+
+// GetOutstandingMentions returns a page of the mentions the user hasn't answered yet, the newest
+// first, along with the stats of the mentions of the user. Only the mentions in the team are
+// returned when teamID is set.
+func (a *App) GetOutstandingMentions(userID, teamID string, page, perPage int) (*model.OutstandingMentionList, *model.AppError) {
+	if !a.isOutstandingMentionsEnabled() {
+		return nil, model.NewAppError("GetOutstandingMentions", "app.outstanding_mention.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	stats, err := a.Srv().Store.OutstandingMention().GetStats(userID, teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetOutstandingMentions", "app.outstanding_mention.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	mentions, err := a.Srv().Store.OutstandingMention().GetOutstanding(userID, teamID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetOutstandingMentions", "app.outstanding_mention.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return &model.OutstandingMentionList{Stats: stats, Mentions: mentions}, nil
+}
+
+// ProcessOutstandingMentions deletes the mentions past their retention, then has the system bot
+// nudge the users about the mentions they left unanswered for a day, if enabled.
+func (a *App) ProcessOutstandingMentions() *model.AppError {
+	endTime := model.GetMillis() - int64(*a.Config().ServiceSettings.OutstandingMentionRetentionDays)*24*time.Hour.Milliseconds()
+	for {
+		deleted, err := a.Srv().Store.OutstandingMention().PermanentDeleteBatch(endTime, outstandingMentionDeleteBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessOutstandingMentions", "app.outstanding_mention.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if deleted < outstandingMentionDeleteBatchSize {
+			break
+		}
+	}
+
+	if !*a.Config().ServiceSettings.EnableOutstandingMentionNudges {
+		return nil
+	}
+
+	c := request.EmptyContext()
+	mentionedBefore := model.GetMillis() - outstandingMentionNudgeAge.Milliseconds()
+	afterUserID := ""
+	for {
+		counts, err := a.Srv().Store.OutstandingMention().GetOutstandingCounts(mentionedBefore, afterUserID, outstandingMentionNudgeBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessOutstandingMentions", "app.outstanding_mention.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, count := range counts {
+			a.nudgeOutstandingMentions(c, count)
+		}
+
+		if len(counts) < outstandingMentionNudgeBatchSize {
+			return nil
+		}
+		afterUserID = counts[len(counts)-1].UserId
+	}
+}
+
+// nudgeOutstandingMentions has the system bot DM the user about their outstanding mentions. A
+// failed nudge doesn't keep the other users from being nudged.
+func (a *App) nudgeOutstandingMentions(c *request.Context, count *model.OutstandingMentionCount) {
+	user, appErr := a.GetUser(count.UserId)
+	if appErr != nil {
+		mlog.Warn("Failed to get user to nudge about outstanding mentions", mlog.String("user_id", count.UserId), mlog.Err(appErr))
+		return
+	}
+	if user.DeleteAt != 0 || user.IsBot {
+		return
+	}
+
+	T := i18n.GetUserTranslations(user.Locale)
+	message := T("app.outstanding_mention.nudge", int(count.Count), map[string]interface{}{"Count": count.Count})
+	if appErr := a.sendSystemBotDirectMessage(c, user.Id, message); appErr != nil {
+		mlog.Warn("Failed to nudge user about outstanding mentions", mlog.String("user_id", user.Id), mlog.Err(appErr))
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestOutstandingMentions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, appErr := th.App.GetOutstandingMentions(th.BasicUser2.Id, "", 0, 60)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutstandingMentions = true
+	})
+
+	mention := func(t *testing.T, rootID string) *model.Post {
+		t.Helper()
+		post, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			RootId:    rootID,
+			Message:   "@" + th.BasicUser2.Username + " can you take a look?",
+		}, "", true)
+		require.Nil(t, appErr)
+		return post
+	}
+
+	getOutstanding := func(t *testing.T) *model.OutstandingMentionList {
+		t.Helper()
+		list, appErr := th.App.GetOutstandingMentions(th.BasicUser2.Id, th.BasicTeam.Id, 0, 60)
+		require.Nil(t, appErr)
+		return list
+	}
+
+	t.Run("a reaction resolves the mention", func(t *testing.T) {
+		post := mention(t, "")
+
+		list := getOutstanding(t)
+		require.Len(t, list.Mentions, 1)
+		assert.Equal(t, post.Id, list.Mentions[0].PostId)
+		assert.Equal(t, int64(1), list.Stats.OutstandingCount)
+		assert.Equal(t, post.CreateAt, list.Stats.OldestMentionedAt)
+
+		_, appErr := th.App.SaveReactionForPost(th.Context, &model.Reaction{
+			UserId:    th.BasicUser2.Id,
+			PostId:    post.Id,
+			EmojiName: "+1",
+		})
+		require.Nil(t, appErr)
+
+		list = getOutstanding(t)
+		assert.Empty(t, list.Mentions)
+		assert.Zero(t, list.Stats.OutstandingCount)
+		assert.Equal(t, int64(1), list.Stats.ResolvedCount)
+	})
+
+	t.Run("a reply in the thread resolves the mentions of the thread", func(t *testing.T) {
+		root := mention(t, "")
+		mention(t, root.Id)
+		other := mention(t, "")
+		require.Len(t, getOutstanding(t).Mentions, 3)
+
+		_, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{
+			UserId:    th.BasicUser2.Id,
+			ChannelId: th.BasicChannel.Id,
+			RootId:    root.Id,
+			Message:   "on it",
+		}, "", true)
+		require.Nil(t, appErr)
+
+		list := getOutstanding(t)
+		require.Len(t, list.Mentions, 1)
+		assert.Equal(t, other.Id, list.Mentions[0].PostId)
+	})
+
+	t.Run("the mentions of other teams are left out", func(t *testing.T) {
+		list, appErr := th.App.GetOutstandingMentions(th.BasicUser2.Id, model.NewId(), 0, 60)
+		require.Nil(t, appErr)
+		assert.Empty(t, list.Mentions)
+	})
+}
+
+func TestProcessOutstandingMentions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutstandingMentions = true
+		*cfg.ServiceSettings.EnableOutstandingMentionNudges = true
+		*cfg.ServiceSettings.OutstandingMentionRetentionDays = 7
+	})
+
+	now := model.GetMillis()
+	day := int64(24 * 60 * 60 * 1000)
+	expired := th.CreatePost(th.BasicChannel)
+	stale := th.CreatePost(th.BasicChannel)
+	recent := th.CreatePost(th.BasicChannel)
+	require.NoError(t, th.App.Srv().Store.OutstandingMention().Save([]*model.OutstandingMention{
+		{UserId: th.BasicUser2.Id, PostId: expired.Id, ChannelId: th.BasicChannel.Id, TeamId: th.BasicTeam.Id, MentionedAt: now - 8*day},
+		{UserId: th.BasicUser2.Id, PostId: stale.Id, ChannelId: th.BasicChannel.Id, TeamId: th.BasicTeam.Id, MentionedAt: now - 2*day},
+		{UserId: th.BasicUser2.Id, PostId: recent.Id, ChannelId: th.BasicChannel.Id, TeamId: th.BasicTeam.Id, MentionedAt: now},
+	}))
+
+	require.Nil(t, th.App.ProcessOutstandingMentions())
+
+	list, appErr := th.App.GetOutstandingMentions(th.BasicUser2.Id, "", 0, 60)
+	require.Nil(t, appErr)
+	require.Len(t, list.Mentions, 2, "the mentions past their retention are deleted")
+
+	systemBot, appErr := th.App.GetSystemBot()
+	require.Nil(t, appErr)
+	channel, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.BasicUser2.Id, systemBot.UserId)
+	require.Nil(t, appErr)
+	posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: channel.Id, PerPage: 10})
+	require.Nil(t, appErr)
+	require.Len(t, posts.Order, 1)
+	message := posts.Posts[posts.Order[0]].Message
+	assert.True(t, strings.HasPrefix(message, "You have 1 mention "), message)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const permissionDenialCleanupBatch = 1000
+
+// RecordPermissionDenial keeps track of a request of the session denied for lacking any of
+// the permissions on the resource, when the permission denial log is enabled.
+func (a *App) RecordPermissionDenial(session *model.Session, permissions []*model.Permission, resourceType, resourceID, path string) {
+	if !*a.Config().ServiceSettings.EnablePermissionDenialLog || session == nil || session.UserId == "" || len(permissions) == 0 {
+		return
+	}
+
+	denial := model.NewPermissionDenial(session.UserId, permissions, resourceType, resourceID, path)
+	if _, err := a.Srv().Store.PermissionDenial().Save(denial); err != nil {
+		mlog.Warn("Failed to record permission denial", mlog.String("user_id", session.UserId), mlog.Err(err))
+	}
+}
+
+func (a *App) SearchPermissionDenials(opts *model.PermissionDenialSearch, page, perPage int) ([]*model.PermissionDenial, *model.AppError) {
+	denials, err := a.Srv().Store.PermissionDenial().Search(opts, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("SearchPermissionDenials", "app.permission_denial.search.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return denials, nil
+}
+
+func runPermissionDenialCleanupJob(s *Server) {
+	doPermissionDenialCleanup(s)
+	model.CreateRecurringTask("Permission Denial Cleanup", func() {
+		doPermissionDenialCleanup(s)
+	}, time.Hour)
+}
+
+func doPermissionDenialCleanup(s *Server) {
+	retention := time.Duration(*s.Config().ServiceSettings.PermissionDenialLogRetentionDays) * 24 * time.Hour
+	endTime := model.GetMillisForTime(time.Now().Add(-retention))
+
+	mlog.Debug("Cleaning up permission denial store.")
+
+	for {
+		deleted, err := s.Store.PermissionDenial().PermanentDeleteBatch(endTime, permissionDenialCleanupBatch)
+		if err != nil {
+			mlog.Warn("Error while cleaning up permission denials", mlog.Err(err))
+			return
+		}
+		if deleted < permissionDenialCleanupBatch {
+			return
+		}
+	}
+}
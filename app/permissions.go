@@ -261,3 +261,164 @@ func updateRole(a *App, sc *model.SchemeConveyor, roleCreatedName, defaultRoleNa
 
 	return nil
 }
+
+// ExportScheme returns a portable document describing the scheme and the permissions of its
+// default roles, that ImportScheme can recreate on another server.
+func (a *App) ExportScheme(scheme *model.Scheme) (*model.SchemeConveyor, *model.AppError) {
+	roleNames := []string{}
+	for _, roleName := range scheme.DefaultRoleNames() {
+		if roleName != "" {
+			roleNames = append(roleNames, roleName)
+		}
+	}
+
+	roles, appErr := a.GetRolesByNames(roleNames)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// Only keep what makes sense on another server.
+	exportedRoles := make([]*model.Role, 0, len(roles))
+	for _, role := range roles {
+		exportedRoles = append(exportedRoles, &model.Role{
+			Name:        role.Name,
+			DisplayName: role.DisplayName,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		})
+	}
+
+	return &model.SchemeConveyor{
+		Name:           scheme.Name,
+		DisplayName:    scheme.DisplayName,
+		Description:    scheme.Description,
+		Scope:          scheme.Scope,
+		TeamAdmin:      scheme.DefaultTeamAdminRole,
+		TeamUser:       scheme.DefaultTeamUserRole,
+		TeamGuest:      scheme.DefaultTeamGuestRole,
+		ChannelAdmin:   scheme.DefaultChannelAdminRole,
+		ChannelUser:    scheme.DefaultChannelUserRole,
+		ChannelGuest:   scheme.DefaultChannelGuestRole,
+		PlaybookAdmin:  scheme.DefaultPlaybookAdminRole,
+		PlaybookMember: scheme.DefaultPlaybookMemberRole,
+		RunAdmin:       scheme.DefaultRunAdminRole,
+		RunMember:      scheme.DefaultRunMemberRole,
+		Roles:          exportedRoles,
+	}, nil
+}
+
+// ImportScheme creates the scheme described by a document made by ExportScheme, giving its
+// roles the exported permissions. onConflict decides what happens when a scheme with the same
+// name already exists.
+func (a *App) ImportScheme(conveyor *model.SchemeConveyor, onConflict string) (*model.Scheme, *model.AppError) {
+	if appErr := conveyor.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	schemeIn := conveyor.Scheme()
+	existing, appErr := a.GetSchemeByName(schemeIn.Name)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	if existing != nil {
+		switch onConflict {
+		case model.SchemeImportOnConflictRename:
+			name, appErr := a.availableSchemeName(schemeIn.Name)
+			if appErr != nil {
+				return nil, appErr
+			}
+			schemeIn.Name = name
+		case model.SchemeImportOnConflictOverwrite:
+			return a.overwriteScheme(existing, conveyor)
+		default:
+			return nil, model.NewAppError("ImportScheme", "app.scheme.import.name_exists.app_error", map[string]interface{}{"Name": schemeIn.Name}, "", http.StatusConflict)
+		}
+	}
+
+	// The new Roles are created along with the Scheme.
+	schemeCreated, appErr := a.CreateScheme(schemeIn)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.importSchemeRoles(schemeCreated, conveyor); appErr != nil {
+		rollback(a, []string{schemeCreated.Id})
+		return nil, appErr
+	}
+
+	return schemeCreated, nil
+}
+
+func (a *App) overwriteScheme(scheme *model.Scheme, conveyor *model.SchemeConveyor) (*model.Scheme, *model.AppError) {
+	if scheme.DeleteAt != 0 {
+		return nil, model.NewAppError("ImportScheme", "app.scheme.import.deleted.app_error", map[string]interface{}{"Name": scheme.Name}, "", http.StatusBadRequest)
+	}
+
+	if scheme.Scope != conveyor.Scope {
+		return nil, model.NewAppError("ImportScheme", "app.scheme.import.scope_mismatch.app_error", map[string]interface{}{"Name": scheme.Name}, "", http.StatusBadRequest)
+	}
+
+	scheme.DisplayName = conveyor.DisplayName
+	scheme.Description = conveyor.Description
+	scheme, appErr := a.UpdateScheme(scheme)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.importSchemeRoles(scheme, conveyor); appErr != nil {
+		return nil, appErr
+	}
+
+	return scheme, nil
+}
+
+// importSchemeRoles gives the default roles of the scheme the permissions of the matching roles
+// of the conveyor.
+func (a *App) importSchemeRoles(scheme *model.Scheme, conveyor *model.SchemeConveyor) *model.AppError {
+	conveyorRoleNames := conveyor.Scheme().DefaultRoleNames()
+	for i, roleName := range scheme.DefaultRoleNames() {
+		if roleName == "" || conveyorRoleNames[i] == "" {
+			continue
+		}
+
+		role, appErr := a.GetRoleByName(context.Background(), roleName)
+		if appErr != nil {
+			return appErr
+		}
+
+		roleIn := conveyor.Role(conveyorRoleNames[i])
+		role.DisplayName = roleIn.DisplayName
+		role.Description = roleIn.Description
+		role.Permissions = roleIn.Permissions
+
+		role, appErr = a.UpdateRole(role)
+		if appErr != nil {
+			return appErr
+		}
+		a.sendUpdatedRoleEvent(role)
+	}
+
+	return nil
+}
+
+// availableSchemeName returns the first of name_2, name_3, ... that no scheme uses.
+func (a *App) availableSchemeName(name string) (string, *model.AppError) {
+	for i := 2; i < 100; i++ {
+		suffix := fmt.Sprintf("_%d", i)
+		candidate := name
+		if len(candidate)+len(suffix) > model.SchemeNameMaxLength {
+			candidate = candidate[:model.SchemeNameMaxLength-len(suffix)]
+		}
+		candidate += suffix
+
+		if _, appErr := a.GetSchemeByName(candidate); appErr != nil {
+			if appErr.StatusCode == http.StatusNotFound {
+				return candidate, nil
+			}
+			return "", appErr
+		}
+	}
+
+	return "", model.NewAppError("ImportScheme", "app.scheme.import.name_exists.app_error", map[string]interface{}{"Name": name}, "", http.StatusConflict)
+}
@@ -991,6 +991,105 @@ func (a *App) getPlaybooksPermissionsAddManageRoles() (permissionsMap, error) {
 	return transformations, nil
 }
 
+func (a *App) getAddViewTeamExtendedStatsPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.TeamAdminRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionViewTeamExtendedStats.Id},
+	})
+
+	return transformations, nil
+}
+
+func (a *App) getAddManageChannelCommandsPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.ChannelAdminRoleId),
+			isRole(model.TeamAdminRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionManageChannelCommands.Id},
+	})
+
+	return transformations, nil
+}
+
+func (a *App) getAddManageChannelScheduledMessagesPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.ChannelAdminRoleId),
+			isRole(model.TeamAdminRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionManageChannelScheduledMessages.Id},
+	})
+
+	return transformations, nil
+}
+
+func (a *App) getAddRestorePostsPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.ChannelAdminRoleId),
+			isRole(model.TeamAdminRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionRestorePosts.Id},
+	})
+
+	return transformations, nil
+}
+
+func (a *App) getAddManagePostReportsPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.TeamAdminRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionManagePostReports.Id},
+	})
+
+	return transformations, nil
+}
+
+func (a *App) getAddSearchArchivedChannelsPermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On: permissionOr(
+			isRole(model.SystemUserRoleId),
+			isRole(model.SystemAdminRoleId),
+		),
+		Add: []string{model.PermissionSearchArchivedChannels.Id},
+	})
+
+	return transformations, nil
+}
+
+// getAddDownloadFilePermission lets everyone who can read a channel keep downloading its files.
+func (a *App) getAddDownloadFilePermission() (permissionsMap, error) {
+	transformations := []permissionTransformation{}
+
+	transformations = append(transformations, permissionTransformation{
+		On:  permissionExists(model.PermissionReadChannel.Id),
+		Add: []string{model.PermissionDownloadFile.Id},
+	})
+
+	return transformations, nil
+}
+
 // DoPermissionsMigrations execute all the permissions migrations need by the current version.
 func (a *App) DoPermissionsMigrations() error {
 	return a.Srv().doPermissionsMigrations()
@@ -1032,6 +1131,13 @@ func (s *Server) doPermissionsMigrations() error {
 		{Key: model.MigrationKeyAddPlaybooksPermissions, Migration: a.getAddPlaybooksPermissions},
 		{Key: model.MigrationKeyAddCustomUserGroupsPermissions, Migration: a.getAddCustomUserGroupsPermissions},
 		{Key: model.MigrationKeyAddPlayboosksManageRolesPermissions, Migration: a.getPlaybooksPermissionsAddManageRoles},
+		{Key: model.MigrationKeyAddViewTeamExtendedStatsPermission, Migration: a.getAddViewTeamExtendedStatsPermission},
+		{Key: model.MigrationKeyAddManageChannelCommandsPermission, Migration: a.getAddManageChannelCommandsPermission},
+		{Key: model.MigrationKeyAddChannelScheduledMessagesPermission, Migration: a.getAddManageChannelScheduledMessagesPermission},
+		{Key: model.MigrationKeyAddRestorePostsPermission, Migration: a.getAddRestorePostsPermission},
+		{Key: model.MigrationKeyAddManagePostReportsPermission, Migration: a.getAddManagePostReportsPermission},
+		{Key: model.MigrationKeyAddSearchArchivedChannelsPermission, Migration: a.getAddSearchArchivedChannelsPermission},
+		{Key: model.MigrationKeyAddDownloadFilePermission, Migration: a.getAddDownloadFilePermission},
 	}
 
 	roles, err := s.Store.Role().GetAll()
@@ -76,6 +76,11 @@ func (api *PluginAPI) UnregisterCommand(teamID, trigger string) error {
 	return nil
 }
 
+func (api *PluginAPI) RegisterAuditRecordFilter(eventPrefixes []string) error {
+	api.app.RegisterPluginAuditRecordFilter(api.id, eventPrefixes)
+	return nil
+}
+
 func (api *PluginAPI) ExecuteSlashCommand(commandArgs *model.CommandArgs) (*model.CommandResponse, error) {
 	user, appErr := api.app.GetUser(commandArgs.UserId)
 	if appErr != nil {
@@ -972,6 +977,49 @@ func (api *PluginAPI) PermanentDeleteBot(userID string) *model.AppError {
 	return api.app.PermanentDeleteBot(userID)
 }
 
+// checkBotStateOwner makes sure the bot is owned by the plugin, plugins only having access to the
+// states of their own bots.
+func (api *PluginAPI) checkBotStateOwner(botUserID string) *model.AppError {
+	bot, appErr := api.app.GetBot(botUserID, true)
+	if appErr != nil {
+		return appErr
+	}
+
+	if bot.OwnerId != api.id {
+		return model.NewAppError("checkBotStateOwner", "app.bot_state.not_owner.app_error", nil, "bot_user_id="+botUserID, http.StatusForbidden)
+	}
+
+	return nil
+}
+
+func (api *PluginAPI) BotStateGet(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError) {
+	if appErr := api.checkBotStateOwner(botUserID); appErr != nil {
+		return nil, appErr
+	}
+	return api.app.GetBotState(botUserID, userID, channelID, key)
+}
+
+func (api *PluginAPI) BotStateSet(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError) {
+	if appErr := api.checkBotStateOwner(botUserID); appErr != nil {
+		return nil, appErr
+	}
+	return api.app.SetBotState(botUserID, userID, channelID, key, value, expireInSeconds)
+}
+
+func (api *PluginAPI) BotStateDelete(botUserID, userID, channelID, key string) *model.AppError {
+	if appErr := api.checkBotStateOwner(botUserID); appErr != nil {
+		return appErr
+	}
+	return api.app.DeleteBotState(botUserID, userID, channelID, key)
+}
+
+func (api *PluginAPI) BotStateList(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError) {
+	if appErr := api.checkBotStateOwner(botUserID); appErr != nil {
+		return nil, appErr
+	}
+	return api.app.ListBotStateKeys(botUserID, userID, channelID, page, perPage)
+}
+
 func (api *PluginAPI) PublishUserTyping(userID, channelID, parentId string) *model.AppError {
 	return api.app.PublishUserTyping(userID, channelID, parentId)
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/mattermost/mattermost-server/v6/audit"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// RegisterPluginAuditRecordFilter sets the event prefixes an audit record must match to be
+// passed to the OnAuditRecord hook of the plugin. No prefixes remove the filter.
+func (a *App) RegisterPluginAuditRecordFilter(pluginID string, eventPrefixes []string) {
+	a.ch.pluginAuditFiltersLock.Lock()
+	defer a.ch.pluginAuditFiltersLock.Unlock()
+
+	if len(eventPrefixes) == 0 {
+		delete(a.ch.pluginAuditFilters, pluginID)
+		return
+	}
+
+	a.ch.pluginAuditFilters[pluginID] = append([]string(nil), eventPrefixes...)
+}
+
+func (ch *Channels) unregisterPluginAuditRecordFilter(pluginID string) {
+	ch.pluginAuditFiltersLock.Lock()
+	defer ch.pluginAuditFiltersLock.Unlock()
+
+	delete(ch.pluginAuditFilters, pluginID)
+}
+
+func (ch *Channels) pluginAuditRecordFilter(pluginID string) []string {
+	ch.pluginAuditFiltersLock.RLock()
+	defer ch.pluginAuditFiltersLock.RUnlock()
+
+	return ch.pluginAuditFilters[pluginID]
+}
+
+// newModelAuditRecord converts the audit record to the form it's passed to plugins and kept in
+// the database in.
+func newModelAuditRecord(rec audit.Record) *model.AuditRecord {
+	record := &model.AuditRecord{
+		APIPath:   rec.APIPath,
+		Event:     rec.Event,
+		Status:    rec.Status,
+		UserID:    rec.UserID,
+		SessionID: rec.SessionID,
+		Client:    rec.Client,
+		IPAddress: rec.IPAddress,
+	}
+
+	// Meta values can be of any type, so they go through JSON to only keep what can be decoded.
+	if len(rec.Meta) > 0 {
+		if b, err := json.Marshal(rec.Meta); err != nil {
+			mlog.Warn("Failed to encode audit record meta", mlog.String("event", rec.Event), mlog.Err(err))
+		} else if err := json.Unmarshal(b, &record.Meta); err != nil {
+			mlog.Warn("Failed to decode audit record meta", mlog.String("event", rec.Event), mlog.Err(err))
+		}
+	}
+
+	return record
+}
+
+// passAuditRecordToPlugins passes a written audit record to the OnAuditRecord hook of the active
+// plugins whose filter matches it, without holding up the caller.
+func (s *Server) passAuditRecordToPlugins(rec audit.Record) {
+	ch := s.Channels()
+	if ch == nil {
+		return
+	}
+	pluginsEnvironment := ch.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return
+	}
+
+	s.Go(func() {
+		active := pluginsEnvironment.Active()
+		if len(active) == 0 {
+			return
+		}
+
+		record := newModelAuditRecord(rec)
+		for _, info := range active {
+			pluginID := info.Manifest.Id
+			if !record.MatchesEventPrefixes(ch.pluginAuditRecordFilter(pluginID)) {
+				continue
+			}
+
+			hooks, err := pluginsEnvironment.HooksForPlugin(pluginID)
+			if err != nil {
+				continue
+			}
+			hooks.OnAuditRecord(record)
+		}
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/audit"
 	"github.com/mattermost/mattermost-server/v6/einterfaces/mocks"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/plugin"
@@ -1302,3 +1303,131 @@ func TestHookOnSendDailyTelemetry(t *testing.T) {
 
 	require.True(t, hookCalled)
 }
+
+func TestHookOnAuditRecord(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	tearDown, pluginIDs, _ := SetAppEnvironmentWithPlugins(t,
+		[]string{
+			`
+		package main
+
+		import (
+			"github.com/mattermost/mattermost-server/v6/model"
+			"github.com/mattermost/mattermost-server/v6/plugin"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) OnActivate() error {
+			return p.API.RegisterAuditRecordFilter([]string{"localCreate"})
+		}
+
+		func (p *MyPlugin) OnAuditRecord(record *model.AuditRecord) {
+			teamID, _ := record.Meta["team_id"].(string)
+			p.API.KVSet(record.Event, []byte(record.UserID+":"+teamID))
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+	`}, th.App, th.NewPluginAPI)
+	defer tearDown()
+
+	require.Len(t, pluginIDs, 1)
+	pluginID := pluginIDs[0]
+
+	require.True(t, th.App.GetPluginsEnvironment().IsActive(pluginID))
+
+	deleteRec := th.App.MakeAuditRecord("localDeleteTeam", audit.Success)
+	th.App.LogAuditRec(deleteRec, nil)
+
+	createRec := th.App.MakeAuditRecord("localCreateTeam", audit.Success)
+	createRec.AddMeta("team_id", th.BasicTeam.Id)
+	th.App.LogAuditRec(createRec, nil)
+
+	require.Eventually(t, func() bool {
+		value, appErr := th.App.GetPluginKey(pluginID, "localCreateTeam")
+		return appErr == nil && string(value) == createRec.UserID+":"+th.BasicTeam.Id
+	}, 5*time.Second, 100*time.Millisecond)
+
+	value, appErr := th.App.GetPluginKey(pluginID, "localDeleteTeam")
+	require.Nil(t, appErr)
+	assert.Nil(t, value)
+}
+
+func TestHookInviteUsersToTeamWillBeSent(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	tearDown, _, _ := SetAppEnvironmentWithPlugins(t,
+		[]string{
+			`
+		package main
+
+		import (
+			"strings"
+
+			"github.com/mattermost/mattermost-server/v6/model"
+			"github.com/mattermost/mattermost-server/v6/plugin"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) InviteUsersToTeamWillBeSent(c *plugin.Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string) {
+			var allowed []string
+			for _, email := range invite.Emails {
+				if strings.HasSuffix(email, "@blocked.com") {
+					return nil, "blocked domain"
+				}
+				if strings.HasSuffix(email, "@example.com") {
+					allowed = append(allowed, email)
+				}
+			}
+			invite.Emails = allowed
+			invite.TeamId = model.NewId()
+			invite.Metadata = map[string]string{"hr_checked": "true"}
+			return invite, ""
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+	`}, th.App, th.NewPluginAPI)
+	defer tearDown()
+
+	t.Run("rewrites the invite", func(t *testing.T) {
+		invite, appErr := th.App.RunInviteUsersToTeamWillBeSentHook(th.Context, &model.TeamEmailInvite{
+			TeamId:   th.BasicTeam.Id,
+			SenderId: th.BasicUser.Id,
+			Emails:   []string{"one@example.com", "two@other.com"},
+		})
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicTeam.Id, invite.TeamId)
+		assert.Equal(t, []string{"one@example.com"}, invite.Emails)
+		assert.Equal(t, "true", invite.Metadata["hr_checked"])
+	})
+
+	t.Run("rejects the invite", func(t *testing.T) {
+		_, appErr := th.App.RunInviteUsersToTeamWillBeSentHook(th.Context, &model.TeamEmailInvite{
+			TeamId: th.BasicTeam.Id,
+			Emails: []string{"one@example.com", "two@blocked.com"},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.invite_members.rejected_by_plugin.app_error", appErr.Id)
+	})
+
+	t.Run("no one left to invite", func(t *testing.T) {
+		_, appErr := th.App.RunInviteUsersToTeamWillBeSentHook(th.Context, &model.TeamEmailInvite{
+			TeamId: th.BasicTeam.Id,
+			Emails: []string{"two@other.com"},
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.team.invite_members.no_one.app_error", appErr.Id)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/store"
 	"github.com/mattermost/mattermost-server/v6/store/sqlstore"
+	"github.com/mattermost/mattermost-server/v6/utils"
 )
 
 const (
@@ -138,7 +140,12 @@ func (a *App) deduplicateCreatePost(post *model.Post) (foundPost *model.Post, er
 	return actualPost, nil
 }
 
-func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Channel, triggerWebhooks, setOnline bool) (savedPost *model.Post, err *model.AppError) {
+func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Channel, triggerWebhooks, setOnline bool) (*model.Post, *model.AppError) {
+	return a.createPost(c, post, channel, triggerWebhooks, setOnline, true)
+}
+
+// createPost creates the post, running it through the moderation pipeline when moderate is true.
+func (a *App) createPost(c *request.Context, post *model.Post, channel *model.Channel, triggerWebhooks, setOnline, moderate bool) (savedPost *model.Post, err *model.AppError) {
 	foundPost, err := a.deduplicateCreatePost(post)
 	if err != nil {
 		return nil, err
@@ -218,11 +225,21 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 
 		rootPost := parentPostList.Posts[post.RootId]
 		if rootPost.RootId != "" {
-			return nil, model.NewAppError("createPost", "api.post.create_post.root_id.app_error", nil, "", http.StatusBadRequest)
+			if !channel.ForceThreadedReplies {
+				return nil, model.NewAppError("createPost", "api.post.create_post.root_id.app_error", nil, "", http.StatusBadRequest)
+			}
+
+			// Channels forcing threaded replies keep the replies to a reply in its thread.
+			post.RootId = rootPost.RootId
+			parentPostList, nErr = a.Srv().Store.Post().Get(sqlstore.WithMaster(context.Background()), post.RootId, false, false, false, "")
+			if nErr != nil {
+				return nil, model.NewAppError("createPost", "api.post.create_post.root_id.app_error", nil, "", http.StatusBadRequest)
+			}
 		}
 	}
 
 	post.Hashtags, _ = model.ParseHashtags(post.Message)
+	a.setPostLanguage(post)
 
 	if err = a.FillInPostProps(post, channel); err != nil {
 		return nil, err
@@ -266,6 +283,13 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 		}
 	}
 
+	var moderationVerdict *model.PostModerationVerdict
+	if moderate {
+		if moderationVerdict, err = a.moderatePost(post, channel); err != nil {
+			return nil, err
+		}
+	}
+
 	// Pre-fill the CreateAt field for link previews to get the correct timestamp.
 	if post.CreateAt == 0 {
 		post.CreateAt = model.GetMillis()
@@ -277,7 +301,19 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 		post.AddProp(model.PostPropsPreviewedPost, previewPost.PostID)
 	}
 
-	rpost, nErr := a.Srv().Store.Post().Save(post)
+	var task *model.PostTask
+	if post.Type == model.PostTypeTask {
+		if task, err = a.newPostTask(post); err != nil {
+			return nil, err
+		}
+	}
+
+	priority, err := a.newPostPriority(post)
+	if err != nil {
+		return nil, err
+	}
+
+	rpost, nErr := a.Srv().Store.Post().SaveWithExtras(post, store.PostExtras{Priority: priority, Task: task})
 	if nErr != nil {
 		var appErr *model.AppError
 		var invErr *store.ErrInvalidInput
@@ -291,6 +327,13 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 		}
 	}
 
+	if priority != nil {
+		if rpost.Metadata == nil {
+			rpost.Metadata = &model.PostMetadata{}
+		}
+		rpost.Metadata.Priority = priority
+	}
+
 	// Update the mapping from pending post id to the actual post id, for any clients that
 	// might be duplicating requests.
 	a.Srv().seenPendingPostIdsCache.SetWithExpiry(post.PendingPostId, rpost.Id, PendingPostIDsCacheTTL)
@@ -319,6 +362,10 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 		a.Metrics().IncrementPostCreate()
 	}
 
+	a.recordPostActivity(rpost, channel)
+	a.resolveOutstandingMentionsForPost(rpost)
+	a.enqueuePostRESTProxyBridgeEvent(model.RESTProxyBridgeEventPostCreated, rpost, channel)
+
 	if len(post.FileIds) > 0 {
 		if err = a.attachFilesToPost(post); err != nil {
 			mlog.Warn("Encountered error attaching files to post", mlog.String("post_id", post.Id), mlog.Any("file_ids", post.FileIds), mlog.Err(err))
@@ -348,6 +395,10 @@ func (a *App) CreatePost(c *request.Context, post *model.Post, channel *model.Ch
 		mlog.Warn("Failed to handle post events", mlog.Err(err))
 	}
 
+	if moderationVerdict != nil {
+		a.queueFlaggedPost(rpost, channel, moderationVerdict)
+	}
+
 	// Send any ephemeral posts after the post is created to ensure it shows up after the latest post created
 	if ephemeralPost != nil {
 		a.SendEphemeralPost(post.UserId, ephemeralPost)
@@ -557,6 +608,12 @@ func (a *App) DeleteEphemeralPost(userID, postID string) {
 }
 
 func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool) (*model.Post, *model.AppError) {
+	return a.updatePost(c, post, safeUpdate, true)
+}
+
+// updatePost edits the post, running the edited message through the moderation pipeline when
+// moderate is true.
+func (a *App) updatePost(c *request.Context, post *model.Post, safeUpdate, moderate bool) (*model.Post, *model.AppError) {
 	post.SanitizeProps()
 
 	postLists, nErr := a.Srv().Store.Post().Get(context.Background(), post.Id, false, false, false, "")
@@ -621,6 +678,8 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		newPost.SetProps(post.GetProps())
 	}
 
+	a.setPostLanguage(newPost)
+
 	// Avoid deep-equal checks if EditAt was already modified through message change
 	if newPost.EditAt == oldPost.EditAt && (!oldPost.FileIds.Equals(newPost.FileIds) || !oldPost.AttachmentsEqual(newPost)) {
 		newPost.EditAt = model.GetMillis()
@@ -646,6 +705,13 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		}
 	}
 
+	var moderationVerdict *model.PostModerationVerdict
+	if moderate && newPost.Message != oldPost.Message {
+		if moderationVerdict, err = a.moderatePost(newPost, channel); err != nil {
+			return nil, err
+		}
+	}
+
 	rpost, nErr := a.Srv().Store.Post().Update(newPost, oldPost)
 	if nErr != nil {
 		var appErr *model.AppError
@@ -667,6 +733,8 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 		})
 	}
 
+	a.enqueuePostRESTProxyBridgeEvent(model.RESTProxyBridgeEventPostEdited, rpost, channel)
+
 	rpost = a.PreparePostForClientWithEmbedsAndImages(rpost, false, true)
 
 	// Ensure IsFollowing is nil since this updated post will be broadcast to all users
@@ -696,6 +764,10 @@ func (a *App) UpdatePost(c *request.Context, post *model.Post, safeUpdate bool)
 
 	a.invalidateCacheForChannelPosts(rpost.ChannelId)
 
+	if moderationVerdict != nil {
+		a.queueFlaggedPost(rpost, channel, moderationVerdict)
+	}
+
 	return rpost, nil
 }
 
@@ -1160,6 +1232,8 @@ func (a *App) DeletePost(postID, deleteByID string) (*model.Post, *model.AppErro
 	a.Srv().Go(func() {
 		a.deleteFlaggedPosts(post.Id)
 	})
+	a.deletePostActivity(post.Id)
+	a.enqueuePostDeletedRESTProxyBridgeEvent(post, channel, deleteByID)
 
 	a.invalidateCacheForChannelPosts(post.ChannelId)
 
@@ -1174,8 +1248,21 @@ func (a *App) deleteFlaggedPosts(postID string) {
 }
 
 func (a *App) deletePostFiles(postID string) {
+	infos, err := a.Srv().Store.FileInfo().GetForPost(postID, true, false, false)
+	if err != nil {
+		mlog.Warn("Encountered error when getting files for post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+
 	if _, err := a.Srv().Store.FileInfo().DeleteForPost(postID); err != nil {
 		mlog.Warn("Encountered error when deleting files for post", mlog.String("post_id", postID), mlog.Err(err))
+		return
+	}
+
+	for _, info := range infos {
+		// External files are only referenced, and never watermarked from a stored copy.
+		if !info.IsExternal() {
+			a.removeWatermarkedFiles(info.Path, info.ThumbnailPath, info.PreviewPath)
+		}
 	}
 }
 
@@ -1265,8 +1352,14 @@ func (a *App) convertChannelNamesToChannelIds(c *request.Context, channels []str
 	return channels
 }
 
-func (a *App) convertUserNameToUserIds(usernames []string) []string {
+func (a *App) convertUserNameToUserIds(userID string, usernames []string) []string {
 	for idx, username := range usernames {
+		// "me" stands for the searching user, even if a user is named "me"
+		if strings.EqualFold(username, model.SearchFromMe) {
+			usernames[idx] = userID
+			continue
+		}
+
 		user, err := a.GetUserByUsername(username)
 		if err != nil {
 			mlog.Warn("error getting user by username", mlog.String("user_name", username), mlog.Err(err))
@@ -1303,12 +1396,12 @@ func (a *App) SearchPostsForUser(c *request.Context, terms string, userID string
 		// Don't allow users to search for "*"
 		if params.Terms != "*" {
 			// Convert channel names to channel IDs
-			params.InChannels = a.convertChannelNamesToChannelIds(c, params.InChannels, userID, teamID, includeDeletedChannels)
-			params.ExcludedChannels = a.convertChannelNamesToChannelIds(c, params.ExcludedChannels, userID, teamID, includeDeletedChannels)
+			params.InChannels = a.convertChannelNamesToChannelIds(c, params.InChannels, userID, teamID, includeDeleted)
+			params.ExcludedChannels = a.convertChannelNamesToChannelIds(c, params.ExcludedChannels, userID, teamID, includeDeleted)
 
 			// Convert usernames to user IDs
-			params.FromUsers = a.convertUserNameToUserIds(params.FromUsers)
-			params.ExcludedUsers = a.convertUserNameToUserIds(params.ExcludedUsers)
+			params.FromUsers = a.convertUserNameToUserIds(userID, params.FromUsers)
+			params.ExcludedUsers = a.convertUserNameToUserIds(userID, params.ExcludedUsers)
 
 			finalParamsList = append(finalParamsList, params)
 		}
@@ -1319,6 +1412,10 @@ func (a *App) SearchPostsForUser(c *request.Context, terms string, userID string
 		return model.MakePostSearchResults(model.NewPostList(), nil), nil
 	}
 
+	if appErr := a.checkPostSearchOperators(finalParamsList); appErr != nil {
+		return nil, appErr
+	}
+
 	postSearchResults, nErr := a.Srv().Store.Post().SearchPostsForUser(finalParamsList, userID, teamID, page, perPage)
 	if nErr != nil {
 		var appErr *model.AppError
@@ -1330,9 +1427,79 @@ func (a *App) SearchPostsForUser(c *request.Context, terms string, userID string
 		}
 	}
 
+	if includeDeleted {
+		channelIDs := make([]string, 0, len(postSearchResults.Posts))
+		for _, post := range postSearchResults.Posts {
+			channelIDs = append(channelIDs, post.ChannelId)
+		}
+		archivedChannelIDs, appErr := a.archivedChannelIds(channelIDs)
+		if appErr != nil {
+			return nil, appErr
+		}
+		postSearchResults.ArchivedChannelIds = archivedChannelIDs
+	}
+
 	return postSearchResults, nil
 }
 
+// archivedChannelIds returns the ids of the archived channels among the channels of search
+// results. The results are annotated here rather than by the search backends so that they are
+// annotated alike whichever backend ran the search.
+func (a *App) archivedChannelIds(channelIDs []string) ([]string, *model.AppError) {
+	channelIDs = model.RemoveDuplicateStrings(channelIDs)
+	if len(channelIDs) == 0 {
+		return nil, nil
+	}
+
+	channels, err := a.Srv().Store.Channel().GetChannelsByIds(channelIDs, true)
+	if err != nil {
+		return nil, model.NewAppError("archivedChannelIds", "app.channel.get_channels_by_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var archivedChannelIDs []string
+	for _, channel := range channels {
+		if channel.DeleteAt > 0 {
+			archivedChannelIDs = append(archivedChannelIDs, channel.Id)
+		}
+	}
+	sort.Strings(archivedChannelIDs)
+
+	return archivedChannelIDs, nil
+}
+
+// GetPostSearchCapabilities returns the backend that post searches run against and the
+// search operators it supports.
+func (a *App) GetPostSearchCapabilities() *model.SearchCapabilities {
+	return a.Srv().Store.Post().GetSearchCapabilities()
+}
+
+// checkPostSearchOperators makes sure that the search operators used by the params are
+// supported by the active search backend, and that any regular expressions are bounded.
+func (a *App) checkPostSearchOperators(paramsList []*model.SearchParams) *model.AppError {
+	var capabilities *model.SearchCapabilities
+	for _, params := range paramsList {
+		for _, operator := range params.Operators() {
+			if capabilities == nil {
+				capabilities = a.GetPostSearchCapabilities()
+			}
+			if !utils.StringInSlice(operator, capabilities.Operators) {
+				return model.NewAppError("SearchPostsForUser", "app.post.search.unsupported_operator.app_error", map[string]interface{}{"Operator": operator}, "backend="+capabilities.Backend, http.StatusBadRequest)
+			}
+		}
+
+		if len(params.RegexTerms) > model.SearchRegexMaxTerms {
+			return model.NewAppError("SearchPostsForUser", "app.post.search.invalid_regex.app_error", map[string]interface{}{"MaxLength": model.SearchRegexMaxLength, "MaxTerms": model.SearchRegexMaxTerms}, "", http.StatusBadRequest)
+		}
+		for _, regexTerm := range params.RegexTerms {
+			if !model.IsValidSearchRegex(regexTerm) {
+				return model.NewAppError("SearchPostsForUser", "app.post.search.invalid_regex.app_error", map[string]interface{}{"MaxLength": model.SearchRegexMaxLength, "MaxTerms": model.SearchRegexMaxTerms}, "pattern="+regexTerm, http.StatusBadRequest)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (a *App) GetFileInfosForPostWithMigration(postID string) ([]*model.FileInfo, *model.AppError) {
 
 	pchan := make(chan store.StoreResult, 1)
@@ -1364,6 +1531,7 @@ func (a *App) GetFileInfosForPostWithMigration(postID string) ([]*model.FileInfo
 		if len(post.Filenames) > 0 {
 			a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(postID, false)
 			a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(postID, true)
+			a.invalidatePostMetadataCache(postID)
 			// The post has Filenames that need to be replaced with FileInfos
 			infos = a.MigrateFilenamesToFileInfos(post)
 		}
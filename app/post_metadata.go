@@ -20,6 +20,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/services/cache"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/markdown"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/utils/imgutils"
@@ -47,6 +48,7 @@ func (s *Server) initPostMetadata() {
 			(before.ImageProxySettings.RemoteImageProxyURL != after.ImageProxySettings.RemoteImageProxyURL) ||
 			(before.ImageProxySettings.RemoteImageProxyOptions != after.ImageProxySettings.RemoteImageProxyOptions) {
 			linkCache.Purge()
+			s.postMetadataCache.Purge()
 		}
 	})
 }
@@ -59,10 +61,15 @@ func (a *App) PreparePostListForClient(originalList *model.PostList) *model.Post
 		PrevPostId: originalList.PrevPostId,
 	}
 
+	ids := make([]string, 0, len(originalList.Posts))
+	originalPosts := make([]*model.Post, 0, len(originalList.Posts))
 	for id, originalPost := range originalList.Posts {
-		post := a.PreparePostForClientWithEmbedsAndImages(originalPost, false, false)
+		ids = append(ids, id)
+		originalPosts = append(originalPosts, originalPost)
+	}
 
-		list.Posts[id] = post
+	for i, post := range a.PreparePostsForClient(originalPosts) {
+		list.Posts[ids[i]] = post
 	}
 
 	return list
@@ -94,7 +101,9 @@ func (a *App) OverrideIconURLIfEmoji(post *model.Post) {
 	}
 }
 
-func (a *App) PreparePostForClient(originalPost *model.Post, isNewPost, isEditPost bool) *model.Post {
+// clonePostForClient copies the post with proxied image links and an initialized metadata,
+// ready to be filled in.
+func (a *App) clonePostForClient(originalPost *model.Post) *model.Post {
 	post := originalPost.Clone()
 
 	// Proxy image links before constructing metadata so that requests go through the proxy
@@ -109,9 +118,24 @@ func (a *App) PreparePostForClient(originalPost *model.Post, isNewPost, isEditPo
 		// For deleted posts we don't fill out metadata nor do we return the post content
 		post.Message = ""
 		post.Metadata = &model.PostMetadata{}
+	}
+
+	return post
+}
+
+func (a *App) PreparePostForClient(originalPost *model.Post, isNewPost, isEditPost bool) *model.Post {
+	post := a.clonePostForClient(originalPost)
+	if post.DeleteAt > 0 {
 		return post
 	}
 
+	// System posts stored with their props only get their message in the language of the server
+	if post.Message == "" {
+		if message, ok := renderSystemPostMessage(post, i18n.T); ok {
+			post.Message = message
+		}
+	}
+
 	// Emojis and reaction counts
 	if emojis, reactions, err := a.getEmojisAndReactionsForPost(post); err != nil {
 		mlog.Warn("Failed to get emojis and reactions for a post", mlog.String("post_id", post.Id), mlog.Err(err))
@@ -127,12 +151,118 @@ func (a *App) PreparePostForClient(originalPost *model.Post, isNewPost, isEditPo
 		post.Metadata.Files = fileInfos
 	}
 
+	// Priority and acknowledgements, which are already known for new posts
+	if !isNewPost {
+		if priority, acknowledgements, err := a.getPriorityAndAcknowledgementsForPost(post); err != nil {
+			mlog.Warn("Failed to get priority for a post", mlog.String("post_id", post.Id), mlog.Err(err))
+		} else {
+			post.Metadata.Priority = priority
+			post.Metadata.Acknowledgements = acknowledgements
+		}
+	}
+
 	return post
 }
 
+// PreparePostsForClient does what PreparePostForClientWithEmbedsAndImages does for each of
+// the posts, but loads the reactions, files, priorities and acknowledgements of all the
+// posts at once.
+func (a *App) PreparePostsForClient(originalPosts []*model.Post) []*model.Post {
+	posts := make([]*model.Post, 0, len(originalPosts))
+	// The posts whose metadata is to be computed, by their index in posts.
+	uncached := make(map[int]*model.Post, len(originalPosts))
+	var reactionPostIDs, filePostIDs, rootPostIDs []string
+	for _, originalPost := range originalPosts {
+		if post := a.getPostWithCachedMetadata(originalPost); post != nil {
+			posts = append(posts, post)
+			continue
+		}
+
+		post := a.clonePostForClient(originalPost)
+		uncached[len(posts)] = originalPost
+		posts = append(posts, post)
+		if post.DeleteAt > 0 {
+			continue
+		}
+
+		if post.HasReactions {
+			reactionPostIDs = append(reactionPostIDs, post.Id)
+		}
+		if len(post.FileIds) > 0 {
+			filePostIDs = append(filePostIDs, post.Id)
+		}
+		if post.RootId == "" {
+			rootPostIDs = append(rootPostIDs, post.Id)
+		}
+	}
+
+	reactions := map[string][]*model.Reaction{}
+	if len(reactionPostIDs) > 0 {
+		var appErr *model.AppError
+		if reactions, appErr = a.GetBulkReactionsForPosts(reactionPostIDs); appErr != nil {
+			mlog.Warn("Failed to get reactions for posts", mlog.Err(appErr))
+			reactions = map[string][]*model.Reaction{}
+		}
+	}
+
+	files := map[string][]*model.FileInfo{}
+	if len(filePostIDs) > 0 {
+		if fileInfos, err := a.Srv().Store.FileInfo().GetForPosts(filePostIDs); err != nil {
+			mlog.Warn("Failed to get files for posts", mlog.Err(err))
+		} else {
+			a.generateMiniPreviewForInfos(fileInfos)
+			for _, fileInfo := range fileInfos {
+				files[fileInfo.PostId] = append(files[fileInfo.PostId], fileInfo)
+			}
+		}
+	}
+
+	priorities, acknowledgements, err := a.getPrioritiesAndAcknowledgementsForPosts(rootPostIDs)
+	if err != nil {
+		mlog.Warn("Failed to get priorities for posts", mlog.Err(err))
+	}
+
+	for i, originalPost := range uncached {
+		post := posts[i]
+		if post.DeleteAt > 0 {
+			continue
+		}
+
+		post.Metadata.Reactions = reactions[post.Id]
+		if emojis, appErr := a.getCustomEmojisForPost(post, post.Metadata.Reactions); appErr != nil {
+			mlog.Warn("Failed to get emojis for a post", mlog.String("post_id", post.Id), mlog.Err(appErr))
+		} else {
+			post.Metadata.Emojis = emojis
+		}
+
+		post.Metadata.Files = files[post.Id]
+		post.Metadata.Priority = priorities[post.Id]
+		post.Metadata.Acknowledgements = acknowledgements[post.Id]
+
+		a.getEmbedsAndImages(post, false)
+		a.cachePostMetadata(originalPost, post)
+	}
+
+	return posts
+}
+
+// PreparePostForClientWithEmbedsAndImages prepares the post for the client along with its
+// embeds and images. The metadata of posts that are neither new nor edited is cached for as
+// long as the post doesn't change.
 func (a *App) PreparePostForClientWithEmbedsAndImages(originalPost *model.Post, isNewPost, isEditPost bool) *model.Post {
+	cacheable := !isNewPost && !isEditPost
+	if cacheable {
+		if post := a.getPostWithCachedMetadata(originalPost); post != nil {
+			return post
+		}
+	}
+
 	post := a.PreparePostForClient(originalPost, isNewPost, isEditPost)
 	post = a.getEmbedsAndImages(post, isNewPost)
+
+	if cacheable {
+		a.cachePostMetadata(originalPost, post)
+	}
 	return post
 }
 
@@ -163,6 +293,47 @@ func (a *App) getEmbedsAndImages(post *model.Post, isNewPost bool) *model.Post {
 }
 
 func (a *App) SanitizePostMetadataForUser(post *model.Post, userID string) (*model.Post, *model.AppError) {
+	return a.sanitizePostMetadataForUser(post, userID, a.getMutedKeywordsForSanitizing(userID), nil)
+}
+
+// getPreviewedChannels returns the channels of the posts previewed by permalinks in the posts,
+// by id, fetched at once.
+func (a *App) getPreviewedChannels(posts []*model.Post) (map[string]*model.Channel, *model.AppError) {
+	var channelIDs []string
+	seen := make(map[string]bool)
+	for _, post := range posts {
+		if post.Metadata == nil {
+			continue
+		}
+		previewPost := post.GetPreviewPost()
+		if previewPost == nil || seen[previewPost.Post.ChannelId] {
+			continue
+		}
+		seen[previewPost.Post.ChannelId] = true
+		channelIDs = append(channelIDs, previewPost.Post.ChannelId)
+	}
+
+	channels := make(map[string]*model.Channel, len(channelIDs))
+	if len(channelIDs) == 0 {
+		return channels, nil
+	}
+
+	list, err := a.GetChannelsByIds(channelIDs, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range list {
+		channels[channel.Id] = channel
+	}
+	return channels, nil
+}
+
+// sanitizePostMetadataForUser flags the muted keywords of the user in the post, and removes its
+// permalink preview if the user can't read the previewed post. The channel of the previewed post
+// is looked up in previewedChannels first, when given.
+func (a *App) sanitizePostMetadataForUser(post *model.Post, userID string, mutedKeywords model.MutedKeywords, previewedChannels map[string]*model.Channel) (*model.Post, *model.AppError) {
+	post = flagMutedKeywords(post, userID, mutedKeywords)
+
 	if post.Metadata == nil || len(post.Metadata.Embeds) == 0 {
 		return post, nil
 	}
@@ -172,9 +343,13 @@ func (a *App) SanitizePostMetadataForUser(post *model.Post, userID string) (*mod
 		return post, nil
 	}
 
-	previewedChannel, err := a.GetChannel(previewPost.Post.ChannelId)
-	if err != nil {
-		return nil, err
+	previewedChannel, ok := previewedChannels[previewPost.Post.ChannelId]
+	if !ok {
+		var err *model.AppError
+		previewedChannel, err = a.GetChannel(previewPost.Post.ChannelId)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if previewedChannel != nil && !a.HasPermissionToReadChannel(userID, previewedChannel) {
@@ -186,9 +361,20 @@ func (a *App) SanitizePostMetadataForUser(post *model.Post, userID string) (*mod
 }
 
 func (a *App) SanitizePostListMetadataForUser(postList *model.PostList, userID string) (*model.PostList, *model.AppError) {
+	mutedKeywords := a.getMutedKeywordsForSanitizing(userID)
+
 	clonedPostList := postList.Clone()
+	posts := make([]*model.Post, 0, len(clonedPostList.Posts))
+	for _, post := range clonedPostList.Posts {
+		posts = append(posts, post)
+	}
+	previewedChannels, err := a.getPreviewedChannels(posts)
+	if err != nil {
+		return nil, err
+	}
+
 	for postID, post := range clonedPostList.Posts {
-		sanitizedPost, err := a.SanitizePostMetadataForUser(post, userID)
+		sanitizedPost, err := a.sanitizePostMetadataForUser(post, userID, mutedKeywords, previewedChannels)
 		if err != nil {
 			return nil, err
 		}
@@ -197,6 +383,24 @@ func (a *App) SanitizePostListMetadataForUser(postList *model.PostList, userID s
 	return clonedPostList, nil
 }
 
+func (a *App) SanitizePostsMetadataForUser(posts []*model.Post, userID string) ([]*model.Post, *model.AppError) {
+	mutedKeywords := a.getMutedKeywordsForSanitizing(userID)
+	previewedChannels, err := a.getPreviewedChannels(posts)
+	if err != nil {
+		return nil, err
+	}
+
+	sanitizedPosts := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		sanitizedPost, err := a.sanitizePostMetadataForUser(post, userID, mutedKeywords, previewedChannels)
+		if err != nil {
+			return nil, err
+		}
+		sanitizedPosts = append(sanitizedPosts, sanitizedPost)
+	}
+	return sanitizedPosts, nil
+}
+
 func (a *App) getFileMetadataForPost(post *model.Post, fromMaster bool) ([]*model.FileInfo, *model.AppError) {
 	if len(post.FileIds) == 0 {
 		return nil, nil
@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	postMetadataCacheName = "PostMetadata"
+	postMetadataCacheSize = 20000
+	// postMetadataCacheDuration bounds how long embeds fetched from other sites are reused
+	// without being looked up again.
+	postMetadataCacheDuration = 15 * time.Minute
+)
+
+// postMetadataCacheEntry is the metadata prepared for the client of a post, as of the version
+// of the post it was computed for. The metadata is kept as JSON, like the OpenGraph data, since
+// the data of its embeds are of any type.
+type postMetadataCacheEntry struct {
+	UpdateAt int64
+	Metadata []byte
+}
+
+// getPostWithCachedMetadata returns the post prepared for the client with the metadata cached
+// for it, or nil when none was cached for the current version of the post.
+func (a *App) getPostWithCachedMetadata(originalPost *model.Post) *model.Post {
+	if originalPost.Id == "" || originalPost.DeleteAt > 0 {
+		return nil
+	}
+
+	metrics := a.Metrics()
+
+	var entry postMetadataCacheEntry
+	if err := a.Srv().postMetadataCache.Get(originalPost.Id, &entry); err != nil || entry.UpdateAt != originalPost.UpdateAt {
+		if metrics != nil {
+			metrics.IncrementMemCacheMissCounter(postMetadataCacheName)
+		}
+		return nil
+	}
+
+	var metadata model.PostMetadata
+	if err := json.Unmarshal(entry.Metadata, &metadata); err != nil {
+		mlog.Warn("Failed to decode the cached metadata of a post", mlog.String("post_id", originalPost.Id), mlog.Err(err))
+		if metrics != nil {
+			metrics.IncrementMemCacheMissCounter(postMetadataCacheName)
+		}
+		return nil
+	}
+
+	if metrics != nil {
+		metrics.IncrementMemCacheHitCounter(postMetadataCacheName)
+	}
+
+	post := a.clonePostForClient(originalPost)
+	post.Metadata = &metadata
+	return post
+}
+
+// cachePostMetadata caches the metadata prepared for the client of the post. Permalink
+// previews depend on who reads them, and on a post other than this one, so the posts with
+// one aren't cached.
+func (a *App) cachePostMetadata(originalPost, post *model.Post) {
+	if originalPost.Id == "" || post.DeleteAt > 0 || post.Metadata == nil || a.containsPermalink(post) {
+		return
+	}
+	for _, embed := range post.Metadata.Embeds {
+		if embed.Type == model.PostEmbedPermalink {
+			return
+		}
+	}
+
+	metadata, err := json.Marshal(post.Metadata)
+	if err != nil {
+		mlog.Warn("Failed to encode the metadata of a post", mlog.String("post_id", post.Id), mlog.Err(err))
+		return
+	}
+
+	a.Srv().postMetadataCache.SetWithDefaultExpiry(originalPost.Id, &postMetadataCacheEntry{
+		UpdateAt: originalPost.UpdateAt,
+		Metadata: metadata,
+	})
+}
+
+// invalidatePostMetadataCache drops the metadata cached for the post, on every node of the
+// cluster. It is needed for what changes the metadata of a post without changing its UpdateAt,
+// such as acknowledgements and file previews.
+func (a *App) invalidatePostMetadataCache(postID string) {
+	a.Srv().invalidatePostMetadataCacheSkipClusterSend(postID)
+
+	if a.Cluster() != nil {
+		msg := &model.ClusterMessage{
+			Event:    model.ClusterEventInvalidateCacheForPostMetadata,
+			SendType: model.ClusterSendBestEffort,
+			Data:     []byte(postID),
+		}
+		a.Cluster().SendClusterMessage(msg)
+	}
+}
+
+// purgePostMetadataCache drops the metadata cached for every post, on every node of the
+// cluster, for changes that may concern any post such as custom emojis.
+func (a *App) purgePostMetadataCache() {
+	a.invalidatePostMetadataCache("")
+}
+
+// invalidatePostMetadataCacheSkipClusterSend drops the metadata cached for the post, or for
+// every post when no post is given.
+func (s *Server) invalidatePostMetadataCacheSkipClusterSend(postID string) {
+	if s.Metrics != nil {
+		s.Metrics.IncrementMemCacheInvalidationCounter(postMetadataCacheName)
+	}
+
+	if postID == "" {
+		s.postMetadataCache.Purge()
+		return
+	}
+	s.postMetadataCache.Remove(postID)
+}
+
+func (s *Server) clusterInvalidateCacheForPostMetadataHandler(msg *model.ClusterMessage) {
+	s.invalidatePostMetadataCacheSkipClusterSend(string(msg.Data))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPostMetadataCache(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost(th.BasicChannel)
+
+	t.Run("caches the metadata of the post as of its UpdateAt", func(t *testing.T) {
+		require.Nil(t, th.App.getPostWithCachedMetadata(post))
+
+		clientPost := th.App.PreparePostForClientWithEmbedsAndImages(post, false, false)
+		require.NotNil(t, clientPost.Metadata)
+
+		cachedPost := th.App.getPostWithCachedMetadata(post)
+		require.NotNil(t, cachedPost)
+		assert.Equal(t, post.Id, cachedPost.Id)
+		assert.Equal(t, clientPost.Metadata.Reactions, cachedPost.Metadata.Reactions)
+		assert.Equal(t, clientPost.Metadata.Emojis, cachedPost.Metadata.Emojis)
+
+		edited := post.Clone()
+		edited.UpdateAt++
+		assert.Nil(t, th.App.getPostWithCachedMetadata(edited))
+	})
+
+	t.Run("new and edited posts aren't cached", func(t *testing.T) {
+		other := th.CreatePost(th.BasicChannel)
+
+		th.App.PreparePostForClientWithEmbedsAndImages(other, true, false)
+		assert.Nil(t, th.App.getPostWithCachedMetadata(other))
+
+		th.App.PreparePostForClientWithEmbedsAndImages(other, false, true)
+		assert.Nil(t, th.App.getPostWithCachedMetadata(other))
+	})
+
+	t.Run("invalidated by reactions", func(t *testing.T) {
+		th.App.PreparePostForClientWithEmbedsAndImages(post, false, false)
+		require.NotNil(t, th.App.getPostWithCachedMetadata(post))
+
+		_, appErr := th.App.SaveReactionForPost(th.Context, &model.Reaction{
+			UserId:    th.BasicUser.Id,
+			PostId:    post.Id,
+			EmojiName: "smile",
+		})
+		require.Nil(t, appErr)
+		assert.Nil(t, th.App.getPostWithCachedMetadata(post))
+
+		updated, appErr := th.App.GetSinglePost(post.Id)
+		require.Nil(t, appErr)
+		clientPost := th.App.PreparePostForClientWithEmbedsAndImages(updated, false, false)
+		require.Len(t, clientPost.Metadata.Reactions, 1)
+
+		cachedPost := th.App.getPostWithCachedMetadata(updated)
+		require.NotNil(t, cachedPost)
+		require.Len(t, cachedPost.Metadata.Reactions, 1)
+		assert.Equal(t, "smile", cachedPost.Metadata.Reactions[0].EmojiName)
+	})
+
+	t.Run("posts prepared in bulk", func(t *testing.T) {
+		first := th.CreatePost(th.BasicChannel)
+		second := th.CreatePost(th.BasicChannel)
+
+		th.App.PreparePostForClientWithEmbedsAndImages(first, false, false)
+		require.NotNil(t, th.App.getPostWithCachedMetadata(first))
+		require.Nil(t, th.App.getPostWithCachedMetadata(second))
+
+		posts := th.App.PreparePostsForClient([]*model.Post{first, second})
+		require.Len(t, posts, 2)
+		assert.Equal(t, first.Id, posts[0].Id)
+		assert.Equal(t, second.Id, posts[1].Id)
+		assert.NotNil(t, posts[1].Metadata)
+		assert.NotNil(t, th.App.getPostWithCachedMetadata(second))
+	})
+
+	t.Run("permalink previews aren't cached", func(t *testing.T) {
+		permalink := th.CreatePost(th.BasicChannel)
+		permalink.Message = th.App.GetSiteURL() + "/" + th.BasicTeam.Name + "/pl/" + post.Id
+
+		th.App.PreparePostForClientWithEmbedsAndImages(permalink, false, false)
+		assert.Nil(t, th.App.getPostWithCachedMetadata(permalink))
+	})
+
+	t.Run("purged when custom emojis change", func(t *testing.T) {
+		th.App.PreparePostForClientWithEmbedsAndImages(post, false, false)
+		require.NotNil(t, th.App.getPostWithCachedMetadata(post))
+
+		th.App.purgePostMetadataCache()
+		assert.Nil(t, th.App.getPostWithCachedMetadata(post))
+	})
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// PostClassifier checks the posts being created or edited when moderation is enabled. It returns
+// a nil verdict, or one without action, when the post can be published as is.
+type PostClassifier interface {
+	Classify(post *model.Post) (*model.PostModerationVerdict, error)
+}
+
+// rulePostClassifier takes the action of a moderation rule on the posts matching it.
+type rulePostClassifier struct {
+	name   string
+	re     *regexp.Regexp
+	action string
+}
+
+func newRulePostClassifier(rule *model.ModerationRule) (*rulePostClassifier, error) {
+	pattern := rule.Pattern
+	if len(rule.Keywords) > 0 {
+		keywords := make([]string, len(rule.Keywords))
+		for i, keyword := range rule.Keywords {
+			keywords[i] = regexp.QuoteMeta(keyword)
+		}
+		pattern = `(?i)\b(?:` + strings.Join(keywords, "|") + `)\b`
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &rulePostClassifier{name: rule.Name, re: re, action: rule.Action}, nil
+}
+
+func (c *rulePostClassifier) Classify(post *model.Post) (*model.PostModerationVerdict, error) {
+	if !c.re.MatchString(post.Message) {
+		return nil, nil
+	}
+	return &model.PostModerationVerdict{Action: c.action, Reason: c.name}, nil
+}
+
+// httpPostClassifier sends the posts to an external classifier, which answers with the verdict.
+type httpPostClassifier struct {
+	url    string
+	client *http.Client
+}
+
+func (c *httpPostClassifier) Classify(post *model.Post) (*model.PostModerationVerdict, error) {
+	body, err := json.Marshal(post)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+		return nil, fmt.Errorf("the classifier responded with status code %d", resp.StatusCode)
+	}
+
+	var verdict model.PostModerationVerdict
+	if err := json.NewDecoder(io.LimitReader(resp.Body, MaxIntegrationResponseSize)).Decode(&verdict); err != nil {
+		return nil, err
+	}
+	if verdict.Action != "" && !model.IsValidPostModerationAction(verdict.Action) {
+		return nil, fmt.Errorf("the classifier responded with an unknown action %q", verdict.Action)
+	}
+	return &verdict, nil
+}
+
+// postModerator holds the classifiers of the moderation pipeline. The classifiers built from the
+// configuration are rebuilt whenever it changes.
+type postModerator struct {
+	mut        sync.Mutex
+	config     *model.Config
+	configured []PostClassifier
+
+	// added holds the classifiers registered through AddPostClassifier.
+	added []PostClassifier
+}
+
+func newPostModerator() *postModerator {
+	return &postModerator{}
+}
+
+// AddPostClassifier adds a classifier to the moderation pipeline, run along with the rules and
+// external classifier of the configuration.
+func (s *Server) AddPostClassifier(classifier PostClassifier) {
+	s.postModerator.mut.Lock()
+	defer s.postModerator.mut.Unlock()
+	s.postModerator.added = append(s.postModerator.added, classifier)
+}
+
+func (a *App) postClassifiers() []PostClassifier {
+	moderator := a.Srv().postModerator
+	config := a.Config()
+
+	moderator.mut.Lock()
+	defer moderator.mut.Unlock()
+
+	if moderator.config != config {
+		moderator.config = config
+		moderator.configured = nil
+		for _, rule := range config.ModerationSettings.Rules {
+			classifier, err := newRulePostClassifier(rule)
+			if err != nil {
+				mlog.Warn("Failed to compile a moderation rule", mlog.String("rule", rule.Name), mlog.Err(err))
+				continue
+			}
+			moderator.configured = append(moderator.configured, classifier)
+		}
+		if url := *config.ModerationSettings.ClassifierURL; url != "" {
+			client := a.HTTPService().MakeClient(false)
+			client.Timeout = time.Duration(*config.ModerationSettings.ClassifierTimeoutMilliseconds) * time.Millisecond
+			moderator.configured = append(moderator.configured, &httpPostClassifier{url: url, client: client})
+		}
+	}
+
+	classifiers := make([]PostClassifier, 0, len(moderator.configured)+len(moderator.added))
+	classifiers = append(classifiers, moderator.configured...)
+	return append(classifiers, moderator.added...)
+}
+
+// classifyPost runs the classifiers on the post and returns the most severe verdict, or nil when
+// the post can be published as is. A failing classifier lets the post through.
+func (a *App) classifyPost(post *model.Post) *model.PostModerationVerdict {
+	var verdict *model.PostModerationVerdict
+	for _, classifier := range a.postClassifiers() {
+		v, err := classifier.Classify(post)
+		if err != nil {
+			mlog.Warn("Failed to classify a post", mlog.String("post_id", post.Id), mlog.Err(err))
+			continue
+		}
+		if v == nil || v.Action == "" {
+			continue
+		}
+		if verdict == nil || model.PostModerationActionSeverity(v.Action) > model.PostModerationActionSeverity(verdict.Action) {
+			verdict = v
+		}
+	}
+	return verdict
+}
+
+// moderatePost runs the moderation pipeline on a post about to be created or edited. A rejected
+// post returns an error, as does a held post once queued for review. The verdict of a flagged
+// post is returned so that the post is queued once saved.
+func (a *App) moderatePost(post *model.Post, channel *model.Channel) (*model.PostModerationVerdict, *model.AppError) {
+	if !*a.Config().ModerationSettings.Enable || post.IsSystemMessage() {
+		return nil, nil
+	}
+
+	verdict := a.classifyPost(post)
+	if verdict == nil {
+		return nil, nil
+	}
+
+	switch verdict.Action {
+	case model.PostModerationActionReject:
+		return nil, model.NewAppError("moderatePost", "app.post.moderation.rejected.app_error", nil, "reason="+verdict.Reason, http.StatusBadRequest)
+	case model.PostModerationActionHold:
+		if _, appErr := a.queuePostModeration(post, channel, verdict); appErr != nil {
+			return nil, appErr
+		}
+		a.sendPostModerationNotice(post, "app.post.moderation.held.message")
+		return nil, model.NewAppError("moderatePost", "app.post.moderation.held.app_error", nil, "reason="+verdict.Reason, http.StatusBadRequest)
+	}
+
+	return verdict, nil
+}
+
+// queueFlaggedPost queues a published post for review. The post stays published if this fails.
+func (a *App) queueFlaggedPost(post *model.Post, channel *model.Channel, verdict *model.PostModerationVerdict) {
+	if _, appErr := a.queuePostModeration(post, channel, verdict); appErr != nil {
+		mlog.Warn("Failed to queue a flagged post for review", mlog.String("post_id", post.Id), mlog.Err(appErr))
+	}
+}
+
+func (a *App) queuePostModeration(post *model.Post, channel *model.Channel, verdict *model.PostModerationVerdict) (*model.PostModeration, *model.AppError) {
+	reason := verdict.Reason
+	if len([]rune(reason)) > model.PostModerationReasonMaxRunes {
+		reason = string([]rune(reason)[:model.PostModerationReasonMaxRunes])
+	}
+
+	moderation, err := a.Srv().Store.PostModeration().Save(&model.PostModeration{
+		Post:   post.Clone(),
+		TeamId: channel.TeamId,
+		Action: verdict.Action,
+		Reason: reason,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("queuePostModeration", "app.post_moderation.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.publishPostModerationEvent(model.WebsocketEventPostModerationQueued, moderation)
+	return moderation, nil
+}
+
+// publishPostModerationEvent notifies the moderators, who are the only ones allowed to see the
+// contents of the queue.
+func (a *App) publishPostModerationEvent(event string, moderation *model.PostModeration) {
+	message := model.NewWebSocketEvent(event, "", "", "", nil)
+	message.Add("post_moderation", moderation)
+	message.GetBroadcast().ContainsSensitiveData = true
+	a.Publish(message)
+}
+
+// sendPostModerationNotice lets the author of a held post know what became of it.
+func (a *App) sendPostModerationNotice(post *model.Post, translationID string) {
+	user, err := a.Srv().Store.User().Get(context.Background(), post.UserId)
+	if err != nil {
+		mlog.Warn("Failed to get the author of a held post", mlog.String("user_id", post.UserId), mlog.Err(err))
+		return
+	}
+
+	T := i18n.GetUserTranslations(user.Locale)
+	a.SendEphemeralPost(user.Id, &model.Post{
+		UserId:    user.Id,
+		ChannelId: post.ChannelId,
+		RootId:    post.RootId,
+		Message:   T(translationID),
+	})
+}
+
+func (a *App) GetPostModeration(moderationID string) (*model.PostModeration, *model.AppError) {
+	moderation, err := a.Srv().Store.PostModeration().Get(moderationID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPostModeration", "app.post_moderation.get.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetPostModeration", "app.post_moderation.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return moderation, nil
+}
+
+func (a *App) GetPostModerations(teamID, status string, page, perPage int) ([]*model.PostModeration, *model.AppError) {
+	moderations, err := a.Srv().Store.PostModeration().GetAll(teamID, status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPostModerations", "app.post_moderation.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return moderations, nil
+}
+
+// ApprovePostModeration publishes a held post, applies a held edit, or keeps a flagged post.
+func (a *App) ApprovePostModeration(c *request.Context, moderationID, reviewerID string) (*model.PostModeration, *model.AppError) {
+	moderation, appErr := a.reviewPostModeration(moderationID, reviewerID, model.PostModerationStatusApproved)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	switch {
+	case moderation.IsHeldEdit():
+		post, appErr := a.GetSinglePost(moderation.PostId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		post.Message = moderation.Post.Message
+		post.FileIds = moderation.Post.FileIds
+		post.SetProps(moderation.Post.GetProps())
+		if _, appErr := a.updatePost(c, post, false, false); appErr != nil {
+			return nil, appErr
+		}
+	case moderation.Action == model.PostModerationActionHold:
+		channel, appErr := a.GetChannel(moderation.ChannelId)
+		if appErr != nil {
+			return nil, appErr
+		}
+		post := moderation.Post.Clone()
+		post.PendingPostId = ""
+		if _, appErr := a.createPost(c, post, channel, true, false, false); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	a.publishPostModerationEvent(model.WebsocketEventPostModerationReviewed, moderation)
+	return moderation, nil
+}
+
+// RemovePostModeration discards a held post or edit, or deletes a flagged post.
+func (a *App) RemovePostModeration(moderationID, reviewerID string) (*model.PostModeration, *model.AppError) {
+	moderation, appErr := a.reviewPostModeration(moderationID, reviewerID, model.PostModerationStatusRemoved)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if moderation.Action == model.PostModerationActionFlag {
+		if _, appErr := a.DeletePost(moderation.PostId, reviewerID); appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return nil, appErr
+		}
+	} else {
+		a.sendPostModerationNotice(moderation.Post, "app.post.moderation.removed.message")
+	}
+
+	a.publishPostModerationEvent(model.WebsocketEventPostModerationReviewed, moderation)
+	return moderation, nil
+}
+
+// reviewPostModeration records the decision of the reviewer, failing when another reviewer
+// already took one.
+func (a *App) reviewPostModeration(moderationID, reviewerID, status string) (*model.PostModeration, *model.AppError) {
+	moderation, appErr := a.GetPostModeration(moderationID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	moderation.Status = status
+	moderation.ReviewerId = reviewerID
+	moderation, err := a.Srv().Store.PostModeration().Review(moderation)
+	if err != nil {
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("reviewPostModeration", "app.post_moderation.already_reviewed.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("reviewPostModeration", "app.post_moderation.review.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return moderation, nil
+}
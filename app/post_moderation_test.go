@@ -0,0 +1,196 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+type testPostClassifier func(post *model.Post) (*model.PostModerationVerdict, error)
+
+func (f testPostClassifier) Classify(post *model.Post) (*model.PostModerationVerdict, error) {
+	return f(post)
+}
+
+func TestRulePostClassifier(t *testing.T) {
+	classifier, err := newRulePostClassifier(&model.ModerationRule{Name: "Spam", Keywords: []string{"buy now", "c.heap"}, Action: model.PostModerationActionHold})
+	require.NoError(t, err)
+
+	for message, caught := range map[string]bool{
+		"BUY NOW while it lasts": true,
+		"it's c.heap":            true,
+		"buy nowhere":            false,
+		"it's cheap":             false,
+	} {
+		verdict, err := classifier.Classify(&model.Post{Message: message})
+		require.NoError(t, err)
+		if caught {
+			require.NotNil(t, verdict, message)
+			assert.Equal(t, model.PostModerationActionHold, verdict.Action)
+			assert.Equal(t, "Spam", verdict.Reason)
+		} else {
+			assert.Nil(t, verdict, message)
+		}
+	}
+}
+
+func TestPostModeration(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ModerationSettings.Enable = true
+		cfg.ModerationSettings.Rules = []*model.ModerationRule{
+			{Name: "Flagged", Keywords: []string{"flagme"}, Action: model.PostModerationActionFlag},
+			{Name: "Held", Keywords: []string{"holdme"}, Action: model.PostModerationActionHold},
+			{Name: "Rejected", Pattern: `\d{4}-\d{4}-\d{4}-\d{4}`, Action: model.PostModerationActionReject},
+		}
+	})
+
+	newPost := func(message string) *model.Post {
+		return &model.Post{UserId: th.BasicUser.Id, ChannelId: th.BasicChannel.Id, Message: message}
+	}
+
+	t.Run("clean posts are published", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost("hello"), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+	})
+
+	t.Run("rejected posts are refused", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost("my card is 1234-5678-9012-3456"), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.rejected.app_error", appErr.Id)
+	})
+
+	t.Run("the most severe verdict wins", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost("flagme holdme 1234-5678-9012-3456"), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.rejected.app_error", appErr.Id)
+	})
+
+	t.Run("held posts are published once approved", func(t *testing.T) {
+		_, appErr := th.App.CreatePost(th.Context, newPost("please holdme"), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.held.app_error", appErr.Id)
+
+		moderations, appErr := th.App.GetPostModerations(th.BasicTeam.Id, model.PostModerationStatusPending, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, moderations, 1)
+		held := moderations[0]
+		assert.Equal(t, model.PostModerationActionHold, held.Action)
+		assert.Equal(t, "Held", held.Reason)
+		assert.Empty(t, held.PostId)
+
+		approved, appErr := th.App.ApprovePostModeration(th.Context, held.Id, th.SystemAdminUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostModerationStatusApproved, approved.Status)
+
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, PerPage: 1})
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		assert.Equal(t, "please holdme", posts.Posts[posts.Order[0]].Message)
+
+		_, appErr = th.App.RemovePostModeration(held.Id, th.SystemAdminUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_moderation.already_reviewed.app_error", appErr.Id)
+	})
+
+	t.Run("held edits are applied once approved", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost("original"), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		edit := post.Clone()
+		edit.Message = "edited holdme"
+		_, appErr = th.App.UpdatePost(th.Context, edit, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.held.app_error", appErr.Id)
+
+		current, appErr := th.App.GetSinglePost(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "original", current.Message)
+
+		moderations, appErr := th.App.GetPostModerations(th.BasicTeam.Id, model.PostModerationStatusPending, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, moderations, 1)
+		require.True(t, moderations[0].IsHeldEdit())
+
+		_, appErr = th.App.ApprovePostModeration(th.Context, moderations[0].Id, th.SystemAdminUser.Id)
+		require.Nil(t, appErr)
+
+		current, appErr = th.App.GetSinglePost(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "edited holdme", current.Message)
+	})
+
+	t.Run("flagged posts are deleted once removed", func(t *testing.T) {
+		post, appErr := th.App.CreatePost(th.Context, newPost("flagme"), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		moderations, appErr := th.App.GetPostModerations(th.BasicTeam.Id, model.PostModerationStatusPending, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, moderations, 1)
+		assert.Equal(t, post.Id, moderations[0].PostId)
+
+		removed, appErr := th.App.RemovePostModeration(moderations[0].Id, th.SystemAdminUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostModerationStatusRemoved, removed.Status)
+
+		_, appErr = th.App.GetSinglePost(post.Id)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("added classifiers are run", func(t *testing.T) {
+		th.Server.AddPostClassifier(testPostClassifier(func(post *model.Post) (*model.PostModerationVerdict, error) {
+			if strings.Contains(post.Message, "plugged") {
+				return &model.PostModerationVerdict{Action: model.PostModerationActionReject, Reason: "Plugged"}, nil
+			}
+			return nil, nil
+		}))
+
+		_, appErr := th.App.CreatePost(th.Context, newPost("plugged"), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.rejected.app_error", appErr.Id)
+	})
+
+	t.Run("external classifier", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var post model.Post
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&post))
+			if post.Message == "broken" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			verdict := model.PostModerationVerdict{}
+			if post.Message == "toxic" {
+				verdict = model.PostModerationVerdict{Action: model.PostModerationActionReject, Reason: "Toxicity"}
+			}
+			json.NewEncoder(w).Encode(verdict)
+		}))
+		defer ts.Close()
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+			*cfg.ModerationSettings.ClassifierURL = ts.URL
+		})
+
+		_, appErr := th.App.CreatePost(th.Context, newPost("toxic"), th.BasicChannel, false, true)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post.moderation.rejected.app_error", appErr.Id)
+
+		_, appErr = th.App.CreatePost(th.Context, newPost("nice"), th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.CreatePost(th.Context, newPost("broken"), th.BasicChannel, false, true)
+		require.Nil(t, appErr, "a failing classifier lets the post through")
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// newPostPriority builds the priority requested in the metadata of a post that is about
+// to be created. The priority is dropped when the feature is disabled, and can only be
+// set on root posts.
+func (a *App) newPostPriority(post *model.Post) (*model.PostPriority, *model.AppError) {
+	requested := post.GetPriority()
+	if requested == nil {
+		return nil, nil
+	}
+
+	if !*a.Config().ServiceSettings.EnablePostPriority {
+		post.Metadata.Priority = nil
+		return nil, nil
+	}
+
+	if post.RootId != "" {
+		return nil, model.NewAppError("newPostPriority", "app.post_priority.root_only.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !model.IsValidPostPriority(requested.Priority) {
+		return nil, model.NewAppError("newPostPriority", "model.post_priority.is_valid.priority.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return &model.PostPriority{
+		ChannelId:    post.ChannelId,
+		Priority:     requested.Priority,
+		RequestedAck: requested.RequestedAck,
+	}, nil
+}
+
+// getPriorityAndAcknowledgementsForPost fills in the priority of a root post and, if it
+// requested them, the acknowledgements it received.
+func (a *App) getPriorityAndAcknowledgementsForPost(post *model.Post) (*model.PostPriority, []*model.PostAcknowledgement, error) {
+	if !*a.Config().ServiceSettings.EnablePostPriority || post.RootId != "" {
+		return nil, nil, nil
+	}
+
+	priority, err := a.Srv().Store.PostPriority().GetForPost(post.Id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	if !priority.RequestedAck {
+		return priority, nil, nil
+	}
+
+	acknowledgements, err := a.Srv().Store.PostAcknowledgement().GetForPost(post.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return priority, acknowledgements, nil
+}
+
+// getPostForAcknowledgement returns the post a user wants to acknowledge, making sure it
+// requested acknowledgements and was not sent by the user.
+func (a *App) getPostForAcknowledgement(userID, postID string) (*model.Post, *model.AppError) {
+	post, appErr := a.GetSinglePost(postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	priority, _, err := a.getPriorityAndAcknowledgementsForPost(post)
+	if err != nil {
+		return nil, model.NewAppError("getPostForAcknowledgement", "app.post_priority.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if priority == nil || !priority.RequestedAck {
+		return nil, model.NewAppError("getPostForAcknowledgement", "app.post_acknowledgement.not_requested.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if post.UserId == userID {
+		return nil, model.NewAppError("getPostForAcknowledgement", "app.post_acknowledgement.own_post.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return post, nil
+}
+
+// AcknowledgePost records that the user acknowledged a post that requested it, and lets
+// the members of the channel know.
+func (a *App) AcknowledgePost(userID, postID string) (*model.PostAcknowledgement, *model.AppError) {
+	post, appErr := a.getPostForAcknowledgement(userID, postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	acknowledgement, err := a.Srv().Store.PostAcknowledgement().Save(&model.PostAcknowledgement{
+		UserId:         userID,
+		PostId:         post.Id,
+		AcknowledgedAt: model.GetMillis(),
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("AcknowledgePost", "app.post_acknowledgement.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.invalidatePostMetadataCache(post.Id)
+	a.publishPostAcknowledgement(model.WebsocketEventPostAcknowledgementAdded, post.ChannelId, acknowledgement)
+
+	return acknowledgement, nil
+}
+
+// UnacknowledgePost withdraws the acknowledgement of a post by the user.
+func (a *App) UnacknowledgePost(userID, postID string) *model.AppError {
+	post, appErr := a.getPostForAcknowledgement(userID, postID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.PostAcknowledgement().Delete(userID, post.Id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("UnacknowledgePost", "app.post_acknowledgement.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("UnacknowledgePost", "app.post_acknowledgement.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.invalidatePostMetadataCache(post.Id)
+	a.publishPostAcknowledgement(model.WebsocketEventPostAcknowledgementRemoved, post.ChannelId, &model.PostAcknowledgement{
+		UserId: userID,
+		PostId: post.Id,
+	})
+
+	return nil
+}
+
+func (a *App) publishPostAcknowledgement(event, channelID string, acknowledgement *model.PostAcknowledgement) {
+	acknowledgementJSON, err := json.Marshal(acknowledgement)
+	if err != nil {
+		mlog.Warn("Failed to encode post acknowledgement to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(event, "", channelID, "", nil)
+	message.Add("acknowledgement", string(acknowledgementJSON))
+	a.Publish(message)
+}
+
+// GetPostAcknowledgementSummariesForUser returns, for the posts of the user that requested
+// acknowledgements, how many were received and how many are still outstanding.
+func (a *App) GetPostAcknowledgementSummariesForUser(userID string, page, perPage int) ([]*model.PostAcknowledgementSummary, *model.AppError) {
+	summaries, err := a.Srv().Store.PostAcknowledgement().GetSummariesForUser(userID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPostAcknowledgementSummariesForUser", "app.post_acknowledgement.get_summaries.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return summaries, nil
+}
+
+// statusForUrgentPost returns the status to notify a user of an urgent post with. Users in
+// do not disturb are notified as if they were offline when the policy allows it.
+func (a *App) statusForUrgentPost(post *model.Post, status *model.Status) *model.Status {
+	if status.Status != model.StatusDnd || !post.IsUrgent() || !*a.Config().ServiceSettings.AllowUrgentPostsToBypassDND {
+		return status
+	}
+
+	urgentStatus := *status
+	urgentStatus.Status = model.StatusOffline
+	return &urgentStatus
+}
+
+// getPrioritiesAndAcknowledgementsForPosts is the batched version of
+// getPriorityAndAcknowledgementsForPost, keyed by post id. The given posts must be root posts.
+func (a *App) getPrioritiesAndAcknowledgementsForPosts(postIDs []string) (map[string]*model.PostPriority, map[string][]*model.PostAcknowledgement, error) {
+	priorities := map[string]*model.PostPriority{}
+	acknowledgements := map[string][]*model.PostAcknowledgement{}
+	if !*a.Config().ServiceSettings.EnablePostPriority || len(postIDs) == 0 {
+		return priorities, acknowledgements, nil
+	}
+
+	postPriorities, err := a.Srv().Store.PostPriority().GetForPosts(postIDs)
+	if err != nil {
+		return priorities, acknowledgements, err
+	}
+
+	var ackPostIDs []string
+	for _, priority := range postPriorities {
+		priorities[priority.PostId] = priority
+		if priority.RequestedAck {
+			ackPostIDs = append(ackPostIDs, priority.PostId)
+			acknowledgements[priority.PostId] = []*model.PostAcknowledgement{}
+		}
+	}
+	if len(ackPostIDs) == 0 {
+		return priorities, acknowledgements, nil
+	}
+
+	postAcknowledgements, err := a.Srv().Store.PostAcknowledgement().GetForPosts(ackPostIDs)
+	if err != nil {
+		return priorities, acknowledgements, err
+	}
+	for _, acknowledgement := range postAcknowledgements {
+		acknowledgements[acknowledgement.PostId] = append(acknowledgements[acknowledgement.PostId], acknowledgement)
+	}
+
+	return priorities, acknowledgements, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestStatusForUrgentPost(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	dnd := &model.Status{Status: model.StatusDnd}
+	urgent := &model.Post{Metadata: &model.PostMetadata{Priority: &model.PostPriority{Priority: model.PostPriorityUrgent}}}
+	important := &model.Post{Metadata: &model.PostMetadata{Priority: &model.PostPriority{Priority: model.PostPriorityImportant}}}
+
+	t.Run("policy disabled", func(t *testing.T) {
+		assert.Equal(t, model.StatusDnd, th.App.statusForUrgentPost(urgent, dnd).Status)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowUrgentPostsToBypassDND = true })
+
+	t.Run("urgent post bypasses do not disturb", func(t *testing.T) {
+		status := th.App.statusForUrgentPost(urgent, dnd)
+		assert.Equal(t, model.StatusOffline, status.Status)
+		assert.Equal(t, model.StatusDnd, dnd.Status)
+	})
+
+	t.Run("important post does not", func(t *testing.T) {
+		assert.Equal(t, model.StatusDnd, th.App.statusForUrgentPost(important, dnd).Status)
+	})
+
+	t.Run("other statuses are left alone", func(t *testing.T) {
+		away := &model.Status{Status: model.StatusAway}
+		assert.Same(t, away, th.App.statusForUrgentPost(urgent, away))
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// recycleBinStart returns the time posts deleted from then on can still be restored.
+func recycleBinStart() int64 {
+	return model.GetMillis() - model.PostRecycleBinDays*model.TeamStatsDayMillis
+}
+
+// GetDeletedPostsForChannel returns the posts of a channel that were deleted recently enough
+// to be restored, most recently deleted first.
+func (a *App) GetDeletedPostsForChannel(channelID string, page, perPage int) (*model.PostList, *model.AppError) {
+	posts, err := a.Srv().Store.Post().GetDeletedForChannel(channelID, recycleBinStart(), page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetDeletedPostsForChannel", "app.post.get_deleted_for_channel.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	postList := model.NewPostList()
+	for _, post := range posts {
+		postList.AddPost(post)
+		postList.AddOrder(post.Id)
+	}
+	return postList, nil
+}
+
+// GetSinglePostIncludingDeleted returns a post whether or not it was deleted.
+func (a *App) GetSinglePostIncludingDeleted(postID string) (*model.Post, *model.AppError) {
+	post, err := a.Srv().Store.Post().GetSingle(postID, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetSinglePostIncludingDeleted", "app.post.get.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetSinglePostIncludingDeleted", "app.post.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return post, nil
+}
+
+// RestorePost undoes the deletion of a post deleted within the recycle bin period, along with
+// the replies and files deleted with it.
+func (a *App) RestorePost(postID string) (*model.Post, *model.AppError) {
+	post, appErr := a.GetSinglePostIncludingDeleted(postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if post.DeleteAt == 0 {
+		return nil, model.NewAppError("RestorePost", "app.post.restore.not_deleted.app_error", nil, "", http.StatusBadRequest)
+	}
+	if post.DeleteAt < recycleBinStart() {
+		return nil, model.NewAppError("RestorePost", "app.post.restore.expired.app_error", map[string]interface{}{"Days": model.PostRecycleBinDays}, "", http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(post.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("RestorePost", "app.post.restore.archived_channel.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if post.RootId != "" {
+		root, err := a.Srv().Store.Post().GetSingle(post.RootId, true)
+		if err != nil {
+			return nil, model.NewAppError("RestorePost", "app.post.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if root.DeleteAt != 0 {
+			return nil, model.NewAppError("RestorePost", "app.post.restore.root_deleted.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	postIDs, err := a.Srv().Store.Post().Restore(postID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("RestorePost", "app.post.restore.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("RestorePost", "app.post.restore.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if err := a.Srv().Store.FileInfo().RestoreForPosts(postIDs); err != nil {
+		mlog.Warn("Encountered error when restoring files for post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+	for _, id := range postIDs {
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(id, false)
+		a.Srv().Store.FileInfo().InvalidateFileInfosForPostCache(id, true)
+	}
+
+	a.invalidateCacheForChannelPosts(post.ChannelId)
+
+	restored, appErr := a.GetSinglePost(postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	postJSON, jsonErr := json.Marshal(a.PreparePostForClient(restored, false, false))
+	if jsonErr != nil {
+		mlog.Warn("Failed to encode post to JSON", mlog.Err(jsonErr))
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventPostRestored, "", restored.ChannelId, "", nil)
+	message.Add("post", string(postJSON))
+	a.Publish(message)
+
+	return restored, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// ReportPost files the report of the post for the moderators of its team. A user can only report
+// a post once.
+func (a *App) ReportPost(post *model.Post, report *model.PostReport) (*model.PostReport, *model.AppError) {
+	if post.UserId == report.ReporterId {
+		return nil, model.NewAppError("ReportPost", "app.post_report.own_post.app_error", nil, "post_id="+post.Id, http.StatusBadRequest)
+	}
+	if post.IsSystemMessage() {
+		return nil, model.NewAppError("ReportPost", "app.post_report.system_message.app_error", nil, "post_id="+post.Id, http.StatusBadRequest)
+	}
+
+	channel, appErr := a.GetChannel(post.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	report.PostId = post.Id
+	report.ChannelId = post.ChannelId
+	report.TeamId = channel.TeamId
+	report.PostUserId = post.UserId
+
+	saved, err := a.Srv().Store.PostReport().Save(report)
+	if err != nil {
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("ReportPost", "app.post_report.already_reported.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("ReportPost", "app.post_report.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+func (a *App) GetPostReport(reportID string) (*model.PostReport, *model.AppError) {
+	report, err := a.Srv().Store.PostReport().Get(reportID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPostReport", "app.post_report.get.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetPostReport", "app.post_report.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return report, nil
+}
+
+func (a *App) GetPostReports(teamID, status string, page, perPage int) ([]*model.PostReport, *model.AppError) {
+	reports, err := a.Srv().Store.PostReport().GetAll(teamID, status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPostReports", "app.post_report.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return reports, nil
+}
+
+// ResolvePostReport closes an open report, deleting the reported post or warning its author
+// through the system bot when asked to.
+func (a *App) ResolvePostReport(c *request.Context, reportID, resolverID string, resolution *model.PostReportResolution) (*model.PostReport, *model.AppError) {
+	report, appErr := a.GetPostReport(reportID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	report.Status = model.PostReportStatusResolved
+	report.Resolution = resolution.Action
+	report.ResolverId = resolverID
+	report.Note = resolution.Note
+	report, err := a.Srv().Store.PostReport().Resolve(report)
+	if err != nil {
+		var cErr *store.ErrConflict
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("ResolvePostReport", "app.post_report.already_resolved.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("ResolvePostReport", "app.post_report.resolve.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	switch report.Resolution {
+	case model.PostReportResolutionDeletePost:
+		if _, appErr := a.DeletePost(report.PostId, resolverID); appErr != nil && appErr.StatusCode != http.StatusNotFound {
+			return nil, appErr
+		}
+	case model.PostReportResolutionWarnUser:
+		if appErr := a.warnReportedUser(c, report); appErr != nil {
+			mlog.Warn("Failed to warn the author of a reported post", mlog.String("post_report_id", report.Id), mlog.Err(appErr))
+		}
+	}
+
+	return report, nil
+}
+
+func (a *App) warnReportedUser(c *request.Context, report *model.PostReport) *model.AppError {
+	user, appErr := a.GetUser(report.PostUserId)
+	if appErr != nil {
+		return appErr
+	}
+
+	T := i18n.GetUserTranslations(user.Locale)
+	message := T("app.post_report.warning.message", map[string]interface{}{"Reason": T("app.post_report.reason." + report.Reason)})
+	if report.Note != "" {
+		message += "\n\n" + T("app.post_report.warning.note", map[string]interface{}{"Note": report.Note})
+	}
+
+	return a.sendSystemBotDirectMessage(c, user.Id, message)
+}
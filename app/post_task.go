@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// newPostTask builds the task for a task post that is about to be created.
+func (a *App) newPostTask(post *model.Post) (*model.PostTask, *model.AppError) {
+	task, err := model.PostTaskFromPost(post)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.checkPostTaskAssignee(task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// checkPostTaskAssignee makes sure tasks are only assigned to members of the channel they were posted in.
+func (a *App) checkPostTaskAssignee(task *model.PostTask) *model.AppError {
+	if task.AssigneeId == "" {
+		return nil
+	}
+
+	if _, err := a.Srv().Store.Channel().GetMember(context.Background(), task.ChannelId, task.AssigneeId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("checkPostTaskAssignee", "app.post_task.assignee_not_member.app_error", nil, nfErr.Error(), http.StatusBadRequest)
+		default:
+			return model.NewAppError("checkPostTaskAssignee", "app.channel.get_member.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) GetPostTask(postID string) (*model.PostTask, *model.AppError) {
+	task, err := a.Srv().Store.PostTask().Get(postID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPostTask", "app.post_task.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetPostTask", "app.post_task.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return task, nil
+}
+
+// PatchPostTask updates the state of a task and notifies the members of its channel.
+func (a *App) PatchPostTask(postID string, patch *model.PostTaskPatch) (*model.PostTask, *model.AppError) {
+	task, appErr := a.GetPostTask(postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	previousAssigneeID := task.AssigneeId
+	task.Patch(patch)
+
+	if task.AssigneeId != previousAssigneeID {
+		if appErr = a.checkPostTaskAssignee(task); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	task, err := a.Srv().Store.PostTask().Update(task)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchPostTask", "app.post_task.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("PatchPostTask", "app.post_task.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	taskJSON, jsonErr := json.Marshal(task)
+	if jsonErr != nil {
+		return nil, model.NewAppError("PatchPostTask", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventPostTaskUpdated, "", task.ChannelId, "", nil)
+	message.Add("task", string(taskJSON))
+	a.Publish(message)
+
+	return task, nil
+}
+
+// GetPostTasksForUser returns the tasks assigned to a user across all of their channels.
+func (a *App) GetPostTasksForUser(userID string, statuses []string, page, perPage int) ([]*model.PostTask, *model.AppError) {
+	tasks, err := a.Srv().Store.PostTask().GetForAssignee(userID, statuses, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPostTasksForUser", "app.post_task.get_for_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return tasks, nil
+}
@@ -966,6 +966,51 @@ func TestPatchPost(t *testing.T) {
 	})
 }
 
+func TestCreatePostForceThreadedReplies(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	rootPost, appErr := th.App.CreatePost(th.Context, &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		Message:   "root",
+		UserId:    th.BasicUser.Id,
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	reply, appErr := th.App.CreatePost(th.Context, &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		Message:   "reply",
+		UserId:    th.BasicUser.Id,
+		RootId:    rootPost.Id,
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+
+	replyToReply := func() (*model.Post, *model.AppError) {
+		return th.App.CreatePost(th.Context, &model.Post{
+			ChannelId: th.BasicChannel.Id,
+			Message:   "reply to reply",
+			UserId:    th.BasicUser2.Id,
+			RootId:    reply.Id,
+		}, th.BasicChannel, false, true)
+	}
+
+	t.Run("replies to a reply are rejected by default", func(t *testing.T) {
+		_, appErr := replyToReply()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.post.create_post.root_id.app_error", appErr.Id)
+	})
+
+	t.Run("replies to a reply go to its thread when forced", func(t *testing.T) {
+		channel, appErr := th.App.UpdateChannelThreadSettings(th.BasicChannel, &model.ChannelThreadSettings{ForceThreadedReplies: true})
+		require.Nil(t, appErr)
+		th.BasicChannel = channel
+
+		post, appErr := replyToReply()
+		require.Nil(t, appErr)
+		assert.Equal(t, rootPost.Id, post.RootId)
+	})
+}
+
 func TestCreatePostAsUser(t *testing.T) {
 	t.Run("marks channel as viewed for regular user", func(t *testing.T) {
 		th := Setup(t).InitBasic()
@@ -58,6 +58,45 @@ func (a *App) UpdatePreferences(userID string, preferences model.Preferences) *m
 		}
 	}
 
+	return a.preferencesUpdated(userID, preferences)
+}
+
+// CompareAndSetPreferences saves the preferences only if none of them changed since the versions
+// given with them. Otherwise nothing is saved and the result holds the current values of the
+// preferences that changed.
+func (a *App) CompareAndSetPreferences(userID string, preferences model.Preferences) (*model.PreferencesCompareAndSetResult, *model.AppError) {
+	for _, preference := range preferences {
+		if userID != preference.UserId {
+			return nil, model.NewAppError("CompareAndSetPreferences", "api.preference.update_preferences.set.app_error", nil,
+				"userId="+userID+", preference.UserId="+preference.UserId, http.StatusForbidden)
+		}
+	}
+
+	saved, conflicts, err := a.Srv().Store.Preference().CompareAndSet(preferences)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CompareAndSetPreferences", "app.preference.save.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	result := &model.PreferencesCompareAndSetResult{Saved: saved, Conflicts: conflicts}
+	if len(conflicts) > 0 {
+		return result, nil
+	}
+
+	if appErr := a.preferencesUpdated(userID, saved); appErr != nil {
+		return nil, appErr
+	}
+	return result, nil
+}
+
+// preferencesUpdated updates the sidebar for saved preferences and lets the user's clients know
+// about them.
+func (a *App) preferencesUpdated(userID string, preferences model.Preferences) *model.AppError {
 	if err := a.Srv().Store.Channel().UpdateSidebarChannelsByPreferences(preferences); err != nil {
 		return model.NewAppError("UpdatePreferences", "api.preference.update_preferences.update_sidebar.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -74,6 +113,12 @@ func (a *App) UpdatePreferences(userID string, preferences model.Preferences) *m
 	message.Add("preferences", string(prefsJSON))
 	a.Publish(message)
 
+	for _, preference := range preferences {
+		if preference.Category == model.PreferenceCategoryFlaggedPost && preference.Value == "true" {
+			a.publishPostFlaggedEvent(userID, preference.Name)
+		}
+	}
+
 	return nil
 }
 
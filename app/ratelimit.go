@@ -6,8 +6,10 @@ package app
 import (
 	"math"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
@@ -20,6 +22,8 @@ import (
 )
 
 type RateLimiter struct {
+	mut                  sync.RWMutex
+	enabled              bool
 	throttledRateLimiter *throttled.GCRARateLimiter
 	useAuth              bool
 	useIP                bool
@@ -28,9 +32,28 @@ type RateLimiter struct {
 }
 
 func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []string) (*RateLimiter, error) {
+	rl := &RateLimiter{}
+	if err := rl.configure(settings, trustedProxyIPHeader); err != nil {
+		return nil, err
+	}
+	return rl, nil
+}
+
+// configure applies the settings to the rate limiter. Nothing is limited while the rate
+// limiter is disabled.
+func (rl *RateLimiter) configure(settings *model.RateLimitSettings, trustedProxyIPHeader []string) error {
+	if !*settings.Enable {
+		rl.mut.Lock()
+		defer rl.mut.Unlock()
+
+		rl.enabled = false
+		rl.throttledRateLimiter = nil
+		return nil
+	}
+
 	store, err := memstore.New(*settings.MemoryStoreSize)
 	if err != nil {
-		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_memory_store"))
+		return errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_memory_store"))
 	}
 
 	quota := throttled.RateQuota{
@@ -40,19 +63,43 @@ func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []st
 
 	throttledRateLimiter, err := throttled.NewGCRARateLimiter(store, quota)
 	if err != nil {
-		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+		return errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+	}
+
+	rl.mut.Lock()
+	defer rl.mut.Unlock()
+
+	rl.enabled = true
+	rl.throttledRateLimiter = throttledRateLimiter
+	rl.useAuth = *settings.VaryByUser
+	rl.useIP = *settings.VaryByRemoteAddr
+	rl.header = settings.VaryByHeader
+	rl.trustedProxyIPHeader = trustedProxyIPHeader
+
+	return nil
+}
+
+// ReloadConfig applies changes to the rate limit settings. The counts of requests made so
+// far are reset when they change.
+func (rl *RateLimiter) ReloadConfig(oldCfg, newCfg *model.Config) error {
+	if reflect.DeepEqual(oldCfg.RateLimitSettings, newCfg.RateLimitSettings) &&
+		reflect.DeepEqual(oldCfg.ServiceSettings.TrustedProxyIPHeader, newCfg.ServiceSettings.TrustedProxyIPHeader) {
+		return nil
 	}
 
-	return &RateLimiter{
-		throttledRateLimiter: throttledRateLimiter,
-		useAuth:              *settings.VaryByUser,
-		useIP:                *settings.VaryByRemoteAddr,
-		header:               settings.VaryByHeader,
-		trustedProxyIPHeader: trustedProxyIPHeader,
-	}, nil
+	return rl.configure(&newCfg.RateLimitSettings, newCfg.ServiceSettings.TrustedProxyIPHeader)
+}
+
+func (rl *RateLimiter) isEnabled() bool {
+	rl.mut.RLock()
+	defer rl.mut.RUnlock()
+	return rl.enabled
 }
 
 func (rl *RateLimiter) GenerateKey(r *http.Request) string {
+	rl.mut.RLock()
+	defer rl.mut.RUnlock()
+
 	key := ""
 
 	if rl.useAuth {
@@ -76,7 +123,15 @@ func (rl *RateLimiter) GenerateKey(r *http.Request) string {
 }
 
 func (rl *RateLimiter) RateLimitWriter(key string, w http.ResponseWriter) bool {
-	limited, context, err := rl.throttledRateLimiter.RateLimit(key, 1)
+	rl.mut.RLock()
+	throttledRateLimiter := rl.throttledRateLimiter
+	rl.mut.RUnlock()
+
+	if throttledRateLimiter == nil {
+		return false
+	}
+
+	limited, context, err := throttledRateLimiter.RateLimit(key, 1)
 	if err != nil {
 		mlog.Error("Internal server error when rate limiting. Rate Limiting broken.", mlog.Err(err))
 		return false
@@ -93,7 +148,11 @@ func (rl *RateLimiter) RateLimitWriter(key string, w http.ResponseWriter) bool {
 }
 
 func (rl *RateLimiter) UserIdRateLimit(userID string, w http.ResponseWriter) bool {
-	if rl.useAuth {
+	rl.mut.RLock()
+	useAuth := rl.enabled && rl.useAuth
+	rl.mut.RUnlock()
+
+	if useAuth {
 		return rl.RateLimitWriter(userID, w)
 	}
 	return false
@@ -101,6 +160,11 @@ func (rl *RateLimiter) UserIdRateLimit(userID string, w http.ResponseWriter) boo
 
 func (rl *RateLimiter) RateLimitHandler(wrappedHandler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !rl.isEnabled() {
+			wrappedHandler.ServeHTTP(w, r)
+			return
+		}
+
 		key := rl.GenerateKey(r)
 
 		if !rl.RateLimitWriter(key, w) {
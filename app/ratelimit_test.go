@@ -103,3 +103,44 @@ func TestGenerateKey_TrustedHeader(t *testing.T) {
 	key = rateLimiter.GenerateKey(req)
 	require.Equal(t, "10.10.10.5", key, "Wrong key on test without allowed trusted proxy header")
 }
+
+func TestRateLimiterReloadConfig(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	request := func(rateLimiter *RateLimiter) int {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "10.10.10.5:80"
+		w := httptest.NewRecorder()
+		rateLimiter.RateLimitHandler(handler).ServeHTTP(w, req)
+		return w.Code
+	}
+
+	disabled := genRateLimitSettings(false, true, "")
+	disabled.Enable = model.NewBool(false)
+	rateLimiter, err := NewRateLimiter(disabled, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, request(rateLimiter))
+	}
+
+	oldCfg := &model.Config{RateLimitSettings: *disabled}
+	enabled := genRateLimitSettings(false, true, "")
+	enabled.MaxBurst = model.NewInt(1)
+	enabled.PerSec = model.NewInt(1)
+	newCfg := &model.Config{RateLimitSettings: *enabled}
+	require.NoError(t, rateLimiter.ReloadConfig(oldCfg, newCfg))
+
+	require.Equal(t, http.StatusOK, request(rateLimiter))
+	require.Equal(t, http.StatusOK, request(rateLimiter))
+	require.Equal(t, http.StatusTooManyRequests, request(rateLimiter))
+
+	require.NoError(t, rateLimiter.ReloadConfig(newCfg, oldCfg))
+	require.Equal(t, http.StatusOK, request(rateLimiter))
+
+	invalid := genRateLimitSettings(false, true, "")
+	invalid.MaxBurst = model.NewInt(-100)
+	require.Error(t, rateLimiter.ReloadConfig(oldCfg, &model.Config{RateLimitSettings: *invalid}))
+}
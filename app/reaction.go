@@ -40,8 +40,12 @@ func (a *App) SaveReactionForPost(c *request.Context, reaction *model.Reaction)
 		}
 	}
 
+	a.recordReactionActivity(reaction, channel)
+	a.resolveOutstandingMentionsForReaction(reaction)
+
 	// The post is always modified since the UpdateAt always changes
 	a.invalidateCacheForChannelPosts(post.ChannelId)
+	a.invalidatePostMetadataCache(post.Id)
 
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		a.Srv().Go(func() {
@@ -114,9 +118,11 @@ func (a *App) DeleteReactionForPost(c *request.Context, reaction *model.Reaction
 	if _, err := a.Srv().Store.Reaction().Delete(reaction); err != nil {
 		return model.NewAppError("DeleteReactionForPost", "app.reaction.delete_all_with_emoji_name.get_reactions.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
+	a.deleteReactionActivity(reaction)
 
 	// The post is always modified since the UpdateAt always changes
 	a.invalidateCacheForChannelPosts(post.ChannelId)
+	a.invalidatePostMetadataCache(post.Id)
 
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		a.Srv().Go(func() {
@@ -54,6 +54,26 @@ func (a *App) GetRemoteCluster(remoteClusterId string) (*model.RemoteCluster, *m
 	return rc, nil
 }
 
+// GetRemoteClusterHealth reports how well the channels shared with the remote cluster are kept
+// in sync.
+func (a *App) GetRemoteClusterHealth(remoteClusterId string) (*model.RemoteClusterHealth, *model.AppError) {
+	syncService := a.Srv().GetSharedChannelSyncService()
+	if syncService == nil {
+		return nil, model.NewAppError("GetRemoteClusterHealth", "api.remote_cluster.health.service_not_enabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	rc, appErr := a.GetRemoteCluster(remoteClusterId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	health, err := syncService.GetRemoteHealth(rc)
+	if err != nil {
+		return nil, model.NewAppError("GetRemoteClusterHealth", "api.remote_cluster.health.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return health, nil
+}
+
 func (a *App) GetAllRemoteClusters(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, *model.AppError) {
 	list, err := a.Srv().Store.RemoteCluster().GetAll(filter)
 	if err != nil {
@@ -70,6 +90,34 @@ func (a *App) UpdateRemoteClusterTopics(remoteClusterId string, topics string) (
 	return rc, nil
 }
 
+// UpdateRemoteClusterProfilePolicy sets which user profile fields are masked when users
+// are synchronized with the remote cluster.
+func (a *App) UpdateRemoteClusterProfilePolicy(remoteClusterId string, policy *model.RemoteClusterProfilePolicy) (*model.RemoteCluster, *model.AppError) {
+	if appErr := policy.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	rc, err := a.Srv().Store.RemoteCluster().UpdateHiddenProfileFields(remoteClusterId, policy.ToHiddenProfileFields())
+	if err != nil {
+		return nil, model.NewAppError("UpdateRemoteClusterProfilePolicy", "api.remote_cluster.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return rc, nil
+}
+
+// UpdateRemoteClusterInvitePolicy sets what happens to the shared channel invites received
+// from the remote cluster.
+func (a *App) UpdateRemoteClusterInvitePolicy(remoteClusterId string, policy *model.RemoteClusterInvitePolicy) (*model.RemoteCluster, *model.AppError) {
+	if appErr := policy.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	rc, err := a.Srv().Store.RemoteCluster().UpdateInvitePolicy(remoteClusterId, policy.Policy)
+	if err != nil {
+		return nil, model.NewAppError("UpdateRemoteClusterInvitePolicy", "api.remote_cluster.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return rc, nil
+}
+
 func (a *App) SetRemoteClusterLastPingAt(remoteClusterId string) *model.AppError {
 	err := a.Srv().Store.RemoteCluster().SetLastPingAt(remoteClusterId)
 	if err != nil {
@@ -0,0 +1,183 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/services/restproxybridge"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	restProxyBridgePublishBatchSize = 500
+	restProxyBridgeDeleteBatchSize  = 1000
+)
+
+// enqueueRESTProxyBridgeEvent saves the event to the outbox of the REST proxy bridge, if it's to
+// be published for the team. The REST proxy bridge job publishes the outbox in order, retrying
+// the event until the proxy of the message queue accepts it, even if it can't be reached for a
+// while.
+func (a *App) enqueueRESTProxyBridgeEvent(event, teamID, messageKey string, data interface{}) {
+	settings := a.Config().RESTProxyBridgeSettings
+	if !settings.IsPublished(event, teamID) {
+		return
+	}
+
+	message := &model.RESTProxyBridgeMessage{
+		Id:         model.NewId(),
+		Event:      event,
+		Topic:      settings.TopicFor(event),
+		MessageKey: messageKey,
+		CreateAt:   model.GetMillis(),
+	}
+
+	payload, err := json.Marshal(&model.RESTProxyBridgePayload{
+		Id:       message.Id,
+		Event:    event,
+		CreateAt: message.CreateAt,
+		Data:     data,
+	})
+	if err != nil {
+		mlog.Warn("Failed to encode a REST proxy bridge payload", mlog.String("event", event), mlog.Err(err))
+		return
+	}
+	message.Payload = string(payload)
+
+	if _, err := a.Srv().Store.RESTProxyBridge().Save(message); err != nil {
+		mlog.Warn("Failed to save a REST proxy bridge message", mlog.String("event", event), mlog.Err(err))
+	}
+}
+
+// enqueuePostRESTProxyBridgeEvent sends the created or edited post to the REST proxy bridge,
+// keyed by its channel so that the events of a channel are consumed in order.
+func (a *App) enqueuePostRESTProxyBridgeEvent(event string, post *model.Post, channel *model.Channel) {
+	published := post.Clone()
+	published.Metadata = nil
+
+	a.enqueueRESTProxyBridgeEvent(event, channel.TeamId, channel.Id, map[string]interface{}{
+		"post":         published,
+		"team_id":      channel.TeamId,
+		"channel_type": channel.Type,
+	})
+}
+
+func (a *App) enqueuePostDeletedRESTProxyBridgeEvent(post *model.Post, channel *model.Channel, deleteByID string) {
+	a.enqueueRESTProxyBridgeEvent(model.RESTProxyBridgeEventPostDeleted, channel.TeamId, channel.Id, map[string]interface{}{
+		"post_id":    post.Id,
+		"root_id":    post.RootId,
+		"channel_id": channel.Id,
+		"team_id":    channel.TeamId,
+		"user_id":    post.UserId,
+		"deleted_by": deleteByID,
+	})
+}
+
+func (a *App) enqueueChannelMemberRESTProxyBridgeEvent(event string, channel *model.Channel, userID, actorID string) {
+	a.enqueueRESTProxyBridgeEvent(event, channel.TeamId, channel.Id, map[string]interface{}{
+		"channel_id":   channel.Id,
+		"channel_type": channel.Type,
+		"team_id":      channel.TeamId,
+		"user_id":      userID,
+		"actor_id":     actorID,
+	})
+}
+
+func (a *App) enqueueTeamMemberRESTProxyBridgeEvent(event string, teamMember *model.TeamMember, actorID string) {
+	a.enqueueRESTProxyBridgeEvent(event, teamMember.TeamId, teamMember.TeamId, map[string]interface{}{
+		"team_id":  teamMember.TeamId,
+		"user_id":  teamMember.UserId,
+		"actor_id": actorID,
+	})
+}
+
+// PublishRESTProxyBridgeMessages publishes the outbox of the REST proxy bridge to the message
+// queue, in order, then deletes the messages published before their retention. Publishing
+// stops at the first failure, to be attempted again after a backoff.
+func (a *App) PublishRESTProxyBridgeMessages() *model.AppError {
+	settings := a.Config().RESTProxyBridgeSettings
+	publisher, err := restproxybridge.NewPublisher(&settings, a.HTTPService().MakeClient(true))
+	if err != nil {
+		return model.NewAppError("PublishRESTProxyBridgeMessages", "app.rest_proxy_bridge.publisher.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if appErr := a.publishRESTProxyBridgeMessages(publisher); appErr != nil {
+		return appErr
+	}
+
+	endTime := model.GetMillis() - int64(*settings.RetentionHours)*time.Hour.Milliseconds()
+	for {
+		deleted, err := a.Srv().Store.RESTProxyBridge().PermanentDeletePublishedBatch(endTime, restProxyBridgeDeleteBatchSize)
+		if err != nil {
+			return model.NewAppError("PublishRESTProxyBridgeMessages", "app.rest_proxy_bridge.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if deleted < restProxyBridgeDeleteBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *App) publishRESTProxyBridgeMessages(publisher restproxybridge.Publisher) *model.AppError {
+	for {
+		pending, err := a.Srv().Store.RESTProxyBridge().GetPending(restProxyBridgePublishBatchSize)
+		if err != nil {
+			return model.NewAppError("PublishRESTProxyBridgeMessages", "app.rest_proxy_bridge.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if len(pending) == 0 || pending[0].NextAttemptAt > model.GetMillis() {
+			return nil
+		}
+
+		// The consecutive messages of a topic are published together.
+		for start := 0; start < len(pending); {
+			end := start + 1
+			for end < len(pending) && pending[end].Topic == pending[start].Topic {
+				end++
+			}
+			messages := pending[start:end]
+
+			if err := publisher.Publish(messages[0].Topic, messages); err != nil {
+				mlog.Warn("Failed to publish REST proxy bridge messages", mlog.String("topic", messages[0].Topic), mlog.Int("count", len(messages)), mlog.Err(err))
+
+				messages[0].RecordFailure(err.Error(), model.GetMillis())
+				if err := a.Srv().Store.RESTProxyBridge().UpdateAttempt(messages[0]); err != nil {
+					return model.NewAppError("PublishRESTProxyBridgeMessages", "app.rest_proxy_bridge.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+				}
+				return nil
+			}
+
+			ids := make([]string, 0, len(messages))
+			for _, message := range messages {
+				ids = append(ids, message.Id)
+			}
+			if err := a.Srv().Store.RESTProxyBridge().MarkPublished(ids, model.GetMillis()); err != nil {
+				return model.NewAppError("PublishRESTProxyBridgeMessages", "app.rest_proxy_bridge.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+
+			start = end
+		}
+
+		if len(pending) < restProxyBridgePublishBatchSize {
+			return nil
+		}
+	}
+}
+
+// GetRESTProxyBridgeStatus returns how far the REST proxy bridge got in publishing its outbox. The
+// bridge is unhealthy while the next message to publish has failed to be.
+func (a *App) GetRESTProxyBridgeStatus() (*model.RESTProxyBridgeStatus, *model.AppError) {
+	status, err := a.Srv().Store.RESTProxyBridge().GetStatus()
+	if err != nil {
+		return nil, model.NewAppError("GetRESTProxyBridgeStatus", "app.rest_proxy_bridge.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	settings := a.Config().RESTProxyBridgeSettings
+	status.Enabled = *settings.Enable
+	status.Driver = *settings.Driver
+	status.Healthy = status.NextPending == nil || status.NextPending.Attempts == 0
+
+	return status, nil
+}
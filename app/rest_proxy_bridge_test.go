@@ -0,0 +1,133 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestPublishRESTProxyBridgeMessages(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var mut sync.Mutex
+	var fail bool
+	var published []model.RESTProxyBridgePayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var request struct {
+			Records []struct {
+				Key   string                       `json:"key"`
+				Value model.RESTProxyBridgePayload `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		offsets := []map[string]interface{}{}
+		for i, record := range request.Records {
+			published = append(published, record.Value)
+			offsets = append(offsets, map[string]interface{}{"partition": 0, "offset": i})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"offsets": offsets})
+	}))
+	defer server.Close()
+
+	// Publish whatever other tests may have left in the outbox first.
+	require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.RESTProxyBridgeSettings.Enable = true
+		*cfg.RESTProxyBridgeSettings.URL = server.URL
+		cfg.RESTProxyBridgeSettings.Events = []string{model.RESTProxyBridgeEventPostCreated, model.RESTProxyBridgeEventPostEdited}
+	})
+
+	publishedEvents := func() []string {
+		mut.Lock()
+		defer mut.Unlock()
+		events := []string{}
+		for _, payload := range published {
+			events = append(events, payload.Event)
+		}
+		published = nil
+		return events
+	}
+
+	t.Run("publishes the events in order", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		post.Message = "edited"
+		_, appErr := th.App.UpdatePost(th.Context, post, false)
+		require.Nil(t, appErr)
+
+		require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+		assert.Equal(t, []string{model.RESTProxyBridgeEventPostCreated, model.RESTProxyBridgeEventPostEdited}, publishedEvents())
+
+		status, appErr := th.App.GetRESTProxyBridgeStatus()
+		require.Nil(t, appErr)
+		assert.True(t, status.Enabled)
+		assert.True(t, status.Healthy)
+		assert.Zero(t, status.PendingCount)
+		require.NotNil(t, status.LastPublished)
+		assert.Equal(t, model.RESTProxyBridgeEventPostEdited, status.LastPublished.Event)
+	})
+
+	t.Run("leaves out the events of the direct messages", func(t *testing.T) {
+		dm := th.CreateDmChannel(th.BasicUser2)
+		th.CreatePost(dm)
+
+		require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+		assert.Empty(t, publishedEvents())
+	})
+
+	t.Run("keeps the events until the queue takes them", func(t *testing.T) {
+		mut.Lock()
+		fail = true
+		mut.Unlock()
+
+		th.CreatePost(th.BasicChannel)
+		require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+
+		status, appErr := th.App.GetRESTProxyBridgeStatus()
+		require.Nil(t, appErr)
+		assert.False(t, status.Healthy)
+		assert.Equal(t, int64(1), status.PendingCount)
+		require.NotNil(t, status.NextPending)
+		assert.Equal(t, 1, status.NextPending.Attempts)
+		assert.Contains(t, status.NextPending.LastError, "503")
+
+		mut.Lock()
+		fail = false
+		mut.Unlock()
+
+		// The retry is not due yet.
+		require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+		assert.Empty(t, publishedEvents())
+
+		status.NextPending.NextAttemptAt = model.GetMillis() - 1
+		require.NoError(t, th.App.Srv().Store.RESTProxyBridge().UpdateAttempt(status.NextPending))
+
+		require.Nil(t, th.App.PublishRESTProxyBridgeMessages())
+		assert.Equal(t, []string{model.RESTProxyBridgeEventPostCreated}, publishedEvents())
+
+		status, appErr = th.App.GetRESTProxyBridgeStatus()
+		require.Nil(t, appErr)
+		assert.True(t, status.Healthy)
+		assert.Zero(t, status.PendingCount)
+	})
+}
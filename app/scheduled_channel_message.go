@@ -0,0 +1,212 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const scheduledChannelMessageBatchSize = 100
+
+func (a *App) GetScheduledChannelMessage(id string) (*model.ScheduledChannelMessage, *model.AppError) {
+	message, err := a.Srv().Store.ScheduledChannelMessage().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetScheduledChannelMessage", "app.scheduled_channel_message.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetScheduledChannelMessage", "app.scheduled_channel_message.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return message, nil
+}
+
+func (a *App) GetScheduledChannelMessagesForChannel(channelID string) ([]*model.ScheduledChannelMessage, *model.AppError) {
+	messages, err := a.Srv().Store.ScheduledChannelMessage().GetForChannel(channelID)
+	if err != nil {
+		return nil, model.NewAppError("GetScheduledChannelMessagesForChannel", "app.scheduled_channel_message.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return messages, nil
+}
+
+// CreateScheduledChannelMessage schedules a recurring message in a team channel, starting with
+// its first run from now on.
+func (a *App) CreateScheduledChannelMessage(message *model.ScheduledChannelMessage) (*model.ScheduledChannelMessage, *model.AppError) {
+	if appErr := a.checkScheduledChannelMessageChannel(message.ChannelId); appErr != nil {
+		return nil, appErr
+	}
+
+	existing, appErr := a.GetScheduledChannelMessagesForChannel(message.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(existing) >= model.ScheduledChannelMessageMaxPerChannel {
+		return nil, model.NewAppError("CreateScheduledChannelMessage", "app.scheduled_channel_message.limit.app_error", map[string]interface{}{"Max": model.ScheduledChannelMessageMaxPerChannel}, "channel_id="+message.ChannelId, http.StatusBadRequest)
+	}
+
+	message.NextRunAt = message.NextRunAfter(model.GetMillis())
+	if message.NextRunAt == 0 {
+		return nil, model.NewAppError("CreateScheduledChannelMessage", "app.scheduled_channel_message.no_next_run.app_error", nil, "channel_id="+message.ChannelId, http.StatusBadRequest)
+	}
+
+	saved, err := a.Srv().Store.ScheduledChannelMessage().Save(message, a.MaxPostSize())
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateScheduledChannelMessage", "app.scheduled_channel_message.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateScheduledChannelMessage", "app.scheduled_channel_message.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return saved, nil
+}
+
+// UpdateScheduledChannelMessage changes the message or schedule of a scheduled message. The next
+// run is computed again from now on, so that a changed schedule applies right away.
+func (a *App) UpdateScheduledChannelMessage(message *model.ScheduledChannelMessage) (*model.ScheduledChannelMessage, *model.AppError) {
+	if appErr := a.checkScheduledChannelMessageChannel(message.ChannelId); appErr != nil {
+		return nil, appErr
+	}
+
+	message.NextRunAt = message.NextRunAfter(model.GetMillis())
+	if message.NextRunAt == 0 {
+		return nil, model.NewAppError("UpdateScheduledChannelMessage", "app.scheduled_channel_message.no_next_run.app_error", nil, "id="+message.Id, http.StatusBadRequest)
+	}
+
+	updated, err := a.Srv().Store.ScheduledChannelMessage().Update(message, a.MaxPostSize())
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateScheduledChannelMessage", "app.scheduled_channel_message.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("UpdateScheduledChannelMessage", "app.scheduled_channel_message.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return updated, nil
+}
+
+func (a *App) DeleteScheduledChannelMessage(id string) *model.AppError {
+	if err := a.Srv().Store.ScheduledChannelMessage().Delete(id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteScheduledChannelMessage", "app.scheduled_channel_message.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteScheduledChannelMessage", "app.scheduled_channel_message.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) checkScheduledChannelMessageChannel(channelID string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableScheduledChannelMessages {
+		return model.NewAppError("checkScheduledChannelMessageChannel", "app.scheduled_channel_message.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	channel, appErr := a.GetChannel(channelID)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.IsGroupOrDirect() {
+		return model.NewAppError("checkScheduledChannelMessageChannel", "app.scheduled_channel_message.team_channel_only.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+	}
+	if channel.DeleteAt != 0 {
+		return model.NewAppError("checkScheduledChannelMessageChannel", "app.scheduled_channel_message.archived_channel.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SendDueScheduledChannelMessages posts the scheduled messages whose next run is due. Each run
+// is claimed before being posted so that it's posted once, even by concurrent jobs. The runs
+// missed while the server was down aren't caught up on.
+func (a *App) SendDueScheduledChannelMessages() *model.AppError {
+	c := request.EmptyContext()
+	for {
+		now := model.GetMillis()
+		due, err := a.Srv().Store.ScheduledChannelMessage().GetDue(now, scheduledChannelMessageBatchSize)
+		if err != nil {
+			return model.NewAppError("SendDueScheduledChannelMessages", "app.scheduled_channel_message.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		for _, message := range due {
+			claimed, err := a.Srv().Store.ScheduledChannelMessage().UpdateRun(message.Id, message.NextRunAt, now, message.NextRunAfter(now))
+			if err != nil {
+				return model.NewAppError("SendDueScheduledChannelMessages", "app.scheduled_channel_message.update_run.app_error", nil, err.Error(), http.StatusInternalServerError)
+			}
+			if !claimed {
+				continue
+			}
+
+			if appErr := a.sendScheduledChannelMessage(c, message); appErr != nil {
+				mlog.Warn("Failed to send scheduled channel message", mlog.String("scheduled_channel_message_id", message.Id), mlog.Err(appErr))
+			}
+		}
+
+		if len(due) < scheduledChannelMessageBatchSize {
+			return nil
+		}
+	}
+}
+
+// sendScheduledChannelMessage posts the message as its creator. The run is skipped when the
+// channel was archived, or when the creator was deactivated or left the channel.
+func (a *App) sendScheduledChannelMessage(c *request.Context, message *model.ScheduledChannelMessage) *model.AppError {
+	channel, appErr := a.GetChannel(message.ChannelId)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.DeleteAt != 0 {
+		return nil
+	}
+
+	creator, appErr := a.GetUser(message.CreatorId)
+	if appErr != nil {
+		return appErr
+	}
+	if creator.DeleteAt != 0 {
+		return nil
+	}
+
+	if _, err := a.Srv().Store.Channel().GetMember(context.Background(), channel.Id, creator.Id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil
+		default:
+			return model.NewAppError("sendScheduledChannelMessage", "app.channel.get_member.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	post := &model.Post{
+		UserId:    creator.Id,
+		ChannelId: channel.Id,
+		Message:   message.Message,
+	}
+	post.AddProp(model.PostPropsScheduledChannelMessageId, message.Id)
+
+	_, appErr = a.CreatePost(c, post, channel, false, false)
+	return appErr
+}
@@ -4,10 +4,13 @@
 package app
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/store"
 )
 
@@ -201,6 +204,99 @@ func (a *App) GetChannelsForScheme(scheme *model.Scheme, offset int, limit int)
 	return channelList, nil
 }
 
+// AssignScheme switches every team or channel listed in the assignment to the
+// given scheme. Small assignments are applied inline and return a nil job;
+// larger ones are queued as a scheme assignment job whose progress can be polled.
+func (a *App) AssignScheme(scheme *model.Scheme, assignment *model.SchemeAssignment) (*model.Job, *model.AppError) {
+	if err := a.IsPhase2MigrationCompleted(); err != nil {
+		return nil, err
+	}
+
+	if !assignment.IsValid() {
+		return nil, model.NewAppError("AssignScheme", "app.scheme.assign.invalid.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if assignment.Scope() != scheme.Scope {
+		return nil, model.NewAppError("AssignScheme", "app.scheme.assign.scope.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	ids := assignment.Ids()
+	if len(ids) > model.SchemeAssignmentJobThreshold {
+		return a.Srv().Jobs.CreateJob(model.JobTypeSchemeAssignment, map[string]string{
+			"scheme_id": scheme.Id,
+			"scope":     scheme.Scope,
+			"ids":       strings.Join(ids, ","),
+		})
+	}
+
+	if scheme.Scope == model.SchemeScopeTeam {
+		return nil, a.AssignSchemeToTeams(scheme.Id, ids)
+	}
+	return nil, a.AssignSchemeToChannels(scheme.Id, ids)
+}
+
+// AssignSchemeToTeams sets the scheme of all the given teams in a single
+// transaction. Either every team is switched or none is.
+func (a *App) AssignSchemeToTeams(schemeID string, teamIDs []string) *model.AppError {
+	if err := a.Srv().Store.Scheme().AssignTeams(schemeID, teamIDs); err != nil {
+		return schemeAssignAppError("AssignSchemeToTeams", err)
+	}
+
+	for _, teamID := range teamIDs {
+		a.ClearTeamMembersCache(teamID)
+
+		team, err := a.GetTeam(teamID)
+		if err != nil {
+			mlog.Warn("Failed to get team after scheme assignment", mlog.String("team_id", teamID), mlog.Err(err))
+			continue
+		}
+		a.sendTeamEvent(team, model.WebsocketEventUpdateTeamScheme)
+	}
+
+	return nil
+}
+
+// AssignSchemeToChannels sets the scheme of all the given channels in a single
+// transaction. Either every channel is switched or none is.
+func (a *App) AssignSchemeToChannels(schemeID string, channelIDs []string) *model.AppError {
+	if err := a.Srv().Store.Scheme().AssignChannels(schemeID, channelIDs); err != nil {
+		return schemeAssignAppError("AssignSchemeToChannels", err)
+	}
+
+	for _, channelID := range channelIDs {
+		a.Srv().Store.Channel().InvalidateChannel(channelID)
+
+		channel, err := a.GetChannel(channelID)
+		if err != nil {
+			mlog.Warn("Failed to get channel after scheme assignment", mlog.String("channel_id", channelID), mlog.Err(err))
+			continue
+		}
+		a.invalidateCacheForChannel(channel)
+
+		message := model.NewWebSocketEvent(model.WebsocketEventChannelUpdated, "", channel.Id, "", nil)
+		channelJSON, jsonErr := json.Marshal(channel)
+		if jsonErr != nil {
+			mlog.Warn("Failed to encode channel to JSON", mlog.Err(jsonErr))
+		}
+		message.Add("channel", string(channelJSON))
+		a.Publish(message)
+
+		a.ClearChannelMembersCache(channelID)
+	}
+
+	return nil
+}
+
+func schemeAssignAppError(where string, err error) *model.AppError {
+	var nfErr *store.ErrNotFound
+	switch {
+	case errors.As(err, &nfErr):
+		return model.NewAppError(where, "app.scheme.assign.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+	default:
+		return model.NewAppError(where, "app.scheme.assign.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (s *Server) IsPhase2MigrationCompleted() *model.AppError {
 	if s.phase2PermissionsMigrationComplete {
 		return nil
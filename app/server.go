@@ -46,15 +46,37 @@ import (
 	"github.com/mattermost/mattermost-server/v6/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/jobs"
 	"github.com/mattermost/mattermost-server/v6/jobs/active_users"
+	"github.com/mattermost/mattermost-server/v6/jobs/alert_rules"
+	"github.com/mattermost/mattermost-server/v6/jobs/bot_token_rotation"
+	"github.com/mattermost/mattermost-server/v6/jobs/channel_auto_archive"
+	"github.com/mattermost/mattermost-server/v6/jobs/channel_digest"
+	"github.com/mattermost/mattermost-server/v6/jobs/channel_feeds"
+	"github.com/mattermost/mattermost-server/v6/jobs/channel_language_stats_rollup"
+	"github.com/mattermost/mattermost-server/v6/jobs/channel_member_bulk_add"
+	"github.com/mattermost/mattermost-server/v6/jobs/data_retention_preview"
+	"github.com/mattermost/mattermost-server/v6/jobs/direct_channel_retention"
+	"github.com/mattermost/mattermost-server/v6/jobs/event_webhook_deliveries"
 	"github.com/mattermost/mattermost-server/v6/jobs/expirynotify"
 	"github.com/mattermost/mattermost-server/v6/jobs/export_delete"
 	"github.com/mattermost/mattermost-server/v6/jobs/export_process"
 	"github.com/mattermost/mattermost-server/v6/jobs/extract_content"
+	"github.com/mattermost/mattermost-server/v6/jobs/file_deduplication"
+	"github.com/mattermost/mattermost-server/v6/jobs/file_residency_migration"
 	"github.com/mattermost/mattermost-server/v6/jobs/import_delete"
 	"github.com/mattermost/mattermost-server/v6/jobs/import_process"
+	"github.com/mattermost/mattermost-server/v6/jobs/license_usage_rollup"
 	"github.com/mattermost/mattermost-server/v6/jobs/migrations"
+	"github.com/mattermost/mattermost-server/v6/jobs/outstanding_mentions"
 	"github.com/mattermost/mattermost-server/v6/jobs/product_notices"
 	"github.com/mattermost/mattermost-server/v6/jobs/resend_invitation_email"
+	"github.com/mattermost/mattermost-server/v6/jobs/rest_proxy_bridge"
+	"github.com/mattermost/mattermost-server/v6/jobs/scheduled_channel_messages"
+	"github.com/mattermost/mattermost-server/v6/jobs/scheme_assignment"
+	"github.com/mattermost/mattermost-server/v6/jobs/slack_import"
+	"github.com/mattermost/mattermost-server/v6/jobs/team_deletion"
+	"github.com/mattermost/mattermost-server/v6/jobs/team_group_role_sync"
+	"github.com/mattermost/mattermost-server/v6/jobs/team_stats_rollup"
+	"github.com/mattermost/mattermost-server/v6/jobs/user_merge"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/plugin/scheduler"
 	"github.com/mattermost/mattermost-server/v6/services/awsmeter"
@@ -115,6 +137,11 @@ type Server struct {
 	RateLimiter *RateLimiter
 	Busy        *Busy
 
+	// configReloaders maps the reloadable config sections to the config listener
+	// reloading them.
+	configReloaders    map[string]string
+	configReloadersMut sync.RWMutex
+
 	localModeServer *http.Server
 
 	metricsServer *http.Server
@@ -135,6 +162,8 @@ type Server struct {
 	PushNotificationsHub   PushNotificationsHub
 	pushNotificationClient *http.Client // TODO: move this to it's own package
 
+	contentExtractionPool *ContentExtractionPool
+
 	runEssentialJobs bool
 	Jobs             *jobs.JobServer
 
@@ -153,6 +182,9 @@ type Server struct {
 	seenPendingPostIdsCache cache.Cache
 	statusCache             cache.Cache
 	openGraphDataCache      cache.Cache
+	postMetadataCache       cache.Cache
+	teamResidencyCache      cache.Cache
+	userIsolationCache      cache.Cache
 	configListenerId        string
 	licenseListenerId       string
 	clusterLeaderListenerId string
@@ -161,6 +193,8 @@ type Server struct {
 	loggerLicenseListenerId string
 	configStore             *configWrapper
 	filestore               filestore.FileBackend
+	// residencyFileBackends holds the file storage of each data residency, keyed by residency.
+	residencyFileBackends map[string]filestore.FileBackend
 
 	telemetryService *telemetry.TelemetryService
 	userService      *users.UserService
@@ -198,6 +232,20 @@ type Server struct {
 	featureFlagSynchronizerMutex sync.Mutex
 
 	products map[string]Product
+
+	apiUsage *apiUsageTracker
+
+	oauthAppRateLimiter *oauthAppRateLimiter
+	oauthAppUsage       *oauthAppUsageTracker
+
+	postModerator *postModerator
+
+	// systemHealth is the last result of the health probes, reused until it's older than
+	// systemHealthCacheTTL or the configuration it was probed with changes.
+	systemHealth       *model.SystemHealth
+	systemHealthAt     time.Time
+	systemHealthConfig *model.Config
+	systemHealthMut    sync.Mutex
 }
 
 func NewServer(options ...Option) (*Server, error) {
@@ -211,10 +259,14 @@ func NewServer(options ...Option) (*Server, error) {
 		WebSocketRouter: &WebSocketRouter{
 			handlers: make(map[string]webSocketHandler),
 		},
-		licenseListeners: map[string]func(*model.License, *model.License){},
-		hashSeed:         maphash.MakeSeed(),
-		timezones:        timezones.New(),
-		products:         make(map[string]Product),
+		licenseListeners:    map[string]func(*model.License, *model.License){},
+		hashSeed:            maphash.MakeSeed(),
+		timezones:           timezones.New(),
+		products:            make(map[string]Product),
+		apiUsage:            newAPIUsageTracker(),
+		oauthAppRateLimiter: newOAuthAppRateLimiter(),
+		oauthAppUsage:       newOAuthAppUsageTracker(),
+		postModerator:       newPostModerator(),
 	}
 
 	for _, option := range options {
@@ -270,11 +322,20 @@ func NewServer(options ...Option) (*Server, error) {
 	// Step 4: Init Enterprise
 	// Depends on step 3 (s.SearchEngine must be non-nil)
 	s.initEnterprise()
+	s.registerClusterConfigReloader()
 
 	// Step 5: Cache provider.
-	// At the moment we only have this implementation
-	// in the future the cache provider will be built based on the loaded config
-	s.CacheProvider = cache.NewProvider()
+	// Depends on step 1 (config) and 4 (metrics).
+	if cacheSettings := s.Config().CacheSettings; *cacheSettings.CacheType == model.CacheTypeRedis {
+		s.CacheProvider = cache.NewRedisProvider(cache.RedisProviderOptions{
+			Address:  *cacheSettings.RedisAddress,
+			Password: *cacheSettings.RedisPassword,
+			DB:       *cacheSettings.RedisDB,
+			Metrics:  s.Metrics,
+		})
+	} else {
+		s.CacheProvider = cache.NewProvider()
+	}
 	if err2 := s.CacheProvider.Connect(); err2 != nil {
 		return nil, errors.Wrapf(err2, "Unable to connect to cache provider")
 	}
@@ -286,7 +347,7 @@ func NewServer(options ...Option) (*Server, error) {
 			s.sqlStore = sqlstore.New(s.Config().SqlSettings, s.Metrics)
 
 			lcl, err2 := localcachelayer.NewLocalCacheLayer(
-				retrylayer.New(s.sqlStore),
+				retrylayer.NewWithSettings(s.sqlStore, s.Config().SqlSettings, s.Metrics),
 				s.Metrics,
 				s.Cluster,
 				s.CacheProvider,
@@ -324,13 +385,14 @@ func NewServer(options ...Option) (*Server, error) {
 
 	// Needed to run before loading license.
 	s.userService, err = users.New(users.ServiceConfig{
-		UserStore:    s.Store.User(),
-		SessionStore: s.Store.Session(),
-		OAuthStore:   s.Store.OAuth(),
-		ConfigFn:     s.Config,
-		Metrics:      s.Metrics,
-		Cluster:      s.Cluster,
-		LicenseFn:    s.License,
+		UserStore:     s.Store.User(),
+		SessionStore:  s.Store.Session(),
+		OAuthStore:    s.Store.OAuth(),
+		ConfigFn:      s.Config,
+		Metrics:       s.Metrics,
+		Cluster:       s.Cluster,
+		LicenseFn:     s.License,
+		CacheProvider: s.CacheProvider,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to create users service")
@@ -345,6 +407,14 @@ func NewServer(options ...Option) (*Server, error) {
 		return nil, errors.Wrap(err, "Unable to create status cache")
 	}
 
+	if s.userIsolationCache, err = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          userIsolationCacheSize,
+		Name:          userIsolationCacheName,
+		DefaultExpiry: userIsolationCacheExpiry,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create user isolation cache")
+	}
+
 	if model.BuildEnterpriseReady == "true" {
 		// Dependent on user service
 		s.LoadLicense()
@@ -352,7 +422,14 @@ func NewServer(options ...Option) (*Server, error) {
 
 	license := s.License()
 	// Step 7: Initialize filestore
-	backend, err := filestore.NewFileBackend(s.Config().FileSettings.ToFileBackendSettings(license != nil && *license.Features.Compliance))
+	if s.teamResidencyCache, err = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          teamResidencyCacheSize,
+		Name:          teamResidencyCacheName,
+		DefaultExpiry: teamResidencyCacheExpiry,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create team residency cache")
+	}
+	backend, err := s.newFileBackend()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to initialize filebackend")
 	}
@@ -441,8 +518,16 @@ func NewServer(options ...Option) (*Server, error) {
 	}); err != nil {
 		return nil, errors.Wrap(err, "Unable to create opengraphdata cache")
 	}
+	if s.postMetadataCache, err = s.CacheProvider.NewCache(&cache.CacheOptions{
+		Size:          postMetadataCacheSize,
+		Name:          postMetadataCacheName,
+		DefaultExpiry: postMetadataCacheDuration,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create post metadata cache")
+	}
 
 	s.createPushNotificationsHub()
+	s.createContentExtractionPool()
 
 	if err2 := i18n.InitTranslations(*s.Config().LocalizationSettings.DefaultServerLocale, *s.Config().LocalizationSettings.DefaultClientLocale); err2 != nil {
 		return nil, errors.Wrapf(err2, "unable to load Mattermost translation files")
@@ -478,7 +563,9 @@ func NewServer(options ...Option) (*Server, error) {
 
 	s.configListenerId = s.AddConfigListener(func(_, _ *model.Config) {
 		ch := s.Channels()
+		oldClientConfig, _ := ch.clientConfig.Load().(map[string]string)
 		ch.regenerateClientConfig()
+		s.publishClientCapabilityChanges(oldClientConfig, ch.clientConfig.Load().(map[string]string))
 
 		message := model.NewWebSocketEvent(model.WebsocketEventConfigChanged, "", "", "", nil)
 
@@ -510,6 +597,7 @@ func NewServer(options ...Option) (*Server, error) {
 		ConfigFn:           s.Config,
 		LicenseFn:          s.License,
 		GoFn:               s.Go,
+		Metrics:            s.Metrics,
 		TemplatesContainer: s.TemplatesContainer(),
 		UserService:        s.userService,
 		Store:              s.GetStore(),
@@ -655,6 +743,7 @@ func NewServer(options ...Option) (*Server, error) {
 	s.doAppMigrations()
 
 	s.initPostMetadata()
+	s.initUserIsolation()
 
 	// Dump the image cache if the proxy settings have changed. (need switch URLs to the correct proxy)
 	s.AddConfigListener(func(oldCfg, newCfg *model.Config) {
@@ -719,6 +808,33 @@ func (s *Server) runJobs() {
 	s.Go(func() {
 		runCommandWebhookCleanupJob(s)
 	})
+	s.Go(func() {
+		runAPIUsageFlushJob(s)
+	})
+	s.Go(func() {
+		runAPIUsageCleanupJob(s)
+	})
+	s.Go(func() {
+		runOAuthAppUsageFlushJob(s)
+	})
+	s.Go(func() {
+		runOAuthAppUsageCleanupJob(s)
+	})
+	s.Go(func() {
+		runIdempotencyKeyCleanupJob(s)
+	})
+	s.Go(func() {
+		runPermissionDenialCleanupJob(s)
+	})
+	s.Go(func() {
+		runAuditLogCleanupJob(s)
+	})
+	s.Go(func() {
+		runTeamBannerScheduleJob(s)
+	})
+	s.Go(func() {
+		runBotStateCleanupJob(s)
+	})
 
 	if complianceI := s.Channels().Compliance; complianceI != nil {
 		complianceI.StartComplianceDailyJob()
@@ -994,10 +1110,20 @@ func (s *Server) Shutdown() {
 	// Push notification hub needs to be shutdown after HTTP server
 	// to prevent stray requests from generating a push notification after it's shut down.
 	s.StopPushNotificationsHubWorkers()
+	s.stopContentExtractionPool()
 	s.htmlTemplateWatcher.Close()
 
 	s.WaitForGoroutines()
 
+	// Store the API usage counted since the last flush, now that no request is being served.
+	if s.Store != nil {
+		doAPIUsageFlush(s)
+	}
+
+	if s.EmailService != nil {
+		s.EmailService.Stop()
+	}
+
 	s.RemoveConfigListener(s.configListenerId)
 	s.stopSearchEngine()
 
@@ -1186,12 +1312,13 @@ func (s *Server) Start() error {
 	err := s.FileBackend().TestConnection()
 	if err != nil {
 		if _, ok := err.(*filestore.S3FileBackendNoBucketError); ok {
-			err = s.FileBackend().(*filestore.S3FileBackend).MakeBucket()
+			err = s.defaultFileBackend().(*filestore.S3FileBackend).MakeBucket()
 		}
 		if err != nil {
 			mlog.Error("Problem with file storage settings", mlog.Err(err))
 		}
 	}
+	s.testResidencyFileBackends()
 
 	s.checkPushNotificationServerURL()
 
@@ -1232,15 +1359,17 @@ func (s *Server) Start() error {
 
 	if *s.Config().RateLimitSettings.Enable {
 		mlog.Info("RateLimiter is enabled")
+	}
 
-		rateLimiter, err2 := NewRateLimiter(&s.Config().RateLimitSettings, s.Config().ServiceSettings.TrustedProxyIPHeader)
-		if err2 != nil {
-			return err2
-		}
-
-		s.RateLimiter = rateLimiter
-		handler = rateLimiter.RateLimitHandler(handler)
+	// The rate limiter is always installed so that it can be enabled at runtime.
+	rateLimiter, err2 := NewRateLimiter(&s.Config().RateLimitSettings, s.Config().ServiceSettings.TrustedProxyIPHeader)
+	if err2 != nil {
+		return err2
 	}
+
+	s.RateLimiter = rateLimiter
+	handler = rateLimiter.RateLimitHandler(handler)
+	s.RegisterConfigReloader(ConfigSectionRateLimit, rateLimiter)
 	s.Busy = NewBusy(s.Cluster)
 
 	// Creating a logger for logging errors from http.Server at error level
@@ -1983,6 +2112,138 @@ func (s *Server) initJobs() {
 		extract_content.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store),
 		nil,
 	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeSchemeAssignment,
+		scheme_assignment.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelDigest,
+		channel_digest.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_digest.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeDirectChannelRetention,
+		direct_channel_retention.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		direct_channel_retention.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeDataRetentionPreview,
+		data_retention_preview.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeTeamStatsRollup,
+		team_stats_rollup.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		team_stats_rollup.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeUserMerge,
+		user_merge.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeTeamDeletion,
+		team_deletion.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		team_deletion.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelAutoArchive,
+		channel_auto_archive.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_auto_archive.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeBotTokenRotation,
+		bot_token_rotation.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		bot_token_rotation.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeSlackImport,
+		slack_import.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeScheduledChannelMessages,
+		scheduled_channel_messages.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		scheduled_channel_messages.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeEventWebhookDeliveries,
+		event_webhook_deliveries.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		event_webhook_deliveries.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeAlertRules,
+		alert_rules.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		alert_rules.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelLanguageStatsRollup,
+		channel_language_stats_rollup.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_language_stats_rollup.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelMemberBulkAdd,
+		channel_member_bulk_add.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeFileResidencyMigration,
+		file_residency_migration.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeLicenseUsageRollup,
+		license_usage_rollup.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		license_usage_rollup.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeRESTProxyBridge,
+		rest_proxy_bridge.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		rest_proxy_bridge.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelFeeds,
+		channel_feeds.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_feeds.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeOutstandingMentions,
+		outstanding_mentions.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		outstanding_mentions.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeFileDeduplication,
+		file_deduplication.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeTeamGroupRoleSync,
+		team_group_role_sync.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		team_group_role_sync.MakeScheduler(s.Jobs),
+	)
 }
 
 func (s *Server) TelemetryId() string {
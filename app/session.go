@@ -5,6 +5,7 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math"
 	"net/http"
@@ -263,6 +264,12 @@ func (a *App) ExtendSessionExpiryIfNeeded(session *model.Session) bool {
 		return false
 	}
 
+	// The sessions of the access tokens last as long as the tokens, and the sessions of the
+	// impersonations as long as they were granted for.
+	if session.Props[model.SessionPropType] == model.SessionTypeUserAccessToken || session.Props[model.SessionPropType] == model.SessionTypeImpersonation {
+		return false
+	}
+
 	sessionLength := a.GetSessionLengthInMillis(session)
 
 	// Only extend the expiry if the lessor of 1% or 1 day has elapsed within the
@@ -355,6 +362,10 @@ func (a *App) CreateUserAccessToken(token *model.UserAccessToken) (*model.UserAc
 		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.disabled", nil, "", http.StatusNotImplemented)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	token.Token = model.NewId()
 
 	token, nErr = a.Srv().Store.UserAccessToken().Save(token)
@@ -389,6 +400,10 @@ func (a *App) createSessionForUserAccessToken(tokenString string) (*model.Sessio
 		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "inactive_token", http.StatusUnauthorized)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "expired_token", http.StatusUnauthorized)
+	}
+
 	user, nErr := a.Srv().Store.User().Get(context.Background(), token.UserId)
 	if nErr != nil {
 		var nfErr *store.ErrNotFound
@@ -425,7 +440,17 @@ func (a *App) createSessionForUserAccessToken(tokenString string) (*model.Sessio
 	} else {
 		session.AddProp(model.SessionPropIsGuest, "false")
 	}
+	if token.WebSocketScope != nil {
+		scope, err := json.Marshal(token.WebSocketScope)
+		if err != nil {
+			return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.websocket_scope.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		session.AddProp(model.SessionPropWebSocketScope, string(scope))
+	}
 	a.ch.srv.userService.SetSessionExpireInDays(session, model.SessionUserAccessTokenExpiry)
+	if token.ExpiresAt != 0 && token.ExpiresAt < session.ExpiresAt {
+		session.ExpiresAt = token.ExpiresAt
+	}
 
 	session, nErr = a.Srv().Store.Session().Save(session)
 	if nErr != nil {
@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/utils"
+)
+
+// addSessionDeviceProps records the address and the location the session is created from,
+// along with the fingerprint of its device.
+func (a *App) addSessionDeviceProps(session *model.Session, r *http.Request, platform, os, browserName string) {
+	location := ""
+	if header := *a.Config().ServiceSettings.LoginLocationHeader; header != "" {
+		location = model.SanitizeSessionLocation(r.Header.Get(header))
+	}
+
+	session.AddProp(model.SessionPropIpAddress, utils.GetIPAddress(r, a.Config().ServiceSettings.TrustedProxyIPHeader))
+	session.AddProp(model.SessionPropLocation, location)
+	session.AddProp(model.SessionPropDeviceFingerprint, model.SessionDeviceFingerprint(platform, os, browserName, location))
+}
+
+// recordSessionDevice remembers the device of the session among the known devices of the user,
+// returning true when the device wasn't known yet. The first device of a user isn't reported
+// as a new one.
+func (a *App) recordSessionDevice(session *model.Session) (bool, *model.AppError) {
+	fingerprint := session.Props[model.SessionPropDeviceFingerprint]
+	if fingerprint == "" {
+		return false, nil
+	}
+
+	known, err := a.Srv().Store.Preference().GetCategory(session.UserId, model.PreferenceCategoryKnownDevice)
+	if err != nil {
+		return false, model.NewAppError("recordSessionDevice", "app.preference.get_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	for _, preference := range known {
+		if preference.Name == fingerprint {
+			return false, nil
+		}
+	}
+
+	preference := model.Preference{
+		UserId:   session.UserId,
+		Category: model.PreferenceCategoryKnownDevice,
+		Name:     fingerprint,
+		Value:    strconv.FormatInt(session.CreateAt, 10),
+	}
+	if err := a.Srv().Store.Preference().Save(model.Preferences{preference}); err != nil {
+		return false, model.NewAppError("recordSessionDevice", "app.preference.save.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return len(known) > 0, nil
+}
+
+// checkSessionDevice tells the user about a login from a device they didn't log in from before,
+// by email and by a direct message from the system bot.
+func (a *App) checkSessionDevice(c *request.Context, user *model.User, session *model.Session) {
+	if user.IsBot {
+		return
+	}
+
+	isNew, appErr := a.recordSessionDevice(session)
+	if appErr != nil {
+		mlog.Warn("Failed to record the device of a session", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+	if !isNew || !*a.Config().ServiceSettings.EnableNewDeviceLoginNotifications {
+		return
+	}
+
+	device := model.SessionDeviceFromSession(session, "")
+	a.Srv().Go(func() {
+		if err := a.Srv().EmailService.SendNewDeviceLoginEmail(user.Email, user.Locale, a.GetSiteURL(), device); err != nil {
+			mlog.Error("Unable to send new device login email", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+
+		T := i18n.GetUserTranslations(user.Locale)
+		message := T("app.session.new_device.message", newDeviceLoginParams(device))
+		if appErr := a.sendSystemBotDirectMessage(c, user.Id, message); appErr != nil {
+			mlog.Warn("Failed to send new device login message", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		}
+	})
+}
+
+func newDeviceLoginParams(device *model.SessionDevice) map[string]interface{} {
+	location := device.Location
+	if location == "" {
+		location = "-"
+	}
+	return map[string]interface{}{
+		"Browser":   device.Browser,
+		"Os":        device.Os,
+		"IpAddress": device.IpAddress,
+		"Location":  location,
+		"Time":      model.GetTimeForMillis(device.CreateAt).UTC().Format(time.RFC1123),
+	}
+}
+
+// GetSessionDevices returns the devices the user is logged in from, telling which one made the
+// request. Sessions of personal access tokens aren't devices and are left out.
+func (a *App) GetSessionDevices(userID, currentSessionID string) ([]*model.SessionDevice, *model.AppError) {
+	sessions, appErr := a.GetSessions(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	devices := []*model.SessionDevice{}
+	for _, session := range sessions {
+		if session.Props[model.SessionPropType] == model.SessionTypeUserAccessToken || session.IsExpired() {
+			continue
+		}
+		devices = append(devices, model.SessionDeviceFromSession(session, currentSessionID))
+	}
+
+	return devices, nil
+}
+
+// RevokeOtherSessionDevices logs the user out of all their devices but the one of the current
+// session.
+func (a *App) RevokeOtherSessionDevices(userID, currentSessionID string) *model.AppError {
+	sessions, appErr := a.GetSessions(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	for _, session := range sessions {
+		if session.Id == currentSessionID || session.Props[model.SessionPropType] == model.SessionTypeUserAccessToken {
+			continue
+		}
+		if appErr := a.RevokeSession(session); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRecordSessionDevice(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newSession := func(fingerprint string) *model.Session {
+		session, appErr := th.App.CreateSession(&model.Session{
+			UserId: th.BasicUser.Id,
+			Props:  model.StringMap{model.SessionPropDeviceFingerprint: fingerprint},
+		})
+		require.Nil(t, appErr)
+		return session
+	}
+
+	laptop := model.SessionDeviceFingerprint("Windows", "Windows", "Edge", "")
+	phone := model.SessionDeviceFingerprint("iPhone", "iOS", "Safari", "")
+
+	isNew, appErr := th.App.recordSessionDevice(newSession(laptop))
+	require.Nil(t, appErr)
+	assert.False(t, isNew, "the first device of a user isn't reported")
+
+	isNew, appErr = th.App.recordSessionDevice(newSession(laptop))
+	require.Nil(t, appErr)
+	assert.False(t, isNew)
+
+	isNew, appErr = th.App.recordSessionDevice(newSession(phone))
+	require.Nil(t, appErr)
+	assert.True(t, isNew)
+
+	isNew, appErr = th.App.recordSessionDevice(newSession(""))
+	require.Nil(t, appErr)
+	assert.False(t, isNew)
+}
+
+func TestSessionDevices(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	current, appErr := th.App.CreateSession(&model.Session{UserId: th.BasicUser.Id, Props: model.StringMap{model.SessionPropOs: "Linux"}})
+	require.Nil(t, appErr)
+	other, appErr := th.App.CreateSession(&model.Session{UserId: th.BasicUser.Id, Props: model.StringMap{model.SessionPropOs: "Android"}})
+	require.Nil(t, appErr)
+	tokenSession, appErr := th.App.CreateSession(&model.Session{UserId: th.BasicUser.Id, Props: model.StringMap{model.SessionPropType: model.SessionTypeUserAccessToken}})
+	require.Nil(t, appErr)
+
+	devices, appErr := th.App.GetSessionDevices(th.BasicUser.Id, current.Id)
+	require.Nil(t, appErr)
+	bySession := map[string]*model.SessionDevice{}
+	for _, device := range devices {
+		bySession[device.SessionId] = device
+	}
+	require.Contains(t, bySession, current.Id)
+	require.Contains(t, bySession, other.Id)
+	assert.NotContains(t, bySession, tokenSession.Id)
+	assert.True(t, bySession[current.Id].IsCurrent)
+	assert.False(t, bySession[other.Id].IsCurrent)
+	assert.Equal(t, "Android", bySession[other.Id].Os)
+
+	require.Nil(t, th.App.RevokeOtherSessionDevices(th.BasicUser.Id, current.Id))
+
+	_, appErr = th.App.GetSessionById(other.Id)
+	require.NotNil(t, appErr)
+	_, appErr = th.App.GetSessionById(current.Id)
+	require.Nil(t, appErr)
+	_, appErr = th.App.GetSessionById(tokenSession.Id)
+	require.Nil(t, appErr)
+}
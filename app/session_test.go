@@ -281,6 +281,23 @@ func TestApp_ExtendExpiryIfNeeded(t *testing.T) {
 		require.False(t, session.IsExpired())
 	})
 
+	t.Run("access token session should not be extended", func(t *testing.T) {
+		session := &model.Session{
+			UserId: model.NewId(),
+		}
+		session.AddProp(model.SessionPropType, model.SessionTypeUserAccessToken)
+		session, err := th.App.CreateSession(session)
+		require.Nil(t, err)
+
+		expires := model.GetMillis() + th.App.GetSessionLengthInMillis(session) - hourMillis
+		session.ExpiresAt = expires
+
+		ok := th.App.ExtendSessionExpiryIfNeeded(session)
+
+		require.False(t, ok)
+		require.Equal(t, expires, session.ExpiresAt)
+	})
+
 	var tests = []struct {
 		enabled bool
 		name    string
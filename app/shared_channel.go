@@ -148,6 +148,98 @@ func (a *App) GetSharedChannelRemotesStatus(channelID string) ([]*model.SharedCh
 	return a.Srv().Store.SharedChannel().GetRemotesStatus(channelID)
 }
 
+// SharedChannelInvites
+
+func (a *App) GetSharedChannelInvites(opts model.SharedChannelInviteFilterOpts, page, perPage int) ([]*model.SharedChannelInvite, *model.AppError) {
+	invites, err := a.Srv().Store.SharedChannel().GetInvites(opts, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetSharedChannelInvites", "app.shared_channel_invite.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return invites, nil
+}
+
+func (a *App) GetSharedChannelInvite(inviteID string) (*model.SharedChannelInvite, *model.AppError) {
+	invite, err := a.Srv().Store.SharedChannel().GetInvite(inviteID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetSharedChannelInvite", "app.shared_channel_invite.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetSharedChannelInvite", "app.shared_channel_invite.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return invite, nil
+}
+
+// GetSharedChannelInvitePreview returns the invite along with the remote cluster which sent it.
+func (a *App) GetSharedChannelInvitePreview(inviteID string) (*model.SharedChannelInvitePreview, *model.AppError) {
+	invite, appErr := a.GetSharedChannelInvite(inviteID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	rc, appErr := a.GetRemoteCluster(invite.RemoteId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.SharedChannelInvitePreview{
+		Invite: invite,
+		Remote: rc.ToRemoteClusterInfo(),
+	}, nil
+}
+
+// ApproveSharedChannelInvite creates the channel of a pending invite, and tells the remote
+// cluster which sent it to start sharing the channel.
+func (a *App) ApproveSharedChannelInvite(inviteID, userID string) (*model.SharedChannelInvite, *model.AppError) {
+	return a.decideSharedChannelInvite(inviteID, userID, model.SharedChannelInviteStatusApproved)
+}
+
+// DenySharedChannelInvite tells the remote cluster which sent a pending invite that it was
+// denied.
+func (a *App) DenySharedChannelInvite(inviteID, userID string) (*model.SharedChannelInvite, *model.AppError) {
+	return a.decideSharedChannelInvite(inviteID, userID, model.SharedChannelInviteStatusDenied)
+}
+
+func (a *App) decideSharedChannelInvite(inviteID, userID, status string) (*model.SharedChannelInvite, *model.AppError) {
+	syncService := a.Srv().GetSharedChannelSyncService()
+	if syncService == nil || !syncService.Active() {
+		return nil, model.NewAppError("decideSharedChannelInvite", "app.shared_channel_invite.service_not_enabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	invite, appErr := a.GetSharedChannelInvite(inviteID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if invite.Status != model.SharedChannelInviteStatusPending {
+		return nil, model.NewAppError("decideSharedChannelInvite", "app.shared_channel_invite.not_pending.app_error", nil, "status="+invite.Status, http.StatusBadRequest)
+	}
+
+	var err error
+	if status == model.SharedChannelInviteStatusApproved {
+		err = syncService.ApproveChannelInvite(invite)
+	} else {
+		err = syncService.DenyChannelInvite(invite)
+	}
+	if err != nil {
+		return nil, model.NewAppError("decideSharedChannelInvite", "app.shared_channel_invite.decide.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	invite, err = a.Srv().Store.SharedChannel().UpdateInviteStatus(inviteID, status, userID)
+	if err != nil {
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("decideSharedChannelInvite", "app.shared_channel_invite.not_pending.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("decideSharedChannelInvite", "app.shared_channel_invite.decide.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return invite, nil
+}
+
 // SharedChannelUsers
 
 func (a *App) NotifySharedChannelUserUpdate(user *model.User) {
@@ -16,6 +16,9 @@ type SharedChannelServiceIFace interface {
 	NotifyUserProfileChanged(userID string)
 	SendChannelInvite(channel *model.Channel, userId string, rc *model.RemoteCluster, options ...sharedchannel.InviteOption) error
 	Active() bool
+	GetRemoteHealth(rc *model.RemoteCluster) (*model.RemoteClusterHealth, error)
+	ApproveChannelInvite(invite *model.SharedChannelInvite) error
+	DenyChannelInvite(invite *model.SharedChannelInvite) error
 }
 
 type MockOptionSharedChannelService func(service *mockSharedChannelService)
@@ -27,7 +30,7 @@ func MockOptionSharedChannelServiceWithActive(active bool) MockOptionSharedChann
 }
 
 func NewMockSharedChannelService(service SharedChannelServiceIFace, options ...MockOptionSharedChannelService) *mockSharedChannelService {
-	mrcs := &mockSharedChannelService{service, true, []string{}, []string{}, 0}
+	mrcs := &mockSharedChannelService{service, true, []string{}, []string{}, 0, []string{}, []string{}}
 	for _, option := range options {
 		option(mrcs)
 	}
@@ -40,6 +43,8 @@ type mockSharedChannelService struct {
 	channelNotifications     []string
 	userProfileNotifications []string
 	numInvitations           int
+	approvedInvites          []string
+	deniedInvites            []string
 }
 
 func (mrcs *mockSharedChannelService) NotifyChannelChanged(channelId string) {
@@ -67,6 +72,25 @@ func (mrcs *mockSharedChannelService) SendChannelInvite(channel *model.Channel,
 	return nil
 }
 
+func (mrcs *mockSharedChannelService) GetRemoteHealth(rc *model.RemoteCluster) (*model.RemoteClusterHealth, error) {
+	return &model.RemoteClusterHealth{
+		RemoteId:   rc.RemoteId,
+		IsOnline:   rc.IsOnline(),
+		LastPingAt: rc.LastPingAt,
+		Channels:   []*model.RemoteClusterChannelHealth{},
+	}, nil
+}
+
+func (mrcs *mockSharedChannelService) ApproveChannelInvite(invite *model.SharedChannelInvite) error {
+	mrcs.approvedInvites = append(mrcs.approvedInvites, invite.Id)
+	return nil
+}
+
+func (mrcs *mockSharedChannelService) DenyChannelInvite(invite *model.SharedChannelInvite) error {
+	mrcs.deniedInvites = append(mrcs.deniedInvites, invite.Id)
+	return nil
+}
+
 func (mrcs *mockSharedChannelService) NumInvitations() int {
 	return mrcs.numInvitations
 }
@@ -0,0 +1,322 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"image"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/services/slackimport"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// slackImportDirectory holds the exports, tokens and reports of the Slack imports, apart from
+// the bulk imports so that they aren't listed among them.
+const slackImportDirectory = "slack_imports"
+
+var errSlackImportCanceled = errors.New("the Slack import was canceled")
+
+// CreateSlackImportJob queues the import of a Slack workspace to the team, read from the
+// export when given or through the Slack API with the token otherwise. The export and the
+// token are kept in the file store until the import succeeds.
+func (a *App) CreateSlackImportJob(teamID string, export io.Reader, token string) (*model.Job, *model.AppError) {
+	if (export == nil) == (token == "") {
+		return nil, model.NewAppError("CreateSlackImportJob", "app.slack_import.create.source.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if _, appErr := a.GetTeam(teamID); appErr != nil {
+		return nil, appErr
+	}
+
+	dir := filepath.Join(slackImportDirectory, model.NewId())
+	data := map[string]string{
+		"team_id":     teamID,
+		"report_file": filepath.Join(dir, "report.json"),
+	}
+
+	if export != nil {
+		data["source"] = model.SlackImportSourceZip
+		data["import_file"] = filepath.Join(dir, "export.zip")
+		if _, appErr := a.WriteFile(export, data["import_file"]); appErr != nil {
+			return nil, appErr
+		}
+	} else {
+		data["source"] = model.SlackImportSourceAPI
+		data["token_file"] = filepath.Join(dir, "token")
+		if _, appErr := a.WriteFile(strings.NewReader(token), data["token_file"]); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	job, appErr := a.Srv().Jobs.CreateJob(model.JobTypeSlackImport, data)
+	if appErr != nil {
+		a.removeSlackImportFiles(data)
+		return nil, appErr
+	}
+
+	return job, nil
+}
+
+// ResumeSlackImportJob queues a new job that carries on a Slack import which failed or was
+// canceled, from the last checkpoint it saved.
+func (a *App) ResumeSlackImportJob(jobID string) (*model.Job, *model.AppError) {
+	job, appErr := a.GetSlackImportJob(jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if job.Status != model.JobStatusError && job.Status != model.JobStatusCanceled {
+		return nil, model.NewAppError("ResumeSlackImportJob", "app.slack_import.resume.not_stopped.app_error", nil, "job_id="+jobID, http.StatusBadRequest)
+	}
+
+	data := make(map[string]string, len(job.Data))
+	for key, value := range job.Data {
+		data[key] = value
+	}
+	data["resumed_job_id"] = job.Id
+
+	return a.Srv().Jobs.CreateJob(model.JobTypeSlackImport, data)
+}
+
+// GetSlackImportReport returns how the users and channels of the workspace were mapped by
+// the Slack import, as of its last checkpoint.
+func (a *App) GetSlackImportReport(jobID string) (*model.SlackImportReport, *model.AppError) {
+	job, appErr := a.GetSlackImportJob(jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	report, appErr := a.readSlackImportReport(job.Data["report_file"])
+	if appErr != nil {
+		return nil, appErr
+	}
+	if report == nil {
+		return nil, model.NewAppError("GetSlackImportReport", "app.slack_import.report.not_ready.app_error", nil, "job_id="+jobID, http.StatusNotFound)
+	}
+
+	return report, nil
+}
+
+// GetSlackImportJob returns the job of a Slack import, telling which team it imports to.
+func (a *App) GetSlackImportJob(jobID string) (*model.Job, *model.AppError) {
+	job, appErr := a.GetJob(jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if job.Type != model.JobTypeSlackImport {
+		return nil, model.NewAppError("GetSlackImportJob", "app.slack_import.not_found.app_error", nil, "job_id="+jobID, http.StatusNotFound)
+	}
+
+	return job, nil
+}
+
+// ProcessSlackImport runs the Slack import of the job, saving a checkpoint along with the
+// report as it goes so that it can be resumed should it stop.
+func (a *App) ProcessSlackImport(job *model.Job) *model.AppError {
+	c := request.EmptyContext()
+
+	source, closeSource, appErr := a.slackImportSource(job)
+	if appErr != nil {
+		return appErr
+	}
+	defer closeSource()
+
+	report, appErr := a.readSlackImportReport(job.Data["report_file"])
+	if appErr != nil {
+		return appErr
+	}
+	if report == nil {
+		report = &model.SlackImportReport{}
+	}
+	report.JobId = job.Id
+	report.TeamId = job.Data["team_id"]
+
+	channelIndex, _ := strconv.Atoi(job.Data["channel_index"])
+	progress := slackimport.Progress{
+		ChannelIndex:  channelIndex,
+		LastTimeStamp: job.Data["last_ts"],
+	}
+
+	checkpoint := func(progress slackimport.Progress, report *model.SlackImportReport) error {
+		if appErr := a.writeSlackImportReport(job.Data["report_file"], report); appErr != nil {
+			return appErr
+		}
+
+		job.Data["channel_index"] = strconv.Itoa(progress.ChannelIndex)
+		job.Data["last_ts"] = progress.LastTimeStamp
+		if progress.ChannelCount > 0 {
+			job.Progress = int64(progress.ChannelIndex * 100 / progress.ChannelCount)
+		}
+		job.LastActivityAt = model.GetMillis()
+		updated, err := a.Srv().Store.Job().UpdateOptimistically(job, model.JobStatusInProgress)
+		if err != nil {
+			return model.NewAppError("ProcessSlackImport", "app.job.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		if !updated {
+			return errSlackImportCanceled
+		}
+		return nil
+	}
+
+	if err := a.slackImporter(c, true).Import(source, report.TeamId, progress, report, checkpoint); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("ProcessSlackImport", "app.slack_import.process.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if appErr := a.writeSlackImportReport(job.Data["report_file"], report); appErr != nil {
+		return appErr
+	}
+
+	a.removeSlackImportFiles(job.Data)
+
+	return nil
+}
+
+// slackImportSource returns the source of the Slack import of the job, along with what closes it.
+func (a *App) slackImportSource(job *model.Job) (slackimport.Source, func(), *model.AppError) {
+	client := a.HTTPService().MakeClient(false)
+
+	switch job.Data["source"] {
+	case model.SlackImportSourceZip:
+		size, appErr := a.FileSize(job.Data["import_file"])
+		if appErr != nil {
+			return nil, nil, appErr
+		}
+		file, appErr := a.FileReader(job.Data["import_file"])
+		if appErr != nil {
+			return nil, nil, appErr
+		}
+		// The export stays open for its files to be read as the import goes.
+		source, err := slackimport.NewZipSource(file.(io.ReaderAt), size, client)
+		if err != nil {
+			file.Close()
+			return nil, nil, model.NewAppError("ProcessSlackImport", "app.slack_import.process.open_export.app_error", nil, err.Error(), http.StatusBadRequest)
+		}
+		return source, func() { file.Close() }, nil
+	case model.SlackImportSourceAPI:
+		token, appErr := a.ReadFile(job.Data["token_file"])
+		if appErr != nil {
+			return nil, nil, appErr
+		}
+		return slackimport.NewAPISource(string(token), client), func() {}, nil
+	default:
+		return nil, nil, model.NewAppError("ProcessSlackImport", "app.slack_import.process.source.app_error", nil, "source="+job.Data["source"], http.StatusBadRequest)
+	}
+}
+
+// readSlackImportReport returns the report saved at path, or nil when none was saved yet.
+func (a *App) readSlackImportReport(path string) (*model.SlackImportReport, *model.AppError) {
+	if path == "" {
+		return nil, nil
+	}
+
+	exists, appErr := a.FileExists(path)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	data, appErr := a.ReadFile(path)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var report model.SlackImportReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, model.NewAppError("readSlackImportReport", "app.slack_import.report.decode.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return &report, nil
+}
+
+func (a *App) writeSlackImportReport(path string, report *model.SlackImportReport) *model.AppError {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return model.NewAppError("writeSlackImportReport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	_, appErr := a.WriteFile(bytes.NewReader(data), path)
+	return appErr
+}
+
+// removeSlackImportFiles removes the export and the token of a Slack import, once they are no
+// longer needed. The report is kept.
+func (a *App) removeSlackImportFiles(data map[string]string) {
+	for _, key := range []string{"import_file", "token_file"} {
+		if data[key] == "" {
+			continue
+		}
+		if appErr := a.RemoveFile(data[key]); appErr != nil {
+			mlog.Warn("Failed to remove a file of a Slack import", mlog.String("path", data[key]), mlog.Err(appErr))
+		}
+	}
+}
+
+// slackImporter returns an importer acting on behalf of the request. A resumable importer
+// reuses the direct and group message channels that already exist instead of failing on them,
+// since resuming an import meets the ones it created before.
+func (a *App) slackImporter(c *request.Context, resumable bool) *slackimport.SlackImporter {
+	actions := slackimport.Actions{
+		UpdateActive: func(user *model.User, active bool) (*model.User, *model.AppError) {
+			return a.UpdateActive(c, user, active)
+		},
+		AddUserToChannel: a.AddUserToChannel,
+		JoinUserToTeam: func(team *model.Team, user *model.User, userRequestorId string) (*model.TeamMember, *model.AppError) {
+			return a.JoinUserToTeam(c, team, user, userRequestorId)
+		},
+		CreateDirectChannel: a.createDirectChannel,
+		CreateGroupChannel:  a.createGroupChannel,
+		CreateChannel: func(channel *model.Channel, addMember bool) (*model.Channel, *model.AppError) {
+			return a.CreateChannel(c, channel, addMember)
+		},
+		DoUploadFile: func(now time.Time, rawTeamId string, rawChannelId string, rawUserId string, rawFilename string, data []byte) (*model.FileInfo, *model.AppError) {
+			return a.DoUploadFile(c, now, rawTeamId, rawChannelId, rawUserId, rawFilename, data)
+		},
+		GenerateThumbnailImage: a.generateThumbnailImage,
+		GeneratePreviewImage:   a.generatePreviewImage,
+		InvalidateAllCaches:    func() { a.ch.srv.InvalidateAllCaches() },
+		MaxPostSize:            func() int { return a.ch.srv.MaxPostSize() },
+		PrepareImage: func(fileData []byte) (image.Image, func(), error) {
+			img, release, err := prepareImage(a.ch.imgDecoder, bytes.NewReader(fileData))
+			if err != nil {
+				return nil, nil, err
+			}
+			return img, release, err
+		},
+	}
+
+	if resumable {
+		actions.CreateDirectChannel = func(userID string, otherUserID string, channelOptions ...model.ChannelOption) (*model.Channel, *model.AppError) {
+			return a.GetOrCreateDirectChannel(c, userID, otherUserID, channelOptions...)
+		}
+		actions.CreateGroupChannel = func(userIDs []string) (*model.Channel, *model.AppError) {
+			channel, appErr := a.createGroupChannel(userIDs)
+			if appErr != nil && appErr.Id == store.ChannelExistsError && channel != nil {
+				return channel, nil
+			}
+			return channel, appErr
+		}
+	}
+
+	return slackimport.New(a.ch.srv.Store, actions, a.Config())
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/app"
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+type CannedProvider struct {
+}
+
+const (
+	CmdCanned = "canned"
+)
+
+// cannedVariableRegex matches the name=value pairs given after the shortcut. Values holding
+// spaces can be quoted.
+var cannedVariableRegex = regexp.MustCompile(`([A-Za-z0-9_]+)=(?:"([^"]*)"|(\S+))`)
+
+func init() {
+	app.RegisterCommandProvider(&CannedProvider{})
+}
+
+func (*CannedProvider) GetTrigger() string {
+	return CmdCanned
+}
+
+func (*CannedProvider) GetCommand(a *app.App, T i18n.TranslateFunc) *model.Command {
+	canned := model.NewAutocompleteData(CmdCanned, T("api.command_canned.hint"), T("api.command_canned.desc"))
+	canned.AddDynamicListArgument(T("api.command_canned.shortcut.help"), "builtin:"+CmdCanned, true)
+	canned.AddTextArgument(T("api.command_canned.variables.help"), T("api.command_canned.variables.hint"), "")
+
+	return &model.Command{
+		Trigger:          CmdCanned,
+		AutoComplete:     true,
+		AutoCompleteDesc: T("api.command_canned.desc"),
+		AutoCompleteHint: T("api.command_canned.hint"),
+		DisplayName:      T("api.command_canned.name"),
+		AutocompleteData: canned,
+	}
+}
+
+func (*CannedProvider) GetAutoCompleteListItems(a *app.App, commandArgs *model.CommandArgs, arg *model.AutocompleteArg, parsed, toBeParsed string) ([]model.AutocompleteListItem, error) {
+	prefix := ""
+	if fields := strings.Fields(toBeParsed); len(fields) > 0 {
+		prefix = fields[0]
+	}
+
+	responses, appErr := a.AutocompleteCannedResponses(commandArgs.UserId, commandArgs.TeamId, prefix)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	items := make([]model.AutocompleteListItem, 0, len(responses))
+	for _, response := range responses {
+		var hint []string
+		for _, variable := range response.Variables() {
+			hint = append(hint, variable+"=")
+		}
+		items = append(items, model.AutocompleteListItem{
+			Item:     response.Shortcut,
+			Hint:     strings.Join(hint, " "),
+			HelpText: response.Content,
+		})
+	}
+
+	return items, nil
+}
+
+func (*CannedProvider) DoCommand(a *app.App, c *request.Context, args *model.CommandArgs, message string) *model.CommandResponse {
+	fields := strings.Fields(message)
+	if len(fields) == 0 {
+		return &model.CommandResponse{Text: args.T("api.command_canned.missing_shortcut.app_error"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+	shortcut := fields[0]
+
+	response, appErr := a.GetCannedResponseByShortcut(args.UserId, args.TeamId, shortcut)
+	if appErr != nil {
+		return &model.CommandResponse{Text: args.T("api.command_canned.not_found.app_error", map[string]interface{}{"Shortcut": shortcut}), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	variables := map[string]string{}
+	for _, match := range cannedVariableRegex.FindAllStringSubmatch(strings.TrimPrefix(strings.TrimSpace(message), shortcut), -1) {
+		variables[match[1]] = match[2] + match[3]
+	}
+
+	var missing []string
+	for _, variable := range response.Variables() {
+		if _, ok := variables[variable]; !ok {
+			missing = append(missing, fmt.Sprintf("`%s`", variable))
+		}
+	}
+	if len(missing) > 0 {
+		return &model.CommandResponse{Text: args.T("api.command_canned.missing_variables.app_error", map[string]interface{}{"Variables": strings.Join(missing, ", ")}), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	return &model.CommandResponse{ResponseType: model.CommandResponseTypeInChannel, Text: a.UseCannedResponse(response, variables)}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+func TestCannedProviderDoCommand(t *testing.T) {
+	th := setup(t).initBasic()
+	defer th.tearDown()
+
+	own, appErr := th.App.CreateCannedResponse(&model.CannedResponse{
+		UserId:    th.BasicUser.Id,
+		CreatorId: th.BasicUser.Id,
+		Shortcut:  "thanks",
+		Content:   "Thanks {{customer}}, ticket {{ticket}} is closed.",
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.CreateCannedResponse(&model.CannedResponse{
+		TeamId:    th.BasicTeam.Id,
+		CreatorId: th.BasicUser.Id,
+		Shortcut:  "thanks",
+		Content:   "Thanks from the team",
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.CreateCannedResponse(&model.CannedResponse{
+		TeamId:    th.BasicTeam.Id,
+		CreatorId: th.BasicUser.Id,
+		Shortcut:  "welcome",
+		Content:   "Welcome!",
+	})
+	require.Nil(t, appErr)
+
+	cp := CannedProvider{}
+	args := &model.CommandArgs{
+		T:      i18n.IdentityTfunc(),
+		UserId: th.BasicUser.Id,
+		TeamId: th.BasicTeam.Id,
+	}
+
+	resp := cp.DoCommand(th.App, th.Context, args, "")
+	assert.Equal(t, "api.command_canned.missing_shortcut.app_error", resp.Text)
+
+	resp = cp.DoCommand(th.App, th.Context, args, "unknown")
+	assert.Equal(t, "api.command_canned.not_found.app_error", resp.Text)
+
+	resp = cp.DoCommand(th.App, th.Context, args, "thanks customer=Acme")
+	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
+	assert.Equal(t, "api.command_canned.missing_variables.app_error", resp.Text)
+
+	resp = cp.DoCommand(th.App, th.Context, args, `thanks customer="Acme Corp" ticket=42`)
+	assert.Equal(t, model.CommandResponseTypeInChannel, resp.ResponseType)
+	assert.Equal(t, "Thanks Acme Corp, ticket 42 is closed.", resp.Text)
+
+	resp = cp.DoCommand(th.App, th.Context, args, "welcome")
+	assert.Equal(t, "Welcome!", resp.Text)
+
+	used, appErr := th.App.GetCannedResponse(own.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(1), used.UseCount)
+}
+
+func TestCannedProviderAutocomplete(t *testing.T) {
+	th := setup(t).initBasic()
+	defer th.tearDown()
+
+	_, appErr := th.App.CreateCannedResponse(&model.CannedResponse{
+		UserId:    th.BasicUser.Id,
+		CreatorId: th.BasicUser.Id,
+		Shortcut:  "thanks",
+		Content:   "Thanks {{customer}}",
+	})
+	require.Nil(t, appErr)
+
+	cp := CannedProvider{}
+	args := &model.CommandArgs{UserId: th.BasicUser.Id, TeamId: th.BasicTeam.Id}
+
+	items, err := cp.GetAutoCompleteListItems(th.App, args, nil, "canned ", "th")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "thanks", items[0].Item)
+	assert.Equal(t, "customer=", items[0].Hint)
+
+	items, err = cp.GetAutoCompleteListItems(th.App, args, nil, "canned ", "x")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -425,6 +425,8 @@ func (a *App) SetCustomStatus(userID string, cs *model.CustomStatus) *model.AppE
 		a.Log().Error("Can't add recent custom status for", mlog.String("userID", userID), mlog.Err(err))
 	}
 
+	a.recordCustomStatusTemplateUse(userID, cs)
+
 	return nil
 }
 
@@ -0,0 +1,218 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/services/searchengine"
+	"github.com/mattermost/mattermost-server/v6/shared/mail"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	healthCheckFileStoreDirectory = "health_check/"
+	systemHealthCacheTTL          = 5 * time.Second
+)
+
+// GetSystemHealth probes the dependencies of the server concurrently. Probes that don't apply,
+// such as the SMTP server when email notifications are disabled, are left out. The result is
+// shared for a few seconds, so that frequent requests don't each probe the dependencies.
+func (a *App) GetSystemHealth() *model.SystemHealth {
+	s := a.Srv()
+	s.systemHealthMut.Lock()
+	defer s.systemHealthMut.Unlock()
+
+	cfg := a.Config()
+	if s.systemHealth == nil || s.systemHealthConfig != cfg || time.Since(s.systemHealthAt) > systemHealthCacheTTL {
+		s.systemHealth = a.probeSystemHealth()
+		s.systemHealthAt = time.Now()
+		s.systemHealthConfig = cfg
+	}
+
+	return s.systemHealth
+}
+
+func (a *App) probeSystemHealth() *model.SystemHealth {
+	required := make(map[string]bool)
+	for _, probe := range a.Config().ServiceSettings.HealthCheckRequiredProbes {
+		required[probe] = true
+	}
+
+	health := &model.SystemHealth{
+		Status: model.StatusOk,
+		Checks: make(map[string]*model.HealthCheck),
+	}
+	var mut sync.Mutex
+	addCheck := func(name string, check *model.HealthCheck) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		health.Checks[name] = check
+		if check.Status == model.StatusUnhealthy && required[check.Probe] {
+			health.Status = model.StatusUnhealthy
+		} else if check.Status != model.StatusOk && health.Status == model.StatusOk {
+			health.Status = model.StatusDegraded
+		}
+	}
+
+	probes := []func(addCheck func(name string, check *model.HealthCheck)){
+		a.probeDatabase,
+		a.probeDatabaseReplicas,
+		a.probeFileStore,
+		a.probeSMTP,
+		a.probeSearchEngines,
+		a.probeCluster,
+	}
+
+	var wg sync.WaitGroup
+	for _, probe := range probes {
+		wg.Add(1)
+		go func(probe func(addCheck func(name string, check *model.HealthCheck))) {
+			defer wg.Done()
+			probe(addCheck)
+		}(probe)
+	}
+	wg.Wait()
+
+	return health
+}
+
+// newHealthCheck returns the check of a probe that took latency, degraded when it's over the
+// threshold in milliseconds, if any.
+func newHealthCheck(probe string, latency time.Duration, threshold int, err error) *model.HealthCheck {
+	check := &model.HealthCheck{
+		Probe:       probe,
+		Status:      model.StatusOk,
+		LatencyMs:   latency.Milliseconds(),
+		ThresholdMs: int64(threshold),
+	}
+	if err != nil {
+		check.Status = model.StatusUnhealthy
+	} else if threshold > 0 && check.LatencyMs > check.ThresholdMs {
+		check.Status = model.StatusDegraded
+	}
+	return check
+}
+
+func (a *App) probeDatabase(addCheck func(name string, check *model.HealthCheck)) {
+	start := time.Now()
+	err := a.DBHealthCheckWrite()
+	if err == nil {
+		err = a.DBHealthCheckDelete()
+	}
+	if err != nil {
+		mlog.Warn("Health check of the database failed.", mlog.Err(err))
+	}
+
+	threshold := *a.Config().ServiceSettings.HealthCheckDatabaseLatencyThresholdMilliseconds
+	addCheck(model.HealthProbeDatabase, newHealthCheck(model.HealthProbeDatabase, time.Since(start), threshold, err))
+}
+
+func (a *App) probeDatabaseReplicas(addCheck func(name string, check *model.HealthCheck)) {
+	threshold := *a.Config().ServiceSettings.HealthCheckDatabaseLatencyThresholdMilliseconds
+	for i, ping := range a.Srv().Store.PingReplicas() {
+		if ping.Err != nil {
+			mlog.Warn("Health check of a database replica failed.", mlog.Int("replica", i), mlog.Err(ping.Err))
+		}
+		name := fmt.Sprintf("%s_%d", model.HealthProbeDatabaseReplica, i)
+		addCheck(name, newHealthCheck(model.HealthProbeDatabaseReplica, ping.Latency, threshold, ping.Err))
+	}
+}
+
+// probeFileStore writes a file to the file storage, reads it back and removes it.
+func (a *App) probeFileStore(addCheck func(name string, check *model.HealthCheck)) {
+	backend := a.FileBackend()
+	path := healthCheckFileStoreDirectory + model.NewId()
+	data := []byte(path)
+
+	start := time.Now()
+	err := func() error {
+		if _, err := backend.WriteFile(bytes.NewReader(data), path); err != nil {
+			return err
+		}
+		defer backend.RemoveFile(path)
+
+		read, err := backend.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(read, data) {
+			return errors.New("the file read back differs from the file written")
+		}
+		return nil
+	}()
+	if err != nil {
+		mlog.Warn("Health check of the file storage failed.", mlog.Err(err))
+	}
+
+	threshold := *a.Config().ServiceSettings.HealthCheckFileStoreLatencyThresholdMilliseconds
+	addCheck(model.HealthProbeFileStore, newHealthCheck(model.HealthProbeFileStore, time.Since(start), threshold, err))
+}
+
+func (a *App) probeSMTP(addCheck func(name string, check *model.HealthCheck)) {
+	if !*a.Config().EmailSettings.SendEmailNotifications {
+		return
+	}
+
+	start := time.Now()
+	err := mail.TestConnection(a.Srv().MailServiceConfig())
+	if err != nil {
+		mlog.Warn("Health check of the SMTP server failed.", mlog.Err(err))
+	}
+
+	threshold := *a.Config().ServiceSettings.HealthCheckSMTPLatencyThresholdMilliseconds
+	addCheck(model.HealthProbeSMTP, newHealthCheck(model.HealthProbeSMTP, time.Since(start), threshold, err))
+}
+
+// probeSearchEngines checks that the search engines with indexing enabled are running.
+func (a *App) probeSearchEngines(addCheck func(name string, check *model.HealthCheck)) {
+	if a.SearchEngine() == nil {
+		return
+	}
+
+	for _, engine := range []searchengine.SearchEngineInterface{a.SearchEngine().ElasticsearchEngine, a.SearchEngine().BleveEngine} {
+		if engine == nil || !engine.IsIndexingEnabled() {
+			continue
+		}
+
+		var err error
+		if !engine.IsActive() {
+			err = errors.New("the search engine isn't running")
+			mlog.Warn("Health check of a search engine failed.", mlog.String("engine", engine.GetName()))
+		}
+		name := model.HealthProbeSearch + "_" + engine.GetName()
+		addCheck(name, newHealthCheck(model.HealthProbeSearch, 0, 0, err))
+	}
+}
+
+// probeCluster checks that the cluster has enough nodes and that they all run the same version
+// with the same configuration.
+func (a *App) probeCluster(addCheck func(name string, check *model.HealthCheck)) {
+	if a.Cluster() == nil {
+		return
+	}
+
+	infos := a.Cluster().GetClusterInfos()
+	check := newHealthCheck(model.HealthProbeCluster, 0, 0, nil)
+	check.Nodes = len(infos)
+
+	for _, info := range infos {
+		if info.ConfigHash != infos[0].ConfigHash || info.Version != infos[0].Version {
+			check.Status = model.StatusDegraded
+		}
+	}
+	if minNodes := *a.Config().ServiceSettings.HealthCheckMinClusterNodes; len(infos) < minNodes {
+		mlog.Warn("Health check of the cluster failed.", mlog.Int("nodes", len(infos)), mlog.Int("min_nodes", minNodes))
+		check.Status = model.StatusUnhealthy
+	}
+
+	addCheck(model.HealthProbeCluster, check)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestGetSystemHealth(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	health := th.App.GetSystemHealth()
+	require.Equal(t, model.StatusOk, health.Status)
+
+	t.Run("the result is shared by the following requests", func(t *testing.T) {
+		assert.Same(t, health, th.App.GetSystemHealth())
+	})
+
+	t.Run("the dependencies are probed again when the configuration changes", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.HealthCheckDatabaseLatencyThresholdMilliseconds++
+		})
+
+		assert.NotSame(t, health, th.App.GetSystemHealth())
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+)
+
+// systemPostMessageRenderers render the messages of the system posts that are stored with their
+// props only, in the language of the reader. They return false when the post lacks a prop the
+// message needs, as is the case of the posts made by integrations with these types.
+var systemPostMessageRenderers = map[string]func(T i18n.TranslateFunc, props model.StringInterface) (string, bool){
+	model.PostTypeJoinChannel:       userSystemPostMessage("api.channel.join_channel.post_and_forget", "username"),
+	model.PostTypeGuestJoinChannel:  userSystemPostMessage("api.channel.guest_join_channel.post_and_forget", "username"),
+	model.PostTypeJoinTeam:          userSystemPostMessage("api.team.join_team.post_and_forget", "username"),
+	model.PostTypeLeaveTeam:         userSystemPostMessage("api.team.leave.left", "username"),
+	model.PostTypeRemoveFromTeam:    userSystemPostMessage("api.team.remove_user_from_team.removed", "username"),
+	model.PostTypeAddToChannel:      userSystemPostMessage("api.channel.add_member.added", "addedUsername", "username"),
+	model.PostTypeAddGuestToChannel: userSystemPostMessage("api.channel.add_guest.added", "addedUsername", "username"),
+	model.PostTypeAddToTeam:         userSystemPostMessage("api.team.add_user_to_team.added", "addedUsername", "username"),
+	// The messages of the users leaving a channel embed `@username` so that they count as a
+	// mention of the user, even though the user has left the channel.
+	model.PostTypeLeaveChannel: func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+		username, ok := props["username"].(string)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf(T("api.channel.leave.left"), "@"+username), true
+	},
+	model.PostTypeRemoveFromChannel: func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+		username, ok := props["removedUsername"].(string)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf(T("api.channel.remove_member.removed"), "@"+username), true
+	},
+	model.PostTypeHeaderChange: changeSystemPostMessage("old_header", "new_header",
+		"api.channel.post_update_channel_header_message_and_forget.updated_to",
+		"api.channel.post_update_channel_header_message_and_forget.removed",
+		"api.channel.post_update_channel_header_message_and_forget.updated_from"),
+	model.PostTypePurposeChange: changeSystemPostMessage("old_purpose", "new_purpose",
+		"app.channel.post_update_channel_purpose_message.updated_to",
+		"app.channel.post_update_channel_purpose_message.removed",
+		"app.channel.post_update_channel_purpose_message.updated_from"),
+	model.PostTypeDisplaynameChange: changeSystemPostMessage("old_displayname", "new_displayname",
+		"", "", "api.channel.post_update_channel_displayname_message_and_forget.updated_from"),
+	model.PostTypeTeamRename: changeSystemPostMessage("old_name", "new_name",
+		"", "", "app.team.rename_team.post.updated_from"),
+}
+
+// userSystemPostMessage renders the translation with the usernames stored in the props.
+func userSystemPostMessage(translationID string, usernameProps ...string) func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+	return func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+		args := make([]interface{}, 0, len(usernameProps))
+		for _, prop := range usernameProps {
+			username, ok := props[prop].(string)
+			if !ok {
+				return "", false
+			}
+			args = append(args, username)
+		}
+		return fmt.Sprintf(T(translationID), args...), true
+	}
+}
+
+// changeSystemPostMessage renders the change of a property of a channel by a user, picking the
+// translation depending on whether the property was set, removed or updated. The properties that
+// can't be empty have no translation for being set or removed.
+func changeSystemPostMessage(oldProp, newProp, setID, removedID, updatedID string) func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+	return func(T i18n.TranslateFunc, props model.StringInterface) (string, bool) {
+		username, ok := props["username"].(string)
+		if !ok {
+			return "", false
+		}
+		oldValue, ok := props[oldProp].(string)
+		if !ok {
+			return "", false
+		}
+		newValue, ok := props[newProp].(string)
+		if !ok {
+			return "", false
+		}
+
+		switch {
+		case setID != "" && oldValue == "":
+			return fmt.Sprintf(T(setID), username, newValue), true
+		case removedID != "" && newValue == "":
+			return fmt.Sprintf(T(removedID), username, oldValue), true
+		default:
+			return fmt.Sprintf(T(updatedID), username, oldValue, newValue), true
+		}
+	}
+}
+
+// renderSystemPostMessage renders the message of the system post in the language of T, returning
+// false when the message can't be rendered from the props of the post.
+func renderSystemPostMessage(post *model.Post, T i18n.TranslateFunc) (string, bool) {
+	render, ok := systemPostMessageRenderers[post.Type]
+	if !ok {
+		return "", false
+	}
+	return render(T, post.GetProps())
+}
+
+// RenderSystemPostMessages renders the messages of the system posts in the locale, by post id.
+// The posts without a renderable message are left out.
+func (a *App) RenderSystemPostMessages(posts []*model.Post, locale string) map[string]string {
+	T := i18n.GetUserTranslations(locale)
+
+	messages := make(map[string]string, len(posts))
+	for _, post := range posts {
+		if post.DeleteAt > 0 {
+			continue
+		}
+		if message, ok := renderSystemPostMessage(post, T); ok {
+			messages[post.Id] = message
+		}
+	}
+
+	return messages
+}
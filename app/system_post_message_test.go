@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRenderSystemPostMessages(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	joined := &model.Post{Id: model.NewId(), Type: model.PostTypeJoinChannel, Props: model.StringInterface{"username": "alice"}}
+	left := &model.Post{Id: model.NewId(), Type: model.PostTypeLeaveChannel, Props: model.StringInterface{"username": "alice"}}
+	added := &model.Post{Id: model.NewId(), Type: model.PostTypeAddToChannel, Props: model.StringInterface{"username": "alice", "addedUsername": "bob"}}
+	headerSet := &model.Post{Id: model.NewId(), Type: model.PostTypeHeaderChange, Props: model.StringInterface{"username": "alice", "old_header": "", "new_header": "new"}}
+	headerRemoved := &model.Post{Id: model.NewId(), Type: model.PostTypeHeaderChange, Props: model.StringInterface{"username": "alice", "old_header": "old", "new_header": ""}}
+	renamed := &model.Post{Id: model.NewId(), Type: model.PostTypeDisplaynameChange, Props: model.StringInterface{"username": "alice", "old_displayname": "Old", "new_displayname": "New"}}
+	teamRenamed := &model.Post{Id: model.NewId(), Type: model.PostTypeTeamRename, Props: model.StringInterface{"username": "alice", "old_name": "old", "new_name": "new"}}
+	withoutProps := &model.Post{Id: model.NewId(), Type: model.PostTypeAddToChannel, Message: "test"}
+	regular := &model.Post{Id: model.NewId(), Message: "hello"}
+	deleted := &model.Post{Id: model.NewId(), Type: model.PostTypeJoinChannel, Props: model.StringInterface{"username": "alice"}, DeleteAt: 1}
+
+	posts := []*model.Post{joined, left, added, headerSet, headerRemoved, renamed, teamRenamed, withoutProps, regular, deleted}
+
+	messages := th.App.RenderSystemPostMessages(posts, "en")
+	assert.Equal(t, map[string]string{
+		joined.Id:        "alice joined the channel.",
+		left.Id:          "@alice left the channel.",
+		added.Id:         "bob added to the channel by alice.",
+		headerSet.Id:     "alice updated the channel header to: new",
+		headerRemoved.Id: "alice removed the channel header (was: old)",
+		renamed.Id:       "alice updated the channel display name from: Old to: New",
+		teamRenamed.Id:   "alice changed the team URL from /old to /new. Links using the previous URL redirect to the new one.",
+	}, messages)
+
+	messages = th.App.RenderSystemPostMessages(posts, "fr")
+	assert.Equal(t, "alice a rejoint le canal.", messages[joined.Id])
+}
+
+func TestSystemPostsStoredWithProps(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	appErr := th.App.PostUpdateChannelDisplayNameMessage(th.Context, th.BasicUser.Id, th.BasicChannel, "Old", "New")
+	require.Nil(t, appErr)
+
+	postList, err := th.App.Srv().Store.Post().GetPosts(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1}, false)
+	require.NoError(t, err)
+	require.Len(t, postList.Order, 1)
+
+	post := postList.Posts[postList.Order[0]]
+	require.Equal(t, model.PostTypeDisplaynameChange, post.Type)
+	assert.Empty(t, post.Message)
+
+	post = th.App.PreparePostForClient(post, false, false)
+	assert.Equal(t, th.BasicUser.Username+" updated the channel display name from: Old to: New", post.Message)
+}
@@ -23,13 +23,17 @@ import (
 	"github.com/mattermost/mattermost-server/v6/app/users"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/plugin"
-	"github.com/mattermost/mattermost-server/v6/shared/i18n"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/store"
 	"github.com/mattermost/mattermost-server/v6/store/sqlstore"
 )
 
 func (a *App) CreateTeam(c *request.Context, team *model.Team) (*model.Team, *model.AppError) {
+	// The aliases of the teams stay theirs, not to break the links made with them.
+	if _, err := a.Srv().Store.TeamAlias().Get(strings.ToLower(team.Name)); err == nil {
+		return nil, model.NewAppError("CreateTeam", "app.team.save.existing.app_error", nil, "name="+team.Name, http.StatusBadRequest)
+	}
+
 	rteam, err := a.ch.srv.teamService.CreateTeam(team)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -37,7 +41,10 @@ func (a *App) CreateTeam(c *request.Context, team *model.Team) (*model.Team, *mo
 		var cErr *store.ErrConflict
 		var ltErr *store.ErrLimitExceeded
 		var appErr *model.AppError
+		var invDomErr *teams.InvalidDomainError
 		switch {
+		case errors.As(err, &invDomErr):
+			return nil, model.NewAppError("CreateTeam", "app.team.allowed_domains.invalid.app_error", map[string]interface{}{"Domain": invDomErr.Domain}, invDomErr.Reason, http.StatusBadRequest)
 		case errors.As(err, &invErr):
 			switch {
 			case invErr.Entity == "Channel" && invErr.Field == "DeleteAt":
@@ -46,6 +53,8 @@ func (a *App) CreateTeam(c *request.Context, team *model.Team) (*model.Team, *mo
 				return nil, model.NewAppError("CreateTeam", "store.sql_channel.save.direct_channel.app_error", nil, "", http.StatusBadRequest)
 			case invErr.Entity == "Channel" && invErr.Field == "Id":
 				return nil, model.NewAppError("CreateTeam", "store.sql_channel.save_channel.existing.app_error", nil, "id="+invErr.Value.(string), http.StatusBadRequest)
+			case invErr.Entity == "Team" && invErr.Field == "ParentTeamId":
+				return nil, model.NewAppError("CreateTeam", "app.team.parent_team_id.invalid.app_error", nil, invErr.Error(), http.StatusBadRequest)
 			default:
 				return nil, model.NewAppError("CreateTeam", "app.team.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
 			}
@@ -69,6 +78,7 @@ func (a *App) CreateTeamWithUser(c *request.Context, team *model.Team, userID st
 		return nil, err
 	}
 	team.Email = user.Email
+	team.EmailVerified = user.EmailVerified
 
 	if !a.ch.srv.teamService.IsTeamEmailAllowed(user, team) {
 		return nil, model.NewAppError("CreateTeamWithUser", "api.team.is_team_creation_allowed.domain.app_error", nil, "", http.StatusBadRequest)
@@ -79,7 +89,7 @@ func (a *App) CreateTeamWithUser(c *request.Context, team *model.Team, userID st
 		return nil, err
 	}
 
-	if _, err := a.JoinUserToTeam(c, rteam, user, ""); err != nil {
+	if _, err := a.joinUserToTeam(c, rteam, user, "", teams.JoinOptions{Creator: true}); err != nil {
 		return nil, err
 	}
 
@@ -98,6 +108,7 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 		var invErr *store.ErrInvalidInput
 		var appErr *model.AppError
 		var domErr *teams.DomainError
+		var invDomErr *teams.InvalidDomainError
 		var nfErr *store.ErrNotFound
 		switch {
 		case errors.As(err, &nfErr):
@@ -108,6 +119,8 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 			return nil, appErr
 		case errors.As(err, &domErr):
 			return nil, model.NewAppError("UpdateTeam", "api.team.update_restricted_domains.mismatch.app_error", map[string]interface{}{"Domain": domErr.Domain}, "", http.StatusBadRequest)
+		case errors.As(err, &invDomErr):
+			return nil, model.NewAppError("UpdateTeam", "app.team.allowed_domains.invalid.app_error", map[string]interface{}{"Domain": invDomErr.Domain}, invDomErr.Reason, http.StatusBadRequest)
 		default:
 			return nil, model.NewAppError("UpdateTeam", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
@@ -121,15 +134,16 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 // RenameTeam is used to rename the team Name and the DisplayName fields
 func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError) {
 
-	// check if name is occupied
-	_, errnf := a.GetTeamByName(newTeamName)
+	// check if name is occupied, a team being allowed to take back one of its aliases
+	occupant, errnf := a.GetTeamByName(newTeamName)
 
 	// "-" can be used as a newTeamName if only DisplayName change is wanted
-	if errnf == nil && newTeamName != "-" {
+	if errnf == nil && newTeamName != "-" && (occupant.Id != team.Id || occupant.Name == newTeamName) {
 		errbody := fmt.Sprintf("team with name %s already exists", newTeamName)
 		return nil, model.NewAppError("RenameTeam", "app.team.rename_team.name_occupied", nil, errbody, http.StatusBadRequest)
 	}
 
+	oldTeamName := team.Name
 	if newTeamName != "-" {
 		team.Name = newTeamName
 	}
@@ -143,6 +157,7 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 		var invErr *store.ErrInvalidInput
 		var appErr *model.AppError
 		var domErr *teams.DomainError
+		var invDomErr *teams.InvalidDomainError
 		var nfErr *store.ErrNotFound
 		switch {
 		case errors.As(err, &nfErr):
@@ -153,11 +168,19 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 			return nil, appErr
 		case errors.As(err, &domErr):
 			return nil, model.NewAppError("RenameTeam", "api.team.update_restricted_domains.mismatch.app_error", map[string]interface{}{"Domain": domErr.Domain}, "", http.StatusBadRequest)
+		case errors.As(err, &invDomErr):
+			return nil, model.NewAppError("RenameTeam", "app.team.allowed_domains.invalid.app_error", map[string]interface{}{"Domain": invDomErr.Domain}, invDomErr.Reason, http.StatusBadRequest)
 		default:
 			return nil, model.NewAppError("RenameTeam", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
 	}
 
+	if newTeam.Name != oldTeamName {
+		a.recordTeamRename(newTeam.Id, oldTeamName, newTeam.Name)
+	}
+
+	a.sendTeamEvent(newTeam, model.WebsocketEventUpdateTeam)
+
 	return newTeam, nil
 }
 
@@ -229,6 +252,7 @@ func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *mo
 		var invErr *store.ErrInvalidInput
 		var appErr *model.AppError
 		var domErr *teams.DomainError
+		var invDomErr *teams.InvalidDomainError
 		var nfErr *store.ErrNotFound
 		switch {
 		case errors.As(err, &nfErr):
@@ -239,6 +263,8 @@ func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *mo
 			return nil, appErr
 		case errors.As(err, &domErr):
 			return nil, model.NewAppError("PatchTeam", "api.team.update_restricted_domains.mismatch.app_error", map[string]interface{}{"Domain": domErr.Domain}, "", http.StatusBadRequest)
+		case errors.As(err, &invDomErr):
+			return nil, model.NewAppError("PatchTeam", "app.team.allowed_domains.invalid.app_error", map[string]interface{}{"Domain": invDomErr.Domain}, invDomErr.Reason, http.StatusBadRequest)
 		default:
 			return nil, model.NewAppError("PatchTeam", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
 		}
@@ -646,7 +672,11 @@ func (a *App) AddUserToTeamByInviteId(c *request.Context, inviteId string, userI
 }
 
 func (a *App) JoinUserToTeam(c *request.Context, team *model.Team, user *model.User, userRequestorId string) (*model.TeamMember, *model.AppError) {
-	teamMember, alreadyAdded, err := a.ch.srv.teamService.JoinUserToTeam(team, user)
+	return a.joinUserToTeam(c, team, user, userRequestorId, teams.JoinOptions{})
+}
+
+func (a *App) joinUserToTeam(c *request.Context, team *model.Team, user *model.User, userRequestorId string, opts teams.JoinOptions) (*model.TeamMember, *model.AppError) {
+	teamMember, alreadyAdded, err := a.ch.srv.teamService.JoinUserToTeamWithOptions(team, user, opts)
 	if err != nil {
 		var appErr *model.AppError
 		var conflictErr *store.ErrConflict
@@ -672,6 +702,8 @@ func (a *App) JoinUserToTeam(c *request.Context, team *model.Team, user *model.U
 		return teamMember, nil
 	}
 
+	a.enqueueTeamMemberRESTProxyBridgeEvent(model.RESTProxyBridgeEventTeamMemberAdded, teamMember, userRequestorId)
+
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		var actor *model.User
 		if userRequestorId != "" {
@@ -700,7 +732,7 @@ func (a *App) JoinUserToTeam(c *request.Context, team *model.Team, user *model.U
 		)
 	}
 
-	shouldBeAdmin := team.Email == user.Email
+	shouldBeAdmin := opts.Creator
 
 	if !user.IsGuest() {
 		// Soft error if there is an issue joining the default channels
@@ -747,6 +779,10 @@ func (a *App) GetTeamByName(name string) (*model.Team, *model.AppError) {
 		var nfErr *store.ErrNotFound
 		switch {
 		case errors.As(err, &nfErr):
+			// The name may be one the team had before being renamed.
+			if team, aliasErr := a.GetTeamByAlias(name); aliasErr == nil {
+				return team, nil
+			}
 			return nil, model.NewAppError("GetTeamByName", "app.team.get_by_name.missing.app_error", nil, nfErr.Error(), http.StatusNotFound)
 		default:
 			return nil, model.NewAppError("GetTeamByName", "app.team.get_by_name.app_error", nil, err.Error(), http.StatusNotFound)
@@ -1062,6 +1098,8 @@ func (a *App) RemoveUserFromTeam(c *request.Context, teamID string, userID strin
 }
 
 func (a *App) postProcessTeamMemberLeave(c *request.Context, teamMember *model.TeamMember, requestorId string) *model.AppError {
+	a.enqueueTeamMemberRESTProxyBridgeEvent(model.RESTProxyBridgeEventTeamMemberRemoved, teamMember, requestorId)
+
 	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil {
 		var actor *model.User
 		if requestorId != "" {
@@ -1174,7 +1212,6 @@ func (a *App) LeaveTeam(c *request.Context, team *model.Team, user *model.User,
 func (a *App) postLeaveTeamMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(i18n.T("api.team.leave.left"), user.Username),
 		Type:      model.PostTypeLeaveTeam,
 		UserId:    user.Id,
 		Props: model.StringInterface{
@@ -1192,7 +1229,6 @@ func (a *App) postLeaveTeamMessage(c *request.Context, user *model.User, channel
 func (a *App) postRemoveFromTeamMessage(c *request.Context, user *model.User, channel *model.Channel) *model.AppError {
 	post := &model.Post{
 		ChannelId: channel.Id,
-		Message:   fmt.Sprintf(i18n.T("api.team.remove_user_from_team.removed"), user.Username),
 		Type:      model.PostTypeRemoveFromTeam,
 		UserId:    user.Id,
 		Props: model.StringInterface{
@@ -1289,7 +1325,10 @@ func (a *App) GetErrorListForEmailsOverLimit(emailList []string, cloudUserLimit
 	return emailList, invitesNotSent, nil
 }
 
-func (a *App) InviteNewUsersToTeamGracefully(emailList []string, teamID, senderId string, reminderInterval string) ([]*model.EmailInviteWithError, *model.AppError) {
+// InviteNewUsersToTeamGracefully sends the invitations to join the team, reporting the addresses
+// that couldn't be invited rather than failing. The invitations are written in the locale when
+// set, otherwise in the locale picked for their address, falling back to the sender's.
+func (a *App) InviteNewUsersToTeamGracefully(emailList []string, teamID, senderId string, reminderInterval, locale string) ([]*model.EmailInviteWithError, *model.AppError) {
 	if !*a.Config().ServiceSettings.EnableEmailInvitations {
 		return nil, model.NewAppError("InviteNewUsersToTeam", "api.team.invite_members.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
@@ -1303,6 +1342,10 @@ func (a *App) InviteNewUsersToTeamGracefully(emailList []string, teamID, senderI
 		return nil, err
 	}
 	allowedDomains := a.ch.srv.teamService.GetAllowedDomains(user, team)
+	suppressions, err := a.getEmailSuppressionsByEmail(emailList)
+	if err != nil {
+		return nil, err
+	}
 	var inviteListWithErrors []*model.EmailInviteWithError
 	var goodEmails []string
 	for _, email := range emailList {
@@ -1312,6 +1355,8 @@ func (a *App) InviteNewUsersToTeamGracefully(emailList []string, teamID, senderI
 		}
 		if !teams.IsEmailAddressAllowed(email, allowedDomains) {
 			invite.Error = model.NewAppError("InviteNewUsersToTeam", "api.team.invite_members.invalid_email.app_error", map[string]interface{}{"Addresses": email}, "", http.StatusBadRequest)
+		} else if suppression, ok := suppressions[model.NormalizeEmail(email)]; ok {
+			invite.Error = emailSuppressedError("InviteNewUsersToTeam", suppression)
 		} else {
 			goodEmails = append(goodEmails, email)
 		}
@@ -1324,9 +1369,16 @@ func (a *App) InviteNewUsersToTeamGracefully(emailList []string, teamID, senderI
 	}
 
 	if len(goodEmails) > 0 {
+		if appErr := a.checkTeamInviteBudget(team.Id, len(goodEmails)); appErr != nil {
+			return nil, appErr
+		}
+
 		nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
-		eErr := a.Srv().EmailService.SendInviteEmails(team, user.GetDisplayName(nameFormat), user.Id, goodEmails, a.GetSiteURL(), reminderData, true)
-		if eErr != nil {
+		locales := &email.InviteLocales{Locale: locale, SenderLocale: user.Locale}
+		eErr := a.Srv().EmailService.SendInviteEmails(team, user.GetDisplayName(nameFormat), user.Id, goodEmails, a.GetSiteURL(), locales, reminderData, true)
+		if eErr == nil {
+			a.recordTeamInvites(team.Id, len(goodEmails))
+		} else {
 			switch {
 			case errors.Is(eErr, email.SendMailError):
 				for i := range inviteListWithErrors {
@@ -1423,6 +1475,11 @@ func (a *App) InviteGuestsToChannelsGracefully(teamID string, guestsInvite *mode
 		return nil, err
 	}
 
+	suppressions, err := a.getEmailSuppressionsByEmail(guestsInvite.Emails)
+	if err != nil {
+		return nil, err
+	}
+
 	var inviteListWithErrors []*model.EmailInviteWithError
 	var goodEmails []string
 	for _, email := range guestsInvite.Emails {
@@ -1432,6 +1489,8 @@ func (a *App) InviteGuestsToChannelsGracefully(teamID string, guestsInvite *mode
 		}
 		if !users.CheckEmailDomain(email, *a.Config().GuestAccountsSettings.RestrictCreationToDomains) {
 			invite.Error = model.NewAppError("InviteGuestsToChannelsGracefully", "api.team.invite_members.invalid_email.app_error", map[string]interface{}{"Addresses": email}, "", http.StatusBadRequest)
+		} else if suppression, ok := suppressions[model.NormalizeEmail(email)]; ok {
+			invite.Error = emailSuppressedError("InviteGuestsToChannelsGracefully", suppression)
 		} else {
 			goodEmails = append(goodEmails, email)
 		}
@@ -1439,13 +1498,19 @@ func (a *App) InviteGuestsToChannelsGracefully(teamID string, guestsInvite *mode
 	}
 
 	if len(goodEmails) > 0 {
+		if appErr := a.checkTeamInviteBudget(team.Id, len(goodEmails)); appErr != nil {
+			return nil, appErr
+		}
+
 		nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
 		senderProfileImage, _, err := a.GetProfileImage(user)
 		if err != nil {
 			a.Log().Warn("Unable to get the sender user profile image.", mlog.String("user_id", user.Id), mlog.String("team_id", team.Id), mlog.Err(err))
 		}
 		eErr := a.Srv().EmailService.SendGuestInviteEmails(team, channels, user.GetDisplayName(nameFormat), user.Id, senderProfileImage, goodEmails, a.GetSiteURL(), guestsInvite.Message, true)
-		if eErr != nil {
+		if eErr == nil {
+			a.recordTeamInvites(team.Id, len(goodEmails))
+		} else {
 			switch {
 			case errors.Is(eErr, email.SendMailError):
 				for i := range inviteListWithErrors {
@@ -1471,7 +1536,9 @@ func (a *App) InviteGuestsToChannelsGracefully(teamID string, guestsInvite *mode
 	return inviteListWithErrors, nil
 }
 
-func (a *App) InviteNewUsersToTeam(emailList []string, teamID, senderId string) *model.AppError {
+// InviteNewUsersToTeam sends the invitations to join the team, written in the locale when set,
+// otherwise in the locale picked for their address, falling back to the sender's.
+func (a *App) InviteNewUsersToTeam(emailList []string, teamID, senderId, locale string) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableEmailInvitations {
 		return model.NewAppError("InviteNewUsersToTeam", "api.team.invite_members.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
@@ -1500,8 +1567,17 @@ func (a *App) InviteNewUsersToTeam(emailList []string, teamID, senderId string)
 		return model.NewAppError("InviteNewUsersToTeam", "api.team.invite_members.invalid_email.app_error", map[string]interface{}{"Addresses": s}, "", http.StatusBadRequest)
 	}
 
+	if err := a.checkEmailsNotSuppressed("InviteNewUsersToTeam", emailList); err != nil {
+		return err
+	}
+
+	if err := a.checkTeamInviteBudget(team.Id, len(emailList)); err != nil {
+		return err
+	}
+
 	nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
-	eErr := a.Srv().EmailService.SendInviteEmails(team, user.GetDisplayName(nameFormat), user.Id, emailList, a.GetSiteURL(), nil, false)
+	locales := &email.InviteLocales{Locale: locale, SenderLocale: user.Locale}
+	eErr := a.Srv().EmailService.SendInviteEmails(team, user.GetDisplayName(nameFormat), user.Id, emailList, a.GetSiteURL(), locales, nil, false)
 	if eErr != nil {
 		switch {
 		case errors.Is(eErr, email.NoRateLimiterError):
@@ -1512,10 +1588,129 @@ func (a *App) InviteNewUsersToTeam(emailList []string, teamID, senderId string)
 			return model.NewAppError("SendInviteEmails", "app.email.rate_limit_exceeded.app_error", nil, fmt.Sprintf("user_id=%s, team_id=%s, error=%v", user.Id, team.Id, eErr), http.StatusRequestEntityTooLarge)
 		}
 	}
+	a.recordTeamInvites(team.Id, len(emailList))
 
 	return nil
 }
 
+// PreviewInviteEmail renders the email inviting users to the team, as sent by the given user.
+// When sendTest is set, the email is also sent to the address of the sender, for them to check
+// the email settings. An empty senderID previews the email sent in local mode.
+func (a *App) PreviewInviteEmail(teamID, senderID string, sendTest bool) (*model.TeamInviteEmailPreview, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableEmailInvitations {
+		return nil, model.NewAppError("PreviewInviteEmail", "api.team.invite_members.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	var sender *model.User
+	var team *model.Team
+	var appErr *model.AppError
+	if senderID != "" {
+		sender, team, appErr = a.prepareInviteNewUsersToTeam(teamID, senderID)
+	} else {
+		team, appErr = a.GetTeam(teamID)
+	}
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	senderName := "Administrator"
+	if sender != nil {
+		senderName = sender.GetDisplayName(*a.Config().TeamSettings.TeammateNameDisplay)
+	}
+
+	preview, err := a.Srv().EmailService.RenderInviteEmailPreview(team, senderName, a.GetSiteURL())
+	if err != nil {
+		return nil, model.NewAppError("PreviewInviteEmail", "app.team.invite_email_preview.render.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if !sendTest {
+		return preview, nil
+	}
+
+	if sender == nil {
+		return nil, model.NewAppError("PreviewInviteEmail", "app.team.invite_email_preview.no_sender.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if err := a.Srv().EmailService.SendInviteEmailPreview(preview, sender.Id, sender.Email); err != nil {
+		switch {
+		case errors.Is(err, email.NoRateLimiterError):
+			return nil, model.NewAppError("PreviewInviteEmail", "app.email.no_rate_limiter.app_error", nil, fmt.Sprintf("user_id=%s, team_id=%s", sender.Id, team.Id), http.StatusInternalServerError)
+		case errors.Is(err, email.SetupRateLimiterError):
+			return nil, model.NewAppError("PreviewInviteEmail", "app.email.setup_rate_limiter.app_error", nil, fmt.Sprintf("user_id=%s, team_id=%s, error=%v", sender.Id, team.Id, err), http.StatusInternalServerError)
+		case errors.Is(err, email.RateLimitExceededError):
+			return nil, model.NewAppError("PreviewInviteEmail", "app.email.rate_limit_exceeded.app_error", nil, fmt.Sprintf("user_id=%s, team_id=%s", sender.Id, team.Id), http.StatusRequestEntityTooLarge)
+		default:
+			return nil, model.NewAppError("PreviewInviteEmail", "app.team.invite_email_preview.send.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	preview.SentTo = sender.Email
+
+	return preview, nil
+}
+
+// RunInviteUsersToTeamWillBeSentHook lets plugins reject or rewrite email invitations to a team
+// before they are sent. The returned invite holds the email addresses to invite.
+func (a *App) RunInviteUsersToTeamWillBeSentHook(c *request.Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, *model.AppError) {
+	pluginsEnvironment := a.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return invite, nil
+	}
+
+	teamID, senderID := invite.TeamId, invite.SenderId
+
+	var rejectionError *model.AppError
+	pluginContext := pluginContext(c)
+	pluginsEnvironment.RunMultiPluginHook(func(hooks plugin.Hooks) bool {
+		replacementInvite, rejectionReason := hooks.InviteUsersToTeamWillBeSent(pluginContext, invite)
+		if rejectionReason != "" {
+			rejectionError = model.NewAppError("RunInviteUsersToTeamWillBeSentHook", "app.team.invite_members.rejected_by_plugin.app_error", map[string]interface{}{"Reason": rejectionReason}, "", http.StatusForbidden)
+			return false
+		}
+		if replacementInvite != nil {
+			invite = replacementInvite
+		}
+
+		return true
+	}, plugin.InviteUsersToTeamWillBeSentID)
+
+	if rejectionError != nil {
+		return nil, rejectionError
+	}
+
+	// Plugins may only change who is invited, not to which team or by whom.
+	invite.TeamId, invite.SenderId = teamID, senderID
+
+	if len(invite.Emails) == 0 {
+		return nil, model.NewAppError("RunInviteUsersToTeamWillBeSentHook", "api.team.invite_members.no_one.app_error", nil, "", http.StatusBadRequest)
+	}
+	for i := range invite.Emails {
+		invite.Emails[i] = strings.ToLower(invite.Emails[i])
+		if !model.IsValidEmail(invite.Emails[i]) {
+			return nil, model.NewAppError("RunInviteUsersToTeamWillBeSentHook", "app.team.invite_members.invalid_email.app_error", map[string]interface{}{"Address": invite.Emails[i]}, "", http.StatusBadRequest)
+		}
+	}
+
+	return invite, nil
+}
+
+// RunUserInvitedToTeamHook notifies plugins of the email addresses the invite was sent to.
+func (a *App) RunUserInvitedToTeamHook(c *request.Context, invite *model.TeamEmailInvite, emails []string) {
+	pluginsEnvironment := a.GetPluginsEnvironment()
+	if pluginsEnvironment == nil || len(emails) == 0 {
+		return
+	}
+
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		for _, email := range emails {
+			pluginsEnvironment.RunMultiPluginHook(func(hooks plugin.Hooks) bool {
+				hooks.UserInvitedToTeam(pluginContext, invite, email)
+				return true
+			}, plugin.UserInvitedToTeamID)
+		}
+	})
+}
+
 func (a *App) InviteGuestsToChannels(teamID string, guestsInvite *model.GuestsInvite, senderId string) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableEmailInvitations {
 		return model.NewAppError("InviteNewUsersToTeam", "api.team.invite_members.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -1538,6 +1733,14 @@ func (a *App) InviteGuestsToChannels(teamID string, guestsInvite *model.GuestsIn
 		return model.NewAppError("InviteGuestsToChannels", "api.team.invite_members.invalid_email.app_error", map[string]interface{}{"Addresses": s}, "", http.StatusBadRequest)
 	}
 
+	if err := a.checkEmailsNotSuppressed("InviteGuestsToChannels", guestsInvite.Emails); err != nil {
+		return err
+	}
+
+	if err := a.checkTeamInviteBudget(team.Id, len(guestsInvite.Emails)); err != nil {
+		return err
+	}
+
 	nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
 	senderProfileImage, _, err := a.GetProfileImage(user)
 	if err != nil {
@@ -1554,6 +1757,7 @@ func (a *App) InviteGuestsToChannels(teamID string, guestsInvite *model.GuestsIn
 			return model.NewAppError("SendInviteEmails", "app.email.rate_limit_exceeded.app_error", nil, fmt.Sprintf("user_id=%s, team_id=%s, error=%v", user.Id, team.Id, err), http.StatusRequestEntityTooLarge)
 		}
 	}
+	a.recordTeamInvites(team.Id, len(guestsInvite.Emails))
 
 	return nil
 }
@@ -1665,6 +1869,10 @@ func (a *App) PermanentDeleteTeam(team *model.Team) *model.AppError {
 		return model.NewAppError("PermanentDeleteTeam", "app.team.remove_member.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
+	if err := a.Srv().Store.TeamAlias().DeleteForTeam(team.Id); err != nil {
+		return model.NewAppError("PermanentDeleteTeam", "app.team_alias.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	if err := a.Srv().Store.Command().PermanentDeleteByTeam(team.Id); err != nil {
 		return model.NewAppError("PermanentDeleteTeam", "app.team.permanentdeleteteam.internal_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -1674,6 +1882,7 @@ func (a *App) PermanentDeleteTeam(team *model.Team) *model.AppError {
 	}
 
 	a.sendTeamEvent(team, model.WebsocketEventDeleteTeam)
+	a.publishTeamDeletedEvent(team, true)
 
 	return nil
 }
@@ -1700,6 +1909,7 @@ func (a *App) SoftDeleteTeam(teamID string) *model.AppError {
 	}
 
 	a.sendTeamEvent(team, model.WebsocketEventDeleteTeam)
+	a.publishTeamDeletedEvent(team, false)
 
 	return nil
 }
@@ -1826,7 +2036,7 @@ func (a *App) GetTeamIcon(team *model.Team) ([]byte, *model.AppError) {
 		return nil, model.NewAppError("GetTeamIcon", "api.team.get_team_icon.filesettings_no_driver.app_error", nil, "", http.StatusNotImplemented)
 	}
 
-	path := "teams/" + team.Id + "/teamIcon.png"
+	path := getTeamIconPath(team.Id)
 	data, err := a.ReadFile(path)
 	if err != nil {
 		return nil, model.NewAppError("GetTeamIcon", "api.team.get_team_icon.read_file.app_error", nil, err.Error(), http.StatusNotFound)
@@ -1835,6 +2045,19 @@ func (a *App) GetTeamIcon(team *model.Team) ([]byte, *model.AppError) {
 	return data, nil
 }
 
+// GetTeamIconURL returns a signed URL for the team icon, or an empty string if signed URLs are
+// not available or the team has no icon.
+func (a *App) GetTeamIconURL(team *model.Team) (string, *model.AppError) {
+	if team.LastTeamIconUpdate == 0 {
+		return "", nil
+	}
+	return a.GetSignedFileURL(getTeamIconPath(team.Id))
+}
+
+func getTeamIconPath(teamID string) string {
+	return "teams/" + teamID + "/teamIcon.png"
+}
+
 func (a *App) SetTeamIcon(teamID string, imageData *multipart.FileHeader) *model.AppError {
 	file, err := imageData.Open()
 	if err != nil {
@@ -1883,7 +2106,7 @@ func (a *App) SetTeamIconFromFile(team *model.Team, file io.Reader) *model.AppEr
 		return model.NewAppError("SetTeamIcon", "api.team.set_team_icon.encode.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
-	path := "teams/" + team.Id + "/teamIcon.png"
+	path := getTeamIconPath(team.Id)
 
 	if _, err := a.WriteFile(buf, path); err != nil {
 		return model.NewAppError("SetTeamIcon", "api.team.set_team_icon.write_file.app_error", nil, err.Error(), http.StatusInternalServerError)
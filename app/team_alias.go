@@ -0,0 +1,179 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func (a *App) GetTeamAliases(teamID string) ([]*model.TeamAlias, *model.AppError) {
+	aliases, err := a.Srv().Store.TeamAlias().GetForTeam(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamAliases", "app.team_alias.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return aliases, nil
+}
+
+// AddTeamAlias makes the team reachable by another name, which must not be taken by a team
+// or by an alias.
+func (a *App) AddTeamAlias(teamID, name string) (*model.TeamAlias, *model.AppError) {
+	name = strings.ToLower(name)
+
+	if _, err := a.GetTeamByName(name); err == nil {
+		return nil, model.NewAppError("AddTeamAlias", "app.team_alias.save.name_occupied.app_error", nil, "name="+name, http.StatusBadRequest)
+	}
+
+	aliases, appErr := a.GetTeamAliases(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(aliases) >= model.TeamAliasMaxPerTeam {
+		return nil, model.NewAppError("AddTeamAlias", "app.team_alias.save.too_many.app_error", map[string]interface{}{"Max": model.TeamAliasMaxPerTeam}, "team_id="+teamID, http.StatusBadRequest)
+	}
+
+	alias, err := a.Srv().Store.TeamAlias().Save(&model.TeamAlias{Name: name, TeamId: teamID})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("AddTeamAlias", "app.team_alias.save.name_occupied.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("AddTeamAlias", "app.team_alias.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return alias, nil
+}
+
+func (a *App) DeleteTeamAlias(teamID, name string) *model.AppError {
+	alias, err := a.Srv().Store.TeamAlias().Get(strings.ToLower(name))
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteTeamAlias", "app.team_alias.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteTeamAlias", "app.team_alias.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	if alias.TeamId != teamID {
+		return model.NewAppError("DeleteTeamAlias", "app.team_alias.get.not_found.app_error", nil, "name="+alias.Name, http.StatusNotFound)
+	}
+
+	if err := a.Srv().Store.TeamAlias().Delete(alias.Name); err != nil {
+		return model.NewAppError("DeleteTeamAlias", "app.team_alias.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+// GetTeamByAlias returns the team reachable by the alias.
+func (a *App) GetTeamByAlias(name string) (*model.Team, *model.AppError) {
+	alias, err := a.Srv().Store.TeamAlias().Get(strings.ToLower(name))
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamByAlias", "app.team_alias.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamByAlias", "app.team_alias.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return a.GetTeam(alias.TeamId)
+}
+
+// recordTeamRename keeps the previous name of a renamed team as an alias, so that the links
+// made with it keep working for the rename redirect window. The new name stops being an alias
+// of the team.
+func (a *App) recordTeamRename(teamID, oldName, newName string) {
+	if err := a.Srv().Store.TeamAlias().Delete(newName); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			mlog.Warn("Failed to delete the alias of a renamed team", mlog.String("team_id", teamID), mlog.Err(err))
+		}
+	}
+
+	alias := &model.TeamAlias{Name: oldName, TeamId: teamID, CreateAt: model.GetMillis()}
+	if days := *a.Config().TeamSettings.RenameRedirectWindowDays; days > 0 {
+		alias.ExpireAt = alias.CreateAt + int64(days)*24*int64(time.Hour/time.Millisecond)
+	}
+
+	if _, err := a.Srv().Store.TeamAlias().Save(alias); err != nil {
+		mlog.Warn("Failed to keep the previous name of a renamed team as an alias", mlog.String("team_id", teamID), mlog.Err(err))
+	}
+}
+
+// UpdateTeamName renames the team on behalf of the user. The previous name keeps redirecting to
+// the team, and can't be taken by another team, for the rename redirect window, and the members
+// are told about the new name in the default channel of the team.
+func (a *App) UpdateTeamName(c *request.Context, teamID, newName, userID string) (*model.Team, *model.AppError) {
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	newName = strings.ToLower(newName)
+	if !model.IsValidTeamName(newName) || len(newName) > model.TeamNameMaxLength || model.IsReservedTeamName(newName) {
+		return nil, model.NewAppError("UpdateTeamName", "app.team.rename_team.invalid_name.app_error", nil, "name="+newName, http.StatusBadRequest)
+	}
+
+	oldName := team.Name
+	if newName == oldName {
+		return team, nil
+	}
+
+	team, appErr = a.RenameTeam(team, newName, "")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.postTeamRenameMessage(c, userID, team, oldName); err != nil {
+		mlog.Warn("Failed to post the team rename message", mlog.String("team_id", team.Id), mlog.Err(err))
+	}
+
+	return team, nil
+}
+
+func (a *App) postTeamRenameMessage(c *request.Context, userID string, team *model.Team, oldName string) *model.AppError {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	channel, appErr := a.GetChannelByName(model.DefaultChannelName, team.Id, false)
+	if appErr != nil {
+		return appErr
+	}
+
+	post := &model.Post{
+		ChannelId: channel.Id,
+		Type:      model.PostTypeTeamRename,
+		UserId:    userID,
+		Props: model.StringInterface{
+			"username": user.Username,
+			"old_name": oldName,
+			"new_name": team.Name,
+		},
+	}
+
+	if _, appErr := a.CreatePost(c, post, channel, false, true); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
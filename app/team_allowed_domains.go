@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// ValidateTeamAllowedDomains parses a list of allowed domains the way it would be when saved with
+// a team, the domains not permitted by TeamSettings.RestrictCreationToDomains being invalid.
+func (a *App) ValidateTeamAllowedDomains(allowedDomains string) *model.TeamAllowedDomainsValidation {
+	validation := model.ValidateAllowedDomains(allowedDomains)
+
+	restrictions := model.AllowedDomainsList(*a.Config().TeamSettings.RestrictCreationToDomains)
+	if len(restrictions) == 0 {
+		return validation
+	}
+
+	for _, result := range validation.Results {
+		if !result.Valid {
+			continue
+		}
+		covered := false
+		for _, restriction := range restrictions {
+			if model.AllowedDomainCovers(restriction, result.Domain) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			result.Valid = false
+			result.Error = "the domain is not allowed by the system config"
+			validation.Valid = false
+		}
+	}
+
+	return validation
+}
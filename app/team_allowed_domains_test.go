@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestValidateTeamAllowedDomains(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	validation := th.App.ValidateTeamAllowedDomains("@Example.com, *.eng.example.com")
+	assert.True(t, validation.Valid)
+	assert.Equal(t, "example.com, *.eng.example.com", validation.AllowedDomains)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.RestrictCreationToDomains = "*.example.com" })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.RestrictCreationToDomains = "" })
+
+	validation = th.App.ValidateTeamAllowedDomains("@Example.com, *.eng.example.com")
+	assert.False(t, validation.Valid)
+	require.Len(t, validation.Results, 2)
+	assert.False(t, validation.Results[0].Valid, "example.com isn't a subdomain of example.com")
+	assert.True(t, validation.Results[1].Valid)
+}
+
+func TestTeamAllowedDomains(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("saved in their canonical form", func(t *testing.T) {
+		patched, appErr := th.App.PatchTeam(th.BasicTeam.Id, &model.TeamPatch{AllowedDomains: model.NewString("@Example.com,bücher.de")})
+		require.Nil(t, appErr)
+		assert.Equal(t, "example.com, xn--bcher-kva.de", patched.AllowedDomains)
+	})
+
+	t.Run("invalid domain", func(t *testing.T) {
+		_, appErr := th.App.PatchTeam(th.BasicTeam.Id, &model.TeamPatch{AllowedDomains: model.NewString("example.com, under_score.com")})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.allowed_domains.invalid.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+
+		_, appErr = th.App.CreateTeam(th.Context, &model.Team{
+			DisplayName:    "dn_" + model.NewId(),
+			Name:           "name" + model.NewId(),
+			Type:           model.TeamOpen,
+			AllowedDomains: "eng.*.example.com",
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.allowed_domains.invalid.app_error", appErr.Id)
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		_, appErr := th.App.PatchTeam(th.BasicTeam.Id, &model.TeamPatch{AllowedDomains: model.NewString("*.example.com")})
+		require.Nil(t, appErr)
+
+		allowed, appErr := th.App.CreateUser(th.Context, &model.User{Email: strings.ToLower(model.NewId()) + "@eng.example.com", Username: "un_" + model.NewId(), Password: "passwd1"})
+		require.Nil(t, appErr)
+		defer th.App.PermanentDeleteUser(th.Context, allowed)
+		_, _, appErr = th.App.AddUserToTeam(th.Context, th.BasicTeam.Id, allowed.Id, "")
+		require.Nil(t, appErr)
+
+		restricted, appErr := th.App.CreateUser(th.Context, &model.User{Email: strings.ToLower(model.NewId()) + "@example.com", Username: "un_" + model.NewId(), Password: "passwd1"})
+		require.Nil(t, appErr)
+		defer th.App.PermanentDeleteUser(th.Context, restricted)
+		_, _, appErr = th.App.AddUserToTeam(th.Context, th.BasicTeam.Id, restricted.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.team.join_user_to_team.allowed_domains.app_error", appErr.Id)
+	})
+
+	t.Run("domains saved before the validation don't prevent updating the team", func(t *testing.T) {
+		_, err := th.GetSqlStore().GetMasterX().Exec("UPDATE Teams SET AllowedDomains = ? WHERE Id = ?", "under_score.com", th.BasicTeam.Id)
+		require.NoError(t, err)
+
+		patched, appErr := th.App.PatchTeam(th.BasicTeam.Id, &model.TeamPatch{DisplayName: model.NewString("Renamed")})
+		require.Nil(t, appErr)
+		assert.Equal(t, "under_score.com", patched.AllowedDomains)
+	})
+}
+
+func TestTeamAllowedDomainsCanonicalizationMigration(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, err := th.GetSqlStore().GetMasterX().Exec("UPDATE Teams SET AllowedDomains = ? WHERE Id = ?", "@Example.com,bücher.de user@Example.org", th.BasicTeam.Id)
+	require.NoError(t, err)
+	_, err = th.App.Srv().Store.System().PermanentDeleteByName(TeamAllowedDomainsCanonicalizationMigrationKey)
+	require.NoError(t, err)
+
+	th.App.Srv().doTeamAllowedDomainsCanonicalizationMigration()
+
+	team, appErr := th.App.GetTeam(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, "example.com, xn--bcher-kva.de, user, example.org", team.AllowedDomains)
+
+	_, err = th.App.Srv().Store.System().GetByName(TeamAllowedDomainsCanonicalizationMigrationKey)
+	require.NoError(t, err)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const teamBannerScheduleInterval = time.Minute
+
+func (a *App) CreateTeamBanner(banner *model.TeamBanner) (*model.TeamBanner, *model.AppError) {
+	banner, err := a.Srv().Store.TeamBanner().Save(banner)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateTeamBanner", "app.team_banner.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateTeamBanner", "app.team_banner.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.publishTeamBanner(banner)
+
+	return banner, nil
+}
+
+func (a *App) GetTeamBanner(bannerID string) (*model.TeamBanner, *model.AppError) {
+	banner, err := a.Srv().Store.TeamBanner().Get(bannerID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamBanner", "app.team_banner.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamBanner", "app.team_banner.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return banner, nil
+}
+
+// GetTeamBanners returns all the banners of the team, including the ones that are not
+// scheduled to be shown right now.
+func (a *App) GetTeamBanners(teamID string) ([]*model.TeamBanner, *model.AppError) {
+	banners, err := a.Srv().Store.TeamBanner().GetForTeam(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamBanners", "app.team_banner.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return banners, nil
+}
+
+// GetActiveTeamBannersForUser returns the banners of the team that are scheduled to be
+// shown right now and that the user did not dismiss.
+func (a *App) GetActiveTeamBannersForUser(teamID, userID string) ([]*model.TeamBanner, *model.AppError) {
+	banners, appErr := a.GetTeamBanners(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	now := model.GetMillis()
+	active := []*model.TeamBanner{}
+	var dismissibleIDs []string
+	for _, banner := range banners {
+		if !banner.IsActive(now) {
+			continue
+		}
+		active = append(active, banner)
+		if banner.AllowDismissal {
+			dismissibleIDs = append(dismissibleIDs, banner.Id)
+		}
+	}
+
+	dismissedIDs, err := a.Srv().Store.TeamBanner().GetDismissedBannerIds(userID, dismissibleIDs)
+	if err != nil {
+		return nil, model.NewAppError("GetActiveTeamBannersForUser", "app.team_banner.get_dismissals.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	if len(dismissedIDs) == 0 {
+		return active, nil
+	}
+
+	dismissed := make(map[string]bool, len(dismissedIDs))
+	for _, id := range dismissedIDs {
+		dismissed[id] = true
+	}
+
+	shown := make([]*model.TeamBanner, 0, len(active))
+	for _, banner := range active {
+		if !dismissed[banner.Id] {
+			shown = append(shown, banner)
+		}
+	}
+
+	return shown, nil
+}
+
+func (a *App) PatchTeamBanner(bannerID string, patch *model.TeamBannerPatch) (*model.TeamBanner, *model.AppError) {
+	banner, appErr := a.GetTeamBanner(bannerID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	banner.Patch(patch)
+
+	banner, err := a.Srv().Store.TeamBanner().Update(banner)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchTeamBanner", "app.team_banner.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("PatchTeamBanner", "app.team_banner.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.publishTeamBanner(banner)
+
+	return banner, nil
+}
+
+func (a *App) DeleteTeamBanner(bannerID string) *model.AppError {
+	banner, appErr := a.GetTeamBanner(bannerID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store.TeamBanner().Delete(banner.Id, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteTeamBanner", "app.team_banner.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("DeleteTeamBanner", "app.team_banner.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventTeamBannerHidden, banner.TeamId, "", "", nil)
+	message.Add("banner_id", banner.Id)
+	a.Publish(message)
+
+	return nil
+}
+
+// DismissTeamBanner hides a banner that allows it from the user, on all of their sessions.
+func (a *App) DismissTeamBanner(userID, bannerID string) *model.AppError {
+	banner, appErr := a.GetTeamBanner(bannerID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if !banner.AllowDismissal {
+		return model.NewAppError("DismissTeamBanner", "app.team_banner.dismiss.not_allowed.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if err := a.Srv().Store.TeamBanner().SaveDismissal(&model.TeamBannerDismissal{
+		BannerId:    banner.Id,
+		UserId:      userID,
+		DismissedAt: model.GetMillis(),
+	}); err != nil {
+		return model.NewAppError("DismissTeamBanner", "app.team_banner.dismiss.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventTeamBannerHidden, banner.TeamId, "", userID, nil)
+	message.Add("banner_id", banner.Id)
+	a.Publish(message)
+
+	return nil
+}
+
+// publishTeamBanner shows the banner to the members of its team who did not dismiss it if
+// it is scheduled to be shown right now, and hides it otherwise.
+func (a *App) publishTeamBanner(banner *model.TeamBanner) {
+	if !banner.IsActive(model.GetMillis()) {
+		message := model.NewWebSocketEvent(model.WebsocketEventTeamBannerHidden, banner.TeamId, "", "", nil)
+		message.Add("banner_id", banner.Id)
+		a.Publish(message)
+		return
+	}
+
+	var dismissingUserIDs []string
+	if banner.AllowDismissal {
+		var err error
+		if dismissingUserIDs, err = a.Srv().Store.TeamBanner().GetDismissingUserIds(banner.Id); err != nil {
+			mlog.Warn("Failed to get the users who dismissed a team banner", mlog.String("banner_id", banner.Id), mlog.Err(err))
+		}
+	}
+
+	omitUsers := make(map[string]bool, len(dismissingUserIDs))
+	for _, userID := range dismissingUserIDs {
+		omitUsers[userID] = true
+	}
+
+	bannerJSON, err := json.Marshal(banner)
+	if err != nil {
+		mlog.Warn("Failed to encode team banner to JSON", mlog.Err(err))
+		return
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventTeamBannerShown, banner.TeamId, "", "", omitUsers)
+	message.Add("banner", string(bannerJSON))
+	a.Publish(message)
+}
+
+// doTeamBannerSchedule shows and hides the banners scheduled to start or end since the
+// last run, so that clients do not need to refresh.
+func (s *Server) doTeamBannerSchedule(since, until int64) {
+	if !s.IsLeader() {
+		return
+	}
+
+	banners, err := s.Store.TeamBanner().GetScheduledBetween(since, until)
+	if err != nil {
+		mlog.Warn("Failed to get scheduled team banners", mlog.Err(err))
+		return
+	}
+
+	a := New(ServerConnector(s.Channels()))
+	for _, banner := range banners {
+		a.publishTeamBanner(banner)
+	}
+}
+
+func runTeamBannerScheduleJob(s *Server) {
+	lastRun := model.GetMillis()
+	model.CreateRecurringTask("Team Banner Schedule", func() {
+		now := model.GetMillis()
+		s.doTeamBannerSchedule(lastRun, now)
+		lastRun = now
+	}, teamBannerScheduleInterval)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const teamDeletionNotificationPageSize = 200
+
+// ScheduleTeamDeletion schedules the permanent deletion of the team at the end of the
+// deletion window, and tells the members of the team about it. Until then, the team stays
+// available and its admins can download its export or cancel the deletion.
+func (a *App) ScheduleTeamDeletion(requesterID, teamID string) (*model.TeamDeletion, *model.AppError) {
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if team.DeleteAt != 0 {
+		return nil, model.NewAppError("ScheduleTeamDeletion", "app.team_deletion.team_deleted.app_error", nil, "team_id="+team.Id, http.StatusBadRequest)
+	}
+
+	window := time.Duration(*a.Config().TeamSettings.DeletionWindowDays) * 24 * time.Hour
+	deletion, err := a.Srv().Store.TeamDeletion().Save(&model.TeamDeletion{
+		TeamId:      team.Id,
+		RequesterId: requesterID,
+		DeleteAt:    model.GetMillisForTime(time.Now().Add(window)),
+	})
+	if err != nil {
+		var appErr *model.AppError
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("ScheduleTeamDeletion", "app.team_deletion.already_scheduled.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("ScheduleTeamDeletion", "app.team_deletion.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.Srv().Go(func() {
+		a.notifyTeamDeletion(team, deletion)
+	})
+
+	return deletion, nil
+}
+
+func (a *App) GetTeamDeletion(teamID string) (*model.TeamDeletion, *model.AppError) {
+	deletion, err := a.Srv().Store.TeamDeletion().Get(teamID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamDeletion", "app.team_deletion.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamDeletion", "app.team_deletion.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return deletion, nil
+}
+
+// CancelTeamDeletion cancels the scheduled deletion of the team and removes its export.
+func (a *App) CancelTeamDeletion(teamID string) *model.AppError {
+	deletion, appErr := a.GetTeamDeletion(teamID)
+	if appErr != nil {
+		return appErr
+	}
+
+	return a.removeTeamDeletion(deletion)
+}
+
+func (a *App) removeTeamDeletion(deletion *model.TeamDeletion) *model.AppError {
+	if deletion.ExportPath != "" {
+		if appErr := a.RemoveFile(deletion.ExportPath); appErr != nil {
+			mlog.Warn("Failed to remove the export of a team scheduled for deletion", mlog.String("team_id", deletion.TeamId), mlog.Err(appErr))
+		}
+	}
+
+	if err := a.Srv().Store.TeamDeletion().Delete(deletion.TeamId); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("removeTeamDeletion", "app.team_deletion.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return model.NewAppError("removeTeamDeletion", "app.team_deletion.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// ProcessTeamDeletions exports the teams scheduled for deletion that weren't exported yet,
+// and permanently deletes those whose deletion window is over.
+func (a *App) ProcessTeamDeletions() *model.AppError {
+	deletions, err := a.Srv().Store.TeamDeletion().GetAll()
+	if err != nil {
+		return model.NewAppError("ProcessTeamDeletions", "app.team_deletion.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	// A team that fails to be exported or deleted doesn't hold back the others.
+	var lastErr *model.AppError
+	now := model.GetMillis()
+	for _, deletion := range deletions {
+		var appErr *model.AppError
+		if deletion.IsDue(now) {
+			appErr = a.deleteScheduledTeam(deletion)
+		} else if deletion.ExportAt == 0 {
+			appErr = a.exportScheduledTeam(deletion)
+		}
+		if appErr != nil {
+			mlog.Warn("Failed to process a scheduled team deletion", mlog.String("team_id", deletion.TeamId), mlog.Err(appErr))
+			lastErr = appErr
+		}
+	}
+
+	return lastErr
+}
+
+func (a *App) deleteScheduledTeam(deletion *model.TeamDeletion) *model.AppError {
+	team, appErr := a.GetTeam(deletion.TeamId)
+	if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return appErr
+	}
+
+	// The team may have been permanently deleted in the meantime.
+	if team != nil {
+		if appErr := a.PermanentDeleteTeam(team); appErr != nil {
+			return appErr
+		}
+	}
+
+	return a.removeTeamDeletion(deletion)
+}
+
+func (a *App) exportScheduledTeam(deletion *model.TeamDeletion) *model.AppError {
+	exportPath := filepath.Join(*a.Config().ExportSettings.Directory, model.TeamDeletionExportDirectory, deletion.TeamId+".jsonl")
+
+	rd, wr := io.Pipe()
+	errCh := make(chan *model.AppError, 1)
+	go func() {
+		defer close(errCh)
+		_, appErr := a.WriteFile(rd, exportPath)
+		// Unblocks the export if the file couldn't be written.
+		rd.Close()
+		errCh <- appErr
+	}()
+
+	appErr := a.ExportTeam(wr, deletion.TeamId)
+	if appErr != nil {
+		wr.CloseWithError(appErr)
+	} else {
+		wr.Close()
+	}
+	if writeErr := <-errCh; appErr == nil {
+		appErr = writeErr
+	}
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			// The team was permanently deleted in the meantime.
+			return a.removeTeamDeletion(deletion)
+		}
+		return appErr
+	}
+
+	deletion.ExportPath = exportPath
+	deletion.ExportAt = model.GetMillis()
+	if _, err := a.Srv().Store.TeamDeletion().Update(deletion); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("exportScheduledTeam", "app.team_deletion.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return nil
+}
+
+// notifyTeamDeletion tells the active members of the team, by a message from the system bot
+// and by email, when the team is going to be deleted. The verified address of the team is
+// emailed too.
+func (a *App) notifyTeamDeletion(team *model.Team, deletion *model.TeamDeletion) {
+	c := request.EmptyContext()
+	deletionDate := model.GetTimeForMillis(deletion.DeleteAt).UTC().Format("2006-01-02")
+
+	teamEmail := teamNotificationEmail(team)
+	if teamEmail != "" && *a.Config().EmailSettings.SendEmailNotifications {
+		if err := a.Srv().EmailService.SendTeamDeletionScheduledEmail(teamEmail, *a.Config().LocalizationSettings.DefaultServerLocale, a.GetSiteURL(), team, deletion.DeleteAt); err != nil {
+			mlog.Warn("Failed to send team deletion email to the team address", mlog.String("team_id", team.Id), mlog.Err(err))
+		}
+	}
+
+	for page := 0; ; page++ {
+		users, appErr := a.GetUsersInTeam(&model.UserGetOptions{
+			InTeamId: team.Id,
+			Active:   true,
+			Page:     page,
+			PerPage:  teamDeletionNotificationPageSize,
+		})
+		if appErr != nil {
+			mlog.Warn("Failed to get the members of a team scheduled for deletion", mlog.String("team_id", team.Id), mlog.Err(appErr))
+			return
+		}
+
+		for _, user := range users {
+			if user.IsBot {
+				continue
+			}
+
+			T := i18n.GetUserTranslations(user.Locale)
+			message := T("app.team_deletion.notification", map[string]interface{}{
+				"TeamDisplayName": team.DisplayName,
+				"DeletionDate":    deletionDate,
+			})
+			if appErr := a.sendSystemBotDirectMessage(c, user.Id, message); appErr != nil {
+				mlog.Warn("Failed to notify a team member of the team deletion", mlog.String("team_id", team.Id), mlog.String("user_id", user.Id), mlog.Err(appErr))
+			}
+
+			if *a.Config().EmailSettings.SendEmailNotifications && user.Email != teamEmail {
+				if err := a.Srv().EmailService.SendTeamDeletionScheduledEmail(user.Email, user.Locale, a.GetSiteURL(), team, deletion.DeleteAt); err != nil {
+					mlog.Warn("Failed to send team deletion email", mlog.String("team_id", team.Id), mlog.String("user_id", user.Id), mlog.Err(err))
+				}
+			}
+		}
+
+		if len(users) < teamDeletionNotificationPageSize {
+			return
+		}
+	}
+}
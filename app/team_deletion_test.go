@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestScheduleTeamDeletion(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.TeamSettings.DeletionWindowDays = 30
+	})
+
+	before := model.GetMillis()
+	deletion, appErr := th.App.ScheduleTeamDeletion(th.BasicUser.Id, th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicTeam.Id, deletion.TeamId)
+	assert.Equal(t, th.BasicUser.Id, deletion.RequesterId)
+	assert.GreaterOrEqual(t, deletion.DeleteAt, before+(30*24*time.Hour).Milliseconds())
+
+	_, appErr = th.App.ScheduleTeamDeletion(th.BasicUser.Id, th.BasicTeam.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.team_deletion.already_scheduled.app_error", appErr.Id)
+
+	team, appErr := th.App.GetTeam(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Zero(t, team.DeleteAt, "the team stays available during the deletion window")
+
+	appErr = th.App.CancelTeamDeletion(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.GetTeamDeletion(th.BasicTeam.Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+}
+
+func TestProcessTeamDeletions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("exports the team during the window", func(t *testing.T) {
+		_, err := th.App.Srv().Store.TeamDeletion().Save(&model.TeamDeletion{
+			TeamId:      th.BasicTeam.Id,
+			RequesterId: th.BasicUser.Id,
+			DeleteAt:    model.GetMillis() + time.Hour.Milliseconds(),
+		})
+		require.NoError(t, err)
+
+		appErr := th.App.ProcessTeamDeletions()
+		require.Nil(t, appErr)
+
+		deletion, appErr := th.App.GetTeamDeletion(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		require.NotZero(t, deletion.ExportAt)
+
+		data, appErr := th.App.ReadFile(deletion.ExportPath)
+		require.Nil(t, appErr)
+		assert.Contains(t, string(data), th.BasicTeam.Name)
+		assert.Contains(t, string(data), th.BasicChannel.Name)
+
+		appErr = th.App.CancelTeamDeletion(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+
+		exists, appErr := th.App.FileExists(deletion.ExportPath)
+		require.Nil(t, appErr)
+		assert.False(t, exists)
+	})
+
+	t.Run("deletes the team once the window is over", func(t *testing.T) {
+		team := th.CreateTeam()
+
+		_, err := th.App.Srv().Store.TeamDeletion().Save(&model.TeamDeletion{
+			TeamId:      team.Id,
+			RequesterId: th.BasicUser.Id,
+			DeleteAt:    model.GetMillis() - 1,
+		})
+		require.NoError(t, err)
+
+		appErr := th.App.ProcessTeamDeletions()
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.GetTeam(team.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+		_, appErr = th.App.GetTeamDeletion(team.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/app/email"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// UpdateTeamEmail changes the email address of the team, which stays unverified until the link
+// sent to the new address is followed.
+func (a *App) UpdateTeamEmail(teamID, newEmail string) (*model.Team, *model.AppError) {
+	newEmail = strings.ToLower(strings.TrimSpace(newEmail))
+	if len(newEmail) > model.TeamEmailMaxLength || !model.IsValidEmail(newEmail) {
+		return nil, model.NewAppError("UpdateTeamEmail", "model.team.is_valid.email.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if team.Email == newEmail {
+		return team, nil
+	}
+
+	team.Email = newEmail
+	team.EmailVerified = false
+	team, appErr = a.updateTeamEmailFields(team)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.SendTeamEmailVerification(team); appErr != nil {
+		return nil, appErr
+	}
+
+	return team, nil
+}
+
+// SendTeamEmailVerification sends a link verifying the email address of the team to that
+// address. Links sent before stop working.
+func (a *App) SendTeamEmailVerification(team *model.Team) *model.AppError {
+	if team.Email == "" {
+		return model.NewAppError("SendTeamEmailVerification", "app.team.email.verify.no_email.app_error", nil, "team_id="+team.Id, http.StatusBadRequest)
+	}
+
+	token, err := a.Srv().EmailService.CreateVerifyTeamEmailToken(team.Id, team.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, email.CreateEmailTokenError):
+			return model.NewAppError("SendTeamEmailVerification", "api.user.create_email_token.error", nil, "", http.StatusInternalServerError)
+		default:
+			return model.NewAppError("SendTeamEmailVerification", "app.recover.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	locale := *a.Config().LocalizationSettings.DefaultServerLocale
+	if err := a.Srv().EmailService.SendVerifyTeamEmail(team.Email, locale, a.GetSiteURL(), token.Token, team); err != nil {
+		return model.NewAppError("SendTeamEmailVerification", "app.team.email.verify.send.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+// VerifyTeamEmailFromToken marks the email address of a team as verified, as long as it's still
+// the address the token was sent to.
+func (a *App) VerifyTeamEmailFromToken(userSuppliedTokenString string) (*model.Team, *model.AppError) {
+	token, err := a.Srv().Store.Token().GetByToken(userSuppliedTokenString)
+	if err != nil {
+		return nil, model.NewAppError("VerifyTeamEmailFromToken", "api.user.verify_email.bad_link.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	if token.Type != email.TokenTypeVerifyTeamEmail {
+		return nil, model.NewAppError("VerifyTeamEmailFromToken", "api.user.verify_email.broken_token.app_error", nil, "", http.StatusBadRequest)
+	}
+	if model.GetMillis()-token.CreateAt >= PasswordRecoverExpiryTime {
+		return nil, model.NewAppError("VerifyTeamEmailFromToken", "api.user.verify_email.link_expired.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	tokenData := struct {
+		TeamId string
+		Email  string
+	}{}
+	if err := json.Unmarshal([]byte(token.Extra), &tokenData); err != nil {
+		return nil, model.NewAppError("VerifyTeamEmailFromToken", "api.user.verify_email.token_parse.error", nil, "", http.StatusInternalServerError)
+	}
+
+	team, appErr := a.GetTeam(tokenData.TeamId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if team.Email != tokenData.Email {
+		return nil, model.NewAppError("VerifyTeamEmailFromToken", "app.team.email.verify.changed.app_error", nil, "team_id="+team.Id, http.StatusBadRequest)
+	}
+
+	if !team.EmailVerified {
+		team.EmailVerified = true
+		team, appErr = a.updateTeamEmailFields(team)
+		if appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	if err := a.DeleteToken(token); err != nil {
+		mlog.Warn("Failed to delete token", mlog.Err(err))
+	}
+
+	return team, nil
+}
+
+// updateTeamEmailFields saves the team after its email address or the verification of it
+// changed, which UpdateTeam leaves untouched.
+func (a *App) updateTeamEmailFields(team *model.Team) (*model.Team, *model.AppError) {
+	team, err := a.Srv().Store.Team().Update(team)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("updateTeamEmailFields", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.sendTeamEvent(team, model.WebsocketEventUpdateTeam)
+
+	return team, nil
+}
+
+// teamNotificationEmail returns the address team-level notifications are sent to, or an empty
+// string when the team has no verified address.
+func teamNotificationEmail(team *model.Team) string {
+	if !team.EmailVerified {
+		return ""
+	}
+	return team.Email
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/app/email"
+	emailmocks "github.com/mattermost/mattermost-server/v6/app/email/mocks"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamEmailVerification(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newVerifyToken := func(teamID, address string) *model.Token {
+		token := model.NewToken(email.TokenTypeVerifyTeamEmail, model.MapToJSON(map[string]string{"TeamId": teamID, "Email": address}))
+		require.NoError(t, th.App.Srv().Store.Token().Save(token))
+		return token
+	}
+
+	var sent *model.Token
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("CreateVerifyTeamEmailToken", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(func(teamID, address string) *model.Token {
+		sent = newVerifyToken(teamID, address)
+		return sent
+	}, nil)
+	emailServiceMock.On("SendVerifyTeamEmail",
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("*model.Team"),
+	).Return(nil)
+	th.App.Srv().EmailService = &emailServiceMock
+
+	t.Run("invalid email", func(t *testing.T) {
+		_, appErr := th.App.UpdateTeamEmail(th.BasicTeam.Id, "not an email")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.team.is_valid.email.app_error", appErr.Id)
+	})
+
+	t.Run("change and verify", func(t *testing.T) {
+		team, appErr := th.App.UpdateTeamEmail(th.BasicTeam.Id, "Team-Contact@Example.com")
+		require.Nil(t, appErr)
+		assert.Equal(t, "team-contact@example.com", team.Email)
+		assert.False(t, team.EmailVerified)
+		emailServiceMock.AssertCalled(t, "SendVerifyTeamEmail", "team-contact@example.com", mock.Anything, mock.Anything, sent.Token, mock.Anything)
+
+		team, appErr = th.App.VerifyTeamEmailFromToken(sent.Token)
+		require.Nil(t, appErr)
+		assert.True(t, team.EmailVerified)
+		assert.Equal(t, "team-contact@example.com", teamNotificationEmail(team))
+
+		team, appErr = th.App.GetTeam(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.True(t, team.EmailVerified)
+
+		_, appErr = th.App.VerifyTeamEmailFromToken(sent.Token)
+		require.NotNil(t, appErr, "the token can only be used once")
+	})
+
+	t.Run("changing the email removes the verification", func(t *testing.T) {
+		team, appErr := th.App.UpdateTeamEmail(th.BasicTeam.Id, "other-contact@example.com")
+		require.Nil(t, appErr)
+		assert.False(t, team.EmailVerified)
+		assert.Empty(t, teamNotificationEmail(team))
+	})
+
+	t.Run("token for a previous email", func(t *testing.T) {
+		token := newVerifyToken(th.BasicTeam.Id, "team-contact@example.com")
+
+		_, appErr := th.App.VerifyTeamEmailFromToken(token.Token)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.team.email.verify.changed.app_error", appErr.Id)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("token of another type", func(t *testing.T) {
+		token := model.NewToken(TokenTypeVerifyEmail, model.MapToJSON(map[string]string{"UserId": th.BasicUser.Id, "Email": th.BasicUser.Email}))
+		require.NoError(t, th.App.Srv().Store.Token().Save(token))
+
+		_, appErr := th.App.VerifyTeamEmailFromToken(token.Token)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("the team email doesn't make its owner an admin", func(t *testing.T) {
+		team := th.CreateTeam()
+		_, appErr := th.App.UpdateTeamEmail(team.Id, th.BasicUser2.Email)
+		require.Nil(t, appErr)
+
+		team, appErr = th.App.GetTeam(team.Id)
+		require.Nil(t, appErr)
+		member, appErr := th.App.JoinUserToTeam(th.Context, team, th.BasicUser2, "")
+		require.Nil(t, appErr)
+		assert.False(t, member.SchemeAdmin)
+	})
+
+	t.Run("the creator of a team is its admin", func(t *testing.T) {
+		team, appErr := th.App.CreateTeamWithUser(th.Context, &model.Team{
+			DisplayName: "dn_" + model.NewId(),
+			Name:        "name" + model.NewId(),
+			Type:        model.TeamOpen,
+		}, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Email, team.Email)
+		assert.Equal(t, th.BasicUser.EmailVerified, team.EmailVerified)
+
+		member, appErr := th.App.GetTeamMember(team.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.True(t, member.SchemeAdmin)
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+func (a *App) GetTeamGroupRoles(teamID string) ([]*model.TeamGroupRole, *model.AppError) {
+	mappings, err := a.Srv().Store.TeamGroupRole().GetForTeam(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamGroupRoles", "app.team_group_role.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return mappings, nil
+}
+
+// SaveTeamGroupRole maps the group to the role on the team, then reconciles the team admins of
+// the team right away.
+func (a *App) SaveTeamGroupRole(mapping *model.TeamGroupRole) (*model.TeamGroupRole, *model.AppError) {
+	if _, appErr := a.GetTeam(mapping.TeamId); appErr != nil {
+		return nil, appErr
+	}
+
+	group, appErr := a.GetGroup(mapping.GroupId, nil)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if group.DeleteAt != 0 {
+		return nil, model.NewAppError("SaveTeamGroupRole", "app.team_group_role.save.deleted_group.app_error", nil, "group_id="+group.Id, http.StatusBadRequest)
+	}
+
+	mapping, err := a.Srv().Store.TeamGroupRole().Save(mapping)
+	if err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, model.NewAppError("SaveTeamGroupRole", "app.team_group_role.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if appErr := a.ReconcileTeamGroupRoles(mapping.TeamId); appErr != nil {
+		return nil, appErr
+	}
+
+	return mapping, nil
+}
+
+// DeleteTeamGroupRole unmaps the group from the team, revoking the team admin role of the members
+// who held it only through the group.
+func (a *App) DeleteTeamGroupRole(teamID, groupID string) *model.AppError {
+	if err := a.Srv().Store.TeamGroupRole().Delete(teamID, groupID); err != nil {
+		return model.NewAppError("DeleteTeamGroupRole", "app.team_group_role.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return a.ReconcileTeamGroupRoles(teamID)
+}
+
+// ReconcileTeamGroupRoles makes the members of the groups mapped to the team admin role team
+// admins, and revokes the role of those who were granted it by a group they are no longer in.
+// Team admins made so by hand are left alone, in a mapped group or not.
+func (a *App) ReconcileTeamGroupRoles(teamID string) *model.AppError {
+	members, err := a.Srv().Store.TeamGroupRole().GetMembers(teamID)
+	if err != nil {
+		return model.NewAppError("ReconcileTeamGroupRoles", "app.team_group_role.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var granted, revoked []string
+	for _, member := range members {
+		switch {
+		case member.InMappedGroup && !member.SchemeAdmin:
+			if _, appErr := a.UpdateTeamMemberSchemeRoles(teamID, member.UserId, false, true, true); appErr != nil {
+				mlog.Warn("Failed to grant team admin role from group", mlog.String("team_id", teamID), mlog.String("user_id", member.UserId), mlog.Err(appErr))
+				continue
+			}
+			granted = append(granted, member.UserId)
+		case !member.InMappedGroup && member.Granted:
+			if member.SchemeAdmin {
+				if _, appErr := a.UpdateTeamMemberSchemeRoles(teamID, member.UserId, false, true, false); appErr != nil {
+					mlog.Warn("Failed to revoke team admin role from group", mlog.String("team_id", teamID), mlog.String("user_id", member.UserId), mlog.Err(appErr))
+					continue
+				}
+			}
+			revoked = append(revoked, member.UserId)
+		}
+	}
+
+	if err := a.Srv().Store.TeamGroupRole().SaveGrants(teamID, granted, model.GetMillis()); err != nil {
+		return model.NewAppError("ReconcileTeamGroupRoles", "app.team_group_role.save_grants.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.TeamGroupRole().DeleteGrants(teamID, revoked); err != nil {
+		return model.NewAppError("ReconcileTeamGroupRoles", "app.team_group_role.delete_grants.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return nil
+}
+
+// reconcileTeamGroupRolesForGroup reconciles the teams the group is mapped to a role on, once the
+// members of the group changed.
+func (a *App) reconcileTeamGroupRolesForGroup(groupID string) {
+	mappings, err := a.Srv().Store.TeamGroupRole().GetForGroup(groupID)
+	if err != nil {
+		mlog.Warn("Failed to get the teams of group to reconcile", mlog.String("group_id", groupID), mlog.Err(err))
+		return
+	}
+
+	for _, mapping := range mappings {
+		if appErr := a.ReconcileTeamGroupRoles(mapping.TeamId); appErr != nil {
+			mlog.Warn("Failed to reconcile team admins from groups", mlog.String("team_id", mapping.TeamId), mlog.Err(appErr))
+		}
+	}
+}
+
+// SyncTeamGroupRoles reconciles the team admins of every team with groups mapped to roles, or with
+// roles still granted by groups. A team failing to reconcile doesn't keep the others from being
+// reconciled.
+func (a *App) SyncTeamGroupRoles() *model.AppError {
+	teamIDs, err := a.Srv().Store.TeamGroupRole().GetTeamIDs()
+	if err != nil {
+		return model.NewAppError("SyncTeamGroupRoles", "app.team_group_role.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	for _, teamID := range teamIDs {
+		if appErr := a.ReconcileTeamGroupRoles(teamID); appErr != nil {
+			mlog.Warn("Failed to reconcile team admins from groups", mlog.String("team_id", teamID), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+// GetTeamGroupRoleDrift reports the members of the team whose team admin role differs from the one
+// given by the groups mapped to roles on the team.
+func (a *App) GetTeamGroupRoleDrift(teamID string) (*model.TeamGroupRoleDriftReport, *model.AppError) {
+	members, err := a.Srv().Store.TeamGroupRole().GetMembers(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamGroupRoleDrift", "app.team_group_role.get_members.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	report := &model.TeamGroupRoleDriftReport{TeamId: teamID, Drifts: []*model.TeamGroupRoleDrift{}}
+	for _, member := range members {
+		if kind := member.Drift(); kind != "" {
+			report.Drifts = append(report.Drifts, &model.TeamGroupRoleDrift{UserId: member.UserId, Kind: kind})
+		}
+	}
+
+	return report, nil
+}
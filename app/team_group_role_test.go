@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamGroupRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	group := th.CreateGroup()
+
+	member := th.CreateUser()
+	th.LinkUserToTeam(member, th.BasicTeam)
+	manualAdmin := th.CreateUser()
+	th.LinkUserToTeam(manualAdmin, th.BasicTeam)
+	_, appErr := th.App.UpdateTeamMemberSchemeRoles(th.BasicTeam.Id, manualAdmin.Id, false, true, true)
+	require.Nil(t, appErr)
+
+	isTeamAdmin := func(user *model.User) bool {
+		teamMember, appErr := th.App.GetTeamMember(th.BasicTeam.Id, user.Id)
+		require.Nil(t, appErr)
+		return teamMember.SchemeAdmin
+	}
+
+	_, err := th.App.Srv().Store.Group().UpsertMember(group.Id, member.Id)
+	require.NoError(t, err)
+
+	_, appErr = th.App.SaveTeamGroupRole(&model.TeamGroupRole{TeamId: th.BasicTeam.Id, GroupId: group.Id, Role: model.TeamUserRoleId})
+	require.NotNil(t, appErr, "only the team admin role can be mapped")
+
+	_, appErr = th.App.SaveTeamGroupRole(&model.TeamGroupRole{TeamId: th.BasicTeam.Id, GroupId: model.NewId(), Role: model.TeamAdminRoleId})
+	require.NotNil(t, appErr)
+
+	mapping, appErr := th.App.SaveTeamGroupRole(&model.TeamGroupRole{TeamId: th.BasicTeam.Id, GroupId: group.Id, Role: model.TeamAdminRoleId})
+	require.Nil(t, appErr)
+	assert.NotZero(t, mapping.CreateAt)
+	assert.True(t, isTeamAdmin(member), "the members of the group are made team admins right away")
+
+	mappings, appErr := th.App.GetTeamGroupRoles(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	require.Len(t, mappings, 1)
+
+	report, appErr := th.App.GetTeamGroupRoleDrift(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Contains(t, report.Drifts, &model.TeamGroupRoleDrift{UserId: manualAdmin.Id, Kind: model.TeamGroupRoleDriftManual})
+	assert.NotContains(t, report.Drifts, &model.TeamGroupRoleDrift{UserId: member.Id, Kind: model.TeamGroupRoleDriftMissing})
+
+	t.Run("members leaving the group lose the role on the next sync", func(t *testing.T) {
+		_, err := th.App.Srv().Store.Group().DeleteMember(group.Id, member.Id)
+		require.NoError(t, err)
+
+		report, appErr := th.App.GetTeamGroupRoleDrift(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.Contains(t, report.Drifts, &model.TeamGroupRoleDrift{UserId: member.Id, Kind: model.TeamGroupRoleDriftUnexpected})
+
+		require.Nil(t, th.App.SyncTeamGroupRoles())
+		assert.False(t, isTeamAdmin(member))
+		assert.True(t, isTeamAdmin(manualAdmin), "team admins made so by hand are left alone")
+	})
+
+	t.Run("members joining the group get the role on the next sync", func(t *testing.T) {
+		_, err := th.App.Srv().Store.Group().UpsertMember(group.Id, member.Id)
+		require.NoError(t, err)
+
+		report, appErr := th.App.GetTeamGroupRoleDrift(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.Contains(t, report.Drifts, &model.TeamGroupRoleDrift{UserId: member.Id, Kind: model.TeamGroupRoleDriftMissing})
+
+		require.Nil(t, th.App.SyncTeamGroupRoles())
+		assert.True(t, isTeamAdmin(member))
+	})
+
+	t.Run("unmapping the group revokes the role it granted", func(t *testing.T) {
+		require.Nil(t, th.App.DeleteTeamGroupRole(th.BasicTeam.Id, group.Id))
+		assert.False(t, isTeamAdmin(member))
+		assert.True(t, isTeamAdmin(manualAdmin))
+
+		mappings, appErr := th.App.GetTeamGroupRoles(th.BasicTeam.Id)
+		require.Nil(t, appErr)
+		assert.Empty(t, mappings)
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// GetChildTeams returns the direct sub-teams of a team.
+func (a *App) GetChildTeams(teamID string) ([]*model.Team, *model.AppError) {
+	teams, err := a.Srv().Store.Team().GetChildTeams(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetChildTeams", "app.team.get_child_teams.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return teams, nil
+}
+
+// GetTeamAncestors returns the teams above a team in the hierarchy, starting with its parent.
+func (a *App) GetTeamAncestors(teamID string) ([]*model.Team, *model.AppError) {
+	team, err := a.GetTeam(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors := []*model.Team{}
+	for parentID := team.ParentTeamId; parentID != "" && len(ancestors) < model.TeamMaxHierarchyDepth; {
+		parent, err := a.GetTeam(parentID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentTeamId
+	}
+
+	return ancestors, nil
+}
+
+// SetParentTeam moves a team under another one, or to the top of the hierarchy if
+// parentTeamID is empty. The sub-teams of the team move along with it.
+func (a *App) SetParentTeam(teamID, parentTeamID string) (*model.Team, *model.AppError) {
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if team.ParentTeamId == parentTeamID {
+		return team, nil
+	}
+
+	team.ParentTeamId = parentTeamID
+	updatedTeam, err := a.Srv().Store.Team().Update(team)
+	if err != nil {
+		var invErr *store.ErrInvalidInput
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("SetParentTeam", "app.team.parent_team_id.invalid.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SetParentTeam", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.sendTeamEvent(updatedTeam, model.WebsocketEventUpdateTeam)
+
+	return updatedTeam, nil
+}
+
+// HasTeamVisibilityThroughParent returns true if the team lets the members of its parent
+// team see and join it, and the user is one of them.
+func (a *App) HasTeamVisibilityThroughParent(userID string, team *model.Team) bool {
+	if team.ParentTeamId == "" || !team.InheritParentMembership {
+		return false
+	}
+
+	member, err := a.GetTeamMember(team.ParentTeamId, userID)
+	return err == nil && member.DeleteAt == 0
+}
+
+// sessionHasPermissionToParentTeams cascades permissions down the team hierarchy: the
+// admins of a team have the same permissions on its sub-teams as on the team itself.
+func (a *App) sessionHasPermissionToParentTeams(session model.Session, teamID string, permission *model.Permission) bool {
+	isTeamAdmin := false
+	for _, teamMember := range session.TeamMembers {
+		if teamMember.SchemeAdmin {
+			isTeamAdmin = true
+			break
+		}
+	}
+	if !isTeamAdmin {
+		return false
+	}
+
+	ancestors, err := a.GetTeamAncestors(teamID)
+	if err != nil {
+		return false
+	}
+
+	for _, ancestor := range ancestors {
+		teamMember := session.GetTeamByTeamId(ancestor.Id)
+		if teamMember != nil && teamMember.SchemeAdmin && a.RolesGrantPermission(teamMember.GetRoles(), permission.Id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasPermissionToParentTeams is the counterpart of sessionHasPermissionToParentTeams for
+// checks that aren't made against a session.
+func (a *App) hasPermissionToParentTeams(userID string, teamID string, permission *model.Permission) bool {
+	ancestors, err := a.GetTeamAncestors(teamID)
+	if err != nil {
+		return false
+	}
+
+	for _, ancestor := range ancestors {
+		teamMember, err := a.GetTeamMember(ancestor.Id, userID)
+		if err == nil && teamMember.DeleteAt == 0 && teamMember.SchemeAdmin && a.RolesGrantPermission(teamMember.GetRoles(), permission.Id) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// TeamInviteBudgetExceededError is the id of the error returned when a team doesn't have enough
+// invitations left today.
+const TeamInviteBudgetExceededError = "app.team.invite_budget.exceeded.app_error"
+
+// GetTeamInviteBudget returns how many invitations the given team can send today and how many
+// of them it sent.
+func (a *App) GetTeamInviteBudget(teamID string) (*model.TeamInviteBudget, *model.AppError) {
+	usage, err := a.Srv().Store.TeamInviteUsage().Get(teamID, model.TeamInviteUsageDay(model.GetMillis()))
+	if err != nil {
+		return nil, model.NewAppError("GetTeamInviteBudget", "app.team.invite_budget.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return model.NewTeamInviteBudget(int64(*a.Config().TeamSettings.MaxInvitesPerTeamPerDay), usage), nil
+}
+
+// GrantTeamInviteBudget lets the given team send extra invitations today, on top of the daily
+// invitation budget.
+func (a *App) GrantTeamInviteBudget(teamID string, grant *model.TeamInviteBudgetGrant) (*model.TeamInviteBudget, *model.AppError) {
+	if appErr := grant.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	if _, appErr := a.GetTeam(teamID); appErr != nil {
+		return nil, appErr
+	}
+
+	usage := &model.TeamInviteUsage{
+		TeamId:       teamID,
+		Day:          model.TeamInviteUsageDay(model.GetMillis()),
+		ExtraInvites: grant.ExtraInvites,
+	}
+	if err := a.Srv().Store.TeamInviteUsage().Increment(usage); err != nil {
+		return nil, model.NewAppError("GrantTeamInviteBudget", "app.team.invite_budget.grant.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return a.GetTeamInviteBudget(teamID)
+}
+
+// checkTeamInviteBudget returns an error when sending the given number of invitations would
+// exceed the daily invitation budget of the given team.
+func (a *App) checkTeamInviteBudget(teamID string, invites int) *model.AppError {
+	if *a.Config().TeamSettings.MaxInvitesPerTeamPerDay == 0 {
+		return nil
+	}
+
+	budget, appErr := a.GetTeamInviteBudget(teamID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if !budget.Allows(invites) {
+		resetTime := time.Unix(0, budget.ResetAt*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+		return model.NewAppError("checkTeamInviteBudget", TeamInviteBudgetExceededError,
+			map[string]interface{}{"Invites": invites, "Limit": budget.DailyLimit + budget.ExtraInvites, "Remaining": budget.Remaining, "ResetTime": resetTime},
+			"team_id="+teamID, http.StatusTooManyRequests)
+	}
+
+	return nil
+}
+
+// recordTeamInvites adds the given number of invitations to the invitations the given team sent
+// today. Invitations are recorded even without a budget, so setting one accounts for earlier
+// invitations.
+func (a *App) recordTeamInvites(teamID string, invites int) {
+	if invites <= 0 {
+		return
+	}
+
+	usage := &model.TeamInviteUsage{
+		TeamId:  teamID,
+		Day:     model.TeamInviteUsageDay(model.GetMillis()),
+		Invites: int64(invites),
+	}
+	if err := a.Srv().Store.TeamInviteUsage().Increment(usage); err != nil {
+		mlog.Warn("Failed to record team invitations", mlog.String("team_id", teamID), mlog.Err(err))
+		return
+	}
+
+	if *a.Config().TeamSettings.MaxInvitesPerTeamPerDay > 0 {
+		a.notifyTeamInviteBudgetExhausted(teamID, invites)
+	}
+}
+
+// notifyTeamInviteBudgetExhausted emails the verified address of the team when the given number
+// of invitations it just sent used up its invitation budget for the day.
+func (a *App) notifyTeamInviteBudgetExhausted(teamID string, invites int) {
+	if !*a.Config().EmailSettings.SendEmailNotifications {
+		return
+	}
+
+	budget, appErr := a.GetTeamInviteBudget(teamID)
+	if appErr != nil {
+		mlog.Warn("Failed to get the team invitation budget", mlog.String("team_id", teamID), mlog.Err(appErr))
+		return
+	}
+	// Only the invitations that used up the budget trigger the email.
+	limit := budget.DailyLimit + budget.ExtraInvites
+	if budget.Used < limit || budget.Used-int64(invites) >= limit {
+		return
+	}
+
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		mlog.Warn("Failed to get the team", mlog.String("team_id", teamID), mlog.Err(appErr))
+		return
+	}
+
+	teamEmail := teamNotificationEmail(team)
+	if teamEmail == "" {
+		return
+	}
+
+	a.Srv().Go(func() {
+		if err := a.Srv().EmailService.SendTeamInviteBudgetExhaustedEmail(teamEmail, *a.Config().LocalizationSettings.DefaultServerLocale, a.GetSiteURL(), team, budget); err != nil {
+			mlog.Warn("Failed to send the team invitation budget email", mlog.String("team_id", teamID), mlog.Err(err))
+		}
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// maxTeamsOrderAttempts is how many times moving a team is attempted when the order of the teams
+// keeps being changed concurrently, from another device of the user for instance.
+const maxTeamsOrderAttempts = 3
+
+// getTeamsOrder returns the order of the teams of the user, along with the version of the
+// preference it's stored in. The teams the user left are dropped from the stored order, and the
+// teams missing from it follow in the order of their display names.
+func (a *App) getTeamsOrder(userID string) ([]string, int64, *model.AppError) {
+	teams, appErr := a.GetTeamsForUser(userID)
+	if appErr != nil {
+		return nil, 0, appErr
+	}
+
+	preferences, err := a.Srv().Store.Preference().GetCategory(userID, model.PreferenceCategoryTeamsOrder)
+	if err != nil {
+		return nil, 0, model.NewAppError("getTeamsOrder", "app.preference.get_category.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	var storedOrder []string
+	var version int64
+	for _, preference := range preferences {
+		if preference.Name == model.PreferenceNameTeamsOrder {
+			version = preference.Version
+			if preference.Value != "" {
+				storedOrder = strings.Split(preference.Value, ",")
+			}
+		}
+	}
+
+	unordered := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		unordered[team.Id] = true
+	}
+
+	order := make([]string, 0, len(teams))
+	for _, teamID := range storedOrder {
+		if unordered[teamID] {
+			order = append(order, teamID)
+			delete(unordered, teamID)
+		}
+	}
+
+	remaining := make([]*model.Team, 0, len(unordered))
+	for _, team := range teams {
+		if unordered[team.Id] {
+			remaining = append(remaining, team)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return strings.ToLower(remaining[i].DisplayName) < strings.ToLower(remaining[j].DisplayName)
+	})
+	for _, team := range remaining {
+		order = append(order, team.Id)
+	}
+
+	return order, version, nil
+}
+
+// GetTeamsOrder returns the ids of the teams of the user in the order the user sorted them.
+func (a *App) GetTeamsOrder(userID string) ([]string, *model.AppError) {
+	order, _, appErr := a.getTeamsOrder(userID)
+	return order, appErr
+}
+
+// UpdateTeamsOrder sorts the teams of the user in the order. It must hold every team of the user,
+// and only these.
+func (a *App) UpdateTeamsOrder(userID string, order []string) *model.AppError {
+	currentOrder, _, appErr := a.getTeamsOrder(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	teamIDs := make(map[string]bool, len(currentOrder))
+	for _, teamID := range currentOrder {
+		teamIDs[teamID] = true
+	}
+	if len(order) != len(currentOrder) {
+		return model.NewAppError("UpdateTeamsOrder", "app.team.teams_order.invalid.app_error", nil, "", http.StatusBadRequest)
+	}
+	for _, teamID := range order {
+		if !teamIDs[teamID] {
+			return model.NewAppError("UpdateTeamsOrder", "app.team.teams_order.invalid.app_error", nil, "team_id="+teamID, http.StatusBadRequest)
+		}
+		// Each team may appear once only.
+		delete(teamIDs, teamID)
+	}
+
+	if appErr := a.UpdatePreferences(userID, model.Preferences{teamsOrderPreference(userID, order, 0)}); appErr != nil {
+		return appErr
+	}
+
+	a.publishTeamsOrderUpdated(userID, order)
+	return nil
+}
+
+// MoveTeamInOrder moves the team to the index in the order of the teams of the user, shifting the
+// teams in between, and returns the new order.
+func (a *App) MoveTeamInOrder(userID, teamID string, index int) ([]string, *model.AppError) {
+	for attempt := 0; attempt < maxTeamsOrderAttempts; attempt++ {
+		order, version, appErr := a.getTeamsOrder(userID)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		from := -1
+		for i, id := range order {
+			if id == teamID {
+				from = i
+				break
+			}
+		}
+		if from == -1 {
+			return nil, model.NewAppError("MoveTeamInOrder", "app.team.teams_order.not_member.app_error", nil, "team_id="+teamID, http.StatusNotFound)
+		}
+		if index < 0 || index >= len(order) {
+			return nil, model.NewAppError("MoveTeamInOrder", "app.team.teams_order.index.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		order = append(order[:from], order[from+1:]...)
+		order = append(order[:index], append([]string{teamID}, order[index:]...)...)
+
+		result, appErr := a.CompareAndSetPreferences(userID, model.Preferences{teamsOrderPreference(userID, order, version)})
+		if appErr != nil {
+			return nil, appErr
+		}
+		if len(result.Conflicts) == 0 {
+			a.publishTeamsOrderUpdated(userID, order)
+			return order, nil
+		}
+	}
+
+	return nil, model.NewAppError("MoveTeamInOrder", "app.team.teams_order.conflict.app_error", nil, "", http.StatusConflict)
+}
+
+func teamsOrderPreference(userID string, order []string, version int64) model.Preference {
+	return model.Preference{
+		UserId:   userID,
+		Category: model.PreferenceCategoryTeamsOrder,
+		Name:     model.PreferenceNameTeamsOrder,
+		Value:    strings.Join(order, ","),
+		Version:  version,
+	}
+}
+
+// publishTeamsOrderUpdated lets the clients of the user know about the new order of their teams.
+func (a *App) publishTeamsOrderUpdated(userID string, order []string) {
+	message := model.NewWebSocketEvent(model.WebsocketEventTeamsOrderUpdated, "", "", userID, nil)
+	message.Add("order", order)
+	a.Publish(message)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestTeamsOrder(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	teamA := th.CreateTeam()
+	teamA.DisplayName = "a"
+	teamA, appErr := th.App.UpdateTeam(teamA)
+	require.Nil(t, appErr)
+	th.LinkUserToTeam(th.BasicUser, teamA)
+
+	teamB := th.CreateTeam()
+	teamB.DisplayName = "B"
+	teamB, appErr = th.App.UpdateTeam(teamB)
+	require.Nil(t, appErr)
+	th.LinkUserToTeam(th.BasicUser, teamB)
+
+	t.Run("teams are sorted by display name by default", func(t *testing.T) {
+		order, appErr := th.App.GetTeamsOrder(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{teamA.Id, teamB.Id, th.BasicTeam.Id}, order)
+	})
+
+	t.Run("update the order", func(t *testing.T) {
+		appErr := th.App.UpdateTeamsOrder(th.BasicUser.Id, []string{th.BasicTeam.Id, teamB.Id, teamA.Id})
+		require.Nil(t, appErr)
+
+		order, appErr := th.App.GetTeamsOrder(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicTeam.Id, teamB.Id, teamA.Id}, order)
+
+		preference, appErr := th.App.GetPreferenceByCategoryAndNameForUser(th.BasicUser.Id, model.PreferenceCategoryTeamsOrder, model.PreferenceNameTeamsOrder)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicTeam.Id+","+teamB.Id+","+teamA.Id, preference.Value)
+	})
+
+	t.Run("the order must hold every team of the user once", func(t *testing.T) {
+		for _, order := range [][]string{
+			{th.BasicTeam.Id, teamB.Id},
+			{th.BasicTeam.Id, teamB.Id, teamB.Id},
+			{th.BasicTeam.Id, teamB.Id, model.NewId()},
+		} {
+			appErr := th.App.UpdateTeamsOrder(th.BasicUser.Id, order)
+			require.NotNil(t, appErr)
+			assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+		}
+	})
+
+	t.Run("move a team", func(t *testing.T) {
+		order, appErr := th.App.MoveTeamInOrder(th.BasicUser.Id, teamA.Id, 0)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{teamA.Id, th.BasicTeam.Id, teamB.Id}, order)
+
+		order, appErr = th.App.MoveTeamInOrder(th.BasicUser.Id, teamA.Id, 2)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicTeam.Id, teamB.Id, teamA.Id}, order)
+
+		_, appErr = th.App.MoveTeamInOrder(th.BasicUser.Id, teamA.Id, 3)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+
+		_, appErr = th.App.MoveTeamInOrder(th.BasicUser.Id, model.NewId(), 0)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	t.Run("teams joined and left are reconciled", func(t *testing.T) {
+		teamC := th.CreateTeam()
+		th.LinkUserToTeam(th.BasicUser, teamC)
+		th.RemoveUserFromTeam(th.BasicUser, teamB)
+
+		order, appErr := th.App.GetTeamsOrder(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicTeam.Id, teamA.Id, teamC.Id}, order)
+	})
+}
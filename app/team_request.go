@@ -0,0 +1,245 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/app/request"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/i18n"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// CreateTeamRequest records the request of a user to have a team created for them, and lets
+// the system admins know about it.
+func (a *App) CreateTeamRequest(c *request.Context, teamRequest *model.TeamRequest) (*model.TeamRequest, *model.AppError) {
+	if _, err := a.Srv().Store.Team().GetByName(teamRequest.Name); err == nil {
+		return nil, model.NewAppError("CreateTeamRequest", "app.team_request.save.name_taken.app_error", nil, "name="+teamRequest.Name, http.StatusBadRequest)
+	}
+
+	teamRequest, err := a.Srv().Store.TeamRequest().Save(teamRequest)
+	if err != nil {
+		var appErr *model.AppError
+		var invErr *store.ErrInvalidInput
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("CreateTeamRequest", "app.team_request.save.existing.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("CreateTeamRequest", "app.team_request.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.Srv().Go(func() {
+		if appErr := a.notifyAdminsOfTeamRequest(c, teamRequest); appErr != nil {
+			mlog.Warn("Failed to notify admins of team request", mlog.String("team_request_id", teamRequest.Id), mlog.Err(appErr))
+		}
+	})
+
+	return teamRequest, nil
+}
+
+func (a *App) GetTeamRequest(requestID string) (*model.TeamRequest, *model.AppError) {
+	teamRequest, err := a.Srv().Store.TeamRequest().Get(requestID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetTeamRequest", "app.team_request.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetTeamRequest", "app.team_request.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return teamRequest, nil
+}
+
+// GetTeamRequests returns a page of team requests, oldest first, optionally filtered by status.
+func (a *App) GetTeamRequests(status string, page, perPage int) ([]*model.TeamRequest, *model.AppError) {
+	teamRequests, err := a.Srv().Store.TeamRequest().GetAll(status, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamRequests", "app.team_request.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return teamRequests, nil
+}
+
+// ApproveTeamRequest creates the requested team, with the requester as its admin, and lets the
+// requester know.
+func (a *App) ApproveTeamRequest(c *request.Context, requestID, reviewerID, note string) (*model.TeamRequest, *model.AppError) {
+	teamRequest, appErr := a.getPendingTeamRequest(requestID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	team, appErr := a.CreateTeamWithUser(c, &model.Team{
+		Name:        teamRequest.Name,
+		DisplayName: teamRequest.DisplayName,
+		Type:        teamRequest.Type,
+	}, teamRequest.RequesterId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	teamRequest.Status = model.TeamRequestStatusApproved
+	teamRequest.TeamId = team.Id
+
+	return a.reviewTeamRequest(c, teamRequest, reviewerID, note, team)
+}
+
+// DenyTeamRequest closes the team request without creating the team and lets the requester know.
+func (a *App) DenyTeamRequest(c *request.Context, requestID, reviewerID, note string) (*model.TeamRequest, *model.AppError) {
+	teamRequest, appErr := a.getPendingTeamRequest(requestID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	teamRequest.Status = model.TeamRequestStatusDenied
+
+	return a.reviewTeamRequest(c, teamRequest, reviewerID, note, nil)
+}
+
+func (a *App) getPendingTeamRequest(requestID string) (*model.TeamRequest, *model.AppError) {
+	teamRequest, appErr := a.GetTeamRequest(requestID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !teamRequest.IsPending() {
+		return nil, model.NewAppError("getPendingTeamRequest", "app.team_request.review.already_reviewed.app_error", nil, "id="+requestID, http.StatusBadRequest)
+	}
+
+	return teamRequest, nil
+}
+
+func (a *App) reviewTeamRequest(c *request.Context, teamRequest *model.TeamRequest, reviewerID, note string, team *model.Team) (*model.TeamRequest, *model.AppError) {
+	teamRequest.ReviewerId = reviewerID
+	teamRequest.ReviewNote = note
+
+	teamRequest, err := a.Srv().Store.TeamRequest().Review(teamRequest)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("reviewTeamRequest", "app.team_request.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("reviewTeamRequest", "app.team_request.review.already_reviewed.app_error", nil, cErr.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("reviewTeamRequest", "app.team_request.review.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.Srv().Go(func() {
+		if appErr := a.notifyTeamRequestReviewed(c, teamRequest, team); appErr != nil {
+			mlog.Warn("Failed to notify the requester of a reviewed team request", mlog.String("team_request_id", teamRequest.Id), mlog.Err(appErr))
+		}
+	})
+
+	return teamRequest, nil
+}
+
+// notifyAdminsOfTeamRequest sends a direct message from the system bot to every system admin.
+func (a *App) notifyAdminsOfTeamRequest(c *request.Context, teamRequest *model.TeamRequest) *model.AppError {
+	requester, appErr := a.GetUser(teamRequest.RequesterId)
+	if appErr != nil {
+		return appErr
+	}
+
+	perPage := 200
+	userOptions := &model.UserGetOptions{
+		Page:     0,
+		PerPage:  perPage,
+		Role:     model.SystemAdminRoleId,
+		Inactive: false,
+	}
+	var sysAdmins []*model.User
+	for {
+		sysAdminsList, appErr := a.GetUsers(userOptions)
+		if appErr != nil {
+			return appErr
+		}
+
+		sysAdmins = append(sysAdmins, sysAdminsList...)
+
+		if len(sysAdminsList) < perPage {
+			break
+		}
+
+		userOptions.Page += 1
+	}
+
+	for _, sysAdmin := range sysAdmins {
+		T := i18n.GetUserTranslations(sysAdmin.Locale)
+		message := T("app.team_request.notification.submitted", map[string]interface{}{
+			"Username":        requester.Username,
+			"TeamDisplayName": teamRequest.DisplayName,
+			"TeamName":        teamRequest.Name,
+			"Justification":   teamRequest.Justification,
+		})
+		if appErr := a.sendSystemBotDirectMessage(c, sysAdmin.Id, message); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
+
+// notifyTeamRequestReviewed lets the requester know about the outcome of their team request,
+// both by email and by a direct message from the system bot.
+func (a *App) notifyTeamRequestReviewed(c *request.Context, teamRequest *model.TeamRequest, team *model.Team) *model.AppError {
+	requester, appErr := a.GetUser(teamRequest.RequesterId)
+	if appErr != nil {
+		return appErr
+	}
+
+	teamURL := ""
+	if team != nil {
+		teamURL = a.GetSiteURL() + "/" + team.Name
+	}
+
+	if err := a.Srv().EmailService.SendTeamRequestReviewedEmail(requester.Email, requester.Locale, a.GetSiteURL(), teamURL, teamRequest); err != nil {
+		mlog.Warn("Failed to send team request email", mlog.String("team_request_id", teamRequest.Id), mlog.Err(err))
+	}
+
+	T := i18n.GetUserTranslations(requester.Locale)
+	message := T("app.team_request.notification."+teamRequest.Status, map[string]interface{}{
+		"TeamDisplayName": teamRequest.DisplayName,
+		"TeamURL":         teamURL,
+	})
+	if teamRequest.ReviewNote != "" {
+		message += fmt.Sprintf("\n\n%s\n> %s", T("app.team_request.email.note"), teamRequest.ReviewNote)
+	}
+
+	return a.sendSystemBotDirectMessage(c, requester.Id, message)
+}
+
+func (a *App) sendSystemBotDirectMessage(c *request.Context, userID, message string) *model.AppError {
+	systemBot, appErr := a.GetSystemBot()
+	if appErr != nil {
+		return appErr
+	}
+
+	channel, appErr := a.GetOrCreateDirectChannel(c, userID, systemBot.UserId)
+	if appErr != nil {
+		return appErr
+	}
+
+	post := &model.Post{
+		UserId:    systemBot.UserId,
+		ChannelId: channel.Id,
+		Message:   message,
+	}
+
+	_, appErr = a.CreatePost(c, post, channel, false, true)
+	return appErr
+}
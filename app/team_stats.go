@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// startOfTodayUTC is the start of the day that the team stats rollups don't cover yet.
+func startOfTodayUTC() int64 {
+	return model.GetStartOfDayMillis(time.Now().UTC(), 0)
+}
+
+// rollupDays calls rollupDay for every full day since the last day recorded under the system
+// key, or over the given number of days when nothing was rolled up yet. The last day is
+// recorded after every day so that a failed run resumes where it stopped.
+func (a *App) rollupDays(where, systemKey string, backfillDays int64, rollupDay func(day int64) *model.AppError) *model.AppError {
+	today := startOfTodayUTC()
+	day := today - backfillDays*model.TeamStatsDayMillis
+
+	lastRollup, err := a.Srv().Store.System().GetByName(systemKey)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return model.NewAppError(where, "app.system.get_by_name.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	} else {
+		lastDay, parseErr := strconv.ParseInt(lastRollup.Value, 10, 64)
+		if parseErr != nil {
+			return model.NewAppError(where, "app.stats.last_rollup_day.app_error", nil, parseErr.Error(), http.StatusInternalServerError)
+		}
+		day = lastDay + model.TeamStatsDayMillis
+	}
+
+	for ; day < today; day += model.TeamStatsDayMillis {
+		if appErr := rollupDay(day); appErr != nil {
+			return appErr
+		}
+
+		if err := a.Srv().Store.System().SaveOrUpdate(&model.System{
+			Name:  systemKey,
+			Value: strconv.FormatInt(day, 10),
+		}); err != nil {
+			return model.NewAppError(where, "app.system.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		mlog.Debug("Rolled up the stats of a day", mlog.String("system_key", systemKey), mlog.Int64("day", day))
+	}
+
+	return nil
+}
+
+// RollupTeamStats computes the team stats, and the channel stats along with them, of every
+// full day since the last rollup. The first rollup backfills the stats of the default stats
+// period.
+func (a *App) RollupTeamStats() *model.AppError {
+	return a.rollupDays("RollupTeamStats", model.SystemTeamStatsLastRollupDay, model.TeamExtendedStatsDefaultDays, func(day int64) *model.AppError {
+		if err := a.Srv().Store.TeamStats().RollupDay(day); err != nil {
+			return model.NewAppError("RollupTeamStats", "app.team_stats.rollup.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+		return nil
+	})
+}
+
+// GetTeamExtendedStats returns the stats of the team over the given number of days up to
+// the last rollup.
+func (a *App) GetTeamExtendedStats(teamID string, days int) (*model.TeamExtendedStats, *model.AppError) {
+	until := startOfTodayUTC()
+	stats := &model.TeamExtendedStats{
+		TeamId: teamID,
+		Since:  until - int64(days)*model.TeamStatsDayMillis,
+		Until:  until,
+	}
+
+	var err error
+	stats.DailyStats, err = a.Srv().Store.TeamStats().GetDailyStats(teamID, stats.Since, stats.Until)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamExtendedStats", "app.team_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	stats.PostsPerChannel, err = a.Srv().Store.TeamStats().GetChannelPostCounts(teamID, stats.Since, stats.Until, model.TeamExtendedStatsTopLimit)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamExtendedStats", "app.team_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	stats.TopPosters, err = a.Srv().Store.TeamStats().GetTopPosters(teamID, stats.Since, stats.Until, model.TeamExtendedStatsTopLimit)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamExtendedStats", "app.team_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	stats.FileCount, stats.FileSize, err = a.Srv().Store.TeamStats().GetFileUsage(teamID)
+	if err != nil {
+		return nil, model.NewAppError("GetTeamExtendedStats", "app.team_stats.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return stats, nil
+}
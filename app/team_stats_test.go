@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestRollupTeamStats(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	yesterday := startOfTodayUTC() - model.TeamStatsDayMillis
+	_, err := th.App.Srv().Store.Post().Save(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser.Id,
+		Message:   "yesterday",
+		CreateAt:  yesterday + 1,
+	})
+	require.NoError(t, err)
+
+	// Start from the day before yesterday so that only yesterday is rolled up.
+	require.NoError(t, th.App.Srv().Store.System().SaveOrUpdate(&model.System{
+		Name:  model.SystemTeamStatsLastRollupDay,
+		Value: strconv.FormatInt(yesterday-model.TeamStatsDayMillis, 10),
+	}))
+
+	require.Nil(t, th.App.RollupTeamStats())
+
+	lastRollup, err := th.App.Srv().Store.System().GetByName(model.SystemTeamStatsLastRollupDay)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(yesterday, 10), lastRollup.Value)
+
+	stats, appErr := th.App.GetTeamExtendedStats(th.BasicTeam.Id, 1)
+	require.Nil(t, appErr)
+	require.Len(t, stats.DailyStats, 1)
+	assert.Equal(t, int64(1), stats.DailyStats[0].PostCount)
+
+	// Today isn't over yet, so running again has nothing left to roll up.
+	require.Nil(t, th.App.RollupTeamStats())
+	lastRollup, err = th.App.Srv().Store.System().GetByName(model.SystemTeamStatsLastRollupDay)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(yesterday, 10), lastRollup.Value)
+}
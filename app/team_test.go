@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"sort"
 	"strings"
 	"testing"
@@ -1260,11 +1261,12 @@ func TestInviteNewUsersToTeamGracefully(t *testing.T) {
 			[]string{"idontexist@mattermost.com"},
 			"",
 			mock.Anything,
+			mock.Anything,
 			true,
 		).Once().Return(nil)
 		th.App.Srv().EmailService = &emailServiceMock
 
-		res, err := th.App.InviteNewUsersToTeamGracefully([]string{"idontexist@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "")
+		res, err := th.App.InviteNewUsersToTeamGracefully([]string{"idontexist@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "", "")
 		require.Nil(t, err)
 		require.Len(t, res, 1)
 		require.Nil(t, res[0].Error)
@@ -1279,15 +1281,93 @@ func TestInviteNewUsersToTeamGracefully(t *testing.T) {
 			[]string{"idontexist@mattermost.com"},
 			"",
 			mock.Anything,
+			mock.Anything,
 			true,
 		).Once().Return(email.SendMailError)
 		th.App.Srv().EmailService = &emailServiceMock
 
-		res, err := th.App.InviteNewUsersToTeamGracefully([]string{"idontexist@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "")
+		res, err := th.App.InviteNewUsersToTeamGracefully([]string{"idontexist@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "", "")
 		require.Nil(t, err)
 		require.Len(t, res, 1)
 		require.NotNil(t, res[0].Error)
 	})
+
+	t.Run("it should pass the locale of the invitations along with the sender's", func(t *testing.T) {
+		emailServiceMock := emailmocks.ServiceInterface{}
+		emailServiceMock.On("SendInviteEmails",
+			mock.AnythingOfType("*model.Team"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			[]string{"idontexist@mattermost.com"},
+			"",
+			&email.InviteLocales{Locale: "fr", SenderLocale: th.BasicUser.Locale},
+			mock.Anything,
+			true,
+		).Once().Return(nil)
+		th.App.Srv().EmailService = &emailServiceMock
+
+		res, err := th.App.InviteNewUsersToTeamGracefully([]string{"idontexist@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "", "fr")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		require.Nil(t, res[0].Error)
+		emailServiceMock.AssertExpectations(t)
+	})
+}
+
+func TestTeamInviteBudget(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEmailInvitations = true
+		*cfg.TeamSettings.MaxInvitesPerTeamPerDay = 2
+	})
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("SendInviteEmails",
+		mock.AnythingOfType("*model.Team"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("[]string"),
+		"",
+		mock.Anything,
+		mock.Anything,
+		mock.AnythingOfType("bool"),
+	).Return(nil)
+	th.App.Srv().EmailService = &emailServiceMock
+
+	appErr := th.App.InviteNewUsersToTeam([]string{"one@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "")
+	require.Nil(t, appErr)
+
+	budget, appErr := th.App.GetTeamInviteBudget(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(2), budget.DailyLimit)
+	assert.Equal(t, int64(1), budget.Used)
+	assert.Equal(t, int64(1), budget.Remaining)
+
+	_, appErr = th.App.InviteNewUsersToTeamGracefully([]string{"two@mattermost.com", "three@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "", "")
+	require.NotNil(t, appErr)
+	assert.Equal(t, TeamInviteBudgetExceededError, appErr.Id)
+	assert.Equal(t, http.StatusTooManyRequests, appErr.StatusCode)
+
+	budget, appErr = th.App.GrantTeamInviteBudget(th.BasicTeam.Id, &model.TeamInviteBudgetGrant{ExtraInvites: 5})
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(6), budget.Remaining)
+
+	res, appErr := th.App.InviteNewUsersToTeamGracefully([]string{"two@mattermost.com", "three@mattermost.com"}, th.BasicTeam.Id, th.BasicUser.Id, "", "")
+	require.Nil(t, appErr)
+	require.Len(t, res, 2)
+
+	budget, appErr = th.App.GetTeamInviteBudget(th.BasicTeam.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(3), budget.Used)
+	assert.Equal(t, int64(4), budget.Remaining)
+
+	_, appErr = th.App.GetTeamInviteBudget(model.NewId())
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.GrantTeamInviteBudget(model.NewId(), &model.TeamInviteBudgetGrant{ExtraInvites: 5})
+	require.NotNil(t, appErr)
 }
 
 func TestInviteGuestsToChannelsGracefully(t *testing.T) {
@@ -18,3 +18,12 @@ type DomainError struct {
 func (DomainError) Error() string {
 	return "restricting team to the domain, it is not allowed by the system config"
 }
+
+type InvalidDomainError struct {
+	Domain string
+	Reason string
+}
+
+func (e InvalidDomainError) Error() string {
+	return "invalid allowed domain " + e.Domain + ": " + e.Reason
+}
@@ -11,6 +11,10 @@ import (
 )
 
 func (ts *TeamService) CreateTeam(team *model.Team) (*model.Team, error) {
+	if err := checkAllowedDomainsFormat("", team.AllowedDomains); err != nil {
+		return nil, err
+	}
+
 	team.InviteId = ""
 	rteam, err := ts.store.Save(team)
 	if err != nil {
@@ -72,6 +76,10 @@ func (ts *TeamService) UpdateTeam(team *model.Team, opts UpdateOptions) (*model.
 			return nil, err
 		}
 
+		if err = checkAllowedDomainsFormat(oldTeam.AllowedDomains, team.AllowedDomains); err != nil {
+			return nil, err
+		}
+
 		if err = ts.checkValidDomains(team); err != nil {
 			return nil, err
 		}
@@ -85,6 +93,7 @@ func (ts *TeamService) UpdateTeam(team *model.Team, opts UpdateOptions) (*model.
 		oldTeam.AllowedDomains = team.AllowedDomains
 		oldTeam.LastTeamIconUpdate = team.LastTeamIconUpdate
 		oldTeam.GroupConstrained = team.GroupConstrained
+		oldTeam.InheritParentMembership = team.InheritParentMembership
 	}
 
 	oldTeam, err = ts.store.Update(oldTeam)
@@ -101,11 +110,16 @@ func (ts *TeamService) PatchTeam(teamID string, patch *model.TeamPatch) (*model.
 		return nil, err
 	}
 
+	oldDomains := team.AllowedDomains
 	team.Patch(patch)
 	if patch.AllowOpenInvite != nil && !*patch.AllowOpenInvite {
 		team.InviteId = model.NewId()
 	}
 
+	if err = checkAllowedDomainsFormat(oldDomains, team.AllowedDomains); err != nil {
+		return nil, err
+	}
+
 	if err = ts.checkValidDomains(team); err != nil {
 		return nil, err
 	}
@@ -118,11 +132,23 @@ func (ts *TeamService) PatchTeam(teamID string, patch *model.TeamPatch) (*model.
 	return team, nil
 }
 
+// JoinOptions changes how a user joins a team.
+type JoinOptions struct {
+	// Creator makes the user an admin of the team, the team's email address not being tied to
+	// its admins.
+	Creator bool
+}
+
 // JoinUserToTeam adds a user to the team and it returns three values:
 // 1. a pointer to the team member, if successful
 // 2. a boolean: true if the user has a non-deleted team member for that team already, otherwise false.
 // 3. a pointer to an AppError if something went wrong.
 func (ts *TeamService) JoinUserToTeam(team *model.Team, user *model.User) (*model.TeamMember, bool, error) {
+	return ts.JoinUserToTeamWithOptions(team, user, JoinOptions{})
+}
+
+// JoinUserToTeamWithOptions is JoinUserToTeam with options.
+func (ts *TeamService) JoinUserToTeamWithOptions(team *model.Team, user *model.User, opts JoinOptions) (*model.TeamMember, bool, error) {
 	if !ts.IsTeamEmailAllowed(user, team) {
 		return nil, false, AcceptedDomainError
 	}
@@ -142,7 +168,7 @@ func (ts *TeamService) JoinUserToTeam(team *model.Team, user *model.User) (*mode
 		tm.SchemeAdmin = userShouldBeAdmin
 	}
 
-	if team.Email == user.Email {
+	if opts.Creator {
 		tm.SchemeAdmin = true
 	}
 
@@ -128,3 +128,13 @@ func TestJoinUserToTeam(t *testing.T) {
 		require.Error(t, err, "Should fail")
 	})
 }
+
+func TestIsEmailAddressAllowed(t *testing.T) {
+	allowedDomains := []string{"@Example.com, *.eng.example.org", ""}
+
+	require.True(t, IsEmailAddressAllowed("user@example.com", allowedDomains))
+	require.True(t, IsEmailAddressAllowed("user@web.eng.example.org", allowedDomains))
+	require.False(t, IsEmailAddressAllowed("user@eng.example.org", allowedDomains))
+	require.False(t, IsEmailAddressAllowed("user@sub.example.com", allowedDomains))
+	require.False(t, IsEmailAddressAllowed("user@example.com", append(allowedDomains, "example.org")))
+}
@@ -35,13 +35,13 @@ func (ts *TeamService) DefaultChannelNames() []string {
 
 func IsEmailAddressAllowed(email string, allowedDomains []string) bool {
 	for _, restriction := range allowedDomains {
-		domains := normalizeDomains(restriction)
+		domains := model.AllowedDomainsList(restriction)
 		if len(domains) <= 0 {
 			continue
 		}
 		matched := false
 		for _, d := range domains {
-			if strings.HasSuffix(email, "@"+d) {
+			if model.AllowedDomainMatchesEmail(d, email) {
 				matched = true
 				break
 			}
@@ -71,13 +71,30 @@ func (ts *TeamService) GetAllowedDomains(user *model.User, team *model.Team) []s
 	return []string{team.AllowedDomains, *ts.config().TeamSettings.RestrictCreationToDomains}
 }
 
+// checkAllowedDomainsFormat returns an error for the first allowed domain that can't be parsed.
+// Unchanged domains aren't checked, not to prevent updating teams whose domains were saved before
+// they were validated.
+func checkAllowedDomainsFormat(oldDomains, newDomains string) error {
+	if oldDomains != "" && model.CanonicalAllowedDomains(oldDomains) == model.CanonicalAllowedDomains(newDomains) {
+		return nil
+	}
+
+	for _, result := range model.ValidateAllowedDomains(newDomains).Results {
+		if !result.Valid {
+			return &InvalidDomainError{Domain: result.Input, Reason: result.Error}
+		}
+	}
+
+	return nil
+}
+
 func (ts *TeamService) checkValidDomains(team *model.Team) error {
-	validDomains := normalizeDomains(*ts.config().TeamSettings.RestrictCreationToDomains)
+	validDomains := model.AllowedDomainsList(*ts.config().TeamSettings.RestrictCreationToDomains)
 	if len(validDomains) > 0 {
-		for _, domain := range normalizeDomains(team.AllowedDomains) {
+		for _, domain := range team.GetAllowedDomains() {
 			matched := false
 			for _, d := range validDomains {
-				if domain == d {
+				if model.AllowedDomainCovers(d, domain) {
 					matched = true
 					break
 				}
@@ -91,12 +108,6 @@ func (ts *TeamService) checkValidDomains(team *model.Team) error {
 	return nil
 }
 
-func normalizeDomains(domains string) []string {
-	// commas and @ signs are optional
-	// can be in the form of "@corp.mattermost.com, mattermost.com mattermost.org" -> corp.mattermost.com mattermost.com mattermost.org
-	return strings.Fields(strings.TrimSpace(strings.ToLower(strings.Replace(strings.Replace(domains, "@", " ", -1), ",", " ", -1))))
-}
-
 // UserIsInAdminRoleGroup returns true at least one of the user's groups are configured to set the members as
 // admins in the given syncable.
 func (ts *TeamService) userIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, error) {
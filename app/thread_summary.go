@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// GetThreadSummary returns the participants, the reply counts over time and the most recent
+// replies of the thread. The participants come with their sanitized profile and the URL of
+// their profile image.
+func (a *App) GetThreadSummary(threadID string, opts model.GetThreadSummaryOpts, asAdmin bool) (*model.ThreadSummary, *model.AppError) {
+	summary, err := a.Srv().Store.Thread().GetSummary(threadID, opts.BucketSize(), opts.Replies)
+	if err != nil {
+		return nil, model.NewAppError("GetThreadSummary", "app.thread.get_summary.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if len(summary.Participants) == 0 {
+		return summary, nil
+	}
+
+	userIDs := make([]string, 0, len(summary.Participants))
+	for _, participant := range summary.Participants {
+		userIDs = append(userIDs, participant.UserId)
+	}
+	users, appErr := a.GetUsersByIds(userIDs, &store.UserGetByIdsOpts{IsAdmin: asAdmin})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	usersByID := make(map[string]*model.User, len(users))
+	for _, user := range users {
+		usersByID[user.Id] = user
+	}
+	for _, participant := range summary.Participants {
+		user, ok := usersByID[participant.UserId]
+		if !ok {
+			continue
+		}
+		participant.User = user
+		participant.AvatarURL = fmt.Sprintf("%s/users/%s/image?_=%d", model.APIURLSuffix, user.Id, user.LastPictureUpdate)
+	}
+
+	return summary, nil
+}
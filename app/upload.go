@@ -21,10 +21,12 @@ import (
 
 const minFirstPartSize = 5 * 1024 * 1024 // 5MB
 
-func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.Reader) *model.AppError {
+// runPluginsHook lets the plugins reject the file just uploaded or replace its content, returning
+// whether the content was replaced.
+func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.Reader) (bool, *model.AppError) {
 	pluginsEnvironment := a.GetPluginsEnvironment()
 	if pluginsEnvironment == nil {
-		return nil
+		return false, nil
 	}
 
 	filePath := info.Path
@@ -62,7 +64,7 @@ func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.R
 
 	// If the plugin hook has not run we can return early.
 	if _, ok := <-hookHasRunCh; !ok {
-		return nil
+		return false, nil
 	}
 
 	tmpPath := filePath + ".tmp"
@@ -71,7 +73,7 @@ func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.R
 		if fileErr := a.RemoveFile(tmpPath); fileErr != nil {
 			mlog.Warn("Failed to remove file", mlog.Err(fileErr))
 		}
-		return err
+		return false, err
 	}
 
 	if err = <-errChan; err != nil {
@@ -81,13 +83,13 @@ func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.R
 		if fileErr := a.RemoveFile(tmpPath); fileErr != nil {
 			mlog.Warn("Failed to remove file", mlog.Err(fileErr))
 		}
-		return err
+		return false, err
 	}
 
 	if written > 0 {
 		info.Size = written
 		if fileErr := a.MoveFile(tmpPath, info.Path); fileErr != nil {
-			return model.NewAppError("runPluginsHook", "app.upload.run_plugins_hook.move_fail",
+			return false, model.NewAppError("runPluginsHook", "app.upload.run_plugins_hook.move_fail",
 				nil, fileErr.Error(), http.StatusInternalServerError)
 		}
 	} else {
@@ -96,7 +98,7 @@ func (a *App) runPluginsHook(c *request.Context, info *model.FileInfo, file io.R
 		}
 	}
 
-	return nil
+	return written > 0, nil
 }
 
 func (a *App) CreateUploadSession(us *model.UploadSession) (*model.UploadSession, *model.AppError) {
@@ -117,6 +119,13 @@ func (a *App) CreateUploadSession(us *model.UploadSession) (*model.UploadSession
 		return nil, err
 	}
 
+	// Uploads relayed from remote clusters aren't counted against the local users' quotas.
+	if us.Type == model.UploadTypeAttachment && us.RemoteId == "" {
+		if err := a.checkUploadQuota(us.UserId, us.FileSize); err != nil {
+			return nil, err
+		}
+	}
+
 	if us.Type == model.UploadTypeAttachment {
 		channel, err := a.GetChannel(us.ChannelId)
 		if err != nil {
@@ -258,7 +267,7 @@ func (a *App) UploadData(c *request.Context, us *model.UploadSession, rd io.Read
 	}
 
 	// run plugins upload hook
-	if err := a.runPluginsHook(c, info, file); err != nil {
+	if _, err := a.runPluginsHook(c, info, file); err != nil {
 		return nil, err
 	}
 
@@ -285,6 +294,11 @@ func (a *App) UploadData(c *request.Context, us *model.UploadSession, rd io.Read
 		}
 	}
 
+	// Upload sessions are written in parts, so the file is only hashed once complete.
+	if us.Type == model.UploadTypeAttachment {
+		a.hashUploadedFile(info, "")
+	}
+
 	var storeErr error
 	if info, storeErr = a.Srv().Store.FileInfo().Save(info); storeErr != nil {
 		var appErr *model.AppError
@@ -296,16 +310,16 @@ func (a *App) UploadData(c *request.Context, us *model.UploadSession, rd io.Read
 		}
 	}
 
-	if *a.Config().FileSettings.ExtractContent {
-		infoCopy := *info
-		a.Srv().Go(func() {
-			err := a.ExtractContentFromFileInfo(&infoCopy)
-			if err != nil {
-				mlog.Error("Failed to extract file content", mlog.Err(err), mlog.String("fileInfoId", infoCopy.Id))
-			}
-		})
+	if us.Type == model.UploadTypeAttachment {
+		a.deduplicateUploadedFile(info)
 	}
 
+	if us.Type == model.UploadTypeAttachment && us.RemoteId == "" {
+		a.recordUpload(us.UserId, us.FileSize)
+	}
+
+	a.extractContentInBackground(info)
+
 	// delete upload session
 	if storeErr := a.Srv().Store.UploadSession().Delete(us.Id); storeErr != nil {
 		mlog.Warn("Failed to delete UploadSession", mlog.Err(storeErr))
@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// uploadQuotasEnabled reports whether any upload quota is configured, so uploads can skip
+// looking up the usage of their user otherwise.
+func (a *App) uploadQuotasEnabled() bool {
+	settings := a.Config().FileSettings
+	return *settings.UserDailyUploadQuotaBytes > 0 || *settings.UserTotalUploadQuotaBytes > 0 || len(settings.UploadQuotaRoleOverrides) > 0
+}
+
+// uploadQuotasForUser returns the daily and total upload quotas of the given user, in bytes,
+// zero being unlimited. When overrides are configured for several of the user's roles, the
+// most generous one applies.
+func (a *App) uploadQuotasForUser(user *model.User) (daily, total int64) {
+	settings := a.Config().FileSettings
+	daily, total = *settings.UserDailyUploadQuotaBytes, *settings.UserTotalUploadQuotaBytes
+
+	overridden := false
+	for _, role := range user.GetRoles() {
+		quota, ok := settings.UploadQuotaRoleOverrides[role]
+		if !ok || quota == nil {
+			continue
+		}
+		if !overridden {
+			daily, total = quota.DailyBytes, quota.TotalBytes
+			overridden = true
+			continue
+		}
+		daily = moreGenerousUploadQuota(daily, quota.DailyBytes)
+		total = moreGenerousUploadQuota(total, quota.TotalBytes)
+	}
+
+	return daily, total
+}
+
+func moreGenerousUploadQuota(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// GetUserLimits returns the limits applying to the given user, along with how much of them is
+// used.
+func (a *App) GetUserLimits(userID string) (*model.UserLimits, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	uploads, appErr := a.getUploadLimits(user)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return &model.UserLimits{Uploads: uploads}, nil
+}
+
+func (a *App) getUploadLimits(user *model.User) (*model.UploadLimits, *model.AppError) {
+	dailyQuota, totalQuota := a.uploadQuotasForUser(user)
+
+	dailyUsed, totalUsed, err := a.Srv().Store.UploadUsage().GetForUser(user.Id, model.UploadUsageDay(model.GetMillis()))
+	if err != nil {
+		return nil, model.NewAppError("getUploadLimits", "app.upload_usage.get_for_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	return model.NewUploadLimits(dailyQuota, dailyUsed, totalQuota, totalUsed), nil
+}
+
+// checkUploadQuota returns an error when uploading the given number of bytes would exceed one
+// of the upload quotas of the given user.
+func (a *App) checkUploadQuota(userID string, size int64) *model.AppError {
+	if !a.uploadQuotasEnabled() {
+		return nil
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	limits, appErr := a.getUploadLimits(user)
+	if appErr != nil {
+		return appErr
+	}
+
+	if limits.DailyQuotaBytes > 0 && limits.DailyUsedBytes+size > limits.DailyQuotaBytes {
+		return model.NewAppError("checkUploadQuota", "app.upload_quota.daily_exceeded.app_error",
+			map[string]interface{}{"Size": size, "Quota": limits.DailyQuotaBytes, "Remaining": limits.DailyRemainingBytes},
+			"", http.StatusRequestEntityTooLarge)
+	}
+
+	if limits.TotalQuotaBytes > 0 && limits.TotalUsedBytes+size > limits.TotalQuotaBytes {
+		return model.NewAppError("checkUploadQuota", "app.upload_quota.total_exceeded.app_error",
+			map[string]interface{}{"Size": size, "Quota": limits.TotalQuotaBytes, "Remaining": limits.TotalRemainingBytes},
+			"", http.StatusRequestEntityTooLarge)
+	}
+
+	return nil
+}
+
+// recordUpload adds the given number of bytes to the upload usage of the given user. Usage is
+// recorded even when no quota is configured, so enabling one accounts for earlier uploads.
+func (a *App) recordUpload(userID string, size int64) {
+	if !model.IsValidId(userID) || size <= 0 {
+		return
+	}
+
+	usage := &model.UploadUsage{
+		UserId: userID,
+		Day:    model.UploadUsageDay(model.GetMillis()),
+		Bytes:  size,
+	}
+	if err := a.Srv().Store.UploadUsage().Increment(usage); err != nil {
+		mlog.Warn("Failed to record upload usage", mlog.String("user_id", userID), mlog.Err(err))
+	}
+}
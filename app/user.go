@@ -292,6 +292,8 @@ func (a *App) createUserOrGuest(c *request.Context, user *model.User, guest bool
 		})
 	}
 
+	a.publishUserCreatedEvent(ruser)
+
 	return ruser, nil
 }
 
@@ -621,6 +623,26 @@ func (a *App) GetUsersByIds(userIDs []string, options *store.UserGetByIdsOpts) (
 	return users, nil
 }
 
+// GetUserFieldsByIds returns the fields of the users along with their ids, by their JSON name. The
+// fields hidden by the privacy settings are neither fetched nor returned.
+func (a *App) GetUserFieldsByIds(userIDs []string, fields []string, options *store.UserGetByIdsOpts) ([]map[string]interface{}, *model.AppError) {
+	fields = model.SanitizeUserFields(fields, a.GetSanitizeOptions(options.IsAdmin))
+
+	fieldsOptions := *options
+	fieldsOptions.Fields = fields
+	users, appErr := a.GetUsersByIds(userIDs, &fieldsOptions)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	profiles := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		profiles = append(profiles, user.SelectFields(fields))
+	}
+
+	return profiles, nil
+}
+
 func (a *App) GetUsersByGroupChannelIds(c *request.Context, channelIDs []string, asAdmin bool) (map[string][]*model.User, *model.AppError) {
 	usersByChannelId, err := a.Srv().Store.User().GetProfileByGroupChannelIdsForUser(c.Session().UserId, channelIDs)
 	if err != nil {
@@ -1560,37 +1582,26 @@ func (a *App) PermanentDeleteUser(c *request.Context, user *model.User) *model.A
 		mlog.Warn("Error getting file list for user from FileInfoStore", mlog.Err(err))
 	}
 
-	for _, info := range infos {
-		res, err := a.FileExists(info.Path)
-		if err != nil {
-			mlog.Warn(
-				"Error checking existence of file",
-				mlog.String("path", info.Path),
-				mlog.Err(err),
-			)
-			continue
-		}
+	if _, err := a.Srv().Store.FileInfo().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.file_info.permanent_delete_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
 
-		if !res {
-			mlog.Warn("File not found", mlog.String("path", info.Path))
+	// The stored files are removed once their FileInfos are, since deduplicated files share them.
+	for _, info := range infos {
+		// External files are only referenced, their objects aren't ours to remove.
+		if info.IsExternal() {
 			continue
 		}
 
-		err = a.RemoveFile(info.Path)
-
-		if err != nil {
+		if _, appErr := a.removeUnreferencedFile(info.Path); appErr != nil {
 			mlog.Warn(
 				"Unable to remove file",
 				mlog.String("path", info.Path),
-				mlog.Err(err),
+				mlog.Err(appErr),
 			)
 		}
 	}
 
-	if _, err := a.Srv().Store.FileInfo().PermanentDeleteByUser(user.Id); err != nil {
-		return model.NewAppError("PermanentDeleteUser", "app.file_info.permanent_delete_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
-	}
-
 	if err := a.Srv().Store.User().PermanentDelete(user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.user.permanent_delete.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -1599,6 +1610,18 @@ func (a *App) PermanentDeleteUser(c *request.Context, user *model.User) *model.A
 		return model.NewAppError("PermanentDeleteUser", "app.audit.permanent_delete_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
 
+	if err := a.Srv().Store.ActivityEvent().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.activity_event.permanent_delete_by_user.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.OutstandingMention().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.outstanding_mention.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	if err := a.Srv().Store.BotState().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.bot_state.delete.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
 	if err := a.Srv().Store.Team().RemoveAllMembersByUser(user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.team.remove_member.app_error", nil, err.Error(), http.StatusInternalServerError)
 	}
@@ -2071,6 +2094,14 @@ func (a *App) userBelongsToChannels(userID string, channelIDs []string) (bool, *
 }
 
 func (a *App) GetViewUsersRestrictions(userID string) (*model.ViewUsersRestrictions, *model.AppError) {
+	isolated, appErr := a.IsUserStrictlyIsolated(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if isolated {
+		return a.getStrictIsolationRestrictions(userID)
+	}
+
 	if a.HasPermissionTo(userID, model.PermissionViewMembers) {
 		return nil, nil
 	}
@@ -2493,6 +2524,15 @@ func (a *App) GetUsersWithInvalidEmails(page int, perPage int) ([]*model.User, *
 	return users, nil
 }
 
+// GetProfileImageURL returns a signed URL for the profile image uploaded by the user, or an empty
+// string if signed URLs are not available or the user has the default generated image.
+func (a *App) GetProfileImageURL(user *model.User) (string, *model.AppError) {
+	if user.LastPictureUpdate <= 0 {
+		return "", nil
+	}
+	return a.GetSignedFileURL(getProfileImagePath(user.Id))
+}
+
 func getProfileImagePath(userID string) string {
 	return filepath.Join("users", userID, "profile.png")
 }
@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+const (
+	userIsolationCacheSize   = 20000
+	userIsolationCacheName   = "UserIsolation"
+	userIsolationCacheExpiry = 1 * time.Minute
+)
+
+// initUserIsolation forgets the isolation of the users when the setting of the server changes.
+func (s *Server) initUserIsolation() {
+	s.AddConfigListener(func(before, after *model.Config) {
+		if *before.TeamSettings.StrictUserIsolation != *after.TeamSettings.StrictUserIsolation {
+			s.userIsolationCache.Purge()
+		}
+	})
+}
+
+// IsUserStrictlyIsolated tells whether the user can only see the users they share a team with,
+// which is the case as soon as one of their teams is strictly isolated. A user without a team
+// follows the setting of the server, and system admins are never isolated. Since it's checked
+// for every status sent to the user, it's cached until their teams, their roles or the
+// isolation of a team change.
+func (a *App) IsUserStrictlyIsolated(userID string) (bool, *model.AppError) {
+	var isolated bool
+	if err := a.Srv().userIsolationCache.Get(userID, &isolated); err == nil {
+		return isolated, nil
+	}
+
+	serverIsolation := *a.Config().TeamSettings.StrictUserIsolation
+
+	teams, err := a.Srv().Store.Team().GetTeamsByUserId(userID)
+	if err != nil {
+		return false, model.NewAppError("IsUserStrictlyIsolated", "app.team.get_all.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	isolated = len(teams) == 0 && serverIsolation
+	for _, team := range teams {
+		if team.IsStrictlyIsolated(serverIsolation) {
+			isolated = true
+			break
+		}
+	}
+
+	if isolated && a.HasPermissionTo(userID, model.PermissionManageSystem) {
+		isolated = false
+	}
+
+	a.Srv().userIsolationCache.SetWithDefaultExpiry(userID, isolated)
+	return isolated, nil
+}
+
+// getStrictIsolationRestrictions restricts an isolated user to the members of their teams,
+// leaving out the other members of the channels they belong to.
+func (a *App) getStrictIsolationRestrictions(userID string) (*model.ViewUsersRestrictions, *model.AppError) {
+	teamIDs, err := a.Srv().Store.Team().GetUserTeamIds(userID, true)
+	if err != nil {
+		return nil, model.NewAppError("getStrictIsolationRestrictions", "app.team.get_user_team_ids.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	teamIDsWithPermission := []string{}
+	for _, teamID := range teamIDs {
+		if a.HasPermissionToTeam(userID, teamID, model.PermissionViewMembers) {
+			teamIDsWithPermission = append(teamIDsWithPermission, teamID)
+		}
+	}
+
+	return &model.ViewUsersRestrictions{Teams: teamIDsWithPermission, Channels: []string{}}, nil
+}
+
+// FilterUserIDsForIsolation returns the users the user can see among userIDs when they are
+// strictly isolated, and userIDs unchanged otherwise.
+func (a *App) FilterUserIDsForIsolation(userID string, userIDs []string) ([]string, *model.AppError) {
+	isolated, appErr := a.IsUserStrictlyIsolated(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !isolated || len(userIDs) == 0 {
+		return userIDs, nil
+	}
+
+	restrictions, appErr := a.getStrictIsolationRestrictions(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	users, err := a.Srv().Store.User().GetProfileByIds(context.Background(), userIDs, &store.UserGetByIdsOpts{ViewRestrictions: restrictions}, true)
+	if err != nil {
+		return nil, model.NewAppError("FilterUserIDsForIsolation", "app.user.get_profiles.app_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+
+	visibleIDs := make(map[string]bool, len(users))
+	for _, user := range users {
+		visibleIDs[user.Id] = true
+	}
+
+	visible := []string{}
+	for _, id := range userIDs {
+		if id == userID || visibleIDs[id] {
+			visible = append(visible, id)
+		}
+	}
+
+	return visible, nil
+}
+
+// SetTeamStrictIsolation overrides the strict user isolation of the server for the team, or
+// makes the team follow it again when isolation is nil.
+func (a *App) SetTeamStrictIsolation(teamID string, isolation *bool) (*model.Team, *model.AppError) {
+	team, appErr := a.GetTeam(teamID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	team.StrictIsolation = isolation
+	team, err := a.Srv().Store.Team().Update(team)
+	if err != nil {
+		var invErr *store.ErrInvalidInput
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &invErr):
+			return nil, model.NewAppError("SetTeamStrictIsolation", "app.team.update.find.app_error", nil, invErr.Error(), http.StatusBadRequest)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SetTeamStrictIsolation", "app.team.update.updating.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	a.Srv().userIsolationCache.Purge()
+	a.sendTeamEvent(team, model.WebsocketEventUpdateTeam)
+
+	return team, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestStrictUserIsolation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	otherTeam := th.CreateTeam()
+	outsider := th.CreateUser()
+	th.LinkUserToTeam(outsider, otherTeam)
+	teamless := th.CreateUser()
+
+	isolated, appErr := th.App.IsUserStrictlyIsolated(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.False(t, isolated)
+
+	canSee, appErr := th.App.UserCanSeeOtherUser(th.BasicUser.Id, outsider.Id)
+	require.Nil(t, appErr)
+	assert.True(t, canSee)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = false })
+
+	t.Run("isolated by the server", func(t *testing.T) {
+		isolated, appErr := th.App.IsUserStrictlyIsolated(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.True(t, isolated)
+
+		isolated, appErr = th.App.IsUserStrictlyIsolated(teamless.Id)
+		require.Nil(t, appErr)
+		assert.True(t, isolated)
+
+		isolated, appErr = th.App.IsUserStrictlyIsolated(th.SystemAdminUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, isolated)
+
+		canSee, appErr := th.App.UserCanSeeOtherUser(th.BasicUser.Id, outsider.Id)
+		require.Nil(t, appErr)
+		assert.False(t, canSee)
+
+		canSee, appErr = th.App.UserCanSeeOtherUser(th.BasicUser.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.True(t, canSee)
+
+		userIDs, appErr := th.App.FilterUserIDsForIsolation(th.BasicUser.Id, []string{th.BasicUser.Id, th.BasicUser2.Id, outsider.Id, teamless.Id})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicUser.Id, th.BasicUser2.Id}, userIDs)
+
+		userIDs, appErr = th.App.FilterUserIDsForIsolation(teamless.Id, []string{teamless.Id, th.BasicUser.Id})
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{teamless.Id}, userIDs)
+	})
+
+	t.Run("overridden by the team", func(t *testing.T) {
+		_, appErr := th.App.SetTeamStrictIsolation(th.BasicTeam.Id, model.NewBool(false))
+		require.Nil(t, appErr)
+		defer th.App.SetTeamStrictIsolation(th.BasicTeam.Id, nil)
+
+		isolated, appErr := th.App.IsUserStrictlyIsolated(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, isolated)
+
+		// The outsider remains isolated by the setting of the server in their team.
+		canSee, appErr := th.App.UserCanSeeOtherUser(outsider.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, canSee)
+
+		th.LinkUserToTeam(outsider, th.BasicTeam)
+		isolated, appErr = th.App.IsUserStrictlyIsolated(outsider.Id)
+		require.Nil(t, appErr)
+		assert.True(t, isolated, "one isolated team is enough to isolate the user")
+
+		canSee, appErr = th.App.UserCanSeeOtherUser(outsider.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.True(t, canSee)
+	})
+}
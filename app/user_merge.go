@@ -0,0 +1,189 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// MergeUsers starts a job moving the posts, files, memberships, preferences and sessions of
+// a duplicate account into the primary one. The returned merge records what the job moved.
+func (a *App) MergeUsers(requesterID string, mergeRequest *model.UserMergeRequest) (*model.UserMerge, *model.AppError) {
+	if mergeRequest.PrimaryUserId == mergeRequest.DuplicateUserId {
+		return nil, model.NewAppError("MergeUsers", "app.user_merge.same_user.app_error", nil, "user_id="+mergeRequest.PrimaryUserId, http.StatusBadRequest)
+	}
+
+	primary, appErr := a.GetUser(mergeRequest.PrimaryUserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	duplicate, appErr := a.GetUser(mergeRequest.DuplicateUserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if primary.IsBot || duplicate.IsBot {
+		return nil, model.NewAppError("MergeUsers", "app.user_merge.bot.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if primary.DeleteAt != 0 {
+		return nil, model.NewAppError("MergeUsers", "app.user_merge.primary_inactive.app_error", nil, "user_id="+primary.Id, http.StatusBadRequest)
+	}
+
+	merge, err := a.Srv().Store.UserMerge().Save(&model.UserMerge{
+		PrimaryUserId:   primary.Id,
+		DuplicateUserId: duplicate.Id,
+		RequesterId:     requesterID,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("MergeUsers", "app.user_merge.save.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	job, appErr := a.Srv().Jobs.CreateJob(model.JobTypeUserMerge, map[string]string{
+		"user_merge_id": merge.Id,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+	// The job saves its id on the merge when it starts, so that the merge isn't written
+	// concurrently by the job and by this request.
+	merge.JobId = job.Id
+
+	return merge, nil
+}
+
+func (a *App) GetUserMerge(mergeID string) (*model.UserMerge, *model.AppError) {
+	merge, err := a.Srv().Store.UserMerge().Get(mergeID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetUserMerge", "app.user_merge.get.not_found.app_error", nil, nfErr.Error(), http.StatusNotFound)
+		default:
+			return nil, model.NewAppError("GetUserMerge", "app.user_merge.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	return merge, nil
+}
+
+func (a *App) updateUserMerge(merge *model.UserMerge) *model.AppError {
+	if _, err := a.Srv().Store.UserMerge().Update(merge); err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("updateUserMerge", "app.user_merge.update.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+// ProcessUserMerge moves the content of the duplicate account of a merge into the primary one,
+// one table per transaction. The manifest is saved in the transaction of every table, so that a
+// merge which failed half way can be either resumed by running the job again or reverted.
+func (a *App) ProcessUserMerge(job *model.Job) *model.AppError {
+	merge, appErr := a.GetUserMerge(job.Data["user_merge_id"])
+	if appErr != nil {
+		return appErr
+	}
+
+	if merge.Status != model.UserMergeStatusPending && merge.Status != model.UserMergeStatusInProgress {
+		return model.NewAppError("ProcessUserMerge", "app.user_merge.process.not_pending.app_error", nil, "user_merge_id="+merge.Id, http.StatusBadRequest)
+	}
+
+	merge.JobId = job.Id
+	merge.Status = model.UserMergeStatusInProgress
+	if appErr := a.updateUserMerge(merge); appErr != nil {
+		return appErr
+	}
+
+	defer a.invalidateCacheForUserMerge(merge)
+
+	for i, table := range model.UserMergeTables {
+		if _, err := a.Srv().Store.UserMerge().MergeRows(merge, table); err != nil {
+			merge.Status = model.UserMergeStatusError
+			if appErr := a.updateUserMerge(merge); appErr != nil {
+				mlog.Warn("Failed to save the status of a failed user merge", mlog.String("user_merge_id", merge.Id), mlog.Err(appErr))
+			}
+			return model.NewAppError("ProcessUserMerge", "app.user_merge.process.app_error", nil, "table="+table+", "+err.Error(), http.StatusInternalServerError)
+		}
+
+		// Sessions are cached by token, and would keep resolving to the duplicate user until
+		// the end of the merge otherwise.
+		if table == model.UserMergeTableSessions {
+			a.clearSessionCacheForUserMerge(merge)
+		}
+
+		if appErr := a.Srv().Jobs.SetJobProgress(job, int64((i+1)*100/len(model.UserMergeTables))); appErr != nil {
+			mlog.Warn("Failed to set the progress of a user merge job", mlog.String("job_id", job.Id), mlog.Err(appErr))
+		}
+	}
+
+	merge.Status = model.UserMergeStatusSuccess
+	return a.updateUserMerge(merge)
+}
+
+// RevertUserMerge moves the rows listed in the manifest of a finished merge back to the
+// duplicate account.
+func (a *App) RevertUserMerge(mergeID string) (*model.UserMerge, *model.AppError) {
+	merge, appErr := a.GetUserMerge(mergeID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !merge.IsFinished() {
+		return nil, model.NewAppError("RevertUserMerge", "app.user_merge.revert.not_finished.app_error", nil, "user_merge_id="+merge.Id+", status="+merge.Status, http.StatusBadRequest)
+	}
+
+	defer a.invalidateCacheForUserMerge(merge)
+
+	for i := len(model.UserMergeTables) - 1; i >= 0; i-- {
+		table := model.UserMergeTables[i]
+		// A nil list of keys would move all the rows of the primary user.
+		keys := merge.Manifest[table]
+		if len(keys) == 0 {
+			continue
+		}
+		if _, err := a.Srv().Store.UserMerge().MoveRows(table, merge.PrimaryUserId, merge.DuplicateUserId, keys); err != nil {
+			return nil, model.NewAppError("RevertUserMerge", "app.user_merge.revert.app_error", nil, "table="+table+", "+err.Error(), http.StatusInternalServerError)
+		}
+		if table == model.UserMergeTableSessions {
+			a.clearSessionCacheForUserMerge(merge)
+		}
+	}
+
+	merge.Status = model.UserMergeStatusReverted
+	merge.RevertAt = model.GetMillis()
+	if appErr := a.updateUserMerge(merge); appErr != nil {
+		return nil, appErr
+	}
+
+	return merge, nil
+}
+
+func (a *App) clearSessionCacheForUserMerge(merge *model.UserMerge) {
+	a.ClearSessionCacheForUser(merge.PrimaryUserId)
+	a.ClearSessionCacheForUser(merge.DuplicateUserId)
+}
+
+func (a *App) invalidateCacheForUserMerge(merge *model.UserMerge) {
+	for _, userID := range []string{merge.PrimaryUserId, merge.DuplicateUserId} {
+		a.ClearSessionCacheForUser(userID)
+		a.InvalidateCacheForUser(userID)
+		a.invalidateCacheForUserTeams(userID)
+	}
+}
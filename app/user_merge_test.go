@@ -0,0 +1,102 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestProcessUserMerge(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	duplicate := th.CreateUser()
+	th.LinkUserToTeam(duplicate, th.BasicTeam)
+	th.AddUserToChannel(duplicate, th.BasicChannel)
+
+	post, err := th.App.Srv().Store.Post().Save(&model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    duplicate.Id,
+		Message:   "from the duplicate account",
+	})
+	require.NoError(t, err)
+	session, appErr := th.App.CreateSession(&model.Session{UserId: duplicate.Id})
+	require.Nil(t, appErr)
+
+	merge, err := th.App.Srv().Store.UserMerge().Save(&model.UserMerge{
+		PrimaryUserId:   th.BasicUser.Id,
+		DuplicateUserId: duplicate.Id,
+		RequesterId:     th.SystemAdminUser.Id,
+	})
+	require.NoError(t, err)
+
+	// The job is saved as already started so that no worker picks it up.
+	job, err := th.App.Srv().Store.Job().Save(&model.Job{
+		Id:       model.NewId(),
+		Type:     model.JobTypeUserMerge,
+		Status:   model.JobStatusInProgress,
+		CreateAt: model.GetMillis(),
+		Data:     map[string]string{"user_merge_id": merge.Id},
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, th.App.ProcessUserMerge(job))
+
+	merge, appErr = th.App.GetUserMerge(merge.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.UserMergeStatusSuccess, merge.Status)
+	assert.Equal(t, job.Id, merge.JobId)
+	assert.Equal(t, []string{post.Id}, merge.Manifest[model.UserMergeTablePosts])
+	assert.Equal(t, []string{session.Id}, merge.Manifest[model.UserMergeTableSessions])
+	// Both users were already members of the channel and team, so those stay as they were.
+	assert.Empty(t, merge.Manifest[model.UserMergeTableChannelMembers])
+	assert.Empty(t, merge.Manifest[model.UserMergeTableTeamMembers])
+
+	job, err = th.App.Srv().Store.Job().Get(job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), job.Progress)
+
+	merged, err := th.App.Srv().Store.Post().GetSingle(post.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicUser.Id, merged.UserId)
+	mergedSession, err := th.App.Srv().Store.Session().Get(context.Background(), session.Id)
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicUser.Id, mergedSession.UserId)
+	assert.Equal(t, th.BasicUser.Roles, mergedSession.Roles)
+
+	cachedSession, appErr := th.App.GetSession(session.Token)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicUser.Id, cachedSession.UserId, "the sessions of the duplicate user aren't served from the cache")
+
+	t.Run("a processed merge isn't processed again", func(t *testing.T) {
+		appErr := th.App.ProcessUserMerge(job)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		reverted, appErr := th.App.RevertUserMerge(merge.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.UserMergeStatusReverted, reverted.Status)
+		assert.NotZero(t, reverted.RevertAt)
+
+		post, err := th.App.Srv().Store.Post().GetSingle(post.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, duplicate.Id, post.UserId)
+		session, err := th.App.Srv().Store.Session().Get(context.Background(), session.Id)
+		require.NoError(t, err)
+		assert.Equal(t, duplicate.Id, session.UserId)
+
+		_, appErr = th.App.RevertUserMerge(merge.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+}
@@ -38,6 +38,9 @@ type ServiceConfig struct {
 	// Optional fields
 	Metrics einterfaces.MetricsInterface
 	Cluster einterfaces.ClusterInterface
+	// CacheProvider provides the session cache. The service connects a provider of its own
+	// when none is given.
+	CacheProvider cache.Provider
 }
 
 func New(c ServiceConfig) (*UserService, error) {
@@ -45,15 +48,20 @@ func New(c ServiceConfig) (*UserService, error) {
 		return nil, err
 	}
 
-	cacheProvider := cache.NewProvider()
-	if err := cacheProvider.Connect(); err != nil {
-		return nil, fmt.Errorf("could not connect to cache provider: %w", err)
+	cacheProvider := c.CacheProvider
+	if cacheProvider == nil {
+		cacheProvider = cache.NewProvider()
+		if err := cacheProvider.Connect(); err != nil {
+			return nil, fmt.Errorf("could not connect to cache provider: %w", err)
+		}
 	}
 
 	sessionCache, err := cacheProvider.NewCache(&cache.CacheOptions{
 		Size:           model.SessionCacheSize,
+		Name:           "Session",
 		Striped:        true,
 		StripedBuckets: maxInt(runtime.NumCPU()-1, 1),
+		Shared:         true,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not create session cache: %w", err)
@@ -136,7 +136,7 @@ func (us *UserService) GetUsersEtag(restrictionsHash string) string {
 }
 
 func (us *UserService) GetUsersByIds(userIDs []string, options *store.UserGetByIdsOpts) ([]*model.User, error) {
-	allowFromCache := options.ViewRestrictions == nil
+	allowFromCache := options.ViewRestrictions == nil && len(options.Fields) == 0
 
 	users, err := us.store.GetProfileByIds(context.Background(), userIDs, options, allowFromCache)
 	if err != nil {
@@ -4,8 +4,6 @@
 package users
 
 import (
-	"strings"
-
 	"github.com/mattermost/mattermost-server/v6/model"
 )
 
@@ -45,10 +43,8 @@ func CheckEmailDomain(email string, domains string) bool {
 		return true
 	}
 
-	domainArray := strings.Fields(strings.TrimSpace(strings.ToLower(strings.Replace(strings.Replace(domains, "@", " ", -1), ",", " ", -1))))
-
-	for _, d := range domainArray {
-		if strings.HasSuffix(strings.ToLower(email), "@"+d) {
+	for _, d := range model.AllowedDomainsList(domains) {
+		if model.AllowedDomainMatchesEmail(d, email) {
 			return true
 		}
 	}
@@ -34,6 +34,7 @@ const (
 	pingInterval           = (pongWaitTime * 6) / 10
 	authCheckInterval      = 5 * time.Second
 	webConnMemberCacheTime = 1000 * 60 * 30 // 30 minutes
+	webConnStatusCacheTime = 1000 * 60      // 1 minute
 	deadQueueSize          = 128            // Approximated from /proc/sys/net/core/wmem_default / 2048 (avg msg size)
 )
 
@@ -81,8 +82,12 @@ type WebConn struct {
 
 	allChannelMembers         map[string]string
 	lastAllChannelMembersTime int64
-	lastUserActivityAt        int64
-	send                      chan model.WebSocketMessage
+	// visibleStatusUsers caches whether the statuses of other users can be sent to the user,
+	// which isn't the case for the users a strictly isolated user doesn't share a team with.
+	visibleStatusUsers         map[string]bool
+	lastVisibleStatusUsersTime int64
+	lastUserActivityAt         int64
+	send                       chan model.WebSocketMessage
 	// deadQueue behaves like a queue of a finite size
 	// which is used to store all messages that are sent via the websocket.
 	// It basically acts as the user-space socket buffer, and is used
@@ -109,6 +114,10 @@ type WebConn struct {
 	endWritePump chan struct{}
 	pumpFinished chan struct{}
 	pluginPosted chan pluginWSPostedHook
+
+	// webSocketScope restricts the events sent over the connection, when its session was made
+	// from a scoped user access token. It's set before the connection is registered to its hub.
+	webSocketScope *model.WebSocketScope
 }
 
 // CheckConnResult indicates whether a connectionID was present in the hub or not.
@@ -638,6 +647,8 @@ func (wc *WebConn) drainDeadQueue(index int) error {
 func (wc *WebConn) InvalidateCache() {
 	wc.allChannelMembers = nil
 	wc.lastAllChannelMembersTime = 0
+	wc.visibleStatusUsers = nil
+	wc.lastVisibleStatusUsersTime = 0
 	wc.SetSession(nil)
 	wc.SetSessionExpiresAt(0)
 }
@@ -708,6 +719,41 @@ func (wc *WebConn) shouldSendEventToGuest(msg *model.WebSocketEvent) bool {
 	return canSee
 }
 
+// canSeeUserStatus returns whether the status change can be sent to the user, applying the
+// same restrictions as FilterUserIDsForIsolation.
+func (wc *WebConn) canSeeUserStatus(msg *model.WebSocketEvent) bool {
+	userID, ok := msg.GetData()["user_id"].(string)
+	if !ok {
+		mlog.Debug("webhub.shouldSendEvent: user not found in message", mlog.Any("user_id", msg.GetData()["user_id"]))
+		return false
+	}
+	if userID == wc.UserId {
+		return true
+	}
+
+	if model.GetMillis()-wc.lastVisibleStatusUsersTime > webConnStatusCacheTime {
+		wc.visibleStatusUsers = nil
+		wc.lastVisibleStatusUsersTime = 0
+	}
+
+	if visible, ok := wc.visibleStatusUsers[userID]; ok {
+		return visible
+	}
+
+	visibleIDs, err := wc.App.FilterUserIDsForIsolation(wc.UserId, []string{userID})
+	if err != nil {
+		mlog.Error("webhub.shouldSendEvent.", mlog.Err(err))
+		return false
+	}
+
+	if wc.visibleStatusUsers == nil {
+		wc.visibleStatusUsers = make(map[string]bool)
+		wc.lastVisibleStatusUsersTime = model.GetMillis()
+	}
+	wc.visibleStatusUsers[userID] = len(visibleIDs) > 0
+	return len(visibleIDs) > 0
+}
+
 // shouldSendEvent returns whether the message should be sent or not.
 func (wc *WebConn) shouldSendEvent(msg *model.WebSocketEvent) bool {
 	// IMPORTANT: Do not send event if WebConn does not have a session
@@ -715,6 +761,11 @@ func (wc *WebConn) shouldSendEvent(msg *model.WebSocketEvent) bool {
 		return false
 	}
 
+	// Connections made with scoped tokens only receive the events in their scope
+	if wc.webSocketScope != nil && !wc.webSocketScope.Allows(msg) {
+		return false
+	}
+
 	// If the event contains sanitized data, only send to users that don't have permission to
 	// see sensitive data. Prevents admin clients from receiving events with bad data
 	var hasReadPrivateDataPermission *bool
@@ -737,6 +788,11 @@ func (wc *WebConn) shouldSendEvent(msg *model.WebSocketEvent) bool {
 		}
 	}
 
+	// Statuses are only sent to the users who can see the user whose status changed
+	if msg.EventType() == model.WebsocketEventStatusChange && !wc.canSeeUserStatus(msg) {
+		return false
+	}
+
 	// If the event is destined to a specific user
 	if msg.GetBroadcast().UserId != "" {
 		return wc.UserId == msg.GetBroadcast().UserId
@@ -151,6 +151,109 @@ func TestWebConnShouldSendEvent(t *testing.T) {
 	assert.False(t, basicUserWc.shouldSendEvent(event3))
 }
 
+func TestWebConnShouldSendStatusChange(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	outsider := th.CreateUser()
+	th.LinkUserToTeam(outsider, th.CreateTeam())
+
+	session, err := th.App.CreateSession(&model.Session{UserId: th.BasicUser.Id, Roles: th.BasicUser.GetRawRoles()})
+	require.Nil(t, err)
+
+	wc := &WebConn{
+		App:    th.App,
+		UserId: th.BasicUser.Id,
+		T:      i18n.T,
+	}
+
+	wc.SetSession(session)
+	wc.SetSessionToken(session.Token)
+	wc.SetSessionExpiresAt(session.ExpiresAt)
+
+	statusChange := func(userID string) *model.WebSocketEvent {
+		event := model.NewWebSocketEvent(model.WebsocketEventStatusChange, "", "", "", nil)
+		event.Add("status", model.StatusOnline)
+		event.Add("user_id", userID)
+		return event
+	}
+
+	assert.True(t, wc.shouldSendEvent(statusChange(outsider.Id)), "expected the outsider without isolation")
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.TeamSettings.StrictUserIsolation = false })
+	wc.InvalidateCache()
+
+	assert.True(t, wc.shouldSendEvent(statusChange(th.BasicUser.Id)), "expected the user")
+	assert.True(t, wc.shouldSendEvent(statusChange(th.BasicUser2.Id)), "expected a member of the team")
+	assert.False(t, wc.shouldSendEvent(statusChange(outsider.Id)), "did not expect the outsider")
+
+	th.LinkUserToTeam(outsider, th.BasicTeam)
+	assert.False(t, wc.shouldSendEvent(statusChange(outsider.Id)), "expected the connection to keep its cache")
+
+	wc.InvalidateCache()
+	assert.True(t, wc.shouldSendEvent(statusChange(outsider.Id)), "expected the outsider once in the team")
+}
+
+func TestWebConnWebSocketScope(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserAccessTokens = true })
+
+	otherChannel := th.CreateChannel(th.BasicTeam)
+	require.Nil(t, th.App.RemoveUserFromChannel(th.Context, th.BasicUser.Id, th.SystemAdminUser.Id, otherChannel))
+	memberChannel := th.CreateChannel(th.BasicTeam)
+
+	newScopedWebConn := func(scope *model.WebSocketScope) *WebConn {
+		token, appErr := th.App.CreateUserAccessToken(&model.UserAccessToken{UserId: th.BasicUser.Id, Description: "stream", WebSocketScope: scope})
+		require.Nil(t, appErr)
+		session, appErr := th.App.createSessionForUserAccessToken(token.Token)
+		require.Nil(t, appErr)
+
+		wc := &WebConn{
+			App:    th.App,
+			UserId: th.BasicUser.Id,
+			T:      i18n.T,
+		}
+		wc.SetSession(session)
+		wc.SetSessionToken(session.Token)
+		wc.SetSessionExpiresAt(session.ExpiresAt)
+		return wc
+	}
+
+	t.Run("channels the user isn't a member of are left out", func(t *testing.T) {
+		wc := newScopedWebConn(&model.WebSocketScope{ChannelIds: []string{th.BasicChannel.Id, otherChannel.Id}})
+		require.Nil(t, th.App.setWebSocketScope(wc))
+		assert.Equal(t, []string{th.BasicChannel.Id}, wc.webSocketScope.ChannelIds)
+
+		assert.True(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPosted, "", th.BasicChannel.Id, "", nil)))
+		assert.False(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPosted, "", memberChannel.Id, "", nil)))
+		assert.False(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPreferencesChanged, "", "", th.BasicUser.Id, nil)))
+	})
+
+	t.Run("connections are refused when no channel is left", func(t *testing.T) {
+		wc := newScopedWebConn(&model.WebSocketScope{ChannelIds: []string{otherChannel.Id}})
+		require.NotNil(t, th.App.setWebSocketScope(wc))
+	})
+
+	t.Run("event types", func(t *testing.T) {
+		wc := newScopedWebConn(&model.WebSocketScope{EventTypes: []string{model.WebsocketEventPosted}})
+		require.Nil(t, th.App.setWebSocketScope(wc))
+
+		assert.True(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPosted, "", memberChannel.Id, "", nil)))
+		assert.False(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventTyping, "", memberChannel.Id, "", nil)))
+		assert.False(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPosted, "", otherChannel.Id, "", nil)), "scopes don't widen what the user can see")
+	})
+
+	t.Run("tokens without a scope aren't restricted", func(t *testing.T) {
+		wc := newScopedWebConn(nil)
+		require.Nil(t, th.App.setWebSocketScope(wc))
+		assert.Nil(t, wc.webSocketScope)
+		assert.True(t, wc.shouldSendEvent(model.NewWebSocketEvent(model.WebsocketEventPreferencesChanged, "", "", th.BasicUser.Id, nil)))
+	})
+}
+
 func TestWebConnAddDeadQueue(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -5,6 +5,7 @@ package app
 
 import (
 	"hash/maphash"
+	"net/http"
 	"runtime"
 	"runtime/debug"
 	"strconv"
@@ -16,8 +17,9 @@ import (
 )
 
 const (
-	broadcastQueueSize         = 4096
-	inactiveConnReaperInterval = 5 * time.Minute
+	broadcastQueueSize            = 4096
+	inactiveConnReaperInterval    = 5 * time.Minute
+	channelPresenceReaperInterval = 15 * time.Second
 )
 
 type webConnActivityMessage struct {
@@ -43,6 +45,17 @@ type webConnCheckMessage struct {
 	result       chan *CheckConnResult
 }
 
+type webConnPresenceMessage struct {
+	userID       string
+	sessionToken string
+	channelID    string
+}
+
+type webConnChannelPresenceMessage struct {
+	channelID string
+	result    chan []string
+}
+
 // Hub is the central place to manage all websocket connections in the server.
 // It handles different websocket events and sending messages to individual
 // user connections.
@@ -63,6 +76,8 @@ type Hub struct {
 	explicitStop    bool
 	checkRegistered chan *webConnSessionMessage
 	checkConn       chan *webConnCheckMessage
+	presence        chan *webConnPresenceMessage
+	channelPresence chan *webConnChannelPresenceMessage
 }
 
 // newWebHub creates a new Hub.
@@ -79,6 +94,8 @@ func newWebHub(s *Server) *Hub {
 		directMsg:       make(chan *webConnDirectMessage),
 		checkRegistered: make(chan *webConnSessionMessage),
 		checkConn:       make(chan *webConnCheckMessage),
+		presence:        make(chan *webConnPresenceMessage),
+		channelPresence: make(chan *webConnChannelPresenceMessage),
 	}
 }
 
@@ -135,7 +152,15 @@ func (a *App) GetHubForUserId(userID string) *Hub {
 }
 
 // HubRegister registers a connection to a hub.
+// Connections made with a scoped token are refused when none of the channels of the scope
+// can be streamed.
 func (a *App) HubRegister(webConn *WebConn) {
+	if appErr := a.setWebSocketScope(webConn); appErr != nil {
+		mlog.Debug("Refused websocket connection of scoped token", mlog.String("user_id", webConn.UserId), mlog.Err(appErr))
+		webConn.WebSocket.Close()
+		return
+	}
+
 	hub := a.GetHubForUserId(webConn.UserId)
 	if hub != nil {
 		if metrics := a.Metrics(); metrics != nil {
@@ -145,6 +170,46 @@ func (a *App) HubRegister(webConn *WebConn) {
 	}
 }
 
+// setWebSocketScope restricts the connection to the websocket scope of its session, if any. The
+// channels of the scope the user isn't a member of are left out of it.
+func (a *App) setWebSocketScope(webConn *WebConn) *model.AppError {
+	webConn.webSocketScope = nil
+
+	session := webConn.GetSession()
+	if session == nil {
+		return nil
+	}
+
+	scope, err := session.WebSocketScope()
+	if err != nil {
+		return model.NewAppError("setWebSocketScope", "app.web_conn.websocket_scope.invalid.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+	if scope == nil {
+		return nil
+	}
+
+	if len(scope.ChannelIds) > 0 {
+		members, err := a.Srv().Store.Channel().GetAllChannelMembersForUser(webConn.UserId, true, false)
+		if err != nil {
+			return model.NewAppError("setWebSocketScope", "app.channel.get_channels.get.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+
+		channelIDs := make([]string, 0, len(scope.ChannelIds))
+		for _, channelID := range scope.ChannelIds {
+			if _, ok := members[channelID]; ok {
+				channelIDs = append(channelIDs, channelID)
+			}
+		}
+		if len(channelIDs) == 0 {
+			return model.NewAppError("setWebSocketScope", "app.web_conn.websocket_scope.no_channels.app_error", nil, "", http.StatusForbidden)
+		}
+		scope.ChannelIds = channelIDs
+	}
+
+	webConn.webSocketScope = scope
+	return nil
+}
+
 // HubUnregister unregisters a connection from a hub.
 func (a *App) HubUnregister(webConn *WebConn) {
 	hub := a.GetHubForUserId(webConn.UserId)
@@ -260,6 +325,7 @@ func (a *App) InvalidateCacheForUser(userID string) {
 }
 
 func (a *App) invalidateCacheForUserTeams(userID string) {
+	a.Srv().userIsolationCache.Remove(userID)
 	a.Srv().invalidateWebConnSessionCacheForUser(userID)
 	a.Srv().Store.Team().InvalidateAllTeamIdsForUser(userID)
 
@@ -384,6 +450,33 @@ func (h *Hub) UpdateActivity(userID, sessionToken string, activityAt int64) {
 	}
 }
 
+// SetChannelPresence records that the session has the channel open, or that it
+// closed the channel it had open if channelID is empty.
+func (h *Hub) SetChannelPresence(userID, sessionToken, channelID string) {
+	select {
+	case h.presence <- &webConnPresenceMessage{
+		userID:       userID,
+		sessionToken: sessionToken,
+		channelID:    channelID,
+	}:
+	case <-h.stop:
+	}
+}
+
+// GetChannelPresence returns the ids of the users of the hub who have the channel open.
+func (h *Hub) GetChannelPresence(channelID string) []string {
+	req := &webConnChannelPresenceMessage{
+		channelID: channelID,
+		result:    make(chan []string),
+	}
+	select {
+	case h.channelPresence <- req:
+		return <-req.result
+	case <-h.stop:
+	}
+	return nil
+}
+
 // SendMessage sends the given message to the given connection.
 func (h *Hub) SendMessage(conn *WebConn, msg model.WebSocketMessage) {
 	select {
@@ -413,9 +506,22 @@ func (h *Hub) Start() {
 		ticker := time.NewTicker(inactiveConnReaperInterval)
 		defer ticker.Stop()
 
+		presenceTicker := time.NewTicker(channelPresenceReaperInterval)
+		defer presenceTicker.Stop()
+
 		appInstance := New(ServerConnector(h.srv.Channels()))
 
 		connIndex := newHubConnectionIndex(inactiveConnReaperInterval)
+		presenceIndex := newHubChannelPresenceIndex(model.ChannelPresenceTimeoutMilliseconds)
+
+		publishPresenceChanges := func(changes []channelPresenceChange) {
+			if len(changes) == 0 {
+				return
+			}
+			h.srv.Go(func() {
+				appInstance.publishChannelPresenceChanges(changes)
+			})
+		}
 
 		for {
 			select {
@@ -447,6 +553,12 @@ func (h *Hub) Start() {
 				req.result <- res
 			case <-ticker.C:
 				connIndex.RemoveInactiveConnections()
+			case <-presenceTicker.C:
+				publishPresenceChanges(presenceIndex.RemoveExpired())
+			case presence := <-h.presence:
+				publishPresenceChanges(presenceIndex.Set(presence.userID, presence.sessionToken, presence.channelID))
+			case req := <-h.channelPresence:
+				req.result <- presenceIndex.ForChannel(req.channelID)
 			case webConn := <-h.register:
 				// Mark the current one as active.
 				// There is no need to check if it was inactive or not,
@@ -475,6 +587,9 @@ func (h *Hub) Start() {
 				}
 
 				conns := connIndex.ForUser(webConn.UserId)
+				if !hasActiveSession(conns, webConn.GetSessionToken()) {
+					publishPresenceChanges(presenceIndex.Remove(webConn.GetSessionToken()))
+				}
 				if len(conns) == 0 || areAllInactive(conns) {
 					h.srv.Go(func() {
 						appInstance.SetStatusOffline(webConn.UserId, false)
@@ -689,3 +804,119 @@ func (i *hubConnectionIndex) AllActive() int {
 	}
 	return cnt
 }
+
+func hasActiveSession(conns []*WebConn, sessionToken string) bool {
+	for _, conn := range conns {
+		if conn.active && conn.GetSessionToken() == sessionToken {
+			return true
+		}
+	}
+	return false
+}
+
+// channelPresenceChange is a user starting or stopping to view a channel.
+type channelPresenceChange struct {
+	userID    string
+	channelID string
+	viewing   bool
+}
+
+type channelViewer struct {
+	userID    string
+	channelID string
+	expiresAt int64
+}
+
+// hubChannelPresenceIndex keeps track of the channel each session of the hub has open.
+// A session stops being listed once it hasn't renewed its presence for the ttl.
+// Since all the connections of a user belong to the same hub, the index knows when a
+// user starts or stops viewing a channel across all of their sessions.
+type hubChannelPresenceIndex struct {
+	bySession map[string]*channelViewer
+	ttl       int64
+}
+
+func newHubChannelPresenceIndex(ttl int64) *hubChannelPresenceIndex {
+	return &hubChannelPresenceIndex{
+		bySession: make(map[string]*channelViewer),
+		ttl:       ttl,
+	}
+}
+
+func (i *hubChannelPresenceIndex) isViewing(userID, channelID string, now int64) bool {
+	for _, viewer := range i.bySession {
+		if viewer.userID == userID && viewer.channelID == channelID && viewer.expiresAt > now {
+			return true
+		}
+	}
+	return false
+}
+
+// Set records the channel the session has open, or removes the session if channelID
+// is empty, and returns the resulting changes.
+func (i *hubChannelPresenceIndex) Set(userID, sessionToken, channelID string) []channelPresenceChange {
+	now := model.GetMillis()
+	var changes []channelPresenceChange
+
+	if channelID != "" && !i.isViewing(userID, channelID, now) {
+		changes = append(changes, channelPresenceChange{userID: userID, channelID: channelID, viewing: true})
+	}
+
+	previous, ok := i.bySession[sessionToken]
+	if channelID == "" {
+		delete(i.bySession, sessionToken)
+	} else {
+		i.bySession[sessionToken] = &channelViewer{userID: userID, channelID: channelID, expiresAt: now + i.ttl}
+	}
+
+	if ok && previous.channelID != channelID && !i.isViewing(previous.userID, previous.channelID, now) {
+		changes = append(changes, channelPresenceChange{userID: previous.userID, channelID: previous.channelID, viewing: false})
+	}
+
+	return changes
+}
+
+// Remove removes the session from the index and returns the resulting changes.
+func (i *hubChannelPresenceIndex) Remove(sessionToken string) []channelPresenceChange {
+	return i.Set("", sessionToken, "")
+}
+
+// RemoveExpired removes the sessions that didn't renew their presence in time and
+// returns the resulting changes.
+func (i *hubChannelPresenceIndex) RemoveExpired() []channelPresenceChange {
+	now := model.GetMillis()
+	var expired []*channelViewer
+	for sessionToken, viewer := range i.bySession {
+		if viewer.expiresAt <= now {
+			expired = append(expired, viewer)
+			delete(i.bySession, sessionToken)
+		}
+	}
+
+	var changes []channelPresenceChange
+	seen := make(map[channelViewer]bool)
+	for _, viewer := range expired {
+		key := channelViewer{userID: viewer.userID, channelID: viewer.channelID}
+		if seen[key] || i.isViewing(viewer.userID, viewer.channelID, now) {
+			continue
+		}
+		seen[key] = true
+		changes = append(changes, channelPresenceChange{userID: viewer.userID, channelID: viewer.channelID, viewing: false})
+	}
+
+	return changes
+}
+
+// ForChannel returns the ids of the users who have the channel open.
+func (i *hubChannelPresenceIndex) ForChannel(channelID string) []string {
+	now := model.GetMillis()
+	seen := make(map[string]bool)
+	userIDs := []string{}
+	for _, viewer := range i.bySession {
+		if viewer.channelID == channelID && viewer.expiresAt > now && !seen[viewer.userID] {
+			seen[viewer.userID] = true
+			userIDs = append(userIDs, viewer.userID)
+		}
+	}
+	return userIDs
+}
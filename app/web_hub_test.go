@@ -337,6 +337,70 @@ func TestHubConnIndexInactive(t *testing.T) {
 	assert.Len(t, connIndex.All(), 2)
 }
 
+func TestHubChannelPresenceIndex(t *testing.T) {
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+	channelID1 := model.NewId()
+	channelID2 := model.NewId()
+
+	t.Run("lists the users viewing a channel", func(t *testing.T) {
+		index := newHubChannelPresenceIndex(60000)
+
+		changes := index.Set(userID1, "token1", channelID1)
+		assert.Equal(t, []channelPresenceChange{{userID: userID1, channelID: channelID1, viewing: true}}, changes)
+
+		index.Set(userID2, "token2", channelID1)
+		assert.ElementsMatch(t, []string{userID1, userID2}, index.ForChannel(channelID1))
+		assert.Empty(t, index.ForChannel(channelID2))
+
+		changes = index.Set(userID1, "token1", channelID1)
+		assert.Empty(t, changes, "renewing the presence should not change anything")
+	})
+
+	t.Run("switching channels", func(t *testing.T) {
+		index := newHubChannelPresenceIndex(60000)
+
+		index.Set(userID1, "token1", channelID1)
+		changes := index.Set(userID1, "token1", channelID2)
+		assert.ElementsMatch(t, []channelPresenceChange{
+			{userID: userID1, channelID: channelID2, viewing: true},
+			{userID: userID1, channelID: channelID1, viewing: false},
+		}, changes)
+		assert.Empty(t, index.ForChannel(channelID1))
+		assert.Equal(t, []string{userID1}, index.ForChannel(channelID2))
+	})
+
+	t.Run("a user viewing a channel from several sessions", func(t *testing.T) {
+		index := newHubChannelPresenceIndex(60000)
+
+		index.Set(userID1, "token1", channelID1)
+		changes := index.Set(userID1, "token2", channelID1)
+		assert.Empty(t, changes)
+		assert.Equal(t, []string{userID1}, index.ForChannel(channelID1))
+
+		changes = index.Remove("token1")
+		assert.Empty(t, changes, "the user still has the channel open in another session")
+
+		changes = index.Remove("token2")
+		assert.Equal(t, []channelPresenceChange{{userID: userID1, channelID: channelID1, viewing: false}}, changes)
+		assert.Empty(t, index.ForChannel(channelID1))
+
+		assert.Empty(t, index.Remove("unknown"))
+	})
+
+	t.Run("expired presence", func(t *testing.T) {
+		index := newHubChannelPresenceIndex(0)
+
+		index.Set(userID1, "token1", channelID1)
+		index.Set(userID1, "token2", channelID1)
+		assert.Empty(t, index.ForChannel(channelID1))
+
+		changes := index.RemoveExpired()
+		assert.Equal(t, []channelPresenceChange{{userID: userID1, channelID: channelID1, viewing: false}}, changes)
+		assert.Empty(t, index.RemoveExpired())
+	})
+}
+
 func TestReliableWebSocketSend(t *testing.T) {
 	testCluster := &testlib.FakeClusterInterface{}
 
@@ -673,6 +673,12 @@ func (a *App) HandleIncomingWebhook(c *request.Context, hookID string, req *mode
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.parse.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	// The payload is audited as received, before it gets processed
+	payload, jsonErr := json.Marshal(req)
+	if jsonErr != nil {
+		return model.NewAppError("HandleIncomingWebhook", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+
 	text := req.Text
 	if text == "" && req.Attachments == nil {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.text.app_error", nil, "", http.StatusBadRequest)
@@ -779,18 +785,17 @@ func (a *App) HandleIncomingWebhook(c *request.Context, hookID string, req *mode
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.permissions.app_error", nil, "", http.StatusForbidden)
 	}
 
-	overrideUsername := hook.Username
-	if req.Username != "" {
-		overrideUsername = req.Username
-	}
+	overrideUsername, overrideIconURL, overrideIconEmoji, identityOverride := a.webhookPostIdentity(hook, channel, req)
 
-	overrideIconURL := hook.IconURL
-	if req.IconURL != "" {
-		overrideIconURL = req.IconURL
+	if _, err := a.CreateWebhookPost(c, hook.UserId, channel, text, overrideUsername, overrideIconURL, overrideIconEmoji, req.Props, webhookType, ""); err != nil {
+		return err
 	}
 
-	_, err := a.CreateWebhookPost(c, hook.UserId, channel, text, overrideUsername, overrideIconURL, req.IconEmoji, req.Props, webhookType, "")
-	return err
+	a.auditIntegrationUse(c, hook.UserId, model.AuditActionIncomingWebhookPosted, model.IntegrationAuditExtraInfo(hook.Id, channel.Id, "", payload))
+	if identityOverride != nil {
+		a.auditIntegrationUse(c, hook.UserId, model.AuditActionIncomingWebhookIdentityOverridden, identityOverride.AuditExtraInfo())
+	}
+	return nil
 }
 
 func (a *App) CreateCommandWebhook(commandID string, args *model.CommandArgs) (*model.CommandWebhook, *model.AppError) {
@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// webhookPostIdentity returns the username and icons the incoming webhook posts the request with
+// in the channel. The username and icons the request asks for are only used when the channel
+// allows it, the post falling back to the identity of the webhook otherwise, in which case the
+// emoji asked for in the props of the request is dropped as well. The override is nil when the
+// request doesn't ask for another identity than the webhook's.
+func (a *App) webhookPostIdentity(hook *model.IncomingWebhook, channel *model.Channel, req *model.IncomingWebhookRequest) (username, iconURL, iconEmoji string, override *model.WebhookIdentityOverride) {
+	username = hook.Username
+	iconURL = hook.IconURL
+	iconEmoji = req.IconEmoji
+
+	override = &model.WebhookIdentityOverride{
+		HookId:    hook.Id,
+		ChannelId: channel.Id,
+		UserId:    hook.UserId,
+	}
+	overridden := false
+
+	if *a.Config().ServiceSettings.EnablePostUsernameOverride && req.Username != "" && req.Username != hook.Username {
+		overridden = true
+		override.Username = req.Username
+		if channel.DisableWebhookUsernameOverride {
+			override.UsernameBlocked = true
+		} else {
+			username = req.Username
+		}
+	}
+
+	if *a.Config().ServiceSettings.EnablePostIconOverride {
+		if req.IconURL != "" && req.IconURL != hook.IconURL {
+			override.IconURL = req.IconURL
+		}
+		override.IconEmoji = req.IconEmoji
+		if propEmoji, ok := req.Props["override_icon_emoji"].(string); ok && override.IconEmoji == "" {
+			override.IconEmoji = propEmoji
+		}
+
+		if override.IconURL != "" || override.IconEmoji != "" {
+			overridden = true
+			if channel.DisableWebhookIconOverride {
+				override.IconBlocked = true
+				iconEmoji = ""
+				delete(req.Props, "override_icon_emoji")
+			} else if override.IconURL != "" {
+				iconURL = req.IconURL
+			}
+		}
+	}
+
+	if !overridden {
+		return username, iconURL, iconEmoji, nil
+	}
+	return username, iconURL, iconEmoji, override
+}
+
+// GetWebhookIdentityOverrides returns a page of the posts of the incoming webhooks asking for
+// another identity in the channel, newest first.
+func (a *App) GetWebhookIdentityOverrides(channelID string, page, perPage int) ([]*model.WebhookIdentityOverride, *model.AppError) {
+	actions := []string{model.AuditActionIncomingWebhookIdentityOverridden}
+	audits, err := a.Srv().Store.Audit().GetByActions(actions, model.WebhookIdentityOverrideAuditPrefix(channelID), page*perPage, perPage)
+	if err != nil {
+		var outErr *store.ErrOutOfBounds
+		switch {
+		case errors.As(err, &outErr):
+			return nil, model.NewAppError("GetWebhookIdentityOverrides", "app.audit.get.limit.app_error", nil, err.Error(), http.StatusBadRequest)
+		default:
+			return nil, model.NewAppError("GetWebhookIdentityOverrides", "app.audit.get.finding.app_error", nil, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	overrides := make([]*model.WebhookIdentityOverride, 0, len(audits))
+	for _, audit := range audits {
+		override, err := model.WebhookIdentityOverrideFromAudit(&audit)
+		if err != nil {
+			mlog.Warn("Failed to read the audit of a webhook identity override", mlog.String("audit_id", audit.Id), mlog.Err(err))
+			continue
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
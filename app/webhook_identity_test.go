@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestWebhookIdentityOverrides(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableIncomingWebhooks = true
+		*cfg.ServiceSettings.EnablePostUsernameOverride = true
+		*cfg.ServiceSettings.EnablePostIconOverride = true
+	})
+
+	hook, appErr := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{
+		ChannelId: th.BasicChannel.Id,
+		Username:  "deploybot",
+		IconURL:   "https://example.com/deploybot.png",
+	})
+	require.Nil(t, appErr)
+	defer th.App.DeleteIncomingWebhook(hook.Id)
+
+	lastPost := func() *model.Post {
+		postList, err := th.App.Srv().Store.Post().GetPosts(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 1}, false)
+		require.NoError(t, err)
+		require.Len(t, postList.Order, 1)
+		return postList.Posts[postList.Order[0]]
+	}
+
+	t.Run("the webhook's own identity isn't an override", func(t *testing.T) {
+		appErr := th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{Text: "hello", Username: "deploybot"})
+		require.Nil(t, appErr)
+
+		overrides, appErr := th.App.GetWebhookIdentityOverrides(th.BasicChannel.Id, 0, 100)
+		require.Nil(t, appErr)
+		assert.Empty(t, overrides)
+	})
+
+	t.Run("overrides allowed by the channel", func(t *testing.T) {
+		appErr := th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{
+			Text:     "hello",
+			Username: "ceo",
+			IconURL:  "https://example.com/ceo.png",
+		})
+		require.Nil(t, appErr)
+
+		post := lastPost()
+		assert.Equal(t, "ceo", post.GetProp("override_username"))
+		assert.Equal(t, "https://example.com/ceo.png", post.GetProp("override_icon_url"))
+
+		overrides, appErr := th.App.GetWebhookIdentityOverrides(th.BasicChannel.Id, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, hook.Id, overrides[0].HookId)
+		assert.Equal(t, th.BasicUser.Id, overrides[0].UserId)
+		assert.Equal(t, "ceo", overrides[0].Username)
+		assert.Equal(t, "https://example.com/ceo.png", overrides[0].IconURL)
+		assert.False(t, overrides[0].UsernameBlocked)
+		assert.False(t, overrides[0].IconBlocked)
+	})
+
+	t.Run("overrides blocked by the channel", func(t *testing.T) {
+		channel := th.BasicChannel.DeepCopy()
+		channel.DisableWebhookUsernameOverride = true
+		channel.DisableWebhookIconOverride = true
+		_, appErr := th.App.UpdateChannel(channel)
+		require.Nil(t, appErr)
+
+		appErr = th.App.HandleIncomingWebhook(th.Context, hook.Id, &model.IncomingWebhookRequest{
+			Text:      "hello",
+			Username:  "ceo",
+			IconEmoji: "crown",
+			Props:     model.StringInterface{"override_icon_emoji": "crown"},
+		})
+		require.Nil(t, appErr)
+
+		post := lastPost()
+		assert.Equal(t, "deploybot", post.GetProp("override_username"))
+		assert.Equal(t, "https://example.com/deploybot.png", post.GetProp("override_icon_url"))
+		assert.Nil(t, post.GetProp("override_icon_emoji"))
+
+		overrides, appErr := th.App.GetWebhookIdentityOverrides(th.BasicChannel.Id, 0, 100)
+		require.Nil(t, appErr)
+		require.Len(t, overrides, 2)
+		assert.Equal(t, "ceo", overrides[0].Username)
+		assert.Equal(t, "crown", overrides[0].IconEmoji)
+		assert.True(t, overrides[0].UsernameBlocked)
+		assert.True(t, overrides[0].IconBlocked)
+	})
+
+	t.Run("other channels", func(t *testing.T) {
+		overrides, appErr := th.App.GetWebhookIdentityOverrides(model.NewId(), 0, 100)
+		require.Nil(t, appErr)
+		assert.Empty(t, overrides)
+	})
+}
@@ -79,6 +79,13 @@ func (wr *WebSocketRouter) ServeWebSocket(conn *WebConn, r *model.WebSocketReque
 		return
 	}
 
+	// Connections made with scoped tokens only stream events, they can't make requests.
+	if conn.webSocketScope != nil {
+		err := model.NewAppError("ServeWebSocket", "api.web_socket_router.scoped.app_error", nil, "", http.StatusForbidden)
+		returnWebSocketError(conn.App, conn, r, err)
+		return
+	}
+
 	handler, ok := wr.handlers[r.Action]
 	if !ok {
 		err := model.NewAppError("ServeWebSocket", "api.web_socket_router.bad_action.app_error", nil, "", http.StatusInternalServerError)
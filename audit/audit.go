@@ -18,6 +18,9 @@ type Audit struct {
 
 	// OnError is called when an error occurs while writing an audit record.
 	OnError func(err error)
+
+	// OnRecord is called with every audit record emitted, after it was queued for writing.
+	OnRecord func(rec Record)
 }
 
 func (a *Audit) Init(maxQueueSize int) {
@@ -45,6 +48,10 @@ func (a *Audit) LogRecord(level mlog.Level, rec Record) {
 		flds = append(flds, mlog.Any(k, v))
 	}
 	a.logger.Log(level, "", flds...)
+
+	if a.OnRecord != nil {
+		a.OnRecord(rec)
+	}
 }
 
 // Log emits an audit record based on minimum required info.
@@ -14,6 +14,10 @@ const (
 	KeyClient    = "client"
 	KeyIPAddress = "ip_address"
 	KeyClusterID = "cluster_id"
+	KeyLocal     = "local"
+
+	KeyImpersonatorID  = "impersonator_id"
+	KeyImpersonationID = "impersonation_id"
 
 	Success = "success"
 	Attempt = "attempt"
@@ -65,11 +65,17 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 	props["SendPushNotifications"] = strconv.FormatBool(*c.EmailSettings.SendPushNotifications)
 	props["RequireEmailVerification"] = strconv.FormatBool(*c.EmailSettings.RequireEmailVerification)
 	props["EnableEmailBatching"] = strconv.FormatBool(*c.EmailSettings.EnableEmailBatching)
+	props["EnableChannelDigestEmails"] = strconv.FormatBool(*c.EmailSettings.EnableChannelDigestEmails)
+	props["EnablePostPriority"] = strconv.FormatBool(*c.ServiceSettings.EnablePostPriority)
+	props["EnableDirectChannelRetention"] = strconv.FormatBool(*c.DataRetentionSettings.EnableDirectChannelRetention)
 	props["EnablePreviewModeBanner"] = strconv.FormatBool(*c.EmailSettings.EnablePreviewModeBanner)
 	props["EmailNotificationContentsType"] = *c.EmailSettings.EmailNotificationContentsType
 
 	props["ShowEmailAddress"] = strconv.FormatBool(*c.PrivacySettings.ShowEmailAddress)
 	props["ShowFullName"] = strconv.FormatBool(*c.PrivacySettings.ShowFullName)
+	props["ShowChannelPresence"] = strconv.FormatBool(*c.PrivacySettings.ShowChannelPresence)
+	props["EnableUserActivityTimeline"] = strconv.FormatBool(*c.PrivacySettings.EnableUserActivityTimeline)
+	props["ShowUserActivityTimeline"] = strconv.FormatBool(*c.PrivacySettings.ShowUserActivityTimeline)
 
 	props["EnableFileAttachments"] = strconv.FormatBool(*c.FileSettings.EnableFileAttachments)
 	props["EnablePublicLink"] = strconv.FormatBool(*c.FileSettings.EnablePublicLink)
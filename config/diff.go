@@ -22,11 +22,15 @@ var configSensitivePaths = map[string]bool{
 	"LdapSettings.BindPassword":                              true,
 	"FileSettings.PublicLinkSalt":                            true,
 	"FileSettings.AmazonS3SecretAccessKey":                   true,
+	"FileSettings.AmazonCloudFrontPrivateKey":                true,
+	"FileSettings.ResidencyBackends":                         true,
 	"SqlSettings.DataSource":                                 true,
 	"SqlSettings.AtRestEncryptKey":                           true,
 	"SqlSettings.DataSourceReplicas":                         true,
 	"SqlSettings.DataSourceSearchReplicas":                   true,
 	"EmailSettings.SMTPPassword":                             true,
+	"EmailSettings.EmailProviderAPIKey":                      true,
+	"EmailSettings.EmailProviderWebhookToken":                true,
 	"GitLabSettings.Secret":                                  true,
 	"GoogleSettings.Secret":                                  true,
 	"Office365Settings.Secret":                               true,
@@ -38,6 +42,8 @@ var configSensitivePaths = map[string]bool{
 	"ServiceSettings.GfycatAPISecret":                        true,
 	"ServiceSettings.SplitKey":                               true,
 	"PluginSettings.Plugins":                                 true,
+	"CacheSettings.RedisPassword":                            true,
+	"RESTProxyBridgeSettings.Password":                       true,
 }
 
 // Sanitize replaces sensitive config values in the diff with asterisks filled strings.
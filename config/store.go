@@ -18,6 +18,10 @@ var (
 	// ErrReadOnlyStore is returned when an attempt to modify a read-only
 	// configuration store is made.
 	ErrReadOnlyStore = errors.New("configuration store is read-only")
+
+	// ErrVersionMismatch is returned when an attempt to modify the configuration is made
+	// based on a version other than the current one.
+	ErrVersionMismatch = errors.New("configuration was changed since the given version")
 )
 
 // Store is the higher level object that handles storing and retrieval of config data.
@@ -167,6 +171,28 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 
+	return s.set(newCfg)
+}
+
+// SetIfVersion replaces the current configuration like Set, provided the current configuration
+// is still at the given version. Otherwise, it returns ErrVersionMismatch.
+func (s *Store) SetIfVersion(newCfg *model.Config, version string) (*model.Config, *model.Config, error) {
+	s.configLock.Lock()
+	defer s.configLock.Unlock()
+
+	current, err := Version(s.config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current != version {
+		return nil, nil, ErrVersionMismatch
+	}
+
+	return s.set(newCfg)
+}
+
+// set replaces the current configuration. It must be called with the config lock held.
+func (s *Store) set(newCfg *model.Config) (*model.Config, *model.Config, error) {
 	if s.readOnly {
 		return nil, nil, ErrReadOnlyStore
 	}
@@ -102,3 +102,32 @@ func TestNewStoreReadOnly(t *testing.T) {
 		fs.Close()
 	})
 }
+
+func TestStoreSetIfVersion(t *testing.T) {
+	store := NewTestMemoryStore()
+	defer store.Close()
+
+	version, err := Version(store.Get())
+	require.NoError(t, err)
+
+	newCfg := store.Get().Clone()
+	*newCfg.TeamSettings.SiteName = "Versioned"
+	oldCfg, savedCfg, err := store.SetIfVersion(newCfg, version)
+	require.NoError(t, err)
+	require.NotNil(t, oldCfg)
+	require.Equal(t, "Versioned", *savedCfg.TeamSettings.SiteName)
+
+	newVersion, err := Version(store.Get())
+	require.NoError(t, err)
+	require.NotEqual(t, version, newVersion)
+
+	t.Run("stale version", func(t *testing.T) {
+		staleCfg := store.Get().Clone()
+		*staleCfg.TeamSettings.SiteName = "Stale"
+		oldCfg, savedCfg, err := store.SetIfVersion(staleCfg, version)
+		require.Nil(t, oldCfg)
+		require.Nil(t, savedCfg)
+		require.Equal(t, ErrVersionMismatch, err)
+		require.Equal(t, "Versioned", *store.Get().TeamSettings.SiteName)
+	})
+}
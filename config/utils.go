@@ -5,6 +5,8 @@ package config
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -34,11 +36,29 @@ func desanitize(actual, target *model.Config) {
 	if *target.FileSettings.AmazonS3SecretAccessKey == model.FakeSetting {
 		target.FileSettings.AmazonS3SecretAccessKey = actual.FileSettings.AmazonS3SecretAccessKey
 	}
+	if *target.FileSettings.AmazonCloudFrontPrivateKey == model.FakeSetting {
+		target.FileSettings.AmazonCloudFrontPrivateKey = actual.FileSettings.AmazonCloudFrontPrivateKey
+	}
+	for residency, backend := range target.FileSettings.ResidencyBackends {
+		if backend != nil && backend.AmazonS3SecretAccessKey == model.FakeSetting {
+			if actualBackend := actual.FileSettings.ResidencyBackends[residency]; actualBackend != nil {
+				backend.AmazonS3SecretAccessKey = actualBackend.AmazonS3SecretAccessKey
+			}
+		}
+	}
 
 	if *target.EmailSettings.SMTPPassword == model.FakeSetting {
 		target.EmailSettings.SMTPPassword = actual.EmailSettings.SMTPPassword
 	}
 
+	if *target.EmailSettings.EmailProviderAPIKey == model.FakeSetting {
+		target.EmailSettings.EmailProviderAPIKey = actual.EmailSettings.EmailProviderAPIKey
+	}
+
+	if *target.EmailSettings.EmailProviderWebhookToken == model.FakeSetting {
+		target.EmailSettings.EmailProviderWebhookToken = actual.EmailSettings.EmailProviderWebhookToken
+	}
+
 	if *target.GitLabSettings.Secret == model.FakeSetting {
 		target.GitLabSettings.Secret = actual.GitLabSettings.Secret
 	}
@@ -66,6 +86,14 @@ func desanitize(actual, target *model.Config) {
 		*target.ElasticsearchSettings.Password = *actual.ElasticsearchSettings.Password
 	}
 
+	if *target.CacheSettings.RedisPassword == model.FakeSetting {
+		*target.CacheSettings.RedisPassword = *actual.CacheSettings.RedisPassword
+	}
+
+	if *target.RESTProxyBridgeSettings.Password == model.FakeSetting {
+		*target.RESTProxyBridgeSettings.Password = *actual.RESTProxyBridgeSettings.Password
+	}
+
 	if len(target.SqlSettings.DataSourceReplicas) == len(actual.SqlSettings.DataSourceReplicas) {
 		for i, value := range target.SqlSettings.DataSourceReplicas {
 			if value == model.FakeSetting {
@@ -263,6 +291,17 @@ func equal(oldCfg, newCfg *model.Config) (bool, error) {
 	return !bytes.Equal(oldCfgBytes, newCfgBytes), nil
 }
 
+// Version returns the version of the given configuration, a hash of its contents. Any change to
+// the configuration changes its version.
+func Version(cfg *model.Config) (string, error) {
+	cfgBytes, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	sum := sha256.Sum256(cfgBytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // appendMultipleStatementsFlag attached dsn parameters to MySQL dsn in order to make migrations work.
 func appendMultipleStatementsFlag(dataSource string) (string, error) {
 
@@ -25,6 +25,7 @@ func TestDesanitize(t *testing.T) {
 	actual.LdapSettings.BindPassword = model.NewString("bind_password")
 	actual.FileSettings.PublicLinkSalt = model.NewString("public_link_salt")
 	actual.FileSettings.AmazonS3SecretAccessKey = model.NewString("amazon_s3_secret_access_key")
+	actual.FileSettings.AmazonCloudFrontPrivateKey = model.NewString("amazon_cloudfront_private_key")
 	actual.EmailSettings.SMTPPassword = model.NewString("smtp_password")
 	actual.GitLabSettings.Secret = model.NewString("secret")
 	actual.OpenIdSettings.Secret = model.NewString("secret")
@@ -47,6 +48,7 @@ func TestDesanitize(t *testing.T) {
 	target.LdapSettings.BindPassword = model.NewString(model.FakeSetting)
 	target.FileSettings.PublicLinkSalt = model.NewString(model.FakeSetting)
 	target.FileSettings.AmazonS3SecretAccessKey = model.NewString(model.FakeSetting)
+	target.FileSettings.AmazonCloudFrontPrivateKey = model.NewString(model.FakeSetting)
 	target.EmailSettings.SMTPPassword = model.NewString(model.FakeSetting)
 	target.GitLabSettings.Secret = model.NewString(model.FakeSetting)
 	target.OpenIdSettings.Secret = model.NewString(model.FakeSetting)
@@ -68,6 +70,7 @@ func TestDesanitize(t *testing.T) {
 	assert.Equal(t, *actual.LdapSettings.BindPassword, *target.LdapSettings.BindPassword)
 	assert.Equal(t, *actual.FileSettings.PublicLinkSalt, *target.FileSettings.PublicLinkSalt)
 	assert.Equal(t, *actual.FileSettings.AmazonS3SecretAccessKey, *target.FileSettings.AmazonS3SecretAccessKey)
+	assert.Equal(t, *actual.FileSettings.AmazonCloudFrontPrivateKey, *target.FileSettings.AmazonCloudFrontPrivateKey)
 	assert.Equal(t, *actual.EmailSettings.SMTPPassword, *target.EmailSettings.SMTPPassword)
 	assert.Equal(t, *actual.GitLabSettings.Secret, *target.GitLabSettings.Secret)
 	assert.Equal(t, *actual.OpenIdSettings.Secret, *target.OpenIdSettings.Secret)
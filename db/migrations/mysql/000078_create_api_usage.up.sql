@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS APIUsage (
+    UserId varchar(26) NOT NULL,
+    TokenId varchar(26) NOT NULL DEFAULT '',
+    Route varchar(128) NOT NULL,
+    BucketAt bigint(20) NOT NULL,
+    Count bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, TokenId, Route, BucketAt),
+    KEY idx_apiusage_bucketat (BucketAt),
+    KEY idx_apiusage_tokenid_bucketat (TokenId, BucketAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
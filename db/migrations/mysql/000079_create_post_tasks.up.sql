@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS PostTasks (
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    AssigneeId varchar(26) NOT NULL DEFAULT '',
+    Status varchar(32) NOT NULL,
+    DueAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (PostId),
+    KEY idx_posttasks_assigneeid_status_dueat (AssigneeId, Status, DueAt),
+    KEY idx_posttasks_channelid (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
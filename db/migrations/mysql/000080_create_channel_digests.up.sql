@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS ChannelDigests (
+    UserId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    Cadence varchar(16) NOT NULL,
+    LastSentAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, ChannelId),
+    KEY idx_channeldigests_cadence_lastsentat (Cadence, LastSentAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
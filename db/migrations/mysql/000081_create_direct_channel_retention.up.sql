@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS DirectChannelRetention (
+    ChannelId varchar(26) NOT NULL,
+    RetentionDays bigint(20) NOT NULL,
+    ProposedBy varchar(26) NOT NULL,
+    ProposedAt bigint(20) DEFAULT 0,
+    AcceptedAt bigint(20) DEFAULT 0,
+    LastPurgeAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
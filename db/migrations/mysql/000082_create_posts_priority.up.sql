@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS PostsPriority (
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    Priority varchar(32) NOT NULL,
+    RequestedAck tinyint(1) DEFAULT 0,
+    PRIMARY KEY (PostId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
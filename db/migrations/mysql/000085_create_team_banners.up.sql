@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS TeamBanners (
+    Id varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    Message text,
+    BackgroundColor varchar(16) NOT NULL,
+    TextColor varchar(16) NOT NULL,
+    AllowDismissal tinyint(1) DEFAULT 0,
+    StartAt bigint(20) DEFAULT 0,
+    EndAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    DeleteAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_teambanners_teamid_deleteat (TeamId, DeleteAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
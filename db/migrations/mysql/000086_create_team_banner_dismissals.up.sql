@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS TeamBannerDismissals (
+    BannerId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    DismissedAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, BannerId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
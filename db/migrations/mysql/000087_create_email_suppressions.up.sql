@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS EmailSuppressions (
+    Email varchar(128) NOT NULL,
+    Reason varchar(32) NOT NULL,
+    Provider varchar(32) NOT NULL,
+    Details text,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Email),
+    KEY idx_emailsuppressions_create_at (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_channel_id_last_viewed_at'
+    ) > 0,
+    'DROP INDEX idx_channelmembers_channel_id_last_viewed_at ON ChannelMembers;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_channel_id_scheme_admin'
+    ) > 0,
+    'DROP INDEX idx_channelmembers_channel_id_scheme_admin ON ChannelMembers;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'Posts'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_posts_channel_id_user_id_create_at'
+    ) > 0,
+    'DROP INDEX idx_posts_channel_id_user_id_create_at ON Posts;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
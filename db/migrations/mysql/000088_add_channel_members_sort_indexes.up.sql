@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_channel_id_last_viewed_at'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_channelmembers_channel_id_last_viewed_at ON ChannelMembers(ChannelId, LastViewedAt);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_channel_id_scheme_admin'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_channelmembers_channel_id_scheme_admin ON ChannelMembers(ChannelId, SchemeAdmin);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'Posts'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_posts_channel_id_user_id_create_at'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_posts_channel_id_user_id_create_at ON Posts(ChannelId, UserId, CreateAt);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
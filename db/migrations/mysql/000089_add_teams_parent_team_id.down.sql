@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_teams_parent_team_id'
+    ) > 0,
+    'DROP INDEX idx_teams_parent_team_id ON Teams;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND column_name = 'InheritParentMembership'
+    ) > 0,
+    'ALTER TABLE Teams DROP COLUMN InheritParentMembership;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND column_name = 'ParentTeamId'
+    ) > 0,
+    'ALTER TABLE Teams DROP COLUMN ParentTeamId;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND column_name = 'ParentTeamId'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE Teams ADD COLUMN ParentTeamId VARCHAR(26) DEFAULT "";'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND column_name = 'InheritParentMembership'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE Teams ADD COLUMN InheritParentMembership tinyint(1) DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'Teams'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_teams_parent_team_id'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_teams_parent_team_id ON Teams(ParentTeamId);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS CannedResponses (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    Shortcut varchar(64) NOT NULL,
+    Content text,
+    UseCount bigint(20) DEFAULT 0,
+    LastUsedAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    UNIQUE KEY idx_cannedresponses_userid_teamid_shortcut (UserId, TeamId, Shortcut),
+    KEY idx_cannedresponses_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS TeamRequests (
+    Id varchar(26) NOT NULL,
+    RequesterId varchar(26) NOT NULL,
+    Name varchar(64) NOT NULL,
+    DisplayName varchar(64) NOT NULL,
+    Type varchar(255) NOT NULL,
+    Justification text,
+    Status varchar(32) NOT NULL,
+    ReviewerId varchar(26) NOT NULL,
+    ReviewNote text,
+    TeamId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_teamrequests_status_createat (Status, CreateAt),
+    KEY idx_teamrequests_requesterid (RequesterId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
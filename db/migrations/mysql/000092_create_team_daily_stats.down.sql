@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS TeamUserDailyStats;
+DROP TABLE IF EXISTS TeamChannelDailyStats;
+DROP TABLE IF EXISTS TeamDailyStats;
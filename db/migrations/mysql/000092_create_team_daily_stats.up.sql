@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS TeamDailyStats (
+    TeamId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    ActiveUsers bigint(20) DEFAULT 0,
+    PostCount bigint(20) DEFAULT 0,
+    FileCount bigint(20) DEFAULT 0,
+    FileSize bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS TeamChannelDailyStats (
+    TeamId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    PostCount bigint(20) DEFAULT 0,
+    PRIMARY KEY (ChannelId, Day),
+    KEY idx_teamchanneldailystats_teamid_day (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS TeamUserDailyStats (
+    TeamId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    PostCount bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId, UserId, Day),
+    KEY idx_teamuserdailystats_teamid_day (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS UserMerges (
+    Id varchar(26) NOT NULL,
+    PrimaryUserId varchar(26) NOT NULL,
+    DuplicateUserId varchar(26) NOT NULL,
+    RequesterId varchar(26) NOT NULL,
+    JobId varchar(26) NOT NULL,
+    Status varchar(32) NOT NULL,
+    Manifest mediumtext,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    RevertAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS TeamDeletions (
+    TeamId varchar(26) NOT NULL,
+    RequesterId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    DeleteAt bigint(20) DEFAULT 0,
+    ExportPath varchar(512) NOT NULL DEFAULT '',
+    ExportAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS IdempotencyKeys (
+    UserId varchar(26) NOT NULL DEFAULT '',
+    IdempotencyKey varchar(255) NOT NULL,
+    RequestHash varchar(64) NOT NULL,
+    StatusCode int DEFAULT 0,
+    Response mediumtext,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, IdempotencyKey),
+    KEY idx_idempotencykeys_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ChannelArchiveWarnings;
+DROP TABLE IF EXISTS ChannelArchivePolicies;
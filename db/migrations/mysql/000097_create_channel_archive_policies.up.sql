@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS ChannelArchivePolicies (
+    TeamId varchar(26) NOT NULL,
+    InactiveDays int DEFAULT 0,
+    ExcludedChannelIds text,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS ChannelArchiveWarnings (
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    LastActivityAt bigint(20) DEFAULT 0,
+    WarnedAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (ChannelId),
+    KEY idx_channelarchivewarnings_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS PermissionDenials (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Permissions varchar(1024) NOT NULL,
+    ResourceType varchar(16) NOT NULL,
+    ResourceId varchar(26) NOT NULL DEFAULT '',
+    Path varchar(512) NOT NULL DEFAULT '',
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_permissiondenials_userid_createat (UserId, CreateAt),
+    KEY idx_permissiondenials_resourceid_createat (ResourceId, CreateAt),
+    KEY idx_permissiondenials_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS TeamAliases (
+    Name varchar(64) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Name),
+    KEY idx_teamaliases_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS BotTokenRotations (
+    BotUserId varchar(26) NOT NULL,
+    RotationDays int(11) NOT NULL,
+    GraceHours int(11) NOT NULL DEFAULT 0,
+    WebhookURL varchar(1024) NOT NULL DEFAULT '',
+    CurrentTokenId varchar(26) NOT NULL DEFAULT '',
+    LastRotatedAt bigint(20) DEFAULT 0,
+    NextRotationAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (BotUserId),
+    KEY idx_bottokenrotations_nextrotationat (NextRotationAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
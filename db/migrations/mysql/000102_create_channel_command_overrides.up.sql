@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS ChannelCommandOverrides (
+    ChannelId varchar(26) NOT NULL,
+    `Trigger` varchar(128) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (ChannelId, `Trigger`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS Impersonations (
+    Id varchar(26) NOT NULL,
+    ImpersonatorId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Reason text,
+    DurationMinutes int DEFAULT 0,
+    RequireConsent tinyint(1) DEFAULT 0,
+    Status varchar(32) NOT NULL,
+    SessionId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    StartAt bigint(20) DEFAULT 0,
+    ExpiresAt bigint(20) DEFAULT 0,
+    EndAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_impersonations_userid_createat (UserId, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
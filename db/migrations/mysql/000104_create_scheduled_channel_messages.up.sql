@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS ScheduledChannelMessages (
+    Id varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    Message text,
+    Frequency varchar(16) NOT NULL,
+    Weekday int DEFAULT 0,
+    DayOfMonth int DEFAULT 0,
+    TimeOfDay varchar(5) NOT NULL,
+    Timezone varchar(64) NOT NULL,
+    SkipWeekends tinyint(1) DEFAULT 0,
+    SkipDates text,
+    NextRunAt bigint(20) DEFAULT 0,
+    LastRunAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_scheduledchannelmessages_channelid (ChannelId),
+    KEY idx_scheduledchannelmessages_nextrunat (NextRunAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
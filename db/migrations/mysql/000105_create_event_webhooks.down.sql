@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS EventWebhookDeliveries;
+DROP TABLE IF EXISTS EventWebhooks;
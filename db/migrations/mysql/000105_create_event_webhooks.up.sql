@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS EventWebhooks (
+    Id varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    DisplayName varchar(64),
+    Description text,
+    URL text NOT NULL,
+    Secret varchar(32) NOT NULL,
+    Events text,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS EventWebhookDeliveries (
+    Id varchar(26) NOT NULL,
+    WebhookId varchar(26) NOT NULL,
+    Event varchar(64) NOT NULL,
+    Payload mediumtext,
+    Status varchar(32) NOT NULL,
+    Attempts int DEFAULT 0,
+    ResponseCode int DEFAULT 0,
+    Error text,
+    NextAttemptAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_eventwebhookdeliveries_webhookid_createat (WebhookId, CreateAt),
+    KEY idx_eventwebhookdeliveries_status_nextattemptat (Status, NextAttemptAt),
+    KEY idx_eventwebhookdeliveries_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ConfigHistory (
+    Id varchar(26) NOT NULL,
+    Version varchar(64) NOT NULL,
+    UserId varchar(26),
+    Value mediumtext,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_confighistory_version (Version),
+    KEY idx_confighistory_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS UploadUsage (
+    UserId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    Bytes bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
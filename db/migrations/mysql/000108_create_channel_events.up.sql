@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS ChannelEvents (
+    Id varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    UserId varchar(26),
+    Type varchar(32) NOT NULL,
+    OldValue varchar(64),
+    NewValue varchar(64),
+    CreateAt bigint(20) DEFAULT 0,
+    RevertedAt bigint(20) DEFAULT 0,
+    RevertedBy varchar(26),
+    PRIMARY KEY (Id),
+    KEY idx_channelevents_channelid_createat (ChannelId, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
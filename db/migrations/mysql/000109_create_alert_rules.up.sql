@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS AlertRules (
+    Id varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    Name varchar(64) NOT NULL,
+    Metric varchar(32) NOT NULL,
+    Threshold bigint(20) DEFAULT 0,
+    WindowMinutes int DEFAULT 0,
+    CooldownMinutes int DEFAULT 0,
+    ChannelId varchar(26),
+    DMUserIds text,
+    Enabled tinyint(1) DEFAULT 1,
+    State varchar(32) NOT NULL,
+    LastValue bigint(20) DEFAULT 0,
+    LastEvaluatedAt bigint(20) DEFAULT 0,
+    LastNotifiedAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
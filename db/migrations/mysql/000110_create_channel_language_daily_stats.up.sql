@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS ChannelLanguageDailyStats (
+    ChannelId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    Language varchar(16) NOT NULL,
+    PostCount bigint(20) DEFAULT 0,
+    PRIMARY KEY (ChannelId, Day, Language),
+    KEY idx_channellanguagedailystats_day (Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
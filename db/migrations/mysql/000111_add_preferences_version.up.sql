@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Preferences'
+        AND table_schema = DATABASE()
+        AND column_name = 'Version'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE Preferences ADD COLUMN Version bigint(20) NOT NULL DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS TeamInviteUsage (
+    TeamId varchar(26) NOT NULL,
+    Day bigint(20) NOT NULL,
+    Invites bigint(20) DEFAULT 0,
+    ExtraInvites bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId, Day)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
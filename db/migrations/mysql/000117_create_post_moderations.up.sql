@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS PostModerations (
+    Id varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Post mediumtext,
+    Action varchar(32) NOT NULL,
+    Reason text,
+    Status varchar(32) NOT NULL,
+    ReviewerId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_postmoderations_status_createat (Status, CreateAt),
+    KEY idx_postmoderations_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
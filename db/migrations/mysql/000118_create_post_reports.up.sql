@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS PostReports (
+    Id varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    PostUserId varchar(26) NOT NULL,
+    ReporterId varchar(26) NOT NULL,
+    Anonymous tinyint(1) DEFAULT 0,
+    Reason varchar(32) NOT NULL,
+    Comment text,
+    Status varchar(32) NOT NULL,
+    Resolution varchar(32) NOT NULL,
+    ResolverId varchar(26) NOT NULL,
+    Note text,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    UNIQUE KEY idx_postreports_postid_reporterid (PostId, ReporterId),
+    KEY idx_postreports_status_createat (Status, CreateAt),
+    KEY idx_postreports_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS ActivityEvents (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Type varchar(32) NOT NULL,
+    TeamId varchar(26),
+    ChannelId varchar(26) NOT NULL,
+    PostId varchar(26),
+    FileId varchar(26),
+    EmojiName varchar(64),
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_activityevents_userid_createat (UserId, CreateAt),
+    KEY idx_activityevents_postid (PostId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
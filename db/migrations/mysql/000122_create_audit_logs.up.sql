@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS AuditLogs (
+    Id varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    Event varchar(128) NOT NULL,
+    Status varchar(32) NOT NULL DEFAULT '',
+    UserId varchar(128) NOT NULL DEFAULT '',
+    SessionId varchar(64) NOT NULL DEFAULT '',
+    IpAddress varchar(64) NOT NULL DEFAULT '',
+    Client varchar(512) NOT NULL DEFAULT '',
+    APIPath varchar(512) NOT NULL DEFAULT '',
+    TargetType varchar(32) NOT NULL DEFAULT '',
+    TargetId varchar(26) NOT NULL DEFAULT '',
+    Meta text,
+    PRIMARY KEY (Id),
+    KEY idx_auditlogs_userid_createat (UserId, CreateAt),
+    KEY idx_auditlogs_event_createat (Event, CreateAt),
+    KEY idx_auditlogs_targetid_createat (TargetId, CreateAt),
+    KEY idx_auditlogs_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
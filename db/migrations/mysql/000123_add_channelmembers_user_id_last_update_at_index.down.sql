@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_user_id_last_update_at'
+    ) > 0,
+    'DROP INDEX idx_channelmembers_user_id_last_update_at ON ChannelMembers;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelMembers'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelmembers_user_id_last_update_at'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_channelmembers_user_id_last_update_at ON ChannelMembers(UserId, LastUpdateAt);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'TeamChannelDailyStats'
+        AND table_schema = DATABASE()
+        AND column_name = 'ActiveMembers'
+    ) > 0,
+    'ALTER TABLE TeamChannelDailyStats DROP COLUMN ActiveMembers;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
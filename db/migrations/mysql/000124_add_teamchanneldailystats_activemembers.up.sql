@@ -0,0 +1,16 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'TeamChannelDailyStats'
+        AND table_schema = DATABASE()
+        AND column_name = 'ActiveMembers'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE TeamChannelDailyStats ADD COLUMN ActiveMembers bigint(20) DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+DELETE FROM Systems WHERE Name = 'TeamStatsLastRollupDay';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS SharedChannelInvites (
+    Id varchar(26) NOT NULL,
+    RemoteId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) DEFAULT '',
+    Type varchar(1) DEFAULT '',
+    Name varchar(64) DEFAULT '',
+    DisplayName varchar(64) DEFAULT '',
+    Header text,
+    Purpose varchar(250) DEFAULT '',
+    ReadOnly tinyint(1) DEFAULT 0,
+    MemberCount bigint(20) DEFAULT 0,
+    PostCount bigint(20) DEFAULT 0,
+    Status varchar(16) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    DecidedAt bigint(20) DEFAULT 0,
+    DecidedBy varchar(26) DEFAULT '',
+    PRIMARY KEY (Id),
+    KEY idx_sharedchannelinvites_status_create_at (Status, CreateAt),
+    KEY idx_sharedchannelinvites_remote_id_channel_id (RemoteId, ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'TeamAliases'
+        AND table_schema = DATABASE()
+        AND column_name = 'ExpireAt'
+    ) > 0,
+    'ALTER TABLE TeamAliases DROP COLUMN ExpireAt;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS LicenseUsage (
+    Month bigint(20) NOT NULL,
+    MonthlyActiveUsers bigint(20) DEFAULT 0,
+    PostCount bigint(20) DEFAULT 0,
+    StorageBytes bigint(20) DEFAULT 0,
+    IncomingWebhooks bigint(20) DEFAULT 0,
+    OutgoingWebhooks bigint(20) DEFAULT 0,
+    Commands bigint(20) DEFAULT 0,
+    Bots bigint(20) DEFAULT 0,
+    OAuthApps bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Month)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
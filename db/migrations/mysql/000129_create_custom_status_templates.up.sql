@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS CustomStatusTemplates (
+    Id varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    OverrideId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    Emoji varchar(64) NOT NULL,
+    Text varchar(100) NOT NULL,
+    Duration varchar(32) NOT NULL,
+    UseCount bigint(20) DEFAULT 0,
+    LastUsedAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_customstatustemplates_teamid (TeamId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS RESTProxyBridgeMessages (
+    Id varchar(26) NOT NULL,
+    Event varchar(64) NOT NULL,
+    Topic varchar(255) NOT NULL,
+    MessageKey varchar(26) NOT NULL,
+    Payload mediumtext,
+    Attempts int DEFAULT 0,
+    LastError text,
+    NextAttemptAt bigint(20) DEFAULT 0,
+    PublishedAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_restproxybridgemessages_publishedat_createat (PublishedAt, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ChannelFeedItems;
+DROP TABLE IF EXISTS ChannelFeeds;
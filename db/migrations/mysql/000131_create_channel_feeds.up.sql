@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS ChannelFeeds (
+    Id varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    URL text NOT NULL,
+    Template text,
+    PollIntervalMinutes int DEFAULT 0,
+    LastPolledAt bigint(20) DEFAULT 0,
+    NextPollAt bigint(20) DEFAULT 0,
+    LastError text,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_channelfeeds_channelid (ChannelId),
+    KEY idx_channelfeeds_nextpollat (NextPollAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS ChannelFeedItems (
+    FeedId varchar(26) NOT NULL,
+    ItemGuid varchar(255) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (FeedId, ItemGuid)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS OutstandingMentions (
+    UserId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    RootId varchar(26) NOT NULL,
+    MentionedAt bigint(20) DEFAULT 0,
+    ResolvedAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (UserId, PostId),
+    KEY idx_outstandingmentions_userid_resolvedat_mentionedat (UserId, ResolvedAt, MentionedAt),
+    KEY idx_outstandingmentions_mentionedat (MentionedAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS BotStates (
+    BotUserId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    StateKey varchar(150) NOT NULL,
+    Value text,
+    ExpireAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) DEFAULT 0,
+    UpdateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (BotUserId, UserId, ChannelId, StateKey),
+    KEY idx_botstates_userid (UserId),
+    KEY idx_botstates_expireat (ExpireAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS OAuthAppUsage (
+    AppId varchar(26) NOT NULL,
+    BucketAt bigint(20) NOT NULL,
+    Count bigint(20) DEFAULT 0,
+    LimitedCount bigint(20) DEFAULT 0,
+    PRIMARY KEY (AppId, BucketAt),
+    KEY idx_oauthappusage_bucketat (BucketAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
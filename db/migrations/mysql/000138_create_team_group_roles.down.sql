@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS TeamGroupRoleGrants;
+DROP TABLE IF EXISTS TeamGroupRoles;
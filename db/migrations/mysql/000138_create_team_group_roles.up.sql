@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS TeamGroupRoles (
+    TeamId varchar(26) NOT NULL,
+    GroupId varchar(26) NOT NULL,
+    Role varchar(64) NOT NULL,
+    CreatorId varchar(26) DEFAULT '',
+    CreateAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId, GroupId),
+    KEY idx_teamgrouproles_groupid (GroupId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS TeamGroupRoleGrants (
+    TeamId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    GrantedAt bigint(20) DEFAULT 0,
+    PRIMARY KEY (TeamId, UserId),
+    KEY idx_teamgrouprolegrants_userid (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_apiusage_tokenid_bucketat;
+DROP INDEX IF EXISTS idx_apiusage_bucketat;
+
+DROP TABLE IF EXISTS apiusage;
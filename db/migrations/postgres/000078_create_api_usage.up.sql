@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS apiusage (
+    userid VARCHAR(26) NOT NULL,
+    tokenid VARCHAR(26) NOT NULL DEFAULT '',
+    route VARCHAR(128) NOT NULL,
+    bucketat bigint NOT NULL,
+    count bigint DEFAULT 0,
+    PRIMARY KEY (userid, tokenid, route, bucketat)
+);
+
+CREATE INDEX IF NOT EXISTS idx_apiusage_bucketat ON apiusage (bucketat);
+CREATE INDEX IF NOT EXISTS idx_apiusage_tokenid_bucketat ON apiusage (tokenid, bucketat);
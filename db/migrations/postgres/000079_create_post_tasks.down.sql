@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_posttasks_channelid;
+DROP INDEX IF EXISTS idx_posttasks_assigneeid_status_dueat;
+
+DROP TABLE IF EXISTS posttasks;
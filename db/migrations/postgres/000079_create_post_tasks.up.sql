@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS posttasks (
+    postid VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    assigneeid VARCHAR(26) NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL,
+    dueat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_posttasks_assigneeid_status_dueat ON posttasks (assigneeid, status, dueat);
+CREATE INDEX IF NOT EXISTS idx_posttasks_channelid ON posttasks (channelid);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_channeldigests_cadence_lastsentat;
+
+DROP TABLE IF EXISTS channeldigests;
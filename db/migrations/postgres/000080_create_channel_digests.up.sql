@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS channeldigests (
+    userid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    cadence VARCHAR(16) NOT NULL,
+    lastsentat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (userid, channelid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channeldigests_cadence_lastsentat ON channeldigests (cadence, lastsentat);
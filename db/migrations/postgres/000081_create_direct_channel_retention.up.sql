@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS directchannelretention (
+    channelid VARCHAR(26) PRIMARY KEY,
+    retentiondays bigint NOT NULL,
+    proposedby VARCHAR(26) NOT NULL,
+    proposedat bigint DEFAULT 0,
+    acceptedat bigint DEFAULT 0,
+    lastpurgeat bigint DEFAULT 0
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS postacknowledgements (
+    postid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    acknowledgedat bigint DEFAULT 0,
+    PRIMARY KEY (postid, userid)
+);
@@ -0,0 +1 @@
+ALTER TABLE remoteclusters ADD COLUMN IF NOT EXISTS hiddenprofilefields VARCHAR(256) DEFAULT '';
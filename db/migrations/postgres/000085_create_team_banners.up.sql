@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS teambanners (
+    id VARCHAR(26) PRIMARY KEY,
+    teamid VARCHAR(26) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    message VARCHAR(4000),
+    backgroundcolor VARCHAR(16) NOT NULL,
+    textcolor VARCHAR(16) NOT NULL,
+    allowdismissal boolean DEFAULT false,
+    startat bigint DEFAULT 0,
+    endat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    deleteat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_teambanners_teamid_deleteat ON teambanners (teamid, deleteat);
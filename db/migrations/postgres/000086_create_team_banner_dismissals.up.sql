@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS teambannerdismissals (
+    bannerid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    dismissedat bigint DEFAULT 0,
+    PRIMARY KEY (userid, bannerid)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS emailsuppressions (
+    email VARCHAR(128) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    provider VARCHAR(32) NOT NULL,
+    details text,
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (email)
+);
+
+CREATE INDEX IF NOT EXISTS idx_emailsuppressions_create_at ON emailsuppressions (createat);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_channelmembers_channel_id_last_viewed_at;
+DROP INDEX IF EXISTS idx_channelmembers_channel_id_scheme_admin;
+DROP INDEX IF EXISTS idx_posts_channel_id_user_id_create_at;
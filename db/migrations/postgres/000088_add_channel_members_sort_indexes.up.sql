@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_channelmembers_channel_id_last_viewed_at ON channelmembers(channelid, lastviewedat);
+CREATE INDEX IF NOT EXISTS idx_channelmembers_channel_id_scheme_admin ON channelmembers(channelid, schemeadmin);
+CREATE INDEX IF NOT EXISTS idx_posts_channel_id_user_id_create_at ON posts(channelid, userid, createat);
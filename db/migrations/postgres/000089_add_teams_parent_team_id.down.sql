@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_teams_parent_team_id;
+ALTER TABLE teams DROP COLUMN IF EXISTS inheritparentmembership;
+ALTER TABLE teams DROP COLUMN IF EXISTS parentteamid;
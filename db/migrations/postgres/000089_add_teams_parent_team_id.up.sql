@@ -0,0 +1,3 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS parentteamid VARCHAR(26) DEFAULT '';
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS inheritparentmembership boolean DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_teams_parent_team_id ON teams(parentteamid);
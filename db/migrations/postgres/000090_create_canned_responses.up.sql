@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS cannedresponses (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    shortcut VARCHAR(64) NOT NULL,
+    content VARCHAR(65535),
+    usecount bigint DEFAULT 0,
+    lastusedat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    UNIQUE (userid, teamid, shortcut)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cannedresponses_teamid ON cannedresponses (teamid);
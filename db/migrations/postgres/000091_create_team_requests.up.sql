@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS teamrequests (
+    id VARCHAR(26) PRIMARY KEY,
+    requesterid VARCHAR(26) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    displayname VARCHAR(64) NOT NULL,
+    type VARCHAR(255) NOT NULL,
+    justification VARCHAR(1024),
+    status VARCHAR(32) NOT NULL,
+    reviewerid VARCHAR(26) NOT NULL,
+    reviewnote VARCHAR(1024),
+    teamid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamrequests_status_createat ON teamrequests (status, createat);
+CREATE INDEX IF NOT EXISTS idx_teamrequests_requesterid ON teamrequests (requesterid);
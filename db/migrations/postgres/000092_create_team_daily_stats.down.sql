@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS teamuserdailystats;
+DROP TABLE IF EXISTS teamchanneldailystats;
+DROP TABLE IF EXISTS teamdailystats;
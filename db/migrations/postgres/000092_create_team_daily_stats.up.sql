@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS teamdailystats (
+    teamid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    activeusers bigint DEFAULT 0,
+    postcount bigint DEFAULT 0,
+    filecount bigint DEFAULT 0,
+    filesize bigint DEFAULT 0,
+    PRIMARY KEY (teamid, day)
+);
+
+CREATE TABLE IF NOT EXISTS teamchanneldailystats (
+    teamid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    postcount bigint DEFAULT 0,
+    PRIMARY KEY (channelid, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamchanneldailystats_teamid_day ON teamchanneldailystats (teamid, day);
+
+CREATE TABLE IF NOT EXISTS teamuserdailystats (
+    teamid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    postcount bigint DEFAULT 0,
+    PRIMARY KEY (teamid, userid, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamuserdailystats_teamid_day ON teamuserdailystats (teamid, day);
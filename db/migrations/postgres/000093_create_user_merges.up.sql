@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS usermerges (
+    id VARCHAR(26) PRIMARY KEY,
+    primaryuserid VARCHAR(26) NOT NULL,
+    duplicateuserid VARCHAR(26) NOT NULL,
+    requesterid VARCHAR(26) NOT NULL,
+    jobid VARCHAR(26) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    manifest text,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    revertat bigint DEFAULT 0
+);
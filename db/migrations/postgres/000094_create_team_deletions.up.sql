@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS teamdeletions (
+    teamid VARCHAR(26) PRIMARY KEY,
+    requesterid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    deleteat bigint DEFAULT 0,
+    exportpath VARCHAR(512) NOT NULL DEFAULT '',
+    exportat bigint DEFAULT 0
+);
@@ -0,0 +1 @@
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS defaultlocale VARCHAR(5) DEFAULT '';
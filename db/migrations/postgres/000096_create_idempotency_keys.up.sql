@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS idempotencykeys (
+    userid VARCHAR(26) NOT NULL DEFAULT '',
+    idempotencykey VARCHAR(255) NOT NULL,
+    requesthash VARCHAR(64) NOT NULL,
+    statuscode integer DEFAULT 0,
+    response text,
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (userid, idempotencykey)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotencykeys_createat ON idempotencykeys (createat);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS channelarchivewarnings;
+DROP TABLE IF EXISTS channelarchivepolicies;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS channelarchivepolicies (
+    teamid VARCHAR(26) PRIMARY KEY,
+    inactivedays integer DEFAULT 0,
+    excludedchannelids text,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS channelarchivewarnings (
+    channelid VARCHAR(26) PRIMARY KEY,
+    teamid VARCHAR(26) NOT NULL,
+    lastactivityat bigint DEFAULT 0,
+    warnedat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_channelarchivewarnings_teamid ON channelarchivewarnings (teamid);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS permissiondenials (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    permissions VARCHAR(1024) NOT NULL,
+    resourcetype VARCHAR(16) NOT NULL,
+    resourceid VARCHAR(26) NOT NULL DEFAULT '',
+    path VARCHAR(512) NOT NULL DEFAULT '',
+    createat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_permissiondenials_userid_createat ON permissiondenials (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_permissiondenials_resourceid_createat ON permissiondenials (resourceid, createat);
+CREATE INDEX IF NOT EXISTS idx_permissiondenials_createat ON permissiondenials (createat);
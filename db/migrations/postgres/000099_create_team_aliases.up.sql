@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS teamaliases (
+    name VARCHAR(64) PRIMARY KEY,
+    teamid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamaliases_teamid ON teamaliases (teamid);
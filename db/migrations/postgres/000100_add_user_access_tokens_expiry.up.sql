@@ -0,0 +1,2 @@
+ALTER TABLE useraccesstokens ADD COLUMN IF NOT EXISTS createat bigint DEFAULT 0;
+ALTER TABLE useraccesstokens ADD COLUMN IF NOT EXISTS expiresat bigint DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS bottokenrotations (
+    botuserid VARCHAR(26) PRIMARY KEY,
+    rotationdays integer NOT NULL,
+    gracehours integer NOT NULL DEFAULT 0,
+    webhookurl VARCHAR(1024) NOT NULL DEFAULT '',
+    currenttokenid VARCHAR(26) NOT NULL DEFAULT '',
+    lastrotatedat bigint DEFAULT 0,
+    nextrotationat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_bottokenrotations_nextrotationat ON bottokenrotations (nextrotationat);
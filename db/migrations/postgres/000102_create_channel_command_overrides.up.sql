@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS channelcommandoverrides (
+    channelid VARCHAR(26) NOT NULL,
+    trigger VARCHAR(128) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (channelid, trigger)
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS impersonations (
+    id VARCHAR(26) PRIMARY KEY,
+    impersonatorid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    reason VARCHAR(1024),
+    durationminutes integer DEFAULT 0,
+    requireconsent boolean DEFAULT false,
+    status VARCHAR(32) NOT NULL,
+    sessionid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    startat bigint DEFAULT 0,
+    expiresat bigint DEFAULT 0,
+    endat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_impersonations_userid_createat ON impersonations (userid, createat);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS scheduledchannelmessages (
+    id VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    message VARCHAR(65535),
+    frequency VARCHAR(16) NOT NULL,
+    weekday integer DEFAULT 0,
+    dayofmonth integer DEFAULT 0,
+    timeofday VARCHAR(5) NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    skipweekends boolean DEFAULT false,
+    skipdates text,
+    nextrunat bigint DEFAULT 0,
+    lastrunat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduledchannelmessages_channelid ON scheduledchannelmessages (channelid);
+CREATE INDEX IF NOT EXISTS idx_scheduledchannelmessages_nextrunat ON scheduledchannelmessages (nextrunat);
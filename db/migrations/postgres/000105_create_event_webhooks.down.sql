@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS eventwebhookdeliveries;
+DROP TABLE IF EXISTS eventwebhooks;
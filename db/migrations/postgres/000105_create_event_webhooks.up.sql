@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS eventwebhooks (
+    id VARCHAR(26) PRIMARY KEY,
+    creatorid VARCHAR(26) NOT NULL,
+    displayname VARCHAR(64),
+    description VARCHAR(500),
+    url VARCHAR(1024) NOT NULL,
+    secret VARCHAR(32) NOT NULL,
+    events VARCHAR(1024),
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS eventwebhookdeliveries (
+    id VARCHAR(26) PRIMARY KEY,
+    webhookid VARCHAR(26) NOT NULL,
+    event VARCHAR(64) NOT NULL,
+    payload text,
+    status VARCHAR(32) NOT NULL,
+    attempts integer DEFAULT 0,
+    responsecode integer DEFAULT 0,
+    error VARCHAR(1024),
+    nextattemptat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_eventwebhookdeliveries_webhookid_createat ON eventwebhookdeliveries (webhookid, createat);
+CREATE INDEX IF NOT EXISTS idx_eventwebhookdeliveries_status_nextattemptat ON eventwebhookdeliveries (status, nextattemptat);
+CREATE INDEX IF NOT EXISTS idx_eventwebhookdeliveries_createat ON eventwebhookdeliveries (createat);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS confighistory (
+    id VARCHAR(26) PRIMARY KEY,
+    version VARCHAR(64) NOT NULL,
+    userid VARCHAR(26),
+    value text,
+    createat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_confighistory_version ON confighistory (version);
+CREATE INDEX IF NOT EXISTS idx_confighistory_createat ON confighistory (createat);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS uploadusage (
+    userid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    bytes bigint DEFAULT 0,
+    PRIMARY KEY (userid, day)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS channelevents (
+    id VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26),
+    type VARCHAR(32) NOT NULL,
+    oldvalue VARCHAR(64),
+    newvalue VARCHAR(64),
+    createat bigint DEFAULT 0,
+    revertedat bigint DEFAULT 0,
+    revertedby VARCHAR(26)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channelevents_channelid_createat ON channelevents (channelid, createat);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS alertrules (
+    id VARCHAR(26) PRIMARY KEY,
+    creatorid VARCHAR(26) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    metric VARCHAR(32) NOT NULL,
+    threshold bigint DEFAULT 0,
+    windowminutes integer DEFAULT 0,
+    cooldownminutes integer DEFAULT 0,
+    channelid VARCHAR(26),
+    dmuserids VARCHAR(1000),
+    enabled boolean DEFAULT true,
+    state VARCHAR(32) NOT NULL,
+    lastvalue bigint DEFAULT 0,
+    lastevaluatedat bigint DEFAULT 0,
+    lastnotifiedat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
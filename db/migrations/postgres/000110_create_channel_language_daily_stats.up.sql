@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS channellanguagedailystats (
+    channelid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    language VARCHAR(16) NOT NULL,
+    postcount bigint DEFAULT 0,
+    PRIMARY KEY (channelid, day, language)
+);
+
+CREATE INDEX IF NOT EXISTS idx_channellanguagedailystats_day ON channellanguagedailystats (day);
@@ -0,0 +1 @@
+ALTER TABLE preferences DROP COLUMN IF EXISTS version;
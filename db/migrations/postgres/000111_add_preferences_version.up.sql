@@ -0,0 +1 @@
+ALTER TABLE preferences ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS teaminviteusage (
+    teamid VARCHAR(26) NOT NULL,
+    day bigint NOT NULL,
+    invites bigint DEFAULT 0,
+    extrainvites bigint DEFAULT 0,
+    PRIMARY KEY (teamid, day)
+);
@@ -0,0 +1 @@
+ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS externalurl VARCHAR(2048) DEFAULT '';
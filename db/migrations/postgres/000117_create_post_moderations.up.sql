@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS postmoderations (
+    id VARCHAR(26) PRIMARY KEY,
+    postid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    post text,
+    action VARCHAR(32) NOT NULL,
+    reason VARCHAR(1024),
+    status VARCHAR(32) NOT NULL,
+    reviewerid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_postmoderations_status_createat ON postmoderations (status, createat);
+CREATE INDEX IF NOT EXISTS idx_postmoderations_teamid ON postmoderations (teamid);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS postreports (
+    id VARCHAR(26) PRIMARY KEY,
+    postid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    postuserid VARCHAR(26) NOT NULL,
+    reporterid VARCHAR(26) NOT NULL,
+    anonymous boolean DEFAULT false,
+    reason VARCHAR(32) NOT NULL,
+    comment VARCHAR(1024),
+    status VARCHAR(32) NOT NULL,
+    resolution VARCHAR(32) NOT NULL,
+    resolverid VARCHAR(26) NOT NULL,
+    note VARCHAR(1024),
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    UNIQUE (postid, reporterid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_postreports_status_createat ON postreports (status, createat);
+CREATE INDEX IF NOT EXISTS idx_postreports_teamid ON postreports (teamid);
@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS strictisolation boolean;
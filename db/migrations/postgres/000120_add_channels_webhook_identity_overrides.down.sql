@@ -0,0 +1,2 @@
+ALTER TABLE channels DROP COLUMN IF EXISTS disablewebhookiconoverride;
+ALTER TABLE channels DROP COLUMN IF EXISTS disablewebhookusernameoverride;
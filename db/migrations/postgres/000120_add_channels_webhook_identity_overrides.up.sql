@@ -0,0 +1,2 @@
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS disablewebhookusernameoverride boolean DEFAULT false;
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS disablewebhookiconoverride boolean DEFAULT false;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS activityevents (
+    id VARCHAR(26) PRIMARY KEY,
+    userid VARCHAR(26) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    teamid VARCHAR(26),
+    channelid VARCHAR(26) NOT NULL,
+    postid VARCHAR(26),
+    fileid VARCHAR(26),
+    emojiname VARCHAR(64),
+    createat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_activityevents_userid_createat ON activityevents (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_activityevents_postid ON activityevents (postid);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS auditlogs (
+    id VARCHAR(26) PRIMARY KEY,
+    createat bigint DEFAULT 0,
+    event VARCHAR(128) NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT '',
+    userid VARCHAR(128) NOT NULL DEFAULT '',
+    sessionid VARCHAR(64) NOT NULL DEFAULT '',
+    ipaddress VARCHAR(64) NOT NULL DEFAULT '',
+    client VARCHAR(512) NOT NULL DEFAULT '',
+    apipath VARCHAR(512) NOT NULL DEFAULT '',
+    targettype VARCHAR(32) NOT NULL DEFAULT '',
+    targetid VARCHAR(26) NOT NULL DEFAULT '',
+    meta text
+);
+
+CREATE INDEX IF NOT EXISTS idx_auditlogs_userid_createat ON auditlogs (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_event_createat ON auditlogs (event, createat);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_targetid_createat ON auditlogs (targetid, createat);
+CREATE INDEX IF NOT EXISTS idx_auditlogs_createat ON auditlogs (createat);
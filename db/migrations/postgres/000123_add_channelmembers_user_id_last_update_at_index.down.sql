@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_channelmembers_user_id_last_update_at;
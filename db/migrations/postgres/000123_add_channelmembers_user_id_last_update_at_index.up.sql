@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_channelmembers_user_id_last_update_at ON channelmembers(userid, lastupdateat);
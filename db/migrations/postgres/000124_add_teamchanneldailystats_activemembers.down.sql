@@ -0,0 +1 @@
+ALTER TABLE teamchanneldailystats DROP COLUMN IF EXISTS activemembers;
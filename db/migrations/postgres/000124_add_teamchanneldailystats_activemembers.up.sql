@@ -0,0 +1,3 @@
+ALTER TABLE teamchanneldailystats ADD COLUMN IF NOT EXISTS activemembers bigint DEFAULT 0;
+
+DELETE FROM systems WHERE name = 'TeamStatsLastRollupDay';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS sharedchannelinvites (
+    id VARCHAR(26) PRIMARY KEY,
+    remoteid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) DEFAULT '',
+    type VARCHAR(1) DEFAULT '',
+    name VARCHAR(64) DEFAULT '',
+    displayname VARCHAR(64) DEFAULT '',
+    header VARCHAR(1024) DEFAULT '',
+    purpose VARCHAR(250) DEFAULT '',
+    readonly boolean DEFAULT false,
+    membercount bigint DEFAULT 0,
+    postcount bigint DEFAULT 0,
+    status VARCHAR(16) NOT NULL,
+    createat bigint NOT NULL,
+    decidedat bigint DEFAULT 0,
+    decidedby VARCHAR(26) DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_sharedchannelinvites_status_create_at ON sharedchannelinvites (status, createat);
+CREATE INDEX IF NOT EXISTS idx_sharedchannelinvites_remote_id_channel_id ON sharedchannelinvites (remoteid, channelid);
@@ -0,0 +1 @@
+ALTER TABLE remoteclusters DROP COLUMN IF EXISTS invitepolicy;
@@ -0,0 +1 @@
+ALTER TABLE remoteclusters ADD COLUMN IF NOT EXISTS invitepolicy VARCHAR(32) DEFAULT '';
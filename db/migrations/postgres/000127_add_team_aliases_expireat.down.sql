@@ -0,0 +1 @@
+ALTER TABLE teamaliases DROP COLUMN IF EXISTS expireat;
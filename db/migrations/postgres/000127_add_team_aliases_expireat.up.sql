@@ -0,0 +1 @@
+ALTER TABLE teamaliases ADD COLUMN IF NOT EXISTS expireat bigint DEFAULT 0;
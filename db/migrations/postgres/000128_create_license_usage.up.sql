@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS licenseusage (
+    month bigint NOT NULL,
+    monthlyactiveusers bigint DEFAULT 0,
+    postcount bigint DEFAULT 0,
+    storagebytes bigint DEFAULT 0,
+    incomingwebhooks bigint DEFAULT 0,
+    outgoingwebhooks bigint DEFAULT 0,
+    commands bigint DEFAULT 0,
+    bots bigint DEFAULT 0,
+    oauthapps bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    PRIMARY KEY (month)
+);
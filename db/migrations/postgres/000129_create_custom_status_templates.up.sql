@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS customstatustemplates (
+    id VARCHAR(26) PRIMARY KEY,
+    teamid VARCHAR(26) NOT NULL,
+    overrideid VARCHAR(26) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    emoji VARCHAR(64) NOT NULL,
+    text VARCHAR(100) NOT NULL,
+    duration VARCHAR(32) NOT NULL,
+    usecount bigint DEFAULT 0,
+    lastusedat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_customstatustemplates_teamid ON customstatustemplates (teamid);
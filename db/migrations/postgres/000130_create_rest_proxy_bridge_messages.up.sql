@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS restproxybridgemessages (
+    id VARCHAR(26) PRIMARY KEY,
+    event VARCHAR(64) NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    messagekey VARCHAR(26) NOT NULL,
+    payload text,
+    attempts integer DEFAULT 0,
+    lasterror VARCHAR(1024),
+    nextattemptat bigint DEFAULT 0,
+    publishedat bigint DEFAULT 0,
+    createat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_restproxybridgemessages_publishedat_createat ON restproxybridgemessages (publishedat, createat);
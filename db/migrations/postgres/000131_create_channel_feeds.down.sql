@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS channelfeeditems;
+DROP TABLE IF EXISTS channelfeeds;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS channelfeeds (
+    id VARCHAR(26) PRIMARY KEY,
+    channelid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    creatorid VARCHAR(26) NOT NULL,
+    url VARCHAR(1024) NOT NULL,
+    template text,
+    pollintervalminutes integer DEFAULT 0,
+    lastpolledat bigint DEFAULT 0,
+    nextpollat bigint DEFAULT 0,
+    lasterror VARCHAR(1024),
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_channelfeeds_channelid ON channelfeeds (channelid);
+CREATE INDEX IF NOT EXISTS idx_channelfeeds_nextpollat ON channelfeeds (nextpollat);
+
+CREATE TABLE IF NOT EXISTS channelfeeditems (
+    feedid VARCHAR(26) NOT NULL,
+    itemguid VARCHAR(255) NOT NULL,
+    postid VARCHAR(26) NOT NULL,
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (feedid, itemguid)
+);
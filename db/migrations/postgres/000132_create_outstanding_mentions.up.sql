@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outstandingmentions (
+    userid VARCHAR(26) NOT NULL,
+    postid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    teamid VARCHAR(26) NOT NULL,
+    rootid VARCHAR(26) NOT NULL,
+    mentionedat bigint DEFAULT 0,
+    resolvedat bigint DEFAULT 0,
+    PRIMARY KEY (userid, postid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_outstandingmentions_userid_resolvedat_mentionedat ON outstandingmentions (userid, resolvedat, mentionedat);
+CREATE INDEX IF NOT EXISTS idx_outstandingmentions_mentionedat ON outstandingmentions (mentionedat);
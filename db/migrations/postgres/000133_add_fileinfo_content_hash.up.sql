@@ -0,0 +1,4 @@
+ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS contenthash VARCHAR(64) DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_fileinfo_content_hash ON fileinfo(contenthash);
+CREATE INDEX IF NOT EXISTS idx_fileinfo_path ON fileinfo(path);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS botstates (
+    botuserid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    channelid VARCHAR(26) NOT NULL,
+    statekey VARCHAR(150) NOT NULL,
+    value text,
+    expireat bigint DEFAULT 0,
+    createat bigint DEFAULT 0,
+    updateat bigint DEFAULT 0,
+    PRIMARY KEY (botuserid, userid, channelid, statekey)
+);
+
+CREATE INDEX IF NOT EXISTS idx_botstates_userid ON botstates (userid);
+CREATE INDEX IF NOT EXISTS idx_botstates_expireat ON botstates (expireat);
@@ -0,0 +1,2 @@
+ALTER TABLE oauthapps DROP COLUMN IF EXISTS ratelimitmaxburst;
+ALTER TABLE oauthapps DROP COLUMN IF EXISTS ratelimitpersec;
@@ -0,0 +1,2 @@
+ALTER TABLE oauthapps ADD COLUMN IF NOT EXISTS ratelimitpersec integer DEFAULT 0;
+ALTER TABLE oauthapps ADD COLUMN IF NOT EXISTS ratelimitmaxburst integer DEFAULT 0;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS oauthappusage (
+    appid VARCHAR(26) NOT NULL,
+    bucketat bigint NOT NULL,
+    count bigint DEFAULT 0,
+    limitedcount bigint DEFAULT 0,
+    PRIMARY KEY (appid, bucketat)
+);
+
+CREATE INDEX IF NOT EXISTS idx_oauthappusage_bucketat ON oauthappusage (bucketat);
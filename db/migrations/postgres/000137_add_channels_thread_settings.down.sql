@@ -0,0 +1,2 @@
+ALTER TABLE channels DROP COLUMN IF EXISTS autofollowthreads;
+ALTER TABLE channels DROP COLUMN IF EXISTS forcethreadedreplies;
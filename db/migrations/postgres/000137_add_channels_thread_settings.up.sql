@@ -0,0 +1,2 @@
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS forcethreadedreplies boolean DEFAULT false;
+ALTER TABLE channels ADD COLUMN IF NOT EXISTS autofollowthreads boolean DEFAULT false;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS teamgrouprolegrants;
+DROP TABLE IF EXISTS teamgrouproles;
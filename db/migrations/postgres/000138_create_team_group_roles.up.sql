@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS teamgrouproles (
+    teamid VARCHAR(26) NOT NULL,
+    groupid VARCHAR(26) NOT NULL,
+    role VARCHAR(64) NOT NULL,
+    creatorid VARCHAR(26) DEFAULT '',
+    createat bigint DEFAULT 0,
+    PRIMARY KEY (teamid, groupid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamgrouproles_groupid ON teamgrouproles (groupid);
+
+CREATE TABLE IF NOT EXISTS teamgrouprolegrants (
+    teamid VARCHAR(26) NOT NULL,
+    userid VARCHAR(26) NOT NULL,
+    grantedat bigint DEFAULT 0,
+    PRIMARY KEY (teamid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teamgrouprolegrants_userid ON teamgrouprolegrants (userid);
@@ -54,12 +54,16 @@ type MetricsInterface interface {
 	IncrementFilesSearchCounter()
 	ObserveFilesSearchDuration(elapsed float64)
 	ObserveStoreMethodDuration(method, success string, elapsed float64)
+	IncrementStoreRetryCounter(method, reason string)
+	SetStoreReadOnlyMode(enabled bool)
 	ObserveAPIEndpointDuration(endpoint, method, statusCode string, elapsed float64)
 	IncrementPostIndexCounter()
 	IncrementFileIndexCounter()
 	IncrementUserIndexCounter()
 	IncrementChannelIndexCounter()
 
+	IncrementChannelsAutoArchivedCounter()
+
 	ObservePluginHookDuration(pluginID, hookName string, success bool, elapsed float64)
 	ObservePluginMultiHookIterationDuration(pluginID string, elapsed float64)
 	ObservePluginMultiHookDuration(elapsed float64)
@@ -80,4 +84,11 @@ type MetricsInterface interface {
 
 	SetReplicaLagAbsolute(node string, value float64)
 	SetReplicaLagTime(node string, value float64)
+
+	SetSMTPPoolQueueDepth(depth float64)
+	SetSMTPPoolOpenConnections(count float64)
+
+	ObserveRedisCacheRequestDuration(cacheName, operation string, elapsed float64)
+	IncrementRedisCacheErrorCounter(cacheName, operation string)
+	IncrementRedisCacheInvalidationCounter(cacheName string)
 }
@@ -62,6 +62,11 @@ func (_m *MetricsInterface) IncrementChannelIndexCounter() {
 	_m.Called()
 }
 
+// IncrementChannelsAutoArchivedCounter provides a mock function with given fields:
+func (_m *MetricsInterface) IncrementChannelsAutoArchivedCounter() {
+	_m.Called()
+}
+
 // IncrementClusterEventType provides a mock function with given fields: eventType
 func (_m *MetricsInterface) IncrementClusterEventType(eventType model.ClusterEvent) {
 	_m.Called(eventType)
@@ -182,6 +187,16 @@ func (_m *MetricsInterface) IncrementPostsSearchCounter() {
 	_m.Called()
 }
 
+// IncrementRedisCacheErrorCounter provides a mock function with given fields: cacheName, operation
+func (_m *MetricsInterface) IncrementRedisCacheErrorCounter(cacheName string, operation string) {
+	_m.Called(cacheName, operation)
+}
+
+// IncrementRedisCacheInvalidationCounter provides a mock function with given fields: cacheName
+func (_m *MetricsInterface) IncrementRedisCacheInvalidationCounter(cacheName string) {
+	_m.Called(cacheName)
+}
+
 // IncrementRemoteClusterConnStateChangeCounter provides a mock function with given fields: remoteID, online
 func (_m *MetricsInterface) IncrementRemoteClusterConnStateChangeCounter(remoteID string, online bool) {
 	_m.Called(remoteID, online)
@@ -202,6 +217,11 @@ func (_m *MetricsInterface) IncrementRemoteClusterMsgSentCounter(remoteID string
 	_m.Called(remoteID)
 }
 
+// IncrementStoreRetryCounter provides a mock function with given fields: method, reason
+func (_m *MetricsInterface) IncrementStoreRetryCounter(method string, reason string) {
+	_m.Called(method, reason)
+}
+
 // IncrementUserIndexCounter provides a mock function with given fields:
 func (_m *MetricsInterface) IncrementUserIndexCounter() {
 	_m.Called()
@@ -282,6 +302,11 @@ func (_m *MetricsInterface) ObservePostsSearchDuration(elapsed float64) {
 	_m.Called(elapsed)
 }
 
+// ObserveRedisCacheRequestDuration provides a mock function with given fields: cacheName, operation, elapsed
+func (_m *MetricsInterface) ObserveRedisCacheRequestDuration(cacheName string, operation string, elapsed float64) {
+	_m.Called(cacheName, operation, elapsed)
+}
+
 // ObserveRemoteClusterClockSkew provides a mock function with given fields: remoteID, skew
 func (_m *MetricsInterface) ObserveRemoteClusterClockSkew(remoteID string, skew float64) {
 	_m.Called(remoteID, skew)
@@ -311,3 +336,18 @@ func (_m *MetricsInterface) SetReplicaLagAbsolute(node string, value float64) {
 func (_m *MetricsInterface) SetReplicaLagTime(node string, value float64) {
 	_m.Called(node, value)
 }
+
+// SetSMTPPoolOpenConnections provides a mock function with given fields: count
+func (_m *MetricsInterface) SetSMTPPoolOpenConnections(count float64) {
+	_m.Called(count)
+}
+
+// SetSMTPPoolQueueDepth provides a mock function with given fields: depth
+func (_m *MetricsInterface) SetSMTPPoolQueueDepth(depth float64) {
+	_m.Called(depth)
+}
+
+// SetStoreReadOnlyMode provides a mock function with given fields: enabled
+func (_m *MetricsInterface) SetStoreReadOnlyMode(enabled bool) {
+	_m.Called(enabled)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package alert_rules
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// The windows and cooldowns of the rules are set in minutes, so the rules are evaluated every
+// minute.
+const schedFreq = time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ServiceSettings.EnableAdminAlerts
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeAlertRules, schedFreq, isEnabled)
+}
@@ -54,6 +54,16 @@ func NewDailyScheduler(jobs *JobServer, jobType string, startTimeFunc func(cfg *
 	}
 }
 
+// The nightly schedulers run their jobs when the server is the least busy.
+var nightlyStartTime = time.Date(0, time.January, 1, 1, 0, 0, 0, time.Local)
+
+func NewNightlyScheduler(jobs *JobServer, jobType string, enabledFunc func(cfg *model.Config) bool) *DailyScheduler {
+	startTimeFunc := func(_ *model.Config) *time.Time {
+		return &nightlyStartTime
+	}
+	return NewDailyScheduler(jobs, jobType, startTimeFunc, enabledFunc)
+}
+
 func (scheduler *DailyScheduler) Enabled(cfg *model.Config) bool {
 	return scheduler.enabledFunc(cfg)
 }
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package bot_token_rotation
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const schedFreq = time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	// The rotations are set per bot, so the scheduler is always enabled and the job does
+	// nothing when no bot has one.
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeBotTokenRotation, schedFreq, isEnabled)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package bot_token_rotation
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const jobName = "BotTokenRotation"
+
+type AppIface interface {
+	ProcessBotTokenRotations() (int, int, *model.AppError)
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	execute := func(job *model.Job) error {
+		rotated, revoked, appErr := app.ProcessBotTokenRotations()
+
+		if job.Data == nil {
+			job.Data = make(model.StringMap)
+		}
+		job.Data["rotated_count"] = strconv.Itoa(rotated)
+		job.Data["revoked_count"] = strconv.Itoa(revoked)
+		if err := jobServer.UpdateInProgressJobData(job); err != nil {
+			return err
+		}
+
+		if appErr != nil {
+			return appErr
+		}
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+	return worker
+}
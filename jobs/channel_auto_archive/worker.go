@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_auto_archive
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const jobName = "ChannelAutoArchive"
+
+type AppIface interface {
+	ProcessChannelArchivePolicies() (int, int, *model.AppError)
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	execute := func(job *model.Job) error {
+		archived, warned, appErr := app.ProcessChannelArchivePolicies()
+
+		if job.Data == nil {
+			job.Data = make(model.StringMap)
+		}
+		job.Data["archived_count"] = strconv.Itoa(archived)
+		job.Data["warned_count"] = strconv.Itoa(warned)
+		if err := jobServer.UpdateInProgressJobData(job); err != nil {
+			return err
+		}
+
+		if appErr != nil {
+			return appErr
+		}
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+	return worker
+}
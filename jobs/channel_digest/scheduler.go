@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_digest
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// Digests are due at most once a day per user, so checking hourly keeps them close
+// to their cadence without repeatedly scanning the subscriptions.
+const schedFreq = time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.EmailSettings.EnableChannelDigestEmails
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeChannelDigest, schedFreq, isEnabled)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_feeds
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// The poll intervals of the feeds are set in minutes, so the feeds due are polled every minute.
+const schedFreq = time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ServiceSettings.EnableChannelFeeds
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeChannelFeeds, schedFreq, isEnabled)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package data_retention_preview
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	jobName = "DataRetentionPreview"
+
+	// previewDirectory is where the CSV results are written in the file store.
+	previewDirectory = "data_retention_preview"
+)
+
+type AppIface interface {
+	GetRetentionPolicyPreviewCounts(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, *model.AppError)
+	WriteFile(fr io.Reader, path string) (int64, *model.AppError)
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return true
+	}
+	execute := func(job *model.Job) error {
+		postDuration, err := strconv.ParseInt(job.Data["post_duration"], 10, 64)
+		if err != nil || postDuration <= 0 {
+			return model.NewAppError("DataRetentionPreviewWorker", "app.data_retention.preview.post_duration.app_error", nil, "job_id="+job.Id, http.StatusBadRequest)
+		}
+
+		policy := &model.RetentionPolicyWithTeamAndChannelIDs{
+			RetentionPolicy: model.RetentionPolicy{
+				ID:           job.Data["policy_id"],
+				PostDuration: model.NewInt64(postDuration),
+			},
+			TeamIDs:    splitIDs(job.Data["team_ids"]),
+			ChannelIDs: splitIDs(job.Data["channel_ids"]),
+		}
+		endTime := model.GetMillis() - postDuration*24*60*60*1000
+
+		counts, appErr := app.GetRetentionPolicyPreviewCounts(policy, endTime)
+		if appErr != nil {
+			return appErr
+		}
+
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		w.Write([]string{"team_id", "team_name", "channel_id", "channel_name", "post_count", "file_count"})
+
+		var channelCount, postCount, fileCount int64
+		for _, count := range counts {
+			if count.PostCount == 0 {
+				continue
+			}
+			w.Write([]string{
+				count.TeamId,
+				count.TeamName,
+				count.ChannelId,
+				count.ChannelName,
+				strconv.FormatInt(count.PostCount, 10),
+				strconv.FormatInt(count.FileCount, 10),
+			})
+			channelCount++
+			postCount += count.PostCount
+			fileCount += count.FileCount
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+
+		filePath := path.Join(previewDirectory, job.Id+".csv")
+		if _, appErr := app.WriteFile(&buf, filePath); appErr != nil {
+			return appErr
+		}
+
+		job.Data["end_time"] = strconv.FormatInt(endTime, 10)
+		job.Data["file_path"] = filePath
+		job.Data["channel_count"] = strconv.FormatInt(channelCount, 10)
+		job.Data["post_count"] = strconv.FormatInt(postCount, 10)
+		job.Data["file_count"] = strconv.FormatInt(fileCount, 10)
+		if appErr := jobServer.UpdateInProgressJobData(job); appErr != nil {
+			return appErr
+		}
+
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+	return worker
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
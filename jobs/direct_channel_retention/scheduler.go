@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package direct_channel_retention
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// Retention periods are counted in days, so purging hourly keeps expired messages
+// from lingering for long without running the deletes constantly.
+const schedFreq = time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.DataRetentionSettings.EnableDirectChannelRetention
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeDirectChannelRetention, schedFreq, isEnabled)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package event_webhook_deliveries
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// The first retry of a failed delivery is due a minute after it, so the retries are checked
+// every minute to keep to the backoff.
+const schedFreq = time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ServiceSettings.EnableEventWebhooks
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeEventWebhookDeliveries, schedFreq, isEnabled)
+}
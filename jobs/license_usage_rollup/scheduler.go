@@ -0,0 +1,16 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package license_usage_rollup
+
+import (
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	return jobs.NewNightlyScheduler(jobServer, model.JobTypeLicenseUsageRollup, isEnabled)
+}
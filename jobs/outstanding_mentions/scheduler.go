@@ -0,0 +1,24 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package outstanding_mentions
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// The users are nudged about their outstanding mentions in the morning.
+var startTime = time.Date(0, time.January, 1, 9, 0, 0, 0, time.Local)
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	startTimeFunc := func(_ *model.Config) *time.Time {
+		return &startTime
+	}
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ServiceSettings.EnableOutstandingMentions
+	}
+	return jobs.NewDailyScheduler(jobServer, model.JobTypeOutstandingMentions, startTimeFunc, isEnabled)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rest_proxy_bridge
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// The outbox is published every minute, which bounds how late the events reach the message
+// queue while it's up.
+const schedFreq = time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.RESTProxyBridgeSettings.Enable
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeRESTProxyBridge, schedFreq, isEnabled)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package scheduled_channel_messages
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// Messages are scheduled to the minute, so checking every five minutes posts them at most
+// a few minutes late without scanning the schedules all the time.
+const schedFreq = 5 * time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ServiceSettings.EnableScheduledChannelMessages
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeScheduledChannelMessages, schedFreq, isEnabled)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package scheme_assignment
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const jobName = "SchemeAssignment"
+
+type AppIface interface {
+	AssignSchemeToTeams(schemeID string, teamIDs []string) *model.AppError
+	AssignSchemeToChannels(schemeID string, channelIDs []string) *model.AppError
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) model.Worker {
+	isEnabled := func(cfg *model.Config) bool {
+		return true
+	}
+	execute := func(job *model.Job) error {
+		schemeID := job.Data["scheme_id"]
+		scope := job.Data["scope"]
+		if job.Data["ids"] == "" {
+			return model.NewAppError("SchemeAssignmentWorker", "app.scheme.assign.invalid.app_error", nil, "job_id="+job.Id, http.StatusBadRequest)
+		}
+		ids := strings.Split(job.Data["ids"], ",")
+
+		assign := app.AssignSchemeToChannels
+		if scope == model.SchemeScopeTeam {
+			assign = app.AssignSchemeToTeams
+		}
+
+		// The whole assignment is applied in a single transaction, so that a job failing
+		// midway leaves every team or channel on the scheme it had.
+		if appErr := assign(schemeID, ids); appErr != nil {
+			return appErr
+		}
+
+		if appErr := jobServer.SetJobProgress(job, 100); appErr != nil {
+			mlog.Warn("Worker: Failed to set job progress", mlog.String("worker", model.JobTypeSchemeAssignment), mlog.String("job_id", job.Id), mlog.Err(appErr))
+		}
+
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+	return worker
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package team_group_role_sync
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/jobs"
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const schedFreq = time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) model.Scheduler {
+	// The groups are mapped to roles per team, so the scheduler is always enabled and the job
+	// does nothing when no team has one. The changes made to custom groups are reconciled right
+	// away, the job picks up those of the groups synchronized from LDAP.
+	isEnabled := func(_ *model.Config) bool {
+		return true
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeTeamGroupRoleSync, schedFreq, isEnabled)
+}
@@ -11,6 +11,11 @@ const (
 	AccessTokenGrantType  = "authorization_code"
 	AccessTokenType       = "bearer"
 	RefreshTokenGrantType = "refresh_token"
+
+	// TokenTypeHintAccessToken and TokenTypeHintRefreshToken are the token_type_hint values of
+	// the introspection and revocation requests.
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
 )
 
 type AccessData struct {
@@ -32,6 +37,19 @@ type AccessResponse struct {
 	IdToken      string `json:"id_token"`
 }
 
+// OAuthIntrospectionResponse describes a token of an OAuth app, as per RFC 7662. Only Active is
+// set for tokens that aren't active, or weren't issued to the app asking.
+type OAuthIntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientId  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+}
+
 // IsValid validates the AccessData and returns an error if it isn't configured
 // correctly.
 func (ad *AccessData) IsValid() *AppError {
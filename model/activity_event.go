@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	// ActivityEventTypePost records a post of the user, and ActivityEventTypeFileUpload each file
+	// attached to it.
+	ActivityEventTypePost       = "post"
+	ActivityEventTypeFileUpload = "file_upload"
+	// ActivityEventTypeReaction records a reaction of the user to a post.
+	ActivityEventTypeReaction = "reaction"
+	// ActivityEventTypeChannelJoin records the user joining a channel, or being added to it.
+	ActivityEventTypeChannelJoin = "channel_join"
+)
+
+// ActivityEvent is an entry of the activity timeline of a user. PostId is the post created, the
+// file was attached to, or reacted to, and is empty for channel joins.
+type ActivityEvent struct {
+	Id        string `json:"id"`
+	UserId    string `json:"user_id"`
+	Type      string `json:"type"`
+	TeamId    string `json:"team_id"`
+	ChannelId string `json:"channel_id"`
+	PostId    string `json:"post_id,omitempty"`
+	FileId    string `json:"file_id,omitempty"`
+	EmojiName string `json:"emoji_name,omitempty"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+// IsValidActivityEventType reports whether the type is one of the types of the activity events.
+func IsValidActivityEventType(eventType string) bool {
+	switch eventType {
+	case ActivityEventTypePost, ActivityEventTypeFileUpload, ActivityEventTypeReaction, ActivityEventTypeChannelJoin:
+		return true
+	}
+	return false
+}
+
+func (e *ActivityEvent) PreSave() {
+	if e.Id == "" {
+		e.Id = NewId()
+	}
+
+	if e.CreateAt == 0 {
+		e.CreateAt = GetMillis()
+	}
+}
+
+func (e *ActivityEvent) IsValid() *AppError {
+	if !IsValidId(e.Id) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(e.UserId) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.user_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidActivityEventType(e.Type) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.type.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	// The direct and group messages belong to no team.
+	if e.TeamId != "" && !IsValidId(e.TeamId) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.team_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(e.ChannelId) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.channel_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if (e.Type == ActivityEventTypeChannelJoin) != (e.PostId == "") || (e.PostId != "" && !IsValidId(e.PostId)) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.post_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if (e.Type == ActivityEventTypeFileUpload) != (e.FileId != "") || (e.FileId != "" && !IsValidId(e.FileId)) {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.file_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if (e.Type == ActivityEventTypeReaction) != (e.EmojiName != "") || len(e.EmojiName) > EmojiNameMaxLength {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.emoji_name.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if e.CreateAt == 0 {
+		return NewAppError("ActivityEvent.IsValid", "model.activity_event.is_valid.create_at.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityEventIsValid(t *testing.T) {
+	e := ActivityEvent{}
+
+	err := e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.id.app_error")
+
+	e.Id = NewId()
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.user_id.app_error")
+
+	e.UserId = NewId()
+	e.Type = "login"
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.type.app_error")
+
+	e.Type = ActivityEventTypeReaction
+	e.TeamId = "invalid"
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.team_id.app_error")
+
+	e.TeamId = ""
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.channel_id.app_error")
+
+	e.ChannelId = NewId()
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.post_id.app_error")
+
+	e.PostId = NewId()
+	e.FileId = NewId()
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.file_id.app_error")
+
+	e.FileId = ""
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.emoji_name.app_error")
+
+	e.EmojiName = "smile"
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.create_at.app_error")
+
+	e.PreSave()
+	require.Nil(t, e.IsValid())
+
+	join := ActivityEvent{UserId: NewId(), Type: ActivityEventTypeChannelJoin, ChannelId: NewId(), PostId: NewId()}
+	join.PreSave()
+	err = join.IsValid()
+	require.False(t, err == nil || err.Id != "model.activity_event.is_valid.post_id.app_error")
+
+	join.PostId = ""
+	require.Nil(t, join.IsValid())
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	AlertRuleMetricFailedLogins = "failed_logins"
+	AlertRuleMetricJobFailures  = "job_failures"
+	AlertRuleMetricDiskUsage    = "disk_usage"
+
+	AlertRuleStateOk        = "ok"
+	AlertRuleStateTriggered = "triggered"
+
+	AlertRuleNameMaxRunes       = 64
+	AlertRuleMaxDMUsers         = 20
+	AlertRuleMaxWindowMinutes   = 7 * 24 * 60
+	AlertRuleMaxCooldownMinutes = 7 * 24 * 60
+)
+
+var AllAlertRuleMetrics = []string{
+	AlertRuleMetricFailedLogins,
+	AlertRuleMetricJobFailures,
+	AlertRuleMetricDiskUsage,
+}
+
+// AlertRule is a metric watched by the server on behalf of the admins. The rule triggers when
+// the value of its metric reaches the threshold, that is the number of failed logins or failed
+// jobs over the last WindowMinutes, or the percentage of the file storage disk that's used. The
+// system bot then posts to the channel and DMs the users of the rule, and doesn't notify again
+// until the cooldown has passed, however long the rule stays triggered. It notifies once more
+// when the rule is resolved.
+type AlertRule struct {
+	Id              string      `json:"id"`
+	CreatorId       string      `json:"creator_id"`
+	Name            string      `json:"name"`
+	Metric          string      `json:"metric"`
+	Threshold       int64       `json:"threshold"`
+	WindowMinutes   int         `json:"window_minutes"`
+	CooldownMinutes int         `json:"cooldown_minutes"`
+	ChannelId       string      `json:"channel_id"`
+	DMUserIds       StringArray `json:"dm_user_ids"`
+	Enabled         bool        `json:"enabled"`
+	State           string      `json:"state"`
+	LastValue       int64       `json:"last_value"`
+	LastEvaluatedAt int64       `json:"last_evaluated_at"`
+	LastNotifiedAt  int64       `json:"last_notified_at"`
+	CreateAt        int64       `json:"create_at"`
+	UpdateAt        int64       `json:"update_at"`
+}
+
+func (r *AlertRule) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	if r.State == "" {
+		r.State = AlertRuleStateOk
+	}
+
+	if r.DMUserIds == nil {
+		r.DMUserIds = StringArray{}
+	}
+
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *AlertRule) PreUpdate() {
+	if r.DMUserIds == nil {
+		r.DMUserIds = StringArray{}
+	}
+
+	r.UpdateAt = GetMillis()
+}
+
+func (r *AlertRule) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.CreatorId) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.creator_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Name == "" || utf8.RuneCountInString(r.Name) > AlertRuleNameMaxRunes {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.name.app_error", map[string]interface{}{"MaxLength": AlertRuleNameMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidAlertRuleMetric(r.Metric) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.metric.app_error", nil, "id="+r.Id+", metric="+r.Metric, http.StatusBadRequest)
+	}
+
+	if r.Threshold <= 0 || (r.Metric == AlertRuleMetricDiskUsage && r.Threshold > 100) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.threshold.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	// The disk usage is read as is, so only the counted metrics have a window.
+	if r.Metric != AlertRuleMetricDiskUsage && (r.WindowMinutes <= 0 || r.WindowMinutes > AlertRuleMaxWindowMinutes) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.window_minutes.app_error", map[string]interface{}{"Max": AlertRuleMaxWindowMinutes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.CooldownMinutes < 0 || r.CooldownMinutes > AlertRuleMaxCooldownMinutes {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.cooldown_minutes.app_error", map[string]interface{}{"Max": AlertRuleMaxCooldownMinutes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.ChannelId != "" && !IsValidId(r.ChannelId) {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.channel_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.DMUserIds) > AlertRuleMaxDMUsers {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.dm_user_ids.app_error", map[string]interface{}{"Max": AlertRuleMaxDMUsers}, "id="+r.Id, http.StatusBadRequest)
+	}
+	for _, userID := range r.DMUserIds {
+		if !IsValidId(userID) {
+			return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.dm_user_ids.app_error", map[string]interface{}{"Max": AlertRuleMaxDMUsers}, "id="+r.Id+", user_id="+userID, http.StatusBadRequest)
+		}
+	}
+
+	if r.ChannelId == "" && len(r.DMUserIds) == 0 {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.no_target.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.State != AlertRuleStateOk && r.State != AlertRuleStateTriggered {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.state.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("AlertRule.IsValid", "model.alert_rule.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsTriggeredBy reports whether the value of the metric reaches the threshold of the rule.
+func (r *AlertRule) IsTriggeredBy(value int64) bool {
+	return value >= r.Threshold
+}
+
+// CanNotify reports whether the cooldown since the rule last notified has passed.
+func (r *AlertRule) CanNotify(now int64) bool {
+	return r.LastNotifiedAt == 0 || now-r.LastNotifiedAt >= int64(r.CooldownMinutes)*60*1000
+}
+
+func IsValidAlertRuleMetric(metric string) bool {
+	for _, valid := range AllAlertRuleMetrics {
+		if metric == valid {
+			return true
+		}
+	}
+	return false
+}
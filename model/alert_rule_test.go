@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRuleIsValid(t *testing.T) {
+	r := AlertRule{}
+
+	err := r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.id.app_error")
+
+	r.Id = NewId()
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.creator_id.app_error")
+
+	r.CreatorId = NewId()
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.name.app_error")
+
+	r.Name = "Failed logins"
+	r.Metric = "unknown"
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.metric.app_error")
+
+	r.Metric = AlertRuleMetricFailedLogins
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.threshold.app_error")
+
+	r.Threshold = 50
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.window_minutes.app_error")
+
+	r.WindowMinutes = 10
+	r.CooldownMinutes = -1
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.cooldown_minutes.app_error")
+
+	r.CooldownMinutes = 30
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.no_target.app_error")
+
+	r.DMUserIds = StringArray{"invalid"}
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.dm_user_ids.app_error")
+
+	r.DMUserIds = StringArray{NewId()}
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.state.app_error")
+
+	r.State = AlertRuleStateTriggered
+	err = r.IsValid()
+	require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.create_at.app_error")
+
+	r.State = ""
+	r.PreSave()
+	require.Nil(t, r.IsValid())
+	require.Equal(t, AlertRuleStateOk, r.State)
+
+	t.Run("disk usage is a percentage without a window", func(t *testing.T) {
+		diskRule := r
+		diskRule.Metric = AlertRuleMetricDiskUsage
+		diskRule.WindowMinutes = 0
+		diskRule.Threshold = 101
+		err := diskRule.IsValid()
+		require.False(t, err == nil || err.Id != "model.alert_rule.is_valid.threshold.app_error")
+
+		diskRule.Threshold = 90
+		require.Nil(t, diskRule.IsValid())
+	})
+}
+
+func TestAlertRuleCanNotify(t *testing.T) {
+	r := AlertRule{CooldownMinutes: 10}
+	now := GetMillis()
+
+	assert.True(t, r.CanNotify(now))
+
+	r.LastNotifiedAt = now - 5*60*1000
+	assert.False(t, r.CanNotify(now))
+
+	r.LastNotifiedAt = now - 10*60*1000
+	assert.True(t, r.CanNotify(now))
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// APIUsageBucketSize is the width of the rolling window each APIUsage row accumulates calls for.
+	APIUsageBucketSize = time.Hour
+
+	APIUsageRouteMaxLength = 128
+)
+
+// APIUsage holds the number of calls a user made to a single API route within one bucket.
+// TokenId is empty unless the calls were authenticated with a user access token.
+type APIUsage struct {
+	UserId   string `json:"user_id"`
+	TokenId  string `json:"token_id"`
+	Route    string `json:"route"`
+	BucketAt int64  `json:"bucket_at"`
+	Count    int64  `json:"count"`
+}
+
+// APIUsageSummary aggregates the calls made by a user, or one of its tokens, across all routes.
+type APIUsageSummary struct {
+	UserId  string `json:"user_id"`
+	TokenId string `json:"token_id"`
+	Count   int64  `json:"count"`
+}
+
+// APIUsageBucket returns the start of the bucket the given timestamp, in milliseconds, falls into.
+func APIUsageBucket(millis int64) int64 {
+	size := int64(APIUsageBucketSize / time.Millisecond)
+	return millis - millis%size
+}
+
+func (u *APIUsage) IsValid() *AppError {
+	if !IsValidId(u.UserId) {
+		return NewAppError("APIUsage.IsValid", "model.api_usage.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.TokenId != "" && !IsValidId(u.TokenId) {
+		return NewAppError("APIUsage.IsValid", "model.api_usage.is_valid.token_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Route == "" || len(u.Route) > APIUsageRouteMaxLength {
+		return NewAppError("APIUsage.IsValid", "model.api_usage.is_valid.route.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.BucketAt <= 0 || u.BucketAt != APIUsageBucket(u.BucketAt) {
+		return NewAppError("APIUsage.IsValid", "model.api_usage.is_valid.bucket_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Count <= 0 {
+		return NewAppError("APIUsage.IsValid", "model.api_usage.is_valid.count.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
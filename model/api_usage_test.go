@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIUsageBucket(t *testing.T) {
+	require.Equal(t, int64(0), APIUsageBucket(0))
+	require.Equal(t, int64(3600000), APIUsageBucket(3600000))
+	require.Equal(t, int64(3600000), APIUsageBucket(3600000+59*60*1000))
+	require.Equal(t, int64(7200000), APIUsageBucket(7200001))
+}
+
+func TestAPIUsageIsValid(t *testing.T) {
+	u := APIUsage{}
+
+	err := u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.user_id.app_error")
+
+	u.UserId = NewId()
+	u.TokenId = "invalid"
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.token_id.app_error")
+
+	u.TokenId = ""
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.route.app_error")
+
+	u.Route = strings.Repeat("a", APIUsageRouteMaxLength+1)
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.route.app_error")
+
+	u.Route = "getUser"
+	u.BucketAt = APIUsageBucket(GetMillis()) + 1
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.bucket_at.app_error")
+
+	u.BucketAt = APIUsageBucket(GetMillis())
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.api_usage.is_valid.count.app_error")
+
+	u.Count = 1
+	require.Nil(t, u.IsValid())
+
+	u.TokenId = NewId()
+	require.Nil(t, u.IsValid())
+}
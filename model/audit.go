@@ -3,6 +3,34 @@
 
 package model
 
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// AuditActionCommandExecuted and AuditActionIncomingWebhookPosted are the actions of the
+	// audits recorded for each use of the integrations, whose extra info starts with the id of
+	// the integration.
+	AuditActionCommandExecuted       = "integration_command_executed"
+	AuditActionIncomingWebhookPosted = "integration_incoming_webhook_posted"
+
+	// AuditActionIncomingWebhookIdentityOverridden is the action of the audits recorded for each
+	// post of an incoming webhook asking for another username or icon than its own, whose extra
+	// info starts with the id of the channel.
+	AuditActionIncomingWebhookIdentityOverridden = "integration_incoming_webhook_identity_overridden"
+
+	webhookIdentityOverrideMaxValueRunes = 256
+
+	IntegrationTypeCommand         = "command"
+	IntegrationTypeIncomingWebhook = "incoming_webhook"
+)
+
 type Audit struct {
 	Id        string `json:"id"`
 	CreateAt  int64  `json:"create_at"`
@@ -12,3 +40,119 @@ type Audit struct {
 	IpAddress string `json:"ip_address"`
 	SessionId string `json:"session_id"`
 }
+
+// IntegrationAuditActions returns the audit actions of the type of integration, or of all the
+// integrations when the type is empty. It returns nil for an unknown type.
+func IntegrationAuditActions(integrationType string) []string {
+	switch integrationType {
+	case "":
+		return []string{AuditActionCommandExecuted, AuditActionIncomingWebhookPosted}
+	case IntegrationTypeCommand:
+		return []string{AuditActionCommandExecuted}
+	case IntegrationTypeIncomingWebhook:
+		return []string{AuditActionIncomingWebhookPosted}
+	}
+	return nil
+}
+
+// IntegrationAuditPrefix is the start of the extra info of the audits of the integration.
+func IntegrationAuditPrefix(integrationID string) string {
+	return "integration_id=" + integrationID + " "
+}
+
+// IntegrationAuditExtraInfo is the extra info of the audit of a use of an integration. The
+// payload is only recorded by its hash, so that the audits don't leak the secrets it may hold.
+func IntegrationAuditExtraInfo(integrationID, channelID, command string, payload []byte) string {
+	hash := sha256.Sum256(payload)
+
+	extraInfo := IntegrationAuditPrefix(integrationID) + "channel_id=" + channelID
+	if command != "" {
+		extraInfo += " command=" + command
+	}
+	return extraInfo + " payload_hash=" + hex.EncodeToString(hash[:])
+}
+
+// WebhookIdentityOverride is a post of an incoming webhook asking for another username or icon
+// than the webhook was registered with. The blocked overrides were refused by the channel, the
+// post falling back to the identity of the webhook.
+type WebhookIdentityOverride struct {
+	HookId          string `json:"hook_id"`
+	ChannelId       string `json:"channel_id"`
+	UserId          string `json:"user_id"`
+	Username        string `json:"username,omitempty"`
+	IconURL         string `json:"icon_url,omitempty"`
+	IconEmoji       string `json:"icon_emoji,omitempty"`
+	UsernameBlocked bool   `json:"username_blocked"`
+	IconBlocked     bool   `json:"icon_blocked"`
+	CreateAt        int64  `json:"create_at"`
+}
+
+// webhookIdentityOverrideDetails are the details of an override recorded after the ids in the
+// extra info of its audit.
+type webhookIdentityOverrideDetails struct {
+	Username        string `json:"username,omitempty"`
+	IconURL         string `json:"icon_url,omitempty"`
+	IconEmoji       string `json:"icon_emoji,omitempty"`
+	UsernameBlocked bool   `json:"username_blocked,omitempty"`
+	IconBlocked     bool   `json:"icon_blocked,omitempty"`
+}
+
+// WebhookIdentityOverrideAuditPrefix is the start of the extra info of the audits of the
+// identity overrides in the channel.
+func WebhookIdentityOverrideAuditPrefix(channelID string) string {
+	return "channel_id=" + channelID + " "
+}
+
+// AuditExtraInfo is the extra info of the audit of the override. The requested identity is
+// shortened so that it fits in the audit.
+func (o *WebhookIdentityOverride) AuditExtraInfo() string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding strings and bools can't fail.
+	_ = encoder.Encode(webhookIdentityOverrideDetails{
+		Username:        truncateRunes(o.Username, webhookIdentityOverrideMaxValueRunes),
+		IconURL:         truncateRunes(o.IconURL, webhookIdentityOverrideMaxValueRunes),
+		IconEmoji:       truncateRunes(o.IconEmoji, webhookIdentityOverrideMaxValueRunes),
+		UsernameBlocked: o.UsernameBlocked,
+		IconBlocked:     o.IconBlocked,
+	})
+
+	return WebhookIdentityOverrideAuditPrefix(o.ChannelId) + "integration_id=" + o.HookId + " " + strings.TrimSpace(buf.String())
+}
+
+// WebhookIdentityOverrideFromAudit returns the override recorded by the audit.
+func WebhookIdentityOverrideFromAudit(audit *Audit) (*WebhookIdentityOverride, error) {
+	if audit.Action != AuditActionIncomingWebhookIdentityOverridden {
+		return nil, errors.New("not the audit of an identity override")
+	}
+
+	parts := strings.SplitN(audit.ExtraInfo, " ", 3)
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "channel_id=") || !strings.HasPrefix(parts[1], "integration_id=") {
+		return nil, errors.New("malformed identity override audit")
+	}
+
+	var details webhookIdentityOverrideDetails
+	if err := json.Unmarshal([]byte(parts[2]), &details); err != nil {
+		return nil, err
+	}
+
+	return &WebhookIdentityOverride{
+		HookId:          strings.TrimPrefix(parts[1], "integration_id="),
+		ChannelId:       strings.TrimPrefix(parts[0], "channel_id="),
+		UserId:          audit.UserId,
+		Username:        details.Username,
+		IconURL:         details.IconURL,
+		IconEmoji:       details.IconEmoji,
+		UsernameBlocked: details.UsernameBlocked,
+		IconBlocked:     details.IconBlocked,
+		CreateAt:        audit.CreateAt,
+	}, nil
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
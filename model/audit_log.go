@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	AuditLogEventMaxRunes      = 128
+	AuditLogStatusMaxRunes     = 32
+	AuditLogUserIdMaxRunes     = 128
+	AuditLogSessionIdMaxRunes  = 64
+	AuditLogIpAddressMaxRunes  = 64
+	AuditLogClientMaxRunes     = 512
+	AuditLogAPIPathMaxRunes    = 512
+	AuditLogTargetTypeMaxRunes = 32
+	AuditLogMetaMaxLength      = 65535
+)
+
+// auditLogTargetKinds are the kinds of the objects an audit record may be about, the most
+// specific first. The target of a record is the first of them found in its meta, either by id
+// under "<kind>_id" or as the object itself under "<kind>".
+var auditLogTargetKinds = []string{
+	"post",
+	"file",
+	"user",
+	"bot",
+	"channel",
+	"team",
+	"hook",
+	"command",
+	"oauth_app",
+	"emoji",
+	"group",
+	"scheme",
+	"role",
+	"job",
+	"policy",
+	"plugin",
+}
+
+// AuditLog is an audit record kept in the database so that it can be searched. UserId is the
+// actor of the record, which isn't a user id for the records of the CLI, and TargetType and
+// TargetId the object it is about, when one could be told from its meta.
+type AuditLog struct {
+	Id         string          `json:"id"`
+	CreateAt   int64           `json:"create_at"`
+	Event      string          `json:"event"`
+	Status     string          `json:"status"`
+	UserId     string          `json:"user_id"`
+	SessionId  string          `json:"session_id"`
+	IpAddress  string          `json:"ip_address"`
+	Client     string          `json:"client"`
+	APIPath    string          `json:"api_path"`
+	TargetType string          `json:"target_type"`
+	TargetId   string          `json:"target_id"`
+	Meta       StringInterface `json:"meta"`
+}
+
+// AuditLogSearch filters the audit logs. Empty fields match any audit log.
+type AuditLogSearch struct {
+	UserId    string
+	Event     string
+	IpAddress string
+	TargetId  string
+	Since     int64
+	Until     int64
+}
+
+// NewAuditLog describes the audit record as it's kept in the database, truncating what doesn't
+// fit. Meta too long to be kept is replaced by a note saying so.
+func NewAuditLog(rec *AuditRecord) *AuditLog {
+	log := &AuditLog{
+		Event:     truncateRunes(rec.Event, AuditLogEventMaxRunes),
+		Status:    truncateRunes(rec.Status, AuditLogStatusMaxRunes),
+		UserId:    truncateRunes(rec.UserID, AuditLogUserIdMaxRunes),
+		SessionId: truncateRunes(rec.SessionID, AuditLogSessionIdMaxRunes),
+		IpAddress: truncateRunes(rec.IPAddress, AuditLogIpAddressMaxRunes),
+		Client:    truncateRunes(rec.Client, AuditLogClientMaxRunes),
+		APIPath:   truncateRunes(rec.APIPath, AuditLogAPIPathMaxRunes),
+		Meta:      rec.Meta,
+	}
+	if log.Meta == nil {
+		log.Meta = StringInterface{}
+	}
+	if len(StringInterfaceToJSON(log.Meta)) > AuditLogMetaMaxLength {
+		log.Meta = StringInterface{"truncated": true}
+	}
+
+	log.TargetType, log.TargetId = auditLogTarget(rec.Meta)
+	return log
+}
+
+// auditLogTarget returns the kind and id of the object the meta of an audit record is about,
+// if any.
+func auditLogTarget(meta map[string]interface{}) (string, string) {
+	for _, kind := range auditLogTargetKinds {
+		if id, ok := meta[kind+"_id"].(string); ok && IsValidId(id) {
+			return kind, id
+		}
+
+		object, ok := meta[kind].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// The objects converted for the audit records have no JSON tags.
+		for _, key := range []string{"id", "ID", "Id"} {
+			if id, ok := object[key].(string); ok && IsValidId(id) {
+				return kind, id
+			}
+		}
+	}
+	return "", ""
+}
+
+func (l *AuditLog) PreSave() {
+	if l.Id == "" {
+		l.Id = NewId()
+	}
+
+	if l.CreateAt == 0 {
+		l.CreateAt = GetMillis()
+	}
+
+	if l.Meta == nil {
+		l.Meta = StringInterface{}
+	}
+}
+
+func (l *AuditLog) IsValid() *AppError {
+	if !IsValidId(l.Id) {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if l.Event == "" || utf8.RuneCountInString(l.Event) > AuditLogEventMaxRunes {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.event.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(l.Status) > AuditLogStatusMaxRunes ||
+		utf8.RuneCountInString(l.UserId) > AuditLogUserIdMaxRunes ||
+		utf8.RuneCountInString(l.SessionId) > AuditLogSessionIdMaxRunes ||
+		utf8.RuneCountInString(l.IpAddress) > AuditLogIpAddressMaxRunes ||
+		utf8.RuneCountInString(l.Client) > AuditLogClientMaxRunes ||
+		utf8.RuneCountInString(l.APIPath) > AuditLogAPIPathMaxRunes {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.too_long.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if (l.TargetType == "") != (l.TargetId == "") || utf8.RuneCountInString(l.TargetType) > AuditLogTargetTypeMaxRunes || (l.TargetId != "" && !IsValidId(l.TargetId)) {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.target.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if len(StringInterfaceToJSON(l.Meta)) > AuditLogMetaMaxLength {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.meta.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if l.CreateAt == 0 {
+		return NewAppError("AuditLog.IsValid", "model.audit_log.is_valid.create_at.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
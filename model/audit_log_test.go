@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	teamID := NewId()
+	userID := NewId()
+
+	t.Run("describes the record", func(t *testing.T) {
+		log := NewAuditLog(&AuditRecord{
+			APIPath:   "/api/v4/teams/" + teamID,
+			Event:     "localDeleteTeam",
+			Status:    "success",
+			UserID:    "1000:admin",
+			Client:    strings.Repeat("a", AuditLogClientMaxRunes+10),
+			IPAddress: "127.0.0.1",
+			Meta:      map[string]interface{}{"team": map[string]interface{}{"ID": teamID, "Name": "team"}},
+		})
+		assert.Equal(t, "localDeleteTeam", log.Event)
+		assert.Equal(t, "1000:admin", log.UserId)
+		assert.Len(t, log.Client, AuditLogClientMaxRunes)
+		assert.Equal(t, "team", log.TargetType)
+		assert.Equal(t, teamID, log.TargetId)
+
+		log.PreSave()
+		require.Nil(t, log.IsValid())
+	})
+
+	t.Run("prefers the most specific target", func(t *testing.T) {
+		log := NewAuditLog(&AuditRecord{
+			Event: "removeTeamMember",
+			Meta:  map[string]interface{}{"team_id": teamID, "user_id": userID},
+		})
+		assert.Equal(t, "user", log.TargetType)
+		assert.Equal(t, userID, log.TargetId)
+	})
+
+	t.Run("no target", func(t *testing.T) {
+		log := NewAuditLog(&AuditRecord{Event: "getAudits", Meta: map[string]interface{}{"page": 0, "user_id": "invalid"}})
+		assert.Empty(t, log.TargetType)
+		assert.Empty(t, log.TargetId)
+	})
+
+	t.Run("meta too long", func(t *testing.T) {
+		log := NewAuditLog(&AuditRecord{Event: "uploadFile", Meta: map[string]interface{}{"filename": strings.Repeat("a", AuditLogMetaMaxLength)}})
+		assert.Equal(t, StringInterface{"truncated": true}, log.Meta)
+	})
+}
+
+func TestAuditLogIsValid(t *testing.T) {
+	log := &AuditLog{Event: "localDeleteTeam"}
+	log.PreSave()
+	require.Nil(t, log.IsValid())
+
+	log.Event = ""
+	require.NotNil(t, log.IsValid())
+	log.Event = "localDeleteTeam"
+
+	log.TargetType = "team"
+	require.NotNil(t, log.IsValid())
+	log.TargetId = NewId()
+	require.Nil(t, log.IsValid())
+
+	log.Status = strings.Repeat("a", AuditLogStatusMaxRunes+1)
+	require.NotNil(t, log.IsValid())
+	log.Status = "fail"
+
+	log.CreateAt = 0
+	require.NotNil(t, log.IsValid())
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import "strings"
+
+// AuditRecord is an audit record as passed to plugins through the OnAuditRecord hook. Meta only
+// holds values that survive a JSON round trip.
+type AuditRecord struct {
+	APIPath   string                 `json:"api_path"`
+	Event     string                 `json:"event"`
+	Status    string                 `json:"status"`
+	UserID    string                 `json:"user_id"`
+	SessionID string                 `json:"session_id"`
+	Client    string                 `json:"client"`
+	IPAddress string                 `json:"ip_address"`
+	Meta      map[string]interface{} `json:"meta"`
+}
+
+// MatchesEventPrefixes returns whether the event of the record starts with one of the given
+// prefixes. No prefixes match every record.
+func (r *AuditRecord) MatchesEventPrefixes(prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(r.Event, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRecordMatchesEventPrefixes(t *testing.T) {
+	record := &AuditRecord{Event: "localCreateTeam"}
+
+	assert.True(t, record.MatchesEventPrefixes(nil))
+	assert.True(t, record.MatchesEventPrefixes([]string{"local"}))
+	assert.True(t, record.MatchesEventPrefixes([]string{"delete", "localCreateTeam"}))
+	assert.False(t, record.MatchesEventPrefixes([]string{"localDelete", "create"}))
+}
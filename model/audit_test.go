@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationAuditExtraInfo(t *testing.T) {
+	integrationID := NewId()
+	channelID := NewId()
+
+	extraInfo := IntegrationAuditExtraInfo(integrationID, channelID, "/deploy", []byte("/deploy prod --token=secret"))
+	assert.True(t, strings.HasPrefix(extraInfo, IntegrationAuditPrefix(integrationID)))
+	assert.Contains(t, extraInfo, "channel_id="+channelID+" command=/deploy payload_hash=")
+	assert.NotContains(t, extraInfo, "secret")
+	assert.Equal(t, extraInfo, IntegrationAuditExtraInfo(integrationID, channelID, "/deploy", []byte("/deploy prod --token=secret")))
+	assert.NotEqual(t, extraInfo, IntegrationAuditExtraInfo(integrationID, channelID, "/deploy", []byte("/deploy staging")))
+
+	extraInfo = IntegrationAuditExtraInfo(integrationID, channelID, "", []byte(`{"text":"hello"}`))
+	assert.NotContains(t, extraInfo, "command=")
+}
+
+func TestIntegrationAuditActions(t *testing.T) {
+	assert.Equal(t, []string{AuditActionCommandExecuted, AuditActionIncomingWebhookPosted}, IntegrationAuditActions(""))
+	assert.Equal(t, []string{AuditActionCommandExecuted}, IntegrationAuditActions(IntegrationTypeCommand))
+	assert.Equal(t, []string{AuditActionIncomingWebhookPosted}, IntegrationAuditActions(IntegrationTypeIncomingWebhook))
+	assert.Nil(t, IntegrationAuditActions("outgoing_webhook"))
+}
+
+func TestWebhookIdentityOverrideAudit(t *testing.T) {
+	override := &WebhookIdentityOverride{
+		HookId:          NewId(),
+		ChannelId:       NewId(),
+		Username:        "deploy bot",
+		IconURL:         "https://example.com/icon.png?a=1&b=<2>",
+		IconEmoji:       "rocket",
+		UsernameBlocked: true,
+	}
+
+	extraInfo := override.AuditExtraInfo()
+	assert.True(t, strings.HasPrefix(extraInfo, WebhookIdentityOverrideAuditPrefix(override.ChannelId)))
+
+	audit := &Audit{
+		UserId:    NewId(),
+		Action:    AuditActionIncomingWebhookIdentityOverridden,
+		ExtraInfo: extraInfo,
+		CreateAt:  GetMillis(),
+	}
+	parsed, err := WebhookIdentityOverrideFromAudit(audit)
+	require.NoError(t, err)
+	override.UserId = audit.UserId
+	override.CreateAt = audit.CreateAt
+	assert.Equal(t, override, parsed)
+
+	override.IconURL = "https://example.com/" + strings.Repeat("é", 2000)
+	assert.Less(t, utf8.RuneCountInString(override.AuditExtraInfo()), 1024)
+
+	_, err = WebhookIdentityOverrideFromAudit(&Audit{Action: AuditActionIncomingWebhookPosted, ExtraInfo: extraInfo})
+	assert.Error(t, err)
+	_, err = WebhookIdentityOverrideFromAudit(&Audit{Action: AuditActionIncomingWebhookIdentityOverridden, ExtraInfo: "channel_id=x"})
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ const (
 	BotCreatorIdMaxRunes     = KeyValuePluginIdMaxRunes // UserId or PluginId
 	BotWarnMetricBotUsername = "mattermost-advisor"
 	BotSystemBotUsername     = "system-bot"
+	BotFeedBotUsername       = "feeds"
 )
 
 // Bot is a special type of User meant for programmatic interactions.
@@ -43,6 +44,7 @@ type BotPatch struct {
 // BotGetOptions acts as a filter on bulk bot fetching queries.
 type BotGetOptions struct {
 	OwnerId        string
+	InChannelId    string
 	IncludeDeleted bool
 	OnlyOrphaned   bool
 	Page           int
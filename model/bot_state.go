@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+)
+
+const (
+	BotStateKeyMaxLength  = 150
+	BotStateValueMaxBytes = 32 * 1024
+)
+
+var botStateKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+
+// BotState is a JSON value a bot keeps along a conversation, e.g. the step of a dialog a user is
+// at. States are scoped to the bot and, optionally, to a user and a channel: the same key holds a
+// different value for every user and channel the bot talks with. States are dropped once they
+// expire, when they have an expiry.
+type BotState struct {
+	BotUserId string          `json:"bot_user_id"`
+	UserId    string          `json:"user_id"`
+	ChannelId string          `json:"channel_id"`
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	ExpireAt  int64           `json:"expire_at"`
+	CreateAt  int64           `json:"create_at"`
+	UpdateAt  int64           `json:"update_at"`
+}
+
+// BotStateRequest is the value of a state set through the API, with its time to live. Zero keeps
+// the state until it's deleted.
+type BotStateRequest struct {
+	Value           json.RawMessage `json:"value"`
+	ExpireInSeconds int64           `json:"expire_in_seconds"`
+}
+
+func (s *BotState) PreSave() {
+	if s.CreateAt == 0 {
+		s.CreateAt = GetMillis()
+	}
+	s.UpdateAt = GetMillis()
+}
+
+// SetExpiry sets when the state expires, expireInSeconds from now, or never when zero.
+func (s *BotState) SetExpiry(expireInSeconds int64) {
+	s.ExpireAt = 0
+	if expireInSeconds > 0 {
+		s.ExpireAt = GetMillis() + expireInSeconds*1000
+	}
+}
+
+// IsExpired tells whether the state has expired as of now, in milliseconds.
+func (s *BotState) IsExpired(now int64) bool {
+	return s.ExpireAt != 0 && s.ExpireAt <= now
+}
+
+func (s *BotState) IsValid() *AppError {
+	if !IsValidId(s.BotUserId) {
+		return NewAppError("BotState.IsValid", "model.bot_state.is_valid.bot_user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if appErr := IsValidBotStateScope(s.UserId, s.ChannelId); appErr != nil {
+		return appErr
+	}
+
+	if !IsValidBotStateKey(s.Key) {
+		return NewAppError("BotState.IsValid", "model.bot_state.is_valid.key.app_error", map[string]interface{}{"Max": BotStateKeyMaxLength}, "", http.StatusBadRequest)
+	}
+
+	if len(s.Value) == 0 || !json.Valid(s.Value) {
+		return NewAppError("BotState.IsValid", "model.bot_state.is_valid.value.app_error", nil, "key="+s.Key, http.StatusBadRequest)
+	}
+
+	if len(s.Value) > BotStateValueMaxBytes {
+		return NewAppError("BotState.IsValid", "model.bot_state.is_valid.value_size.app_error", map[string]interface{}{"Max": BotStateValueMaxBytes}, "key="+s.Key, http.StatusBadRequest)
+	}
+
+	if s.ExpireAt < 0 {
+		return NewAppError("BotState.IsValid", "model.bot_state.is_valid.expire_at.app_error", nil, "key="+s.Key, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsValidBotStateKey tells whether the key can name a state. Keys are made of letters, digits,
+// and "_", ".", ":" or "-", so that they can be part of URLs.
+func IsValidBotStateKey(key string) bool {
+	return len(key) <= BotStateKeyMaxLength && botStateKeyRegex.MatchString(key)
+}
+
+// IsValidBotStateScope checks the user and channel of a state, either of which can be empty for
+// states not scoped to a user or a channel.
+func IsValidBotStateScope(userID, channelID string) *AppError {
+	if userID != "" && !IsValidId(userID) {
+		return NewAppError("IsValidBotStateScope", "model.bot_state.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if channelID != "" && !IsValidId(channelID) {
+		return NewAppError("IsValidBotStateScope", "model.bot_state.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
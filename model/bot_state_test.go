@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotStateIsValid(t *testing.T) {
+	state := &BotState{
+		BotUserId: NewId(),
+		Key:       "dialog:step",
+		Value:     []byte(`{"step": 2}`),
+	}
+	require.Nil(t, state.IsValid(), "states don't need a user or a channel")
+
+	state.UserId = NewId()
+	state.ChannelId = NewId()
+	require.Nil(t, state.IsValid())
+
+	state.ChannelId = "junk"
+	require.NotNil(t, state.IsValid())
+	state.ChannelId = ""
+
+	for _, key := range []string{"", "a/b", "a b", strings.Repeat("a", BotStateKeyMaxLength+1)} {
+		state.Key = key
+		require.NotNil(t, state.IsValid(), key)
+	}
+	state.Key = "step"
+
+	state.Value = []byte(`{"step":`)
+	require.NotNil(t, state.IsValid(), "values are JSON")
+	state.Value = []byte(`"` + strings.Repeat("a", BotStateValueMaxBytes) + `"`)
+	require.NotNil(t, state.IsValid())
+	state.Value = []byte(`"done"`)
+	require.Nil(t, state.IsValid())
+}
+
+func TestBotStateExpiry(t *testing.T) {
+	state := &BotState{}
+
+	state.SetExpiry(0)
+	assert.Zero(t, state.ExpireAt)
+	assert.False(t, state.IsExpired(GetMillis()))
+
+	state.SetExpiry(60)
+	assert.False(t, state.IsExpired(GetMillis()))
+	assert.True(t, state.IsExpired(GetMillis()+61*1000))
+}
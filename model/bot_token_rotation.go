@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	BotTokenRotationMaxDays          = 365
+	BotTokenRotationWebhookMaxLength = 1024
+
+	botTokenRotationHourMillis int64 = int64(time.Hour / time.Millisecond)
+)
+
+// BotTokenRotation replaces the access token of a bot every RotationDays, sending the new
+// token to WebhookURL. The replaced token keeps working for GraceHours, giving the
+// integration time to switch over.
+type BotTokenRotation struct {
+	BotUserId      string `json:"bot_user_id"`
+	RotationDays   int    `json:"rotation_days"`
+	GraceHours     int    `json:"grace_hours"`
+	WebhookURL     string `json:"webhook_url"`
+	CurrentTokenId string `json:"current_token_id"`
+	LastRotatedAt  int64  `json:"last_rotated_at"`
+	NextRotationAt int64  `json:"next_rotation_at"`
+	CreateAt       int64  `json:"create_at"`
+	UpdateAt       int64  `json:"update_at"`
+}
+
+// BotTokenRotationNotification is sent to the webhook of a rotation when a new token
+// replaces the previous one.
+type BotTokenRotationNotification struct {
+	BotUserId              string `json:"bot_user_id"`
+	TokenId                string `json:"token_id"`
+	Token                  string `json:"token"`
+	ExpiresAt              int64  `json:"expires_at"`
+	PreviousTokenId        string `json:"previous_token_id,omitempty"`
+	PreviousTokenExpiresAt int64  `json:"previous_token_expires_at,omitempty"`
+}
+
+// BotTokenAge describes an access token of a bot, for the admins to spot the tokens that
+// weren't replaced for long.
+type BotTokenAge struct {
+	TokenId     string `json:"token_id"`
+	BotUserId   string `json:"bot_user_id"`
+	BotUsername string `json:"bot_username"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"is_active"`
+	CreateAt    int64  `json:"create_at"`
+	ExpiresAt   int64  `json:"expires_at"`
+	// Rotated tells whether the token is the current one of the rotation of the bot.
+	Rotated bool `json:"rotated"`
+}
+
+func (r *BotTokenRotation) PreSave() {
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *BotTokenRotation) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+func (r *BotTokenRotation) IsValid() *AppError {
+	if !IsValidId(r.BotUserId) {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.bot_user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.RotationDays < 1 || r.RotationDays > BotTokenRotationMaxDays {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.rotation_days.app_error", map[string]interface{}{"Max": BotTokenRotationMaxDays}, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	if r.GraceHours < 0 || r.GraceHours >= r.RotationDays*24 {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.grace_hours.app_error", nil, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	if len(r.WebhookURL) > BotTokenRotationWebhookMaxLength || !IsValidHTTPURL(r.WebhookURL) {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.webhook_url.app_error", nil, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	if r.CurrentTokenId != "" && !IsValidId(r.CurrentTokenId) {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.current_token_id.app_error", nil, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.create_at.app_error", nil, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("BotTokenRotation.IsValid", "model.bot_token_rotation.is_valid.update_at.app_error", nil, "bot_user_id="+r.BotUserId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// ScheduleNextRotation sets when the token is next replaced, a rotation that never replaced a
+// token doing it right away.
+func (r *BotTokenRotation) ScheduleNextRotation(now int64) {
+	if r.LastRotatedAt == 0 {
+		r.NextRotationAt = now
+		return
+	}
+	r.NextRotationAt = r.LastRotatedAt + int64(r.RotationDays)*24*botTokenRotationHourMillis
+}
+
+// TokenExpiresAt returns when a token made at the given time expires: at the end of the grace
+// period following its replacement.
+func (r *BotTokenRotation) TokenExpiresAt(now int64) int64 {
+	return now + int64(r.RotationDays*24+r.GraceHours)*botTokenRotationHourMillis
+}
+
+// GraceEnd returns when a token replaced at the given time stops being accepted.
+func (r *BotTokenRotation) GraceEnd(now int64) int64 {
+	return now + int64(r.GraceHours)*botTokenRotationHourMillis
+}
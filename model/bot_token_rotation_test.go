@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotTokenRotationIsValid(t *testing.T) {
+	rotation := &BotTokenRotation{
+		BotUserId:    NewId(),
+		RotationDays: 30,
+		GraceHours:   24,
+		WebhookURL:   "https://example.com/rotate",
+	}
+	rotation.PreSave()
+	require.Nil(t, rotation.IsValid())
+
+	for _, days := range []int{0, -1, BotTokenRotationMaxDays + 1} {
+		rotation.RotationDays = days
+		assert.NotNil(t, rotation.IsValid(), days)
+	}
+	rotation.RotationDays = 1
+
+	for _, hours := range []int{-1, 24, 48} {
+		rotation.GraceHours = hours
+		assert.NotNil(t, rotation.IsValid(), hours)
+	}
+	rotation.GraceHours = 23
+	require.Nil(t, rotation.IsValid())
+
+	for _, webhookURL := range []string{"", "ftp://example.com", "https://example.com/" + strings.Repeat("a", BotTokenRotationWebhookMaxLength)} {
+		rotation.WebhookURL = webhookURL
+		assert.NotNil(t, rotation.IsValid(), webhookURL)
+	}
+	rotation.WebhookURL = "http://example.com"
+
+	rotation.CurrentTokenId = "junk"
+	require.NotNil(t, rotation.IsValid())
+	rotation.CurrentTokenId = NewId()
+	require.Nil(t, rotation.IsValid())
+
+	rotation.BotUserId = "junk"
+	require.NotNil(t, rotation.IsValid())
+}
+
+func TestBotTokenRotationSchedule(t *testing.T) {
+	const hour = int64(60 * 60 * 1000)
+	rotation := &BotTokenRotation{RotationDays: 2, GraceHours: 6}
+
+	rotation.ScheduleNextRotation(1000)
+	assert.Equal(t, int64(1000), rotation.NextRotationAt, "a rotation that never ran is due right away")
+
+	rotation.LastRotatedAt = 5000
+	rotation.ScheduleNextRotation(6000)
+	assert.Equal(t, 5000+48*hour, rotation.NextRotationAt)
+
+	assert.Equal(t, 5000+54*hour, rotation.TokenExpiresAt(5000))
+	assert.Equal(t, 5000+6*hour, rotation.GraceEnd(5000))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"regexp"
+	"unicode/utf8"
+)
+
+const (
+	CannedResponseShortcutMaxRunes = 64
+	CannedResponseContentMaxRunes  = 16383
+	CannedResponseAutocompleteMax  = 25
+)
+
+var (
+	cannedResponseShortcutRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+	cannedResponseVariableRegex = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+)
+
+// CannedResponse is a reusable message that can be posted by its shortcut. It belongs either
+// to a user, who is the only one able to use it, or to a team, whose members share it.
+//
+// The content can hold variables such as {{customer}}, which are replaced with the values given
+// when the response is used.
+type CannedResponse struct {
+	Id         string `json:"id"`
+	UserId     string `json:"user_id"`
+	TeamId     string `json:"team_id"`
+	CreatorId  string `json:"creator_id"`
+	Shortcut   string `json:"shortcut"`
+	Content    string `json:"content"`
+	UseCount   int64  `json:"use_count"`
+	LastUsedAt int64  `json:"last_used_at"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+}
+
+type CannedResponsePatch struct {
+	Shortcut *string `json:"shortcut"`
+	Content  *string `json:"content"`
+}
+
+// CannedResponseUse holds the values of the variables of a canned response being used.
+type CannedResponseUse struct {
+	Variables map[string]string `json:"variables"`
+}
+
+// CannedResponseUseResult is the message produced by using a canned response.
+type CannedResponseUseResult struct {
+	Message string `json:"message"`
+}
+
+func (r *CannedResponse) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	r.UseCount = 0
+	r.LastUsedAt = 0
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *CannedResponse) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+func (r *CannedResponse) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if (r.UserId == "") == (r.TeamId == "") {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.scope.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UserId != "" && !IsValidId(r.UserId) {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.user_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.TeamId != "" && !IsValidId(r.TeamId) {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.team_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.CreatorId) {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.creator_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.Shortcut) > CannedResponseShortcutMaxRunes || !cannedResponseShortcutRegex.MatchString(r.Shortcut) {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.shortcut.app_error", map[string]interface{}{"MaxLength": CannedResponseShortcutMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Content == "" || utf8.RuneCountInString(r.Content) > CannedResponseContentMaxRunes {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.content.app_error", map[string]interface{}{"MaxLength": CannedResponseContentMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("CannedResponse.IsValid", "model.canned_response.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (r *CannedResponse) Patch(patch *CannedResponsePatch) {
+	if patch.Shortcut != nil {
+		r.Shortcut = *patch.Shortcut
+	}
+
+	if patch.Content != nil {
+		r.Content = *patch.Content
+	}
+}
+
+// Variables returns the names of the variables used in the content, in the order they first
+// appear.
+func (r *CannedResponse) Variables() []string {
+	variables := []string{}
+	seen := map[string]bool{}
+	for _, match := range cannedResponseVariableRegex.FindAllStringSubmatch(r.Content, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			variables = append(variables, match[1])
+		}
+	}
+	return variables
+}
+
+// Render returns the content with its variables replaced by the given values. Variables
+// without a value are left as they are.
+func (r *CannedResponse) Render(values map[string]string) string {
+	return cannedResponseVariableRegex.ReplaceAllStringFunc(r.Content, func(variable string) string {
+		name := cannedResponseVariableRegex.FindStringSubmatch(variable)[1]
+		if value, ok := values[name]; ok {
+			return value
+		}
+		return variable
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCannedResponseIsValid(t *testing.T) {
+	response := &CannedResponse{
+		UserId:    NewId(),
+		CreatorId: NewId(),
+		Shortcut:  "greeting",
+		Content:   "Hello {{customer}}",
+	}
+	response.PreSave()
+	require.Nil(t, response.IsValid())
+
+	response.TeamId = NewId()
+	require.NotNil(t, response.IsValid())
+	response.UserId = ""
+	require.Nil(t, response.IsValid())
+	response.TeamId = ""
+	require.NotNil(t, response.IsValid())
+	response.UserId = NewId()
+
+	response.Shortcut = "Greeting"
+	require.NotNil(t, response.IsValid())
+	response.Shortcut = "with space"
+	require.NotNil(t, response.IsValid())
+	response.Shortcut = strings.Repeat("a", CannedResponseShortcutMaxRunes+1)
+	require.NotNil(t, response.IsValid())
+	response.Shortcut = "follow-up_2"
+	require.Nil(t, response.IsValid())
+
+	response.Content = ""
+	require.NotNil(t, response.IsValid())
+	response.Content = strings.Repeat("a", CannedResponseContentMaxRunes+1)
+	require.NotNil(t, response.IsValid())
+}
+
+func TestCannedResponseVariables(t *testing.T) {
+	response := &CannedResponse{Content: "Hi {{customer}}, your ticket {{ ticket }} is closed. Thanks {{customer}}!"}
+	assert.Equal(t, []string{"customer", "ticket"}, response.Variables())
+
+	response.Content = "No variables {{ not a variable }}"
+	assert.Empty(t, response.Variables())
+}
+
+func TestCannedResponseRender(t *testing.T) {
+	response := &CannedResponse{Content: "Hi {{customer}}, your ticket {{ ticket }} is closed."}
+
+	assert.Equal(t, "Hi Acme, your ticket 42 is closed.", response.Render(map[string]string{"customer": "Acme", "ticket": "42"}))
+	assert.Equal(t, "Hi Acme, your ticket {{ ticket }} is closed.", response.Render(map[string]string{"customer": "Acme"}))
+	assert.Equal(t, response.Content, response.Render(nil))
+}
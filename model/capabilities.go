@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const PluginIdCalls = "com.mattermost.calls"
+
+// Capabilities describes the features the server has enabled, so that clients can detect them
+// without probing endpoints or reading a configuration they may not be allowed to read.
+type Capabilities struct {
+	Version        string           `json:"version"`
+	GraphQL        bool             `json:"graphql"`
+	SharedChannels bool             `json:"shared_channels"`
+	Calls          bool             `json:"calls"`
+	CustomEmoji    bool             `json:"custom_emoji"`
+	Files          FileCapabilities `json:"files"`
+	Posts          PostCapabilities `json:"posts"`
+}
+
+type FileCapabilities struct {
+	Attachments bool  `json:"attachments"`
+	PublicLinks bool  `json:"public_links"`
+	MaxFileSize int64 `json:"max_file_size"`
+}
+
+type PostCapabilities struct {
+	MaxPostSize int  `json:"max_post_size"`
+	Priority    bool `json:"priority"`
+}
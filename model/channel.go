@@ -35,6 +35,8 @@ const (
 
 	ChannelSortByUsername = "username"
 	ChannelSortByStatus   = "status"
+
+	ChannelSensitivityTagMaxLength = 64
 )
 
 type Channel struct {
@@ -59,6 +61,15 @@ type Channel struct {
 	TotalMsgCountRoot int64                  `json:"total_msg_count_root"`
 	PolicyID          *string                `json:"policy_id"`
 	LastRootPostAt    int64                  `json:"last_root_post_at"`
+	DefaultLocale     string                 `json:"default_locale"`
+	SensitivityTag    string                 `json:"sensitivity_tag"`
+	// DisableWebhookUsernameOverride and DisableWebhookIconOverride make the incoming webhooks
+	// post in the channel with the username and icon they were registered with.
+	DisableWebhookUsernameOverride bool `json:"disable_webhook_username_override"`
+	DisableWebhookIconOverride     bool `json:"disable_webhook_icon_override"`
+	// ForceThreadedReplies and AutoFollowThreads set the default thread behavior of the channel.
+	ForceThreadedReplies bool `json:"force_threaded_replies"`
+	AutoFollowThreads    bool `json:"auto_follow_threads"`
 }
 
 type ChannelWithTeamData struct {
@@ -79,6 +90,20 @@ type ChannelPatch struct {
 	Header           *string `json:"header"`
 	Purpose          *string `json:"purpose"`
 	GroupConstrained *bool   `json:"group_constrained"`
+	DefaultLocale    *string `json:"default_locale"`
+	SensitivityTag   *string `json:"sensitivity_tag"`
+
+	DisableWebhookUsernameOverride *bool `json:"disable_webhook_username_override"`
+	DisableWebhookIconOverride     *bool `json:"disable_webhook_icon_override"`
+}
+
+// ChannelThreadSettings is the default thread behavior of a channel. ForceThreadedReplies keeps
+// the replies to a reply in the thread of its root post, rather than rejecting them.
+// AutoFollowThreads has every member of the channel follow the threads replied to, save for
+// those who unfollowed them.
+type ChannelThreadSettings struct {
+	ForceThreadedReplies bool `json:"force_threaded_replies"`
+	AutoFollowThreads    bool `json:"auto_follow_threads"`
 }
 
 type ChannelForExport struct {
@@ -235,6 +260,14 @@ func (o *Channel) IsValid() *AppError {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.creator_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if !IsValidLocale(o.DefaultLocale) {
+		return NewAppError("Channel.IsValid", "model.channel.is_valid.default_locale.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.SensitivityTag != "" && !IsValidChannelSensitivityTag(o.SensitivityTag) {
+		return NewAppError("Channel.IsValid", "model.channel.is_valid.sensitivity_tag.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	userIds := strings.Split(o.Name, "__")
 	if o.Type != ChannelTypeDirect && len(userIds) == 2 && IsValidId(userIds[0]) && IsValidId(userIds[1]) {
 		return NewAppError("Channel.IsValid", "model.channel.is_valid.name.app_error", nil, "", http.StatusBadRequest)
@@ -270,6 +303,18 @@ func (o *Channel) IsOpen() bool {
 	return o.Type == ChannelTypeOpen
 }
 
+func (o *Channel) ThreadSettings() *ChannelThreadSettings {
+	return &ChannelThreadSettings{
+		ForceThreadedReplies: o.ForceThreadedReplies,
+		AutoFollowThreads:    o.AutoFollowThreads,
+	}
+}
+
+func (o *Channel) SetThreadSettings(settings *ChannelThreadSettings) {
+	o.ForceThreadedReplies = settings.ForceThreadedReplies
+	o.AutoFollowThreads = settings.AutoFollowThreads
+}
+
 func (o *Channel) Patch(patch *ChannelPatch) {
 	if patch.DisplayName != nil {
 		o.DisplayName = *patch.DisplayName
@@ -290,6 +335,39 @@ func (o *Channel) Patch(patch *ChannelPatch) {
 	if patch.GroupConstrained != nil {
 		o.GroupConstrained = patch.GroupConstrained
 	}
+
+	if patch.DefaultLocale != nil {
+		o.DefaultLocale = *patch.DefaultLocale
+	}
+
+	if patch.SensitivityTag != nil {
+		o.SensitivityTag = *patch.SensitivityTag
+	}
+
+	if patch.DisableWebhookUsernameOverride != nil {
+		o.DisableWebhookUsernameOverride = *patch.DisableWebhookUsernameOverride
+	}
+
+	if patch.DisableWebhookIconOverride != nil {
+		o.DisableWebhookIconOverride = *patch.DisableWebhookIconOverride
+	}
+}
+
+// IsValidChannelSensitivityTag reports whether the given string can tag channels by the
+// sensitivity of their contents, such as "confidential".
+func IsValidChannelSensitivityTag(tag string) bool {
+	return len(tag) <= ChannelSensitivityTagMaxLength && isLower(tag) && IsValidAlphaNumHyphenUnderscore(tag, false)
+}
+
+// GetNotificationLocale returns the locale to render the notifications of the channel in for
+// a user with the given locale, falling back to the default locale of the channel for users
+// without one.
+func (o *Channel) GetNotificationLocale(userLocale string) string {
+	if userLocale != "" {
+		return userLocale
+	}
+
+	return o.DefaultLocale
 }
 
 func (o *Channel) MakeNonNil() {
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// ChannelArchiveWarningDays is how long before being archived an inactive channel is
+	// warned about it.
+	ChannelArchiveWarningDays = 7
+
+	ChannelArchivePolicyMinInactiveDays           = ChannelArchiveWarningDays + 1
+	ChannelArchivePolicyMaxExcludedChannels       = 1000
+	channelArchivePolicyDayMillis           int64 = int64(24 * time.Hour / time.Millisecond)
+)
+
+// ChannelArchivePolicy archives the public and private channels of a team that had no posts
+// for InactiveDays, except the default channel and the excluded ones.
+type ChannelArchivePolicy struct {
+	TeamId             string      `json:"team_id"`
+	InactiveDays       int         `json:"inactive_days"`
+	ExcludedChannelIds StringArray `json:"excluded_channel_ids"`
+	CreateAt           int64       `json:"create_at"`
+	UpdateAt           int64       `json:"update_at"`
+}
+
+// ChannelArchiveWarning records that a channel was warned about being archived for its
+// inactivity. Posts made after WarnedAt cancel the warning.
+type ChannelArchiveWarning struct {
+	ChannelId      string `json:"channel_id"`
+	TeamId         string `json:"team_id"`
+	LastActivityAt int64  `json:"last_activity_at"`
+	WarnedAt       int64  `json:"warned_at"`
+}
+
+func (p *ChannelArchivePolicy) PreSave() {
+	if p.ExcludedChannelIds == nil {
+		p.ExcludedChannelIds = StringArray{}
+	}
+
+	p.CreateAt = GetMillis()
+	p.UpdateAt = p.CreateAt
+}
+
+func (p *ChannelArchivePolicy) PreUpdate() {
+	if p.ExcludedChannelIds == nil {
+		p.ExcludedChannelIds = StringArray{}
+	}
+
+	p.UpdateAt = GetMillis()
+}
+
+func (p *ChannelArchivePolicy) IsValid() *AppError {
+	if !IsValidId(p.TeamId) {
+		return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if p.InactiveDays < ChannelArchivePolicyMinInactiveDays {
+		return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.inactive_days.app_error", map[string]interface{}{"Min": ChannelArchivePolicyMinInactiveDays}, "team_id="+p.TeamId, http.StatusBadRequest)
+	}
+
+	if len(p.ExcludedChannelIds) > ChannelArchivePolicyMaxExcludedChannels {
+		return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.excluded_channels.app_error", map[string]interface{}{"Max": ChannelArchivePolicyMaxExcludedChannels}, "team_id="+p.TeamId, http.StatusBadRequest)
+	}
+
+	for _, channelID := range p.ExcludedChannelIds {
+		if !IsValidId(channelID) {
+			return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.excluded_channel_id.app_error", nil, "team_id="+p.TeamId, http.StatusBadRequest)
+		}
+	}
+
+	if p.CreateAt == 0 {
+		return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.create_at.app_error", nil, "team_id="+p.TeamId, http.StatusBadRequest)
+	}
+
+	if p.UpdateAt == 0 {
+		return NewAppError("ChannelArchivePolicy.IsValid", "model.channel_archive_policy.is_valid.update_at.app_error", nil, "team_id="+p.TeamId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsExcluded tells whether the channel is exempt from the policy.
+func (p *ChannelArchivePolicy) IsExcluded(channelID string) bool {
+	return p.ExcludedChannelIds.Contains(channelID)
+}
+
+// WarnBefore returns the time before which the last activity of a channel has it warned
+// about being archived, at the given time.
+func (p *ChannelArchivePolicy) WarnBefore(now int64) int64 {
+	return now - int64(p.InactiveDays-ChannelArchiveWarningDays)*channelArchivePolicyDayMillis
+}
+
+// ArchiveAt returns the time at which the warned channel is archived: once inactive for
+// InactiveDays, and no sooner than a week after the warning.
+func (p *ChannelArchivePolicy) ArchiveAt(warning *ChannelArchiveWarning) int64 {
+	archiveAt := warning.LastActivityAt + int64(p.InactiveDays)*channelArchivePolicyDayMillis
+	if earliest := warning.WarnedAt + ChannelArchiveWarningDays*channelArchivePolicyDayMillis; archiveAt < earliest {
+		return earliest
+	}
+	return archiveAt
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelArchivePolicyIsValid(t *testing.T) {
+	policy := &ChannelArchivePolicy{
+		TeamId:       NewId(),
+		InactiveDays: 30,
+	}
+	policy.PreSave()
+
+	assert.NotNil(t, policy.ExcludedChannelIds)
+	require.Nil(t, policy.IsValid())
+
+	policy.TeamId = "junk"
+	require.NotNil(t, policy.IsValid())
+	policy.TeamId = NewId()
+
+	policy.InactiveDays = ChannelArchiveWarningDays
+	require.NotNil(t, policy.IsValid())
+	policy.InactiveDays = ChannelArchivePolicyMinInactiveDays
+	require.Nil(t, policy.IsValid())
+
+	policy.ExcludedChannelIds = StringArray{"junk"}
+	require.NotNil(t, policy.IsValid())
+	policy.ExcludedChannelIds = make(StringArray, ChannelArchivePolicyMaxExcludedChannels+1)
+	for i := range policy.ExcludedChannelIds {
+		policy.ExcludedChannelIds[i] = NewId()
+	}
+	require.NotNil(t, policy.IsValid())
+	policy.ExcludedChannelIds = StringArray{NewId()}
+	require.Nil(t, policy.IsValid())
+
+	policy.UpdateAt = 0
+	require.NotNil(t, policy.IsValid())
+}
+
+func TestChannelArchivePolicyIsExcluded(t *testing.T) {
+	channelID := NewId()
+	policy := &ChannelArchivePolicy{ExcludedChannelIds: StringArray{channelID}}
+
+	assert.True(t, policy.IsExcluded(channelID))
+	assert.False(t, policy.IsExcluded(NewId()))
+}
+
+func TestChannelArchivePolicyTimes(t *testing.T) {
+	policy := &ChannelArchivePolicy{InactiveDays: 30}
+	now := int64(100) * channelArchivePolicyDayMillis
+
+	assert.Equal(t, int64(77)*channelArchivePolicyDayMillis, policy.WarnBefore(now))
+
+	t.Run("archived at the end of the inactivity period", func(t *testing.T) {
+		warning := &ChannelArchiveWarning{
+			LastActivityAt: int64(70) * channelArchivePolicyDayMillis,
+			WarnedAt:       int64(93) * channelArchivePolicyDayMillis,
+		}
+		assert.Equal(t, now, policy.ArchiveAt(warning))
+	})
+
+	t.Run("archived no sooner than a week after the warning", func(t *testing.T) {
+		warning := &ChannelArchiveWarning{
+			LastActivityAt: int64(10) * channelArchivePolicyDayMillis,
+			WarnedAt:       now,
+		}
+		assert.Equal(t, now+ChannelArchiveWarningDays*channelArchivePolicyDayMillis, policy.ArchiveAt(warning))
+	})
+}
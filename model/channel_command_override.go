@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ChannelCommandOverride disables a slash command in a channel. The command is referred to by
+// its trigger, so that built-in, plugin and custom commands can all be disabled alike.
+type ChannelCommandOverride struct {
+	ChannelId string `json:"channel_id"`
+	Trigger   string `json:"trigger"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+// NormalizeCommandTrigger returns the trigger the way commands are matched on execution:
+// lowercased and without its leading slash.
+func NormalizeCommandTrigger(trigger string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(trigger), "/"))
+}
+
+func (o *ChannelCommandOverride) PreSave() {
+	o.Trigger = NormalizeCommandTrigger(o.Trigger)
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+}
+
+func (o *ChannelCommandOverride) IsValid() *AppError {
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("ChannelCommandOverride.IsValid", "model.channel_command_override.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(o.Trigger) < MinTriggerLength || len(o.Trigger) > MaxTriggerLength || strings.HasPrefix(o.Trigger, "/") || strings.Contains(o.Trigger, " ") {
+		return NewAppError("ChannelCommandOverride.IsValid", "model.channel_command_override.is_valid.trigger.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("ChannelCommandOverride.IsValid", "model.channel_command_override.is_valid.creator_id.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("ChannelCommandOverride.IsValid", "model.channel_command_override.is_valid.create_at.app_error", nil, "channel_id="+o.ChannelId, http.StatusBadRequest)
+	}
+
+	return nil
+}
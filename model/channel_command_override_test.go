@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelCommandOverridePreSave(t *testing.T) {
+	override := ChannelCommandOverride{ChannelId: NewId(), Trigger: "/Giphy", CreatorId: NewId()}
+	override.PreSave()
+	assert.Equal(t, "giphy", override.Trigger)
+	assert.NotZero(t, override.CreateAt)
+}
+
+func TestChannelCommandOverrideIsValid(t *testing.T) {
+	override := ChannelCommandOverride{ChannelId: NewId(), Trigger: "giphy", CreatorId: NewId()}
+	override.PreSave()
+	require.Nil(t, override.IsValid())
+
+	for _, trigger := range []string{"", "/giphy", "gi phy", strings.Repeat("a", MaxTriggerLength+1)} {
+		override.Trigger = trigger
+		assert.NotNil(t, override.IsValid(), trigger)
+	}
+	override.Trigger = "giphy"
+
+	override.ChannelId = "junk"
+	require.NotNil(t, override.IsValid())
+	override.ChannelId = NewId()
+
+	override.CreatorId = "junk"
+	require.NotNil(t, override.IsValid())
+	override.CreatorId = NewId()
+
+	override.CreateAt = 0
+	require.NotNil(t, override.IsValid())
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ChannelCreatorTransfer is the outcome of making another member the creator of a channel.
+// Integrations lists what the previous creator owns in the channel, so that the new creator can
+// be asked to take those over too.
+type ChannelCreatorTransfer struct {
+	Channel           *Channel             `json:"channel"`
+	PreviousCreatorId string               `json:"previous_creator_id"`
+	Integrations      *ChannelIntegrations `json:"integrations"`
+}
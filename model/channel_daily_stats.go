@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	ChannelStatsTimeseriesDefaultDays = 30
+	ChannelStatsTimeseriesMaxDays     = 365
+)
+
+// ChannelDailyStats are the activity figures of a channel for a single UTC day. They are
+// computed along with the team stats by the nightly rollup job rather than queried live, so
+// direct and group messages have none. Active members are the users who posted in the channel
+// that day.
+type ChannelDailyStats struct {
+	ChannelId     string `json:"channel_id"`
+	Day           int64  `json:"day"`
+	PostCount     int64  `json:"post_count"`
+	ActiveMembers int64  `json:"active_members"`
+}
+
+// ChannelStatsTimeseries are the daily stats of a channel for every day between Since and
+// Until, the days without activity included.
+type ChannelStatsTimeseries struct {
+	ChannelId string               `json:"channel_id"`
+	Since     int64                `json:"since"`
+	Until     int64                `json:"until"`
+	Days      []*ChannelDailyStats `json:"days"`
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	ChannelDigestCadenceDaily  = "daily"
+	ChannelDigestCadenceWeekly = "weekly"
+
+	ChannelDigestMaxTopThreads = 3
+	ChannelDigestMaxMentions   = 5
+)
+
+// ChannelDigest is a user's subscription to a periodic email summarizing the
+// activity of a channel they have muted.
+type ChannelDigest struct {
+	UserId     string `json:"user_id"`
+	ChannelId  string `json:"channel_id"`
+	Cadence    string `json:"cadence"`
+	LastSentAt int64  `json:"last_sent_at"`
+	CreateAt   int64  `json:"create_at"`
+}
+
+// ChannelDigestPost is a post surfaced in a channel digest.
+type ChannelDigestPost struct {
+	PostId     string `json:"post_id"`
+	UserId     string `json:"user_id"`
+	Message    string `json:"message"`
+	CreateAt   int64  `json:"create_at"`
+	ReplyCount int64  `json:"reply_count"`
+}
+
+// ChannelDigestActivity summarizes what happened in a channel over a digest period.
+type ChannelDigestActivity struct {
+	ChannelId          string               `json:"channel_id"`
+	PostCount          int64                `json:"post_count"`
+	TopThreads         []*ChannelDigestPost `json:"top_threads"`
+	UnansweredMentions []*ChannelDigestPost `json:"unanswered_mentions"`
+}
+
+func IsValidChannelDigestCadence(cadence string) bool {
+	return cadence == ChannelDigestCadenceDaily || cadence == ChannelDigestCadenceWeekly
+}
+
+// ChannelDigestPeriod returns the length in milliseconds of the period covered
+// by a digest sent with the given cadence.
+func ChannelDigestPeriod(cadence string) int64 {
+	if cadence == ChannelDigestCadenceWeekly {
+		return 7 * 24 * 60 * 60 * 1000
+	}
+	return 24 * 60 * 60 * 1000
+}
+
+func (d *ChannelDigest) PreSave() {
+	if d.CreateAt == 0 {
+		d.CreateAt = GetMillis()
+	}
+
+	if d.LastSentAt == 0 {
+		d.LastSentAt = d.CreateAt
+	}
+}
+
+func (d *ChannelDigest) IsValid() *AppError {
+	if !IsValidId(d.UserId) {
+		return NewAppError("ChannelDigest.IsValid", "model.channel_digest.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(d.ChannelId) {
+		return NewAppError("ChannelDigest.IsValid", "model.channel_digest.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidChannelDigestCadence(d.Cadence) {
+		return NewAppError("ChannelDigest.IsValid", "model.channel_digest.is_valid.cadence.app_error", nil, "cadence="+d.Cadence, http.StatusBadRequest)
+	}
+
+	if d.CreateAt == 0 {
+		return NewAppError("ChannelDigest.IsValid", "model.channel_digest.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsEmpty reports whether there is nothing worth emailing about.
+func (a *ChannelDigestActivity) IsEmpty() bool {
+	return a.PostCount == 0 && len(a.UnansweredMentions) == 0
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelDigestIsValid(t *testing.T) {
+	digest := &ChannelDigest{
+		UserId:    NewId(),
+		ChannelId: NewId(),
+		Cadence:   ChannelDigestCadenceDaily,
+	}
+	digest.PreSave()
+	require.Nil(t, digest.IsValid())
+	assert.Equal(t, digest.CreateAt, digest.LastSentAt)
+
+	digest.Cadence = ChannelDigestCadenceWeekly
+	require.Nil(t, digest.IsValid())
+
+	digest.Cadence = "hourly"
+	require.NotNil(t, digest.IsValid())
+	digest.Cadence = ChannelDigestCadenceDaily
+
+	digest.ChannelId = "junk"
+	require.NotNil(t, digest.IsValid())
+	digest.ChannelId = NewId()
+
+	digest.UserId = ""
+	require.NotNil(t, digest.IsValid())
+}
+
+func TestChannelDigestPeriod(t *testing.T) {
+	assert.Equal(t, int64(24*60*60*1000), ChannelDigestPeriod(ChannelDigestCadenceDaily))
+	assert.Equal(t, 7*ChannelDigestPeriod(ChannelDigestCadenceDaily), ChannelDigestPeriod(ChannelDigestCadenceWeekly))
+}
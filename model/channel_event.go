@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	// ChannelEventTypeConvert records the conversion of a channel between public and private.
+	// OldValue and NewValue hold the channel types before and after the conversion.
+	ChannelEventTypeConvert = "convert"
+
+	ChannelEventValueMaxLength = 64
+
+	// PostPropsChannelEventId references the channel event announced by a system post, and
+	// PostPropsRevertExpireAt the time until which the channel admins can revert it.
+	PostPropsChannelEventId = "channel_event_id"
+	PostPropsRevertExpireAt = "revert_expire_at"
+)
+
+// ChannelEvent records a change made to a channel, so it can be reviewed by the channel members
+// and reverted by the channel admins.
+type ChannelEvent struct {
+	Id         string `json:"id"`
+	ChannelId  string `json:"channel_id"`
+	UserId     string `json:"user_id"`
+	Type       string `json:"type"`
+	OldValue   string `json:"old_value"`
+	NewValue   string `json:"new_value"`
+	CreateAt   int64  `json:"create_at"`
+	RevertedAt int64  `json:"reverted_at"`
+	RevertedBy string `json:"reverted_by"`
+}
+
+func (e *ChannelEvent) PreSave() {
+	if e.Id == "" {
+		e.Id = NewId()
+	}
+
+	if e.CreateAt == 0 {
+		e.CreateAt = GetMillis()
+	}
+}
+
+func (e *ChannelEvent) IsValid() *AppError {
+	if !IsValidId(e.Id) {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(e.ChannelId) {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.channel_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	// The user is empty for the changes made through the local mode.
+	if e.UserId != "" && !IsValidId(e.UserId) {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.user_id.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if e.Type != ChannelEventTypeConvert {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.type.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if len(e.OldValue) > ChannelEventValueMaxLength || len(e.NewValue) > ChannelEventValueMaxLength {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.value.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if e.CreateAt == 0 {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.create_at.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if e.RevertedBy != "" && !IsValidId(e.RevertedBy) {
+		return NewAppError("ChannelEvent.IsValid", "model.channel_event.is_valid.reverted_by.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsReverted reports whether the change recorded by the event was reverted.
+func (e *ChannelEvent) IsReverted() bool {
+	return e.RevertedAt != 0
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelEventIsValid(t *testing.T) {
+	e := ChannelEvent{}
+
+	err := e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.id.app_error")
+
+	e.Id = NewId()
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.channel_id.app_error")
+
+	e.ChannelId = NewId()
+	e.UserId = "invalid"
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.user_id.app_error")
+
+	e.UserId = ""
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.type.app_error")
+
+	e.Type = ChannelEventTypeConvert
+	e.OldValue = string(ChannelTypeOpen)
+	e.NewValue = string(ChannelTypePrivate)
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.create_at.app_error")
+
+	e.PreSave()
+	require.Nil(t, e.IsValid())
+
+	e.RevertedBy = "invalid"
+	err = e.IsValid()
+	require.False(t, err == nil || err.Id != "model.channel_event.is_valid.reverted_by.app_error")
+
+	e.RevertedBy = NewId()
+	require.Nil(t, e.IsValid())
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"text/template"
+	"unicode/utf8"
+)
+
+const (
+	ChannelFeedURLMaxLength         = 1024
+	ChannelFeedTemplateMaxRunes     = 4000
+	ChannelFeedErrorMaxRunes        = 1024
+	ChannelFeedItemGuidMaxLength    = 255
+	ChannelFeedDefaultPollInterval  = 15
+	ChannelFeedMaxPollIntervalHours = 24
+
+	// ChannelFeedDefaultTemplate is the template of the posts of a feed without one. The
+	// fields of ChannelFeedEntry are available to templates.
+	ChannelFeedDefaultTemplate = "#### [{{.Title}}]({{.Link}})\n{{.Summary}}"
+)
+
+// ChannelFeed is the subscription of a channel to an RSS or Atom feed. The new items of the feed
+// are posted to the channel by the feed bot, every PollIntervalMinutes, using the template.
+type ChannelFeed struct {
+	Id                  string `json:"id"`
+	ChannelId           string `json:"channel_id"`
+	TeamId              string `json:"team_id"`
+	CreatorId           string `json:"creator_id"`
+	URL                 string `json:"url"`
+	Template            string `json:"template"`
+	PollIntervalMinutes int    `json:"poll_interval_minutes"`
+	LastPolledAt        int64  `json:"last_polled_at"`
+	NextPollAt          int64  `json:"next_poll_at"`
+	LastError           string `json:"last_error"`
+	CreateAt            int64  `json:"create_at"`
+	UpdateAt            int64  `json:"update_at"`
+}
+
+type ChannelFeedPatch struct {
+	URL                 *string `json:"url"`
+	Template            *string `json:"template"`
+	PollIntervalMinutes *int    `json:"poll_interval_minutes"`
+}
+
+// ChannelFeedItem records an item of a feed as seen, so that it's posted to the channel only
+// once. Items are told apart by their GUID, or their link when they have none.
+type ChannelFeedItem struct {
+	FeedId   string `json:"feed_id"`
+	ItemGuid string `json:"item_guid"`
+	PostId   string `json:"post_id"`
+	CreateAt int64  `json:"create_at"`
+}
+
+// ChannelFeedEntry is what the template of a feed is executed with for each new item.
+type ChannelFeedEntry struct {
+	FeedTitle string
+	Title     string
+	Link      string
+	Summary   string
+	Author    string
+	Published string
+}
+
+func (f *ChannelFeed) PreSave() {
+	if f.Id == "" {
+		f.Id = NewId()
+	}
+
+	if f.PollIntervalMinutes == 0 {
+		f.PollIntervalMinutes = ChannelFeedDefaultPollInterval
+	}
+
+	f.LastPolledAt = 0
+	f.LastError = ""
+	f.CreateAt = GetMillis()
+	f.UpdateAt = f.CreateAt
+	f.NextPollAt = f.CreateAt
+}
+
+func (f *ChannelFeed) PreUpdate() {
+	f.UpdateAt = GetMillis()
+}
+
+func (f *ChannelFeed) IsValid() *AppError {
+	if !IsValidId(f.Id) {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(f.ChannelId) {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.channel_id.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(f.TeamId) {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.team_id.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(f.CreatorId) {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.creator_id.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if len(f.URL) > ChannelFeedURLMaxLength || !IsValidHTTPURL(f.URL) {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.url.app_error", map[string]interface{}{"MaxLength": ChannelFeedURLMaxLength}, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(f.Template) > ChannelFeedTemplateMaxRunes {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.template.app_error", map[string]interface{}{"MaxLength": ChannelFeedTemplateMaxRunes}, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if f.Template != "" {
+		if _, err := template.New("feed").Parse(f.Template); err != nil {
+			return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.template_parse.app_error", nil, "id="+f.Id+", "+err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	if f.PollIntervalMinutes < 1 || f.PollIntervalMinutes > ChannelFeedMaxPollIntervalHours*60 {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.poll_interval.app_error", map[string]interface{}{"Max": ChannelFeedMaxPollIntervalHours * 60}, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if f.CreateAt == 0 {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.create_at.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if f.UpdateAt == 0 {
+		return NewAppError("ChannelFeed.IsValid", "model.channel_feed.is_valid.update_at.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Patch updates the feed with the patch. A feed whose URL changes is polled again right away.
+func (f *ChannelFeed) Patch(patch *ChannelFeedPatch) {
+	if patch.URL != nil && *patch.URL != f.URL {
+		f.URL = *patch.URL
+		f.NextPollAt = GetMillis()
+	}
+
+	if patch.Template != nil {
+		f.Template = *patch.Template
+	}
+
+	if patch.PollIntervalMinutes != nil {
+		f.PollIntervalMinutes = *patch.PollIntervalMinutes
+	}
+}
+
+// GetTemplate returns the template of the posts of the feed.
+func (f *ChannelFeed) GetTemplate() string {
+	if f.Template == "" {
+		return ChannelFeedDefaultTemplate
+	}
+	return f.Template
+}
+
+// RecordPoll updates the feed with the outcome of a poll, an empty error meaning it succeeded.
+// LastPolledAt is the time of the last successful poll.
+func (f *ChannelFeed) RecordPoll(pollErr string, now int64) {
+	if pollErr == "" {
+		f.LastPolledAt = now
+	}
+	f.NextPollAt = now + int64(f.PollIntervalMinutes)*60*1000
+	f.LastError = pollErr
+	if utf8.RuneCountInString(f.LastError) > ChannelFeedErrorMaxRunes {
+		f.LastError = string([]rune(f.LastError)[:ChannelFeedErrorMaxRunes])
+	}
+}
+
+// ChannelFeedItemGuid returns the key an item of a feed is deduplicated by. GUIDs too long to
+// be stored are replaced by their hash.
+func ChannelFeedItemGuid(guid string) string {
+	if len(guid) <= ChannelFeedItemGuidMaxLength {
+		return guid
+	}
+	sum := sha256.Sum256([]byte(guid))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
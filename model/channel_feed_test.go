@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelFeedIsValid(t *testing.T) {
+	feed := &ChannelFeed{
+		ChannelId: NewId(),
+		TeamId:    NewId(),
+		CreatorId: NewId(),
+		URL:       "https://example.com/feed.xml",
+	}
+	feed.PreSave()
+	require.Nil(t, feed.IsValid())
+	assert.Equal(t, ChannelFeedDefaultPollInterval, feed.PollIntervalMinutes)
+	assert.Equal(t, feed.CreateAt, feed.NextPollAt, "a new feed is polled right away")
+	assert.Equal(t, ChannelFeedDefaultTemplate, feed.GetTemplate())
+
+	feed.URL = "ftp://example.com/feed.xml"
+	require.NotNil(t, feed.IsValid())
+	feed.URL = "https://example.com/" + strings.Repeat("a", ChannelFeedURLMaxLength)
+	require.NotNil(t, feed.IsValid())
+	feed.URL = "https://example.com/feed.xml"
+
+	feed.Template = "{{.Title"
+	require.NotNil(t, feed.IsValid())
+	feed.Template = strings.Repeat("a", ChannelFeedTemplateMaxRunes+1)
+	require.NotNil(t, feed.IsValid())
+	feed.Template = "{{.Title}}: {{.Link}}"
+	require.Nil(t, feed.IsValid())
+	assert.Equal(t, feed.Template, feed.GetTemplate())
+
+	feed.PollIntervalMinutes = -1
+	require.NotNil(t, feed.IsValid())
+	feed.PollIntervalMinutes = ChannelFeedMaxPollIntervalHours*60 + 1
+	require.NotNil(t, feed.IsValid())
+	feed.PollIntervalMinutes = 30
+
+	feed.ChannelId = "junk"
+	require.NotNil(t, feed.IsValid())
+}
+
+func TestChannelFeedPatch(t *testing.T) {
+	feed := &ChannelFeed{URL: "https://example.com/feed.xml", PollIntervalMinutes: 15, NextPollAt: 1}
+
+	feed.Patch(&ChannelFeedPatch{Template: NewString("{{.Title}}"), PollIntervalMinutes: NewInt(60)})
+	assert.Equal(t, "{{.Title}}", feed.Template)
+	assert.Equal(t, 60, feed.PollIntervalMinutes)
+	assert.Equal(t, int64(1), feed.NextPollAt)
+
+	feed.Patch(&ChannelFeedPatch{URL: NewString("https://example.com/atom.xml")})
+	assert.Equal(t, "https://example.com/atom.xml", feed.URL)
+	assert.Greater(t, feed.NextPollAt, int64(1), "a feed with a new URL is polled right away")
+}
+
+func TestChannelFeedRecordPoll(t *testing.T) {
+	feed := &ChannelFeed{PollIntervalMinutes: 15}
+
+	feed.RecordPoll(strings.Repeat("a", ChannelFeedErrorMaxRunes+1), 1000)
+	assert.Zero(t, feed.LastPolledAt, "only successful polls count")
+	assert.Equal(t, int64(1000+15*60*1000), feed.NextPollAt)
+	assert.Len(t, feed.LastError, ChannelFeedErrorMaxRunes)
+
+	feed.RecordPoll("", 2000)
+	assert.Equal(t, int64(2000), feed.LastPolledAt)
+	assert.Empty(t, feed.LastError)
+}
+
+func TestChannelFeedItemGuid(t *testing.T) {
+	assert.Equal(t, "urn:uuid:1234", ChannelFeedItemGuid("urn:uuid:1234"))
+
+	long := "https://example.com/" + strings.Repeat("a", ChannelFeedItemGuidMaxLength)
+	guid := ChannelFeedItemGuid(long)
+	assert.True(t, strings.HasPrefix(guid, "sha256:"))
+	assert.LessOrEqual(t, len(guid), ChannelFeedItemGuidMaxLength)
+	assert.Equal(t, guid, ChannelFeedItemGuid(long))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ChannelIntegrations lists the integrations able to post in a channel, along with the users
+// owning them.
+type ChannelIntegrations struct {
+	ChannelId        string             `json:"channel_id"`
+	IncomingWebhooks []*IncomingWebhook `json:"incoming_webhooks"`
+	OutgoingWebhooks []*OutgoingWebhook `json:"outgoing_webhooks"`
+	Commands         []*Command         `json:"commands"`
+	Bots             []*Bot             `json:"bots"`
+	// Owners holds the creators of the webhooks and commands and the owners of the bots, keyed
+	// by user id. Bots owned by plugins have no entry.
+	Owners map[string]*User `json:"owners"`
+}
+
+// OwnerIds returns the ids of the users owning the integrations, without duplicates.
+func (ci *ChannelIntegrations) OwnerIds() []string {
+	seen := map[string]bool{}
+	var ids []string
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, hook := range ci.IncomingWebhooks {
+		add(hook.UserId)
+	}
+	for _, hook := range ci.OutgoingWebhooks {
+		add(hook.CreatorId)
+	}
+	for _, command := range ci.Commands {
+		add(command.CreatorId)
+	}
+	for _, bot := range ci.Bots {
+		add(bot.OwnerId)
+	}
+
+	return ids
+}
+
+// Sanitize removes the secrets the integrations use to authenticate, which listing them does
+// not need.
+func (ci *ChannelIntegrations) Sanitize() {
+	for _, hook := range ci.OutgoingWebhooks {
+		hook.Token = ""
+	}
+	for _, command := range ci.Commands {
+		command.Token = ""
+	}
+}
+
+// OwnedBy returns the integrations owned by the user, along with the user when among the owners.
+func (ci *ChannelIntegrations) OwnedBy(userID string) *ChannelIntegrations {
+	owned := &ChannelIntegrations{
+		ChannelId:        ci.ChannelId,
+		IncomingWebhooks: []*IncomingWebhook{},
+		OutgoingWebhooks: []*OutgoingWebhook{},
+		Commands:         []*Command{},
+		Bots:             []*Bot{},
+		Owners:           map[string]*User{},
+	}
+
+	for _, hook := range ci.IncomingWebhooks {
+		if hook.UserId == userID {
+			owned.IncomingWebhooks = append(owned.IncomingWebhooks, hook)
+		}
+	}
+	for _, hook := range ci.OutgoingWebhooks {
+		if hook.CreatorId == userID {
+			owned.OutgoingWebhooks = append(owned.OutgoingWebhooks, hook)
+		}
+	}
+	for _, command := range ci.Commands {
+		if command.CreatorId == userID {
+			owned.Commands = append(owned.Commands, command)
+		}
+	}
+	for _, bot := range ci.Bots {
+		if bot.OwnerId == userID {
+			owned.Bots = append(owned.Bots, bot)
+		}
+	}
+	if owner, ok := ci.Owners[userID]; ok {
+		owned.Owners[userID] = owner
+	}
+
+	return owned
+}
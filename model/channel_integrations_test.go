@@ -0,0 +1,61 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelIntegrationsOwnerIds(t *testing.T) {
+	userID1 := NewId()
+	userID2 := NewId()
+
+	integrations := &ChannelIntegrations{
+		IncomingWebhooks: []*IncomingWebhook{{UserId: userID1}},
+		OutgoingWebhooks: []*OutgoingWebhook{{CreatorId: userID2}},
+		Commands:         []*Command{{CreatorId: userID1}},
+		Bots:             []*Bot{{OwnerId: "com.example.plugin"}, {OwnerId: ""}},
+	}
+
+	assert.Equal(t, []string{userID1, userID2, "com.example.plugin"}, integrations.OwnerIds())
+}
+
+func TestChannelIntegrationsSanitize(t *testing.T) {
+	integrations := &ChannelIntegrations{
+		OutgoingWebhooks: []*OutgoingWebhook{{Token: NewId(), CreatorId: NewId()}},
+		Commands:         []*Command{{Token: NewId(), Trigger: "deploy"}},
+	}
+
+	integrations.Sanitize()
+
+	assert.Empty(t, integrations.OutgoingWebhooks[0].Token)
+	assert.NotEmpty(t, integrations.OutgoingWebhooks[0].CreatorId)
+	assert.Empty(t, integrations.Commands[0].Token)
+	assert.Equal(t, "deploy", integrations.Commands[0].Trigger)
+}
+
+func TestChannelIntegrationsOwnedBy(t *testing.T) {
+	userID1 := NewId()
+	userID2 := NewId()
+
+	integrations := &ChannelIntegrations{
+		ChannelId:        NewId(),
+		IncomingWebhooks: []*IncomingWebhook{{UserId: userID1}, {UserId: userID2}},
+		OutgoingWebhooks: []*OutgoingWebhook{{CreatorId: userID2}},
+		Commands:         []*Command{{CreatorId: userID1}},
+		Bots:             []*Bot{{OwnerId: userID1}, {OwnerId: "com.example.plugin"}},
+		Owners:           map[string]*User{userID1: {Id: userID1}, userID2: {Id: userID2}},
+	}
+
+	owned := integrations.OwnedBy(userID1)
+
+	assert.Equal(t, integrations.ChannelId, owned.ChannelId)
+	assert.Equal(t, []*IncomingWebhook{{UserId: userID1}}, owned.IncomingWebhooks)
+	assert.Empty(t, owned.OutgoingWebhooks)
+	assert.Equal(t, []*Command{{CreatorId: userID1}}, owned.Commands)
+	assert.Equal(t, []*Bot{{OwnerId: userID1}}, owned.Bots)
+	assert.Equal(t, map[string]*User{userID1: {Id: userID1}}, owned.Owners)
+}
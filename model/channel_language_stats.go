@@ -0,0 +1,25 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	ChannelLanguageStatsDefaultDays = 30
+	ChannelLanguageStatsMaxDays     = 365
+)
+
+// ChannelLanguagePostCount is the number of posts made in a language. Posts whose language
+// wasn't detected are counted under an empty language.
+type ChannelLanguagePostCount struct {
+	Language  string `json:"language"`
+	PostCount int64  `json:"post_count"`
+}
+
+// ChannelLanguageStats are the languages of the posts made in a channel between Since and
+// Until, as computed by the nightly channel language stats rollup job.
+type ChannelLanguageStats struct {
+	ChannelId string                      `json:"channel_id"`
+	Since     int64                       `json:"since"`
+	Until     int64                       `json:"until"`
+	Languages []*ChannelLanguagePostCount `json:"languages"`
+}
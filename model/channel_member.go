@@ -19,6 +19,12 @@ const (
 	IgnoreChannelMentionsOff        = "off"
 	IgnoreChannelMentionsOn         = "on"
 	IgnoreChannelMentionsNotifyProp = "ignore_channel_mentions"
+
+	ChannelMembersSortByUserId       = "user_id"
+	ChannelMembersSortByLastViewedAt = "last_viewed_at"
+	ChannelMembersSortByLastPostAt   = "last_post_at"
+	ChannelMembersSortByUsername     = "username"
+	ChannelMembersSortByAdmin        = "admin"
 )
 
 type ChannelUnread struct {
@@ -96,6 +102,23 @@ type ChannelMemberWithTeamData struct {
 
 type ChannelMembers []ChannelMember
 
+type ChannelMembersGetOptions struct {
+	// Sort the channel members. Accepts "last_viewed_at" and "last_post_at", most recent
+	// first, "username", and "admin" to list the channel admins first, by username. Defaults
+	// to "user_id".
+	Sort string
+}
+
+// IsValidChannelMembersSort returns whether sort is one of the supported orders of the
+// channel members, the empty string meaning the default one.
+func IsValidChannelMembersSort(sort string) bool {
+	switch sort {
+	case "", ChannelMembersSortByUserId, ChannelMembersSortByLastViewedAt, ChannelMembersSortByLastPostAt, ChannelMembersSortByUsername, ChannelMembersSortByAdmin:
+		return true
+	}
+	return false
+}
+
 type ChannelMembersWithTeamData []ChannelMemberWithTeamData
 
 type ChannelMemberForExport struct {
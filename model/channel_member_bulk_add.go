@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// ChannelMemberBulkAddMaxUsers is the most users a single bulk add can add to a channel.
+const ChannelMemberBulkAddMaxUsers = 50000
+
+// ChannelMemberBulkAddRequest lists the users to add to a channel in the background.
+type ChannelMemberBulkAddRequest struct {
+	UserIds []string `json:"user_ids"`
+}
+
+func (r *ChannelMemberBulkAddRequest) IsValid() *AppError {
+	if len(r.UserIds) == 0 {
+		return NewAppError("ChannelMemberBulkAddRequest.IsValid", "model.channel_member_bulk_add.is_valid.empty.app_error", nil, "", http.StatusBadRequest)
+	}
+	if len(r.UserIds) > ChannelMemberBulkAddMaxUsers {
+		return NewAppError("ChannelMemberBulkAddRequest.IsValid", "model.channel_member_bulk_add.is_valid.too_many.app_error",
+			map[string]interface{}{"Max": ChannelMemberBulkAddMaxUsers}, "", http.StatusBadRequest)
+	}
+	for _, userID := range r.UserIds {
+		if !IsValidId(userID) {
+			return NewAppError("ChannelMemberBulkAddRequest.IsValid", "model.channel_member_bulk_add.is_valid.user_id.app_error", nil, "user_id="+userID, http.StatusBadRequest)
+		}
+	}
+	return nil
+}
+
+// ChannelMemberBulkAddFailure tells why a user couldn't be added to the channel. Error is the
+// id of the error.
+type ChannelMemberBulkAddFailure struct {
+	UserId string `json:"user_id"`
+	Error  string `json:"error"`
+}
+
+// ChannelMemberBulkAddReport tells how far the bulk add of members to a channel went, and
+// which users it couldn't add.
+type ChannelMemberBulkAddReport struct {
+	JobId          string                         `json:"job_id"`
+	ChannelId      string                         `json:"channel_id"`
+	Status         string                         `json:"status"`
+	Total          int                            `json:"total"`
+	Processed      int                            `json:"processed"`
+	Added          int                            `json:"added"`
+	AlreadyMembers int                            `json:"already_members"`
+	Failures       []*ChannelMemberBulkAddFailure `json:"failures"`
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMemberBulkAddRequestIsValid(t *testing.T) {
+	req := &ChannelMemberBulkAddRequest{}
+	appErr := req.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.channel_member_bulk_add.is_valid.empty.app_error", appErr.Id)
+
+	req.UserIds = []string{NewId(), "junk"}
+	appErr = req.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.channel_member_bulk_add.is_valid.user_id.app_error", appErr.Id)
+
+	req.UserIds = make([]string, ChannelMemberBulkAddMaxUsers+1)
+	for i := range req.UserIds {
+		req.UserIds[i] = NewId()
+	}
+	appErr = req.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.channel_member_bulk_add.is_valid.too_many.app_error", appErr.Id)
+
+	req.UserIds = req.UserIds[:ChannelMemberBulkAddMaxUsers]
+	assert.Nil(t, req.IsValid())
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	// ChannelPresenceTimeoutMilliseconds is how long a user is listed as viewing a channel
+	// after the client last reported it. Clients renew their presence more often than that
+	// for as long as the channel stays open.
+	ChannelPresenceTimeoutMilliseconds = 60000
+)
+
+// ChannelPresence lists the users who currently have a channel open.
+type ChannelPresence struct {
+	ChannelId string   `json:"channel_id"`
+	UserIds   []string `json:"user_ids"`
+}
@@ -6,7 +6,9 @@ package model
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
 	"regexp"
+	"unicode/utf8"
 )
 
 type SidebarCategoryType string
@@ -34,6 +36,8 @@ const (
 	SidebarCategorySortRecent SidebarCategorySorting = "recent"
 	// sort by display name alphabetically
 	SidebarCategorySortAlphabetical SidebarCategorySorting = "alpha"
+	// The length of the display names of the categories is limited by the database
+	SidebarCategoryDisplayNameMaxRunes = 64
 )
 
 // SidebarCategory represents the corresponding DB table
@@ -67,6 +71,66 @@ type OrderedSidebarCategories struct {
 	Order      SidebarCategoryOrder          `json:"order"`
 }
 
+// SidebarCategoriesExport is the layout of the sidebar of a user on a team, in a form which can
+// be applied to another team or user. The channels are referred to by name as their IDs differ
+// between teams, and the direct and group messages, which belong to a single user, are left out.
+type SidebarCategoriesExport struct {
+	Categories []*SidebarCategoryExport `json:"categories"`
+}
+
+type SidebarCategoryExport struct {
+	Type         SidebarCategoryType    `json:"type"`
+	DisplayName  string                 `json:"display_name"`
+	Sorting      SidebarCategorySorting `json:"sorting"`
+	Muted        bool                   `json:"muted"`
+	Collapsed    bool                   `json:"collapsed"`
+	ChannelNames []string               `json:"channel_names"`
+}
+
+func (e *SidebarCategoriesExport) IsValid() *AppError {
+	types := map[SidebarCategoryType]bool{}
+	channelNames := map[string]bool{}
+	for _, category := range e.Categories {
+		if category == nil {
+			return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.category.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		switch category.Type {
+		case SidebarCategoryChannels, SidebarCategoryDirectMessages, SidebarCategoryFavorites:
+			if types[category.Type] {
+				return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.duplicate_type.app_error", map[string]interface{}{"Type": category.Type}, "", http.StatusBadRequest)
+			}
+			types[category.Type] = true
+		case SidebarCategoryCustom:
+			if category.DisplayName == "" || utf8.RuneCountInString(category.DisplayName) > SidebarCategoryDisplayNameMaxRunes {
+				return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.display_name.app_error", map[string]interface{}{"MaxLength": SidebarCategoryDisplayNameMaxRunes}, "", http.StatusBadRequest)
+			}
+		default:
+			return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.type.app_error", nil, "type="+string(category.Type), http.StatusBadRequest)
+		}
+
+		switch category.Sorting {
+		case SidebarCategorySortDefault, SidebarCategorySortManual, SidebarCategorySortRecent, SidebarCategorySortAlphabetical:
+		default:
+			return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.sorting.app_error", nil, "sorting="+string(category.Sorting), http.StatusBadRequest)
+		}
+
+		if category.Type == SidebarCategoryDirectMessages && len(category.ChannelNames) > 0 {
+			return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.direct_messages.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		// A channel can only be in one category.
+		for _, name := range category.ChannelNames {
+			if !IsValidChannelIdentifier(name) || channelNames[name] {
+				return NewAppError("SidebarCategoriesExport.IsValid", "model.sidebar_categories_export.is_valid.channel_name.app_error", map[string]interface{}{"Name": name}, "", http.StatusBadRequest)
+			}
+			channelNames[name] = true
+		}
+	}
+
+	return nil
+}
+
 type SidebarChannel struct {
 	ChannelId  string `json:"channel_id"`
 	UserId     string `json:"user_id"`
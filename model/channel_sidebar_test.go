@@ -5,6 +5,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,3 +48,75 @@ func TestIsValidCategoryId(t *testing.T) {
 		})
 	}
 }
+
+func TestSidebarCategoriesExportIsValid(t *testing.T) {
+	for _, test := range []struct {
+		Name     string
+		Category *SidebarCategoryExport
+		Other    *SidebarCategoryExport
+		Valid    bool
+	}{
+		{
+			Name:     "should accept a custom category",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryCustom, DisplayName: "Projects", ChannelNames: []string{"town-square", "off-topic"}},
+			Valid:    true,
+		},
+		{
+			Name:     "should accept system categories",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryFavorites, ChannelNames: []string{"town-square"}},
+			Other:    &SidebarCategoryExport{Type: SidebarCategoryDirectMessages, Sorting: SidebarCategorySortRecent},
+			Valid:    true,
+		},
+		{
+			Name:     "should reject a nil category",
+			Category: nil,
+		},
+		{
+			Name:     "should reject an unknown type",
+			Category: &SidebarCategoryExport{Type: "unknown"},
+		},
+		{
+			Name:     "should reject an unknown sorting",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryChannels, Sorting: "unknown"},
+		},
+		{
+			Name:     "should reject a custom category without a display name",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryCustom},
+		},
+		{
+			Name:     "should reject a custom category with a display name too long",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryCustom, DisplayName: strings.Repeat("a", SidebarCategoryDisplayNameMaxRunes+1)},
+		},
+		{
+			Name:     "should reject a system category listed twice",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryFavorites},
+			Other:    &SidebarCategoryExport{Type: SidebarCategoryFavorites},
+		},
+		{
+			Name:     "should reject channels in the direct messages category",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryDirectMessages, ChannelNames: []string{"town-square"}},
+		},
+		{
+			Name:     "should reject an invalid channel name",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryChannels, ChannelNames: []string{"Town Square"}},
+		},
+		{
+			Name:     "should reject a channel listed in two categories",
+			Category: &SidebarCategoryExport{Type: SidebarCategoryChannels, ChannelNames: []string{"town-square"}},
+			Other:    &SidebarCategoryExport{Type: SidebarCategoryCustom, DisplayName: "Projects", ChannelNames: []string{"town-square"}},
+		},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			export := &SidebarCategoriesExport{Categories: []*SidebarCategoryExport{test.Category}}
+			if test.Other != nil {
+				export.Categories = append(export.Categories, test.Other)
+			}
+
+			if test.Valid {
+				assert.Nil(t, export.IsValid())
+			} else {
+				assert.NotNil(t, export.IsValid())
+			}
+		})
+	}
+}
@@ -18,12 +18,13 @@ func TestChannelCopy(t *testing.T) {
 }
 
 func TestChannelPatch(t *testing.T) {
-	p := &ChannelPatch{Name: new(string), DisplayName: new(string), Header: new(string), Purpose: new(string), GroupConstrained: new(bool)}
+	p := &ChannelPatch{Name: new(string), DisplayName: new(string), Header: new(string), Purpose: new(string), GroupConstrained: new(bool), DefaultLocale: new(string)}
 	*p.Name = NewId()
 	*p.DisplayName = NewId()
 	*p.Header = NewId()
 	*p.Purpose = NewId()
 	*p.GroupConstrained = true
+	*p.DefaultLocale = "fr"
 
 	o := Channel{Id: NewId(), Name: NewId()}
 	o.Patch(p)
@@ -33,6 +34,27 @@ func TestChannelPatch(t *testing.T) {
 	require.Equal(t, *p.Header, o.Header)
 	require.Equal(t, *p.Purpose, o.Purpose)
 	require.Equal(t, *p.GroupConstrained, *o.GroupConstrained)
+	require.Equal(t, *p.DefaultLocale, o.DefaultLocale)
+	require.Empty(t, o.SensitivityTag)
+
+	o.Patch(&ChannelPatch{SensitivityTag: NewString("confidential")})
+	require.Equal(t, "confidential", o.SensitivityTag)
+	require.Equal(t, *p.Name, o.Name)
+
+	o.Patch(&ChannelPatch{DisableWebhookIconOverride: NewBool(true)})
+	require.True(t, o.DisableWebhookIconOverride)
+	require.False(t, o.DisableWebhookUsernameOverride)
+}
+
+func TestChannelThreadSettings(t *testing.T) {
+	o := Channel{Id: NewId(), Name: NewId()}
+	require.Equal(t, &ChannelThreadSettings{}, o.ThreadSettings())
+
+	settings := &ChannelThreadSettings{ForceThreadedReplies: true, AutoFollowThreads: true}
+	o.SetThreadSettings(settings)
+	require.True(t, o.ForceThreadedReplies)
+	require.True(t, o.AutoFollowThreads)
+	require.Equal(t, settings, o.ThreadSettings())
 }
 
 func TestChannelIsValid(t *testing.T) {
@@ -79,6 +101,30 @@ func TestChannelIsValid(t *testing.T) {
 
 	o.Purpose = strings.Repeat("0123456789", 25)
 	require.Nil(t, o.IsValid())
+
+	o.DefaultLocale = "not-a-locale"
+	require.NotNil(t, o.IsValid())
+
+	o.DefaultLocale = "es"
+	require.Nil(t, o.IsValid())
+
+	o.SensitivityTag = "Confidential"
+	require.NotNil(t, o.IsValid())
+
+	o.SensitivityTag = strings.Repeat("a", ChannelSensitivityTagMaxLength+1)
+	require.NotNil(t, o.IsValid())
+
+	o.SensitivityTag = "legal_hold-2"
+	require.Nil(t, o.IsValid())
+}
+
+func TestChannelGetNotificationLocale(t *testing.T) {
+	o := Channel{DefaultLocale: "fr"}
+	require.Equal(t, "es", o.GetNotificationLocale("es"))
+	require.Equal(t, "fr", o.GetNotificationLocale(""))
+
+	o.DefaultLocale = ""
+	require.Equal(t, "", o.GetNotificationLocale(""))
 }
 
 func TestChannelPreSave(t *testing.T) {
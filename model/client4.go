@@ -23,6 +23,7 @@ const (
 	HeaderClusterId          = "X-Cluster-ID"
 	HeaderEtagServer         = "ETag"
 	HeaderEtagClient         = "If-None-Match"
+	HeaderIfMatch            = "If-Match"
 	HeaderForwarded          = "X-Forwarded-For"
 	HeaderRealIP             = "X-Real-IP"
 	HeaderForwardedProto     = "X-Forwarded-Proto"
@@ -40,6 +41,7 @@ const (
 	StatusOk                 = "OK"
 	StatusFail               = "FAIL"
 	StatusUnhealthy          = "UNHEALTHY"
+	StatusDegraded           = "DEGRADED"
 	StatusRemove             = "REMOVE"
 
 	ClientDir = "client"
@@ -544,10 +546,18 @@ func (c *Client4) sharedChannelsRoute() string {
 	return "/sharedchannels"
 }
 
+func (c *Client4) remoteClusterRoute(remoteID string) string {
+	return fmt.Sprintf("/remotecluster/%v", remoteID)
+}
+
 func (c *Client4) permissionsRoute() string {
 	return "/permissions"
 }
 
+func (c *Client4) apiUsageRoute() string {
+	return "/api_usage"
+}
+
 func (c *Client4) DoAPIGet(url string, etag string) (*http.Response, error) {
 	return c.DoAPIRequest(http.MethodGet, c.APIURL+url, "", etag)
 }
@@ -1240,12 +1250,16 @@ func (c *Client4) GetUsersByIds(userIds []string) ([]*User, *Response, error) {
 	return list, BuildResponse(r), nil
 }
 
-// GetUsersByIds returns a list of users based on the provided user ids.
+// GetUsersByIdsWithOptions returns a list of users based on the provided user ids. When fields
+// are set, only these fields of the users are filled in, along with their id.
 func (c *Client4) GetUsersByIdsWithOptions(userIds []string, options *UserGetByIdsOptions) ([]*User, *Response, error) {
 	v := url.Values{}
 	if options.Since != 0 {
 		v.Set("since", fmt.Sprintf("%d", options.Since))
 	}
+	if len(options.Fields) > 0 {
+		v.Set("fields", strings.Join(options.Fields, ","))
+	}
 
 	url := c.usersRoute() + "/ids"
 	if len(v) > 0 {
@@ -1586,6 +1600,31 @@ func (c *Client4) RevokeAllSessions(userId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetSessionDevices returns the devices a user is logged in from.
+func (c *Client4) GetSessionDevices(userId string) ([]*SessionDevice, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/devices", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var devices []*SessionDevice
+	if jsonErr := json.NewDecoder(r.Body).Decode(&devices); jsonErr != nil {
+		return nil, nil, NewAppError("GetSessionDevices", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return devices, BuildResponse(r), nil
+}
+
+// RevokeOtherSessionDevices logs the user out of all the devices but the current one.
+func (c *Client4) RevokeOtherSessionDevices(userId string) (*Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/devices/revoke/others", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // RevokeAllSessions revokes all sessions for all the users.
 func (c *Client4) RevokeSessionsFromAllUsers() (*Response, error) {
 	r, err := c.DoAPIPost(c.usersRoute()+"/sessions/revoke/all", "")
@@ -1758,6 +1797,25 @@ func (c *Client4) CreateUserAccessToken(userId, description string) (*UserAccess
 	return &uat, BuildResponse(r), nil
 }
 
+// CreateScopedUserAccessToken creates a user access token whose websocket connections only
+// stream the events in the scope.
+func (c *Client4) CreateScopedUserAccessToken(userId, description string, scope *WebSocketScope) (*UserAccessToken, *Response, error) {
+	buf, err := json.Marshal(&UserAccessToken{Description: description, WebSocketScope: scope})
+	if err != nil {
+		return nil, nil, NewAppError("CreateScopedUserAccessToken", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/tokens", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var uat UserAccessToken
+	if jsonErr := json.NewDecoder(r.Body).Decode(&uat); jsonErr != nil {
+		return nil, nil, NewAppError("CreateScopedUserAccessToken", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &uat, BuildResponse(r), nil
+}
+
 // GetUserAccessTokens will get a page of access tokens' id, description, is_active
 // and the user_id in the system. The actual token will not be returned. Must have
 // the 'manage_system' permission.
@@ -2208,6 +2266,41 @@ func (c *Client4) GetTeamsForUser(userId, etag string) ([]*Team, *Response, erro
 	return list, BuildResponse(r), nil
 }
 
+// GetTeamsOrder returns the ids of the teams of the user in the order the user sorted them.
+func (c *Client4) GetTeamsOrder(userId string) ([]string, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/teams/order", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ArrayFromJSON(r.Body), BuildResponse(r), nil
+}
+
+// UpdateTeamsOrder sorts the teams of the user in the order, which must hold every team of the user.
+func (c *Client4) UpdateTeamsOrder(userId string, order []string) ([]string, *Response, error) {
+	r, err := c.DoAPIPut(c.userRoute(userId)+"/teams/order", ArrayToJSON(order))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ArrayFromJSON(r.Body), BuildResponse(r), nil
+}
+
+// MoveTeamInOrder moves the team to the index in the order of the teams of the user, and returns
+// the new order.
+func (c *Client4) MoveTeamInOrder(userId, teamId string, index int) ([]string, *Response, error) {
+	buf, err := json.Marshal(TeamOrderMove{Index: index})
+	if err != nil {
+		return nil, nil, NewAppError("MoveTeamInOrder", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.userRoute(userId)+"/teams/"+teamId+"/order", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ArrayFromJSON(r.Body), BuildResponse(r), nil
+}
+
 // GetTeamMember returns a team member based on the provided team and user id strings.
 func (c *Client4) GetTeamMember(teamId, userId, etag string) (*TeamMember, *Response, error) {
 	r, err := c.DoAPIGet(c.teamMemberRoute(teamId, userId), etag)
@@ -2351,6 +2444,86 @@ func (c *Client4) UpdateTeamPrivacy(teamId string, privacy string) (*Team, *Resp
 	return &t, BuildResponse(r), nil
 }
 
+// SetTeamDataResidency queues the move of the files of the team to the storage of the data
+// residency, an empty residency being the default file storage.
+func (c *Client4) SetTeamDataResidency(teamId string, residency string) (*FileResidencyMigration, *Response, error) {
+	requestBody := map[string]string{"data_residency": residency}
+	r, err := c.DoAPIPut(c.teamRoute(teamId)+"/data_residency", MapToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var m FileResidencyMigration
+	if jsonErr := json.NewDecoder(r.Body).Decode(&m); jsonErr != nil {
+		return nil, nil, NewAppError("SetTeamDataResidency", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &m, BuildResponse(r), nil
+}
+
+// SetTeamStrictIsolation overrides the strict user isolation of the server for the team. A nil
+// isolation makes the team follow the setting of the server again.
+func (c *Client4) SetTeamStrictIsolation(teamId string, isolation *bool) (*Team, *Response, error) {
+	requestBody := map[string]*bool{"strict_isolation": isolation}
+	buf, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, nil, NewAppError("SetTeamStrictIsolation", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.teamRoute(teamId)+"/strict_isolation", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var t Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&t); jsonErr != nil {
+		return nil, nil, NewAppError("SetTeamStrictIsolation", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &t, BuildResponse(r), nil
+}
+
+// GetChildTeams returns the direct sub-teams of a team that the user can see.
+func (c *Client4) GetChildTeams(teamId string) ([]*Team, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/children", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetChildTeams", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetTeamAncestors returns the teams above a team in the hierarchy, starting with its parent.
+func (c *Client4) GetTeamAncestors(teamId string) ([]*Team, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/ancestors", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamAncestors", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// SetParentTeam moves a team under another one. An empty parentTeamId moves the team to
+// the top of the hierarchy.
+func (c *Client4) SetParentTeam(teamId, parentTeamId string) (*Team, *Response, error) {
+	requestBody := map[string]string{"parent_team_id": parentTeamId}
+	r, err := c.DoAPIPut(c.teamRoute(teamId)+"/parent", MapToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var t Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&t); jsonErr != nil {
+		return nil, nil, NewAppError("SetParentTeam", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &t, BuildResponse(r), nil
+}
+
 // GetTeamMembers returns team members based on the provided team id string.
 func (c *Client4) GetTeamMembers(teamId string, page int, perPage int, etag string) ([]*TeamMember, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
@@ -2536,6 +2709,25 @@ func (c *Client4) GetTeamStats(teamId, etag string) (*TeamStats, *Response, erro
 	return &ts, BuildResponse(r), nil
 }
 
+// GetTeamExtendedStats returns the activity of a team over the given number of days, as
+// computed by the last nightly rollup. A days value of 0 uses the server default.
+func (c *Client4) GetTeamExtendedStats(teamId string, days int) (*TeamExtendedStats, *Response, error) {
+	query := ""
+	if days > 0 {
+		query = fmt.Sprintf("?days=%v", days)
+	}
+	r, err := c.DoAPIGet(c.teamStatsRoute(teamId)+"/extended"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var stats TeamExtendedStats
+	if jsonErr := json.NewDecoder(r.Body).Decode(&stats); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamExtendedStats", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &stats, BuildResponse(r), nil
+}
+
 // GetTotalUsersStats returns a total system user stats.
 // Must be authenticated.
 func (c *Client4) GetTotalUsersStats(etag string) (*UsersStats, *Response, error) {
@@ -2616,6 +2808,33 @@ func (c *Client4) InviteUsersToTeam(teamId string, userEmails []string) (*Respon
 	return BuildResponse(r), nil
 }
 
+// InviteUsersToTeamWithLocale invite users by email to the team, the invitations being written
+// in the given locale.
+func (c *Client4) InviteUsersToTeamWithLocale(teamId string, userEmails []string, locale string) (*Response, error) {
+	r, err := c.DoAPIPost(c.teamRoute(teamId)+"/invite/email?locale="+url.QueryEscape(locale), ArrayToJSON(userEmails))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// PreviewInviteEmail returns the email inviting users to the team as sent by the current user.
+// When sendTest is set, the email is also sent to the current user.
+func (c *Client4) PreviewInviteEmail(teamId string, sendTest bool) (*TeamInviteEmailPreview, *Response, error) {
+	r, err := c.DoAPIPost(c.teamRoute(teamId)+"/invite/email/preview?send_test="+c.boolString(sendTest), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var preview TeamInviteEmailPreview
+	if jsonErr := json.NewDecoder(r.Body).Decode(&preview); jsonErr != nil {
+		return nil, nil, NewAppError("PreviewInviteEmail", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &preview, BuildResponse(r), nil
+}
+
 // InviteGuestsToTeam invite guest by email to some channels in a team.
 func (c *Client4) InviteGuestsToTeam(teamId string, userEmails []string, channels []string, message string) (*Response, error) {
 	guestsInvite := GuestsInvite{
@@ -2891,6 +3110,42 @@ func (c *Client4) UpdateChannelPrivacy(channelId string, privacy ChannelType) (*
 	return ch, BuildResponse(r), nil
 }
 
+// TransferChannelCreator makes the channel member with the user id the creator of the channel.
+func (c *Client4) TransferChannelCreator(channelId, userId string) (*ChannelCreatorTransfer, *Response, error) {
+	requestBody := map[string]string{"user_id": userId}
+	r, err := c.DoAPIPut(c.channelRoute(channelId)+"/creator", MapToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var transfer ChannelCreatorTransfer
+	if jsonErr := json.NewDecoder(r.Body).Decode(&transfer); jsonErr != nil {
+		return nil, BuildResponse(r), NewAppError("TransferChannelCreator", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &transfer, BuildResponse(r), nil
+}
+
+// GetChannelsWithDeactivatedCreator returns a page of the channels whose creator is deactivated,
+// in the team or in all teams when teamId is empty.
+func (c *Client4) GetChannelsWithDeactivatedCreator(teamId string, page, perPage int) ([]*Channel, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	if teamId != "" {
+		query += "&team_id=" + teamId
+	}
+	r, err := c.DoAPIGet(c.channelsRoute()+"/deactivated_creators"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch []*Channel
+	if jsonErr := json.NewDecoder(r.Body).Decode(&ch); jsonErr != nil {
+		return nil, BuildResponse(r), NewAppError("GetChannelsWithDeactivatedCreator", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return ch, BuildResponse(r), nil
+}
+
 // RestoreChannel restores a previously deleted channel. Any missing fields are not updated.
 func (c *Client4) RestoreChannel(channelId string) (*Channel, *Response, error) {
 	r, err := c.DoAPIPost(c.channelRoute(channelId)+"/restore", "")
@@ -2971,6 +3226,86 @@ func (c *Client4) GetChannelStats(channelId string, etag string) (*ChannelStats,
 	return &stats, BuildResponse(r), nil
 }
 
+// GetChannelPresence returns the users who currently have the channel open.
+func (c *Client4) GetChannelPresence(channelId string) (*ChannelPresence, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/presence", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var presence ChannelPresence
+	if jsonErr := json.NewDecoder(r.Body).Decode(&presence); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelPresence", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &presence, BuildResponse(r), nil
+}
+
+// GetChannelIntegrations returns the webhooks, slash commands and bots able to post in the
+// channel, along with their owners.
+func (c *Client4) GetChannelIntegrations(channelId string) (*ChannelIntegrations, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/integrations", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var integrations ChannelIntegrations
+	if jsonErr := json.NewDecoder(r.Body).Decode(&integrations); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelIntegrations", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &integrations, BuildResponse(r), nil
+}
+
+// GetWebhookIdentityOverrides returns a page of the posts of the incoming webhooks asking for
+// another username or icon in a channel, newest first.
+func (c *Client4) GetWebhookIdentityOverrides(channelId string, page, perPage int) ([]*WebhookIdentityOverride, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/webhook_identity_overrides"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var overrides []*WebhookIdentityOverride
+	if jsonErr := json.NewDecoder(r.Body).Decode(&overrides); jsonErr != nil {
+		return nil, nil, NewAppError("GetWebhookIdentityOverrides", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return overrides, BuildResponse(r), nil
+}
+
+// GetChannelThreadSettings returns the default thread behavior of a channel.
+func (c *Client4) GetChannelThreadSettings(channelId string) (*ChannelThreadSettings, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/thread_settings", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var settings ChannelThreadSettings
+	if jsonErr := json.NewDecoder(r.Body).Decode(&settings); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelThreadSettings", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &settings, BuildResponse(r), nil
+}
+
+// UpdateChannelThreadSettings sets the default thread behavior of a public or private channel.
+func (c *Client4) UpdateChannelThreadSettings(channelId string, settings *ChannelThreadSettings) (*ChannelThreadSettings, *Response, error) {
+	buf, err := json.Marshal(settings)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateChannelThreadSettings", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.channelRoute(channelId)+"/thread_settings", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updated ChannelThreadSettings
+	if jsonErr := json.NewDecoder(r.Body).Decode(&updated); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateChannelThreadSettings", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
 // GetChannelMembersTimezones gets a list of timezones for a channel.
 func (c *Client4) GetChannelMembersTimezones(channelId string) ([]string, *Response, error) {
 	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/timezones", "")
@@ -3363,6 +3698,24 @@ func (c *Client4) GetChannelMembers(channelId string, page, perPage int, etag st
 	return ch, BuildResponse(r), nil
 }
 
+// GetChannelMembersSorted gets a page of channel members, sorted by one of the
+// ChannelMembersSortBy orders.
+func (c *Client4) GetChannelMembersSorted(channelId string, page, perPage int, sort string, etag string) (ChannelMembers, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v&sort=%v", page, perPage, sort)
+	r, err := c.DoAPIGet(c.channelMembersRoute(channelId)+query, etag)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch ChannelMembers
+	err = json.NewDecoder(r.Body).Decode(&ch)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("GetChannelMembersSorted", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return ch, BuildResponse(r), nil
+}
+
 // GetChannelMembersWithTeamData gets a page of all channel members for a user.
 func (c *Client4) GetChannelMembersWithTeamData(userID string, page, perPage int) (ChannelMembersWithTeamData, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
@@ -3518,6 +3871,41 @@ func (c *Client4) AddChannelMember(channelId, userId string) (*ChannelMember, *R
 	return ch, BuildResponse(r), nil
 }
 
+// BulkAddChannelMembers queues the addition of the users to the channel, returning the report
+// of the job adding them.
+func (c *Client4) BulkAddChannelMembers(channelId string, userIds []string) (*ChannelMemberBulkAddReport, *Response, error) {
+	buf, err := json.Marshal(ChannelMemberBulkAddRequest{UserIds: userIds})
+	if err != nil {
+		return nil, nil, NewAppError("BulkAddChannelMembers", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.channelMembersRoute(channelId)+"/bulk", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var report ChannelMemberBulkAddReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		return nil, nil, NewAppError("BulkAddChannelMembers", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &report, BuildResponse(r), nil
+}
+
+// GetChannelMemberBulkAddReport returns how far the bulk add of members to the channel went.
+func (c *Client4) GetChannelMemberBulkAddReport(channelId, jobId string) (*ChannelMemberBulkAddReport, *Response, error) {
+	r, err := c.DoAPIGet(c.channelMembersRoute(channelId)+"/bulk/"+jobId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var report ChannelMemberBulkAddReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelMemberBulkAddReport", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &report, BuildResponse(r), nil
+}
+
 // AddChannelMemberWithRootId adds user to channel and return a channel member. Post add to channel message has the postRootId.
 func (c *Client4) AddChannelMemberWithRootId(channelId, userId, postRootId string) (*ChannelMember, *Response, error) {
 	requestBody := map[string]string{"user_id": userId, "post_root_id": postRootId}
@@ -3727,6 +4115,59 @@ func (c *Client4) DeletePost(postId string) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// RestorePost restores a post deleted within the recycle bin period, along with the replies
+// deleted with it.
+func (c *Client4) RestorePost(postId string) (*Post, *Response, error) {
+	r, err := c.DoAPIPost(c.postRoute(postId)+"/restore", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var post Post
+	if jsonErr := json.NewDecoder(r.Body).Decode(&post); jsonErr != nil {
+		return nil, nil, NewAppError("RestorePost", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &post, BuildResponse(r), nil
+}
+
+// GetDeletedPostsForChannel gets a page of the posts of a channel that can be restored, most
+// recently deleted first.
+func (c *Client4) GetDeletedPostsForChannel(channelId string, page, perPage int) (*PostList, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/posts/deleted"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list PostList
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetDeletedPostsForChannel", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+// GetThreadSummary gets the participants, the reply counts over time and the most recent
+// replies of a thread. A zero Replies or an empty Bucket uses the server default.
+func (c *Client4) GetThreadSummary(threadId string, opts GetThreadSummaryOpts) (*ThreadSummary, *Response, error) {
+	values := url.Values{}
+	if opts.Replies > 0 {
+		values.Set("replies", strconv.Itoa(opts.Replies))
+	}
+	if opts.Bucket != "" {
+		values.Set("bucket", opts.Bucket)
+	}
+	r, err := c.DoAPIGet("/threads/"+threadId+"/summary?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var summary ThreadSummary
+	if jsonErr := json.NewDecoder(r.Body).Decode(&summary); jsonErr != nil {
+		return nil, nil, NewAppError("GetThreadSummary", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &summary, BuildResponse(r), nil
+}
+
 // GetPostThread gets a post with all the other posts in the same thread.
 func (c *Client4) GetPostThread(postId string, etag string, collapsedThreads bool) (*PostList, *Response, error) {
 	url := c.postRoute(postId) + "/thread"
@@ -3790,6 +4231,25 @@ func (c *Client4) GetPostsByIds(postIds []string) ([]*Post, *Response, error) {
 	return list, BuildResponse(r), nil
 }
 
+// RenderSystemPostMessages returns the messages of the system posts rendered in the locale, by
+// post id. The locale of the user is used when empty.
+func (c *Client4) RenderSystemPostMessages(postIds []string, locale string) (map[string]string, *Response, error) {
+	buf, err := json.Marshal(SystemPostMessagesRequest{PostIds: postIds, Locale: locale})
+	if err != nil {
+		return nil, nil, NewAppError("RenderSystemPostMessages", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.postsRoute()+"/system_messages", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var messages map[string]string
+	if jsonErr := json.NewDecoder(r.Body).Decode(&messages); jsonErr != nil {
+		return nil, nil, NewAppError("RenderSystemPostMessages", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return messages, BuildResponse(r), nil
+}
+
 // GetFlaggedPostsForUser returns flagged posts of a user based on user id string.
 func (c *Client4) GetFlaggedPostsForUser(userId string, page int, perPage int) (*PostList, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
@@ -4000,6 +4460,31 @@ func (c *Client4) SearchPostsWithParams(teamId string, params *SearchParameter)
 	return &list, BuildResponse(r), nil
 }
 
+// SearchPostResultsWithParams returns the posts matching the search parameters, along with
+// the matches and the archived channels the posts come from.
+func (c *Client4) SearchPostResultsWithParams(teamId string, params *SearchParameter) (*PostSearchResults, *Response, error) {
+	js, jsonErr := json.Marshal(params)
+	if jsonErr != nil {
+		return nil, nil, NewAppError("SearchPostResultsWithParams", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	var route string
+	if teamId == "" {
+		route = c.postsRoute() + "/search"
+	} else {
+		route = c.teamRoute(teamId) + "/posts/search"
+	}
+	r, err := c.DoAPIPost(route, string(js))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var psr PostSearchResults
+	if jsonErr := json.NewDecoder(r.Body).Decode(&psr); jsonErr != nil {
+		return nil, nil, NewAppError("SearchPostResultsWithParams", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &psr, BuildResponse(r), nil
+}
+
 // SearchPostsWithMatches returns any posts with matching terms string, including.
 func (c *Client4) SearchPostsWithMatches(teamId string, terms string, isOrSearch bool) (*PostSearchResults, *Response, error) {
 	requestBody := map[string]interface{}{"terms": terms, "is_or_search": isOrSearch}
@@ -4021,6 +4506,21 @@ func (c *Client4) SearchPostsWithMatches(teamId string, terms string, isOrSearch
 	return &psr, BuildResponse(r), nil
 }
 
+// GetSearchCapabilities returns the backend that post searches run against and the search
+// operators it supports.
+func (c *Client4) GetSearchCapabilities() (*SearchCapabilities, *Response, error) {
+	r, err := c.DoAPIGet("/search/capabilities", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var capabilities SearchCapabilities
+	if jsonErr := json.NewDecoder(r.Body).Decode(&capabilities); jsonErr != nil {
+		return nil, nil, NewAppError("GetSearchCapabilities", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &capabilities, BuildResponse(r), nil
+}
+
 // DoPostAction performs a post action.
 func (c *Client4) DoPostAction(postId, actionId string) (*Response, error) {
 	r, err := c.DoAPIPost(c.postRoute(postId)+"/actions/"+actionId, "")
@@ -4077,6 +4577,20 @@ func (c *Client4) SubmitInteractiveDialog(request SubmitDialogRequest) (*SubmitD
 	return &resp, BuildResponse(r), nil
 }
 
+// ValidateInteractiveDialogStep has the integration validate a step of a multi-step dialog.
+func (c *Client4) ValidateInteractiveDialogStep(request SubmitDialogRequest) (*SubmitDialogResponse, *Response, error) {
+	b, _ := json.Marshal(request)
+	r, err := c.DoAPIPost("/actions/dialogs/validate", string(b))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var resp SubmitDialogResponse
+	json.NewDecoder(r.Body).Decode(&resp)
+	return &resp, BuildResponse(r), nil
+}
+
 // UploadFile will upload a file to a channel using a multipart request, to be later attached to a post.
 // This method is functionally equivalent to Client4.UploadFileAsRequestBody.
 func (c *Client4) UploadFile(data []byte, channelId string, filename string) (*FileUploadResponse, *Response, error) {
@@ -4116,6 +4630,26 @@ func (c *Client4) UploadFileAsRequestBody(data []byte, channelId string, filenam
 	return c.DoUploadFile(c.filesRoute()+fmt.Sprintf("?channel_id=%v&filename=%v", url.QueryEscape(channelId), url.QueryEscape(filename)), data, http.DetectContentType(data))
 }
 
+// AttachExternalFile creates a file for an object hosted in external object storage, to be later
+// attached to a post. The object is not copied to the server, it's proxied when downloaded.
+func (c *Client4) AttachExternalFile(channelId, externalURL, name string) (*FileInfo, *Response, error) {
+	buf, err := json.Marshal(&ExternalFileAttachment{ChannelId: channelId, URL: externalURL, Name: name})
+	if err != nil {
+		return nil, nil, NewAppError("AttachExternalFile", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.filesRoute()+"/external", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var info FileInfo
+	if jsonErr := json.NewDecoder(r.Body).Decode(&info); jsonErr != nil {
+		return nil, nil, NewAppError("AttachExternalFile", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &info, BuildResponse(r), nil
+}
+
 // GetFile gets the bytes for a file by id.
 func (c *Client4) GetFile(fileId string) ([]byte, *Response, error) {
 	r, err := c.DoAPIGet(c.fileRoute(fileId), "")
@@ -4310,7 +4844,26 @@ func (c *Client4) GetPingWithFullServerStatus() (map[string]string, *Response, e
 	return MapFromJSON(r.Body), BuildResponse(r), nil
 }
 
-// TestEmail will attempt to connect to the configured SMTP server.
+// GetSystemHealth returns the state of the server, along with the state of each of its
+// dependencies for the sessions allowed to manage the system. When the server is unhealthy only
+// the status is returned.
+func (c *Client4) GetSystemHealth() (*SystemHealth, *Response, error) {
+	r, err := c.DoAPIGet(c.systemRoute()+"/health", "")
+	if r != nil && r.StatusCode == http.StatusServiceUnavailable {
+		defer r.Body.Close()
+		return &SystemHealth{Status: StatusUnhealthy}, BuildResponse(r), err
+	}
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var health SystemHealth
+	if jsonErr := json.NewDecoder(r.Body).Decode(&health); jsonErr != nil {
+		return nil, nil, NewAppError("GetSystemHealth", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &health, BuildResponse(r), nil
+}
+
 func (c *Client4) TestEmail(config *Config) (*Response, error) {
 	buf, err := json.Marshal(config)
 	if err != nil {
@@ -4370,6 +4923,17 @@ func (c *Client4) ReloadConfig() (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetReloadableConfigSections returns the config sections that the server applies at
+// runtime, without a restart.
+func (c *Client4) GetReloadableConfigSections() ([]string, *Response, error) {
+	r, err := c.DoAPIGet(c.configRoute()+"/reloadable", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ArrayFromJSON(r.Body), BuildResponse(r), nil
+}
+
 // GetOldClientConfig will retrieve the parts of the server configuration needed by the
 // client, formatted in the old format.
 func (c *Client4) GetOldClientConfig(etag string) (map[string]string, *Response, error) {
@@ -4438,6 +5002,21 @@ func (c *Client4) UpdateConfig(config *Config) (*Config, *Response, error) {
 	return ConfigFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// UpdateConfigWithEtag will update the server configuration, provided it's still at the version
+// given by the etag returned when getting it.
+func (c *Client4) UpdateConfigWithEtag(config *Config, etag string) (*Config, *Response, error) {
+	buf, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateConfigWithEtag", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIRequestReader(http.MethodPut, c.APIURL+c.configRoute(), bytes.NewReader(buf), map[string]string{HeaderIfMatch: etag})
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ConfigFromJSON(r.Body), BuildResponse(r), nil
+}
+
 // MigrateConfig will migrate existing config to the new one.
 // DEPRECATED: The config migrate API has been moved to be a purely
 // mmctl --local endpoint. This method will be removed in a
@@ -4506,6 +5085,47 @@ func (c *Client4) RemoveLicenseFile() (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetLicenseUsage returns the monthly license usage of the given number of months up to the
+// current one. A zero months uses the server default.
+func (c *Client4) GetLicenseUsage(months int) (LicenseUsageList, *Response, error) {
+	r, err := c.DoAPIGet(c.licenseUsageRoute(months, ""), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var usage LicenseUsageList
+	if jsonErr := json.NewDecoder(r.Body).Decode(&usage); jsonErr != nil {
+		return nil, nil, NewAppError("GetLicenseUsage", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return usage, BuildResponse(r), nil
+}
+
+// GetLicenseUsageCSV returns the monthly license usage as CSV.
+func (c *Client4) GetLicenseUsageCSV(months int) ([]byte, *Response, error) {
+	r, err := c.DoAPIGet(c.licenseUsageRoute(months, "csv"), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("GetLicenseUsageCSV", "model.client.read_file.app_error", nil, err.Error(), r.StatusCode)
+	}
+	return data, BuildResponse(r), nil
+}
+
+func (c *Client4) licenseUsageRoute(months int, format string) string {
+	values := url.Values{}
+	if months > 0 {
+		values.Set("months", strconv.Itoa(months))
+	}
+	if format != "" {
+		values.Set("format", format)
+	}
+	return c.licenseRoute() + "/usage?" + values.Encode()
+}
+
 // GetAnalyticsOld will retrieve analytics using the old format. New format is not
 // available but the "/analytics" endpoint is reserved for it. The "name" argument is optional
 // and defaults to "standard". The "teamId" argument is optional and will limit results
@@ -4802,6 +5422,25 @@ func (c *Client4) DeletePreferences(userId string, preferences Preferences) (*Re
 	return BuildResponse(r), nil
 }
 
+// CompareAndSetPreferences saves the user's preferences only if none of them changed since the
+// versions given with them. When any did, nothing is saved and the result holds their current values.
+func (c *Client4) CompareAndSetPreferences(userId string, preferences Preferences) (*PreferencesCompareAndSetResult, *Response, error) {
+	buf, err := json.Marshal(preferences)
+	if err != nil {
+		return nil, nil, NewAppError("CompareAndSetPreferences", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.preferencesRoute(userId)+"/compare_and_set", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var result PreferencesCompareAndSetResult
+	if jsonErr := json.NewDecoder(r.Body).Decode(&result); jsonErr != nil {
+		return nil, nil, NewAppError("CompareAndSetPreferences", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &result, BuildResponse(r), nil
+}
+
 // GetPreferencesByCategory returns the user's preferences from the provided category string.
 func (c *Client4) GetPreferencesByCategory(userId string, category string) (Preferences, *Response, error) {
 	url := fmt.Sprintf(c.preferencesRoute(userId)+"/%s", category)
@@ -5389,6 +6028,64 @@ func (c *Client4) GetAudits(page int, perPage int, etag string) (Audits, *Respon
 	return audits, BuildResponse(r), nil
 }
 
+// GetIntegrationAudits returns a page of the audits of the uses of the integrations, filtered by
+// type of integration and by integration when not empty.
+func (c *Client4) GetIntegrationAudits(integrationType, integrationId string, page int, perPage int) (Audits, *Response, error) {
+	values := url.Values{}
+	values.Set("integration_type", integrationType)
+	values.Set("integration_id", integrationId)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	r, err := c.DoAPIGet("/audits/integrations?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var audits Audits
+	if jsonErr := json.NewDecoder(r.Body).Decode(&audits); jsonErr != nil {
+		return nil, BuildResponse(r), NewAppError("GetIntegrationAudits", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return audits, BuildResponse(r), nil
+}
+
+// SearchAuditLogs returns the audit records kept in the database matching the search, most
+// recent first.
+func (c *Client4) SearchAuditLogs(search *AuditLogSearch, page, perPage int) ([]*AuditLog, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if search.UserId != "" {
+		values.Set("user_id", search.UserId)
+	}
+	if search.Event != "" {
+		values.Set("event", search.Event)
+	}
+	if search.IpAddress != "" {
+		values.Set("ip_address", search.IpAddress)
+	}
+	if search.TargetId != "" {
+		values.Set("target_id", search.TargetId)
+	}
+	if search.Since != 0 {
+		values.Set("since", strconv.FormatInt(search.Since, 10))
+	}
+	if search.Until != 0 {
+		values.Set("until", strconv.FormatInt(search.Until, 10))
+	}
+
+	r, err := c.DoAPIGet("/audits/search?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*AuditLog
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("SearchAuditLogs", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // Brand Section
 
 // GetBrandImage retrieves the previously uploaded brand image.
@@ -5664,6 +6361,54 @@ func (c *Client4) GetOAuthAccessToken(data url.Values) (*AccessResponse, *Respon
 	return ar, BuildResponse(rp), nil
 }
 
+// doOAuthFormRequest posts the form to an OAuth 2.0 endpoint outside of the API.
+func (c *Client4) doOAuthFormRequest(path string, data url.Values) (*http.Response, error) {
+	rq, err := http.NewRequest(http.MethodPost, c.URL+path, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	rq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rp, err := c.HTTPClient.Do(rq)
+	if err != nil {
+		return rp, err
+	}
+
+	if rp.StatusCode >= 300 {
+		defer closeBody(rp)
+		return rp, AppErrorFromJSON(rp.Body)
+	}
+
+	return rp, nil
+}
+
+// IntrospectOAuthToken describes a token issued to an OAuth app, as per RFC 7662. The form holds
+// the token, and the client_id and client_secret of the app.
+func (c *Client4) IntrospectOAuthToken(data url.Values) (*OAuthIntrospectionResponse, *Response, error) {
+	r, err := c.doOAuthFormRequest("/oauth/introspect", data)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var response OAuthIntrospectionResponse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&response); jsonErr != nil {
+		return nil, nil, NewAppError("IntrospectOAuthToken", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &response, BuildResponse(r), nil
+}
+
+// RevokeOAuthToken revokes a token issued to an OAuth app, as per RFC 7009. The form holds the
+// token, and the client_id and client_secret of the app.
+func (c *Client4) RevokeOAuthToken(data url.Values) (*Response, error) {
+	r, err := c.doOAuthFormRequest("/oauth/revoke", data)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Elasticsearch Section
 
 // TestElasticsearch will attempt to connect to the configured Elasticsearch server and return OK if configured.
@@ -5715,6 +6460,21 @@ func (c *Client4) GetDataRetentionPolicy() (*GlobalRetentionPolicy, *Response, e
 	return &p, BuildResponse(r), nil
 }
 
+// DataRetentionDryRun counts the posts the data retention policies would delete if the
+// job ran now, without deleting anything.
+func (c *Client4) DataRetentionDryRun() (*RetentionPolicyDryRun, *Response, error) {
+	r, err := c.DoAPIPost(c.dataRetentionRoute()+"/dry_run", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var dryRun RetentionPolicyDryRun
+	if jsonErr := json.NewDecoder(r.Body).Decode(&dryRun); jsonErr != nil {
+		return nil, nil, NewAppError("DataRetentionDryRun", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &dryRun, BuildResponse(r), nil
+}
+
 // GetDataRetentionPolicyByID will get the details for the granular data retention policy with the specified ID.
 func (c *Client4) GetDataRetentionPolicyByID(policyID string) (*RetentionPolicyWithTeamAndChannelCounts, *Response, error) {
 	r, err := c.DoAPIGet(c.dataRetentionPolicyRoute(policyID), "")
@@ -5782,6 +6542,40 @@ func (c *Client4) CreateDataRetentionPolicy(policy *RetentionPolicyWithTeamAndCh
 	return &p, BuildResponse(r), nil
 }
 
+// PreviewDataRetentionPolicy starts a job that counts the posts and files the given policy
+// would delete from each of its channels, without saving the policy.
+func (c *Client4) PreviewDataRetentionPolicy(policy *RetentionPolicyWithTeamAndChannelIDs) (*Job, *Response, error) {
+	policyJSON, jsonErr := json.Marshal(policy)
+	if jsonErr != nil {
+		return nil, nil, NewAppError("PreviewDataRetentionPolicy", "api.marshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.dataRetentionRoute()+"/policies/preview", policyJSON)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var job Job
+	if jsonErr := json.NewDecoder(r.Body).Decode(&job); jsonErr != nil {
+		return nil, nil, NewAppError("PreviewDataRetentionPolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &job, BuildResponse(r), nil
+}
+
+// DownloadDataRetentionPolicyPreview returns the CSV results of a finished policy preview job.
+func (c *Client4) DownloadDataRetentionPolicyPreview(jobId string) ([]byte, *Response, error) {
+	r, err := c.DoAPIGet(c.dataRetentionRoute()+"/policies/preview/"+jobId+"/download", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("DownloadDataRetentionPolicyPreview", "model.client.read_job_result_file.app_error", nil, err.Error(), r.StatusCode)
+	}
+	return data, BuildResponse(r), nil
+}
+
 // DeleteDataRetentionPolicy will delete the granular data retention policy with the specified ID.
 func (c *Client4) DeleteDataRetentionPolicy(policyID string) (*Response, error) {
 	r, err := c.DoAPIDelete(c.dataRetentionPolicyRoute(policyID))
@@ -6727,6 +7521,67 @@ func (c *Client4) GetChannelsForScheme(schemeId string, page int, perPage int) (
 	return ch, BuildResponse(r), nil
 }
 
+// AssignScheme switches the given teams or channels to the scheme. When the
+// assignment is large enough to be processed in the background, the returned
+// job can be polled for progress; otherwise the job is nil.
+func (c *Client4) AssignScheme(schemeId string, assignment *SchemeAssignment) (*Job, *Response, error) {
+	buf, err := json.Marshal(assignment)
+	if err != nil {
+		return nil, nil, NewAppError("AssignScheme", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.schemeRoute(schemeId)+"/assign", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	if r.StatusCode != http.StatusAccepted {
+		return nil, BuildResponse(r), nil
+	}
+	var j Job
+	if jsonErr := json.NewDecoder(r.Body).Decode(&j); jsonErr != nil {
+		return nil, nil, NewAppError("AssignScheme", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &j, BuildResponse(r), nil
+}
+
+// ExportScheme returns a portable document describing a scheme and the permissions of its
+// roles, that ImportScheme can recreate on another server.
+func (c *Client4) ExportScheme(schemeId string) (*SchemeConveyor, *Response, error) {
+	r, err := c.DoAPIGet(c.schemeRoute(schemeId)+"/export", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var conveyor SchemeConveyor
+	if jsonErr := json.NewDecoder(r.Body).Decode(&conveyor); jsonErr != nil {
+		return nil, nil, NewAppError("ExportScheme", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &conveyor, BuildResponse(r), nil
+}
+
+// ImportScheme creates a scheme from a document made by ExportScheme. onConflict is one of the
+// SchemeImportOnConflict values and defaults to failing when the name is taken.
+func (c *Client4) ImportScheme(conveyor *SchemeConveyor, onConflict string) (*Scheme, *Response, error) {
+	buf, err := json.Marshal(conveyor)
+	if err != nil {
+		return nil, nil, NewAppError("ImportScheme", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	route := c.schemesRoute() + "/import"
+	if onConflict != "" {
+		route += "?on_conflict=" + url.QueryEscape(onConflict)
+	}
+	r, err := c.DoAPIPostBytes(route, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var s Scheme
+	if jsonErr := json.NewDecoder(r.Body).Decode(&s); jsonErr != nil {
+		return nil, nil, NewAppError("ImportScheme", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &s, BuildResponse(r), nil
+}
+
 // Plugin Section
 
 // UploadPlugin takes an io.Reader stream pointing to the contents of a .tar.gz plugin.
@@ -7260,6 +8115,21 @@ func (c *Client4) PatchConfig(config *Config) (*Config, *Response, error) {
 	return ConfigFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// PatchConfigWithEtag will patch the server configuration, provided it's still at the version
+// given by the etag returned when getting it.
+func (c *Client4) PatchConfigWithEtag(config *Config, etag string) (*Config, *Response, error) {
+	buf, err := json.Marshal(config)
+	if err != nil {
+		return nil, nil, NewAppError("PatchConfigWithEtag", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIRequestReader(http.MethodPut, c.APIURL+c.configRoute()+"/patch", bytes.NewReader(buf), map[string]string{HeaderIfMatch: etag})
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return ConfigFromJSON(r.Body), BuildResponse(r), nil
+}
+
 func (c *Client4) GetChannelModerations(channelID string, etag string) ([]*ChannelModeration, *Response, error) {
 	r, err := c.DoAPIGet(c.channelRoute(channelID)+"/moderations", etag)
 	if err != nil {
@@ -7464,22 +8334,54 @@ func (c *Client4) UpdateSidebarCategoryForTeamForUser(userID, teamID, categoryID
 	return cat, BuildResponse(r), nil
 }
 
-// CheckIntegrity performs a database integrity check.
-func (c *Client4) CheckIntegrity() ([]IntegrityCheckResult, *Response, error) {
-	r, err := c.DoAPIPost("/integrity", "")
+// ExportSidebarCategoriesForTeamForUser returns the layout of the sidebar of the user on the team.
+func (c *Client4) ExportSidebarCategoriesForTeamForUser(userID, teamID string) (*SidebarCategoriesExport, *Response, error) {
+	r, err := c.DoAPIGet(c.userCategoryRoute(userID, teamID)+"/export", "")
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var results []IntegrityCheckResult
-	if err := json.NewDecoder(r.Body).Decode(&results); err != nil {
-		return nil, BuildResponse(r), NewAppError("Api4.CheckIntegrity", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+
+	var export *SidebarCategoriesExport
+	if err := json.NewDecoder(r.Body).Decode(&export); err != nil {
+		return nil, BuildResponse(r), NewAppError("Client4.ExportSidebarCategoriesForTeamForUser", "model.utils.decode_json.app_error", nil, err.Error(), r.StatusCode)
 	}
-	return results, BuildResponse(r), nil
+	return export, BuildResponse(r), nil
 }
 
-func (c *Client4) GetNotices(lastViewed int64, teamId string, client NoticeClientType, clientVersion, locale, etag string) (NoticeMessages, *Response, error) {
-	url := fmt.Sprintf("/system/notices/%s?lastViewed=%d&client=%s&clientVersion=%s&locale=%s", teamId, lastViewed, client, clientVersion, locale)
+// ImportSidebarCategoriesForTeamForUser applies an exported sidebar layout to the sidebar of the
+// user on the team.
+func (c *Client4) ImportSidebarCategoriesForTeamForUser(userID, teamID string, export *SidebarCategoriesExport) (*OrderedSidebarCategories, *Response, error) {
+	payload, _ := json.Marshal(export)
+	r, err := c.DoAPIPutBytes(c.userCategoryRoute(userID, teamID)+"/export", payload)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var categories *OrderedSidebarCategories
+	if err := json.NewDecoder(r.Body).Decode(&categories); err != nil {
+		return nil, BuildResponse(r), NewAppError("Client4.ImportSidebarCategoriesForTeamForUser", "model.utils.decode_json.app_error", nil, err.Error(), r.StatusCode)
+	}
+	return categories, BuildResponse(r), nil
+}
+
+// CheckIntegrity performs a database integrity check.
+func (c *Client4) CheckIntegrity() ([]IntegrityCheckResult, *Response, error) {
+	r, err := c.DoAPIPost("/integrity", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var results []IntegrityCheckResult
+	if err := json.NewDecoder(r.Body).Decode(&results); err != nil {
+		return nil, BuildResponse(r), NewAppError("Api4.CheckIntegrity", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	return results, BuildResponse(r), nil
+}
+
+func (c *Client4) GetNotices(lastViewed int64, teamId string, client NoticeClientType, clientVersion, locale, etag string) (NoticeMessages, *Response, error) {
+	url := fmt.Sprintf("/system/notices/%s?lastViewed=%d&client=%s&clientVersion=%s&locale=%s", teamId, lastViewed, client, clientVersion, locale)
 	r, err := c.DoAPIGet(url, etag)
 	if err != nil {
 		return nil, BuildResponse(r), err
@@ -7548,6 +8450,27 @@ func (c *Client4) GetUpload(uploadId string) (*UploadSession, *Response, error)
 	return &s, BuildResponse(r), nil
 }
 
+// GetUserActivity returns a page of the activity timeline of the user, newest first. The types
+// filter the events returned, all of them being returned when empty.
+func (c *Client4) GetUserActivity(userId string, types []string, page, perPage int) ([]*ActivityEvent, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if len(types) > 0 {
+		values.Set("types", strings.Join(types, ","))
+	}
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/activity?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var events []*ActivityEvent
+	if jsonErr := json.NewDecoder(r.Body).Decode(&events); jsonErr != nil {
+		return nil, nil, NewAppError("GetUserActivity", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return events, BuildResponse(r), nil
+}
+
 // GetUploadsForUser returns the upload sessions created by the specified
 // userId.
 func (c *Client4) GetUploadsForUser(userId string) ([]*UploadSession, *Response, error) {
@@ -7902,6 +8825,143 @@ func (c *Client4) GetRemoteClusterInfo(remoteID string) (RemoteClusterInfo, *Res
 	return rci, BuildResponse(r), nil
 }
 
+// GetRemoteClusterProfilePolicy returns which user profile fields are masked when users
+// are synchronized with a remote cluster.
+func (c *Client4) GetRemoteClusterProfilePolicy(remoteID string) (*RemoteClusterProfilePolicy, *Response, error) {
+	r, err := c.DoAPIGet(c.remoteClusterRoute(remoteID)+"/profile_policy", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var policy RemoteClusterProfilePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&policy); jsonErr != nil {
+		return nil, nil, NewAppError("GetRemoteClusterProfilePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &policy, BuildResponse(r), nil
+}
+
+// UpdateRemoteClusterProfilePolicy sets which user profile fields are masked when users
+// are synchronized with a remote cluster.
+func (c *Client4) UpdateRemoteClusterProfilePolicy(remoteID string, policy *RemoteClusterProfilePolicy) (*RemoteClusterProfilePolicy, *Response, error) {
+	buf, err := json.Marshal(policy)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateRemoteClusterProfilePolicy", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(c.remoteClusterRoute(remoteID)+"/profile_policy", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updated RemoteClusterProfilePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&updated); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateRemoteClusterProfilePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
+// GetRemoteClusterInvitePolicy returns what happens to the shared channel invites received
+// from a remote cluster.
+func (c *Client4) GetRemoteClusterInvitePolicy(remoteID string) (*RemoteClusterInvitePolicy, *Response, error) {
+	r, err := c.DoAPIGet(c.remoteClusterRoute(remoteID)+"/invite_policy", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var policy RemoteClusterInvitePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&policy); jsonErr != nil {
+		return nil, nil, NewAppError("GetRemoteClusterInvitePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &policy, BuildResponse(r), nil
+}
+
+// UpdateRemoteClusterInvitePolicy sets what happens to the shared channel invites received
+// from a remote cluster.
+func (c *Client4) UpdateRemoteClusterInvitePolicy(remoteID string, policy *RemoteClusterInvitePolicy) (*RemoteClusterInvitePolicy, *Response, error) {
+	buf, err := json.Marshal(policy)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateRemoteClusterInvitePolicy", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(c.remoteClusterRoute(remoteID)+"/invite_policy", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updated RemoteClusterInvitePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&updated); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateRemoteClusterInvitePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
+// GetSharedChannelInvites returns a page of the shared channel invites received from remote
+// clusters, the most recent first. Empty filters match any invite.
+func (c *Client4) GetSharedChannelInvites(remoteID, status string, page, perPage int) ([]*SharedChannelInvite, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if remoteID != "" {
+		values.Set("remote_id", remoteID)
+	}
+	if status != "" {
+		values.Set("status", status)
+	}
+	r, err := c.DoAPIGet(c.sharedChannelsRoute()+"/invites?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var invites []*SharedChannelInvite
+	if jsonErr := json.NewDecoder(r.Body).Decode(&invites); jsonErr != nil {
+		return nil, nil, NewAppError("GetSharedChannelInvites", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return invites, BuildResponse(r), nil
+}
+
+// GetSharedChannelInvitePreview returns a shared channel invite along with the remote cluster
+// which sent it.
+func (c *Client4) GetSharedChannelInvitePreview(inviteID string) (*SharedChannelInvitePreview, *Response, error) {
+	r, err := c.DoAPIGet(c.sharedChannelsRoute()+"/invites/"+inviteID, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var preview SharedChannelInvitePreview
+	if jsonErr := json.NewDecoder(r.Body).Decode(&preview); jsonErr != nil {
+		return nil, nil, NewAppError("GetSharedChannelInvitePreview", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &preview, BuildResponse(r), nil
+}
+
+// ApproveSharedChannelInvite accepts a pending shared channel invite.
+func (c *Client4) ApproveSharedChannelInvite(inviteID string) (*SharedChannelInvite, *Response, error) {
+	return c.decideSharedChannelInvite(inviteID, "approve")
+}
+
+// DenySharedChannelInvite refuses a pending shared channel invite.
+func (c *Client4) DenySharedChannelInvite(inviteID string) (*SharedChannelInvite, *Response, error) {
+	return c.decideSharedChannelInvite(inviteID, "deny")
+}
+
+func (c *Client4) decideSharedChannelInvite(inviteID, decision string) (*SharedChannelInvite, *Response, error) {
+	r, err := c.DoAPIPost(c.sharedChannelsRoute()+"/invites/"+inviteID+"/"+decision, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var invite SharedChannelInvite
+	if jsonErr := json.NewDecoder(r.Body).Decode(&invite); jsonErr != nil {
+		return nil, nil, NewAppError("decideSharedChannelInvite", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &invite, BuildResponse(r), nil
+}
+
 func (c *Client4) GetAncillaryPermissions(subsectionPermissions []string) ([]string, *Response, error) {
 	var returnedPermissions []string
 	url := fmt.Sprintf("%s/ancillary?subsection_permissions=%s", c.permissionsRoute(), strings.Join(subsectionPermissions, ","))
@@ -7931,3 +8991,2198 @@ func (c *Client4) GetUsersWithInvalidEmails(page, perPage int) ([]*User, *Respon
 	}
 	return list, BuildResponse(r), nil
 }
+
+// GetUserAPIUsage returns the API calls made by a user since the given time, per route and bucket.
+// Must be the user or have the 'sysconsole_read_user_management_users' permission.
+func (c *Client4) GetUserAPIUsage(userId string, since int64) ([]*APIUsage, *Response, error) {
+	query := fmt.Sprintf("?since=%v", since)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/api_usage"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*APIUsage
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetUserAPIUsage", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetAPIUsageSummaries returns the total API calls made per user and token since the given time,
+// busiest first. Must have the 'sysconsole_read_user_management_users' permission.
+func (c *Client4) GetAPIUsageSummaries(since int64, page, perPage int) ([]*APIUsageSummary, *Response, error) {
+	query := fmt.Sprintf("?since=%v&page=%v&per_page=%v", since, page, perPage)
+	r, err := c.DoAPIGet(c.apiUsageRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*APIUsageSummary
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetAPIUsageSummaries", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetOAuthAppUsageSummaries returns the total API calls made with the tokens of each OAuth app
+// since the given time, busiest first. Must have the
+// 'sysconsole_read_integrations_integration_management' permission.
+func (c *Client4) GetOAuthAppUsageSummaries(since int64, page, perPage int) ([]*OAuthAppUsageSummary, *Response, error) {
+	query := fmt.Sprintf("?since=%v&page=%v&per_page=%v", since, page, perPage)
+	r, err := c.DoAPIGet(c.apiUsageRoute()+"/oauth_apps"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*OAuthAppUsageSummary
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetOAuthAppUsageSummaries", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetPostTask returns the state of a task post.
+func (c *Client4) GetPostTask(postId string) (*PostTask, *Response, error) {
+	r, err := c.DoAPIGet(c.postRoute(postId)+"/task", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var task PostTask
+	if jsonErr := json.NewDecoder(r.Body).Decode(&task); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostTask", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &task, BuildResponse(r), nil
+}
+
+// PatchPostTask updates the assignee, status or due date of a task post.
+func (c *Client4) PatchPostTask(postId string, patch *PostTaskPatch) (*PostTask, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchPostTask", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.postRoute(postId)+"/task/patch", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var task PostTask
+	if jsonErr := json.NewDecoder(r.Body).Decode(&task); jsonErr != nil {
+		return nil, nil, NewAppError("PatchPostTask", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &task, BuildResponse(r), nil
+}
+
+// GetPostTasksForUser returns the tasks assigned to a user with one of the given statuses, or
+// with any status if none are given.
+func (c *Client4) GetPostTasksForUser(userId string, statuses []string, page, perPage int) ([]*PostTask, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	if len(statuses) > 0 {
+		query += "&status=" + strings.Join(statuses, ",")
+	}
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/tasks"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*PostTask
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostTasksForUser", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetChannelDigestsForUser returns the channel digests a user is subscribed to.
+func (c *Client4) GetChannelDigestsForUser(userId string) ([]*ChannelDigest, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/channel_digests", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ChannelDigest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelDigestsForUser", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// SaveChannelDigest subscribes a user to a digest of a channel with the given cadence, or
+// changes the cadence of an existing subscription.
+func (c *Client4) SaveChannelDigest(userId, channelId, cadence string) (*ChannelDigest, *Response, error) {
+	buf, err := json.Marshal(&ChannelDigest{Cadence: cadence})
+	if err != nil {
+		return nil, nil, NewAppError("SaveChannelDigest", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.userRoute(userId)+"/channels/"+channelId+"/digest", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var digest ChannelDigest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&digest); jsonErr != nil {
+		return nil, nil, NewAppError("SaveChannelDigest", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &digest, BuildResponse(r), nil
+}
+
+// DeleteChannelDigest unsubscribes a user from the digest of a channel.
+func (c *Client4) DeleteChannelDigest(userId, channelId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/channels/" + channelId + "/digest")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetDirectChannelRetention returns the retention period proposed or agreed on in a direct channel.
+func (c *Client4) GetDirectChannelRetention(channelId string) (*DirectChannelRetention, *Response, error) {
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/retention", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var retention DirectChannelRetention
+	if jsonErr := json.NewDecoder(r.Body).Decode(&retention); jsonErr != nil {
+		return nil, nil, NewAppError("GetDirectChannelRetention", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &retention, BuildResponse(r), nil
+}
+
+// ProposeDirectChannelRetention proposes a retention period to the other member of a direct channel.
+func (c *Client4) ProposeDirectChannelRetention(channelId string, retentionDays int64) (*DirectChannelRetention, *Response, error) {
+	buf, err := json.Marshal(&DirectChannelRetentionRequest{RetentionDays: retentionDays})
+	if err != nil {
+		return nil, nil, NewAppError("ProposeDirectChannelRetention", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.channelRoute(channelId)+"/retention", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var retention DirectChannelRetention
+	if jsonErr := json.NewDecoder(r.Body).Decode(&retention); jsonErr != nil {
+		return nil, nil, NewAppError("ProposeDirectChannelRetention", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &retention, BuildResponse(r), nil
+}
+
+// AcceptDirectChannelRetention accepts the retention period proposed by the other member of a
+// direct channel. The days must match the pending proposal.
+func (c *Client4) AcceptDirectChannelRetention(channelId string, retentionDays int64) (*DirectChannelRetention, *Response, error) {
+	buf, err := json.Marshal(&DirectChannelRetentionRequest{RetentionDays: retentionDays})
+	if err != nil {
+		return nil, nil, NewAppError("AcceptDirectChannelRetention", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.channelRoute(channelId)+"/retention/accept", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var retention DirectChannelRetention
+	if jsonErr := json.NewDecoder(r.Body).Decode(&retention); jsonErr != nil {
+		return nil, nil, NewAppError("AcceptDirectChannelRetention", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &retention, BuildResponse(r), nil
+}
+
+// RemoveDirectChannelRetention withdraws the proposal of, or consent to, the retention
+// period of a direct channel.
+func (c *Client4) RemoveDirectChannelRetention(channelId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelRoute(channelId) + "/retention")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// AcknowledgePost acknowledges a post that requested acknowledgements on behalf of a user.
+func (c *Client4) AcknowledgePost(userId, postId string) (*PostAcknowledgement, *Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+c.postRoute(postId)+"/ack", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var acknowledgement PostAcknowledgement
+	if jsonErr := json.NewDecoder(r.Body).Decode(&acknowledgement); jsonErr != nil {
+		return nil, nil, NewAppError("AcknowledgePost", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &acknowledgement, BuildResponse(r), nil
+}
+
+// UnacknowledgePost withdraws the acknowledgement of a post by a user.
+func (c *Client4) UnacknowledgePost(userId, postId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + c.postRoute(postId) + "/ack")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetPostAcknowledgementSummariesForUser returns a page of the posts of a user that requested
+// acknowledgements, with how many were received and how many are outstanding.
+func (c *Client4) GetPostAcknowledgementSummariesForUser(userId string, page, perPage int) ([]*PostAcknowledgementSummary, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/posts/acknowledgements"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*PostAcknowledgementSummary
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostAcknowledgementSummariesForUser", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetMutedKeywords returns the keywords a user doesn't want to be notified about.
+func (c *Client4) GetMutedKeywords(userId string) (MutedKeywords, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/muted_keywords", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var keywords MutedKeywords
+	if jsonErr := json.NewDecoder(r.Body).Decode(&keywords); jsonErr != nil {
+		return nil, nil, NewAppError("GetMutedKeywords", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return keywords, BuildResponse(r), nil
+}
+
+// UpdateMutedKeywords replaces the muted keywords of a user, returning them as saved.
+func (c *Client4) UpdateMutedKeywords(userId string, keywords MutedKeywords) (MutedKeywords, *Response, error) {
+	buf, err := json.Marshal(keywords)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateMutedKeywords", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(c.userRoute(userId)+"/muted_keywords", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved MutedKeywords
+	if jsonErr := json.NewDecoder(r.Body).Decode(&saved); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateMutedKeywords", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return saved, BuildResponse(r), nil
+}
+
+// AddMutedKeywords adds to the muted keywords of a user, returning all of them.
+func (c *Client4) AddMutedKeywords(userId string, keywords MutedKeywords) (MutedKeywords, *Response, error) {
+	buf, err := json.Marshal(keywords)
+	if err != nil {
+		return nil, nil, NewAppError("AddMutedKeywords", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/muted_keywords", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved MutedKeywords
+	if jsonErr := json.NewDecoder(r.Body).Decode(&saved); jsonErr != nil {
+		return nil, nil, NewAppError("AddMutedKeywords", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return saved, BuildResponse(r), nil
+}
+
+// DeleteMutedKeywords unmutes all the keywords muted by a user.
+func (c *Client4) DeleteMutedKeywords(userId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.userRoute(userId) + "/muted_keywords")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) teamBannersRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/banners"
+}
+
+// GetTeamBanners returns the banners of a team that the user should see right now. Team
+// admins can pass includeInactive to get all the banners of the team.
+func (c *Client4) GetTeamBanners(teamId string, includeInactive bool) ([]*TeamBanner, *Response, error) {
+	query := ""
+	if includeInactive {
+		query = "?include_inactive=true"
+	}
+	r, err := c.DoAPIGet(c.teamBannersRoute(teamId)+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var banners []*TeamBanner
+	if jsonErr := json.NewDecoder(r.Body).Decode(&banners); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamBanners", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return banners, BuildResponse(r), nil
+}
+
+// CreateTeamBanner creates a banner for a team.
+func (c *Client4) CreateTeamBanner(banner *TeamBanner) (*TeamBanner, *Response, error) {
+	buf, err := json.Marshal(banner)
+	if err != nil {
+		return nil, nil, NewAppError("CreateTeamBanner", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.teamBannersRoute(banner.TeamId), string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created TeamBanner
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateTeamBanner", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// PatchTeamBanner partially updates a banner of a team.
+func (c *Client4) PatchTeamBanner(teamId, bannerId string, patch *TeamBannerPatch) (*TeamBanner, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchTeamBanner", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(c.teamBannersRoute(teamId)+"/"+bannerId+"/patch", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var patched TeamBanner
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patched); jsonErr != nil {
+		return nil, nil, NewAppError("PatchTeamBanner", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &patched, BuildResponse(r), nil
+}
+
+// DeleteTeamBanner deletes a banner of a team.
+func (c *Client4) DeleteTeamBanner(teamId, bannerId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.teamBannersRoute(teamId) + "/" + bannerId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// DismissTeamBanner hides a banner of a team from the current user.
+func (c *Client4) DismissTeamBanner(teamId, bannerId string) (*Response, error) {
+	r, err := c.DoAPIPost(c.teamBannersRoute(teamId)+"/"+bannerId+"/dismiss", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) emailSuppressionsRoute() string {
+	return "/email/suppressions"
+}
+
+// GetEmailSuppressions returns a page of the addresses that emails are no longer sent to.
+func (c *Client4) GetEmailSuppressions(page, perPage int) ([]*EmailSuppression, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.emailSuppressionsRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var suppressions []*EmailSuppression
+	if jsonErr := json.NewDecoder(r.Body).Decode(&suppressions); jsonErr != nil {
+		return nil, nil, NewAppError("GetEmailSuppressions", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return suppressions, BuildResponse(r), nil
+}
+
+// GetEmailSuppression returns why emails are no longer sent to an address.
+func (c *Client4) GetEmailSuppression(email string) (*EmailSuppression, *Response, error) {
+	r, err := c.DoAPIGet(c.emailSuppressionsRoute()+"/"+url.PathEscape(email), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var suppression EmailSuppression
+	if jsonErr := json.NewDecoder(r.Body).Decode(&suppression); jsonErr != nil {
+		return nil, nil, NewAppError("GetEmailSuppression", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &suppression, BuildResponse(r), nil
+}
+
+// DeleteEmailSuppression removes an address from the suppression list so that emails are
+// sent to it again.
+func (c *Client4) DeleteEmailSuppression(email string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.emailSuppressionsRoute() + "/" + url.PathEscape(email))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) userCannedResponsesRoute(userId string) string {
+	return c.userRoute(userId) + "/canned_responses"
+}
+
+func (c *Client4) teamCannedResponsesRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/canned_responses"
+}
+
+// GetUserCannedResponses returns the canned responses owned by a user.
+func (c *Client4) GetUserCannedResponses(userId string) ([]*CannedResponse, *Response, error) {
+	return c.getCannedResponses(c.userCannedResponsesRoute(userId), "GetUserCannedResponses")
+}
+
+// GetTeamCannedResponses returns the canned responses shared with the members of a team.
+func (c *Client4) GetTeamCannedResponses(teamId string) ([]*CannedResponse, *Response, error) {
+	return c.getCannedResponses(c.teamCannedResponsesRoute(teamId), "GetTeamCannedResponses")
+}
+
+// AutocompleteCannedResponses returns the canned responses of a user, and of a team when
+// teamId is not empty, whose shortcut starts with term.
+func (c *Client4) AutocompleteCannedResponses(userId, teamId, term string) ([]*CannedResponse, *Response, error) {
+	values := url.Values{}
+	values.Set("term", term)
+	if teamId != "" {
+		values.Set("team_id", teamId)
+	}
+	return c.getCannedResponses(c.userCannedResponsesRoute(userId)+"/autocomplete?"+values.Encode(), "AutocompleteCannedResponses")
+}
+
+func (c *Client4) getCannedResponses(route, where string) ([]*CannedResponse, *Response, error) {
+	r, err := c.DoAPIGet(route, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var responses []*CannedResponse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&responses); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return responses, BuildResponse(r), nil
+}
+
+// CreateCannedResponse creates a canned response for the user or the team it is scoped to.
+func (c *Client4) CreateCannedResponse(response *CannedResponse) (*CannedResponse, *Response, error) {
+	route := c.userCannedResponsesRoute(response.UserId)
+	if response.TeamId != "" {
+		route = c.teamCannedResponsesRoute(response.TeamId)
+	}
+	buf, err := json.Marshal(response)
+	if err != nil {
+		return nil, nil, NewAppError("CreateCannedResponse", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(route, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created CannedResponse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateCannedResponse", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// PatchUserCannedResponse partially updates a canned response owned by a user.
+func (c *Client4) PatchUserCannedResponse(userId, responseId string, patch *CannedResponsePatch) (*CannedResponse, *Response, error) {
+	return c.patchCannedResponse(c.userCannedResponsesRoute(userId)+"/"+responseId+"/patch", patch)
+}
+
+// PatchTeamCannedResponse partially updates a canned response of a team.
+func (c *Client4) PatchTeamCannedResponse(teamId, responseId string, patch *CannedResponsePatch) (*CannedResponse, *Response, error) {
+	return c.patchCannedResponse(c.teamCannedResponsesRoute(teamId)+"/"+responseId+"/patch", patch)
+}
+
+func (c *Client4) patchCannedResponse(route string, patch *CannedResponsePatch) (*CannedResponse, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchCannedResponse", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(route, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var patched CannedResponse
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patched); jsonErr != nil {
+		return nil, nil, NewAppError("PatchCannedResponse", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &patched, BuildResponse(r), nil
+}
+
+// DeleteUserCannedResponse deletes a canned response owned by a user.
+func (c *Client4) DeleteUserCannedResponse(userId, responseId string) (*Response, error) {
+	return c.deleteCannedResponse(c.userCannedResponsesRoute(userId) + "/" + responseId)
+}
+
+// DeleteTeamCannedResponse deletes a canned response of a team.
+func (c *Client4) DeleteTeamCannedResponse(teamId, responseId string) (*Response, error) {
+	return c.deleteCannedResponse(c.teamCannedResponsesRoute(teamId) + "/" + responseId)
+}
+
+func (c *Client4) deleteCannedResponse(route string) (*Response, error) {
+	r, err := c.DoAPIDelete(route)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// UseUserCannedResponse returns the message of a canned response owned by a user, with its
+// variables replaced by the given values, and counts the use.
+func (c *Client4) UseUserCannedResponse(userId, responseId string, variables map[string]string) (*CannedResponseUseResult, *Response, error) {
+	return c.useCannedResponse(c.userCannedResponsesRoute(userId)+"/"+responseId+"/use", variables)
+}
+
+// UseTeamCannedResponse returns the message of a canned response of a team, with its
+// variables replaced by the given values, and counts the use.
+func (c *Client4) UseTeamCannedResponse(teamId, responseId string, variables map[string]string) (*CannedResponseUseResult, *Response, error) {
+	return c.useCannedResponse(c.teamCannedResponsesRoute(teamId)+"/"+responseId+"/use", variables)
+}
+
+func (c *Client4) useCannedResponse(route string, variables map[string]string) (*CannedResponseUseResult, *Response, error) {
+	buf, err := json.Marshal(&CannedResponseUse{Variables: variables})
+	if err != nil {
+		return nil, nil, NewAppError("UseCannedResponse", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(route, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var result CannedResponseUseResult
+	if jsonErr := json.NewDecoder(r.Body).Decode(&result); jsonErr != nil {
+		return nil, nil, NewAppError("UseCannedResponse", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &result, BuildResponse(r), nil
+}
+
+func (c *Client4) teamRequestsRoute() string {
+	return c.teamsRoute() + "/requests"
+}
+
+// CreateTeamRequest asks the admins to create a team for the current user.
+func (c *Client4) CreateTeamRequest(teamRequest *TeamRequest) (*TeamRequest, *Response, error) {
+	buf, err := json.Marshal(teamRequest)
+	if err != nil {
+		return nil, nil, NewAppError("CreateTeamRequest", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.teamRequestsRoute(), string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created TeamRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateTeamRequest", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// GetTeamRequests returns a page of team requests, oldest first. When status is not empty,
+// only the requests with that status are returned.
+func (c *Client4) GetTeamRequests(status string, page, perPage int) ([]*TeamRequest, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if status != "" {
+		values.Set("status", status)
+	}
+	r, err := c.DoAPIGet(c.teamRequestsRoute()+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var teamRequests []*TeamRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&teamRequests); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamRequests", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return teamRequests, BuildResponse(r), nil
+}
+
+func (c *Client4) GetTeamRequest(teamRequestId string) (*TeamRequest, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRequestsRoute()+"/"+teamRequestId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var teamRequest TeamRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&teamRequest); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamRequest", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &teamRequest, BuildResponse(r), nil
+}
+
+// ApproveTeamRequest creates the requested team, with the requester as its admin.
+func (c *Client4) ApproveTeamRequest(teamRequestId, note string) (*TeamRequest, *Response, error) {
+	return c.reviewTeamRequest(teamRequestId, "approve", note)
+}
+
+// DenyTeamRequest closes a team request without creating the team.
+func (c *Client4) DenyTeamRequest(teamRequestId, note string) (*TeamRequest, *Response, error) {
+	return c.reviewTeamRequest(teamRequestId, "deny", note)
+}
+
+func (c *Client4) reviewTeamRequest(teamRequestId, action, note string) (*TeamRequest, *Response, error) {
+	buf, err := json.Marshal(&TeamRequestReview{Note: note})
+	if err != nil {
+		return nil, nil, NewAppError("ReviewTeamRequest", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.teamRequestsRoute()+"/"+teamRequestId+"/"+action, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var teamRequest TeamRequest
+	if jsonErr := json.NewDecoder(r.Body).Decode(&teamRequest); jsonErr != nil {
+		return nil, nil, NewAppError("ReviewTeamRequest", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &teamRequest, BuildResponse(r), nil
+}
+
+func (c *Client4) userMergesRoute() string {
+	return c.usersRoute() + "/merge"
+}
+
+// MergeUsers starts moving the content of the duplicate account into the primary one. The
+// returned merge can be polled to follow the progress of the job doing it.
+func (c *Client4) MergeUsers(primaryUserId, duplicateUserId string) (*UserMerge, *Response, error) {
+	buf, err := json.Marshal(&UserMergeRequest{PrimaryUserId: primaryUserId, DuplicateUserId: duplicateUserId})
+	if err != nil {
+		return nil, nil, NewAppError("MergeUsers", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.userMergesRoute(), string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var merge UserMerge
+	if jsonErr := json.NewDecoder(r.Body).Decode(&merge); jsonErr != nil {
+		return nil, nil, NewAppError("MergeUsers", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &merge, BuildResponse(r), nil
+}
+
+func (c *Client4) GetUserMerge(userMergeId string) (*UserMerge, *Response, error) {
+	r, err := c.DoAPIGet(c.userMergesRoute()+"/"+userMergeId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var merge UserMerge
+	if jsonErr := json.NewDecoder(r.Body).Decode(&merge); jsonErr != nil {
+		return nil, nil, NewAppError("GetUserMerge", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &merge, BuildResponse(r), nil
+}
+
+// RevertUserMerge moves what a finished merge moved back to the duplicate account.
+func (c *Client4) RevertUserMerge(userMergeId string) (*UserMerge, *Response, error) {
+	r, err := c.DoAPIPost(c.userMergesRoute()+"/"+userMergeId+"/revert", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var merge UserMerge
+	if jsonErr := json.NewDecoder(r.Body).Decode(&merge); jsonErr != nil {
+		return nil, nil, NewAppError("RevertUserMerge", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &merge, BuildResponse(r), nil
+}
+
+func (c *Client4) teamDeletionRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/deletion"
+}
+
+// GetTeamDeletion returns the scheduled deletion of the team.
+func (c *Client4) GetTeamDeletion(teamId string) (*TeamDeletion, *Response, error) {
+	r, err := c.DoAPIGet(c.teamDeletionRoute(teamId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var deletion TeamDeletion
+	if jsonErr := json.NewDecoder(r.Body).Decode(&deletion); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamDeletion", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &deletion, BuildResponse(r), nil
+}
+
+// CancelTeamDeletion cancels the scheduled deletion of the team.
+func (c *Client4) CancelTeamDeletion(teamId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.teamDeletionRoute(teamId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// DownloadTeamDeletionExport writes the export of a team scheduled for deletion to wr.
+func (c *Client4) DownloadTeamDeletionExport(teamId string, wr io.Writer) (int64, *Response, error) {
+	r, err := c.DoAPIGet(c.teamDeletionRoute(teamId)+"/export", "")
+	if err != nil {
+		return 0, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	n, err := io.Copy(wr, r.Body)
+	if err != nil {
+		return n, BuildResponse(r), NewAppError("DownloadTeamDeletionExport", "model.client.copy.app_error", nil, err.Error(), r.StatusCode)
+	}
+	return n, BuildResponse(r), nil
+}
+
+func (c *Client4) channelArchivePolicyRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/channel_archive_policy"
+}
+
+// GetChannelArchivePolicy returns the policy archiving the inactive channels of the team.
+func (c *Client4) GetChannelArchivePolicy(teamId string) (*ChannelArchivePolicy, *Response, error) {
+	r, err := c.DoAPIGet(c.channelArchivePolicyRoute(teamId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var policy ChannelArchivePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&policy); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelArchivePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &policy, BuildResponse(r), nil
+}
+
+// UpdateChannelArchivePolicy creates or replaces the policy archiving the inactive channels
+// of the team.
+func (c *Client4) UpdateChannelArchivePolicy(teamId string, policy *ChannelArchivePolicy) (*ChannelArchivePolicy, *Response, error) {
+	buf, err := json.Marshal(policy)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateChannelArchivePolicy", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.channelArchivePolicyRoute(teamId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved ChannelArchivePolicy
+	if jsonErr := json.NewDecoder(r.Body).Decode(&saved); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateChannelArchivePolicy", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// DeleteChannelArchivePolicy stops archiving the inactive channels of the team.
+func (c *Client4) DeleteChannelArchivePolicy(teamId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelArchivePolicyRoute(teamId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// SearchPermissionDenials returns the requests denied for lacking permissions matching the
+// search, most recent first.
+func (c *Client4) SearchPermissionDenials(search *PermissionDenialSearch, page, perPage int) ([]*PermissionDenial, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if search.UserId != "" {
+		values.Set("user_id", search.UserId)
+	}
+	if search.ResourceId != "" {
+		values.Set("resource_id", search.ResourceId)
+	}
+	if search.Since != 0 {
+		values.Set("since", strconv.FormatInt(search.Since, 10))
+	}
+
+	r, err := c.DoAPIGet(c.permissionsRoute()+"/denials?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*PermissionDenial
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("SearchPermissionDenials", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+func (c *Client4) teamAliasesRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/aliases"
+}
+
+// GetTeamAliases returns the other names the team can be reached by.
+func (c *Client4) GetTeamAliases(teamId string) ([]*TeamAlias, *Response, error) {
+	r, err := c.DoAPIGet(c.teamAliasesRoute(teamId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*TeamAlias
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamAliases", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// AddTeamAlias makes the team reachable by another name.
+func (c *Client4) AddTeamAlias(teamId, name string) (*TeamAlias, *Response, error) {
+	buf, err := json.Marshal(&TeamAlias{Name: name})
+	if err != nil {
+		return nil, nil, NewAppError("AddTeamAlias", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.teamAliasesRoute(teamId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var alias TeamAlias
+	if jsonErr := json.NewDecoder(r.Body).Decode(&alias); jsonErr != nil {
+		return nil, nil, NewAppError("AddTeamAlias", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &alias, BuildResponse(r), nil
+}
+
+// DeleteTeamAlias stops the team from being reachable by the name.
+func (c *Client4) DeleteTeamAlias(teamId, name string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.teamAliasesRoute(teamId) + "/" + name)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// UpdateTeamName renames the team. Its previous name keeps redirecting to it for a while.
+func (c *Client4) UpdateTeamName(teamId, name string) (*Team, *Response, error) {
+	r, err := c.DoAPIPut(c.teamRoute(teamId)+"/name", MapToJSON(map[string]string{"name": name}))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var team Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&team); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateTeamName", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &team, BuildResponse(r), nil
+}
+
+func (c *Client4) botTokenRotationRoute(botUserId string) string {
+	return c.botRoute(botUserId) + "/token_rotation"
+}
+
+// GetBotTokenAges returns a page of the access tokens of the bots, the oldest first.
+func (c *Client4) GetBotTokenAges(page, perPage int) ([]*BotTokenAge, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.botsRoute()+"/tokens"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*BotTokenAge
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetBotTokenAges", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetBotTokenRotation returns the rotation replacing the access token of the bot.
+func (c *Client4) GetBotTokenRotation(botUserId string) (*BotTokenRotation, *Response, error) {
+	r, err := c.DoAPIGet(c.botTokenRotationRoute(botUserId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var rotation BotTokenRotation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rotation); jsonErr != nil {
+		return nil, nil, NewAppError("GetBotTokenRotation", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &rotation, BuildResponse(r), nil
+}
+
+// UpdateBotTokenRotation creates or replaces the rotation of the access token of the bot.
+func (c *Client4) UpdateBotTokenRotation(botUserId string, rotation *BotTokenRotation) (*BotTokenRotation, *Response, error) {
+	buf, err := json.Marshal(rotation)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateBotTokenRotation", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.botTokenRotationRoute(botUserId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved BotTokenRotation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&saved); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateBotTokenRotation", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// DeleteBotTokenRotation stops replacing the access token of the bot.
+func (c *Client4) DeleteBotTokenRotation(botUserId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.botTokenRotationRoute(botUserId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// RotateBotToken replaces the access token of the bot right away, sending the new one to the
+// webhook of the rotation.
+func (c *Client4) RotateBotToken(botUserId string) (*BotTokenRotation, *Response, error) {
+	r, err := c.DoAPIPost(c.botTokenRotationRoute(botUserId)+"/rotate", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var rotation BotTokenRotation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rotation); jsonErr != nil {
+		return nil, nil, NewAppError("RotateBotToken", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &rotation, BuildResponse(r), nil
+}
+
+func (c *Client4) channelDisabledCommandsRoute(channelId string) string {
+	return c.channelRoute(channelId) + "/commands/disabled"
+}
+
+// GetChannelCommands returns the autocomplete commands that can be run in the channel. Direct
+// and group channels belong to no team, teamId tells which team to list the commands of then.
+func (c *Client4) GetChannelCommands(channelId, teamId string) ([]*Command, *Response, error) {
+	query := ""
+	if teamId != "" {
+		query = "?team_id=" + url.QueryEscape(teamId)
+	}
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/commands"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Command
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelCommands", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetDisabledChannelCommands returns the overrides disabling commands in the channel.
+func (c *Client4) GetDisabledChannelCommands(channelId string) ([]*ChannelCommandOverride, *Response, error) {
+	r, err := c.DoAPIGet(c.channelDisabledCommandsRoute(channelId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ChannelCommandOverride
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetDisabledChannelCommands", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// DisableChannelCommand stops the command of the trigger from being run in the channel.
+func (c *Client4) DisableChannelCommand(channelId, trigger string) (*ChannelCommandOverride, *Response, error) {
+	buf, err := json.Marshal(&ChannelCommandOverride{Trigger: trigger})
+	if err != nil {
+		return nil, nil, NewAppError("DisableChannelCommand", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.channelDisabledCommandsRoute(channelId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var override ChannelCommandOverride
+	if jsonErr := json.NewDecoder(r.Body).Decode(&override); jsonErr != nil {
+		return nil, nil, NewAppError("DisableChannelCommand", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &override, BuildResponse(r), nil
+}
+
+// EnableChannelCommand lets the command of the trigger be run in the channel again.
+func (c *Client4) EnableChannelCommand(channelId, trigger string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelDisabledCommandsRoute(channelId) + "/" + url.PathEscape(NormalizeCommandTrigger(trigger)))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// CreateSlackImport queues the import of a Slack export to the team.
+func (c *Client4) CreateSlackImport(teamId string, data []byte, filename string) (*Job, *Response, error) {
+	return c.createSlackImport(teamId, func(writer *multipart.Writer) error {
+		part, err := writer.CreateFormFile("file", filename)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(part, bytes.NewReader(data))
+		return err
+	})
+}
+
+// CreateSlackImportFromToken queues the import of a Slack workspace to the team, read
+// through the Slack API with the token.
+func (c *Client4) CreateSlackImportFromToken(teamId, token string) (*Job, *Response, error) {
+	return c.createSlackImport(teamId, func(writer *multipart.Writer) error {
+		return writer.WriteField("token", token)
+	})
+}
+
+func (c *Client4) createSlackImport(teamId string, writeSource func(writer *multipart.Writer) error) (*Job, *Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.WriteField("team_id", teamId); err != nil {
+		return nil, nil, err
+	}
+	if err := writeSource(writer); err != nil {
+		return nil, nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	r, err := c.DoAPIRequestReader(http.MethodPost, c.APIURL+c.importsRoute()+"/slack", body, map[string]string{"Content-Type": writer.FormDataContentType()})
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var job Job
+	if jsonErr := json.NewDecoder(r.Body).Decode(&job); jsonErr != nil {
+		return nil, nil, NewAppError("CreateSlackImport", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &job, BuildResponse(r), nil
+}
+
+// ResumeSlackImport queues a new job carrying on the Slack import of the job from its last
+// checkpoint.
+func (c *Client4) ResumeSlackImport(jobId string) (*Job, *Response, error) {
+	r, err := c.DoAPIPost(c.importsRoute()+"/slack/"+jobId+"/resume", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var job Job
+	if jsonErr := json.NewDecoder(r.Body).Decode(&job); jsonErr != nil {
+		return nil, nil, NewAppError("ResumeSlackImport", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &job, BuildResponse(r), nil
+}
+
+// GetSlackImportReport returns how the Slack import of the job mapped the users and channels
+// of the workspace.
+func (c *Client4) GetSlackImportReport(jobId string) (*SlackImportReport, *Response, error) {
+	r, err := c.DoAPIGet(c.importsRoute()+"/slack/"+jobId+"/report", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var report SlackImportReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		return nil, nil, NewAppError("GetSlackImportReport", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &report, BuildResponse(r), nil
+}
+
+func (c *Client4) impersonationRoute(impersonationId string) string {
+	return "/impersonations/" + impersonationId
+}
+
+// CreateImpersonation asks to impersonate the user. Unless the user's consent is required, the
+// session is started right away and its token returned.
+func (c *Client4) CreateImpersonation(userId string, impersonation *Impersonation) (*Impersonation, *Response, error) {
+	buf, err := json.Marshal(impersonation)
+	if err != nil {
+		return nil, nil, NewAppError("CreateImpersonation", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/impersonations", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created Impersonation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateImpersonation", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// GetImpersonationsForUser returns a page of the impersonations of the user, newest first.
+func (c *Client4) GetImpersonationsForUser(userId string, page, perPage int) ([]*Impersonation, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/impersonations"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var impersonations []*Impersonation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&impersonations); jsonErr != nil {
+		return nil, nil, NewAppError("GetImpersonationsForUser", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return impersonations, BuildResponse(r), nil
+}
+
+func (c *Client4) GetImpersonation(impersonationId string) (*Impersonation, *Response, error) {
+	r, err := c.DoAPIGet(c.impersonationRoute(impersonationId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeImpersonation("GetImpersonation", r)
+}
+
+// AnswerImpersonationConsent approves or declines a request to impersonate the current user.
+func (c *Client4) AnswerImpersonationConsent(impersonationId string, approve bool) (*Impersonation, *Response, error) {
+	buf, err := json.Marshal(&ImpersonationConsent{Approve: approve})
+	if err != nil {
+		return nil, nil, NewAppError("AnswerImpersonationConsent", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.impersonationRoute(impersonationId)+"/consent", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeImpersonation("AnswerImpersonationConsent", r)
+}
+
+// StartImpersonation starts the session of an approved impersonation, returning its token.
+func (c *Client4) StartImpersonation(impersonationId string) (*Impersonation, *Response, error) {
+	r, err := c.DoAPIPost(c.impersonationRoute(impersonationId)+"/start", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeImpersonation("StartImpersonation", r)
+}
+
+// EndImpersonation ends an impersonation, revoking its session.
+func (c *Client4) EndImpersonation(impersonationId string) (*Impersonation, *Response, error) {
+	r, err := c.DoAPIPost(c.impersonationRoute(impersonationId)+"/end", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeImpersonation("EndImpersonation", r)
+}
+
+func (c *Client4) decodeImpersonation(where string, r *http.Response) (*Impersonation, *Response, error) {
+	var impersonation Impersonation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&impersonation); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &impersonation, BuildResponse(r), nil
+}
+
+// GetCapabilities returns the features the server has enabled. It doesn't require to be logged in.
+func (c *Client4) GetCapabilities() (*Capabilities, *Response, error) {
+	r, err := c.DoAPIGet("/capabilities", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var capabilities Capabilities
+	if jsonErr := json.NewDecoder(r.Body).Decode(&capabilities); jsonErr != nil {
+		return nil, nil, NewAppError("GetCapabilities", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &capabilities, BuildResponse(r), nil
+}
+
+func (c *Client4) scheduledChannelMessagesRoute(channelId string) string {
+	return c.channelRoute(channelId) + "/scheduled_messages"
+}
+
+// GetScheduledChannelMessages returns the recurring messages scheduled in the channel.
+func (c *Client4) GetScheduledChannelMessages(channelId string) ([]*ScheduledChannelMessage, *Response, error) {
+	r, err := c.DoAPIGet(c.scheduledChannelMessagesRoute(channelId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*ScheduledChannelMessage
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetScheduledChannelMessages", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetScheduledChannelMessage returns a recurring message scheduled in the channel.
+func (c *Client4) GetScheduledChannelMessage(channelId, messageId string) (*ScheduledChannelMessage, *Response, error) {
+	r, err := c.DoAPIGet(c.scheduledChannelMessagesRoute(channelId)+"/"+messageId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeScheduledChannelMessage("GetScheduledChannelMessage", r)
+}
+
+// CreateScheduledChannelMessage schedules a recurring message in the channel, posted as the
+// current user.
+func (c *Client4) CreateScheduledChannelMessage(channelId string, message *ScheduledChannelMessage) (*ScheduledChannelMessage, *Response, error) {
+	buf, err := json.Marshal(message)
+	if err != nil {
+		return nil, nil, NewAppError("CreateScheduledChannelMessage", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.scheduledChannelMessagesRoute(channelId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeScheduledChannelMessage("CreateScheduledChannelMessage", r)
+}
+
+// UpdateScheduledChannelMessage changes the message and schedule of a recurring message, posted
+// as the current user from then on.
+func (c *Client4) UpdateScheduledChannelMessage(message *ScheduledChannelMessage) (*ScheduledChannelMessage, *Response, error) {
+	buf, err := json.Marshal(message)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateScheduledChannelMessage", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.scheduledChannelMessagesRoute(message.ChannelId)+"/"+message.Id, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeScheduledChannelMessage("UpdateScheduledChannelMessage", r)
+}
+
+// DeleteScheduledChannelMessage stops a recurring message from being posted in the channel.
+func (c *Client4) DeleteScheduledChannelMessage(channelId, messageId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.scheduledChannelMessagesRoute(channelId) + "/" + messageId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) decodeScheduledChannelMessage(where string, r *http.Response) (*ScheduledChannelMessage, *Response, error) {
+	var message ScheduledChannelMessage
+	if jsonErr := json.NewDecoder(r.Body).Decode(&message); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &message, BuildResponse(r), nil
+}
+
+func (c *Client4) eventWebhooksRoute() string {
+	return "/event_webhooks"
+}
+
+// CreateEventWebhook registers an event webhook. The returned webhook holds the secret its
+// deliveries are signed with.
+func (c *Client4) CreateEventWebhook(webhook *EventWebhook) (*EventWebhook, *Response, error) {
+	buf, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, nil, NewAppError("CreateEventWebhook", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.eventWebhooksRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeEventWebhook("CreateEventWebhook", r)
+}
+
+// GetEventWebhooks returns a page of the event webhooks, without their secrets.
+func (c *Client4) GetEventWebhooks(page, perPage int) ([]*EventWebhook, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.eventWebhooksRoute()+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*EventWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetEventWebhooks", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetEventWebhook returns an event webhook, without its secret.
+func (c *Client4) GetEventWebhook(webhookId string) (*EventWebhook, *Response, error) {
+	r, err := c.DoAPIGet(c.eventWebhooksRoute()+"/"+webhookId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeEventWebhook("GetEventWebhook", r)
+}
+
+// UpdateEventWebhook changes the endpoint and subscriptions of an event webhook.
+func (c *Client4) UpdateEventWebhook(webhook *EventWebhook) (*EventWebhook, *Response, error) {
+	buf, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateEventWebhook", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.eventWebhooksRoute()+"/"+webhook.Id, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeEventWebhook("UpdateEventWebhook", r)
+}
+
+// DeleteEventWebhook deletes an event webhook along with its delivery log.
+func (c *Client4) DeleteEventWebhook(webhookId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.eventWebhooksRoute() + "/" + webhookId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// RegenerateEventWebhookSecret replaces the secret of an event webhook and returns the webhook
+// with its new secret.
+func (c *Client4) RegenerateEventWebhookSecret(webhookId string) (*EventWebhook, *Response, error) {
+	r, err := c.DoAPIPost(c.eventWebhooksRoute()+"/"+webhookId+"/regen_secret", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeEventWebhook("RegenerateEventWebhookSecret", r)
+}
+
+// GetEventWebhookDeliveries returns a page of the delivery log of an event webhook, newest
+// first.
+func (c *Client4) GetEventWebhookDeliveries(webhookId string, page, perPage int) ([]*EventWebhookDelivery, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.eventWebhooksRoute()+"/"+webhookId+"/deliveries"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*EventWebhookDelivery
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetEventWebhookDeliveries", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+func (c *Client4) decodeEventWebhook(where string, r *http.Response) (*EventWebhook, *Response, error) {
+	var webhook EventWebhook
+	if jsonErr := json.NewDecoder(r.Body).Decode(&webhook); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &webhook, BuildResponse(r), nil
+}
+
+// GetConfigHistory returns a page of the versions of the configuration saved through the API,
+// newest first.
+func (c *Client4) GetConfigHistory(page, perPage int) ([]*ConfigVersion, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.configRoute()+"/history"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ConfigVersion
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetConfigHistory", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetConfigDiff returns the settings changed between two versions of the configuration. An
+// empty to compares with the current configuration.
+func (c *Client4) GetConfigDiff(from, to string) ([]*ConfigVersionDiff, *Response, error) {
+	values := url.Values{}
+	values.Set("from", from)
+	if to != "" {
+		values.Set("to", to)
+	}
+	r, err := c.DoAPIGet(c.configRoute()+"/diff?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ConfigVersionDiff
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetConfigDiff", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetUserLimits returns the limits applying to a user, along with how much of them is used.
+func (c *Client4) GetUserLimits(userId string) (*UserLimits, *Response, error) {
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/limits", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var limits UserLimits
+	if jsonErr := json.NewDecoder(r.Body).Decode(&limits); jsonErr != nil {
+		return nil, nil, NewAppError("GetUserLimits", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &limits, BuildResponse(r), nil
+}
+
+// GetChannelEvents returns a page of the events recorded for a channel, newest first.
+func (c *Client4) GetChannelEvents(channelId string, page, perPage int) ([]*ChannelEvent, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/events"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*ChannelEvent
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelEvents", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RevertChannelEvent reverts the change recorded by a channel event, returning the updated
+// channel.
+func (c *Client4) RevertChannelEvent(channelId, eventId string) (*Channel, *Response, error) {
+	r, err := c.DoAPIPost(c.channelRoute(channelId)+"/events/"+eventId+"/revert", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch Channel
+	if jsonErr := json.NewDecoder(r.Body).Decode(&ch); jsonErr != nil {
+		return nil, nil, NewAppError("RevertChannelEvent", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &ch, BuildResponse(r), nil
+}
+
+// GetChannelLanguageStats returns the number of posts made in each language in a channel
+// over the given number of days, as computed by the last nightly rollup. A days value of 0
+// uses the server default.
+func (c *Client4) GetChannelLanguageStats(channelId string, days int) (*ChannelLanguageStats, *Response, error) {
+	query := ""
+	if days > 0 {
+		query = fmt.Sprintf("?days=%v", days)
+	}
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/language_stats"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var stats ChannelLanguageStats
+	if jsonErr := json.NewDecoder(r.Body).Decode(&stats); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelLanguageStats", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &stats, BuildResponse(r), nil
+}
+
+// GetChannelStatsTimeseries returns the daily stats of a channel between since and until, in
+// milliseconds and rounded to whole UTC days. A zero since or until uses the server default.
+func (c *Client4) GetChannelStatsTimeseries(channelId string, since, until int64) (*ChannelStatsTimeseries, *Response, error) {
+	values := url.Values{}
+	if since > 0 {
+		values.Set("since", strconv.FormatInt(since, 10))
+	}
+	if until > 0 {
+		values.Set("until", strconv.FormatInt(until, 10))
+	}
+	r, err := c.DoAPIGet(c.channelRoute(channelId)+"/stats/timeseries?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var timeseries ChannelStatsTimeseries
+	if jsonErr := json.NewDecoder(r.Body).Decode(&timeseries); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelStatsTimeseries", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &timeseries, BuildResponse(r), nil
+}
+
+// GetRemoteClusterHealth returns how well the channels shared with a remote cluster are kept in
+// sync.
+func (c *Client4) GetRemoteClusterHealth(remoteID string) (*RemoteClusterHealth, *Response, error) {
+	r, err := c.DoAPIGet(c.remoteClusterRoute(remoteID)+"/health", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var health RemoteClusterHealth
+	if jsonErr := json.NewDecoder(r.Body).Decode(&health); jsonErr != nil {
+		return nil, nil, NewAppError("GetRemoteClusterHealth", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &health, BuildResponse(r), nil
+}
+
+func (c *Client4) alertRulesRoute() string {
+	return "/alert_rules"
+}
+
+// CreateAlertRule creates an alert rule, evaluated by the server every minute.
+func (c *Client4) CreateAlertRule(rule *AlertRule) (*AlertRule, *Response, error) {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, nil, NewAppError("CreateAlertRule", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.alertRulesRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeAlertRule("CreateAlertRule", r)
+}
+
+// GetAlertRules returns the alert rules along with their state.
+func (c *Client4) GetAlertRules() ([]*AlertRule, *Response, error) {
+	r, err := c.DoAPIGet(c.alertRulesRoute(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*AlertRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetAlertRules", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return list, BuildResponse(r), nil
+}
+
+func (c *Client4) GetAlertRule(ruleId string) (*AlertRule, *Response, error) {
+	r, err := c.DoAPIGet(c.alertRulesRoute()+"/"+ruleId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeAlertRule("GetAlertRule", r)
+}
+
+// UpdateAlertRule changes the settings of an alert rule.
+func (c *Client4) UpdateAlertRule(rule *AlertRule) (*AlertRule, *Response, error) {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateAlertRule", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.alertRulesRoute()+"/"+rule.Id, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeAlertRule("UpdateAlertRule", r)
+}
+
+func (c *Client4) DeleteAlertRule(ruleId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.alertRulesRoute() + "/" + ruleId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) decodeAlertRule(where string, r *http.Response) (*AlertRule, *Response, error) {
+	var rule AlertRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rule); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &rule, BuildResponse(r), nil
+}
+
+func (c *Client4) postModerationsRoute() string {
+	return "/post_moderations"
+}
+
+// GetPostModerations returns a page of the moderation queue, oldest first. When teamId or status
+// are not empty, only the moderations of the team or with that status are returned.
+func (c *Client4) GetPostModerations(teamId, status string, page, perPage int) ([]*PostModeration, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if teamId != "" {
+		values.Set("team_id", teamId)
+	}
+	if status != "" {
+		values.Set("status", status)
+	}
+	r, err := c.DoAPIGet(c.postModerationsRoute()+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var moderations []*PostModeration
+	if jsonErr := json.NewDecoder(r.Body).Decode(&moderations); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostModerations", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return moderations, BuildResponse(r), nil
+}
+
+func (c *Client4) GetPostModeration(moderationId string) (*PostModeration, *Response, error) {
+	r, err := c.DoAPIGet(c.postModerationsRoute()+"/"+moderationId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostModeration("GetPostModeration", r)
+}
+
+// ApprovePostModeration publishes a held post or edit, or keeps a flagged post.
+func (c *Client4) ApprovePostModeration(moderationId string) (*PostModeration, *Response, error) {
+	r, err := c.DoAPIPost(c.postModerationsRoute()+"/"+moderationId+"/approve", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostModeration("ApprovePostModeration", r)
+}
+
+// RemovePostModeration discards a held post or edit, or deletes a flagged post.
+func (c *Client4) RemovePostModeration(moderationId string) (*PostModeration, *Response, error) {
+	r, err := c.DoAPIPost(c.postModerationsRoute()+"/"+moderationId+"/remove", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostModeration("RemovePostModeration", r)
+}
+
+func (c *Client4) decodePostModeration(where string, r *http.Response) (*PostModeration, *Response, error) {
+	var moderation PostModeration
+	if jsonErr := json.NewDecoder(r.Body).Decode(&moderation); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &moderation, BuildResponse(r), nil
+}
+
+// ReportPost reports a post to the moderators of its team.
+func (c *Client4) ReportPost(postId string, report *PostReport) (*PostReport, *Response, error) {
+	buf, err := json.Marshal(report)
+	if err != nil {
+		return nil, nil, NewAppError("ReportPost", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.postRoute(postId)+"/report", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostReport("ReportPost", r)
+}
+
+func (c *Client4) postReportsRoute() string {
+	return "/post_reports"
+}
+
+// GetPostReports returns a page of reports, oldest first. When teamId is empty, the reports of
+// every team are returned, which requires the permission for the whole system.
+func (c *Client4) GetPostReports(teamId, status string, page, perPage int) ([]*PostReport, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	if teamId != "" {
+		values.Set("team_id", teamId)
+	}
+	if status != "" {
+		values.Set("status", status)
+	}
+	r, err := c.DoAPIGet(c.postReportsRoute()+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var reports []*PostReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&reports); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostReports", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return reports, BuildResponse(r), nil
+}
+
+func (c *Client4) GetPostReport(reportId string) (*PostReport, *Response, error) {
+	r, err := c.DoAPIGet(c.postReportsRoute()+"/"+reportId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostReport("GetPostReport", r)
+}
+
+// ResolvePostReport closes a report, taking the action of the resolution on the reported post.
+func (c *Client4) ResolvePostReport(reportId string, resolution *PostReportResolution) (*PostReport, *Response, error) {
+	buf, err := json.Marshal(resolution)
+	if err != nil {
+		return nil, nil, NewAppError("ResolvePostReport", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPostBytes(c.postReportsRoute()+"/"+reportId+"/resolve", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodePostReport("ResolvePostReport", r)
+}
+
+func (c *Client4) decodePostReport(where string, r *http.Response) (*PostReport, *Response, error) {
+	var report PostReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &report, BuildResponse(r), nil
+}
+
+// SendTestPushNotifications sends a test push notification to every mobile device the user is
+// logged in on and returns what the push proxy answered for each of them.
+func (c *Client4) SendTestPushNotifications(userId string) ([]*PushNotificationTestResult, *Response, error) {
+	r, err := c.DoAPIPost(c.userRoute(userId)+"/push_notifications/test", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var results []*PushNotificationTestResult
+	if jsonErr := json.NewDecoder(r.Body).Decode(&results); jsonErr != nil {
+		return nil, nil, NewAppError("SendTestPushNotifications", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return results, BuildResponse(r), nil
+}
+
+// GetTeamInviteBudget returns how many invitations a team can send today and how many of them
+// it sent.
+func (c *Client4) GetTeamInviteBudget(teamId string) (*TeamInviteBudget, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/invite_budget", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeTeamInviteBudget("GetTeamInviteBudget", r)
+}
+
+// GrantTeamInviteBudget lets a team send extra invitations today, on top of its daily
+// invitation budget.
+func (c *Client4) GrantTeamInviteBudget(teamId string, extraInvites int64) (*TeamInviteBudget, *Response, error) {
+	buf, err := json.Marshal(&TeamInviteBudgetGrant{ExtraInvites: extraInvites})
+	if err != nil {
+		return nil, nil, NewAppError("GrantTeamInviteBudget", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.teamRoute(teamId)+"/invite_budget/grant", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return c.decodeTeamInviteBudget("GrantTeamInviteBudget", r)
+}
+
+func (c *Client4) decodeTeamInviteBudget(where string, r *http.Response) (*TeamInviteBudget, *Response, error) {
+	var budget TeamInviteBudget
+	if jsonErr := json.NewDecoder(r.Body).Decode(&budget); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &budget, BuildResponse(r), nil
+}
+
+// UpdateTeamEmail changes the email address of a team and sends a link verifying it to the new
+// address. The address is unverified until then.
+func (c *Client4) UpdateTeamEmail(teamId, email string) (*Team, *Response, error) {
+	requestBody := map[string]string{"email": email}
+	r, err := c.DoAPIPut(c.teamRoute(teamId)+"/email", MapToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var t Team
+	if jsonErr := json.NewDecoder(r.Body).Decode(&t); jsonErr != nil {
+		return nil, nil, NewAppError("UpdateTeamEmail", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &t, BuildResponse(r), nil
+}
+
+// SendTeamEmailVerification sends a new link verifying the email address of a team to that address.
+func (c *Client4) SendTeamEmailVerification(teamId string) (*Response, error) {
+	r, err := c.DoAPIPost(c.teamRoute(teamId)+"/email/verify/send", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// ValidateTeamAllowedDomains returns how a list of allowed domains would be parsed when saved
+// with a team.
+func (c *Client4) ValidateTeamAllowedDomains(allowedDomains string) (*TeamAllowedDomainsValidation, *Response, error) {
+	requestBody := map[string]string{"allowed_domains": allowedDomains}
+	r, err := c.DoAPIPost(c.teamsRoute()+"/allowed_domains/validate", MapToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var validation TeamAllowedDomainsValidation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&validation); jsonErr != nil {
+		return nil, nil, NewAppError("ValidateTeamAllowedDomains", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &validation, BuildResponse(r), nil
+}
+
+// VerifyTeamEmail verifies the email address of a team with the token sent to that address.
+func (c *Client4) VerifyTeamEmail(token string) (*Response, error) {
+	requestBody := map[string]string{"token": token}
+	r, err := c.DoAPIPost(c.teamsRoute()+"/email/verify", MapToJSON(requestBody))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) customStatusTemplatesRoute() string {
+	return "/custom_status/templates"
+}
+
+func (c *Client4) teamCustomStatusTemplatesRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/custom_status/templates"
+}
+
+// GetCustomStatusSuggestions returns the custom status templates suggested to the members of a
+// team, or the organization-wide ones when teamId is empty.
+func (c *Client4) GetCustomStatusSuggestions(teamId string) ([]*CustomStatusTemplate, *Response, error) {
+	route := c.customStatusTemplatesRoute()
+	if teamId != "" {
+		route += "?team_id=" + url.QueryEscape(teamId)
+	}
+	return c.getCustomStatusTemplates(route, "GetCustomStatusSuggestions")
+}
+
+// GetTeamCustomStatusTemplates returns the custom status templates of a team.
+func (c *Client4) GetTeamCustomStatusTemplates(teamId string) ([]*CustomStatusTemplate, *Response, error) {
+	return c.getCustomStatusTemplates(c.teamCustomStatusTemplatesRoute(teamId), "GetTeamCustomStatusTemplates")
+}
+
+// GetCustomStatusTemplateUsage returns the custom status templates of the organization and of
+// every team, the most used first.
+func (c *Client4) GetCustomStatusTemplateUsage() ([]*CustomStatusTemplate, *Response, error) {
+	return c.getCustomStatusTemplates(c.customStatusTemplatesRoute()+"/usage", "GetCustomStatusTemplateUsage")
+}
+
+func (c *Client4) getCustomStatusTemplates(route, where string) ([]*CustomStatusTemplate, *Response, error) {
+	r, err := c.DoAPIGet(route, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var templates []*CustomStatusTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&templates); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return templates, BuildResponse(r), nil
+}
+
+// CreateCustomStatusTemplate creates a custom status template for the team it is scoped to, or
+// for the whole organization when its team id is empty.
+func (c *Client4) CreateCustomStatusTemplate(template *CustomStatusTemplate) (*CustomStatusTemplate, *Response, error) {
+	route := c.customStatusTemplatesRoute()
+	if template.TeamId != "" {
+		route = c.teamCustomStatusTemplatesRoute(template.TeamId)
+	}
+	buf, err := json.Marshal(template)
+	if err != nil {
+		return nil, nil, NewAppError("CreateCustomStatusTemplate", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(route, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created CustomStatusTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateCustomStatusTemplate", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// PatchCustomStatusTemplate partially updates an organization-wide custom status template.
+func (c *Client4) PatchCustomStatusTemplate(templateId string, patch *CustomStatusTemplatePatch) (*CustomStatusTemplate, *Response, error) {
+	return c.patchCustomStatusTemplate(c.customStatusTemplatesRoute()+"/"+templateId+"/patch", patch)
+}
+
+// PatchTeamCustomStatusTemplate partially updates a custom status template of a team.
+func (c *Client4) PatchTeamCustomStatusTemplate(teamId, templateId string, patch *CustomStatusTemplatePatch) (*CustomStatusTemplate, *Response, error) {
+	return c.patchCustomStatusTemplate(c.teamCustomStatusTemplatesRoute(teamId)+"/"+templateId+"/patch", patch)
+}
+
+func (c *Client4) patchCustomStatusTemplate(route string, patch *CustomStatusTemplatePatch) (*CustomStatusTemplate, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchCustomStatusTemplate", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(route, string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var patched CustomStatusTemplate
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patched); jsonErr != nil {
+		return nil, nil, NewAppError("PatchCustomStatusTemplate", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &patched, BuildResponse(r), nil
+}
+
+// DeleteCustomStatusTemplate deletes an organization-wide custom status template along with the
+// team templates overriding it.
+func (c *Client4) DeleteCustomStatusTemplate(templateId string) (*Response, error) {
+	return c.deleteCustomStatusTemplate(c.customStatusTemplatesRoute() + "/" + templateId)
+}
+
+// DeleteTeamCustomStatusTemplate deletes a custom status template of a team.
+func (c *Client4) DeleteTeamCustomStatusTemplate(teamId, templateId string) (*Response, error) {
+	return c.deleteCustomStatusTemplate(c.teamCustomStatusTemplatesRoute(teamId) + "/" + templateId)
+}
+
+func (c *Client4) deleteCustomStatusTemplate(route string) (*Response, error) {
+	r, err := c.DoAPIDelete(route)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetRESTProxyBridgeStatus returns how far the REST proxy bridge got in publishing the post and
+// membership events to the message queue.
+func (c *Client4) GetRESTProxyBridgeStatus() (*RESTProxyBridgeStatus, *Response, error) {
+	r, err := c.DoAPIGet("/rest_proxy_bridge/status", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var status RESTProxyBridgeStatus
+	if jsonErr := json.NewDecoder(r.Body).Decode(&status); jsonErr != nil {
+		return nil, nil, NewAppError("GetRESTProxyBridgeStatus", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+func (c *Client4) channelFeedsRoute(channelId string) string {
+	return c.channelRoute(channelId) + "/feeds"
+}
+
+// CreateChannelFeed subscribes the channel of the feed to it.
+func (c *Client4) CreateChannelFeed(channelFeed *ChannelFeed) (*ChannelFeed, *Response, error) {
+	buf, err := json.Marshal(channelFeed)
+	if err != nil {
+		return nil, nil, NewAppError("CreateChannelFeed", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPost(c.channelFeedsRoute(channelFeed.ChannelId), string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var created ChannelFeed
+	if jsonErr := json.NewDecoder(r.Body).Decode(&created); jsonErr != nil {
+		return nil, nil, NewAppError("CreateChannelFeed", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &created, BuildResponse(r), nil
+}
+
+// GetChannelFeeds returns the feeds the channel subscribes to.
+func (c *Client4) GetChannelFeeds(channelId string) ([]*ChannelFeed, *Response, error) {
+	r, err := c.DoAPIGet(c.channelFeedsRoute(channelId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var feeds []*ChannelFeed
+	if jsonErr := json.NewDecoder(r.Body).Decode(&feeds); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelFeeds", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return feeds, BuildResponse(r), nil
+}
+
+func (c *Client4) GetChannelFeed(channelId, feedId string) (*ChannelFeed, *Response, error) {
+	r, err := c.DoAPIGet(c.channelFeedsRoute(channelId)+"/"+feedId, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var channelFeed ChannelFeed
+	if jsonErr := json.NewDecoder(r.Body).Decode(&channelFeed); jsonErr != nil {
+		return nil, nil, NewAppError("GetChannelFeed", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &channelFeed, BuildResponse(r), nil
+}
+
+// PatchChannelFeed partially updates a feed of the channel.
+func (c *Client4) PatchChannelFeed(channelId, feedId string, patch *ChannelFeedPatch) (*ChannelFeed, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchChannelFeed", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPut(c.channelFeedsRoute(channelId)+"/"+feedId+"/patch", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var patched ChannelFeed
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patched); jsonErr != nil {
+		return nil, nil, NewAppError("PatchChannelFeed", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &patched, BuildResponse(r), nil
+}
+
+// DeleteChannelFeed unsubscribes the channel from the feed.
+func (c *Client4) DeleteChannelFeed(channelId, feedId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.channelFeedsRoute(channelId) + "/" + feedId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetOutstandingMentions returns a page of the mentions the user hasn't answered yet, with their
+// stats, in the team when teamId is set.
+func (c *Client4) GetOutstandingMentions(userId, teamId string, page, perPage int) (*OutstandingMentionList, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	if teamId != "" {
+		query += "&team_id=" + teamId
+	}
+	r, err := c.DoAPIGet(c.userRoute(userId)+"/mentions/outstanding"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list OutstandingMentionList
+	if jsonErr := json.NewDecoder(r.Body).Decode(&list); jsonErr != nil {
+		return nil, nil, NewAppError("GetOutstandingMentions", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &list, BuildResponse(r), nil
+}
+
+func (c *Client4) botStatesRoute(botUserId string) string {
+	return c.botRoute(botUserId) + "/state"
+}
+
+// botStateScopeQuery returns the query scoping the states of a bot to the user and channel.
+func botStateScopeQuery(userId, channelId string) url.Values {
+	values := url.Values{}
+	if userId != "" {
+		values.Set("user_id", userId)
+	}
+	if channelId != "" {
+		values.Set("channel_id", channelId)
+	}
+	return values
+}
+
+func (c *Client4) botStateRoute(botUserId, userId, channelId, key string) string {
+	return c.botStatesRoute(botUserId) + "/" + key + "?" + botStateScopeQuery(userId, channelId).Encode()
+}
+
+// GetBotStateKeys returns a page of the keys the bot has states under, in the scope of the user
+// and channel, either of which can be empty.
+func (c *Client4) GetBotStateKeys(botUserId, userId, channelId string, page, perPage int) ([]string, *Response, error) {
+	values := botStateScopeQuery(userId, channelId)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("per_page", strconv.Itoa(perPage))
+	r, err := c.DoAPIGet(c.botStatesRoute(botUserId)+"?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var keys []string
+	if jsonErr := json.NewDecoder(r.Body).Decode(&keys); jsonErr != nil {
+		return nil, nil, NewAppError("GetBotStateKeys", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return keys, BuildResponse(r), nil
+}
+
+func (c *Client4) GetBotState(botUserId, userId, channelId, key string) (*BotState, *Response, error) {
+	r, err := c.DoAPIGet(c.botStateRoute(botUserId, userId, channelId, key), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var state BotState
+	if jsonErr := json.NewDecoder(r.Body).Decode(&state); jsonErr != nil {
+		return nil, nil, NewAppError("GetBotState", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &state, BuildResponse(r), nil
+}
+
+// SetBotState sets the state of the bot under the key, expiring after the time to live of the
+// request, if any.
+func (c *Client4) SetBotState(botUserId, userId, channelId, key string, stateRequest *BotStateRequest) (*BotState, *Response, error) {
+	buf, err := json.Marshal(stateRequest)
+	if err != nil {
+		return nil, nil, NewAppError("SetBotState", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.botStateRoute(botUserId, userId, channelId, key), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var state BotState
+	if jsonErr := json.NewDecoder(r.Body).Decode(&state); jsonErr != nil {
+		return nil, nil, NewAppError("SetBotState", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &state, BuildResponse(r), nil
+}
+
+func (c *Client4) DeleteBotState(botUserId, userId, channelId, key string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.botStateRoute(botUserId, userId, channelId, key))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetTeamGroupRoles returns the groups mapped to roles on the team.
+func (c *Client4) GetTeamGroupRoles(teamId string) ([]*TeamGroupRole, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/group_roles", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var mappings []*TeamGroupRole
+	if jsonErr := json.NewDecoder(r.Body).Decode(&mappings); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamGroupRoles", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return mappings, BuildResponse(r), nil
+}
+
+// SaveTeamGroupRole maps the group to the role on the team, so that the members of the group hold
+// the role on the team for as long as they are in it.
+func (c *Client4) SaveTeamGroupRole(teamId, groupId, role string) (*TeamGroupRole, *Response, error) {
+	buf, err := json.Marshal(&TeamGroupRole{Role: role})
+	if err != nil {
+		return nil, nil, NewAppError("SaveTeamGroupRole", "api.marshal_error", nil, err.Error(), http.StatusInternalServerError)
+	}
+	r, err := c.DoAPIPutBytes(c.teamRoute(teamId)+"/group_roles/"+groupId, buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var mapping TeamGroupRole
+	if jsonErr := json.NewDecoder(r.Body).Decode(&mapping); jsonErr != nil {
+		return nil, nil, NewAppError("SaveTeamGroupRole", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &mapping, BuildResponse(r), nil
+}
+
+func (c *Client4) DeleteTeamGroupRole(teamId, groupId string) (*Response, error) {
+	r, err := c.DoAPIDelete(c.teamRoute(teamId) + "/group_roles/" + groupId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetTeamGroupRoleDrift returns the members of the team whose team admin role differs from the
+// one given by the groups mapped to roles on the team.
+func (c *Client4) GetTeamGroupRoleDrift(teamId string) (*TeamGroupRoleDriftReport, *Response, error) {
+	r, err := c.DoAPIGet(c.teamRoute(teamId)+"/group_roles/drift", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var report TeamGroupRoleDriftReport
+	if jsonErr := json.NewDecoder(r.Body).Decode(&report); jsonErr != nil {
+		return nil, nil, NewAppError("GetTeamGroupRoleDrift", "api.unmarshal_error", nil, jsonErr.Error(), http.StatusInternalServerError)
+	}
+	return &report, BuildResponse(r), nil
+}
@@ -36,6 +36,7 @@ const (
 	ClusterEventRemovePlugin                                ClusterEvent = "remove_plugin"
 	ClusterEventPluginEvent                                 ClusterEvent = "plugin_event"
 	ClusterEventInvalidateCacheForTermsOfService            ClusterEvent = "inv_terms_of_service"
+	ClusterEventInvalidateCacheForPostMetadata              ClusterEvent = "inv_post_metadata"
 	ClusterEventBusyStateChanged                            ClusterEvent = "busy_state_change"
 
 	// Gossip communication
@@ -106,6 +106,58 @@ func TestConfigDefaultEmailNotificationContentsType(t *testing.T) {
 	require.Equal(t, *c1.EmailSettings.EmailNotificationContentsType, EmailNotificationContentsFull)
 }
 
+func TestConfigInviteLocalesByDomain(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Empty(t, c1.LocalizationSettings.InviteLocalesByDomain)
+	require.Nil(t, c1.IsValid())
+
+	c1.LocalizationSettings.InviteLocalesByDomain["example.fr"] = "fr"
+	c1.LocalizationSettings.InviteLocalesByDomain["de.example.com"] = "de"
+	require.Nil(t, c1.IsValid())
+
+	assert.Equal(t, "fr", c1.LocalizationSettings.InviteLocaleForEmail("user@example.fr"))
+	assert.Equal(t, "fr", c1.LocalizationSettings.InviteLocaleForEmail("user@Mail.Example.FR"))
+	assert.Equal(t, "de", c1.LocalizationSettings.InviteLocaleForEmail("user@de.example.com"))
+	assert.Equal(t, "", c1.LocalizationSettings.InviteLocaleForEmail("user@example.com"))
+	assert.Equal(t, "", c1.LocalizationSettings.InviteLocaleForEmail("user"))
+
+	c1.LocalizationSettings.InviteLocalesByDomain["Example.com"] = "es"
+	require.NotNil(t, c1.IsValid())
+	delete(c1.LocalizationSettings.InviteLocalesByDomain, "Example.com")
+
+	c1.LocalizationSettings.InviteLocalesByDomain["example.com"] = ""
+	require.NotNil(t, c1.IsValid())
+	delete(c1.LocalizationSettings.InviteLocalesByDomain, "example.com")
+}
+
+func TestConfigPushNotificationContentsOverrides(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Empty(t, c1.EmailSettings.PushNotificationContentsByRole)
+	require.Empty(t, c1.EmailSettings.PushNotificationContentsBySensitivityTag)
+	require.Nil(t, c1.IsValid())
+
+	c1.EmailSettings.PushNotificationContentsByRole[SystemGuestRoleId] = IdLoadedNotification
+	c1.EmailSettings.PushNotificationContentsBySensitivityTag["confidential"] = GenericNoChannelNotification
+	require.Nil(t, c1.IsValid())
+
+	c1.EmailSettings.PushNotificationContentsByRole[SystemGuestRoleId] = "everything"
+	require.NotNil(t, c1.IsValid())
+	delete(c1.EmailSettings.PushNotificationContentsByRole, SystemGuestRoleId)
+
+	c1.EmailSettings.PushNotificationContentsByRole["Not A Role"] = FullNotification
+	require.NotNil(t, c1.IsValid())
+	delete(c1.EmailSettings.PushNotificationContentsByRole, "Not A Role")
+
+	c1.EmailSettings.PushNotificationContentsBySensitivityTag["Confidential"] = GenericNotification
+	require.NotNil(t, c1.IsValid())
+	delete(c1.EmailSettings.PushNotificationContentsBySensitivityTag, "Confidential")
+
+	c1.EmailSettings.PushNotificationContentsBySensitivityTag[""] = GenericNotification
+	require.NotNil(t, c1.IsValid())
+}
+
 func TestConfigDefaultFileSettingsS3SSE(t *testing.T) {
 	c1 := Config{}
 	c1.SetDefaults()
@@ -113,6 +165,26 @@ func TestConfigDefaultFileSettingsS3SSE(t *testing.T) {
 	require.False(t, *c1.FileSettings.AmazonS3SSE)
 }
 
+func TestConfigFileSettingsResidencyBackends(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Empty(t, c1.FileSettings.ResidencyBackends)
+
+	c1.FileSettings.ResidencyBackends["eu"] = &FileResidencyBackend{DriverName: ImageDriverS3, AmazonS3Bucket: "eu-bucket", AmazonS3SecretAccessKey: "secret"}
+	require.Nil(t, c1.IsValid())
+
+	c1.FileSettings.ResidencyBackends["eu"].AmazonS3Bucket = ""
+	appErr := c1.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.residency_backend_bucket.app_error", appErr.Id)
+
+	delete(c1.FileSettings.ResidencyBackends, "eu")
+	c1.FileSettings.ResidencyBackends["EU"] = &FileResidencyBackend{DriverName: ImageDriverLocal, Directory: "./eu/"}
+	appErr = c1.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.residency_backend.app_error", appErr.Id)
+}
+
 func TestConfigDefaultSignatureAlgorithm(t *testing.T) {
 	c1 := Config{}
 	c1.SetDefaults()
@@ -1285,9 +1357,11 @@ func TestConfigSanitize(t *testing.T) {
 
 	*c.LdapSettings.BindPassword = "foo"
 	*c.FileSettings.AmazonS3SecretAccessKey = "bar"
+	*c.FileSettings.AmazonCloudFrontPrivateKey = "qux"
 	*c.EmailSettings.SMTPPassword = "baz"
 	*c.GitLabSettings.Secret = "bingo"
 	*c.OpenIdSettings.Secret = "secret"
+	*c.CacheSettings.RedisPassword = "redis"
 	c.SqlSettings.DataSourceReplicas = []string{"stuff"}
 	c.SqlSettings.DataSourceSearchReplicas = []string{"stuff"}
 
@@ -1296,6 +1370,7 @@ func TestConfigSanitize(t *testing.T) {
 	assert.Equal(t, FakeSetting, *c.LdapSettings.BindPassword)
 	assert.Equal(t, FakeSetting, *c.FileSettings.PublicLinkSalt)
 	assert.Equal(t, FakeSetting, *c.FileSettings.AmazonS3SecretAccessKey)
+	assert.Equal(t, FakeSetting, *c.FileSettings.AmazonCloudFrontPrivateKey)
 	assert.Equal(t, FakeSetting, *c.EmailSettings.SMTPPassword)
 	assert.Equal(t, FakeSetting, *c.GitLabSettings.Secret)
 	assert.Equal(t, FakeSetting, *c.OpenIdSettings.Secret)
@@ -1304,6 +1379,133 @@ func TestConfigSanitize(t *testing.T) {
 	assert.Equal(t, FakeSetting, *c.ElasticsearchSettings.Password)
 	assert.Equal(t, FakeSetting, c.SqlSettings.DataSourceReplicas[0])
 	assert.Equal(t, FakeSetting, c.SqlSettings.DataSourceSearchReplicas[0])
+	assert.Equal(t, FakeSetting, *c.CacheSettings.RedisPassword)
+}
+
+func TestCacheSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings      CacheSettings
+		ExpectedError string
+	}{
+		"lru": {
+			Settings: CacheSettings{CacheType: NewString(CacheTypeLRU)},
+		},
+		"redis": {
+			Settings: CacheSettings{CacheType: NewString(CacheTypeRedis)},
+		},
+		"unknown type": {
+			Settings:      CacheSettings{CacheType: NewString("memcached")},
+			ExpectedError: "model.config.is_valid.cache.type.app_error",
+		},
+		"redis without address": {
+			Settings:      CacheSettings{CacheType: NewString(CacheTypeRedis), RedisAddress: NewString("")},
+			ExpectedError: "model.config.is_valid.cache.redis_address.app_error",
+		},
+		"negative redis database": {
+			Settings:      CacheSettings{CacheType: NewString(CacheTypeRedis), RedisDB: NewInt(-1)},
+			ExpectedError: "model.config.is_valid.cache.redis_db.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ExpectedError == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ExpectedError, appErr.Id)
+			}
+		})
+	}
+}
+
+func TestRESTProxyBridgeSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Settings      RESTProxyBridgeSettings
+		ExpectedError string
+	}{
+		"defaults": {},
+		"kafka rest proxy": {
+			Settings: RESTProxyBridgeSettings{Enable: NewBool(true), URL: NewString("http://kafka-rest:8082")},
+		},
+		"rabbitmq with topic mapping": {
+			Settings: RESTProxyBridgeSettings{
+				Enable:       NewBool(true),
+				Driver:       NewString(RESTProxyBridgeDriverRabbitMQ),
+				URL:          NewString("https://rabbitmq:15671"),
+				TopicMapping: map[string]string{RESTProxyBridgeEventPostCreated: "posts.created"},
+			},
+		},
+		"unknown driver": {
+			Settings:      RESTProxyBridgeSettings{Driver: NewString("sqs")},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.driver.app_error",
+		},
+		"enabled without url": {
+			Settings:      RESTProxyBridgeSettings{Enable: NewBool(true)},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.url.app_error",
+		},
+		"rabbitmq without exchange": {
+			Settings:      RESTProxyBridgeSettings{Driver: NewString(RESTProxyBridgeDriverRabbitMQ), RabbitMQExchange: NewString("")},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.rabbitmq_exchange.app_error",
+		},
+		"topic mapping of an unknown event": {
+			Settings:      RESTProxyBridgeSettings{TopicMapping: map[string]string{"user_created": "users"}},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.topic_mapping.app_error",
+		},
+		"unknown event": {
+			Settings:      RESTProxyBridgeSettings{Events: []string{RESTProxyBridgeEventPostCreated, "user_created"}},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.events.app_error",
+		},
+		"invalid team id": {
+			Settings:      RESTProxyBridgeSettings{TeamIds: []string{"team"}},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.team_ids.app_error",
+		},
+		"no retention": {
+			Settings:      RESTProxyBridgeSettings{RetentionHours: NewInt(0)},
+			ExpectedError: "model.config.is_valid.rest_proxy_bridge.retention_hours.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.Settings.SetDefaults()
+
+			appErr := test.Settings.isValid()
+			if test.ExpectedError == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, test.ExpectedError, appErr.Id)
+			}
+		})
+	}
+}
+
+func TestRESTProxyBridgeSettingsIsPublished(t *testing.T) {
+	teamID := NewId()
+	settings := RESTProxyBridgeSettings{
+		Enable:       NewBool(true),
+		Events:       []string{RESTProxyBridgeEventPostCreated},
+		TopicMapping: map[string]string{RESTProxyBridgeEventPostCreated: "posts"},
+	}
+	settings.SetDefaults()
+
+	assert.True(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, teamID))
+	assert.False(t, settings.IsPublished(RESTProxyBridgeEventPostEdited, teamID))
+	assert.False(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, ""), "direct messages are left out by default")
+
+	settings.IncludeDirectMessages = NewBool(true)
+	assert.True(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, ""))
+
+	settings.TeamIds = []string{NewId()}
+	assert.False(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, teamID))
+	settings.TeamIds = append(settings.TeamIds, teamID)
+	assert.True(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, teamID))
+
+	settings.Enable = NewBool(false)
+	assert.False(t, settings.IsPublished(RESTProxyBridgeEventPostCreated, teamID))
+
+	assert.Equal(t, "posts", settings.TopicFor(RESTProxyBridgeEventPostCreated))
+	assert.Equal(t, RESTProxyBridgeSettingsDefaultTopic, settings.TopicFor(RESTProxyBridgeEventPostDeleted))
 }
 
 func TestConfigFilteredByTag(t *testing.T) {
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const ConfigVersionLength = 64
+
+// ConfigVersion is a version of the configuration recorded in the config history when the
+// configuration is saved through the API. Version is the hash of the configuration returned as
+// the ETag of the config endpoints, and Value the sanitized configuration, in JSON.
+type ConfigVersion struct {
+	Id       string `json:"id"`
+	Version  string `json:"version"`
+	UserId   string `json:"user_id"`
+	Value    string `json:"value,omitempty"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (v *ConfigVersion) PreSave() {
+	if v.Id == "" {
+		v.Id = NewId()
+	}
+
+	v.CreateAt = GetMillis()
+}
+
+func (v *ConfigVersion) IsValid() *AppError {
+	if !IsValidId(v.Id) {
+		return NewAppError("ConfigVersion.IsValid", "model.config_version.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(v.Version) != ConfigVersionLength {
+		return NewAppError("ConfigVersion.IsValid", "model.config_version.is_valid.version.app_error", nil, "id="+v.Id, http.StatusBadRequest)
+	}
+
+	// The user is empty for the versions recorded as the base of the first change saved
+	// through the API.
+	if v.UserId != "" && !IsValidId(v.UserId) {
+		return NewAppError("ConfigVersion.IsValid", "model.config_version.is_valid.user_id.app_error", nil, "id="+v.Id, http.StatusBadRequest)
+	}
+
+	if v.Value == "" {
+		return NewAppError("ConfigVersion.IsValid", "model.config_version.is_valid.value.app_error", nil, "id="+v.Id, http.StatusBadRequest)
+	}
+
+	if v.CreateAt == 0 {
+		return NewAppError("ConfigVersion.IsValid", "model.config_version.is_valid.create_at.app_error", nil, "id="+v.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Config returns the configuration of the version.
+func (v *ConfigVersion) Config() (*Config, error) {
+	var cfg Config
+	if err := json.Unmarshal([]byte(v.Value), &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ConfigVersionDiff is a setting changed between two versions of the configuration, identified
+// by its path in the configuration.
+type ConfigVersionDiff struct {
+	Path      string      `json:"path"`
+	BaseVal   interface{} `json:"base_val"`
+	ActualVal interface{} `json:"actual_val"`
+}
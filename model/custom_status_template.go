@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const CustomStatusTemplateEmojiMaxRunes = 64
+
+// CustomStatusTemplate is a custom status suggested to the users by the administrators. It is
+// either offered to every user, or only to the members of a team when TeamId is set. A team
+// template can take the place of an organization-wide one for the members of the team by
+// setting OverrideId to the id of the template it replaces.
+//
+// The duration is the one suggested to clear the status after, empty for never.
+type CustomStatusTemplate struct {
+	Id         string `json:"id"`
+	TeamId     string `json:"team_id"`
+	OverrideId string `json:"override_id"`
+	CreatorId  string `json:"creator_id"`
+	Emoji      string `json:"emoji"`
+	Text       string `json:"text"`
+	Duration   string `json:"duration"`
+	UseCount   int64  `json:"use_count"`
+	LastUsedAt int64  `json:"last_used_at"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+}
+
+type CustomStatusTemplatePatch struct {
+	Emoji    *string `json:"emoji"`
+	Text     *string `json:"text"`
+	Duration *string `json:"duration"`
+}
+
+func (t *CustomStatusTemplate) PreSave() {
+	if t.Id == "" {
+		t.Id = NewId()
+	}
+
+	if t.Emoji == "" {
+		t.Emoji = DefaultCustomStatusEmoji
+	}
+
+	t.UseCount = 0
+	t.LastUsedAt = 0
+	t.CreateAt = GetMillis()
+	t.UpdateAt = t.CreateAt
+}
+
+func (t *CustomStatusTemplate) PreUpdate() {
+	t.UpdateAt = GetMillis()
+}
+
+func (t *CustomStatusTemplate) IsValid() *AppError {
+	if !IsValidId(t.Id) {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.TeamId != "" && !IsValidId(t.TeamId) {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.team_id.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if t.OverrideId != "" && (t.TeamId == "" || !IsValidId(t.OverrideId)) {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.override_id.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(t.CreatorId) {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.creator_id.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if t.Emoji == "" || utf8.RuneCountInString(t.Emoji) > CustomStatusTemplateEmojiMaxRunes {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.emoji.app_error", map[string]interface{}{"MaxLength": CustomStatusTemplateEmojiMaxRunes}, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if t.Text == "" || utf8.RuneCountInString(t.Text) > CustomStatusTextMaxRunes {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.text.app_error", map[string]interface{}{"MaxLength": CustomStatusTextMaxRunes}, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	// A template can't suggest a fixed date, it would be in the past sooner or later.
+	if t.Duration != "" && (!validCustomStatusDuration[t.Duration] || t.Duration == "date_and_time") {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.duration.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if t.CreateAt == 0 {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.create_at.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	if t.UpdateAt == 0 {
+		return NewAppError("CustomStatusTemplate.IsValid", "model.custom_status_template.is_valid.update_at.app_error", nil, "id="+t.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (t *CustomStatusTemplate) Patch(patch *CustomStatusTemplatePatch) {
+	if patch.Emoji != nil {
+		t.Emoji = *patch.Emoji
+	}
+
+	if patch.Text != nil {
+		t.Text = *patch.Text
+	}
+
+	if patch.Duration != nil {
+		t.Duration = *patch.Duration
+	}
+}
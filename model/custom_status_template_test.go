@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomStatusTemplateIsValid(t *testing.T) {
+	template := &CustomStatusTemplate{
+		CreatorId: NewId(),
+		Text:      "In a meeting",
+		Duration:  "one_hour",
+	}
+	template.PreSave()
+	require.Nil(t, template.IsValid())
+	assert.Equal(t, DefaultCustomStatusEmoji, template.Emoji)
+
+	template.OverrideId = NewId()
+	require.NotNil(t, template.IsValid(), "only team templates override")
+	template.TeamId = NewId()
+	require.Nil(t, template.IsValid())
+	template.OverrideId = "junk"
+	require.NotNil(t, template.IsValid())
+	template.OverrideId = ""
+
+	template.Text = ""
+	require.NotNil(t, template.IsValid())
+	template.Text = strings.Repeat("a", CustomStatusTextMaxRunes+1)
+	require.NotNil(t, template.IsValid())
+	template.Text = "Commuting"
+
+	template.Emoji = ""
+	require.NotNil(t, template.IsValid())
+	template.Emoji = strings.Repeat("a", CustomStatusTemplateEmojiMaxRunes+1)
+	require.NotNil(t, template.IsValid())
+	template.Emoji = "bus"
+
+	template.Duration = "date_and_time"
+	require.NotNil(t, template.IsValid())
+	template.Duration = "forever"
+	require.NotNil(t, template.IsValid())
+	template.Duration = ""
+	require.Nil(t, template.IsValid())
+}
+
+func TestCustomStatusTemplatePatch(t *testing.T) {
+	template := &CustomStatusTemplate{Emoji: "calendar", Text: "In a meeting", Duration: "one_hour"}
+
+	text := "Out for lunch"
+	template.Patch(&CustomStatusTemplatePatch{Text: &text})
+	assert.Equal(t, &CustomStatusTemplate{Emoji: "calendar", Text: "Out for lunch", Duration: "one_hour"}, template)
+
+	emoji, duration := "hamburger", ""
+	template.Patch(&CustomStatusTemplatePatch{Emoji: &emoji, Duration: &duration})
+	assert.Equal(t, &CustomStatusTemplate{Emoji: "hamburger", Text: "Out for lunch"}, template)
+}
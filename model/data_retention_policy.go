@@ -12,6 +12,16 @@ type GlobalRetentionPolicy struct {
 	BoardsRetentionCutoff  int64 `json:"boards_retention_cutoff"`
 }
 
+// RetentionPolicyDryRun is the number of posts the data retention job would delete if it
+// ran at Now, by the kind of policy they fall under.
+type RetentionPolicyDryRun struct {
+	Now                 int64 `json:"now"`
+	GlobalPolicyEndTime int64 `json:"global_policy_end_time"`
+	ChannelPolicies     int64 `json:"channel_policies"`
+	TeamPolicies        int64 `json:"team_policies"`
+	GlobalPolicy        int64 `json:"global_policy"`
+}
+
 type RetentionPolicy struct {
 	ID           string `db:"Id" json:"id"`
 	DisplayName  string `json:"display_name"`
@@ -30,6 +40,17 @@ type RetentionPolicyWithTeamAndChannelCounts struct {
 	TeamCount    int64 `json:"team_count"`
 }
 
+// RetentionPolicyPreviewCount is the number of posts, and of files attached to them, that a
+// retention policy would delete from a channel.
+type RetentionPolicyPreviewCount struct {
+	TeamId      string `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	ChannelId   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	PostCount   int64  `json:"post_count"`
+	FileCount   int64  `json:"file_count"`
+}
+
 type RetentionPolicyChannel struct {
 	PolicyID  string `db:"PolicyId"`
 	ChannelID string `db:"ChannelId"`
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	DirectChannelRetentionMinDays = 1
+	DirectChannelRetentionMaxDays = 3650
+)
+
+// DirectChannelRetention is a retention period agreed on by the members of a direct
+// message channel. It is proposed by one member and only enforced once the other
+// member has accepted it.
+type DirectChannelRetention struct {
+	ChannelId     string `json:"channel_id"`
+	RetentionDays int64  `json:"retention_days"`
+	ProposedBy    string `json:"proposed_by"`
+	ProposedAt    int64  `json:"proposed_at"`
+	AcceptedAt    int64  `json:"accepted_at"`
+	LastPurgeAt   int64  `json:"last_purge_at"`
+}
+
+// DirectChannelRetentionRequest is the body used to propose or accept a retention period.
+type DirectChannelRetentionRequest struct {
+	RetentionDays int64 `json:"retention_days"`
+}
+
+func IsValidDirectChannelRetentionDays(days int64) bool {
+	return days >= DirectChannelRetentionMinDays && days <= DirectChannelRetentionMaxDays
+}
+
+func (r *DirectChannelRetention) IsValid() *AppError {
+	if !IsValidId(r.ChannelId) {
+		return NewAppError("DirectChannelRetention.IsValid", "model.direct_channel_retention.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidDirectChannelRetentionDays(r.RetentionDays) {
+		return NewAppError("DirectChannelRetention.IsValid", "model.direct_channel_retention.is_valid.retention_days.app_error", map[string]interface{}{"Min": DirectChannelRetentionMinDays, "Max": DirectChannelRetentionMaxDays}, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.ProposedBy) {
+		return NewAppError("DirectChannelRetention.IsValid", "model.direct_channel_retention.is_valid.proposed_by.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.ProposedAt == 0 {
+		return NewAppError("DirectChannelRetention.IsValid", "model.direct_channel_retention.is_valid.proposed_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsActive reports whether both members consented to the retention period.
+func (r *DirectChannelRetention) IsActive() bool {
+	return r.AcceptedAt > 0
+}
+
+// PurgeBefore returns the time before which posts are removed by the retention period.
+func (r *DirectChannelRetention) PurgeBefore(now int64) int64 {
+	return now - r.RetentionDays*24*60*60*1000
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectChannelRetentionIsValid(t *testing.T) {
+	retention := &DirectChannelRetention{
+		ChannelId:     NewId(),
+		RetentionDays: 30,
+		ProposedBy:    NewId(),
+		ProposedAt:    GetMillis(),
+	}
+	require.Nil(t, retention.IsValid())
+	assert.False(t, retention.IsActive())
+
+	retention.AcceptedAt = GetMillis()
+	assert.True(t, retention.IsActive())
+
+	retention.RetentionDays = 0
+	require.NotNil(t, retention.IsValid())
+	retention.RetentionDays = DirectChannelRetentionMaxDays + 1
+	require.NotNil(t, retention.IsValid())
+	retention.RetentionDays = 30
+
+	retention.ProposedBy = ""
+	require.NotNil(t, retention.IsValid())
+}
+
+func TestDirectChannelRetentionPurgeBefore(t *testing.T) {
+	retention := &DirectChannelRetention{RetentionDays: 2}
+	assert.Equal(t, int64(1000), retention.PurgeBefore(2*24*60*60*1000+1000))
+}
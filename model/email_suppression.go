@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	EmailSuppressionReasonBounce    = "bounce"
+	EmailSuppressionReasonComplaint = "complaint"
+
+	EmailSuppressionDetailsMaxLength = 1024
+)
+
+// EmailSuppression is an address that emails are no longer sent to, because the email
+// service provider reported that it bounced or that its owner complained.
+type EmailSuppression struct {
+	Email    string `json:"email"`
+	Reason   string `json:"reason"`
+	Provider string `json:"provider"`
+	Details  string `json:"details"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (s *EmailSuppression) PreSave() {
+	s.Email = NormalizeEmail(s.Email)
+
+	if len(s.Details) > EmailSuppressionDetailsMaxLength {
+		s.Details = s.Details[:EmailSuppressionDetailsMaxLength]
+	}
+
+	if s.CreateAt == 0 {
+		s.CreateAt = GetMillis()
+	}
+}
+
+func (s *EmailSuppression) IsValid() *AppError {
+	if !IsValidEmail(s.Email) {
+		return NewAppError("EmailSuppression.IsValid", "model.email_suppression.is_valid.email.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if s.Reason != EmailSuppressionReasonBounce && s.Reason != EmailSuppressionReasonComplaint {
+		return NewAppError("EmailSuppression.IsValid", "model.email_suppression.is_valid.reason.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if s.CreateAt == 0 {
+		return NewAppError("EmailSuppression.IsValid", "model.email_suppression.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailSuppressionPreSaveAndIsValid(t *testing.T) {
+	s := &EmailSuppression{
+		Email:   "Bounced@Example.com",
+		Reason:  EmailSuppressionReasonBounce,
+		Details: strings.Repeat("x", EmailSuppressionDetailsMaxLength+1),
+	}
+	s.PreSave()
+	assert.Equal(t, "bounced@example.com", s.Email)
+	assert.Len(t, s.Details, EmailSuppressionDetailsMaxLength)
+	assert.NotZero(t, s.CreateAt)
+	require.Nil(t, s.IsValid())
+
+	s.Reason = "unsubscribed"
+	require.NotNil(t, s.IsValid())
+
+	s.Reason = EmailSuppressionReasonComplaint
+	s.Email = "not an email"
+	require.NotNil(t, s.IsValid())
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	EventWebhookEventUserCreated = "user_created"
+	EventWebhookEventTeamDeleted = "team_deleted"
+	EventWebhookEventPostFlagged = "post_flagged"
+
+	EventWebhookDeliveryStatusPending   = "pending"
+	EventWebhookDeliveryStatusSucceeded = "succeeded"
+	EventWebhookDeliveryStatusFailed    = "failed"
+
+	EventWebhookHeaderEvent     = "X-Mattermost-Event"
+	EventWebhookHeaderDelivery  = "X-Mattermost-Delivery"
+	EventWebhookHeaderTimestamp = "X-Mattermost-Timestamp"
+	EventWebhookHeaderSignature = "X-Mattermost-Signature"
+
+	EventWebhookMaxAttempts = 6
+
+	EventWebhookDisplayNameMaxRunes   = 64
+	EventWebhookDescriptionMaxRunes   = 500
+	EventWebhookURLMaxLength          = 1024
+	EventWebhookSecretLength          = 32
+	EventWebhookDeliveryErrorMaxRunes = 1024
+
+	eventWebhookFirstRetryDelay = time.Minute
+)
+
+var AllEventWebhookEvents = []string{
+	EventWebhookEventUserCreated,
+	EventWebhookEventTeamDeleted,
+	EventWebhookEventPostFlagged,
+}
+
+// EventWebhook is an HTTPS endpoint registered by an admin to be sent the server events it's
+// subscribed to. The deliveries are signed with its secret, which is only returned when it's
+// created or regenerated.
+type EventWebhook struct {
+	Id          string      `json:"id"`
+	CreatorId   string      `json:"creator_id"`
+	DisplayName string      `json:"display_name"`
+	Description string      `json:"description"`
+	URL         string      `json:"url"`
+	Secret      string      `json:"secret,omitempty"`
+	Events      StringArray `json:"events"`
+	CreateAt    int64       `json:"create_at"`
+	UpdateAt    int64       `json:"update_at"`
+}
+
+func (w *EventWebhook) PreSave() {
+	if w.Id == "" {
+		w.Id = NewId()
+	}
+
+	if w.Secret == "" {
+		w.Secret = NewRandomString(EventWebhookSecretLength)
+	}
+
+	w.CreateAt = GetMillis()
+	w.UpdateAt = w.CreateAt
+}
+
+func (w *EventWebhook) PreUpdate() {
+	w.UpdateAt = GetMillis()
+}
+
+func (w *EventWebhook) IsValid() *AppError {
+	if !IsValidId(w.Id) {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(w.CreatorId) {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.creator_id.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(w.DisplayName) > EventWebhookDisplayNameMaxRunes {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.display_name.app_error", map[string]interface{}{"MaxLength": EventWebhookDisplayNameMaxRunes}, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(w.Description) > EventWebhookDescriptionMaxRunes {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.description.app_error", map[string]interface{}{"MaxLength": EventWebhookDescriptionMaxRunes}, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if len(w.URL) > EventWebhookURLMaxLength || !strings.HasPrefix(w.URL, "https://") || !IsValidHTTPURL(w.URL) {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.url.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if len(w.Secret) != EventWebhookSecretLength {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.secret.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if len(w.Events) == 0 {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.events.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+	for _, event := range w.Events {
+		if !IsValidEventWebhookEvent(event) {
+			return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.events.app_error", nil, "id="+w.Id+", event="+event, http.StatusBadRequest)
+		}
+	}
+
+	if w.CreateAt == 0 {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.create_at.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	if w.UpdateAt == 0 {
+		return NewAppError("EventWebhook.IsValid", "model.event_webhook.is_valid.update_at.app_error", nil, "id="+w.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (w *EventWebhook) Sanitize() {
+	w.Secret = ""
+}
+
+func (w *EventWebhook) IsSubscribedTo(event string) bool {
+	return w.Events.Contains(event)
+}
+
+func IsValidEventWebhookEvent(event string) bool {
+	for _, valid := range AllEventWebhookEvents {
+		if event == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// EventWebhookPayload is the body of a delivery. Data holds the object the event is about,
+// such as the created user.
+type EventWebhookPayload struct {
+	Event    string      `json:"event"`
+	CreateAt int64       `json:"create_at"`
+	Data     interface{} `json:"data"`
+}
+
+// EventWebhookDelivery records the delivery of an event to an event webhook, along with the
+// outcome of its last attempt.
+type EventWebhookDelivery struct {
+	Id            string `json:"id"`
+	WebhookId     string `json:"webhook_id"`
+	Event         string `json:"event"`
+	Payload       string `json:"payload"`
+	Status        string `json:"status"`
+	Attempts      int    `json:"attempts"`
+	ResponseCode  int    `json:"response_code"`
+	Error         string `json:"error"`
+	NextAttemptAt int64  `json:"next_attempt_at"`
+	CreateAt      int64  `json:"create_at"`
+	UpdateAt      int64  `json:"update_at"`
+}
+
+func (d *EventWebhookDelivery) PreSave() {
+	if d.Id == "" {
+		d.Id = NewId()
+	}
+
+	if d.Status == "" {
+		d.Status = EventWebhookDeliveryStatusPending
+	}
+
+	d.CreateAt = GetMillis()
+	d.UpdateAt = d.CreateAt
+}
+
+func (d *EventWebhookDelivery) PreUpdate() {
+	if utf8.RuneCountInString(d.Error) > EventWebhookDeliveryErrorMaxRunes {
+		d.Error = string([]rune(d.Error)[:EventWebhookDeliveryErrorMaxRunes])
+	}
+
+	d.UpdateAt = GetMillis()
+}
+
+// RecordAttempt updates the delivery with the outcome of an attempt that got the given response
+// code, or failed with the given error. A failed attempt is retried with an exponential
+// backoff, until the delivery runs out of attempts.
+func (d *EventWebhookDelivery) RecordAttempt(responseCode int, attemptErr string, now int64) {
+	d.Attempts++
+	d.ResponseCode = responseCode
+	d.Error = attemptErr
+
+	switch {
+	case attemptErr == "" && responseCode >= 200 && responseCode < 300:
+		d.Status = EventWebhookDeliveryStatusSucceeded
+		d.NextAttemptAt = 0
+	case d.Attempts >= EventWebhookMaxAttempts:
+		d.Status = EventWebhookDeliveryStatusFailed
+		d.NextAttemptAt = 0
+	default:
+		d.Status = EventWebhookDeliveryStatusPending
+		d.NextAttemptAt = now + EventWebhookRetryDelay(d.Attempts).Milliseconds()
+	}
+}
+
+// EventWebhookRetryDelay returns how long to wait before retrying a delivery after the given
+// number of failed attempts, doubling with each attempt.
+func EventWebhookRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	return eventWebhookFirstRetryDelay << (attempts - 1)
+}
+
+// SignEventWebhookPayload returns the signature of a delivery sent at the given time, in
+// seconds, as sent in the X-Mattermost-Signature header. Receivers verify the deliveries by
+// computing the HMAC-SHA256 of the timestamp and the body joined by a dot, keyed with the
+// secret of the webhook.
+func SignEventWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventWebhookIsValid(t *testing.T) {
+	webhook := &EventWebhook{
+		CreatorId:   NewId(),
+		DisplayName: "Provisioning",
+		URL:         "https://example.com/hooks/mattermost",
+		Events:      StringArray{EventWebhookEventUserCreated, EventWebhookEventTeamDeleted},
+	}
+	webhook.PreSave()
+	require.Len(t, webhook.Secret, EventWebhookSecretLength)
+	require.Nil(t, webhook.IsValid())
+
+	for _, url := range []string{"", "http://example.com/hooks", "https://", "ftp://example.com", "https://example.com/" + strings.Repeat("a", EventWebhookURLMaxLength)} {
+		webhook.URL = url
+		require.NotNil(t, webhook.IsValid(), url)
+	}
+	webhook.URL = "https://example.com/hooks/mattermost"
+
+	webhook.Events = StringArray{}
+	require.NotNil(t, webhook.IsValid())
+	webhook.Events = StringArray{EventWebhookEventPostFlagged, "post_liked"}
+	require.NotNil(t, webhook.IsValid())
+	webhook.Events = StringArray{EventWebhookEventPostFlagged}
+	require.Nil(t, webhook.IsValid())
+	assert.True(t, webhook.IsSubscribedTo(EventWebhookEventPostFlagged))
+	assert.False(t, webhook.IsSubscribedTo(EventWebhookEventUserCreated))
+
+	webhook.DisplayName = strings.Repeat("a", EventWebhookDisplayNameMaxRunes+1)
+	require.NotNil(t, webhook.IsValid())
+	webhook.DisplayName = ""
+	require.Nil(t, webhook.IsValid())
+
+	webhook.Sanitize()
+	assert.Empty(t, webhook.Secret)
+	require.NotNil(t, webhook.IsValid())
+}
+
+func TestEventWebhookDeliveryRecordAttempt(t *testing.T) {
+	delivery := &EventWebhookDelivery{WebhookId: NewId(), Event: EventWebhookEventUserCreated}
+	delivery.PreSave()
+	assert.Equal(t, EventWebhookDeliveryStatusPending, delivery.Status)
+
+	now := GetMillis()
+	delivery.RecordAttempt(0, "connection refused", now)
+	assert.Equal(t, EventWebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, now+time.Minute.Milliseconds(), delivery.NextAttemptAt)
+
+	delivery.RecordAttempt(http.StatusInternalServerError, "", now)
+	assert.Equal(t, EventWebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, now+2*time.Minute.Milliseconds(), delivery.NextAttemptAt)
+
+	delivery.RecordAttempt(204, "", now)
+	assert.Equal(t, EventWebhookDeliveryStatusSucceeded, delivery.Status)
+	assert.Zero(t, delivery.NextAttemptAt)
+	assert.Equal(t, 3, delivery.Attempts)
+
+	t.Run("runs out of attempts", func(t *testing.T) {
+		delivery := &EventWebhookDelivery{}
+		for i := 1; i < EventWebhookMaxAttempts; i++ {
+			delivery.RecordAttempt(http.StatusInternalServerError, "", now)
+			require.Equal(t, EventWebhookDeliveryStatusPending, delivery.Status)
+		}
+		assert.Equal(t, now+16*time.Minute.Milliseconds(), delivery.NextAttemptAt)
+
+		delivery.RecordAttempt(http.StatusInternalServerError, "", now)
+		assert.Equal(t, EventWebhookDeliveryStatusFailed, delivery.Status)
+		assert.Zero(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("long errors are truncated", func(t *testing.T) {
+		delivery.Error = strings.Repeat("é", EventWebhookDeliveryErrorMaxRunes+10)
+		delivery.PreUpdate()
+		assert.Equal(t, EventWebhookDeliveryErrorMaxRunes, len([]rune(delivery.Error)))
+	})
+}
+
+func TestSignEventWebhookPayload(t *testing.T) {
+	// Computed with: printf '1700000000.{"event":"user_created"}' | openssl dgst -sha256 -hmac secret
+	signature := SignEventWebhookPayload("secret", 1700000000, []byte(`{"event":"user_created"}`))
+	assert.Equal(t, "sha256=dae4f5102f8cff51756b5f055ea28dcf8b89bf9682c546cc3b869f2b42df2505", signature)
+
+	assert.NotEqual(t, signature, SignEventWebhookPayload("other", 1700000000, []byte(`{"event":"user_created"}`)))
+	assert.NotEqual(t, signature, SignEventWebhookPayload("secret", 1700000001, []byte(`{"event":"user_created"}`)))
+}
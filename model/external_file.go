@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// ExternalFileURLMaxLength is the longest URL of an external file, signed URLs being long.
+	ExternalFileURLMaxLength = 2048
+
+	ExternalFileNameMaxRunes = 256
+)
+
+// ExternalFileAttachment describes an object hosted in external object storage, such as a
+// signed S3 or GCS URL, to attach to posts in a channel without copying it to the file store.
+type ExternalFileAttachment struct {
+	ChannelId string `json:"channel_id"`
+	URL       string `json:"url"`
+	// Name is the name of the file shown to users. It defaults to the last segment of the URL path.
+	Name string `json:"name,omitempty"`
+}
+
+func (a *ExternalFileAttachment) IsValid() *AppError {
+	if !IsValidId(a.ChannelId) {
+		return NewAppError("ExternalFileAttachment.IsValid", "model.external_file_attachment.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	u, err := url.Parse(a.URL)
+	if err != nil || len(a.URL) > ExternalFileURLMaxLength || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return NewAppError("ExternalFileAttachment.IsValid", "model.external_file_attachment.is_valid.url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(a.Name) > ExternalFileNameMaxRunes || strings.ContainsAny(a.Name, "/\\") {
+		return NewAppError("ExternalFileAttachment.IsValid", "model.external_file_attachment.is_valid.name.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsValidExternalFileHost reports whether the given string can be configured as a host external
+// files may be attached from. A host also allows its subdomains.
+func IsValidExternalFileHost(host string) bool {
+	if host == "" || host != strings.ToLower(host) || strings.ContainsAny(host, "/:@?# ") {
+		return false
+	}
+
+	u, err := url.Parse("https://" + host)
+	return err == nil && u.Host == host
+}
+
+// ExternalFileHostAllowed reports whether the given host is one of the allowed hosts or one of
+// their subdomains.
+func ExternalFileHostAllowed(host string, allowedHosts []string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range allowedHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
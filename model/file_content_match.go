@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// FileContentSnippetContext is the number of bytes of the content of a file kept around the
+// first search match of its snippet.
+const FileContentSnippetContext = 80
+
+var fileSearchTermsRegexp = regexp.MustCompile(`"[^"]+"|\S+`)
+
+// FileContentMatch is the part of the extracted content of a file that matched a search,
+// along with the matched words for the client to highlight.
+type FileContentMatch struct {
+	Snippet string   `json:"snippet"`
+	Matches []string `json:"matches"`
+}
+
+// FileSearchTerms splits the terms of a file search into the words and quoted phrases to
+// look for in the content of the files, without their quotes and wildcards.
+func FileSearchTerms(terms string) []string {
+	words := []string{}
+	for _, word := range fileSearchTermsRegexp.FindAllString(terms, -1) {
+		word = strings.TrimSuffix(strings.Trim(word, `"`), "*")
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// NewFileContentMatch returns the snippet of the content around the first of the terms it
+// contains, or nil when it contains none of them.
+func NewFileContentMatch(content string, terms []string) *FileContentMatch {
+	if content == "" || len(terms) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	termsRegexp, err := regexp.Compile("(?i)" + strings.Join(quoted, "|"))
+	if err != nil {
+		return nil
+	}
+
+	loc := termsRegexp.FindStringIndex(content)
+	if loc == nil {
+		return nil
+	}
+
+	start := loc[0] - FileContentSnippetContext
+	if start < 0 {
+		start = 0
+	}
+	for start > 0 && !utf8.RuneStart(content[start]) {
+		start--
+	}
+	end := loc[1] + FileContentSnippetContext
+	if end > len(content) {
+		end = len(content)
+	}
+	for end < len(content) && !utf8.RuneStart(content[end]) {
+		end++
+	}
+
+	snippet := strings.Join(strings.Fields(content[start:end]), " ")
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(content) {
+		snippet += "..."
+	}
+
+	match := &FileContentMatch{
+		Snippet: snippet,
+		Matches: []string{},
+	}
+	seen := map[string]bool{}
+	for _, word := range termsRegexp.FindAllString(snippet, -1) {
+		if !seen[word] {
+			seen[word] = true
+			match.Matches = append(match.Matches, word)
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{}, FileSearchTerms(""))
+	assert.Equal(t, []string{"budget", "report"}, FileSearchTerms("budget report"))
+	assert.Equal(t, []string{"quarterly budget", "rep"}, FileSearchTerms(`"quarterly budget" rep*`))
+}
+
+func TestNewFileContentMatch(t *testing.T) {
+	t.Run("no match", func(t *testing.T) {
+		assert.Nil(t, NewFileContentMatch("", []string{"budget"}))
+		assert.Nil(t, NewFileContentMatch("the quarterly report", nil))
+		assert.Nil(t, NewFileContentMatch("the quarterly report", []string{"budget"}))
+	})
+
+	t.Run("short content", func(t *testing.T) {
+		match := NewFileContentMatch("The quarterly\n\nBudget report", []string{"budget", "report"})
+		require.NotNil(t, match)
+		assert.Equal(t, "The quarterly Budget report", match.Snippet)
+		assert.Equal(t, []string{"Budget", "report"}, match.Matches)
+	})
+
+	t.Run("long content", func(t *testing.T) {
+		padding := strings.Repeat("a ", FileContentSnippetContext)
+		match := NewFileContentMatch(padding+"budget"+padding, []string{"budget"})
+		require.NotNil(t, match)
+		assert.True(t, strings.HasPrefix(match.Snippet, "..."))
+		assert.True(t, strings.HasSuffix(match.Snippet, "..."))
+		assert.Contains(t, match.Snippet, "budget")
+		assert.Equal(t, []string{"budget"}, match.Matches)
+	})
+
+	t.Run("multibyte content", func(t *testing.T) {
+		padding := strings.Repeat("é", FileContentSnippetContext)
+		match := NewFileContentMatch(padding+"budget"+padding, []string{"budget"})
+		require.NotNil(t, match)
+		assert.True(t, strings.HasPrefix(match.Snippet, "..."))
+		assert.True(t, strings.HasSuffix(match.Snippet, "..."))
+		assert.Contains(t, match.Snippet, "budget")
+	})
+
+	t.Run("special characters", func(t *testing.T) {
+		match := NewFileContentMatch("costs (estimated) in $", []string{"(estimated)", "$"})
+		require.NotNil(t, match)
+		assert.Equal(t, []string{"(estimated)", "$"}, match.Matches)
+	})
+}
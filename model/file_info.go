@@ -55,6 +55,8 @@ type FileInfo struct {
 	MiniPreview     *[]byte `json:"mini_preview"` // declared as *[]byte to avoid postgres/mysql differences in deserialization
 	Content         string  `json:"-"`
 	RemoteId        *string `json:"remote_id"`
+	ExternalURL     string  `json:"-"` // not sent back to the client, since it may hold credentials
+	ContentHash     string  `json:"-"` // SHA-256 of the content, the files of a team with the same content sharing their Path
 }
 
 func (fi *FileInfo) PreSave() {
@@ -96,13 +98,23 @@ func (fi *FileInfo) IsValid() *AppError {
 		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.update_at.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
 	}
 
-	if fi.Path == "" {
+	if fi.Path == "" && fi.ExternalURL == "" {
 		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.path.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
 	}
 
+	if fi.ExternalURL != "" && (len(fi.ExternalURL) > ExternalFileURLMaxLength || !IsValidHTTPURL(fi.ExternalURL)) {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.external_url.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
+// IsExternal reports whether the file is hosted outside of the file store, in which case the
+// server only proxies it.
+func (fi *FileInfo) IsExternal() bool {
+	return fi.ExternalURL != ""
+}
+
 func (fi *FileInfo) IsImage() bool {
 	return strings.HasPrefix(fi.MimeType, "image")
 }
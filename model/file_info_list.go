@@ -12,6 +12,12 @@ type FileInfoList struct {
 	FileInfos      map[string]*FileInfo `json:"file_infos"`
 	NextFileInfoId string               `json:"next_file_info_id"`
 	PrevFileInfoId string               `json:"prev_file_info_id"`
+	// ContentMatches holds, for the files of search results whose content matched, the
+	// part of the content that matched.
+	ContentMatches map[string]*FileContentMatch `json:"content_matches,omitempty"`
+	// ArchivedChannelIds are the ids of the archived channels the files of search results were
+	// posted in, when the search included the archived channels.
+	ArchivedChannelIds []string `json:"archived_channel_ids,omitempty"`
 }
 
 func NewFileInfoList() *FileInfoList {
@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import "strings"
+
+const DataResidencyMaxLength = 32
+
+// IsValidDataResidency reports whether the string can name a data residency, such as "eu".
+func IsValidDataResidency(residency string) bool {
+	if residency == "" || len(residency) > DataResidencyMaxLength {
+		return false
+	}
+	return strings.TrimLeft(residency, "abcdefghijklmnopqrstuvwxyz0123456789_-") == ""
+}
+
+// FileResidencyMigration describes the move of the files of a team to the storage of another data
+// residency, an empty residency being the default file storage.
+type FileResidencyMigration struct {
+	JobId         string `json:"job_id"`
+	TeamId        string `json:"team_id"`
+	FromResidency string `json:"from_residency"`
+	ToResidency   string `json:"to_residency"`
+	Status        string `json:"status"`
+}
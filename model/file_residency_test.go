@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidDataResidency(t *testing.T) {
+	for residency, valid := range map[string]bool{
+		"eu":                    true,
+		"us-east_1":             true,
+		"":                      false,
+		"EU":                    false,
+		"eu/west":               false,
+		"eu west":               false,
+		strings.Repeat("a", 32): true,
+		strings.Repeat("a", 33): false,
+	} {
+		assert.Equal(t, valid, IsValidDataResidency(residency), residency)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotentReplayed  = "Idempotent-Replayed"
+	IdempotencyKeyMaxLength   = 255
+	IdempotencyKeyMaxResponse = 1024 * 1024
+)
+
+// IdempotencyKey records a request made with an Idempotency-Key header, so that retries of
+// the request with the same key replay its response instead of being processed again. A
+// record without a status code is a request still being processed.
+type IdempotencyKey struct {
+	Key         string `db:"IdempotencyKey" json:"key"`
+	UserId      string `json:"user_id"`
+	RequestHash string `json:"request_hash"`
+	StatusCode  int    `json:"status_code"`
+	Response    string `json:"response"`
+	CreateAt    int64  `json:"create_at"`
+}
+
+func (k *IdempotencyKey) PreSave() {
+	if k.CreateAt == 0 {
+		k.CreateAt = GetMillis()
+	}
+}
+
+func (k *IdempotencyKey) IsValid() *AppError {
+	if k.Key == "" || len(k.Key) > IdempotencyKeyMaxLength {
+		return NewAppError("IdempotencyKey.IsValid", "model.idempotency_key.is_valid.key.app_error", map[string]interface{}{"Max": IdempotencyKeyMaxLength}, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(k.UserId) {
+		return NewAppError("IdempotencyKey.IsValid", "model.idempotency_key.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if k.RequestHash == "" {
+		return NewAppError("IdempotencyKey.IsValid", "model.idempotency_key.is_valid.request_hash.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(k.Response) > IdempotencyKeyMaxResponse {
+		return NewAppError("IdempotencyKey.IsValid", "model.idempotency_key.is_valid.response.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if k.CreateAt == 0 {
+		return NewAppError("IdempotencyKey.IsValid", "model.idempotency_key.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsCompleted tells whether the response of the request was recorded.
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.StatusCode != 0
+}
+
+// HashIdempotentRequest identifies a request, so that a key reused for a different request
+// can be told apart from a retry.
+func HashIdempotentRequest(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKeyIsValid(t *testing.T) {
+	key := &IdempotencyKey{
+		Key:         NewId(),
+		UserId:      NewId(),
+		RequestHash: HashIdempotentRequest(http.MethodPost, "/api/v4/teams", nil),
+	}
+	key.PreSave()
+	require.Nil(t, key.IsValid())
+
+	key.UserId = ""
+	require.NotNil(t, key.IsValid())
+
+	key.UserId = "invalid"
+	require.NotNil(t, key.IsValid())
+	key.UserId = NewId()
+
+	key.Key = strings.Repeat("k", IdempotencyKeyMaxLength+1)
+	require.NotNil(t, key.IsValid())
+	key.Key = ""
+	require.NotNil(t, key.IsValid())
+	key.Key = NewId()
+
+	key.Response = strings.Repeat("r", IdempotencyKeyMaxResponse+1)
+	require.NotNil(t, key.IsValid())
+}
+
+func TestHashIdempotentRequest(t *testing.T) {
+	hash := HashIdempotentRequest(http.MethodPost, "/api/v4/teams", []byte(`{"name":"a"}`))
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashIdempotentRequest(http.MethodPost, "/api/v4/teams", []byte(`{"name":"a"}`)))
+	assert.NotEqual(t, hash, HashIdempotentRequest(http.MethodPost, "/api/v4/teams", []byte(`{"name":"b"}`)))
+	assert.NotEqual(t, hash, HashIdempotentRequest(http.MethodPost, "/api/v4/users", []byte(`{"name":"a"}`)))
+}
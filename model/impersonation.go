@@ -0,0 +1,135 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	ImpersonationStatusPending  = "pending"
+	ImpersonationStatusDeclined = "declined"
+	ImpersonationStatusApproved = "approved"
+	ImpersonationStatusActive   = "active"
+	ImpersonationStatusEnded    = "ended"
+	ImpersonationStatusExpired  = "expired"
+
+	ImpersonationReasonMaxRunes = 1024
+
+	SessionPropImpersonationId = "impersonation_id"
+	SessionPropImpersonatorId  = "impersonator_id"
+	SessionTypeImpersonation   = "Impersonation"
+)
+
+// Impersonation is a time-boxed support session a system admin opens to act as a user. When
+// the user's consent is required, the impersonation waits for it before the admin can start
+// the session. The user can review every impersonation of their account.
+type Impersonation struct {
+	Id              string `json:"id"`
+	ImpersonatorId  string `json:"impersonator_id"`
+	UserId          string `json:"user_id"`
+	Reason          string `json:"reason"`
+	DurationMinutes int    `json:"duration_minutes"`
+	RequireConsent  bool   `json:"require_consent"`
+	Status          string `json:"status"`
+	SessionId       string `json:"session_id"`
+	CreateAt        int64  `json:"create_at"`
+	UpdateAt        int64  `json:"update_at"`
+	StartAt         int64  `json:"start_at"`
+	ExpiresAt       int64  `json:"expires_at"`
+	EndAt           int64  `json:"end_at"`
+
+	// Token is the token of the session, only ever returned to the admin starting it.
+	Token string `json:"token,omitempty" db:"-"`
+}
+
+func (i *Impersonation) PreSave() {
+	if i.Id == "" {
+		i.Id = NewId()
+	}
+
+	i.Status = ImpersonationStatusPending
+	if !i.RequireConsent {
+		i.Status = ImpersonationStatusApproved
+	}
+	i.SessionId = ""
+	i.CreateAt = GetMillis()
+	i.UpdateAt = i.CreateAt
+	i.StartAt = 0
+	i.ExpiresAt = 0
+	i.EndAt = 0
+}
+
+func (i *Impersonation) PreUpdate() {
+	i.UpdateAt = GetMillis()
+}
+
+func (i *Impersonation) IsValid() *AppError {
+	if !IsValidId(i.Id) {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.ImpersonatorId) {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.impersonator_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.UserId) || i.UserId == i.ImpersonatorId {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.user_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.Reason == "" || utf8.RuneCountInString(i.Reason) > ImpersonationReasonMaxRunes {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.reason.app_error", map[string]interface{}{"MaxLength": ImpersonationReasonMaxRunes}, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.DurationMinutes < 1 {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.duration.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidImpersonationStatus(i.Status) {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.status.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.SessionId != "" && !IsValidId(i.SessionId) {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.session_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.CreateAt == 0 {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.create_at.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.UpdateAt == 0 {
+		return NewAppError("Impersonation.IsValid", "model.impersonation.is_valid.update_at.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsExpired tells whether the session of an active impersonation ran out of time.
+func (i *Impersonation) IsExpired() bool {
+	return i.Status == ImpersonationStatusActive && i.ExpiresAt <= GetMillis()
+}
+
+// Sanitize removes the token of the session, and reports an active impersonation whose time is
+// up as expired.
+func (i *Impersonation) Sanitize() {
+	i.Token = ""
+	if i.IsExpired() {
+		i.Status = ImpersonationStatusExpired
+	}
+}
+
+func IsValidImpersonationStatus(status string) bool {
+	switch status {
+	case ImpersonationStatusPending, ImpersonationStatusDeclined, ImpersonationStatusApproved,
+		ImpersonationStatusActive, ImpersonationStatusEnded, ImpersonationStatusExpired:
+		return true
+	}
+	return false
+}
+
+// ImpersonationConsent is the answer of a user to a request to impersonate them.
+type ImpersonationConsent struct {
+	Approve bool `json:"approve"`
+}
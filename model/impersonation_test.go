@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationPreSave(t *testing.T) {
+	impersonation := &Impersonation{
+		ImpersonatorId:  NewId(),
+		UserId:          NewId(),
+		Reason:          "Reproduce a reported issue.",
+		DurationMinutes: 30,
+		RequireConsent:  true,
+		Status:          ImpersonationStatusActive,
+		SessionId:       NewId(),
+		ExpiresAt:       GetMillis(),
+	}
+	impersonation.PreSave()
+
+	assert.True(t, IsValidId(impersonation.Id))
+	assert.Equal(t, ImpersonationStatusPending, impersonation.Status)
+	assert.Empty(t, impersonation.SessionId)
+	assert.Zero(t, impersonation.ExpiresAt)
+	assert.NotZero(t, impersonation.CreateAt)
+	assert.Equal(t, impersonation.CreateAt, impersonation.UpdateAt)
+
+	impersonation.Id = ""
+	impersonation.RequireConsent = false
+	impersonation.PreSave()
+	assert.Equal(t, ImpersonationStatusApproved, impersonation.Status)
+}
+
+func TestImpersonationIsValid(t *testing.T) {
+	impersonation := &Impersonation{
+		ImpersonatorId:  NewId(),
+		UserId:          NewId(),
+		Reason:          "Reproduce a reported issue.",
+		DurationMinutes: 30,
+	}
+	impersonation.PreSave()
+	require.Nil(t, impersonation.IsValid())
+
+	userID := impersonation.UserId
+	impersonation.UserId = impersonation.ImpersonatorId
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.UserId = userID
+
+	impersonation.Reason = ""
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.Reason = strings.Repeat("a", ImpersonationReasonMaxRunes+1)
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.Reason = "Reproduce a reported issue."
+
+	impersonation.DurationMinutes = 0
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.DurationMinutes = 30
+
+	impersonation.Status = "unknown"
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.Status = ImpersonationStatusActive
+
+	impersonation.SessionId = "invalid"
+	require.NotNil(t, impersonation.IsValid())
+	impersonation.SessionId = NewId()
+
+	require.Nil(t, impersonation.IsValid())
+}
+
+func TestImpersonationSanitize(t *testing.T) {
+	impersonation := &Impersonation{
+		Status:    ImpersonationStatusActive,
+		ExpiresAt: GetMillis() + 60*1000,
+		Token:     NewId(),
+	}
+	impersonation.Sanitize()
+	assert.Empty(t, impersonation.Token)
+	assert.Equal(t, ImpersonationStatusActive, impersonation.Status)
+
+	impersonation.ExpiresAt = GetMillis() - 1
+	assert.True(t, impersonation.IsExpired())
+	impersonation.Sanitize()
+	assert.Equal(t, ImpersonationStatusExpired, impersonation.Status)
+	assert.False(t, impersonation.IsExpired())
+}
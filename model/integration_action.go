@@ -212,6 +212,14 @@ type Dialog struct {
 	SubmitLabel      string          `json:"submit_label"`
 	NotifyOnCancel   bool            `json:"notify_on_cancel"`
 	State            string          `json:"state"`
+
+	// Steps turns the dialog into a wizard, its steps being shown one after the other and
+	// submitted together after the last one. Elements must be left empty when set.
+	Steps []DialogStep `json:"steps,omitempty"`
+
+	// ValidateSteps has the dialog validated by the integration after every step, through a
+	// "dialog_validation" request, before the next step is shown.
+	ValidateSteps bool `json:"validate_steps,omitempty"`
 }
 
 type DialogElement struct {
@@ -227,6 +235,9 @@ type DialogElement struct {
 	MaxLength   int                  `json:"max_length"`
 	DataSource  string               `json:"data_source"`
 	Options     []*PostActionOptions `json:"options"`
+
+	// ShowIf shows the element only when another element has one of the given values.
+	ShowIf *DialogCondition `json:"show_if,omitempty"`
 }
 
 type OpenDialogRequest struct {
@@ -245,6 +256,9 @@ type SubmitDialogRequest struct {
 	TeamId     string                 `json:"team_id"`
 	Submission map[string]interface{} `json:"submission"`
 	Cancelled  bool                   `json:"cancelled"`
+
+	// Step is the index of the step of a multi-step dialog being validated.
+	Step int `json:"step,omitempty"`
 }
 
 type SubmitDialogResponse struct {
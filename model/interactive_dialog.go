@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	DialogElementTypeText     = "text"
+	DialogElementTypeTextarea = "textarea"
+	DialogElementTypeSelect   = "select"
+	DialogElementTypeBool     = "bool"
+	DialogElementTypeRadio    = "radio"
+	DialogElementTypeDate     = "date"
+	DialogElementTypeDateTime = "datetime"
+	DialogElementTypeUser     = "user"
+	DialogElementTypeChannel  = "channel"
+
+	// DialogDateFormat and DialogDateTimeFormat are the formats of the values of the date and
+	// datetime elements.
+	DialogDateFormat     = "2006-01-02"
+	DialogDateTimeFormat = time.RFC3339
+
+	DialogMaxSteps = 10
+
+	SubmitDialogRequestTypeSubmission = "dialog_submission"
+	SubmitDialogRequestTypeValidation = "dialog_validation"
+)
+
+// DialogStep is a step of a multi-step dialog.
+type DialogStep struct {
+	Title            string          `json:"title"`
+	IntroductionText string          `json:"introduction_text"`
+	Elements         []DialogElement `json:"elements"`
+	SubmitLabel      string          `json:"submit_label"`
+}
+
+// DialogCondition makes an element conditional on the value of another element, found earlier
+// in the dialog.
+type DialogCondition struct {
+	Element string   `json:"element"`
+	Values  []string `json:"values"`
+}
+
+// GetSteps returns the steps of the dialog, a dialog without steps having a single one made of
+// its elements.
+func (d *Dialog) GetSteps() []DialogStep {
+	if len(d.Steps) > 0 {
+		return d.Steps
+	}
+
+	return []DialogStep{{
+		Title:            d.Title,
+		IntroductionText: d.IntroductionText,
+		Elements:         d.Elements,
+		SubmitLabel:      d.SubmitLabel,
+	}}
+}
+
+// IsValid checks the steps, conditions and new element types of the dialog. Dialogs using none
+// of them are left alone, so that they keep working as they always did.
+func (d *Dialog) IsValid() *AppError {
+	if len(d.Steps) > 0 && len(d.Elements) > 0 {
+		return NewAppError("Dialog.IsValid", "model.dialog.is_valid.steps.app_error", nil, "callback_id="+d.CallbackId, http.StatusBadRequest)
+	}
+
+	if len(d.Steps) > DialogMaxSteps {
+		return NewAppError("Dialog.IsValid", "model.dialog.is_valid.max_steps.app_error", map[string]interface{}{"Max": DialogMaxSteps}, "callback_id="+d.CallbackId, http.StatusBadRequest)
+	}
+
+	seen := make(map[string]bool)
+	for _, step := range d.GetSteps() {
+		for _, element := range step.Elements {
+			if len(d.Steps) > 0 && seen[element.Name] {
+				return NewAppError("Dialog.IsValid", "model.dialog.is_valid.duplicate_element.app_error", map[string]interface{}{"Name": element.Name}, "callback_id="+d.CallbackId, http.StatusBadRequest)
+			}
+
+			if element.ShowIf != nil && (!seen[element.ShowIf.Element] || len(element.ShowIf.Values) == 0) {
+				return NewAppError("Dialog.IsValid", "model.dialog.is_valid.show_if.app_error", map[string]interface{}{"Name": element.Name}, "callback_id="+d.CallbackId, http.StatusBadRequest)
+			}
+
+			if element.Default != "" && element.hasTypedValue() && element.validateValue(element.Default) != "" {
+				return NewAppError("Dialog.IsValid", "model.dialog.is_valid.default.app_error", map[string]interface{}{"Name": element.Name}, "callback_id="+d.CallbackId, http.StatusBadRequest)
+			}
+
+			seen[element.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// ValidateStep checks the values of the elements of the step in the submission, returning the
+// errors by element name. Hidden elements aren't checked.
+func (d *Dialog) ValidateStep(step int, submission map[string]interface{}) map[string]string {
+	errors := make(map[string]string)
+
+	steps := d.GetSteps()
+	if step < 0 || step >= len(steps) {
+		return errors
+	}
+
+	for _, element := range steps[step].Elements {
+		if !element.IsVisible(submission) {
+			continue
+		}
+
+		value, ok := submission[element.Name]
+		if !ok || value == nil || value == "" {
+			if !element.Optional {
+				errors[element.Name] = "This field is required."
+			}
+			continue
+		}
+
+		if message := element.validateValue(value); message != "" {
+			errors[element.Name] = message
+		}
+	}
+
+	return errors
+}
+
+// ValidateSubmission checks the values of the elements of every step in the submission.
+func (d *Dialog) ValidateSubmission(submission map[string]interface{}) map[string]string {
+	errors := make(map[string]string)
+	for step := range d.GetSteps() {
+		for name, message := range d.ValidateStep(step, submission) {
+			errors[name] = message
+		}
+	}
+	return errors
+}
+
+// IsVisible tells whether the element is shown given the values submitted so far.
+func (e *DialogElement) IsVisible(submission map[string]interface{}) bool {
+	if e.ShowIf == nil {
+		return true
+	}
+
+	value, ok := submission[e.ShowIf.Element]
+	if !ok || value == nil {
+		return false
+	}
+
+	for _, expected := range e.ShowIf.Values {
+		if fmt.Sprint(value) == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTypedValue tells whether the values of the element must be of a given format.
+func (e *DialogElement) hasTypedValue() bool {
+	switch e.Type {
+	case DialogElementTypeDate, DialogElementTypeDateTime, DialogElementTypeUser, DialogElementTypeChannel:
+		return true
+	}
+	return false
+}
+
+// validateValue returns why the value doesn't suit the element, if it doesn't.
+func (e *DialogElement) validateValue(value interface{}) string {
+	switch e.Type {
+	case DialogElementTypeText, DialogElementTypeTextarea:
+		// Numbers are submitted as such, their length isn't checked.
+		text, ok := value.(string)
+		if !ok {
+			return ""
+		}
+		if e.MinLength > 0 && utf8.RuneCountInString(text) < e.MinLength {
+			return fmt.Sprintf("This field must be at least %d characters long.", e.MinLength)
+		}
+		if e.MaxLength > 0 && utf8.RuneCountInString(text) > e.MaxLength {
+			return fmt.Sprintf("This field must be at most %d characters long.", e.MaxLength)
+		}
+	case DialogElementTypeDate:
+		if date, ok := value.(string); !ok || !isValidDialogTime(DialogDateFormat, date) {
+			return "This field must be a date formatted as YYYY-MM-DD."
+		}
+	case DialogElementTypeDateTime:
+		if dateTime, ok := value.(string); !ok || !isValidDialogTime(DialogDateTimeFormat, dateTime) {
+			return "This field must be a date and time formatted as RFC 3339."
+		}
+	case DialogElementTypeUser:
+		if id, ok := value.(string); !ok || !IsValidId(id) {
+			return "This field must be a user."
+		}
+	case DialogElementTypeChannel:
+		if id, ok := value.(string); !ok || !IsValidId(id) {
+			return "This field must be a channel."
+		}
+	}
+
+	return ""
+}
+
+func isValidDialogTime(layout, value string) bool {
+	_, err := time.Parse(layout, value)
+	return err == nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialogIsValid(t *testing.T) {
+	legacy := &Dialog{
+		Elements: []DialogElement{
+			{Name: "a", Type: DialogElementTypeText, Default: "x", MinLength: 5},
+			{Name: "a", Type: DialogElementTypeText},
+		},
+	}
+	require.Nil(t, legacy.IsValid(), "dialogs without the new features are left alone")
+
+	dialog := &Dialog{
+		Steps: []DialogStep{
+			{Elements: []DialogElement{{Name: "kind", Type: DialogElementTypeRadio}}},
+			{Elements: []DialogElement{
+				{Name: "due", Type: DialogElementTypeDateTime, Default: "2022-05-01T10:00:00Z"},
+				{Name: "channel", Type: DialogElementTypeChannel, ShowIf: &DialogCondition{Element: "kind", Values: []string{"post"}}},
+			}},
+		},
+	}
+	require.Nil(t, dialog.IsValid())
+	assert.Len(t, dialog.GetSteps(), 2)
+
+	dialog.Elements = []DialogElement{{Name: "extra"}}
+	require.NotNil(t, dialog.IsValid())
+	dialog.Elements = nil
+
+	dialog.Steps[1].Elements[0].Default = "tomorrow"
+	require.NotNil(t, dialog.IsValid())
+	dialog.Steps[1].Elements[0].Default = ""
+
+	dialog.Steps[1].Elements[1].ShowIf.Element = "channel"
+	require.NotNil(t, dialog.IsValid(), "conditions refer to earlier elements")
+	dialog.Steps[1].Elements[1].ShowIf.Element = "kind"
+
+	dialog.Steps[1].Elements[1].Name = "kind"
+	require.NotNil(t, dialog.IsValid())
+	dialog.Steps[1].Elements[1].Name = "channel"
+
+	dialog.Steps = make([]DialogStep, DialogMaxSteps+1)
+	require.NotNil(t, dialog.IsValid())
+}
+
+func TestDialogValidateSubmission(t *testing.T) {
+	dialog := &Dialog{
+		Steps: []DialogStep{
+			{Elements: []DialogElement{
+				{Name: "kind", Type: DialogElementTypeRadio},
+				{Name: "title", Type: DialogElementTypeText, MinLength: 3},
+				{Name: "count", Type: DialogElementTypeText, SubType: "number", Optional: true},
+			}},
+			{Elements: []DialogElement{
+				{Name: "due", Type: DialogElementTypeDate},
+				{Name: "assignee", Type: DialogElementTypeUser, ShowIf: &DialogCondition{Element: "kind", Values: []string{"task"}}},
+			}},
+		},
+	}
+
+	errors := dialog.ValidateStep(0, map[string]interface{}{"kind": "note", "title": "ab", "count": 2.0})
+	assert.Len(t, errors, 1)
+	assert.Contains(t, errors, "title")
+
+	errors = dialog.ValidateStep(1, map[string]interface{}{"kind": "note", "due": "2022-05-01"})
+	assert.Empty(t, errors, "hidden elements aren't required")
+
+	errors = dialog.ValidateStep(1, map[string]interface{}{"kind": "task", "due": "05/01/2022"})
+	assert.Contains(t, errors, "due")
+	assert.Contains(t, errors, "assignee")
+
+	errors = dialog.ValidateSubmission(map[string]interface{}{"kind": "task", "title": "abc", "due": "2022-05-01", "assignee": NewId()})
+	assert.Empty(t, errors)
+
+	assert.Empty(t, dialog.ValidateStep(5, nil))
+}
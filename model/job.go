@@ -27,6 +27,28 @@ const (
 	JobTypeCloud                        = "cloud"
 	JobTypeResendInvitationEmail        = "resend_invitation_email"
 	JobTypeExtractContent               = "extract_content"
+	JobTypeSchemeAssignment             = "scheme_assignment"
+	JobTypeChannelDigest                = "channel_digest"
+	JobTypeDirectChannelRetention       = "direct_channel_retention"
+	JobTypeDataRetentionPreview         = "data_retention_preview"
+	JobTypeTeamStatsRollup              = "team_stats_rollup"
+	JobTypeUserMerge                    = "user_merge"
+	JobTypeTeamDeletion                 = "team_deletion"
+	JobTypeChannelAutoArchive           = "channel_auto_archive"
+	JobTypeBotTokenRotation             = "bot_token_rotation"
+	JobTypeSlackImport                  = "slack_import"
+	JobTypeScheduledChannelMessages     = "scheduled_channel_messages"
+	JobTypeEventWebhookDeliveries       = "event_webhook_deliveries"
+	JobTypeAlertRules                   = "alert_rules"
+	JobTypeChannelLanguageStatsRollup   = "channel_language_stats_rollup"
+	JobTypeChannelMemberBulkAdd         = "channel_member_bulk_add"
+	JobTypeFileResidencyMigration       = "file_residency_migration"
+	JobTypeLicenseUsageRollup           = "license_usage_rollup"
+	JobTypeRESTProxyBridge              = "rest_proxy_bridge"
+	JobTypeChannelFeeds                 = "channel_feeds"
+	JobTypeOutstandingMentions          = "outstanding_mentions"
+	JobTypeFileDeduplication            = "file_deduplication"
+	JobTypeTeamGroupRoleSync            = "team_group_role_sync"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -55,6 +77,28 @@ var AllJobTypes = [...]string{
 	JobTypeExportDelete,
 	JobTypeCloud,
 	JobTypeExtractContent,
+	JobTypeSchemeAssignment,
+	JobTypeChannelDigest,
+	JobTypeDirectChannelRetention,
+	JobTypeDataRetentionPreview,
+	JobTypeTeamStatsRollup,
+	JobTypeUserMerge,
+	JobTypeTeamDeletion,
+	JobTypeChannelAutoArchive,
+	JobTypeBotTokenRotation,
+	JobTypeSlackImport,
+	JobTypeScheduledChannelMessages,
+	JobTypeEventWebhookDeliveries,
+	JobTypeAlertRules,
+	JobTypeChannelLanguageStatsRollup,
+	JobTypeChannelMemberBulkAdd,
+	JobTypeFileResidencyMigration,
+	JobTypeLicenseUsageRollup,
+	JobTypeRESTProxyBridge,
+	JobTypeChannelFeeds,
+	JobTypeOutstandingMentions,
+	JobTypeFileDeduplication,
+	JobTypeTeamGroupRoleSync,
 }
 
 type Job struct {
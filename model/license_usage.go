@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	LicenseUsageDefaultMonths = 12
+	LicenseUsageMaxMonths     = 36
+)
+
+// LicenseUsage are the figures of a calendar month, starting at Month in UTC, that matter for
+// license compliance. They are computed by the nightly license usage rollup job. The monthly
+// active users are the people, bots excluded, seen during the month and the posts are the ones
+// created during the month. The storage and integration counts are the totals at the last rollup
+// of the month.
+type LicenseUsage struct {
+	Month              int64 `json:"month"`
+	MonthlyActiveUsers int64 `json:"monthly_active_users"`
+	PostCount          int64 `json:"post_count"`
+	StorageBytes       int64 `json:"storage_bytes"`
+	IncomingWebhooks   int64 `json:"incoming_webhooks"`
+	OutgoingWebhooks   int64 `json:"outgoing_webhooks"`
+	Commands           int64 `json:"commands"`
+	Bots               int64 `json:"bots"`
+	OAuthApps          int64 `json:"oauth_apps"`
+	UpdateAt           int64 `json:"update_at"`
+}
+
+type LicenseUsageList []*LicenseUsage
+
+// LicenseUsageMonth returns the start of the UTC month containing the given time, in
+// milliseconds.
+func LicenseUsageMonth(t time.Time) int64 {
+	t = t.UTC()
+	return GetMillisForTime(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC))
+}
+
+// AddLicenseUsageMonths returns the start of the UTC month the given number of months away from
+// the month starting at month.
+func AddLicenseUsageMonths(month int64, months int) int64 {
+	return LicenseUsageMonth(GetTimeForMillis(month).AddDate(0, months, 0))
+}
+
+// WriteCSV writes the usage as CSV, one month per row after a header row. The months are
+// written as YYYY-MM.
+func (l LicenseUsageList) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"month",
+		"monthly_active_users",
+		"post_count",
+		"storage_bytes",
+		"incoming_webhooks",
+		"outgoing_webhooks",
+		"commands",
+		"bots",
+		"oauth_apps",
+	}); err != nil {
+		return err
+	}
+
+	for _, usage := range l {
+		if err := cw.Write([]string{
+			GetTimeForMillis(usage.Month).UTC().Format("2006-01"),
+			strconv.FormatInt(usage.MonthlyActiveUsers, 10),
+			strconv.FormatInt(usage.PostCount, 10),
+			strconv.FormatInt(usage.StorageBytes, 10),
+			strconv.FormatInt(usage.IncomingWebhooks, 10),
+			strconv.FormatInt(usage.OutgoingWebhooks, 10),
+			strconv.FormatInt(usage.Commands, 10),
+			strconv.FormatInt(usage.Bots, 10),
+			strconv.FormatInt(usage.OAuthApps, 10),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
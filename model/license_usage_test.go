@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicenseUsageMonth(t *testing.T) {
+	month := LicenseUsageMonth(time.Date(2021, time.December, 31, 23, 59, 0, 0, time.UTC))
+	assert.Equal(t, GetMillisForTime(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)), month)
+
+	assert.Equal(t, GetMillisForTime(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)), AddLicenseUsageMonths(month, 1))
+	assert.Equal(t, GetMillisForTime(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)), AddLicenseUsageMonths(month, -12))
+}
+
+func TestLicenseUsageListWriteCSV(t *testing.T) {
+	usage := LicenseUsageList{
+		{
+			Month:              GetMillisForTime(time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)),
+			MonthlyActiveUsers: 10,
+			PostCount:          200,
+			StorageBytes:       3000,
+			IncomingWebhooks:   1,
+			OutgoingWebhooks:   2,
+			Commands:           3,
+			Bots:               4,
+			OAuthApps:          5,
+		},
+		{
+			Month:              GetMillisForTime(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+			MonthlyActiveUsers: 11,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, usage.WriteCSV(&buf))
+	assert.Equal(t, "month,monthly_active_users,post_count,storage_bytes,incoming_webhooks,outgoing_webhooks,commands,bots,oauth_apps\n"+
+		"2021-11,10,200,3000,1,2,3,4,5\n"+
+		"2021-12,11,0,0,0,0,0,0,0\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, LicenseUsageList{}.WriteCSV(&buf))
+	assert.Equal(t, "month,monthly_active_users,post_count,storage_bytes,incoming_webhooks,outgoing_webhooks,commands,bots,oauth_apps\n", buf.String())
+}
@@ -38,4 +38,11 @@ const (
 	MigrationKeyAddPlaybooksPermissions                = "playbooks_permissions"
 	MigrationKeyAddCustomUserGroupsPermissions         = "custom_groups_permissions"
 	MigrationKeyAddPlayboosksManageRolesPermissions    = "playbooks_manage_roles"
+	MigrationKeyAddViewTeamExtendedStatsPermission     = "view_team_extended_stats_permission"
+	MigrationKeyAddManageChannelCommandsPermission     = "manage_channel_commands_permission"
+	MigrationKeyAddChannelScheduledMessagesPermission  = "manage_channel_scheduled_messages_permission"
+	MigrationKeyAddRestorePostsPermission              = "restore_posts_permission"
+	MigrationKeyAddManagePostReportsPermission         = "manage_post_reports_permission"
+	MigrationKeyAddSearchArchivedChannelsPermission    = "search_archived_channels_permission"
+	MigrationKeyAddDownloadFilePermission              = "download_file_permission"
 )
@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MutedKeywordsMaxCount = 50
+	MutedKeywordMaxRunes  = 32
+)
+
+// MutedKeywords is the list of keywords a user doesn't want to be notified about. It is
+// stored as a JSON array in the muted_keywords notification preference of the user.
+type MutedKeywords []string
+
+func (k MutedKeywords) IsValid() *AppError {
+	if len(k) > MutedKeywordsMaxCount {
+		return NewAppError("MutedKeywords.IsValid", "model.muted_keywords.is_valid.count.app_error", map[string]interface{}{"Max": MutedKeywordsMaxCount}, "", http.StatusBadRequest)
+	}
+
+	for _, keyword := range k {
+		if strings.TrimSpace(keyword) == "" || utf8.RuneCountInString(keyword) > MutedKeywordMaxRunes {
+			return NewAppError("MutedKeywords.IsValid", "model.muted_keywords.is_valid.keyword.app_error", map[string]interface{}{"Max": MutedKeywordMaxRunes}, "keyword="+keyword, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// Normalize trims and lowercases the keywords, dropping the empty and duplicate ones.
+func (k MutedKeywords) Normalize() MutedKeywords {
+	normalized := make(MutedKeywords, 0, len(k))
+	seen := make(map[string]bool, len(k))
+	for _, keyword := range k {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
+
+// Match returns the keywords contained in the message. Keywords match case insensitively
+// and only on word boundaries, so that muting "art" doesn't mute "party".
+func (k MutedKeywords) Match(message string) []string {
+	if len(k) == 0 || message == "" {
+		return nil
+	}
+
+	message = strings.ToLower(message)
+
+	var matched []string
+	for _, keyword := range k {
+		if containsWord(message, strings.ToLower(keyword)) {
+			matched = append(matched, keyword)
+		}
+	}
+	return matched
+}
+
+func containsWord(text, word string) bool {
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(word)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutedKeywordsIsValid(t *testing.T) {
+	require.Nil(t, MutedKeywords{}.IsValid())
+	require.Nil(t, MutedKeywords{"spoiler", "release party"}.IsValid())
+
+	require.NotNil(t, MutedKeywords{" "}.IsValid())
+	require.NotNil(t, MutedKeywords{strings.Repeat("a", MutedKeywordMaxRunes+1)}.IsValid())
+
+	tooMany := make(MutedKeywords, MutedKeywordsMaxCount+1)
+	for i := range tooMany {
+		tooMany[i] = NewId()
+	}
+	require.NotNil(t, tooMany.IsValid())
+}
+
+func TestMutedKeywordsNormalize(t *testing.T) {
+	assert.Equal(t, MutedKeywords{"spoiler", "release party"}, MutedKeywords{" Spoiler", "", "release party ", "SPOILER"}.Normalize())
+	assert.Equal(t, MutedKeywords{}, MutedKeywords{" "}.Normalize())
+}
+
+func TestMutedKeywordsMatch(t *testing.T) {
+	keywords := MutedKeywords{"art", "release party", "c++"}
+
+	for name, tc := range map[string]struct {
+		Message  string
+		Expected []string
+	}{
+		"no match":            {"Nothing to see here", nil},
+		"word":                {"Have you seen the new art?", []string{"art"}},
+		"case insensitive":    {"ART is great", []string{"art"}},
+		"inside another word": {"See you at the party", nil},
+		"repeated inside":     {"party art", []string{"art"}},
+		"phrase":              {"Who's coming to the Release Party tonight", []string{"release party"}},
+		"punctuation":         {"learning c++!", []string{"c++"}},
+		"multiple":            {"art at the release party", []string{"art", "release party"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, keywords.Match(tc.Message))
+		})
+	}
+
+	assert.Nil(t, MutedKeywords{}.Match("art"))
+}
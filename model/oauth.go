@@ -29,6 +29,11 @@ type OAuthApp struct {
 	CallbackUrls StringArray `json:"callback_urls"`
 	Homepage     string      `json:"homepage"`
 	IsTrusted    bool        `json:"is_trusted"`
+
+	// RateLimitPerSec and RateLimitMaxBurst limit the calls made with the tokens of the app,
+	// all users together. Zero leaves the app limited by the rate limit settings alone.
+	RateLimitPerSec   int `json:"rate_limit_per_sec"`
+	RateLimitMaxBurst int `json:"rate_limit_max_burst"`
 }
 
 // IsValid validates the app and returns an error if it isn't configured
@@ -83,6 +88,10 @@ func (a *OAuthApp) IsValid() *AppError {
 		}
 	}
 
+	if a.RateLimitPerSec < 0 || a.RateLimitMaxBurst < 0 {
+		return NewAppError("OAuthApp.IsValid", "model.oauth.is_valid.rate_limit.app_error", nil, "app_id="+a.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -111,6 +120,11 @@ func (a *OAuthApp) Etag() string {
 	return Etag(a.Id, a.UpdateAt)
 }
 
+// HasRateLimit tells whether the calls made with the tokens of the app are limited.
+func (a *OAuthApp) HasRateLimit() bool {
+	return a.RateLimitPerSec > 0
+}
+
 // Remove any private data from the app object
 func (a *OAuthApp) Sanitize() {
 	a.ClientSecret = ""
@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// OAuthAppUsage holds the number of calls made with the tokens of an OAuth app within one
+// bucket of APIUsageBucketSize, and how many of them were rate limited.
+type OAuthAppUsage struct {
+	AppId        string `json:"app_id"`
+	BucketAt     int64  `json:"bucket_at"`
+	Count        int64  `json:"count"`
+	LimitedCount int64  `json:"limited_count"`
+}
+
+// OAuthAppUsageSummary aggregates the calls made with the tokens of an OAuth app, along with its
+// rate limit.
+type OAuthAppUsageSummary struct {
+	AppId             string `json:"app_id"`
+	Name              string `json:"name"`
+	Count             int64  `json:"count"`
+	LimitedCount      int64  `json:"limited_count"`
+	RateLimitPerSec   int    `json:"rate_limit_per_sec"`
+	RateLimitMaxBurst int    `json:"rate_limit_max_burst"`
+}
+
+func (u *OAuthAppUsage) IsValid() *AppError {
+	if !IsValidId(u.AppId) {
+		return NewAppError("OAuthAppUsage.IsValid", "model.oauth_app_usage.is_valid.app_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.BucketAt <= 0 || u.BucketAt != APIUsageBucket(u.BucketAt) {
+		return NewAppError("OAuthAppUsage.IsValid", "model.oauth_app_usage.is_valid.bucket_at.app_error", nil, "app_id="+u.AppId, http.StatusBadRequest)
+	}
+
+	if u.Count <= 0 || u.LimitedCount < 0 || u.LimitedCount > u.Count {
+		return NewAppError("OAuthAppUsage.IsValid", "model.oauth_app_usage.is_valid.count.app_error", nil, "app_id="+u.AppId, http.StatusBadRequest)
+	}
+
+	return nil
+}
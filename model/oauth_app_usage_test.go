@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthAppUsageIsValid(t *testing.T) {
+	u := OAuthAppUsage{}
+
+	err := u.IsValid()
+	require.False(t, err == nil || err.Id != "model.oauth_app_usage.is_valid.app_id.app_error")
+
+	u.AppId = NewId()
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.oauth_app_usage.is_valid.bucket_at.app_error")
+
+	u.BucketAt = APIUsageBucket(GetMillis()) + 1
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.oauth_app_usage.is_valid.bucket_at.app_error")
+
+	u.BucketAt = APIUsageBucket(GetMillis())
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.oauth_app_usage.is_valid.count.app_error")
+
+	u.Count = 1
+	u.LimitedCount = 2
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.oauth_app_usage.is_valid.count.app_error")
+
+	u.LimitedCount = 1
+	require.Nil(t, u.IsValid())
+}
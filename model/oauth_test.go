@@ -64,4 +64,15 @@ func TestOAuthAppIsValid(t *testing.T) {
 
 	app.IconURL = "https://nowhere.com/icon_image.png"
 	require.Nil(t, app.IsValid())
+
+	app.RateLimitPerSec = -1
+	require.NotNil(t, app.IsValid())
+
+	app.RateLimitPerSec = 10
+	app.RateLimitMaxBurst = -1
+	require.NotNil(t, app.IsValid())
+
+	app.RateLimitMaxBurst = 20
+	require.Nil(t, app.IsValid())
+	require.True(t, app.HasRateLimit())
 }
@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// OutstandingMention is a mention of a user in a post, tracked until the user answers it by
+// posting in its thread, or in its channel for a mention outside of a thread, or by reacting to
+// the post. ResolvedAt is zero while the mention is outstanding.
+type OutstandingMention struct {
+	UserId      string `json:"user_id"`
+	PostId      string `json:"post_id"`
+	ChannelId   string `json:"channel_id"`
+	TeamId      string `json:"team_id"`
+	RootId      string `json:"root_id"`
+	MentionedAt int64  `json:"mentioned_at"`
+	ResolvedAt  int64  `json:"resolved_at"`
+}
+
+// OutstandingMentionStats sums up how a user answers their mentions: how many are outstanding,
+// since when, and how many were answered, and how fast on average, over the retention of the
+// mentions.
+type OutstandingMentionStats struct {
+	OutstandingCount      int64 `json:"outstanding_count"`
+	OldestMentionedAt     int64 `json:"oldest_mentioned_at"`
+	ResolvedCount         int64 `json:"resolved_count"`
+	AverageResponseMillis int64 `json:"average_response_millis"`
+}
+
+// OutstandingMentionList is a page of the outstanding mentions of a user, the newest first,
+// along with the stats of all of their mentions.
+type OutstandingMentionList struct {
+	Stats    *OutstandingMentionStats `json:"stats"`
+	Mentions []*OutstandingMention    `json:"mentions"`
+}
+
+// OutstandingMentionCount is the number of outstanding mentions of a user.
+type OutstandingMentionCount struct {
+	UserId string `json:"user_id"`
+	Count  int64  `json:"count"`
+}
@@ -34,6 +34,9 @@ var PermissionAssignSystemAdminRole *Permission
 var PermissionManageRoles *Permission
 var PermissionManageTeamRoles *Permission
 var PermissionManageChannelRoles *Permission
+var PermissionManageChannelCommands *Permission
+var PermissionManageChannelScheduledMessages *Permission
+var PermissionRestorePosts *Permission
 var PermissionCreateDirectChannel *Permission
 var PermissionCreateGroupChannel *Permission
 var PermissionManagePublicChannelProperties *Permission
@@ -56,6 +59,7 @@ var PermissionRemoveReaction *Permission
 var PermissionRemoveOthersReactions *Permission
 var PermissionPermanentDeleteUser *Permission
 var PermissionUploadFile *Permission
+var PermissionDownloadFile *Permission
 var PermissionGetPublicLink *Permission
 var PermissionManageWebhooks *Permission
 var PermissionManageOthersWebhooks *Permission
@@ -82,7 +86,10 @@ var PermissionCreateTeam *Permission
 var PermissionManageTeam *Permission
 var PermissionImportTeam *Permission
 var PermissionViewTeam *Permission
+var PermissionViewTeamExtendedStats *Permission
+var PermissionManagePostReports *Permission
 var PermissionListUsersWithoutTeam *Permission
+var PermissionSearchArchivedChannels *Permission
 var PermissionReadJobs *Permission
 var PermissionManageJobs *Permission
 var PermissionCreateUserAccessToken *Permission
@@ -463,6 +470,24 @@ func initializePermissions() {
 		"authentication.permissions.manage_channel_roles.description",
 		PermissionScopeChannel,
 	}
+	PermissionManageChannelCommands = &Permission{
+		"manage_channel_commands",
+		"authentication.permissions.manage_channel_commands.name",
+		"authentication.permissions.manage_channel_commands.description",
+		PermissionScopeChannel,
+	}
+	PermissionManageChannelScheduledMessages = &Permission{
+		"manage_channel_scheduled_messages",
+		"authentication.permissions.manage_channel_scheduled_messages.name",
+		"authentication.permissions.manage_channel_scheduled_messages.description",
+		PermissionScopeChannel,
+	}
+	PermissionRestorePosts = &Permission{
+		"restore_posts",
+		"authentication.permissions.restore_posts.name",
+		"authentication.permissions.restore_posts.description",
+		PermissionScopeChannel,
+	}
 	PermissionManageSystem = &Permission{
 		"manage_system",
 		"authentication.permissions.manage_system.name",
@@ -602,6 +627,12 @@ func initializePermissions() {
 		"authentication.permissions.upload_file.description",
 		PermissionScopeChannel,
 	}
+	PermissionDownloadFile = &Permission{
+		"download_file",
+		"authentication.permissions.download_file.name",
+		"authentication.permissions.download_file.description",
+		PermissionScopeChannel,
+	}
 	PermissionGetPublicLink = &Permission{
 		"get_public_link",
 		"authentication.permissions.get_public_link.name",
@@ -1039,12 +1070,30 @@ func initializePermissions() {
 		"authentication.permissions.view_team.description",
 		PermissionScopeTeam,
 	}
+	PermissionViewTeamExtendedStats = &Permission{
+		"view_team_extended_stats",
+		"authentication.permissions.view_team_extended_stats.name",
+		"authentication.permissions.view_team_extended_stats.description",
+		PermissionScopeTeam,
+	}
+	PermissionManagePostReports = &Permission{
+		"manage_post_reports",
+		"authentication.permissions.manage_post_reports.name",
+		"authentication.permissions.manage_post_reports.description",
+		PermissionScopeTeam,
+	}
 	PermissionListUsersWithoutTeam = &Permission{
 		"list_users_without_team",
 		"authentication.permissions.list_users_without_team.name",
 		"authentication.permissions.list_users_without_team.description",
 		PermissionScopeSystem,
 	}
+	PermissionSearchArchivedChannels = &Permission{
+		"search_archived_channels",
+		"authentication.permissions.search_archived_channels.name",
+		"authentication.permissions.search_archived_channels.description",
+		PermissionScopeSystem,
+	}
 	PermissionCreateUserAccessToken = &Permission{
 		"create_user_access_token",
 		"authentication.permissions.create_user_access_token.name",
@@ -2194,6 +2243,7 @@ func initializePermissions() {
 		PermissionManageSystemWideOAuth,
 		PermissionCreateTeam,
 		PermissionListUsersWithoutTeam,
+		PermissionSearchArchivedChannels,
 		PermissionCreateUserAccessToken,
 		PermissionReadUserAccessToken,
 		PermissionRevokeUserAccessToken,
@@ -2275,6 +2325,8 @@ func initializePermissions() {
 		PermissionManageTeam,
 		PermissionImportTeam,
 		PermissionViewTeam,
+		PermissionViewTeamExtendedStats,
+		PermissionManagePostReports,
 		PermissionViewMembers,
 		PermissionInviteGuest,
 		PermissionPublicPlaybookCreate,
@@ -2286,6 +2338,9 @@ func initializePermissions() {
 		PermissionManagePublicChannelMembers,
 		PermissionManagePrivateChannelMembers,
 		PermissionManageChannelRoles,
+		PermissionManageChannelCommands,
+		PermissionManageChannelScheduledMessages,
+		PermissionRestorePosts,
 		PermissionManagePublicChannelProperties,
 		PermissionManagePrivateChannelProperties,
 		PermissionConvertPublicChannelToPrivate,
@@ -2299,6 +2354,7 @@ func initializePermissions() {
 		PermissionRemoveReaction,
 		PermissionRemoveOthersReactions,
 		PermissionUploadFile,
+		PermissionDownloadFile,
 		PermissionCreatePost,
 		PermissionCreatePostPublic,
 		PermissionCreatePostEphemeral,
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	PermissionDenialResourceSystem  = "system"
+	PermissionDenialResourceTeam    = "team"
+	PermissionDenialResourceChannel = "channel"
+
+	PermissionDenialPermissionsMaxLength = 1024
+	PermissionDenialPathMaxLength        = 512
+)
+
+// PermissionDenial records a request denied for lacking permissions, so that admins can tell
+// why a user can't do something. Permissions lists the permissions of which the user had none,
+// separated by commas.
+type PermissionDenial struct {
+	Id           string `json:"id"`
+	UserId       string `json:"user_id"`
+	Permissions  string `json:"permissions"`
+	ResourceType string `json:"resource_type"`
+	ResourceId   string `json:"resource_id"`
+	Path         string `json:"path"`
+	CreateAt     int64  `json:"create_at"`
+}
+
+// PermissionDenialSearch filters the permission denials. Empty fields match any denial.
+type PermissionDenialSearch struct {
+	UserId     string
+	ResourceId string
+	Since      int64
+}
+
+// NewPermissionDenial describes the denial of the permissions to the user on the resource.
+func NewPermissionDenial(userID string, permissions []*Permission, resourceType, resourceID, path string) *PermissionDenial {
+	ids := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		ids = append(ids, permission.Id)
+	}
+
+	denial := &PermissionDenial{
+		UserId:       userID,
+		Permissions:  strings.Join(ids, ","),
+		ResourceType: resourceType,
+		ResourceId:   resourceID,
+		Path:         path,
+	}
+	if len(denial.Permissions) > PermissionDenialPermissionsMaxLength {
+		denial.Permissions = denial.Permissions[:PermissionDenialPermissionsMaxLength]
+	}
+	if len(denial.Path) > PermissionDenialPathMaxLength {
+		denial.Path = denial.Path[:PermissionDenialPathMaxLength]
+	}
+	return denial
+}
+
+func (d *PermissionDenial) PreSave() {
+	if d.Id == "" {
+		d.Id = NewId()
+	}
+
+	if d.CreateAt == 0 {
+		d.CreateAt = GetMillis()
+	}
+}
+
+func (d *PermissionDenial) IsValid() *AppError {
+	if !IsValidId(d.Id) {
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(d.UserId) {
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.user_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	if d.Permissions == "" || len(d.Permissions) > PermissionDenialPermissionsMaxLength {
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.permissions.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	switch d.ResourceType {
+	case PermissionDenialResourceSystem:
+		if d.ResourceId != "" {
+			return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.resource_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+		}
+	case PermissionDenialResourceTeam, PermissionDenialResourceChannel:
+		if !IsValidId(d.ResourceId) {
+			return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.resource_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.resource_type.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	if len(d.Path) > PermissionDenialPathMaxLength {
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.path.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	if d.CreateAt == 0 {
+		return NewAppError("PermissionDenial.IsValid", "model.permission_denial.is_valid.create_at.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
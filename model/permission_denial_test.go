@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPermissionDenial(t *testing.T) {
+	userID := NewId()
+	denial := NewPermissionDenial(userID, []*Permission{PermissionManageTeam, PermissionManageSystem}, PermissionDenialResourceTeam, NewId(), "/api/v4/teams")
+	assert.Equal(t, userID, denial.UserId)
+	assert.Equal(t, "manage_team,manage_system", denial.Permissions)
+
+	denial = NewPermissionDenial(userID, []*Permission{PermissionManageSystem}, PermissionDenialResourceSystem, "", strings.Repeat("a", PermissionDenialPathMaxLength+1))
+	assert.Len(t, denial.Path, PermissionDenialPathMaxLength)
+}
+
+func TestPermissionDenialIsValid(t *testing.T) {
+	denial := NewPermissionDenial(NewId(), []*Permission{PermissionManageSystem}, PermissionDenialResourceSystem, "", "/api/v4/config")
+	denial.PreSave()
+	require.Nil(t, denial.IsValid())
+
+	denial.ResourceId = NewId()
+	require.NotNil(t, denial.IsValid())
+
+	denial.ResourceType = PermissionDenialResourceChannel
+	require.Nil(t, denial.IsValid())
+
+	denial.ResourceId = ""
+	require.NotNil(t, denial.IsValid())
+	denial.ResourceId = NewId()
+
+	denial.ResourceType = "junk"
+	require.NotNil(t, denial.IsValid())
+	denial.ResourceType = PermissionDenialResourceTeam
+
+	denial.Permissions = ""
+	require.NotNil(t, denial.IsValid())
+	denial.Permissions = PermissionManageTeam.Id
+
+	denial.UserId = "junk"
+	require.NotNil(t, denial.IsValid())
+	denial.UserId = NewId()
+
+	denial.CreateAt = 0
+	require.NotNil(t, denial.IsValid())
+}
@@ -38,6 +38,7 @@ const (
 	PostTypeRemoveFromTeam         = "system_remove_from_team"
 	PostTypeHeaderChange           = "system_header_change"
 	PostTypeDisplaynameChange      = "system_displayname_change"
+	PostTypeTeamRename             = "system_team_rename"
 	PostTypeConvertChannel         = "system_convert_channel"
 	PostTypePurposeChange          = "system_purpose_change"
 	PostTypeChannelDeleted         = "system_channel_deleted"
@@ -47,6 +48,7 @@ const (
 	PostTypeAddBotTeamsChannels    = "add_bot_teams_channels"
 	PostTypeSystemWarnMetricStatus = "warn_metric_status"
 	PostTypeMe                     = "me"
+	PostTypeTask                   = "task"
 	PostCustomTypePrefix           = "custom_"
 
 	PostFileidsMaxRunes   = 300
@@ -69,6 +71,14 @@ const (
 	PostPropsGroupHighlightDisabled   = "disable_group_highlight"
 
 	PostPropsPreviewedPost = "previewed_post"
+
+	PostPropsTaskAssigneeId = "task_assignee_id"
+	PostPropsTaskDueAt      = "task_due_at"
+
+	PostPropsLanguage = "language"
+
+	// PostRecycleBinDays is the number of days a deleted post can be restored for.
+	PostRecycleBinDays = 30
 )
 
 type Post struct {
@@ -120,12 +130,24 @@ type PostPatch struct {
 	HasReactions *bool            `json:"has_reactions"`
 }
 
+// SystemPostMessagesRequest asks for the messages of system posts rendered in a locale, the
+// locale of the user being used when empty.
+type SystemPostMessagesRequest struct {
+	PostIds []string `json:"post_ids"`
+	Locale  string   `json:"locale"`
+}
+
+// SearchParameter holds the parameters of a search. IncludeDeleted asks for the archived channels
+// to be searched too, which requires the search_archived_channels permission. It supersedes
+// IncludeDeletedChannels, which is ignored for the users without the permission rather than
+// failing the search.
 type SearchParameter struct {
 	Terms                  *string `json:"terms"`
 	IsOrSearch             *bool   `json:"is_or_search"`
 	TimeZoneOffset         *int    `json:"time_zone_offset"`
 	Page                   *int    `json:"page"`
 	PerPage                *int    `json:"per_page"`
+	IncludeDeleted         *bool   `json:"include_deleted"`
 	IncludeDeletedChannels *bool   `json:"include_deleted_channels"`
 }
 
@@ -328,13 +350,15 @@ func (o *Post) IsValid(maxPostSize int) *AppError {
 		PostTypeHeaderChange,
 		PostTypePurposeChange,
 		PostTypeDisplaynameChange,
+		PostTypeTeamRename,
 		PostTypeConvertChannel,
 		PostTypeChannelDeleted,
 		PostTypeChannelRestored,
 		PostTypeChangeChannelPrivacy,
 		PostTypeAddBotTeamsChannels,
 		PostTypeSystemWarnMetricStatus,
-		PostTypeMe:
+		PostTypeMe,
+		PostTypeTask:
 	default:
 		if !strings.HasPrefix(o.Type, PostCustomTypePrefix) {
 			return NewAppError("Post.IsValid", "model.post.is_valid.type.app_error", nil, "id="+o.Type, http.StatusBadRequest)
@@ -723,3 +747,28 @@ func (o *Post) GetPreviewedPostProp() string {
 	}
 	return ""
 }
+
+// The following are some GraphQL methods necessary to return the
+// data in float64 type. The spec doesn't support 64 bit integers,
+// so we have to pass the data in float64. The _ at the end is
+// a hack to keep the attribute name same in GraphQL schema.
+
+func (o *Post) CreateAt_() float64 {
+	return float64(o.CreateAt)
+}
+
+func (o *Post) UpdateAt_() float64 {
+	return float64(o.UpdateAt)
+}
+
+func (o *Post) EditAt_() float64 {
+	return float64(o.EditAt)
+}
+
+func (o *Post) DeleteAt_() float64 {
+	return float64(o.DeleteAt)
+}
+
+func (o *Post) ReplyCount_() float64 {
+	return float64(o.ReplyCount)
+}
@@ -22,6 +22,17 @@ type PostMetadata struct {
 
 	// Reactions holds reactions made to the post.
 	Reactions []*Reaction `json:"reactions,omitempty"`
+
+	// Priority holds the priority of the post and whether it requests acknowledgements. It is set by the client
+	// when creating a root post.
+	Priority *PostPriority `json:"priority,omitempty"`
+
+	// Acknowledgements holds the acknowledgements received by a post that requested them.
+	Acknowledgements []*PostAcknowledgement `json:"acknowledgements,omitempty"`
+
+	// MutedKeywords holds the keywords muted by the user requesting the post that the post contains, so that
+	// clients can dim it.
+	MutedKeywords []string `json:"muted_keywords,omitempty"`
 }
 
 type PostImage struct {
@@ -54,11 +65,26 @@ func (p *PostMetadata) Copy() *PostMetadata {
 	reactionsCopy := make([]*Reaction, len(p.Reactions))
 	copy(reactionsCopy, p.Reactions)
 
+	var priorityCopy *PostPriority
+	if p.Priority != nil {
+		priority := *p.Priority
+		priorityCopy = &priority
+	}
+
+	acknowledgementsCopy := make([]*PostAcknowledgement, len(p.Acknowledgements))
+	copy(acknowledgementsCopy, p.Acknowledgements)
+
+	mutedKeywordsCopy := make([]string, len(p.MutedKeywords))
+	copy(mutedKeywordsCopy, p.MutedKeywords)
+
 	return &PostMetadata{
-		Embeds:    embedsCopy,
-		Emojis:    emojisCopy,
-		Files:     filesCopy,
-		Images:    imagesCopy,
-		Reactions: reactionsCopy,
+		Embeds:           embedsCopy,
+		Emojis:           emojisCopy,
+		Files:            filesCopy,
+		Images:           imagesCopy,
+		Reactions:        reactionsCopy,
+		Priority:         priorityCopy,
+		Acknowledgements: acknowledgementsCopy,
+		MutedKeywords:    mutedKeywordsCopy,
 	}
 }
@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	// PostModerationActionFlag publishes the post and queues it for review.
+	PostModerationActionFlag = "flag"
+	// PostModerationActionHold queues the post for review, and only publishes it once approved.
+	PostModerationActionHold = "hold"
+	// PostModerationActionReject refuses the post.
+	PostModerationActionReject = "reject"
+
+	PostModerationStatusPending  = "pending"
+	PostModerationStatusApproved = "approved"
+	PostModerationStatusRemoved  = "removed"
+
+	PostModerationReasonMaxRunes = 1024
+)
+
+// PostModerationVerdict is the outcome of checking a post, the action being empty when the post
+// can be published as is.
+type PostModerationVerdict struct {
+	Action string `json:"action"`
+	Reason string `json:"reason"`
+}
+
+// PostModeration is a post waiting in the review queue of the moderators. A held post is only
+// published once approved, and a flagged post, already published, is deleted when removed. Held
+// edits of published posts are applied once approved.
+type PostModeration struct {
+	Id         string `json:"id"`
+	PostId     string `json:"post_id"`
+	ChannelId  string `json:"channel_id"`
+	TeamId     string `json:"team_id"`
+	UserId     string `json:"user_id"`
+	Post       *Post  `json:"post"`
+	Action     string `json:"action"`
+	Reason     string `json:"reason"`
+	Status     string `json:"status"`
+	ReviewerId string `json:"reviewer_id"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+}
+
+func (m *PostModeration) PreSave() {
+	if m.Id == "" {
+		m.Id = NewId()
+	}
+
+	if m.Post != nil {
+		m.PostId = m.Post.Id
+		m.ChannelId = m.Post.ChannelId
+		m.UserId = m.Post.UserId
+	}
+
+	m.Status = PostModerationStatusPending
+	m.ReviewerId = ""
+	m.CreateAt = GetMillis()
+	m.UpdateAt = m.CreateAt
+}
+
+func (m *PostModeration) PreUpdate() {
+	m.UpdateAt = GetMillis()
+}
+
+func (m *PostModeration) IsValid() *AppError {
+	if !IsValidId(m.Id) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if m.Post == nil {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.post.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.PostId != "" && !IsValidId(m.PostId) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.post_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.ChannelId) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.channel_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.TeamId != "" && !IsValidId(m.TeamId) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.team_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.UserId) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.user_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !(m.Action == PostModerationActionFlag || m.Action == PostModerationActionHold) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.action.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.Action == PostModerationActionFlag && m.PostId == "" {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.post_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(m.Reason) > PostModerationReasonMaxRunes {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.reason.app_error", map[string]interface{}{"MaxLength": PostModerationReasonMaxRunes}, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidPostModerationStatus(m.Status) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.status.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.ReviewerId != "" && !IsValidId(m.ReviewerId) {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.reviewer_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.CreateAt == 0 {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.create_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.UpdateAt == 0 {
+		return NewAppError("PostModeration.IsValid", "model.post_moderation.is_valid.update_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (m *PostModeration) IsPending() bool {
+	return m.Status == PostModerationStatusPending
+}
+
+// IsHeldEdit tells whether the moderation holds the edit of a published post, rather than a post
+// not published yet.
+func (m *PostModeration) IsHeldEdit() bool {
+	return m.Action == PostModerationActionHold && m.PostId != ""
+}
+
+func IsValidPostModerationStatus(status string) bool {
+	switch status {
+	case PostModerationStatusPending, PostModerationStatusApproved, PostModerationStatusRemoved:
+		return true
+	}
+	return false
+}
+
+// IsValidPostModerationAction reports whether the action can be taken on a post by a moderation rule.
+func IsValidPostModerationAction(action string) bool {
+	switch action {
+	case PostModerationActionFlag, PostModerationActionHold, PostModerationActionReject:
+		return true
+	}
+	return false
+}
+
+// PostModerationActionSeverity orders the actions, so that the most severe one is taken when a
+// post is caught by several checks.
+func PostModerationActionSeverity(action string) int {
+	switch action {
+	case PostModerationActionFlag:
+		return 1
+	case PostModerationActionHold:
+		return 2
+	case PostModerationActionReject:
+		return 3
+	}
+	return 0
+}
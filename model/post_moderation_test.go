@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostModerationPreSave(t *testing.T) {
+	post := &Post{Id: NewId(), ChannelId: NewId(), UserId: NewId(), Message: "message"}
+	moderation := &PostModeration{
+		Post:       post,
+		Action:     PostModerationActionFlag,
+		Status:     PostModerationStatusApproved,
+		ReviewerId: NewId(),
+	}
+	moderation.PreSave()
+
+	assert.True(t, IsValidId(moderation.Id))
+	assert.Equal(t, post.Id, moderation.PostId)
+	assert.Equal(t, post.ChannelId, moderation.ChannelId)
+	assert.Equal(t, post.UserId, moderation.UserId)
+	assert.True(t, moderation.IsPending())
+	assert.Empty(t, moderation.ReviewerId)
+	assert.NotZero(t, moderation.CreateAt)
+	assert.Equal(t, moderation.CreateAt, moderation.UpdateAt)
+}
+
+func TestPostModerationIsValid(t *testing.T) {
+	moderation := &PostModeration{
+		Post:   &Post{ChannelId: NewId(), UserId: NewId(), Message: "message"},
+		TeamId: NewId(),
+		Action: PostModerationActionHold,
+		Reason: "Keywords",
+	}
+	moderation.PreSave()
+	require.Nil(t, moderation.IsValid())
+	assert.False(t, moderation.IsHeldEdit())
+
+	moderation.Action = PostModerationActionReject
+	require.NotNil(t, moderation.IsValid())
+
+	moderation.Action = PostModerationActionFlag
+	require.NotNil(t, moderation.IsValid(), "a flagged post must have been published")
+	moderation.PostId = NewId()
+	require.Nil(t, moderation.IsValid())
+
+	moderation.Action = PostModerationActionHold
+	assert.True(t, moderation.IsHeldEdit())
+
+	moderation.Reason = strings.Repeat("a", PostModerationReasonMaxRunes+1)
+	require.NotNil(t, moderation.IsValid())
+	moderation.Reason = "Keywords"
+
+	moderation.Status = "X"
+	require.NotNil(t, moderation.IsValid())
+	moderation.Status = PostModerationStatusRemoved
+
+	moderation.ReviewerId = "X"
+	require.NotNil(t, moderation.IsValid())
+	moderation.ReviewerId = NewId()
+	require.Nil(t, moderation.IsValid())
+
+	moderation.Post = nil
+	require.NotNil(t, moderation.IsValid())
+}
+
+func TestPostModerationActionSeverity(t *testing.T) {
+	assert.Less(t, PostModerationActionSeverity(""), PostModerationActionSeverity(PostModerationActionFlag))
+	assert.Less(t, PostModerationActionSeverity(PostModerationActionFlag), PostModerationActionSeverity(PostModerationActionHold))
+	assert.Less(t, PostModerationActionSeverity(PostModerationActionHold), PostModerationActionSeverity(PostModerationActionReject))
+}
+
+func TestModerationSettingsIsValid(t *testing.T) {
+	newSettings := func() *ModerationSettings {
+		s := &ModerationSettings{}
+		s.SetDefaults()
+		return s
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		require.Nil(t, newSettings().isValid())
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			rule  *ModerationRule
+			valid bool
+		}{
+			"keywords":             {&ModerationRule{Name: "Spam", Keywords: []string{"buy now"}, Action: PostModerationActionHold}, true},
+			"pattern":              {&ModerationRule{Name: "Cards", Pattern: `\d{4}-\d{4}-\d{4}-\d{4}`, Action: PostModerationActionReject}, true},
+			"no name":              {&ModerationRule{Keywords: []string{"spam"}, Action: PostModerationActionFlag}, false},
+			"no match":             {&ModerationRule{Name: "Spam", Action: PostModerationActionFlag}, false},
+			"keywords and pattern": {&ModerationRule{Name: "Spam", Keywords: []string{"spam"}, Pattern: "spam", Action: PostModerationActionFlag}, false},
+			"invalid pattern":      {&ModerationRule{Name: "Spam", Pattern: "(", Action: PostModerationActionFlag}, false},
+			"invalid action":       {&ModerationRule{Name: "Spam", Keywords: []string{"spam"}, Action: "ban"}, false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				s := newSettings()
+				s.Rules = []*ModerationRule{tc.rule}
+				if tc.valid {
+					require.Nil(t, s.isValid())
+				} else {
+					require.NotNil(t, s.isValid())
+				}
+			})
+		}
+	})
+
+	t.Run("classifier", func(t *testing.T) {
+		s := newSettings()
+		s.ClassifierURL = NewString("not a url")
+		require.NotNil(t, s.isValid())
+
+		s.ClassifierURL = NewString("https://classifier.example.com/classify")
+		require.Nil(t, s.isValid())
+
+		s.ClassifierTimeoutMilliseconds = NewInt(0)
+		require.NotNil(t, s.isValid())
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	PostPriorityUrgent    = "urgent"
+	PostPriorityImportant = "important"
+)
+
+// PostPriority is the priority a root post was sent with, and whether its sender asked the
+// members of the channel to acknowledge it. It is sent and received as part of the post metadata.
+type PostPriority struct {
+	PostId    string `json:"post_id,omitempty"`
+	ChannelId string `json:"channel_id,omitempty"`
+
+	// Priority is one of PostPriorityUrgent or PostPriorityImportant, or empty for a standard post.
+	Priority string `json:"priority"`
+
+	RequestedAck bool `json:"requested_ack"`
+}
+
+func IsValidPostPriority(priority string) bool {
+	return priority == "" || priority == PostPriorityUrgent || priority == PostPriorityImportant
+}
+
+func (p *PostPriority) IsValid() *AppError {
+	if !IsValidId(p.PostId) {
+		return NewAppError("PostPriority.IsValid", "model.post_priority.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(p.ChannelId) {
+		return NewAppError("PostPriority.IsValid", "model.post_priority.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidPostPriority(p.Priority) {
+		return NewAppError("PostPriority.IsValid", "model.post_priority.is_valid.priority.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsStandard reports whether the priority carries no information worth storing.
+func (p *PostPriority) IsStandard() bool {
+	return p.Priority == "" && !p.RequestedAck
+}
+
+// PostAcknowledgement records that a user acknowledged a post that requested it.
+type PostAcknowledgement struct {
+	UserId         string `json:"user_id"`
+	PostId         string `json:"post_id"`
+	AcknowledgedAt int64  `json:"acknowledged_at"`
+}
+
+func (a *PostAcknowledgement) IsValid() *AppError {
+	if !IsValidId(a.UserId) {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(a.PostId) {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if a.AcknowledgedAt == 0 {
+		return NewAppError("PostAcknowledgement.IsValid", "model.post_acknowledgement.is_valid.acknowledged_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PostAcknowledgementSummary is how many members of its channel acknowledged a post that
+// requested it, and how many still have to.
+type PostAcknowledgementSummary struct {
+	PostId       string `json:"post_id"`
+	ChannelId    string `json:"channel_id"`
+	CreateAt     int64  `json:"create_at"`
+	Acknowledged int64  `json:"acknowledged"`
+	Outstanding  int64  `json:"outstanding"`
+}
+
+// GetPriority returns the priority of the post from its metadata, or nil for a standard post.
+func (o *Post) GetPriority() *PostPriority {
+	if o.Metadata == nil || o.Metadata.Priority == nil || o.Metadata.Priority.IsStandard() {
+		return nil
+	}
+	return o.Metadata.Priority
+}
+
+// IsUrgent reports whether the post was sent with urgent priority.
+func (o *Post) IsUrgent() bool {
+	priority := o.GetPriority()
+	return priority != nil && priority.Priority == PostPriorityUrgent
+}
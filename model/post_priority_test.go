@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostPriorityIsValid(t *testing.T) {
+	priority := &PostPriority{
+		PostId:    NewId(),
+		ChannelId: NewId(),
+		Priority:  PostPriorityUrgent,
+	}
+	require.Nil(t, priority.IsValid())
+
+	priority.Priority = ""
+	priority.RequestedAck = true
+	require.Nil(t, priority.IsValid())
+
+	priority.Priority = "critical"
+	require.NotNil(t, priority.IsValid())
+	priority.Priority = PostPriorityImportant
+
+	priority.PostId = ""
+	require.NotNil(t, priority.IsValid())
+}
+
+func TestPostGetPriority(t *testing.T) {
+	post := &Post{}
+	assert.Nil(t, post.GetPriority())
+	assert.False(t, post.IsUrgent())
+
+	post.Metadata = &PostMetadata{Priority: &PostPriority{}}
+	assert.Nil(t, post.GetPriority())
+
+	post.Metadata.Priority.Priority = PostPriorityImportant
+	require.NotNil(t, post.GetPriority())
+	assert.False(t, post.IsUrgent())
+
+	post.Metadata.Priority.Priority = PostPriorityUrgent
+	assert.True(t, post.IsUrgent())
+}
+
+func TestPostAcknowledgementIsValid(t *testing.T) {
+	acknowledgement := &PostAcknowledgement{
+		UserId:         NewId(),
+		PostId:         NewId(),
+		AcknowledgedAt: GetMillis(),
+	}
+	require.Nil(t, acknowledgement.IsValid())
+
+	acknowledgement.AcknowledgedAt = 0
+	require.NotNil(t, acknowledgement.IsValid())
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	PostReportReasonSpam          = "spam"
+	PostReportReasonHarassment    = "harassment"
+	PostReportReasonInappropriate = "inappropriate"
+	PostReportReasonOther         = "other"
+
+	PostReportStatusOpen     = "open"
+	PostReportStatusResolved = "resolved"
+
+	// PostReportResolutionDismiss closes the report, leaving the post as is.
+	PostReportResolutionDismiss = "dismiss"
+	// PostReportResolutionDeletePost deletes the reported post.
+	PostReportResolutionDeletePost = "delete_post"
+	// PostReportResolutionWarnUser sends a warning to the author of the reported post.
+	PostReportResolutionWarnUser = "warn_user"
+
+	PostReportCommentMaxRunes = 1024
+)
+
+// PostReport is the report of a post by a user, waiting in the queue of the moderators until
+// resolved. The reporter of an anonymous report is only known to the server.
+type PostReport struct {
+	Id         string `json:"id"`
+	PostId     string `json:"post_id"`
+	ChannelId  string `json:"channel_id"`
+	TeamId     string `json:"team_id"`
+	PostUserId string `json:"post_user_id"`
+	ReporterId string `json:"reporter_id"`
+	Anonymous  bool   `json:"anonymous"`
+	Reason     string `json:"reason"`
+	Comment    string `json:"comment"`
+	Status     string `json:"status"`
+	Resolution string `json:"resolution"`
+	ResolverId string `json:"resolver_id"`
+	Note       string `json:"note"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+}
+
+// PostReportResolution is how a moderator resolves a report. The note is sent to the author of
+// the post along with a warning.
+type PostReportResolution struct {
+	Action string `json:"action"`
+	Note   string `json:"note"`
+}
+
+func (r *PostReport) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	r.Status = PostReportStatusOpen
+	r.Resolution = ""
+	r.ResolverId = ""
+	r.Note = ""
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *PostReport) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+func (r *PostReport) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.PostId) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.post_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.ChannelId) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.channel_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.TeamId != "" && !IsValidId(r.TeamId) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.team_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.PostUserId) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.post_user_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.ReporterId) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.reporter_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidPostReportReason(r.Reason) {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.reason.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.Comment) > PostReportCommentMaxRunes {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.comment.app_error", map[string]interface{}{"MaxLength": PostReportCommentMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Reason == PostReportReasonOther && r.Comment == "" {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.comment_required.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	switch r.Status {
+	case PostReportStatusOpen:
+		if r.Resolution != "" || r.ResolverId != "" {
+			return NewAppError("PostReport.IsValid", "model.post_report.is_valid.resolution.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+		}
+	case PostReportStatusResolved:
+		if !IsValidPostReportResolution(r.Resolution) {
+			return NewAppError("PostReport.IsValid", "model.post_report.is_valid.resolution.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+		}
+		if !IsValidId(r.ResolverId) {
+			return NewAppError("PostReport.IsValid", "model.post_report.is_valid.resolver_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.status.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.Note) > PostReportCommentMaxRunes {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.note.app_error", map[string]interface{}{"MaxLength": PostReportCommentMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("PostReport.IsValid", "model.post_report.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (r *PostReport) IsOpen() bool {
+	return r.Status == PostReportStatusOpen
+}
+
+// Sanitize hides the reporter of an anonymous report.
+func (r *PostReport) Sanitize() {
+	if r.Anonymous {
+		r.ReporterId = ""
+	}
+}
+
+func IsValidPostReportReason(reason string) bool {
+	switch reason {
+	case PostReportReasonSpam, PostReportReasonHarassment, PostReportReasonInappropriate, PostReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func IsValidPostReportStatus(status string) bool {
+	return status == PostReportStatusOpen || status == PostReportStatusResolved
+}
+
+func IsValidPostReportResolution(resolution string) bool {
+	switch resolution {
+	case PostReportResolutionDismiss, PostReportResolutionDeletePost, PostReportResolutionWarnUser:
+		return true
+	}
+	return false
+}
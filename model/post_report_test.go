@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostReportIsValid(t *testing.T) {
+	report := &PostReport{
+		PostId:     NewId(),
+		ChannelId:  NewId(),
+		PostUserId: NewId(),
+		ReporterId: NewId(),
+		Reason:     PostReportReasonSpam,
+		Resolution: PostReportResolutionDismiss,
+	}
+	report.PreSave()
+	require.Nil(t, report.IsValid())
+	assert.True(t, report.IsOpen())
+	assert.Empty(t, report.Resolution, "a new report can't be resolved")
+
+	report.Reason = "X"
+	require.NotNil(t, report.IsValid())
+
+	report.Reason = PostReportReasonOther
+	require.NotNil(t, report.IsValid(), "other requires a comment")
+	report.Comment = "Off topic"
+	require.Nil(t, report.IsValid())
+
+	report.Comment = strings.Repeat("a", PostReportCommentMaxRunes+1)
+	require.NotNil(t, report.IsValid())
+	report.Comment = "Off topic"
+
+	report.Resolution = PostReportResolutionDismiss
+	require.NotNil(t, report.IsValid(), "an open report can't have a resolution")
+
+	report.Status = PostReportStatusResolved
+	require.NotNil(t, report.IsValid(), "a resolved report must have a resolver")
+	report.ResolverId = NewId()
+	require.Nil(t, report.IsValid())
+
+	report.Resolution = "ban"
+	require.NotNil(t, report.IsValid())
+	report.Resolution = PostReportResolutionWarnUser
+
+	report.Note = strings.Repeat("a", PostReportCommentMaxRunes+1)
+	require.NotNil(t, report.IsValid())
+	report.Note = ""
+
+	report.Status = "X"
+	require.NotNil(t, report.IsValid())
+}
+
+func TestPostReportSanitize(t *testing.T) {
+	reporterID := NewId()
+
+	report := &PostReport{ReporterId: reporterID}
+	report.Sanitize()
+	assert.Equal(t, reporterID, report.ReporterId)
+
+	report.Anonymous = true
+	report.Sanitize()
+	assert.Empty(t, report.ReporterId)
+}
@@ -13,12 +13,15 @@ type PostSearchMatches map[string][]string
 type PostSearchResults struct {
 	*PostList
 	Matches PostSearchMatches `json:"matches"`
+	// ArchivedChannelIds are the ids of the archived channels the posts come from, when the
+	// search included the archived channels.
+	ArchivedChannelIds []string `json:"archived_channel_ids,omitempty"`
 }
 
 func MakePostSearchResults(posts *PostList, matches PostSearchMatches) *PostSearchResults {
 	return &PostSearchResults{
-		posts,
-		matches,
+		PostList: posts,
+		Matches:  matches,
 	}
 }
 
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	PostTaskStatusOpen       = "open"
+	PostTaskStatusInProgress = "in_progress"
+	PostTaskStatusDone       = "done"
+)
+
+// PostTask holds the state of a post of type PostTypeTask.
+type PostTask struct {
+	// PostId is the task post.
+	PostId string `json:"post_id"`
+
+	// ChannelId is the channel in which the task was posted.
+	ChannelId string `json:"channel_id"`
+
+	// AssigneeId is the user responsible for the task, empty if the task is unassigned.
+	AssigneeId string `json:"assignee_id"`
+
+	// Status is one of PostTaskStatusOpen, PostTaskStatusInProgress or PostTaskStatusDone.
+	Status string `json:"status"`
+
+	// DueAt is the timestamp the task is due at, or zero if it has no due date.
+	DueAt int64 `json:"due_at"`
+
+	CreateAt int64 `json:"create_at"`
+	UpdateAt int64 `json:"update_at"`
+}
+
+type PostTaskPatch struct {
+	AssigneeId *string `json:"assignee_id"`
+	Status     *string `json:"status"`
+	DueAt      *int64  `json:"due_at"`
+}
+
+// PostTaskFromPost builds the task described by the props of the given task post. The post id
+// is only known once the post is saved, so it is not validated here.
+func PostTaskFromPost(post *Post) (*PostTask, *AppError) {
+	task := &PostTask{
+		PostId:    post.Id,
+		ChannelId: post.ChannelId,
+		Status:    PostTaskStatusOpen,
+	}
+
+	switch assigneeID := post.GetProp(PostPropsTaskAssigneeId).(type) {
+	case nil:
+	case string:
+		task.AssigneeId = assigneeID
+	default:
+		return nil, NewAppError("PostTaskFromPost", "model.post_task.is_valid.assignee_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	switch dueAt := post.GetProp(PostPropsTaskDueAt).(type) {
+	case nil:
+	case float64:
+		task.DueAt = int64(dueAt)
+	case int64:
+		task.DueAt = dueAt
+	case int:
+		task.DueAt = int64(dueAt)
+	default:
+		return nil, NewAppError("PostTaskFromPost", "model.post_task.is_valid.due_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if task.AssigneeId != "" && !IsValidId(task.AssigneeId) {
+		return nil, NewAppError("PostTaskFromPost", "model.post_task.is_valid.assignee_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if task.DueAt < 0 {
+		return nil, NewAppError("PostTaskFromPost", "model.post_task.is_valid.due_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return task, nil
+}
+
+func IsValidPostTaskStatus(status string) bool {
+	switch status {
+	case PostTaskStatusOpen, PostTaskStatusInProgress, PostTaskStatusDone:
+		return true
+	}
+	return false
+}
+
+func (t *PostTask) IsValid() *AppError {
+	if !IsValidId(t.PostId) {
+		return NewAppError("PostTask.IsValid", "model.post_task.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(t.ChannelId) {
+		return NewAppError("PostTask.IsValid", "model.post_task.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.AssigneeId != "" && !IsValidId(t.AssigneeId) {
+		return NewAppError("PostTask.IsValid", "model.post_task.is_valid.assignee_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidPostTaskStatus(t.Status) {
+		return NewAppError("PostTask.IsValid", "model.post_task.is_valid.status.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.DueAt < 0 {
+		return NewAppError("PostTask.IsValid", "model.post_task.is_valid.due_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (t *PostTask) PreSave() {
+	if t.CreateAt == 0 {
+		t.CreateAt = GetMillis()
+	}
+	t.UpdateAt = t.CreateAt
+}
+
+func (t *PostTask) Patch(patch *PostTaskPatch) {
+	if patch.AssigneeId != nil {
+		t.AssigneeId = *patch.AssigneeId
+	}
+
+	if patch.Status != nil {
+		t.Status = *patch.Status
+	}
+
+	if patch.DueAt != nil {
+		t.DueAt = *patch.DueAt
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTaskFromPost(t *testing.T) {
+	assigneeID := NewId()
+
+	t.Run("without props", func(t *testing.T) {
+		post := &Post{Id: NewId(), ChannelId: NewId(), Type: PostTypeTask}
+		task, err := PostTaskFromPost(post)
+		require.Nil(t, err)
+		assert.Equal(t, post.Id, task.PostId)
+		assert.Equal(t, post.ChannelId, task.ChannelId)
+		assert.Equal(t, PostTaskStatusOpen, task.Status)
+		assert.Empty(t, task.AssigneeId)
+		assert.Zero(t, task.DueAt)
+	})
+
+	t.Run("with props decoded from json", func(t *testing.T) {
+		var post Post
+		require.NoError(t, json.Unmarshal([]byte(`{"type":"task","props":{"task_assignee_id":"`+assigneeID+`","task_due_at":1600000000000}}`), &post))
+		task, err := PostTaskFromPost(&post)
+		require.Nil(t, err)
+		assert.Equal(t, assigneeID, task.AssigneeId)
+		assert.Equal(t, int64(1600000000000), task.DueAt)
+	})
+
+	t.Run("invalid props", func(t *testing.T) {
+		post := &Post{Type: PostTypeTask}
+		post.AddProp(PostPropsTaskAssigneeId, 1)
+		_, err := PostTaskFromPost(post)
+		require.NotNil(t, err)
+		assert.Equal(t, "model.post_task.is_valid.assignee_id.app_error", err.Id)
+
+		post = &Post{Type: PostTypeTask}
+		post.AddProp(PostPropsTaskDueAt, "tomorrow")
+		_, err = PostTaskFromPost(post)
+		require.NotNil(t, err)
+		assert.Equal(t, "model.post_task.is_valid.due_at.app_error", err.Id)
+
+		post = &Post{Type: PostTypeTask}
+		post.AddProp(PostPropsTaskAssigneeId, "invalid")
+		_, err = PostTaskFromPost(post)
+		require.NotNil(t, err)
+		assert.Equal(t, "model.post_task.is_valid.assignee_id.app_error", err.Id)
+	})
+}
+
+func TestPostTaskIsValid(t *testing.T) {
+	task := PostTask{}
+
+	err := task.IsValid()
+	require.False(t, err == nil || err.Id != "model.post_task.is_valid.post_id.app_error")
+
+	task.PostId = NewId()
+	err = task.IsValid()
+	require.False(t, err == nil || err.Id != "model.post_task.is_valid.channel_id.app_error")
+
+	task.ChannelId = NewId()
+	task.AssigneeId = "invalid"
+	err = task.IsValid()
+	require.False(t, err == nil || err.Id != "model.post_task.is_valid.assignee_id.app_error")
+
+	task.AssigneeId = NewId()
+	err = task.IsValid()
+	require.False(t, err == nil || err.Id != "model.post_task.is_valid.status.app_error")
+
+	task.Status = PostTaskStatusDone
+	task.DueAt = -1
+	err = task.IsValid()
+	require.False(t, err == nil || err.Id != "model.post_task.is_valid.due_at.app_error")
+
+	task.DueAt = 0
+	require.Nil(t, task.IsValid())
+}
+
+func TestPostTaskPatch(t *testing.T) {
+	task := &PostTask{Status: PostTaskStatusOpen, AssigneeId: NewId()}
+	status := PostTaskStatusInProgress
+	dueAt := int64(1234)
+
+	task.Patch(&PostTaskPatch{Status: &status, DueAt: &dueAt})
+	assert.Equal(t, PostTaskStatusInProgress, task.Status)
+	assert.Equal(t, int64(1234), task.DueAt)
+	assert.NotEmpty(t, task.AssigneeId)
+}
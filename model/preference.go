@@ -35,6 +35,9 @@ const (
 	PreferenceCategoryAuthorizedOAuthApp = "oauth_app"
 	// the name for oauth_app is the client_id and value is the current scope
 
+	PreferenceCategoryKnownDevice = "known_device"
+	// the name for known_device is the device fingerprint and value is when it was first seen
+
 	PreferenceCategoryLast    = "last"
 	PreferenceNameLastChannel = "channel"
 	PreferenceNameLastTeam    = "team"
@@ -45,8 +48,16 @@ const (
 
 	PreferenceCustomStatusModalViewed = "custom_status_modal_viewed"
 
+	PreferenceCategoryPrivacy          = "privacy"
+	PreferenceNameShareChannelPresence = "share_channel_presence"
+
+	// The order of the teams of the user is stored as their comma separated ids.
+	PreferenceCategoryTeamsOrder = "teams_order"
+	PreferenceNameTeamsOrder     = ""
+
 	PreferenceCategoryNotifications = "notifications"
 	PreferenceNameEmailInterval     = "email_interval"
+	PreferenceNameMutedKeywords     = "muted_keywords"
 
 	PreferenceEmailIntervalNoBatchingSeconds = "30"  // the "immediate" setting is actually 30s
 	PreferenceEmailIntervalBatchingSeconds   = "900" // fifteen minutes is 900 seconds
@@ -62,10 +73,20 @@ type Preference struct {
 	Category string `json:"category"`
 	Name     string `json:"name"`
 	Value    string `json:"value"`
+	// Version is incremented on every save. A preference that doesn't exist has version 0.
+	Version int64 `json:"version"`
 }
 
 type Preferences []Preference
 
+// PreferencesCompareAndSetResult is the outcome of saving preferences only if they are still
+// at the versions the client last saw. When any of them changed in the meantime, none are
+// saved and Conflicts holds their current values so that the client can merge them.
+type PreferencesCompareAndSetResult struct {
+	Saved     Preferences `json:"saved"`
+	Conflicts Preferences `json:"conflicts"`
+}
+
 func (o *Preference) IsValid() *AppError {
 	if !IsValidId(o.UserId) {
 		return NewAppError("Preference.IsValid", "model.preference.is_valid.id.app_error", nil, "user_id="+o.UserId, http.StatusBadRequest)
@@ -83,6 +104,10 @@ func (o *Preference) IsValid() *AppError {
 		return NewAppError("Preference.IsValid", "model.preference.is_valid.value.app_error", nil, "value="+o.Value, http.StatusBadRequest)
 	}
 
+	if o.Version < 0 {
+		return NewAppError("Preference.IsValid", "model.preference.is_valid.version.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if o.Category == PreferenceCategoryTheme {
 		var unused map[string]string
 		if err := json.NewDecoder(strings.NewReader(o.Value)).Decode(&unused); err != nil {
@@ -90,6 +115,16 @@ func (o *Preference) IsValid() *AppError {
 		}
 	}
 
+	if o.Category == PreferenceCategoryNotifications && o.Name == PreferenceNameMutedKeywords {
+		var keywords MutedKeywords
+		if err := json.Unmarshal([]byte(o.Value), &keywords); err != nil {
+			return NewAppError("Preference.IsValid", "model.preference.is_valid.muted_keywords.app_error", nil, "value="+o.Value, http.StatusBadRequest)
+		}
+		if appErr := keywords.IsValid(); appErr != nil {
+			return appErr
+		}
+	}
+
 	return nil
 }
 
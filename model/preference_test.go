@@ -46,6 +46,12 @@ func TestPreferenceIsValid(t *testing.T) {
 
 	preference.Value = `{"color": "#ff0000", "color2": "#faf"}`
 	require.Nil(t, preference.IsValid())
+
+	preference.Version = -1
+	require.NotNil(t, preference.IsValid())
+
+	preference.Version = 3
+	require.Nil(t, preference.IsValid())
 }
 
 func TestPreferencePreUpdate(t *testing.T) {
@@ -65,3 +71,19 @@ func TestPreferencePreUpdate(t *testing.T) {
 
 	require.NotEqual(t, "invalid", props["invalid"], "should have changed invalid prop")
 }
+
+func TestPreferenceIsValidMutedKeywords(t *testing.T) {
+	preference := Preference{
+		UserId:   NewId(),
+		Category: PreferenceCategoryNotifications,
+		Name:     PreferenceNameMutedKeywords,
+		Value:    `["spoiler"]`,
+	}
+	require.Nil(t, preference.IsValid())
+
+	preference.Value = "spoiler"
+	require.NotNil(t, preference.IsValid())
+
+	preference.Value = `[""]`
+	require.NotNil(t, preference.IsValid())
+}
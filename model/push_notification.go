@@ -34,6 +34,41 @@ const (
 	PushReceived    = "Received by device"
 )
 
+// pushNotificationContentsDisclosure ranks the push notification contents settings from the one
+// disclosing the least about a post to the one disclosing the most.
+var pushNotificationContentsDisclosure = map[string]int{
+	IdLoadedNotification:         0,
+	GenericNoChannelNotification: 1,
+	GenericNotification:          2,
+	FullNotification:             3,
+}
+
+// IsValidPushNotificationContents reports whether the given string is a push notification
+// contents setting.
+func IsValidPushNotificationContents(contents string) bool {
+	_, ok := pushNotificationContentsDisclosure[contents]
+	return ok
+}
+
+// LeastDisclosingPushNotificationContents returns whichever of the given push notification
+// contents settings discloses the least about a post.
+func LeastDisclosingPushNotificationContents(a, b string) string {
+	if pushNotificationContentsDisclosure[b] < pushNotificationContentsDisclosure[a] {
+		return b
+	}
+	return a
+}
+
+// PushNotificationTestResult is the outcome of sending a test push notification to one of the
+// devices of a user.
+type PushNotificationTestResult struct {
+	SessionId string `json:"session_id"`
+	Platform  string `json:"platform"`
+	AckId     string `json:"ack_id"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
 type PushNotificationAck struct {
 	Id               string `json:"id"`
 	ClientReceivedAt int64  `json:"received_at"`
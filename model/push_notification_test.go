@@ -55,3 +55,16 @@ func TestPushNotificationDeviceId(t *testing.T) {
 	msg.Platform = ""
 	msg.DeviceId = ""
 }
+
+func TestLeastDisclosingPushNotificationContents(t *testing.T) {
+	require.True(t, IsValidPushNotificationContents(FullNotification))
+	require.True(t, IsValidPushNotificationContents(IdLoadedNotification))
+	require.False(t, IsValidPushNotificationContents(""))
+	require.False(t, IsValidPushNotificationContents("everything"))
+
+	require.Equal(t, GenericNotification, LeastDisclosingPushNotificationContents(FullNotification, GenericNotification))
+	require.Equal(t, GenericNotification, LeastDisclosingPushNotificationContents(GenericNotification, FullNotification))
+	require.Equal(t, GenericNoChannelNotification, LeastDisclosingPushNotificationContents(GenericNoChannelNotification, GenericNotification))
+	require.Equal(t, IdLoadedNotification, LeastDisclosingPushNotificationContents(GenericNoChannelNotification, IdLoadedNotification))
+	require.Equal(t, FullNotification, LeastDisclosingPushNotificationContents(FullNotification, FullNotification))
+}
@@ -21,6 +21,18 @@ const (
 	RemoteOfflineAfterMillis = 1000 * 60 * 5 // 5 minutes
 	RemoteNameMinLength      = 1
 	RemoteNameMaxLength      = 64
+
+	RemoteProfileFieldEmail            = "email"
+	RemoteProfileFieldFullName         = "full_name"
+	RemoteProfileFieldNickname         = "nickname"
+	RemoteProfileFieldPosition         = "position"
+	RemoteProfileFieldCustomAttributes = "custom_attributes"
+
+	// The invite policies tell what happens to the shared channel invites received from a remote
+	// cluster. They are accepted right away unless the remote cluster requires approval.
+	RemoteInvitePolicyAccept          = "accept"
+	RemoteInvitePolicyRequireApproval = "require_approval"
+	RemoteInvitePolicyDeny            = "deny"
 )
 
 var (
@@ -39,6 +51,91 @@ type RemoteCluster struct {
 	RemoteToken  string `json:"remote_token"`
 	Topics       string `json:"topics"`
 	CreatorId    string `json:"creator_id"`
+
+	// HiddenProfileFields is the space separated list of user profile fields that
+	// are not synchronized with the remote cluster.
+	HiddenProfileFields string `json:"hidden_profile_fields"`
+
+	// InvitePolicy is what happens to the shared channel invites received from the remote
+	// cluster. Empty accepts them.
+	InvitePolicy string `json:"invite_policy"`
+}
+
+// RemoteClusterInvitePolicy controls what happens to the shared channel invites received
+// from a remote cluster.
+type RemoteClusterInvitePolicy struct {
+	Policy string `json:"policy"`
+}
+
+func IsValidRemoteInvitePolicy(policy string) bool {
+	switch policy {
+	case RemoteInvitePolicyAccept, RemoteInvitePolicyRequireApproval, RemoteInvitePolicyDeny:
+		return true
+	}
+	return false
+}
+
+func (p *RemoteClusterInvitePolicy) IsValid() *AppError {
+	if !IsValidRemoteInvitePolicy(p.Policy) {
+		return NewAppError("RemoteClusterInvitePolicy.IsValid", "model.remote_cluster.invite_policy.is_valid.policy.app_error", nil, "policy="+p.Policy, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// RemoteClusterProfilePolicy controls which user profile fields are masked when users
+// are synchronized with a remote cluster through shared channels.
+type RemoteClusterProfilePolicy struct {
+	HiddenFields []string `json:"hidden_fields"`
+}
+
+func IsValidRemoteProfileField(field string) bool {
+	switch field {
+	case RemoteProfileFieldEmail, RemoteProfileFieldFullName, RemoteProfileFieldNickname,
+		RemoteProfileFieldPosition, RemoteProfileFieldCustomAttributes:
+		return true
+	}
+	return false
+}
+
+func (p *RemoteClusterProfilePolicy) IsValid() *AppError {
+	for _, field := range p.HiddenFields {
+		if !IsValidRemoteProfileField(field) {
+			return NewAppError("RemoteClusterProfilePolicy.IsValid", "model.remote_cluster.profile_policy.is_valid.field.app_error", nil, "field="+field, http.StatusBadRequest)
+		}
+	}
+	return nil
+}
+
+// ToHiddenProfileFields returns the hidden fields of the policy as stored with the
+// remote cluster.
+func (p *RemoteClusterProfilePolicy) ToHiddenProfileFields() string {
+	fields := make([]string, len(p.HiddenFields))
+	copy(fields, p.HiddenFields)
+	return strings.Join(RemoveDuplicateStrings(fields), " ")
+}
+
+// GetProfilePolicy returns the profile fields masking policy of the remote cluster.
+func (rc *RemoteCluster) GetProfilePolicy() *RemoteClusterProfilePolicy {
+	return &RemoteClusterProfilePolicy{HiddenFields: strings.Fields(rc.HiddenProfileFields)}
+}
+
+// GetInvitePolicy returns the shared channel invite policy of the remote cluster.
+func (rc *RemoteCluster) GetInvitePolicy() *RemoteClusterInvitePolicy {
+	if rc.InvitePolicy == "" {
+		return &RemoteClusterInvitePolicy{Policy: RemoteInvitePolicyAccept}
+	}
+	return &RemoteClusterInvitePolicy{Policy: rc.InvitePolicy}
+}
+
+// IsProfileFieldHidden returns true if the user profile field must not be synchronized
+// with the remote cluster.
+func (rc *RemoteCluster) IsProfileFieldHidden(field string) bool {
+	for _, hidden := range strings.Fields(rc.HiddenProfileFields) {
+		if hidden == field {
+			return true
+		}
+	}
+	return false
 }
 
 func (rc *RemoteCluster) PreSave() {
@@ -80,6 +177,10 @@ func (rc *RemoteCluster) IsValid() *AppError {
 	if !IsValidId(rc.CreatorId) {
 		return NewAppError("RemoteCluster.IsValid", "model.cluster.is_valid.id.app_error", nil, "creator_id="+rc.CreatorId, http.StatusBadRequest)
 	}
+
+	if rc.InvitePolicy != "" && !IsValidRemoteInvitePolicy(rc.InvitePolicy) {
+		return NewAppError("RemoteCluster.IsValid", "model.remote_cluster.invite_policy.is_valid.policy.app_error", nil, "invite_policy="+rc.InvitePolicy, http.StatusBadRequest)
+	}
 	return nil
 }
 
@@ -0,0 +1,34 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// RemoteClusterHealth reports how well the shared channels are kept in sync with a remote
+// cluster. The sync statistics are gathered by the shared channel service of the node
+// reporting them, over its most recent attempts to send sync messages to the remote.
+type RemoteClusterHealth struct {
+	RemoteId      string                        `json:"remote_id"`
+	IsOnline      bool                          `json:"is_online"`
+	LastPingAt    int64                         `json:"last_ping_at"`
+	LastSyncAt    int64                         `json:"last_sync_at"`
+	LastFailureAt int64                         `json:"last_failure_at"`
+	LastError     string                        `json:"last_error,omitempty"`
+	PendingTasks  int                           `json:"pending_tasks"`
+	PendingPosts  int64                         `json:"pending_posts"`
+	Attempts      int                           `json:"attempts"`
+	Failures      int                           `json:"failures"`
+	ErrorRate     float64                       `json:"error_rate"`
+	LatencyP50    int64                         `json:"latency_p50_ms"`
+	LatencyP90    int64                         `json:"latency_p90_ms"`
+	LatencyP99    int64                         `json:"latency_p99_ms"`
+	Channels      []*RemoteClusterChannelHealth `json:"channels"`
+}
+
+// RemoteClusterChannelHealth reports how far a channel shared with a remote cluster is from
+// being in sync.
+type RemoteClusterChannelHealth struct {
+	ChannelId        string `json:"channel_id"`
+	LastSyncAt       int64  `json:"last_sync_at"`
+	LastPostUpdateAt int64  `json:"last_post_update_at"`
+	PendingPosts     int64  `json:"pending_posts"`
+}
@@ -96,6 +96,36 @@ func TestFixTopics(t *testing.T) {
 	}
 }
 
+func TestRemoteClusterProfilePolicy(t *testing.T) {
+	policy := &RemoteClusterProfilePolicy{HiddenFields: []string{RemoteProfileFieldEmail, RemoteProfileFieldCustomAttributes, RemoteProfileFieldEmail}}
+	require.Nil(t, policy.IsValid())
+
+	rc := &RemoteCluster{HiddenProfileFields: policy.ToHiddenProfileFields()}
+	assert.Equal(t, "custom_attributes email", rc.HiddenProfileFields)
+	assert.True(t, rc.IsProfileFieldHidden(RemoteProfileFieldEmail))
+	assert.True(t, rc.IsProfileFieldHidden(RemoteProfileFieldCustomAttributes))
+	assert.False(t, rc.IsProfileFieldHidden(RemoteProfileFieldPosition))
+	assert.ElementsMatch(t, []string{RemoteProfileFieldEmail, RemoteProfileFieldCustomAttributes}, rc.GetProfilePolicy().HiddenFields)
+
+	policy.HiddenFields = append(policy.HiddenFields, "password")
+	require.NotNil(t, policy.IsValid())
+
+	assert.Empty(t, (&RemoteCluster{}).GetProfilePolicy().HiddenFields)
+}
+
+func TestRemoteClusterInvitePolicy(t *testing.T) {
+	assert.Equal(t, RemoteInvitePolicyAccept, (&RemoteCluster{}).GetInvitePolicy().Policy)
+
+	rc := &RemoteCluster{InvitePolicy: RemoteInvitePolicyRequireApproval}
+	assert.Equal(t, RemoteInvitePolicyRequireApproval, rc.GetInvitePolicy().Policy)
+
+	for _, policy := range []string{RemoteInvitePolicyAccept, RemoteInvitePolicyRequireApproval, RemoteInvitePolicyDeny} {
+		require.Nil(t, (&RemoteClusterInvitePolicy{Policy: policy}).IsValid())
+	}
+	require.NotNil(t, (&RemoteClusterInvitePolicy{}).IsValid())
+	require.NotNil(t, (&RemoteClusterInvitePolicy{Policy: "maybe"}).IsValid())
+}
+
 func TestRemoteClusterInviteEncryption(t *testing.T) {
 	testData := []struct {
 		name       string
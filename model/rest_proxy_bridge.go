@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	RESTProxyBridgeEventPostCreated          = "post_created"
+	RESTProxyBridgeEventPostEdited           = "post_edited"
+	RESTProxyBridgeEventPostDeleted          = "post_deleted"
+	RESTProxyBridgeEventChannelMemberAdded   = "channel_member_added"
+	RESTProxyBridgeEventChannelMemberRemoved = "channel_member_removed"
+	RESTProxyBridgeEventTeamMemberAdded      = "team_member_added"
+	RESTProxyBridgeEventTeamMemberRemoved    = "team_member_removed"
+
+	RESTProxyBridgeDriverKafka    = "kafka_rest_proxy"
+	RESTProxyBridgeDriverRabbitMQ = "rabbitmq_http_api"
+
+	RESTProxyBridgeMessageErrorMaxRunes = 1024
+
+	restProxyBridgeFirstRetryDelay = 10 * time.Second
+	restProxyBridgeMaxRetryDelay   = 10 * time.Minute
+)
+
+var AllRESTProxyBridgeEvents = []string{
+	RESTProxyBridgeEventPostCreated,
+	RESTProxyBridgeEventPostEdited,
+	RESTProxyBridgeEventPostDeleted,
+	RESTProxyBridgeEventChannelMemberAdded,
+	RESTProxyBridgeEventChannelMemberRemoved,
+	RESTProxyBridgeEventTeamMemberAdded,
+	RESTProxyBridgeEventTeamMemberRemoved,
+}
+
+func IsValidRESTProxyBridgeEvent(event string) bool {
+	for _, valid := range AllRESTProxyBridgeEvents {
+		if event == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// RESTProxyBridgePayload is the body of a message published to the message queue. Consumers may
+// be sent a message more than once, and tell the copies apart by its id.
+type RESTProxyBridgePayload struct {
+	Id       string      `json:"id"`
+	Event    string      `json:"event"`
+	CreateAt int64       `json:"create_at"`
+	Data     interface{} `json:"data"`
+}
+
+// RESTProxyBridgeMessage is an event waiting in the outbox to be published to the message queue,
+// or published already and kept until the end of its retention. The message key is the one the
+// queue orders the messages by, such as the id of the channel of a post.
+type RESTProxyBridgeMessage struct {
+	Id            string `json:"id"`
+	Event         string `json:"event"`
+	Topic         string `json:"topic"`
+	MessageKey    string `json:"message_key"`
+	Payload       string `json:"payload"`
+	Attempts      int    `json:"attempts"`
+	LastError     string `json:"last_error"`
+	NextAttemptAt int64  `json:"next_attempt_at"`
+	PublishedAt   int64  `json:"published_at"`
+	CreateAt      int64  `json:"create_at"`
+}
+
+func (m *RESTProxyBridgeMessage) PreSave() {
+	if m.Id == "" {
+		m.Id = NewId()
+	}
+
+	if m.CreateAt == 0 {
+		m.CreateAt = GetMillis()
+	}
+}
+
+// RecordFailure updates the message with a failed attempt to publish it. The message is
+// retried with an exponential backoff, and never given up on.
+func (m *RESTProxyBridgeMessage) RecordFailure(attemptErr string, now int64) {
+	m.Attempts++
+	m.LastError = attemptErr
+	if utf8.RuneCountInString(m.LastError) > RESTProxyBridgeMessageErrorMaxRunes {
+		m.LastError = string([]rune(m.LastError)[:RESTProxyBridgeMessageErrorMaxRunes])
+	}
+	m.NextAttemptAt = now + RESTProxyBridgeRetryDelay(m.Attempts).Milliseconds()
+}
+
+// RESTProxyBridgeRetryDelay returns how long to wait before publishing a message again after the
+// given number of failed attempts, doubling with each attempt up to ten minutes.
+func RESTProxyBridgeRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	if attempts > 16 {
+		return restProxyBridgeMaxRetryDelay
+	}
+	delay := restProxyBridgeFirstRetryDelay << (attempts - 1)
+	if delay > restProxyBridgeMaxRetryDelay {
+		return restProxyBridgeMaxRetryDelay
+	}
+	return delay
+}
+
+// RESTProxyBridgeOffset identifies a message of the outbox. The messages are published in the
+// order of their offsets.
+type RESTProxyBridgeOffset struct {
+	MessageId   string `json:"message_id"`
+	Event       string `json:"event"`
+	CreateAt    int64  `json:"create_at"`
+	PublishedAt int64  `json:"published_at"`
+}
+
+// RESTProxyBridgeStatus reports how far the REST proxy bridge got in publishing the outbox. The
+// next pending message is the one holding up the others when the queue can't be reached.
+type RESTProxyBridgeStatus struct {
+	Enabled         bool                    `json:"enabled"`
+	Driver          string                  `json:"driver"`
+	Healthy         bool                    `json:"healthy"`
+	PendingCount    int64                   `json:"pending_count"`
+	OldestPendingAt int64                   `json:"oldest_pending_at"`
+	LastPublished   *RESTProxyBridgeOffset  `json:"last_published"`
+	NextPending     *RESTProxyBridgeMessage `json:"next_pending"`
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRESTProxyBridgeRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), RESTProxyBridgeRetryDelay(0))
+	assert.Equal(t, 10*time.Second, RESTProxyBridgeRetryDelay(1))
+	assert.Equal(t, 20*time.Second, RESTProxyBridgeRetryDelay(2))
+	assert.Equal(t, 320*time.Second, RESTProxyBridgeRetryDelay(6))
+	assert.Equal(t, 10*time.Minute, RESTProxyBridgeRetryDelay(7))
+	assert.Equal(t, 10*time.Minute, RESTProxyBridgeRetryDelay(100))
+}
+
+func TestRESTProxyBridgeMessageRecordFailure(t *testing.T) {
+	message := &RESTProxyBridgeMessage{Id: NewId()}
+
+	message.RecordFailure("connection refused", 1000)
+	assert.Equal(t, 1, message.Attempts)
+	assert.Equal(t, "connection refused", message.LastError)
+	assert.Equal(t, int64(1000)+RESTProxyBridgeRetryDelay(1).Milliseconds(), message.NextAttemptAt)
+
+	message.RecordFailure(strings.Repeat("é", RESTProxyBridgeMessageErrorMaxRunes+1), 2000)
+	assert.Equal(t, 2, message.Attempts)
+	assert.Equal(t, RESTProxyBridgeMessageErrorMaxRunes, len([]rune(message.LastError)))
+	assert.Equal(t, int64(2000)+RESTProxyBridgeRetryDelay(2).Milliseconds(), message.NextAttemptAt)
+}
@@ -13,6 +13,8 @@ var SysconsoleAncillaryPermissions map[string][]*Permission
 var SystemManagerDefaultPermissions []string
 var SystemUserManagerDefaultPermissions []string
 var SystemReadOnlyAdminDefaultPermissions []string
+var SystemBillingViewerDefaultPermissions []string
+var SystemComplianceViewerDefaultPermissions []string
 
 var BuiltInSchemeManagedRoleIDs []string
 
@@ -23,6 +25,8 @@ func init() {
 		SystemUserManagerRoleId,
 		SystemReadOnlyAdminRoleId,
 		SystemManagerRoleId,
+		SystemBillingViewerRoleId,
+		SystemComplianceViewerRoleId,
 	}
 
 	BuiltInSchemeManagedRoleIDs = append([]string{
@@ -106,6 +110,7 @@ func init() {
 		},
 		PermissionSysconsoleReadReportingTeamStatistics.Id: {
 			PermissionViewTeam,
+			PermissionViewTeamExtendedStats,
 		},
 		PermissionSysconsoleWriteUserManagementUsers.Id: {
 			PermissionEditOtherUsers,
@@ -121,6 +126,9 @@ func init() {
 			PermissionDeletePrivateChannel,
 			PermissionDeletePublicChannel,
 			PermissionManageChannelRoles,
+			PermissionManageChannelCommands,
+			PermissionManageChannelScheduledMessages,
+			PermissionRestorePosts,
 			PermissionConvertPublicChannelToPrivate,
 			PermissionConvertPrivateChannelToPublic,
 		},
@@ -262,6 +270,19 @@ func init() {
 		PermissionSysconsoleReadExperimentalBleve.Id,
 	}
 
+	SystemBillingViewerDefaultPermissions = []string{
+		PermissionSysconsoleReadAboutEditionAndLicense.Id,
+		PermissionSysconsoleReadBilling.Id,
+	}
+
+	SystemComplianceViewerDefaultPermissions = []string{
+		PermissionSysconsoleReadComplianceDataRetentionPolicy.Id,
+		PermissionSysconsoleReadComplianceComplianceExport.Id,
+		PermissionSysconsoleReadComplianceComplianceMonitoring.Id,
+		PermissionSysconsoleReadComplianceCustomTermsOfService.Id,
+		PermissionReadAudits.Id,
+	}
+
 	SystemManagerDefaultPermissions = []string{
 		PermissionSysconsoleReadAboutEditionAndLicense.Id,
 		PermissionSysconsoleReadReportingSiteStatistics.Id,
@@ -344,21 +365,25 @@ func init() {
 	SystemUserManagerDefaultPermissions = AddAncillaryPermissions(SystemUserManagerDefaultPermissions)
 	SystemReadOnlyAdminDefaultPermissions = AddAncillaryPermissions(SystemReadOnlyAdminDefaultPermissions)
 	SystemManagerDefaultPermissions = AddAncillaryPermissions(SystemManagerDefaultPermissions)
+	SystemBillingViewerDefaultPermissions = AddAncillaryPermissions(SystemBillingViewerDefaultPermissions)
+	SystemComplianceViewerDefaultPermissions = AddAncillaryPermissions(SystemComplianceViewerDefaultPermissions)
 }
 
 type RoleType string
 type RoleScope string
 
 const (
-	SystemGuestRoleId           = "system_guest"
-	SystemUserRoleId            = "system_user"
-	SystemAdminRoleId           = "system_admin"
-	SystemPostAllRoleId         = "system_post_all"
-	SystemPostAllPublicRoleId   = "system_post_all_public"
-	SystemUserAccessTokenRoleId = "system_user_access_token"
-	SystemUserManagerRoleId     = "system_user_manager"
-	SystemReadOnlyAdminRoleId   = "system_read_only_admin"
-	SystemManagerRoleId         = "system_manager"
+	SystemGuestRoleId            = "system_guest"
+	SystemUserRoleId             = "system_user"
+	SystemAdminRoleId            = "system_admin"
+	SystemPostAllRoleId          = "system_post_all"
+	SystemPostAllPublicRoleId    = "system_post_all_public"
+	SystemUserAccessTokenRoleId  = "system_user_access_token"
+	SystemUserManagerRoleId      = "system_user_manager"
+	SystemReadOnlyAdminRoleId    = "system_read_only_admin"
+	SystemManagerRoleId          = "system_manager"
+	SystemBillingViewerRoleId    = "system_billing_viewer"
+	SystemComplianceViewerRoleId = "system_compliance_viewer"
 
 	TeamGuestRoleId         = "team_guest"
 	TeamUserRoleId          = "team_user"
@@ -705,6 +730,7 @@ func MakeDefaultRoles() map[string]*Role {
 			PermissionAddReaction.Id,
 			PermissionRemoveReaction.Id,
 			PermissionUploadFile.Id,
+			PermissionDownloadFile.Id,
 			PermissionEditPost.Id,
 			PermissionCreatePost.Id,
 			PermissionUseChannelMentions.Id,
@@ -724,6 +750,7 @@ func MakeDefaultRoles() map[string]*Role {
 			PermissionRemoveReaction.Id,
 			PermissionManagePublicChannelMembers.Id,
 			PermissionUploadFile.Id,
+			PermissionDownloadFile.Id,
 			PermissionGetPublicLink.Id,
 			PermissionCreatePost.Id,
 			PermissionUseChannelMentions.Id,
@@ -746,6 +773,9 @@ func MakeDefaultRoles() map[string]*Role {
 		Description: "authentication.roles.channel_admin.description",
 		Permissions: []string{
 			PermissionManageChannelRoles.Id,
+			PermissionManageChannelCommands.Id,
+			PermissionManageChannelScheduledMessages.Id,
+			PermissionRestorePosts.Id,
 			PermissionUseGroupMentions.Id,
 		},
 		SchemeManaged: true,
@@ -825,6 +855,11 @@ func MakeDefaultRoles() map[string]*Role {
 			PermissionConvertPrivateChannelToPublic.Id,
 			PermissionDeletePost.Id,
 			PermissionDeleteOthersPosts.Id,
+			PermissionViewTeamExtendedStats.Id,
+			PermissionManageChannelCommands.Id,
+			PermissionManageChannelScheduledMessages.Id,
+			PermissionRestorePosts.Id,
+			PermissionManagePostReports.Id,
 		},
 		SchemeManaged: true,
 		BuiltIn:       true,
@@ -914,6 +949,7 @@ func MakeDefaultRoles() map[string]*Role {
 			PermissionEditCustomGroup.Id,
 			PermissionDeleteCustomGroup.Id,
 			PermissionManageCustomGroupMembers.Id,
+			PermissionSearchArchivedChannels.Id,
 		},
 		SchemeManaged: true,
 		BuiltIn:       true,
@@ -983,6 +1019,24 @@ func MakeDefaultRoles() map[string]*Role {
 		BuiltIn:       true,
 	}
 
+	roles[SystemBillingViewerRoleId] = &Role{
+		Name:          "system_billing_viewer",
+		DisplayName:   "authentication.roles.system_billing_viewer.name",
+		Description:   "authentication.roles.system_billing_viewer.description",
+		Permissions:   SystemBillingViewerDefaultPermissions,
+		SchemeManaged: false,
+		BuiltIn:       true,
+	}
+
+	roles[SystemComplianceViewerRoleId] = &Role{
+		Name:          "system_compliance_viewer",
+		DisplayName:   "authentication.roles.system_compliance_viewer.name",
+		Description:   "authentication.roles.system_compliance_viewer.description",
+		Permissions:   SystemComplianceViewerDefaultPermissions,
+		SchemeManaged: false,
+		BuiltIn:       true,
+	}
+
 	allPermissionIDs := []string{}
 	for _, permission := range AllPermissions {
 		allPermissionIDs = append(allPermissionIDs, permission.Id)
@@ -4,9 +4,11 @@
 package model
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChannelModeratedPermissionsChangedByPatch(t *testing.T) {
@@ -276,3 +278,23 @@ func TestGetChannelModeratedPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestSystemViewerRolesPermissions(t *testing.T) {
+	roles := MakeDefaultRoles()
+
+	billingViewer := roles[SystemBillingViewerRoleId]
+	require.NotNil(t, billingViewer)
+	assert.Contains(t, billingViewer.Permissions, PermissionSysconsoleReadBilling.Id)
+	assert.Contains(t, billingViewer.Permissions, PermissionReadLicenseInformation.Id)
+	assert.NotContains(t, billingViewer.Permissions, PermissionSysconsoleWriteBilling.Id)
+
+	complianceViewer := roles[SystemComplianceViewerRoleId]
+	require.NotNil(t, complianceViewer)
+	assert.Contains(t, complianceViewer.Permissions, PermissionReadComplianceExportJob.Id)
+	assert.Contains(t, complianceViewer.Permissions, PermissionReadDataRetentionJob.Id)
+	assert.Contains(t, complianceViewer.Permissions, PermissionReadAudits.Id)
+	for _, permission := range complianceViewer.Permissions {
+		assert.NotEqual(t, PermissionManageSystem.Id, permission)
+		assert.False(t, strings.HasPrefix(permission, "sysconsole_write_"), permission)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ScheduledChannelMessageFrequencyDaily   = "daily"
+	ScheduledChannelMessageFrequencyWeekly  = "weekly"
+	ScheduledChannelMessageFrequencyMonthly = "monthly"
+
+	ScheduledChannelMessageMaxPerChannel = 25
+	ScheduledChannelMessageMaxSkipDates  = 100
+
+	PostPropsScheduledChannelMessageId = "scheduled_channel_message_id"
+
+	scheduledChannelMessageTimeLayout       = "15:04"
+	scheduledChannelMessageDateLayout       = "2006-01-02"
+	scheduledChannelMessageAnnualDateLayout = "01-02"
+
+	// scheduledChannelMessageLookaheadDays bounds the search for the next run, should the skipped
+	// dates leave none.
+	scheduledChannelMessageLookaheadDays = 2 * 366
+)
+
+// ScheduledChannelMessage is an announcement posted to a channel on a recurring schedule, such
+// as a weekly standup reminder. The runs falling on a weekend, when asked, or on one of the
+// skipped dates are skipped. A skipped date is either a single day, as 2006-01-02, or a day
+// of every year, as 12-25.
+type ScheduledChannelMessage struct {
+	Id           string      `json:"id"`
+	ChannelId    string      `json:"channel_id"`
+	CreatorId    string      `json:"creator_id"`
+	Message      string      `json:"message"`
+	Frequency    string      `json:"frequency"`
+	Weekday      int         `json:"weekday"`
+	DayOfMonth   int         `json:"day_of_month"`
+	TimeOfDay    string      `json:"time_of_day"`
+	Timezone     string      `json:"timezone"`
+	SkipWeekends bool        `json:"skip_weekends"`
+	SkipDates    StringArray `json:"skip_dates"`
+	NextRunAt    int64       `json:"next_run_at"`
+	LastRunAt    int64       `json:"last_run_at"`
+	CreateAt     int64       `json:"create_at"`
+	UpdateAt     int64       `json:"update_at"`
+}
+
+func (m *ScheduledChannelMessage) PreSave() {
+	if m.Id == "" {
+		m.Id = NewId()
+	}
+
+	if m.Timezone == "" {
+		m.Timezone = "UTC"
+	}
+
+	if m.SkipDates == nil {
+		m.SkipDates = StringArray{}
+	}
+
+	m.LastRunAt = 0
+	m.CreateAt = GetMillis()
+	m.UpdateAt = m.CreateAt
+}
+
+func (m *ScheduledChannelMessage) PreUpdate() {
+	if m.Timezone == "" {
+		m.Timezone = "UTC"
+	}
+
+	if m.SkipDates == nil {
+		m.SkipDates = StringArray{}
+	}
+
+	m.UpdateAt = GetMillis()
+}
+
+func (m *ScheduledChannelMessage) IsValid(maxPostSize int) *AppError {
+	if !IsValidId(m.Id) {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.ChannelId) {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.channel_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.CreatorId) {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.creator_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.Message == "" || utf8.RuneCountInString(m.Message) > maxPostSize {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.message.app_error", map[string]interface{}{"MaxLength": maxPostSize}, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	switch m.Frequency {
+	case ScheduledChannelMessageFrequencyDaily:
+	case ScheduledChannelMessageFrequencyWeekly:
+		if m.Weekday < int(time.Sunday) || m.Weekday > int(time.Saturday) {
+			return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.weekday.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+		}
+	case ScheduledChannelMessageFrequencyMonthly:
+		if m.DayOfMonth < 1 || m.DayOfMonth > 31 {
+			return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.day_of_month.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.frequency.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if _, err := time.Parse(scheduledChannelMessageTimeLayout, m.TimeOfDay); err != nil {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.time_of_day.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if _, err := time.LoadLocation(m.Timezone); err != nil {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.timezone.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if len(m.SkipDates) > ScheduledChannelMessageMaxSkipDates {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.skip_dates.app_error", map[string]interface{}{"Max": ScheduledChannelMessageMaxSkipDates}, "id="+m.Id, http.StatusBadRequest)
+	}
+	for _, date := range m.SkipDates {
+		if !isValidScheduledChannelMessageSkipDate(date) {
+			return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.skip_dates.app_error", map[string]interface{}{"Max": ScheduledChannelMessageMaxSkipDates}, "id="+m.Id+", date="+date, http.StatusBadRequest)
+		}
+	}
+
+	if m.CreateAt == 0 {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.create_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.UpdateAt == 0 {
+		return NewAppError("ScheduledChannelMessage.IsValid", "model.scheduled_channel_message.is_valid.update_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func isValidScheduledChannelMessageSkipDate(date string) bool {
+	if _, err := time.Parse(scheduledChannelMessageDateLayout, date); err == nil {
+		return true
+	}
+	// Parsed within a leap year so that 02-29 is accepted.
+	_, err := time.Parse(scheduledChannelMessageDateLayout, "2000-"+date)
+	return err == nil && len(date) == len(scheduledChannelMessageAnnualDateLayout)
+}
+
+// NextRunAfter returns the time of the first run strictly after the given time, in
+// milliseconds, skipping the runs that fall on a skipped day. It returns 0 when there is no
+// such run within the next two years, or when the schedule is invalid.
+func (m *ScheduledChannelMessage) NextRunAfter(after int64) int64 {
+	location, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return 0
+	}
+	timeOfDay, err := time.Parse(scheduledChannelMessageTimeLayout, m.TimeOfDay)
+	if err != nil {
+		return 0
+	}
+
+	start := time.Unix(0, after*int64(time.Millisecond)).In(location)
+	for i := 0; i < scheduledChannelMessageLookaheadDays; i++ {
+		run := time.Date(start.Year(), start.Month(), start.Day()+i, timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, location)
+		if run.UnixNano()/int64(time.Millisecond) <= after || !m.runsOn(run) || m.skips(run) {
+			continue
+		}
+		return run.UnixNano() / int64(time.Millisecond)
+	}
+
+	return 0
+}
+
+func (m *ScheduledChannelMessage) runsOn(day time.Time) bool {
+	switch m.Frequency {
+	case ScheduledChannelMessageFrequencyDaily:
+		return true
+	case ScheduledChannelMessageFrequencyWeekly:
+		return int(day.Weekday()) == m.Weekday
+	case ScheduledChannelMessageFrequencyMonthly:
+		// The runs of the days a month doesn't have happen on its last day instead.
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		if m.DayOfMonth > lastDay {
+			return day.Day() == lastDay
+		}
+		return day.Day() == m.DayOfMonth
+	}
+	return false
+}
+
+func (m *ScheduledChannelMessage) skips(day time.Time) bool {
+	if m.SkipWeekends && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+		return true
+	}
+
+	date := day.Format(scheduledChannelMessageDateLayout)
+	annualDate := day.Format(scheduledChannelMessageAnnualDateLayout)
+	for _, skipped := range m.SkipDates {
+		if skipped == date || skipped == annualDate {
+			return true
+		}
+	}
+	return false
+}
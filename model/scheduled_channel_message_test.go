@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledChannelMessageIsValid(t *testing.T) {
+	message := &ScheduledChannelMessage{
+		ChannelId: NewId(),
+		CreatorId: NewId(),
+		Message:   "Standup in 10 minutes!",
+		Frequency: ScheduledChannelMessageFrequencyWeekly,
+		Weekday:   int(time.Monday),
+		TimeOfDay: "09:50",
+		Timezone:  "Europe/Paris",
+		SkipDates: StringArray{"2026-04-06", "12-25", "02-29"},
+	}
+	message.PreSave()
+	require.Nil(t, message.IsValid(PostMessageMaxRunesV2))
+
+	message.Message = ""
+	require.NotNil(t, message.IsValid(PostMessageMaxRunesV2))
+	message.Message = "Standup in 10 minutes!"
+
+	message.Weekday = 7
+	require.NotNil(t, message.IsValid(PostMessageMaxRunesV2))
+	message.Weekday = int(time.Monday)
+
+	message.Frequency = "yearly"
+	require.NotNil(t, message.IsValid(PostMessageMaxRunesV2))
+	message.Frequency = ScheduledChannelMessageFrequencyMonthly
+	message.DayOfMonth = 0
+	require.NotNil(t, message.IsValid(PostMessageMaxRunesV2))
+	message.DayOfMonth = 31
+	require.Nil(t, message.IsValid(PostMessageMaxRunesV2))
+
+	for _, timeOfDay := range []string{"", "9", "24:00", "09:60"} {
+		message.TimeOfDay = timeOfDay
+		require.NotNil(t, message.IsValid(PostMessageMaxRunesV2), timeOfDay)
+	}
+	message.TimeOfDay = "09:50"
+
+	message.Timezone = "Mars/Olympus_Mons"
+	require.NotNil(t, message.IsValid(PostMessageMaxRunesV2))
+	message.Timezone = "Europe/Paris"
+
+	for _, date := range []string{"2026-13-01", "12-32", "1225", "tomorrow"} {
+		message.SkipDates = StringArray{date}
+		require.NotNil(t, message.IsValid(PostMessageMaxRunesV2), date)
+	}
+}
+
+func TestScheduledChannelMessageNextRunAfter(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	millis := func(year int, month time.Month, day, hour, minute int) int64 {
+		return time.Date(year, month, day, hour, minute, 0, 0, paris).UnixNano() / int64(time.Millisecond)
+	}
+
+	t.Run("daily", func(t *testing.T) {
+		message := &ScheduledChannelMessage{Frequency: ScheduledChannelMessageFrequencyDaily, TimeOfDay: "09:30", Timezone: "Europe/Paris"}
+		assert.Equal(t, millis(2026, time.March, 2, 9, 30), message.NextRunAfter(millis(2026, time.March, 2, 8, 0)))
+		assert.Equal(t, millis(2026, time.March, 3, 9, 30), message.NextRunAfter(millis(2026, time.March, 2, 9, 30)))
+	})
+
+	t.Run("daily skipping weekends", func(t *testing.T) {
+		message := &ScheduledChannelMessage{Frequency: ScheduledChannelMessageFrequencyDaily, TimeOfDay: "09:30", Timezone: "Europe/Paris", SkipWeekends: true}
+		// Friday, March 6th, 2026 is followed by a weekend.
+		assert.Equal(t, millis(2026, time.March, 9, 9, 30), message.NextRunAfter(millis(2026, time.March, 6, 10, 0)))
+	})
+
+	t.Run("weekly skipping holidays", func(t *testing.T) {
+		message := &ScheduledChannelMessage{
+			Frequency: ScheduledChannelMessageFrequencyWeekly,
+			Weekday:   int(time.Monday),
+			TimeOfDay: "09:50",
+			Timezone:  "Europe/Paris",
+			SkipDates: StringArray{"2026-04-06", "12-28"},
+		}
+		assert.Equal(t, millis(2026, time.March, 30, 9, 50), message.NextRunAfter(millis(2026, time.March, 26, 12, 0)))
+		assert.Equal(t, millis(2026, time.April, 13, 9, 50), message.NextRunAfter(millis(2026, time.March, 30, 9, 50)))
+		assert.Equal(t, millis(2027, time.January, 4, 9, 50), message.NextRunAfter(millis(2026, time.December, 22, 0, 0)))
+	})
+
+	t.Run("monthly on days some months don't have", func(t *testing.T) {
+		message := &ScheduledChannelMessage{Frequency: ScheduledChannelMessageFrequencyMonthly, DayOfMonth: 31, TimeOfDay: "17:00", Timezone: "Europe/Paris"}
+		assert.Equal(t, millis(2026, time.February, 28, 17, 0), message.NextRunAfter(millis(2026, time.February, 1, 0, 0)))
+		assert.Equal(t, millis(2026, time.March, 31, 17, 0), message.NextRunAfter(millis(2026, time.February, 28, 17, 0)))
+	})
+
+	t.Run("across daylight saving time", func(t *testing.T) {
+		message := &ScheduledChannelMessage{Frequency: ScheduledChannelMessageFrequencyDaily, TimeOfDay: "09:00", Timezone: "Europe/Paris"}
+		// Clocks go forward on March 29th, 2026 in Paris.
+		assert.Equal(t, millis(2026, time.March, 30, 9, 0), message.NextRunAfter(millis(2026, time.March, 29, 9, 0)))
+	})
+
+	t.Run("every run skipped", func(t *testing.T) {
+		message := &ScheduledChannelMessage{Frequency: ScheduledChannelMessageFrequencyMonthly, DayOfMonth: 1, TimeOfDay: "09:00", Timezone: "UTC"}
+		for month := 1; month <= 12; month++ {
+			message.SkipDates = append(message.SkipDates, time.Date(2000, time.Month(month), 1, 0, 0, 0, 0, time.UTC).Format("01-02"))
+		}
+		assert.Zero(t, message.NextRunAfter(GetMillis()))
+	})
+}
@@ -5,6 +5,7 @@ package model
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 )
 
@@ -16,6 +17,19 @@ const (
 	SchemeScopeChannel         = "channel"
 	SchemeScopePlaybook        = "playbook"
 	SchemeScopeRun             = "run"
+
+	// SchemeAssignmentMaxIds is the maximum number of teams or channels a single
+	// SchemeAssignment may reference.
+	SchemeAssignmentMaxIds = 50000
+	// SchemeAssignmentJobThreshold is the number of teams or channels above which
+	// an assignment is processed by a background job rather than inline.
+	SchemeAssignmentJobThreshold = 100
+
+	// What to do when importing a scheme whose name is already taken: fail, import it under
+	// another name, or overwrite the existing scheme and the permissions of its roles.
+	SchemeImportOnConflictFail      = "fail"
+	SchemeImportOnConflictRename    = "rename"
+	SchemeImportOnConflictOverwrite = "overwrite"
 )
 
 type Scheme struct {
@@ -49,6 +63,48 @@ type SchemeIDPatch struct {
 	SchemeID *string `json:"scheme_id"`
 }
 
+// SchemeAssignment lists the teams or channels to switch to a scheme in bulk.
+// Exactly one of the two lists must be set, matching the scope of the scheme.
+type SchemeAssignment struct {
+	TeamIds    []string `json:"team_ids"`
+	ChannelIds []string `json:"channel_ids"`
+}
+
+func (sa *SchemeAssignment) IsValid() bool {
+	if (len(sa.TeamIds) == 0) == (len(sa.ChannelIds) == 0) {
+		return false
+	}
+
+	ids := sa.Ids()
+	if len(ids) > SchemeAssignmentMaxIds {
+		return false
+	}
+
+	for _, id := range ids {
+		if !IsValidId(id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Scope returns the scheme scope the assignment applies to.
+func (sa *SchemeAssignment) Scope() string {
+	if len(sa.TeamIds) > 0 {
+		return SchemeScopeTeam
+	}
+	return SchemeScopeChannel
+}
+
+// Ids returns whichever of TeamIds or ChannelIds is set.
+func (sa *SchemeAssignment) Ids() []string {
+	if len(sa.TeamIds) > 0 {
+		return sa.TeamIds
+	}
+	return sa.ChannelIds
+}
+
 // SchemeConveyor is used for importing and exporting a Scheme and its associated Roles.
 type SchemeConveyor struct {
 	Name           string  `json:"name"`
@@ -87,6 +143,61 @@ func (sc *SchemeConveyor) Scheme() *Scheme {
 	}
 }
 
+// IsValid checks that the conveyor describes a valid scheme and includes each of the default
+// roles of the scheme, with known permissions.
+func (sc *SchemeConveyor) IsValid() *AppError {
+	if sc.DisplayName == "" || len(sc.DisplayName) > SchemeDisplayNameMaxLength {
+		return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.display_name.app_error", map[string]interface{}{"MaxLength": SchemeDisplayNameMaxLength}, "", http.StatusBadRequest)
+	}
+
+	if !IsValidSchemeName(sc.Name) {
+		return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.name.app_error", nil, "name="+sc.Name, http.StatusBadRequest)
+	}
+
+	if len(sc.Description) > SchemeDescriptionMaxLength {
+		return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.description.app_error", map[string]interface{}{"MaxLength": SchemeDescriptionMaxLength}, "", http.StatusBadRequest)
+	}
+
+	switch sc.Scope {
+	case SchemeScopeTeam:
+	case SchemeScopeChannel:
+		if sc.TeamAdmin != "" || sc.TeamUser != "" || sc.TeamGuest != "" || sc.PlaybookAdmin != "" || sc.PlaybookMember != "" || sc.RunAdmin != "" || sc.RunMember != "" {
+			return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.scope_roles.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.scope.app_error", nil, "scope="+sc.Scope, http.StatusBadRequest)
+	}
+
+	roles := make(map[string]*Role, len(sc.Roles))
+	for _, role := range sc.Roles {
+		if role == nil || !role.IsValidWithoutId() {
+			return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.role.app_error", nil, "", http.StatusBadRequest)
+		}
+		roles[role.Name] = role
+	}
+
+	for _, roleName := range sc.Scheme().DefaultRoleNames() {
+		if roleName == "" {
+			continue
+		}
+		if _, ok := roles[roleName]; !ok {
+			return NewAppError("SchemeConveyor.IsValid", "model.scheme_conveyor.is_valid.missing_role.app_error", map[string]interface{}{"Name": roleName}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// Role returns the role of the conveyor with the given name, or nil.
+func (sc *SchemeConveyor) Role(name string) *Role {
+	for _, role := range sc.Roles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
 type SchemeRoles struct {
 	SchemeAdmin bool `json:"scheme_admin"`
 	SchemeUser  bool `json:"scheme_user"`
@@ -179,6 +290,23 @@ func (scheme *Scheme) IsValidForCreate() bool {
 	return true
 }
 
+// DefaultRoleNames returns the names of the default roles of the scheme, always in the same
+// order, with empty names for the roles the scope of the scheme doesn't have.
+func (scheme *Scheme) DefaultRoleNames() []string {
+	return []string{
+		scheme.DefaultTeamAdminRole,
+		scheme.DefaultTeamUserRole,
+		scheme.DefaultTeamGuestRole,
+		scheme.DefaultChannelAdminRole,
+		scheme.DefaultChannelUserRole,
+		scheme.DefaultChannelGuestRole,
+		scheme.DefaultPlaybookAdminRole,
+		scheme.DefaultPlaybookMemberRole,
+		scheme.DefaultRunAdminRole,
+		scheme.DefaultRunMemberRole,
+	}
+}
+
 func (scheme *Scheme) Patch(patch *SchemePatch) {
 	if patch.DisplayName != nil {
 		scheme.DisplayName = *patch.DisplayName
@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemeConveyorIsValid(t *testing.T) {
+	newRole := func(name string) *Role {
+		return &Role{Name: name, DisplayName: name, Permissions: []string{PermissionReadChannel.Id}}
+	}
+	newConveyor := func() *SchemeConveyor {
+		return &SchemeConveyor{
+			Name:         "staging_scheme",
+			DisplayName:  "Staging",
+			Scope:        SchemeScopeChannel,
+			ChannelAdmin: "channel_admin_role",
+			ChannelUser:  "channel_user_role",
+			ChannelGuest: "channel_guest_role",
+			Roles: []*Role{
+				newRole("channel_admin_role"),
+				newRole("channel_user_role"),
+				newRole("channel_guest_role"),
+			},
+		}
+	}
+
+	assert.Nil(t, newConveyor().IsValid())
+
+	conveyor := newConveyor()
+	conveyor.Name = "Not Valid"
+	assert.NotNil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.DisplayName = ""
+	assert.NotNil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.Scope = "system"
+	assert.NotNil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.TeamUser = "team_user_role"
+	conveyor.Roles = append(conveyor.Roles, newRole("team_user_role"))
+	assert.NotNil(t, conveyor.IsValid())
+	conveyor.Scope = SchemeScopeTeam
+	assert.Nil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.Roles = conveyor.Roles[1:]
+	assert.NotNil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.Roles[0].Permissions = []string{"not_a_permission"}
+	assert.NotNil(t, conveyor.IsValid())
+
+	conveyor = newConveyor()
+	conveyor.Roles = append(conveyor.Roles, nil)
+	assert.NotNil(t, conveyor.IsValid())
+}
@@ -13,6 +13,55 @@ import (
 var searchTermPuncStart = regexp.MustCompile(`^[^\pL\d\s#"]+`)
 var searchTermPuncEnd = regexp.MustCompile(`[^\pL\d\s*"]+$`)
 
+const (
+	SearchOperatorFrom     = "from"
+	SearchOperatorIn       = "in"
+	SearchOperatorOn       = "on"
+	SearchOperatorBefore   = "before"
+	SearchOperatorAfter    = "after"
+	SearchOperatorDateTime = "datetime" // before: and after: with a time of day
+	SearchOperatorHasFile  = "has"
+	SearchOperatorFileType = "type"
+	SearchOperatorRegex    = "regex"
+	SearchOperatorLanguage = "lang"
+
+	SearchBackendDatabase = "database"
+	SearchBackendNone     = "none"
+
+	// SearchFromMe is the value of the from: filter that stands for the searching user.
+	SearchFromMe = "me"
+	// SearchHasFile is the only supported value of the has: filter.
+	SearchHasFile = "file"
+
+	SearchRegexMaxLength = 100
+	SearchRegexMaxTerms  = 3
+)
+
+// SearchFileTypeMimePrefixes maps the values of the type: filter to the prefix of the
+// MIME types of the files they match.
+var SearchFileTypeMimePrefixes = map[string]string{
+	"image": "image/",
+	"video": "video/",
+	"audio": "audio/",
+}
+
+// searchDateTimeLayouts are the layouts accepted by the before: and after: filters when
+// a time of day is given, with the precision of each.
+var searchDateTimeLayouts = []struct {
+	layout    string
+	precision time.Duration
+}{
+	{"2006-01-02T15:04:05", time.Second},
+	{"2006-01-02T15:04", time.Minute},
+}
+
+// SearchCapabilities describes the search backend that post searches are run against
+// and the operators it supports.
+type SearchCapabilities struct {
+	Backend   string   `json:"backend"`
+	Operators []string `json:"operators"`
+}
+
 type SearchParams struct {
 	Terms                  string
 	ExcludedTerms          string
@@ -29,6 +78,13 @@ type SearchParams struct {
 	ExcludedExtensions     []string
 	OnDate                 string
 	ExcludedDate           string
+	HasFiles               bool
+	ExcludedHasFiles       bool
+	FileTypes              []string
+	ExcludedFileTypes      []string
+	RegexTerms             []string
+	Languages              []string
+	ExcludedLanguages      []string
 	OrTerms                bool
 	IncludeDeletedChannels bool
 	TimeZoneOffset         int
@@ -36,8 +92,13 @@ type SearchParams struct {
 	SearchWithoutUserId bool
 }
 
-// Returns the epoch timestamp of the start of the day specified by SearchParams.AfterDate
+// Returns the epoch timestamp of the start of the day specified by SearchParams.AfterDate,
+// or of the end of the minute or second if it has a time of day
 func (p *SearchParams) GetAfterDateMillis() int64 {
+	if t, precision, ok := parseSearchDateTime(p.AfterDate, p.TimeZoneOffset); ok {
+		return GetMillisForTime(t.Add(precision))
+	}
+
 	date, err := time.Parse("2006-01-02", PadDateStringZeros(p.AfterDate))
 	if err != nil {
 		date = time.Now()
@@ -49,8 +110,13 @@ func (p *SearchParams) GetAfterDateMillis() int64 {
 	return GetStartOfDayMillis(afterDate, p.TimeZoneOffset)
 }
 
-// Returns the epoch timestamp of the start of the day specified by SearchParams.ExcludedAfterDate
+// Returns the epoch timestamp of the start of the day specified by SearchParams.ExcludedAfterDate,
+// or of the end of the minute or second if it has a time of day
 func (p *SearchParams) GetExcludedAfterDateMillis() int64 {
+	if t, precision, ok := parseSearchDateTime(p.ExcludedAfterDate, p.TimeZoneOffset); ok {
+		return GetMillisForTime(t.Add(precision))
+	}
+
 	date, err := time.Parse("2006-01-02", PadDateStringZeros(p.ExcludedAfterDate))
 	if err != nil {
 		date = time.Now()
@@ -62,8 +128,13 @@ func (p *SearchParams) GetExcludedAfterDateMillis() int64 {
 	return GetStartOfDayMillis(afterDate, p.TimeZoneOffset)
 }
 
-// Returns the epoch timestamp of the end of the day specified by SearchParams.BeforeDate
+// Returns the epoch timestamp of the end of the day specified by SearchParams.BeforeDate,
+// or just before the time if it has a time of day
 func (p *SearchParams) GetBeforeDateMillis() int64 {
+	if t, _, ok := parseSearchDateTime(p.BeforeDate, p.TimeZoneOffset); ok {
+		return GetMillisForTime(t) - 1
+	}
+
 	date, err := time.Parse("2006-01-02", PadDateStringZeros(p.BeforeDate))
 	if err != nil {
 		return 0
@@ -75,8 +146,13 @@ func (p *SearchParams) GetBeforeDateMillis() int64 {
 	return GetEndOfDayMillis(beforeDate, p.TimeZoneOffset)
 }
 
-// Returns the epoch timestamp of the end of the day specified by SearchParams.ExcludedBeforeDate
+// Returns the epoch timestamp of the end of the day specified by SearchParams.ExcludedBeforeDate,
+// or just before the time if it has a time of day
 func (p *SearchParams) GetExcludedBeforeDateMillis() int64 {
+	if t, _, ok := parseSearchDateTime(p.ExcludedBeforeDate, p.TimeZoneOffset); ok {
+		return GetMillisForTime(t) - 1
+	}
+
 	date, err := time.Parse("2006-01-02", PadDateStringZeros(p.ExcludedBeforeDate))
 	if err != nil {
 		return 0
@@ -108,7 +184,75 @@ func (p *SearchParams) GetExcludedDateMillis() (int64, int64) {
 	return GetStartOfDayMillis(date, p.TimeZoneOffset), GetEndOfDayMillis(date, p.TimeZoneOffset)
 }
 
-var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext"}
+// parseSearchDateTime parses a date with a time of day, in the time zone of the search.
+func parseSearchDateTime(value string, timeZoneOffset int) (time.Time, time.Duration, bool) {
+	sep := strings.Index(value, "T")
+	if sep == -1 {
+		return time.Time{}, 0, false
+	}
+	value = PadDateStringZeros(value[:sep]) + value[sep:]
+
+	localSearchTimeZone := time.FixedZone("Local Search Time Zone", timeZoneOffset)
+	for _, l := range searchDateTimeLayouts {
+		if t, err := time.ParseInLocation(l.layout, value, localSearchTimeZone); err == nil {
+			return t, l.precision, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+// Operators returns the search operators used by the SearchParams.
+func (p *SearchParams) Operators() []string {
+	operators := []string{}
+	if len(p.FromUsers) != 0 || len(p.ExcludedUsers) != 0 {
+		operators = append(operators, SearchOperatorFrom)
+	}
+	if len(p.InChannels) != 0 || len(p.ExcludedChannels) != 0 {
+		operators = append(operators, SearchOperatorIn)
+	}
+	if p.OnDate != "" || p.ExcludedDate != "" {
+		operators = append(operators, SearchOperatorOn)
+	}
+	if p.BeforeDate != "" || p.ExcludedBeforeDate != "" {
+		operators = append(operators, SearchOperatorBefore)
+	}
+	if p.AfterDate != "" || p.ExcludedAfterDate != "" {
+		operators = append(operators, SearchOperatorAfter)
+	}
+	for _, date := range []string{p.BeforeDate, p.ExcludedBeforeDate, p.AfterDate, p.ExcludedAfterDate} {
+		if _, _, ok := parseSearchDateTime(date, p.TimeZoneOffset); ok {
+			operators = append(operators, SearchOperatorDateTime)
+			break
+		}
+	}
+	if p.HasFiles || p.ExcludedHasFiles {
+		operators = append(operators, SearchOperatorHasFile)
+	}
+	if len(p.FileTypes) != 0 || len(p.ExcludedFileTypes) != 0 {
+		operators = append(operators, SearchOperatorFileType)
+	}
+	if len(p.RegexTerms) != 0 {
+		operators = append(operators, SearchOperatorRegex)
+	}
+	if len(p.Languages) != 0 || len(p.ExcludedLanguages) != 0 {
+		operators = append(operators, SearchOperatorLanguage)
+	}
+	return operators
+}
+
+// IsValidSearchRegex reports whether pattern can be used as a regular expression search term.
+// Patterns are bounded in length and must be valid RE2 syntax, which rules out the
+// backtracking constructs that could make a database scan expensive.
+func IsValidSearchRegex(pattern string) bool {
+	if pattern == "" || len(pattern) > SearchRegexMaxLength {
+		return false
+	}
+
+	_, err := regexp.Compile(pattern)
+	return err == nil
+}
+
+var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext", "has", "type", "lang"}
 
 type flag struct {
 	name    string
@@ -119,6 +263,7 @@ type flag struct {
 type searchWord struct {
 	value   string
 	exclude bool
+	regex   bool
 }
 
 func splitWords(text string) []string {
@@ -204,6 +349,15 @@ func parseSearchFlags(input []string) ([]searchWord, []flag) {
 			}
 		}
 
+		// a word enclosed in slashes is a regular expression
+		if !isFlag && len(word) > 2 && strings.HasPrefix(word, "/") && strings.HasSuffix(word, "/") {
+			words = append(words, searchWord{
+				value: word[1 : len(word)-1],
+				regex: true,
+			})
+			continue
+		}
+
 		if !isFlag {
 			exclude := false
 			if strings.HasPrefix(word, "-") {
@@ -218,8 +372,8 @@ func parseSearchFlags(input []string) ([]searchWord, []flag) {
 
 			if word != "" {
 				words = append(words, searchWord{
-					word,
-					exclude,
+					value:   word,
+					exclude: exclude,
 				})
 			}
 		}
@@ -235,9 +389,12 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 	excludedHashtagTermList := []string{}
 	plainTermList := []string{}
 	excludedPlainTermList := []string{}
+	var regexTerms []string
 
 	for _, word := range words {
-		if validHashtag.MatchString(word.value) {
+		if word.regex {
+			regexTerms = append(regexTerms, word.value)
+		} else if validHashtag.MatchString(word.value) {
 			if word.exclude {
 				excludedHashtagTermList = append(excludedHashtagTermList, word.value)
 			} else {
@@ -269,6 +426,12 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 	excludedDate := ""
 	excludedExtensions := []string{}
 	extensions := []string{}
+	hasFiles := false
+	excludedHasFiles := false
+	var fileTypes []string
+	var excludedFileTypes []string
+	var languages []string
+	var excludedLanguages []string
 
 	for _, flag := range flags {
 		if flag.name == "in" || flag.name == "channel" {
@@ -307,6 +470,30 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			} else {
 				extensions = append(extensions, flag.value)
 			}
+		} else if flag.name == "has" && strings.EqualFold(flag.value, SearchHasFile) {
+			if flag.exclude {
+				excludedHasFiles = true
+			} else {
+				hasFiles = true
+			}
+		} else if flag.name == "type" {
+			fileType := strings.ToLower(flag.value)
+			if _, ok := SearchFileTypeMimePrefixes[fileType]; !ok {
+				continue
+			}
+			if flag.exclude {
+				excludedFileTypes = append(excludedFileTypes, fileType)
+			} else {
+				fileTypes = append(fileTypes, fileType)
+			}
+		} else if flag.name == "lang" {
+			// languages are stored as lowercase ISO 639-1 codes
+			language := strings.ToLower(flag.value)
+			if flag.exclude {
+				excludedLanguages = append(excludedLanguages, language)
+			} else {
+				languages = append(languages, language)
+			}
 		}
 	}
 
@@ -329,6 +516,13 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedExtensions: excludedExtensions,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
+			HasFiles:           hasFiles,
+			ExcludedHasFiles:   excludedHasFiles,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			RegexTerms:         regexTerms,
+			Languages:          languages,
+			ExcludedLanguages:  excludedLanguages,
 			TimeZoneOffset:     timeZoneOffset,
 		})
 	}
@@ -350,6 +544,13 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedExtensions: excludedExtensions,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
+			HasFiles:           hasFiles,
+			ExcludedHasFiles:   excludedHasFiles,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			RegexTerms:         regexTerms,
+			Languages:          languages,
+			ExcludedLanguages:  excludedLanguages,
 			TimeZoneOffset:     timeZoneOffset,
 		})
 	}
@@ -362,7 +563,11 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			len(extensions) != 0 || len(excludedExtensions) != 0 ||
 			afterDate != "" || excludedAfterDate != "" ||
 			beforeDate != "" || excludedBeforeDate != "" ||
-			onDate != "" || excludedDate != "") {
+			onDate != "" || excludedDate != "" ||
+			hasFiles || excludedHasFiles ||
+			len(fileTypes) != 0 || len(excludedFileTypes) != 0 ||
+			len(regexTerms) != 0 ||
+			len(languages) != 0 || len(excludedLanguages) != 0) {
 		paramsList = append(paramsList, &SearchParams{
 			Terms:              "",
 			ExcludedTerms:      "",
@@ -379,6 +584,13 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			ExcludedExtensions: excludedExtensions,
 			OnDate:             onDate,
 			ExcludedDate:       excludedDate,
+			HasFiles:           hasFiles,
+			ExcludedHasFiles:   excludedHasFiles,
+			FileTypes:          fileTypes,
+			ExcludedFileTypes:  excludedFileTypes,
+			RegexTerms:         regexTerms,
+			Languages:          languages,
+			ExcludedLanguages:  excludedLanguages,
 			TimeZoneOffset:     timeZoneOffset,
 		})
 	}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -1783,6 +1784,77 @@ func TestGetAfterDateMillis(t *testing.T) {
 	}
 }
 
+func TestParseSearchParamsFileAndRegexFilters(t *testing.T) {
+	t.Run("has:file and type:image", func(t *testing.T) {
+		paramsList := ParseSearchParams("report has:file type:Image -type:video type:unknown", 0)
+		require.Len(t, paramsList, 1)
+		assert.Equal(t, "report", paramsList[0].Terms)
+		assert.True(t, paramsList[0].HasFiles)
+		assert.False(t, paramsList[0].ExcludedHasFiles)
+		assert.Equal(t, []string{"image"}, paramsList[0].FileTypes)
+		assert.Equal(t, []string{"video"}, paramsList[0].ExcludedFileTypes)
+		assert.Equal(t, []string{SearchOperatorHasFile, SearchOperatorFileType}, paramsList[0].Operators())
+	})
+
+	t.Run("filters without terms", func(t *testing.T) {
+		paramsList := ParseSearchParams("-has:file", 0)
+		require.Len(t, paramsList, 1)
+		assert.Equal(t, "", paramsList[0].Terms)
+		assert.True(t, paramsList[0].ExcludedHasFiles)
+	})
+
+	t.Run("regular expressions apply to every param", func(t *testing.T) {
+		paramsList := ParseSearchParams("/err(or)?s?/ #bug", 0)
+		require.Len(t, paramsList, 1)
+		assert.True(t, paramsList[0].IsHashtag)
+		assert.Equal(t, []string{"err(or)?s?"}, paramsList[0].RegexTerms)
+		assert.Equal(t, []string{SearchOperatorRegex}, paramsList[0].Operators())
+
+		paramsList = ParseSearchParams("/^deploy/", 0)
+		require.Len(t, paramsList, 1)
+		assert.Equal(t, "", paramsList[0].Terms)
+		assert.Equal(t, []string{"^deploy"}, paramsList[0].RegexTerms)
+	})
+
+	t.Run("before and after with a time", func(t *testing.T) {
+		paramsList := ParseSearchParams("after:2018-08-01T10:30 before:2018-8-1", 0)
+		require.Len(t, paramsList, 1)
+		assert.Equal(t, []string{SearchOperatorBefore, SearchOperatorAfter, SearchOperatorDateTime}, paramsList[0].Operators())
+	})
+}
+
+func TestParseSearchParamsLanguageFilter(t *testing.T) {
+	paramsList := ParseSearchParams("release lang:FR -lang:en #launch", 0)
+	require.Len(t, paramsList, 2)
+	for _, params := range paramsList {
+		assert.Equal(t, []string{"fr"}, params.Languages)
+		assert.Equal(t, []string{"en"}, params.ExcludedLanguages)
+		assert.Equal(t, []string{SearchOperatorLanguage}, params.Operators())
+	}
+
+	paramsList = ParseSearchParams("lang:de", 0)
+	require.Len(t, paramsList, 1)
+	assert.Equal(t, "", paramsList[0].Terms)
+	assert.Equal(t, []string{"de"}, paramsList[0].Languages)
+}
+
+func TestGetDateMillisWithTime(t *testing.T) {
+	sp := &SearchParams{AfterDate: "2018-08-01T10:30", BeforeDate: "2018-08-01T10:30:15", TimeZoneOffset: 0}
+	assert.Equal(t, int64(1533119460000), sp.GetAfterDateMillis())
+	assert.Equal(t, int64(1533119414999), sp.GetBeforeDateMillis())
+
+	sp = &SearchParams{ExcludedAfterDate: "2018-8-1T10:30", TimeZoneOffset: 3600}
+	assert.Equal(t, int64(1533115860000), sp.GetExcludedAfterDateMillis())
+}
+
+func TestIsValidSearchRegex(t *testing.T) {
+	assert.True(t, IsValidSearchRegex("err(or)?s?"))
+	assert.False(t, IsValidSearchRegex(""))
+	assert.False(t, IsValidSearchRegex("(unclosed"))
+	assert.False(t, IsValidSearchRegex(`(a)\1`))
+	assert.False(t, IsValidSearchRegex(strings.Repeat("a", SearchRegexMaxLength+1)))
+}
+
 func TestIsSearchParamsListValid(t *testing.T) {
 	var err *AppError
 
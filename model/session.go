@@ -4,6 +4,7 @@
 package model
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"strings"
@@ -28,6 +29,11 @@ const (
 	SessionTypeCloudKey           = "CloudKey"
 	SessionTypeRemoteclusterToken = "RemoteClusterToken"
 	SessionPropIsGuest            = "is_guest"
+	SessionPropIpAddress          = "ip_address"
+	SessionPropLocation           = "location"
+	SessionPropDeviceFingerprint  = "device_fingerprint"
+	SessionPropOAuthAppId         = "oauth_app_id"
+	SessionPropWebSocketScope     = "websocket_scope"
 	SessionActivityTimeout        = 1000 * 60 * 5 // 5 minutes
 	SessionUserAccessTokenExpiry  = 100 * 365     // 100 years
 )
@@ -62,6 +68,26 @@ func (s *Session) IsUnrestricted() bool {
 	return s.Local
 }
 
+// ImpersonatorId returns the id of the admin impersonating the user of the session, if any.
+func (s *Session) ImpersonatorId() string {
+	return s.Props[SessionPropImpersonatorId]
+}
+
+// WebSocketScope returns the scope the websocket connections opened with the session are
+// restricted to, or nil when they aren't.
+func (s *Session) WebSocketScope() (*WebSocketScope, error) {
+	value := s.Props[SessionPropWebSocketScope]
+	if value == "" {
+		return nil, nil
+	}
+
+	var scope WebSocketScope
+	if err := json.Unmarshal([]byte(value), &scope); err != nil {
+		return nil, err
+	}
+	return &scope, nil
+}
+
 func (s *Session) DeepCopy() *Session {
 	copySession := *s
 
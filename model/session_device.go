@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+)
+
+// SessionLocationMaxRunes is the longest location kept for a session, as read from the header
+// set by the proxy in front of the server.
+const SessionLocationMaxRunes = 64
+
+// SessionDevice describes the device and the place a session of the user was created from.
+type SessionDevice struct {
+	SessionId      string `json:"session_id"`
+	Fingerprint    string `json:"fingerprint"`
+	Platform       string `json:"platform"`
+	Os             string `json:"os"`
+	Browser        string `json:"browser"`
+	IpAddress      string `json:"ip_address"`
+	Location       string `json:"location"`
+	CreateAt       int64  `json:"create_at"`
+	LastActivityAt int64  `json:"last_activity_at"`
+	IsCurrent      bool   `json:"is_current"`
+}
+
+// SessionDeviceFingerprint identifies the kind of device a session is created from, regardless
+// of the version of its browser. A login from another location counts as another device.
+func SessionDeviceFingerprint(platform, os, browserName, location string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{platform, os, browserName, strings.ToLower(location)}, "\x00")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// SanitizeSessionLocation trims the location read from a request header to what is kept.
+func SanitizeSessionLocation(location string) string {
+	location = strings.TrimSpace(location)
+	if utf8.RuneCountInString(location) > SessionLocationMaxRunes {
+		location = string([]rune(location)[:SessionLocationMaxRunes])
+	}
+	return location
+}
+
+// SessionDeviceFromSession returns the device the session was created from. currentSessionId
+// tells which session is the one making the request.
+func SessionDeviceFromSession(session *Session, currentSessionId string) *SessionDevice {
+	return &SessionDevice{
+		SessionId:      session.Id,
+		Fingerprint:    session.Props[SessionPropDeviceFingerprint],
+		Platform:       session.Props[SessionPropPlatform],
+		Os:             session.Props[SessionPropOs],
+		Browser:        session.Props[SessionPropBrowser],
+		IpAddress:      session.Props[SessionPropIpAddress],
+		Location:       session.Props[SessionPropLocation],
+		CreateAt:       session.CreateAt,
+		LastActivityAt: session.LastActivityAt,
+		IsCurrent:      session.Id == currentSessionId,
+	}
+}
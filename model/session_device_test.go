@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionDeviceFingerprint(t *testing.T) {
+	fingerprint := SessionDeviceFingerprint("Macintosh", "Mac OS", "Chrome", "US")
+	assert.Len(t, fingerprint, 32)
+	assert.Equal(t, fingerprint, SessionDeviceFingerprint("Macintosh", "Mac OS", "Chrome", "us"))
+	assert.NotEqual(t, fingerprint, SessionDeviceFingerprint("Macintosh", "Mac OS", "Firefox", "US"))
+	assert.NotEqual(t, fingerprint, SessionDeviceFingerprint("Macintosh", "Mac OS", "Chrome", "FR"))
+	assert.NotEqual(t, SessionDeviceFingerprint("a", "bc", "", ""), SessionDeviceFingerprint("ab", "c", "", ""))
+}
+
+func TestSanitizeSessionLocation(t *testing.T) {
+	assert.Equal(t, "Paris, FR", SanitizeSessionLocation("  Paris, FR "))
+	assert.Equal(t, strings.Repeat("é", SessionLocationMaxRunes), SanitizeSessionLocation(strings.Repeat("é", SessionLocationMaxRunes+10)))
+}
+
+func TestSessionDeviceFromSession(t *testing.T) {
+	session := &Session{Id: NewId(), CreateAt: 1, LastActivityAt: 2, Props: StringMap{
+		SessionPropOs:                "Linux",
+		SessionPropBrowser:           "Firefox/99.0",
+		SessionPropIpAddress:         "10.0.0.1",
+		SessionPropDeviceFingerprint: "fingerprint",
+	}}
+
+	device := SessionDeviceFromSession(session, session.Id)
+	assert.True(t, device.IsCurrent)
+	assert.Equal(t, "Linux", device.Os)
+	assert.Equal(t, "Firefox/99.0", device.Browser)
+	assert.Equal(t, "10.0.0.1", device.IpAddress)
+	assert.Equal(t, "fingerprint", device.Fingerprint)
+	assert.Equal(t, int64(2), device.LastActivityAt)
+
+	assert.False(t, SessionDeviceFromSession(session, NewId()).IsCurrent)
+}
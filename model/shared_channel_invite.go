@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	SharedChannelInviteStatusPending  = "pending"
+	SharedChannelInviteStatusApproved = "approved"
+	SharedChannelInviteStatusDenied   = "denied"
+)
+
+// SharedChannelInvite is an invite to share a channel received from a remote cluster which
+// requires approval. MemberCount and PostCount are the stats of the channel on the remote
+// cluster when the invite was sent.
+type SharedChannelInvite struct {
+	Id          string      `json:"id"`
+	RemoteId    string      `json:"remote_id"`
+	ChannelId   string      `json:"channel_id"`
+	TeamId      string      `json:"team_id"`
+	Type        ChannelType `json:"type"`
+	Name        string      `json:"name"`
+	DisplayName string      `json:"display_name"`
+	Header      string      `json:"header"`
+	Purpose     string      `json:"purpose"`
+	ReadOnly    bool        `json:"read_only"`
+	MemberCount int64       `json:"member_count"`
+	PostCount   int64       `json:"post_count"`
+	Status      string      `json:"status"`
+	CreateAt    int64       `json:"create_at"`
+	DecidedAt   int64       `json:"decided_at"`
+	DecidedBy   string      `json:"decided_by"`
+}
+
+// SharedChannelInvitePreview is what an admin is shown to decide on an invite.
+type SharedChannelInvitePreview struct {
+	Invite *SharedChannelInvite `json:"invite"`
+	Remote RemoteClusterInfo    `json:"remote"`
+}
+
+// SharedChannelInviteFilterOpts filters the shared channel invites. Empty fields match any
+// invite.
+type SharedChannelInviteFilterOpts struct {
+	RemoteId  string
+	ChannelId string
+	Status    string
+}
+
+func IsValidSharedChannelInviteStatus(status string) bool {
+	switch status {
+	case SharedChannelInviteStatusPending, SharedChannelInviteStatusApproved, SharedChannelInviteStatusDenied:
+		return true
+	}
+	return false
+}
+
+func (i *SharedChannelInvite) PreSave() {
+	if i.Id == "" {
+		i.Id = NewId()
+	}
+
+	if i.Status == "" {
+		i.Status = SharedChannelInviteStatusPending
+	}
+
+	if i.CreateAt == 0 {
+		i.CreateAt = GetMillis()
+	}
+}
+
+func (i *SharedChannelInvite) IsValid() *AppError {
+	if !IsValidId(i.Id) {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.RemoteId) {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.remote_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.ChannelId) {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.channel_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidSharedChannelInviteStatus(i.Status) {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.status.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if len(i.Name) > ChannelNameMaxLength ||
+		utf8.RuneCountInString(i.DisplayName) > ChannelDisplayNameMaxRunes ||
+		utf8.RuneCountInString(i.Header) > ChannelHeaderMaxRunes ||
+		utf8.RuneCountInString(i.Purpose) > ChannelPurposeMaxRunes {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.too_long.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if i.CreateAt == 0 {
+		return NewAppError("SharedChannelInvite.IsValid", "model.shared_channel_invite.is_valid.create_at.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
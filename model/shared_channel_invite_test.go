@@ -0,0 +1,53 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedChannelInvitePreSave(t *testing.T) {
+	i := SharedChannelInvite{}
+	i.PreSave()
+
+	assert.True(t, IsValidId(i.Id))
+	assert.Equal(t, SharedChannelInviteStatusPending, i.Status)
+	assert.NotZero(t, i.CreateAt)
+}
+
+func TestSharedChannelInviteIsValid(t *testing.T) {
+	i := SharedChannelInvite{}
+
+	err := i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.id.app_error")
+
+	i.Id = NewId()
+	err = i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.remote_id.app_error")
+
+	i.RemoteId = NewId()
+	err = i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.channel_id.app_error")
+
+	i.ChannelId = NewId()
+	i.Status = "maybe"
+	err = i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.status.app_error")
+
+	i.Status = SharedChannelInviteStatusPending
+	i.DisplayName = strings.Repeat("a", ChannelDisplayNameMaxRunes+1)
+	err = i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.too_long.app_error")
+
+	i.DisplayName = "Shared"
+	err = i.IsValid()
+	require.False(t, err == nil || err.Id != "model.shared_channel_invite.is_valid.create_at.app_error")
+
+	i.CreateAt = GetMillis()
+	require.Nil(t, i.IsValid())
+}
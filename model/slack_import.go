@@ -0,0 +1,56 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	// SlackImportSourceZip imports a Slack export, SlackImportSourceAPI reads the workspace
+	// through the Slack API instead.
+	SlackImportSourceZip = "zip"
+	SlackImportSourceAPI = "api"
+
+	SlackImportUserMatched = "matched"
+	SlackImportUserCreated = "created"
+	SlackImportUserFailed  = "failed"
+
+	SlackImportChannelCreated = "created"
+	SlackImportChannelMerged  = "merged"
+	SlackImportChannelFailed  = "failed"
+)
+
+// SlackImportUser maps a Slack user to the user their messages were imported as. Users are
+// matched by email, the ones without a match being created.
+type SlackImportUser struct {
+	SlackId       string `json:"slack_id"`
+	SlackUsername string `json:"slack_username"`
+	Email         string `json:"email"`
+	UserId        string `json:"user_id,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Status        string `json:"status"`
+}
+
+// SlackImportChannel maps a Slack channel to the channel its messages were imported to.
+type SlackImportChannel struct {
+	SlackId     string      `json:"slack_id"`
+	SlackName   string      `json:"slack_name"`
+	Type        ChannelType `json:"type"`
+	ChannelId   string      `json:"channel_id,omitempty"`
+	ChannelName string      `json:"channel_name,omitempty"`
+	Status      string      `json:"status"`
+	Posts       int64       `json:"posts"`
+}
+
+// SlackImportReport tells what a Slack import made of the users and channels of the
+// workspace, and how much it imported.
+type SlackImportReport struct {
+	JobId       string                `json:"job_id"`
+	TeamId      string                `json:"team_id"`
+	BotUserId   string                `json:"bot_user_id,omitempty"`
+	Users       []*SlackImportUser    `json:"users"`
+	Channels    []*SlackImportChannel `json:"channels"`
+	Posts       int64                 `json:"posts"`
+	Replies     int64                 `json:"replies"`
+	Reactions   int64                 `json:"reactions"`
+	Files       int64                 `json:"files"`
+	FailedFiles int64                 `json:"failed_files"`
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	HealthProbeDatabase        = "database"
+	HealthProbeDatabaseReplica = "database_replica"
+	HealthProbeFileStore       = "filestore"
+	HealthProbeSMTP            = "smtp"
+	HealthProbeSearch          = "search"
+	HealthProbeCluster         = "cluster"
+)
+
+// IsValidHealthProbe reports whether the string names a dependency probed by the health checks.
+func IsValidHealthProbe(probe string) bool {
+	switch probe {
+	case HealthProbeDatabase, HealthProbeDatabaseReplica, HealthProbeFileStore, HealthProbeSMTP, HealthProbeSearch, HealthProbeCluster:
+		return true
+	}
+	return false
+}
+
+// SystemHealth is the result of probing the dependencies of the server. The server is unhealthy
+// when a required probe fails, and degraded when any other probe fails or is over its threshold.
+// The checks are only given to the sessions allowed to manage the system.
+type SystemHealth struct {
+	Status string                  `json:"status"`
+	Checks map[string]*HealthCheck `json:"checks,omitempty"`
+}
+
+// HealthCheck is the result of a single probe, such as the ping of a database replica.
+type HealthCheck struct {
+	Probe       string `json:"probe"`
+	Status      string `json:"status"`
+	LatencyMs   int64  `json:"latency_ms"`
+	ThresholdMs int64  `json:"threshold_ms,omitempty"`
+	// Nodes is the number of nodes of the cluster, for the cluster probe.
+	Nodes int `json:"nodes,omitempty"`
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthCheckSettings(t *testing.T) {
+	c := Config{}
+	c.SetDefaults()
+	assert.Equal(t, []string{HealthProbeDatabase, HealthProbeFileStore}, c.ServiceSettings.HealthCheckRequiredProbes)
+	require.Nil(t, c.ServiceSettings.isValid())
+
+	c.ServiceSettings.HealthCheckRequiredProbes = []string{HealthProbeSMTP, "ldap"}
+	appErr := c.ServiceSettings.isValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.config.is_valid.health_check_probe.app_error", appErr.Id)
+
+	c.ServiceSettings.HealthCheckRequiredProbes = nil
+	*c.ServiceSettings.HealthCheckSMTPLatencyThresholdMilliseconds = -1
+	appErr = c.ServiceSettings.isValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.config.is_valid.health_check_threshold.app_error", appErr.Id)
+}
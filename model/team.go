@@ -21,26 +21,37 @@ const (
 	TeamEmailMaxLength          = 128
 	TeamNameMaxLength           = 64
 	TeamNameMinLength           = 2
+	// TeamMaxHierarchyDepth is the maximum number of ancestors of a team.
+	TeamMaxHierarchyDepth = 5
 )
 
 type Team struct {
-	Id                 string  `json:"id"`
-	CreateAt           int64   `json:"create_at"`
-	UpdateAt           int64   `json:"update_at"`
-	DeleteAt           int64   `json:"delete_at"`
-	DisplayName        string  `json:"display_name"`
-	Name               string  `json:"name"`
-	Description        string  `json:"description"`
-	Email              string  `json:"email"`
-	Type               string  `json:"type"`
-	CompanyName        string  `json:"company_name"`
-	AllowedDomains     string  `json:"allowed_domains"`
-	InviteId           string  `json:"invite_id"`
-	AllowOpenInvite    bool    `json:"allow_open_invite"`
-	LastTeamIconUpdate int64   `json:"last_team_icon_update,omitempty"`
-	SchemeId           *string `json:"scheme_id"`
-	GroupConstrained   *bool   `json:"group_constrained"`
-	PolicyID           *string `json:"policy_id"`
+	Id                      string  `json:"id"`
+	CreateAt                int64   `json:"create_at"`
+	UpdateAt                int64   `json:"update_at"`
+	DeleteAt                int64   `json:"delete_at"`
+	DisplayName             string  `json:"display_name"`
+	Name                    string  `json:"name"`
+	Description             string  `json:"description"`
+	Email                   string  `json:"email"`
+	EmailVerified           bool    `json:"email_verified"`
+	Type                    string  `json:"type"`
+	CompanyName             string  `json:"company_name"`
+	AllowedDomains          string  `json:"allowed_domains"`
+	InviteId                string  `json:"invite_id"`
+	AllowOpenInvite         bool    `json:"allow_open_invite"`
+	LastTeamIconUpdate      int64   `json:"last_team_icon_update,omitempty"`
+	SchemeId                *string `json:"scheme_id"`
+	GroupConstrained        *bool   `json:"group_constrained"`
+	PolicyID                *string `json:"policy_id"`
+	ParentTeamId            string  `json:"parent_team_id"`
+	InheritParentMembership bool    `json:"inherit_parent_membership"`
+	// DataResidency tells which of the residency backends the files of the team are stored in,
+	// the default file storage being used when empty.
+	DataResidency string `json:"data_residency"`
+	// StrictIsolation overrides the strict user isolation of the server for the team, the
+	// setting of the server being used when nil.
+	StrictIsolation *bool `json:"strict_isolation"`
 }
 
 type TeamPatch struct {
@@ -50,6 +61,9 @@ type TeamPatch struct {
 	AllowedDomains   *string `json:"allowed_domains"`
 	AllowOpenInvite  *bool   `json:"allow_open_invite"`
 	GroupConstrained *bool   `json:"group_constrained"`
+	// InheritParentMembership lets the members of the parent team see and join the team,
+	// even if it isn't open.
+	InheritParentMembership *bool `json:"inherit_parent_membership"`
 }
 
 type TeamForExport struct {
@@ -66,6 +80,12 @@ type TeamsWithCount struct {
 	TotalCount int64   `json:"total_count"`
 }
 
+// TeamOrderMove moves a team to the index in the order of the teams of a user, shifting the teams
+// in between, as when dragging the team.
+type TeamOrderMove struct {
+	Index int `json:"index"`
+}
+
 func (o *Invites) ToEmailList() []string {
 	emailList := make([]string, len(o.Invites))
 	for _, invite := range o.Invites {
@@ -135,6 +155,14 @@ func (o *Team) IsValid() *AppError {
 		return NewAppError("Team.IsValid", "model.team.is_valid.domains.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
 
+	if o.ParentTeamId != "" && (!IsValidId(o.ParentTeamId) || o.ParentTeamId == o.Id) {
+		return NewAppError("Team.IsValid", "model.team.is_valid.parent_team_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.DataResidency != "" && !IsValidDataResidency(o.DataResidency) {
+		return NewAppError("Team.IsValid", "model.team.is_valid.data_residency.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -150,6 +178,7 @@ func (o *Team) PreSave() {
 	o.DisplayName = SanitizeUnicode(o.DisplayName)
 	o.Description = SanitizeUnicode(o.Description)
 	o.CompanyName = SanitizeUnicode(o.CompanyName)
+	o.AllowedDomains = CanonicalAllowedDomains(o.AllowedDomains)
 
 	if o.InviteId == "" {
 		o.InviteId = NewId()
@@ -162,6 +191,7 @@ func (o *Team) PreUpdate() {
 	o.DisplayName = SanitizeUnicode(o.DisplayName)
 	o.Description = SanitizeUnicode(o.Description)
 	o.CompanyName = SanitizeUnicode(o.CompanyName)
+	o.AllowedDomains = CanonicalAllowedDomains(o.AllowedDomains)
 }
 
 func IsReservedTeamName(s string) bool {
@@ -246,12 +276,25 @@ func (o *Team) Patch(patch *TeamPatch) {
 	if patch.GroupConstrained != nil {
 		o.GroupConstrained = patch.GroupConstrained
 	}
+
+	if patch.InheritParentMembership != nil {
+		o.InheritParentMembership = *patch.InheritParentMembership
+	}
 }
 
 func (o *Team) IsGroupConstrained() bool {
 	return o.GroupConstrained != nil && *o.GroupConstrained
 }
 
+// IsStrictlyIsolated tells whether the members of the team are restricted to the users they
+// share a team with, given the strict user isolation setting of the server.
+func (o *Team) IsStrictlyIsolated(serverIsolation bool) bool {
+	if o.StrictIsolation != nil {
+		return *o.StrictIsolation
+	}
+	return serverIsolation
+}
+
 // The following are some GraphQL methods necessary to return the
 // data in float64 type. The spec doesn't support 64 bit integers,
 // so we have to pass the data in float64. The _ at the end is
@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+// TeamAliasMaxPerTeam caps how many alternate names a team can be reached by.
+const TeamAliasMaxPerTeam = 20
+
+// TeamAlias is an alternate name of a team, such as its name before being renamed, so that
+// the links made with it keep working. The aliases with an expiry stop resolving, and can be
+// taken by another team, once expired.
+type TeamAlias struct {
+	Name     string `json:"name"`
+	TeamId   string `json:"team_id"`
+	CreateAt int64  `json:"create_at"`
+	ExpireAt int64  `json:"expire_at"`
+}
+
+func (a *TeamAlias) PreSave() {
+	a.Name = strings.ToLower(SanitizeUnicode(a.Name))
+
+	if a.CreateAt == 0 {
+		a.CreateAt = GetMillis()
+	}
+}
+
+func (a *TeamAlias) IsValid() *AppError {
+	if !IsValidTeamName(a.Name) || len(a.Name) > TeamNameMaxLength {
+		return NewAppError("TeamAlias.IsValid", "model.team_alias.is_valid.name.app_error", nil, "name="+a.Name, http.StatusBadRequest)
+	}
+
+	if IsReservedTeamName(a.Name) {
+		return NewAppError("TeamAlias.IsValid", "model.team_alias.is_valid.reserved.app_error", nil, "name="+a.Name, http.StatusBadRequest)
+	}
+
+	if !IsValidId(a.TeamId) {
+		return NewAppError("TeamAlias.IsValid", "model.team_alias.is_valid.team_id.app_error", nil, "name="+a.Name, http.StatusBadRequest)
+	}
+
+	if a.CreateAt == 0 {
+		return NewAppError("TeamAlias.IsValid", "model.team_alias.is_valid.create_at.app_error", nil, "name="+a.Name, http.StatusBadRequest)
+	}
+
+	if a.ExpireAt < 0 || (a.ExpireAt > 0 && a.ExpireAt < a.CreateAt) {
+		return NewAppError("TeamAlias.IsValid", "model.team_alias.is_valid.expire_at.app_error", nil, "name="+a.Name, http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamAliasPreSave(t *testing.T) {
+	alias := TeamAlias{Name: "Old-Name", TeamId: NewId()}
+	alias.PreSave()
+	assert.Equal(t, "old-name", alias.Name)
+	assert.NotZero(t, alias.CreateAt)
+}
+
+func TestTeamAliasIsValid(t *testing.T) {
+	alias := TeamAlias{Name: "old-name", TeamId: NewId()}
+	alias.PreSave()
+	require.Nil(t, alias.IsValid())
+
+	for _, name := range []string{"", "a", "old name", "old_name", strings.Repeat("a", TeamNameMaxLength+1), "login", "api-team"} {
+		alias.Name = name
+		assert.NotNil(t, alias.IsValid(), name)
+	}
+	alias.Name = "old-name"
+
+	alias.TeamId = "junk"
+	require.NotNil(t, alias.IsValid())
+	alias.TeamId = NewId()
+
+	alias.ExpireAt = alias.CreateAt + 1000
+	require.Nil(t, alias.IsValid())
+
+	alias.ExpireAt = alias.CreateAt - 1000
+	require.NotNil(t, alias.IsValid())
+	alias.ExpireAt = 0
+
+	alias.CreateAt = 0
+	require.NotNil(t, alias.IsValid())
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// AllowedDomainWildcardPrefix starts an allowed domain matching all the subdomains of the domain
+// following it, e.g. "*.example.com" allows "eng.example.com" but not "example.com".
+const AllowedDomainWildcardPrefix = "*."
+
+var allowedDomainProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.VerifyDNSLength(true))
+
+// AllowedDomainParseResult is the outcome of parsing one entry of the allowed domains of a team.
+type AllowedDomainParseResult struct {
+	Input  string `json:"input"`
+	Domain string `json:"domain,omitempty"`
+	Valid  bool   `json:"valid"`
+	Error  string `json:"error,omitempty"`
+}
+
+// TeamAllowedDomainsValidation describes how a list of allowed domains is parsed. AllowedDomains is
+// the canonical form the list is stored in.
+type TeamAllowedDomainsValidation struct {
+	Valid          bool                        `json:"valid"`
+	Domains        []string                    `json:"domains"`
+	AllowedDomains string                      `json:"allowed_domains"`
+	Results        []*AllowedDomainParseResult `json:"results"`
+}
+
+// splitAllowedDomains splits a list of allowed domains on commas and whitespace.
+func splitAllowedDomains(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+}
+
+// ParseAllowedDomain returns the canonical form of an allowed domain: lowercase, punycode encoded,
+// without the optional leading "@" and trailing dot, and keeping a leading wildcard label.
+func ParseAllowedDomain(entry string) (string, error) {
+	domain := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(entry), "@"), ".")
+	if domain == "" {
+		return "", errors.New("the domain is empty")
+	}
+
+	prefix := ""
+	if strings.HasPrefix(domain, AllowedDomainWildcardPrefix) {
+		prefix = AllowedDomainWildcardPrefix
+		domain = strings.TrimPrefix(domain, AllowedDomainWildcardPrefix)
+	}
+	if strings.Contains(domain, "@") {
+		return "", errors.New("the domain can't contain an @ other than a leading one")
+	}
+	if strings.Contains(domain, "*") {
+		return "", errors.New("a wildcard is only allowed as the first label of the domain")
+	}
+
+	ascii, err := allowedDomainProfile.ToASCII(domain)
+	if err != nil {
+		return "", err
+	}
+	if prefix != "" && !strings.Contains(ascii, ".") {
+		return "", errors.New("a wildcard must be followed by at least two labels")
+	}
+
+	return prefix + ascii, nil
+}
+
+// ValidateAllowedDomains parses each entry of a list of allowed domains separated by commas or
+// whitespace.
+func ValidateAllowedDomains(s string) *TeamAllowedDomainsValidation {
+	validation := &TeamAllowedDomainsValidation{
+		Valid:   true,
+		Domains: []string{},
+		Results: []*AllowedDomainParseResult{},
+	}
+
+	seen := map[string]bool{}
+	for _, entry := range splitAllowedDomains(s) {
+		result := &AllowedDomainParseResult{Input: entry}
+		domain, err := ParseAllowedDomain(entry)
+		if err != nil {
+			result.Error = err.Error()
+			validation.Valid = false
+		} else {
+			result.Domain = domain
+			result.Valid = true
+			if !seen[domain] {
+				seen[domain] = true
+				validation.Domains = append(validation.Domains, domain)
+			}
+		}
+		validation.Results = append(validation.Results, result)
+	}
+	validation.AllowedDomains = strings.Join(validation.Domains, ", ")
+
+	return validation
+}
+
+// AllowedDomainsList returns the canonical domains of a list of allowed domains. The entries that
+// can't be parsed, stored before the domains were validated, are split the way they used to be
+// so that the restriction they put on a team isn't loosened.
+func AllowedDomainsList(s string) []string {
+	domains := []string{}
+	seen := map[string]bool{}
+	for _, entry := range splitAllowedDomains(s) {
+		parsed := []string{}
+		if domain, err := ParseAllowedDomain(entry); err == nil {
+			parsed = append(parsed, domain)
+		} else {
+			parsed = append(parsed, strings.Fields(strings.ToLower(strings.Replace(entry, "@", " ", -1)))...)
+		}
+
+		for _, domain := range parsed {
+			if !seen[domain] {
+				seen[domain] = true
+				domains = append(domains, domain)
+			}
+		}
+	}
+	return domains
+}
+
+// CanonicalAllowedDomains returns the form a list of allowed domains is stored in.
+func CanonicalAllowedDomains(s string) string {
+	return strings.Join(AllowedDomainsList(s), ", ")
+}
+
+// AllowedDomainMatchesEmail returns true if the domain of the email address is the canonical
+// allowed domain, or one of its subdomains for a wildcard.
+func AllowedDomainMatchesEmail(domain, email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at == -1 {
+		return false
+	}
+
+	emailDomain := strings.ToLower(email[at+1:])
+	if ascii, err := allowedDomainProfile.ToASCII(emailDomain); err == nil {
+		emailDomain = ascii
+	}
+
+	if strings.HasPrefix(domain, AllowedDomainWildcardPrefix) {
+		return strings.HasSuffix(emailDomain, domain[len(AllowedDomainWildcardPrefix)-1:])
+	}
+	return emailDomain == domain
+}
+
+// AllowedDomainCovers returns true if all the email addresses matching the canonical domain also
+// match the canonical allowed domain.
+func AllowedDomainCovers(allowed, domain string) bool {
+	if allowed == domain {
+		return true
+	}
+	if strings.HasPrefix(allowed, AllowedDomainWildcardPrefix) {
+		return strings.HasSuffix(strings.TrimPrefix(domain, AllowedDomainWildcardPrefix), allowed[len(AllowedDomainWildcardPrefix)-1:])
+	}
+	return false
+}
+
+// GetAllowedDomains returns the canonical domains the email addresses of the members of the team
+// are restricted to.
+func (o *Team) GetAllowedDomains() []string {
+	return AllowedDomainsList(o.AllowedDomains)
+}
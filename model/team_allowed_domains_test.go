@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAllowedDomain(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{"example.com", "example.com", true},
+		{"@Example.COM", "example.com", true},
+		{"example.com.", "example.com", true},
+		{"localhost", "localhost", true},
+		{"*.Example.com", "*.example.com", true},
+		{"bücher.de", "xn--bcher-kva.de", true},
+		{"*.bücher.de", "*.xn--bcher-kva.de", true},
+		{"xn--bcher-kva.de", "xn--bcher-kva.de", true},
+		{"", "", false},
+		{"@", "", false},
+		{"user@example.com", "", false},
+		{"eng.*.example.com", "", false},
+		{"*.com", "", false},
+		{"under_score.com", "", false},
+		{"example..com", "", false},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			domain, err := ParseAllowedDomain(tc.Input)
+			if !tc.Valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, domain)
+		})
+	}
+}
+
+func TestValidateAllowedDomains(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		validation := ValidateAllowedDomains("@Example.com, *.corp.example.com\tbücher.de example.com")
+		assert.True(t, validation.Valid)
+		assert.Equal(t, []string{"example.com", "*.corp.example.com", "xn--bcher-kva.de"}, validation.Domains)
+		assert.Equal(t, "example.com, *.corp.example.com, xn--bcher-kva.de", validation.AllowedDomains)
+		require.Len(t, validation.Results, 4)
+		assert.Equal(t, "@Example.com", validation.Results[0].Input)
+		assert.Equal(t, "example.com", validation.Results[0].Domain)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		validation := ValidateAllowedDomains("example.com,under_score.com")
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []string{"example.com"}, validation.Domains)
+		require.Len(t, validation.Results, 2)
+		assert.True(t, validation.Results[0].Valid)
+		assert.False(t, validation.Results[1].Valid)
+		assert.NotEmpty(t, validation.Results[1].Error)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		validation := ValidateAllowedDomains(" , ")
+		assert.True(t, validation.Valid)
+		assert.Empty(t, validation.Domains)
+		assert.Empty(t, validation.AllowedDomains)
+	})
+}
+
+func TestCanonicalAllowedDomains(t *testing.T) {
+	assert.Equal(t, "example.com, example.org", CanonicalAllowedDomains("@Example.com,example.org EXAMPLE.com"))
+	assert.Equal(t, "user, example.com, under_score.com", CanonicalAllowedDomains("user@example.com under_score.com"), "legacy entries are split the way they used to be")
+	assert.Equal(t, "", CanonicalAllowedDomains(""))
+
+	team := &Team{AllowedDomains: "@Example.com,bücher.de"}
+	team.PreUpdate()
+	assert.Equal(t, "example.com, xn--bcher-kva.de", team.AllowedDomains)
+	assert.Equal(t, []string{"example.com", "xn--bcher-kva.de"}, team.GetAllowedDomains())
+}
+
+func TestAllowedDomainMatchesEmail(t *testing.T) {
+	assert.True(t, AllowedDomainMatchesEmail("example.com", "user@example.com"))
+	assert.True(t, AllowedDomainMatchesEmail("example.com", "User@EXAMPLE.com"))
+	assert.False(t, AllowedDomainMatchesEmail("example.com", "user@eng.example.com"))
+	assert.False(t, AllowedDomainMatchesEmail("example.com", "user@badexample.com"))
+	assert.False(t, AllowedDomainMatchesEmail("example.com", "example.com"))
+
+	assert.True(t, AllowedDomainMatchesEmail("*.example.com", "user@eng.example.com"))
+	assert.True(t, AllowedDomainMatchesEmail("*.example.com", "user@a.eng.example.com"))
+	assert.False(t, AllowedDomainMatchesEmail("*.example.com", "user@example.com"))
+	assert.False(t, AllowedDomainMatchesEmail("*.example.com", "user@badexample.com"))
+
+	assert.True(t, AllowedDomainMatchesEmail("xn--bcher-kva.de", "user@bücher.de"))
+	assert.True(t, AllowedDomainMatchesEmail("xn--bcher-kva.de", "user@xn--bcher-kva.de"))
+}
+
+func TestAllowedDomainCovers(t *testing.T) {
+	assert.True(t, AllowedDomainCovers("example.com", "example.com"))
+	assert.False(t, AllowedDomainCovers("example.com", "eng.example.com"))
+	assert.False(t, AllowedDomainCovers("example.com", "*.example.com"))
+
+	assert.True(t, AllowedDomainCovers("*.example.com", "eng.example.com"))
+	assert.True(t, AllowedDomainCovers("*.example.com", "*.example.com"))
+	assert.True(t, AllowedDomainCovers("*.example.com", "*.eng.example.com"))
+	assert.False(t, AllowedDomainCovers("*.example.com", "example.com"))
+	assert.False(t, AllowedDomainCovers("*.example.com", "badexample.com"))
+}
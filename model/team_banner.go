@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"regexp"
+	"unicode/utf8"
+)
+
+const (
+	TeamBannerMessageMaxRunes = 1024
+)
+
+var teamBannerColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// TeamBanner is an announcement banner shown to the members of a team. It is shown from
+// StartAt until EndAt; a zero StartAt or EndAt leaves that end of the schedule open.
+type TeamBanner struct {
+	Id              string `json:"id"`
+	TeamId          string `json:"team_id"`
+	CreatorId       string `json:"creator_id"`
+	Message         string `json:"message"`
+	BackgroundColor string `json:"background_color"`
+	TextColor       string `json:"text_color"`
+	AllowDismissal  bool   `json:"allow_dismissal"`
+	StartAt         int64  `json:"start_at"`
+	EndAt           int64  `json:"end_at"`
+	CreateAt        int64  `json:"create_at"`
+	UpdateAt        int64  `json:"update_at"`
+	DeleteAt        int64  `json:"delete_at"`
+}
+
+type TeamBannerPatch struct {
+	Message         *string `json:"message"`
+	BackgroundColor *string `json:"background_color"`
+	TextColor       *string `json:"text_color"`
+	AllowDismissal  *bool   `json:"allow_dismissal"`
+	StartAt         *int64  `json:"start_at"`
+	EndAt           *int64  `json:"end_at"`
+}
+
+// TeamBannerDismissal records that a user dismissed a banner that allows it.
+type TeamBannerDismissal struct {
+	BannerId    string `json:"banner_id"`
+	UserId      string `json:"user_id"`
+	DismissedAt int64  `json:"dismissed_at"`
+}
+
+func (b *TeamBanner) PreSave() {
+	if b.Id == "" {
+		b.Id = NewId()
+	}
+
+	if b.BackgroundColor == "" {
+		b.BackgroundColor = AnnouncementSettingsDefaultBannerColor
+	}
+
+	if b.TextColor == "" {
+		b.TextColor = AnnouncementSettingsDefaultBannerTextColor
+	}
+
+	b.CreateAt = GetMillis()
+	b.UpdateAt = b.CreateAt
+	b.DeleteAt = 0
+}
+
+func (b *TeamBanner) PreUpdate() {
+	b.UpdateAt = GetMillis()
+}
+
+func (b *TeamBanner) IsValid() *AppError {
+	if !IsValidId(b.Id) {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(b.TeamId) {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.team_id.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(b.CreatorId) {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.creator_id.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if b.Message == "" || utf8.RuneCountInString(b.Message) > TeamBannerMessageMaxRunes {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.message.app_error", map[string]interface{}{"MaxLength": TeamBannerMessageMaxRunes}, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if !teamBannerColorRegex.MatchString(b.BackgroundColor) || !teamBannerColorRegex.MatchString(b.TextColor) {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.color.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if b.StartAt < 0 || b.EndAt < 0 || (b.EndAt != 0 && b.EndAt <= b.StartAt) {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.schedule.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if b.CreateAt == 0 {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.create_at.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	if b.UpdateAt == 0 {
+		return NewAppError("TeamBanner.IsValid", "model.team_banner.is_valid.update_at.app_error", nil, "id="+b.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsActive reports whether the banner is scheduled to be shown at the given time.
+func (b *TeamBanner) IsActive(now int64) bool {
+	if b.DeleteAt != 0 {
+		return false
+	}
+	return (b.StartAt == 0 || b.StartAt <= now) && (b.EndAt == 0 || now < b.EndAt)
+}
+
+func (b *TeamBanner) Patch(patch *TeamBannerPatch) {
+	if patch.Message != nil {
+		b.Message = *patch.Message
+	}
+
+	if patch.BackgroundColor != nil {
+		b.BackgroundColor = *patch.BackgroundColor
+	}
+
+	if patch.TextColor != nil {
+		b.TextColor = *patch.TextColor
+	}
+
+	if patch.AllowDismissal != nil {
+		b.AllowDismissal = *patch.AllowDismissal
+	}
+
+	if patch.StartAt != nil {
+		b.StartAt = *patch.StartAt
+	}
+
+	if patch.EndAt != nil {
+		b.EndAt = *patch.EndAt
+	}
+}
+
+func (b *TeamBanner) Clone() *TeamBanner {
+	bCopy := *b
+	return &bCopy
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamBannerIsValid(t *testing.T) {
+	banner := &TeamBanner{
+		TeamId:    NewId(),
+		CreatorId: NewId(),
+		Message:   "Maintenance tonight",
+	}
+	banner.PreSave()
+	require.Nil(t, banner.IsValid())
+	assert.Equal(t, AnnouncementSettingsDefaultBannerColor, banner.BackgroundColor)
+	assert.Equal(t, AnnouncementSettingsDefaultBannerTextColor, banner.TextColor)
+
+	banner.Message = ""
+	require.NotNil(t, banner.IsValid())
+	banner.Message = strings.Repeat("a", TeamBannerMessageMaxRunes+1)
+	require.NotNil(t, banner.IsValid())
+	banner.Message = "Maintenance tonight"
+
+	banner.BackgroundColor = "red"
+	require.NotNil(t, banner.IsValid())
+	banner.BackgroundColor = "#FF0000"
+	require.Nil(t, banner.IsValid())
+
+	banner.StartAt = 2000
+	banner.EndAt = 1000
+	require.NotNil(t, banner.IsValid())
+	banner.EndAt = 0
+	require.Nil(t, banner.IsValid())
+
+	banner.TeamId = ""
+	require.NotNil(t, banner.IsValid())
+}
+
+func TestTeamBannerIsActive(t *testing.T) {
+	banner := &TeamBanner{}
+	assert.True(t, banner.IsActive(1000))
+
+	banner.StartAt = 1000
+	banner.EndAt = 2000
+	assert.False(t, banner.IsActive(999))
+	assert.True(t, banner.IsActive(1000))
+	assert.True(t, banner.IsActive(1999))
+	assert.False(t, banner.IsActive(2000))
+
+	banner.DeleteAt = 1500
+	assert.False(t, banner.IsActive(1500))
+}
+
+func TestTeamBannerPatch(t *testing.T) {
+	banner := &TeamBanner{Message: "old", StartAt: 10}
+	banner.Patch(&TeamBannerPatch{
+		Message:        NewString("new"),
+		AllowDismissal: NewBool(true),
+		EndAt:          NewInt64(20),
+	})
+
+	assert.Equal(t, "new", banner.Message)
+	assert.True(t, banner.AllowDismissal)
+	assert.Equal(t, int64(10), banner.StartAt)
+	assert.Equal(t, int64(20), banner.EndAt)
+}
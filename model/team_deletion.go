@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+// TeamDeletionExportDirectory is the directory, within the export directory, where the
+// exports of the teams scheduled for deletion are written.
+const TeamDeletionExportDirectory = "team_deletions"
+
+// TeamDeletion schedules the permanent deletion of a team. Until DeleteAt, the team stays
+// available, its admins can download its export, and the deletion can be canceled.
+type TeamDeletion struct {
+	TeamId      string `json:"team_id"`
+	RequesterId string `json:"requester_id"`
+	CreateAt    int64  `json:"create_at"`
+	DeleteAt    int64  `json:"delete_at"`
+	ExportPath  string `json:"-"`
+	ExportAt    int64  `json:"export_at"`
+}
+
+func (d *TeamDeletion) PreSave() {
+	d.CreateAt = GetMillis()
+	d.ExportPath = ""
+	d.ExportAt = 0
+}
+
+func (d *TeamDeletion) IsValid() *AppError {
+	if !IsValidId(d.TeamId) {
+		return NewAppError("TeamDeletion.IsValid", "model.team_deletion.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(d.RequesterId) {
+		return NewAppError("TeamDeletion.IsValid", "model.team_deletion.is_valid.requester_id.app_error", nil, "team_id="+d.TeamId, http.StatusBadRequest)
+	}
+
+	if d.CreateAt == 0 {
+		return NewAppError("TeamDeletion.IsValid", "model.team_deletion.is_valid.create_at.app_error", nil, "team_id="+d.TeamId, http.StatusBadRequest)
+	}
+
+	if d.DeleteAt == 0 {
+		return NewAppError("TeamDeletion.IsValid", "model.team_deletion.is_valid.delete_at.app_error", nil, "team_id="+d.TeamId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsDue tells whether the team is to be deleted at the given time.
+func (d *TeamDeletion) IsDue(now int64) bool {
+	return now >= d.DeleteAt
+}
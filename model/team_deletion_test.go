@@ -0,0 +1,45 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamDeletionIsValid(t *testing.T) {
+	deletion := &TeamDeletion{
+		TeamId:      NewId(),
+		RequesterId: NewId(),
+		ExportPath:  "export.jsonl",
+		ExportAt:    1,
+	}
+	deletion.PreSave()
+	deletion.DeleteAt = deletion.CreateAt + 1000
+
+	assert.Empty(t, deletion.ExportPath)
+	assert.Zero(t, deletion.ExportAt)
+	require.Nil(t, deletion.IsValid())
+
+	deletion.DeleteAt = 0
+	require.NotNil(t, deletion.IsValid())
+	deletion.DeleteAt = deletion.CreateAt
+
+	deletion.RequesterId = "junk"
+	require.NotNil(t, deletion.IsValid())
+	deletion.RequesterId = NewId()
+
+	deletion.TeamId = ""
+	require.NotNil(t, deletion.IsValid())
+}
+
+func TestTeamDeletionIsDue(t *testing.T) {
+	deletion := &TeamDeletion{DeleteAt: 1000}
+
+	assert.False(t, deletion.IsDue(999))
+	assert.True(t, deletion.IsDue(1000))
+	assert.True(t, deletion.IsDue(1001))
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	TeamExtendedStatsDefaultDays = 30
+	TeamExtendedStatsMaxDays     = 365
+	TeamExtendedStatsTopLimit    = 10
+
+	// TeamStatsDayMillis is the length of a day of the team stats rollups.
+	TeamStatsDayMillis = 24 * 60 * 60 * 1000
+)
+
+// TeamDailyStats are the activity figures of a team for a single UTC day. They are computed
+// by the nightly team stats rollup job rather than queried live. Active users are the users
+// who posted in the team that day.
+type TeamDailyStats struct {
+	TeamId      string `json:"team_id"`
+	Day         int64  `json:"day"`
+	ActiveUsers int64  `json:"active_users"`
+	PostCount   int64  `json:"post_count"`
+	FileCount   int64  `json:"file_count"`
+	FileSize    int64  `json:"file_size"`
+}
+
+type TeamChannelPostCount struct {
+	ChannelId string `json:"channel_id"`
+	PostCount int64  `json:"post_count"`
+}
+
+type TeamPosterCount struct {
+	UserId    string `json:"user_id"`
+	PostCount int64  `json:"post_count"`
+}
+
+// TeamExtendedStats is the activity of a team between Since and Until, along with the
+// storage used by the files ever shared in it.
+type TeamExtendedStats struct {
+	TeamId          string                  `json:"team_id"`
+	Since           int64                   `json:"since"`
+	Until           int64                   `json:"until"`
+	DailyStats      []*TeamDailyStats       `json:"daily_stats"`
+	PostsPerChannel []*TeamChannelPostCount `json:"posts_per_channel"`
+	TopPosters      []*TeamPosterCount      `json:"top_posters"`
+	FileCount       int64                   `json:"file_count"`
+	FileSize        int64                   `json:"file_size"`
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	// TeamGroupRoleDriftMissing is a member of a group mapped to the team admin role who isn't a
+	// team admin yet.
+	TeamGroupRoleDriftMissing = "missing"
+	// TeamGroupRoleDriftUnexpected is a team admin made so by a group they are no longer in.
+	TeamGroupRoleDriftUnexpected = "unexpected"
+	// TeamGroupRoleDriftManual is a team admin made so by hand, outside of the mapped groups.
+	TeamGroupRoleDriftManual = "manual"
+)
+
+// TeamGroupRole maps a group to a role on a team: the members of the group hold the role on the
+// team for as long as they are in the group. Only the team admin role can be mapped.
+type TeamGroupRole struct {
+	TeamId    string `json:"team_id"`
+	GroupId   string `json:"group_id"`
+	Role      string `json:"role"`
+	CreatorId string `json:"creator_id"`
+	CreateAt  int64  `json:"create_at"`
+}
+
+func (r *TeamGroupRole) PreSave() {
+	if r.CreateAt == 0 {
+		r.CreateAt = GetMillis()
+	}
+}
+
+func (r *TeamGroupRole) IsValid() *AppError {
+	if !IsValidId(r.TeamId) {
+		return NewAppError("TeamGroupRole.IsValid", "model.team_group_role.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.GroupId) {
+		return NewAppError("TeamGroupRole.IsValid", "model.team_group_role.is_valid.group_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.Role != TeamAdminRoleId {
+		return NewAppError("TeamGroupRole.IsValid", "model.team_group_role.is_valid.role.app_error", nil, "role="+r.Role, http.StatusBadRequest)
+	}
+
+	if r.CreatorId != "" && !IsValidId(r.CreatorId) {
+		return NewAppError("TeamGroupRole.IsValid", "model.team_group_role.is_valid.creator_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// TeamGroupRoleMember is a member of a team as seen by the groups mapped to roles on the team.
+// Granted tells whether the member was made a team admin by one of the groups.
+type TeamGroupRoleMember struct {
+	UserId        string
+	SchemeAdmin   bool
+	InMappedGroup bool
+	Granted       bool
+}
+
+// Drift returns how the team admin role of the member differs from the one given by the mapped
+// groups, if it does.
+func (m *TeamGroupRoleMember) Drift() string {
+	switch {
+	case m.InMappedGroup && !m.SchemeAdmin:
+		return TeamGroupRoleDriftMissing
+	case !m.InMappedGroup && m.SchemeAdmin && m.Granted:
+		return TeamGroupRoleDriftUnexpected
+	case !m.InMappedGroup && m.SchemeAdmin:
+		return TeamGroupRoleDriftManual
+	}
+	return ""
+}
+
+type TeamGroupRoleDrift struct {
+	UserId string `json:"user_id"`
+	Kind   string `json:"kind"`
+}
+
+// TeamGroupRoleDriftReport lists the members of a team whose team admin role differs from the
+// one given by the groups mapped to roles on the team. Missing and unexpected drifts are fixed by
+// the next reconciliation, manual ones are left alone.
+type TeamGroupRoleDriftReport struct {
+	TeamId string                `json:"team_id"`
+	Drifts []*TeamGroupRoleDrift `json:"drifts"`
+}
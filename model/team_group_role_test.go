@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamGroupRoleIsValid(t *testing.T) {
+	mapping := &TeamGroupRole{
+		TeamId:  NewId(),
+		GroupId: NewId(),
+		Role:    TeamAdminRoleId,
+	}
+	require.Nil(t, mapping.IsValid())
+
+	mapping.CreatorId = NewId()
+	require.Nil(t, mapping.IsValid())
+
+	mapping.CreatorId = "junk"
+	require.NotNil(t, mapping.IsValid())
+	mapping.CreatorId = ""
+
+	mapping.TeamId = "junk"
+	require.NotNil(t, mapping.IsValid())
+	mapping.TeamId = NewId()
+
+	mapping.GroupId = ""
+	require.NotNil(t, mapping.IsValid())
+	mapping.GroupId = NewId()
+
+	for _, role := range []string{"", TeamUserRoleId, TeamGuestRoleId, SystemAdminRoleId} {
+		mapping.Role = role
+		require.NotNil(t, mapping.IsValid(), role)
+	}
+}
+
+func TestTeamGroupRoleMemberDrift(t *testing.T) {
+	for name, tc := range map[string]struct {
+		member   TeamGroupRoleMember
+		expected string
+	}{
+		"in group, not admin":            {TeamGroupRoleMember{InMappedGroup: true}, TeamGroupRoleDriftMissing},
+		"in group, granted admin":        {TeamGroupRoleMember{InMappedGroup: true, SchemeAdmin: true, Granted: true}, ""},
+		"in group, manual admin":         {TeamGroupRoleMember{InMappedGroup: true, SchemeAdmin: true}, ""},
+		"out of group, granted admin":    {TeamGroupRoleMember{SchemeAdmin: true, Granted: true}, TeamGroupRoleDriftUnexpected},
+		"out of group, manual admin":     {TeamGroupRoleMember{SchemeAdmin: true}, TeamGroupRoleDriftManual},
+		"out of group, demoted by hand":  {TeamGroupRoleMember{Granted: true}, ""},
+		"out of group, not admin at all": {TeamGroupRoleMember{}, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.member.Drift())
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// TeamInviteUsageBucketSize is the width of the window each TeamInviteUsage row counts
+	// invitations for, a day in UTC.
+	TeamInviteUsageBucketSize = 24 * time.Hour
+
+	// TeamInviteBudgetUnlimited is reported as the remaining invitations of a team without a
+	// daily invitation budget.
+	TeamInviteBudgetUnlimited = -1
+
+	// TeamInviteBudgetMaxExtraInvites caps the extra invitations granted to a team at once.
+	TeamInviteBudgetMaxExtraInvites = 100000
+)
+
+// TeamInviteUsage holds the number of invitations sent to join a team within one day, along
+// with the extra invitations an admin granted the team on that day.
+type TeamInviteUsage struct {
+	TeamId       string `json:"team_id"`
+	Day          int64  `json:"day"`
+	Invites      int64  `json:"invites"`
+	ExtraInvites int64  `json:"extra_invites"`
+}
+
+// TeamInviteUsageDay returns the start of the day the given timestamp, in milliseconds, falls
+// into.
+func TeamInviteUsageDay(millis int64) int64 {
+	size := int64(TeamInviteUsageBucketSize / time.Millisecond)
+	return millis - millis%size
+}
+
+func (u *TeamInviteUsage) IsValid() *AppError {
+	if !IsValidId(u.TeamId) {
+		return NewAppError("TeamInviteUsage.IsValid", "model.team_invite_usage.is_valid.team_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Day <= 0 || u.Day != TeamInviteUsageDay(u.Day) {
+		return NewAppError("TeamInviteUsage.IsValid", "model.team_invite_usage.is_valid.day.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Invites < 0 || u.ExtraInvites < 0 || u.Invites+u.ExtraInvites == 0 {
+		return NewAppError("TeamInviteUsage.IsValid", "model.team_invite_usage.is_valid.invites.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// TeamInviteBudget reports how many invitations a team can send today and how many of them it
+// sent. A daily limit of zero is unlimited, in which case Remaining is TeamInviteBudgetUnlimited.
+// ResetAt is when the budget starts over, in milliseconds.
+type TeamInviteBudget struct {
+	TeamId       string `json:"team_id"`
+	DailyLimit   int64  `json:"daily_limit"`
+	ExtraInvites int64  `json:"extra_invites"`
+	Used         int64  `json:"used"`
+	Remaining    int64  `json:"remaining"`
+	ResetAt      int64  `json:"reset_at"`
+}
+
+func NewTeamInviteBudget(dailyLimit int64, usage *TeamInviteUsage) *TeamInviteBudget {
+	budget := &TeamInviteBudget{
+		TeamId:       usage.TeamId,
+		DailyLimit:   dailyLimit,
+		ExtraInvites: usage.ExtraInvites,
+		Used:         usage.Invites,
+		Remaining:    TeamInviteBudgetUnlimited,
+		ResetAt:      usage.Day + int64(TeamInviteUsageBucketSize/time.Millisecond),
+	}
+
+	if dailyLimit > 0 {
+		budget.Remaining = dailyLimit + usage.ExtraInvites - usage.Invites
+		if budget.Remaining < 0 {
+			budget.Remaining = 0
+		}
+	}
+
+	return budget
+}
+
+// Allows reports whether the team can send the given number of invitations.
+func (b *TeamInviteBudget) Allows(invites int) bool {
+	return b.Remaining == TeamInviteBudgetUnlimited || int64(invites) <= b.Remaining
+}
+
+// TeamInviteBudgetGrant holds the extra invitations an admin grants a team for the current day.
+type TeamInviteBudgetGrant struct {
+	ExtraInvites int64 `json:"extra_invites"`
+}
+
+func (g *TeamInviteBudgetGrant) IsValid() *AppError {
+	if g.ExtraInvites <= 0 || g.ExtraInvites > TeamInviteBudgetMaxExtraInvites {
+		return NewAppError("TeamInviteBudgetGrant.IsValid", "model.team_invite_budget_grant.is_valid.extra_invites.app_error", map[string]interface{}{"Max": TeamInviteBudgetMaxExtraInvites}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamInviteUsageIsValid(t *testing.T) {
+	u := TeamInviteUsage{}
+
+	err := u.IsValid()
+	require.False(t, err == nil || err.Id != "model.team_invite_usage.is_valid.team_id.app_error")
+
+	u.TeamId = NewId()
+	u.Day = TeamInviteUsageDay(GetMillis()) + 1
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.team_invite_usage.is_valid.day.app_error")
+
+	u.Day = TeamInviteUsageDay(GetMillis())
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.team_invite_usage.is_valid.invites.app_error")
+
+	u.ExtraInvites = -1
+	u.Invites = 2
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.team_invite_usage.is_valid.invites.app_error")
+
+	u.ExtraInvites = 0
+	require.Nil(t, u.IsValid())
+}
+
+func TestNewTeamInviteBudget(t *testing.T) {
+	day := TeamInviteUsageDay(GetMillis())
+	usage := &TeamInviteUsage{TeamId: NewId(), Day: day, Invites: 8, ExtraInvites: 5}
+
+	budget := NewTeamInviteBudget(10, usage)
+	require.Equal(t, int64(7), budget.Remaining)
+	require.Equal(t, day+24*60*60*1000, budget.ResetAt)
+	require.True(t, budget.Allows(7))
+	require.False(t, budget.Allows(8))
+
+	usage.Invites = 20
+	budget = NewTeamInviteBudget(10, usage)
+	require.Equal(t, int64(0), budget.Remaining)
+	require.False(t, budget.Allows(1))
+
+	budget = NewTeamInviteBudget(0, usage)
+	require.Equal(t, int64(TeamInviteBudgetUnlimited), budget.Remaining)
+	require.True(t, budget.Allows(1000))
+}
+
+func TestTeamInviteBudgetGrantIsValid(t *testing.T) {
+	require.NotNil(t, (&TeamInviteBudgetGrant{}).IsValid())
+	require.NotNil(t, (&TeamInviteBudgetGrant{ExtraInvites: TeamInviteBudgetMaxExtraInvites + 1}).IsValid())
+	require.Nil(t, (&TeamInviteBudgetGrant{ExtraInvites: 50}).IsValid())
+}
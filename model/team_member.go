@@ -74,6 +74,26 @@ type TeamInviteReminderData struct {
 	Interval string
 }
 
+//msgp:ignore TeamEmailInvite
+// TeamEmailInvite describes email invitations to a team as seen by the InviteUsersToTeamWillBeSent
+// and UserInvitedToTeam plugin hooks. SenderId is empty for invitations sent in local mode.
+type TeamEmailInvite struct {
+	TeamId   string            `json:"team_id"`
+	SenderId string            `json:"sender_id"`
+	Emails   []string          `json:"emails"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+//msgp:ignore TeamInviteEmailPreview
+// TeamInviteEmailPreview is the email inviting users to a team, rendered for its sender to check
+// before sending the invitations. SentTo is the address a test email was sent to, if any.
+type TeamInviteEmailPreview struct {
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"html_body"`
+	TextBody string `json:"text_body"`
+	SentTo   string `json:"sent_to,omitempty"`
+}
+
 func EmailInviteWithErrorToEmails(o []*EmailInviteWithError) []string {
 	var ret []string
 	for _, o := range o {
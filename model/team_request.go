@@ -0,0 +1,126 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	TeamRequestStatusPending  = "pending"
+	TeamRequestStatusApproved = "approved"
+	TeamRequestStatusDenied   = "denied"
+
+	TeamRequestJustificationMaxRunes = 1024
+	TeamRequestReviewNoteMaxRunes    = 1024
+)
+
+// TeamRequest is a request made by a user who isn't allowed to create teams to have one
+// created for them. An admin reviews it and, when approved, the team is created with the
+// requester as its admin.
+type TeamRequest struct {
+	Id            string `json:"id"`
+	RequesterId   string `json:"requester_id"`
+	Name          string `json:"name"`
+	DisplayName   string `json:"display_name"`
+	Type          string `json:"type"`
+	Justification string `json:"justification"`
+	Status        string `json:"status"`
+	ReviewerId    string `json:"reviewer_id"`
+	ReviewNote    string `json:"review_note"`
+	TeamId        string `json:"team_id"`
+	CreateAt      int64  `json:"create_at"`
+	UpdateAt      int64  `json:"update_at"`
+}
+
+// TeamRequestReview is the note an admin leaves when approving or denying a team request.
+type TeamRequestReview struct {
+	Note string `json:"note"`
+}
+
+func (r *TeamRequest) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	if r.Type == "" {
+		r.Type = TeamOpen
+	}
+
+	r.Status = TeamRequestStatusPending
+	r.ReviewerId = ""
+	r.ReviewNote = ""
+	r.TeamId = ""
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+}
+
+func (r *TeamRequest) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+func (r *TeamRequest) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.RequesterId) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.requester_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.Name) > TeamNameMaxLength || IsReservedTeamName(r.Name) || !IsValidTeamName(r.Name) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.name.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.DisplayName) == 0 || utf8.RuneCountInString(r.DisplayName) > TeamDisplayNameMaxRunes {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.display_name.app_error", map[string]interface{}{"MaxLength": TeamDisplayNameMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !(r.Type == TeamOpen || r.Type == TeamInvite) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.type.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.Justification == "" || utf8.RuneCountInString(r.Justification) > TeamRequestJustificationMaxRunes {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.justification.app_error", map[string]interface{}{"MaxLength": TeamRequestJustificationMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidTeamRequestStatus(r.Status) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.status.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.ReviewerId != "" && !IsValidId(r.ReviewerId) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.reviewer_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.ReviewNote) > TeamRequestReviewNoteMaxRunes {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.review_note.app_error", map[string]interface{}{"MaxLength": TeamRequestReviewNoteMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.TeamId != "" && !IsValidId(r.TeamId) {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.team_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("TeamRequest.IsValid", "model.team_request.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (r *TeamRequest) IsPending() bool {
+	return r.Status == TeamRequestStatusPending
+}
+
+func IsValidTeamRequestStatus(status string) bool {
+	switch status {
+	case TeamRequestStatusPending, TeamRequestStatusApproved, TeamRequestStatusDenied:
+		return true
+	}
+	return false
+}
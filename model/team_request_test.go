@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamRequestPreSave(t *testing.T) {
+	request := &TeamRequest{
+		RequesterId:   NewId(),
+		Name:          "support",
+		DisplayName:   "Support",
+		Justification: "Customer support needs its own space.",
+		Status:        TeamRequestStatusApproved,
+		ReviewerId:    NewId(),
+		TeamId:        NewId(),
+	}
+	request.PreSave()
+
+	assert.True(t, IsValidId(request.Id))
+	assert.Equal(t, TeamOpen, request.Type)
+	assert.True(t, request.IsPending())
+	assert.Empty(t, request.ReviewerId)
+	assert.Empty(t, request.TeamId)
+	assert.NotZero(t, request.CreateAt)
+	assert.Equal(t, request.CreateAt, request.UpdateAt)
+}
+
+func TestTeamRequestIsValid(t *testing.T) {
+	request := &TeamRequest{
+		RequesterId:   NewId(),
+		Name:          "support",
+		DisplayName:   "Support",
+		Type:          TeamInvite,
+		Justification: "Customer support needs its own space.",
+	}
+	request.PreSave()
+	require.Nil(t, request.IsValid())
+
+	request.Name = "Not Valid"
+	require.NotNil(t, request.IsValid())
+	request.Name = "support"
+
+	request.DisplayName = ""
+	require.NotNil(t, request.IsValid())
+	request.DisplayName = "Support"
+
+	request.Type = "X"
+	require.NotNil(t, request.IsValid())
+	request.Type = TeamInvite
+
+	request.Justification = ""
+	require.NotNil(t, request.IsValid())
+	request.Justification = strings.Repeat("a", TeamRequestJustificationMaxRunes+1)
+	require.NotNil(t, request.IsValid())
+	request.Justification = "Customer support needs its own space."
+
+	request.Status = "archived"
+	require.NotNil(t, request.IsValid())
+	request.Status = TeamRequestStatusDenied
+	require.Nil(t, request.IsValid())
+
+	request.ReviewNote = strings.Repeat("a", TeamRequestReviewNoteMaxRunes+1)
+	require.NotNil(t, request.IsValid())
+	request.ReviewNote = "Use the existing support team."
+	require.Nil(t, request.IsValid())
+
+	request.ReviewerId = "invalid"
+	require.NotNil(t, request.IsValid())
+}
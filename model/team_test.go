@@ -48,6 +48,18 @@ func TestTeamIsValid(t *testing.T) {
 	o.InviteId = NewId()
 	err = o.IsValid()
 	require.Nil(t, err, err)
+
+	o.ParentTeamId = "abc"
+	err = o.IsValid()
+	require.NotNil(t, err, "should be invalid")
+
+	o.ParentTeamId = o.Id
+	err = o.IsValid()
+	require.NotNil(t, err, "should be invalid")
+
+	o.ParentTeamId = NewId()
+	err = o.IsValid()
+	require.Nil(t, err, err)
 }
 
 func TestTeamPreSave(t *testing.T) {
@@ -141,3 +153,15 @@ func TestTeamPatch(t *testing.T) {
 	require.Equal(t, *p.AllowOpenInvite, o.AllowOpenInvite, "AllowOpenInvite did not update")
 	require.Equal(t, *p.GroupConstrained, *o.GroupConstrained)
 }
+
+func TestTeamIsStrictlyIsolated(t *testing.T) {
+	team := Team{Id: NewId()}
+	require.False(t, team.IsStrictlyIsolated(false))
+	require.True(t, team.IsStrictlyIsolated(true))
+
+	team.StrictIsolation = NewBool(false)
+	require.False(t, team.IsStrictlyIsolated(true))
+
+	team.StrictIsolation = NewBool(true)
+	require.True(t, team.IsStrictlyIsolated(false))
+}
@@ -35,6 +35,64 @@ type ThreadResponse struct {
 	UnreadMentions int64   `json:"unread_mentions"`
 }
 
+const (
+	ThreadSummaryDefaultReplies = 5
+	ThreadSummaryMaxReplies     = 50
+
+	ThreadSummaryBucketHour = "hour"
+	ThreadSummaryBucketDay  = "day"
+)
+
+// ThreadSummary gathers everything needed to render a collapsed thread in a single response.
+type ThreadSummary struct {
+	PostId      string `json:"id"`
+	ReplyCount  int64  `json:"reply_count"`
+	LastReplyAt int64  `json:"last_reply_at"`
+
+	// Participants are the users who replied to the thread, the most recent first.
+	Participants []*ThreadParticipant `json:"participants"`
+
+	// ReplyBuckets are the number of replies made during every hour or day, the oldest first.
+	// The hours or days without replies are left out.
+	BucketSize   int64                `json:"bucket_size"`
+	ReplyBuckets []*ThreadReplyBucket `json:"reply_buckets"`
+
+	// LastReplies are the most recent replies to the thread, with their metadata.
+	LastReplies *PostList `json:"last_replies"`
+}
+
+// ThreadParticipant is a user who replied to a thread.
+type ThreadParticipant struct {
+	UserId      string `json:"user_id"`
+	ReplyCount  int64  `json:"reply_count"`
+	LastReplyAt int64  `json:"last_reply_at"`
+	User        *User  `json:"user,omitempty"`
+	AvatarURL   string `json:"avatar_url,omitempty"`
+}
+
+// ThreadReplyBucket is the number of replies made to a thread during the bucket starting at
+// Start.
+type ThreadReplyBucket struct {
+	Start      int64 `json:"start"`
+	ReplyCount int64 `json:"reply_count"`
+}
+
+type GetThreadSummaryOpts struct {
+	// Replies is the number of most recent replies to return. Default = 5
+	Replies int
+
+	// Bucket is the size of the reply buckets, hour or day. Default = day
+	Bucket string
+}
+
+// BucketSize returns the size of the reply buckets in milliseconds.
+func (o GetThreadSummaryOpts) BucketSize() int64 {
+	if o.Bucket == ThreadSummaryBucketHour {
+		return 60 * 60 * 1000
+	}
+	return TeamStatsDayMillis
+}
+
 type Threads struct {
 	Total               int64             `json:"total"`
 	TotalUnreadThreads  int64             `json:"total_unread_threads"`
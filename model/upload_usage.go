@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// UploadUsageBucketSize is the width of the window each UploadUsage row accumulates uploads
+	// for, a day in UTC.
+	UploadUsageBucketSize = 24 * time.Hour
+
+	// UploadQuotaUnlimited is reported as the remaining bytes of an upload quota that isn't set.
+	UploadQuotaUnlimited = -1
+)
+
+// UploadUsage holds the number of bytes a user uploaded within one day.
+type UploadUsage struct {
+	UserId string `json:"user_id"`
+	Day    int64  `json:"day"`
+	Bytes  int64  `json:"bytes"`
+}
+
+// UploadUsageDay returns the start of the day the given timestamp, in milliseconds, falls into.
+func UploadUsageDay(millis int64) int64 {
+	size := int64(UploadUsageBucketSize / time.Millisecond)
+	return millis - millis%size
+}
+
+func (u *UploadUsage) IsValid() *AppError {
+	if !IsValidId(u.UserId) {
+		return NewAppError("UploadUsage.IsValid", "model.upload_usage.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Day <= 0 || u.Day != UploadUsageDay(u.Day) {
+		return NewAppError("UploadUsage.IsValid", "model.upload_usage.is_valid.day.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if u.Bytes <= 0 {
+		return NewAppError("UploadUsage.IsValid", "model.upload_usage.is_valid.bytes.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// UploadLimits reports the upload quotas of a user and how much of them is used. A quota of
+// zero is unlimited, in which case its remaining bytes are UploadQuotaUnlimited.
+type UploadLimits struct {
+	DailyQuotaBytes     int64 `json:"daily_quota_bytes"`
+	DailyUsedBytes      int64 `json:"daily_used_bytes"`
+	DailyRemainingBytes int64 `json:"daily_remaining_bytes"`
+	TotalQuotaBytes     int64 `json:"total_quota_bytes"`
+	TotalUsedBytes      int64 `json:"total_used_bytes"`
+	TotalRemainingBytes int64 `json:"total_remaining_bytes"`
+}
+
+func NewUploadLimits(dailyQuota, dailyUsed, totalQuota, totalUsed int64) *UploadLimits {
+	return &UploadLimits{
+		DailyQuotaBytes:     dailyQuota,
+		DailyUsedBytes:      dailyUsed,
+		DailyRemainingBytes: remainingUploadBytes(dailyQuota, dailyUsed),
+		TotalQuotaBytes:     totalQuota,
+		TotalUsedBytes:      totalUsed,
+		TotalRemainingBytes: remainingUploadBytes(totalQuota, totalUsed),
+	}
+}
+
+func remainingUploadBytes(quota, used int64) int64 {
+	if quota == 0 {
+		return UploadQuotaUnlimited
+	}
+	if used >= quota {
+		return 0
+	}
+	return quota - used
+}
+
+// UserLimits holds the limits applying to a user.
+type UserLimits struct {
+	Uploads *UploadLimits `json:"uploads"`
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadUsageIsValid(t *testing.T) {
+	u := UploadUsage{}
+
+	err := u.IsValid()
+	require.False(t, err == nil || err.Id != "model.upload_usage.is_valid.user_id.app_error")
+
+	u.UserId = NewId()
+	u.Day = UploadUsageDay(GetMillis()) + 1
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.upload_usage.is_valid.day.app_error")
+
+	u.Day = UploadUsageDay(GetMillis())
+	err = u.IsValid()
+	require.False(t, err == nil || err.Id != "model.upload_usage.is_valid.bytes.app_error")
+
+	u.Bytes = 1
+	require.Nil(t, u.IsValid())
+}
+
+func TestNewUploadLimits(t *testing.T) {
+	limits := NewUploadLimits(10, 4, 0, 40)
+	require.Equal(t, int64(6), limits.DailyRemainingBytes)
+	require.Equal(t, int64(UploadQuotaUnlimited), limits.TotalRemainingBytes)
+
+	limits = NewUploadLimits(10, 12, 100, 12)
+	require.Equal(t, int64(0), limits.DailyRemainingBytes)
+	require.Equal(t, int64(88), limits.TotalRemainingBytes)
+}
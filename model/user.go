@@ -580,6 +580,73 @@ func (u *User) Sanitize(options map[string]bool) {
 	}
 }
 
+// userFieldValues are the fields of the users that can be selected when fetching them, by their
+// JSON name.
+var userFieldValues = map[string]func(u *User) interface{}{
+	"create_at":            func(u *User) interface{} { return u.CreateAt },
+	"update_at":            func(u *User) interface{} { return u.UpdateAt },
+	"delete_at":            func(u *User) interface{} { return u.DeleteAt },
+	"username":             func(u *User) interface{} { return u.Username },
+	"auth_service":         func(u *User) interface{} { return u.AuthService },
+	"email":                func(u *User) interface{} { return u.Email },
+	"nickname":             func(u *User) interface{} { return u.Nickname },
+	"first_name":           func(u *User) interface{} { return u.FirstName },
+	"last_name":            func(u *User) interface{} { return u.LastName },
+	"position":             func(u *User) interface{} { return u.Position },
+	"roles":                func(u *User) interface{} { return u.Roles },
+	"props":                func(u *User) interface{} { return u.Props },
+	"last_picture_update":  func(u *User) interface{} { return u.LastPictureUpdate },
+	"locale":               func(u *User) interface{} { return u.Locale },
+	"timezone":             func(u *User) interface{} { return u.Timezone },
+	"remote_id":            func(u *User) interface{} { return u.RemoteId },
+	"is_bot":               func(u *User) interface{} { return u.IsBot },
+	"bot_description":      func(u *User) interface{} { return u.BotDescription },
+	"bot_last_icon_update": func(u *User) interface{} { return u.BotLastIconUpdate },
+}
+
+// IsValidUserField returns whether the field can be selected when fetching users.
+func IsValidUserField(field string) bool {
+	_, ok := userFieldValues[field]
+	return ok
+}
+
+// SanitizeUserFields removes from the fields those that the sanitize options hide, the same way
+// Sanitize clears them from a user.
+func SanitizeUserFields(fields []string, options map[string]bool) []string {
+	sanitized := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "email":
+			if len(options) != 0 && !options["email"] {
+				continue
+			}
+		case "first_name", "last_name":
+			if len(options) != 0 && !options["fullname"] {
+				continue
+			}
+		case "auth_service":
+			if len(options) != 0 && !options["authservice"] {
+				continue
+			}
+		}
+		sanitized = append(sanitized, field)
+	}
+	return sanitized
+}
+
+// SelectFields returns the values of the fields of the user by their JSON name, along with the id
+// of the user. The fields that can't be selected are ignored.
+func (u *User) SelectFields(fields []string) map[string]interface{} {
+	selected := make(map[string]interface{}, len(fields)+1)
+	selected["id"] = u.Id
+	for _, field := range fields {
+		if value, ok := userFieldValues[field]; ok {
+			selected[field] = value(u)
+		}
+	}
+	return selected
+}
+
 // Remove any input data from the user object that is not user controlled
 func (u *User) SanitizeInput(isAdmin bool) {
 	if !isAdmin {
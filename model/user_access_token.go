@@ -13,6 +13,12 @@ type UserAccessToken struct {
 	UserId      string `json:"user_id"`
 	Description string `json:"description"`
 	IsActive    bool   `json:"is_active"`
+	CreateAt    int64  `json:"create_at"`
+	// ExpiresAt is when the token stops being accepted, or 0 if it never expires.
+	ExpiresAt int64 `json:"expires_at"`
+	// WebSocketScope restricts the websocket connections opened with the token, or is nil if
+	// they aren't restricted.
+	WebSocketScope *WebSocketScope `json:"websocket_scope,omitempty"`
 }
 
 func (t *UserAccessToken) IsValid() *AppError {
@@ -32,10 +38,26 @@ func (t *UserAccessToken) IsValid() *AppError {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.description.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if t.ExpiresAt < 0 {
+		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.WebSocketScope != nil {
+		if appErr := t.WebSocketScope.IsValid(); appErr != nil {
+			return appErr
+		}
+	}
+
 	return nil
 }
 
 func (t *UserAccessToken) PreSave() {
 	t.Id = NewId()
 	t.IsActive = true
+	t.CreateAt = GetMillis()
+}
+
+// IsExpired tells whether the token stopped being accepted.
+func (t *UserAccessToken) IsExpired() bool {
+	return t.ExpiresAt != 0 && t.ExpiresAt <= GetMillis()
 }
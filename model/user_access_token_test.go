@@ -30,3 +30,19 @@ func TestUserAccessTokenIsValid(t *testing.T) {
 	err = ad.IsValid()
 	require.False(t, err == nil || err.Id != "model.user_access_token.is_valid.description.app_error")
 }
+
+func TestUserAccessTokenExpiry(t *testing.T) {
+	token := UserAccessToken{Id: NewId(), Token: NewId(), UserId: NewId()}
+	require.Nil(t, token.IsValid())
+	require.False(t, token.IsExpired())
+
+	token.ExpiresAt = -1
+	err := token.IsValid()
+	require.False(t, err == nil || err.Id != "model.user_access_token.is_valid.expires_at.app_error")
+
+	token.ExpiresAt = GetMillis() - 1
+	require.True(t, token.IsExpired())
+
+	token.ExpiresAt = GetMillis() + 60*1000
+	require.False(t, token.IsExpired())
+}
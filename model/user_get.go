@@ -45,4 +45,7 @@ type UserGetOptions struct {
 type UserGetByIdsOptions struct {
 	// Since filters the users based on their UpdateAt timestamp.
 	Since int64
+	// Fields restricts the fields of the users returned to these, by their JSON name. The id of
+	// the users is always returned. See IsValidUserField.
+	Fields []string
 }
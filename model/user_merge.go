@@ -0,0 +1,168 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	UserMergeStatusPending    = "pending"
+	UserMergeStatusInProgress = "in_progress"
+	UserMergeStatusSuccess    = "success"
+	UserMergeStatusError      = "error"
+	UserMergeStatusReverted   = "reverted"
+
+	UserMergeTablePosts          = "Posts"
+	UserMergeTableFileInfo       = "FileInfo"
+	UserMergeTableChannelMembers = "ChannelMembers"
+	UserMergeTableTeamMembers    = "TeamMembers"
+	UserMergeTablePreferences    = "Preferences"
+	UserMergeTableSessions       = "Sessions"
+)
+
+// UserMergeTables are the tables whose rows are reassigned by a user merge, in the order
+// they're merged. A revert goes through them in the reverse order.
+var UserMergeTables = []string{
+	UserMergeTablePosts,
+	UserMergeTableFileInfo,
+	UserMergeTableChannelMembers,
+	UserMergeTableTeamMembers,
+	UserMergeTablePreferences,
+	UserMergeTableSessions,
+}
+
+// UserMergeManifest lists, for each table, the keys of the rows that were moved from the
+// duplicate account to the primary one, so that the merge can be reverted. Rows keyed by
+// more than one column, like preferences, have their key values joined by
+// UserMergeKeySeparator.
+type UserMergeManifest map[string][]string
+
+const UserMergeKeySeparator = ":"
+
+// JoinUserMergeKey builds the manifest key of a row keyed by several columns.
+func JoinUserMergeKey(values ...string) string {
+	return strings.Join(values, UserMergeKeySeparator)
+}
+
+// SplitUserMergeKey splits a manifest key into the given number of column values. Only the
+// last column may contain the separator.
+func SplitUserMergeKey(key string, columns int) []string {
+	return strings.SplitN(key, UserMergeKeySeparator, columns)
+}
+
+func (m *UserMergeManifest) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, m)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), m)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
+
+func (m UserMergeManifest) Value() (driver.Value, error) {
+	j, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
+
+// UserMergeRequest asks for the content of a duplicate account to be moved into a primary
+// one.
+type UserMergeRequest struct {
+	PrimaryUserId   string `json:"primary_user_id"`
+	DuplicateUserId string `json:"duplicate_user_id"`
+}
+
+// UserMerge records the merge of a duplicate account into a primary one, done by a job one
+// table at a time. The manifest is saved after every table, so that even a merge which
+// failed half way can be reverted.
+type UserMerge struct {
+	Id              string            `json:"id"`
+	PrimaryUserId   string            `json:"primary_user_id"`
+	DuplicateUserId string            `json:"duplicate_user_id"`
+	RequesterId     string            `json:"requester_id"`
+	JobId           string            `json:"job_id"`
+	Status          string            `json:"status"`
+	Manifest        UserMergeManifest `json:"manifest"`
+	CreateAt        int64             `json:"create_at"`
+	UpdateAt        int64             `json:"update_at"`
+	RevertAt        int64             `json:"revert_at"`
+}
+
+func (m *UserMerge) PreSave() {
+	if m.Id == "" {
+		m.Id = NewId()
+	}
+
+	if m.Manifest == nil {
+		m.Manifest = UserMergeManifest{}
+	}
+
+	m.Status = UserMergeStatusPending
+	m.CreateAt = GetMillis()
+	m.UpdateAt = m.CreateAt
+	m.RevertAt = 0
+}
+
+func (m *UserMerge) PreUpdate() {
+	m.UpdateAt = GetMillis()
+}
+
+func (m *UserMerge) IsValid() *AppError {
+	if !IsValidId(m.Id) {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.PrimaryUserId) {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.primary_user_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.DuplicateUserId) || m.DuplicateUserId == m.PrimaryUserId {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.duplicate_user_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(m.RequesterId) {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.requester_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.JobId != "" && !IsValidId(m.JobId) {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.job_id.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	switch m.Status {
+	case UserMergeStatusPending, UserMergeStatusInProgress, UserMergeStatusSuccess, UserMergeStatusError, UserMergeStatusReverted:
+	default:
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.status.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.CreateAt == 0 {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.create_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	if m.UpdateAt == 0 {
+		return NewAppError("UserMerge.IsValid", "model.user_merge.is_valid.update_at.app_error", nil, "id="+m.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsFinished tells whether the merge job is done with the merge, successfully or not.
+func (m *UserMerge) IsFinished() bool {
+	return m.Status == UserMergeStatusSuccess || m.Status == UserMergeStatusError
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserMergeIsValid(t *testing.T) {
+	merge := &UserMerge{
+		PrimaryUserId:   NewId(),
+		DuplicateUserId: NewId(),
+		RequesterId:     NewId(),
+		Status:          UserMergeStatusSuccess,
+	}
+	merge.PreSave()
+	require.Nil(t, merge.IsValid())
+	assert.Equal(t, UserMergeStatusPending, merge.Status)
+	assert.NotNil(t, merge.Manifest)
+
+	merge.DuplicateUserId = merge.PrimaryUserId
+	require.NotNil(t, merge.IsValid())
+	merge.DuplicateUserId = NewId()
+
+	merge.JobId = "junk"
+	require.NotNil(t, merge.IsValid())
+	merge.JobId = NewId()
+
+	merge.Status = "unknown"
+	require.NotNil(t, merge.IsValid())
+	merge.Status = UserMergeStatusInProgress
+
+	require.Nil(t, merge.IsValid())
+	assert.False(t, merge.IsFinished())
+}
+
+func TestUserMergeManifest(t *testing.T) {
+	key := JoinUserMergeKey("display_settings", "name:with:colons")
+	assert.Equal(t, []string{"display_settings", "name:with:colons"}, SplitUserMergeKey(key, 2))
+
+	manifest := UserMergeManifest{UserMergeTablePosts: {NewId()}}
+	value, err := manifest.Value()
+	require.NoError(t, err)
+
+	var scanned UserMergeManifest
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, manifest, scanned)
+}
@@ -154,6 +154,29 @@ func HasExpectedUserIsValidError(err *AppError, fieldName string, userId string)
 		(userId == "" || err.DetailedError == "user_id="+userId)
 }
 
+func TestUserSelectFields(t *testing.T) {
+	user := &User{Id: NewId(), Username: "username", Nickname: "", Position: "engineer", Password: "password"}
+
+	assert.True(t, IsValidUserField("nickname"))
+	assert.False(t, IsValidUserField("password"))
+	assert.False(t, IsValidUserField("id"))
+
+	assert.Equal(t, map[string]interface{}{
+		"id":       user.Id,
+		"username": "username",
+		"nickname": "",
+		"position": "engineer",
+	}, user.SelectFields([]string{"username", "nickname", "position", "password"}))
+}
+
+func TestSanitizeUserFields(t *testing.T) {
+	fields := []string{"username", "email", "first_name", "last_name", "auth_service"}
+
+	assert.Equal(t, fields, SanitizeUserFields(fields, map[string]bool{}))
+	assert.Equal(t, []string{"username", "first_name", "last_name"}, SanitizeUserFields(fields, map[string]bool{"email": false, "fullname": true, "authservice": false}))
+	assert.Equal(t, []string{"username", "email", "auth_service"}, SanitizeUserFields(fields, map[string]bool{"email": true, "fullname": false, "authservice": true}))
+}
+
 func TestUserGetFullName(t *testing.T) {
 	user := User{}
 	assert.Equal(t, user.GetFullName(), "", "Full name should be blank")
@@ -312,6 +312,17 @@ func (wsc *WebSocketClient) UserTyping(channelId, parentId string) {
 	wsc.SendMessage("user_typing", data)
 }
 
+// ChannelViewing reports the channel the user has open, or that they closed it if
+// channelId is empty. It must be sent again before ChannelPresenceTimeoutMilliseconds
+// for as long as the channel stays open.
+func (wsc *WebSocketClient) ChannelViewing(channelId string) {
+	data := map[string]interface{}{
+		"channel_id": channelId,
+	}
+
+	wsc.SendMessage("channel_viewing", data)
+}
+
 // GetStatuses will return a map of string statuses using user id as the key
 func (wsc *WebSocketClient) GetStatuses() {
 	wsc.SendMessage("get_statuses", nil)
@@ -14,6 +14,7 @@ const (
 	WebsocketEventPosted                              = "posted"
 	WebsocketEventPostEdited                          = "post_edited"
 	WebsocketEventPostDeleted                         = "post_deleted"
+	WebsocketEventPostRestored                        = "post_restored"
 	WebsocketEventPostUnread                          = "post_unread"
 	WebsocketEventChannelConverted                    = "channel_converted"
 	WebsocketEventChannelCreated                      = "channel_created"
@@ -54,6 +55,7 @@ const (
 	WebsocketEventRoleUpdated                         = "role_updated"
 	WebsocketEventLicenseChanged                      = "license_changed"
 	WebsocketEventConfigChanged                       = "config_changed"
+	WebsocketEventConfigCapabilitiesChanged           = "config_capabilities_changed"
 	WebsocketEventOpenDialog                          = "open_dialog"
 	WebsocketEventGuestsDeactivated                   = "guests_deactivated"
 	WebsocketEventUserActivationStatusChange          = "user_activation_status_change"
@@ -75,6 +77,20 @@ const (
 	WebsocketEventThreadFollowChanged                 = "thread_follow_changed"
 	WebsocketEventThreadReadChanged                   = "thread_read_changed"
 	WebsocketFirstAdminVisitMarketplaceStatusReceived = "first_admin_visit_marketplace_status_received"
+	WebsocketEventPostTaskUpdated                     = "post_task_updated"
+	WebsocketEventDirectChannelRetentionUpdated       = "direct_channel_retention_updated"
+	WebsocketEventDirectChannelRetentionRemoved       = "direct_channel_retention_removed"
+	WebsocketEventDirectChannelPostsPurged            = "direct_channel_posts_purged"
+	WebsocketEventPostAcknowledgementAdded            = "post_acknowledgement_added"
+	WebsocketEventPostAcknowledgementRemoved          = "post_acknowledgement_removed"
+	WebsocketEventTeamBannerShown                     = "team_banner_shown"
+	WebsocketEventTeamBannerHidden                    = "team_banner_hidden"
+	WebsocketEventChannelPresence                     = "channel_presence"
+	WebsocketEventRemoteClusterSyncFailed             = "remote_cluster_sync_failed"
+	WebsocketEventUsersAdded                          = "users_added"
+	WebsocketEventPostModerationQueued                = "post_moderation_queued"
+	WebsocketEventPostModerationReviewed              = "post_moderation_reviewed"
+	WebsocketEventTeamsOrderUpdated                   = "teams_order_updated"
 )
 
 type WebSocketMessage interface {
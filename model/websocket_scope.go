@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	WebSocketScopeMaxChannels        = 200
+	WebSocketScopeMaxEventTypes      = 50
+	WebSocketScopeEventTypeMaxLength = 64
+)
+
+// WebSocketScope restricts the websocket connections opened with a user access token to the
+// events of some channels, of some types, or both. An empty list doesn't restrict its side, but a
+// scope restricts at least one of them. Connections restricted to channels only receive the
+// events broadcast to one of the channels, so that integrations can stream a few channels
+// without receiving every event of their user.
+type WebSocketScope struct {
+	ChannelIds []string `json:"channel_ids,omitempty"`
+	EventTypes []string `json:"event_types,omitempty"`
+}
+
+func (s *WebSocketScope) IsValid() *AppError {
+	if len(s.ChannelIds) == 0 && len(s.EventTypes) == 0 {
+		return NewAppError("WebSocketScope.IsValid", "model.websocket_scope.is_valid.empty.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(s.ChannelIds) > WebSocketScopeMaxChannels {
+		return NewAppError("WebSocketScope.IsValid", "model.websocket_scope.is_valid.max_channels.app_error", map[string]interface{}{"Max": WebSocketScopeMaxChannels}, "", http.StatusBadRequest)
+	}
+
+	for _, channelID := range s.ChannelIds {
+		if !IsValidId(channelID) {
+			return NewAppError("WebSocketScope.IsValid", "model.websocket_scope.is_valid.channel_id.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+		}
+	}
+
+	if len(s.EventTypes) > WebSocketScopeMaxEventTypes {
+		return NewAppError("WebSocketScope.IsValid", "model.websocket_scope.is_valid.max_event_types.app_error", map[string]interface{}{"Max": WebSocketScopeMaxEventTypes}, "", http.StatusBadRequest)
+	}
+
+	for _, eventType := range s.EventTypes {
+		if eventType == "" || len(eventType) > WebSocketScopeEventTypeMaxLength {
+			return NewAppError("WebSocketScope.IsValid", "model.websocket_scope.is_valid.event_type.app_error", map[string]interface{}{"Max": WebSocketScopeEventTypeMaxLength}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// Allows tells whether the event can be sent over a connection restricted to the scope.
+func (s *WebSocketScope) Allows(event *WebSocketEvent) bool {
+	if len(s.EventTypes) > 0 && !StringArray(s.EventTypes).Contains(event.EventType()) {
+		return false
+	}
+
+	if len(s.ChannelIds) > 0 && !StringArray(s.ChannelIds).Contains(event.GetBroadcast().ChannelId) {
+		return false
+	}
+
+	return true
+}
+
+func (s *WebSocketScope) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, s)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), s)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
+
+func (s WebSocketScope) Value() (driver.Value, error) {
+	j, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketScopeIsValid(t *testing.T) {
+	require.NotNil(t, (&WebSocketScope{}).IsValid(), "scopes restrict something")
+
+	require.Nil(t, (&WebSocketScope{ChannelIds: []string{NewId()}}).IsValid())
+	require.Nil(t, (&WebSocketScope{EventTypes: []string{WebsocketEventPosted}}).IsValid())
+
+	require.NotNil(t, (&WebSocketScope{ChannelIds: []string{"junk"}}).IsValid())
+	require.NotNil(t, (&WebSocketScope{EventTypes: []string{""}}).IsValid())
+	require.NotNil(t, (&WebSocketScope{EventTypes: []string{strings.Repeat("a", WebSocketScopeEventTypeMaxLength+1)}}).IsValid())
+
+	channelIDs := make([]string, WebSocketScopeMaxChannels+1)
+	for i := range channelIDs {
+		channelIDs[i] = NewId()
+	}
+	require.NotNil(t, (&WebSocketScope{ChannelIds: channelIDs}).IsValid())
+
+	token := &UserAccessToken{Id: NewId(), Token: NewId(), UserId: NewId(), WebSocketScope: &WebSocketScope{}}
+	require.NotNil(t, token.IsValid(), "the scope of tokens is checked")
+}
+
+func TestWebSocketScopeAllows(t *testing.T) {
+	channelID := NewId()
+	posted := NewWebSocketEvent(WebsocketEventPosted, "", channelID, "", nil)
+	postedElsewhere := NewWebSocketEvent(WebsocketEventPosted, "", NewId(), "", nil)
+	typing := NewWebSocketEvent(WebsocketEventTyping, "", channelID, "", nil)
+	userUpdated := NewWebSocketEvent(WebsocketEventUserUpdated, "", "", "", nil)
+
+	scope := &WebSocketScope{ChannelIds: []string{channelID}}
+	assert.True(t, scope.Allows(posted))
+	assert.True(t, scope.Allows(typing))
+	assert.False(t, scope.Allows(postedElsewhere))
+	assert.False(t, scope.Allows(userUpdated), "events not broadcast to a channel aren't in channel scopes")
+
+	scope = &WebSocketScope{EventTypes: []string{WebsocketEventPosted}}
+	assert.True(t, scope.Allows(posted))
+	assert.True(t, scope.Allows(postedElsewhere))
+	assert.False(t, scope.Allows(typing))
+
+	scope = &WebSocketScope{ChannelIds: []string{channelID}, EventTypes: []string{WebsocketEventPosted}}
+	assert.True(t, scope.Allows(posted))
+	assert.False(t, scope.Allows(postedElsewhere))
+	assert.False(t, scope.Allows(typing))
+}
+
+func TestSessionWebSocketScope(t *testing.T) {
+	session := &Session{}
+	scope, err := session.WebSocketScope()
+	require.NoError(t, err)
+	assert.Nil(t, scope)
+
+	session.AddProp(SessionPropWebSocketScope, `{"event_types":["posted"]}`)
+	scope, err = session.WebSocketScope()
+	require.NoError(t, err)
+	assert.Equal(t, &WebSocketScope{EventTypes: []string{WebsocketEventPosted}}, scope)
+
+	session.AddProp(SessionPropWebSocketScope, `{"event_types":`)
+	_, err = session.WebSocketScope()
+	require.Error(t, err)
+}
+
+func TestWebSocketScopeScanValue(t *testing.T) {
+	scope := WebSocketScope{ChannelIds: []string{NewId()}, EventTypes: []string{WebsocketEventPosted}}
+	value, err := scope.Value()
+	require.NoError(t, err)
+
+	var scanned WebSocketScope
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, scope, scanned)
+
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, scope, scanned)
+
+	require.Error(t, scanned.Scan(42))
+}
@@ -1147,6 +1147,43 @@ type API interface {
 	//
 	// Minimum server version: 5.36
 	RequestTrialLicense(requesterID string, users int, termsAccepted bool, receiveEmailsAccepted bool) *model.AppError
+
+	// RegisterAuditRecordFilter limits the audit records passed to the OnAuditRecord hook of the
+	// plugin to those whose event starts with one of the given prefixes, e.g. "localCreateTeam"
+	// or "delete". It is meant to be called from OnActivate. Calling it with no prefixes passes
+	// every audit record again.
+	//
+	// Minimum server version: 6.6
+	RegisterAuditRecordFilter(eventPrefixes []string) error
+
+	// BotStateGet gets the state a bot owned by the plugin keeps under the key, in the scope of
+	// the user and channel, either of which can be empty. Expired states aren't returned.
+	//
+	// @tag Bot
+	// Minimum server version: 6.6
+	BotStateGet(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError)
+
+	// BotStateSet sets the state of a bot owned by the plugin under the key, in the scope of the
+	// user and channel. The value must be JSON. The state expires expireInSeconds from now, or
+	// never when zero.
+	//
+	// @tag Bot
+	// Minimum server version: 6.6
+	BotStateSet(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError)
+
+	// BotStateDelete deletes the state of a bot owned by the plugin under the key, in the scope
+	// of the user and channel.
+	//
+	// @tag Bot
+	// Minimum server version: 6.6
+	BotStateDelete(botUserID, userID, channelID, key string) *model.AppError
+
+	// BotStateList lists the keys a bot owned by the plugin has states under, in the scope of the
+	// user and channel, in alphabetical order.
+	//
+	// @tag Bot
+	// Minimum server version: 6.6
+	BotStateList(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError)
 }
 
 var handshake = plugin.HandshakeConfig{
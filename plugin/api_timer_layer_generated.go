@@ -1225,3 +1225,38 @@ func (api *apiTimerLayer) RequestTrialLicense(requesterID string, users int, ter
 	api.recordTime(startTime, "RequestTrialLicense", _returnsA == nil)
 	return _returnsA
 }
+
+func (api *apiTimerLayer) RegisterAuditRecordFilter(eventPrefixes []string) error {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.RegisterAuditRecordFilter(eventPrefixes)
+	api.recordTime(startTime, "RegisterAuditRecordFilter", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) BotStateGet(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.BotStateGet(botUserID, userID, channelID, key)
+	api.recordTime(startTime, "BotStateGet", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) BotStateSet(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.BotStateSet(botUserID, userID, channelID, key, value, expireInSeconds)
+	api.recordTime(startTime, "BotStateSet", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) BotStateDelete(botUserID, userID, channelID, key string) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.BotStateDelete(botUserID, userID, channelID, key)
+	api.recordTime(startTime, "BotStateDelete", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) BotStateList(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.BotStateList(botUserID, userID, channelID, page, perPage)
+	api.recordTime(startTime, "BotStateList", _returnsB == nil)
+	return _returnsA, _returnsB
+}
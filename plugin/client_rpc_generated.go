@@ -774,6 +774,110 @@ func (s *hooksRPCServer) OnSendDailyTelemetry(args *Z_OnSendDailyTelemetryArgs,
 	return nil
 }
 
+func init() {
+	hookNameToId["OnAuditRecord"] = OnAuditRecordID
+}
+
+type Z_OnAuditRecordArgs struct {
+	A *model.AuditRecord
+}
+
+type Z_OnAuditRecordReturns struct {
+}
+
+func (g *hooksRPCClient) OnAuditRecord(record *model.AuditRecord) {
+	_args := &Z_OnAuditRecordArgs{record}
+	_returns := &Z_OnAuditRecordReturns{}
+	if g.implemented[OnAuditRecordID] {
+		if err := g.client.Call("Plugin.OnAuditRecord", _args, _returns); err != nil {
+			g.log.Error("RPC call OnAuditRecord to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) OnAuditRecord(args *Z_OnAuditRecordArgs, returns *Z_OnAuditRecordReturns) error {
+	if hook, ok := s.impl.(interface {
+		OnAuditRecord(record *model.AuditRecord)
+	}); ok {
+		hook.OnAuditRecord(args.A)
+	} else {
+		return encodableError(fmt.Errorf("Hook OnAuditRecord called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["InviteUsersToTeamWillBeSent"] = InviteUsersToTeamWillBeSentID
+}
+
+type Z_InviteUsersToTeamWillBeSentArgs struct {
+	A *Context
+	B *model.TeamEmailInvite
+}
+
+type Z_InviteUsersToTeamWillBeSentReturns struct {
+	A *model.TeamEmailInvite
+	B string
+}
+
+func (g *hooksRPCClient) InviteUsersToTeamWillBeSent(c *Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string) {
+	_args := &Z_InviteUsersToTeamWillBeSentArgs{c, invite}
+	_returns := &Z_InviteUsersToTeamWillBeSentReturns{}
+	if g.implemented[InviteUsersToTeamWillBeSentID] {
+		if err := g.client.Call("Plugin.InviteUsersToTeamWillBeSent", _args, _returns); err != nil {
+			g.log.Error("RPC call InviteUsersToTeamWillBeSent to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *hooksRPCServer) InviteUsersToTeamWillBeSent(args *Z_InviteUsersToTeamWillBeSentArgs, returns *Z_InviteUsersToTeamWillBeSentReturns) error {
+	if hook, ok := s.impl.(interface {
+		InviteUsersToTeamWillBeSent(c *Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string)
+	}); ok {
+		returns.A, returns.B = hook.InviteUsersToTeamWillBeSent(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook InviteUsersToTeamWillBeSent called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["UserInvitedToTeam"] = UserInvitedToTeamID
+}
+
+type Z_UserInvitedToTeamArgs struct {
+	A *Context
+	B *model.TeamEmailInvite
+	C string
+}
+
+type Z_UserInvitedToTeamReturns struct {
+}
+
+func (g *hooksRPCClient) UserInvitedToTeam(c *Context, invite *model.TeamEmailInvite, email string) {
+	_args := &Z_UserInvitedToTeamArgs{c, invite, email}
+	_returns := &Z_UserInvitedToTeamReturns{}
+	if g.implemented[UserInvitedToTeamID] {
+		if err := g.client.Call("Plugin.UserInvitedToTeam", _args, _returns); err != nil {
+			g.log.Error("RPC call UserInvitedToTeam to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) UserInvitedToTeam(args *Z_UserInvitedToTeamArgs, returns *Z_UserInvitedToTeamReturns) error {
+	if hook, ok := s.impl.(interface {
+		UserInvitedToTeam(c *Context, invite *model.TeamEmailInvite, email string)
+	}); ok {
+		hook.UserInvitedToTeam(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook UserInvitedToTeam called but not implemented."))
+	}
+	return nil
+}
+
 type Z_RegisterCommandArgs struct {
 	A *model.Command
 }
@@ -5616,3 +5720,162 @@ func (s *apiRPCServer) RequestTrialLicense(args *Z_RequestTrialLicenseArgs, retu
 	}
 	return nil
 }
+
+type Z_RegisterAuditRecordFilterArgs struct {
+	A []string
+}
+
+type Z_RegisterAuditRecordFilterReturns struct {
+	A error
+}
+
+func (g *apiRPCClient) RegisterAuditRecordFilter(eventPrefixes []string) error {
+	_args := &Z_RegisterAuditRecordFilterArgs{eventPrefixes}
+	_returns := &Z_RegisterAuditRecordFilterReturns{}
+	if err := g.client.Call("Plugin.RegisterAuditRecordFilter", _args, _returns); err != nil {
+		log.Printf("RPC call to RegisterAuditRecordFilter API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) RegisterAuditRecordFilter(args *Z_RegisterAuditRecordFilterArgs, returns *Z_RegisterAuditRecordFilterReturns) error {
+	if hook, ok := s.impl.(interface {
+		RegisterAuditRecordFilter(eventPrefixes []string) error
+	}); ok {
+		returns.A = hook.RegisterAuditRecordFilter(args.A)
+		returns.A = encodableError(returns.A)
+	} else {
+		return encodableError(fmt.Errorf("API RegisterAuditRecordFilter called but not implemented."))
+	}
+	return nil
+}
+
+type Z_BotStateGetArgs struct {
+	A string
+	B string
+	C string
+	D string
+}
+
+type Z_BotStateGetReturns struct {
+	A *model.BotState
+	B *model.AppError
+}
+
+func (g *apiRPCClient) BotStateGet(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError) {
+	_args := &Z_BotStateGetArgs{botUserID, userID, channelID, key}
+	_returns := &Z_BotStateGetReturns{}
+	if err := g.client.Call("Plugin.BotStateGet", _args, _returns); err != nil {
+		log.Printf("RPC call to BotStateGet API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) BotStateGet(args *Z_BotStateGetArgs, returns *Z_BotStateGetReturns) error {
+	if hook, ok := s.impl.(interface {
+		BotStateGet(botUserID, userID, channelID, key string) (*model.BotState, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.BotStateGet(args.A, args.B, args.C, args.D)
+	} else {
+		return encodableError(fmt.Errorf("API BotStateGet called but not implemented."))
+	}
+	return nil
+}
+
+type Z_BotStateSetArgs struct {
+	A string
+	B string
+	C string
+	D string
+	E []byte
+	F int64
+}
+
+type Z_BotStateSetReturns struct {
+	A *model.BotState
+	B *model.AppError
+}
+
+func (g *apiRPCClient) BotStateSet(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError) {
+	_args := &Z_BotStateSetArgs{botUserID, userID, channelID, key, value, expireInSeconds}
+	_returns := &Z_BotStateSetReturns{}
+	if err := g.client.Call("Plugin.BotStateSet", _args, _returns); err != nil {
+		log.Printf("RPC call to BotStateSet API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) BotStateSet(args *Z_BotStateSetArgs, returns *Z_BotStateSetReturns) error {
+	if hook, ok := s.impl.(interface {
+		BotStateSet(botUserID, userID, channelID, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.BotStateSet(args.A, args.B, args.C, args.D, args.E, args.F)
+	} else {
+		return encodableError(fmt.Errorf("API BotStateSet called but not implemented."))
+	}
+	return nil
+}
+
+type Z_BotStateDeleteArgs struct {
+	A string
+	B string
+	C string
+	D string
+}
+
+type Z_BotStateDeleteReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) BotStateDelete(botUserID, userID, channelID, key string) *model.AppError {
+	_args := &Z_BotStateDeleteArgs{botUserID, userID, channelID, key}
+	_returns := &Z_BotStateDeleteReturns{}
+	if err := g.client.Call("Plugin.BotStateDelete", _args, _returns); err != nil {
+		log.Printf("RPC call to BotStateDelete API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) BotStateDelete(args *Z_BotStateDeleteArgs, returns *Z_BotStateDeleteReturns) error {
+	if hook, ok := s.impl.(interface {
+		BotStateDelete(botUserID, userID, channelID, key string) *model.AppError
+	}); ok {
+		returns.A = hook.BotStateDelete(args.A, args.B, args.C, args.D)
+	} else {
+		return encodableError(fmt.Errorf("API BotStateDelete called but not implemented."))
+	}
+	return nil
+}
+
+type Z_BotStateListArgs struct {
+	A string
+	B string
+	C string
+	D int
+	E int
+}
+
+type Z_BotStateListReturns struct {
+	A []string
+	B *model.AppError
+}
+
+func (g *apiRPCClient) BotStateList(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError) {
+	_args := &Z_BotStateListArgs{botUserID, userID, channelID, page, perPage}
+	_returns := &Z_BotStateListReturns{}
+	if err := g.client.Call("Plugin.BotStateList", _args, _returns); err != nil {
+		log.Printf("RPC call to BotStateList API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) BotStateList(args *Z_BotStateListArgs, returns *Z_BotStateListReturns) error {
+	if hook, ok := s.impl.(interface {
+		BotStateList(botUserID, userID, channelID string, page, perPage int) ([]string, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.BotStateList(args.A, args.B, args.C, args.D, args.E)
+	} else {
+		return encodableError(fmt.Errorf("API BotStateList called but not implemented."))
+	}
+	return nil
+}
@@ -42,6 +42,9 @@ const (
 	RunDataRetentionID              = 24
 	OnInstallID                     = 25
 	OnSendDailyTelemetryID          = 26
+	OnAuditRecordID                 = 27
+	InviteUsersToTeamWillBeSentID   = 28
+	UserInvitedToTeamID             = 29
 	TotalHooksID                    = iota
 )
 
@@ -264,4 +267,33 @@ type Hooks interface {
 	//
 	// Minimum server version: 6.5
 	OnSendDailyTelemetry()
+
+	// OnAuditRecord is invoked asynchronously after an audit record was written. Use
+	// RegisterAuditRecordFilter during OnActivate to only receive the records of some events.
+	//
+	// Minimum server version: 6.6
+	OnAuditRecord(record *model.AuditRecord)
+
+	// InviteUsersToTeamWillBeSent is invoked before email invitations to a team are sent, from
+	// both the REST API and local mode.
+	//
+	// To reject the invitations, return a non-empty string describing why they were rejected.
+	// To modify the invitations, e.g. to remove recipients or add metadata, return the
+	// replacement, non-nil *model.TeamEmailInvite and an empty string.
+	// To allow the invitations without modification, return a nil *model.TeamEmailInvite and an
+	// empty string.
+	//
+	// If you don't need to modify or reject the invitations, use UserInvitedToTeam instead.
+	//
+	// Note that this method will be called for invitations sent by plugins, including the plugin
+	// that sent them.
+	//
+	// Minimum server version: 6.6
+	InviteUsersToTeamWillBeSent(c *Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string)
+
+	// UserInvitedToTeam is invoked after an email invitation to a team was sent, once per
+	// invited email address. The invite is the one returned by InviteUsersToTeamWillBeSent.
+	//
+	// Minimum server version: 6.6
+	UserInvitedToTeam(c *Context, invite *model.TeamEmailInvite, email string)
 }
@@ -206,3 +206,22 @@ func (hooks *hooksTimerLayer) OnSendDailyTelemetry() {
 	hooks.hooksImpl.OnSendDailyTelemetry()
 	hooks.recordTime(startTime, "OnSendDailyTelemetry", true)
 }
+
+func (hooks *hooksTimerLayer) OnAuditRecord(record *model.AuditRecord) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.OnAuditRecord(record)
+	hooks.recordTime(startTime, "OnAuditRecord", true)
+}
+
+func (hooks *hooksTimerLayer) InviteUsersToTeamWillBeSent(c *Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := hooks.hooksImpl.InviteUsersToTeamWillBeSent(c, invite)
+	hooks.recordTime(startTime, "InviteUsersToTeamWillBeSent", true)
+	return _returnsA, _returnsB
+}
+
+func (hooks *hooksTimerLayer) UserInvitedToTeam(c *Context, invite *model.TeamEmailInvite, email string) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.UserInvitedToTeam(c, invite, email)
+	hooks.recordTime(startTime, "UserInvitedToTeam", true)
+}
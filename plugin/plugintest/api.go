@@ -93,6 +93,97 @@ func (_m *API) AddUserToChannel(channelId string, userID string, asUserId string
 	return r0, r1
 }
 
+// BotStateDelete provides a mock function with given fields: botUserID, userID, channelID, key
+func (_m *API) BotStateDelete(botUserID string, userID string, channelID string, key string) *model.AppError {
+	ret := _m.Called(botUserID, userID, channelID, key)
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *model.AppError); ok {
+		r0 = rf(botUserID, userID, channelID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
+// BotStateGet provides a mock function with given fields: botUserID, userID, channelID, key
+func (_m *API) BotStateGet(botUserID string, userID string, channelID string, key string) (*model.BotState, *model.AppError) {
+	ret := _m.Called(botUserID, userID, channelID, key)
+
+	var r0 *model.BotState
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *model.BotState); ok {
+		r0 = rf(botUserID, userID, channelID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BotState)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, string, string, string) *model.AppError); ok {
+		r1 = rf(botUserID, userID, channelID, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// BotStateList provides a mock function with given fields: botUserID, userID, channelID, page, perPage
+func (_m *API) BotStateList(botUserID string, userID string, channelID string, page int, perPage int) ([]string, *model.AppError) {
+	ret := _m.Called(botUserID, userID, channelID, page, perPage)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) []string); ok {
+		r0 = rf(botUserID, userID, channelID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) *model.AppError); ok {
+		r1 = rf(botUserID, userID, channelID, page, perPage)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// BotStateSet provides a mock function with given fields: botUserID, userID, channelID, key, value, expireInSeconds
+func (_m *API) BotStateSet(botUserID string, userID string, channelID string, key string, value []byte, expireInSeconds int64) (*model.BotState, *model.AppError) {
+	ret := _m.Called(botUserID, userID, channelID, key, value, expireInSeconds)
+
+	var r0 *model.BotState
+	if rf, ok := ret.Get(0).(func(string, string, string, string, []byte, int64) *model.BotState); ok {
+		r0 = rf(botUserID, userID, channelID, key, value, expireInSeconds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BotState)
+		}
+	}
+
+	var r1 *model.AppError
+	if rf, ok := ret.Get(1).(func(string, string, string, string, []byte, int64) *model.AppError); ok {
+		r1 = rf(botUserID, userID, channelID, key, value, expireInSeconds)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // CopyFileInfos provides a mock function with given fields: userID, fileIds
 func (_m *API) CopyFileInfos(userID string, fileIds []string) ([]string, *model.AppError) {
 	ret := _m.Called(userID, fileIds)
@@ -2897,6 +2988,20 @@ func (_m *API) ReadFile(path string) ([]byte, *model.AppError) {
 	return r0, r1
 }
 
+// RegisterAuditRecordFilter provides a mock function with given fields: eventPrefixes
+func (_m *API) RegisterAuditRecordFilter(eventPrefixes []string) error {
+	ret := _m.Called(eventPrefixes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(eventPrefixes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterCommand provides a mock function with given fields: command
 func (_m *API) RegisterCommand(command *model.Command) error {
 	ret := _m.Called(command)
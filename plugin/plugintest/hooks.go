@@ -96,6 +96,29 @@ func (_m *Hooks) Implemented() ([]string, error) {
 	return r0, r1
 }
 
+// InviteUsersToTeamWillBeSent provides a mock function with given fields: c, invite
+func (_m *Hooks) InviteUsersToTeamWillBeSent(c *plugin.Context, invite *model.TeamEmailInvite) (*model.TeamEmailInvite, string) {
+	ret := _m.Called(c, invite)
+
+	var r0 *model.TeamEmailInvite
+	if rf, ok := ret.Get(0).(func(*plugin.Context, *model.TeamEmailInvite) *model.TeamEmailInvite); ok {
+		r0 = rf(c, invite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamEmailInvite)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(*plugin.Context, *model.TeamEmailInvite) string); ok {
+		r1 = rf(c, invite)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
 // MessageHasBeenPosted provides a mock function with given fields: c, post
 func (_m *Hooks) MessageHasBeenPosted(c *plugin.Context, post *model.Post) {
 	_m.Called(c, post)
@@ -166,6 +189,11 @@ func (_m *Hooks) OnActivate() error {
 	return r0
 }
 
+// OnAuditRecord provides a mock function with given fields: record
+func (_m *Hooks) OnAuditRecord(record *model.AuditRecord) {
+	_m.Called(record)
+}
+
 // OnConfigurationChange provides a mock function with given fields:
 func (_m *Hooks) OnConfigurationChange() error {
 	ret := _m.Called()
@@ -294,6 +322,11 @@ func (_m *Hooks) UserHasLoggedIn(c *plugin.Context, user *model.User) {
 	_m.Called(c, user)
 }
 
+// UserInvitedToTeam provides a mock function with given fields: c, invite, email
+func (_m *Hooks) UserInvitedToTeam(c *plugin.Context, invite *model.TeamEmailInvite, email string) {
+	_m.Called(c, invite, email)
+}
+
 // UserWillLogIn provides a mock function with given fields: c, user
 func (_m *Hooks) UserWillLogIn(c *plugin.Context, user *model.User) string {
 	ret := _m.Called(c, user)
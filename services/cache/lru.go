@@ -8,9 +8,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/tinylib/msgp/msgp"
-	"github.com/vmihailenco/msgpack/v5"
-
 	"github.com/mattermost/mattermost-server/v6/model"
 )
 
@@ -48,6 +45,10 @@ type entry struct {
 
 // NewLRU creates an LRU of the given size.
 func NewLRU(opts LRUOptions) Cache {
+	return newLRU(opts)
+}
+
+func newLRU(opts LRUOptions) *LRU {
 	return &LRU{
 		name:                   opts.Name,
 		size:                   opts.Size,
@@ -138,24 +139,22 @@ func (l *LRU) Name() string {
 }
 
 func (l *LRU) set(key string, value interface{}, ttl time.Duration) error {
+	buf, err := encodeValue(value)
+	if err != nil {
+		return err
+	}
+
+	l.setItem(key, buf, ttl)
+	return nil
+}
+
+// setItem adds the encoded value to the cache.
+func (l *LRU) setItem(key string, buf []byte, ttl time.Duration) {
 	var expires time.Time
 	if ttl > 0 {
 		expires = time.Now().Add(ttl)
 	}
 
-	var buf []byte
-	var err error
-	// We use a fast path for hot structs.
-	if msgpVal, ok := value.(msgp.Marshaler); ok {
-		buf, err = msgpVal.MarshalMsg(nil)
-	} else {
-		// Slow path for other structs.
-		buf, err = msgpack.Marshal(value)
-	}
-	if err != nil {
-		return err
-	}
-
 	l.lock.Lock()
 	defer l.lock.Unlock()
 
@@ -169,7 +168,7 @@ func (l *LRU) set(key string, value interface{}, ttl time.Duration) error {
 			e.generation = l.currentGeneration
 			l.len++
 		}
-		return nil
+		return
 	}
 
 	// Add new item
@@ -181,7 +180,6 @@ func (l *LRU) set(key string, value interface{}, ttl time.Duration) error {
 	if l.evictList.Len() > l.size {
 		l.removeElement(l.evictList.Back())
 	}
-	return nil
 }
 
 func (l *LRU) get(key string, value interface{}) error {
@@ -190,35 +188,7 @@ func (l *LRU) get(key string, value interface{}) error {
 		return err
 	}
 
-	// We use a fast path for hot structs.
-	if msgpVal, ok := value.(msgp.Unmarshaler); ok {
-		_, err := msgpVal.UnmarshalMsg(val)
-		return err
-	}
-
-	// This is ugly and makes the cache package aware of the model package.
-	// But this is due to 2 things.
-	// 1. The msgp package works on methods on structs rather than functions.
-	// 2. Our cache interface passes pointers to empty pointers, and not pointers
-	// to values. This is mainly how all our model structs are passed around.
-	// It might be technically possible to use values _just_ for hot structs
-	// like these and then return a pointer while returning from the cache function,
-	// but it will make the codebase inconsistent, and has some edge-cases to take care of.
-	switch v := value.(type) {
-	case **model.User:
-		var u model.User
-		_, err := u.UnmarshalMsg(val)
-		*v = &u
-		return err
-	case *map[string]*model.User:
-		var u model.UserMap
-		_, err := u.UnmarshalMsg(val)
-		*v = u
-		return err
-	}
-
-	// Slow path for other structs.
-	return msgpack.Unmarshal(val, value)
+	return decodeValue(val, value)
 }
 
 func (l *LRU) getItem(key string) ([]byte, error) {
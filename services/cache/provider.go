@@ -17,6 +17,9 @@ type CacheOptions struct {
 	InvalidateClusterEvent model.ClusterEvent
 	Striped                bool
 	StripedBuckets         int
+	// Shared makes the cache shared by the nodes of the cluster when the provider supports it. It's
+	// meant for the caches that must not serve stale data once another node changed it.
+	Shared bool
 }
 
 // Provider is a provider for Cache
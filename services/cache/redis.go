@@ -0,0 +1,417 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	redisKeyPrefix             = "mattermost:cache:"
+	redisInvalidationsChannel  = "mattermost:cache:invalidations"
+	redisConnectTimeout        = 10 * time.Second
+	redisScanCount             = 1000
+	redisLocalCopyMaxExpiry    = 30 * time.Second
+	redisInvalidationQueueSize = 1000
+)
+
+// RedisMetrics records the metrics of the caches living in Redis, by cache.
+type RedisMetrics interface {
+	ObserveRedisCacheRequestDuration(cacheName, operation string, elapsed float64)
+	IncrementRedisCacheErrorCounter(cacheName, operation string)
+	IncrementRedisCacheInvalidationCounter(cacheName string)
+}
+
+// RedisProviderOptions contains options for initializing the Redis provider.
+type RedisProviderOptions struct {
+	Address  string
+	Password string
+	DB       int
+	// Metrics is optional.
+	Metrics RedisMetrics
+}
+
+// redisInvalidation lets the other nodes know that their local copies of a key, or of all the
+// keys when Purge is set, are stale.
+type redisInvalidation struct {
+	Node  string `json:"node"`
+	Cache string `json:"cache"`
+	Key   string `json:"key,omitempty"`
+	Purge bool   `json:"purge,omitempty"`
+}
+
+type redisProvider struct {
+	opts   RedisProviderOptions
+	local  Provider
+	nodeID string
+
+	client *redis.Client
+	pubsub *redis.PubSub
+	done   chan struct{}
+
+	mut    sync.RWMutex
+	caches map[string]*Redis
+}
+
+// NewRedisProvider creates a provider keeping the shared caches in Redis, and the others in the
+// memory of the node as NewProvider does.
+func NewRedisProvider(opts RedisProviderOptions) Provider {
+	return &redisProvider{
+		opts:   opts,
+		local:  NewProvider(),
+		nodeID: model.NewId(),
+		caches: make(map[string]*Redis),
+	}
+}
+
+// NewCache creates a new cache with given opts. The shared caches must be named, their keys
+// living in Redis under their name.
+func (p *redisProvider) NewCache(opts *CacheOptions) (Cache, error) {
+	if !opts.Shared {
+		return p.local.NewCache(opts)
+	}
+
+	if opts.Name == "" {
+		return nil, errors.New("shared caches must be named")
+	}
+	if p.client == nil {
+		return nil, errors.New("the Redis cache provider isn't connected")
+	}
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if _, ok := p.caches[opts.Name]; ok {
+		return nil, fmt.Errorf("the shared cache %s already exists", opts.Name)
+	}
+
+	c := &Redis{
+		provider:               p,
+		client:                 p.client,
+		name:                   opts.Name,
+		keyPrefix:              redisKeyPrefix + opts.Name + ":",
+		defaultExpiry:          opts.DefaultExpiry,
+		invalidateClusterEvent: opts.InvalidateClusterEvent,
+		local: newLRU(LRUOptions{
+			Name: opts.Name,
+			Size: opts.Size,
+		}),
+	}
+	p.caches[opts.Name] = c
+
+	return c, nil
+}
+
+// Connect opens a new connection to Redis, and subscribes to the invalidations of the local
+// copies of the shared caches.
+func (p *redisProvider) Connect() error {
+	if err := p.local.Connect(); err != nil {
+		return err
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     p.opts.Address,
+		Password: p.opts.Password,
+		DB:       p.opts.DB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisConnectTimeout)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return fmt.Errorf("could not connect to Redis: %w", err)
+	}
+
+	pubsub := client.Subscribe(ctx, redisInvalidationsChannel)
+	// Waits for the subscription to be confirmed so that no invalidation is missed afterwards.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		client.Close()
+		return fmt.Errorf("could not subscribe to the cache invalidations: %w", err)
+	}
+
+	p.client = client
+	p.pubsub = pubsub
+	p.done = make(chan struct{})
+	go p.receiveInvalidations(pubsub.Channel(redis.WithChannelSize(redisInvalidationQueueSize)))
+
+	return nil
+}
+
+// Close releases the connection to Redis.
+func (p *redisProvider) Close() error {
+	if p.client == nil {
+		return p.local.Close()
+	}
+
+	if err := p.pubsub.Close(); err != nil {
+		mlog.Warn("Failed to unsubscribe from the cache invalidations", mlog.Err(err))
+	}
+	<-p.done
+
+	if err := p.client.Close(); err != nil {
+		return err
+	}
+	p.client = nil
+
+	return p.local.Close()
+}
+
+func (p *redisProvider) receiveInvalidations(messages <-chan *redis.Message) {
+	defer close(p.done)
+
+	for message := range messages {
+		var invalidation redisInvalidation
+		if err := json.Unmarshal([]byte(message.Payload), &invalidation); err != nil {
+			mlog.Warn("Failed to decode a cache invalidation", mlog.Err(err))
+			continue
+		}
+		p.handleInvalidation(&invalidation)
+	}
+}
+
+// handleInvalidation drops the local copies made stale by another node.
+func (p *redisProvider) handleInvalidation(invalidation *redisInvalidation) {
+	if invalidation.Node == p.nodeID {
+		return
+	}
+
+	p.mut.RLock()
+	c, ok := p.caches[invalidation.Cache]
+	p.mut.RUnlock()
+	if !ok {
+		return
+	}
+
+	if invalidation.Purge {
+		c.local.Purge()
+	} else {
+		c.local.Remove(invalidation.Key)
+	}
+
+	if p.opts.Metrics != nil {
+		p.opts.Metrics.IncrementRedisCacheInvalidationCounter(c.name)
+	}
+}
+
+// Redis is a cache living in Redis, shared by the nodes of the cluster. Each node keeps local
+// copies of the values it reads, dropped when another node changes them. As an invalidation may
+// be missed while reconnecting to Redis, the local copies expire after redisLocalCopyMaxExpiry at
+// most.
+type Redis struct {
+	provider               *redisProvider
+	client                 *redis.Client
+	name                   string
+	keyPrefix              string
+	defaultExpiry          time.Duration
+	invalidateClusterEvent model.ClusterEvent
+	local                  *LRU
+}
+
+// Purge is used to completely clear the cache.
+func (r *Redis) Purge() error {
+	keys, err := r.redisKeys()
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(keys); start += redisScanCount {
+		end := start + redisScanCount
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		begin := time.Now()
+		err := r.client.Del(context.Background(), keys[start:end]...).Err()
+		r.observe("purge", begin, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.local.Purge()
+	r.publishInvalidation(&redisInvalidation{Purge: true})
+
+	return nil
+}
+
+// Set adds the given key and value to the store without an expiry. If the key already exists,
+// it will overwrite the previous value.
+func (r *Redis) Set(key string, value interface{}) error {
+	return r.SetWithExpiry(key, value, 0)
+}
+
+// SetWithDefaultExpiry adds the given key and value to the store with the default expiry. If
+// the key already exists, it will overwrite the previous value
+func (r *Redis) SetWithDefaultExpiry(key string, value interface{}) error {
+	return r.SetWithExpiry(key, value, r.defaultExpiry)
+}
+
+// SetWithExpiry adds the given key and value to the cache with the given expiry. If the key
+// already exists, it will overwrite the previous value
+func (r *Redis) SetWithExpiry(key string, value interface{}, ttl time.Duration) error {
+	buf, err := encodeValue(value)
+	if err != nil {
+		return err
+	}
+
+	begin := time.Now()
+	err = r.client.Set(context.Background(), r.keyPrefix+key, buf, ttl).Err()
+	r.observe("set", begin, err)
+	if err != nil {
+		return err
+	}
+
+	r.local.setItem(key, buf, localCopyExpiry(ttl))
+	r.publishInvalidation(&redisInvalidation{Key: key})
+
+	return nil
+}
+
+// Get the content stored in the cache for the given key, and decode it into the value interface.
+// Return ErrKeyNotFound if the key is missing from the cache
+func (r *Redis) Get(key string, value interface{}) error {
+	if buf, err := r.local.getItem(key); err == nil {
+		return decodeValue(buf, value)
+	}
+
+	begin := time.Now()
+	pipe := r.client.Pipeline()
+	get := pipe.Get(context.Background(), r.keyPrefix+key)
+	ttl := pipe.PTTL(context.Background(), r.keyPrefix+key)
+	_, err := pipe.Exec(context.Background())
+	if errors.Is(err, redis.Nil) {
+		r.observe("get", begin, nil)
+		return ErrKeyNotFound
+	}
+	r.observe("get", begin, err)
+	if err != nil {
+		return err
+	}
+
+	buf, err := get.Bytes()
+	if err != nil {
+		return err
+	}
+	r.local.setItem(key, buf, localCopyExpiry(ttl.Val()))
+
+	return decodeValue(buf, value)
+}
+
+// Remove deletes the value for a key.
+func (r *Redis) Remove(key string) error {
+	begin := time.Now()
+	err := r.client.Del(context.Background(), r.keyPrefix+key).Err()
+	r.observe("remove", begin, err)
+	if err != nil {
+		return err
+	}
+
+	r.local.Remove(key)
+	r.publishInvalidation(&redisInvalidation{Key: key})
+
+	return nil
+}
+
+// Keys returns a slice of the keys in the cache.
+func (r *Redis) Keys() ([]string, error) {
+	keys, err := r.redisKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		keys[i] = strings.TrimPrefix(key, r.keyPrefix)
+	}
+	return keys, nil
+}
+
+// Len returns the number of items in the cache.
+func (r *Redis) Len() (int, error) {
+	keys, err := r.redisKeys()
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// GetInvalidateClusterEvent returns the cluster event configured when this cache was created.
+func (r *Redis) GetInvalidateClusterEvent() model.ClusterEvent {
+	return r.invalidateClusterEvent
+}
+
+// Name returns the name of the cache
+func (r *Redis) Name() string {
+	return r.name
+}
+
+// redisKeys returns the keys of the cache as they are in Redis.
+func (r *Redis) redisKeys() ([]string, error) {
+	begin := time.Now()
+	keys := []string{}
+	iter := r.client.Scan(context.Background(), 0, r.keyPrefix+"*", redisScanCount).Iterator()
+	for iter.Next(context.Background()) {
+		keys = append(keys, iter.Val())
+	}
+	err := iter.Err()
+	r.observe("keys", begin, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// publishInvalidation lets the other nodes know that their local copies are stale. A failure
+// to do so only delays the expiry of their copies.
+func (r *Redis) publishInvalidation(invalidation *redisInvalidation) {
+	invalidation.Node = r.provider.nodeID
+	invalidation.Cache = r.name
+
+	payload, err := json.Marshal(invalidation)
+	if err != nil {
+		mlog.Warn("Failed to encode a cache invalidation", mlog.String("cache", r.name), mlog.Err(err))
+		return
+	}
+
+	begin := time.Now()
+	err = r.client.Publish(context.Background(), redisInvalidationsChannel, payload).Err()
+	r.observe("publish", begin, err)
+	if err != nil {
+		mlog.Warn("Failed to publish a cache invalidation", mlog.String("cache", r.name), mlog.Err(err))
+	}
+}
+
+func (r *Redis) observe(operation string, begin time.Time, err error) {
+	metrics := r.provider.opts.Metrics
+	if metrics == nil {
+		return
+	}
+
+	metrics.ObserveRedisCacheRequestDuration(r.name, operation, time.Since(begin).Seconds())
+	if err != nil {
+		metrics.IncrementRedisCacheErrorCounter(r.name, operation)
+	}
+}
+
+// localCopyExpiry returns the expiry of the local copy of a value expiring after the ttl, where
+// a ttl that isn't positive means no expiry.
+func localCopyExpiry(ttl time.Duration) time.Duration {
+	if ttl <= 0 || ttl > redisLocalCopyMaxExpiry {
+		return redisLocalCopyMaxExpiry
+	}
+	return ttl
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+type testRedisMetrics struct {
+	invalidations map[string]int
+}
+
+func (m *testRedisMetrics) ObserveRedisCacheRequestDuration(cacheName, operation string, elapsed float64) {
+}
+
+func (m *testRedisMetrics) IncrementRedisCacheErrorCounter(cacheName, operation string) {}
+
+func (m *testRedisMetrics) IncrementRedisCacheInvalidationCounter(cacheName string) {
+	m.invalidations[cacheName]++
+}
+
+func TestRedisProviderNewCache(t *testing.T) {
+	p := NewRedisProvider(RedisProviderOptions{Address: "localhost:6379"})
+
+	t.Run("caches that aren't shared stay local", func(t *testing.T) {
+		c, err := p.NewCache(&CacheOptions{Size: 10, Name: "local"})
+		require.NoError(t, err)
+		assert.IsType(t, &LRU{}, c)
+
+		c, err = p.NewCache(&CacheOptions{Size: 10, Name: "striped", Striped: true, StripedBuckets: 2})
+		require.NoError(t, err)
+		assert.IsType(t, LRUStriped{}, c)
+	})
+
+	t.Run("shared caches must be named", func(t *testing.T) {
+		_, err := p.NewCache(&CacheOptions{Size: 10, Shared: true})
+		require.Error(t, err)
+	})
+
+	t.Run("shared caches need a connection", func(t *testing.T) {
+		_, err := p.NewCache(&CacheOptions{Size: 10, Name: "shared", Shared: true})
+		require.Error(t, err)
+	})
+}
+
+func TestRedisProviderHandleInvalidation(t *testing.T) {
+	metrics := &testRedisMetrics{invalidations: map[string]int{}}
+	p := NewRedisProvider(RedisProviderOptions{Metrics: metrics}).(*redisProvider)
+
+	c := &Redis{provider: p, name: "users", local: newLRU(LRUOptions{Name: "users", Size: 10})}
+	p.caches[c.name] = c
+
+	user := &model.User{Id: model.NewId(), Username: "username"}
+	buf, err := encodeValue(user)
+	require.NoError(t, err)
+	c.local.setItem("key1", buf, time.Minute)
+	c.local.setItem("key2", buf, time.Minute)
+
+	var cached *model.User
+	require.NoError(t, c.local.Get("key1", &cached))
+	assert.Equal(t, user.Username, cached.Username)
+
+	t.Run("the invalidations of the node itself are ignored", func(t *testing.T) {
+		p.handleInvalidation(&redisInvalidation{Node: p.nodeID, Cache: "users", Key: "key1"})
+		require.NoError(t, c.local.Get("key1", &cached))
+		assert.Zero(t, metrics.invalidations["users"])
+	})
+
+	t.Run("the invalidations of other caches are ignored", func(t *testing.T) {
+		p.handleInvalidation(&redisInvalidation{Node: model.NewId(), Cache: "channels", Key: "key1"})
+		require.NoError(t, c.local.Get("key1", &cached))
+	})
+
+	t.Run("the local copy of the key is dropped", func(t *testing.T) {
+		p.handleInvalidation(&redisInvalidation{Node: model.NewId(), Cache: "users", Key: "key1"})
+		require.Equal(t, ErrKeyNotFound, c.local.Get("key1", &cached))
+		require.NoError(t, c.local.Get("key2", &cached))
+		assert.Equal(t, 1, metrics.invalidations["users"])
+	})
+
+	t.Run("the local copies are purged", func(t *testing.T) {
+		p.handleInvalidation(&redisInvalidation{Node: model.NewId(), Cache: "users", Purge: true})
+		require.Equal(t, ErrKeyNotFound, c.local.Get("key2", &cached))
+		assert.Equal(t, 2, metrics.invalidations["users"])
+	})
+}
+
+func TestLocalCopyExpiry(t *testing.T) {
+	assert.Equal(t, redisLocalCopyMaxExpiry, localCopyExpiry(0))
+	assert.Equal(t, redisLocalCopyMaxExpiry, localCopyExpiry(-1))
+	assert.Equal(t, redisLocalCopyMaxExpiry, localCopyExpiry(time.Hour))
+	assert.Equal(t, 5*time.Second, localCopyExpiry(5*time.Second))
+}
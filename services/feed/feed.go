@@ -0,0 +1,267 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package feed fetches and parses the RSS and Atom feeds channels subscribe to.
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// maxFeedSize bounds how much of a feed is read, for a server not to be able to exhaust
+	// the memory of the poller.
+	maxFeedSize = 5 * 1024 * 1024
+
+	// maxErrorBodySize bounds how much of the body of a failed response ends up in the error.
+	maxErrorBodySize = 512
+)
+
+// Feed is an RSS or Atom feed, with its items in the order of the document.
+type Feed struct {
+	Title string
+	Items []*Item
+}
+
+// Item is an entry of a feed. The GUID falls back to the link for the feeds whose items have
+// no id.
+type Item struct {
+	GUID        string
+	Title       string
+	Link        string
+	Summary     string
+	Author      string
+	PublishedAt time.Time
+}
+
+// Fetch gets the feed at the URL with the client, and parses it.
+func Fetch(client *http.Client, url string) (*Feed, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.8")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("feed server responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxFeedSize {
+		return nil, fmt.Errorf("feed is larger than %d bytes", maxFeedSize)
+	}
+
+	return Parse(data)
+}
+
+type rssDocument struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+// rdfDocument is an RSS 1.0 feed, whose items are siblings of the channel.
+type rdfDocument struct {
+	XMLName xml.Name `xml:"RDF"`
+	Channel struct {
+		Title string `xml:"title"`
+	} `xml:"channel"`
+	Items []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	GUID        string `xml:"guid"`
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	Author      string `xml:"author"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate     string `xml:"pubDate"`
+	Date        string `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+type atomDocument struct {
+	XMLName xml.Name    `xml:"feed"`
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID    string `xml:"id"`
+	Title string `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Summary string `xml:"summary"`
+	Content string `xml:"content"`
+	Authors []struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+}
+
+// Parse parses an RSS 2.0, RSS 1.0 or Atom feed.
+func Parse(data []byte) (*Feed, error) {
+	root, err := rootElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root.Local {
+	case "rss":
+		var doc rssDocument
+		if err := unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return &Feed{Title: strings.TrimSpace(doc.Channel.Title), Items: rssItems(doc.Channel.Items)}, nil
+	case "RDF":
+		var doc rdfDocument
+		if err := unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return &Feed{Title: strings.TrimSpace(doc.Channel.Title), Items: rssItems(doc.Items)}, nil
+	case "feed":
+		var doc atomDocument
+		if err := unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		return &Feed{Title: strings.TrimSpace(doc.Title), Items: atomItems(doc.Entries)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported feed format %q", root.Local)
+	}
+}
+
+func newDecoder(data []byte) *xml.Decoder {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	// Feeds declaring another encoding than UTF-8 are read as is, most of them being ASCII
+	// compatible in practice.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	return decoder
+}
+
+func unmarshal(data []byte, v interface{}) error {
+	return newDecoder(data).Decode(v)
+}
+
+func rootElement(data []byte) (xml.Name, error) {
+	decoder := newDecoder(data)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return xml.Name{}, errors.New("feed has no root element")
+			}
+			return xml.Name{}, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+func rssItems(rssItems []rssItem) []*Item {
+	items := make([]*Item, 0, len(rssItems))
+	for _, rssItem := range rssItems {
+		item := &Item{
+			GUID:        strings.TrimSpace(rssItem.GUID),
+			Title:       strings.TrimSpace(rssItem.Title),
+			Link:        strings.TrimSpace(rssItem.Link),
+			Summary:     strings.TrimSpace(rssItem.Description),
+			Author:      strings.TrimSpace(firstNonEmpty(rssItem.Author, rssItem.Creator)),
+			PublishedAt: parseTime(firstNonEmpty(rssItem.PubDate, rssItem.Date)),
+		}
+		if item.GUID == "" {
+			item.GUID = item.Link
+		}
+		if item.GUID == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func atomItems(entries []atomEntry) []*Item {
+	items := make([]*Item, 0, len(entries))
+	for _, entry := range entries {
+		item := &Item{
+			GUID:        strings.TrimSpace(entry.ID),
+			Title:       strings.TrimSpace(entry.Title),
+			Summary:     strings.TrimSpace(firstNonEmpty(entry.Summary, entry.Content)),
+			PublishedAt: parseTime(firstNonEmpty(entry.Published, entry.Updated)),
+		}
+		for _, link := range entry.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				item.Link = strings.TrimSpace(link.Href)
+				break
+			}
+		}
+		if len(entry.Authors) > 0 {
+			item.Author = strings.TrimSpace(entry.Authors[0].Name)
+		}
+		if item.GUID == "" {
+			item.GUID = item.Link
+		}
+		if item.GUID == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02",
+}
+
+// parseTime parses the date of an item, returning the zero time for the dates it can't make
+// sense of.
+func parseTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
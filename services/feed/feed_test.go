@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package feed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSSFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>Release notes</title>
+    <item>
+      <guid isPermaLink="false">release-2</guid>
+      <title>Release 2</title>
+      <link>https://example.com/releases/2</link>
+      <description>Faster &amp; better.</description>
+      <dc:creator>Jane</dc:creator>
+      <pubDate>Tue, 02 Jan 2024 10:00:00 +0000</pubDate>
+    </item>
+    <item>
+      <title>Release 1</title>
+      <link>https://example.com/releases/1</link>
+    </item>
+    <item>
+      <title>No id</title>
+    </item>
+  </channel>
+</rss>`
+
+const testAtomFeed = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Engineering blog</title>
+  <entry>
+    <id>urn:uuid:60a76c80-d399-11d9-b93C-0003939e0af6</id>
+    <title>Atom-Powered Robots Run Amok</title>
+    <link rel="self" href="https://example.com/self"/>
+    <link href="https://example.com/2003/12/13/atom03"/>
+    <author><name>John Doe</name></author>
+    <content type="html">Some text.</content>
+    <updated>2003-12-13T18:30:02Z</updated>
+  </entry>
+</feed>`
+
+const testRDFFeed = `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel><title>Old school</title></channel>
+  <item>
+    <title>An item</title>
+    <link>https://example.com/item</link>
+    <dc:date>2020-05-01</dc:date>
+  </item>
+</rdf:RDF>`
+
+func TestParse(t *testing.T) {
+	t.Run("rss", func(t *testing.T) {
+		feed, err := Parse([]byte(testRSSFeed))
+		require.NoError(t, err)
+		assert.Equal(t, "Release notes", feed.Title)
+		require.Len(t, feed.Items, 2, "items without a guid or a link are skipped")
+
+		assert.Equal(t, "release-2", feed.Items[0].GUID)
+		assert.Equal(t, "Release 2", feed.Items[0].Title)
+		assert.Equal(t, "https://example.com/releases/2", feed.Items[0].Link)
+		assert.Equal(t, "Faster & better.", feed.Items[0].Summary)
+		assert.Equal(t, "Jane", feed.Items[0].Author)
+		assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), feed.Items[0].PublishedAt.UTC())
+
+		assert.Equal(t, "https://example.com/releases/1", feed.Items[1].GUID, "the link stands for a missing guid")
+		assert.True(t, feed.Items[1].PublishedAt.IsZero())
+	})
+
+	t.Run("atom", func(t *testing.T) {
+		feed, err := Parse([]byte(testAtomFeed))
+		require.NoError(t, err)
+		assert.Equal(t, "Engineering blog", feed.Title)
+		require.Len(t, feed.Items, 1)
+
+		item := feed.Items[0]
+		assert.Equal(t, "urn:uuid:60a76c80-d399-11d9-b93C-0003939e0af6", item.GUID)
+		assert.Equal(t, "https://example.com/2003/12/13/atom03", item.Link)
+		assert.Equal(t, "Some text.", item.Summary)
+		assert.Equal(t, "John Doe", item.Author)
+		assert.Equal(t, time.Date(2003, 12, 13, 18, 30, 2, 0, time.UTC), item.PublishedAt.UTC())
+	})
+
+	t.Run("rdf", func(t *testing.T) {
+		feed, err := Parse([]byte(testRDFFeed))
+		require.NoError(t, err)
+		assert.Equal(t, "Old school", feed.Title)
+		require.Len(t, feed.Items, 1)
+		assert.Equal(t, "https://example.com/item", feed.Items[0].GUID)
+		assert.Equal(t, 2020, feed.Items[0].PublishedAt.Year())
+	})
+
+	t.Run("not a feed", func(t *testing.T) {
+		_, err := Parse([]byte(`<html><body>Hello</body></html>`))
+		require.Error(t, err)
+
+		_, err = Parse([]byte(``))
+		require.Error(t, err)
+	})
+}
+
+func TestFetch(t *testing.T) {
+	t.Run("fetches and parses the feed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.Header.Get("Accept"), "application/rss+xml")
+			w.Header().Set("Content-Type", "application/rss+xml")
+			w.Write([]byte(testRSSFeed))
+		}))
+		defer server.Close()
+
+		feed, err := Fetch(server.Client(), server.URL)
+		require.NoError(t, err)
+		assert.Len(t, feed.Items, 2)
+	})
+
+	t.Run("fails on an error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "gone", http.StatusGone)
+		}))
+		defer server.Close()
+
+		_, err := Fetch(server.Client(), server.URL)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "410")
+		assert.Contains(t, err.Error(), "gone")
+	})
+
+	t.Run("fails on a feed too large", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(strings.Repeat(" ", maxFeedSize+1)))
+		}))
+		defer server.Close()
+
+		_, err := Fetch(server.Client(), server.URL)
+		require.Error(t, err)
+	})
+}
@@ -187,3 +187,59 @@ func TestGetAtmosCamoImageURL(t *testing.T) {
 	}
 
 }
+
+func TestImageProxyReloadConfig(t *testing.T) {
+	proxy := makeTestAtmosCamoProxy()
+	configService := proxy.ConfigService.(*testutils.StaticConfigService)
+
+	imageURL := "http://www.mattermost.org/wp-content/uploads/2016/03/logoHorizontal.png"
+
+	t.Run("should ignore unrelated changes", func(t *testing.T) {
+		oldCfg := configService.Cfg.Clone()
+		newCfg := configService.Cfg.Clone()
+		newCfg.TeamSettings.SiteName = model.NewString("Changed")
+
+		backend := proxy.backend
+		require.NoError(t, proxy.ReloadConfig(oldCfg, newCfg))
+		assert.Same(t, backend, proxy.backend)
+	})
+
+	t.Run("should pick up a new remote proxy URL", func(t *testing.T) {
+		oldCfg := configService.Cfg.Clone()
+		newCfg := configService.Cfg.Clone()
+		newCfg.ImageProxySettings.RemoteImageProxyURL = model.NewString("http://camo.example.com")
+		configService.Cfg = newCfg
+
+		require.NoError(t, proxy.ReloadConfig(oldCfg, newCfg))
+		backend, ok := proxy.backend.(*AtmosCamoBackend)
+		require.True(t, ok)
+		assert.Equal(t, "camo.example.com", backend.remoteURL.Host)
+	})
+
+	t.Run("should pick up a new site URL", func(t *testing.T) {
+		oldCfg := configService.Cfg.Clone()
+		newCfg := configService.Cfg.Clone()
+		newCfg.ServiceSettings.SiteURL = model.NewString("https://chat.example.com")
+		configService.Cfg = newCfg
+
+		require.NoError(t, proxy.ReloadConfig(oldCfg, newCfg))
+		assert.Equal(t, "https://chat.example.com/static/logo.png", proxy.GetProxiedImageURL("/static/logo.png"))
+
+		backend, ok := proxy.backend.(*AtmosCamoBackend)
+		require.True(t, ok)
+		assert.Equal(t, "chat.example.com", backend.siteURL.Host)
+	})
+
+	t.Run("should disable the proxy", func(t *testing.T) {
+		oldCfg := configService.Cfg.Clone()
+		newCfg := configService.Cfg.Clone()
+		newCfg.ImageProxySettings.Enable = model.NewBool(false)
+		configService.Cfg = newCfg
+
+		require.NoError(t, proxy.ReloadConfig(oldCfg, newCfg))
+		assert.Nil(t, proxy.backend)
+
+		_, _, err := proxy.GetImageDirect(imageURL)
+		assert.Equal(t, ErrNotEnabled, err)
+	})
+}
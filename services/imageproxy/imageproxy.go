@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"sync"
 
@@ -22,8 +23,7 @@ var ErrNotEnabled = Error{errors.New("imageproxy.ImageProxy: image proxy not ena
 // An ImageProxy is the public interface for Mattermost's image proxy. An instance of ImageProxy should be created
 // using MakeImageProxy which requires a configService and an HTTPService provided by the server.
 type ImageProxy struct {
-	ConfigService    configservice.ConfigService
-	configListenerID string
+	ConfigService configservice.ConfigService
 
 	HTTPService httpservice.HTTPService
 
@@ -56,8 +56,6 @@ func MakeImageProxy(configService configservice.ConfigService, httpService https
 	siteURL, _ := url.Parse(*configService.Config().ServiceSettings.SiteURL)
 	proxy.siteURL = siteURL
 
-	proxy.configListenerID = proxy.ConfigService.AddConfigListener(proxy.OnConfigChange)
-
 	config := proxy.ConfigService.Config()
 	proxy.backend = proxy.makeBackend(*config.ImageProxySettings.Enable, *config.ImageProxySettings.ImageProxyType)
 
@@ -79,21 +77,22 @@ func (proxy *ImageProxy) makeBackend(enable bool, proxyType string) ImageProxyBa
 	}
 }
 
-func (proxy *ImageProxy) Close() {
+// ReloadConfig rebuilds the image proxy backend whenever the image proxy settings or the site URL change. It
+// implements app.ConfigReloader so that the image proxy can be reconfigured without a server restart.
+func (proxy *ImageProxy) ReloadConfig(oldConfig, newConfig *model.Config) error {
+	if reflect.DeepEqual(oldConfig.ImageProxySettings, newConfig.ImageProxySettings) &&
+		*oldConfig.ServiceSettings.SiteURL == *newConfig.ServiceSettings.SiteURL {
+		return nil
+	}
+
 	proxy.lock.Lock()
 	defer proxy.lock.Unlock()
 
-	proxy.ConfigService.RemoveConfigListener(proxy.configListenerID)
-}
-
-func (proxy *ImageProxy) OnConfigChange(oldConfig, newConfig *model.Config) {
-	if *oldConfig.ImageProxySettings.Enable != *newConfig.ImageProxySettings.Enable ||
-		*oldConfig.ImageProxySettings.ImageProxyType != *newConfig.ImageProxySettings.ImageProxyType {
-		proxy.lock.Lock()
-		defer proxy.lock.Unlock()
+	siteURL, _ := url.Parse(*newConfig.ServiceSettings.SiteURL)
+	proxy.siteURL = siteURL
+	proxy.backend = proxy.makeBackend(*newConfig.ImageProxySettings.Enable, *newConfig.ImageProxySettings.ImageProxyType)
 
-		proxy.backend = proxy.makeBackend(*newConfig.ImageProxySettings.Enable, *newConfig.ImageProxySettings.ImageProxyType)
-	}
+	return nil
 }
 
 // GetImage takes an HTTP request for an image and requests that image using the image proxy.
@@ -124,6 +123,9 @@ func (proxy *ImageProxy) GetImageDirect(imageURL string) (io.ReadCloser, string,
 // GetProxiedImageURL takes the URL of an image and returns a URL that can be used to view that image through the
 // image proxy.
 func (proxy *ImageProxy) GetProxiedImageURL(imageURL string) string {
+	proxy.lock.RLock()
+	defer proxy.lock.RUnlock()
+
 	if imageURL == "" || proxy.siteURL == nil {
 		return imageURL
 	}
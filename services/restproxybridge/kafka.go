@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package restproxybridge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	kafkaAccept      = "application/vnd.kafka.v2+json"
+)
+
+// kafkaPublisher publishes the messages through the v2 API of a Kafka REST Proxy, all the
+// messages of a topic in one request. The messages of a key land in the same partition, in
+// order. The proxy answers once its producer got the acknowledgements it's configured with, so
+// accepted messages can still be lost with the leader of a partition unless it uses acks=all.
+type kafkaPublisher struct {
+	client   *http.Client
+	baseURL  string
+	username string
+	password string
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		Partition int     `json:"partition"`
+		Offset    int64   `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *kafkaPublisher) Publish(topic string, messages []*model.RESTProxyBridgeMessage) error {
+	request := kafkaProduceRequest{Records: make([]kafkaRecord, 0, len(messages))}
+	for _, message := range messages {
+		request.Records = append(request.Records, kafkaRecord{
+			Key:   message.MessageKey,
+			Value: json.RawMessage(message.Payload),
+		})
+	}
+
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", p.baseURL+"/topics/"+url.PathEscape(topic), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", kafkaAccept)
+
+	resp, err := doRequest(p.client, req, p.username, p.password)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var response kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode the response of the Kafka REST Proxy: %w", err)
+	}
+
+	if len(response.Offsets) != len(messages) {
+		return fmt.Errorf("the Kafka REST Proxy acknowledged %d of %d messages", len(response.Offsets), len(messages))
+	}
+	for i, offset := range response.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			var reason string
+			if offset.Error != nil {
+				reason = *offset.Error
+			}
+			return fmt.Errorf("failed to publish message %s: %s", messages[i].Id, reason)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package restproxybridge publishes the messages of the REST proxy bridge outbox to a message
+// queue, through the HTTP proxy of the queue rather than its native protocol.
+package restproxybridge
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// maxErrorBodySize bounds how much of the body of a failed response ends up in the error.
+const maxErrorBodySize = 512
+
+// Publisher publishes messages to a topic of a message queue. Publish returns once the proxy
+// accepted every message, or fails without telling which ones were published, if any: they are
+// published again by the next attempt. An accepted message isn't necessarily persisted by the
+// queue yet.
+type Publisher interface {
+	Publish(topic string, messages []*model.RESTProxyBridgeMessage) error
+}
+
+// NewPublisher returns the publisher of the driver of the settings, sending its requests with
+// the client.
+func NewPublisher(settings *model.RESTProxyBridgeSettings, client *http.Client) (Publisher, error) {
+	baseURL := strings.TrimSuffix(*settings.URL, "/")
+
+	switch *settings.Driver {
+	case model.RESTProxyBridgeDriverKafka:
+		return &kafkaPublisher{
+			client:   client,
+			baseURL:  baseURL,
+			username: *settings.Username,
+			password: *settings.Password,
+		}, nil
+	case model.RESTProxyBridgeDriverRabbitMQ:
+		return &rabbitMQPublisher{
+			client:      client,
+			baseURL:     baseURL,
+			username:    *settings.Username,
+			password:    *settings.Password,
+			virtualHost: *settings.RabbitMQVirtualHost,
+			exchange:    *settings.RabbitMQExchange,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown REST proxy bridge driver %q", *settings.Driver)
+	}
+}
+
+func doRequest(client *http.Client, req *http.Request, username, password string) (*http.Response, error) {
+	if username != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package restproxybridge
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func newTestSettings(driver, url string) *model.RESTProxyBridgeSettings {
+	settings := &model.RESTProxyBridgeSettings{
+		Driver:   model.NewString(driver),
+		URL:      model.NewString(url + "/"),
+		Username: model.NewString("bridge"),
+		Password: model.NewString("secret"),
+	}
+	settings.SetDefaults()
+	return settings
+}
+
+func newTestMessages() []*model.RESTProxyBridgeMessage {
+	return []*model.RESTProxyBridgeMessage{
+		{Id: model.NewId(), Event: model.RESTProxyBridgeEventPostCreated, MessageKey: "channel1", Payload: `{"event":"post_created"}`, CreateAt: 1000},
+		{Id: model.NewId(), Event: model.RESTProxyBridgeEventPostEdited, MessageKey: "channel1", Payload: `{"event":"post_edited"}`, CreateAt: 2000},
+	}
+}
+
+func TestKafkaPublisher(t *testing.T) {
+	messages := newTestMessages()
+
+	t.Run("publishes the messages of a topic in one request", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "/topics/mattermost.posts", r.URL.Path)
+			assert.Equal(t, kafkaContentType, r.Header.Get("Content-Type"))
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "bridge", username)
+			assert.Equal(t, "secret", password)
+
+			var request struct {
+				Records []struct {
+					Key   string                 `json:"key"`
+					Value map[string]interface{} `json:"value"`
+				} `json:"records"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			require.Len(t, request.Records, 2)
+			assert.Equal(t, "channel1", request.Records[0].Key)
+			assert.Equal(t, "post_created", request.Records[0].Value["event"])
+			assert.Equal(t, "post_edited", request.Records[1].Value["event"])
+
+			w.Write([]byte(`{"offsets":[{"partition":0,"offset":1},{"partition":0,"offset":2}]}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(newTestSettings(model.RESTProxyBridgeDriverKafka, server.URL), server.Client())
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish("mattermost.posts", messages))
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("fails when a record is rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"offsets":[{"partition":0,"offset":1},{"error_code":50002,"error":"record too large"}]}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(newTestSettings(model.RESTProxyBridgeDriverKafka, server.URL), server.Client())
+		require.NoError(t, err)
+		err = publisher.Publish("mattermost.posts", messages)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "record too large")
+	})
+
+	t.Run("fails on an error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":40401,"message":"Topic not found."}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(newTestSettings(model.RESTProxyBridgeDriverKafka, server.URL), server.Client())
+		require.NoError(t, err)
+		err = publisher.Publish("unknown", messages)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Topic not found.")
+	})
+}
+
+func TestRabbitMQPublisher(t *testing.T) {
+	messages := newTestMessages()
+
+	t.Run("publishes each message to the exchange", func(t *testing.T) {
+		var published []rabbitMQPublishRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/exchanges/%2F/amq.topic/publish", r.URL.EscapedPath())
+
+			var request rabbitMQPublishRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			published = append(published, request)
+
+			w.Write([]byte(`{"routed":true}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(newTestSettings(model.RESTProxyBridgeDriverRabbitMQ, server.URL), server.Client())
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish("mattermost.posts", messages))
+
+		require.Len(t, published, 2)
+		assert.Equal(t, "mattermost.posts", published[0].RoutingKey)
+		assert.Equal(t, messages[0].Id, published[0].Properties.MessageId)
+		assert.Equal(t, rabbitMQDeliveryModePersistent, published[0].Properties.DeliveryMode)
+		assert.Equal(t, messages[1].Payload, published[1].Payload)
+	})
+
+	t.Run("fails when a message is not routed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"routed":false}`))
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(newTestSettings(model.RESTProxyBridgeDriverRabbitMQ, server.URL), server.Client())
+		require.NoError(t, err)
+		err = publisher.Publish("mattermost.posts", messages)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not routed")
+	})
+}
+
+func TestNewPublisherUnknownDriver(t *testing.T) {
+	_, err := NewPublisher(newTestSettings("sqs", "http://localhost"), http.DefaultClient)
+	require.Error(t, err)
+}
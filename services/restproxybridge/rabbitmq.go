@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package restproxybridge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+// rabbitMQDeliveryModePersistent makes the broker write the messages to disk.
+const rabbitMQDeliveryModePersistent = 2
+
+// rabbitMQPublisher publishes the messages to an exchange through the HTTP API of RabbitMQ, with
+// the topic as the routing key. The API takes a message per request, and doesn't wait for the
+// broker to confirm it, so an accepted message can still be lost if the broker fails before
+// writing it to disk.
+type rabbitMQPublisher struct {
+	client      *http.Client
+	baseURL     string
+	username    string
+	password    string
+	virtualHost string
+	exchange    string
+}
+
+type rabbitMQProperties struct {
+	DeliveryMode int    `json:"delivery_mode"`
+	MessageId    string `json:"message_id"`
+	ContentType  string `json:"content_type"`
+	Type         string `json:"type"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+type rabbitMQPublishRequest struct {
+	Properties      rabbitMQProperties `json:"properties"`
+	RoutingKey      string             `json:"routing_key"`
+	Payload         string             `json:"payload"`
+	PayloadEncoding string             `json:"payload_encoding"`
+}
+
+type rabbitMQPublishResponse struct {
+	Routed bool `json:"routed"`
+}
+
+func (p *rabbitMQPublisher) Publish(topic string, messages []*model.RESTProxyBridgeMessage) error {
+	publishURL := p.baseURL + "/api/exchanges/" + url.PathEscape(p.virtualHost) + "/" + url.PathEscape(p.exchange) + "/publish"
+
+	for _, message := range messages {
+		body, err := json.Marshal(&rabbitMQPublishRequest{
+			Properties: rabbitMQProperties{
+				DeliveryMode: rabbitMQDeliveryModePersistent,
+				MessageId:    message.Id,
+				ContentType:  "application/json",
+				Type:         message.Event,
+				Timestamp:    message.CreateAt / 1000,
+			},
+			RoutingKey:      topic,
+			Payload:         message.Payload,
+			PayloadEncoding: "string",
+		})
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest("POST", publishURL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := doRequest(p.client, req, p.username, p.password)
+		if err != nil {
+			return err
+		}
+
+		var response rabbitMQPublishResponse
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode the response of the RabbitMQ API: %w", err)
+		}
+
+		// An unrouted message is dropped by the broker, so it has to be published again once
+		// a queue is bound to the routing key.
+		if !response.Routed {
+			return fmt.Errorf("message %s was not routed to any queue with routing key %q", message.Id, topic)
+		}
+	}
+
+	return nil
+}
@@ -43,6 +43,7 @@ type BleveEngine struct {
 var keywordMapping *mapping.FieldMapping
 var standardMapping *mapping.FieldMapping
 var dateMapping *mapping.FieldMapping
+var booleanMapping *mapping.FieldMapping
 
 func init() {
 	keywordMapping = bleve.NewTextFieldMapping()
@@ -52,6 +53,8 @@ func init() {
 	standardMapping.Analyzer = standard.Name
 
 	dateMapping = bleve.NewNumericFieldMapping()
+
+	booleanMapping = bleve.NewBooleanFieldMapping()
 }
 
 func getChannelIndexMapping() *mapping.IndexMappingImpl {
@@ -80,6 +83,8 @@ func getPostIndexMapping() *mapping.IndexMappingImpl {
 	postMapping.AddFieldMappingsAt("Type", keywordMapping)
 	postMapping.AddFieldMappingsAt("Hashtags", standardMapping)
 	postMapping.AddFieldMappingsAt("Attachments", standardMapping)
+	postMapping.AddFieldMappingsAt("HasFiles", booleanMapping)
+	postMapping.AddFieldMappingsAt("Language", keywordMapping)
 
 	indexMapping := bleve.NewIndexMapping()
 	indexMapping.AddDocumentMapping("_default", postMapping)
@@ -37,6 +37,8 @@ type BLVPost struct {
 	Type        string
 	Hashtags    []string
 	Attachments string
+	HasFiles    bool
+	Language    string
 }
 
 type BLVFile struct {
@@ -119,6 +121,8 @@ func BLVPostFromPost(post *model.Post, teamId string) *BLVPost {
 }
 
 func BLVPostFromPostForIndexing(post *model.PostForIndexing) *BLVPost {
+	language, _ := post.GetProp(model.PostPropsLanguage).(string)
+
 	return &BLVPost{
 		Id:        post.Id,
 		TeamId:    post.TeamId,
@@ -128,6 +132,8 @@ func BLVPostFromPostForIndexing(post *model.PostForIndexing) *BLVPost {
 		Message:   post.Message,
 		Type:      post.Type,
 		Hashtags:  strings.Fields(post.Hashtags),
+		HasFiles:  len(post.FileIds) > 0,
+		Language:  language,
 	}
 }
 
@@ -28,6 +28,22 @@ func (b *BleveEngine) IndexPost(post *model.Post, teamId string) *model.AppError
 	return nil
 }
 
+// GetPostSearchOperators returns the operators supported by SearchPosts. Filtering by file
+// type and regular expressions aren't supported, as the index has neither the file types
+// nor the raw message.
+func (b *BleveEngine) GetPostSearchOperators() []string {
+	return []string{
+		model.SearchOperatorFrom,
+		model.SearchOperatorIn,
+		model.SearchOperatorOn,
+		model.SearchOperatorBefore,
+		model.SearchOperatorAfter,
+		model.SearchOperatorDateTime,
+		model.SearchOperatorHasFile,
+		model.SearchOperatorLanguage,
+	}
+}
+
 func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
 	channelQueries := []query.Query{}
 	for _, channel := range channels {
@@ -144,6 +160,38 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 					notFilters = append(notFilters, onDateQ)
 				}
 			}
+
+			if params.HasFiles {
+				hasFilesQ := bleve.NewBoolFieldQuery(true)
+				hasFilesQ.SetField("HasFiles")
+				filters = append(filters, hasFilesQ)
+			}
+
+			if params.ExcludedHasFiles {
+				hasFilesQ := bleve.NewBoolFieldQuery(true)
+				hasFilesQ.SetField("HasFiles")
+				notFilters = append(notFilters, hasFilesQ)
+			}
+
+			if len(params.Languages) > 0 {
+				languages := []query.Query{}
+				for _, language := range params.Languages {
+					languageQ := bleve.NewTermQuery(language)
+					languageQ.SetField("Language")
+					languages = append(languages, languageQ)
+				}
+				filters = append(filters, bleve.NewDisjunctionQuery(languages...))
+			}
+
+			if len(params.ExcludedLanguages) > 0 {
+				excludedLanguages := []query.Query{}
+				for _, language := range params.ExcludedLanguages {
+					languageQ := bleve.NewTermQuery(language)
+					languageQ.SetField("Language")
+					excludedLanguages = append(excludedLanguages, languageQ)
+				}
+				notFilters = append(notFilters, bleve.NewDisjunctionQuery(excludedLanguages...))
+			}
 		}
 
 		if params.IsHashtag {
@@ -24,6 +24,8 @@ type SearchEngineInterface interface {
 	IsIndexingSync() bool
 	IndexPost(post *model.Post, teamId string) *model.AppError
 	SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError)
+	// GetPostSearchOperators returns the search operators that SearchPosts supports.
+	GetPostSearchOperators() []string
 	DeletePost(post *model.Post) *model.AppError
 	DeleteChannelPosts(channelID string) *model.AppError
 	DeleteUserPosts(userID string) *model.AppError
@@ -220,6 +220,22 @@ func (_m *SearchEngineInterface) GetPlugins() []string {
 	return r0
 }
 
+// GetPostSearchOperators provides a mock function with given fields:
+func (_m *SearchEngineInterface) GetPostSearchOperators() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // GetVersion provides a mock function with given fields:
 func (_m *SearchEngineInterface) GetVersion() int {
 	ret := _m.Called()
@@ -26,6 +26,21 @@ type channelInviteMsg struct {
 	Purpose              string            `json:"purpose"`
 	Type                 model.ChannelType `json:"type"`
 	DirectParticipantIDs []string          `json:"direct_participant_ids"`
+	MemberCount          int64             `json:"member_count"`
+	PostCount            int64             `json:"post_count"`
+}
+
+// channelInviteResponse is the payload of the response to a channel invite. Pending is set when
+// the invite must be approved by an admin of the remote cluster before the channel is shared.
+type channelInviteResponse struct {
+	Pending bool `json:"pending"`
+}
+
+// channelInviteStatusMsg tells the remote cluster which sent a pending channel invite whether
+// it was approved.
+type channelInviteStatusMsg struct {
+	ChannelId string `json:"channel_id"`
+	Approved  bool   `json:"approved"`
 }
 
 type InviteOption func(msg *channelInviteMsg)
@@ -50,6 +65,11 @@ func (scs *Service) SendChannelInvite(channel *model.Channel, userId string, rc
 		return err
 	}
 
+	memberCount, err := scs.server.GetStore().Channel().GetMemberCount(channel.Id, true)
+	if err != nil {
+		return err
+	}
+
 	invite := channelInviteMsg{
 		ChannelId:   channel.Id,
 		TeamId:      rc.RemoteTeamId,
@@ -59,18 +79,20 @@ func (scs *Service) SendChannelInvite(channel *model.Channel, userId string, rc
 		Header:      sc.ShareHeader,
 		Purpose:     sc.SharePurpose,
 		Type:        channel.Type,
+		MemberCount: memberCount,
+		PostCount:   channel.TotalMsgCount,
 	}
 
 	for _, option := range options {
 		option(&invite)
 	}
 
-	json, err := json.Marshal(invite)
+	payload, err := json.Marshal(invite)
 	if err != nil {
 		return err
 	}
 
-	msg := model.NewRemoteClusterMsg(TopicChannelInvite, json)
+	msg := model.NewRemoteClusterMsg(TopicChannelInvite, payload)
 
 	ctx, cancel := context.WithTimeout(context.Background(), remotecluster.SendTimeout)
 	defer cancel()
@@ -81,17 +103,32 @@ func (scs *Service) SendChannelInvite(channel *model.Channel, userId string, rc
 			return
 		}
 
+		// Remote clusters which don't require approval send no payload.
+		var inviteResp channelInviteResponse
+		if len(resp.Payload) > 0 {
+			if err = json.Unmarshal(resp.Payload, &inviteResp); err != nil {
+				scs.sendEphemeralPost(channel.Id, userId, fmt.Sprintf("Error confirming channel invite for %s: %v", rc.DisplayName, err))
+				return
+			}
+		}
+
+		// The pending invites are confirmed once approved, and the channel isn't synchronized
+		// until then.
 		scr := &model.SharedChannelRemote{
 			ChannelId:         sc.ChannelId,
 			CreatorId:         userId,
 			RemoteId:          rc.RemoteId,
-			IsInviteAccepted:  true,
-			IsInviteConfirmed: true,
+			IsInviteAccepted:  !inviteResp.Pending,
+			IsInviteConfirmed: !inviteResp.Pending,
 		}
 		if _, err = scs.server.GetStore().SharedChannel().SaveRemote(scr); err != nil {
 			scs.sendEphemeralPost(channel.Id, userId, fmt.Sprintf("Error confirming channel invite for %s: %v", rc.DisplayName, err))
 			return
 		}
+		if inviteResp.Pending {
+			scs.sendEphemeralPost(channel.Id, userId, fmt.Sprintf("`%s` will be added to channel once an admin approves the invite.", rc.DisplayName))
+			return
+		}
 		scs.NotifyChannelChanged(sc.ChannelId)
 		scs.sendEphemeralPost(channel.Id, userId, fmt.Sprintf("`%s` has been added to channel.", rc.DisplayName))
 	})
@@ -111,7 +148,7 @@ func combineErrors(err error, serror string) string {
 	return sb.String()
 }
 
-func (scs *Service) onReceiveChannelInvite(msg model.RemoteClusterMsg, rc *model.RemoteCluster, _ *remotecluster.Response) error {
+func (scs *Service) onReceiveChannelInvite(msg model.RemoteClusterMsg, rc *model.RemoteCluster, resp *remotecluster.Response) error {
 	if len(msg.Payload) == 0 {
 		return nil
 	}
@@ -129,6 +166,58 @@ func (scs *Service) onReceiveChannelInvite(msg model.RemoteClusterMsg, rc *model
 		mlog.String("team_id", invite.TeamId),
 	)
 
+	// Direct channels are between two users, and need no approval.
+	if invite.Type != model.ChannelTypeDirect {
+		switch rc.GetInvitePolicy().Policy {
+		case model.RemoteInvitePolicyDeny:
+			return fmt.Errorf("channel invites from %s are denied", rc.DisplayName)
+		case model.RemoteInvitePolicyRequireApproval:
+			return scs.queueChannelInvite(invite, rc, resp)
+		}
+	}
+
+	return scs.acceptChannelInvite(invite, rc)
+}
+
+// queueChannelInvite keeps the channel invite until an admin approves or denies it, and tells
+// the remote cluster that the invite is pending.
+func (scs *Service) queueChannelInvite(invite channelInviteMsg, rc *model.RemoteCluster, resp *remotecluster.Response) error {
+	pending, err := scs.server.GetStore().SharedChannel().GetInvites(model.SharedChannelInviteFilterOpts{
+		RemoteId:  rc.RemoteId,
+		ChannelId: invite.ChannelId,
+		Status:    model.SharedChannelInviteStatusPending,
+	}, 0, 1)
+	if err != nil {
+		return fmt.Errorf("cannot get pending channel invites (channel_id=%s): %w", invite.ChannelId, err)
+	}
+
+	// An invite sent again while pending is only kept once.
+	if len(pending) == 0 {
+		if _, err := scs.server.GetStore().SharedChannel().SaveInvite(&model.SharedChannelInvite{
+			RemoteId:    rc.RemoteId,
+			ChannelId:   invite.ChannelId,
+			TeamId:      invite.TeamId,
+			Type:        invite.Type,
+			Name:        invite.Name,
+			DisplayName: invite.DisplayName,
+			Header:      invite.Header,
+			Purpose:     invite.Purpose,
+			ReadOnly:    invite.ReadOnly,
+			MemberCount: invite.MemberCount,
+			PostCount:   invite.PostCount,
+		}); err != nil {
+			return fmt.Errorf("cannot save channel invite (channel_id=%s): %w", invite.ChannelId, err)
+		}
+	}
+
+	if resp == nil {
+		return nil
+	}
+	return resp.SetPayload(channelInviteResponse{Pending: true})
+}
+
+// acceptChannelInvite creates the channel shared by the remote cluster, and starts sharing it.
+func (scs *Service) acceptChannelInvite(invite channelInviteMsg, rc *model.RemoteCluster) error {
 	// create channel if it doesn't exist; the channel may already exist, such as if it was shared then unshared at some point.
 	channel, err := scs.server.GetStore().Channel().Get(invite.ChannelId, true)
 	if err != nil {
@@ -217,3 +306,104 @@ func (scs *Service) createDirectChannel(invite channelInviteMsg) (*model.Channel
 
 	return channel, nil
 }
+
+// ApproveChannelInvite accepts a pending channel invite, and tells the remote cluster which sent
+// it to start sharing the channel.
+func (scs *Service) ApproveChannelInvite(invite *model.SharedChannelInvite) error {
+	rc, err := scs.server.GetStore().RemoteCluster().Get(invite.RemoteId)
+	if err != nil {
+		return fmt.Errorf("cannot find remote cluster %s: %w", invite.RemoteId, err)
+	}
+
+	if err := scs.acceptChannelInvite(channelInviteMsg{
+		ChannelId:   invite.ChannelId,
+		TeamId:      invite.TeamId,
+		ReadOnly:    invite.ReadOnly,
+		Name:        invite.Name,
+		DisplayName: invite.DisplayName,
+		Header:      invite.Header,
+		Purpose:     invite.Purpose,
+		Type:        invite.Type,
+	}, rc); err != nil {
+		return err
+	}
+
+	return scs.sendChannelInviteStatus(invite.ChannelId, rc, true)
+}
+
+// DenyChannelInvite tells the remote cluster which sent a pending channel invite that it was
+// denied.
+func (scs *Service) DenyChannelInvite(invite *model.SharedChannelInvite) error {
+	rc, err := scs.server.GetStore().RemoteCluster().Get(invite.RemoteId)
+	if err != nil {
+		return fmt.Errorf("cannot find remote cluster %s: %w", invite.RemoteId, err)
+	}
+
+	return scs.sendChannelInviteStatus(invite.ChannelId, rc, false)
+}
+
+func (scs *Service) sendChannelInviteStatus(channelID string, rc *model.RemoteCluster, approved bool) error {
+	rcs := scs.server.GetRemoteClusterService()
+	if rcs == nil {
+		return fmt.Errorf("cannot send channel invite status for channel id %s; Remote Cluster Service not enabled", channelID)
+	}
+
+	payload, err := json.Marshal(channelInviteStatusMsg{ChannelId: channelID, Approved: approved})
+	if err != nil {
+		return err
+	}
+
+	msg := model.NewRemoteClusterMsg(TopicChannelInviteStatus, payload)
+
+	ctx, cancel := context.WithTimeout(context.Background(), remotecluster.SendTimeout)
+	defer cancel()
+
+	return rcs.SendMsg(ctx, msg, rc, func(msg model.RemoteClusterMsg, rc *model.RemoteCluster, resp *remotecluster.Response, err error) {
+		if err != nil || !resp.IsSuccess() {
+			scs.server.GetLogger().Log(mlog.LvlSharedChannelServiceError, "Error sending channel invite status",
+				mlog.String("remote", rc.DisplayName),
+				mlog.String("channel_id", channelID),
+				mlog.String("error", combineErrors(err, resp.Err)),
+			)
+		}
+	})
+}
+
+// onReceiveChannelInviteStatus confirms, or drops, a channel invite sent to a remote cluster
+// which required approval.
+func (scs *Service) onReceiveChannelInviteStatus(msg model.RemoteClusterMsg, rc *model.RemoteCluster, _ *remotecluster.Response) error {
+	if len(msg.Payload) == 0 {
+		return nil
+	}
+
+	var status channelInviteStatusMsg
+	if err := json.Unmarshal(msg.Payload, &status); err != nil {
+		return fmt.Errorf("invalid channel invite status: %w", err)
+	}
+
+	scr, err := scs.server.GetStore().SharedChannel().GetRemoteByIds(status.ChannelId, rc.RemoteId)
+	if err != nil {
+		return fmt.Errorf("cannot find channel invite (channel_id=%s): %w", status.ChannelId, err)
+	}
+
+	if scr.IsInviteConfirmed {
+		return nil
+	}
+
+	if !status.Approved {
+		if _, err := scs.server.GetStore().SharedChannel().DeleteRemote(scr.Id); err != nil {
+			return fmt.Errorf("cannot delete denied channel invite (channel_id=%s): %w", status.ChannelId, err)
+		}
+		scs.sendEphemeralPost(scr.ChannelId, scr.CreatorId, fmt.Sprintf("`%s` denied the channel invite.", rc.DisplayName))
+		return nil
+	}
+
+	scr.IsInviteAccepted = true
+	scr.IsInviteConfirmed = true
+	if _, err := scs.server.GetStore().SharedChannel().UpdateRemote(scr); err != nil {
+		return fmt.Errorf("cannot confirm channel invite (channel_id=%s): %w", status.ChannelId, err)
+	}
+	scs.NotifyChannelChanged(scr.ChannelId)
+	scs.sendEphemeralPost(scr.ChannelId, scr.CreatorId, fmt.Sprintf("`%s` has been added to channel.", rc.DisplayName))
+	return nil
+}
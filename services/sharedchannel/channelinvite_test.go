@@ -15,6 +15,7 @@ import (
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost-server/v6/services/remotecluster"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 	"github.com/mattermost/mattermost-server/v6/store/storetest/mocks"
 )
@@ -195,3 +196,146 @@ func TestOnReceiveChannelInvite(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestOnReceiveChannelInvitePolicy(t *testing.T) {
+	setup := func(t *testing.T) (*Service, *mocks.Store, model.RemoteClusterMsg, channelInviteMsg) {
+		mockServer := &MockServerIface{}
+		mockServer.On("GetLogger").Return(&mockLogger{})
+		mockStore := &mocks.Store{}
+		mockServer.On("GetStore").Return(mockStore)
+		scs := &Service{
+			server: mockServer,
+			app:    &MockAppIface{},
+		}
+
+		invitation := channelInviteMsg{
+			ChannelId:   model.NewId(),
+			TeamId:      model.NewId(),
+			Name:        "shared",
+			DisplayName: "Shared",
+			Type:        model.ChannelTypeOpen,
+			MemberCount: 12,
+			PostCount:   340,
+		}
+		payload, err := json.Marshal(invitation)
+		require.NoError(t, err)
+
+		return scs, mockStore, model.RemoteClusterMsg{Payload: payload}, invitation
+	}
+
+	t.Run("when the policy denies invites, it returns an error", func(t *testing.T) {
+		scs, mockStore, msg, _ := setup(t)
+		remoteCluster := &model.RemoteCluster{RemoteId: model.NewId(), DisplayName: "remote", InvitePolicy: model.RemoteInvitePolicyDeny}
+
+		err := scs.onReceiveChannelInvite(msg, remoteCluster, nil)
+		require.Error(t, err)
+		mockStore.AssertNotCalled(t, "Channel")
+		mockStore.AssertNotCalled(t, "SharedChannel")
+	})
+
+	t.Run("when the policy requires approval, it queues the invite", func(t *testing.T) {
+		scs, mockStore, msg, invitation := setup(t)
+		remoteCluster := &model.RemoteCluster{RemoteId: model.NewId(), InvitePolicy: model.RemoteInvitePolicyRequireApproval}
+
+		mockSharedChannelStore := mocks.SharedChannelStore{}
+		mockSharedChannelStore.On("GetInvites", model.SharedChannelInviteFilterOpts{
+			RemoteId:  remoteCluster.RemoteId,
+			ChannelId: invitation.ChannelId,
+			Status:    model.SharedChannelInviteStatusPending,
+		}, 0, 1).Return([]*model.SharedChannelInvite{}, nil)
+		mockSharedChannelStore.On("SaveInvite", mock.MatchedBy(func(invite *model.SharedChannelInvite) bool {
+			return invite.RemoteId == remoteCluster.RemoteId &&
+				invite.ChannelId == invitation.ChannelId &&
+				invite.MemberCount == invitation.MemberCount &&
+				invite.PostCount == invitation.PostCount
+		})).Return(&model.SharedChannelInvite{}, nil)
+		mockStore.On("SharedChannel").Return(&mockSharedChannelStore)
+
+		resp := &remotecluster.Response{}
+		err := scs.onReceiveChannelInvite(msg, remoteCluster, resp)
+		require.NoError(t, err)
+		mockSharedChannelStore.AssertExpectations(t)
+		mockStore.AssertNotCalled(t, "Channel")
+
+		var inviteResp channelInviteResponse
+		require.NoError(t, json.Unmarshal(resp.Payload, &inviteResp))
+		assert.True(t, inviteResp.Pending)
+	})
+
+	t.Run("when an invite is already pending, it doesn't queue it again", func(t *testing.T) {
+		scs, mockStore, msg, _ := setup(t)
+		remoteCluster := &model.RemoteCluster{RemoteId: model.NewId(), InvitePolicy: model.RemoteInvitePolicyRequireApproval}
+
+		mockSharedChannelStore := mocks.SharedChannelStore{}
+		mockSharedChannelStore.On("GetInvites", mock.Anything, 0, 1).Return([]*model.SharedChannelInvite{{Id: model.NewId()}}, nil)
+		mockStore.On("SharedChannel").Return(&mockSharedChannelStore)
+
+		err := scs.onReceiveChannelInvite(msg, remoteCluster, &remotecluster.Response{})
+		require.NoError(t, err)
+		mockSharedChannelStore.AssertNotCalled(t, "SaveInvite", mock.Anything)
+	})
+}
+
+func TestOnReceiveChannelInviteStatus(t *testing.T) {
+	setup := func(t *testing.T, approved bool) (*Service, *MockAppIface, *mocks.SharedChannelStore, model.RemoteClusterMsg, *model.SharedChannelRemote) {
+		mockServer := &MockServerIface{}
+		mockServer.On("GetLogger").Return(&mockLogger{})
+		mockServer.On("GetRemoteClusterService").Return(nil)
+		mockStore := &mocks.Store{}
+		mockServer.On("GetStore").Return(mockStore)
+		mockApp := &MockAppIface{}
+		scs := &Service{
+			server: mockServer,
+			app:    mockApp,
+		}
+
+		scr := &model.SharedChannelRemote{
+			Id:        model.NewId(),
+			ChannelId: model.NewId(),
+			CreatorId: model.NewId(),
+			RemoteId:  model.NewId(),
+		}
+		mockSharedChannelStore := &mocks.SharedChannelStore{}
+		mockSharedChannelStore.On("GetRemoteByIds", scr.ChannelId, scr.RemoteId).Return(scr, nil)
+		mockStore.On("SharedChannel").Return(mockSharedChannelStore)
+
+		payload, err := json.Marshal(channelInviteStatusMsg{ChannelId: scr.ChannelId, Approved: approved})
+		require.NoError(t, err)
+
+		return scs, mockApp, mockSharedChannelStore, model.RemoteClusterMsg{Payload: payload}, scr
+	}
+
+	t.Run("when the invite is approved, it confirms the invite", func(t *testing.T) {
+		scs, mockApp, mockSharedChannelStore, msg, scr := setup(t, true)
+		mockSharedChannelStore.On("UpdateRemote", mock.MatchedBy(func(updated *model.SharedChannelRemote) bool {
+			return updated.Id == scr.Id && updated.IsInviteAccepted && updated.IsInviteConfirmed
+		})).Return(scr, nil)
+		mockApp.On("SendEphemeralPost", scr.CreatorId, mock.Anything).Return(&model.Post{})
+
+		err := scs.onReceiveChannelInviteStatus(msg, &model.RemoteCluster{RemoteId: scr.RemoteId}, nil)
+		require.NoError(t, err)
+		mockSharedChannelStore.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("when the invite is denied, it deletes the invite", func(t *testing.T) {
+		scs, mockApp, mockSharedChannelStore, msg, scr := setup(t, false)
+		mockSharedChannelStore.On("DeleteRemote", scr.Id).Return(true, nil)
+		mockApp.On("SendEphemeralPost", scr.CreatorId, mock.Anything).Return(&model.Post{})
+
+		err := scs.onReceiveChannelInviteStatus(msg, &model.RemoteCluster{RemoteId: scr.RemoteId}, nil)
+		require.NoError(t, err)
+		mockSharedChannelStore.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("when the invite is already confirmed, it does nothing", func(t *testing.T) {
+		scs, _, mockSharedChannelStore, msg, scr := setup(t, false)
+		scr.IsInviteAccepted = true
+		scr.IsInviteConfirmed = true
+
+		err := scs.onReceiveChannelInviteStatus(msg, &model.RemoteCluster{RemoteId: scr.RemoteId}, nil)
+		require.NoError(t, err)
+		mockSharedChannelStore.AssertNotCalled(t, "DeleteRemote", mock.Anything)
+	})
+}
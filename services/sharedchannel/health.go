@@ -0,0 +1,175 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	// MaxHealthSamples is the number of the most recent attempts to send sync messages to a
+	// remote that its error rate and latency percentiles are computed over.
+	MaxHealthSamples = 100
+)
+
+type syncSample struct {
+	latency time.Duration
+	failed  bool
+}
+
+// remoteSyncStats holds the recent attempts to send sync messages to a remote cluster.
+type remoteSyncStats struct {
+	lastSyncAt    int64
+	lastFailureAt int64
+	lastError     string
+	channels      map[string]int64 // last successful sync per channel
+
+	// samples is a ring buffer of the most recent attempts, next being the oldest once full.
+	samples []syncSample
+	next    int
+}
+
+func (rs *remoteSyncStats) addSample(sample syncSample) {
+	if len(rs.samples) < MaxHealthSamples {
+		rs.samples = append(rs.samples, sample)
+		return
+	}
+	rs.samples[rs.next] = sample
+	rs.next = (rs.next + 1) % MaxHealthSamples
+}
+
+// recordSyncResult records an attempt to send a sync message for a channel to a remote
+// cluster, and lets the system admins know when it failed.
+func (scs *Service) recordSyncResult(rc *model.RemoteCluster, channelID string, latency time.Duration, err error) {
+	now := model.GetMillis()
+
+	scs.statsMux.Lock()
+	if scs.stats == nil {
+		scs.stats = make(map[string]*remoteSyncStats)
+	}
+	rs, ok := scs.stats[rc.RemoteId]
+	if !ok {
+		rs = &remoteSyncStats{channels: make(map[string]int64)}
+		scs.stats[rc.RemoteId] = rs
+	}
+	rs.addSample(syncSample{latency: latency, failed: err != nil})
+	if err != nil {
+		rs.lastFailureAt = now
+		rs.lastError = err.Error()
+	} else {
+		rs.lastSyncAt = now
+		rs.channels[channelID] = now
+	}
+	scs.statsMux.Unlock()
+
+	if err != nil {
+		message := model.NewWebSocketEvent(model.WebsocketEventRemoteClusterSyncFailed, "", "", "", nil)
+		message.Add("remote_id", rc.RemoteId)
+		message.Add("channel_id", channelID)
+		message.Add("error", err.Error())
+		message.GetBroadcast().ContainsSensitiveData = true
+		scs.app.Publish(message)
+	}
+}
+
+// GetRemoteHealth reports how well the channels shared with the remote cluster are kept in
+// sync, from the attempts to send sync messages made by this node and the posts left to send.
+func (scs *Service) GetRemoteHealth(rc *model.RemoteCluster) (*model.RemoteClusterHealth, error) {
+	health := &model.RemoteClusterHealth{
+		RemoteId:   rc.RemoteId,
+		IsOnline:   rc.IsOnline(),
+		LastPingAt: rc.LastPingAt,
+		Channels:   []*model.RemoteClusterChannelHealth{},
+	}
+
+	channelSyncAt := make(map[string]int64)
+	var latencies []time.Duration
+
+	scs.statsMux.Lock()
+	if rs, ok := scs.stats[rc.RemoteId]; ok {
+		health.LastSyncAt = rs.lastSyncAt
+		health.LastFailureAt = rs.lastFailureAt
+		health.LastError = rs.lastError
+		health.Attempts = len(rs.samples)
+		for _, sample := range rs.samples {
+			if sample.failed {
+				health.Failures++
+			} else {
+				latencies = append(latencies, sample.latency)
+			}
+		}
+		for channelID, syncAt := range rs.channels {
+			channelSyncAt[channelID] = syncAt
+		}
+	}
+	scs.statsMux.Unlock()
+
+	if health.Attempts > 0 {
+		health.ErrorRate = float64(health.Failures) / float64(health.Attempts)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	health.LatencyP50 = latencyPercentile(latencies, 50)
+	health.LatencyP90 = latencyPercentile(latencies, 90)
+	health.LatencyP99 = latencyPercentile(latencies, 99)
+
+	scs.mux.RLock()
+	for _, task := range scs.tasks {
+		if task.remoteID == "" || task.remoteID == rc.RemoteId {
+			health.PendingTasks++
+		}
+	}
+	scs.mux.RUnlock()
+
+	scrs, err := scs.server.GetStore().SharedChannel().GetRemotes(model.SharedChannelRemoteFilterOpts{RemoteId: rc.RemoteId})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scr := range scrs {
+		options := model.GetPostsSinceForSyncOptions{
+			ChannelId:       scr.ChannelId,
+			IncludeDeleted:  true,
+			ExcludeRemoteId: rc.RemoteId,
+		}
+		cursor := model.GetPostsSinceForSyncCursor{
+			LastPostUpdateAt: scr.LastPostUpdateAt,
+			LastPostId:       scr.LastPostId,
+		}
+		pending, err := scs.server.GetStore().Post().CountPostsSinceForSync(options, cursor)
+		if err != nil {
+			scs.server.GetLogger().Log(mlog.LvlSharedChannelServiceError, "Failed to count the posts left to sync",
+				mlog.String("remote_id", rc.RemoteId),
+				mlog.String("channel_id", scr.ChannelId),
+				mlog.Err(err),
+			)
+			return nil, err
+		}
+
+		health.PendingPosts += pending
+		health.Channels = append(health.Channels, &model.RemoteClusterChannelHealth{
+			ChannelId:        scr.ChannelId,
+			LastSyncAt:       channelSyncAt[scr.ChannelId],
+			LastPostUpdateAt: scr.LastPostUpdateAt,
+			PendingPosts:     pending,
+		})
+	}
+
+	return health, nil
+}
+
+// latencyPercentile returns the given percentile of the sorted latencies, in milliseconds.
+func latencyPercentile(sorted []time.Duration, percentile int) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := (len(sorted)*percentile+99)/100 - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index].Milliseconds()
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost-server/v6/store/storetest/mocks"
+)
+
+func TestGetRemoteHealth(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), LastPingAt: model.GetMillis()}
+	channelID := model.NewId()
+
+	scr := &model.SharedChannelRemote{
+		Id:               model.NewId(),
+		ChannelId:        channelID,
+		RemoteId:         rc.RemoteId,
+		LastPostUpdateAt: 1234,
+		LastPostId:       model.NewId(),
+	}
+
+	mockSharedChannelStore := &mocks.SharedChannelStore{}
+	mockSharedChannelStore.On("GetRemotes", model.SharedChannelRemoteFilterOpts{RemoteId: rc.RemoteId}).Return([]*model.SharedChannelRemote{scr}, nil)
+
+	mockPostStore := &mocks.PostStore{}
+	mockPostStore.On("CountPostsSinceForSync", model.GetPostsSinceForSyncOptions{
+		ChannelId:       channelID,
+		IncludeDeleted:  true,
+		ExcludeRemoteId: rc.RemoteId,
+	}, model.GetPostsSinceForSyncCursor{LastPostUpdateAt: scr.LastPostUpdateAt, LastPostId: scr.LastPostId}).Return(int64(7), nil)
+
+	mockStore := &mocks.Store{}
+	mockStore.On("SharedChannel").Return(mockSharedChannelStore)
+	mockStore.On("Post").Return(mockPostStore)
+
+	mockServer := &MockServerIface{}
+	mockServer.On("GetStore").Return(mockStore)
+
+	mockApp := &MockAppIface{}
+	mockApp.On("Publish", mock.MatchedBy(func(message *model.WebSocketEvent) bool {
+		return message.EventType() == model.WebsocketEventRemoteClusterSyncFailed &&
+			message.GetData()["remote_id"] == rc.RemoteId &&
+			message.GetBroadcast().ContainsSensitiveData
+	})).Return().Once()
+	defer mockApp.AssertExpectations(t)
+
+	scs := &Service{
+		server: mockServer,
+		app:    mockApp,
+		tasks: map[string]syncTask{
+			"all":   newSyncTask(channelID, "", nil),
+			"this":  newSyncTask(channelID, rc.RemoteId, nil),
+			"other": newSyncTask(channelID, model.NewId(), nil),
+		},
+	}
+
+	t.Run("without sync attempts", func(t *testing.T) {
+		health, err := scs.GetRemoteHealth(rc)
+		require.NoError(t, err)
+		assert.True(t, health.IsOnline)
+		assert.Equal(t, rc.LastPingAt, health.LastPingAt)
+		assert.Zero(t, health.LastSyncAt)
+		assert.Zero(t, health.Attempts)
+		assert.Zero(t, health.ErrorRate)
+		assert.Equal(t, 2, health.PendingTasks)
+		assert.Equal(t, int64(7), health.PendingPosts)
+		require.Len(t, health.Channels, 1)
+		assert.Equal(t, int64(1234), health.Channels[0].LastPostUpdateAt)
+		assert.Zero(t, health.Channels[0].LastSyncAt)
+	})
+
+	t.Run("with sync attempts", func(t *testing.T) {
+		for i := 1; i <= 9; i++ {
+			scs.recordSyncResult(rc, channelID, time.Duration(i)*10*time.Millisecond, nil)
+		}
+		scs.recordSyncResult(rc, channelID, time.Second, errors.New("remote unreachable"))
+
+		health, err := scs.GetRemoteHealth(rc)
+		require.NoError(t, err)
+		assert.NotZero(t, health.LastSyncAt)
+		assert.NotZero(t, health.LastFailureAt)
+		assert.Equal(t, "remote unreachable", health.LastError)
+		assert.Equal(t, 10, health.Attempts)
+		assert.Equal(t, 1, health.Failures)
+		assert.InDelta(t, 0.1, health.ErrorRate, 0.0001)
+		assert.Equal(t, int64(50), health.LatencyP50)
+		assert.Equal(t, int64(90), health.LatencyP90)
+		assert.Equal(t, int64(90), health.LatencyP99)
+		require.Len(t, health.Channels, 1)
+		assert.Equal(t, health.LastSyncAt, health.Channels[0].LastSyncAt)
+	})
+}
+
+func TestRemoteSyncStatsAddSample(t *testing.T) {
+	rs := &remoteSyncStats{}
+	for i := 0; i < MaxHealthSamples+10; i++ {
+		rs.addSample(syncSample{latency: time.Duration(i)})
+	}
+	require.Len(t, rs.samples, MaxHealthSamples)
+	assert.Equal(t, 10, rs.next)
+	// the oldest samples were overwritten.
+	assert.Equal(t, time.Duration(MaxHealthSamples), rs.samples[0].latency)
+}
@@ -21,6 +21,7 @@ import (
 const (
 	TopicSync                    = "sharedchannel_sync"
 	TopicChannelInvite           = "sharedchannel_invite"
+	TopicChannelInviteStatus     = "sharedchannel_invite_status"
 	TopicUploadCreate            = "sharedchannel_upload"
 	MaxRetries                   = 3
 	MaxPostsPerSync              = 12 // a bit more than one typical screenfull of posts
@@ -63,6 +64,7 @@ type AppIface interface {
 	GetProfileImage(user *model.User) ([]byte, bool, *model.AppError)
 	InvalidateCacheForUser(userID string)
 	NotifySharedChannelUserUpdate(user *model.User)
+	Publish(message *model.WebSocketEvent)
 }
 
 // errNotFound allows checking against Store.ErrNotFound errors without making Store a dependency.
@@ -90,8 +92,13 @@ type Service struct {
 	tasks                     map[string]syncTask
 	syncTopicListenerId       string
 	inviteTopicListenerId     string
+	inviteStatusListenerId    string
 	uploadTopicListenerId     string
 	siteURL                   *url.URL
+
+	// sync statistics per remote, guarded by `statsMux`
+	statsMux sync.Mutex
+	stats    map[string]*remoteSyncStats
 }
 
 // NewSharedChannelService creates a RemoteClusterService instance.
@@ -101,6 +108,7 @@ func NewSharedChannelService(server ServerIface, app AppIface) (*Service, error)
 		app:          app,
 		changeSignal: make(chan struct{}, 1),
 		tasks:        make(map[string]syncTask),
+		stats:        make(map[string]*remoteSyncStats),
 	}
 	parsed, err := url.Parse(*server.Config().ServiceSettings.SiteURL)
 	if err != nil {
@@ -121,6 +129,7 @@ func (scs *Service) Start() error {
 	scs.leaderListenerId = scs.server.AddClusterLeaderChangedListener(scs.onClusterLeaderChange)
 	scs.syncTopicListenerId = rcs.AddTopicListener(TopicSync, scs.onReceiveSyncMessage)
 	scs.inviteTopicListenerId = rcs.AddTopicListener(TopicChannelInvite, scs.onReceiveChannelInvite)
+	scs.inviteStatusListenerId = rcs.AddTopicListener(TopicChannelInviteStatus, scs.onReceiveChannelInviteStatus)
 	scs.uploadTopicListenerId = rcs.AddTopicListener(TopicUploadCreate, scs.onReceiveUploadCreate)
 	scs.connectionStateListenerId = rcs.AddConnectionStateListener(scs.onConnectionStateChange)
 	scs.mux.Unlock()
@@ -143,6 +152,8 @@ func (scs *Service) Shutdown() error {
 	scs.syncTopicListenerId = ""
 	rcs.RemoveTopicListener(scs.inviteTopicListenerId)
 	scs.inviteTopicListenerId = ""
+	rcs.RemoveTopicListener(scs.inviteStatusListenerId)
+	scs.inviteStatusListenerId = ""
 	rcs.RemoveConnectionStateListener(scs.connectionStateListenerId)
 	scs.connectionStateListenerId = ""
 	scs.mux.Unlock()
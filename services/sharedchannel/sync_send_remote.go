@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/wiggin77/merror"
 
@@ -408,7 +409,10 @@ func (scs *Service) sendSyncData(sd *syncData) error {
 // sendUserSyncData sends the collected user updates to the remote cluster.
 func (scs *Service) sendUserSyncData(sd *syncData) error {
 	msg := newSyncMsg(sd.task.channelID)
-	msg.Users = sd.users
+	msg.Users = make(map[string]*model.User, len(sd.users))
+	for id, user := range sd.users {
+		msg.Users[id] = maskUserForRemote(user, sd.rc)
+	}
 
 	err := scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp SyncResponse, errResp error) {
 		for _, userID := range syncResp.UsersSyncd {
@@ -510,9 +514,12 @@ func (scs *Service) sendSyncMsgToRemote(msg *syncMsg, rc *model.RemoteCluster, f
 	var wg sync.WaitGroup
 	wg.Add(1)
 
+	start := time.Now()
 	err = rcs.SendMsg(ctx, rcMsg, rc, func(rcMsg model.RemoteClusterMsg, rc *model.RemoteCluster, rcResp *remotecluster.Response, errResp error) {
 		defer wg.Done()
 
+		scs.recordSyncResult(rc, msg.ChannelId, time.Since(start), errResp)
+
 		var syncResp SyncResponse
 		if err2 := json.Unmarshal(rcResp.Payload, &syncResp); err2 != nil {
 			scs.server.GetLogger().Log(mlog.LvlSharedChannelServiceError, "Invalid sync msg response from remote cluster",
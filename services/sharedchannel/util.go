@@ -45,6 +45,50 @@ func sanitizeUserForSync(user *model.User) *model.User {
 	return user
 }
 
+// maskUserForRemote returns a copy of the user without the profile fields the remote
+// cluster is not allowed to see.
+func maskUserForRemote(user *model.User, rc *model.RemoteCluster) *model.User {
+	if strings.TrimSpace(rc.HiddenProfileFields) == "" {
+		return user
+	}
+
+	masked := user.DeepCopy()
+
+	if rc.IsProfileFieldHidden(model.RemoteProfileFieldEmail) {
+		masked.Email = ""
+		if _, ok := masked.GetProp(KeyRemoteEmail); ok {
+			masked.SetProp(KeyRemoteEmail, "")
+		}
+	}
+
+	if rc.IsProfileFieldHidden(model.RemoteProfileFieldFullName) {
+		masked.FirstName = ""
+		masked.LastName = ""
+	}
+
+	if rc.IsProfileFieldHidden(model.RemoteProfileFieldNickname) {
+		masked.Nickname = ""
+	}
+
+	if rc.IsProfileFieldHidden(model.RemoteProfileFieldPosition) {
+		masked.Position = ""
+	}
+
+	if rc.IsProfileFieldHidden(model.RemoteProfileFieldCustomAttributes) {
+		// keep the props the shared channels service relies on, and send an empty
+		// map rather than none so that attributes synced before are cleared.
+		props := model.StringMap{}
+		for _, key := range []string{KeyRemoteUsername, KeyRemoteEmail} {
+			if value, ok := masked.GetProp(key); ok {
+				props[key] = value
+			}
+		}
+		masked.Props = props
+	}
+
+	return masked
+}
+
 // mungUsername creates a new username by combining username and remote cluster name, plus
 // a suffix to create uniqueness. If the resulting username exceeds the max length then
 // it is truncated and ellipses added.
@@ -7,7 +7,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
 )
 
 func Test_mungUsername(t *testing.T) {
@@ -74,3 +77,52 @@ func Test_mungUsernameFuzz(t *testing.T) {
 func R(count int, s string) string {
 	return strings.Repeat(s, count)
 }
+
+func Test_maskUserForRemote(t *testing.T) {
+	user := &model.User{
+		Id:        model.NewId(),
+		Username:  "bob",
+		Email:     "bob@example.com",
+		FirstName: "Bob",
+		LastName:  "Smith",
+		Nickname:  "bobby",
+		Position:  "Engineer",
+		Props: model.StringMap{
+			KeyRemoteUsername: "bob",
+			KeyRemoteEmail:    "bob@example.com",
+			"team":            "platform",
+		},
+	}
+
+	t.Run("nothing hidden", func(t *testing.T) {
+		rc := &model.RemoteCluster{}
+		assert.Same(t, user, maskUserForRemote(user, rc))
+	})
+
+	t.Run("email and custom attributes hidden", func(t *testing.T) {
+		rc := &model.RemoteCluster{HiddenProfileFields: "email custom_attributes"}
+		masked := maskUserForRemote(user, rc)
+
+		assert.Empty(t, masked.Email)
+		assert.Equal(t, model.StringMap{KeyRemoteUsername: "bob", KeyRemoteEmail: ""}, masked.Props)
+		assert.Equal(t, "Bob", masked.FirstName)
+		assert.Equal(t, "bobby", masked.Nickname)
+		assert.Equal(t, "Engineer", masked.Position)
+
+		// the original user is left alone
+		assert.Equal(t, "bob@example.com", user.Email)
+		assert.Equal(t, "platform", user.Props["team"])
+	})
+
+	t.Run("names and position hidden", func(t *testing.T) {
+		rc := &model.RemoteCluster{HiddenProfileFields: "full_name nickname position"}
+		masked := maskUserForRemote(user, rc)
+
+		assert.Empty(t, masked.FirstName)
+		assert.Empty(t, masked.LastName)
+		assert.Empty(t, masked.Nickname)
+		assert.Empty(t, masked.Position)
+		assert.Equal(t, "bob@example.com", masked.Email)
+		assert.Equal(t, "bob", masked.Username)
+	})
+}
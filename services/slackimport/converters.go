@@ -22,6 +22,12 @@ func slackConvertTimeStamp(ts string) int64 {
 	return timeStamp * 1000 // Convert to milliseconds
 }
 
+// slackConvertEmojiName drops the skin tone Slack appends to the names of the emojis, such
+// as in thumbsup::skin-tone-2.
+func slackConvertEmojiName(name string) string {
+	return strings.SplitN(name, "::", 2)[0]
+}
+
 func slackConvertChannelName(channelName string, channelId string) string {
 	newName := strings.Trim(channelName, "_-")
 	if len(newName) == 1 {
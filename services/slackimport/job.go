@@ -0,0 +1,249 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slackimport
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// checkpointInterval is how many messages of a channel are imported between two checkpoints.
+const checkpointInterval = 500
+
+// Progress is how far an import went, for it to be resumed from there.
+type Progress struct {
+	// ChannelIndex is the channel being imported, the ones before it being done.
+	ChannelIndex int
+	// LastTimeStamp is the last message imported of the channel being imported.
+	LastTimeStamp string
+	// ChannelCount is how many channels there are to import.
+	ChannelCount int
+}
+
+// Checkpoint saves the progress of an import along with its report. An error stops the import.
+type Checkpoint func(progress Progress, report *model.SlackImportReport) error
+
+// Import imports the users, channels, messages, reactions and files of the source to the team,
+// starting from the progress. The report is filled as the import goes, and saved along with the
+// progress at each checkpoint. Since the users and channels of the workspace are matched to the
+// existing ones, the users and channels imported before resuming are found again.
+func (si *SlackImporter) Import(source Source, teamID string, progress Progress, report *model.SlackImportReport, checkpoint Checkpoint) error {
+	team, err := si.store.Team().Get(teamID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get team with id=%s", teamID)
+	}
+
+	sUsers, err := source.Users()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the Slack users")
+	}
+	sChannels, err := source.Channels()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the Slack channels")
+	}
+	progress.ChannelCount = len(sChannels)
+
+	users := si.mapSlackUsers(team, sUsers, report)
+
+	botUser := si.getSlackBotUser(team, report)
+
+	channels := make(map[string]*model.SlackImportChannel, len(report.Channels))
+	for _, channel := range report.Channels {
+		channels[channel.SlackId] = channel
+	}
+
+	var discardLog bytes.Buffer
+	for ; progress.ChannelIndex < len(sChannels); progress.ChannelIndex, progress.LastTimeStamp = progress.ChannelIndex+1, "" {
+		sChannel := sChannels[progress.ChannelIndex]
+
+		mapping, ok := channels[sChannel.Id]
+		if !ok {
+			mapping = &model.SlackImportChannel{
+				SlackId:   sChannel.Id,
+				SlackName: sChannel.Name,
+				Type:      sChannel.Type,
+			}
+			channels[sChannel.Id] = mapping
+			report.Channels = append(report.Channels, mapping)
+		}
+
+		mChannel, merged := si.slackAddChannel(teamID, sChannel, users, &discardLog)
+		if mChannel == nil {
+			mapping.Status = model.SlackImportChannelFailed
+			continue
+		}
+		mapping.ChannelId = mChannel.Id
+		mapping.ChannelName = mChannel.Name
+		if mapping.Status == "" {
+			mapping.Status = model.SlackImportChannelCreated
+			if merged {
+				mapping.Status = model.SlackImportChannelMerged
+			}
+		}
+
+		if err := si.importSlackChannelPosts(source, team.Id, sChannel, mChannel, sUsers, sChannels, users, botUser, &progress, mapping, report, checkpoint); err != nil {
+			return err
+		}
+
+		if err := checkpoint(Progress{ChannelIndex: progress.ChannelIndex + 1, ChannelCount: progress.ChannelCount}, report); err != nil {
+			return err
+		}
+	}
+
+	if botUser != nil {
+		si.deactivateSlackBotUser(botUser)
+	}
+
+	si.actions.InvalidateAllCaches()
+
+	return nil
+}
+
+func (si *SlackImporter) importSlackChannelPosts(source Source, teamID string, sChannel slackChannel, mChannel *model.Channel, sUsers []slackUser, sChannels []slackChannel, users map[string]*model.User, botUser *model.User, progress *Progress, mapping *model.SlackImportChannel, report *model.SlackImportReport, checkpoint Checkpoint) error {
+	posts, err := source.Posts(sChannel)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the messages of Slack channel %s", sChannel.Id)
+	}
+
+	converted := map[string][]slackPost{sChannel.Id: posts}
+	converted = slackConvertUserMentions(sUsers, converted)
+	converted = slackConvertChannelMentions(sChannels, converted)
+	converted = slackConvertPostsMarkup(converted)
+	posts = converted[sChannel.Id]
+	sort.SliceStable(posts, func(i, j int) bool {
+		return slackTimeStampBefore(posts[i].TimeStamp, posts[j].TimeStamp)
+	})
+
+	threads := make(map[string]string)
+	var sinceCheckpoint int
+	for _, sPost := range posts {
+		if progress.LastTimeStamp != "" && !slackTimeStampBefore(progress.LastTimeStamp, sPost.TimeStamp) {
+			continue
+		}
+
+		imported := si.slackAddPost(teamID, mChannel, sPost, users, source.File, botUser, threads)
+		if imported.postId != "" {
+			mapping.Posts++
+			report.Posts++
+			if imported.reply {
+				report.Replies++
+			}
+		}
+		report.Reactions += int64(imported.reactions)
+		report.Files += int64(imported.files)
+		report.FailedFiles += int64(imported.failedFiles)
+
+		progress.LastTimeStamp = sPost.TimeStamp
+		sinceCheckpoint++
+		if sinceCheckpoint == checkpointInterval {
+			if err := checkpoint(*progress, report); err != nil {
+				return err
+			}
+			sinceCheckpoint = 0
+		}
+	}
+
+	return nil
+}
+
+// mapSlackUsers matches the Slack users to the users of the same email, creating the ones that
+// have no match. The users that were mapped before resuming an import keep their status.
+func (si *SlackImporter) mapSlackUsers(team *model.Team, sUsers []slackUser, report *model.SlackImportReport) map[string]*model.User {
+	previous := make(map[string]*model.SlackImportUser, len(report.Users))
+	for _, mapping := range report.Users {
+		previous[mapping.SlackId] = mapping
+	}
+
+	report.Users = make([]*model.SlackImportUser, 0, len(sUsers))
+	users := make(map[string]*model.User, len(sUsers))
+	for _, sUser := range sUsers {
+		mapping := &model.SlackImportUser{
+			SlackId:       sUser.Id,
+			SlackUsername: sUser.Username,
+			Email:         strings.ToLower(sUser.Profile.Email),
+		}
+		if mapping.Email == "" {
+			mapping.Email = strings.ToLower(sUser.Username + "@example.com")
+			mlog.Warn("Slack Import: User does not have an email address in the Slack export. Used username as a placeholder. The user should update their email address once logged in to the system.", mlog.String("user_email", mapping.Email), mlog.String("user_name", sUser.Username))
+		}
+		report.Users = append(report.Users, mapping)
+
+		if existingUser, err := si.store.User().GetByEmail(mapping.Email); err == nil {
+			if _, appErr := si.actions.JoinUserToTeam(team, existingUser, ""); appErr != nil {
+				mlog.Warn("Slack Import: Unable to add an existing user to the team.", mlog.String("user_id", existingUser.Id), mlog.Err(appErr))
+			}
+			users[sUser.Id] = existingUser
+			mapping.UserId = existingUser.Id
+			mapping.Username = existingUser.Username
+			mapping.Status = model.SlackImportUserMatched
+			if prev, ok := previous[sUser.Id]; ok && prev.Status == model.SlackImportUserCreated {
+				mapping.Status = model.SlackImportUserCreated
+			}
+			continue
+		}
+
+		mUser := si.oldImportUser(team, &model.User{
+			Username:  sUser.Username,
+			FirstName: sUser.Profile.FirstName,
+			LastName:  sUser.Profile.LastName,
+			Email:     mapping.Email,
+			Password:  model.NewId(),
+		})
+		if mUser == nil {
+			mapping.Status = model.SlackImportUserFailed
+			continue
+		}
+		users[sUser.Id] = mUser
+		mapping.UserId = mUser.Id
+		mapping.Username = mUser.Username
+		mapping.Status = model.SlackImportUserCreated
+	}
+
+	return users
+}
+
+// getSlackBotUser returns the user the bot messages are imported as, the one of the import
+// being resumed if any.
+func (si *SlackImporter) getSlackBotUser(team *model.Team, report *model.SlackImportReport) *model.User {
+	if report.BotUserId != "" {
+		if user, err := si.store.User().Get(si.store.Context(), report.BotUserId); err == nil {
+			return user
+		}
+	}
+
+	var discardLog bytes.Buffer
+	botUser := si.slackAddBotUser(team.Id, &discardLog)
+	if botUser != nil {
+		report.BotUserId = botUser.Id
+	}
+	return botUser
+}
+
+// slackTimeStampBefore tells whether the Slack timestamp a, made of seconds and microseconds,
+// is before b.
+func slackTimeStampBefore(a, b string) bool {
+	aSeconds, aMicros := splitSlackTimeStamp(a)
+	bSeconds, bMicros := splitSlackTimeStamp(b)
+	if aSeconds != bSeconds {
+		return aSeconds < bSeconds
+	}
+	return aMicros < bMicros
+}
+
+func splitSlackTimeStamp(ts string) (int64, int64) {
+	parts := strings.SplitN(ts, ".", 2)
+	seconds, _ := strconv.ParseInt(parts[0], 10, 64)
+	var micros int64
+	if len(parts) == 2 {
+		micros, _ = strconv.ParseInt(parts[1], 10, 64)
+	}
+	return seconds, micros
+}
@@ -6,6 +6,7 @@ package slackimport
 import (
 	"archive/zip"
 	"bytes"
+	"errors"
 	"image"
 	"io"
 	"mime/multipart"
@@ -51,8 +52,15 @@ type slackUser struct {
 }
 
 type slackFile struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
+	Id                 string `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+type slackReaction struct {
+	Name  string   `json:"name"`
+	Users []string `json:"users"`
 }
 
 type slackPost struct {
@@ -62,6 +70,7 @@ type slackPost struct {
 	Text        string                   `json:"text"`
 	TimeStamp   string                   `json:"ts"`
 	ThreadTS    string                   `json:"thread_ts"`
+	ReplyCount  int                      `json:"reply_count"`
 	Type        string                   `json:"type"`
 	SubType     string                   `json:"subtype"`
 	Comment     *slackComment            `json:"comment"`
@@ -69,12 +78,15 @@ type slackPost struct {
 	File        *slackFile               `json:"file"`
 	Files       []*slackFile             `json:"files"`
 	Attachments []*model.SlackAttachment `json:"attachments"`
+	Reactions   []*slackReaction         `json:"reactions"`
 }
 
 var isValidChannelNameCharacters = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`).MatchString
 
 const slackImportMaxFileSize = 1024 * 1024 * 70
 
+var errFileMissing = errors.New("file is missing from the Slack export")
+
 type slackComment struct {
 	User    string `json:"user"`
 	Comment string `json:"comment"`
@@ -297,222 +309,263 @@ func (si *SlackImporter) slackAddPosts(teamId string, channel *model.Channel, po
 		return slackConvertTimeStamp(posts[i].TimeStamp) < slackConvertTimeStamp(posts[j].TimeStamp)
 	})
 	threads := make(map[string]string)
+	fetchFile := zipFileFetcher(uploads)
 	for _, sPost := range posts {
-		switch {
-		case sPost.Type == "message" && (sPost.SubType == "" || sPost.SubType == "file_share"):
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Text,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-			}
-			if sPost.Upload {
-				if sPost.File != nil {
-					if fileInfo, ok := si.slackUploadFile(sPost.File, uploads, teamId, newPost.ChannelId, newPost.UserId, sPost.TimeStamp); ok {
-						newPost.FileIds = append(newPost.FileIds, fileInfo.Id)
-					}
-				} else if sPost.Files != nil {
-					for _, file := range sPost.Files {
-						if fileInfo, ok := si.slackUploadFile(file, uploads, teamId, newPost.ChannelId, newPost.UserId, sPost.TimeStamp); ok {
-							newPost.FileIds = append(newPost.FileIds, fileInfo.Id)
-						}
-					}
-				}
-			}
-			// If post in thread
-			if sPost.ThreadTS != "" && sPost.ThreadTS != sPost.TimeStamp {
-				newPost.RootId = threads[sPost.ThreadTS]
-			}
-			postId := si.oldImportPost(&newPost)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
-		case sPost.Type == "message" && sPost.SubType == "file_comment":
-			if sPost.Comment == nil {
-				mlog.Debug("Slack Import: Unable to import the message as it has no comments.")
-				continue
-			}
-			if sPost.Comment.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.Comment.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.Comment.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Comment.Comment,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-			}
-			si.oldImportPost(&newPost)
-		case sPost.Type == "message" && sPost.SubType == "bot_message":
-			if botUser == nil {
-				mlog.Warn("Slack Import: Unable to import the bot message as the bot user does not exist.")
-				continue
-			}
-			if sPost.BotId == "" {
-				mlog.Warn("Slack Import: Unable to import bot message as the BotId field is missing.")
-				continue
-			}
+		si.slackAddPost(teamId, channel, sPost, users, fetchFile, botUser, threads)
+	}
+}
 
-			props := make(model.StringInterface)
-			props["override_username"] = sPost.BotUsername
-			if len(sPost.Attachments) > 0 {
-				props["attachments"] = sPost.Attachments
-			}
+// importedPost tells what was imported of a Slack message.
+type importedPost struct {
+	postId      string
+	reply       bool
+	reactions   int
+	files       int
+	failedFiles int
+}
 
-			post := &model.Post{
-				UserId:    botUser.Id,
-				ChannelId: channel.Id,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-				Message:   sPost.Text,
-				Type:      model.PostTypeSlackAttachment,
+// slackAddPost imports the Slack message to the channel. The posts the threads started so far
+// are tracked by their Slack timestamp in threads.
+func (si *SlackImporter) slackAddPost(teamId string, channel *model.Channel, sPost slackPost, users map[string]*model.User, fetchFile fileFetcher, botUser *model.User, threads map[string]string) importedPost {
+	var result importedPost
+	switch {
+	case sPost.Type == "message" && (sPost.SubType == "" || sPost.SubType == "file_share"):
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Text,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+		}
+		var files []*slackFile
+		if sPost.Upload && sPost.File != nil {
+			files = []*slackFile{sPost.File}
+		} else if sPost.Upload || len(sPost.Files) > 0 {
+			files = sPost.Files
+		}
+		for _, file := range files {
+			if fileInfo, ok := si.slackUploadFile(file, fetchFile, teamId, newPost.ChannelId, newPost.UserId, sPost.TimeStamp); ok {
+				newPost.FileIds = append(newPost.FileIds, fileInfo.Id)
+				result.files++
+			} else {
+				result.failedFiles++
 			}
+		}
+		// If post in thread
+		if sPost.ThreadTS != "" && sPost.ThreadTS != sPost.TimeStamp {
+			newPost.RootId = si.slackThreadRootId(channel.Id, sPost.ThreadTS, threads)
+			result.reply = newPost.RootId != ""
+		}
+		result.postId = si.oldImportPost(&newPost)
+		// If post is thread starter
+		if sPost.ThreadTS == sPost.TimeStamp {
+			threads[sPost.ThreadTS] = result.postId
+		}
+	case sPost.Type == "message" && sPost.SubType == "file_comment":
+		if sPost.Comment == nil {
+			mlog.Debug("Slack Import: Unable to import the message as it has no comments.")
+			return result
+		}
+		if sPost.Comment.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.Comment.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+		newPost := model.Post{
+			UserId:    users[sPost.Comment.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Comment.Comment,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+		}
+		result.postId = si.oldImportPost(&newPost)
+	case sPost.Type == "message" && sPost.SubType == "bot_message":
+		if botUser == nil {
+			mlog.Warn("Slack Import: Unable to import the bot message as the bot user does not exist.")
+			return result
+		}
+		if sPost.BotId == "" {
+			mlog.Warn("Slack Import: Unable to import bot message as the BotId field is missing.")
+			return result
+		}
 
-			postId := si.oldImportIncomingWebhookPost(post, props)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
-		case sPost.Type == "message" && (sPost.SubType == "channel_join" || sPost.SubType == "channel_leave"):
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
+		props := make(model.StringInterface)
+		props["override_username"] = sPost.BotUsername
+		if len(sPost.Attachments) > 0 {
+			props["attachments"] = sPost.Attachments
+		}
 
-			var postType string
-			if sPost.SubType == "channel_join" {
-				postType = model.PostTypeJoinChannel
-			} else {
-				postType = model.PostTypeLeaveChannel
-			}
+		post := &model.Post{
+			UserId:    botUser.Id,
+			ChannelId: channel.Id,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			Message:   sPost.Text,
+			Type:      model.PostTypeSlackAttachment,
+		}
 
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Text,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-				Type:      postType,
-				Props: model.StringInterface{
-					"username": users[sPost.User].Username,
-				},
-			}
-			si.oldImportPost(&newPost)
-		case sPost.Type == "message" && sPost.SubType == "me_message":
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   "*" + sPost.Text + "*",
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-			}
-			postId := si.oldImportPost(&newPost)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
-		case sPost.Type == "message" && sPost.SubType == "channel_topic":
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Text,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-				Type:      model.PostTypeHeaderChange,
-			}
-			si.oldImportPost(&newPost)
-		case sPost.Type == "message" && sPost.SubType == "channel_purpose":
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Text,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-				Type:      model.PostTypePurposeChange,
-			}
-			si.oldImportPost(&newPost)
-		case sPost.Type == "message" && sPost.SubType == "channel_name":
-			if sPost.User == "" {
-				mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
-				continue
-			}
-			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
-				ChannelId: channel.Id,
-				Message:   sPost.Text,
-				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
-				Type:      model.PostTypeDisplaynameChange,
-			}
-			si.oldImportPost(&newPost)
-		default:
-			mlog.Warn(
-				"Slack Import: Unable to import the message as its type is not supported",
-				mlog.String("post_type", sPost.Type),
-				mlog.String("post_subtype", sPost.SubType),
-			)
+		result.postId = si.oldImportIncomingWebhookPost(post, props)
+		// If post is thread starter
+		if sPost.ThreadTS == sPost.TimeStamp {
+			threads[sPost.ThreadTS] = result.postId
+		}
+	case sPost.Type == "message" && (sPost.SubType == "channel_join" || sPost.SubType == "channel_leave"):
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+
+		var postType string
+		if sPost.SubType == "channel_join" {
+			postType = model.PostTypeJoinChannel
+		} else {
+			postType = model.PostTypeLeaveChannel
+		}
+
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Text,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			Type:      postType,
+			Props: model.StringInterface{
+				"username": users[sPost.User].Username,
+			},
+		}
+		result.postId = si.oldImportPost(&newPost)
+	case sPost.Type == "message" && sPost.SubType == "me_message":
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   "*" + sPost.Text + "*",
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+		}
+		result.postId = si.oldImportPost(&newPost)
+		// If post is thread starter
+		if sPost.ThreadTS == sPost.TimeStamp {
+			threads[sPost.ThreadTS] = result.postId
+		}
+	case sPost.Type == "message" && sPost.SubType == "channel_topic":
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Text,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			Type:      model.PostTypeHeaderChange,
+		}
+		result.postId = si.oldImportPost(&newPost)
+	case sPost.Type == "message" && sPost.SubType == "channel_purpose":
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
 		}
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Text,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			Type:      model.PostTypePurposeChange,
+		}
+		result.postId = si.oldImportPost(&newPost)
+	case sPost.Type == "message" && sPost.SubType == "channel_name":
+		if sPost.User == "" {
+			mlog.Debug("Slack Import: Unable to import the message as the user field is missing.")
+			return result
+		}
+		if users[sPost.User] == nil {
+			mlog.Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			return result
+		}
+		newPost := model.Post{
+			UserId:    users[sPost.User].Id,
+			ChannelId: channel.Id,
+			Message:   sPost.Text,
+			CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			Type:      model.PostTypeDisplaynameChange,
+		}
+		result.postId = si.oldImportPost(&newPost)
+	default:
+		mlog.Warn(
+			"Slack Import: Unable to import the message as its type is not supported",
+			mlog.String("post_type", sPost.Type),
+			mlog.String("post_subtype", sPost.SubType),
+		)
+	}
+
+	if result.postId != "" {
+		result.reactions = si.slackAddReactions(result.postId, sPost, users)
 	}
+
+	return result
 }
 
-func (si *SlackImporter) slackUploadFile(slackPostFile *slackFile, uploads map[string]*zip.File, teamId string, channelId string, userId string, slackTimestamp string) (*model.FileInfo, bool) {
+// fileFetcher opens the content of a Slack file, returning its name too.
+type fileFetcher func(file *slackFile) (io.ReadCloser, string, error)
+
+// zipFileFetcher fetches the files uploaded to the __uploads directory of a Slack export.
+func zipFileFetcher(uploads map[string]*zip.File) fileFetcher {
+	return func(slackPostFile *slackFile) (io.ReadCloser, string, error) {
+		file, ok := uploads[slackPostFile.Id]
+		if !ok {
+			return nil, "", errFileMissing
+		}
+		openFile, err := file.Open()
+		if err != nil {
+			return nil, "", err
+		}
+		return openFile, filepath.Base(file.Name), nil
+	}
+}
+
+func (si *SlackImporter) slackUploadFile(slackPostFile *slackFile, fetchFile fileFetcher, teamId string, channelId string, userId string, slackTimestamp string) (*model.FileInfo, bool) {
 	if slackPostFile == nil {
 		mlog.Warn("Slack Import: Unable to attach the file to the post as the latter has no file section present in Slack export.")
 		return nil, false
 	}
-	file, ok := uploads[slackPostFile.Id]
-	if !ok {
+	openFile, fileName, err := fetchFile(slackPostFile)
+	if err == errFileMissing {
 		mlog.Warn("Slack Import: Unable to import file as the file is missing from the Slack export zip file.", mlog.String("file_id", slackPostFile.Id))
 		return nil, false
-	}
-	openFile, err := file.Open()
-	if err != nil {
+	} else if err != nil {
 		mlog.Warn("Slack Import: Unable to open the file from the Slack export.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
 		return nil, false
 	}
 	defer openFile.Close()
 
 	timestamp := utils.TimeFromMillis(slackConvertTimeStamp(slackTimestamp))
-	uploadedFile, err := si.oldImportFile(timestamp, openFile, teamId, channelId, userId, filepath.Base(file.Name))
+	uploadedFile, err := si.oldImportFile(timestamp, openFile, teamId, channelId, userId, fileName)
 	if err != nil {
 		mlog.Warn("Slack Import: An error occurred when uploading file.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
 		return nil, false
@@ -521,6 +574,56 @@ func (si *SlackImporter) slackUploadFile(slackPostFile *slackFile, uploads map[s
 	return uploadedFile, true
 }
 
+// slackThreadRootId returns the post the Slack thread was imported as. A thread started
+// before an import was resumed is looked up by the time of its first message.
+func (si *SlackImporter) slackThreadRootId(channelId string, threadTS string, threads map[string]string) string {
+	if rootId, ok := threads[threadTS]; ok {
+		return rootId
+	}
+
+	posts, err := si.store.Post().GetPostsCreatedAt(channelId, slackConvertTimeStamp(threadTS))
+	if err != nil {
+		mlog.Warn("Slack Import: Unable to look up the first message of a thread.", mlog.String("thread_ts", threadTS), mlog.Err(err))
+		return ""
+	}
+	for _, post := range posts {
+		if post.RootId == "" {
+			threads[threadTS] = post.Id
+			return post.Id
+		}
+	}
+
+	return ""
+}
+
+// slackAddReactions adds the reactions of the Slack message to its post, returning how many
+// were added.
+func (si *SlackImporter) slackAddReactions(postId string, sPost slackPost, users map[string]*model.User) int {
+	var added int
+	for _, sReaction := range sPost.Reactions {
+		emojiName := slackConvertEmojiName(sReaction.Name)
+		for _, slackUserId := range sReaction.Users {
+			user := users[slackUserId]
+			if user == nil {
+				continue
+			}
+			reaction := &model.Reaction{
+				UserId:    user.Id,
+				PostId:    postId,
+				EmojiName: emojiName,
+				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			}
+			if _, err := si.store.Reaction().Save(reaction); err != nil {
+				mlog.Debug("Slack Import: Unable to add a reaction.", mlog.String("emoji_name", emojiName), mlog.Err(err))
+				continue
+			}
+			added++
+		}
+	}
+
+	return added
+}
+
 func (si *SlackImporter) deactivateSlackBotUser(user *model.User) {
 	if _, err := si.actions.UpdateActive(user, false); err != nil {
 		mlog.Warn("Slack Import: Unable to deactivate the user account used for the bot.")
@@ -571,53 +674,73 @@ func (si *SlackImporter) slackAddChannels(teamId string, slackchannels []slackCh
 
 	addedChannels := make(map[string]*model.Channel)
 	for _, sChannel := range slackchannels {
-		newChannel := model.Channel{
-			TeamId:      teamId,
-			Type:        sChannel.Type,
-			DisplayName: sChannel.Name,
-			Name:        slackConvertChannelName(sChannel.Name, sChannel.Id),
-			Purpose:     sChannel.Purpose.Value,
-			Header:      sChannel.Topic.Value,
+		mChannel, _ := si.slackAddChannel(teamId, sChannel, users, importerLog)
+		if mChannel == nil {
+			continue
 		}
 
-		// Direct message channels in Slack don't have a name so we set the id as name or else the messages won't get imported.
-		if newChannel.Type == model.ChannelTypeDirect {
-			sChannel.Name = sChannel.Id
-		}
+		addedChannels[sChannel.Id] = mChannel
+		si.slackAddPosts(teamId, mChannel, posts[slackChannelKey(sChannel)], users, uploads, botUser)
+	}
 
-		newChannel = slackSanitiseChannelProperties(newChannel)
+	return addedChannels
+}
 
-		var mChannel *model.Channel
-		var err error
-		if mChannel, err = si.store.Channel().GetByName(teamId, sChannel.Name, true); err == nil {
-			// The channel already exists as an active channel. Merge with the existing one.
-			importerLog.WriteString(i18n.T("api.slackimport.slack_add_channels.merge", map[string]interface{}{"DisplayName": newChannel.DisplayName}))
-		} else if _, nErr := si.store.Channel().GetDeletedByName(teamId, sChannel.Name); nErr == nil {
-			// The channel already exists but has been deleted. Generate a random string for the handle instead.
-			newChannel.Name = model.NewId()
-			newChannel = slackSanitiseChannelProperties(newChannel)
-		}
+// slackChannelKey returns what the messages of the channel are filed under in a Slack export.
+// Direct message channels in Slack don't have a name so their id is used instead.
+func slackChannelKey(sChannel slackChannel) string {
+	if sChannel.Type == model.ChannelTypeDirect {
+		return sChannel.Id
+	}
+	return sChannel.Name
+}
 
+// slackAddChannel imports the Slack channel, or merges it with the active channel of the same
+// name. It returns the channel and whether it already existed, or nil when it failed.
+func (si *SlackImporter) slackAddChannel(teamId string, sChannel slackChannel, users map[string]*model.User, importerLog *bytes.Buffer) (*model.Channel, bool) {
+	newChannel := model.Channel{
+		TeamId:      teamId,
+		Type:        sChannel.Type,
+		DisplayName: sChannel.Name,
+		Name:        slackConvertChannelName(sChannel.Name, sChannel.Id),
+		Purpose:     sChannel.Purpose.Value,
+		Header:      sChannel.Topic.Value,
+	}
+
+	sChannel.Name = slackChannelKey(sChannel)
+
+	newChannel = slackSanitiseChannelProperties(newChannel)
+
+	var mChannel *model.Channel
+	var err error
+	merged := false
+	if mChannel, err = si.store.Channel().GetByName(teamId, sChannel.Name, true); err == nil {
+		// The channel already exists as an active channel. Merge with the existing one.
+		importerLog.WriteString(i18n.T("api.slackimport.slack_add_channels.merge", map[string]interface{}{"DisplayName": newChannel.DisplayName}))
+		merged = true
+	} else if _, nErr := si.store.Channel().GetDeletedByName(teamId, sChannel.Name); nErr == nil {
+		// The channel already exists but has been deleted. Generate a random string for the handle instead.
+		newChannel.Name = model.NewId()
+		newChannel = slackSanitiseChannelProperties(newChannel)
+	}
+
+	if mChannel == nil {
+		// Haven't found an existing channel to merge with. Try importing it as a new one.
+		mChannel = si.oldImportChannel(&newChannel, sChannel, users)
 		if mChannel == nil {
-			// Haven't found an existing channel to merge with. Try importing it as a new one.
-			mChannel = si.oldImportChannel(&newChannel, sChannel, users)
-			if mChannel == nil {
-				mlog.Warn("Slack Import: Unable to import Slack channel.", mlog.String("channel_display_name", newChannel.DisplayName))
-				importerLog.WriteString(i18n.T("api.slackimport.slack_add_channels.import_failed", map[string]interface{}{"DisplayName": newChannel.DisplayName}))
-				continue
-			}
+			mlog.Warn("Slack Import: Unable to import Slack channel.", mlog.String("channel_display_name", newChannel.DisplayName))
+			importerLog.WriteString(i18n.T("api.slackimport.slack_add_channels.import_failed", map[string]interface{}{"DisplayName": newChannel.DisplayName}))
+			return nil, false
 		}
+	}
 
-		// Members for direct and group channels are added during the creation of the channel in the oldImportChannel function
-		if sChannel.Type == model.ChannelTypeOpen || sChannel.Type == model.ChannelTypePrivate {
-			si.addSlackUsersToChannel(sChannel.Members, users, mChannel, importerLog)
-		}
-		importerLog.WriteString(newChannel.DisplayName + "\r\n")
-		addedChannels[sChannel.Id] = mChannel
-		si.slackAddPosts(teamId, mChannel, posts[sChannel.Name], users, uploads, botUser)
+	// Members for direct and group channels are added during the creation of the channel in the oldImportChannel function
+	if sChannel.Type == model.ChannelTypeOpen || sChannel.Type == model.ChannelTypePrivate {
+		si.addSlackUsersToChannel(sChannel.Members, users, mChannel, importerLog)
 	}
+	importerLog.WriteString(newChannel.DisplayName + "\r\n")
 
-	return addedChannels
+	return mChannel, merged
 }
 
 //
@@ -386,3 +386,8 @@ func TestOldImportChannel(t *testing.T) {
 		_ = importer.oldImportChannel(ch, sCh, users)
 	})
 }
+
+func TestSlackConvertEmojiName(t *testing.T) {
+	assert.Equal(t, "thumbsup", slackConvertEmojiName("thumbsup"))
+	assert.Equal(t, "wave", slackConvertEmojiName("wave::skin-tone-2"))
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slackimport
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+const (
+	slackAPIURL               = "https://slack.com/api/"
+	slackAPIPageSize          = 200
+	slackAPIMaxRetries        = 5
+	slackAPIDefaultRetryAfter = time.Second
+	slackAPIMaxRetryAfter     = time.Minute
+)
+
+// Source reads the users, channels, messages and files of a Slack workspace, either from an
+// export or through the Slack API.
+type Source interface {
+	Users() ([]slackUser, error)
+	Channels() ([]slackChannel, error)
+	Posts(sChannel slackChannel) ([]slackPost, error)
+	File(file *slackFile) (io.ReadCloser, string, error)
+}
+
+type zipSource struct {
+	client   *http.Client
+	users    []slackUser
+	channels []slackChannel
+	posts    map[string][]*zip.File
+	uploads  map[string]*zip.File
+}
+
+// NewZipSource reads a Slack export. The files missing from the export are fetched from Slack
+// with the client, by the links the export has for them.
+func NewZipSource(reader io.ReaderAt, size int64, client *http.Client) (Source, error) {
+	zipReader, err := zip.NewReader(reader, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the Slack export")
+	}
+
+	source := &zipSource{
+		client:  client,
+		posts:   make(map[string][]*zip.File),
+		uploads: make(map[string]*zip.File),
+	}
+
+	channelFiles := map[string]model.ChannelType{
+		"channels.json": model.ChannelTypeOpen,
+		"groups.json":   model.ChannelTypePrivate,
+		"dms.json":      model.ChannelTypeDirect,
+		"mpims.json":    model.ChannelTypeGroup,
+	}
+	for _, file := range zipReader.File {
+		if strings.Contains(file.Name, "..") {
+			continue
+		}
+
+		if channelType, ok := channelFiles[file.Name]; ok {
+			reader, err := openZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			// As with the synchronous import, the parts that could be parsed are imported.
+			channels, _ := slackParseChannels(reader, channelType)
+			reader.Close()
+			source.channels = append(source.channels, channels...)
+			continue
+		}
+
+		if file.Name == "users.json" {
+			reader, err := openZipFile(file)
+			if err != nil {
+				return nil, err
+			}
+			source.users, _ = slackParseUsers(reader)
+			reader.Close()
+			continue
+		}
+
+		spl := strings.Split(file.Name, "/")
+		if len(spl) == 2 && strings.HasSuffix(spl[1], ".json") {
+			source.posts[spl[0]] = append(source.posts[spl[0]], file)
+		} else if len(spl) == 3 && spl[0] == "__uploads" {
+			source.uploads[spl[1]] = file
+		}
+	}
+
+	// The messages of a channel are exported day by day, in files named by the date.
+	for _, files := range source.posts {
+		sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	}
+
+	return source, nil
+}
+
+func openZipFile(file *zip.File) (io.ReadCloser, error) {
+	if file.UncompressedSize64 > slackImportMaxFileSize {
+		return nil, errors.Errorf("file %s of the Slack export is too large", file.Name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open file %s of the Slack export", file.Name)
+	}
+	return reader, nil
+}
+
+func (s *zipSource) Users() ([]slackUser, error) {
+	return s.users, nil
+}
+
+func (s *zipSource) Channels() ([]slackChannel, error) {
+	return s.channels, nil
+}
+
+func (s *zipSource) Posts(sChannel slackChannel) ([]slackPost, error) {
+	var posts []slackPost
+	for _, file := range s.posts[slackChannelKey(sChannel)] {
+		reader, err := openZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		filePosts, _ := slackParsePosts(reader)
+		reader.Close()
+		posts = append(posts, filePosts...)
+	}
+	return posts, nil
+}
+
+func (s *zipSource) File(file *slackFile) (io.ReadCloser, string, error) {
+	if _, ok := s.uploads[file.Id]; ok {
+		return zipFileFetcher(s.uploads)(file)
+	}
+
+	// Recent exports link to the files instead of including them, the links carrying what
+	// is needed to download them.
+	if file.URLPrivateDownload == "" {
+		return nil, "", errFileMissing
+	}
+	return downloadSlackFile(s.client, file, "")
+}
+
+type apiSource struct {
+	client  *http.Client
+	token   string
+	baseURL string
+}
+
+// NewAPISource reads the workspace through the Slack API, with a token granted the
+// channels:history, channels:read, groups:history, groups:read, im:history, im:read,
+// mpim:history, mpim:read, files:read, users:read and users:read.email scopes.
+func NewAPISource(token string, client *http.Client) Source {
+	return &apiSource{
+		client:  client,
+		token:   token,
+		baseURL: slackAPIURL,
+	}
+}
+
+type slackAPIResponse struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+type slackAPIConversation struct {
+	slackChannel
+	IsIM      bool `json:"is_im"`
+	IsMPIM    bool `json:"is_mpim"`
+	IsPrivate bool `json:"is_private"`
+}
+
+// call calls the method of the Slack API, decoding its response into out. The calls that are
+// rate limited are retried once Slack allows it.
+func (s *apiSource) call(method string, params url.Values, out interface{}) (string, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest("GET", s.baseURL+method+"?"+params.Encode(), nil)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to build Slack API request %s", method)
+		}
+		req.Header.Set("Authorization", "Bearer "+s.token)
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to call Slack API %s", method)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < slackAPIMaxRetries {
+			resp.Body.Close()
+			wait := slackAPIDefaultRetryAfter
+			if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && retryAfter >= 0 {
+				wait = time.Duration(retryAfter) * time.Second
+			}
+			if wait > slackAPIMaxRetryAfter {
+				wait = slackAPIMaxRetryAfter
+			}
+			time.Sleep(wait)
+			continue
+		}
+
+		cursor, err := decodeSlackAPIResponse(method, resp, out)
+		resp.Body.Close()
+		return cursor, err
+	}
+}
+
+func decodeSlackAPIResponse(method string, resp *http.Response, out interface{}) (string, error) {
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("Slack API %s failed with status code %d", method, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read Slack API %s response", method)
+	}
+
+	var status slackAPIResponse
+	if err := json.Unmarshal(body, &status); err != nil {
+		return "", errors.Wrapf(err, "failed to decode Slack API %s response", method)
+	}
+	if !status.OK {
+		return "", errors.Errorf("Slack API %s failed: %s", method, status.Error)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return "", errors.Wrapf(err, "failed to decode Slack API %s response", method)
+	}
+
+	return status.ResponseMetadata.NextCursor, nil
+}
+
+// paginate calls the method of the Slack API for each page of its results, decoded into page
+// before collect is called.
+func (s *apiSource) paginate(method string, params url.Values, page interface{}, collect func()) error {
+	params.Set("limit", strconv.Itoa(slackAPIPageSize))
+	for {
+		cursor, err := s.call(method, params, page)
+		if err != nil {
+			return err
+		}
+		collect()
+		if cursor == "" {
+			return nil
+		}
+		params.Set("cursor", cursor)
+	}
+}
+
+func (s *apiSource) Users() ([]slackUser, error) {
+	var users []slackUser
+	var page struct {
+		Members []slackUser `json:"members"`
+	}
+	err := s.paginate("users.list", url.Values{}, &page, func() { users = append(users, page.Members...) })
+	return users, err
+}
+
+func (s *apiSource) Channels() ([]slackChannel, error) {
+	var conversations []slackAPIConversation
+	var page struct {
+		Channels []slackAPIConversation `json:"channels"`
+	}
+	params := url.Values{"types": {"public_channel,private_channel,mpim,im"}}
+	err := s.paginate("conversations.list", params, &page, func() { conversations = append(conversations, page.Channels...) })
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]slackChannel, 0, len(conversations))
+	for _, conversation := range conversations {
+		sChannel := conversation.slackChannel
+		switch {
+		case conversation.IsIM:
+			sChannel.Type = model.ChannelTypeDirect
+		case conversation.IsMPIM:
+			sChannel.Type = model.ChannelTypeGroup
+		case conversation.IsPrivate:
+			sChannel.Type = model.ChannelTypePrivate
+		default:
+			sChannel.Type = model.ChannelTypeOpen
+		}
+
+		members, err := s.members(sChannel.Id)
+		if err != nil {
+			return nil, err
+		}
+		sChannel.Members = members
+
+		channels = append(channels, sChannel)
+	}
+
+	return channels, nil
+}
+
+func (s *apiSource) members(channelID string) ([]string, error) {
+	var members []string
+	var page struct {
+		Members []string `json:"members"`
+	}
+	err := s.paginate("conversations.members", url.Values{"channel": {channelID}}, &page, func() { members = append(members, page.Members...) })
+	return members, err
+}
+
+// Posts returns the messages of the channel along with the replies to its threads.
+func (s *apiSource) Posts(sChannel slackChannel) ([]slackPost, error) {
+	posts, err := s.messages("conversations.history", url.Values{"channel": {sChannel.Id}})
+	if err != nil {
+		return nil, err
+	}
+
+	var replies []slackPost
+	for _, sPost := range posts {
+		if sPost.ReplyCount == 0 || sPost.ThreadTS != sPost.TimeStamp {
+			continue
+		}
+
+		thread, err := s.messages("conversations.replies", url.Values{"channel": {sChannel.Id}, "ts": {sPost.ThreadTS}})
+		if err != nil {
+			return nil, err
+		}
+		for _, reply := range thread {
+			if reply.TimeStamp != sPost.TimeStamp {
+				replies = append(replies, reply)
+			}
+		}
+	}
+
+	return append(posts, replies...), nil
+}
+
+func (s *apiSource) messages(method string, params url.Values) ([]slackPost, error) {
+	var posts []slackPost
+	var page struct {
+		Messages []slackPost `json:"messages"`
+	}
+	err := s.paginate(method, params, &page, func() { posts = append(posts, page.Messages...) })
+	return posts, err
+}
+
+func (s *apiSource) File(file *slackFile) (io.ReadCloser, string, error) {
+	if file.URLPrivateDownload == "" {
+		return nil, "", errFileMissing
+	}
+	return downloadSlackFile(s.client, file, s.token)
+}
+
+// downloadSlackFile fetches the file from Slack, authenticated by the token when given.
+func downloadSlackFile(client *http.Client, file *slackFile, token string) (io.ReadCloser, string, error) {
+	req, err := http.NewRequest("GET", file.URLPrivateDownload, nil)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to build request for file %s", file.Id)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to download file %s", file.Id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, "", errors.Errorf("failed to download file %s: status code %d", file.Id, resp.StatusCode)
+	}
+	if resp.ContentLength > slackImportMaxFileSize {
+		resp.Body.Close()
+		return nil, "", errors.Errorf("file %s is too large", file.Id)
+	}
+
+	name := file.Name
+	if name == "" {
+		name = path.Base(req.URL.Path)
+	}
+
+	return &limitedReadCloser{
+		Reader: io.LimitReader(resp.Body, slackImportMaxFileSize),
+		Closer: resp.Body,
+	}, name, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slackimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func TestZipSource(t *testing.T) {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"users.json":                 `[{"id": "U1", "name": "alice", "profile": {"email": "alice@example.com"}}]`,
+		"channels.json":              `[{"id": "C1", "name": "general", "members": ["U1"]}]`,
+		"dms.json":                   `[{"id": "D1", "members": ["U1", "U1"]}]`,
+		"general/2021-01-02.json":    `[{"type": "message", "user": "U1", "text": "second", "ts": "1609545600.000100"}]`,
+		"general/2021-01-01.json":    `[{"type": "message", "user": "U1", "text": "first", "ts": "1609459200.000100"}]`,
+		"D1/2021-01-01.json":         `[{"type": "message", "user": "U1", "text": "direct", "ts": "1609459200.000200"}]`,
+		"__uploads/F1/file.txt":      "content",
+		"../general/2021-01-03.json": `[{"type": "message", "user": "U1", "text": "outside", "ts": "1609632000.000100"}]`,
+	} {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	source, err := NewZipSource(bytes.NewReader(buf.Bytes()), int64(buf.Len()), http.DefaultClient)
+	require.NoError(t, err)
+
+	users, err := source.Users()
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "alice@example.com", users[0].Profile.Email)
+
+	channels, err := source.Channels()
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+
+	for _, sChannel := range channels {
+		posts, err := source.Posts(sChannel)
+		require.NoError(t, err)
+		switch sChannel.Type {
+		case model.ChannelTypeOpen:
+			require.Len(t, posts, 2)
+			assert.Equal(t, "first", posts[0].Text)
+			assert.Equal(t, "second", posts[1].Text)
+		case model.ChannelTypeDirect:
+			require.Len(t, posts, 1)
+			assert.Equal(t, "direct", posts[0].Text)
+		}
+	}
+
+	t.Run("file of the export", func(t *testing.T) {
+		reader, name, err := source.File(&slackFile{Id: "F1", Name: "file.txt"})
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, "file.txt", name)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+	})
+
+	t.Run("file missing from the export", func(t *testing.T) {
+		_, _, err := source.File(&slackFile{Id: "F2", Name: "missing.txt"})
+		assert.Equal(t, errFileMissing, err)
+	})
+
+	t.Run("file linked by the export", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			w.Write([]byte("linked"))
+		}))
+		defer server.Close()
+
+		reader, name, err := source.File(&slackFile{Id: "F3", URLPrivateDownload: server.URL + "/files/linked.txt"})
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, "linked.txt", name)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "linked", string(content))
+	})
+}
+
+func TestAPISource(t *testing.T) {
+	var rateLimited int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/users.list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		if atomic.CompareAndSwapInt32(&rateLimited, 0, 1) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"ok": true, "members": [{"id": "U1", "name": "alice"}], "response_metadata": {"next_cursor": "page2"}}`))
+			return
+		}
+		assert.Equal(t, "page2", r.URL.Query().Get("cursor"))
+		w.Write([]byte(`{"ok": true, "members": [{"id": "U2", "name": "bob"}]}`))
+	})
+	mux.HandleFunc("/api/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok": true, "channels": [
+			{"id": "C1", "name": "general"},
+			{"id": "G1", "name": "secret", "is_private": true},
+			{"id": "D1", "is_im": true},
+			{"id": "M1", "name": "mpdm-alice--bob-1", "is_mpim": true}
+		]}`))
+	})
+	mux.HandleFunc("/api/conversations.members", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok": true, "members": ["U1", "U2"]}`))
+	})
+	mux.HandleFunc("/api/conversations.history", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C1", r.URL.Query().Get("channel"))
+		w.Write([]byte(`{"ok": true, "messages": [
+			{"type": "message", "user": "U1", "text": "root", "ts": "1.000001", "thread_ts": "1.000001", "reply_count": 1},
+			{"type": "message", "user": "U2", "text": "alone", "ts": "2.000001"}
+		]}`))
+	})
+	mux.HandleFunc("/api/conversations.replies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1.000001", r.URL.Query().Get("ts"))
+		w.Write([]byte(`{"ok": true, "messages": [
+			{"type": "message", "user": "U1", "text": "root", "ts": "1.000001", "thread_ts": "1.000001", "reply_count": 1},
+			{"type": "message", "user": "U2", "text": "reply", "ts": "3.000001", "thread_ts": "1.000001"}
+		]}`))
+	})
+	mux.HandleFunc("/api/team.info", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok": false, "error": "missing_scope"}`))
+	})
+	mux.HandleFunc("/files/F1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		w.Write([]byte("content"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := NewAPISource("xoxb-token", server.Client()).(*apiSource)
+	source.baseURL = server.URL + "/api/"
+
+	t.Run("users across pages and rate limits", func(t *testing.T) {
+		users, err := source.Users()
+		require.NoError(t, err)
+		require.Len(t, users, 2)
+		assert.Equal(t, "alice", users[0].Username)
+		assert.Equal(t, "bob", users[1].Username)
+	})
+
+	t.Run("channels", func(t *testing.T) {
+		channels, err := source.Channels()
+		require.NoError(t, err)
+		require.Len(t, channels, 4)
+		assert.Equal(t, model.ChannelTypeOpen, channels[0].Type)
+		assert.Equal(t, model.ChannelTypePrivate, channels[1].Type)
+		assert.Equal(t, model.ChannelTypeDirect, channels[2].Type)
+		assert.Equal(t, model.ChannelTypeGroup, channels[3].Type)
+		assert.Equal(t, []string{"U1", "U2"}, channels[0].Members)
+	})
+
+	t.Run("posts with replies", func(t *testing.T) {
+		posts, err := source.Posts(slackChannel{Id: "C1"})
+		require.NoError(t, err)
+		require.Len(t, posts, 3)
+		assert.Equal(t, "reply", posts[2].Text)
+		assert.Equal(t, "1.000001", posts[2].ThreadTS)
+	})
+
+	t.Run("failed call", func(t *testing.T) {
+		var out struct{}
+		_, err := source.call("team.info", nil, &out)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing_scope")
+	})
+
+	t.Run("file", func(t *testing.T) {
+		reader, name, err := source.File(&slackFile{Id: "F1", Name: "file.txt", URLPrivateDownload: server.URL + "/files/F1"})
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, "file.txt", name)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+
+		_, _, err = source.File(&slackFile{Id: "F2"})
+		assert.Equal(t, errFileMissing, err)
+	})
+}
+
+func TestSlackTimeStampBefore(t *testing.T) {
+	assert.True(t, slackTimeStampBefore("1469785419.000033", "1469785419.000100"))
+	assert.True(t, slackTimeStampBefore("999999999.000001", "1469785419.000001"))
+	assert.False(t, slackTimeStampBefore("1469785419.000033", "1469785419.000033"))
+	assert.False(t, slackTimeStampBefore("1469785420.000001", "1469785419.999999"))
+}
@@ -66,6 +66,9 @@ const (
 	TrackConfigImageProxy        = "config_image_proxy"
 	TrackConfigBleve             = "config_bleve"
 	TrackConfigExport            = "config_export"
+	TrackConfigModeration        = "config_moderation"
+	TrackConfigCache             = "config_cache"
+	TrackConfigRESTProxyBridge   = "config_rest_proxy_bridge"
 	TrackFeatureFlags            = "config_feature_flags"
 	TrackPermissionsGeneral      = "permissions_general"
 	TrackPermissionsSystemScheme = "permissions_system_scheme"
@@ -383,6 +386,11 @@ func (ts *TelemetryService) trackConfig() {
 		"uses_letsencrypt":                                        *cfg.ServiceSettings.UseLetsEncrypt,
 		"forward_80_to_443":                                       *cfg.ServiceSettings.Forward80To443,
 		"maximum_login_attempts":                                  *cfg.ServiceSettings.MaximumLoginAttempts,
+		"health_check_database_latency_threshold":                 *cfg.ServiceSettings.HealthCheckDatabaseLatencyThresholdMilliseconds,
+		"health_check_filestore_latency_threshold":                *cfg.ServiceSettings.HealthCheckFileStoreLatencyThresholdMilliseconds,
+		"health_check_smtp_latency_threshold":                     *cfg.ServiceSettings.HealthCheckSMTPLatencyThresholdMilliseconds,
+		"health_check_min_cluster_nodes":                          *cfg.ServiceSettings.HealthCheckMinClusterNodes,
+		"health_check_required_probes":                            len(cfg.ServiceSettings.HealthCheckRequiredProbes),
 		"extend_session_length_with_activity":                     *cfg.ServiceSettings.ExtendSessionLengthWithActivity,
 		"session_length_web_in_days":                              *cfg.ServiceSettings.SessionLengthWebInDays,
 		"session_length_mobile_in_days":                           *cfg.ServiceSettings.SessionLengthMobileInDays,
@@ -437,29 +445,48 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_file_search":                                      *cfg.ServiceSettings.EnableFileSearch,
 		"restrict_link_previews":                                  isDefault(*cfg.ServiceSettings.RestrictLinkPreviews, ""),
 		"enable_custom_groups":                                    *cfg.ServiceSettings.EnableCustomGroups,
+		"enable_api_usage_tracking":                               *cfg.ServiceSettings.EnableAPIUsageTracking,
+		"enable_post_priority":                                    *cfg.ServiceSettings.EnablePostPriority,
+		"allow_urgent_posts_to_bypass_dnd":                        *cfg.ServiceSettings.AllowUrgentPostsToBypassDND,
+		"enable_permission_denial_log":                            *cfg.ServiceSettings.EnablePermissionDenialLog,
+		"enable_impersonation":                                    *cfg.ServiceSettings.EnableImpersonation,
+		"require_impersonation_consent":                           *cfg.ServiceSettings.RequireImpersonationConsent,
+		"enable_scheduled_channel_messages":                       *cfg.ServiceSettings.EnableScheduledChannelMessages,
+		"enable_event_webhooks":                                   *cfg.ServiceSettings.EnableEventWebhooks,
+		"enable_admin_alerts":                                     *cfg.ServiceSettings.EnableAdminAlerts,
+		"enable_post_language_detection":                          *cfg.ServiceSettings.EnablePostLanguageDetection,
+		"enable_new_device_login_notifications":                   *cfg.ServiceSettings.EnableNewDeviceLoginNotifications,
+		"enable_channel_feeds":                                    *cfg.ServiceSettings.EnableChannelFeeds,
+		"enable_outstanding_mentions":                             *cfg.ServiceSettings.EnableOutstandingMentions,
+		"enable_outstanding_mention_nudges":                       *cfg.ServiceSettings.EnableOutstandingMentionNudges,
 	})
 
 	ts.SendTelemetry(TrackConfigTeam, map[string]interface{}{
-		"enable_user_creation":                    cfg.TeamSettings.EnableUserCreation,
-		"enable_open_server":                      *cfg.TeamSettings.EnableOpenServer,
-		"enable_user_deactivation":                *cfg.TeamSettings.EnableUserDeactivation,
-		"enable_custom_user_statuses":             *cfg.TeamSettings.EnableCustomUserStatuses,
-		"enable_custom_brand":                     *cfg.TeamSettings.EnableCustomBrand,
-		"restrict_direct_message":                 *cfg.TeamSettings.RestrictDirectMessage,
-		"max_notifications_per_channel":           *cfg.TeamSettings.MaxNotificationsPerChannel,
-		"enable_confirm_notifications_to_channel": *cfg.TeamSettings.EnableConfirmNotificationsToChannel,
-		"max_users_per_team":                      *cfg.TeamSettings.MaxUsersPerTeam,
-		"max_channels_per_team":                   *cfg.TeamSettings.MaxChannelsPerTeam,
-		"teammate_name_display":                   *cfg.TeamSettings.TeammateNameDisplay,
-		"experimental_view_archived_channels":     *cfg.TeamSettings.ExperimentalViewArchivedChannels,
-		"lock_teammate_name_display":              *cfg.TeamSettings.LockTeammateNameDisplay,
-		"isdefault_site_name":                     isDefault(cfg.TeamSettings.SiteName, "Mattermost"),
-		"isdefault_custom_brand_text":             isDefault(*cfg.TeamSettings.CustomBrandText, model.TeamSettingsDefaultCustomBrandText),
-		"isdefault_custom_description_text":       isDefault(*cfg.TeamSettings.CustomDescriptionText, model.TeamSettingsDefaultCustomDescriptionText),
-		"isdefault_user_status_away_timeout":      isDefault(*cfg.TeamSettings.UserStatusAwayTimeout, model.TeamSettingsDefaultUserStatusAwayTimeout),
-		"experimental_enable_automatic_replies":   *cfg.TeamSettings.ExperimentalEnableAutomaticReplies,
-		"experimental_primary_team":               isDefault(*cfg.TeamSettings.ExperimentalPrimaryTeam, ""),
-		"experimental_default_channels":           len(cfg.TeamSettings.ExperimentalDefaultChannels),
+		"enable_user_creation":                     cfg.TeamSettings.EnableUserCreation,
+		"enable_open_server":                       *cfg.TeamSettings.EnableOpenServer,
+		"enable_user_deactivation":                 *cfg.TeamSettings.EnableUserDeactivation,
+		"enable_custom_user_statuses":              *cfg.TeamSettings.EnableCustomUserStatuses,
+		"enable_custom_brand":                      *cfg.TeamSettings.EnableCustomBrand,
+		"restrict_direct_message":                  *cfg.TeamSettings.RestrictDirectMessage,
+		"max_notifications_per_channel":            *cfg.TeamSettings.MaxNotificationsPerChannel,
+		"enable_confirm_notifications_to_channel":  *cfg.TeamSettings.EnableConfirmNotificationsToChannel,
+		"max_users_per_team":                       *cfg.TeamSettings.MaxUsersPerTeam,
+		"max_channels_per_team":                    *cfg.TeamSettings.MaxChannelsPerTeam,
+		"teammate_name_display":                    *cfg.TeamSettings.TeammateNameDisplay,
+		"experimental_view_archived_channels":      *cfg.TeamSettings.ExperimentalViewArchivedChannels,
+		"lock_teammate_name_display":               *cfg.TeamSettings.LockTeammateNameDisplay,
+		"isdefault_site_name":                      isDefault(cfg.TeamSettings.SiteName, "Mattermost"),
+		"isdefault_custom_brand_text":              isDefault(*cfg.TeamSettings.CustomBrandText, model.TeamSettingsDefaultCustomBrandText),
+		"isdefault_custom_description_text":        isDefault(*cfg.TeamSettings.CustomDescriptionText, model.TeamSettingsDefaultCustomDescriptionText),
+		"isdefault_user_status_away_timeout":       isDefault(*cfg.TeamSettings.UserStatusAwayTimeout, model.TeamSettingsDefaultUserStatusAwayTimeout),
+		"experimental_enable_automatic_replies":    *cfg.TeamSettings.ExperimentalEnableAutomaticReplies,
+		"experimental_primary_team":                isDefault(*cfg.TeamSettings.ExperimentalPrimaryTeam, ""),
+		"experimental_default_channels":            len(cfg.TeamSettings.ExperimentalDefaultChannels),
+		"deletion_window_days":                     *cfg.TeamSettings.DeletionWindowDays,
+		"channel_conversion_revert_window_minutes": *cfg.TeamSettings.ChannelConversionRevertWindowMinutes,
+		"max_invites_per_team_per_day":             *cfg.TeamSettings.MaxInvitesPerTeamPerDay,
+		"rename_redirect_window_days":              *cfg.TeamSettings.RenameRedirectWindowDays,
+		"strict_user_isolation":                    *cfg.TeamSettings.StrictUserIsolation,
 	})
 
 	ts.SendTelemetry(TrackConfigClientReq, map[string]interface{}{
@@ -480,7 +507,12 @@ func (ts *TelemetryService) trackConfig() {
 		"data_source_search_replicas":          len(cfg.SqlSettings.DataSourceSearchReplicas),
 		"query_timeout":                        *cfg.SqlSettings.QueryTimeout,
 		"disable_database_search":              *cfg.SqlSettings.DisableDatabaseSearch,
+		"enable_database_search_regex":         *cfg.SqlSettings.EnableDatabaseSearchRegex,
 		"migrations_statement_timeout_seconds": *cfg.SqlSettings.MigrationsStatementTimeoutSeconds,
+		"retry_max_attempts":                   *cfg.SqlSettings.RetryMaxAttempts,
+		"retry_backoff_milliseconds":           *cfg.SqlSettings.RetryBackoffMilliseconds,
+		"read_only_mode_failure_threshold":     *cfg.SqlSettings.ReadOnlyModeFailureThreshold,
+		"read_only_mode_cooldown_seconds":      *cfg.SqlSettings.ReadOnlyModeCooldownSeconds,
 	})
 
 	ts.SendTelemetry(TrackConfigLog, map[string]interface{}{
@@ -503,6 +535,8 @@ func (ts *TelemetryService) trackConfig() {
 		"file_compress":           *cfg.ExperimentalAuditSettings.FileCompress,
 		"file_max_queue_size":     *cfg.ExperimentalAuditSettings.FileMaxQueueSize,
 		"advanced_logging_config": *cfg.ExperimentalAuditSettings.AdvancedLoggingConfig != "",
+		"database_enabled":        *cfg.ExperimentalAuditSettings.DatabaseEnabled,
+		"database_retention_days": *cfg.ExperimentalAuditSettings.DatabaseRetentionDays,
 	})
 
 	ts.SendTelemetry(TrackConfigNotificationLog, map[string]interface{}{
@@ -525,21 +559,30 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigFile, map[string]interface{}{
-		"enable_public_links":     cfg.FileSettings.EnablePublicLink,
-		"driver_name":             *cfg.FileSettings.DriverName,
-		"isdefault_directory":     isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
-		"isabsolute_directory":    filepath.IsAbs(*cfg.FileSettings.Directory),
-		"extract_content":         *cfg.FileSettings.ExtractContent,
-		"archive_recursion":       *cfg.FileSettings.ArchiveRecursion,
-		"amazon_s3_ssl":           *cfg.FileSettings.AmazonS3SSL,
-		"amazon_s3_sse":           *cfg.FileSettings.AmazonS3SSE,
-		"amazon_s3_signv2":        *cfg.FileSettings.AmazonS3SignV2,
-		"amazon_s3_trace":         *cfg.FileSettings.AmazonS3Trace,
-		"max_file_size":           *cfg.FileSettings.MaxFileSize,
-		"max_image_resolution":    *cfg.FileSettings.MaxImageResolution,
-		"enable_file_attachments": *cfg.FileSettings.EnableFileAttachments,
-		"enable_mobile_upload":    *cfg.FileSettings.EnableMobileUpload,
-		"enable_mobile_download":  *cfg.FileSettings.EnableMobileDownload,
+		"enable_public_links":           cfg.FileSettings.EnablePublicLink,
+		"driver_name":                   *cfg.FileSettings.DriverName,
+		"isdefault_directory":           isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
+		"isabsolute_directory":          filepath.IsAbs(*cfg.FileSettings.Directory),
+		"extract_content":               *cfg.FileSettings.ExtractContent,
+		"archive_recursion":             *cfg.FileSettings.ArchiveRecursion,
+		"extract_content_max_file_size": *cfg.FileSettings.ExtractContentMaxFileSize,
+		"extract_content_workers":       *cfg.FileSettings.ExtractContentWorkers,
+		"amazon_s3_ssl":                 *cfg.FileSettings.AmazonS3SSL,
+		"amazon_s3_sse":                 *cfg.FileSettings.AmazonS3SSE,
+		"amazon_s3_signv2":              *cfg.FileSettings.AmazonS3SignV2,
+		"amazon_s3_trace":               *cfg.FileSettings.AmazonS3Trace,
+		"max_file_size":                 *cfg.FileSettings.MaxFileSize,
+		"max_image_resolution":          *cfg.FileSettings.MaxImageResolution,
+		"enable_file_attachments":       *cfg.FileSettings.EnableFileAttachments,
+		"enable_mobile_upload":          *cfg.FileSettings.EnableMobileUpload,
+		"enable_mobile_download":        *cfg.FileSettings.EnableMobileDownload,
+		"enable_signed_urls":            *cfg.FileSettings.EnableSignedURLs,
+		"user_daily_upload_quota_bytes": *cfg.FileSettings.UserDailyUploadQuotaBytes,
+		"user_total_upload_quota_bytes": *cfg.FileSettings.UserTotalUploadQuotaBytes,
+		"external_file_hosts":           len(cfg.FileSettings.ExternalFileHosts),
+		"watermark_sensitivity_tags":    len(cfg.FileSettings.WatermarkSensitivityTags),
+		"residency_backends":            len(cfg.FileSettings.ResidencyBackends),
+		"enable_file_deduplication":     *cfg.FileSettings.EnableFileDeduplication,
 	})
 
 	ts.SendTelemetry(TrackConfigEmail, map[string]interface{}{
@@ -557,6 +600,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_email_batching":                *cfg.EmailSettings.EnableEmailBatching,
 		"email_batching_buffer_size":           *cfg.EmailSettings.EmailBatchingBufferSize,
 		"email_batching_interval":              *cfg.EmailSettings.EmailBatchingInterval,
+		"enable_channel_digest_emails":         *cfg.EmailSettings.EnableChannelDigestEmails,
 		"enable_preview_mode_banner":           *cfg.EmailSettings.EnablePreviewModeBanner,
 		"isdefault_feedback_name":              isDefault(cfg.EmailSettings.FeedbackName, ""),
 		"isdefault_feedback_email":             isDefault(cfg.EmailSettings.FeedbackEmail, ""),
@@ -567,6 +611,9 @@ func (ts *TelemetryService) trackConfig() {
 		"isdefault_login_button_border_color":  isDefault(*cfg.EmailSettings.LoginButtonBorderColor, ""),
 		"isdefault_login_button_text_color":    isDefault(*cfg.EmailSettings.LoginButtonTextColor, ""),
 		"smtp_server_timeout":                  *cfg.EmailSettings.SMTPServerTimeout,
+		"email_provider":                       *cfg.EmailSettings.EmailProvider,
+		"smtp_pool_max_connections":            *cfg.EmailSettings.SMTPPoolMaxConnections,
+		"smtp_pool_connection_ttl_seconds":     *cfg.EmailSettings.SMTPPoolConnectionTTLSeconds,
 	})
 
 	ts.SendTelemetry(TrackConfigRate, map[string]interface{}{
@@ -580,8 +627,11 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigPrivacy, map[string]interface{}{
-		"show_email_address": cfg.PrivacySettings.ShowEmailAddress,
-		"show_full_name":     cfg.PrivacySettings.ShowFullName,
+		"show_email_address":            cfg.PrivacySettings.ShowEmailAddress,
+		"show_full_name":                cfg.PrivacySettings.ShowFullName,
+		"show_channel_presence":         cfg.PrivacySettings.ShowChannelPresence,
+		"enable_user_activity_timeline": cfg.PrivacySettings.EnableUserActivityTimeline,
+		"show_user_activity_timeline":   cfg.PrivacySettings.ShowUserActivityTimeline,
 	})
 
 	ts.SendTelemetry(TrackConfigTheme, map[string]interface{}{
@@ -758,15 +808,16 @@ func (ts *TelemetryService) trackConfig() {
 	ts.trackPluginConfig(cfg, model.PluginSettingsDefaultMarketplaceURL)
 
 	ts.SendTelemetry(TrackConfigDataRetention, map[string]interface{}{
-		"enable_message_deletion":     *cfg.DataRetentionSettings.EnableMessageDeletion,
-		"enable_file_deletion":        *cfg.DataRetentionSettings.EnableFileDeletion,
-		"enable_boards_deletion":      *cfg.DataRetentionSettings.EnableBoardsDeletion,
-		"message_retention_days":      *cfg.DataRetentionSettings.MessageRetentionDays,
-		"file_retention_days":         *cfg.DataRetentionSettings.FileRetentionDays,
-		"boards_retention_days":       *cfg.DataRetentionSettings.BoardsRetentionDays,
-		"deletion_job_start_time":     *cfg.DataRetentionSettings.DeletionJobStartTime,
-		"batch_size":                  *cfg.DataRetentionSettings.BatchSize,
-		"cleanup_jobs_threshold_days": *cfg.JobSettings.CleanupJobsThresholdDays,
+		"enable_message_deletion":         *cfg.DataRetentionSettings.EnableMessageDeletion,
+		"enable_file_deletion":            *cfg.DataRetentionSettings.EnableFileDeletion,
+		"enable_boards_deletion":          *cfg.DataRetentionSettings.EnableBoardsDeletion,
+		"message_retention_days":          *cfg.DataRetentionSettings.MessageRetentionDays,
+		"file_retention_days":             *cfg.DataRetentionSettings.FileRetentionDays,
+		"boards_retention_days":           *cfg.DataRetentionSettings.BoardsRetentionDays,
+		"deletion_job_start_time":         *cfg.DataRetentionSettings.DeletionJobStartTime,
+		"batch_size":                      *cfg.DataRetentionSettings.BatchSize,
+		"enable_direct_channel_retention": *cfg.DataRetentionSettings.EnableDirectChannelRetention,
+		"cleanup_jobs_threshold_days":     *cfg.JobSettings.CleanupJobsThresholdDays,
 	})
 
 	ts.SendTelemetry(TrackConfigMessageExport, map[string]interface{}{
@@ -813,6 +864,26 @@ func (ts *TelemetryService) trackConfig() {
 		"retention_days": *cfg.ExportSettings.RetentionDays,
 	})
 
+	ts.SendTelemetry(TrackConfigModeration, map[string]interface{}{
+		"enable":                          *cfg.ModerationSettings.Enable,
+		"rules":                           len(cfg.ModerationSettings.Rules),
+		"isdefault_classifier_url":        isDefault(*cfg.ModerationSettings.ClassifierURL, ""),
+		"classifier_timeout_milliseconds": *cfg.ModerationSettings.ClassifierTimeoutMilliseconds,
+	})
+
+	ts.SendTelemetry(TrackConfigCache, map[string]interface{}{
+		"cache_type": *cfg.CacheSettings.CacheType,
+		"redis_db":   *cfg.CacheSettings.RedisDB,
+	})
+
+	ts.SendTelemetry(TrackConfigRESTProxyBridge, map[string]interface{}{
+		"enable":                  *cfg.RESTProxyBridgeSettings.Enable,
+		"driver":                  *cfg.RESTProxyBridgeSettings.Driver,
+		"events":                  len(cfg.RESTProxyBridgeSettings.Events),
+		"include_direct_messages": *cfg.RESTProxyBridgeSettings.IncludeDirectMessages,
+		"retention_hours":         *cfg.RESTProxyBridgeSettings.RetentionHours,
+	})
+
 	// Convert feature flags to map[string]interface{} for sending
 	flags := cfg.FeatureFlags.ToMap()
 	interfaceFlags := make(map[string]interface{})
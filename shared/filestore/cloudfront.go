@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CloudFrontSigner generates CloudFront signed URLs using a canned policy, so that files stored
+// in S3 can be served by a CloudFront distribution restricted to trusted signers.
+type CloudFrontSigner struct {
+	domain     string
+	pathPrefix string
+	keyPairID  string
+	privateKey *rsa.PrivateKey
+}
+
+// cloudFrontURLEncoding replaces the characters CloudFront does not accept in query strings.
+var cloudFrontURLEncoding = strings.NewReplacer("+", "-", "=", "_", "/", "~")
+
+// NewCloudFrontSigner returns a CloudFrontSigner for the given distribution domain, signing with
+// the given PEM encoded RSA private key.
+func NewCloudFrontSigner(domain, pathPrefix, keyPairID, privateKeyPEM string) (*CloudFrontSigner, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("unable to decode the CloudFront private key")
+	}
+
+	var privateKey *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse the CloudFront private key")
+		}
+		privateKey = key
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to parse the CloudFront private key")
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("the CloudFront private key is not an RSA key")
+		}
+		privateKey = rsaKey
+	default:
+		return nil, errors.Errorf("unsupported CloudFront private key type %s", block.Type)
+	}
+
+	if !strings.Contains(domain, "://") {
+		domain = "https://" + domain
+	}
+
+	return &CloudFrontSigner{
+		domain:     strings.TrimSuffix(domain, "/"),
+		pathPrefix: pathPrefix,
+		keyPairID:  keyPairID,
+		privateKey: privateKey,
+	}, nil
+}
+
+// SignedURL returns a URL giving read access to the file at the given path until expiry elapses.
+func (s *CloudFrontSigner) SignedURL(filePath string, expiry time.Duration) (string, error) {
+	resource := s.domain + "/" + strings.TrimPrefix(path.Join(s.pathPrefix, filePath), "/")
+	expires := time.Now().Add(expiry).Unix()
+
+	policy, err := cloudFrontCannedPolicy(resource, expires)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha1.Sum(policy)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA1, hash[:])
+	if err != nil {
+		return "", errors.Wrap(err, "unable to sign the CloudFront policy")
+	}
+
+	query := url.Values{}
+	query.Set("Expires", strconv.FormatInt(expires, 10))
+	query.Set("Key-Pair-Id", s.keyPairID)
+	query.Set("Signature", cloudFrontURLEncoding.Replace(base64.StdEncoding.EncodeToString(signature)))
+
+	return resource + "?" + query.Encode(), nil
+}
+
+func cloudFrontCannedPolicy(resource string, expires int64) ([]byte, error) {
+	type condition struct {
+		DateLessThan struct {
+			EpochTime int64 `json:"AWS:EpochTime"`
+		}
+	}
+	type statement struct {
+		Resource  string
+		Condition condition
+	}
+
+	var policy struct {
+		Statement []statement
+	}
+	stmt := statement{Resource: resource}
+	stmt.Condition.DateLessThan.EpochTime = expires
+	policy.Statement = append(policy.Statement, stmt)
+
+	// CloudFront signs the policy verbatim, so characters like & must not be escaped.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(policy); err != nil {
+		return nil, errors.Wrap(err, "unable to encode the CloudFront policy")
+	}
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFrontSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := NewCloudFrontSigner("cdn.example.com", "", "KEYPAIR", "not a key")
+		require.Error(t, err)
+	})
+
+	t.Run("signed url", func(t *testing.T) {
+		signer, err := NewCloudFrontSigner("cdn.example.com", "prefix", "KEYPAIR", keyPEM)
+		require.NoError(t, err)
+
+		signedURL, err := signer.SignedURL("teams/abc/teamIcon.png", time.Hour)
+		require.NoError(t, err)
+
+		u, err := url.Parse(signedURL)
+		require.NoError(t, err)
+		assert.Equal(t, "https", u.Scheme)
+		assert.Equal(t, "cdn.example.com", u.Host)
+		assert.Equal(t, "/prefix/teams/abc/teamIcon.png", u.Path)
+		assert.Equal(t, "KEYPAIR", u.Query().Get("Key-Pair-Id"))
+
+		expires, err := strconv.ParseInt(u.Query().Get("Expires"), 10, 64)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Now().Add(time.Hour).Unix(), expires, 5)
+
+		policy, err := cloudFrontCannedPolicy("https://cdn.example.com/prefix/teams/abc/teamIcon.png", expires)
+		require.NoError(t, err)
+
+		decoder := strings.NewReplacer("-", "+", "_", "=", "~", "/")
+		signature, err := base64.StdEncoding.DecodeString(decoder.Replace(u.Query().Get("Signature")))
+		require.NoError(t, err)
+
+		hash := sha1.Sum(policy)
+		require.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, hash[:], signature))
+	})
+}
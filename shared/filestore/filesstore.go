@@ -38,6 +38,17 @@ type FileBackend interface {
 	RemoveDirectory(path string) error
 }
 
+// URLSigner generates time limited URLs from which clients can download files directly,
+// bypassing the application servers.
+type URLSigner interface {
+	SignedURL(path string, expiry time.Duration) (string, error)
+}
+
+// DiskUsageReporter reports how much of the disk the files are stored on is used, in bytes.
+type DiskUsageReporter interface {
+	DiskUsage() (used uint64, total uint64, err error)
+}
+
 type FileBackendSettings struct {
 	DriverName              string
 	Directory               string
@@ -0,0 +1,27 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+//go:build !windows
+// +build !windows
+
+package filestore
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// DiskUsage returns the bytes used on the disk holding the directory and the bytes it can hold.
+// As with df, the blocks reserved for the superuser are left out of the total.
+func (b *LocalFileBackend) DiskUsage() (uint64, uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(b.directory, &stat); err != nil {
+		return 0, 0, errors.Wrapf(err, "unable to get the disk usage of %s", b.directory)
+	}
+
+	blockSize := uint64(stat.Bsize)
+	used := (uint64(stat.Blocks) - uint64(stat.Bfree)) * blockSize
+	available := uint64(stat.Bavail) * blockSize
+
+	return used, used + available, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+//go:build !windows
+// +build !windows
+
+package filestore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalFileBackendDiskUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var backend FileBackend = &LocalFileBackend{directory: dir}
+	reporter, ok := backend.(DiskUsageReporter)
+	require.True(t, ok)
+
+	used, total, err := reporter.DiskUsage()
+	require.NoError(t, err)
+	assert.NotZero(t, total)
+	assert.LessOrEqual(t, used, total)
+
+	t.Run("missing directory", func(t *testing.T) {
+		backend := &LocalFileBackend{directory: filepath.Join(dir, "missing")}
+		_, _, err := backend.DiskUsage()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RoutedFileBackend stores each file in the backend its path is routed to, or in the default
+// backend when the path isn't routed anywhere. Files whose path can't be routed aren't accessed at
+// all, rather than being accessed in the default backend. Directories are listed and removed in
+// the backend of their own path only.
+type RoutedFileBackend struct {
+	defaultBackend FileBackend
+	route          func(path string) (FileBackend, error)
+}
+
+// NewRoutedFileBackend returns a backend storing the files in the backend returned by route for
+// their path, or in defaultBackend when route returns nil.
+func NewRoutedFileBackend(defaultBackend FileBackend, route func(path string) (FileBackend, error)) *RoutedFileBackend {
+	return &RoutedFileBackend{
+		defaultBackend: defaultBackend,
+		route:          route,
+	}
+}
+
+// DefaultBackend returns the backend of the files whose path isn't routed.
+func (b *RoutedFileBackend) DefaultBackend() FileBackend {
+	return b.defaultBackend
+}
+
+func (b *RoutedFileBackend) backendFor(path string) (FileBackend, error) {
+	backend, err := b.route(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to route the file at %s", path)
+	}
+	if backend != nil {
+		return backend, nil
+	}
+	return b.defaultBackend, nil
+}
+
+func (b *RoutedFileBackend) TestConnection() error {
+	return b.defaultBackend.TestConnection()
+}
+
+func (b *RoutedFileBackend) Reader(path string) (ReadCloseSeeker, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Reader(path)
+}
+
+func (b *RoutedFileBackend) ReadFile(path string) ([]byte, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ReadFile(path)
+}
+
+func (b *RoutedFileBackend) FileExists(path string) (bool, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return false, err
+	}
+	return backend.FileExists(path)
+}
+
+func (b *RoutedFileBackend) FileSize(path string) (int64, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return 0, err
+	}
+	return backend.FileSize(path)
+}
+
+func (b *RoutedFileBackend) FileModTime(path string) (time.Time, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return backend.FileModTime(path)
+}
+
+// CopyFile copies the file within its backend, or through the server when the new path is
+// routed to another backend.
+func (b *RoutedFileBackend) CopyFile(oldPath, newPath string) error {
+	src, dst, err := b.backendsFor(oldPath, newPath)
+	if err != nil {
+		return err
+	}
+	if src == dst {
+		return src.CopyFile(oldPath, newPath)
+	}
+	return CopyBetweenBackends(src, dst, oldPath, newPath)
+}
+
+// MoveFile moves the file within its backend, or through the server when the new path is
+// routed to another backend.
+func (b *RoutedFileBackend) MoveFile(oldPath, newPath string) error {
+	src, dst, err := b.backendsFor(oldPath, newPath)
+	if err != nil {
+		return err
+	}
+	if src == dst {
+		return src.MoveFile(oldPath, newPath)
+	}
+	if err := CopyBetweenBackends(src, dst, oldPath, newPath); err != nil {
+		return err
+	}
+	return src.RemoveFile(oldPath)
+}
+
+func (b *RoutedFileBackend) backendsFor(oldPath, newPath string) (FileBackend, FileBackend, error) {
+	src, err := b.backendFor(oldPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := b.backendFor(newPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return src, dst, nil
+}
+
+func (b *RoutedFileBackend) WriteFile(fr io.Reader, path string) (int64, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return 0, err
+	}
+	return backend.WriteFile(fr, path)
+}
+
+func (b *RoutedFileBackend) AppendFile(fr io.Reader, path string) (int64, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return 0, err
+	}
+	return backend.AppendFile(fr, path)
+}
+
+func (b *RoutedFileBackend) RemoveFile(path string) error {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return err
+	}
+	return backend.RemoveFile(path)
+}
+
+func (b *RoutedFileBackend) ListDirectory(path string) ([]string, error) {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ListDirectory(path)
+}
+
+func (b *RoutedFileBackend) RemoveDirectory(path string) error {
+	backend, err := b.backendFor(path)
+	if err != nil {
+		return err
+	}
+	return backend.RemoveDirectory(path)
+}
+
+// CopyBetweenBackends copies the file at oldPath in src to newPath in dst.
+func CopyBetweenBackends(src, dst FileBackend, oldPath, newPath string) error {
+	r, err := src.Reader(oldPath)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read the file at %s", oldPath)
+	}
+	defer r.Close()
+
+	if _, err := dst.WriteFile(r, newPath); err != nil {
+		return errors.Wrapf(err, "unable to write the file at %s", newPath)
+	}
+	return nil
+}
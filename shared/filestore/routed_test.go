@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutedFileBackend(t *testing.T) {
+	newLocalBackend := func() FileBackend {
+		dir, err := ioutil.TempDir("", "")
+		require.NoError(t, err)
+		t.Cleanup(func() { os.RemoveAll(dir) })
+
+		backend, err := NewFileBackend(FileBackendSettings{DriverName: driverLocal, Directory: dir})
+		require.NoError(t, err)
+		return backend
+	}
+
+	defaultBackend := newLocalBackend()
+	euBackend := newLocalBackend()
+	backend := NewRoutedFileBackend(defaultBackend, func(path string) (FileBackend, error) {
+		switch {
+		case strings.HasPrefix(path, "eu/"):
+			return euBackend, nil
+		case strings.HasPrefix(path, "unknown/"):
+			return nil, errors.New("unknown residency")
+		}
+		return nil, nil
+	})
+
+	t.Run("files are written to the backend of their path", func(t *testing.T) {
+		_, err := backend.WriteFile(bytes.NewReader([]byte("eu")), "eu/file.txt")
+		require.NoError(t, err)
+		_, err = backend.WriteFile(bytes.NewReader([]byte("default")), "us/file.txt")
+		require.NoError(t, err)
+
+		exists, err := euBackend.FileExists("eu/file.txt")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		exists, err = defaultBackend.FileExists("eu/file.txt")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		data, err := backend.ReadFile("us/file.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "default", string(data))
+	})
+
+	t.Run("files are moved across backends", func(t *testing.T) {
+		_, err := backend.WriteFile(bytes.NewReader([]byte("moved")), "us/moved.txt")
+		require.NoError(t, err)
+
+		require.NoError(t, backend.MoveFile("us/moved.txt", "eu/moved.txt"))
+
+		exists, err := defaultBackend.FileExists("us/moved.txt")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		data, err := euBackend.ReadFile("eu/moved.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "moved", string(data))
+	})
+
+	t.Run("files are copied across backends", func(t *testing.T) {
+		_, err := backend.WriteFile(bytes.NewReader([]byte("copied")), "eu/copied.txt")
+		require.NoError(t, err)
+
+		require.NoError(t, backend.CopyFile("eu/copied.txt", "us/copied.txt"))
+
+		data, err := defaultBackend.ReadFile("us/copied.txt")
+		require.NoError(t, err)
+		assert.Equal(t, "copied", string(data))
+		exists, err := euBackend.FileExists("eu/copied.txt")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("files which can't be routed aren't accessed", func(t *testing.T) {
+		_, err := backend.WriteFile(bytes.NewReader([]byte("unknown")), "unknown/file.txt")
+		require.Error(t, err)
+		_, err = backend.ReadFile("unknown/file.txt")
+		require.Error(t, err)
+		require.Error(t, backend.MoveFile("us/file.txt", "unknown/file.txt"))
+
+		exists, err := defaultBackend.FileExists("unknown/file.txt")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	assert.Equal(t, defaultBackend, backend.DefaultBackend())
+}
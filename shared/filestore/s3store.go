@@ -179,6 +179,16 @@ func (b *S3FileBackend) TestConnection() error {
 	return nil
 }
 
+// SignedURL returns a presigned URL giving read access to the file at the given path until expiry elapses.
+func (b *S3FileBackend) SignedURL(path string, expiry time.Duration) (string, error) {
+	path = filepath.Join(b.pathPrefix, path)
+	u, err := b.client.PresignedGetObject(context.Background(), b.bucket, path, expiry, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to presign file %s", path)
+	}
+	return u.String(), nil
+}
+
 func (b *S3FileBackend) MakeBucket() error {
 	err := b.client.MakeBucket(context.Background(), b.bucket, s3.MakeBucketOptions{Region: b.region})
 	if err != nil {
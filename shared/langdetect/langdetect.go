@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package langdetect guesses the language of short texts such as posts. Texts in a script used
+// by a single language are told apart by their script, and texts in the Latin script by their
+// most common words. It errs on the side of not detecting a language rather than detecting the
+// wrong one.
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// minLetters is the number of letters below which a text is too short to tell its language.
+	minLetters = 10
+	// minStopWords is the number of common words of a language a text in the Latin script must
+	// have to be detected as written in that language.
+	minStopWords = 2
+)
+
+// scripts are the scripts used by a single language, or for which a language is the most likely.
+// Hiragana and Katakana are checked before Han as Japanese mixes them with Han characters.
+var scripts = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Hangul, "ko"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Hebrew, "he"},
+	{unicode.Greek, "el"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// ukrainianLetters are Cyrillic letters used in Ukrainian but not in Russian.
+const ukrainianLetters = "іїєґ"
+
+// stopWords are the most common words of the languages written in the Latin script. The words
+// shared by several of these languages count for each of them.
+var stopWords = map[string][]string{
+	"en": {"the", "and", "is", "are", "was", "were", "this", "that", "with", "for", "you", "have", "not", "it", "of", "to", "be", "will", "can", "what", "we", "they"},
+	"es": {"el", "los", "las", "es", "está", "y", "que", "del", "por", "para", "con", "una", "pero", "muy", "como", "más", "yo", "tiene", "hay", "gracias"},
+	"fr": {"le", "les", "est", "et", "des", "du", "une", "pour", "avec", "pas", "que", "qui", "je", "vous", "nous", "sur", "dans", "mais", "très", "merci"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "mit", "ich", "sie", "wir", "ein", "eine", "auf", "für", "auch", "sind", "den", "dem", "zu", "danke"},
+	"pt": {"o", "os", "as", "é", "e", "não", "do", "da", "dos", "das", "com", "uma", "para", "mas", "você", "muito", "obrigado", "isso", "está", "tem"},
+	"it": {"il", "gli", "è", "e", "che", "di", "non", "per", "con", "sono", "una", "della", "anche", "ma", "io", "molto", "grazie", "questo", "ci", "ho"},
+	"nl": {"de", "het", "een", "en", "is", "niet", "van", "dat", "ik", "je", "wij", "zijn", "met", "voor", "ook", "maar", "op", "dank", "heb", "wat"},
+}
+
+var stopWordLanguages = func() map[string][]string {
+	languages := map[string][]string{}
+	for language, words := range stopWords {
+		for _, word := range words {
+			languages[word] = append(languages[word], language)
+		}
+	}
+	return languages
+}()
+
+// Detect returns the ISO 639-1 code of the language the text is written in, or an empty string
+// when it can't be told.
+func Detect(text string) string {
+	text = stripNonProse(text)
+
+	letters := 0
+	scriptCounts := make([]int, len(scripts))
+	latin := 0
+	ukrainian := false
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			continue
+		}
+		for i, script := range scripts {
+			if unicode.Is(script.table, r) {
+				scriptCounts[i]++
+				break
+			}
+		}
+		if strings.ContainsRune(ukrainianLetters, unicode.ToLower(r)) {
+			ukrainian = true
+		}
+	}
+
+	if letters == 0 {
+		return ""
+	}
+
+	best, bestCount := -1, 0
+	for i, count := range scriptCounts {
+		// Japanese texts are mostly Han characters, so any kana is enough to tell them apart.
+		if scripts[i].language == "ja" && count > 0 && count*10 >= letters {
+			return "ja"
+		}
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+	if best != -1 && bestCount*2 > letters {
+		language := scripts[best].language
+		// Chinese and Korean characters are words or syllables in themselves, so short texts
+		// are enough to tell them.
+		if language != "zh" && language != "ko" && letters < minLetters {
+			return ""
+		}
+		if language == "ru" && ukrainian {
+			return "uk"
+		}
+		return language
+	}
+
+	if latin*2 <= letters || letters < minLetters {
+		return ""
+	}
+	return detectLatin(text)
+}
+
+// detectLatin returns the language whose common words the text has the most of, provided it has
+// enough of them and clearly more than of any other language.
+func detectLatin(text string) string {
+	scores := map[string]int{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		for _, language := range stopWordLanguages[word] {
+			scores[language]++
+		}
+	}
+
+	best, bestScore, secondScore := "", 0, 0
+	for language, score := range scores {
+		switch {
+		case score > bestScore:
+			best, bestScore, secondScore = language, score, bestScore
+		case score > secondScore:
+			secondScore = score
+		}
+	}
+
+	if bestScore < minStopWords || bestScore == secondScore {
+		return ""
+	}
+	return best
+}
+
+// stripNonProse removes the parts of a message that aren't written in its language, such as
+// code, links and mentions.
+func stripNonProse(text string) string {
+	var b strings.Builder
+	inCodeBlock := false
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		for _, word := range strings.Fields(line) {
+			if strings.HasPrefix(word, "@") || strings.HasPrefix(word, "~") || strings.HasPrefix(word, "`") ||
+				strings.HasPrefix(word, ":") || strings.Contains(word, "://") {
+				continue
+			}
+			b.WriteString(word)
+			b.WriteByte(' ')
+		}
+	}
+	return b.String()
+}
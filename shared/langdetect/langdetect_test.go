@@ -0,0 +1,40 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		expected string
+	}{
+		"empty":              {"", ""},
+		"too short":          {"ok", ""},
+		"english":            {"The build is broken and we are not able to deploy it today", "en"},
+		"spanish":            {"El servidor está caído y no hay nadie que lo pueda arreglar por ahora", "es"},
+		"french":             {"Le serveur est en panne et nous ne savons pas pour combien de temps", "fr"},
+		"german":             {"Der Server ist nicht erreichbar und wir wissen nicht warum", "de"},
+		"portuguese":         {"O servidor não está funcionando e você precisa verificar isso", "pt"},
+		"italian":            {"Il server non funziona e non so per quanto tempo sarà così", "it"},
+		"dutch":              {"De server is niet bereikbaar en ik weet niet wat het probleem is", "nl"},
+		"russian":            {"Сервер не работает, и мы не знаем почему", "ru"},
+		"ukrainian":          {"Сервер не працює, і ми не знаємо чому", "uk"},
+		"japanese":           {"サーバーが停止しています。原因を調査中です。", "ja"},
+		"chinese":            {"服务器停止了", "zh"},
+		"korean":             {"서버가 다운되었습니다", "ko"},
+		"arabic":             {"الخادم لا يعمل حاليا ونحن نعمل على إصلاحه", "ar"},
+		"no common words":    {"Kubernetes Grafana Prometheus Elasticsearch", ""},
+		"only code and link": {"```\nfunc main() {}\n```\nhttps://example.com/the/and/is", ""},
+		"mentions ignored":   {"@the @and @is @are hello everyone", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Detect(tc.text))
+		})
+	}
+}
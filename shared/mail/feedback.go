@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const (
+	FeedbackBounce    = "bounce"
+	FeedbackComplaint = "complaint"
+)
+
+// Feedback is a bounce or a complaint reported by an email service provider for an address.
+type Feedback struct {
+	Address string
+	Type    string
+	Details string
+}
+
+// ParseFeedbackWebhook extracts the bounces and complaints from the body of a webhook sent by
+// the provider. Events that are neither, such as deliveries or transient failures, are ignored.
+func ParseFeedbackWebhook(provider string, body []byte) ([]Feedback, error) {
+	switch provider {
+	case ProviderSendGrid:
+		return parseSendGridFeedback(body)
+	case ProviderAmazonSES:
+		return parseAmazonSESFeedback(body)
+	case ProviderMailgun:
+		return parseMailgunFeedback(body)
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", provider)
+	}
+}
+
+func parseSendGridFeedback(body []byte) ([]Feedback, error) {
+	var events []struct {
+		Email  string `json:"email"`
+		Event  string `json:"event"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, errors.Wrap(err, "failed to decode SendGrid events")
+	}
+
+	var feedback []Feedback
+	for _, event := range events {
+		switch event.Event {
+		case "bounce":
+			feedback = append(feedback, Feedback{Address: event.Email, Type: FeedbackBounce, Details: event.Reason})
+		case "spamreport":
+			feedback = append(feedback, Feedback{Address: event.Email, Type: FeedbackComplaint})
+		}
+	}
+
+	return feedback, nil
+}
+
+func parseAmazonSESFeedback(body []byte) ([]Feedback, error) {
+	var envelope struct {
+		Type         string `json:"Type"`
+		Message      string `json:"Message"`
+		SubscribeURL string `json:"SubscribeURL"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to decode Amazon SNS notification")
+	}
+
+	if envelope.Type == "SubscriptionConfirmation" {
+		mlog.Info("Amazon SNS subscription for email feedback needs to be confirmed", mlog.String("subscribe_url", envelope.SubscribeURL))
+		return nil, nil
+	}
+
+	var notification struct {
+		NotificationType string `json:"notificationType"`
+		EventType        string `json:"eventType"`
+		Bounce           struct {
+			BounceType        string `json:"bounceType"`
+			BouncedRecipients []struct {
+				EmailAddress   string `json:"emailAddress"`
+				DiagnosticCode string `json:"diagnosticCode"`
+			} `json:"bouncedRecipients"`
+		} `json:"bounce"`
+		Complaint struct {
+			ComplainedRecipients []struct {
+				EmailAddress string `json:"emailAddress"`
+			} `json:"complainedRecipients"`
+			ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		} `json:"complaint"`
+	}
+	if err := json.Unmarshal([]byte(envelope.Message), &notification); err != nil {
+		return nil, errors.Wrap(err, "failed to decode Amazon SES notification")
+	}
+
+	notificationType := notification.NotificationType
+	if notificationType == "" {
+		notificationType = notification.EventType
+	}
+
+	var feedback []Feedback
+	switch notificationType {
+	case "Bounce":
+		if notification.Bounce.BounceType != "Permanent" {
+			return nil, nil
+		}
+		for _, recipient := range notification.Bounce.BouncedRecipients {
+			feedback = append(feedback, Feedback{Address: recipient.EmailAddress, Type: FeedbackBounce, Details: recipient.DiagnosticCode})
+		}
+	case "Complaint":
+		for _, recipient := range notification.Complaint.ComplainedRecipients {
+			feedback = append(feedback, Feedback{Address: recipient.EmailAddress, Type: FeedbackComplaint, Details: notification.Complaint.ComplaintFeedbackType})
+		}
+	}
+
+	return feedback, nil
+}
+
+func parseMailgunFeedback(body []byte) ([]Feedback, error) {
+	var webhook struct {
+		EventData struct {
+			Event          string `json:"event"`
+			Severity       string `json:"severity"`
+			Recipient      string `json:"recipient"`
+			DeliveryStatus struct {
+				Message     string `json:"message"`
+				Description string `json:"description"`
+			} `json:"delivery-status"`
+		} `json:"event-data"`
+	}
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return nil, errors.Wrap(err, "failed to decode Mailgun event")
+	}
+
+	event := webhook.EventData
+	switch event.Event {
+	case "failed":
+		if event.Severity != "permanent" {
+			return nil, nil
+		}
+		details := strings.TrimSpace(event.DeliveryStatus.Message + " " + event.DeliveryStatus.Description)
+		return []Feedback{{Address: event.Recipient, Type: FeedbackBounce, Details: details}}, nil
+	case "complained":
+		return []Feedback{{Address: event.Recipient, Type: FeedbackComplaint}}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeedbackWebhook(t *testing.T) {
+	t.Run("sendgrid", func(t *testing.T) {
+		body := `[
+			{"email": "bounced@example.com", "event": "bounce", "reason": "550 no such user"},
+			{"email": "spam@example.com", "event": "spamreport"},
+			{"email": "ok@example.com", "event": "delivered"}
+		]`
+
+		feedback, err := ParseFeedbackWebhook(ProviderSendGrid, []byte(body))
+		require.NoError(t, err)
+		assert.Equal(t, []Feedback{
+			{Address: "bounced@example.com", Type: FeedbackBounce, Details: "550 no such user"},
+			{Address: "spam@example.com", Type: FeedbackComplaint},
+		}, feedback)
+	})
+
+	t.Run("amazon ses", func(t *testing.T) {
+		envelope := func(message string) []byte {
+			body, err := json.Marshal(map[string]string{"Type": "Notification", "Message": message})
+			require.NoError(t, err)
+			return body
+		}
+
+		feedback, err := ParseFeedbackWebhook(ProviderAmazonSES, envelope(`{
+			"notificationType": "Bounce",
+			"bounce": {"bounceType": "Permanent", "bouncedRecipients": [{"emailAddress": "bounced@example.com", "diagnosticCode": "smtp; 550"}]}
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, []Feedback{{Address: "bounced@example.com", Type: FeedbackBounce, Details: "smtp; 550"}}, feedback)
+
+		feedback, err = ParseFeedbackWebhook(ProviderAmazonSES, envelope(`{
+			"notificationType": "Bounce",
+			"bounce": {"bounceType": "Transient", "bouncedRecipients": [{"emailAddress": "full@example.com"}]}
+		}`))
+		require.NoError(t, err)
+		assert.Empty(t, feedback)
+
+		feedback, err = ParseFeedbackWebhook(ProviderAmazonSES, envelope(`{
+			"notificationType": "Complaint",
+			"complaint": {"complainedRecipients": [{"emailAddress": "spam@example.com"}], "complaintFeedbackType": "abuse"}
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, []Feedback{{Address: "spam@example.com", Type: FeedbackComplaint, Details: "abuse"}}, feedback)
+
+		feedback, err = ParseFeedbackWebhook(ProviderAmazonSES, []byte(`{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.example.com"}`))
+		require.NoError(t, err)
+		assert.Empty(t, feedback)
+	})
+
+	t.Run("mailgun", func(t *testing.T) {
+		feedback, err := ParseFeedbackWebhook(ProviderMailgun, []byte(`{"event-data": {"event": "failed", "severity": "permanent", "recipient": "bounced@example.com", "delivery-status": {"message": "No such user"}}}`))
+		require.NoError(t, err)
+		assert.Equal(t, []Feedback{{Address: "bounced@example.com", Type: FeedbackBounce, Details: "No such user"}}, feedback)
+
+		feedback, err = ParseFeedbackWebhook(ProviderMailgun, []byte(`{"event-data": {"event": "failed", "severity": "temporary", "recipient": "full@example.com"}}`))
+		require.NoError(t, err)
+		assert.Empty(t, feedback)
+
+		feedback, err = ParseFeedbackWebhook(ProviderMailgun, []byte(`{"event-data": {"event": "complained", "recipient": "spam@example.com"}}`))
+		require.NoError(t, err)
+		assert.Equal(t, []Feedback{{Address: "spam@example.com", Type: FeedbackComplaint}}, feedback)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseFeedbackWebhook(ProviderSendGrid, []byte("nope"))
+		require.Error(t, err)
+
+		_, err = ParseFeedbackWebhook(ProviderSMTP, []byte("[]"))
+		require.Error(t, err)
+	})
+}
@@ -40,6 +40,12 @@ type SMTPConfig struct {
 	FeedbackName                      string
 	FeedbackEmail                     string
 	ReplyToAddress                    string
+	Provider                          string
+	ProviderAPIKey                    string
+	ProviderAccessKeyId               string
+	ProviderRegion                    string
+	ProviderDomain                    string
+	Suppressions                      SuppressionList
 }
 
 type mailData struct {
@@ -212,6 +218,14 @@ func TestConnection(config *SMTPConfig) error {
 		return errors.New("SendEmailNotifications is not true")
 	}
 
+	if config.Provider != "" && config.Provider != ProviderSMTP {
+		provider, err := NewProvider(config)
+		if err != nil {
+			return err
+		}
+		return provider.TestConnection()
+	}
+
 	conn, err := ConnectToSMTPServer(config)
 	if err != nil {
 		return errors.Wrap(err, "unable to connect")
@@ -235,21 +249,20 @@ func TestConnection(config *SMTPConfig) error {
 }
 
 func SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, enableComplianceFeatures bool, ccMail string) error {
-	fromMail := mail.Address{Name: config.FeedbackName, Address: config.FeedbackEmail}
-	replyTo := mail.Address{Name: config.FeedbackName, Address: config.ReplyToAddress}
+	return sendMailUsingConfigAdvanced(newMailData(to, subject, htmlBody, embeddedFiles, config, ccMail), config)
+}
 
-	mail := mailData{
+func newMailData(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, ccMail string) mailData {
+	return mailData{
 		mimeTo:        to,
 		smtpTo:        to,
-		from:          fromMail,
+		from:          mail.Address{Name: config.FeedbackName, Address: config.FeedbackEmail},
 		cc:            ccMail,
-		replyTo:       replyTo,
+		replyTo:       mail.Address{Name: config.FeedbackName, Address: config.ReplyToAddress},
 		subject:       subject,
 		htmlBody:      htmlBody,
 		embeddedFiles: embeddedFiles,
 	}
-
-	return sendMailUsingConfigAdvanced(mail, config)
 }
 
 func SendMailUsingConfig(to, subject, htmlBody string, config *SMTPConfig, enableComplianceFeatures bool, ccMail string) error {
@@ -258,26 +271,57 @@ func SendMailUsingConfig(to, subject, htmlBody string, config *SMTPConfig, enabl
 
 // allows for sending an email with differing MIME/SMTP recipients
 func sendMailUsingConfigAdvanced(mail mailData, config *SMTPConfig) error {
+	return deliverMail(mail, config, sendMailOverNewConnection)
+}
+
+// deliverMail skips the suppressed addresses and sends the mail through the API provider of
+// the config when there is one, or through sendOverSMTP otherwise.
+func deliverMail(mail mailData, config *SMTPConfig, sendOverSMTP func(mailData, *SMTPConfig) error) error {
+	if config.Suppressions != nil && config.Suppressions.IsSuppressed(mail.smtpTo) {
+		mlog.Debug("Skipping email to a suppressed address", mlog.String("to", mail.smtpTo))
+		return nil
+	}
+
+	if config.Provider != "" && config.Provider != ProviderSMTP {
+		provider, err := NewProvider(config)
+		if err != nil {
+			return err
+		}
+		return provider.Send(mail, time.Now())
+	}
+
 	if config.Server == "" {
 		return nil
 	}
 
+	return sendOverSMTP(mail, config)
+}
+
+// openSMTPClient connects and authenticates to the SMTP server of the config.
+func openSMTPClient(config *SMTPConfig) (net.Conn, *smtp.Client, error) {
 	conn, err := ConnectToSMTPServer(config)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	defer conn.Close()
 
-	sec := config.ServerTimeout
-
-	ctx := context.Background()
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(sec)*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.ServerTimeout)*time.Second)
 	defer cancel()
 
 	c, err := NewSMTPClient(ctx, conn, config)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, c, nil
+}
+
+func sendMailOverNewConnection(mail mailData, config *SMTPConfig) error {
+	conn, c, err := openSMTPClient(config)
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
 	defer c.Quit()
 	defer c.Close()
 
@@ -287,6 +331,35 @@ func sendMailUsingConfigAdvanced(mail mailData, config *SMTPConfig) error {
 func SendMail(c smtpClient, mail mailData, date time.Time) error {
 	mlog.Debug("sending mail", mlog.String("to", mail.smtpTo), mlog.String("subject", mail.subject))
 
+	m := buildMessage(mail, date)
+
+	if err := c.Mail(mail.from.Address); err != nil {
+		return errors.Wrap(err, "failed to set the from address")
+	}
+
+	if err := c.Rcpt(mail.smtpTo); err != nil {
+		return errors.Wrap(err, "failed to set the to address")
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to add email message data")
+	}
+
+	_, err = m.WriteTo(w)
+	if err != nil {
+		return errors.Wrap(err, "failed to write the email message")
+	}
+	err = w.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to close connection to the SMTP server")
+	}
+
+	return nil
+}
+
+// buildMessage builds the MIME message for the mail, with both a plain text and an HTML body.
+func buildMessage(mail mailData, date time.Time) *gomail.Message {
 	htmlMessage := mail.htmlBody
 
 	txtBody, err := html2text.FromString(mail.htmlBody)
@@ -326,27 +399,5 @@ func SendMail(c smtpClient, mail mailData, date time.Time) error {
 		m.EmbedReader(name, reader)
 	}
 
-	if err = c.Mail(mail.from.Address); err != nil {
-		return errors.Wrap(err, "failed to set the from address")
-	}
-
-	if err = c.Rcpt(mail.smtpTo); err != nil {
-		return errors.Wrap(err, "failed to set the to address")
-	}
-
-	w, err := c.Data()
-	if err != nil {
-		return errors.Wrap(err, "failed to add email message data")
-	}
-
-	_, err = m.WriteTo(w)
-	if err != nil {
-		return errors.Wrap(err, "failed to write the email message")
-	}
-	err = w.Close()
-	if err != nil {
-		return errors.Wrap(err, "failed to close connection to the SMTP server")
-	}
-
-	return nil
+	return m
 }
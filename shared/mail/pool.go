@@ -0,0 +1,241 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"io"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// ErrSMTPPoolClosed is returned when sending through a pool that was closed.
+var ErrSMTPPoolClosed = errors.New("the SMTP pool is closed")
+
+// PoolMetrics receives how many emails wait for a connection of an SMTP pool and how many
+// connections it keeps open.
+type PoolMetrics interface {
+	SetSMTPPoolQueueDepth(depth float64)
+	SetSMTPPoolOpenConnections(count float64)
+}
+
+type poolRequest struct {
+	mail   mailData
+	config *SMTPConfig
+	result chan error
+}
+
+// pooledConnection is an SMTP connection kept open between sends.
+type pooledConnection struct {
+	conn     net.Conn
+	client   *smtp.Client
+	key      string
+	openedAt time.Time
+}
+
+// SMTPPool sends emails in parallel through a bounded number of workers, each keeping its SMTP
+// connection open for the next emails until it's older than the TTL. A connection is checked
+// to still work before it's reused.
+type SMTPPool struct {
+	maxConnections int
+	ttl            time.Duration
+	metrics        PoolMetrics
+
+	mut     sync.RWMutex
+	closed  bool
+	queue   chan *poolRequest
+	workers sync.WaitGroup
+
+	waiting int64
+	open    int64
+}
+
+// NewSMTPPool starts the workers of a pool sending through at most maxConnections connections
+// at once. metrics may be nil.
+func NewSMTPPool(maxConnections int, ttl time.Duration, metrics PoolMetrics) *SMTPPool {
+	p := &SMTPPool{
+		maxConnections: maxConnections,
+		ttl:            ttl,
+		metrics:        metrics,
+		queue:          make(chan *poolRequest),
+	}
+
+	p.workers.Add(maxConnections)
+	for i := 0; i < maxConnections; i++ {
+		go p.worker()
+	}
+
+	return p
+}
+
+// MaxConnections returns how many connections the pool sends through at most.
+func (p *SMTPPool) MaxConnections() int {
+	return p.maxConnections
+}
+
+// TTL returns how long a connection of the pool is used for.
+func (p *SMTPPool) TTL() time.Duration {
+	return p.ttl
+}
+
+// SendMailUsingConfig is SendMailUsingConfig sending through the connections of the pool.
+func (p *SMTPPool) SendMailUsingConfig(to, subject, htmlBody string, config *SMTPConfig, enableComplianceFeatures bool, ccMail string) error {
+	return p.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, nil, config, enableComplianceFeatures, ccMail)
+}
+
+// SendMailWithEmbeddedFilesUsingConfig is SendMailWithEmbeddedFilesUsingConfig sending through
+// the connections of the pool.
+func (p *SMTPPool) SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, enableComplianceFeatures bool, ccMail string) error {
+	return deliverMail(newMailData(to, subject, htmlBody, embeddedFiles, config, ccMail), config, p.send)
+}
+
+// send waits for a worker to send the mail, returning the outcome.
+func (p *SMTPPool) send(mail mailData, config *SMTPConfig) error {
+	p.mut.RLock()
+	defer p.mut.RUnlock()
+
+	if p.closed {
+		return ErrSMTPPoolClosed
+	}
+
+	req := &poolRequest{mail: mail, config: config, result: make(chan error, 1)}
+
+	p.reportQueueDepth(atomic.AddInt64(&p.waiting, 1))
+	p.queue <- req
+
+	return <-req.result
+}
+
+// Close stops the workers once the emails given to them are sent, closing their connections.
+func (p *SMTPPool) Close() {
+	p.mut.Lock()
+	if p.closed {
+		p.mut.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.queue)
+	p.mut.Unlock()
+
+	p.workers.Wait()
+}
+
+func (p *SMTPPool) worker() {
+	defer p.workers.Done()
+
+	var pc *pooledConnection
+	defer func() {
+		p.closeConnection(pc)
+	}()
+
+	// Expired connections are closed even when no email comes.
+	ticker := time.NewTicker(p.ttl)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case req, ok := <-p.queue:
+			if !ok {
+				return
+			}
+			p.reportQueueDepth(atomic.AddInt64(&p.waiting, -1))
+
+			var err error
+			pc, err = p.connection(pc, req.config)
+			if err == nil {
+				if err = SendMail(pc.client, req.mail, time.Now()); err != nil {
+					p.closeConnection(pc)
+					pc = nil
+				}
+			}
+			req.result <- err
+		case <-ticker.C:
+			if pc != nil && time.Since(pc.openedAt) >= p.ttl {
+				p.closeConnection(pc)
+				pc = nil
+			}
+		}
+	}
+}
+
+// connection returns a working connection for the config, reusing pc when it's recent enough,
+// for the same server and still answering.
+func (p *SMTPPool) connection(pc *pooledConnection, config *SMTPConfig) (*pooledConnection, error) {
+	key := connectionKey(config)
+
+	if pc != nil {
+		if pc.key == key && time.Since(pc.openedAt) < p.ttl && p.healthy(pc, config) {
+			return pc, nil
+		}
+		p.closeConnection(pc)
+	}
+
+	conn, client, err := openSMTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	p.reportOpenConnections(atomic.AddInt64(&p.open, 1))
+
+	return &pooledConnection{conn: conn, client: client, key: key, openedAt: time.Now()}, nil
+}
+
+// healthy checks the server still answers on the connection, without waiting longer than the
+// timeout of the config.
+func (p *SMTPPool) healthy(pc *pooledConnection, config *SMTPConfig) bool {
+	pc.conn.SetDeadline(time.Now().Add(time.Duration(config.ServerTimeout) * time.Second))
+	defer pc.conn.SetDeadline(time.Time{})
+
+	if err := pc.client.Noop(); err != nil {
+		mlog.Debug("Pooled SMTP connection failed its health check", mlog.Err(err))
+		return false
+	}
+	return true
+}
+
+func (p *SMTPPool) closeConnection(pc *pooledConnection) {
+	if pc == nil {
+		return
+	}
+
+	if err := pc.client.Quit(); err != nil {
+		mlog.Debug("Failed to quit a pooled SMTP connection", mlog.Err(err))
+	}
+	pc.conn.Close()
+	p.reportOpenConnections(atomic.AddInt64(&p.open, -1))
+}
+
+func (p *SMTPPool) reportQueueDepth(depth int64) {
+	if p.metrics != nil {
+		p.metrics.SetSMTPPoolQueueDepth(float64(depth))
+	}
+}
+
+func (p *SMTPPool) reportOpenConnections(count int64) {
+	if p.metrics != nil {
+		p.metrics.SetSMTPPoolOpenConnections(float64(count))
+	}
+}
+
+// connectionKey tells apart the configs that can't share a connection.
+func connectionKey(config *SMTPConfig) string {
+	return strings.Join([]string{
+		config.Server,
+		config.Port,
+		config.ServerName,
+		config.Hostname,
+		config.ConnectionSecurity,
+		strconv.FormatBool(config.SkipServerCertificateVerification),
+		strconv.FormatBool(config.EnableSMTPAuth),
+		config.Username,
+		config.Password,
+	}, "\x00")
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts any email, counting the connections and the emails it gets.
+type fakeSMTPServer struct {
+	listener    net.Listener
+	connections int64
+	messages    int64
+	wg          sync.WaitGroup
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{listener: listener}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt64(&s.connections, 1)
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.serve(conn)
+			}()
+		}
+	}()
+
+	t.Cleanup(func() {
+		listener.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) bool {
+		_, err := conn.Write([]byte(line + "\r\n"))
+		return err == nil
+	}
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.Fields(line + " x")[0])
+		switch command {
+		case "EHLO", "HELO", "MAIL", "RCPT", "NOOP", "RSET":
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+			}
+			atomic.AddInt64(&s.messages, 1)
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) config() *SMTPConfig {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+
+	cfg := getConfig()
+	cfg.Server = host
+	cfg.ServerName = host
+	cfg.Port = port
+	return cfg
+}
+
+type poolMetricsRecorder struct {
+	mut             sync.Mutex
+	queueDepths     []float64
+	openConnections []float64
+}
+
+func (r *poolMetricsRecorder) SetSMTPPoolQueueDepth(depth float64) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.queueDepths = append(r.queueDepths, depth)
+}
+
+func (r *poolMetricsRecorder) SetSMTPPoolOpenConnections(count float64) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.openConnections = append(r.openConnections, count)
+}
+
+func TestSMTPPool(t *testing.T) {
+	t.Run("connections are reused", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		metrics := &poolMetricsRecorder{}
+		pool := NewSMTPPool(1, time.Minute, metrics)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, ""))
+		}
+		pool.Close()
+
+		assert.Equal(t, int64(1), atomic.LoadInt64(&server.connections))
+		assert.Equal(t, int64(3), atomic.LoadInt64(&server.messages))
+
+		metrics.mut.Lock()
+		defer metrics.mut.Unlock()
+		assert.Equal(t, []float64{1, 0}, metrics.openConnections)
+		assert.Equal(t, []float64{1, 0, 1, 0, 1, 0}, metrics.queueDepths)
+	})
+
+	t.Run("emails are sent in parallel", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		pool := NewSMTPPool(4, time.Minute, nil)
+		defer pool.Close()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, ""))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int64(20), atomic.LoadInt64(&server.messages))
+		assert.LessOrEqual(t, atomic.LoadInt64(&server.connections), int64(4))
+	})
+
+	t.Run("expired connections are replaced", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		pool := NewSMTPPool(1, 50*time.Millisecond, nil)
+		defer pool.Close()
+
+		require.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, ""))
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, ""))
+
+		assert.Equal(t, int64(2), atomic.LoadInt64(&server.connections))
+	})
+
+	t.Run("another server gets another connection", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		otherServer := newFakeSMTPServer(t)
+		pool := NewSMTPPool(1, time.Minute, nil)
+		defer pool.Close()
+
+		require.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, ""))
+		require.NoError(t, pool.SendMailUsingConfig("test@example.com", "Subject", "Body", otherServer.config(), false, ""))
+
+		assert.Equal(t, int64(1), atomic.LoadInt64(&server.messages))
+		assert.Equal(t, int64(1), atomic.LoadInt64(&otherServer.messages))
+	})
+
+	t.Run("closed pool", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		pool := NewSMTPPool(1, time.Minute, nil)
+		pool.Close()
+		pool.Close()
+
+		err := pool.SendMailUsingConfig("test@example.com", "Subject", "Body", server.config(), false, "")
+		assert.ErrorIs(t, err, ErrSMTPPoolClosed)
+	})
+}
+
+func TestConnectionKey(t *testing.T) {
+	cfg := getConfig()
+	other := getConfig()
+	assert.Equal(t, connectionKey(cfg), connectionKey(other))
+
+	other.Password = "other"
+	assert.NotEqual(t, connectionKey(cfg), connectionKey(other))
+
+	other = getConfig()
+	other.Port = "2525"
+	assert.NotEqual(t, connectionKey(cfg), connectionKey(other))
+}
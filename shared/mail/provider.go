@@ -0,0 +1,335 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/jaytaylor/html2text"
+	"github.com/pkg/errors"
+)
+
+const (
+	ProviderSMTP      = "smtp"
+	ProviderSendGrid  = "sendgrid"
+	ProviderAmazonSES = "amazon_ses"
+	ProviderMailgun   = "mailgun"
+
+	sendGridBaseURL  = "https://api.sendgrid.com"
+	mailgunBaseURL   = "https://api.mailgun.net"
+	mailgunEUBaseURL = "https://api.eu.mailgun.net"
+	mailgunEURegion  = "eu"
+)
+
+// SuppressionList tells whether emails must no longer be sent to an address, for instance
+// because it bounced or its owner complained.
+type SuppressionList interface {
+	IsSuppressed(address string) bool
+}
+
+// Provider sends emails through the HTTP API of an email service provider rather than
+// through an SMTP server.
+type Provider interface {
+	Send(mail mailData, date time.Time) error
+	TestConnection() error
+}
+
+// NewProvider returns the API based provider configured in config.
+func NewProvider(config *SMTPConfig) (Provider, error) {
+	client := &http.Client{Timeout: time.Duration(config.ServerTimeout) * time.Second}
+
+	switch config.Provider {
+	case ProviderSendGrid:
+		return &sendGridProvider{baseURL: sendGridBaseURL, apiKey: config.ProviderAPIKey, client: client}, nil
+	case ProviderAmazonSES:
+		return &amazonSESProvider{
+			baseURL:     fmt.Sprintf("https://email.%s.amazonaws.com", config.ProviderRegion),
+			region:      config.ProviderRegion,
+			credentials: credentials.NewStaticCredentials(config.ProviderAccessKeyId, config.ProviderAPIKey, ""),
+			client:      client,
+		}, nil
+	case ProviderMailgun:
+		baseURL := mailgunBaseURL
+		if config.ProviderRegion == mailgunEURegion {
+			baseURL = mailgunEUBaseURL
+		}
+		return &mailgunProvider{baseURL: baseURL, apiKey: config.ProviderAPIKey, domain: config.ProviderDomain, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", config.Provider)
+	}
+}
+
+// checkResponse closes the response body and returns an error unless its status is one of
+// the expected ones.
+func checkResponse(resp *http.Response, expected ...int) error {
+	defer resp.Body.Close()
+
+	for _, status := range expected {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+}
+
+func writeMIME(mail mailData, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := buildMessage(mail, date).WriteTo(&buf); err != nil {
+		return nil, errors.Wrap(err, "failed to write the email message")
+	}
+	return buf.Bytes(), nil
+}
+
+type sendGridProvider struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridAttachment struct {
+	Content     string `json:"content"`
+	Filename    string `json:"filename"`
+	Disposition string `json:"disposition"`
+	ContentID   string `json:"content_id"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+	Cc []sendGridAddress `json:"cc,omitempty"`
+}
+
+type sendGridMessage struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	ReplyTo          *sendGridAddress          `json:"reply_to,omitempty"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+	Headers          map[string]string         `json:"headers,omitempty"`
+}
+
+func (p *sendGridProvider) newRequest(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, p.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func (p *sendGridProvider) Send(mail mailData, date time.Time) error {
+	txtBody, err := html2text.FromString(mail.htmlBody)
+	if err != nil {
+		txtBody = ""
+	}
+
+	personalization := sendGridPersonalization{To: []sendGridAddress{{Email: mail.smtpTo}}}
+	if mail.cc != "" {
+		personalization.Cc = []sendGridAddress{{Email: mail.cc}}
+	}
+
+	message := sendGridMessage{
+		Personalizations: []sendGridPersonalization{personalization},
+		From:             sendGridAddress{Email: mail.from.Address, Name: mail.from.Name},
+		Subject:          mail.subject,
+		Content: []sendGridContent{
+			{Type: "text/plain", Value: txtBody},
+			{Type: "text/html", Value: mail.htmlBody},
+		},
+		Headers: map[string]string{
+			"Auto-Submitted": "auto-generated",
+			"Precedence":     "bulk",
+		},
+	}
+	if mail.replyTo.Address != "" {
+		message.ReplyTo = &sendGridAddress{Email: mail.replyTo.Address, Name: mail.replyTo.Name}
+	}
+	for k, v := range mail.mimeHeaders {
+		message.Headers[k] = v
+	}
+	for name, reader := range mail.embeddedFiles {
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read embedded file %s", name)
+		}
+		message.Attachments = append(message.Attachments, sendGridAttachment{
+			Content:     base64.StdEncoding.EncodeToString(data),
+			Filename:    name,
+			Disposition: "inline",
+			ContentID:   name,
+		})
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+
+	req, err := p.newRequest(http.MethodPost, "/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the email through SendGrid")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK, http.StatusAccepted), "failed to send the email through SendGrid")
+}
+
+func (p *sendGridProvider) TestConnection() error {
+	req, err := p.newRequest(http.MethodGet, "/v3/scopes", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to SendGrid")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK), "unable to connect to SendGrid")
+}
+
+type amazonSESProvider struct {
+	baseURL     string
+	region      string
+	credentials *credentials.Credentials
+	client      *http.Client
+}
+
+func (p *amazonSESProvider) do(method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, p.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := v4.NewSigner(p.credentials).Sign(req, bytes.NewReader(body), "ses", p.region, time.Now()); err != nil {
+		return nil, errors.Wrap(err, "failed to sign the request")
+	}
+
+	return p.client.Do(req)
+}
+
+func (p *amazonSESProvider) Send(mail mailData, date time.Time) error {
+	raw, err := writeMIME(mail, date)
+	if err != nil {
+		return err
+	}
+
+	destination := map[string][]string{"ToAddresses": {mail.smtpTo}}
+	if mail.cc != "" {
+		destination["CcAddresses"] = []string{mail.cc}
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"FromEmailAddress": mail.from.String(),
+		"Destination":      destination,
+		"Content": map[string]interface{}{
+			"Raw": map[string]string{"Data": base64.StdEncoding.EncodeToString(raw)},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+
+	resp, err := p.do(http.MethodPost, "/v2/email/outbound-emails", body)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the email through Amazon SES")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK), "failed to send the email through Amazon SES")
+}
+
+func (p *amazonSESProvider) TestConnection() error {
+	resp, err := p.do(http.MethodGet, "/v2/email/account", nil)
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to Amazon SES")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK), "unable to connect to Amazon SES")
+}
+
+type mailgunProvider struct {
+	baseURL string
+	apiKey  string
+	domain  string
+	client  *http.Client
+}
+
+func (p *mailgunProvider) Send(mail mailData, date time.Time) error {
+	raw, err := writeMIME(mail, date)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if err := w.WriteField("to", mail.smtpTo); err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+	if mail.cc != "" {
+		if err := w.WriteField("cc", mail.cc); err != nil {
+			return errors.Wrap(err, "failed to encode the email message")
+		}
+	}
+	part, err := w.CreateFormFile("message", "message.mime")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+	if _, err := part.Write(raw); err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "failed to encode the email message")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v3/%s/messages.mime", p.baseURL, p.domain), &body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("api", p.apiKey)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the email through Mailgun")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK), "failed to send the email through Mailgun")
+}
+
+func (p *mailgunProvider) TestConnection() error {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v3/domains/%s", p.baseURL, p.domain), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("api", p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "unable to connect to Mailgun")
+	}
+	return errors.Wrap(checkResponse(resp, http.StatusOK), "unable to connect to Mailgun")
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProviderMail() mailData {
+	return mailData{
+		mimeTo:   "test@example.com",
+		smtpTo:   "test@example.com",
+		from:     mail.Address{Name: "Nobody", Address: "nobody@mattermost.com"},
+		replyTo:  mail.Address{Name: "Nobody", Address: "reply@mattermost.com"},
+		subject:  "Test subject",
+		htmlBody: "<p>Test body</p>",
+	}
+}
+
+type fakeSuppressionList map[string]bool
+
+func (l fakeSuppressionList) IsSuppressed(address string) bool {
+	return l[address]
+}
+
+func TestNewProvider(t *testing.T) {
+	p, err := NewProvider(&SMTPConfig{Provider: ProviderMailgun, ProviderRegion: "eu", ProviderDomain: "mg.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, mailgunEUBaseURL, p.(*mailgunProvider).baseURL)
+
+	p, err = NewProvider(&SMTPConfig{Provider: ProviderAmazonSES, ProviderRegion: "us-east-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://email.us-east-1.amazonaws.com", p.(*amazonSESProvider).baseURL)
+
+	_, err = NewProvider(&SMTPConfig{Provider: "unknown"})
+	require.Error(t, err)
+}
+
+func TestSendGridProviderSend(t *testing.T) {
+	var received sendGridMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mail/send", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	p := &sendGridProvider{baseURL: server.URL, apiKey: "key", client: server.Client()}
+	require.NoError(t, p.Send(testProviderMail(), time.Now()))
+
+	require.Len(t, received.Personalizations, 1)
+	assert.Equal(t, "test@example.com", received.Personalizations[0].To[0].Email)
+	assert.Equal(t, "nobody@mattermost.com", received.From.Email)
+	assert.Equal(t, "reply@mattermost.com", received.ReplyTo.Email)
+	assert.Equal(t, "Test subject", received.Subject)
+	require.Len(t, received.Content, 2)
+	assert.Equal(t, "<p>Test body</p>", received.Content[1].Value)
+
+	t.Run("error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		p := &sendGridProvider{baseURL: server.URL, apiKey: "key", client: server.Client()}
+		require.Error(t, p.Send(testProviderMail(), time.Now()))
+	})
+}
+
+func TestAmazonSESProviderSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/email/outbound-emails", r.URL.Path)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/"))
+
+		var body struct {
+			Destination struct{ ToAddresses []string }
+			Content     struct{ Raw struct{ Data string } }
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"test@example.com"}, body.Destination.ToAddresses)
+
+		raw, err := base64.StdEncoding.DecodeString(body.Content.Raw.Data)
+		require.NoError(t, err)
+		assert.Contains(t, string(raw), "Subject: Test subject")
+
+		w.Write([]byte(`{"MessageId":"id"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewProvider(&SMTPConfig{Provider: ProviderAmazonSES, ProviderAccessKeyId: "access", ProviderAPIKey: "secret", ProviderRegion: "us-east-1"})
+	require.NoError(t, err)
+	p.(*amazonSESProvider).baseURL = server.URL
+
+	require.NoError(t, p.Send(testProviderMail(), time.Now()))
+}
+
+func TestMailgunProviderSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mg.example.com/messages.mime", r.URL.Path)
+		user, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, "api", user)
+		assert.Equal(t, "key", password)
+
+		assert.Equal(t, "test@example.com", r.FormValue("to"))
+		file, _, err := r.FormFile("message")
+		require.NoError(t, err)
+		raw, err := ioutil.ReadAll(file)
+		require.NoError(t, err)
+		assert.Contains(t, string(raw), "Subject: Test subject")
+	}))
+	defer server.Close()
+
+	p := &mailgunProvider{baseURL: server.URL, apiKey: "key", domain: "mg.example.com", client: server.Client()}
+	require.NoError(t, p.Send(testProviderMail(), time.Now()))
+}
+
+func TestSendMailUsingConfigSuppressed(t *testing.T) {
+	cfg := &SMTPConfig{
+		Provider:     ProviderSendGrid,
+		Suppressions: fakeSuppressionList{"test@example.com": true},
+	}
+
+	// The provider is never called, so sending succeeds without a reachable API.
+	require.NoError(t, sendMailUsingConfigAdvanced(testProviderMail(), cfg))
+}
@@ -27,6 +27,19 @@ func isError(typeName string) bool {
 	return strings.Contains(typeName, ErrorType)
 }
 
+// readOnlyMethodPrefixes are the prefixes of the names of the store methods which only read,
+// and so can be attempted again whatever their failure.
+var readOnlyMethodPrefixes = []string{"Get", "Search", "Count", "Analytics", "Autocomplete", "Is", "Has", "Exists", "Find", "List"}
+
+func isReadOnlyMethod(name string) bool {
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func main() {
 	if err := buildTimerLayer(); err != nil {
 		log.Fatal(err)
@@ -243,6 +256,20 @@ func generateLayer(name, templateFile string) ([]byte, error) {
 			}
 			return strings.Join(vars, ", ")
 		},
+		"declareResultsVars": func(results []string) string {
+			vars := []string{}
+			for i, typeName := range results {
+				if isError(typeName) {
+					continue
+				} else if i == 0 {
+					vars = append(vars, fmt.Sprintf("var result %s", typeName))
+				} else {
+					vars = append(vars, fmt.Sprintf("var resultVar%d %s", i, typeName))
+				}
+			}
+			return strings.Join(vars, "\n")
+		},
+		"isReadOnlyMethod": isReadOnlyMethod,
 		"errorToBoolean": func(results []string) string {
 			for _, typeName := range results {
 				if isError(typeName) {
@@ -282,7 +309,7 @@ func generateLayer(name, templateFile string) ([]byte, error) {
 			paramsWithType := []string{}
 			for _, param := range params {
 				switch param.Type {
-				case "ChannelSearchOpts", "UserGetByIdsOpts", "ThreadMembershipOpts":
+				case "ChannelSearchOpts", "UserGetByIdsOpts", "ThreadMembershipOpts", "ActivityEventGetOpts", "PostExtras":
 					paramsWithType = append(paramsWithType, fmt.Sprintf("%s store.%s", param.Name, param.Type))
 				case "*UserGetByIdsOpts":
 					paramsWithType = append(paramsWithType, fmt.Sprintf("%s *store.UserGetByIdsOpts", param.Name))
@@ -296,7 +323,7 @@ func generateLayer(name, templateFile string) ([]byte, error) {
 			paramsWithType := []string{}
 			for _, param := range params {
 				switch param.Type {
-				case "ChannelSearchOpts", "UserGetByIdsOpts", "ThreadMembershipOpts":
+				case "ChannelSearchOpts", "UserGetByIdsOpts", "ThreadMembershipOpts", "ActivityEventGetOpts", "PostExtras":
 					paramsWithType = append(paramsWithType, fmt.Sprintf("%s store.%s", param.Name, param.Type))
 				case "*UserGetByIdsOpts":
 					paramsWithType = append(paramsWithType, fmt.Sprintf("%s *store.UserGetByIdsOpts", param.Name))
@@ -210,6 +210,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ChannelPinnedPostsCounts",
 		DefaultExpiry:          ChannelPinnedPostsCountsCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForChannelPinnedpostsCounts,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -218,6 +219,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ChannelMemberCounts",
 		DefaultExpiry:          ChannelMembersCountsCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForChannelMemberCounts,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -226,6 +228,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ChannelGuestsCount",
 		DefaultExpiry:          ChannelGuestCountCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForChannelGuestCount,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -234,6 +237,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "channelById",
 		DefaultExpiry:          ChannelCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForChannel,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -277,6 +281,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForProfileByIds,
 		Striped:                true,
 		StripedBuckets:         maxInt(runtime.NumCPU()-1, 1),
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
@@ -285,6 +290,7 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		Name:                   "ProfilesInChannel",
 		DefaultExpiry:          ProfilesInChannelCacheSec * time.Second,
 		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForProfileInChannel,
+		Shared:                 true,
 	}); err != nil {
 		return
 	}
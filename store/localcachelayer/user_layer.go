@@ -106,14 +106,15 @@ func (s *LocalCacheUserStore) GetAllProfilesInChannel(ctx context.Context, chann
 }
 
 func (s *LocalCacheUserStore) GetProfileByIds(ctx context.Context, userIds []string, options *store.UserGetByIdsOpts, allowFromCache bool) ([]*model.User, error) {
-	if !allowFromCache {
-		return s.UserStore.GetProfileByIds(ctx, userIds, options, false)
-	}
-
 	if options == nil {
 		options = &store.UserGetByIdsOpts{}
 	}
 
+	// The users fetched with some of their fields only must not end up in the cache.
+	if !allowFromCache || len(options.Fields) > 0 {
+		return s.UserStore.GetProfileByIds(ctx, userIds, options, false)
+	}
+
 	users := []*model.User{}
 	remainingUserIds := make([]string, 0)
 
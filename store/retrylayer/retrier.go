@@ -0,0 +1,189 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package retrylayer
+
+import (
+	"database/sql/driver"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/einterfaces"
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+const mySQLDeadlockCode = uint16(1213)
+
+const (
+	retryReasonDeadlock   = "deadlock"
+	retryReasonConnection = "connection"
+)
+
+// ErrReadOnlyMode is returned for the writes refused while the primary database is considered
+// down.
+var ErrReadOnlyMode = errors.New("the database is in read-only mode as the primary can't be reached")
+
+// retrier decides which failed store calls are attempted again, and refuses the writes for a
+// while once too many of them in a row lost their connection to the primary database.
+type retrier struct {
+	maxAttempts      int
+	backoff          time.Duration
+	failureThreshold int
+	cooldown         time.Duration
+	metrics          einterfaces.MetricsInterface
+
+	mut           sync.Mutex
+	writeFailures int
+	readOnly      bool
+	readOnlyUntil time.Time
+}
+
+func newRetrier(settings model.SqlSettings, metrics einterfaces.MetricsInterface) *retrier {
+	settings.SetDefaults(false)
+	return &retrier{
+		maxAttempts:      *settings.RetryMaxAttempts,
+		backoff:          time.Duration(*settings.RetryBackoffMilliseconds) * time.Millisecond,
+		failureThreshold: *settings.ReadOnlyModeFailureThreshold,
+		cooldown:         time.Duration(*settings.ReadOnlyModeCooldownSeconds) * time.Second,
+		metrics:          metrics,
+	}
+}
+
+// allow returns ErrReadOnlyMode for the writes made while the store is in read-only mode. Once
+// the cooldown is over, the writes are let through again to find out whether the primary is
+// back.
+func (r *retrier) allow(readOnly bool) error {
+	if readOnly {
+		return nil
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	if r.readOnly && time.Now().Before(r.readOnlyUntil) {
+		return ErrReadOnlyMode
+	}
+	return nil
+}
+
+// retry records the outcome of an attempt of a store call and reports whether the call should be
+// attempted again, after waiting for the backoff. The calls failing on a deadlock are always
+// retried as their transaction was rolled back, but the calls losing their connection only when
+// they are reads, as a write may have been applied before the connection was lost. The error is
+// wrapped when giving up on a call that could have been retried.
+func (r *retrier) retry(method string, readOnly bool, attempts int, err error) (bool, error) {
+	if errors.Is(err, ErrReadOnlyMode) {
+		return false, err
+	}
+
+	reason := retryReason(err)
+	if !readOnly {
+		r.recordWrite(reason == retryReasonConnection)
+	}
+
+	if reason == "" || (reason == retryReasonConnection && !readOnly) {
+		return false, err
+	}
+
+	if attempts >= r.maxAttempts {
+		return false, errors.Wrapf(err, "giving up after %d consecutive repeatable failures", attempts)
+	}
+
+	if r.metrics != nil {
+		r.metrics.IncrementStoreRetryCounter(method, reason)
+	}
+	time.Sleep(r.backoff << (attempts - 1))
+	return true, nil
+}
+
+// recordWrite counts the writes in a row which lost their connection, switching the store to
+// read-only mode past the threshold, and back once a write succeeds.
+func (r *retrier) recordWrite(connectionLost bool) {
+	if r.failureThreshold == 0 {
+		return
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	if !connectionLost {
+		r.writeFailures = 0
+		if r.readOnly {
+			r.readOnly = false
+			mlog.Info("The primary database can be reached again, leaving read-only mode.")
+			if r.metrics != nil {
+				r.metrics.SetStoreReadOnlyMode(false)
+			}
+		}
+		return
+	}
+
+	r.writeFailures++
+	if r.writeFailures < r.failureThreshold {
+		return
+	}
+
+	r.readOnlyUntil = time.Now().Add(r.cooldown)
+	if !r.readOnly {
+		r.readOnly = true
+		mlog.Error("The primary database can't be reached, switching to read-only mode.", mlog.Int("failed_writes", r.writeFailures), mlog.Duration("cooldown", r.cooldown))
+		if r.metrics != nil {
+			r.metrics.SetStoreReadOnlyMode(true)
+		}
+	}
+}
+
+// retryReason tells why the error could go away when trying again, if it can.
+func retryReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	if isRepeatableError(err) {
+		return retryReasonDeadlock
+	}
+	if isConnectionError(err) {
+		return retryReasonConnection
+	}
+	return ""
+}
+
+func isRepeatableError(err error) bool {
+	var pqErr *pq.Error
+	var mysqlErr *mysql.MySQLError
+	switch {
+	case errors.As(errors.Cause(err), &pqErr):
+		if pqErr.Code == "40001" || pqErr.Code == "40P01" {
+			return true
+		}
+	case errors.As(errors.Cause(err), &mysqlErr):
+		if mysqlErr.Number == mySQLDeadlockCode {
+			return true
+		}
+	}
+	return false
+}
+
+// isConnectionError reports whether the error comes from the connection to the database being
+// lost or refused rather than from the query.
+func isConnectionError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// Connection exceptions, and the server shutting down.
+		return pqErr.Code.Class() == "08" || pqErr.Code == "57P01" || pqErr.Code == "57P02" || pqErr.Code == "57P03"
+	}
+
+	var netErr *net.OpError
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.As(err, &netErr)
+}
@@ -8,70 +8,158 @@ package retrylayer
 
 import (
 	"context"
-	timepkg "time"
 
-	"github.com/go-sql-driver/mysql"
-	"github.com/lib/pq"
+	"github.com/mattermost/mattermost-server/v6/einterfaces"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/store"
-	"github.com/pkg/errors"
 )
 
-const mySQLDeadlockCode = uint16(1213)
-
 type RetryLayer struct {
 	store.Store
-	AuditStore                store.AuditStore
-	BotStore                  store.BotStore
-	ChannelStore              store.ChannelStore
-	ChannelMemberHistoryStore store.ChannelMemberHistoryStore
-	ClusterDiscoveryStore     store.ClusterDiscoveryStore
-	CommandStore              store.CommandStore
-	CommandWebhookStore       store.CommandWebhookStore
-	ComplianceStore           store.ComplianceStore
-	EmojiStore                store.EmojiStore
-	FileInfoStore             store.FileInfoStore
-	GroupStore                store.GroupStore
-	JobStore                  store.JobStore
-	LicenseStore              store.LicenseStore
-	LinkMetadataStore         store.LinkMetadataStore
-	OAuthStore                store.OAuthStore
-	PluginStore               store.PluginStore
-	PostStore                 store.PostStore
-	PreferenceStore           store.PreferenceStore
-	ProductNoticesStore       store.ProductNoticesStore
-	ReactionStore             store.ReactionStore
-	RemoteClusterStore        store.RemoteClusterStore
-	RetentionPolicyStore      store.RetentionPolicyStore
-	RoleStore                 store.RoleStore
-	SchemeStore               store.SchemeStore
-	SessionStore              store.SessionStore
-	SharedChannelStore        store.SharedChannelStore
-	StatusStore               store.StatusStore
-	SystemStore               store.SystemStore
-	TeamStore                 store.TeamStore
-	TermsOfServiceStore       store.TermsOfServiceStore
-	ThreadStore               store.ThreadStore
-	TokenStore                store.TokenStore
-	UploadSessionStore        store.UploadSessionStore
-	UserStore                 store.UserStore
-	UserAccessTokenStore      store.UserAccessTokenStore
-	UserTermsOfServiceStore   store.UserTermsOfServiceStore
-	WebhookStore              store.WebhookStore
+	retrier                      *retrier
+	APIUsageStore                store.APIUsageStore
+	ActivityEventStore           store.ActivityEventStore
+	AlertRuleStore               store.AlertRuleStore
+	AuditStore                   store.AuditStore
+	AuditLogStore                store.AuditLogStore
+	BotStore                     store.BotStore
+	BotStateStore                store.BotStateStore
+	BotTokenRotationStore        store.BotTokenRotationStore
+	CannedResponseStore          store.CannedResponseStore
+	ChannelStore                 store.ChannelStore
+	ChannelArchivePolicyStore    store.ChannelArchivePolicyStore
+	ChannelCommandOverrideStore  store.ChannelCommandOverrideStore
+	ChannelDigestStore           store.ChannelDigestStore
+	ChannelEventStore            store.ChannelEventStore
+	ChannelFeedStore             store.ChannelFeedStore
+	ChannelLanguageStatsStore    store.ChannelLanguageStatsStore
+	ChannelMemberHistoryStore    store.ChannelMemberHistoryStore
+	ClusterDiscoveryStore        store.ClusterDiscoveryStore
+	CommandStore                 store.CommandStore
+	CommandWebhookStore          store.CommandWebhookStore
+	ComplianceStore              store.ComplianceStore
+	ConfigHistoryStore           store.ConfigHistoryStore
+	CustomStatusTemplateStore    store.CustomStatusTemplateStore
+	DirectChannelRetentionStore  store.DirectChannelRetentionStore
+	EmailSuppressionStore        store.EmailSuppressionStore
+	EmojiStore                   store.EmojiStore
+	EventWebhookStore            store.EventWebhookStore
+	FileInfoStore                store.FileInfoStore
+	GroupStore                   store.GroupStore
+	IdempotencyKeyStore          store.IdempotencyKeyStore
+	ImpersonationStore           store.ImpersonationStore
+	JobStore                     store.JobStore
+	LicenseStore                 store.LicenseStore
+	LicenseUsageStore            store.LicenseUsageStore
+	LinkMetadataStore            store.LinkMetadataStore
+	OAuthStore                   store.OAuthStore
+	OAuthAppUsageStore           store.OAuthAppUsageStore
+	OutstandingMentionStore      store.OutstandingMentionStore
+	PermissionDenialStore        store.PermissionDenialStore
+	PluginStore                  store.PluginStore
+	PostStore                    store.PostStore
+	PostAcknowledgementStore     store.PostAcknowledgementStore
+	PostModerationStore          store.PostModerationStore
+	PostPriorityStore            store.PostPriorityStore
+	PostReportStore              store.PostReportStore
+	PostTaskStore                store.PostTaskStore
+	PreferenceStore              store.PreferenceStore
+	ProductNoticesStore          store.ProductNoticesStore
+	RESTProxyBridgeStore         store.RESTProxyBridgeStore
+	ReactionStore                store.ReactionStore
+	RemoteClusterStore           store.RemoteClusterStore
+	RetentionPolicyStore         store.RetentionPolicyStore
+	RoleStore                    store.RoleStore
+	ScheduledChannelMessageStore store.ScheduledChannelMessageStore
+	SchemeStore                  store.SchemeStore
+	SessionStore                 store.SessionStore
+	SharedChannelStore           store.SharedChannelStore
+	StatusStore                  store.StatusStore
+	SystemStore                  store.SystemStore
+	TeamStore                    store.TeamStore
+	TeamAliasStore               store.TeamAliasStore
+	TeamBannerStore              store.TeamBannerStore
+	TeamDeletionStore            store.TeamDeletionStore
+	TeamGroupRoleStore           store.TeamGroupRoleStore
+	TeamInviteUsageStore         store.TeamInviteUsageStore
+	TeamRequestStore             store.TeamRequestStore
+	TeamStatsStore               store.TeamStatsStore
+	TermsOfServiceStore          store.TermsOfServiceStore
+	ThreadStore                  store.ThreadStore
+	TokenStore                   store.TokenStore
+	UploadSessionStore           store.UploadSessionStore
+	UploadUsageStore             store.UploadUsageStore
+	UserStore                    store.UserStore
+	UserAccessTokenStore         store.UserAccessTokenStore
+	UserMergeStore               store.UserMergeStore
+	UserTermsOfServiceStore      store.UserTermsOfServiceStore
+	WebhookStore                 store.WebhookStore
+}
+
+func (s *RetryLayer) APIUsage() store.APIUsageStore {
+	return s.APIUsageStore
+}
+
+func (s *RetryLayer) ActivityEvent() store.ActivityEventStore {
+	return s.ActivityEventStore
+}
+
+func (s *RetryLayer) AlertRule() store.AlertRuleStore {
+	return s.AlertRuleStore
 }
 
 func (s *RetryLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *RetryLayer) AuditLog() store.AuditLogStore {
+	return s.AuditLogStore
+}
+
 func (s *RetryLayer) Bot() store.BotStore {
 	return s.BotStore
 }
 
+func (s *RetryLayer) BotState() store.BotStateStore {
+	return s.BotStateStore
+}
+
+func (s *RetryLayer) BotTokenRotation() store.BotTokenRotationStore {
+	return s.BotTokenRotationStore
+}
+
+func (s *RetryLayer) CannedResponse() store.CannedResponseStore {
+	return s.CannedResponseStore
+}
+
 func (s *RetryLayer) Channel() store.ChannelStore {
 	return s.ChannelStore
 }
 
+func (s *RetryLayer) ChannelArchivePolicy() store.ChannelArchivePolicyStore {
+	return s.ChannelArchivePolicyStore
+}
+
+func (s *RetryLayer) ChannelCommandOverride() store.ChannelCommandOverrideStore {
+	return s.ChannelCommandOverrideStore
+}
+
+func (s *RetryLayer) ChannelDigest() store.ChannelDigestStore {
+	return s.ChannelDigestStore
+}
+
+func (s *RetryLayer) ChannelEvent() store.ChannelEventStore {
+	return s.ChannelEventStore
+}
+
+func (s *RetryLayer) ChannelFeed() store.ChannelFeedStore {
+	return s.ChannelFeedStore
+}
+
+func (s *RetryLayer) ChannelLanguageStats() store.ChannelLanguageStatsStore {
+	return s.ChannelLanguageStatsStore
+}
+
 func (s *RetryLayer) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return s.ChannelMemberHistoryStore
 }
@@ -92,10 +180,30 @@ func (s *RetryLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *RetryLayer) ConfigHistory() store.ConfigHistoryStore {
+	return s.ConfigHistoryStore
+}
+
+func (s *RetryLayer) CustomStatusTemplate() store.CustomStatusTemplateStore {
+	return s.CustomStatusTemplateStore
+}
+
+func (s *RetryLayer) DirectChannelRetention() store.DirectChannelRetentionStore {
+	return s.DirectChannelRetentionStore
+}
+
+func (s *RetryLayer) EmailSuppression() store.EmailSuppressionStore {
+	return s.EmailSuppressionStore
+}
+
 func (s *RetryLayer) Emoji() store.EmojiStore {
 	return s.EmojiStore
 }
 
+func (s *RetryLayer) EventWebhook() store.EventWebhookStore {
+	return s.EventWebhookStore
+}
+
 func (s *RetryLayer) FileInfo() store.FileInfoStore {
 	return s.FileInfoStore
 }
@@ -104,6 +212,14 @@ func (s *RetryLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *RetryLayer) IdempotencyKey() store.IdempotencyKeyStore {
+	return s.IdempotencyKeyStore
+}
+
+func (s *RetryLayer) Impersonation() store.ImpersonationStore {
+	return s.ImpersonationStore
+}
+
 func (s *RetryLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -112,6 +228,10 @@ func (s *RetryLayer) License() store.LicenseStore {
 	return s.LicenseStore
 }
 
+func (s *RetryLayer) LicenseUsage() store.LicenseUsageStore {
+	return s.LicenseUsageStore
+}
+
 func (s *RetryLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
@@ -120,6 +240,18 @@ func (s *RetryLayer) OAuth() store.OAuthStore {
 	return s.OAuthStore
 }
 
+func (s *RetryLayer) OAuthAppUsage() store.OAuthAppUsageStore {
+	return s.OAuthAppUsageStore
+}
+
+func (s *RetryLayer) OutstandingMention() store.OutstandingMentionStore {
+	return s.OutstandingMentionStore
+}
+
+func (s *RetryLayer) PermissionDenial() store.PermissionDenialStore {
+	return s.PermissionDenialStore
+}
+
 func (s *RetryLayer) Plugin() store.PluginStore {
 	return s.PluginStore
 }
@@ -128,6 +260,26 @@ func (s *RetryLayer) Post() store.PostStore {
 	return s.PostStore
 }
 
+func (s *RetryLayer) PostAcknowledgement() store.PostAcknowledgementStore {
+	return s.PostAcknowledgementStore
+}
+
+func (s *RetryLayer) PostModeration() store.PostModerationStore {
+	return s.PostModerationStore
+}
+
+func (s *RetryLayer) PostPriority() store.PostPriorityStore {
+	return s.PostPriorityStore
+}
+
+func (s *RetryLayer) PostReport() store.PostReportStore {
+	return s.PostReportStore
+}
+
+func (s *RetryLayer) PostTask() store.PostTaskStore {
+	return s.PostTaskStore
+}
+
 func (s *RetryLayer) Preference() store.PreferenceStore {
 	return s.PreferenceStore
 }
@@ -136,6 +288,10 @@ func (s *RetryLayer) ProductNotices() store.ProductNoticesStore {
 	return s.ProductNoticesStore
 }
 
+func (s *RetryLayer) RESTProxyBridge() store.RESTProxyBridgeStore {
+	return s.RESTProxyBridgeStore
+}
+
 func (s *RetryLayer) Reaction() store.ReactionStore {
 	return s.ReactionStore
 }
@@ -152,6 +308,10 @@ func (s *RetryLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *RetryLayer) ScheduledChannelMessage() store.ScheduledChannelMessageStore {
+	return s.ScheduledChannelMessageStore
+}
+
 func (s *RetryLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -176,6 +336,34 @@ func (s *RetryLayer) Team() store.TeamStore {
 	return s.TeamStore
 }
 
+func (s *RetryLayer) TeamAlias() store.TeamAliasStore {
+	return s.TeamAliasStore
+}
+
+func (s *RetryLayer) TeamBanner() store.TeamBannerStore {
+	return s.TeamBannerStore
+}
+
+func (s *RetryLayer) TeamDeletion() store.TeamDeletionStore {
+	return s.TeamDeletionStore
+}
+
+func (s *RetryLayer) TeamGroupRole() store.TeamGroupRoleStore {
+	return s.TeamGroupRoleStore
+}
+
+func (s *RetryLayer) TeamInviteUsage() store.TeamInviteUsageStore {
+	return s.TeamInviteUsageStore
+}
+
+func (s *RetryLayer) TeamRequest() store.TeamRequestStore {
+	return s.TeamRequestStore
+}
+
+func (s *RetryLayer) TeamStats() store.TeamStatsStore {
+	return s.TeamStatsStore
+}
+
 func (s *RetryLayer) TermsOfService() store.TermsOfServiceStore {
 	return s.TermsOfServiceStore
 }
@@ -192,6 +380,10 @@ func (s *RetryLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
 
+func (s *RetryLayer) UploadUsage() store.UploadUsageStore {
+	return s.UploadUsageStore
+}
+
 func (s *RetryLayer) User() store.UserStore {
 	return s.UserStore
 }
@@ -200,6 +392,10 @@ func (s *RetryLayer) UserAccessToken() store.UserAccessTokenStore {
 	return s.UserAccessTokenStore
 }
 
+func (s *RetryLayer) UserMerge() store.UserMergeStore {
+	return s.UserMergeStore
+}
+
 func (s *RetryLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
@@ -208,21 +404,86 @@ func (s *RetryLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
 
+type RetryLayerAPIUsageStore struct {
+	store.APIUsageStore
+	Root *RetryLayer
+}
+
+type RetryLayerActivityEventStore struct {
+	store.ActivityEventStore
+	Root *RetryLayer
+}
+
+type RetryLayerAlertRuleStore struct {
+	store.AlertRuleStore
+	Root *RetryLayer
+}
+
 type RetryLayerAuditStore struct {
 	store.AuditStore
 	Root *RetryLayer
 }
 
+type RetryLayerAuditLogStore struct {
+	store.AuditLogStore
+	Root *RetryLayer
+}
+
 type RetryLayerBotStore struct {
 	store.BotStore
 	Root *RetryLayer
 }
 
+type RetryLayerBotStateStore struct {
+	store.BotStateStore
+	Root *RetryLayer
+}
+
+type RetryLayerBotTokenRotationStore struct {
+	store.BotTokenRotationStore
+	Root *RetryLayer
+}
+
+type RetryLayerCannedResponseStore struct {
+	store.CannedResponseStore
+	Root *RetryLayer
+}
+
 type RetryLayerChannelStore struct {
 	store.ChannelStore
 	Root *RetryLayer
 }
 
+type RetryLayerChannelArchivePolicyStore struct {
+	store.ChannelArchivePolicyStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelCommandOverrideStore struct {
+	store.ChannelCommandOverrideStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelDigestStore struct {
+	store.ChannelDigestStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelEventStore struct {
+	store.ChannelEventStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelFeedStore struct {
+	store.ChannelFeedStore
+	Root *RetryLayer
+}
+
+type RetryLayerChannelLanguageStatsStore struct {
+	store.ChannelLanguageStatsStore
+	Root *RetryLayer
+}
+
 type RetryLayerChannelMemberHistoryStore struct {
 	store.ChannelMemberHistoryStore
 	Root *RetryLayer
@@ -248,11 +509,36 @@ type RetryLayerComplianceStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerConfigHistoryStore struct {
+	store.ConfigHistoryStore
+	Root *RetryLayer
+}
+
+type RetryLayerCustomStatusTemplateStore struct {
+	store.CustomStatusTemplateStore
+	Root *RetryLayer
+}
+
+type RetryLayerDirectChannelRetentionStore struct {
+	store.DirectChannelRetentionStore
+	Root *RetryLayer
+}
+
+type RetryLayerEmailSuppressionStore struct {
+	store.EmailSuppressionStore
+	Root *RetryLayer
+}
+
 type RetryLayerEmojiStore struct {
 	store.EmojiStore
 	Root *RetryLayer
 }
 
+type RetryLayerEventWebhookStore struct {
+	store.EventWebhookStore
+	Root *RetryLayer
+}
+
 type RetryLayerFileInfoStore struct {
 	store.FileInfoStore
 	Root *RetryLayer
@@ -263,6 +549,16 @@ type RetryLayerGroupStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerIdempotencyKeyStore struct {
+	store.IdempotencyKeyStore
+	Root *RetryLayer
+}
+
+type RetryLayerImpersonationStore struct {
+	store.ImpersonationStore
+	Root *RetryLayer
+}
+
 type RetryLayerJobStore struct {
 	store.JobStore
 	Root *RetryLayer
@@ -273,6 +569,11 @@ type RetryLayerLicenseStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerLicenseUsageStore struct {
+	store.LicenseUsageStore
+	Root *RetryLayer
+}
+
 type RetryLayerLinkMetadataStore struct {
 	store.LinkMetadataStore
 	Root *RetryLayer
@@ -283,6 +584,21 @@ type RetryLayerOAuthStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerOAuthAppUsageStore struct {
+	store.OAuthAppUsageStore
+	Root *RetryLayer
+}
+
+type RetryLayerOutstandingMentionStore struct {
+	store.OutstandingMentionStore
+	Root *RetryLayer
+}
+
+type RetryLayerPermissionDenialStore struct {
+	store.PermissionDenialStore
+	Root *RetryLayer
+}
+
 type RetryLayerPluginStore struct {
 	store.PluginStore
 	Root *RetryLayer
@@ -293,6 +609,31 @@ type RetryLayerPostStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerPostAcknowledgementStore struct {
+	store.PostAcknowledgementStore
+	Root *RetryLayer
+}
+
+type RetryLayerPostModerationStore struct {
+	store.PostModerationStore
+	Root *RetryLayer
+}
+
+type RetryLayerPostPriorityStore struct {
+	store.PostPriorityStore
+	Root *RetryLayer
+}
+
+type RetryLayerPostReportStore struct {
+	store.PostReportStore
+	Root *RetryLayer
+}
+
+type RetryLayerPostTaskStore struct {
+	store.PostTaskStore
+	Root *RetryLayer
+}
+
 type RetryLayerPreferenceStore struct {
 	store.PreferenceStore
 	Root *RetryLayer
@@ -303,6 +644,11 @@ type RetryLayerProductNoticesStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerRESTProxyBridgeStore struct {
+	store.RESTProxyBridgeStore
+	Root *RetryLayer
+}
+
 type RetryLayerReactionStore struct {
 	store.ReactionStore
 	Root *RetryLayer
@@ -323,6 +669,11 @@ type RetryLayerRoleStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerScheduledChannelMessageStore struct {
+	store.ScheduledChannelMessageStore
+	Root *RetryLayer
+}
+
 type RetryLayerSchemeStore struct {
 	store.SchemeStore
 	Root *RetryLayer
@@ -353,6 +704,41 @@ type RetryLayerTeamStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerTeamAliasStore struct {
+	store.TeamAliasStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamBannerStore struct {
+	store.TeamBannerStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamDeletionStore struct {
+	store.TeamDeletionStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamGroupRoleStore struct {
+	store.TeamGroupRoleStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamInviteUsageStore struct {
+	store.TeamInviteUsageStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamRequestStore struct {
+	store.TeamRequestStore
+	Root *RetryLayer
+}
+
+type RetryLayerTeamStatsStore struct {
+	store.TeamStatsStore
+	Root *RetryLayer
+}
+
 type RetryLayerTermsOfServiceStore struct {
 	store.TermsOfServiceStore
 	Root *RetryLayer
@@ -373,6 +759,11 @@ type RetryLayerUploadSessionStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerUploadUsageStore struct {
+	store.UploadUsageStore
+	Root *RetryLayer
+}
+
 type RetryLayerUserStore struct {
 	store.UserStore
 	Root *RetryLayer
@@ -383,6 +774,11 @@ type RetryLayerUserAccessTokenStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerUserMergeStore struct {
+	store.UserMergeStore
+	Root *RetryLayer
+}
+
 type RetryLayerUserTermsOfServiceStore struct {
 	store.UserTermsOfServiceStore
 	Root *RetryLayer
@@ -393,10065 +789,12898 @@ type RetryLayerWebhookStore struct {
 	Root *RetryLayer
 }
 
-func isRepeatableError(err error) bool {
-	var pqErr *pq.Error
-	var mysqlErr *mysql.MySQLError
-	switch {
-	case errors.As(errors.Cause(err), &pqErr):
-		if pqErr.Code == "40001" || pqErr.Code == "40P01" {
-			return true
+func (s *RetryLayerAPIUsageStore) GetForUser(userID string, since int64) ([]*model.APIUsage, error) {
+
+	tries := 0
+	for {
+		var result []*model.APIUsage
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.APIUsageStore.GetForUser(userID, since)
 		}
-	case errors.As(errors.Cause(err), &mysqlErr):
-		if mysqlErr.Number == mySQLDeadlockCode {
-			return true
+		tries++
+		retry, err := s.Root.retrier.retry("APIUsageStore.GetForUser", true, tries, err)
+		if !retry {
+			return result, err
 		}
 	}
-	return false
+
 }
 
-func (s *RetryLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
+func (s *RetryLayerAPIUsageStore) GetSummaries(since int64, offset int, limit int) ([]*model.APIUsageSummary, error) {
 
 	tries := 0
 	for {
-		result, err := s.AuditStore.Get(user_id, offset, limit)
+		var result []*model.APIUsageSummary
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.APIUsageStore.GetSummaries(since, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("APIUsageStore.GetSummaries", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerAPIUsageStore) GetTokenCounts(tokenID string, since int64) (map[int64]int64, error) {
 
 	tries := 0
 	for {
-		err := s.AuditStore.PermanentDeleteByUser(userID)
+		var result map[int64]int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.APIUsageStore.GetTokenCounts(tokenID, since)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("APIUsageStore.GetTokenCounts", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
+func (s *RetryLayerAPIUsageStore) Increment(usages []*model.APIUsage) error {
 
 	tries := 0
 	for {
-		err := s.AuditStore.Save(audit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.APIUsageStore.Increment(usages)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("APIUsageStore.Increment", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
+func (s *RetryLayerAPIUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Get(userID, includeDeleted)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.APIUsageStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("APIUsageStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+func (s *RetryLayerActivityEventStore) DeleteForPost(postID string) error {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.GetAll(options)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ActivityEventStore.DeleteForPost(postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ActivityEventStore.DeleteForPost", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) PermanentDelete(userID string) error {
+func (s *RetryLayerActivityEventStore) DeleteReaction(userID string, postID string, emojiName string) error {
 
 	tries := 0
 	for {
-		err := s.BotStore.PermanentDelete(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ActivityEventStore.DeleteReaction(userID, postID, emojiName)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ActivityEventStore.DeleteReaction", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+func (s *RetryLayerActivityEventStore) GetForUser(userID string, opts store.ActivityEventGetOpts) ([]*model.ActivityEvent, error) {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Save(bot)
+		var result []*model.ActivityEvent
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ActivityEventStore.GetForUser(userID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ActivityEventStore.GetForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+func (s *RetryLayerActivityEventStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.BotStore.Update(bot)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ActivityEventStore.PermanentDeleteByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ActivityEventStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *RetryLayerActivityEventStore) Save(event *model.ActivityEvent) (*model.ActivityEvent, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
+		var result *model.ActivityEvent
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ActivityEventStore.Save(event)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ActivityEventStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *RetryLayerAlertRuleStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.AlertRuleStore.Delete(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("AlertRuleStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerAlertRuleStore) Get(id string) (*model.AlertRule, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Autocomplete(userID, term, includeDeleted)
+		var result *model.AlertRule
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.AlertRuleStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("AlertRuleStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerAlertRuleStore) GetAll() ([]*model.AlertRule, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted)
+		var result []*model.AlertRule
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.AlertRuleStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("AlertRuleStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerAlertRuleStore) GetAllEnabled() ([]*model.AlertRule, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+		var result []*model.AlertRule
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.AlertRuleStore.GetAllEnabled()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("AlertRuleStore.GetAllEnabled", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) ClearAllCustomRoleAssignments() error {
+func (s *RetryLayerAlertRuleStore) Save(rule *model.AlertRule) (*model.AlertRule, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ClearAllCustomRoleAssignments()
+		var result *model.AlertRule
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
+			result, err = s.AlertRuleStore.Save(rule)
 		}
-		if !isRepeatableError(err) {
-			return err
+		tries++
+		retry, err := s.Root.retrier.retry("AlertRuleStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAlertRuleStore) Update(rule *model.AlertRule) (*model.AlertRule, error) {
+
+	tries := 0
+	for {
+		var result *model.AlertRule
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.AlertRuleStore.Update(rule)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AlertRuleStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAlertRuleStore) UpdateState(rule *model.AlertRule) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.AlertRuleStore.UpdateState(rule)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AlertRuleStore.UpdateState", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
+
+	tries := 0
+	for {
+		var result model.Audits
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.AuditStore.Get(user_id, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditStore) GetByActions(actions []string, extraInfoPrefix string, offset int, limit int) (model.Audits, error) {
+
+	tries := 0
+	for {
+		var result model.Audits
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.AuditStore.GetByActions(actions, extraInfoPrefix, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditStore.GetByActions", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditStore) GetCountByExtraInfoPrefix(prefix string, since int64) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.AuditStore.GetCountByExtraInfoPrefix(prefix, since)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditStore.GetCountByExtraInfoPrefix", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.AuditStore.PermanentDeleteByUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.AuditStore.Save(audit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditStore.Save", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditLogStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.AuditLogStore.PermanentDeleteBatch(endTime, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditLogStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditLogStore) Save(log *model.AuditLog) (*model.AuditLog, error) {
+
+	tries := 0
+	for {
+		var result *model.AuditLog
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.AuditLogStore.Save(log)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditLogStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerAuditLogStore) Search(opts *model.AuditLogSearch, offset int, limit int) ([]*model.AuditLog, error) {
+
+	tries := 0
+	for {
+		var result []*model.AuditLog
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.AuditLogStore.Search(opts, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("AuditLogStore.Search", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
+
+	tries := 0
+	for {
+		var result *model.Bot
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotStore.Get(userID, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+
+	tries := 0
+	for {
+		var result []*model.Bot
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotStore.GetAll(options)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStore) PermanentDelete(userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.BotStore.PermanentDelete(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStore.PermanentDelete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+
+	tries := 0
+	for {
+		var result *model.Bot
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotStore.Save(bot)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+
+	tries := 0
+	for {
+		var result *model.Bot
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotStore.Update(bot)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) Delete(botUserID string, userID string, channelID string, key string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.BotStateStore.Delete(botUserID, userID, channelID, key)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) Get(botUserID string, userID string, channelID string, key string) (*model.BotState, error) {
+
+	tries := 0
+	for {
+		var result *model.BotState
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotStateStore.Get(botUserID, userID, channelID, key)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) GetKeys(botUserID string, userID string, channelID string, offset int, limit int) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotStateStore.GetKeys(botUserID, userID, channelID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.GetKeys", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.BotStateStore.PermanentDeleteByUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) PermanentDeleteExpiredBatch(now int64, limit int64) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotStateStore.PermanentDeleteExpiredBatch(now, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.PermanentDeleteExpiredBatch", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotStateStore) Save(state *model.BotState) (*model.BotState, error) {
+
+	tries := 0
+	for {
+		var result *model.BotState
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotStateStore.Save(state)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotStateStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) Delete(botUserID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.BotTokenRotationStore.Delete(botUserID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) Get(botUserID string) (*model.BotTokenRotation, error) {
+
+	tries := 0
+	for {
+		var result *model.BotTokenRotation
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotTokenRotationStore.Get(botUserID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) GetDue(now int64, limit int) ([]*model.BotTokenRotation, error) {
+
+	tries := 0
+	for {
+		var result []*model.BotTokenRotation
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotTokenRotationStore.GetDue(now, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.GetDue", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) GetTokenAges(offset int, limit int) ([]*model.BotTokenAge, error) {
+
+	tries := 0
+	for {
+		var result []*model.BotTokenAge
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.BotTokenRotationStore.GetTokenAges(offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.GetTokenAges", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) Save(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
+
+	tries := 0
+	for {
+		var result *model.BotTokenRotation
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotTokenRotationStore.Save(rotation)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerBotTokenRotationStore) Update(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
+
+	tries := 0
+	for {
+		var result *model.BotTokenRotation
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.BotTokenRotationStore.Update(rotation)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("BotTokenRotationStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) Autocomplete(userID string, teamID string, prefix string, limit int) ([]*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result []*model.CannedResponse
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CannedResponseStore.Autocomplete(userID, teamID, prefix, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.Autocomplete", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) Delete(id string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CannedResponseStore.Delete(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) Get(id string) (*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result *model.CannedResponse
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CannedResponseStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) GetForTeam(teamID string) ([]*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result []*model.CannedResponse
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CannedResponseStore.GetForTeam(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.GetForTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) GetForUser(userID string) ([]*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result []*model.CannedResponse
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CannedResponseStore.GetForUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.GetForUser", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) IncrementUseCount(id string, usedAt int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CannedResponseStore.IncrementUseCount(id, usedAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.IncrementUseCount", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) Save(response *model.CannedResponse) (*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result *model.CannedResponse
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CannedResponseStore.Save(response)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCannedResponseStore) Update(response *model.CannedResponse) (*model.CannedResponse, error) {
+
+	tries := 0
+	for {
+		var result *model.CannedResponse
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CannedResponseStore.Update(response)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CannedResponseStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.AnalyticsDeletedTypeCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.AnalyticsTypeCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool) (model.ChannelListWithTeamData, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelListWithTeamData
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.Autocomplete(userID, term, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Autocomplete", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.AutocompleteInTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.AutocompleteInTeamForSearch", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) ClearAllCustomRoleAssignments() error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.ClearAllCustomRoleAssignments()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.ClearAllCustomRoleAssignments", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) ClearCaches() {
+
+	s.ChannelStore.ClearCaches()
+
+}
+
+func (s *RetryLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.ClearSidebarOnTeamLeave", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
+
+	tries := 0
+	for {
+		var result int
+		var resultVar1 int
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, resultVar1, err = s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.CountPostsAfter", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.CreateDirectChannel", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) CreateInitialSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+
+	tries := 0
+	for {
+		var result *model.OrderedSidebarCategories
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.CreateInitialSidebarCategories(userID, teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.CreateInitialSidebarCategories", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
+
+	tries := 0
+	for {
+		var result *model.SidebarCategoryWithChannels
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.CreateSidebarCategory", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Delete(channelID string, time int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.Delete(channelID, time)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.DeleteSidebarCategory(categoryID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.DeleteSidebarCategory", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.DeleteSidebarChannelsByPreferences", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.Get(id, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result []*model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAll(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannelMembersById(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannelMembersById", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
+
+	tries := 0
+	for {
+		var result map[string]string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannelMembersForUser", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
+
+	tries := 0
+	for {
+		var result map[string]model.StringMap
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannelMembersNotifyPropsForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelListWithTeamData
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannels(page, perPage, opts)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannelsCount(opts)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannelsCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelForExport
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllChannelsForExportAfter", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
+
+	tries := 0
+	for {
+		var result []*model.DirectChannelForExport
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetAllDirectChannelsForExportAfter", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetByName(team_id, name, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetByName", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetByNameIncludeDeleted", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result []*model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetByNames", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelCounts
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelCounts(teamID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelCounts", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelMemberForExport
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelMembersForExport(userID, teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelMembersForExport", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
+
+	tries := 0
+	for {
+		var result []model.StringMap
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelMembersTimezones(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelMembersTimezones", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelUnread
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelUnread(channelID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelUnread", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannels(teamID, userID, opts)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result []*model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsBatchForIndexing", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result []*model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsByIds", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsByScheme", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsByUser", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsWithCursor", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsWithDeactivatedCreator(teamID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsWithDeactivatedCreator(teamID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsWithDeactivatedCreator", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelWithTeamData
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetChannelsWithTeamDataByIds", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetDeleted", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetDeletedByName(team_id, name)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetDeletedByName", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetForPost(postID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetForPost", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetFromMaster(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetFromMaster", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetGuestCount(channelID, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetGuestCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelMember
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMember(ctx, channelID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMember", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMemberCount(channelID, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMemberCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
+
+	return s.ChannelStore.GetMemberCountFromCache(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelMemberCountByGroup
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMemberCountsByGroup", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelMember
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMemberForPost(postID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMemberForPost", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembers
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembers(channelID, offset, limit, opts)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembers", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembers
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersByChannelIds", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembers
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersByIds(channelID, userIds)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersByIds", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembers
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersForUser(teamID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersForUser", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersForUserWithCursor(userID string, afterChannel string, afterUser string, limit int, lastUpdateAt int) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembers
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersForUserWithCursor(userID, afterChannel, afterUser, limit, lastUpdateAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersForUserWithCursor", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelMembersWithTeamData
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersForUserWithPagination", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
+
+	tries := 0
+	for {
+		var result map[string][]*model.User
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMembersInfoByChannelIds", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetMoreChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetPinnedPostCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
+
+	tries := 0
+	for {
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetPinnedPosts(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetPinnedPosts", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetPrivateChannelsForTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetPublicChannelsByIdsForTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetPublicChannelsForTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+
+	tries := 0
+	for {
+		var result *model.OrderedSidebarCategories
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetSidebarCategories(userID, teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetSidebarCategories", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+
+	tries := 0
+	for {
+		var result *model.SidebarCategoryWithChannels
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetSidebarCategory(categoryID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetSidebarCategory", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetSidebarCategoryOrder", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetTeamChannels(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetTeamChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+
+	tries := 0
+	for {
+		var result *model.Team
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetTeamForChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetTeamForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.GetTeamMembersForChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GetTeamMembersForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.GroupSyncedChannelCount()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.GroupSyncedChannelCount", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.IncrementMentionCount", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
+
+	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
+
+	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateChannel(id string) {
+
+	s.ChannelStore.InvalidateChannel(id)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
+
+	s.ChannelStore.InvalidateChannelByName(teamID, name)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateGuestCount(channelID string) {
+
+	s.ChannelStore.InvalidateGuestCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidateMemberCount(channelID string) {
+
+	s.ChannelStore.InvalidateMemberCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
+
+	s.ChannelStore.InvalidatePinnedPostCount(channelID)
+
+}
+
+func (s *RetryLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
+
+	return s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
+
+}
+
+func (s *RetryLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+
+	tries := 0
+	for {
+		var result map[string]string
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.MigrateChannelMembers", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDelete(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.PermanentDelete(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.PermanentDelete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.PermanentDeleteByTeam(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.PermanentDeleteByTeam", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.PermanentDeleteMembersByChannel", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.PermanentDeleteMembersByUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.PermanentDeleteMembersByUser", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.RemoveAllDeactivatedMembers", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveMember(channelID string, userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.RemoveMember(channelID, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.RemoveMember", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.RemoveMembers(channelID, userIds)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.RemoveMembers", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) ResetAllChannelSchemes() error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.ResetAllChannelSchemes()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.ResetAllChannelSchemes", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Restore(channelID string, time int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.Restore(channelID, time)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Restore", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.Save(channel, maxChannelsPerTeam)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SaveDirectChannel", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelMember
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.SaveMember(member)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SaveMember", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelMember
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.SaveMultipleMembers(members)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SaveMultipleMembers", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelListWithTeamData
+		var resultVar1 int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, resultVar1, err = s.ChannelStore.SearchAllChannels(term, opts)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchAllChannels", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchArchivedInTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchForUserInTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.SearchGroupChannels(userID, term)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchGroupChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchInTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+
+	tries := 0
+	for {
+		var result model.ChannelList
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelStore.SearchMore(userID, teamID, term)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SearchMore", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SetDeleteAt", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) SetShared(channelId string, shared bool) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.SetShared(channelId, shared)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.SetShared", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result *model.Channel
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.Update(channel)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+
+	tries := 0
+	for {
+		var result map[string]int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateLastViewedAt", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelUnreadAt
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, setUnreadCountRoot)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateLastViewedAtPost", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelMember
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UpdateMember(member)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateMember", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelMember
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateMemberNotifyProps", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateMembersRole", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelMember
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UpdateMultipleMembers(members)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateMultipleMembers", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+
+	tries := 0
+	for {
+		var result []*model.SidebarCategoryWithChannels
+		var resultVar1 []*model.SidebarCategoryWithChannels
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, resultVar1, err = s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateSidebarCategories", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateSidebarCategoryOrder", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateSidebarChannelCategoryOnMove", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UpdateSidebarChannelsByPreferences", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+
+	tries := 0
+	for {
+		var result bool
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelStore.UserBelongsToChannels", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) Delete(teamID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelArchivePolicyStore.Delete(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) DeleteWarning(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelArchivePolicyStore.DeleteWarning(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.DeleteWarning", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) Get(teamID string) (*model.ChannelArchivePolicy, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelArchivePolicy
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.Get(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) GetAll() ([]*model.ChannelArchivePolicy, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelArchivePolicy
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.GetAll()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) GetInactiveChannels(policy *model.ChannelArchivePolicy, before int64, limit int) ([]*model.Channel, error) {
+
+	tries := 0
+	for {
+		var result []*model.Channel
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.GetInactiveChannels(policy, before, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.GetInactiveChannels", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) GetWarnings(teamID string) ([]*model.ChannelArchiveWarning, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelArchiveWarning
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.GetWarnings(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.GetWarnings", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) Save(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelArchivePolicy
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.Save(policy)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) SaveWarning(warning *model.ChannelArchiveWarning) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelArchivePolicyStore.SaveWarning(warning)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.SaveWarning", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelArchivePolicyStore) Update(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelArchivePolicy
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelArchivePolicyStore.Update(policy)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelArchivePolicyStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelCommandOverrideStore) Delete(channelID string, trigger string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelCommandOverrideStore.Delete(channelID, trigger)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelCommandOverrideStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelCommandOverrideStore) DeleteForChannel(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelCommandOverrideStore.DeleteForChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelCommandOverrideStore.DeleteForChannel", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelCommandOverrideStore) Get(channelID string, trigger string) (*model.ChannelCommandOverride, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelCommandOverride
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelCommandOverrideStore.Get(channelID, trigger)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelCommandOverrideStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelCommandOverrideStore) GetForChannel(channelID string) ([]*model.ChannelCommandOverride, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelCommandOverride
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelCommandOverrideStore.GetForChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelCommandOverrideStore.GetForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelCommandOverrideStore) Save(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelCommandOverride
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelCommandOverrideStore.Save(override)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelCommandOverrideStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) Delete(userID string, channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelDigestStore.Delete(userID, channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) Get(userID string, channelID string) (*model.ChannelDigest, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelDigest
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelDigestStore.Get(userID, channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) GetActivity(channelID string, userID string, username string, since int64, until int64) (*model.ChannelDigestActivity, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelDigestActivity
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelDigestStore.GetActivity(channelID, userID, username, since, until)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.GetActivity", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) GetDue(cadence string, sentBefore int64, limit int) ([]*model.ChannelDigest, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelDigest
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelDigestStore.GetDue(cadence, sentBefore, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.GetDue", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) GetForUser(userID string) ([]*model.ChannelDigest, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelDigest
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelDigestStore.GetForUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.GetForUser", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) Save(digest *model.ChannelDigest) (*model.ChannelDigest, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelDigest
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelDigestStore.Save(digest)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelDigestStore) UpdateLastSentAt(userID string, channelIDs []string, sentAt int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelDigestStore.UpdateLastSentAt(userID, channelIDs, sentAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelDigestStore.UpdateLastSentAt", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelEventStore) Get(id string) (*model.ChannelEvent, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelEvent
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelEventStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelEventStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelEventStore) GetForChannel(channelID string, offset int, limit int) ([]*model.ChannelEvent, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelEvent
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelEventStore.GetForChannel(channelID, offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelEventStore.GetForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelEventStore) MarkReverted(id string, userID string, revertedAt int64) (bool, error) {
+
+	tries := 0
+	for {
+		var result bool
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelEventStore.MarkReverted(id, userID, revertedAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelEventStore.MarkReverted", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelEventStore) Save(event *model.ChannelEvent) (*model.ChannelEvent, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelEvent
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelEventStore.Save(event)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelEventStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) Delete(id string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelFeedStore.Delete(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) Get(id string) (*model.ChannelFeed, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelFeed
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelFeedStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) GetDue(now int64, limit int) ([]*model.ChannelFeed, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelFeed
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelFeedStore.GetDue(now, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.GetDue", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) GetExistingItemGuids(feedID string, guids []string) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelFeedStore.GetExistingItemGuids(feedID, guids)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.GetExistingItemGuids", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) GetForChannel(channelID string) ([]*model.ChannelFeed, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelFeed
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelFeedStore.GetForChannel(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.GetForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) Save(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelFeed
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelFeedStore.Save(feed)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) SaveItems(items []*model.ChannelFeedItem) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelFeedStore.SaveItems(items)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.SaveItems", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) Update(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+
+	tries := 0
+	for {
+		var result *model.ChannelFeed
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelFeedStore.Update(feed)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelFeedStore) UpdatePollResult(feed *model.ChannelFeed) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelFeedStore.UpdatePollResult(feed)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelFeedStore.UpdatePollResult", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelLanguageStatsStore) GetForChannel(channelID string, since int64, until int64) ([]*model.ChannelLanguagePostCount, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelLanguagePostCount
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelLanguageStatsStore.GetForChannel(channelID, since, until)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelLanguageStatsStore.GetForChannel", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelLanguageStatsStore) RollupDay(day int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelLanguageStatsStore.RollupDay(day)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelLanguageStatsStore.RollupDay", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.GetChannelsLeftSince", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+
+	tries := 0
+	for {
+		var result []*model.ChannelMemberHistoryResult
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.GetUsersInChannelDuring", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.LogJoinEvent", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.LogLeaveEvent", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+
+	tries := 0
+	for {
+		var result int64
+		var resultVar1 model.RetentionPolicyCursor
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, resultVar1, err = s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Cleanup() error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ClusterDiscoveryStore.Cleanup()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.Cleanup", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+
+	tries := 0
+	for {
+		var result bool
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ClusterDiscoveryStore.Delete(discovery)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.Delete", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+
+	tries := 0
+	for {
+		var result bool
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ClusterDiscoveryStore.Exists(discovery)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.Exists", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+
+	tries := 0
+	for {
+		var result []*model.ClusterDiscovery
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ClusterDiscoveryStore.Save(discovery)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.Save", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ClusterDiscoveryStore.SetLastPingAt", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CommandStore.AnalyticsCommandCount(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.AnalyticsCommandCount", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Delete(commandID string, time int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CommandStore.Delete(commandID, time)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Get(id string) (*model.Command, error) {
+
+	tries := 0
+	for {
+		var result *model.Command
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CommandStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+
+	tries := 0
+	for {
+		var result []*model.Command
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CommandStore.GetByTeam(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.GetByTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+
+	tries := 0
+	for {
+		var result *model.Command
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CommandStore.GetByTrigger(teamID, trigger)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.GetByTrigger", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CommandStore.PermanentDeleteByTeam(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.PermanentDeleteByTeam", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CommandStore.PermanentDeleteByUser(userID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+
+	tries := 0
+	for {
+		var result *model.Command
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CommandStore.Save(webhook)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+
+	tries := 0
+	for {
+		var result *model.Command
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CommandStore.Update(hook)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Cleanup() {
+
+	s.CommandWebhookStore.Cleanup()
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+
+	tries := 0
+	for {
+		var result *model.CommandWebhook
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CommandWebhookStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandWebhookStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+
+	tries := 0
+	for {
+		var result *model.CommandWebhook
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CommandWebhookStore.Save(webhook)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandWebhookStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCommandWebhookStore) TryUse(id string, limit int) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CommandWebhookStore.TryUse(id, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CommandWebhookStore.TryUse", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+
+	tries := 0
+	for {
+		var result []*model.CompliancePost
+		var resultVar1 model.ComplianceExportCursor
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, resultVar1, err = s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.ComplianceExport", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		var result *model.Compliance
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ComplianceStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+
+	tries := 0
+	for {
+		var result model.Compliances
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ComplianceStore.GetAll(offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) MessageExport(cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+
+	tries := 0
+	for {
+		var result []*model.MessageExport
+		var resultVar1 model.MessageExportCursor
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, resultVar1, err = s.ComplianceStore.MessageExport(cursor, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.MessageExport", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		var result *model.Compliance
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ComplianceStore.Save(compliance)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		var result *model.Compliance
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ComplianceStore.Update(compliance)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ComplianceStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerConfigHistoryStore) GetAll(offset int, limit int) ([]*model.ConfigVersion, error) {
+
+	tries := 0
+	for {
+		var result []*model.ConfigVersion
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ConfigHistoryStore.GetAll(offset, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ConfigHistoryStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerConfigHistoryStore) GetByVersion(version string) (*model.ConfigVersion, error) {
+
+	tries := 0
+	for {
+		var result *model.ConfigVersion
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ConfigHistoryStore.GetByVersion(version)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ConfigHistoryStore.GetByVersion", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerConfigHistoryStore) Save(version *model.ConfigVersion) (*model.ConfigVersion, error) {
+
+	tries := 0
+	for {
+		var result *model.ConfigVersion
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.ConfigHistoryStore.Save(version)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("ConfigHistoryStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) Delete(id string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CustomStatusTemplateStore.Delete(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) Get(id string) (*model.CustomStatusTemplate, error) {
+
+	tries := 0
+	for {
+		var result *model.CustomStatusTemplate
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CustomStatusTemplateStore.Get(id)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) GetForTeam(teamID string) ([]*model.CustomStatusTemplate, error) {
+
+	tries := 0
+	for {
+		var result []*model.CustomStatusTemplate
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CustomStatusTemplateStore.GetForTeam(teamID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.GetForTeam", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) GetMostUsed() ([]*model.CustomStatusTemplate, error) {
+
+	tries := 0
+	for {
+		var result []*model.CustomStatusTemplate
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.CustomStatusTemplateStore.GetMostUsed()
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.GetMostUsed", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) IncrementUseCount(teamIDs []string, emoji string, text string, usedAt int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.CustomStatusTemplateStore.IncrementUseCount(teamIDs, emoji, text, usedAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.IncrementUseCount", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) Save(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+
+	tries := 0
+	for {
+		var result *model.CustomStatusTemplate
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CustomStatusTemplateStore.Save(template)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.Save", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerCustomStatusTemplateStore) Update(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+
+	tries := 0
+	for {
+		var result *model.CustomStatusTemplate
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.CustomStatusTemplateStore.Update(template)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("CustomStatusTemplateStore.Update", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerDirectChannelRetentionStore) Delete(channelID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.DirectChannelRetentionStore.Delete(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("DirectChannelRetentionStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerDirectChannelRetentionStore) Get(channelID string) (*model.DirectChannelRetention, error) {
+
+	tries := 0
+	for {
+		var result *model.DirectChannelRetention
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.DirectChannelRetentionStore.Get(channelID)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("DirectChannelRetentionStore.Get", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerDirectChannelRetentionStore) GetActive(afterChannelID string, limit int) ([]*model.DirectChannelRetention, error) {
+
+	tries := 0
+	for {
+		var result []*model.DirectChannelRetention
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.DirectChannelRetentionStore.GetActive(afterChannelID, limit)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("DirectChannelRetentionStore.GetActive", true, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerDirectChannelRetentionStore) Save(retention *model.DirectChannelRetention) (*model.DirectChannelRetention, error) {
+
+	tries := 0
+	for {
+		var result *model.DirectChannelRetention
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.DirectChannelRetentionStore.Save(retention)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("DirectChannelRetentionStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) ClearCaches() {
+func (s *RetryLayerDirectChannelRetentionStore) UpdateLastPurgeAt(channelID string, purgeAt int64) error {
 
-	s.ChannelStore.ClearCaches()
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.DirectChannelRetentionStore.UpdateLastPurgeAt(channelID, purgeAt)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("DirectChannelRetentionStore.UpdateLastPurgeAt", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
 
 }
 
-func (s *RetryLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
+func (s *RetryLayerEmailSuppressionStore) Delete(email string) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.EmailSuppressionStore.Delete(email)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmailSuppressionStore.Delete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
+func (s *RetryLayerEmailSuppressionStore) Get(email string) (*model.EmailSuppression, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
+		var result *model.EmailSuppression
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.EmailSuppressionStore.Get(email)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("EmailSuppressionStore.Get", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
+func (s *RetryLayerEmailSuppressionStore) GetAll(offset int, limit int) ([]*model.EmailSuppression, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
+		var result []*model.EmailSuppression
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmailSuppressionStore.GetAll(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmailSuppressionStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CreateInitialSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+func (s *RetryLayerEmailSuppressionStore) GetForEmails(emails []string) ([]*model.EmailSuppression, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateInitialSidebarCategories(userID, teamID)
+		var result []*model.EmailSuppression
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmailSuppressionStore.GetForEmails(emails)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmailSuppressionStore.GetForEmails", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerEmailSuppressionStore) Save(suppression *model.EmailSuppression) (*model.EmailSuppression, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
+		var result *model.EmailSuppression
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmailSuppressionStore.Save(suppression)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmailSuppressionStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Delete(channelID string, time int64) error {
+func (s *RetryLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.Delete(channelID, time)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.EmojiStore.Delete(emoji, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmojiStore.Delete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
+func (s *RetryLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.DeleteSidebarCategory(categoryID)
+		var result *model.Emoji
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.EmojiStore.Get(ctx, id, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("EmojiStore.Get", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *RetryLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
+		var result *model.Emoji
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.EmojiStore.GetByName(ctx, name, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("EmojiStore.GetByName", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Get(id, allowFromCache)
+		var result []*model.Emoji
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmojiStore.GetList(offset, limit, sort)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmojiStore.GetList", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
+func (s *RetryLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAll(teamID)
+		var result []*model.Emoji
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmojiStore.GetMultipleByName(names)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmojiStore.GetMultipleByName", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
+func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersById(id)
+		var result *model.Emoji
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.EmojiStore.Save(emoji)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EmojiStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
+func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
+		var result []*model.Emoji
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.EmojiStore.Search(name, prefixOnly, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EmojiStore.Search", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) ClaimDelivery(id string, expectedNextAttemptAt int64, leaseUntil int64) (bool, error) {
+
+	tries := 0
+	for {
+		var result bool
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.EventWebhookStore.ClaimDelivery(id, expectedNextAttemptAt, leaseUntil)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.ClaimDelivery", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
+func (s *RetryLayerEventWebhookStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			err = s.EventWebhookStore.Delete(id)
 		}
-		if !isRepeatableError(err) {
-			return result, err
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) Get(id string) (*model.EventWebhook, error) {
+
+	tries := 0
+	for {
+		var result *model.EventWebhook
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.EventWebhookStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerEventWebhookStore) GetAll(offset int, limit int) ([]*model.EventWebhook, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannels(page, perPage, opts)
+		var result []*model.EventWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.EventWebhookStore.GetAll(offset, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) GetDeliveries(webhookID string, offset int, limit int) ([]*model.EventWebhookDelivery, error) {
+
+	tries := 0
+	for {
+		var result []*model.EventWebhookDelivery
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.EventWebhookStore.GetDeliveries(webhookID, offset, limit)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.GetDeliveries", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
+func (s *RetryLayerEventWebhookStore) GetDueDeliveries(now int64, limit int) ([]*model.EventWebhookDelivery, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelsCount(opts)
+		var result []*model.EventWebhookDelivery
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.EventWebhookStore.GetDueDeliveries(now, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.GetDueDeliveries", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) GetForEvent(event string) ([]*model.EventWebhook, error) {
+
+	tries := 0
+	for {
+		var result []*model.EventWebhook
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.EventWebhookStore.GetForEvent(event)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.GetForEvent", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
+func (s *RetryLayerEventWebhookStore) PermanentDeleteDeliveriesBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.EventWebhookStore.PermanentDeleteDeliveriesBatch(endTime, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.PermanentDeleteDeliveriesBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) Save(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+
+	tries := 0
+	for {
+		var result *model.EventWebhook
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.EventWebhookStore.Save(webhook)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
+func (s *RetryLayerEventWebhookStore) SaveDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
+		var result *model.EventWebhookDelivery
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.EventWebhookStore.SaveDelivery(delivery)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.SaveDelivery", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerEventWebhookStore) Update(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+
+	tries := 0
+	for {
+		var result *model.EventWebhook
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.EventWebhookStore.Update(webhook)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("EventWebhookStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerEventWebhookStore) UpdateDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByName(team_id, name, allowFromCache)
+		var result *model.EventWebhookDelivery
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.EventWebhookStore.UpdateDelivery(delivery)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("EventWebhookStore.UpdateDelivery", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) AttachToPost(fileID string, postID string, creatorID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.FileInfoStore.AttachToPost(fileID, postID, creatorID)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("FileInfoStore.AttachToPost", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) ClearCaches() {
+
+	s.FileInfoStore.ClearCaches()
+
+}
+
+func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.CountAll()
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.CountAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) CountByPath(path string) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.CountByPath(path)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.CountByPath", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.DeleteForPost(postID)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.DeleteForPost", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result *model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.Get(id)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
+func (s *RetryLayerFileInfoStore) GetAfter(afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelCounts(teamID, userID)
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetAfter(afterCreateAt, afterID, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetByContentHash(hash string, size int64, limit int) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.GetByContentHash(hash, size, limit)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetByContentHash", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
+func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelMembersForExport(userID, teamID)
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetByIds(ids)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result *model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.GetByPath(path)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetByPath", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
+func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.FileForIndexing, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelMembersTimezones(channelID)
+		var result []*model.FileForIndexing
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetFilesBatchForIndexing(startTime, endTime, limit)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetFilesBatchForIndexing", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetForPost", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
+func (s *RetryLayerFileInfoStore) GetForPosts(postIds []string) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelUnread(channelID, userID)
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetForPosts(postIds)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetForPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetForTeamAfter(teamID string, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.GetForTeamAfter(teamID, afterCreateAt, afterID, limit)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetForTeamAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
+func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannels(teamID, userID, opts)
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetForUser(userID)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		var result *model.FileInfo
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.FileInfoStore.GetFromMaster(id)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetFromMaster", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+		var result []*model.FileInfo
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.FileInfoStore.GetWithOptions(page, perPage, opt)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("FileInfoStore.GetWithOptions", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+
+}
+
+func (s *RetryLayerFileInfoStore) PermanentDelete(fileID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.FileInfoStore.PermanentDelete(fileID)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("FileInfoStore.PermanentDelete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.FileInfoStore.PermanentDeleteByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
+func (s *RetryLayerFileInfoStore) RestoreForPosts(postIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.FileInfoStore.RestoreForPosts(postIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("FileInfoStore.RestoreForPosts", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
+func (s *RetryLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
+		var result *model.FileInfo
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.FileInfoStore.Save(info)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
+func (s *RetryLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
+		var result *model.FileInfoList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.Search", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+func (s *RetryLayerFileInfoStore) SetContent(fileID string, content string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.FileInfoStore.SetContent(fileID, content)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("FileInfoStore.SetContent", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) SetContentHash(fileID string, hash string, path string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetDeletedByName(team_id, name)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.FileInfoStore.SetContentHash(fileID, hash, path)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("FileInfoStore.SetContentHash", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
+func (s *RetryLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetForPost(postID)
+		var result *model.FileInfo
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.FileInfoStore.Upsert(info)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("FileInfoStore.Upsert", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetFromMaster(id)
+		var result []string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.AdminRoleGroupsForSyncableMember", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetGuestCount(channelID, allowFromCache)
+		var result []*model.UserWithGroups
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.ChannelMembersMinusGroupMembers", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMember(ctx, channelID, userID)
+		var result []*model.UserChannelIDPair
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.ChannelMembersToAdd", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberCount(channelID, allowFromCache)
+		var result []*model.ChannelMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.ChannelMembersToRemove(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.ChannelMembersToRemove", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
-
-	return s.ChannelStore.GetMemberCountFromCache(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
+func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CountChannelMembersMinusGroupMembers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMemberForPost(postID, userID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CountGroupsByChannel(channelID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CountGroupsByChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit int) (model.ChannelMembers, error) {
+func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembers(channelID, offset, limit)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CountGroupsByTeam(teamID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CountGroupsByTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
+func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CountTeamMembersMinusGroupMembers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
+func (s *RetryLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersByIds(channelID, userIds)
+		var result *model.Group
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.Create(group)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.Create", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
+func (s *RetryLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUser(teamID, userID)
+		var result *model.GroupSyncable
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CreateGroupSyncable(groupSyncable)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CreateGroupSyncable", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUserWithCursor(userID string, afterChannel string, afterUser string, limit int, lastUpdateAt int) (model.ChannelMembers, error) {
+func (s *RetryLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUserWithCursor(userID, afterChannel, afterUser, limit, lastUpdateAt)
+		var result *model.Group
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.CreateWithUserIds(group)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.CreateWithUserIds", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
+func (s *RetryLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
+		var result *model.Group
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.Delete(groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
+func (s *RetryLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
+		var result *model.GroupSyncable
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.DeleteGroupSyncable", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+		var result *model.GroupMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.DeleteMember(groupID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.DeleteMember", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *RetryLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+		var result []*model.GroupMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.DeleteMembers(groupID, userIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.DeleteMembers", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
+func (s *RetryLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPinnedPosts(channelID)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.DistinctGroupMemberCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.DistinctGroupMemberCount", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) Get(groupID string) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+		var result *model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.Get(groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+		var result []*model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetAllBySource(groupSource)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetAllBySource", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+		var result []*model.GroupSyncable
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetAllGroupSyncablesByGroupId", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+func (s *RetryLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategories(userID, teamID)
+		var result []*model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetByIDs(groupIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetByIDs", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategory(categoryID)
+		var result *model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetByName(name, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetByName", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+func (s *RetryLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+		var result *model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetByRemoteID(remoteID, groupSource)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetByRemoteID", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamChannels(teamID)
+		var result []*model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+func (s *RetryLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamForChannel(channelID)
+		var result *model.GroupSyncable
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetGroupSyncable", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+func (s *RetryLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GetTeamMembersForChannel(channelID)
+		var result []*model.Group
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetGroups(page, perPage, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetGroups", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+func (s *RetryLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.GroupSyncedChannelCount()
+		var result map[string][]*model.GroupWithSchemeAdmin
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetGroupsAssociatedToChannelsByTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool) error {
+func (s *RetryLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot)
+		var result []*model.GroupWithSchemeAdmin
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetGroupsByChannel(channelID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetGroupsByChannel", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
-
-	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
-
-	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateChannel(id string) {
-
-	s.ChannelStore.InvalidateChannel(id)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
-
-	s.ChannelStore.InvalidateChannelByName(teamID, name)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateGuestCount(channelID string) {
-
-	s.ChannelStore.InvalidateGuestCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidateMemberCount(channelID string) {
-
-	s.ChannelStore.InvalidateMemberCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
-
-	s.ChannelStore.InvalidatePinnedPostCount(channelID)
-
-}
-
-func (s *RetryLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
-
-	return s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
-
-}
-
-func (s *RetryLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+func (s *RetryLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+		var result []*model.GroupWithSchemeAdmin
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GetGroupsByTeam(teamID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GetGroupsByTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDelete(channelID string) error {
+func (s *RetryLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDelete(channelID)
+		var result *model.GroupMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMember(groupID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMember", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+func (s *RetryLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteByTeam(teamID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMemberCount(groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMemberCount", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+func (s *RetryLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMemberUsers(groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMemberUsers", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *RetryLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.PermanentDeleteMembersByUser(userID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMemberUsersInTeam", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+func (s *RetryLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMemberUsersNotInChannel", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) RemoveMember(channelID string, userID string) error {
+func (s *RetryLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveMember(channelID, userID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetMemberUsersPage", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+func (s *RetryLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.RemoveMembers(channelID, userIds)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GetNonMemberUsersPage", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) ResetAllChannelSchemes() error {
+func (s *RetryLayerGroupStore) GroupChannelCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.ResetAllChannelSchemes()
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GroupChannelCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GroupChannelCount", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Restore(channelID string, time int64) error {
+func (s *RetryLayerGroupStore) GroupCount() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.Restore(channelID, time)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.GroupCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.GroupCount", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GroupCountWithAllowReference()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GroupCountWithAllowReference", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+func (s *RetryLayerGroupStore) GroupMemberCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GroupMemberCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GroupMemberCount", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) GroupTeamCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveMember(member)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.GroupTeamCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.GroupTeamCount", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SaveMultipleMembers(members)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.GroupStore.PermanentDeleteMembersByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("GroupStore.PermanentDeleteMembersByUser", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+func (s *RetryLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.SearchAllChannels(term, opts)
+		var result []string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("GroupStore.PermittedSyncableAdmins", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+		var result []*model.UserWithGroups
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.TeamMembersMinusGroupMembers", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+		var result []*model.UserTeamIDPair
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.TeamMembersToAdd", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchGroupChannels(userID, term)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.TeamMembersToRemove(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.TeamMembersToRemove", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+		var result *model.Group
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.Update(group)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.SearchMore(userID, teamID, term)
+		var result *model.GroupSyncable
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.GroupStore.UpdateGroupSyncable(groupSyncable)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("GroupStore.UpdateGroupSyncable", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+		var result *model.GroupMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.UpsertMember(groupID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.UpsertMember", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) SetShared(channelId string, shared bool) error {
+func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.SetShared(channelId, shared)
+		var result []*model.GroupMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.GroupStore.UpsertMembers(groupID, userIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("GroupStore.UpsertMembers", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+func (s *RetryLayerIdempotencyKeyStore) Delete(userID string, key string) error {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.Update(channel)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.IdempotencyKeyStore.Delete(userID, key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+func (s *RetryLayerIdempotencyKeyStore) DeleteStaleReservation(userID string, key string, reservedBefore int64) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.IdempotencyKeyStore.DeleteStaleReservation(userID, key, reservedBefore)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.DeleteStaleReservation", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+func (s *RetryLayerIdempotencyKeyStore) Get(userID string, key string) (*model.IdempotencyKey, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, setUnreadCountRoot)
+		var result *model.IdempotencyKey
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.IdempotencyKeyStore.Get(userID, key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *RetryLayerIdempotencyKeyStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMember(member)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.IdempotencyKeyStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+func (s *RetryLayerIdempotencyKeyStore) Save(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+		var result *model.IdempotencyKey
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.IdempotencyKeyStore.Save(key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+func (s *RetryLayerIdempotencyKeyStore) Update(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+		var result *model.IdempotencyKey
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.IdempotencyKeyStore.Update(key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("IdempotencyKeyStore.Update", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *RetryLayerImpersonationStore) Get(id string) (*model.Impersonation, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UpdateMultipleMembers(members)
+		var result *model.Impersonation
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ImpersonationStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ImpersonationStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+func (s *RetryLayerImpersonationStore) GetForUser(userID string, offset int, limit int) ([]*model.Impersonation, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+		var result []*model.Impersonation
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.ImpersonationStore.GetForUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("ImpersonationStore.GetForUser", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+func (s *RetryLayerImpersonationStore) Save(impersonation *model.Impersonation) (*model.Impersonation, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+		var result *model.Impersonation
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ImpersonationStore.Save(impersonation)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ImpersonationStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+func (s *RetryLayerImpersonationStore) UpdateStatus(impersonation *model.Impersonation, currentStatus string) (*model.Impersonation, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+		var result *model.Impersonation
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ImpersonationStore.UpdateStatus(impersonation, currentStatus)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ImpersonationStore.UpdateStatus", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 
 	tries := 0
 	for {
-		err := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.JobStore.Cleanup(expiryTime, batchSize)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.Cleanup", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+func (s *RetryLayerJobStore) Delete(id string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.Delete(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerJobStore) Get(id string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+		var result *model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+func (s *RetryLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetAllByStatus(status)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetAllByStatus", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+func (s *RetryLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetAllByType(jobType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetAllByType", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+func (s *RetryLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.GetAllByTypeAndStatus(jobType, status)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.GetAllByTypeAndStatus", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+func (s *RetryLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.GetAllByTypePage(jobType, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.GetAllByTypePage", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetAllByTypesPage", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		var result []*model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.JobStore.GetAllPage(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("JobStore.GetAllPage", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Cleanup() error {
+func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.Cleanup()
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.GetCountByStatusAndType(status, jobType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.GetCountByStatusAndType", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *RetryLayerJobStore) GetCountByStatusSince(status string, since int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.Delete(discovery)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetCountByStatusSince(status, since)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetCountByStatusSince", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.Exists(discovery)
+		var result *model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetNewestJobByStatusAndType", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+		var result *model.Job
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.GetNewestJobByStatusesAndType", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.Save(discovery)
+		var result *model.Job
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.Save(job)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.UpdateOptimistically(job, currentStatus)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.UpdateOptimistically", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+		var result *model.Job
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.JobStore.UpdateStatus(id, status)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("JobStore.UpdateStatus", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Delete(commandID string, time int64) error {
+func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		err := s.CommandStore.Delete(commandID, time)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("JobStore.UpdateStatusOptimistically", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Get(id string) (*model.Command, error) {
+func (s *RetryLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Get(id)
+		var result *model.LicenseRecord
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.LicenseStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("LicenseStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.GetByTeam(teamID)
+		var result []*model.LicenseRecord
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.LicenseStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("LicenseStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+		var result *model.LicenseRecord
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.LicenseStore.Save(license)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("LicenseStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+func (s *RetryLayerLicenseUsageStore) GetForMonths(since int64, until int64) ([]*model.LicenseUsage, error) {
 
 	tries := 0
 	for {
-		err := s.CommandStore.PermanentDeleteByTeam(teamID)
+		var result []*model.LicenseUsage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.LicenseUsageStore.GetForMonths(since, until)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("LicenseUsageStore.GetForMonths", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerLicenseUsageStore) RollupMonth(month int64) (*model.LicenseUsage, error) {
 
 	tries := 0
 	for {
-		err := s.CommandStore.PermanentDeleteByUser(userID)
+		var result *model.LicenseUsage
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.LicenseUsageStore.RollupMonth(month)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("LicenseUsageStore.RollupMonth", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Save(webhook)
+		var result *model.LinkMetadata
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.LinkMetadataStore.Get(url, timestamp)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("LinkMetadataStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandStore.Update(hook)
+		var result *model.LinkMetadata
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.LinkMetadataStore.Save(linkMetadata)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("LinkMetadataStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandWebhookStore) Cleanup() {
-
-	s.CommandWebhookStore.Cleanup()
-
-}
-
-func (s *RetryLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.CommandWebhookStore.Get(id)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthStore.DeleteApp(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthStore.DeleteApp", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.CommandWebhookStore.Save(webhook)
+		var result *model.AccessData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.GetAccessData(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAccessData", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerCommandWebhookStore) TryUse(id string, limit int) error {
+func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		err := s.CommandWebhookStore.TryUse(id, limit)
+		var result *model.AccessData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.OAuthStore.GetAccessDataByRefreshToken(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAccessDataByRefreshToken", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+		var result []*model.AccessData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAccessDataByUserForApp", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Get(id)
+		var result *model.OAuthApp
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.GetApp(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.GetApp", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.GetAll(offset, limit)
+		var result []*model.OAuthApp
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.GetAppByUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAppByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) MessageExport(cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ComplianceStore.MessageExport(cursor, limit)
+		var result []*model.OAuthApp
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.OAuthStore.GetApps(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("OAuthStore.GetApps", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Save(compliance)
+		var result *model.AuthData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.GetAuthData(code)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAuthData", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.ComplianceStore.Update(compliance)
+		var result []*model.OAuthApp
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.GetAuthorizedApps", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		err := s.EmojiStore.Delete(emoji, time)
+		var result *model.AccessData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.OAuthStore.GetPreviousAccessData(userID, clientId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("OAuthStore.GetPreviousAccessData", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthStore.PermanentDeleteAuthDataByUser", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) RemoveAccessData(token string) error {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthStore.RemoveAccessData(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthStore.RemoveAccessData", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) RemoveAllAccessData() error {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetList(offset, limit, sort)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthStore.RemoveAllAccessData()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthStore.RemoveAllAccessData", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) RemoveAuthData(code string) error {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetMultipleByName(names)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthStore.RemoveAuthData(code)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthStore.RemoveAuthData", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Save(emoji)
+		var result *model.AccessData
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.SaveAccessData(accessData)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.SaveAccessData", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *RetryLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+		var result *model.OAuthApp
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.SaveApp(app)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.SaveApp", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) AttachToPost(fileID string, postID string, creatorID string) error {
+func (s *RetryLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.AttachToPost(fileID, postID, creatorID)
+		var result *model.AuthData
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.OAuthStore.SaveAuthData(authData)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("OAuthStore.SaveAuthData", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) ClearCaches() {
-
-	s.FileInfoStore.ClearCaches()
-
-}
-
-func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
+func (s *RetryLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.CountAll()
+		var result *model.AccessData
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.UpdateAccessData(accessData)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.UpdateAccessData", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+func (s *RetryLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.DeleteForPost(postID)
+		var result *model.OAuthApp
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthStore.UpdateApp(app)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthStore.UpdateApp", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+func (s *RetryLayerOAuthAppUsageStore) GetSummaries(since int64, offset int, limit int) ([]*model.OAuthAppUsageSummary, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Get(id)
+		var result []*model.OAuthAppUsageSummary
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthAppUsageStore.GetSummaries(since, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthAppUsageStore.GetSummaries", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+func (s *RetryLayerOAuthAppUsageStore) Increment(usages []*model.OAuthAppUsage) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByIds(ids)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OAuthAppUsageStore.Increment(usages)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OAuthAppUsageStore.Increment", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+func (s *RetryLayerOAuthAppUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByPath(path)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OAuthAppUsageStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OAuthAppUsageStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.FileForIndexing, error) {
+func (s *RetryLayerOutstandingMentionStore) GetOutstanding(userID string, teamID string, offset int, limit int) ([]*model.OutstandingMention, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, endTime, limit)
+		var result []*model.OutstandingMention
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OutstandingMentionStore.GetOutstanding(userID, teamID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.GetOutstanding", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+func (s *RetryLayerOutstandingMentionStore) GetOutstandingCounts(mentionedBefore int64, afterUserID string, limit int) ([]*model.OutstandingMentionCount, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+		var result []*model.OutstandingMentionCount
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OutstandingMentionStore.GetOutstandingCounts(mentionedBefore, afterUserID, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.GetOutstandingCounts", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+func (s *RetryLayerOutstandingMentionStore) GetStats(userID string, teamID string) (*model.OutstandingMentionStats, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForUser(userID)
+		var result *model.OutstandingMentionStats
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OutstandingMentionStore.GetStats(userID, teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.GetStats", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+func (s *RetryLayerOutstandingMentionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFromMaster(id)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.OutstandingMentionStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+func (s *RetryLayerOutstandingMentionStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OutstandingMentionStore.PermanentDeleteByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
-
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
-
-}
-
-func (s *RetryLayerFileInfoStore) PermanentDelete(fileID string) error {
+func (s *RetryLayerOutstandingMentionStore) ResolveForPost(userID string, postID string, resolvedAt int64) error {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.PermanentDelete(fileID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.OutstandingMentionStore.ResolveForPost(userID, postID, resolvedAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.ResolveForPost", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerOutstandingMentionStore) ResolveForThread(userID string, channelID string, rootID string, resolvedAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OutstandingMentionStore.ResolveForThread(userID, channelID, rootID, resolvedAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.ResolveForThread", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
+func (s *RetryLayerOutstandingMentionStore) Save(mentions []*model.OutstandingMention) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.OutstandingMentionStore.Save(mentions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("OutstandingMentionStore.Save", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerPermissionDenialStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Save(info)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PermissionDenialStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PermissionDenialStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+func (s *RetryLayerPermissionDenialStore) Save(denial *model.PermissionDenial) (*model.PermissionDenial, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
+		var result *model.PermissionDenial
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PermissionDenialStore.Save(denial)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PermissionDenialStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) SetContent(fileID string, content string) error {
+func (s *RetryLayerPermissionDenialStore) Search(opts *model.PermissionDenialSearch, offset int, limit int) ([]*model.PermissionDenial, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.SetContent(fileID, content)
+		var result []*model.PermissionDenial
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PermissionDenialStore.Search(opts, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PermissionDenialStore.Search", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Upsert(info)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.CompareAndDelete(keyVal, oldValue)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.CompareAndDelete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *RetryLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.CompareAndSet(keyVal, oldValue)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.CompareAndSet", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *RetryLayerPluginStore) Delete(pluginID string, key string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PluginStore.Delete(pluginID, key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PluginStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
+func (s *RetryLayerPluginStore) DeleteAllExpired() error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PluginStore.DeleteAllExpired()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PluginStore.DeleteAllExpired", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
+func (s *RetryLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToRemove(channelID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PluginStore.DeleteAllForPlugin(PluginID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PluginStore.DeleteAllForPlugin", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+		var result *model.PluginKeyValue
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.Get(pluginID, key)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.List(pluginID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.List", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+		var result *model.PluginKeyValue
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.SaveOrUpdate(keyVal)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.SaveOrUpdate", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PluginStore.SetWithOptions(pluginID, key, value, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PluginStore.SetWithOptions", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
+func (s *RetryLayerPostStore) AnalyticsPostCount(teamID string, mustHaveFile bool, mustHaveHashtag bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Create(group)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.AnalyticsPostCount(teamID, mustHaveFile, mustHaveHashtag)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.AnalyticsPostCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
+		var result model.AnalyticsRows
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.AnalyticsPostCountsByDay(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.AnalyticsPostCountsByDay", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
+func (s *RetryLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CreateWithUserIds(group)
+		var result model.AnalyticsRows
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.AnalyticsUserCountsWithPostsByDay", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) Delete(groupID string) (*model.Group, error) {
+func (s *RetryLayerPostStore) ClearCaches() {
+
+	s.PostStore.ClearCaches()
+
+}
+
+func (s *RetryLayerPostStore) CountForRetentionPolicies(now int64, globalPolicyEndTime int64) (*model.RetentionPolicyDryRun, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Delete(groupID)
+		var result *model.RetentionPolicyDryRun
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.CountForRetentionPolicies(now, globalPolicyEndTime)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.CountForRetentionPolicies", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) CountPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.CountPostsSinceForSync(options, cursor)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.CountPostsSinceForSync", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerPostStore) Delete(postID string, time int64, deleteByID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteMember(groupID, userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PostStore.Delete(postID, time, deleteByID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *RetryLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.DeleteOrphanedRows(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
+func (s *RetryLayerPostStore) Get(ctx context.Context, id string, skipFetchThreads bool, collapsedThreads bool, collapsedThreadsExtended bool, userID string) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.DistinctGroupMemberCount()
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Get(ctx, id, skipFetchThreads, collapsedThreads, collapsedThreadsExtended, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) Get(groupID string) (*model.Group, error) {
+func (s *RetryLayerPostStore) GetDeletedForChannel(channelID string, since int64, offset int, limit int) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Get(groupID)
+		var result []*model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetDeletedForChannel(channelID, since, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetDeletedForChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
+func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetAllBySource(groupSource)
+		var result []*model.DirectPostForExport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetDirectPostParentsForExportAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
+
+	return s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
+
+}
+
+func (s *RetryLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetFlaggedPosts(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetFlaggedPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
+func (s *RetryLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByIDs(groupIDs)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetFlaggedPostsForChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
+func (s *RetryLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByName(name, opts)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetFlaggedPostsForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
+func (s *RetryLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetLastPostRowCreateAt()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetLastPostRowCreateAt", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
+func (s *RetryLayerPostStore) GetMaxPostSize() int {
+
+	return s.PostStore.GetMaxPostSize()
+
+}
+
+func (s *RetryLayerPostStore) GetOldest() (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetByUser(userID)
+		var result *model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetOldest()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetOldest", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetOldestEntityCreationTime()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetOldestEntityCreationTime", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, error) {
+func (s *RetryLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroups(page, perPage, opts)
+		var result []*model.PostForExport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetParentsForExportAfter(limit, afterID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetParentsForExportAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, time int64, collapsedThreads bool) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
+		var result *model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostAfterTime(channelID, time, collapsedThreads)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostAfterTime", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerPostStore) GetPostIdAfterTime(channelID string, time int64, collapsedThreads bool) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
+		var result string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostIdAfterTime(channelID, time, collapsedThreads)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostIdAfterTime", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *RetryLayerPostStore) GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
+		var result string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostIdBeforeTime(channelID, time, collapsedThreads)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostIdBeforeTime", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMember(groupID, userID)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPosts(options, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
+func (s *RetryLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberCount(groupID)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsAfter(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
+func (s *RetryLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsers(groupID)
+		var result []*model.PostForIndexing
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsBatchForIndexing", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
+func (s *RetryLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsBefore(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsBefore", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
+func (s *RetryLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+		var result []*model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsByIds(postIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
+func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, time int64) ([]*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
+		var result []*model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsCreatedAt(channelID, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsCreatedAt", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
+func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetPostsSince(options, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsSince", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupChannelCount() (int64, error) {
+func (s *RetryLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupChannelCount()
+		var result []*model.Post
+		var resultVar1 model.GetPostsSinceForSyncCursor
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, resultVar1, err = s.PostStore.GetPostsSinceForSync(options, cursor, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.GetPostsSinceForSync", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupCount() (int64, error) {
+func (s *RetryLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupCount()
+		var result []*model.ReplyForExport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetRepliesForExport(parentID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetRepliesForExport", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
+func (s *RetryLayerPostStore) GetSearchCapabilities() *model.SearchCapabilities {
+
+	return s.PostStore.GetSearchCapabilities()
+
+}
+
+func (s *RetryLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupCountWithAllowReference()
+		var result *model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.GetSingle(id, inclDeleted)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.GetSingle", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupMemberCount() (int64, error) {
+func (s *RetryLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupMemberCount()
+		var result bool
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.HasAutoResponsePostByUserSince(options, userId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.HasAutoResponsePostByUserSince", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) GroupTeamCount() (int64, error) {
+func (s *RetryLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+
+	s.PostStore.InvalidateLastPostTimeCache(channelID)
+
+}
+
+func (s *RetryLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.GroupTeamCount()
+		var result *model.Post
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Overwrite(post)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Overwrite", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *RetryLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 
 	tries := 0
 	for {
-		err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+		var result []*model.Post
+		var resultVar1 int
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, resultVar1, err = s.PostStore.OverwriteMultiple(posts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PostStore.OverwriteMultiple", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *RetryLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *RetryLayerPostStore) PermanentDeleteBatchForChannel(channelID string, endTime int64, limit int64) (int64, []*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+		var result int64
+		var resultVar1 []*model.FileInfo
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, resultVar1, err = s.PostStore.PermanentDeleteBatchForChannel(channelID, endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.PermanentDeleteBatchForChannel", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+func (s *RetryLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+		var result int64
+		var resultVar1 model.RetentionPolicyCursor
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, resultVar1, err = s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.PermanentDeleteBatchForRetentionPolicies", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
+func (s *RetryLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.TeamMembersToRemove(teamID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PostStore.PermanentDeleteByChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.PermanentDeleteByChannel", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
+func (s *RetryLayerPostStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.Update(group)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PostStore.PermanentDeleteByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *RetryLayerPostStore) Restore(postID string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+		var result []string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Restore(postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Restore", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *RetryLayerPostStore) Save(post *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpsertMember(groupID, userID)
+		var result *model.Post
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Save(post)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *RetryLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+		var result []*model.Post
+		var resultVar1 int
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, resultVar1, err = s.PostStore.SaveMultiple(posts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostStore.SaveMultiple", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+func (s *RetryLayerPostStore) SaveWithExtras(post *model.Post, extras store.PostExtras) (*model.Post, error) {
 
 	tries := 0
 	for {
-		err := s.JobStore.Cleanup(expiryTime, batchSize)
+		var result *model.Post
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PostStore.SaveWithExtras(post, extras)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PostStore.SaveWithExtras", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Delete(id string) (string, error) {
+func (s *RetryLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Delete(id)
+		var result *model.PostList
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Search(teamID, userID, params)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Search", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Get(id string) (*model.Job, error) {
+func (s *RetryLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Get(id)
+		var result *model.PostSearchResults
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.SearchPostsForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
+func (s *RetryLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByStatus(status)
+		var result *model.Post
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostStore.Update(newPost, oldPost)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
+func (s *RetryLayerPostAcknowledgementStore) Delete(userID string, postID string) error {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByType(jobType)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PostAcknowledgementStore.Delete(userID, postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PostAcknowledgementStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
+func (s *RetryLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
+		var result []*model.PostAcknowledgement
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostAcknowledgementStore.GetForPost(postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostAcknowledgementStore.GetForPost", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostAcknowledgementStore) GetForPosts(postIDs []string) ([]*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+		var result []*model.PostAcknowledgement
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostAcknowledgementStore.GetForPosts(postIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostAcknowledgementStore.GetForPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostAcknowledgementStore) GetSummariesForUser(userID string, offset int, limit int) ([]*model.PostAcknowledgementSummary, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
+		var result []*model.PostAcknowledgementSummary
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostAcknowledgementStore.GetSummariesForUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostAcknowledgementStore.GetSummariesForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllPage(offset, limit)
+		var result *model.PostAcknowledgement
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostAcknowledgementStore.Save(acknowledgement)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostAcknowledgementStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
+func (s *RetryLayerPostModerationStore) Get(id string) (*model.PostModeration, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
+		var result *model.PostModeration
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostModerationStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostModerationStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
+func (s *RetryLayerPostModerationStore) GetAll(teamID string, status string, offset int, limit int) ([]*model.PostModeration, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+		var result []*model.PostModeration
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostModerationStore.GetAll(teamID, status, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostModerationStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
+func (s *RetryLayerPostModerationStore) Review(moderation *model.PostModeration) (*model.PostModeration, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
+		var result *model.PostModeration
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostModerationStore.Review(moderation)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostModerationStore.Review", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
+func (s *RetryLayerPostModerationStore) Save(moderation *model.PostModeration) (*model.PostModeration, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Save(job)
+		var result *model.PostModeration
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostModerationStore.Save(moderation)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostModerationStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
+func (s *RetryLayerPostPriorityStore) GetForPost(postID string) (*model.PostPriority, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
+		var result *model.PostPriority
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostPriorityStore.GetForPost(postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostPriorityStore.GetForPost", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
+func (s *RetryLayerPostPriorityStore) GetForPosts(postIDs []string) ([]*model.PostPriority, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatus(id, status)
+		var result []*model.PostPriority
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostPriorityStore.GetForPosts(postIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostPriorityStore.GetForPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
+func (s *RetryLayerPostPriorityStore) Save(priority *model.PostPriority) (*model.PostPriority, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+		var result *model.PostPriority
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostPriorityStore.Save(priority)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostPriorityStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+func (s *RetryLayerPostReportStore) Get(id string) (*model.PostReport, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.Get(id)
+		var result *model.PostReport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostReportStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostReportStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
+func (s *RetryLayerPostReportStore) GetAll(teamID string, status string, offset int, limit int) ([]*model.PostReport, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.GetAll()
+		var result []*model.PostReport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostReportStore.GetAll(teamID, status, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostReportStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+func (s *RetryLayerPostReportStore) Resolve(report *model.PostReport) (*model.PostReport, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.Save(license)
+		var result *model.PostReport
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostReportStore.Resolve(report)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostReportStore.Resolve", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *RetryLayerPostReportStore) Save(report *model.PostReport) (*model.PostReport, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Get(url, timestamp)
+		var result *model.PostReport
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostReportStore.Save(report)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostReportStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *RetryLayerPostTaskStore) Get(postID string) (*model.PostTask, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Save(linkMetadata)
+		var result *model.PostTask
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostTaskStore.Get(postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostTaskStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
+func (s *RetryLayerPostTaskStore) GetForAssignee(assigneeID string, statuses []string, offset int, limit int) ([]*model.PostTask, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.DeleteApp(id)
+		var result []*model.PostTask
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PostTaskStore.GetForAssignee(assigneeID, statuses, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PostTaskStore.GetForAssignee", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *RetryLayerPostTaskStore) Save(task *model.PostTask) (*model.PostTask, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessData(token)
+		var result *model.PostTask
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostTaskStore.Save(task)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostTaskStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *RetryLayerPostTaskStore) Update(task *model.PostTask) (*model.PostTask, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+		var result *model.PostTask
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PostTaskStore.Update(task)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PostTaskStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+func (s *RetryLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PreferenceStore.CleanupFlagsBatch(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PreferenceStore.CleanupFlagsBatch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *RetryLayerPreferenceStore) CompareAndSet(preferences model.Preferences) (model.Preferences, model.Preferences, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApp(id)
+		var result model.Preferences
+		var resultVar1 model.Preferences
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, resultVar1, err = s.PreferenceStore.CompareAndSet(preferences)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PreferenceStore.CompareAndSet", false, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPreferenceStore) Delete(userID string, category string, name string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PreferenceStore.Delete(userID, category, name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PreferenceStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPreferenceStore) DeleteCategory(userID string, category string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApps(offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PreferenceStore.DeleteCategory(userID, category)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PreferenceStore.DeleteCategory", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *RetryLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthData(code)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PreferenceStore.DeleteCategoryAndName(category, name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PreferenceStore.DeleteCategoryAndName", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PreferenceStore.DeleteOrphanedRows(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PreferenceStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
+func (s *RetryLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
+		var result *model.Preference
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.PreferenceStore.Get(userID, category, name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PreferenceStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
+func (s *RetryLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
+		var result model.Preferences
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PreferenceStore.GetAll(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PreferenceStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAccessData(token string) error {
+func (s *RetryLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAccessData(token)
+		var result model.Preferences
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PreferenceStore.GetCategory(userID, category)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PreferenceStore.GetCategory", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAllAccessData() error {
+func (s *RetryLayerPreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAllAccessData()
+		var result model.Preferences
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.PreferenceStore.GetForUsers(userIDs, category, name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("PreferenceStore.GetForUsers", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) RemoveAuthData(code string) error {
+func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.RemoveAuthData(code)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.PreferenceStore.PermanentDeleteByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("PreferenceStore.PermanentDeleteByUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *RetryLayerPreferenceStore) Save(preferences model.Preferences) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveAccessData(accessData)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.PreferenceStore.Save(preferences)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("PreferenceStore.Save", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *RetryLayerProductNoticesStore) Clear(notices []string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveApp(app)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ProductNoticesStore.Clear(notices)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ProductNoticesStore.Clear", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+func (s *RetryLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.SaveAuthData(authData)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ProductNoticesStore.ClearOldNotices(currentNotices)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ProductNoticesStore.ClearOldNotices", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *RetryLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.UpdateAccessData(accessData)
+		var result []model.ProductNoticeViewState
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ProductNoticesStore.GetViews(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ProductNoticesStore.GetViews", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *RetryLayerProductNoticesStore) View(userID string, notices []string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.UpdateApp(app)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ProductNoticesStore.View(userID, notices)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ProductNoticesStore.View", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *RetryLayerRESTProxyBridgeStore) GetPending(limit int) ([]*model.RESTProxyBridgeMessage, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+		var result []*model.RESTProxyBridgeMessage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RESTProxyBridgeStore.GetPending(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.GetPending", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *RetryLayerRESTProxyBridgeStore) GetStatus() (*model.RESTProxyBridgeStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
+		var result *model.RESTProxyBridgeStatus
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RESTProxyBridgeStore.GetStatus()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.GetStatus", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) Delete(pluginID string, key string) error {
+func (s *RetryLayerRESTProxyBridgeStore) MarkPublished(ids []string, publishedAt int64) error {
 
 	tries := 0
 	for {
-		err := s.PluginStore.Delete(pluginID, key)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.RESTProxyBridgeStore.MarkPublished(ids, publishedAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.MarkPublished", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) DeleteAllExpired() error {
+func (s *RetryLayerRESTProxyBridgeStore) PermanentDeletePublishedBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.PluginStore.DeleteAllExpired()
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.RESTProxyBridgeStore.PermanentDeletePublishedBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.PermanentDeletePublishedBatch", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
+func (s *RetryLayerRESTProxyBridgeStore) Save(message *model.RESTProxyBridgeMessage) (*model.RESTProxyBridgeMessage, error) {
 
 	tries := 0
 	for {
-		err := s.PluginStore.DeleteAllForPlugin(PluginID)
+		var result *model.RESTProxyBridgeMessage
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.RESTProxyBridgeStore.Save(message)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
+func (s *RetryLayerRESTProxyBridgeStore) UpdateAttempt(message *model.RESTProxyBridgeMessage) error {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.Get(pluginID, key)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.RESTProxyBridgeStore.UpdateAttempt(message)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("RESTProxyBridgeStore.UpdateAttempt", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
+func (s *RetryLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.List(pluginID, page, perPage)
+		var result []*model.Reaction
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.BulkGetForPosts(postIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.BulkGetForPosts", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
+func (s *RetryLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.SaveOrUpdate(keyVal)
+		var result *model.Reaction
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.Delete(reaction)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
+func (s *RetryLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
 
 	tries := 0
 	for {
-		result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ReactionStore.DeleteAllWithEmojiName(emojiName)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ReactionStore.DeleteAllWithEmojiName", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsPostCount(teamID string, mustHaveFile bool, mustHaveHashtag bool) (int64, error) {
+func (s *RetryLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsPostCount(teamID, mustHaveFile, mustHaveHashtag)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.DeleteOrphanedRows(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
+func (s *RetryLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsPostCountsByDay(options)
+		var result []*model.Reaction
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.GetForPost(postID, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.GetForPost", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
+func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
+		var result []*model.Reaction
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.GetForPostSince", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) ClearCaches() {
-
-	s.PostStore.ClearCaches()
-
-}
-
-func (s *RetryLayerPostStore) Delete(postID string, time int64, deleteByID string) error {
+func (s *RetryLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.PostStore.Delete(postID, time, deleteByID)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ReactionStore.PermanentDeleteBatch(endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ReactionStore.PermanentDeleteBatch", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.DeleteOrphanedRows(limit)
+		var result *model.Reaction
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ReactionStore.Save(reaction)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ReactionStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Get(ctx context.Context, id string, skipFetchThreads bool, collapsedThreads bool, collapsedThreadsExtended bool, userID string) (*model.PostList, error) {
+func (s *RetryLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Get(ctx, id, skipFetchThreads, collapsedThreads, collapsedThreadsExtended, userID)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.Delete(remoteClusterId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
+func (s *RetryLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.Get(remoteClusterId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
-
-	return s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
-
-}
-
-func (s *RetryLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
+		var result []*model.RemoteCluster
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.GetAll(filter)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.Save(rc)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
+func (s *RetryLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.SetLastPingAt", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
+func (s *RetryLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetLastPostRowCreateAt()
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.Update(rc)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetMaxPostSize() int {
-
-	return s.PostStore.GetMaxPostSize()
-
-}
-
-func (s *RetryLayerPostStore) GetOldest() (*model.Post, error) {
+func (s *RetryLayerRemoteClusterStore) UpdateHiddenProfileFields(remoteClusterId string, hiddenProfileFields string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetOldest()
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.UpdateHiddenProfileFields(remoteClusterId, hiddenProfileFields)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.UpdateHiddenProfileFields", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
+func (s *RetryLayerRemoteClusterStore) UpdateInvitePolicy(remoteClusterId string, invitePolicy string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetOldestEntityCreationTime()
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.UpdateInvitePolicy(remoteClusterId, invitePolicy)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.UpdateInvitePolicy", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
+func (s *RetryLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RemoteClusterStore.UpdateTopics", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, time int64, collapsedThreads bool) (*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostAfterTime(channelID, time, collapsedThreads)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.RetentionPolicyStore.AddChannels(policyId, channelIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.AddChannels", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostIdAfterTime(channelID string, time int64, collapsedThreads bool) (string, error) {
+func (s *RetryLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostIdAfterTime(channelID, time, collapsedThreads)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.RetentionPolicyStore.AddTeams(policyId, teamIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.AddTeams", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, error) {
+func (s *RetryLayerRetentionPolicyStore) CountForPolicyPreview(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostIdBeforeTime(channelID, time, collapsedThreads)
+		var result []*model.RetentionPolicyPreviewCount
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.CountForPolicyPreview(policy, endTime)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.CountForPolicyPreview", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, error) {
+func (s *RetryLayerRetentionPolicyStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPosts(options, allowFromCache)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.RetentionPolicyStore.Delete(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, error) {
+func (s *RetryLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsAfter(options)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.DeleteOrphanedRows(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, error) {
+func (s *RetryLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+		var result *model.RetentionPolicyWithTeamAndChannelCounts
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, error) {
+func (s *RetryLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsBefore(options)
+		var result []*model.RetentionPolicyWithTeamAndChannelCounts
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetAll(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsByIds(postIds)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetChannelPoliciesCountForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, time int64) ([]*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsCreatedAt(channelID, time)
+		var result []*model.RetentionPolicyForChannel
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetChannelPoliciesForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetPostsSince(options, allowFromCache)
+		var result model.ChannelListWithTeamData
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetChannels", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+func (s *RetryLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.RetentionPolicyStore.GetChannelsCount(policyId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetChannelsCount", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
+func (s *RetryLayerRetentionPolicyStore) GetCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetRepliesForExport(parentID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetSingle(id, inclDeleted)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetTeamPoliciesCountForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
+		var result []*model.RetentionPolicyForTeam
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetTeamPoliciesForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
-
-	s.PostStore.InvalidateLastPostTimeCache(channelID)
-
-}
-
-func (s *RetryLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Overwrite(post)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetTeams", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *RetryLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.RetentionPolicyStore.GetTeamsCount(policyId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.GetTeamsCount", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
+		var result *model.RetentionPolicyWithTeamAndChannelCounts
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RetentionPolicyStore.Patch(patch)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.Patch", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *RetryLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			err = s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.RemoveChannels", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteByChannel(channelID string) error {
+func (s *RetryLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
 
 	tries := 0
 	for {
-		err := s.PostStore.PermanentDeleteByChannel(channelID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.RemoveTeams", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 
 	tries := 0
 	for {
-		err := s.PostStore.PermanentDeleteByUser(userID)
+		var result *model.RetentionPolicyWithTeamAndChannelCounts
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.RetentionPolicyStore.Save(policy)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("RetentionPolicyStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Save(post *model.Post) (*model.Post, error) {
+func (s *RetryLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Save(post)
+		var result []*model.Role
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.AllChannelSchemeRoles()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.AllChannelSchemeRoles", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *RetryLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.PostStore.SaveMultiple(posts)
+		var result map[string]*model.RolePermissions
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.RoleStore.ChannelHigherScopedPermissions(roleNames)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("RoleStore.ChannelHigherScopedPermissions", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
+func (s *RetryLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Search(teamID, userID, params)
+		var result []*model.Role
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.ChannelRolesUnderTeamRole(roleName)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.ChannelRolesUnderTeamRole", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
+func (s *RetryLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
+		var result *model.Role
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.Delete(roleID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
+func (s *RetryLayerRoleStore) Get(roleID string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Update(newPost, oldPost)
+		var result *model.Role
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.Get(roleID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
+func (s *RetryLayerRoleStore) GetAll() ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
+		var result []*model.Role
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) Delete(userID string, category string, name string) error {
+func (s *RetryLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.Delete(userID, category, name)
+		var result *model.Role
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.RoleStore.GetByName(ctx, name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("RoleStore.GetByName", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteCategory(userID string, category string) error {
+func (s *RetryLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.DeleteCategory(userID, category)
+		var result []*model.Role
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.RoleStore.GetByNames(names)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("RoleStore.GetByNames", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
+func (s *RetryLayerRoleStore) PermanentDeleteAll() error {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.DeleteCategoryAndName(category, name)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.RoleStore.PermanentDeleteAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.PermanentDeleteAll", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
+		var result *model.Role
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.RoleStore.Save(role)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("RoleStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
+func (s *RetryLayerScheduledChannelMessageStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.Get(userID, category, name)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ScheduledChannelMessageStore.Delete(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
+func (s *RetryLayerScheduledChannelMessageStore) DeleteForChannel(channelID string) error {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.GetAll(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.ScheduledChannelMessageStore.DeleteForChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.DeleteForChannel", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
+func (s *RetryLayerScheduledChannelMessageStore) Get(id string) (*model.ScheduledChannelMessage, error) {
 
 	tries := 0
 	for {
-		result, err := s.PreferenceStore.GetCategory(userID, category)
+		var result *model.ScheduledChannelMessage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ScheduledChannelMessageStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
+func (s *RetryLayerScheduledChannelMessageStore) GetDue(now int64, limit int) ([]*model.ScheduledChannelMessage, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.PermanentDeleteByUser(userID)
+		var result []*model.ScheduledChannelMessage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ScheduledChannelMessageStore.GetDue(now, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.GetDue", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerPreferenceStore) Save(preferences model.Preferences) error {
+func (s *RetryLayerScheduledChannelMessageStore) GetForChannel(channelID string) ([]*model.ScheduledChannelMessage, error) {
 
 	tries := 0
 	for {
-		err := s.PreferenceStore.Save(preferences)
+		var result []*model.ScheduledChannelMessage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ScheduledChannelMessageStore.GetForChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.GetForChannel", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) Clear(notices []string) error {
+func (s *RetryLayerScheduledChannelMessageStore) Save(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.Clear(notices)
+		var result *model.ScheduledChannelMessage
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ScheduledChannelMessageStore.Save(message, maxPostSize)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
+func (s *RetryLayerScheduledChannelMessageStore) Update(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
+		var result *model.ScheduledChannelMessage
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.ScheduledChannelMessageStore.Update(message, maxPostSize)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.Update", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
+func (s *RetryLayerScheduledChannelMessageStore) UpdateRun(id string, expectedNextRunAt int64, lastRunAt int64, nextRunAt int64) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.ProductNoticesStore.GetViews(userID)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ScheduledChannelMessageStore.UpdateRun(id, expectedNextRunAt, lastRunAt, nextRunAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ScheduledChannelMessageStore.UpdateRun", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerProductNoticesStore) View(userID string, notices []string) error {
+func (s *RetryLayerSchemeStore) AssignChannels(schemeID string, channelIDs []string) error {
 
 	tries := 0
 	for {
-		err := s.ProductNoticesStore.View(userID, notices)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SchemeStore.AssignChannels(schemeID, channelIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.AssignChannels", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+func (s *RetryLayerSchemeStore) AssignTeams(schemeID string, teamIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.BulkGetForPosts(postIds)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SchemeStore.AssignTeams(schemeID, teamIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SchemeStore.AssignTeams", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *RetryLayerSchemeStore) CountByScope(scope string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.Delete(reaction)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.CountByScope(scope)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.CountByScope", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+func (s *RetryLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
 
 	tries := 0
 	for {
-		err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SchemeStore.CountWithoutPermission", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.DeleteOrphanedRows(limit)
+		var result *model.Scheme
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.Delete(schemeID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
+func (s *RetryLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
+		var result *model.Scheme
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.Get(schemeID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
+func (s *RetryLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
+		var result []*model.Scheme
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.GetAllPage(scope, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.GetAllPage", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+		var result *model.Scheme
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.GetByName(schemeName)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.GetByName", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *RetryLayerSchemeStore) PermanentDeleteAll() error {
 
 	tries := 0
 	for {
-		result, err := s.ReactionStore.Save(reaction)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SchemeStore.PermanentDeleteAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SchemeStore.PermanentDeleteAll", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
+func (s *RetryLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Delete(remoteClusterId)
+		var result *model.Scheme
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SchemeStore.Save(scheme)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SchemeStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
+func (s *RetryLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Get(remoteClusterId)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.AnalyticsSessionCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.AnalyticsSessionCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
+func (s *RetryLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.GetAll(filter)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.Cleanup(expiryTime, batchSize)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.Cleanup", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *RetryLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Save(rc)
+		var result *model.Session
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.Get(ctx, sessionIDOrToken)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
+func (s *RetryLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
 
 	tries := 0
 	for {
-		err := s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SessionStore.GetLastSessionRowCreateAt()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SessionStore.GetLastSessionRowCreateAt", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *RetryLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.Update(rc)
+		var result []*model.Session
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.GetSessions(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.GetSessions", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
+func (s *RetryLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
+		var result []*model.Session
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.GetSessionsExpired", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
+func (s *RetryLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.AddChannels(policyId, channelIds)
+		var result []*model.Session
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SessionStore.GetSessionsWithActiveDeviceIds", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
+func (s *RetryLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.AddTeams(policyId, teamIds)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SessionStore.PermanentDeleteSessionsByUser(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.PermanentDeleteSessionsByUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Delete(id string) error {
+func (s *RetryLayerSessionStore) Remove(sessionIDOrToken string) error {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.Delete(id)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SessionStore.Remove(sessionIDOrToken)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.Remove", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *RetryLayerSessionStore) RemoveAllSessions() error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.DeleteOrphanedRows(limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.RemoveAllSessions()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.RemoveAllSessions", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Get(id)
+		var result *model.Session
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.Save(session)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetAll(offset, limit)
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateDeviceId", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
+func (s *RetryLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.UpdateExpiredNotify(sessionid, notified)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateExpiredNotify", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
+func (s *RetryLayerSessionStore) UpdateExpiresAt(sessionID string, time int64) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.UpdateExpiresAt(sessionID, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateExpiresAt", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
+func (s *RetryLayerSessionStore) UpdateLastActivityAt(sessionID string, time int64) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.UpdateLastActivityAt(sessionID, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateLastActivityAt", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
+func (s *RetryLayerSessionStore) UpdateProps(session *model.Session) error {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetChannelsCount(policyId)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SessionStore.UpdateProps(session)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateProps", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetCount() (int64, error) {
+func (s *RetryLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetCount()
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SessionStore.UpdateRoles(userID, roles)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SessionStore.UpdateRoles", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
+func (s *RetryLayerSharedChannelStore) Delete(channelId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.Delete(channelId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.Delete", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
+func (s *RetryLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.DeleteRemote(remoteId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.DeleteRemote", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
+func (s *RetryLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
+		var result *model.SharedChannel
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.Get(channelId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
+func (s *RetryLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
+		var result []*model.SharedChannel
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetAll(offset, limit, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Patch(patch)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetAllCount(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetAllCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
+func (s *RetryLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
+		var result *model.SharedChannelAttachment
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SharedChannelStore.GetAttachment(fileId, remoteId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetAttachment", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+func (s *RetryLayerSharedChannelStore) GetInvite(id string) (*model.SharedChannelInvite, error) {
 
 	tries := 0
 	for {
-		err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+		var result *model.SharedChannelInvite
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SharedChannelStore.GetInvite(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetInvite", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *RetryLayerSharedChannelStore) GetInvites(opts model.SharedChannelInviteFilterOpts, offset int, limit int) ([]*model.SharedChannelInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.RetentionPolicyStore.Save(policy)
+		var result []*model.SharedChannelInvite
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetInvites(opts, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetInvites", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.AllChannelSchemeRoles()
+		var result *model.SharedChannelRemote
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetRemote(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetRemote", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+func (s *RetryLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+		var result *model.SharedChannelRemote
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetRemoteByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+		var result *model.RemoteCluster
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetRemoteForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Delete(roleID)
+		var result []*model.SharedChannelRemote
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetRemotes(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetRemotes", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) Get(roleID string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Get(roleID)
+		var result []*model.SharedChannelRemoteStatus
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetRemotesStatus(channelId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetRemotesStatus", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) GetAll() ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetAll()
+		var result *model.SharedChannelUser
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetSingleUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetByName(ctx, name)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetUsersForSync(filter)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetUsersForSync", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.GetByNames(names)
+		var result []*model.SharedChannelUser
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.GetUsersForUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.GetUsersForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) PermanentDeleteAll() error {
+func (s *RetryLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
 
 	tries := 0
 	for {
-		err := s.RoleStore.PermanentDeleteAll()
+		var result bool
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SharedChannelStore.HasChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SharedChannelStore.HasChannel", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *RetryLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Save(role)
+		var result bool
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.HasRemote(channelID, remoteId)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.HasRemote", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) CountByScope(scope string) (int64, error) {
+func (s *RetryLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.CountByScope(scope)
+		var result *model.SharedChannel
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.Save(sc)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+func (s *RetryLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+		var result *model.SharedChannelAttachment
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.SaveAttachment(remote)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.SaveAttachment", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) SaveInvite(invite *model.SharedChannelInvite) (*model.SharedChannelInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Delete(schemeID)
+		var result *model.SharedChannelInvite
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.SaveInvite(invite)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.SaveInvite", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Get(schemeID)
+		var result *model.SharedChannelRemote
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.SaveRemote(remote)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.SaveRemote", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.GetAllPage(scope, offset, limit)
+		var result *model.SharedChannelUser
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.SaveUser(remote)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.SaveUser", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.GetByName(schemeName)
+		var result *model.SharedChannel
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.Update(sc)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) PermanentDeleteAll() error {
+func (s *RetryLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
 
 	tries := 0
 	for {
-		err := s.SchemeStore.PermanentDeleteAll()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpdateAttachmentLastSyncAt", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+func (s *RetryLayerSharedChannelStore) UpdateInviteStatus(id string, status string, decidedBy string) (*model.SharedChannelInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.SchemeStore.Save(scheme)
+		var result *model.SharedChannelInvite
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.UpdateInviteStatus(id, status, decidedBy)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpdateInviteStatus", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) AnalyticsSessionCount() (int64, error) {
+func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.AnalyticsSessionCount()
+		var result *model.SharedChannelRemote
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.UpdateRemote(remote)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpdateRemote", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
+func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.Cleanup(expiryTime, batchSize)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpdateRemoteCursor", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
+func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.Get(ctx, sessionIDOrToken)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpdateUserLastSyncAt", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
+func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetLastSessionRowCreateAt()
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SharedChannelStore.UpsertAttachment(remote)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SharedChannelStore.UpsertAttachment", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
+func (s *RetryLayerStatusStore) Get(userID string) (*model.Status, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessions(userID)
+		var result *model.Status
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.StatusStore.Get(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+func (s *RetryLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+		var result []*model.Status
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.StatusStore.GetByIds(userIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.GetByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
+func (s *RetryLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.StatusStore.GetTotalActiveUsersCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.GetTotalActiveUsersCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
+func (s *RetryLayerStatusStore) ResetAll() error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.PermanentDeleteSessionsByUser(teamID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.StatusStore.ResetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.ResetAll", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Remove(sessionIDOrToken string) error {
+func (s *RetryLayerStatusStore) SaveOrUpdate(status *model.Status) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.Remove(sessionIDOrToken)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.StatusStore.SaveOrUpdate(status)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.SaveOrUpdate", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) RemoveAllSessions() error {
+func (s *RetryLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.RemoveAllSessions()
+		var result []*model.Status
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
+			result, err = s.StatusStore.UpdateExpiredDNDStatuses()
 		}
-		if !isRepeatableError(err) {
-			return err
+		tries++
+		retry, err := s.Root.retrier.retry("StatusStore.UpdateExpiredDNDStatuses", false, tries, err)
+		if !retry {
+			return result, err
+		}
+	}
+
+}
+
+func (s *RetryLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("StatusStore.UpdateLastActivityAt", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+func (s *RetryLayerSystemStore) Get() (model.StringMap, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.Save(session)
+		var result model.StringMap
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SystemStore.Get()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SystemStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
+func (s *RetryLayerSystemStore) GetByName(name string) (*model.System, error) {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
+		var result *model.System
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.SystemStore.GetByName(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SystemStore.GetByName", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+func (s *RetryLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+		var result *model.System
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SystemStore.InsertIfExists(system)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SystemStore.InsertIfExists", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateExpiresAt(sessionID string, time int64) error {
+func (s *RetryLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateExpiresAt(sessionID, time)
+		var result *model.System
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.SystemStore.PermanentDeleteByName(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("SystemStore.PermanentDeleteByName", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateLastActivityAt(sessionID string, time int64) error {
+func (s *RetryLayerSystemStore) Save(system *model.System) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateLastActivityAt(sessionID, time)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SystemStore.Save(system)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SystemStore.Save", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateProps(session *model.Session) error {
+func (s *RetryLayerSystemStore) SaveOrUpdate(system *model.System) error {
 
 	tries := 0
 	for {
-		err := s.SessionStore.UpdateProps(session)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.SystemStore.SaveOrUpdate(system)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("SystemStore.SaveOrUpdate", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
+func (s *RetryLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SessionStore.UpdateRoles(userID, roles)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SystemStore.SaveOrUpdateWithWarnMetricHandling", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) Delete(channelId string) (bool, error) {
+func (s *RetryLayerSystemStore) Update(system *model.System) error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Delete(channelId)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.SystemStore.Update(system)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("SystemStore.Update", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
+func (s *RetryLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.DeleteRemote(remoteId)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.AnalyticsGetTeamCountForScheme", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Get(channelId)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.AnalyticsTeamCount(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.AnalyticsTeamCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) ClearAllCustomRoleAssignments() error {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAll(offset, limit, opts)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.ClearAllCustomRoleAssignments()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.ClearAllCustomRoleAssignments", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
+func (s *RetryLayerTeamStore) ClearCaches() {
+
+	s.TeamStore.ClearCaches()
+
+}
+
+func (s *RetryLayerTeamStore) Get(id string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAllCount(opts)
+		var result *model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
+func (s *RetryLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetAttachment(fileId, remoteId)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetActiveMemberCount(teamID, restrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetActiveMemberCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetAll() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemote(id)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
+		var result []*model.TeamForExport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetAllForExportAfter(limit, afterID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetAllForExportAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
+func (s *RetryLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetAllPage(offset, limit, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetAllPage", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemotes(opts)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetAllPrivateTeamListing()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetAllPrivateTeamListing", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
+func (s *RetryLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetRemotesStatus(channelId)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetAllTeamListing()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetAllTeamListing", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
+func (s *RetryLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
+		var result *model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetByInviteId(inviteID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetByInviteId", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
+func (s *RetryLayerTeamStore) GetByName(name string) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetUsersForSync(filter)
+		var result *model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetByName(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetByName", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
+func (s *RetryLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.GetUsersForUser(userID)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetByNames(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetByNames", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
+func (s *RetryLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.HasChannel(channelID)
+		var result []*model.ChannelUnread
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetChannelUnreadsForAllTeams", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
+func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.HasRemote(channelID, remoteId)
+		var result []*model.ChannelUnread
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetChannelUnreadsForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) GetChildTeams(parentTeamID string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Save(sc)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetChildTeams(parentTeamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetChildTeams", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
+func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveAttachment(remote)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetCommonTeamIDsForTwoUsers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveRemote(remote)
+		var result *model.TeamMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetMember(ctx, teamID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetMember", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
+func (s *RetryLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.SaveUser(remote)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetMembers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *RetryLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.Update(sc)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetMembersByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
+func (s *RetryLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
+		var result []*model.TeamMemberForExport
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.GetTeamMembersForExport(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.GetTeamMembersForExport", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *RetryLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.UpdateRemote(remote)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetTeamsByScheme", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
+func (s *RetryLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.GetTeamsByUserId(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.GetTeamsByUserId", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
+func (s *RetryLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.GetTeamsForUser(ctx, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.GetTeamsForUser", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
+func (s *RetryLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.UpsertAttachment(remote)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetTeamsForUserWithPagination", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) Get(userID string) (*model.Status, error) {
+func (s *RetryLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.Get(userID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetTotalMemberCount(teamID, restrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetTotalMemberCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
+func (s *RetryLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.GetByIds(userIds)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GetUserTeamIds(userID, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GetUserTeamIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
+func (s *RetryLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.GetTotalActiveUsersCount()
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.GroupSyncedTeamCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.GroupSyncedTeamCount", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) ResetAll() error {
+func (s *RetryLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
+
+	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
+
+}
+
+func (s *RetryLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
 
 	tries := 0
 	for {
-		err := s.StatusStore.ResetAll()
+		var result map[string]string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.MigrateTeamMembers", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) SaveOrUpdate(status *model.Status) error {
+func (s *RetryLayerTeamStore) PermanentDelete(teamID string) error {
 
 	tries := 0
 	for {
-		err := s.StatusStore.SaveOrUpdate(status)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TeamStore.PermanentDelete(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.PermanentDelete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
+func (s *RetryLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.StatusStore.UpdateExpiredDNDStatuses()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.RemoveAllMembersByTeam(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.RemoveAllMembersByTeam", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
+func (s *RetryLayerTeamStore) RemoveAllMembersByUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TeamStore.RemoveAllMembersByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.RemoveAllMembersByUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) Get() (model.StringMap, error) {
+func (s *RetryLayerTeamStore) RemoveMember(teamID string, userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.Get()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.RemoveMember(teamID, userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.RemoveMember", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) GetByName(name string) (*model.System, error) {
+func (s *RetryLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.GetByName(name)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.RemoveMembers(teamID, userIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.RemoveMembers", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
+func (s *RetryLayerTeamStore) ResetAllTeamSchemes() error {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.InsertIfExists(system)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.ResetAllTeamSchemes()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.ResetAllTeamSchemes", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
+func (s *RetryLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.SystemStore.PermanentDeleteByName(name)
+		var result *model.Team
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.Save(team)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) Save(system *model.System) error {
+func (s *RetryLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.Save(system)
+		var result *model.TeamMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.SaveMember(member, maxUsersPerTeam)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.SaveMember", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) SaveOrUpdate(system *model.System) error {
+func (s *RetryLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.SaveOrUpdate(system)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.SaveMultipleMembers", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
+func (s *RetryLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.SearchAll(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.SearchAll", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSystemStore) Update(system *model.System) error {
+func (s *RetryLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
 
 	tries := 0
 	for {
-		err := s.SystemStore.Update(system)
+		var result []*model.Team
+		var resultVar1 int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, resultVar1, err = s.TeamStore.SearchAllPaged(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.SearchAllPaged", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
+func (s *RetryLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.SearchOpen(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.SearchOpen", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
+func (s *RetryLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.AnalyticsTeamCount(opts)
+		var result []*model.Team
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.SearchPrivate(opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.SearchPrivate", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) ClearAllCustomRoleAssignments() error {
+func (s *RetryLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.ClearAllCustomRoleAssignments()
+		var result *model.Team
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStore.Update(team)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStore.Update", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) ClearCaches() {
-
-	s.TeamStore.ClearCaches()
-
-}
-
-func (s *RetryLayerTeamStore) Get(id string) (*model.Team, error) {
+func (s *RetryLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Get(id)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.UpdateLastTeamIconUpdate", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *RetryLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetActiveMemberCount(teamID, restrictions)
+		var result *model.TeamMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.UpdateMember(member)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.UpdateMember", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAll() ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAll()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStore.UpdateMembersRole(teamID, userIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStore.UpdateMembersRole", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
+func (s *RetryLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllForExportAfter(limit, afterID)
+		var result []*model.TeamMember
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.UpdateMultipleMembers(members)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.UpdateMultipleMembers", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllPage(offset, limit, opts)
+		var result bool
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStore.UserBelongsToTeams(userID, teamIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStore.UserBelongsToTeams", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
+func (s *RetryLayerTeamAliasStore) Delete(name string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllPrivateTeamListing()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamAliasStore.Delete(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamAliasStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
+func (s *RetryLayerTeamAliasStore) DeleteForTeam(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetAllTeamListing()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamAliasStore.DeleteForTeam(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamAliasStore.DeleteForTeam", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
+func (s *RetryLayerTeamAliasStore) Get(name string) (*model.TeamAlias, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByInviteId(inviteID)
+		var result *model.TeamAlias
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamAliasStore.Get(name)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamAliasStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetByName(name string) (*model.Team, error) {
+func (s *RetryLayerTeamAliasStore) GetForTeam(teamID string) ([]*model.TeamAlias, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByName(name)
+		var result []*model.TeamAlias
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamAliasStore.GetForTeam(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamAliasStore.GetForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
+func (s *RetryLayerTeamAliasStore) Save(alias *model.TeamAlias) (*model.TeamAlias, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetByNames(name)
+		var result *model.TeamAlias
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamAliasStore.Save(alias)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamAliasStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *RetryLayerTeamBannerStore) Delete(id string, deleteAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamBannerStore.Delete(id, deleteAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamBannerStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *RetryLayerTeamBannerStore) Get(id string) (*model.TeamBanner, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
+		var result *model.TeamBanner
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
+func (s *RetryLayerTeamBannerStore) GetDismissedBannerIds(userID string, bannerIDs []string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.GetDismissedBannerIds(userID, bannerIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.GetDismissedBannerIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
+func (s *RetryLayerTeamBannerStore) GetDismissingUserIds(bannerID string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMember(ctx, teamID, userID)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.GetDismissingUserIds(bannerID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.GetDismissingUserIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamBannerStore) GetForTeam(teamID string) ([]*model.TeamBanner, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
+		var result []*model.TeamBanner
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.GetForTeam(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.GetForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamBannerStore) GetScheduledBetween(since int64, until int64) ([]*model.TeamBanner, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
+		var result []*model.TeamBanner
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.GetScheduledBetween(since, until)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.GetScheduledBetween", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
+func (s *RetryLayerTeamBannerStore) Save(banner *model.TeamBanner) (*model.TeamBanner, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamMembersForExport(userID)
+		var result *model.TeamBanner
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.Save(banner)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
+func (s *RetryLayerTeamBannerStore) SaveDismissal(dismissal *model.TeamBannerDismissal) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamBannerStore.SaveDismissal(dismissal)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamBannerStore.SaveDismissal", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
+func (s *RetryLayerTeamBannerStore) Update(banner *model.TeamBanner) (*model.TeamBanner, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsByUserId(userID)
+		var result *model.TeamBanner
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamBannerStore.Update(banner)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamBannerStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamDeletionStore) Delete(teamID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsForUser(ctx, userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamDeletionStore.Delete(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamDeletionStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamDeletionStore) Get(teamID string) (*model.TeamDeletion, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
+		var result *model.TeamDeletion
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamDeletionStore.Get(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamDeletionStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *RetryLayerTeamDeletionStore) GetAll() ([]*model.TeamDeletion, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetTotalMemberCount(teamID, restrictions)
+		var result []*model.TeamDeletion
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamDeletionStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamDeletionStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
+func (s *RetryLayerTeamDeletionStore) Save(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
+		var result *model.TeamDeletion
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamDeletionStore.Save(deletion)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamDeletionStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
+func (s *RetryLayerTeamDeletionStore) Update(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.GroupSyncedTeamCount()
+		var result *model.TeamDeletion
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamDeletionStore.Update(deletion)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamDeletionStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
-
-	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
-
-}
-
-func (s *RetryLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
+func (s *RetryLayerTeamGroupRoleStore) Delete(teamID string, groupID string) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamGroupRoleStore.Delete(teamID, groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.Delete", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) PermanentDelete(teamID string) error {
+func (s *RetryLayerTeamGroupRoleStore) DeleteGrants(teamID string, userIDs []string) error {
 
 	tries := 0
 	for {
-		err := s.TeamStore.PermanentDelete(teamID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TeamGroupRoleStore.DeleteGrants(teamID, userIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.DeleteGrants", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
+func (s *RetryLayerTeamGroupRoleStore) GetForGroup(groupID string) ([]*model.TeamGroupRole, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveAllMembersByTeam(teamID)
+		var result []*model.TeamGroupRole
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamGroupRoleStore.GetForGroup(groupID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.GetForGroup", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveAllMembersByUser(userID string) error {
+func (s *RetryLayerTeamGroupRoleStore) GetForTeam(teamID string) ([]*model.TeamGroupRole, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveAllMembersByUser(userID)
+		var result []*model.TeamGroupRole
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamGroupRoleStore.GetForTeam(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.GetForTeam", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveMember(teamID string, userID string) error {
+func (s *RetryLayerTeamGroupRoleStore) GetMembers(teamID string) ([]*model.TeamGroupRoleMember, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveMember(teamID, userID)
+		var result []*model.TeamGroupRoleMember
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamGroupRoleStore.GetMembers(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.GetMembers", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
+func (s *RetryLayerTeamGroupRoleStore) GetTeamIDs() ([]string, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.RemoveMembers(teamID, userIds)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamGroupRoleStore.GetTeamIDs()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.GetTeamIDs", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) ResetAllTeamSchemes() error {
+func (s *RetryLayerTeamGroupRoleStore) Save(mapping *model.TeamGroupRole) (*model.TeamGroupRole, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.ResetAllTeamSchemes()
+		var result *model.TeamGroupRole
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamGroupRoleStore.Save(mapping)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.Save", false, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
+func (s *RetryLayerTeamGroupRoleStore) SaveGrants(teamID string, userIDs []string, grantedAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Save(team)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamGroupRoleStore.SaveGrants(teamID, userIDs, grantedAt)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamGroupRoleStore.SaveGrants", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+func (s *RetryLayerTeamInviteUsageStore) Get(teamID string, day int64) (*model.TeamInviteUsage, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SaveMember(member, maxUsersPerTeam)
+		var result *model.TeamInviteUsage
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamInviteUsageStore.Get(teamID, day)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamInviteUsageStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamInviteUsageStore) Increment(usage *model.TeamInviteUsage) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamInviteUsageStore.Increment(usage)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamInviteUsageStore.Increment", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerTeamRequestStore) Get(id string) (*model.TeamRequest, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchAll(opts)
+		var result *model.TeamRequest
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamRequestStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamRequestStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
+func (s *RetryLayerTeamRequestStore) GetAll(status string, offset int, limit int) ([]*model.TeamRequest, error) {
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
+		var result []*model.TeamRequest
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, err = s.TeamRequestStore.GetAll(status, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, resultVar1, err
+		retry, err := s.Root.retrier.retry("TeamRequestStore.GetAll", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerTeamRequestStore) Review(request *model.TeamRequest) (*model.TeamRequest, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchOpen(opts)
+		var result *model.TeamRequest
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamRequestStore.Review(request)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamRequestStore.Review", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *RetryLayerTeamRequestStore) Save(request *model.TeamRequest) (*model.TeamRequest, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.SearchPrivate(opts)
+		var result *model.TeamRequest
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamRequestStore.Save(request)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamRequestStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
+func (s *RetryLayerTeamStatsStore) GetChannelDailyStats(channelID string, since int64, until int64) ([]*model.ChannelDailyStats, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.Update(team)
+		var result []*model.ChannelDailyStats
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStatsStore.GetChannelDailyStats(channelID, since, until)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStatsStore.GetChannelDailyStats", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
+func (s *RetryLayerTeamStatsStore) GetChannelPostCounts(teamID string, since int64, until int64, limit int) ([]*model.TeamChannelPostCount, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
+		var result []*model.TeamChannelPostCount
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.TeamStatsStore.GetChannelPostCounts(teamID, since, until, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStatsStore.GetChannelPostCounts", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
+func (s *RetryLayerTeamStatsStore) GetDailyStats(teamID string, since int64, until int64) ([]*model.TeamDailyStats, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UpdateMember(member)
+		var result []*model.TeamDailyStats
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStatsStore.GetDailyStats(teamID, since, until)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStatsStore.GetDailyStats", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
+func (s *RetryLayerTeamStatsStore) GetFileUsage(teamID string) (int64, int64, error) {
 
 	tries := 0
 	for {
-		err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+		var result int64
+		var resultVar1 int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, resultVar1, err = s.TeamStatsStore.GetFileUsage(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("TeamStatsStore.GetFileUsage", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+func (s *RetryLayerTeamStatsStore) GetTopPosters(teamID string, since int64, until int64, limit int) ([]*model.TeamPosterCount, error) {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UpdateMultipleMembers(members)
+		var result []*model.TeamPosterCount
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TeamStatsStore.GetTopPosters(teamID, since, until, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TeamStatsStore.GetTopPosters", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
+func (s *RetryLayerTeamStatsStore) RollupDay(day int64) error {
 
 	tries := 0
 	for {
-		result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			err = s.TeamStatsStore.RollupDay(day)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+		retry, err := s.Root.retrier.retry("TeamStatsStore.RollupDay", false, tries, err)
+		if !retry {
+			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10460,19 +13689,16 @@ func (s *RetryLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*mo
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.Get(id, allowFromCache)
+		var result *model.TermsOfService
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TermsOfServiceStore.Get(id, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TermsOfServiceStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10481,19 +13707,16 @@ func (s *RetryLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.T
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.GetLatest(allowFromCache)
+		var result *model.TermsOfService
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TermsOfServiceStore.GetLatest(allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TermsOfServiceStore.GetLatest", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10502,19 +13725,16 @@ func (s *RetryLayerTermsOfServiceStore) Save(termsOfService *model.TermsOfServic
 
 	tries := 0
 	for {
-		result, err := s.TermsOfServiceStore.Save(termsOfService)
+		var result *model.TermsOfService
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TermsOfServiceStore.Save(termsOfService)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TermsOfServiceStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10523,19 +13743,16 @@ func (s *RetryLayerThreadStore) DeleteMembershipForUser(userId string, postID st
 
 	tries := 0
 	for {
-		err := s.ThreadStore.DeleteMembershipForUser(userId, postID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ThreadStore.DeleteMembershipForUser(userId, postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.DeleteMembershipForUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10544,19 +13761,16 @@ func (s *RetryLayerThreadStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.DeleteOrphanedRows(limit)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.DeleteOrphanedRows(limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.DeleteOrphanedRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10565,19 +13779,16 @@ func (s *RetryLayerThreadStore) Get(id string) (*model.Thread, error) {
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.Get(id)
+		var result *model.Thread
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10586,19 +13797,16 @@ func (s *RetryLayerThreadStore) GetMembershipForUser(userId string, postID strin
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetMembershipForUser(userId, postID)
+		var result *model.ThreadMembership
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetMembershipForUser(userId, postID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetMembershipForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10607,19 +13815,16 @@ func (s *RetryLayerThreadStore) GetMembershipsForUser(userId string, teamID stri
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetMembershipsForUser(userId, teamID)
+		var result []*model.ThreadMembership
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetMembershipsForUser(userId, teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetMembershipsForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10628,19 +13833,34 @@ func (s *RetryLayerThreadStore) GetPosts(threadID string, since int64) ([]*model
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetPosts(threadID, since)
+		var result []*model.Post
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.ThreadStore.GetPosts(threadID, since)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("ThreadStore.GetPosts", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerThreadStore) GetSummary(threadID string, bucketSize int64, replies int) (*model.ThreadSummary, error) {
+
+	tries := 0
+	for {
+		var result *model.ThreadSummary
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.ThreadStore.GetSummary(threadID, bucketSize, replies)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetSummary", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10649,19 +13869,16 @@ func (s *RetryLayerThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []s
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetTeamsUnreadForUser(userID, teamIDs)
+		var result map[string]*model.TeamUnread
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetTeamsUnreadForUser(userID, teamIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetTeamsUnreadForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10670,19 +13887,16 @@ func (s *RetryLayerThreadStore) GetThreadFollowers(threadID string, fetchOnlyAct
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadFollowers(threadID, fetchOnlyActive)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetThreadFollowers(threadID, fetchOnlyActive)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetThreadFollowers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10691,19 +13905,16 @@ func (s *RetryLayerThreadStore) GetThreadForUser(teamID string, threadMembership
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadForUser(teamID, threadMembership, extended)
+		var result *model.ThreadResponse
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetThreadForUser(teamID, threadMembership, extended)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetThreadForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10712,19 +13923,16 @@ func (s *RetryLayerThreadStore) GetThreadUnreadReplyCount(threadMembership *mode
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadUnreadReplyCount(threadMembership)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetThreadUnreadReplyCount(threadMembership)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetThreadUnreadReplyCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10733,19 +13941,16 @@ func (s *RetryLayerThreadStore) GetThreadsForUser(userId string, teamID string,
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.GetThreadsForUser(userId, teamID, opts)
+		var result *model.Threads
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.GetThreadsForUser(userId, teamID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.GetThreadsForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10754,19 +13959,16 @@ func (s *RetryLayerThreadStore) MaintainMembership(userID string, postID string,
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.MaintainMembership(userID, postID, opts)
+		var result *model.ThreadMembership
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.MaintainMembership(userID, postID, opts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.MaintainMembership", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10775,19 +13977,16 @@ func (s *RetryLayerThreadStore) MarkAllAsRead(userID string, threadIds []string)
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsRead(userID, threadIds)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ThreadStore.MarkAllAsRead(userID, threadIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.MarkAllAsRead", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10796,19 +13995,16 @@ func (s *RetryLayerThreadStore) MarkAllAsReadByChannels(userID string, channelID
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsReadByChannels(userID, channelIDs)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ThreadStore.MarkAllAsReadByChannels(userID, channelIDs)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.MarkAllAsReadByChannels", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10817,19 +14013,16 @@ func (s *RetryLayerThreadStore) MarkAllAsReadByTeam(userID string, teamID string
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAllAsReadByTeam(userID, teamID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ThreadStore.MarkAllAsReadByTeam(userID, teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.MarkAllAsReadByTeam", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10838,19 +14031,16 @@ func (s *RetryLayerThreadStore) MarkAsRead(userID string, threadID string, times
 
 	tries := 0
 	for {
-		err := s.ThreadStore.MarkAsRead(userID, threadID, timestamp)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.ThreadStore.MarkAsRead(userID, threadID, timestamp)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.MarkAsRead", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10859,19 +14049,17 @@ func (s *RetryLayerThreadStore) PermanentDeleteBatchForRetentionPolicies(now int
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		var result int64
+		var resultVar1 model.RetentionPolicyCursor
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, resultVar1, err = s.ThreadStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.PermanentDeleteBatchForRetentionPolicies", false, tries, err)
+		if !retry {
 			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10880,19 +14068,17 @@ func (s *RetryLayerThreadStore) PermanentDeleteBatchThreadMembershipsForRetentio
 
 	tries := 0
 	for {
-		result, resultVar1, err := s.ThreadStore.PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+		var result int64
+		var resultVar1 model.RetentionPolicyCursor
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, resultVar1, nil
-		}
-		if !isRepeatableError(err) {
-			return result, resultVar1, err
+			result, resultVar1, err = s.ThreadStore.PermanentDeleteBatchThreadMembershipsForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.PermanentDeleteBatchThreadMembershipsForRetentionPolicies", false, tries, err)
+		if !retry {
 			return result, resultVar1, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10901,19 +14087,16 @@ func (s *RetryLayerThreadStore) UpdateMembership(membership *model.ThreadMembers
 
 	tries := 0
 	for {
-		result, err := s.ThreadStore.UpdateMembership(membership)
+		var result *model.ThreadMembership
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.ThreadStore.UpdateMembership(membership)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("ThreadStore.UpdateMembership", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10928,19 +14111,16 @@ func (s *RetryLayerTokenStore) Delete(token string) error {
 
 	tries := 0
 	for {
-		err := s.TokenStore.Delete(token)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TokenStore.Delete(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TokenStore.Delete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10949,19 +14129,16 @@ func (s *RetryLayerTokenStore) GetAllTokensByType(tokenType string) ([]*model.To
 
 	tries := 0
 	for {
-		result, err := s.TokenStore.GetAllTokensByType(tokenType)
+		var result []*model.Token
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TokenStore.GetAllTokensByType(tokenType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TokenStore.GetAllTokensByType", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10970,19 +14147,16 @@ func (s *RetryLayerTokenStore) GetByToken(token string) (*model.Token, error) {
 
 	tries := 0
 	for {
-		result, err := s.TokenStore.GetByToken(token)
+		var result *model.Token
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.TokenStore.GetByToken(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TokenStore.GetByToken", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -10991,19 +14165,16 @@ func (s *RetryLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
 
 	tries := 0
 	for {
-		err := s.TokenStore.RemoveAllTokensByType(tokenType)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TokenStore.RemoveAllTokensByType(tokenType)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TokenStore.RemoveAllTokensByType", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11012,19 +14183,16 @@ func (s *RetryLayerTokenStore) Save(recovery *model.Token) error {
 
 	tries := 0
 	for {
-		err := s.TokenStore.Save(recovery)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.TokenStore.Save(recovery)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("TokenStore.Save", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11033,19 +14201,16 @@ func (s *RetryLayerUploadSessionStore) Delete(id string) error {
 
 	tries := 0
 	for {
-		err := s.UploadSessionStore.Delete(id)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UploadSessionStore.Delete(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UploadSessionStore.Delete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11054,19 +14219,16 @@ func (s *RetryLayerUploadSessionStore) Get(id string) (*model.UploadSession, err
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.Get(id)
+		var result *model.UploadSession
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UploadSessionStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UploadSessionStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11075,19 +14237,16 @@ func (s *RetryLayerUploadSessionStore) GetForUser(userID string) ([]*model.Uploa
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.GetForUser(userID)
+		var result []*model.UploadSession
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UploadSessionStore.GetForUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UploadSessionStore.GetForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11096,19 +14255,16 @@ func (s *RetryLayerUploadSessionStore) Save(session *model.UploadSession) (*mode
 
 	tries := 0
 	for {
-		result, err := s.UploadSessionStore.Save(session)
+		var result *model.UploadSession
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UploadSessionStore.Save(session)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UploadSessionStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11117,19 +14273,53 @@ func (s *RetryLayerUploadSessionStore) Update(session *model.UploadSession) erro
 
 	tries := 0
 	for {
-		err := s.UploadSessionStore.Update(session)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
+			err = s.UploadSessionStore.Update(session)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UploadSessionStore.Update", false, tries, err)
+		if !retry {
 			return err
 		}
+	}
+
+}
+
+func (s *RetryLayerUploadUsageStore) GetForUser(userID string, day int64) (int64, int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		var resultVar1 int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, resultVar1, err = s.UploadUsageStore.GetForUser(userID, day)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("UploadUsageStore.GetForUser", true, tries, err)
+		if !retry {
+			return result, resultVar1, err
+		}
+	}
+
+}
+
+func (s *RetryLayerUploadUsageStore) Increment(usage *model.UploadUsage) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.UploadUsageStore.Increment(usage)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UploadUsageStore.Increment", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11138,19 +14328,16 @@ func (s *RetryLayerUserStore) AnalyticsActiveCount(time int64, options model.Use
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsActiveCount(time, options)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsActiveCount(time, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsActiveCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11159,19 +14346,16 @@ func (s *RetryLayerUserStore) AnalyticsActiveCountForPeriod(startTime int64, end
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsActiveCountForPeriod(startTime, endTime, options)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsActiveCountForPeriod(startTime, endTime, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsActiveCountForPeriod", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11180,19 +14364,16 @@ func (s *RetryLayerUserStore) AnalyticsGetExternalUsers(hostDomain string) (bool
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetExternalUsers(hostDomain)
+		var result bool
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsGetExternalUsers(hostDomain)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsGetExternalUsers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11201,19 +14382,16 @@ func (s *RetryLayerUserStore) AnalyticsGetGuestCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetGuestCount()
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsGetGuestCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsGetGuestCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11222,19 +14400,16 @@ func (s *RetryLayerUserStore) AnalyticsGetInactiveUsersCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetInactiveUsersCount()
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsGetInactiveUsersCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsGetInactiveUsersCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11243,19 +14418,16 @@ func (s *RetryLayerUserStore) AnalyticsGetSystemAdminCount() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AnalyticsGetSystemAdminCount()
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AnalyticsGetSystemAdminCount()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AnalyticsGetSystemAdminCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11264,19 +14436,16 @@ func (s *RetryLayerUserStore) AutocompleteUsersInChannel(teamID string, channelI
 
 	tries := 0
 	for {
-		result, err := s.UserStore.AutocompleteUsersInChannel(teamID, channelID, term, options)
+		var result *model.UserAutocompleteInChannel
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.AutocompleteUsersInChannel(teamID, channelID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.AutocompleteUsersInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11285,19 +14454,16 @@ func (s *RetryLayerUserStore) ClearAllCustomRoleAssignments() error {
 
 	tries := 0
 	for {
-		err := s.UserStore.ClearAllCustomRoleAssignments()
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.ClearAllCustomRoleAssignments()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.ClearAllCustomRoleAssignments", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11312,19 +14478,16 @@ func (s *RetryLayerUserStore) Count(options model.UserCountOptions) (int64, erro
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Count(options)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.Count(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.Count", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11333,19 +14496,16 @@ func (s *RetryLayerUserStore) DeactivateGuests() ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.DeactivateGuests()
+		var result []string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.DeactivateGuests()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.DeactivateGuests", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11354,19 +14514,16 @@ func (s *RetryLayerUserStore) DemoteUserToGuest(userID string) (*model.User, err
 
 	tries := 0
 	for {
-		result, err := s.UserStore.DemoteUserToGuest(userID)
+		var result *model.User
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.DemoteUserToGuest(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.DemoteUserToGuest", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11375,19 +14532,16 @@ func (s *RetryLayerUserStore) Get(ctx context.Context, id string) (*model.User,
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Get(ctx, id)
+		var result *model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.Get(ctx, id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11396,19 +14550,16 @@ func (s *RetryLayerUserStore) GetAll() ([]*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAll()
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAll()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11417,19 +14568,16 @@ func (s *RetryLayerUserStore) GetAllAfter(limit int, afterID string) ([]*model.U
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllAfter(limit, afterID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAllAfter(limit, afterID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAllAfter", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11438,19 +14586,16 @@ func (s *RetryLayerUserStore) GetAllNotInAuthService(authServices []string) ([]*
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllNotInAuthService(authServices)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAllNotInAuthService(authServices)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAllNotInAuthService", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11459,19 +14604,16 @@ func (s *RetryLayerUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllProfiles(options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAllProfiles(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAllProfiles", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11480,19 +14622,16 @@ func (s *RetryLayerUserStore) GetAllProfilesInChannel(ctx context.Context, chann
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllProfilesInChannel(ctx, channelID, allowFromCache)
+		var result map[string]*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAllProfilesInChannel(ctx, channelID, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAllProfilesInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11501,19 +14640,16 @@ func (s *RetryLayerUserStore) GetAllUsingAuthService(authService string) ([]*mod
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAllUsingAuthService(authService)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAllUsingAuthService(authService)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAllUsingAuthService", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11522,19 +14658,16 @@ func (s *RetryLayerUserStore) GetAnyUnreadPostCountForChannel(userID string, cha
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetAnyUnreadPostCountForChannel(userID, channelID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetAnyUnreadPostCountForChannel(userID, channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetAnyUnreadPostCountForChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11543,19 +14676,16 @@ func (s *RetryLayerUserStore) GetByAuth(authData *string, authService string) (*
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByAuth(authData, authService)
+		var result *model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetByAuth(authData, authService)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetByAuth", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11564,19 +14694,16 @@ func (s *RetryLayerUserStore) GetByEmail(email string) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByEmail(email)
+		var result *model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetByEmail(email)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetByEmail", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11585,19 +14712,16 @@ func (s *RetryLayerUserStore) GetByUsername(username string) (*model.User, error
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetByUsername(username)
+		var result *model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetByUsername(username)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetByUsername", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11606,19 +14730,16 @@ func (s *RetryLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.U
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetChannelGroupUsers(channelID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetChannelGroupUsers(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetChannelGroupUsers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11645,19 +14766,16 @@ func (s *RetryLayerUserStore) GetForLogin(loginID string, allowSignInWithUsernam
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetForLogin(loginID, allowSignInWithUsername, allowSignInWithEmail)
+		var result *model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetForLogin(loginID, allowSignInWithUsername, allowSignInWithEmail)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetForLogin", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11666,19 +14784,16 @@ func (s *RetryLayerUserStore) GetKnownUsers(userID string) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetKnownUsers(userID)
+		var result []string
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetKnownUsers(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetKnownUsers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11687,19 +14802,16 @@ func (s *RetryLayerUserStore) GetMany(ctx context.Context, ids []string) ([]*mod
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetMany(ctx, ids)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetMany(ctx, ids)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetMany", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11708,19 +14820,16 @@ func (s *RetryLayerUserStore) GetNewUsersForTeam(teamID string, offset int, limi
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetNewUsersForTeam(teamID, offset, limit, viewRestrictions)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetNewUsersForTeam(teamID, offset, limit, viewRestrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetNewUsersForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11729,19 +14838,16 @@ func (s *RetryLayerUserStore) GetProfileByGroupChannelIdsForUser(userID string,
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfileByGroupChannelIdsForUser(userID, channelIds)
+		var result map[string][]*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfileByGroupChannelIdsForUser(userID, channelIds)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfileByGroupChannelIdsForUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11750,19 +14856,16 @@ func (s *RetryLayerUserStore) GetProfileByIds(ctx context.Context, userIds []str
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfileByIds(ctx, userIds, options, allowFromCache)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfileByIds(ctx, userIds, options, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfileByIds", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11771,19 +14874,16 @@ func (s *RetryLayerUserStore) GetProfiles(options *model.UserGetOptions) ([]*mod
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfiles(options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfiles(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfiles", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11792,19 +14892,16 @@ func (s *RetryLayerUserStore) GetProfilesByUsernames(usernames []string, viewRes
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesByUsernames(usernames, viewRestrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesByUsernames", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11813,19 +14910,16 @@ func (s *RetryLayerUserStore) GetProfilesInChannel(options *model.UserGetOptions
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesInChannel(options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesInChannel(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11834,19 +14928,16 @@ func (s *RetryLayerUserStore) GetProfilesInChannelByStatus(options *model.UserGe
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesInChannelByStatus(options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesInChannelByStatus(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesInChannelByStatus", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11855,19 +14946,16 @@ func (s *RetryLayerUserStore) GetProfilesNotInChannel(teamID string, channelId s
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesNotInChannel(teamID, channelId, groupConstrained, offset, limit, viewRestrictions)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesNotInChannel(teamID, channelId, groupConstrained, offset, limit, viewRestrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesNotInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11876,19 +14964,16 @@ func (s *RetryLayerUserStore) GetProfilesNotInTeam(teamID string, groupConstrain
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesNotInTeam(teamID, groupConstrained, offset, limit, viewRestrictions)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesNotInTeam(teamID, groupConstrained, offset, limit, viewRestrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesNotInTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11897,19 +14982,16 @@ func (s *RetryLayerUserStore) GetProfilesWithoutTeam(options *model.UserGetOptio
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetProfilesWithoutTeam(options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetProfilesWithoutTeam(options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetProfilesWithoutTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11918,19 +15000,16 @@ func (s *RetryLayerUserStore) GetRecentlyActiveUsersForTeam(teamID string, offse
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetRecentlyActiveUsersForTeam(teamID, offset, limit, viewRestrictions)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetRecentlyActiveUsersForTeam(teamID, offset, limit, viewRestrictions)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetRecentlyActiveUsersForTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11939,19 +15018,16 @@ func (s *RetryLayerUserStore) GetSystemAdminProfiles() (map[string]*model.User,
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetSystemAdminProfiles()
+		var result map[string]*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetSystemAdminProfiles()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetSystemAdminProfiles", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11960,19 +15036,16 @@ func (s *RetryLayerUserStore) GetTeamGroupUsers(teamID string) ([]*model.User, e
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetTeamGroupUsers(teamID)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetTeamGroupUsers(teamID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetTeamGroupUsers", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -11981,19 +15054,16 @@ func (s *RetryLayerUserStore) GetUnreadCount(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUnreadCount(userID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetUnreadCount(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetUnreadCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12002,19 +15072,16 @@ func (s *RetryLayerUserStore) GetUnreadCountForChannel(userID string, channelID
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUnreadCountForChannel(userID, channelID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetUnreadCountForChannel(userID, channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetUnreadCountForChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12023,19 +15090,16 @@ func (s *RetryLayerUserStore) GetUsersBatchForIndexing(startTime int64, endTime
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUsersBatchForIndexing(startTime, endTime, limit)
+		var result []*model.UserForIndexing
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetUsersBatchForIndexing(startTime, endTime, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetUsersBatchForIndexing", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12044,19 +15108,16 @@ func (s *RetryLayerUserStore) GetUsersWithInvalidEmails(page int, perPage int, r
 
 	tries := 0
 	for {
-		result, err := s.UserStore.GetUsersWithInvalidEmails(page, perPage, restrictedDomains)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.GetUsersWithInvalidEmails(page, perPage, restrictedDomains)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.GetUsersWithInvalidEmails", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12065,19 +15126,16 @@ func (s *RetryLayerUserStore) InferSystemInstallDate() (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.InferSystemInstallDate()
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.InferSystemInstallDate()
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.InferSystemInstallDate", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12086,19 +15144,16 @@ func (s *RetryLayerUserStore) InsertUsers(users []*model.User) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.InsertUsers(users)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.InsertUsers(users)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.InsertUsers", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12125,19 +15180,16 @@ func (s *RetryLayerUserStore) IsEmpty(excludeBots bool) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.IsEmpty(excludeBots)
+		var result bool
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.IsEmpty(excludeBots)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.IsEmpty", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12146,19 +15198,16 @@ func (s *RetryLayerUserStore) PermanentDelete(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.PermanentDelete(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.PermanentDelete(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.PermanentDelete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12167,19 +15216,16 @@ func (s *RetryLayerUserStore) PromoteGuestToUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.PromoteGuestToUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.PromoteGuestToUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.PromoteGuestToUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12188,19 +15234,16 @@ func (s *RetryLayerUserStore) ResetAuthDataToEmailForUsers(service string, userI
 
 	tries := 0
 	for {
-		result, err := s.UserStore.ResetAuthDataToEmailForUsers(service, userIDs, includeDeleted, dryRun)
+		var result int
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.ResetAuthDataToEmailForUsers(service, userIDs, includeDeleted, dryRun)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.ResetAuthDataToEmailForUsers", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12209,19 +15252,16 @@ func (s *RetryLayerUserStore) ResetLastPictureUpdate(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.ResetLastPictureUpdate(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.ResetLastPictureUpdate(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.ResetLastPictureUpdate", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12230,19 +15270,16 @@ func (s *RetryLayerUserStore) Save(user *model.User) (*model.User, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Save(user)
+		var result *model.User
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.Save(user)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12251,19 +15288,16 @@ func (s *RetryLayerUserStore) Search(teamID string, term string, options *model.
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Search(teamID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.Search(teamID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.Search", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12272,19 +15306,16 @@ func (s *RetryLayerUserStore) SearchInChannel(channelID string, term string, opt
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchInChannel(channelID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchInChannel(channelID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12293,19 +15324,16 @@ func (s *RetryLayerUserStore) SearchInGroup(groupID string, term string, options
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchInGroup(groupID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchInGroup(groupID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchInGroup", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12314,19 +15342,16 @@ func (s *RetryLayerUserStore) SearchNotInChannel(teamID string, channelID string
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInChannel(teamID, channelID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchNotInChannel(teamID, channelID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchNotInChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12335,19 +15360,16 @@ func (s *RetryLayerUserStore) SearchNotInGroup(groupID string, term string, opti
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInGroup(groupID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchNotInGroup(groupID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchNotInGroup", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12356,19 +15378,16 @@ func (s *RetryLayerUserStore) SearchNotInTeam(notInTeamID string, term string, o
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchNotInTeam(notInTeamID, term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchNotInTeam(notInTeamID, term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchNotInTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12377,19 +15396,16 @@ func (s *RetryLayerUserStore) SearchWithoutTeam(term string, options *model.User
 
 	tries := 0
 	for {
-		result, err := s.UserStore.SearchWithoutTeam(term, options)
+		var result []*model.User
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.SearchWithoutTeam(term, options)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.SearchWithoutTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12398,19 +15414,16 @@ func (s *RetryLayerUserStore) Update(user *model.User, allowRoleUpdate bool) (*m
 
 	tries := 0
 	for {
-		result, err := s.UserStore.Update(user, allowRoleUpdate)
+		var result *model.UserUpdate
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.Update(user, allowRoleUpdate)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12419,19 +15432,16 @@ func (s *RetryLayerUserStore) UpdateAuthData(userID string, service string, auth
 
 	tries := 0
 	for {
-		result, err := s.UserStore.UpdateAuthData(userID, service, authData, email, resetMfa)
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.UpdateAuthData(userID, service, authData, email, resetMfa)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateAuthData", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12440,19 +15450,16 @@ func (s *RetryLayerUserStore) UpdateFailedPasswordAttempts(userID string, attemp
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateFailedPasswordAttempts(userID, attempts)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdateFailedPasswordAttempts(userID, attempts)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateFailedPasswordAttempts", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12461,19 +15468,16 @@ func (s *RetryLayerUserStore) UpdateLastPictureUpdate(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateLastPictureUpdate(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdateLastPictureUpdate(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateLastPictureUpdate", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12482,19 +15486,16 @@ func (s *RetryLayerUserStore) UpdateMfaActive(userID string, active bool) error
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateMfaActive(userID, active)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdateMfaActive(userID, active)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateMfaActive", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12503,19 +15504,16 @@ func (s *RetryLayerUserStore) UpdateMfaSecret(userID string, secret string) erro
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateMfaSecret(userID, secret)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdateMfaSecret(userID, secret)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateMfaSecret", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12524,19 +15522,16 @@ func (s *RetryLayerUserStore) UpdateNotifyProps(userID string, props map[string]
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdateNotifyProps(userID, props)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdateNotifyProps(userID, props)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateNotifyProps", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12545,19 +15540,16 @@ func (s *RetryLayerUserStore) UpdatePassword(userID string, newPassword string)
 
 	tries := 0
 	for {
-		err := s.UserStore.UpdatePassword(userID, newPassword)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserStore.UpdatePassword(userID, newPassword)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdatePassword", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12566,19 +15558,16 @@ func (s *RetryLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserStore.UpdateUpdateAt(userID)
+		var result int64
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.UpdateUpdateAt(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.UpdateUpdateAt", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12587,19 +15576,16 @@ func (s *RetryLayerUserStore) VerifyEmail(userID string, email string) (string,
 
 	tries := 0
 	for {
-		result, err := s.UserStore.VerifyEmail(userID, email)
+		var result string
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserStore.VerifyEmail(userID, email)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserStore.VerifyEmail", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12608,19 +15594,16 @@ func (s *RetryLayerUserAccessTokenStore) Delete(tokenID string) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.Delete(tokenID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserAccessTokenStore.Delete(tokenID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.Delete", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12629,19 +15612,16 @@ func (s *RetryLayerUserAccessTokenStore) DeleteAllForUser(userID string) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.DeleteAllForUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserAccessTokenStore.DeleteAllForUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.DeleteAllForUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12650,19 +15630,16 @@ func (s *RetryLayerUserAccessTokenStore) Get(tokenID string) (*model.UserAccessT
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.Get(tokenID)
+		var result *model.UserAccessToken
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserAccessTokenStore.Get(tokenID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.Get", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12671,19 +15648,16 @@ func (s *RetryLayerUserAccessTokenStore) GetAll(offset int, limit int) ([]*model
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetAll(offset, limit)
+		var result []*model.UserAccessToken
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserAccessTokenStore.GetAll(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.GetAll", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12692,19 +15666,16 @@ func (s *RetryLayerUserAccessTokenStore) GetByToken(tokenString string) (*model.
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetByToken(tokenString)
+		var result *model.UserAccessToken
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserAccessTokenStore.GetByToken(tokenString)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.GetByToken", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12713,19 +15684,34 @@ func (s *RetryLayerUserAccessTokenStore) GetByUser(userID string, page int, perP
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.GetByUser(userID, page, perPage)
+		var result []*model.UserAccessToken
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.UserAccessTokenStore.GetByUser(userID, page, perPage)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.GetByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerUserAccessTokenStore) GetExpired(before int64, limit int) ([]*model.UserAccessToken, error) {
+
+	tries := 0
+	for {
+		var result []*model.UserAccessToken
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.UserAccessTokenStore.GetExpired(before, limit)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.GetExpired", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12734,19 +15720,16 @@ func (s *RetryLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*mo
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.Save(token)
+		var result *model.UserAccessToken
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserAccessTokenStore.Save(token)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12755,40 +15738,52 @@ func (s *RetryLayerUserAccessTokenStore) Search(term string) ([]*model.UserAcces
 
 	tries := 0
 	for {
-		result, err := s.UserAccessTokenStore.Search(term)
+		var result []*model.UserAccessToken
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.UserAccessTokenStore.Search(term)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.Search", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
+func (s *RetryLayerUserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.UpdateTokenDisable(tokenID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
+			err = s.UserAccessTokenStore.UpdateExpiresAt(tokenID, expiresAt)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.UpdateExpiresAt", false, tries, err)
+		if !retry {
 			return err
 		}
+	}
+
+}
+
+func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.UserAccessTokenStore.UpdateTokenDisable(tokenID)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.UpdateTokenDisable", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12797,124 +15792,196 @@ func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 
 	tries := 0
 	for {
-		err := s.UserAccessTokenStore.UpdateTokenEnable(tokenID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.UserAccessTokenStore.UpdateTokenEnable(tokenID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserAccessTokenStore.UpdateTokenEnable", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
+func (s *RetryLayerUserMergeStore) Get(id string) (*model.UserMerge, error) {
 
 	tries := 0
 	for {
-		err := s.UserTermsOfServiceStore.Delete(userID, termsOfServiceId)
+		var result *model.UserMerge
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			result, err = s.UserMergeStore.Get(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+		retry, err := s.Root.retrier.retry("UserMergeStore.Get", true, tries, err)
+		if !retry {
+			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserTermsOfServiceStore) GetByUser(userID string) (*model.UserTermsOfService, error) {
+func (s *RetryLayerUserMergeStore) MergeRows(merge *model.UserMerge, table string) (*model.UserMerge, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserTermsOfServiceStore.GetByUser(userID)
+		var result *model.UserMerge
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.UserMergeStore.MergeRows(merge, table)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UserMergeStore.MergeRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerUserMergeStore) MoveRows(table string, fromUserID string, toUserID string, keys []string) ([]string, error) {
+
+	tries := 0
+	for {
+		var result []string
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.UserMergeStore.MoveRows(table, fromUserID, toUserID, keys)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserMergeStore.MoveRows", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
+func (s *RetryLayerUserMergeStore) Save(merge *model.UserMerge) (*model.UserMerge, error) {
 
 	tries := 0
 	for {
-		result, err := s.UserTermsOfServiceStore.Save(userTermsOfService)
+		var result *model.UserMerge
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
+			result, err = s.UserMergeStore.Save(merge)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UserMergeStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerUserMergeStore) Update(merge *model.UserMerge) (*model.UserMerge, error) {
+
+	tries := 0
+	for {
+		var result *model.UserMerge
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.UserMergeStore.Update(merge)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserMergeStore.Update", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, error) {
+func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
+
+	tries := 0
+	for {
+
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			err = s.UserTermsOfServiceStore.Delete(userID, termsOfServiceId)
+		}
+		tries++
+		retry, err := s.Root.retrier.retry("UserTermsOfServiceStore.Delete", false, tries, err)
+		if !retry {
+			return err
+		}
+	}
+
+}
+
+func (s *RetryLayerUserTermsOfServiceStore) GetByUser(userID string) (*model.UserTermsOfService, error) {
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.AnalyticsIncomingCount(teamID)
+		var result *model.UserTermsOfService
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.UserTermsOfServiceStore.GetByUser(userID)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("UserTermsOfServiceStore.GetByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error) {
+
+	tries := 0
+	for {
+		var result *model.UserTermsOfService
+		err := s.Root.retrier.allow(false)
+		if err == nil {
+			result, err = s.UserTermsOfServiceStore.Save(userTermsOfService)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("UserTermsOfServiceStore.Save", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
+func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.AnalyticsOutgoingCount(teamID)
+		var result int64
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
+			result, err = s.WebhookStore.AnalyticsIncomingCount(teamID)
 		}
-		if !isRepeatableError(err) {
+		tries++
+		retry, err := s.Root.retrier.retry("WebhookStore.AnalyticsIncomingCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
+	}
+
+}
+
+func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
+
+	tries := 0
+	for {
+		var result int64
+		err := s.Root.retrier.allow(true)
+		if err == nil {
+			result, err = s.WebhookStore.AnalyticsOutgoingCount(teamID)
+		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.AnalyticsOutgoingCount", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12929,19 +15996,16 @@ func (s *RetryLayerWebhookStore) DeleteIncoming(webhookID string, time int64) er
 
 	tries := 0
 	for {
-		err := s.WebhookStore.DeleteIncoming(webhookID, time)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.DeleteIncoming(webhookID, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.DeleteIncoming", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12950,19 +16014,16 @@ func (s *RetryLayerWebhookStore) DeleteOutgoing(webhookID string, time int64) er
 
 	tries := 0
 	for {
-		err := s.WebhookStore.DeleteOutgoing(webhookID, time)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.DeleteOutgoing(webhookID, time)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.DeleteOutgoing", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12971,19 +16032,16 @@ func (s *RetryLayerWebhookStore) GetIncoming(id string, allowFromCache bool) (*m
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncoming(id, allowFromCache)
+		var result *model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncoming(id, allowFromCache)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncoming", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -12992,19 +16050,16 @@ func (s *RetryLayerWebhookStore) GetIncomingByChannel(channelID string) ([]*mode
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncomingByChannel(channelID)
+		var result []*model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncomingByChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncomingByChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13013,19 +16068,16 @@ func (s *RetryLayerWebhookStore) GetIncomingByTeam(teamID string, offset int, li
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncomingByTeam(teamID, offset, limit)
+		var result []*model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncomingByTeam(teamID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncomingByTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13034,19 +16086,16 @@ func (s *RetryLayerWebhookStore) GetIncomingByTeamByUser(teamID string, userID s
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncomingByTeamByUser(teamID, userID, offset, limit)
+		var result []*model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncomingByTeamByUser(teamID, userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncomingByTeamByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13055,19 +16104,16 @@ func (s *RetryLayerWebhookStore) GetIncomingList(offset int, limit int) ([]*mode
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncomingList(offset, limit)
+		var result []*model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncomingList(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncomingList", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13076,19 +16122,16 @@ func (s *RetryLayerWebhookStore) GetIncomingListByUser(userID string, offset int
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetIncomingListByUser(userID, offset, limit)
+		var result []*model.IncomingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetIncomingListByUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetIncomingListByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13097,19 +16140,16 @@ func (s *RetryLayerWebhookStore) GetOutgoing(id string) (*model.OutgoingWebhook,
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoing(id)
+		var result *model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoing(id)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoing", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13118,19 +16158,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingByChannel(channelID string, offset i
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingByChannel(channelID, offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingByChannel(channelID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingByChannel", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13139,19 +16176,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingByChannelByUser(channelID string, us
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingByChannelByUser(channelID, userID, offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingByChannelByUser(channelID, userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingByChannelByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13160,19 +16194,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingByTeam(teamID string, offset int, li
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingByTeam(teamID, offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingByTeam(teamID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingByTeam", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13181,19 +16212,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingByTeamByUser(teamID string, userID s
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingByTeamByUser(teamID, userID, offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingByTeamByUser(teamID, userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingByTeamByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13202,19 +16230,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingList(offset int, limit int) ([]*mode
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingList(offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingList(offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingList", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13223,19 +16248,16 @@ func (s *RetryLayerWebhookStore) GetOutgoingListByUser(userID string, offset int
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.GetOutgoingListByUser(userID, offset, limit)
+		var result []*model.OutgoingWebhook
+		err := s.Root.retrier.allow(true)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.GetOutgoingListByUser(userID, offset, limit)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.GetOutgoingListByUser", true, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13250,19 +16272,16 @@ func (s *RetryLayerWebhookStore) PermanentDeleteIncomingByChannel(channelID stri
 
 	tries := 0
 	for {
-		err := s.WebhookStore.PermanentDeleteIncomingByChannel(channelID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.PermanentDeleteIncomingByChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.PermanentDeleteIncomingByChannel", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13271,19 +16290,16 @@ func (s *RetryLayerWebhookStore) PermanentDeleteIncomingByUser(userID string) er
 
 	tries := 0
 	for {
-		err := s.WebhookStore.PermanentDeleteIncomingByUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.PermanentDeleteIncomingByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.PermanentDeleteIncomingByUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13292,19 +16308,16 @@ func (s *RetryLayerWebhookStore) PermanentDeleteOutgoingByChannel(channelID stri
 
 	tries := 0
 	for {
-		err := s.WebhookStore.PermanentDeleteOutgoingByChannel(channelID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.PermanentDeleteOutgoingByChannel(channelID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.PermanentDeleteOutgoingByChannel", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13313,19 +16326,16 @@ func (s *RetryLayerWebhookStore) PermanentDeleteOutgoingByUser(userID string) er
 
 	tries := 0
 	for {
-		err := s.WebhookStore.PermanentDeleteOutgoingByUser(userID)
+
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return nil
-		}
-		if !isRepeatableError(err) {
-			return err
+			err = s.WebhookStore.PermanentDeleteOutgoingByUser(userID)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.PermanentDeleteOutgoingByUser", false, tries, err)
+		if !retry {
 			return err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13334,19 +16344,16 @@ func (s *RetryLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.SaveIncoming(webhook)
+		var result *model.IncomingWebhook
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.SaveIncoming(webhook)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.SaveIncoming", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13355,19 +16362,16 @@ func (s *RetryLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.SaveOutgoing(webhook)
+		var result *model.OutgoingWebhook
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.SaveOutgoing(webhook)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.SaveOutgoing", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13376,19 +16380,16 @@ func (s *RetryLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook)
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.UpdateIncoming(webhook)
+		var result *model.IncomingWebhook
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.UpdateIncoming(webhook)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.UpdateIncoming", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13397,19 +16398,16 @@ func (s *RetryLayerWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*m
 
 	tries := 0
 	for {
-		result, err := s.WebhookStore.UpdateOutgoing(hook)
+		var result *model.OutgoingWebhook
+		err := s.Root.retrier.allow(false)
 		if err == nil {
-			return result, nil
-		}
-		if !isRepeatableError(err) {
-			return result, err
+			result, err = s.WebhookStore.UpdateOutgoing(hook)
 		}
 		tries++
-		if tries >= 3 {
-			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+		retry, err := s.Root.retrier.retry("WebhookStore.UpdateOutgoing", false, tries, err)
+		if !retry {
 			return result, err
 		}
-		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
@@ -13454,46 +16452,94 @@ func (s *RetryLayer) UnlockFromMaster() {
 	s.Store.UnlockFromMaster()
 }
 
+// New wraps the store with the default retry settings and without metrics.
 func New(childStore store.Store) *RetryLayer {
+	return NewWithSettings(childStore, model.SqlSettings{}, nil)
+}
+
+// NewWithSettings wraps the store, retrying the store calls and switching to read-only mode as
+// configured by the SQL settings.
+func NewWithSettings(childStore store.Store, settings model.SqlSettings, metrics einterfaces.MetricsInterface) *RetryLayer {
 	newStore := RetryLayer{
-		Store: childStore,
+		Store:   childStore,
+		retrier: newRetrier(settings, metrics),
 	}
 
+	newStore.APIUsageStore = &RetryLayerAPIUsageStore{APIUsageStore: childStore.APIUsage(), Root: &newStore}
+	newStore.ActivityEventStore = &RetryLayerActivityEventStore{ActivityEventStore: childStore.ActivityEvent(), Root: &newStore}
+	newStore.AlertRuleStore = &RetryLayerAlertRuleStore{AlertRuleStore: childStore.AlertRule(), Root: &newStore}
 	newStore.AuditStore = &RetryLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AuditLogStore = &RetryLayerAuditLogStore{AuditLogStore: childStore.AuditLog(), Root: &newStore}
 	newStore.BotStore = &RetryLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
+	newStore.BotStateStore = &RetryLayerBotStateStore{BotStateStore: childStore.BotState(), Root: &newStore}
+	newStore.BotTokenRotationStore = &RetryLayerBotTokenRotationStore{BotTokenRotationStore: childStore.BotTokenRotation(), Root: &newStore}
+	newStore.CannedResponseStore = &RetryLayerCannedResponseStore{CannedResponseStore: childStore.CannedResponse(), Root: &newStore}
 	newStore.ChannelStore = &RetryLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelArchivePolicyStore = &RetryLayerChannelArchivePolicyStore{ChannelArchivePolicyStore: childStore.ChannelArchivePolicy(), Root: &newStore}
+	newStore.ChannelCommandOverrideStore = &RetryLayerChannelCommandOverrideStore{ChannelCommandOverrideStore: childStore.ChannelCommandOverride(), Root: &newStore}
+	newStore.ChannelDigestStore = &RetryLayerChannelDigestStore{ChannelDigestStore: childStore.ChannelDigest(), Root: &newStore}
+	newStore.ChannelEventStore = &RetryLayerChannelEventStore{ChannelEventStore: childStore.ChannelEvent(), Root: &newStore}
+	newStore.ChannelFeedStore = &RetryLayerChannelFeedStore{ChannelFeedStore: childStore.ChannelFeed(), Root: &newStore}
+	newStore.ChannelLanguageStatsStore = &RetryLayerChannelLanguageStatsStore{ChannelLanguageStatsStore: childStore.ChannelLanguageStats(), Root: &newStore}
 	newStore.ChannelMemberHistoryStore = &RetryLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
 	newStore.ClusterDiscoveryStore = &RetryLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
 	newStore.CommandStore = &RetryLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &RetryLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &RetryLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigHistoryStore = &RetryLayerConfigHistoryStore{ConfigHistoryStore: childStore.ConfigHistory(), Root: &newStore}
+	newStore.CustomStatusTemplateStore = &RetryLayerCustomStatusTemplateStore{CustomStatusTemplateStore: childStore.CustomStatusTemplate(), Root: &newStore}
+	newStore.DirectChannelRetentionStore = &RetryLayerDirectChannelRetentionStore{DirectChannelRetentionStore: childStore.DirectChannelRetention(), Root: &newStore}
+	newStore.EmailSuppressionStore = &RetryLayerEmailSuppressionStore{EmailSuppressionStore: childStore.EmailSuppression(), Root: &newStore}
 	newStore.EmojiStore = &RetryLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
+	newStore.EventWebhookStore = &RetryLayerEventWebhookStore{EventWebhookStore: childStore.EventWebhook(), Root: &newStore}
 	newStore.FileInfoStore = &RetryLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &RetryLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IdempotencyKeyStore = &RetryLayerIdempotencyKeyStore{IdempotencyKeyStore: childStore.IdempotencyKey(), Root: &newStore}
+	newStore.ImpersonationStore = &RetryLayerImpersonationStore{ImpersonationStore: childStore.Impersonation(), Root: &newStore}
 	newStore.JobStore = &RetryLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &RetryLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
+	newStore.LicenseUsageStore = &RetryLayerLicenseUsageStore{LicenseUsageStore: childStore.LicenseUsage(), Root: &newStore}
 	newStore.LinkMetadataStore = &RetryLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
 	newStore.OAuthStore = &RetryLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
+	newStore.OAuthAppUsageStore = &RetryLayerOAuthAppUsageStore{OAuthAppUsageStore: childStore.OAuthAppUsage(), Root: &newStore}
+	newStore.OutstandingMentionStore = &RetryLayerOutstandingMentionStore{OutstandingMentionStore: childStore.OutstandingMention(), Root: &newStore}
+	newStore.PermissionDenialStore = &RetryLayerPermissionDenialStore{PermissionDenialStore: childStore.PermissionDenial(), Root: &newStore}
 	newStore.PluginStore = &RetryLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &RetryLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
+	newStore.PostAcknowledgementStore = &RetryLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostModerationStore = &RetryLayerPostModerationStore{PostModerationStore: childStore.PostModeration(), Root: &newStore}
+	newStore.PostPriorityStore = &RetryLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
+	newStore.PostReportStore = &RetryLayerPostReportStore{PostReportStore: childStore.PostReport(), Root: &newStore}
+	newStore.PostTaskStore = &RetryLayerPostTaskStore{PostTaskStore: childStore.PostTask(), Root: &newStore}
 	newStore.PreferenceStore = &RetryLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
 	newStore.ProductNoticesStore = &RetryLayerProductNoticesStore{ProductNoticesStore: childStore.ProductNotices(), Root: &newStore}
+	newStore.RESTProxyBridgeStore = &RetryLayerRESTProxyBridgeStore{RESTProxyBridgeStore: childStore.RESTProxyBridge(), Root: &newStore}
 	newStore.ReactionStore = &RetryLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
 	newStore.RemoteClusterStore = &RetryLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &RetryLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &RetryLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.ScheduledChannelMessageStore = &RetryLayerScheduledChannelMessageStore{ScheduledChannelMessageStore: childStore.ScheduledChannelMessage(), Root: &newStore}
 	newStore.SchemeStore = &RetryLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &RetryLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &RetryLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
 	newStore.StatusStore = &RetryLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
 	newStore.SystemStore = &RetryLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &RetryLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamAliasStore = &RetryLayerTeamAliasStore{TeamAliasStore: childStore.TeamAlias(), Root: &newStore}
+	newStore.TeamBannerStore = &RetryLayerTeamBannerStore{TeamBannerStore: childStore.TeamBanner(), Root: &newStore}
+	newStore.TeamDeletionStore = &RetryLayerTeamDeletionStore{TeamDeletionStore: childStore.TeamDeletion(), Root: &newStore}
+	newStore.TeamGroupRoleStore = &RetryLayerTeamGroupRoleStore{TeamGroupRoleStore: childStore.TeamGroupRole(), Root: &newStore}
+	newStore.TeamInviteUsageStore = &RetryLayerTeamInviteUsageStore{TeamInviteUsageStore: childStore.TeamInviteUsage(), Root: &newStore}
+	newStore.TeamRequestStore = &RetryLayerTeamRequestStore{TeamRequestStore: childStore.TeamRequest(), Root: &newStore}
+	newStore.TeamStatsStore = &RetryLayerTeamStatsStore{TeamStatsStore: childStore.TeamStats(), Root: &newStore}
 	newStore.TermsOfServiceStore = &RetryLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &RetryLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &RetryLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
 	newStore.UploadSessionStore = &RetryLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
+	newStore.UploadUsageStore = &RetryLayerUploadUsageStore{UploadUsageStore: childStore.UploadUsage(), Root: &newStore}
 	newStore.UserStore = &RetryLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &RetryLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserMergeStore = &RetryLayerUserMergeStore{UserMergeStore: childStore.UserMerge(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &RetryLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
 	newStore.WebhookStore = &RetryLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
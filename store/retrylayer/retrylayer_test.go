@@ -4,12 +4,17 @@
 package retrylayer
 
 import (
+	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	emocks "github.com/mattermost/mattermost-server/v6/einterfaces/mocks"
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/store/storetest/mocks"
 )
@@ -53,6 +58,46 @@ func genStore() *mocks.Store {
 	mock.On("UserAccessToken").Return(&mocks.UserAccessTokenStore{})
 	mock.On("UserTermsOfService").Return(&mocks.UserTermsOfServiceStore{})
 	mock.On("Webhook").Return(&mocks.WebhookStore{})
+	mock.On("APIUsage").Return(&mocks.APIUsageStore{})
+	mock.On("PostTask").Return(&mocks.PostTaskStore{})
+	mock.On("ChannelDigest").Return(&mocks.ChannelDigestStore{})
+	mock.On("DirectChannelRetention").Return(&mocks.DirectChannelRetentionStore{})
+	mock.On("PostPriority").Return(&mocks.PostPriorityStore{})
+	mock.On("PostAcknowledgement").Return(&mocks.PostAcknowledgementStore{})
+	mock.On("TeamBanner").Return(&mocks.TeamBannerStore{})
+	mock.On("EmailSuppression").Return(&mocks.EmailSuppressionStore{})
+	mock.On("CannedResponse").Return(&mocks.CannedResponseStore{})
+	mock.On("TeamRequest").Return(&mocks.TeamRequestStore{})
+	mock.On("TeamStats").Return(&mocks.TeamStatsStore{})
+	mock.On("UserMerge").Return(&mocks.UserMergeStore{})
+	mock.On("TeamDeletion").Return(&mocks.TeamDeletionStore{})
+	mock.On("IdempotencyKey").Return(&mocks.IdempotencyKeyStore{})
+	mock.On("ChannelArchivePolicy").Return(&mocks.ChannelArchivePolicyStore{})
+	mock.On("PermissionDenial").Return(&mocks.PermissionDenialStore{})
+	mock.On("TeamAlias").Return(&mocks.TeamAliasStore{})
+	mock.On("BotTokenRotation").Return(&mocks.BotTokenRotationStore{})
+	mock.On("ChannelCommandOverride").Return(&mocks.ChannelCommandOverrideStore{})
+	mock.On("Impersonation").Return(&mocks.ImpersonationStore{})
+	mock.On("ScheduledChannelMessage").Return(&mocks.ScheduledChannelMessageStore{})
+	mock.On("EventWebhook").Return(&mocks.EventWebhookStore{})
+	mock.On("ConfigHistory").Return(&mocks.ConfigHistoryStore{})
+	mock.On("UploadUsage").Return(&mocks.UploadUsageStore{})
+	mock.On("ChannelEvent").Return(&mocks.ChannelEventStore{})
+	mock.On("AlertRule").Return(&mocks.AlertRuleStore{})
+	mock.On("ChannelLanguageStats").Return(&mocks.ChannelLanguageStatsStore{})
+	mock.On("TeamInviteUsage").Return(&mocks.TeamInviteUsageStore{})
+	mock.On("PostModeration").Return(&mocks.PostModerationStore{})
+	mock.On("PostReport").Return(&mocks.PostReportStore{})
+	mock.On("ActivityEvent").Return(&mocks.ActivityEventStore{})
+	mock.On("AuditLog").Return(&mocks.AuditLogStore{})
+	mock.On("LicenseUsage").Return(&mocks.LicenseUsageStore{})
+	mock.On("CustomStatusTemplate").Return(&mocks.CustomStatusTemplateStore{})
+	mock.On("RESTProxyBridge").Return(&mocks.RESTProxyBridgeStore{})
+	mock.On("ChannelFeed").Return(&mocks.ChannelFeedStore{})
+	mock.On("OutstandingMention").Return(&mocks.OutstandingMentionStore{})
+	mock.On("BotState").Return(&mocks.BotStateStore{})
+	mock.On("OAuthAppUsage").Return(&mocks.OAuthAppUsageStore{})
+	mock.On("TeamGroupRole").Return(&mocks.TeamGroupRoleStore{})
 	return mock
 }
 
@@ -121,4 +166,80 @@ func TestRetry(t *testing.T) {
 		layer.Bot().Get("test", false)
 		mockBotStore.AssertExpectations(t)
 	})
+
+	t.Run("on connection error should retry reads only", func(t *testing.T) {
+		mock := genStore()
+		mockBotStore := mock.Bot().(*mocks.BotStore)
+		mockBotStore.On("Get", "test", false).Return(nil, errors.Wrap(driver.ErrBadConn, "test-error")).Times(3)
+		mockBotStore.On("Save", &model.Bot{}).Return(nil, errors.Wrap(driver.ErrBadConn, "test-error")).Times(1)
+		layer := New(mock)
+		layer.Bot().Get("test", false)
+		layer.Bot().Save(&model.Bot{})
+		mockBotStore.AssertExpectations(t)
+	})
+
+	t.Run("configured attempts", func(t *testing.T) {
+		mock := genStore()
+		mockBotStore := mock.Bot().(*mocks.BotStore)
+		mysqlErr := mysql.MySQLError{Number: uint16(1213), Message: "Deadlock"}
+		mockBotStore.On("Get", "test", false).Return(nil, errors.Wrap(&mysqlErr, "test-error")).Times(5)
+		metrics := &emocks.MetricsInterface{}
+		metrics.On("IncrementStoreRetryCounter", "BotStore.Get", "deadlock").Times(4)
+		layer := NewWithSettings(mock, model.SqlSettings{
+			RetryMaxAttempts:         model.NewInt(5),
+			RetryBackoffMilliseconds: model.NewInt(0),
+		}, metrics)
+		_, err := layer.Bot().Get("test", false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "giving up after 5 consecutive repeatable failures")
+		mockBotStore.AssertExpectations(t)
+		metrics.AssertExpectations(t)
+	})
+}
+
+func TestReadOnlyMode(t *testing.T) {
+	mock := genStore()
+	mockBotStore := mock.Bot().(*mocks.BotStore)
+	metrics := &emocks.MetricsInterface{}
+	layer := NewWithSettings(mock, model.SqlSettings{
+		RetryBackoffMilliseconds:     model.NewInt(0),
+		ReadOnlyModeFailureThreshold: model.NewInt(2),
+		ReadOnlyModeCooldownSeconds:  model.NewInt(60),
+	}, metrics)
+
+	mockBotStore.On("Save", &model.Bot{}).Return(nil, errors.Wrap(driver.ErrBadConn, "test-error")).Times(2)
+	metrics.On("SetStoreReadOnlyMode", true).Once()
+	for i := 0; i < 2; i++ {
+		_, err := layer.Bot().Save(&model.Bot{})
+		require.ErrorIs(t, err, driver.ErrBadConn)
+	}
+	mockBotStore.AssertExpectations(t)
+	metrics.AssertExpectations(t)
+
+	t.Run("writes are refused", func(t *testing.T) {
+		_, err := layer.Bot().Save(&model.Bot{})
+		require.ErrorIs(t, err, ErrReadOnlyMode)
+		mockBotStore.AssertNumberOfCalls(t, "Save", 2)
+	})
+
+	t.Run("reads are not", func(t *testing.T) {
+		mockBotStore.On("Get", "test", false).Return(&model.Bot{}, nil).Once()
+		_, err := layer.Bot().Get("test", false)
+		require.NoError(t, err)
+	})
+
+	t.Run("writes resume after the cooldown", func(t *testing.T) {
+		mockBotStore.On("Save", &model.Bot{}).Return(&model.Bot{}, nil).Times(2)
+		metrics.On("SetStoreReadOnlyMode", false).Once()
+		layer.retrier.mut.Lock()
+		layer.retrier.readOnlyUntil = time.Now()
+		layer.retrier.mut.Unlock()
+
+		_, err := layer.Bot().Save(&model.Bot{})
+		require.NoError(t, err)
+		_, err = layer.Bot().Save(&model.Bot{})
+		require.NoError(t, err)
+		mockBotStore.AssertExpectations(t)
+		metrics.AssertExpectations(t)
+	})
 }
@@ -106,6 +106,15 @@ func (s SearchPostStore) Save(post *model.Post) (*model.Post, error) {
 	return npost, err
 }
 
+func (s SearchPostStore) SaveWithExtras(post *model.Post, extras store.PostExtras) (*model.Post, error) {
+	npost, err := s.PostStore.SaveWithExtras(post, extras)
+
+	if err == nil {
+		s.indexPost(npost)
+	}
+	return npost, err
+}
+
 func (s SearchPostStore) Delete(postId string, date int64, deletedByID string) error {
 	err := s.PostStore.Delete(postId, date, deletedByID)
 
@@ -195,3 +204,23 @@ func (s SearchPostStore) SearchPostsForUser(paramsList []*model.SearchParams, us
 	mlog.Debug("Using database search because no other search engine is available")
 	return s.PostStore.SearchPostsForUser(paramsList, userId, teamId, page, perPage)
 }
+
+func (s SearchPostStore) GetSearchCapabilities() *model.SearchCapabilities {
+	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
+		if engine.IsSearchEnabled() {
+			return &model.SearchCapabilities{
+				Backend:   engine.GetName(),
+				Operators: engine.GetPostSearchOperators(),
+			}
+		}
+	}
+
+	if *s.rootStore.getConfig().SqlSettings.DisableDatabaseSearch {
+		return &model.SearchCapabilities{
+			Backend:   model.SearchBackendNone,
+			Operators: []string{},
+		}
+	}
+
+	return s.PostStore.GetSearchCapabilities()
+}
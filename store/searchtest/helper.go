@@ -389,6 +389,30 @@ func (th *SearchTestHelper) createFileInfo(creatorID, postID, name, content, ext
 	return th.Store.FileInfo().Save(fileInfoModel)
 }
 
+func (th *SearchTestHelper) createPostWithFile(userID, channelID, message, name, extension, mimeType string) (*model.Post, error) {
+	postModel := th.createPostModel(userID, channelID, message, "", model.PostTypeDefault, 1000000, false)
+	postModel.FileIds = model.StringArray{model.NewId()}
+	post, err := th.Store.Post().Save(postModel)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfo := th.createFileInfoModel(userID, post.Id, name, "", extension, mimeType, 1000000, 0)
+	fileInfo.Id = post.FileIds[0]
+	if _, err := th.Store.FileInfo().Save(fileInfo); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (th *SearchTestHelper) createPostWithLanguage(userID, channelID, message, language string) (*model.Post, error) {
+	postModel := th.createPostModel(userID, channelID, message, "", model.PostTypeDefault, 1000000, false)
+	if language != "" {
+		postModel.AddProp(model.PostPropsLanguage, language)
+	}
+	return th.Store.Post().Save(postModel)
+}
+
 func (th *SearchTestHelper) createReply(userID, message, hashtags string, parent *model.Post, createAt int64, pinned bool) (*model.Post, error) {
 	replyModel := th.createPostModel(userID, parent.ChannelId, message, hashtags, parent.Type, createAt, pinned)
 	replyModel.RootId = parent.Id
@@ -79,6 +79,26 @@ var searchPostStoreTests = []searchTest{
 		Fn:   testFilterMessagesAfterSpecificDate,
 		Tags: []string{EngineAll},
 	},
+	{
+		Name: "Should be able to filter messages written before or after a specific time",
+		Fn:   testFilterMessagesAroundSpecificTime,
+		Tags: []string{EngineAll},
+	},
+	{
+		Name: "Should be able to filter messages with or without files",
+		Fn:   testFilterMessagesWithFiles,
+		Tags: []string{EngineAll},
+	},
+	{
+		Name: "Should be able to filter messages by the type of their files",
+		Fn:   testFilterMessagesByFileType,
+		Tags: []string{EnginePostgres, EngineMySql},
+	},
+	{
+		Name: "Should be able to filter messages by their language",
+		Fn:   testFilterMessagesByLanguage,
+		Tags: []string{EnginePostgres, EngineMySql, EngineBleve},
+	},
 	{
 		Name: "Should be able to filter messages written before a specific date",
 		Fn:   testFilterMessagesBeforeSpecificDate,
@@ -819,6 +839,123 @@ func testFilterMessagesAfterSpecificDate(t *testing.T, th *SearchTestHelper) {
 	})
 }
 
+func testFilterMessagesAroundSpecificTime(t *testing.T, th *SearchTestHelper) {
+	creationDate := model.GetMillisForTime(time.Date(2020, 03, 01, 9, 30, 0, 0, time.UTC))
+	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "test in the morning", "", model.PostTypeDefault, creationDate, false)
+	require.NoError(t, err)
+	creationDate2 := model.GetMillisForTime(time.Date(2020, 03, 01, 16, 45, 0, 0, time.UTC))
+	p2, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "test in the afternoon", "", model.PostTypeDefault, creationDate2, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	t.Run("Should be able to search posts after a time", func(t *testing.T) {
+		params := &model.SearchParams{
+			Terms:     "test",
+			AfterDate: "2020-03-01T12:00",
+		}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("Should be able to search posts before a time", func(t *testing.T) {
+		params := &model.SearchParams{
+			Terms:      "test",
+			BeforeDate: "2020-03-01T12:00",
+		}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+}
+
+func testFilterMessagesWithFiles(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPostWithFile(th.User.Id, th.ChannelBasic.Id, "report attached", "report.pdf", "pdf", "application/pdf")
+	require.NoError(t, err)
+	p2, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "report to follow", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+	defer th.deleteUserFileInfos(th.User.Id)
+
+	t.Run("Should be able to search posts with files", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "report", HasFiles: true}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+
+	t.Run("Should be able to exclude posts with files", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "report", ExcludedHasFiles: true}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+}
+
+func testFilterMessagesByFileType(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPostWithFile(th.User.Id, th.ChannelBasic.Id, "screenshot attached", "screen.png", "png", "image/png")
+	require.NoError(t, err)
+	p2, err := th.createPostWithFile(th.User.Id, th.ChannelBasic.Id, "recording attached", "screen.mp4", "mp4", "video/mp4")
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+	defer th.deleteUserFileInfos(th.User.Id)
+
+	t.Run("Should be able to search posts with images", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "attached", FileTypes: []string{"image"}}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+
+	t.Run("Should be able to exclude posts with images", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "attached", ExcludedFileTypes: []string{"image"}}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+}
+
+func testFilterMessagesByLanguage(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPostWithLanguage(th.User.Id, th.ChannelBasic.Id, "release notes", "en")
+	require.NoError(t, err)
+	p2, err := th.createPostWithLanguage(th.User.Id, th.ChannelBasic.Id, "release notes traduites", "fr")
+	require.NoError(t, err)
+	p3, err := th.createPostWithLanguage(th.User.Id, th.ChannelBasic.Id, "release", "")
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	t.Run("Should be able to search posts in a language", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "release", Languages: []string{"fr"}}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("Should be able to exclude posts in a language", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "release", ExcludedLanguages: []string{"fr"}}
+		results, err := th.Store.Post().SearchPostsForUser([]*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 2)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+		th.checkPostInSearchResults(t, p3.Id, results.Posts)
+	})
+}
+
 func testFilterMessagesWithATerm(t *testing.T, th *SearchTestHelper) {
 	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "one two three", "", model.PostTypeDefault, 0, false)
 	require.NoError(t, err)
@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlActivityEventStore struct {
+	*SqlStore
+}
+
+func newSqlActivityEventStore(sqlStore *SqlStore) store.ActivityEventStore {
+	return &SqlActivityEventStore{sqlStore}
+}
+
+var activityEventColumns = []string{"Id", "UserId", "Type", "TeamId", "ChannelId", "PostId", "FileId", "EmojiName", "CreateAt"}
+
+func (s SqlActivityEventStore) Save(event *model.ActivityEvent) (*model.ActivityEvent, error) {
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ActivityEvents").
+		Columns(activityEventColumns...).
+		Values(event.Id, event.UserId, event.Type, event.TeamId, event.ChannelId, event.PostId, event.FileId, event.EmojiName, event.CreateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "activity_event_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ActivityEvent with id=%s", event.Id)
+	}
+
+	return event, nil
+}
+
+// GetForUser returns a page of the activity events of the user, newest first.
+func (s SqlActivityEventStore) GetForUser(userID string, opts store.ActivityEventGetOpts) ([]*model.ActivityEvent, error) {
+	query := s.getQueryBuilder().
+		Select(activityEventColumns...).
+		From("ActivityEvents").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(opts.Limit)).
+		Offset(uint64(opts.Offset))
+
+	if len(opts.Types) > 0 {
+		query = query.Where(sq.Eq{"Type": opts.Types})
+	}
+
+	if opts.VisibleToUserId != "" {
+		query = query.Where(sq.Expr("ChannelId IN (SELECT ChannelId FROM ChannelMembers WHERE UserId = ?)", opts.VisibleToUserId))
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "activity_event_get_for_user_tosql")
+	}
+
+	events := []*model.ActivityEvent{}
+	if err := s.GetReplicaX().Select(&events, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ActivityEvents with userId=%s", userID)
+	}
+
+	return events, nil
+}
+
+// DeleteForPost deletes the events of the post, along with the reactions to it and the files
+// attached to it.
+func (s SqlActivityEventStore) DeleteForPost(postID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ActivityEvents").
+		Where(sq.Eq{"PostId": postID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "activity_event_delete_for_post_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete ActivityEvents with postId=%s", postID)
+	}
+
+	return nil
+}
+
+func (s SqlActivityEventStore) DeleteReaction(userID, postID, emojiName string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ActivityEvents").
+		Where(sq.Eq{
+			"UserId":    userID,
+			"Type":      model.ActivityEventTypeReaction,
+			"PostId":    postID,
+			"EmojiName": emojiName,
+		}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "activity_event_delete_reaction_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete the reaction ActivityEvent with userId=%s postId=%s", userID, postID)
+	}
+
+	return nil
+}
+
+func (s SqlActivityEventStore) PermanentDeleteByUser(userID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ActivityEvents").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "activity_event_permanent_delete_by_user_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete ActivityEvents with userId=%s", userID)
+	}
+
+	return nil
+}
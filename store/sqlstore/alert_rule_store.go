@@ -0,0 +1,220 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlAlertRuleStore struct {
+	*SqlStore
+}
+
+func newSqlAlertRuleStore(sqlStore *SqlStore) store.AlertRuleStore {
+	return &SqlAlertRuleStore{sqlStore}
+}
+
+var alertRuleColumns = []string{
+	"Id",
+	"CreatorId",
+	"Name",
+	"Metric",
+	"Threshold",
+	"WindowMinutes",
+	"CooldownMinutes",
+	"ChannelId",
+	"DMUserIds",
+	"Enabled",
+	"State",
+	"LastValue",
+	"LastEvaluatedAt",
+	"LastNotifiedAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlAlertRuleStore) Save(rule *model.AlertRule) (*model.AlertRule, error) {
+	if rule.Id != "" {
+		return nil, store.NewErrInvalidInput("AlertRule", "id", rule.Id)
+	}
+
+	rule.PreSave()
+	if err := rule.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("AlertRules").
+		Columns(alertRuleColumns...).
+		Values(
+			rule.Id,
+			rule.CreatorId,
+			rule.Name,
+			rule.Metric,
+			rule.Threshold,
+			rule.WindowMinutes,
+			rule.CooldownMinutes,
+			rule.ChannelId,
+			rule.DMUserIds,
+			rule.Enabled,
+			rule.State,
+			rule.LastValue,
+			rule.LastEvaluatedAt,
+			rule.LastNotifiedAt,
+			rule.CreateAt,
+			rule.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "alert_rule_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save AlertRule with id=%s", rule.Id)
+	}
+
+	return rule, nil
+}
+
+func (s SqlAlertRuleStore) Get(id string) (*model.AlertRule, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(alertRuleColumns...).
+		From("AlertRules").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "alert_rule_get_tosql")
+	}
+
+	var rule model.AlertRule
+	if err := s.GetReplicaX().Get(&rule, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("AlertRule", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get AlertRule with id=%s", id)
+	}
+
+	return &rule, nil
+}
+
+// GetAll returns the alert rules, oldest first.
+func (s SqlAlertRuleStore) GetAll() ([]*model.AlertRule, error) {
+	return s.getAll(nil)
+}
+
+// GetAllEnabled returns the alert rules the server evaluates, oldest first.
+func (s SqlAlertRuleStore) GetAllEnabled() ([]*model.AlertRule, error) {
+	return s.getAll(sq.Eq{"Enabled": true})
+}
+
+func (s SqlAlertRuleStore) getAll(where sq.Sqlizer) ([]*model.AlertRule, error) {
+	builder := s.getQueryBuilder().
+		Select(alertRuleColumns...).
+		From("AlertRules").
+		OrderBy("CreateAt", "Id")
+	if where != nil {
+		builder = builder.Where(where)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "alert_rule_get_all_tosql")
+	}
+
+	rules := []*model.AlertRule{}
+	if err := s.GetReplicaX().Select(&rules, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get AlertRules")
+	}
+
+	return rules, nil
+}
+
+// Update saves the settings of the alert rule. Its state is only saved by UpdateState.
+func (s SqlAlertRuleStore) Update(rule *model.AlertRule) (*model.AlertRule, error) {
+	rule.PreUpdate()
+	if err := rule.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("AlertRules").
+		SetMap(map[string]interface{}{
+			"Name":            rule.Name,
+			"Metric":          rule.Metric,
+			"Threshold":       rule.Threshold,
+			"WindowMinutes":   rule.WindowMinutes,
+			"CooldownMinutes": rule.CooldownMinutes,
+			"ChannelId":       rule.ChannelId,
+			"DMUserIds":       rule.DMUserIds,
+			"Enabled":         rule.Enabled,
+			"UpdateAt":        rule.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": rule.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "alert_rule_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update AlertRule with id=%s", rule.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated AlertRule")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("AlertRule", rule.Id)
+	}
+
+	return rule, nil
+}
+
+// UpdateState saves the outcome of the last evaluation of the alert rule, leaving its settings
+// as they are should they have been changed in the meantime.
+func (s SqlAlertRuleStore) UpdateState(rule *model.AlertRule) error {
+	query, args, err := s.getQueryBuilder().
+		Update("AlertRules").
+		SetMap(map[string]interface{}{
+			"State":           rule.State,
+			"LastValue":       rule.LastValue,
+			"LastEvaluatedAt": rule.LastEvaluatedAt,
+			"LastNotifiedAt":  rule.LastNotifiedAt,
+		}).
+		Where(sq.Eq{"Id": rule.Id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "alert_rule_update_state_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update the state of AlertRule with id=%s", rule.Id)
+	}
+
+	return nil
+}
+
+func (s SqlAlertRuleStore) Delete(id string) error {
+	result, err := s.GetMasterX().Exec("DELETE FROM AlertRules WHERE Id = ?", id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete AlertRule with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted AlertRule")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("AlertRule", id)
+	}
+
+	return nil
+}
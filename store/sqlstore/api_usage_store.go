@@ -0,0 +1,151 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlAPIUsageStore struct {
+	*SqlStore
+}
+
+func newSqlAPIUsageStore(sqlStore *SqlStore) store.APIUsageStore {
+	return &SqlAPIUsageStore{sqlStore}
+}
+
+// Increment adds the counts of the given usages to the stored ones, creating the rows
+// that don't exist yet.
+func (s SqlAPIUsageStore) Increment(usages []*model.APIUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Insert("APIUsage").
+		Columns("UserId", "TokenId", "Route", "BucketAt", "Count")
+
+	for _, usage := range usages {
+		if err := usage.IsValid(); err != nil {
+			return err
+		}
+		query = query.Values(usage.UserId, usage.TokenId, usage.Route, usage.BucketAt, usage.Count)
+	}
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Count = Count + VALUES(Count)")
+	} else {
+		query = query.Suffix("ON CONFLICT (userid, tokenid, route, bucketat) DO UPDATE SET Count = APIUsage.Count + EXCLUDED.Count")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "api_usage_increment_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to increment APIUsage")
+	}
+
+	return nil
+}
+
+func (s SqlAPIUsageStore) GetForUser(userID string, since int64) ([]*model.APIUsage, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("UserId", "TokenId", "Route", "BucketAt", "Count").
+		From("APIUsage").
+		Where(sq.Eq{"UserId": userID}).
+		Where(sq.GtOrEq{"BucketAt": since}).
+		OrderBy("BucketAt DESC", "Route").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "api_usage_get_for_user_tosql")
+	}
+
+	usages := []*model.APIUsage{}
+	if err := s.GetReplicaX().Select(&usages, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find APIUsage with userId=%s", userID)
+	}
+
+	return usages, nil
+}
+
+// GetSummaries returns the total number of calls per user and token since the given
+// time, busiest first.
+func (s SqlAPIUsageStore) GetSummaries(since int64, offset, limit int) ([]*model.APIUsageSummary, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("UserId", "TokenId", "SUM(Count) AS Count").
+		From("APIUsage").
+		Where(sq.GtOrEq{"BucketAt": since}).
+		GroupBy("UserId", "TokenId").
+		OrderBy("Count DESC", "UserId", "TokenId").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "api_usage_get_summaries_tosql")
+	}
+
+	summaries := []*model.APIUsageSummary{}
+	if err := s.GetReplicaX().Select(&summaries, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get APIUsage summaries")
+	}
+
+	return summaries, nil
+}
+
+// GetTokenCounts returns the number of calls made with the given token per bucket since
+// the given time.
+func (s SqlAPIUsageStore) GetTokenCounts(tokenID string, since int64) (map[int64]int64, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("BucketAt", "SUM(Count) AS Count").
+		From("APIUsage").
+		Where(sq.Eq{"TokenId": tokenID}).
+		Where(sq.GtOrEq{"BucketAt": since}).
+		GroupBy("BucketAt").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "api_usage_get_token_counts_tosql")
+	}
+
+	var rows []struct {
+		BucketAt int64
+		Count    int64
+	}
+	if err := s.GetReplicaX().Select(&rows, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get APIUsage counts with tokenId=%s", tokenID)
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.BucketAt] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (s SqlAPIUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM APIUsage WHERE BucketAt = any (array (SELECT BucketAt FROM APIUsage WHERE BucketAt < ? LIMIT ?))"
+	} else {
+		query = "DELETE FROM APIUsage WHERE BucketAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete APIUsage")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted APIUsage")
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlAuditLogStore struct {
+	*SqlStore
+}
+
+func newSqlAuditLogStore(sqlStore *SqlStore) store.AuditLogStore {
+	return &SqlAuditLogStore{sqlStore}
+}
+
+var auditLogColumns = []string{
+	"Id",
+	"CreateAt",
+	"Event",
+	"Status",
+	"UserId",
+	"SessionId",
+	"IpAddress",
+	"Client",
+	"APIPath",
+	"TargetType",
+	"TargetId",
+	"Meta",
+}
+
+func (s SqlAuditLogStore) Save(log *model.AuditLog) (*model.AuditLog, error) {
+	log.PreSave()
+	if err := log.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("AuditLogs").
+		Columns(auditLogColumns...).
+		Values(
+			log.Id,
+			log.CreateAt,
+			log.Event,
+			log.Status,
+			log.UserId,
+			log.SessionId,
+			log.IpAddress,
+			log.Client,
+			log.APIPath,
+			log.TargetType,
+			log.TargetId,
+			model.StringInterfaceToJSON(log.Meta),
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "audit_log_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save AuditLog with id=%s", log.Id)
+	}
+
+	return log, nil
+}
+
+// Search returns the audit logs matching the options, most recent first.
+func (s SqlAuditLogStore) Search(opts *model.AuditLogSearch, offset, limit int) ([]*model.AuditLog, error) {
+	builder := s.getQueryBuilder().
+		Select(auditLogColumns...).
+		From("AuditLogs").
+		Where(sq.GtOrEq{"CreateAt": opts.Since}).
+		OrderBy("CreateAt DESC", "Id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if opts.Until != 0 {
+		builder = builder.Where(sq.LtOrEq{"CreateAt": opts.Until})
+	}
+	if opts.UserId != "" {
+		builder = builder.Where(sq.Eq{"UserId": opts.UserId})
+	}
+	if opts.Event != "" {
+		builder = builder.Where(sq.Eq{"Event": opts.Event})
+	}
+	if opts.IpAddress != "" {
+		builder = builder.Where(sq.Eq{"IpAddress": opts.IpAddress})
+	}
+	if opts.TargetId != "" {
+		builder = builder.Where(sq.Eq{"TargetId": opts.TargetId})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "audit_log_search_tosql")
+	}
+
+	logs := []*model.AuditLog{}
+	if err := s.GetReplicaX().Select(&logs, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to search AuditLogs")
+	}
+
+	return logs, nil
+}
+
+func (s SqlAuditLogStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM AuditLogs WHERE Id IN (SELECT Id FROM AuditLogs WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM AuditLogs WHERE CreateAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete AuditLogs")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted AuditLogs")
+	}
+
+	return rowsAffected, nil
+}
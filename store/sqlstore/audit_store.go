@@ -60,6 +60,57 @@ func (s SqlAuditStore) Get(userId string, offset int, limit int) (model.Audits,
 	return audits, nil
 }
 
+// GetCountByExtraInfoPrefix counts the audits created at or after since whose extra info starts
+// with the prefix, such as the failed logins.
+func (s SqlAuditStore) GetCountByExtraInfoPrefix(prefix string, since int64) (int64, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Audits").
+		Where(sq.GtOrEq{"CreateAt": since}).
+		Where("ExtraInfo LIKE ? ESCAPE '*'", sanitizeSearchTerm(prefix, "*")+"%").
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "audits_count_tosql")
+	}
+
+	var count int64
+	if err := s.GetReplicaX().Get(&count, query, args...); err != nil {
+		return 0, errors.Wrapf(err, "failed to count Audits with prefix=%s", prefix)
+	}
+	return count, nil
+}
+
+// GetByActions returns a page of the audits with one of the actions, newest first. When
+// extraInfoPrefix is not empty, only the audits whose extra info starts with it are returned.
+func (s SqlAuditStore) GetByActions(actions []string, extraInfoPrefix string, offset int, limit int) (model.Audits, error) {
+	if limit > 1000 {
+		return nil, store.NewErrOutOfBounds(limit)
+	}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("Audits").
+		Where(sq.Eq{"Action": actions}).
+		OrderBy("CreateAt DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if extraInfoPrefix != "" {
+		query = query.Where("ExtraInfo LIKE ? ESCAPE '*'", sanitizeSearchTerm(extraInfoPrefix, "*")+"%")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "audits_by_actions_tosql")
+	}
+
+	audits := model.Audits{}
+	if err := s.GetReplicaX().Select(&audits, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Audit list for actions=%v", actions)
+	}
+	return audits, nil
+}
+
 func (s SqlAuditStore) PermanentDeleteByUser(userId string) error {
 	if _, err := s.GetMasterX().Exec("DELETE FROM Audits WHERE UserId = ?", userId); err != nil {
 		return errors.Wrapf(err, "failed to delete Audit with userId=%s", userId)
@@ -0,0 +1,158 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlBotStateStore struct {
+	*SqlStore
+}
+
+func newSqlBotStateStore(sqlStore *SqlStore) store.BotStateStore {
+	return &SqlBotStateStore{sqlStore}
+}
+
+// notExpired selects the states without an expiry, or with one still to come.
+func (s SqlBotStateStore) notExpired() sq.Or {
+	return sq.Or{
+		sq.Eq{"ExpireAt": 0},
+		sq.Gt{"ExpireAt": model.GetMillis()},
+	}
+}
+
+// Save creates the state, or updates its value and expiry when the key is already set. The time
+// the state was created at is kept on updates.
+func (s SqlBotStateStore) Save(state *model.BotState) (*model.BotState, error) {
+	state.PreSave()
+	if err := state.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("BotStates").
+		Columns("BotUserId", "UserId", "ChannelId", "StateKey", "Value", "ExpireAt", "CreateAt", "UpdateAt").
+		Values(state.BotUserId, state.UserId, state.ChannelId, state.Key, string(state.Value), state.ExpireAt, state.CreateAt, state.UpdateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Value = VALUES(Value), ExpireAt = VALUES(ExpireAt), UpdateAt = VALUES(UpdateAt)")
+	} else {
+		query = query.Suffix("ON CONFLICT (botuserid, userid, channelid, statekey) DO UPDATE SET value = EXCLUDED.value, expireat = EXCLUDED.expireat, updateat = EXCLUDED.updateat")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_state_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save BotState with botUserId=%s and key=%s", state.BotUserId, state.Key)
+	}
+
+	return state, nil
+}
+
+// Get reads from the master so that bots see the states they just set.
+func (s SqlBotStateStore) Get(botUserID, userID, channelID, key string) (*model.BotState, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("BotUserId", "UserId", "ChannelId", "StateKey", "Value", "ExpireAt", "CreateAt", "UpdateAt").
+		From("BotStates").
+		Where(sq.Eq{"BotUserId": botUserID, "UserId": userID, "ChannelId": channelID, "StateKey": key}).
+		Where(s.notExpired()).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_state_get_tosql")
+	}
+
+	var state model.BotState
+	var value string
+	if err := s.GetMasterX().QueryRowX(query, args...).Scan(&state.BotUserId, &state.UserId, &state.ChannelId, &state.Key, &value, &state.ExpireAt, &state.CreateAt, &state.UpdateAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("BotState", fmt.Sprintf("botUserId=%s, key=%s", botUserID, key))
+		}
+		return nil, errors.Wrapf(err, "failed to get BotState with botUserId=%s and key=%s", botUserID, key)
+	}
+	state.Value = []byte(value)
+
+	return &state, nil
+}
+
+// GetKeys returns the keys of the states of the bot in the scope, in alphabetical order.
+func (s SqlBotStateStore) GetKeys(botUserID, userID, channelID string, offset, limit int) ([]string, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("StateKey").
+		From("BotStates").
+		Where(sq.Eq{"BotUserId": botUserID, "UserId": userID, "ChannelId": channelID}).
+		Where(s.notExpired()).
+		OrderBy("StateKey").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_state_getkeys_tosql")
+	}
+
+	keys := []string{}
+	if err := s.GetReplicaX().Select(&keys, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get BotState keys with botUserId=%s", botUserID)
+	}
+
+	return keys, nil
+}
+
+func (s SqlBotStateStore) Delete(botUserID, userID, channelID, key string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("BotStates").
+		Where(sq.Eq{"BotUserId": botUserID, "UserId": userID, "ChannelId": channelID, "StateKey": key}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "bot_state_delete_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete BotState with botUserId=%s and key=%s", botUserID, key)
+	}
+
+	return nil
+}
+
+// PermanentDeleteExpiredBatch deletes up to limit states expired as of now.
+func (s SqlBotStateStore) PermanentDeleteExpiredBatch(now int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM BotStates WHERE (BotUserId, UserId, ChannelId, StateKey) IN (SELECT BotUserId, UserId, ChannelId, StateKey FROM BotStates WHERE ExpireAt > 0 AND ExpireAt <= ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM BotStates WHERE ExpireAt > 0 AND ExpireAt <= ? LIMIT ?"
+	}
+
+	result, err := s.GetMasterX().Exec(query, now, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired BotStates")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted BotStates")
+	}
+
+	return rowsAffected, nil
+}
+
+// PermanentDeleteByUser deletes the states of the bot user, and those of the bots scoped to the
+// user.
+func (s SqlBotStateStore) PermanentDeleteByUser(userID string) error {
+	if _, err := s.GetMasterX().Exec("DELETE FROM BotStates WHERE BotUserId = ? OR UserId = ?", userID, userID); err != nil {
+		return errors.Wrapf(err, "failed to delete BotStates with userId=%s", userID)
+	}
+
+	return nil
+}
@@ -105,6 +105,10 @@ func (us SqlBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error)
 		conditions = append(conditions, "b.OwnerId = ?")
 		args = append(args, options.OwnerId)
 	}
+	if options.InChannelId != "" {
+		conditions = append(conditions, "b.UserId IN (SELECT cm.UserId FROM ChannelMembers cm WHERE cm.ChannelId = ?)")
+		args = append(args, options.InChannelId)
+	}
 	if options.OnlyOrphaned {
 		additionalJoin = "JOIN Users o ON (o.Id = b.OwnerId)"
 		conditions = append(conditions, "o.DeleteAt != 0")
@@ -0,0 +1,200 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlBotTokenRotationStore struct {
+	*SqlStore
+}
+
+func newSqlBotTokenRotationStore(sqlStore *SqlStore) store.BotTokenRotationStore {
+	return &SqlBotTokenRotationStore{sqlStore}
+}
+
+var botTokenRotationColumns = []string{
+	"BotUserId",
+	"RotationDays",
+	"GraceHours",
+	"WebhookURL",
+	"CurrentTokenId",
+	"LastRotatedAt",
+	"NextRotationAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlBotTokenRotationStore) Save(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
+	rotation.PreSave()
+	if err := rotation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("BotTokenRotations").
+		Columns(botTokenRotationColumns...).
+		Values(
+			rotation.BotUserId,
+			rotation.RotationDays,
+			rotation.GraceHours,
+			rotation.WebhookURL,
+			rotation.CurrentTokenId,
+			rotation.LastRotatedAt,
+			rotation.NextRotationAt,
+			rotation.CreateAt,
+			rotation.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_token_rotation_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "bottokenrotations_pkey"}) {
+			return nil, store.NewErrConflict("BotTokenRotation", err, "botUserId="+rotation.BotUserId)
+		}
+		return nil, errors.Wrapf(err, "failed to save BotTokenRotation with botUserId=%s", rotation.BotUserId)
+	}
+
+	return rotation, nil
+}
+
+func (s SqlBotTokenRotationStore) Get(botUserID string) (*model.BotTokenRotation, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(botTokenRotationColumns...).
+		From("BotTokenRotations").
+		Where(sq.Eq{"BotUserId": botUserID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_token_rotation_get_tosql")
+	}
+
+	var rotation model.BotTokenRotation
+	if err := s.GetReplicaX().Get(&rotation, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("BotTokenRotation", botUserID)
+		}
+		return nil, errors.Wrapf(err, "failed to get BotTokenRotation with botUserId=%s", botUserID)
+	}
+
+	return &rotation, nil
+}
+
+func (s SqlBotTokenRotationStore) Update(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
+	rotation.PreUpdate()
+	if err := rotation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("BotTokenRotations").
+		SetMap(map[string]interface{}{
+			"RotationDays":   rotation.RotationDays,
+			"GraceHours":     rotation.GraceHours,
+			"WebhookURL":     rotation.WebhookURL,
+			"CurrentTokenId": rotation.CurrentTokenId,
+			"LastRotatedAt":  rotation.LastRotatedAt,
+			"NextRotationAt": rotation.NextRotationAt,
+			"UpdateAt":       rotation.UpdateAt,
+		}).
+		Where(sq.Eq{"BotUserId": rotation.BotUserId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_token_rotation_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update BotTokenRotation with botUserId=%s", rotation.BotUserId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated BotTokenRotation")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("BotTokenRotation", rotation.BotUserId)
+	}
+
+	return rotation, nil
+}
+
+func (s SqlBotTokenRotationStore) Delete(botUserID string) error {
+	result, err := s.GetMasterX().Exec("DELETE FROM BotTokenRotations WHERE BotUserId = ?", botUserID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete BotTokenRotation with botUserId=%s", botUserID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted BotTokenRotation")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("BotTokenRotation", botUserID)
+	}
+
+	return nil
+}
+
+// GetDue returns the rotations whose token is to be replaced by now, the most overdue first.
+func (s SqlBotTokenRotationStore) GetDue(now int64, limit int) ([]*model.BotTokenRotation, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(botTokenRotationColumns...).
+		From("BotTokenRotations").
+		Where(sq.LtOrEq{"NextRotationAt": now}).
+		OrderBy("NextRotationAt", "BotUserId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_token_rotation_get_due_tosql")
+	}
+
+	rotations := []*model.BotTokenRotation{}
+	if err := s.GetReplicaX().Select(&rotations, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get due BotTokenRotations")
+	}
+
+	return rotations, nil
+}
+
+// GetTokenAges returns the access tokens of the bots, the oldest first.
+func (s SqlBotTokenRotationStore) GetTokenAges(offset, limit int) ([]*model.BotTokenAge, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(
+			"UserAccessTokens.Id AS TokenId",
+			"UserAccessTokens.UserId AS BotUserId",
+			"Users.Username AS BotUsername",
+			"UserAccessTokens.Description",
+			"UserAccessTokens.IsActive",
+			"UserAccessTokens.CreateAt",
+			"UserAccessTokens.ExpiresAt",
+			"CASE WHEN BotTokenRotations.CurrentTokenId = UserAccessTokens.Id THEN 1 ELSE 0 END AS Rotated",
+		).
+		From("UserAccessTokens").
+		Join("Bots ON Bots.UserId = UserAccessTokens.UserId").
+		Join("Users ON Users.Id = UserAccessTokens.UserId").
+		LeftJoin("BotTokenRotations ON BotTokenRotations.BotUserId = UserAccessTokens.UserId").
+		OrderBy("UserAccessTokens.CreateAt", "UserAccessTokens.Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "bot_token_rotation_get_token_ages_tosql")
+	}
+
+	ages := []*model.BotTokenAge{}
+	if err := s.GetReplicaX().Select(&ages, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get the UserAccessTokens of the Bots")
+	}
+
+	return ages, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlCannedResponseStore struct {
+	*SqlStore
+}
+
+func newSqlCannedResponseStore(sqlStore *SqlStore) store.CannedResponseStore {
+	return &SqlCannedResponseStore{sqlStore}
+}
+
+var cannedResponseColumns = []string{
+	"Id",
+	"UserId",
+	"TeamId",
+	"CreatorId",
+	"Shortcut",
+	"Content",
+	"UseCount",
+	"LastUsedAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+var cannedResponseShortcutIndexes = []string{"idx_cannedresponses_userid_teamid_shortcut", "cannedresponses_userid_teamid_shortcut_key"}
+
+func (s SqlCannedResponseStore) Save(response *model.CannedResponse) (*model.CannedResponse, error) {
+	if response.Id != "" {
+		return nil, store.NewErrInvalidInput("CannedResponse", "id", response.Id)
+	}
+
+	response.PreSave()
+	if err := response.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("CannedResponses").
+		Columns(cannedResponseColumns...).
+		Values(
+			response.Id,
+			response.UserId,
+			response.TeamId,
+			response.CreatorId,
+			response.Shortcut,
+			response.Content,
+			response.UseCount,
+			response.LastUsedAt,
+			response.CreateAt,
+			response.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "canned_response_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, cannedResponseShortcutIndexes) {
+			return nil, store.NewErrConflict("CannedResponse", err, "shortcut="+response.Shortcut)
+		}
+		return nil, errors.Wrapf(err, "failed to save CannedResponse with id=%s", response.Id)
+	}
+
+	return response, nil
+}
+
+func (s SqlCannedResponseStore) Update(response *model.CannedResponse) (*model.CannedResponse, error) {
+	response.PreUpdate()
+	if err := response.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("CannedResponses").
+		SetMap(map[string]interface{}{
+			"Shortcut": response.Shortcut,
+			"Content":  response.Content,
+			"UpdateAt": response.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": response.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "canned_response_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		if IsUniqueConstraintError(err, cannedResponseShortcutIndexes) {
+			return nil, store.NewErrConflict("CannedResponse", err, "shortcut="+response.Shortcut)
+		}
+		return nil, errors.Wrapf(err, "failed to update CannedResponse with id=%s", response.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated CannedResponse")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("CannedResponse", response.Id)
+	}
+
+	return response, nil
+}
+
+func (s SqlCannedResponseStore) Get(id string) (*model.CannedResponse, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(cannedResponseColumns...).
+		From("CannedResponses").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "canned_response_get_tosql")
+	}
+
+	var response model.CannedResponse
+	if err := s.GetReplicaX().Get(&response, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("CannedResponse", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get CannedResponse with id=%s", id)
+	}
+
+	return &response, nil
+}
+
+// GetForUser returns the canned responses owned by the user, ordered by shortcut.
+func (s SqlCannedResponseStore) GetForUser(userID string) ([]*model.CannedResponse, error) {
+	return s.getByScope(sq.Eq{"UserId": userID, "TeamId": ""})
+}
+
+// GetForTeam returns the canned responses shared with the members of the team, ordered by
+// shortcut.
+func (s SqlCannedResponseStore) GetForTeam(teamID string) ([]*model.CannedResponse, error) {
+	return s.getByScope(sq.Eq{"UserId": "", "TeamId": teamID})
+}
+
+func (s SqlCannedResponseStore) getByScope(scope sq.Eq) ([]*model.CannedResponse, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(cannedResponseColumns...).
+		From("CannedResponses").
+		Where(scope).
+		OrderBy("Shortcut").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "canned_response_getbyscope_tosql")
+	}
+
+	responses := []*model.CannedResponse{}
+	if err := s.GetReplicaX().Select(&responses, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get CannedResponses")
+	}
+
+	return responses, nil
+}
+
+// Autocomplete returns the canned responses of the user and, when teamID is not empty, of the
+// team whose shortcut starts with the given prefix. The most used ones come first.
+func (s SqlCannedResponseStore) Autocomplete(userID, teamID, prefix string, limit int) ([]*model.CannedResponse, error) {
+	scope := sq.Or{sq.Eq{"UserId": userID, "TeamId": ""}}
+	if teamID != "" {
+		scope = append(scope, sq.Eq{"UserId": "", "TeamId": teamID})
+	}
+
+	builder := s.getQueryBuilder().
+		Select(cannedResponseColumns...).
+		From("CannedResponses").
+		Where(scope).
+		OrderBy("UseCount DESC", "Shortcut").
+		Limit(uint64(limit))
+	if prefix != "" {
+		builder = builder.Where("Shortcut LIKE ? ESCAPE '*'", sanitizeSearchTerm(prefix, "*")+"%")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "canned_response_autocomplete_tosql")
+	}
+
+	responses := []*model.CannedResponse{}
+	if err := s.GetReplicaX().Select(&responses, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to autocomplete CannedResponses for userId=%s", userID)
+	}
+
+	return responses, nil
+}
+
+// IncrementUseCount counts one more use of the canned response.
+func (s SqlCannedResponseStore) IncrementUseCount(id string, usedAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("CannedResponses").
+		Set("UseCount", sq.Expr("UseCount + 1")).
+		Set("LastUsedAt", usedAt).
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "canned_response_incrementusecount_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to increment use count of CannedResponse with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for used CannedResponse")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("CannedResponse", id)
+	}
+
+	return nil
+}
+
+func (s SqlCannedResponseStore) Delete(id string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("CannedResponses").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "canned_response_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete CannedResponse with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted CannedResponse")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("CannedResponse", id)
+	}
+
+	return nil
+}
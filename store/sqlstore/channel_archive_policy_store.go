@@ -0,0 +1,259 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlChannelArchivePolicyStore struct {
+	*SqlStore
+}
+
+func newSqlChannelArchivePolicyStore(sqlStore *SqlStore) store.ChannelArchivePolicyStore {
+	return &SqlChannelArchivePolicyStore{sqlStore}
+}
+
+var channelArchivePolicyColumns = []string{
+	"TeamId",
+	"InactiveDays",
+	"ExcludedChannelIds",
+	"CreateAt",
+	"UpdateAt",
+}
+
+var channelArchiveWarningColumns = []string{
+	"ChannelId",
+	"TeamId",
+	"LastActivityAt",
+	"WarnedAt",
+}
+
+func (s SqlChannelArchivePolicyStore) Save(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+	policy.PreSave()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ChannelArchivePolicies").
+		Columns(channelArchivePolicyColumns...).
+		Values(
+			policy.TeamId,
+			policy.InactiveDays,
+			policy.ExcludedChannelIds,
+			policy.CreateAt,
+			policy.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_policy_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "channelarchivepolicies_pkey"}) {
+			return nil, store.NewErrConflict("ChannelArchivePolicy", err, "teamId="+policy.TeamId)
+		}
+		return nil, errors.Wrapf(err, "failed to save ChannelArchivePolicy with teamId=%s", policy.TeamId)
+	}
+
+	return policy, nil
+}
+
+func (s SqlChannelArchivePolicyStore) Get(teamID string) (*model.ChannelArchivePolicy, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelArchivePolicyColumns...).
+		From("ChannelArchivePolicies").
+		Where(sq.Eq{"TeamId": teamID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_policy_get_tosql")
+	}
+
+	var policy model.ChannelArchivePolicy
+	if err := s.GetReplicaX().Get(&policy, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelArchivePolicy", teamID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelArchivePolicy with teamId=%s", teamID)
+	}
+
+	return &policy, nil
+}
+
+func (s SqlChannelArchivePolicyStore) GetAll() ([]*model.ChannelArchivePolicy, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelArchivePolicyColumns...).
+		From("ChannelArchivePolicies").
+		OrderBy("TeamId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_policy_get_all_tosql")
+	}
+
+	policies := []*model.ChannelArchivePolicy{}
+	if err := s.GetReplicaX().Select(&policies, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get ChannelArchivePolicies")
+	}
+
+	return policies, nil
+}
+
+func (s SqlChannelArchivePolicyStore) Update(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+	policy.PreUpdate()
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("ChannelArchivePolicies").
+		SetMap(map[string]interface{}{
+			"InactiveDays":       policy.InactiveDays,
+			"ExcludedChannelIds": policy.ExcludedChannelIds,
+			"UpdateAt":           policy.UpdateAt,
+		}).
+		Where(sq.Eq{"TeamId": policy.TeamId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_policy_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ChannelArchivePolicy with teamId=%s", policy.TeamId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated ChannelArchivePolicy")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("ChannelArchivePolicy", policy.TeamId)
+	}
+
+	return policy, nil
+}
+
+// Delete removes the policy of the team along with the warnings of its channels.
+func (s SqlChannelArchivePolicyStore) Delete(teamID string) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	result, err := transaction.Exec("DELETE FROM ChannelArchivePolicies WHERE TeamId = ?", teamID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelArchivePolicy with teamId=%s", teamID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted ChannelArchivePolicy")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("ChannelArchivePolicy", teamID)
+	}
+
+	if _, err = transaction.Exec("DELETE FROM ChannelArchiveWarnings WHERE TeamId = ?", teamID); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelArchiveWarnings with teamId=%s", teamID)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlChannelArchivePolicyStore) GetInactiveChannels(policy *model.ChannelArchivePolicy, before int64, limit int) ([]*model.Channel, error) {
+	builder := s.getQueryBuilder().
+		Select("Channels.*").
+		From("Channels").
+		LeftJoin("ChannelArchiveWarnings ON ChannelArchiveWarnings.ChannelId = Channels.Id").
+		Where(sq.Eq{
+			"Channels.TeamId":                  policy.TeamId,
+			"Channels.Type":                    []model.ChannelType{model.ChannelTypeOpen, model.ChannelTypePrivate},
+			"Channels.DeleteAt":                0,
+			"ChannelArchiveWarnings.ChannelId": nil,
+		}).
+		Where(sq.NotEq{"Channels.Name": model.DefaultChannelName}).
+		Where(sq.Lt{"Channels.LastPostAt": before, "Channels.CreateAt": before}).
+		OrderBy("Channels.LastPostAt", "Channels.Id").
+		Limit(uint64(limit))
+
+	if len(policy.ExcludedChannelIds) > 0 {
+		builder = builder.Where(sq.NotEq{"Channels.Id": []string(policy.ExcludedChannelIds)})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_policy_get_inactive_channels_tosql")
+	}
+
+	channels := []*model.Channel{}
+	if err := s.GetReplicaX().Select(&channels, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get inactive Channels with teamId=%s", policy.TeamId)
+	}
+
+	return channels, nil
+}
+
+func (s SqlChannelArchivePolicyStore) SaveWarning(warning *model.ChannelArchiveWarning) error {
+	query, args, err := s.getQueryBuilder().
+		Insert("ChannelArchiveWarnings").
+		Columns(channelArchiveWarningColumns...).
+		Values(
+			warning.ChannelId,
+			warning.TeamId,
+			warning.LastActivityAt,
+			warning.WarnedAt,
+		).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_archive_warning_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "channelarchivewarnings_pkey"}) {
+			return store.NewErrConflict("ChannelArchiveWarning", err, "channelId="+warning.ChannelId)
+		}
+		return errors.Wrapf(err, "failed to save ChannelArchiveWarning with channelId=%s", warning.ChannelId)
+	}
+
+	return nil
+}
+
+func (s SqlChannelArchivePolicyStore) GetWarnings(teamID string) ([]*model.ChannelArchiveWarning, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelArchiveWarningColumns...).
+		From("ChannelArchiveWarnings").
+		Where(sq.Eq{"TeamId": teamID}).
+		OrderBy("WarnedAt", "ChannelId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_archive_warning_get_tosql")
+	}
+
+	warnings := []*model.ChannelArchiveWarning{}
+	if err := s.GetReplicaX().Select(&warnings, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelArchiveWarnings with teamId=%s", teamID)
+	}
+
+	return warnings, nil
+}
+
+func (s SqlChannelArchivePolicyStore) DeleteWarning(channelID string) error {
+	if _, err := s.GetMasterX().Exec("DELETE FROM ChannelArchiveWarnings WHERE ChannelId = ?", channelID); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelArchiveWarning with channelId=%s", channelID)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlChannelCommandOverrideStore struct {
+	*SqlStore
+}
+
+func newSqlChannelCommandOverrideStore(sqlStore *SqlStore) store.ChannelCommandOverrideStore {
+	return &SqlChannelCommandOverrideStore{sqlStore}
+}
+
+// triggerColumn returns the Trigger column, quoted since trigger is a keyword.
+func (s SqlChannelCommandOverrideStore) triggerColumn() string {
+	if s.DriverName() == model.DatabaseDriverMysql {
+		return "`Trigger`"
+	}
+	return "\"trigger\""
+}
+
+func (s SqlChannelCommandOverrideStore) columns() []string {
+	return []string{
+		"ChannelId",
+		s.triggerColumn(),
+		"CreatorId",
+		"CreateAt",
+	}
+}
+
+func (s SqlChannelCommandOverrideStore) Save(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, error) {
+	override.PreSave()
+	if err := override.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ChannelCommandOverrides").
+		Columns(s.columns()...).
+		Values(override.ChannelId, override.Trigger, override.CreatorId, override.CreateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_command_override_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "channelcommandoverrides_pkey"}) {
+			return nil, store.NewErrConflict("ChannelCommandOverride", err, "channel_id="+override.ChannelId+", trigger="+override.Trigger)
+		}
+		return nil, errors.Wrapf(err, "failed to save ChannelCommandOverride with channelId=%s, trigger=%s", override.ChannelId, override.Trigger)
+	}
+
+	return override, nil
+}
+
+func (s SqlChannelCommandOverrideStore) Get(channelID, trigger string) (*model.ChannelCommandOverride, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(s.columns()...).
+		From("ChannelCommandOverrides").
+		Where(sq.Eq{"ChannelId": channelID, s.triggerColumn(): trigger}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_command_override_get_tosql")
+	}
+
+	var override model.ChannelCommandOverride
+	if err := s.GetReplicaX().Get(&override, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelCommandOverride", "channel_id="+channelID+", trigger="+trigger)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelCommandOverride with channelId=%s, trigger=%s", channelID, trigger)
+	}
+
+	return &override, nil
+}
+
+func (s SqlChannelCommandOverrideStore) GetForChannel(channelID string) ([]*model.ChannelCommandOverride, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(s.columns()...).
+		From("ChannelCommandOverrides").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy(s.triggerColumn()).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_command_override_get_for_channel_tosql")
+	}
+
+	overrides := []*model.ChannelCommandOverride{}
+	if err := s.GetReplicaX().Select(&overrides, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelCommandOverrides with channelId=%s", channelID)
+	}
+
+	return overrides, nil
+}
+
+func (s SqlChannelCommandOverrideStore) Delete(channelID, trigger string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ChannelCommandOverrides").
+		Where(sq.Eq{"ChannelId": channelID, s.triggerColumn(): trigger}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_command_override_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelCommandOverride with channelId=%s, trigger=%s", channelID, trigger)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted ChannelCommandOverride")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("ChannelCommandOverride", "channel_id="+channelID+", trigger="+trigger)
+	}
+
+	return nil
+}
+
+func (s SqlChannelCommandOverrideStore) DeleteForChannel(channelID string) error {
+	if _, err := s.GetMasterX().Exec("DELETE FROM ChannelCommandOverrides WHERE ChannelId = ?", channelID); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelCommandOverrides with channelId=%s", channelID)
+	}
+
+	return nil
+}
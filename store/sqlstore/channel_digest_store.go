@@ -0,0 +1,245 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlChannelDigestStore struct {
+	*SqlStore
+}
+
+func newSqlChannelDigestStore(sqlStore *SqlStore) store.ChannelDigestStore {
+	return &SqlChannelDigestStore{sqlStore}
+}
+
+var channelDigestColumns = []string{"UserId", "ChannelId", "Cadence", "LastSentAt", "CreateAt"}
+
+// Save creates the digest subscription, or changes its cadence if it already exists.
+func (s SqlChannelDigestStore) Save(digest *model.ChannelDigest) (*model.ChannelDigest, error) {
+	digest.PreSave()
+	if err := digest.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ChannelDigests").
+		Columns(channelDigestColumns...).
+		Values(digest.UserId, digest.ChannelId, digest.Cadence, digest.LastSentAt, digest.CreateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Cadence = ?", digest.Cadence))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, channelid) DO UPDATE SET Cadence = ?", digest.Cadence))
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelDigest with userId=%s channelId=%s", digest.UserId, digest.ChannelId)
+	}
+
+	return s.get(s.GetMasterX(), digest.UserId, digest.ChannelId)
+}
+
+func (s SqlChannelDigestStore) Get(userID, channelID string) (*model.ChannelDigest, error) {
+	return s.get(s.GetReplicaX(), userID, channelID)
+}
+
+func (s SqlChannelDigestStore) get(db *sqlxDBWrapper, userID, channelID string) (*model.ChannelDigest, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelDigestColumns...).
+		From("ChannelDigests").
+		Where(sq.Eq{"UserId": userID, "ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_get_tosql")
+	}
+
+	var digest model.ChannelDigest
+	if err := db.Get(&digest, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelDigest", channelID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelDigest with userId=%s channelId=%s", userID, channelID)
+	}
+
+	return &digest, nil
+}
+
+func (s SqlChannelDigestStore) GetForUser(userID string) ([]*model.ChannelDigest, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelDigestColumns...).
+		From("ChannelDigests").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_getforuser_tosql")
+	}
+
+	digests := []*model.ChannelDigest{}
+	if err := s.GetReplicaX().Select(&digests, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelDigests for userId=%s", userID)
+	}
+
+	return digests, nil
+}
+
+func (s SqlChannelDigestStore) Delete(userID, channelID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ChannelDigests").
+		Where(sq.Eq{"UserId": userID, "ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_digest_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelDigest with userId=%s channelId=%s", userID, channelID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted ChannelDigest")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("ChannelDigest", channelID)
+	}
+
+	return nil
+}
+
+// GetDue returns the subscriptions with the given cadence that were last sent before
+// sentBefore, grouped by user so that a user's channels can be sent in a single email. They are
+// read from the master since they are marked as sent right before being looked up again, and
+// would be sent twice when read from a lagging replica.
+func (s SqlChannelDigestStore) GetDue(cadence string, sentBefore int64, limit int) ([]*model.ChannelDigest, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelDigestColumns...).
+		From("ChannelDigests").
+		Where(sq.Eq{"Cadence": cadence}).
+		Where(sq.Lt{"LastSentAt": sentBefore}).
+		OrderBy("UserId", "ChannelId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_getdue_tosql")
+	}
+
+	digests := []*model.ChannelDigest{}
+	if err := s.GetMasterX().Select(&digests, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get due ChannelDigests with cadence=%s", cadence)
+	}
+
+	return digests, nil
+}
+
+func (s SqlChannelDigestStore) UpdateLastSentAt(userID string, channelIDs []string, sentAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("ChannelDigests").
+		Set("LastSentAt", sentAt).
+		Where(sq.Eq{"UserId": userID, "ChannelId": channelIDs}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_digest_updatelastsentat_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update LastSentAt of ChannelDigests for userId=%s", userID)
+	}
+
+	return nil
+}
+
+// GetActivity summarizes the posts made in the channel between since and until: how many
+// there were, the threads that received the most replies, and the posts mentioning the
+// user that they have not replied to since.
+func (s SqlChannelDigestStore) GetActivity(channelID, userID, username string, since, until int64) (*model.ChannelDigestActivity, error) {
+	activity := &model.ChannelDigestActivity{
+		ChannelId:          channelID,
+		TopThreads:         []*model.ChannelDigestPost{},
+		UnansweredMentions: []*model.ChannelDigestPost{},
+	}
+
+	countQuery, args, err := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Posts").
+		Where(sq.Eq{"ChannelId": channelID, "DeleteAt": 0}).
+		Where(sq.GtOrEq{"CreateAt": since}).
+		Where(sq.Lt{"CreateAt": until}).
+		Where(sq.NotLike{"Type": model.PostSystemMessagePrefix + "%"}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_activity_count_tosql")
+	}
+
+	if err := s.GetReplicaX().Get(&activity.PostCount, countQuery, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to count posts for channelId=%s", channelID)
+	}
+
+	if activity.PostCount == 0 {
+		return activity, nil
+	}
+
+	threadsQuery, args, err := s.getQueryBuilder().
+		Select("p.Id AS PostId", "p.UserId", "p.Message", "p.CreateAt", "COUNT(r.Id) AS ReplyCount").
+		From("Posts p").
+		Join("Posts r ON r.RootId = p.Id").
+		Where(sq.Eq{"p.ChannelId": channelID, "p.DeleteAt": 0, "r.DeleteAt": 0}).
+		Where(sq.GtOrEq{"r.CreateAt": since}).
+		Where(sq.Lt{"r.CreateAt": until}).
+		GroupBy("p.Id", "p.UserId", "p.Message", "p.CreateAt").
+		OrderBy("ReplyCount DESC", "p.CreateAt DESC").
+		Limit(model.ChannelDigestMaxTopThreads).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_activity_threads_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&activity.TopThreads, threadsQuery, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get top threads for channelId=%s", channelID)
+	}
+
+	// A mention counts as answered once the user has posted in the same thread after it.
+	mention := "%@" + sanitizeSearchTerm(username, "*") + "%"
+	mentionsQuery, args, err := s.getQueryBuilder().
+		Select("p.Id AS PostId", "p.UserId", "p.Message", "p.CreateAt").
+		From("Posts p").
+		Where(sq.Eq{"p.ChannelId": channelID, "p.DeleteAt": 0}).
+		Where(sq.NotEq{"p.UserId": userID}).
+		Where(sq.GtOrEq{"p.CreateAt": since}).
+		Where(sq.Lt{"p.CreateAt": until}).
+		Where("p.Message LIKE ? ESCAPE '*'", mention).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM Posts a
+			WHERE a.UserId = ?
+				AND a.DeleteAt = 0
+				AND a.CreateAt > p.CreateAt
+				AND (a.RootId = p.Id OR (p.RootId != '' AND a.RootId = p.RootId))
+		)`, userID)).
+		OrderBy("p.CreateAt DESC").
+		Limit(model.ChannelDigestMaxMentions).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_digest_activity_mentions_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&activity.UnansweredMentions, mentionsQuery, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get unanswered mentions for channelId=%s", channelID)
+	}
+
+	return activity, nil
+}
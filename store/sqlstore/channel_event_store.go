@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlChannelEventStore struct {
+	*SqlStore
+}
+
+func newSqlChannelEventStore(sqlStore *SqlStore) store.ChannelEventStore {
+	return &SqlChannelEventStore{sqlStore}
+}
+
+var channelEventColumns = []string{"Id", "ChannelId", "UserId", "Type", "OldValue", "NewValue", "CreateAt", "RevertedAt", "RevertedBy"}
+
+// Save records a channel event. The id of the event may be set beforehand, for it to be
+// referenced before the event is saved.
+func (s SqlChannelEventStore) Save(event *model.ChannelEvent) (*model.ChannelEvent, error) {
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ChannelEvents").
+		Columns(channelEventColumns...).
+		Values(event.Id, event.ChannelId, event.UserId, event.Type, event.OldValue, event.NewValue, event.CreateAt, event.RevertedAt, event.RevertedBy).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_event_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelEvent with id=%s", event.Id)
+	}
+
+	return event, nil
+}
+
+func (s SqlChannelEventStore) Get(id string) (*model.ChannelEvent, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelEventColumns...).
+		From("ChannelEvents").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_event_get_tosql")
+	}
+
+	var event model.ChannelEvent
+	if err := s.GetMasterX().Get(&event, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelEvent", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelEvent with id=%s", id)
+	}
+
+	return &event, nil
+}
+
+// GetForChannel returns a page of the events of the given channel, newest first.
+func (s SqlChannelEventStore) GetForChannel(channelID string, offset, limit int) ([]*model.ChannelEvent, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelEventColumns...).
+		From("ChannelEvents").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_event_get_for_channel_tosql")
+	}
+
+	events := []*model.ChannelEvent{}
+	if err := s.GetReplicaX().Select(&events, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelEvents with channelId=%s", channelID)
+	}
+
+	return events, nil
+}
+
+// MarkReverted records that the event was reverted by the given user. It returns false,
+// without marking anything, when the event was already reverted, so that it's reverted once
+// even by concurrent requests.
+func (s SqlChannelEventStore) MarkReverted(id, userID string, revertedAt int64) (bool, error) {
+	query, args, err := s.getQueryBuilder().
+		Update("ChannelEvents").
+		Set("RevertedAt", revertedAt).
+		Set("RevertedBy", userID).
+		Where(sq.Eq{"Id": id, "RevertedAt": 0}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "channel_event_mark_reverted_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to mark ChannelEvent with id=%s as reverted", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected for reverted ChannelEvent")
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlChannelFeedStore struct {
+	*SqlStore
+}
+
+func newSqlChannelFeedStore(sqlStore *SqlStore) store.ChannelFeedStore {
+	return &SqlChannelFeedStore{sqlStore}
+}
+
+var channelFeedColumns = []string{
+	"Id",
+	"ChannelId",
+	"TeamId",
+	"CreatorId",
+	"URL",
+	"Template",
+	"PollIntervalMinutes",
+	"LastPolledAt",
+	"NextPollAt",
+	"LastError",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlChannelFeedStore) Save(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+	if feed.Id != "" {
+		return nil, store.NewErrInvalidInput("ChannelFeed", "id", feed.Id)
+	}
+
+	feed.PreSave()
+	if err := feed.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ChannelFeeds").
+		Columns(channelFeedColumns...).
+		Values(
+			feed.Id,
+			feed.ChannelId,
+			feed.TeamId,
+			feed.CreatorId,
+			feed.URL,
+			feed.Template,
+			feed.PollIntervalMinutes,
+			feed.LastPolledAt,
+			feed.NextPollAt,
+			feed.LastError,
+			feed.CreateAt,
+			feed.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelFeed with id=%s", feed.Id)
+	}
+
+	return feed, nil
+}
+
+func (s SqlChannelFeedStore) Update(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+	feed.PreUpdate()
+	if err := feed.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("ChannelFeeds").
+		SetMap(map[string]interface{}{
+			"URL":                 feed.URL,
+			"Template":            feed.Template,
+			"PollIntervalMinutes": feed.PollIntervalMinutes,
+			"NextPollAt":          feed.NextPollAt,
+			"UpdateAt":            feed.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": feed.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ChannelFeed with id=%s", feed.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated ChannelFeed")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("ChannelFeed", feed.Id)
+	}
+
+	return feed, nil
+}
+
+// UpdatePollResult records the outcome of the last poll of the feed.
+func (s SqlChannelFeedStore) UpdatePollResult(feed *model.ChannelFeed) error {
+	query, args, err := s.getQueryBuilder().
+		Update("ChannelFeeds").
+		SetMap(map[string]interface{}{
+			"LastPolledAt": feed.LastPolledAt,
+			"NextPollAt":   feed.NextPollAt,
+			"LastError":    feed.LastError,
+		}).
+		Where(sq.Eq{"Id": feed.Id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_feed_updatepollresult_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update ChannelFeed with id=%s", feed.Id)
+	}
+
+	return nil
+}
+
+func (s SqlChannelFeedStore) Get(id string) (*model.ChannelFeed, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelFeedColumns...).
+		From("ChannelFeeds").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_get_tosql")
+	}
+
+	var feed model.ChannelFeed
+	if err := s.GetReplicaX().Get(&feed, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelFeed", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelFeed with id=%s", id)
+	}
+
+	return &feed, nil
+}
+
+// GetForChannel returns the feeds the channel subscribes to, oldest first.
+func (s SqlChannelFeedStore) GetForChannel(channelID string) ([]*model.ChannelFeed, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelFeedColumns...).
+		From("ChannelFeeds").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy("CreateAt", "Id").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_getforchannel_tosql")
+	}
+
+	feeds := []*model.ChannelFeed{}
+	if err := s.GetReplicaX().Select(&feeds, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelFeeds for channelId=%s", channelID)
+	}
+
+	return feeds, nil
+}
+
+// GetDue returns up to limit feeds due to be polled at now, the longest overdue first.
+func (s SqlChannelFeedStore) GetDue(now int64, limit int) ([]*model.ChannelFeed, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(channelFeedColumns...).
+		From("ChannelFeeds").
+		Where(sq.LtOrEq{"NextPollAt": now}).
+		OrderBy("NextPollAt", "Id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_getdue_tosql")
+	}
+
+	feeds := []*model.ChannelFeed{}
+	if err := s.GetMasterX().Select(&feeds, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get due ChannelFeeds")
+	}
+
+	return feeds, nil
+}
+
+// Delete deletes the feed along with the items recorded as posted from it.
+func (s SqlChannelFeedStore) Delete(id string) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	result, err := transaction.Exec("DELETE FROM ChannelFeeds WHERE Id = ?", id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelFeed with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted ChannelFeed")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("ChannelFeed", id)
+	}
+
+	if _, err = transaction.Exec("DELETE FROM ChannelFeedItems WHERE FeedId = ?", id); err != nil {
+		return errors.Wrapf(err, "failed to delete ChannelFeedItems with feedId=%s", id)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// GetExistingItemGuids returns which of the given item GUIDs are recorded for the feed already.
+func (s SqlChannelFeedStore) GetExistingItemGuids(feedID string, guids []string) ([]string, error) {
+	existing := []string{}
+	if len(guids) == 0 {
+		return existing, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("ItemGuid").
+		From("ChannelFeedItems").
+		Where(sq.Eq{"FeedId": feedID, "ItemGuid": guids}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_feed_getexistingitemguids_tosql")
+	}
+
+	if err := s.GetMasterX().Select(&existing, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelFeedItems for feedId=%s", feedID)
+	}
+
+	return existing, nil
+}
+
+func (s SqlChannelFeedStore) SaveItems(items []*model.ChannelFeedItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("ChannelFeedItems").
+		Columns("FeedId", "ItemGuid", "PostId", "CreateAt")
+	for _, item := range items {
+		if item.CreateAt == 0 {
+			item.CreateAt = model.GetMillis()
+		}
+		builder = builder.Values(item.FeedId, item.ItemGuid, item.PostId, item.CreateAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "channel_feed_saveitems_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrap(err, "failed to save ChannelFeedItems")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// channelLanguageMaxLength is the length of the languages kept by the rollups. The language
+// of a post is usually an ISO 639-1 code, but integrations can set any value.
+const channelLanguageMaxLength = 16
+
+type SqlChannelLanguageStatsStore struct {
+	*SqlStore
+}
+
+func newSqlChannelLanguageStatsStore(sqlStore *SqlStore) store.ChannelLanguageStatsStore {
+	return &SqlChannelLanguageStatsStore{sqlStore}
+}
+
+// RollupDay counts the posts made in each language in every team channel during the day
+// starting at the given time, replacing the counts computed by a previous run. The posts
+// whose language wasn't detected are counted under an empty language.
+func (s SqlChannelLanguageStatsStore) RollupDay(day int64) error {
+	language := "COALESCE(SUBSTRING(" + jsonStringFieldExpr(s.DriverName(), "p.Props", model.PostPropsLanguage) +
+		", 1, " + strconv.Itoa(channelLanguageMaxLength) + "), '')"
+
+	txn, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(txn)
+
+	if err := s.rollupDayStats(txn, "ChannelLanguageDailyStats", day,
+		[]string{"ChannelId", "Day", "Language", "PostCount"},
+		s.teamPostsOfDay(day, "p.ChannelId", dayColumn(day), language, "COUNT(*)").GroupBy("p.ChannelId", language),
+	); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlChannelLanguageStatsStore) GetForChannel(channelID string, since, until int64) ([]*model.ChannelLanguagePostCount, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("Language", "SUM(PostCount) AS PostCount").
+		From("ChannelLanguageDailyStats").
+		Where(sq.Eq{"ChannelId": channelID}).
+		Where(sq.GtOrEq{"Day": since}).
+		Where(sq.Lt{"Day": until}).
+		GroupBy("Language").
+		OrderBy("PostCount DESC", "Language").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_language_stats_tosql")
+	}
+
+	counts := []*model.ChannelLanguagePostCount{}
+	if err := s.GetReplicaX().Select(&counts, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the language stats of channelId=%s", channelID)
+	}
+
+	return counts, nil
+}
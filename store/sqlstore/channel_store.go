@@ -681,9 +681,9 @@ func (s SqlChannelStore) saveChannelT(transaction *sqlxTxWrapper, channel *model
 	}
 
 	if _, err := transaction.NamedExec(`INSERT INTO Channels
-		(Id, CreateAt, UpdateAt, DeleteAt, TeamId, Type, DisplayName, Name, Header, Purpose, LastPostAt, TotalMsgCount, ExtraUpdateAt, CreatorId, SchemeId, GroupConstrained, Shared, TotalMsgCountRoot, LastRootPostAt)
+		(Id, CreateAt, UpdateAt, DeleteAt, TeamId, Type, DisplayName, Name, Header, Purpose, LastPostAt, TotalMsgCount, ExtraUpdateAt, CreatorId, SchemeId, GroupConstrained, Shared, TotalMsgCountRoot, LastRootPostAt, DefaultLocale, SensitivityTag, DisableWebhookUsernameOverride, DisableWebhookIconOverride, ForceThreadedReplies, AutoFollowThreads)
 		VALUES
-		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :TeamId, :Type, :DisplayName, :Name, :Header, :Purpose, :LastPostAt, :TotalMsgCount, :ExtraUpdateAt, :CreatorId, :SchemeId, :GroupConstrained, :Shared, :TotalMsgCountRoot, :LastRootPostAt)`, channel); err != nil {
+		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :TeamId, :Type, :DisplayName, :Name, :Header, :Purpose, :LastPostAt, :TotalMsgCount, :ExtraUpdateAt, :CreatorId, :SchemeId, :GroupConstrained, :Shared, :TotalMsgCountRoot, :LastRootPostAt, :DefaultLocale, :SensitivityTag, :DisableWebhookUsernameOverride, :DisableWebhookIconOverride, :ForceThreadedReplies, :AutoFollowThreads)`, channel); err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "channels_name_teamid_key"}) {
 			dupChannel := model.Channel{}
 			s.GetMasterX().Get(&dupChannel, "SELECT * FROM Channels WHERE TeamId = ? AND Name = ?", channel.TeamId, channel.Name)
@@ -747,7 +747,13 @@ func (s SqlChannelStore) updateChannelT(transaction *sqlxTxWrapper, channel *mod
 			GroupConstrained=:GroupConstrained,
 			Shared=:Shared,
 			TotalMsgCountRoot=:TotalMsgCountRoot,
-			LastRootPostAt=:LastRootPostAt
+			LastRootPostAt=:LastRootPostAt,
+			DefaultLocale=:DefaultLocale,
+			SensitivityTag=:SensitivityTag,
+			DisableWebhookUsernameOverride=:DisableWebhookUsernameOverride,
+			DisableWebhookIconOverride=:DisableWebhookIconOverride,
+			ForceThreadedReplies=:ForceThreadedReplies,
+			AutoFollowThreads=:AutoFollowThreads
 		WHERE Id=:Id`, channel)
 	if err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "channels_name_teamid_key"}) {
@@ -1926,11 +1932,42 @@ func (s SqlChannelStore) UpdateMemberNotifyProps(channelID, userID string, props
 	return dbMember.ToModel(), err
 }
 
-func (s SqlChannelStore) GetMembers(channelID string, offset, limit int) (model.ChannelMembers, error) {
-	sql, args, err := s.channelMembersForTeamWithSchemeSelectQuery.
+func (s SqlChannelStore) GetMembers(channelID string, offset, limit int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	query := s.channelMembersForTeamWithSchemeSelectQuery.
 		Where(sq.Eq{
-			"ChannelId": channelID,
-		}).
+			"ChannelMembers.ChannelId": channelID,
+		})
+
+	sort := ""
+	if opts != nil {
+		sort = opts.Sort
+	}
+
+	switch sort {
+	case model.ChannelMembersSortByLastViewedAt:
+		query = query.OrderBy("ChannelMembers.LastViewedAt DESC")
+	case model.ChannelMembersSortByLastPostAt:
+		// Members who never posted in the channel come last.
+		query = query.
+			LeftJoin(`(
+				SELECT UserId, MAX(CreateAt) AS LastPostAt
+				FROM Posts
+				WHERE ChannelId = ? AND DeleteAt = 0
+				GROUP BY UserId
+			) AS LastPosts ON LastPosts.UserId = ChannelMembers.UserId`, channelID).
+			OrderBy("COALESCE(LastPosts.LastPostAt, 0) DESC")
+	case model.ChannelMembersSortByUsername:
+		query = query.
+			InnerJoin("Users ON ChannelMembers.UserId = Users.Id").
+			OrderBy("Users.Username")
+	case model.ChannelMembersSortByAdmin:
+		query = query.
+			InnerJoin("Users ON ChannelMembers.UserId = Users.Id").
+			OrderBy("ChannelMembers.SchemeAdmin DESC", "Users.Username")
+	}
+
+	sql, args, err := query.
+		OrderBy("ChannelMembers.UserId").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
 		ToSql()
@@ -3650,6 +3687,37 @@ func (s SqlChannelStore) GetChannelsByScheme(schemeId string, offset int, limit
 	return channels, nil
 }
 
+func (s SqlChannelStore) GetChannelsWithDeactivatedCreator(teamId string, offset int, limit int) (model.ChannelList, error) {
+	query := s.getQueryBuilder().
+		Select("Channels.*").
+		From("Channels").
+		Join("Users ON Users.Id = Channels.CreatorId").
+		Where(sq.And{
+			sq.NotEq{"Users.DeleteAt": 0},
+			sq.Eq{"Channels.DeleteAt": 0},
+			sq.Eq{"Channels.Type": []string{string(model.ChannelTypeOpen), string(model.ChannelTypePrivate)}},
+		}).
+		OrderBy("Channels.DisplayName", "Channels.Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if teamId != "" {
+		query = query.Where(sq.Eq{"Channels.TeamId": teamId})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channels_with_deactivated_creator_tosql")
+	}
+
+	channels := model.ChannelList{}
+	if err := s.GetReplicaX().Select(&channels, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Channels with a deactivated creator with teamId=%s", teamId)
+	}
+
+	return channels, nil
+}
+
 // This function does the Advanced Permissions Phase 2 migration for ChannelMember objects. It performs the migration
 // in batches as a single transaction per batch to ensure consistency but to also minimise execution time to avoid
 // causing unnecessary table locks. **THIS FUNCTION SHOULD NOT BE USED FOR ANY OTHER PURPOSE.** Executing this function
@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlConfigHistoryStore struct {
+	*SqlStore
+}
+
+func newSqlConfigHistoryStore(sqlStore *SqlStore) store.ConfigHistoryStore {
+	return &SqlConfigHistoryStore{sqlStore}
+}
+
+func (s SqlConfigHistoryStore) Save(version *model.ConfigVersion) (*model.ConfigVersion, error) {
+	if version.Id != "" {
+		return nil, store.NewErrInvalidInput("ConfigVersion", "id", version.Id)
+	}
+
+	version.PreSave()
+	if err := version.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ConfigHistory").
+		Columns("Id", "Version", "UserId", "Value", "CreateAt").
+		Values(version.Id, version.Version, version.UserId, version.Value, version.CreateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "config_history_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ConfigVersion with id=%s", version.Id)
+	}
+
+	return version, nil
+}
+
+// GetByVersion returns the latest record of the given version of the configuration.
+func (s SqlConfigHistoryStore) GetByVersion(version string) (*model.ConfigVersion, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("Id", "Version", "UserId", "Value", "CreateAt").
+		From("ConfigHistory").
+		Where(sq.Eq{"Version": version}).
+		OrderBy("CreateAt DESC", "Id").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "config_history_get_by_version_tosql")
+	}
+
+	var configVersion model.ConfigVersion
+	if err := s.GetMasterX().Get(&configVersion, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ConfigVersion", version)
+		}
+		return nil, errors.Wrapf(err, "failed to get ConfigVersion with version=%s", version)
+	}
+
+	return &configVersion, nil
+}
+
+// GetAll returns a page of the config history, newest first. The configurations themselves
+// are left out.
+func (s SqlConfigHistoryStore) GetAll(offset, limit int) ([]*model.ConfigVersion, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("Id", "Version", "UserId", "CreateAt").
+		From("ConfigHistory").
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "config_history_get_all_tosql")
+	}
+
+	versions := []*model.ConfigVersion{}
+	if err := s.GetReplicaX().Select(&versions, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get ConfigHistory")
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlCustomStatusTemplateStore struct {
+	*SqlStore
+}
+
+func newSqlCustomStatusTemplateStore(sqlStore *SqlStore) store.CustomStatusTemplateStore {
+	return &SqlCustomStatusTemplateStore{sqlStore}
+}
+
+var customStatusTemplateColumns = []string{
+	"Id",
+	"TeamId",
+	"OverrideId",
+	"CreatorId",
+	"Emoji",
+	"Text",
+	"Duration",
+	"UseCount",
+	"LastUsedAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlCustomStatusTemplateStore) Save(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+	if template.Id != "" {
+		return nil, store.NewErrInvalidInput("CustomStatusTemplate", "id", template.Id)
+	}
+
+	template.PreSave()
+	if err := template.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("CustomStatusTemplates").
+		Columns(customStatusTemplateColumns...).
+		Values(
+			template.Id,
+			template.TeamId,
+			template.OverrideId,
+			template.CreatorId,
+			template.Emoji,
+			template.Text,
+			template.Duration,
+			template.UseCount,
+			template.LastUsedAt,
+			template.CreateAt,
+			template.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "custom_status_template_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save CustomStatusTemplate with id=%s", template.Id)
+	}
+
+	return template, nil
+}
+
+func (s SqlCustomStatusTemplateStore) Update(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+	template.PreUpdate()
+	if err := template.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("CustomStatusTemplates").
+		SetMap(map[string]interface{}{
+			"Emoji":    template.Emoji,
+			"Text":     template.Text,
+			"Duration": template.Duration,
+			"UpdateAt": template.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": template.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "custom_status_template_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update CustomStatusTemplate with id=%s", template.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated CustomStatusTemplate")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("CustomStatusTemplate", template.Id)
+	}
+
+	return template, nil
+}
+
+func (s SqlCustomStatusTemplateStore) Get(id string) (*model.CustomStatusTemplate, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(customStatusTemplateColumns...).
+		From("CustomStatusTemplates").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "custom_status_template_get_tosql")
+	}
+
+	var template model.CustomStatusTemplate
+	if err := s.GetReplicaX().Get(&template, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("CustomStatusTemplate", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get CustomStatusTemplate with id=%s", id)
+	}
+
+	return &template, nil
+}
+
+// GetForTeam returns the templates of the team, or the organization-wide ones when teamID is
+// empty, oldest first.
+func (s SqlCustomStatusTemplateStore) GetForTeam(teamID string) ([]*model.CustomStatusTemplate, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(customStatusTemplateColumns...).
+		From("CustomStatusTemplates").
+		Where(sq.Eq{"TeamId": teamID}).
+		OrderBy("CreateAt", "Id").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "custom_status_template_getforteam_tosql")
+	}
+
+	templates := []*model.CustomStatusTemplate{}
+	if err := s.GetReplicaX().Select(&templates, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get CustomStatusTemplates for teamId=%s", teamID)
+	}
+
+	return templates, nil
+}
+
+// GetMostUsed returns the templates of the organization and of every team, the most used first.
+func (s SqlCustomStatusTemplateStore) GetMostUsed() ([]*model.CustomStatusTemplate, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(customStatusTemplateColumns...).
+		From("CustomStatusTemplates").
+		OrderBy("UseCount DESC", "LastUsedAt DESC", "Id").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "custom_status_template_getmostused_tosql")
+	}
+
+	templates := []*model.CustomStatusTemplate{}
+	if err := s.GetReplicaX().Select(&templates, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get the most used CustomStatusTemplates")
+	}
+
+	return templates, nil
+}
+
+// IncrementUseCount counts one more use of the organization-wide templates, and of the
+// templates of the given teams, with the given emoji and text.
+func (s SqlCustomStatusTemplateStore) IncrementUseCount(teamIDs []string, emoji, text string, usedAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("CustomStatusTemplates").
+		Set("UseCount", sq.Expr("UseCount + 1")).
+		Set("LastUsedAt", usedAt).
+		Where(sq.Eq{"TeamId": append([]string{""}, teamIDs...)}).
+		Where(sq.Eq{"Emoji": emoji, "Text": text}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "custom_status_template_incrementusecount_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrap(err, "failed to increment use count of CustomStatusTemplates")
+	}
+
+	return nil
+}
+
+// Delete deletes the template along with the team templates overriding it.
+func (s SqlCustomStatusTemplateStore) Delete(id string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("CustomStatusTemplates").
+		Where(sq.Or{sq.Eq{"Id": id}, sq.Eq{"OverrideId": id}}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "custom_status_template_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete CustomStatusTemplate with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted CustomStatusTemplate")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("CustomStatusTemplate", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlDirectChannelRetentionStore struct {
+	*SqlStore
+}
+
+func newSqlDirectChannelRetentionStore(sqlStore *SqlStore) store.DirectChannelRetentionStore {
+	return &SqlDirectChannelRetentionStore{sqlStore}
+}
+
+var directChannelRetentionColumns = []string{"ChannelId", "RetentionDays", "ProposedBy", "ProposedAt", "AcceptedAt", "LastPurgeAt"}
+
+// Save creates or replaces the retention of the channel.
+func (s SqlDirectChannelRetentionStore) Save(retention *model.DirectChannelRetention) (*model.DirectChannelRetention, error) {
+	if err := retention.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("DirectChannelRetention").
+		Columns(directChannelRetentionColumns...).
+		Values(retention.ChannelId, retention.RetentionDays, retention.ProposedBy, retention.ProposedAt, retention.AcceptedAt, retention.LastPurgeAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE RetentionDays = ?, ProposedBy = ?, ProposedAt = ?, AcceptedAt = ?, LastPurgeAt = ?",
+			retention.RetentionDays, retention.ProposedBy, retention.ProposedAt, retention.AcceptedAt, retention.LastPurgeAt))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (channelid) DO UPDATE SET RetentionDays = ?, ProposedBy = ?, ProposedAt = ?, AcceptedAt = ?, LastPurgeAt = ?",
+			retention.RetentionDays, retention.ProposedBy, retention.ProposedAt, retention.AcceptedAt, retention.LastPurgeAt))
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "direct_channel_retention_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save DirectChannelRetention with channelId=%s", retention.ChannelId)
+	}
+
+	return retention, nil
+}
+
+func (s SqlDirectChannelRetentionStore) Get(channelID string) (*model.DirectChannelRetention, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(directChannelRetentionColumns...).
+		From("DirectChannelRetention").
+		Where(sq.Eq{"ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "direct_channel_retention_get_tosql")
+	}
+
+	var retention model.DirectChannelRetention
+	if err := s.GetMasterX().Get(&retention, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("DirectChannelRetention", channelID)
+		}
+		return nil, errors.Wrapf(err, "failed to get DirectChannelRetention with channelId=%s", channelID)
+	}
+
+	return &retention, nil
+}
+
+func (s SqlDirectChannelRetentionStore) Delete(channelID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("DirectChannelRetention").
+		Where(sq.Eq{"ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "direct_channel_retention_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete DirectChannelRetention with channelId=%s", channelID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted DirectChannelRetention")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("DirectChannelRetention", channelID)
+	}
+
+	return nil
+}
+
+// GetActive returns a page of the retentions both members consented to, ordered by channel id.
+func (s SqlDirectChannelRetentionStore) GetActive(afterChannelID string, limit int) ([]*model.DirectChannelRetention, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(directChannelRetentionColumns...).
+		From("DirectChannelRetention").
+		Where(sq.Gt{"AcceptedAt": 0}).
+		Where(sq.Gt{"ChannelId": afterChannelID}).
+		OrderBy("ChannelId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "direct_channel_retention_getactive_tosql")
+	}
+
+	retentions := []*model.DirectChannelRetention{}
+	if err := s.GetReplicaX().Select(&retentions, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get active DirectChannelRetentions")
+	}
+
+	return retentions, nil
+}
+
+// UpdateLastPurgeAt records when the channel was last purged without touching the
+// agreement itself, which may have been changed by its members in the meantime.
+func (s SqlDirectChannelRetentionStore) UpdateLastPurgeAt(channelID string, purgeAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("DirectChannelRetention").
+		Set("LastPurgeAt", purgeAt).
+		Where(sq.Eq{"ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "direct_channel_retention_updatelastpurgeat_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update LastPurgeAt of DirectChannelRetention with channelId=%s", channelID)
+	}
+
+	return nil
+}
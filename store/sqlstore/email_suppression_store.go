@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlEmailSuppressionStore struct {
+	*SqlStore
+}
+
+func newSqlEmailSuppressionStore(sqlStore *SqlStore) store.EmailSuppressionStore {
+	return &SqlEmailSuppressionStore{sqlStore}
+}
+
+var emailSuppressionColumns = []string{
+	"Email",
+	"Reason",
+	"Provider",
+	"Details",
+	"CreateAt",
+}
+
+// Save adds the address to the suppression list, replacing the reason it was suppressed for
+// if it already was.
+func (s SqlEmailSuppressionStore) Save(suppression *model.EmailSuppression) (*model.EmailSuppression, error) {
+	suppression.PreSave()
+	if err := suppression.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("EmailSuppressions").
+		Columns(emailSuppressionColumns...).
+		Values(
+			suppression.Email,
+			suppression.Reason,
+			suppression.Provider,
+			suppression.Details,
+			suppression.CreateAt,
+		)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Reason = VALUES(Reason), Provider = VALUES(Provider), Details = VALUES(Details), CreateAt = VALUES(CreateAt)")
+	} else {
+		query = query.Suffix("ON CONFLICT (email) DO UPDATE SET reason = EXCLUDED.reason, provider = EXCLUDED.provider, details = EXCLUDED.details, createat = EXCLUDED.createat")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "email_suppression_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save EmailSuppression with email=%s", suppression.Email)
+	}
+
+	return suppression, nil
+}
+
+func (s SqlEmailSuppressionStore) Get(email string) (*model.EmailSuppression, error) {
+	email = model.NormalizeEmail(email)
+
+	query, args, err := s.getQueryBuilder().
+		Select(emailSuppressionColumns...).
+		From("EmailSuppressions").
+		Where(sq.Eq{"Email": email}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "email_suppression_get_tosql")
+	}
+
+	var suppression model.EmailSuppression
+	if err := s.GetReplicaX().Get(&suppression, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("EmailSuppression", email)
+		}
+		return nil, errors.Wrapf(err, "failed to get EmailSuppression with email=%s", email)
+	}
+
+	return &suppression, nil
+}
+
+// GetAll returns a page of the suppressed addresses, most recently suppressed first.
+func (s SqlEmailSuppressionStore) GetAll(offset, limit int) ([]*model.EmailSuppression, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(emailSuppressionColumns...).
+		From("EmailSuppressions").
+		OrderBy("CreateAt DESC", "Email").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "email_suppression_getall_tosql")
+	}
+
+	suppressions := []*model.EmailSuppression{}
+	if err := s.GetReplicaX().Select(&suppressions, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get EmailSuppressions")
+	}
+
+	return suppressions, nil
+}
+
+// GetForEmails returns the suppressions of the given addresses, skipping the ones that are
+// not suppressed.
+func (s SqlEmailSuppressionStore) GetForEmails(emails []string) ([]*model.EmailSuppression, error) {
+	suppressions := []*model.EmailSuppression{}
+	if len(emails) == 0 {
+		return suppressions, nil
+	}
+
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		normalized = append(normalized, model.NormalizeEmail(email))
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select(emailSuppressionColumns...).
+		From("EmailSuppressions").
+		Where(sq.Eq{"Email": normalized}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "email_suppression_getforemails_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&suppressions, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get EmailSuppressions for emails")
+	}
+
+	return suppressions, nil
+}
+
+func (s SqlEmailSuppressionStore) Delete(email string) error {
+	email = model.NormalizeEmail(email)
+
+	query, args, err := s.getQueryBuilder().
+		Delete("EmailSuppressions").
+		Where(sq.Eq{"Email": email}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "email_suppression_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete EmailSuppression with email=%s", email)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted EmailSuppression")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("EmailSuppression", email)
+	}
+
+	return nil
+}
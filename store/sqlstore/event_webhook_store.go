@@ -0,0 +1,393 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlEventWebhookStore struct {
+	*SqlStore
+}
+
+func newSqlEventWebhookStore(sqlStore *SqlStore) store.EventWebhookStore {
+	return &SqlEventWebhookStore{sqlStore}
+}
+
+var eventWebhookColumns = []string{
+	"Id",
+	"CreatorId",
+	"DisplayName",
+	"Description",
+	"URL",
+	"Secret",
+	"Events",
+	"CreateAt",
+	"UpdateAt",
+}
+
+var eventWebhookDeliveryColumns = []string{
+	"Id",
+	"WebhookId",
+	"Event",
+	"Payload",
+	"Status",
+	"Attempts",
+	"ResponseCode",
+	"Error",
+	"NextAttemptAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlEventWebhookStore) Save(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+	if webhook.Id != "" {
+		return nil, store.NewErrInvalidInput("EventWebhook", "id", webhook.Id)
+	}
+
+	webhook.PreSave()
+	if err := webhook.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("EventWebhooks").
+		Columns(eventWebhookColumns...).
+		Values(
+			webhook.Id,
+			webhook.CreatorId,
+			webhook.DisplayName,
+			webhook.Description,
+			webhook.URL,
+			webhook.Secret,
+			webhook.Events,
+			webhook.CreateAt,
+			webhook.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save EventWebhook with id=%s", webhook.Id)
+	}
+
+	return webhook, nil
+}
+
+func (s SqlEventWebhookStore) Get(id string) (*model.EventWebhook, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(eventWebhookColumns...).
+		From("EventWebhooks").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_get_tosql")
+	}
+
+	var webhook model.EventWebhook
+	if err := s.GetReplicaX().Get(&webhook, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("EventWebhook", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get EventWebhook with id=%s", id)
+	}
+
+	return &webhook, nil
+}
+
+// GetAll returns a page of the event webhooks, oldest first.
+func (s SqlEventWebhookStore) GetAll(offset, limit int) ([]*model.EventWebhook, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(eventWebhookColumns...).
+		From("EventWebhooks").
+		OrderBy("CreateAt", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_get_all_tosql")
+	}
+
+	webhooks := []*model.EventWebhook{}
+	if err := s.GetReplicaX().Select(&webhooks, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get EventWebhooks")
+	}
+
+	return webhooks, nil
+}
+
+// GetForEvent returns the event webhooks subscribed to the event.
+func (s SqlEventWebhookStore) GetForEvent(event string) ([]*model.EventWebhook, error) {
+	// The events are stored as a JSON array of strings, none of which contains a quote.
+	query, args, err := s.getQueryBuilder().
+		Select(eventWebhookColumns...).
+		From("EventWebhooks").
+		Where(sq.Like{"Events": "%\"" + event + "\"%"}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_get_for_event_tosql")
+	}
+
+	webhooks := []*model.EventWebhook{}
+	if err := s.GetReplicaX().Select(&webhooks, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get EventWebhooks with event=%s", event)
+	}
+
+	// Guards against an event matching part of another one.
+	subscribed := webhooks[:0]
+	for _, webhook := range webhooks {
+		if webhook.IsSubscribedTo(event) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+
+	return subscribed, nil
+}
+
+// Update saves the endpoint and subscriptions of the event webhook, along with its secret.
+func (s SqlEventWebhookStore) Update(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+	webhook.PreUpdate()
+	if err := webhook.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("EventWebhooks").
+		SetMap(map[string]interface{}{
+			"DisplayName": webhook.DisplayName,
+			"Description": webhook.Description,
+			"URL":         webhook.URL,
+			"Secret":      webhook.Secret,
+			"Events":      webhook.Events,
+			"UpdateAt":    webhook.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": webhook.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update EventWebhook with id=%s", webhook.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated EventWebhook")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("EventWebhook", webhook.Id)
+	}
+
+	return webhook, nil
+}
+
+// Delete deletes the event webhook along with its deliveries.
+func (s SqlEventWebhookStore) Delete(id string) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	result, err := transaction.Exec("DELETE FROM EventWebhooks WHERE Id = ?", id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete EventWebhook with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted EventWebhook")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("EventWebhook", id)
+	}
+
+	if _, err := transaction.Exec("DELETE FROM EventWebhookDeliveries WHERE WebhookId = ?", id); err != nil {
+		return errors.Wrapf(err, "failed to delete EventWebhookDeliveries with webhookId=%s", id)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlEventWebhookStore) SaveDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
+	if delivery.Id != "" {
+		return nil, store.NewErrInvalidInput("EventWebhookDelivery", "id", delivery.Id)
+	}
+
+	delivery.PreSave()
+
+	query, args, err := s.getQueryBuilder().
+		Insert("EventWebhookDeliveries").
+		Columns(eventWebhookDeliveryColumns...).
+		Values(
+			delivery.Id,
+			delivery.WebhookId,
+			delivery.Event,
+			delivery.Payload,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.ResponseCode,
+			delivery.Error,
+			delivery.NextAttemptAt,
+			delivery.CreateAt,
+			delivery.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_delivery_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save EventWebhookDelivery with id=%s", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+// GetDeliveries returns a page of the deliveries of the event webhook, newest first.
+func (s SqlEventWebhookStore) GetDeliveries(webhookID string, offset, limit int) ([]*model.EventWebhookDelivery, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(eventWebhookDeliveryColumns...).
+		From("EventWebhookDeliveries").
+		Where(sq.Eq{"WebhookId": webhookID}).
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_get_deliveries_tosql")
+	}
+
+	deliveries := []*model.EventWebhookDelivery{}
+	if err := s.GetReplicaX().Select(&deliveries, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get EventWebhookDeliveries with webhookId=%s", webhookID)
+	}
+
+	return deliveries, nil
+}
+
+// GetDueDeliveries returns the pending deliveries whose next attempt is due at the given time,
+// most overdue first.
+func (s SqlEventWebhookStore) GetDueDeliveries(now int64, limit int) ([]*model.EventWebhookDelivery, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(eventWebhookDeliveryColumns...).
+		From("EventWebhookDeliveries").
+		Where(sq.Eq{"Status": model.EventWebhookDeliveryStatusPending}).
+		Where(sq.LtOrEq{"NextAttemptAt": now}).
+		OrderBy("NextAttemptAt", "Id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_get_due_deliveries_tosql")
+	}
+
+	deliveries := []*model.EventWebhookDelivery{}
+	if err := s.GetMasterX().Select(&deliveries, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get due EventWebhookDeliveries")
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDelivery postpones the next attempt of the delivery until leaseUntil, so that it's
+// attempted once even by concurrent jobs. It returns false, without claiming anything, when
+// the next attempt is no longer expectedNextAttemptAt because the delivery was claimed
+// elsewhere in the meantime.
+func (s SqlEventWebhookStore) ClaimDelivery(id string, expectedNextAttemptAt, leaseUntil int64) (bool, error) {
+	query, args, err := s.getQueryBuilder().
+		Update("EventWebhookDeliveries").
+		Set("NextAttemptAt", leaseUntil).
+		Where(sq.Eq{
+			"Id":            id,
+			"Status":        model.EventWebhookDeliveryStatusPending,
+			"NextAttemptAt": expectedNextAttemptAt,
+		}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "event_webhook_claim_delivery_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to claim EventWebhookDelivery with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected for claimed EventWebhookDelivery")
+	}
+
+	return count > 0, nil
+}
+
+// UpdateDelivery records the outcome of an attempt of the delivery.
+func (s SqlEventWebhookStore) UpdateDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
+	delivery.PreUpdate()
+
+	query, args, err := s.getQueryBuilder().
+		Update("EventWebhookDeliveries").
+		SetMap(map[string]interface{}{
+			"Status":        delivery.Status,
+			"Attempts":      delivery.Attempts,
+			"ResponseCode":  delivery.ResponseCode,
+			"Error":         delivery.Error,
+			"NextAttemptAt": delivery.NextAttemptAt,
+			"UpdateAt":      delivery.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": delivery.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "event_webhook_update_delivery_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update EventWebhookDelivery with id=%s", delivery.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated EventWebhookDelivery")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("EventWebhookDelivery", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s SqlEventWebhookStore) PermanentDeleteDeliveriesBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM EventWebhookDeliveries WHERE Id IN (SELECT Id FROM EventWebhookDeliveries WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM EventWebhookDeliveries WHERE CreateAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete EventWebhookDeliveries")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted EventWebhookDeliveries")
+	}
+
+	return rowsAffected, nil
+}
@@ -40,6 +40,8 @@ type fileInfoWithChannelID struct {
 	MiniPreview     *[]byte
 	Content         string
 	RemoteId        *string
+	ExternalURL     string
+	ContentHash     string
 }
 
 func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
@@ -64,6 +66,8 @@ func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
 		MiniPreview:     fi.MiniPreview,
 		Content:         fi.Content,
 		RemoteId:        fi.RemoteId,
+		ExternalURL:     fi.ExternalURL,
+		ContentHash:     fi.ContentHash,
 	}
 }
 
@@ -102,6 +106,8 @@ func newSqlFileInfoStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterfac
 		"FileInfo.MiniPreview",
 		"Coalesce(FileInfo.Content, '') AS Content",
 		"Coalesce(FileInfo.RemoteId, '') AS RemoteId",
+		"Coalesce(FileInfo.ExternalURL, '') AS ExternalURL",
+		"Coalesce(FileInfo.ContentHash, '') AS ContentHash",
 	}
 
 	return s
@@ -116,10 +122,10 @@ func (fs SqlFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 	query := `
 		INSERT INTO FileInfo
 		(Id, CreatorId, PostId, CreateAt, UpdateAt, DeleteAt, Path, ThumbnailPath, PreviewPath,
-			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId)
+			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId, ExternalURL, ContentHash)
 		VALUES
 		(:Id, :CreatorId, :PostId, :CreateAt, :UpdateAt, :DeleteAt, :Path, :ThumbnailPath, :PreviewPath,
-			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId)
+			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId, :ExternalURL, :ContentHash)
 	`
 
 	if _, err := fs.GetMasterX().NamedExec(query, info); err != nil {
@@ -180,6 +186,8 @@ func (fs SqlFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error)
 			"HasPreviewImage": info.HasPreviewImage,
 			"Content":         info.Content,
 			"RemoteId":        info.RemoteId,
+			"ExternalURL":     info.ExternalURL,
+			"ContentHash":     info.ContentHash,
 		}).
 		Where(sq.Eq{"Id": info.Id}).
 		ToSql()
@@ -363,6 +371,32 @@ func (fs SqlFileInfoStore) GetForPost(postId string, readFromMaster, includeDele
 	return infos, nil
 }
 
+// GetForPosts returns the non deleted file infos attached to any of the given posts, in
+// creation order.
+func (fs SqlFileInfoStore) GetForPosts(postIds []string) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+	if len(postIds) == 0 {
+		return infos, nil
+	}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Eq{"PostId": postIds}).
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("CreateAt")
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	if err := fs.GetReplicaX().Select(&infos, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find FileInfos for posts")
+	}
+	return infos, nil
+}
+
 func (fs SqlFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, error) {
 	infos := []*model.FileInfo{}
 
@@ -384,6 +418,105 @@ func (fs SqlFileInfoStore) GetForUser(userId string) ([]*model.FileInfo, error)
 	return infos, nil
 }
 
+func (fs SqlFileInfoStore) GetForTeamAfter(teamId string, afterCreateAt int64, afterId string, limit int) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		LeftJoin("Posts ON Posts.Id = FileInfo.PostId").
+		LeftJoin("Channels ON Channels.Id = Posts.ChannelId").
+		Where(sq.Or{
+			sq.Like{"FileInfo.Path": "%teams/" + teamId + "/%"},
+			sq.Eq{"Channels.TeamId": teamId},
+		}).
+		Where(sq.Or{
+			sq.Gt{"FileInfo.CreateAt": afterCreateAt},
+			sq.And{
+				sq.Eq{"FileInfo.CreateAt": afterCreateAt},
+				sq.Gt{"FileInfo.Id": afterId},
+			},
+		}).
+		OrderBy("FileInfo.CreateAt", "FileInfo.Id").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	if err := fs.GetReplicaX().Select(&infos, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find FileInfos with teamId=%s", teamId)
+	}
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) GetAfter(afterCreateAt int64, afterId string, limit int) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Or{
+			sq.Gt{"FileInfo.CreateAt": afterCreateAt},
+			sq.And{
+				sq.Eq{"FileInfo.CreateAt": afterCreateAt},
+				sq.Gt{"FileInfo.Id": afterId},
+			},
+		}).
+		OrderBy("FileInfo.CreateAt", "FileInfo.Id").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	if err := fs.GetReplicaX().Select(&infos, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find FileInfos")
+	}
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) GetByContentHash(hash string, size int64, limit int) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Eq{"FileInfo.ContentHash": hash, "FileInfo.Size": size}).
+		OrderBy("FileInfo.CreateAt", "FileInfo.Id").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	if err := fs.GetReplicaX().Select(&infos, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find FileInfos with contentHash=%s", hash)
+	}
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) CountByPath(path string) (int64, error) {
+	query := fs.getQueryBuilder().
+		Select("COUNT(*)").
+		From("FileInfo").
+		Where(sq.Eq{"Path": path})
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "file_info_tosql")
+	}
+
+	var count int64
+	if err := fs.GetMasterX().Get(&count, queryString, args...); err != nil {
+		return 0, errors.Wrapf(err, "failed to count FileInfos with path=%s", path)
+	}
+	return count, nil
+}
+
 func (fs SqlFileInfoStore) AttachToPost(fileId, postId, creatorId string) error {
 	query := fs.getQueryBuilder().
 		Update("FileInfo").
@@ -436,6 +569,25 @@ func (fs SqlFileInfoStore) SetContent(fileId, content string) error {
 	return nil
 }
 
+func (fs SqlFileInfoStore) SetContentHash(fileId, hash, path string) error {
+	query := fs.getQueryBuilder().
+		Update("FileInfo").
+		Set("ContentHash", hash).
+		Set("Path", path).
+		Where(sq.Eq{"Id": fileId})
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "file_info_tosql")
+	}
+
+	if _, err := fs.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to update FileInfo content hash with id=%s", fileId)
+	}
+
+	return nil
+}
+
 func (fs SqlFileInfoStore) DeleteForPost(postId string) (string, error) {
 	if _, err := fs.GetMasterX().Exec(
 		`UPDATE
@@ -449,6 +601,23 @@ func (fs SqlFileInfoStore) DeleteForPost(postId string) (string, error) {
 	return postId, nil
 }
 
+func (fs SqlFileInfoStore) RestoreForPosts(postIds []string) error {
+	query, args, err := fs.getQueryBuilder().
+		Update("FileInfo").
+		Set("DeleteAt", 0).
+		Where(sq.Eq{"PostId": postIds}).
+		Where(sq.Gt{"DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "restore_for_posts_tosql")
+	}
+
+	if _, err := fs.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrap(err, "failed to update FileInfo")
+	}
+	return nil
+}
+
 func (fs SqlFileInfoStore) PermanentDelete(fileId string) error {
 	if _, err := fs.GetMasterX().Exec(`DELETE FROM FileInfo WHERE Id = ?`, fileId); err != nil {
 		return errors.Wrapf(err, "failed to delete FileInfo with id=%s", fileId)
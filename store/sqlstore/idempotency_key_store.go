@@ -0,0 +1,181 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlIdempotencyKeyStore struct {
+	*SqlStore
+}
+
+func newSqlIdempotencyKeyStore(sqlStore *SqlStore) store.IdempotencyKeyStore {
+	return &SqlIdempotencyKeyStore{sqlStore}
+}
+
+var idempotencyKeyColumns = []string{
+	"UserId",
+	"IdempotencyKey",
+	"RequestHash",
+	"StatusCode",
+	"Response",
+	"CreateAt",
+}
+
+func (s SqlIdempotencyKeyStore) Save(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
+	key.PreSave()
+	if err := key.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("IdempotencyKeys").
+		Columns(idempotencyKeyColumns...).
+		Values(
+			key.UserId,
+			key.Key,
+			key.RequestHash,
+			key.StatusCode,
+			key.Response,
+			key.CreateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "idempotency_key_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "idempotencykeys_pkey"}) {
+			return nil, store.NewErrConflict("IdempotencyKey", err, "userId="+key.UserId)
+		}
+		return nil, errors.Wrapf(err, "failed to save IdempotencyKey with userId=%s", key.UserId)
+	}
+
+	return key, nil
+}
+
+func (s SqlIdempotencyKeyStore) Get(userID, key string) (*model.IdempotencyKey, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(idempotencyKeyColumns...).
+		From("IdempotencyKeys").
+		Where(sq.Eq{"UserId": userID, "IdempotencyKey": key}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "idempotency_key_get_tosql")
+	}
+
+	var idempotencyKey model.IdempotencyKey
+	// Read from the master, as the retry of a request may closely follow it.
+	if err := s.GetMasterX().Get(&idempotencyKey, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("IdempotencyKey", key)
+		}
+		return nil, errors.Wrapf(err, "failed to get IdempotencyKey with userId=%s", userID)
+	}
+
+	return &idempotencyKey, nil
+}
+
+func (s SqlIdempotencyKeyStore) Update(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
+	if err := key.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("IdempotencyKeys").
+		SetMap(map[string]interface{}{
+			"StatusCode": key.StatusCode,
+			"Response":   key.Response,
+		}).
+		Where(sq.Eq{"UserId": key.UserId, "IdempotencyKey": key.Key}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "idempotency_key_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update IdempotencyKey with userId=%s", key.UserId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated IdempotencyKey")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("IdempotencyKey", key.Key)
+	}
+
+	return key, nil
+}
+
+func (s SqlIdempotencyKeyStore) Delete(userID, key string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("IdempotencyKeys").
+		Where(sq.Eq{"UserId": userID, "IdempotencyKey": key}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "idempotency_key_delete_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete IdempotencyKey with userId=%s", userID)
+	}
+
+	return nil
+}
+
+// DeleteStaleReservation deletes the key if it was reserved before reservedBefore and its request
+// never completed, and tells whether it did. Only one of the concurrent callers can delete a
+// given reservation.
+func (s SqlIdempotencyKeyStore) DeleteStaleReservation(userID, key string, reservedBefore int64) (bool, error) {
+	query, args, err := s.getQueryBuilder().
+		Delete("IdempotencyKeys").
+		Where(sq.Eq{"UserId": userID, "IdempotencyKey": key, "StatusCode": 0}).
+		Where(sq.Lt{"CreateAt": reservedBefore}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "idempotency_key_delete_stale_reservation_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to delete stale IdempotencyKey reservation with userId=%s", userID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected for deleted IdempotencyKey reservation")
+	}
+
+	return count > 0, nil
+}
+
+func (s SqlIdempotencyKeyStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM IdempotencyKeys WHERE (UserId, IdempotencyKey) IN (SELECT UserId, IdempotencyKey FROM IdempotencyKeys WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM IdempotencyKeys WHERE CreateAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete IdempotencyKeys")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted IdempotencyKeys")
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlImpersonationStore struct {
+	*SqlStore
+}
+
+func newSqlImpersonationStore(sqlStore *SqlStore) store.ImpersonationStore {
+	return &SqlImpersonationStore{sqlStore}
+}
+
+var impersonationColumns = []string{
+	"Id",
+	"ImpersonatorId",
+	"UserId",
+	"Reason",
+	"DurationMinutes",
+	"RequireConsent",
+	"Status",
+	"SessionId",
+	"CreateAt",
+	"UpdateAt",
+	"StartAt",
+	"ExpiresAt",
+	"EndAt",
+}
+
+func (s SqlImpersonationStore) Save(impersonation *model.Impersonation) (*model.Impersonation, error) {
+	if impersonation.Id != "" {
+		return nil, store.NewErrInvalidInput("Impersonation", "id", impersonation.Id)
+	}
+
+	impersonation.PreSave()
+	if err := impersonation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("Impersonations").
+		Columns(impersonationColumns...).
+		Values(
+			impersonation.Id,
+			impersonation.ImpersonatorId,
+			impersonation.UserId,
+			impersonation.Reason,
+			impersonation.DurationMinutes,
+			impersonation.RequireConsent,
+			impersonation.Status,
+			impersonation.SessionId,
+			impersonation.CreateAt,
+			impersonation.UpdateAt,
+			impersonation.StartAt,
+			impersonation.ExpiresAt,
+			impersonation.EndAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "impersonation_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save Impersonation with id=%s", impersonation.Id)
+	}
+
+	return impersonation, nil
+}
+
+func (s SqlImpersonationStore) Get(id string) (*model.Impersonation, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(impersonationColumns...).
+		From("Impersonations").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "impersonation_get_tosql")
+	}
+
+	var impersonation model.Impersonation
+	if err := s.GetMasterX().Get(&impersonation, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Impersonation", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get Impersonation with id=%s", id)
+	}
+
+	return &impersonation, nil
+}
+
+// GetForUser returns a page of the impersonations of the user, newest first.
+func (s SqlImpersonationStore) GetForUser(userID string, offset, limit int) ([]*model.Impersonation, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(impersonationColumns...).
+		From("Impersonations").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "impersonation_get_for_user_tosql")
+	}
+
+	impersonations := []*model.Impersonation{}
+	if err := s.GetReplicaX().Select(&impersonations, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Impersonations with userId=%s", userID)
+	}
+
+	return impersonations, nil
+}
+
+// UpdateStatus records a change of the status of the impersonation, along with its session and
+// times. A conflict is returned when the status is no longer currentStatus, so that the consent
+// of the user, the start and the end of the impersonation can't race with each other.
+func (s SqlImpersonationStore) UpdateStatus(impersonation *model.Impersonation, currentStatus string) (*model.Impersonation, error) {
+	impersonation.PreUpdate()
+	if err := impersonation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("Impersonations").
+		SetMap(map[string]interface{}{
+			"Status":    impersonation.Status,
+			"SessionId": impersonation.SessionId,
+			"UpdateAt":  impersonation.UpdateAt,
+			"StartAt":   impersonation.StartAt,
+			"ExpiresAt": impersonation.ExpiresAt,
+			"EndAt":     impersonation.EndAt,
+		}).
+		Where(sq.Eq{"Id": impersonation.Id, "Status": currentStatus}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "impersonation_update_status_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update Impersonation with id=%s", impersonation.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated Impersonation")
+	}
+	if count == 0 {
+		if _, err := s.Get(impersonation.Id); err != nil {
+			return nil, err
+		}
+		return nil, store.NewErrConflict("Impersonation", nil, "id="+impersonation.Id)
+	}
+
+	return impersonation, nil
+}
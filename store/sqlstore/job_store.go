@@ -295,6 +295,26 @@ func (jss SqlJobStore) GetCountByStatusAndType(status string, jobType string) (i
 	return count, nil
 }
 
+// GetCountByStatusSince counts the jobs of any type with the status that were last active at
+// or after since.
+func (jss SqlJobStore) GetCountByStatusSince(status string, since int64) (int64, error) {
+	query, args, err := jss.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Jobs").
+		Where(sq.Eq{"Status": status}).
+		Where(sq.GtOrEq{"LastActivityAt": since}).ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "job_tosql")
+	}
+
+	var count int64
+	err = jss.GetReplicaX().Get(&count, query, args...)
+	if err != nil {
+		return int64(0), errors.Wrapf(err, "failed to count Jobs with status=%s since=%d", status, since)
+	}
+	return count, nil
+}
+
 func (jss SqlJobStore) Delete(id string) (string, error) {
 	query, args, err := jss.getQueryBuilder().
 		Delete("Jobs").
@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+var licenseUsageColumns = []string{
+	"Month",
+	"MonthlyActiveUsers",
+	"PostCount",
+	"StorageBytes",
+	"IncomingWebhooks",
+	"OutgoingWebhooks",
+	"Commands",
+	"Bots",
+	"OAuthApps",
+	"UpdateAt",
+}
+
+type SqlLicenseUsageStore struct {
+	*SqlStore
+}
+
+func newSqlLicenseUsageStore(sqlStore *SqlStore) store.LicenseUsageStore {
+	return &SqlLicenseUsageStore{sqlStore}
+}
+
+// RollupMonth computes the usage of the month starting at the given time, replacing the usage
+// computed by a previous run. The active users are the ones last seen since the start of the
+// month, so the month is expected to be the current one or to have just ended.
+func (s SqlLicenseUsageStore) RollupMonth(month int64) (*model.LicenseUsage, error) {
+	nextMonth := model.AddLicenseUsageMonths(month, 1)
+	usage := &model.LicenseUsage{Month: month, UpdateAt: model.GetMillis()}
+
+	counts := []struct {
+		dest  *int64
+		query sq.SelectBuilder
+	}{
+		{&usage.MonthlyActiveUsers, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("Status s").
+			Join("Users u ON u.Id = s.UserId").
+			LeftJoin("Bots b ON b.UserId = s.UserId").
+			Where(sq.GtOrEq{"s.LastActivityAt": month}).
+			Where(sq.Or{sq.Eq{"u.DeleteAt": 0}, sq.GtOrEq{"u.DeleteAt": month}}).
+			Where(sq.Eq{"b.UserId": nil})},
+		{&usage.PostCount, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("Posts").
+			Where(sq.GtOrEq{"CreateAt": month}).
+			Where(sq.Lt{"CreateAt": nextMonth}).
+			Where(sq.NotLike{"Type": model.PostSystemMessagePrefix + "%"})},
+		{&usage.StorageBytes, s.getQueryBuilder().
+			Select("COALESCE(SUM(Size), 0)").
+			From("FileInfo").
+			Where(sq.Eq{"DeleteAt": 0})},
+		{&usage.IncomingWebhooks, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("IncomingWebhooks").
+			Where(sq.Eq{"DeleteAt": 0})},
+		{&usage.OutgoingWebhooks, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("OutgoingWebhooks").
+			Where(sq.Eq{"DeleteAt": 0})},
+		{&usage.Commands, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("Commands").
+			Where(sq.Eq{"DeleteAt": 0})},
+		{&usage.Bots, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("Bots").
+			Where(sq.Eq{"DeleteAt": 0})},
+		{&usage.OAuthApps, s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("OAuthApps")},
+	}
+	for _, count := range counts {
+		query, args, err := count.query.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "license_usage_count_tosql")
+		}
+		if err := s.GetReplicaX().Get(count.dest, query, args...); err != nil {
+			return nil, errors.Wrapf(err, "failed to count the license usage of month=%d", month)
+		}
+	}
+
+	txn, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(txn)
+
+	query, args, err := s.getQueryBuilder().Delete("LicenseUsage").Where(sq.Eq{"Month": month}).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "license_usage_delete_tosql")
+	}
+	if _, err := txn.Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to delete the license usage of month=%d", month)
+	}
+
+	query, args, err = s.getQueryBuilder().
+		Insert("LicenseUsage").
+		Columns(licenseUsageColumns...).
+		Values(usage.Month, usage.MonthlyActiveUsers, usage.PostCount, usage.StorageBytes, usage.IncomingWebhooks,
+			usage.OutgoingWebhooks, usage.Commands, usage.Bots, usage.OAuthApps, usage.UpdateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "license_usage_insert_tosql")
+	}
+	if _, err := txn.Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save the license usage of month=%d", month)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return usage, nil
+}
+
+// GetForMonths returns the usage of the months rolled up between since and until, oldest first.
+func (s SqlLicenseUsageStore) GetForMonths(since, until int64) ([]*model.LicenseUsage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(licenseUsageColumns...).
+		From("LicenseUsage").
+		Where(sq.GtOrEq{"Month": since}).
+		Where(sq.Lt{"Month": until}).
+		OrderBy("Month").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "license_usage_tosql")
+	}
+
+	usage := []*model.LicenseUsage{}
+	if err := s.GetReplicaX().Select(&usage, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the license usage between since=%d and until=%d", since, until)
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlOAuthAppUsageStore struct {
+	*SqlStore
+}
+
+func newSqlOAuthAppUsageStore(sqlStore *SqlStore) store.OAuthAppUsageStore {
+	return &SqlOAuthAppUsageStore{sqlStore}
+}
+
+func (s SqlOAuthAppUsageStore) Increment(usages []*model.OAuthAppUsage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Insert("OAuthAppUsage").
+		Columns("AppId", "BucketAt", "Count", "LimitedCount")
+
+	for _, usage := range usages {
+		if err := usage.IsValid(); err != nil {
+			return err
+		}
+		query = query.Values(usage.AppId, usage.BucketAt, usage.Count, usage.LimitedCount)
+	}
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Count = Count + VALUES(Count), LimitedCount = LimitedCount + VALUES(LimitedCount)")
+	} else {
+		query = query.Suffix("ON CONFLICT (appid, bucketat) DO UPDATE SET Count = OAuthAppUsage.Count + EXCLUDED.Count, LimitedCount = OAuthAppUsage.LimitedCount + EXCLUDED.LimitedCount")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "oauth_app_usage_increment_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrap(err, "failed to increment OAuthAppUsage")
+	}
+
+	return nil
+}
+
+// GetSummaries returns the total number of calls per app since the given time, busiest first,
+// along with the name and rate limit of the apps not deleted since.
+func (s SqlOAuthAppUsageStore) GetSummaries(since int64, offset, limit int) ([]*model.OAuthAppUsageSummary, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select(
+			"OAuthAppUsage.AppId",
+			"COALESCE(OAuthApps.Name, '') AS Name",
+			"SUM(OAuthAppUsage.Count) AS Count",
+			"SUM(OAuthAppUsage.LimitedCount) AS LimitedCount",
+			"COALESCE(OAuthApps.RateLimitPerSec, 0) AS RateLimitPerSec",
+			"COALESCE(OAuthApps.RateLimitMaxBurst, 0) AS RateLimitMaxBurst",
+		).
+		From("OAuthAppUsage").
+		LeftJoin("OAuthApps ON OAuthApps.Id = OAuthAppUsage.AppId").
+		Where(sq.GtOrEq{"OAuthAppUsage.BucketAt": since}).
+		GroupBy("OAuthAppUsage.AppId", "OAuthApps.Name", "OAuthApps.RateLimitPerSec", "OAuthApps.RateLimitMaxBurst").
+		OrderBy("Count DESC", "OAuthAppUsage.AppId").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth_app_usage_get_summaries_tosql")
+	}
+
+	summaries := []*model.OAuthAppUsageSummary{}
+	if err := s.GetReplicaX().Select(&summaries, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get OAuthAppUsage summaries")
+	}
+
+	return summaries, nil
+}
+
+func (s SqlOAuthAppUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM OAuthAppUsage WHERE (AppId, BucketAt) IN (SELECT AppId, BucketAt FROM OAuthAppUsage WHERE BucketAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM OAuthAppUsage WHERE BucketAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete OAuthAppUsage")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted OAuthAppUsage")
+	}
+
+	return rowsAffected, nil
+}
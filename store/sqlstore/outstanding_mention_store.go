@@ -0,0 +1,207 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlOutstandingMentionStore struct {
+	*SqlStore
+}
+
+func newSqlOutstandingMentionStore(sqlStore *SqlStore) store.OutstandingMentionStore {
+	return &SqlOutstandingMentionStore{sqlStore}
+}
+
+var outstandingMentionColumns = []string{
+	"OutstandingMentions.UserId",
+	"OutstandingMentions.PostId",
+	"OutstandingMentions.ChannelId",
+	"OutstandingMentions.TeamId",
+	"OutstandingMentions.RootId",
+	"OutstandingMentions.MentionedAt",
+	"OutstandingMentions.ResolvedAt",
+}
+
+func (s SqlOutstandingMentionStore) Save(mentions []*model.OutstandingMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("OutstandingMentions").
+		Columns("UserId", "PostId", "ChannelId", "TeamId", "RootId", "MentionedAt", "ResolvedAt")
+	for _, mention := range mentions {
+		builder = builder.Values(mention.UserId, mention.PostId, mention.ChannelId, mention.TeamId, mention.RootId, mention.MentionedAt, mention.ResolvedAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "outstanding_mention_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrap(err, "failed to save OutstandingMentions")
+	}
+
+	return nil
+}
+
+// ResolveForThread resolves the mentions of the user in the thread of the root post, or in the
+// channel outside of any thread when rootID is empty, up to resolvedAt.
+func (s SqlOutstandingMentionStore) ResolveForThread(userID, channelID, rootID string, resolvedAt int64) error {
+	builder := s.getQueryBuilder().
+		Update("OutstandingMentions").
+		Set("ResolvedAt", resolvedAt).
+		Where(sq.Eq{"UserId": userID, "ChannelId": channelID, "ResolvedAt": 0}).
+		Where(sq.LtOrEq{"MentionedAt": resolvedAt})
+	if rootID == "" {
+		builder = builder.Where(sq.Eq{"RootId": ""})
+	} else {
+		builder = builder.Where(sq.Or{sq.Eq{"RootId": rootID}, sq.Eq{"PostId": rootID}})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "outstanding_mention_resolveforthread_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to resolve OutstandingMentions with userId=%s", userID)
+	}
+
+	return nil
+}
+
+func (s SqlOutstandingMentionStore) ResolveForPost(userID, postID string, resolvedAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("OutstandingMentions").
+		Set("ResolvedAt", resolvedAt).
+		Where(sq.Eq{"UserId": userID, "PostId": postID, "ResolvedAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "outstanding_mention_resolveforpost_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to resolve OutstandingMention with userId=%s and postId=%s", userID, postID)
+	}
+
+	return nil
+}
+
+// mentionsOfUser selects the mentions of the user in the posts not deleted, in the team when
+// teamID is set.
+func (s SqlOutstandingMentionStore) mentionsOfUser(builder sq.SelectBuilder, userID, teamID string) sq.SelectBuilder {
+	builder = builder.
+		From("OutstandingMentions").
+		Join("Posts ON Posts.Id = OutstandingMentions.PostId").
+		Where(sq.Eq{"OutstandingMentions.UserId": userID, "Posts.DeleteAt": 0})
+	if teamID != "" {
+		builder = builder.Where(sq.Eq{"OutstandingMentions.TeamId": teamID})
+	}
+	return builder
+}
+
+// GetOutstanding returns the mentions of the user not answered yet, the newest first.
+func (s SqlOutstandingMentionStore) GetOutstanding(userID, teamID string, offset, limit int) ([]*model.OutstandingMention, error) {
+	query, args, err := s.mentionsOfUser(s.getQueryBuilder().Select(outstandingMentionColumns...), userID, teamID).
+		Where(sq.Eq{"OutstandingMentions.ResolvedAt": 0}).
+		OrderBy("OutstandingMentions.MentionedAt DESC", "OutstandingMentions.PostId DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outstanding_mention_getoutstanding_tosql")
+	}
+
+	mentions := []*model.OutstandingMention{}
+	if err := s.GetReplicaX().Select(&mentions, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get OutstandingMentions with userId=%s", userID)
+	}
+
+	return mentions, nil
+}
+
+func (s SqlOutstandingMentionStore) GetStats(userID, teamID string) (*model.OutstandingMentionStats, error) {
+	query, args, err := s.mentionsOfUser(s.getQueryBuilder().Select(
+		"COALESCE(SUM(CASE WHEN OutstandingMentions.ResolvedAt = 0 THEN 1 ELSE 0 END), 0)",
+		"COALESCE(MIN(CASE WHEN OutstandingMentions.ResolvedAt = 0 THEN OutstandingMentions.MentionedAt END), 0)",
+		"COALESCE(SUM(CASE WHEN OutstandingMentions.ResolvedAt > 0 THEN 1 ELSE 0 END), 0)",
+		"COALESCE(AVG(CASE WHEN OutstandingMentions.ResolvedAt > 0 THEN OutstandingMentions.ResolvedAt - OutstandingMentions.MentionedAt END), 0)",
+	), userID, teamID).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outstanding_mention_getstats_tosql")
+	}
+
+	stats := &model.OutstandingMentionStats{}
+	var averageResponse float64
+	if err := s.GetReplicaX().QueryRowX(query, args...).Scan(&stats.OutstandingCount, &stats.OldestMentionedAt, &stats.ResolvedCount, &averageResponse); err != nil {
+		return nil, errors.Wrapf(err, "failed to get OutstandingMention stats with userId=%s", userID)
+	}
+	stats.AverageResponseMillis = int64(averageResponse)
+
+	return stats, nil
+}
+
+// GetOutstandingCounts returns how many mentions made before mentionedBefore each user hasn't
+// answered yet, for up to limit users with an id after afterUserID, in the order of their ids.
+func (s SqlOutstandingMentionStore) GetOutstandingCounts(mentionedBefore int64, afterUserID string, limit int) ([]*model.OutstandingMentionCount, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("OutstandingMentions.UserId", "COUNT(*) AS Count").
+		From("OutstandingMentions").
+		Join("Posts ON Posts.Id = OutstandingMentions.PostId").
+		Where(sq.Eq{"OutstandingMentions.ResolvedAt": 0, "Posts.DeleteAt": 0}).
+		Where(sq.Lt{"OutstandingMentions.MentionedAt": mentionedBefore}).
+		Where(sq.Gt{"OutstandingMentions.UserId": afterUserID}).
+		GroupBy("OutstandingMentions.UserId").
+		OrderBy("OutstandingMentions.UserId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outstanding_mention_getoutstandingcounts_tosql")
+	}
+
+	counts := []*model.OutstandingMentionCount{}
+	if err := s.GetReplicaX().Select(&counts, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to count OutstandingMentions")
+	}
+
+	return counts, nil
+}
+
+// PermanentDeleteBatch deletes up to limit mentions made before endTime, answered or not.
+func (s SqlOutstandingMentionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM OutstandingMentions WHERE (UserId, PostId) IN (SELECT UserId, PostId FROM OutstandingMentions WHERE MentionedAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM OutstandingMentions WHERE MentionedAt < ? LIMIT ?"
+	}
+
+	result, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete OutstandingMentions")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted OutstandingMentions")
+	}
+
+	return rowsAffected, nil
+}
+
+func (s SqlOutstandingMentionStore) PermanentDeleteByUser(userID string) error {
+	if _, err := s.GetMasterX().Exec("DELETE FROM OutstandingMentions WHERE UserId = ?", userID); err != nil {
+		return errors.Wrapf(err, "failed to delete OutstandingMentions with userId=%s", userID)
+	}
+
+	return nil
+}
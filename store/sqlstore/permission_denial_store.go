@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPermissionDenialStore struct {
+	*SqlStore
+}
+
+func newSqlPermissionDenialStore(sqlStore *SqlStore) store.PermissionDenialStore {
+	return &SqlPermissionDenialStore{sqlStore}
+}
+
+var permissionDenialColumns = []string{
+	"Id",
+	"UserId",
+	"Permissions",
+	"ResourceType",
+	"ResourceId",
+	"Path",
+	"CreateAt",
+}
+
+func (s SqlPermissionDenialStore) Save(denial *model.PermissionDenial) (*model.PermissionDenial, error) {
+	denial.PreSave()
+	if err := denial.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("PermissionDenials").
+		Columns(permissionDenialColumns...).
+		Values(
+			denial.Id,
+			denial.UserId,
+			denial.Permissions,
+			denial.ResourceType,
+			denial.ResourceId,
+			denial.Path,
+			denial.CreateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "permission_denial_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PermissionDenial with id=%s", denial.Id)
+	}
+
+	return denial, nil
+}
+
+// Search returns the permission denials matching the options, most recent first.
+func (s SqlPermissionDenialStore) Search(opts *model.PermissionDenialSearch, offset, limit int) ([]*model.PermissionDenial, error) {
+	builder := s.getQueryBuilder().
+		Select(permissionDenialColumns...).
+		From("PermissionDenials").
+		Where(sq.GtOrEq{"CreateAt": opts.Since}).
+		OrderBy("CreateAt DESC", "Id").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	if opts.UserId != "" {
+		builder = builder.Where(sq.Eq{"UserId": opts.UserId})
+	}
+	if opts.ResourceId != "" {
+		builder = builder.Where(sq.Eq{"ResourceId": opts.ResourceId})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "permission_denial_search_tosql")
+	}
+
+	denials := []*model.PermissionDenial{}
+	if err := s.GetReplicaX().Select(&denials, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to search PermissionDenials")
+	}
+
+	return denials, nil
+}
+
+func (s SqlPermissionDenialStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM PermissionDenials WHERE Id IN (SELECT Id FROM PermissionDenials WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM PermissionDenials WHERE CreateAt < ? LIMIT ?"
+	}
+
+	sqlResult, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete PermissionDenials")
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted PermissionDenials")
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPostAcknowledgementStore struct {
+	*SqlStore
+}
+
+func newSqlPostAcknowledgementStore(sqlStore *SqlStore) store.PostAcknowledgementStore {
+	return &SqlPostAcknowledgementStore{sqlStore}
+}
+
+// Save records the acknowledgement of a post, updating its time if the user already acknowledged it.
+func (s SqlPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	if err := acknowledgement.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("PostAcknowledgements").
+		Columns("PostId", "UserId", "AcknowledgedAt").
+		Values(acknowledgement.PostId, acknowledgement.UserId, acknowledgement.AcknowledgedAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE AcknowledgedAt = ?", acknowledgement.AcknowledgedAt))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, userid) DO UPDATE SET AcknowledgedAt = ?", acknowledgement.AcknowledgedAt))
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_acknowledgement_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PostAcknowledgement with postId=%s and userId=%s", acknowledgement.PostId, acknowledgement.UserId)
+	}
+
+	return acknowledgement, nil
+}
+
+func (s SqlPostAcknowledgementStore) Delete(userID, postID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("PostAcknowledgements").
+		Where(sq.Eq{"PostId": postID, "UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "post_acknowledgement_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PostAcknowledgement with postId=%s and userId=%s", postID, userID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted PostAcknowledgement")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("PostAcknowledgement", postID)
+	}
+
+	return nil
+}
+
+func (s SqlPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("PostId", "UserId", "AcknowledgedAt").
+		From("PostAcknowledgements").
+		Where(sq.Eq{"PostId": postID}).
+		OrderBy("AcknowledgedAt").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_acknowledgement_getforpost_tosql")
+	}
+
+	acknowledgements := []*model.PostAcknowledgement{}
+	if err := s.GetMasterX().Select(&acknowledgements, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get PostAcknowledgements with postId=%s", postID)
+	}
+
+	return acknowledgements, nil
+}
+
+// GetForPosts returns the acknowledgements of any of the given posts, oldest first.
+func (s SqlPostAcknowledgementStore) GetForPosts(postIDs []string) ([]*model.PostAcknowledgement, error) {
+	acknowledgements := []*model.PostAcknowledgement{}
+	if len(postIDs) == 0 {
+		return acknowledgements, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("PostId", "UserId", "AcknowledgedAt").
+		From("PostAcknowledgements").
+		Where(sq.Eq{"PostId": postIDs}).
+		OrderBy("AcknowledgedAt").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_acknowledgement_getforposts_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&acknowledgements, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get PostAcknowledgements for posts")
+	}
+
+	return acknowledgements, nil
+}
+
+// GetSummariesForUser returns, most recent first, how many acknowledgements the posts of
+// the user that requested them received, and how many active members of their channel
+// have yet to acknowledge them.
+func (s SqlPostAcknowledgementStore) GetSummariesForUser(userID string, offset, limit int) ([]*model.PostAcknowledgementSummary, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(
+			"p.Id AS PostId",
+			"p.ChannelId",
+			"p.CreateAt",
+			"(SELECT COUNT(*) FROM PostAcknowledgements pa WHERE pa.PostId = p.Id) AS Acknowledged",
+			`(SELECT COUNT(*) FROM ChannelMembers cm
+				INNER JOIN Users u ON u.Id = cm.UserId
+				WHERE cm.ChannelId = p.ChannelId
+				AND cm.UserId != p.UserId
+				AND u.DeleteAt = 0
+				AND NOT EXISTS (SELECT 1 FROM PostAcknowledgements pa WHERE pa.PostId = p.Id AND pa.UserId = cm.UserId)) AS Outstanding`,
+		).
+		From("Posts p").
+		InnerJoin("PostsPriority pp ON pp.PostId = p.Id").
+		Where(sq.Eq{"p.UserId": userID, "p.DeleteAt": 0, "pp.RequestedAck": true}).
+		OrderBy("p.CreateAt DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_acknowledgement_getsummariesforuser_tosql")
+	}
+
+	summaries := []*model.PostAcknowledgementSummary{}
+	if err := s.GetReplicaX().Select(&summaries, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get PostAcknowledgementSummaries with userId=%s", userID)
+	}
+
+	return summaries, nil
+}
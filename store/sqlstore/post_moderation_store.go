@@ -0,0 +1,220 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPostModerationStore struct {
+	*SqlStore
+}
+
+func newSqlPostModerationStore(sqlStore *SqlStore) store.PostModerationStore {
+	return &SqlPostModerationStore{sqlStore}
+}
+
+var postModerationColumns = []string{
+	"Id",
+	"PostId",
+	"ChannelId",
+	"TeamId",
+	"UserId",
+	"Post",
+	"Action",
+	"Reason",
+	"Status",
+	"ReviewerId",
+	"CreateAt",
+	"UpdateAt",
+}
+
+// postModerationRow is a row of the PostModerations table, the post being stored as JSON.
+type postModerationRow struct {
+	Id         string
+	PostId     string
+	ChannelId  string
+	TeamId     string
+	UserId     string
+	Post       string
+	Action     string
+	Reason     string
+	Status     string
+	ReviewerId string
+	CreateAt   int64
+	UpdateAt   int64
+}
+
+func (r *postModerationRow) toModel() (*model.PostModeration, error) {
+	var post model.Post
+	if err := json.Unmarshal([]byte(r.Post), &post); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the post of PostModeration with id=%s", r.Id)
+	}
+
+	return &model.PostModeration{
+		Id:         r.Id,
+		PostId:     r.PostId,
+		ChannelId:  r.ChannelId,
+		TeamId:     r.TeamId,
+		UserId:     r.UserId,
+		Post:       &post,
+		Action:     r.Action,
+		Reason:     r.Reason,
+		Status:     r.Status,
+		ReviewerId: r.ReviewerId,
+		CreateAt:   r.CreateAt,
+		UpdateAt:   r.UpdateAt,
+	}, nil
+}
+
+func (s SqlPostModerationStore) Save(moderation *model.PostModeration) (*model.PostModeration, error) {
+	if moderation.Id != "" {
+		return nil, store.NewErrInvalidInput("PostModeration", "id", moderation.Id)
+	}
+
+	moderation.PreSave()
+	if err := moderation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	post, err := json.Marshal(moderation.Post)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the post of PostModeration")
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("PostModerations").
+		Columns(postModerationColumns...).
+		Values(
+			moderation.Id,
+			moderation.PostId,
+			moderation.ChannelId,
+			moderation.TeamId,
+			moderation.UserId,
+			string(post),
+			moderation.Action,
+			moderation.Reason,
+			moderation.Status,
+			moderation.ReviewerId,
+			moderation.CreateAt,
+			moderation.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_moderation_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PostModeration with id=%s", moderation.Id)
+	}
+
+	return moderation, nil
+}
+
+func (s SqlPostModerationStore) Get(id string) (*model.PostModeration, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(postModerationColumns...).
+		From("PostModerations").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_moderation_get_tosql")
+	}
+
+	var row postModerationRow
+	if err := s.GetReplicaX().Get(&row, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostModeration", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostModeration with id=%s", id)
+	}
+
+	return row.toModel()
+}
+
+// GetAll returns a page of moderations, oldest first. When teamID is not empty, only the
+// moderations of posts in the team are returned, and when status is not empty, only those with
+// that status.
+func (s SqlPostModerationStore) GetAll(teamID, status string, offset, limit int) ([]*model.PostModeration, error) {
+	builder := s.getQueryBuilder().
+		Select(postModerationColumns...).
+		From("PostModerations").
+		OrderBy("CreateAt", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+	if teamID != "" {
+		builder = builder.Where(sq.Eq{"TeamId": teamID})
+	}
+	if status != "" {
+		builder = builder.Where(sq.Eq{"Status": status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_moderation_getall_tosql")
+	}
+
+	rows := []*postModerationRow{}
+	if err := s.GetReplicaX().Select(&rows, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get PostModerations")
+	}
+
+	moderations := make([]*model.PostModeration, 0, len(rows))
+	for _, row := range rows {
+		moderation, err := row.toModel()
+		if err != nil {
+			return nil, err
+		}
+		moderations = append(moderations, moderation)
+	}
+
+	return moderations, nil
+}
+
+// Review records the decision of a moderator on a pending moderation. A conflict is returned
+// when the moderation has already been reviewed, so that two moderators can't act on the same post.
+func (s SqlPostModerationStore) Review(moderation *model.PostModeration) (*model.PostModeration, error) {
+	moderation.PreUpdate()
+	if err := moderation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("PostModerations").
+		SetMap(map[string]interface{}{
+			"Status":     moderation.Status,
+			"ReviewerId": moderation.ReviewerId,
+			"UpdateAt":   moderation.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": moderation.Id, "Status": model.PostModerationStatusPending}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_moderation_review_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to review PostModeration with id=%s", moderation.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for reviewed PostModeration")
+	}
+	if count == 0 {
+		if _, err := s.Get(moderation.Id); err != nil {
+			return nil, err
+		}
+		return nil, store.NewErrConflict("PostModeration", nil, "id="+moderation.Id)
+	}
+
+	return moderation, nil
+}
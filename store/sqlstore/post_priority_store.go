@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPostPriorityStore struct {
+	*SqlStore
+}
+
+func newSqlPostPriorityStore(sqlStore *SqlStore) store.PostPriorityStore {
+	return &SqlPostPriorityStore{sqlStore}
+}
+
+// Save stores the priority of a post. The priority is set once, when the post is created.
+func (s SqlPostPriorityStore) Save(priority *model.PostPriority) (*model.PostPriority, error) {
+	if err := s.savePostPriority(s.GetMasterX(), priority); err != nil {
+		return nil, err
+	}
+	return priority, nil
+}
+
+func (ss *SqlStore) savePostPriority(ex sqlxExecutor, priority *model.PostPriority) error {
+	if err := priority.IsValid(); err != nil {
+		return err
+	}
+
+	query, args, err := ss.getQueryBuilder().
+		Insert("PostsPriority").
+		Columns("PostId", "ChannelId", "Priority", "RequestedAck").
+		Values(priority.PostId, priority.ChannelId, priority.Priority, priority.RequestedAck).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "post_priority_save_tosql")
+	}
+
+	if _, err := ex.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to save PostPriority with postId=%s", priority.PostId)
+	}
+
+	return nil
+}
+
+func (s SqlPostPriorityStore) GetForPost(postID string) (*model.PostPriority, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "Priority", "RequestedAck").
+		From("PostsPriority").
+		Where(sq.Eq{"PostId": postID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_priority_get_tosql")
+	}
+
+	var priority model.PostPriority
+	if err := s.GetReplicaX().Get(&priority, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostPriority", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostPriority with postId=%s", postID)
+	}
+
+	return &priority, nil
+}
+
+// GetForPosts returns the priorities set on any of the given posts. Posts without a
+// priority are left out.
+func (s SqlPostPriorityStore) GetForPosts(postIDs []string) ([]*model.PostPriority, error) {
+	priorities := []*model.PostPriority{}
+	if len(postIDs) == 0 {
+		return priorities, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "Priority", "RequestedAck").
+		From("PostsPriority").
+		Where(sq.Eq{"PostId": postIDs}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_priority_getforposts_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&priorities, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get PostPriorities for posts")
+	}
+
+	return priorities, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPostReportStore struct {
+	*SqlStore
+}
+
+func newSqlPostReportStore(sqlStore *SqlStore) store.PostReportStore {
+	return &SqlPostReportStore{sqlStore}
+}
+
+var postReportColumns = []string{
+	"Id",
+	"PostId",
+	"ChannelId",
+	"TeamId",
+	"PostUserId",
+	"ReporterId",
+	"Anonymous",
+	"Reason",
+	"Comment",
+	"Status",
+	"Resolution",
+	"ResolverId",
+	"Note",
+	"CreateAt",
+	"UpdateAt",
+}
+
+// Save stores the report, returning a conflict when the user already reported the post.
+func (s SqlPostReportStore) Save(report *model.PostReport) (*model.PostReport, error) {
+	if report.Id != "" {
+		return nil, store.NewErrInvalidInput("PostReport", "id", report.Id)
+	}
+
+	report.PreSave()
+	if err := report.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("PostReports").
+		Columns(postReportColumns...).
+		Values(
+			report.Id,
+			report.PostId,
+			report.ChannelId,
+			report.TeamId,
+			report.PostUserId,
+			report.ReporterId,
+			report.Anonymous,
+			report.Reason,
+			report.Comment,
+			report.Status,
+			report.Resolution,
+			report.ResolverId,
+			report.Note,
+			report.CreateAt,
+			report.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_report_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"idx_postreports_postid_reporterid", "postreports_postid_reporterid_key"}) {
+			return nil, store.NewErrConflict("PostReport", err, "post_id="+report.PostId)
+		}
+		return nil, errors.Wrapf(err, "failed to save PostReport with id=%s", report.Id)
+	}
+
+	return report, nil
+}
+
+func (s SqlPostReportStore) Get(id string) (*model.PostReport, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(postReportColumns...).
+		From("PostReports").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_report_get_tosql")
+	}
+
+	var report model.PostReport
+	if err := s.GetReplicaX().Get(&report, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostReport", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostReport with id=%s", id)
+	}
+
+	return &report, nil
+}
+
+// GetAll returns a page of reports, oldest first. When teamID is not empty, only the reports of
+// posts in the team are returned, and when status is not empty, only those with that status.
+func (s SqlPostReportStore) GetAll(teamID, status string, offset, limit int) ([]*model.PostReport, error) {
+	builder := s.getQueryBuilder().
+		Select(postReportColumns...).
+		From("PostReports").
+		OrderBy("CreateAt", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+	if teamID != "" {
+		builder = builder.Where(sq.Eq{"TeamId": teamID})
+	}
+	if status != "" {
+		builder = builder.Where(sq.Eq{"Status": status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_report_getall_tosql")
+	}
+
+	reports := []*model.PostReport{}
+	if err := s.GetReplicaX().Select(&reports, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get PostReports")
+	}
+
+	return reports, nil
+}
+
+// Resolve records the resolution of an open report. A conflict is returned when the report has
+// already been resolved, so that two moderators can't act on the same report.
+func (s SqlPostReportStore) Resolve(report *model.PostReport) (*model.PostReport, error) {
+	report.PreUpdate()
+	if err := report.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("PostReports").
+		SetMap(map[string]interface{}{
+			"Status":     report.Status,
+			"Resolution": report.Resolution,
+			"ResolverId": report.ResolverId,
+			"Note":       report.Note,
+			"UpdateAt":   report.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": report.Id, "Status": model.PostReportStatusOpen}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_report_resolve_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve PostReport with id=%s", report.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for resolved PostReport")
+	}
+	if count == 0 {
+		if _, err := s.Get(report.Id); err != nil {
+			return nil, err
+		}
+		return nil, store.NewErrConflict("PostReport", nil, "id="+report.Id)
+	}
+
+	return report, nil
+}
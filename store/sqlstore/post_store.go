@@ -132,6 +132,12 @@ func newSqlPostStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterface) s
 }
 
 func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+	return s.saveMultiple(posts, nil)
+}
+
+// saveMultiple saves the posts, calling saveExtras within the same transaction once they have
+// been inserted.
+func (s *SqlPostStore) saveMultiple(posts []*model.Post, saveExtras func(transaction *sqlxTxWrapper) error) ([]*model.Post, int, error) {
 	channelNewPosts := make(map[string]int)
 	channelNewRootPosts := make(map[string]int)
 	maxDateNewPosts := make(map[string]int64)
@@ -214,6 +220,12 @@ func (s *SqlPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, er
 		return nil, -1, errors.Wrap(err, "failed to save Post")
 	}
 
+	if saveExtras != nil {
+		if err = saveExtras(transaction); err != nil {
+			return nil, -1, err
+		}
+	}
+
 	if err = s.updateThreadsFromPosts(transaction, posts); err != nil {
 		mlog.Warn("Error updating posts, thread update failed", mlog.Err(err))
 	}
@@ -277,6 +289,30 @@ func (s *SqlPostStore) Save(post *model.Post) (*model.Post, error) {
 	return posts[0], nil
 }
 
+// SaveWithExtras saves a post and the rows that come with it in a single transaction, so that
+// none of them is saved if any of them can't be.
+func (s *SqlPostStore) SaveWithExtras(post *model.Post, extras store.PostExtras) (*model.Post, error) {
+	posts, _, err := s.saveMultiple([]*model.Post{post}, func(transaction *sqlxTxWrapper) error {
+		if extras.Priority != nil {
+			extras.Priority.PostId = post.Id
+			if err := s.savePostPriority(transaction, extras.Priority); err != nil {
+				return err
+			}
+		}
+		if extras.Task != nil {
+			extras.Task.PostId = post.Id
+			if err := s.savePostTask(transaction, extras.Task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return posts[0], nil
+}
+
 func (s *SqlPostStore) populateReplyCount(posts []*model.Post) error {
 	rootIds := []string{}
 	for _, post := range posts {
@@ -727,12 +763,13 @@ func (s *SqlPostStore) Delete(postID string, time int64, deleteByID string) erro
 		return errors.Wrapf(err, "failed to delete Post with id=%s", postID)
 	}
 
+	// Replies deleted earlier keep their own deletion time so that restoring the post leaves them deleted.
 	if s.DriverName() == model.DatabaseDriverPostgres {
 		_, err = transaction.Exec(`UPDATE Posts
 			SET DeleteAt = $1,
 				UpdateAt = $1,
 				Props = jsonb_set(Props, $2, $3)
-			WHERE Id = $4 OR RootId = $4`, time, jsonKeyPath(model.PostPropsDeleteBy), jsonStringVal(deleteByID), postID)
+			WHERE Id = $4 OR (RootId = $4 AND DeleteAt = 0)`, time, jsonKeyPath(model.PostPropsDeleteBy), jsonStringVal(deleteByID), postID)
 	} else {
 		// We use ORDER BY clause for MySQL
 		// to trigger filesort optimization in the index_merge.
@@ -742,7 +779,7 @@ func (s *SqlPostStore) Delete(postID string, time int64, deleteByID string) erro
 			SET DeleteAt = ?,
 			UpdateAt = ?,
 			Props = JSON_SET(Props, ?, ?)
-			Where Id = ? OR RootId = ?
+			Where Id = ? OR (RootId = ? AND DeleteAt = 0)
 			ORDER BY Id`, time, time, "$."+model.PostPropsDeleteBy, deleteByID, postID, postID)
 	}
 
@@ -763,6 +800,98 @@ func (s *SqlPostStore) Delete(postID string, time int64, deleteByID string) erro
 	return nil
 }
 
+// GetDeletedForChannel returns the posts of a channel deleted since the given time, most recently
+// deleted first. Replies deleted along with their root post are left out as they are restored with it.
+func (s *SqlPostStore) GetDeletedForChannel(channelID string, since int64, offset, limit int) ([]*model.Post, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("p.*").
+		From("Posts p").
+		Where(sq.Eq{"p.ChannelId": channelID}).
+		Where(sq.Gt{"p.DeleteAt": 0}).
+		Where(sq.GtOrEq{"p.DeleteAt": since}).
+		Where(fmt.Sprintf("p.Type NOT LIKE '%s%%'", model.PostSystemMessagePrefix)).
+		Where("(p.RootId = '' OR NOT EXISTS (SELECT 1 FROM Posts r WHERE r.Id = p.RootId AND r.DeleteAt = p.DeleteAt))").
+		OrderBy("p.DeleteAt DESC", "p.Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "getDeletedForChannel_tosql")
+	}
+
+	posts := []*model.Post{}
+	if err := s.GetReplicaX().Select(&posts, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find deleted Posts with channelId=%s", channelID)
+	}
+	return posts, nil
+}
+
+// Restore undoes the soft deletion of a post along with the replies deleted with it, and updates
+// its thread accordingly. It returns the ids of the restored posts.
+func (s *SqlPostStore) Restore(postID string) ([]string, error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	var post struct {
+		RootId   string
+		DeleteAt int64
+	}
+	err = transaction.Get(&post, "SELECT RootId, DeleteAt FROM Posts WHERE Id = ?", postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Post", postID)
+		}
+
+		return nil, errors.Wrapf(err, "failed to get Post with id=%s", postID)
+	}
+	if post.DeleteAt == 0 {
+		return nil, store.NewErrNotFound("Post", postID)
+	}
+
+	// Replies deleted on their own before the post was deleted stay deleted.
+	postIDs := []string{}
+	err = transaction.Select(&postIDs, "SELECT Id FROM Posts WHERE (Id = ? OR RootId = ?) AND DeleteAt = ?", postID, postID, post.DeleteAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Posts deleted with id=%s", postID)
+	}
+
+	now := model.GetMillis()
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		_, err = transaction.Exec(`UPDATE Posts
+			SET DeleteAt = 0,
+				UpdateAt = $1,
+				Props = Props - $2::text
+			WHERE (Id = $3 OR RootId = $3) AND DeleteAt = $4`, now, model.PostPropsDeleteBy, postID, post.DeleteAt)
+	} else {
+		_, err = transaction.Exec(`UPDATE Posts
+			SET DeleteAt = 0,
+			UpdateAt = ?,
+			Props = JSON_REMOVE(Props, ?)
+			WHERE (Id = ? OR RootId = ?) AND DeleteAt = ?
+			ORDER BY Id`, now, "$."+model.PostPropsDeleteBy, postID, postID, post.DeleteAt)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update Posts")
+	}
+
+	rootID := post.RootId
+	if rootID == "" {
+		rootID = postID
+	}
+	if err = s.refreshThread(transaction, rootID); err != nil {
+		return nil, errors.Wrapf(err, "failed to refresh Thread with postid=%s", rootID)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return postIDs, nil
+}
+
 func (s *SqlPostStore) permanentDelete(postId string) error {
 	var post model.Post
 	transaction, err := s.GetMasterX().Beginx()
@@ -1189,13 +1318,12 @@ func (s *SqlPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinc
 	return exist, nil
 }
 
-func (s *SqlPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
-	query := s.getQueryBuilder().
-		Select("*").
+// postsSinceForSyncQuery filters the query down to the posts updated after the cursor and
+// matching the options.
+func postsSinceForSyncQuery(query sq.SelectBuilder, options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) sq.SelectBuilder {
+	query = query.
 		From("Posts").
-		Where(sq.Or{sq.Gt{"UpdateAt": cursor.LastPostUpdateAt}, sq.And{sq.Eq{"UpdateAt": cursor.LastPostUpdateAt}, sq.Gt{"Id": cursor.LastPostId}}}).
-		OrderBy("UpdateAt", "Id").
-		Limit(uint64(limit))
+		Where(sq.Or{sq.Gt{"UpdateAt": cursor.LastPostUpdateAt}, sq.And{sq.Eq{"UpdateAt": cursor.LastPostUpdateAt}, sq.Gt{"Id": cursor.LastPostId}}})
 
 	if options.ChannelId != "" {
 		query = query.Where(sq.Eq{"ChannelId": options.ChannelId})
@@ -1209,6 +1337,14 @@ func (s *SqlPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOp
 		query = query.Where(sq.NotEq{"COALESCE(Posts.RemoteId,'')": options.ExcludeRemoteId})
 	}
 
+	return query
+}
+
+func (s *SqlPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+	query := postsSinceForSyncQuery(s.getQueryBuilder().Select("*"), options, cursor).
+		OrderBy("UpdateAt", "Id").
+		Limit(uint64(limit))
+
 	queryString, args, err := query.ToSql()
 	if err != nil {
 		return nil, cursor, errors.Wrap(err, "getpostssinceforsync_tosql")
@@ -1227,6 +1363,22 @@ func (s *SqlPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOp
 	return posts, cursor, nil
 }
 
+// CountPostsSinceForSync returns the number of posts GetPostsSinceForSync would return
+// without a limit.
+func (s *SqlPostStore) CountPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) (int64, error) {
+	queryString, args, err := postsSinceForSyncQuery(s.getQueryBuilder().Select("COUNT(*)"), options, cursor).ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "countpostssinceforsync_tosql")
+	}
+
+	var count int64
+	if err := s.GetReplicaX().Get(&count, queryString, args...); err != nil {
+		return 0, errors.Wrapf(err, "error counting Posts with channelId=%s", options.ChannelId)
+	}
+
+	return count, nil
+}
+
 func (s *SqlPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, error) {
 	return s.getPostsAround(true, options)
 }
@@ -1621,6 +1773,61 @@ func (s *SqlPostStore) buildCreateDateFilterClause(params *model.SearchParams, b
 	return builder
 }
 
+func (s *SqlPostStore) buildSearchFileFilterClause(params *model.SearchParams, builder sq.SelectBuilder) sq.SelectBuilder {
+	// handle has: filter
+	if params.HasFiles {
+		builder = builder.Where(sq.And{sq.NotEq{"FileIds": []string{"", "[]"}}, sq.NotEq{"FileIds": nil}})
+	}
+	if params.ExcludedHasFiles {
+		builder = builder.Where(sq.Or{sq.Eq{"FileIds": []string{"", "[]"}}, sq.Eq{"FileIds": nil}})
+	}
+
+	// handle type: filter
+	buildFileTypeClause := func(fileTypes []string) (string, []interface{}) {
+		mimeTypes := sq.Or{}
+		for _, fileType := range fileTypes {
+			mimeTypes = append(mimeTypes, sq.Like{"FileInfo.MimeType": model.SearchFileTypeMimePrefixes[fileType] + "%"})
+		}
+		clause, args, _ := mimeTypes.ToSql()
+		return "SELECT 1 FROM FileInfo WHERE FileInfo.PostId = q2.Id AND FileInfo.DeleteAt = 0 AND " + clause, args
+	}
+	if len(params.FileTypes) > 0 {
+		subQuery, args := buildFileTypeClause(params.FileTypes)
+		builder = builder.Where("EXISTS ("+subQuery+")", args...)
+	}
+	if len(params.ExcludedFileTypes) > 0 {
+		subQuery, args := buildFileTypeClause(params.ExcludedFileTypes)
+		builder = builder.Where("NOT EXISTS ("+subQuery+")", args...)
+	}
+
+	return builder
+}
+
+func (s *SqlPostStore) buildSearchLanguageClause(params *model.SearchParams, builder sq.SelectBuilder) sq.SelectBuilder {
+	// handle lang: filter
+	language := jsonStringFieldExpr(s.DriverName(), "Props", model.PostPropsLanguage)
+	if len(params.Languages) > 0 {
+		builder = builder.Where(sq.Eq{language: params.Languages})
+	}
+	if len(params.ExcludedLanguages) > 0 {
+		// posts whose language wasn't detected aren't in any of the excluded languages
+		builder = builder.Where(sq.Or{sq.NotEq{language: params.ExcludedLanguages}, sq.Eq{language: nil}})
+	}
+
+	return builder
+}
+
+func (s *SqlPostStore) buildSearchRegexClause(regexTerms []string, builder sq.SelectBuilder) sq.SelectBuilder {
+	for _, regexTerm := range regexTerms {
+		if s.DriverName() == model.DatabaseDriverPostgres {
+			builder = builder.Where("Message ~* ?", regexTerm)
+		} else {
+			builder = builder.Where("Message REGEXP ?", regexTerm)
+		}
+	}
+	return builder
+}
+
 func (s *SqlPostStore) buildSearchTeamFilterClause(teamId string, builder sq.SelectBuilder) sq.SelectBuilder {
 	if teamId == "" {
 		return builder
@@ -1702,7 +1909,15 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 	if params.Terms == "" && params.ExcludedTerms == "" &&
 		len(params.InChannels) == 0 && len(params.ExcludedChannels) == 0 &&
 		len(params.FromUsers) == 0 && len(params.ExcludedUsers) == 0 &&
-		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" {
+		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" &&
+		!params.HasFiles && !params.ExcludedHasFiles &&
+		len(params.FileTypes) == 0 && len(params.ExcludedFileTypes) == 0 &&
+		len(params.RegexTerms) == 0 &&
+		len(params.Languages) == 0 && len(params.ExcludedLanguages) == 0 {
+		return list, nil
+	}
+
+	if len(params.RegexTerms) > 0 && !s.isSearchRegexEnabled() {
 		return list, nil
 	}
 
@@ -1721,6 +1936,9 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 		return nil, errors.Wrap(err, "failed to build search post filter clause")
 	}
 	baseQuery = s.buildCreateDateFilterClause(params, baseQuery)
+	baseQuery = s.buildSearchFileFilterClause(params, baseQuery)
+	baseQuery = s.buildSearchLanguageClause(params, baseQuery)
+	baseQuery = s.buildSearchRegexClause(params.RegexTerms, baseQuery)
 
 	termMap := map[string]bool{}
 	terms := params.Terms
@@ -2129,6 +2347,23 @@ func (s *SqlPostStore) PermanentDeleteBatchForRetentionPolicies(now, globalPolic
 	}, s.SqlStore, cursor)
 }
 
+// CountForRetentionPolicies counts the posts PermanentDeleteBatchForRetentionPolicies
+// would delete, for a dry run of the data retention job.
+func (s *SqlPostStore) CountForRetentionPolicies(now, globalPolicyEndTime int64) (*model.RetentionPolicyDryRun, error) {
+	builder := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("Posts")
+	return genericCountForRetentionPolicies(RetentionPolicyBatchDeletionInfo{
+		BaseBuilder:         builder,
+		Table:               "Posts",
+		TimeColumn:          "CreateAt",
+		PrimaryKeys:         []string{"Id"},
+		ChannelIDTable:      "Posts",
+		NowMillis:           now,
+		GlobalPolicyEndTime: globalPolicyEndTime,
+	}, s.SqlStore)
+}
+
 // DeleteOrphanedRows removes entries from Posts when a corresponding channel no longer exists.
 func (s *SqlPostStore) DeleteOrphanedRows(limit int) (deleted int64, err error) {
 	// We need the extra level of nesting to deal with MySQL's locking
@@ -2169,6 +2404,86 @@ func (s *SqlPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64,
 	return rowsAffected, nil
 }
 
+// PermanentDeleteBatchForChannel deletes a batch of the channel's posts created before
+// endTime, along with their reactions, file infos and threads. The deleted file infos are
+// returned so that their stored files can be removed.
+func (s *SqlPostStore) PermanentDeleteBatchForChannel(channelID string, endTime int64, limit int64) (int64, []*model.FileInfo, error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	query, args, err := s.getQueryBuilder().
+		Select("Id").
+		From("Posts").
+		Where(sq.Eq{"ChannelId": channelID}).
+		Where(sq.Lt{"CreateAt": endTime}).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "post_permanent_delete_batch_for_channel_tosql")
+	}
+
+	postIDs := []string{}
+	if err = transaction.Select(&postIDs, query, args...); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to find Posts to delete with channelId=%s", channelID)
+	}
+	if len(postIDs) == 0 {
+		return 0, nil, nil
+	}
+
+	for _, postID := range postIDs {
+		if err = s.permanentDeleteThreads(transaction, postID); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	query, args, err = s.getQueryBuilder().
+		Select("Id", "Path", "ThumbnailPath", "PreviewPath", "COALESCE(ExternalURL, '') AS ExternalURL").
+		From("FileInfo").
+		Where(sq.Eq{"PostId": postIDs}).
+		ToSql()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	infos := []*model.FileInfo{}
+	if err = transaction.Select(&infos, query, args...); err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to find FileInfos to delete with channelId=%s", channelID)
+	}
+
+	for _, table := range []string{"Reactions", "FileInfo"} {
+		query, args, err = s.getQueryBuilder().Delete(table).Where(sq.Eq{"PostId": postIDs}).ToSql()
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "delete_%s_tosql", table)
+		}
+		if _, err = transaction.Exec(query, args...); err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to delete %s with channelId=%s", table, channelID)
+		}
+	}
+
+	query, args, err = s.getQueryBuilder().Delete("Posts").Where(sq.Eq{"Id": postIDs}).ToSql()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "delete_posts_tosql")
+	}
+	result, err := transaction.Exec(query, args...)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to delete Posts with channelId=%s", channelID)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to get rows affected for deleted Posts")
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return rowsAffected, infos, nil
+}
+
 func (s *SqlPostStore) GetOldest() (*model.Post, error) {
 	var post model.Post
 	err := s.GetReplicaX().Get(&post, "SELECT * FROM Posts ORDER BY CreateAt LIMIT 1")
@@ -2439,6 +2754,32 @@ func (s *SqlPostStore) SearchPostsForUser(paramsList []*model.SearchParams, user
 	return model.MakePostSearchResults(posts, nil), nil
 }
 
+func (s *SqlPostStore) isSearchRegexEnabled() bool {
+	return s.settings.EnableDatabaseSearchRegex != nil && *s.settings.EnableDatabaseSearchRegex
+}
+
+func (s *SqlPostStore) GetSearchCapabilities() *model.SearchCapabilities {
+	operators := []string{
+		model.SearchOperatorFrom,
+		model.SearchOperatorIn,
+		model.SearchOperatorOn,
+		model.SearchOperatorBefore,
+		model.SearchOperatorAfter,
+		model.SearchOperatorDateTime,
+		model.SearchOperatorHasFile,
+		model.SearchOperatorFileType,
+		model.SearchOperatorLanguage,
+	}
+	if s.isSearchRegexEnabled() {
+		operators = append(operators, model.SearchOperatorRegex)
+	}
+
+	return &model.SearchCapabilities{
+		Backend:   model.SearchBackendDatabase,
+		Operators: operators,
+	}
+}
+
 func (s *SqlPostStore) GetOldestEntityCreationTime() (int64, error) {
 	query := s.getQueryBuilder().Select("MIN(min_createat) min_createat").
 		Suffix(`FROM (
@@ -2534,6 +2875,44 @@ func (s *SqlPostStore) cleanupThreadComments(transaction *sqlxTxWrapper, postId,
 	return nil
 }
 
+// refreshThread recomputes the reply count, participants and last reply time of an existing
+// thread from its undeleted replies.
+func (s *SqlPostStore) refreshThread(transaction *sqlxTxWrapper, rootId string) error {
+	data := []struct {
+		UserId    string
+		RepliedAt int64
+	}{}
+	if err := transaction.Select(&data, "SELECT UserId, MAX(CreateAt) as RepliedAt FROM Posts WHERE RootId=? AND DeleteAt=0 GROUP BY UserId ORDER BY RepliedAt ASC", rootId); err != nil {
+		return err
+	}
+
+	participants := model.StringArray{}
+	var lastReplyAt int64
+	for _, item := range data {
+		participants = append(participants, item.UserId)
+		if item.RepliedAt > lastReplyAt {
+			lastReplyAt = item.RepliedAt
+		}
+	}
+
+	var count int64
+	if err := transaction.Get(&count, "SELECT COUNT(Id) FROM Posts WHERE RootId=? AND DeleteAt=0", rootId); err != nil {
+		return err
+	}
+
+	_, err := transaction.NamedExec(`UPDATE Threads
+		SET ReplyCount = :ReplyCount,
+			LastReplyAt = :LastReplyAt,
+			Participants = :Participants
+		WHERE PostId=:PostId`, &model.Thread{
+		PostId:       rootId,
+		ReplyCount:   count,
+		LastReplyAt:  lastReplyAt,
+		Participants: participants,
+	})
+	return err
+}
+
 func (s *SqlPostStore) updateThreadsFromPosts(transaction *sqlxTxWrapper, posts []*model.Post) error {
 	postsByRoot := map[string][]*model.Post{}
 	var rootIds []string
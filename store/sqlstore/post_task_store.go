@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlPostTaskStore struct {
+	*SqlStore
+}
+
+func newSqlPostTaskStore(sqlStore *SqlStore) store.PostTaskStore {
+	return &SqlPostTaskStore{sqlStore}
+}
+
+func (s SqlPostTaskStore) Save(task *model.PostTask) (*model.PostTask, error) {
+	if err := s.savePostTask(s.GetMasterX(), task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (ss *SqlStore) savePostTask(ex sqlxExecutor, task *model.PostTask) error {
+	task.PreSave()
+	if err := task.IsValid(); err != nil {
+		return err
+	}
+
+	query, args, err := ss.getQueryBuilder().
+		Insert("PostTasks").
+		Columns("PostId", "ChannelId", "AssigneeId", "Status", "DueAt", "CreateAt", "UpdateAt").
+		Values(task.PostId, task.ChannelId, task.AssigneeId, task.Status, task.DueAt, task.CreateAt, task.UpdateAt).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "post_task_save_tosql")
+	}
+
+	if _, err := ex.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to save PostTask with postId=%s", task.PostId)
+	}
+
+	return nil
+}
+
+func (s SqlPostTaskStore) Get(postID string) (*model.PostTask, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("PostId", "ChannelId", "AssigneeId", "Status", "DueAt", "CreateAt", "UpdateAt").
+		From("PostTasks").
+		Where(sq.Eq{"PostId": postID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_task_get_tosql")
+	}
+
+	var task model.PostTask
+	if err := s.GetReplicaX().Get(&task, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostTask", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get PostTask with postId=%s", postID)
+	}
+
+	return &task, nil
+}
+
+func (s SqlPostTaskStore) Update(task *model.PostTask) (*model.PostTask, error) {
+	task.UpdateAt = model.GetMillis()
+	if err := task.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("PostTasks").
+		Set("AssigneeId", task.AssigneeId).
+		Set("Status", task.Status).
+		Set("DueAt", task.DueAt).
+		Set("UpdateAt", task.UpdateAt).
+		Where(sq.Eq{"PostId": task.PostId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_task_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update PostTask with postId=%s", task.PostId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated PostTask")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("PostTask", task.PostId)
+	}
+
+	return task, nil
+}
+
+// GetForAssignee returns the tasks assigned to the given user with one of the given statuses,
+// soonest due first. Tasks whose post was deleted, or posted in channels the user is no longer
+// a member of, are left out.
+func (s SqlPostTaskStore) GetForAssignee(assigneeID string, statuses []string, offset, limit int) ([]*model.PostTask, error) {
+	query := s.getQueryBuilder().
+		Select("PostTasks.PostId", "PostTasks.ChannelId", "PostTasks.AssigneeId", "PostTasks.Status", "PostTasks.DueAt", "PostTasks.CreateAt", "PostTasks.UpdateAt").
+		From("PostTasks").
+		Join("Posts ON Posts.Id = PostTasks.PostId").
+		Join("ChannelMembers ON ChannelMembers.ChannelId = PostTasks.ChannelId AND ChannelMembers.UserId = PostTasks.AssigneeId").
+		Where(sq.Eq{"PostTasks.AssigneeId": assigneeID}).
+		Where(sq.Eq{"Posts.DeleteAt": 0}).
+		// Tasks without a due date come last.
+		OrderBy("CASE WHEN PostTasks.DueAt = 0 THEN 1 ELSE 0 END", "PostTasks.DueAt", "PostTasks.CreateAt").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if len(statuses) > 0 {
+		query = query.Where(sq.Eq{"PostTasks.Status": statuses})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "post_task_get_for_assignee_tosql")
+	}
+
+	tasks := []*model.PostTask{}
+	if err := s.GetReplicaX().Select(&tasks, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find PostTasks with assigneeId=%s", assigneeID)
+	}
+
+	return tasks, nil
+}
@@ -4,6 +4,8 @@
 package sqlstore
 
 import (
+	"database/sql"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/pkg/errors"
 
@@ -44,11 +46,12 @@ func (s SqlPreferenceStore) Save(preferences model.Preferences) error {
 	}
 
 	defer finalizeTransactionX(transaction)
-	for _, preference := range preferences {
+	for i, preference := range preferences {
 		preference := preference
 		if upsertErr := s.saveTx(transaction, &preference); upsertErr != nil {
 			return upsertErr
 		}
+		preferences[i].Version = preference.Version
 	}
 
 	if err := transaction.Commit(); err != nil {
@@ -67,13 +70,13 @@ func (s SqlPreferenceStore) save(transaction *sqlxTxWrapper, preference *model.P
 
 	query := s.getQueryBuilder().
 		Insert("Preferences").
-		Columns("UserId", "Category", "Name", "Value").
-		Values(preference.UserId, preference.Category, preference.Name, preference.Value)
+		Columns("UserId", "Category", "Name", "Value", "Version").
+		Values(preference.UserId, preference.Category, preference.Name, preference.Value, 1)
 
 	if s.DriverName() == model.DatabaseDriverMysql {
-		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Value = ?", preference.Value))
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Value = ?, Version = Version + 1", preference.Value))
 	} else if s.DriverName() == model.DatabaseDriverPostgres {
-		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, category, name) DO UPDATE SET Value = ?", preference.Value))
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, category, name) DO UPDATE SET Value = ?, Version = Preferences.Version + 1", preference.Value))
 	} else {
 		return store.NewErrNotImplemented("failed to update preference because of missing driver")
 	}
@@ -98,13 +101,13 @@ func (s SqlPreferenceStore) saveTx(transaction *sqlxTxWrapper, preference *model
 
 	query := s.getQueryBuilder().
 		Insert("Preferences").
-		Columns("UserId", "Category", "Name", "Value").
-		Values(preference.UserId, preference.Category, preference.Name, preference.Value)
+		Columns("UserId", "Category", "Name", "Value", "Version").
+		Values(preference.UserId, preference.Category, preference.Name, preference.Value, 1)
 
 	if s.DriverName() == model.DatabaseDriverMysql {
-		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Value = ?", preference.Value))
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Value = ?, Version = Version + 1", preference.Value))
 	} else if s.DriverName() == model.DatabaseDriverPostgres {
-		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, category, name) DO UPDATE SET Value = ?", preference.Value))
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, category, name) DO UPDATE SET Value = ?, Version = Preferences.Version + 1", preference.Value))
 	} else {
 		return store.NewErrNotImplemented("failed to update preference because of missing driver")
 	}
@@ -117,9 +120,127 @@ func (s SqlPreferenceStore) saveTx(transaction *sqlxTxWrapper, preference *model
 	if _, err = transaction.Exec(queryString, args...); err != nil {
 		return errors.Wrap(err, "failed to save Preference")
 	}
+
+	if err = transaction.Get(&preference.Version, "SELECT Version FROM Preferences WHERE UserId = ? AND Category = ? AND Name = ?",
+		preference.UserId, preference.Category, preference.Name); err != nil {
+		return errors.Wrap(err, "failed to get Preference version")
+	}
 	return nil
 }
 
+// CompareAndSet saves the preferences only if each of them is still at its given version, a
+// version of 0 meaning that it must not exist yet. When any of them isn't, none are saved and
+// the current values of those that aren't are returned as conflicts instead.
+func (s SqlPreferenceStore) CompareAndSet(preferences model.Preferences) (model.Preferences, model.Preferences, error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	saved := model.Preferences{}
+	conflicts := model.Preferences{}
+	for _, preference := range preferences {
+		preference := preference
+		preference.PreUpdate()
+		if err := preference.IsValid(); err != nil {
+			return nil, nil, err
+		}
+
+		current, err := s.getForUpdateTx(transaction, preference.UserId, preference.Category, preference.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if current.Version != preference.Version {
+			conflicts = append(conflicts, *current)
+			continue
+		}
+		if len(conflicts) > 0 {
+			continue
+		}
+
+		var query sq.Sqlizer
+		if preference.Version == 0 {
+			insert := s.getQueryBuilder().
+				Insert("Preferences").
+				Columns("UserId", "Category", "Name", "Value", "Version").
+				Values(preference.UserId, preference.Category, preference.Name, preference.Value, 1)
+			// A preference inserted since it was read is left as is and reported as a conflict.
+			if s.DriverName() == model.DatabaseDriverMysql {
+				insert = insert.Suffix("ON DUPLICATE KEY UPDATE UserId = UserId")
+			} else {
+				insert = insert.Suffix("ON CONFLICT (userid, category, name) DO NOTHING")
+			}
+			query = insert
+		} else {
+			query = s.getQueryBuilder().
+				Update("Preferences").
+				Set("Value", preference.Value).
+				Set("Version", preference.Version+1).
+				Where(sq.Eq{"UserId": preference.UserId, "Category": preference.Category, "Name": preference.Name}).
+				Where(sq.Eq{"Version": preference.Version})
+		}
+
+		queryString, args, err := query.ToSql()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate sqlquery")
+		}
+		result, err := transaction.Exec(queryString, args...)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to save Preference")
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "unable to get rows affected")
+		}
+		if rows == 0 {
+			current, err = s.getForUpdateTx(transaction, preference.UserId, preference.Category, preference.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			conflicts = append(conflicts, *current)
+			continue
+		}
+
+		preference.Version++
+		saved = append(saved, preference)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, conflicts, nil
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, nil, errors.Wrap(err, "commit_transaction")
+	}
+	return saved, nil, nil
+}
+
+// getForUpdateTx returns a preference, locking it until the end of the transaction. A preference
+// that doesn't exist is returned with version 0 and an empty value.
+func (s SqlPreferenceStore) getForUpdateTx(transaction *sqlxTxWrapper, userId, category, name string) (*model.Preference, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Preferences").
+		Where(sq.Eq{"UserId": userId}).
+		Where(sq.Eq{"Category": category}).
+		Where(sq.Eq{"Name": name}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get preference")
+	}
+
+	var preference model.Preference
+	if err := transaction.Get(&preference, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return &model.Preference{UserId: userId, Category: category, Name: name}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to find Preference with userId=%s, category=%s, name=%s", userId, category, name)
+	}
+	return &preference, nil
+}
+
 func (s SqlPreferenceStore) Get(userId string, category string, name string) (*model.Preference, error) {
 	var preference model.Preference
 	query, args, err := s.getQueryBuilder().
@@ -158,6 +279,28 @@ func (s SqlPreferenceStore) GetCategory(userId string, category string) (model.P
 
 }
 
+func (s SqlPreferenceStore) GetForUsers(userIds []string, category string, name string) (model.Preferences, error) {
+	preferences := model.Preferences{}
+	if len(userIds) == 0 {
+		return preferences, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Preferences").
+		Where(sq.Eq{"UserId": userIds}).
+		Where(sq.Eq{"Category": category}).
+		Where(sq.Eq{"Name": name}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get preferences")
+	}
+	if err = s.GetReplicaX().Select(&preferences, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Preferences with category=%s, name=%s", category, name)
+	}
+	return preferences, nil
+}
+
 func (s SqlPreferenceStore) GetAll(userId string) (model.Preferences, error) {
 	var preferences model.Preferences
 	query, args, err := s.getQueryBuilder().
@@ -30,10 +30,10 @@ func (s sqlRemoteClusterStore) Save(remoteCluster *model.RemoteCluster) (*model.
 
 	query := `INSERT INTO RemoteClusters
 				(RemoteId, RemoteTeamId, Name, DisplayName, SiteURL, CreateAt,
-				LastPingAt, Token, RemoteToken, Topics, CreatorId)
+				LastPingAt, Token, RemoteToken, Topics, CreatorId, HiddenProfileFields, InvitePolicy)
 				VALUES
 				(:RemoteId, :RemoteTeamId, :Name, :DisplayName, :SiteURL, :CreateAt,
-				:LastPingAt, :Token, :RemoteToken, :Topics, :CreatorId)`
+				:LastPingAt, :Token, :RemoteToken, :Topics, :CreatorId, :HiddenProfileFields, :InvitePolicy)`
 
 	if _, err := s.GetMasterX().NamedExec(query, remoteCluster); err != nil {
 		return nil, errors.Wrap(err, "failed to save RemoteCluster")
@@ -56,7 +56,9 @@ func (s sqlRemoteClusterStore) Update(remoteCluster *model.RemoteCluster) (*mode
 			CreatorId = :CreatorId,
 			DisplayName = :DisplayName,
 			SiteURL = :SiteURL,
-			Topics = :Topics
+			Topics = :Topics,
+			HiddenProfileFields = :HiddenProfileFields,
+			InvitePolicy = :InvitePolicy
 			WHERE RemoteId = :RemoteId AND Name = :Name`
 
 	if _, err := s.GetMasterX().NamedExec(query, remoteCluster); err != nil {
@@ -170,6 +172,40 @@ func (s sqlRemoteClusterStore) UpdateTopics(remoteClusterid string, topics strin
 	return rc, nil
 }
 
+func (s sqlRemoteClusterStore) UpdateHiddenProfileFields(remoteClusterId string, hiddenProfileFields string) (*model.RemoteCluster, error) {
+	rc, err := s.Get(remoteClusterId)
+	if err != nil {
+		return nil, err
+	}
+	rc.HiddenProfileFields = hiddenProfileFields
+
+	query := `UPDATE RemoteClusters
+			  SET HiddenProfileFields = :HiddenProfileFields
+			  WHERE	RemoteId = :RemoteId`
+
+	if _, err = s.GetMasterX().NamedExec(query, rc); err != nil {
+		return nil, errors.Wrap(err, "failed to update RemoteCluster hidden profile fields")
+	}
+	return rc, nil
+}
+
+func (s sqlRemoteClusterStore) UpdateInvitePolicy(remoteClusterId string, invitePolicy string) (*model.RemoteCluster, error) {
+	rc, err := s.Get(remoteClusterId)
+	if err != nil {
+		return nil, err
+	}
+	rc.InvitePolicy = invitePolicy
+
+	query := `UPDATE RemoteClusters
+			  SET InvitePolicy = :InvitePolicy
+			  WHERE	RemoteId = :RemoteId`
+
+	if _, err = s.GetMasterX().NamedExec(query, rc); err != nil {
+		return nil, errors.Wrap(err, "failed to update RemoteCluster invite policy")
+	}
+	return rc, nil
+}
+
 func (s sqlRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
 	query := s.getQueryBuilder().
 		Update("RemoteClusters").
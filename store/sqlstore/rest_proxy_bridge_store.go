@@ -0,0 +1,200 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlRESTProxyBridgeStore struct {
+	*SqlStore
+}
+
+func newSqlRESTProxyBridgeStore(sqlStore *SqlStore) store.RESTProxyBridgeStore {
+	return &SqlRESTProxyBridgeStore{sqlStore}
+}
+
+var restProxyBridgeMessageColumns = []string{
+	"Id",
+	"Event",
+	"Topic",
+	"MessageKey",
+	"Payload",
+	"Attempts",
+	"LastError",
+	"NextAttemptAt",
+	"PublishedAt",
+	"CreateAt",
+}
+
+func (s SqlRESTProxyBridgeStore) Save(message *model.RESTProxyBridgeMessage) (*model.RESTProxyBridgeMessage, error) {
+	message.PreSave()
+
+	query, args, err := s.getQueryBuilder().
+		Insert("RESTProxyBridgeMessages").
+		Columns(restProxyBridgeMessageColumns...).
+		Values(
+			message.Id,
+			message.Event,
+			message.Topic,
+			message.MessageKey,
+			message.Payload,
+			message.Attempts,
+			message.LastError,
+			message.NextAttemptAt,
+			message.PublishedAt,
+			message.CreateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "rest_proxy_bridge_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save RESTProxyBridgeMessage with id=%s", message.Id)
+	}
+
+	return message, nil
+}
+
+// GetPending returns the messages not published yet, in the order they are to be published.
+func (s SqlRESTProxyBridgeStore) GetPending(limit int) ([]*model.RESTProxyBridgeMessage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(restProxyBridgeMessageColumns...).
+		From("RESTProxyBridgeMessages").
+		Where(sq.Eq{"PublishedAt": 0}).
+		OrderBy("CreateAt", "Id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "rest_proxy_bridge_getpending_tosql")
+	}
+
+	messages := []*model.RESTProxyBridgeMessage{}
+	if err := s.GetMasterX().Select(&messages, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get pending RESTProxyBridgeMessages")
+	}
+
+	return messages, nil
+}
+
+func (s SqlRESTProxyBridgeStore) MarkPublished(ids []string, publishedAt int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("RESTProxyBridgeMessages").
+		Set("PublishedAt", publishedAt).
+		Set("NextAttemptAt", 0).
+		Where(sq.Eq{"Id": ids}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "rest_proxy_bridge_markpublished_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrap(err, "failed to mark RESTProxyBridgeMessages as published")
+	}
+
+	return nil
+}
+
+// UpdateAttempt records the outcome of the last failed attempt to publish the message.
+func (s SqlRESTProxyBridgeStore) UpdateAttempt(message *model.RESTProxyBridgeMessage) error {
+	query, args, err := s.getQueryBuilder().
+		Update("RESTProxyBridgeMessages").
+		SetMap(map[string]interface{}{
+			"Attempts":      message.Attempts,
+			"LastError":     message.LastError,
+			"NextAttemptAt": message.NextAttemptAt,
+		}).
+		Where(sq.Eq{"Id": message.Id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "rest_proxy_bridge_updateattempt_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update RESTProxyBridgeMessage with id=%s", message.Id)
+	}
+
+	return nil
+}
+
+// GetStatus returns how many messages are waiting to be published, the next one to be, and the
+// last one published.
+func (s SqlRESTProxyBridgeStore) GetStatus() (*model.RESTProxyBridgeStatus, error) {
+	status := &model.RESTProxyBridgeStatus{}
+
+	query, args, err := s.getQueryBuilder().
+		Select("COUNT(*)", "COALESCE(MIN(CreateAt), 0)").
+		From("RESTProxyBridgeMessages").
+		Where(sq.Eq{"PublishedAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "rest_proxy_bridge_getstatus_tosql")
+	}
+	if err := s.GetReplicaX().QueryRowX(query, args...).Scan(&status.PendingCount, &status.OldestPendingAt); err != nil {
+		return nil, errors.Wrap(err, "failed to count pending RESTProxyBridgeMessages")
+	}
+
+	pending, err := s.GetPending(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		status.NextPending = pending[0]
+	}
+
+	query, args, err = s.getQueryBuilder().
+		Select("Id AS MessageId", "Event", "CreateAt", "PublishedAt").
+		From("RESTProxyBridgeMessages").
+		Where(sq.Gt{"PublishedAt": 0}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "rest_proxy_bridge_getstatus_tosql")
+	}
+
+	var offset model.RESTProxyBridgeOffset
+	if err := s.GetReplicaX().Get(&offset, query, args...); err != nil {
+		if err != sql.ErrNoRows {
+			return nil, errors.Wrap(err, "failed to get the last published RESTProxyBridgeMessage")
+		}
+	} else {
+		status.LastPublished = &offset
+	}
+
+	return status, nil
+}
+
+// PermanentDeletePublishedBatch deletes up to limit messages published before endTime.
+func (s SqlRESTProxyBridgeStore) PermanentDeletePublishedBatch(endTime int64, limit int64) (int64, error) {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM RESTProxyBridgeMessages WHERE Id IN (SELECT Id FROM RESTProxyBridgeMessages WHERE PublishedAt > 0 AND PublishedAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM RESTProxyBridgeMessages WHERE PublishedAt > 0 AND PublishedAt < ? LIMIT ?"
+	}
+
+	result, err := s.GetMasterX().Exec(query, endTime, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete published RESTProxyBridgeMessages")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected for deleted RESTProxyBridgeMessages")
+	}
+
+	return rowsAffected, nil
+}
@@ -485,6 +485,42 @@ func (s *SqlRetentionPolicyStore) GetChannelsCount(policyId string) (int64, erro
 	return count, nil
 }
 
+func (s *SqlRetentionPolicyStore) CountForPolicyPreview(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, error) {
+	scope := sq.Or{}
+	if len(policy.ChannelIDs) > 0 {
+		scope = append(scope, sq.Eq{"Channels.Id": policy.ChannelIDs})
+	}
+	if len(policy.TeamIDs) > 0 {
+		scope = append(scope, sq.And{
+			sq.Eq{"Channels.TeamId": policy.TeamIDs},
+			sq.Expr("Channels.Id NOT IN (SELECT ChannelId FROM RetentionPoliciesChannels WHERE PolicyId != ?)", policy.ID),
+		})
+	}
+	counts := []*model.RetentionPolicyPreviewCount{}
+	if len(scope) == 0 {
+		return counts, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("Channels.TeamId", "COALESCE(Teams.Name, '') AS TeamName", "Channels.Id AS ChannelId", "Channels.Name AS ChannelName").
+		Column(sq.Expr("(SELECT COUNT(*) FROM Posts WHERE Posts.ChannelId = Channels.Id AND Posts.CreateAt < ?) AS PostCount", endTime)).
+		Column(sq.Expr("(SELECT COUNT(*) FROM FileInfo INNER JOIN Posts ON FileInfo.PostId = Posts.Id WHERE Posts.ChannelId = Channels.Id AND Posts.CreateAt < ?) AS FileCount", endTime)).
+		From("Channels").
+		LeftJoin("Teams ON Teams.Id = Channels.TeamId").
+		Where(scope).
+		OrderBy("TeamName", "ChannelName").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "retention_policy_preview_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&counts, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to count the posts and files for the retention policy preview")
+	}
+
+	return counts, nil
+}
+
 func (s *SqlRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
 	if len(channelIds) == 0 {
 		return nil
@@ -845,13 +881,11 @@ type RetentionPolicyBatchDeletionInfo struct {
 	Limit               int64
 }
 
-// genericPermanentDeleteBatchForRetentionPolicies is a helper function for tables
-// which need to delete records for granular and global policies.
-func genericPermanentDeleteBatchForRetentionPolicies(
-	r RetentionPolicyBatchDeletionInfo,
-	s *SqlStore,
-	cursor model.RetentionPolicyCursor,
-) (int64, model.RetentionPolicyCursor, error) {
+// retentionPolicyBuilders returns the builders selecting the records which fall under
+// the scope of a channel-specific policy, a team-specific policy and the global policy
+// respectively. Channel-specific policies override team-specific policies, and granular
+// policies override the global policy, so a record is selected by at most one builder.
+func retentionPolicyBuilders(r RetentionPolicyBatchDeletionInfo) (channelPolicies, teamPolicies, globalPolicy sq.SelectBuilder) {
 	baseBuilder := r.BaseBuilder.InnerJoin("Channels ON " + r.ChannelIDTable + ".ChannelId = Channels.Id")
 
 	scopedTimeColumn := r.Table + "." + r.TimeColumn
@@ -865,6 +899,43 @@ func genericPermanentDeleteBatchForRetentionPolicies(
 		sq.Expr(nowStr + " - " + scopedTimeColumn + " > RetentionPolicies.PostDuration * " + strconv.FormatInt(millisecondsInADay, 10)),
 	}
 
+	channelPolicies = baseBuilder.
+		InnerJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
+		InnerJoin("RetentionPolicies ON RetentionPoliciesChannels.PolicyId = RetentionPolicies.Id").
+		Where(fallsUnderGranularPolicy)
+
+	teamPolicies = baseBuilder.
+		LeftJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
+		InnerJoin("RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId").
+		InnerJoin("RetentionPolicies ON RetentionPoliciesTeams.PolicyId = RetentionPolicies.Id").
+		Where(sq.And{
+			sq.Eq{"RetentionPoliciesChannels.PolicyId": nil},
+			sq.Expr("RetentionPoliciesTeams.PolicyId = RetentionPolicies.Id"),
+		}).
+		Where(fallsUnderGranularPolicy)
+
+	globalPolicy = baseBuilder.
+		LeftJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
+		LeftJoin("RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId").
+		LeftJoin("RetentionPolicies ON RetentionPoliciesChannels.PolicyId = RetentionPolicies.Id").
+		Where(sq.And{
+			sq.Eq{"RetentionPoliciesChannels.PolicyId": nil},
+			sq.Eq{"RetentionPoliciesTeams.PolicyId": nil},
+		}).
+		Where(sq.Lt{scopedTimeColumn: r.GlobalPolicyEndTime})
+
+	return channelPolicies, teamPolicies, globalPolicy
+}
+
+// genericPermanentDeleteBatchForRetentionPolicies is a helper function for tables
+// which need to delete records for granular and global policies.
+func genericPermanentDeleteBatchForRetentionPolicies(
+	r RetentionPolicyBatchDeletionInfo,
+	s *SqlStore,
+	cursor model.RetentionPolicyCursor,
+) (int64, model.RetentionPolicyCursor, error) {
+	channelPoliciesBuilder, teamPoliciesBuilder, globalPolicyBuilder := retentionPolicyBuilders(r)
+
 	// If the caller wants to disable the global policy from running
 	if r.GlobalPolicyEndTime <= 0 {
 		cursor.GlobalPoliciesDone = true
@@ -879,12 +950,7 @@ func genericPermanentDeleteBatchForRetentionPolicies(
 
 	// First, delete all of the records which fall under the scope of a channel-specific policy
 	if !cursor.ChannelPoliciesDone {
-		channelPoliciesBuilder := baseBuilder.
-			InnerJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
-			InnerJoin("RetentionPolicies ON RetentionPoliciesChannels.PolicyId = RetentionPolicies.Id").
-			Where(fallsUnderGranularPolicy).
-			Limit(uint64(r.Limit))
-		rowsAffected, err := genericRetentionPoliciesDeletion(channelPoliciesBuilder, r, s)
+		rowsAffected, err := genericRetentionPoliciesDeletion(channelPoliciesBuilder.Limit(uint64(r.Limit)), r, s)
 		if err != nil {
 			return 0, cursor, err
 		}
@@ -897,18 +963,7 @@ func genericPermanentDeleteBatchForRetentionPolicies(
 
 	// Next, delete all of the records which fall under the scope of a team-specific policy
 	if cursor.ChannelPoliciesDone && !cursor.TeamPoliciesDone {
-		// Channel-specific policies override team-specific policies.
-		teamPoliciesBuilder := baseBuilder.
-			LeftJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
-			InnerJoin("RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId").
-			InnerJoin("RetentionPolicies ON RetentionPoliciesTeams.PolicyId = RetentionPolicies.Id").
-			Where(sq.And{
-				sq.Eq{"RetentionPoliciesChannels.PolicyId": nil},
-				sq.Expr("RetentionPoliciesTeams.PolicyId = RetentionPolicies.Id"),
-			}).
-			Where(fallsUnderGranularPolicy).
-			Limit(uint64(r.Limit))
-		rowsAffected, err := genericRetentionPoliciesDeletion(teamPoliciesBuilder, r, s)
+		rowsAffected, err := genericRetentionPoliciesDeletion(teamPoliciesBuilder.Limit(uint64(r.Limit)), r, s)
 		if err != nil {
 			return 0, cursor, err
 		}
@@ -921,18 +976,7 @@ func genericPermanentDeleteBatchForRetentionPolicies(
 
 	// Finally, delete all of the records which fall under the scope of the global policy
 	if cursor.ChannelPoliciesDone && cursor.TeamPoliciesDone && !cursor.GlobalPoliciesDone {
-		// Granular policies override the global policy.
-		globalPolicyBuilder := baseBuilder.
-			LeftJoin("RetentionPoliciesChannels ON " + r.ChannelIDTable + ".ChannelId = RetentionPoliciesChannels.ChannelId").
-			LeftJoin("RetentionPoliciesTeams ON Channels.TeamId = RetentionPoliciesTeams.TeamId").
-			LeftJoin("RetentionPolicies ON RetentionPoliciesChannels.PolicyId = RetentionPolicies.Id").
-			Where(sq.And{
-				sq.Eq{"RetentionPoliciesChannels.PolicyId": nil},
-				sq.Eq{"RetentionPoliciesTeams.PolicyId": nil},
-			}).
-			Where(sq.Lt{scopedTimeColumn: r.GlobalPolicyEndTime}).
-			Limit(uint64(r.Limit))
-		rowsAffected, err := genericRetentionPoliciesDeletion(globalPolicyBuilder, r, s)
+		rowsAffected, err := genericRetentionPoliciesDeletion(globalPolicyBuilder.Limit(uint64(r.Limit)), r, s)
 		if err != nil {
 			return 0, cursor, err
 		}
@@ -945,6 +989,46 @@ func genericPermanentDeleteBatchForRetentionPolicies(
 	return totalRowsAffected, cursor, nil
 }
 
+// genericCountForRetentionPolicies counts the records which
+// genericPermanentDeleteBatchForRetentionPolicies would delete, without deleting them.
+// `BaseBuilder` should select COUNT(*) instead of the primary keys, and `Limit` is ignored.
+func genericCountForRetentionPolicies(r RetentionPolicyBatchDeletionInfo, s *SqlStore) (*model.RetentionPolicyDryRun, error) {
+	channelPoliciesBuilder, teamPoliciesBuilder, globalPolicyBuilder := retentionPolicyBuilders(r)
+
+	dryRun := &model.RetentionPolicyDryRun{
+		Now:                 r.NowMillis,
+		GlobalPolicyEndTime: r.GlobalPolicyEndTime,
+	}
+
+	count := func(builder sq.SelectBuilder, dest *int64) error {
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return errors.Wrap(err, r.Table+"_count_tosql")
+		}
+		if err := s.GetReplicaX().Get(dest, query, args...); err != nil {
+			return errors.Wrap(err, "failed to count "+r.Table)
+		}
+		return nil
+	}
+
+	if r.NowMillis > 0 {
+		if err := count(channelPoliciesBuilder, &dryRun.ChannelPolicies); err != nil {
+			return nil, err
+		}
+		if err := count(teamPoliciesBuilder, &dryRun.TeamPolicies); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.GlobalPolicyEndTime > 0 {
+		if err := count(globalPolicyBuilder, &dryRun.GlobalPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	return dryRun, nil
+}
+
 // genericRetentionPoliciesDeletion actually executes the DELETE query using a sq.SelectBuilder
 // which selects the rows to delete.
 func genericRetentionPoliciesDeletion(
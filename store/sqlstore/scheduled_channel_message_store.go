@@ -0,0 +1,257 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlScheduledChannelMessageStore struct {
+	*SqlStore
+}
+
+func newSqlScheduledChannelMessageStore(sqlStore *SqlStore) store.ScheduledChannelMessageStore {
+	return &SqlScheduledChannelMessageStore{sqlStore}
+}
+
+var scheduledChannelMessageColumns = []string{
+	"Id",
+	"ChannelId",
+	"CreatorId",
+	"Message",
+	"Frequency",
+	"Weekday",
+	"DayOfMonth",
+	"TimeOfDay",
+	"Timezone",
+	"SkipWeekends",
+	"SkipDates",
+	"NextRunAt",
+	"LastRunAt",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlScheduledChannelMessageStore) Save(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
+	if message.Id != "" {
+		return nil, store.NewErrInvalidInput("ScheduledChannelMessage", "id", message.Id)
+	}
+
+	message.PreSave()
+	if err := message.IsValid(maxPostSize); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("ScheduledChannelMessages").
+		Columns(scheduledChannelMessageColumns...).
+		Values(
+			message.Id,
+			message.ChannelId,
+			message.CreatorId,
+			message.Message,
+			message.Frequency,
+			message.Weekday,
+			message.DayOfMonth,
+			message.TimeOfDay,
+			message.Timezone,
+			message.SkipWeekends,
+			message.SkipDates,
+			message.NextRunAt,
+			message.LastRunAt,
+			message.CreateAt,
+			message.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "scheduled_channel_message_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ScheduledChannelMessage with id=%s", message.Id)
+	}
+
+	return message, nil
+}
+
+func (s SqlScheduledChannelMessageStore) Get(id string) (*model.ScheduledChannelMessage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(scheduledChannelMessageColumns...).
+		From("ScheduledChannelMessages").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "scheduled_channel_message_get_tosql")
+	}
+
+	var message model.ScheduledChannelMessage
+	if err := s.GetReplicaX().Get(&message, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ScheduledChannelMessage", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get ScheduledChannelMessage with id=%s", id)
+	}
+
+	return &message, nil
+}
+
+// GetForChannel returns the scheduled messages of the channel, oldest first.
+func (s SqlScheduledChannelMessageStore) GetForChannel(channelID string) ([]*model.ScheduledChannelMessage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(scheduledChannelMessageColumns...).
+		From("ScheduledChannelMessages").
+		Where(sq.Eq{"ChannelId": channelID}).
+		OrderBy("CreateAt", "Id").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "scheduled_channel_message_get_for_channel_tosql")
+	}
+
+	messages := []*model.ScheduledChannelMessage{}
+	if err := s.GetReplicaX().Select(&messages, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ScheduledChannelMessages with channelId=%s", channelID)
+	}
+
+	return messages, nil
+}
+
+// Update saves the message and schedule of the scheduled message. Its runs are left untouched.
+func (s SqlScheduledChannelMessageStore) Update(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
+	message.PreUpdate()
+	if err := message.IsValid(maxPostSize); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("ScheduledChannelMessages").
+		SetMap(map[string]interface{}{
+			"Message":      message.Message,
+			"Frequency":    message.Frequency,
+			"Weekday":      message.Weekday,
+			"DayOfMonth":   message.DayOfMonth,
+			"TimeOfDay":    message.TimeOfDay,
+			"Timezone":     message.Timezone,
+			"SkipWeekends": message.SkipWeekends,
+			"SkipDates":    message.SkipDates,
+			"NextRunAt":    message.NextRunAt,
+			"UpdateAt":     message.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": message.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "scheduled_channel_message_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ScheduledChannelMessage with id=%s", message.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated ScheduledChannelMessage")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("ScheduledChannelMessage", message.Id)
+	}
+
+	return message, nil
+}
+
+func (s SqlScheduledChannelMessageStore) Delete(id string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ScheduledChannelMessages").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "scheduled_channel_message_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete ScheduledChannelMessage with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted ScheduledChannelMessage")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("ScheduledChannelMessage", id)
+	}
+
+	return nil
+}
+
+func (s SqlScheduledChannelMessageStore) DeleteForChannel(channelID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("ScheduledChannelMessages").
+		Where(sq.Eq{"ChannelId": channelID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "scheduled_channel_message_delete_for_channel_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete ScheduledChannelMessages with channelId=%s", channelID)
+	}
+
+	return nil
+}
+
+// GetDue returns the scheduled messages whose next run is due at the given time, most overdue
+// first. The messages without a next run are never due.
+func (s SqlScheduledChannelMessageStore) GetDue(now int64, limit int) ([]*model.ScheduledChannelMessage, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(scheduledChannelMessageColumns...).
+		From("ScheduledChannelMessages").
+		Where(sq.Gt{"NextRunAt": 0}).
+		Where(sq.LtOrEq{"NextRunAt": now}).
+		OrderBy("NextRunAt", "Id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "scheduled_channel_message_get_due_tosql")
+	}
+
+	messages := []*model.ScheduledChannelMessage{}
+	if err := s.GetMasterX().Select(&messages, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get due ScheduledChannelMessages")
+	}
+
+	return messages, nil
+}
+
+// UpdateRun records a run of the scheduled message. It returns false, without recording
+// anything, when the next run is no longer expectedNextRunAt because the run was already
+// recorded elsewhere or the schedule changed in the meantime.
+func (s SqlScheduledChannelMessageStore) UpdateRun(id string, expectedNextRunAt, lastRunAt, nextRunAt int64) (bool, error) {
+	query, args, err := s.getQueryBuilder().
+		Update("ScheduledChannelMessages").
+		Set("LastRunAt", lastRunAt).
+		Set("NextRunAt", nextRunAt).
+		Where(sq.Eq{"Id": id, "NextRunAt": expectedNextRunAt}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "scheduled_channel_message_update_run_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update the run of ScheduledChannelMessage with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected for updated ScheduledChannelMessage")
+	}
+
+	return count > 0, nil
+}
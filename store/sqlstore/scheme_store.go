@@ -6,6 +6,7 @@ package sqlstore
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/pkg/errors"
@@ -14,6 +15,9 @@ import (
 	"github.com/mattermost/mattermost-server/v6/store"
 )
 
+// schemeAssignBatchSize bounds the number of ids in a single UPDATE when assigning a scheme.
+const schemeAssignBatchSize = 1000
+
 type SqlSchemeStore struct {
 	*SqlStore
 }
@@ -387,6 +391,71 @@ func (s *SqlSchemeStore) Delete(schemeId string) (*model.Scheme, error) {
 	return &scheme, nil
 }
 
+// AssignTeams switches all the given teams to the given scheme, or to the default scheme if
+// schemeID is empty. Either all teams are updated, or none are.
+func (s *SqlSchemeStore) AssignTeams(schemeID string, teamIDs []string) error {
+	return s.assign("Teams", "Team", sq.Eq{"DeleteAt": 0}, schemeID, teamIDs)
+}
+
+// AssignChannels switches all the given channels to the given scheme, or to the default scheme if
+// schemeID is empty. Either all channels are updated, or none are. Direct and group messages
+// can't be assigned a scheme.
+func (s *SqlSchemeStore) AssignChannels(schemeID string, channelIDs []string) error {
+	return s.assign("Channels", "Channel", sq.Eq{"Type": []model.ChannelType{model.ChannelTypeOpen, model.ChannelTypePrivate}}, schemeID, channelIDs)
+}
+
+func (s *SqlSchemeStore) assign(table, entity string, filter sq.Sqlizer, schemeID string, ids []string) error {
+	ids = model.RemoveDuplicateStrings(append([]string(nil), ids...))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	updateAt := model.GetMillis()
+	for start := 0; start < len(ids); start += schemeAssignBatchSize {
+		end := start + schemeAssignBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+
+		query, args, err := s.getQueryBuilder().
+			Update(table).
+			Set("SchemeId", schemeID).
+			Set("UpdateAt", updateAt).
+			Where(sq.Eq{"Id": batch}).
+			Where(filter).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "scheme_assign_tosql")
+		}
+
+		res, err := transaction.Exec(query, args...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update %s with schemeId=%s", table, schemeID)
+		}
+
+		rowsChanged, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get RowsAffected while updating %s", table)
+		}
+		if rowsChanged != int64(len(batch)) {
+			return store.NewErrNotFound(entity, strings.Join(batch, ","))
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
 func (s *SqlSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
 	schemes := []*model.Scheme{}
 
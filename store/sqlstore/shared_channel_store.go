@@ -821,3 +821,116 @@ func (s SqlSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime in
 	}
 	return nil
 }
+
+var sharedChannelInviteColumns = []string{"Id", "RemoteId", "ChannelId", "TeamId", "Type", "Name", "DisplayName", "Header",
+	"Purpose", "ReadOnly", "MemberCount", "PostCount", "Status", "CreateAt", "DecidedAt", "DecidedBy"}
+
+// SaveInvite inserts a new shared channel invite received from a remote cluster.
+func (s SqlSharedChannelStore) SaveInvite(invite *model.SharedChannelInvite) (*model.SharedChannelInvite, error) {
+	invite.PreSave()
+	if err := invite.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().Insert("SharedChannelInvites").
+		Columns(sharedChannelInviteColumns...).
+		Values(invite.Id, invite.RemoteId, invite.ChannelId, invite.TeamId, invite.Type, invite.Name, invite.DisplayName, invite.Header,
+			invite.Purpose, invite.ReadOnly, invite.MemberCount, invite.PostCount, invite.Status, invite.CreateAt, invite.DecidedAt, invite.DecidedBy).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_shared_channel_invite_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save SharedChannelInvite with id=%s", invite.Id)
+	}
+	return invite, nil
+}
+
+// GetInvite fetches a shared channel invite by id.
+func (s SqlSharedChannelStore) GetInvite(id string) (*model.SharedChannelInvite, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(sharedChannelInviteColumns...).
+		From("SharedChannelInvites").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "get_shared_channel_invite_tosql")
+	}
+
+	var invite model.SharedChannelInvite
+	if err := s.GetMasterX().Get(&invite, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("SharedChannelInvite", id)
+		}
+		return nil, errors.Wrapf(err, "failed to find SharedChannelInvite with id=%s", id)
+	}
+	return &invite, nil
+}
+
+// GetInvites fetches a page of the shared channel invites, the most recent first.
+func (s SqlSharedChannelStore) GetInvites(opts model.SharedChannelInviteFilterOpts, offset, limit int) ([]*model.SharedChannelInvite, error) {
+	query := s.getQueryBuilder().
+		Select(sharedChannelInviteColumns...).
+		From("SharedChannelInvites").
+		OrderBy("CreateAt DESC", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if opts.RemoteId != "" {
+		query = query.Where(sq.Eq{"RemoteId": opts.RemoteId})
+	}
+
+	if opts.ChannelId != "" {
+		query = query.Where(sq.Eq{"ChannelId": opts.ChannelId})
+	}
+
+	if opts.Status != "" {
+		query = query.Where(sq.Eq{"Status": opts.Status})
+	}
+
+	squery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "get_shared_channel_invites_tosql")
+	}
+
+	invites := []*model.SharedChannelInvite{}
+	if err := s.GetReplicaX().Select(&invites, squery, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get SharedChannelInvites")
+	}
+	return invites, nil
+}
+
+// UpdateInviteStatus records the decision taken on a pending shared channel invite. Invites
+// already decided on can't be updated.
+func (s SqlSharedChannelStore) UpdateInviteStatus(id string, status string, decidedBy string) (*model.SharedChannelInvite, error) {
+	query, args, err := s.getQueryBuilder().
+		Update("SharedChannelInvites").
+		Set("Status", status).
+		Set("DecidedAt", model.GetMillis()).
+		Set("DecidedBy", decidedBy).
+		Where(sq.Eq{"Id": id, "Status": model.SharedChannelInviteStatusPending}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "update_shared_channel_invite_status_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update the status of SharedChannelInvite with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine rows affected")
+	}
+
+	invite, err := s.GetInvite(id)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, store.NewErrInvalidInput("SharedChannelInvite", "Status", invite.Status)
+	}
+	return invite, nil
+}
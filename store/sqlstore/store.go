@@ -68,43 +68,83 @@ const (
 )
 
 type SqlStoreStores struct {
-	team                 store.TeamStore
-	channel              store.ChannelStore
-	post                 store.PostStore
-	retentionPolicy      store.RetentionPolicyStore
-	thread               store.ThreadStore
-	user                 store.UserStore
-	bot                  store.BotStore
-	audit                store.AuditStore
-	cluster              store.ClusterDiscoveryStore
-	remoteCluster        store.RemoteClusterStore
-	compliance           store.ComplianceStore
-	session              store.SessionStore
-	oauth                store.OAuthStore
-	system               store.SystemStore
-	webhook              store.WebhookStore
-	command              store.CommandStore
-	commandWebhook       store.CommandWebhookStore
-	preference           store.PreferenceStore
-	license              store.LicenseStore
-	token                store.TokenStore
-	emoji                store.EmojiStore
-	status               store.StatusStore
-	fileInfo             store.FileInfoStore
-	uploadSession        store.UploadSessionStore
-	reaction             store.ReactionStore
-	job                  store.JobStore
-	userAccessToken      store.UserAccessTokenStore
-	plugin               store.PluginStore
-	channelMemberHistory store.ChannelMemberHistoryStore
-	role                 store.RoleStore
-	scheme               store.SchemeStore
-	TermsOfService       store.TermsOfServiceStore
-	productNotices       store.ProductNoticesStore
-	group                store.GroupStore
-	UserTermsOfService   store.UserTermsOfServiceStore
-	linkMetadata         store.LinkMetadataStore
-	sharedchannel        store.SharedChannelStore
+	team                    store.TeamStore
+	channel                 store.ChannelStore
+	post                    store.PostStore
+	retentionPolicy         store.RetentionPolicyStore
+	thread                  store.ThreadStore
+	user                    store.UserStore
+	bot                     store.BotStore
+	audit                   store.AuditStore
+	cluster                 store.ClusterDiscoveryStore
+	remoteCluster           store.RemoteClusterStore
+	compliance              store.ComplianceStore
+	session                 store.SessionStore
+	oauth                   store.OAuthStore
+	system                  store.SystemStore
+	webhook                 store.WebhookStore
+	command                 store.CommandStore
+	commandWebhook          store.CommandWebhookStore
+	preference              store.PreferenceStore
+	license                 store.LicenseStore
+	token                   store.TokenStore
+	emoji                   store.EmojiStore
+	status                  store.StatusStore
+	fileInfo                store.FileInfoStore
+	uploadSession           store.UploadSessionStore
+	reaction                store.ReactionStore
+	job                     store.JobStore
+	userAccessToken         store.UserAccessTokenStore
+	plugin                  store.PluginStore
+	channelMemberHistory    store.ChannelMemberHistoryStore
+	role                    store.RoleStore
+	scheme                  store.SchemeStore
+	TermsOfService          store.TermsOfServiceStore
+	productNotices          store.ProductNoticesStore
+	group                   store.GroupStore
+	UserTermsOfService      store.UserTermsOfServiceStore
+	linkMetadata            store.LinkMetadataStore
+	sharedchannel           store.SharedChannelStore
+	apiUsage                store.APIUsageStore
+	postTask                store.PostTaskStore
+	channelDigest           store.ChannelDigestStore
+	directChannelRetention  store.DirectChannelRetentionStore
+	postPriority            store.PostPriorityStore
+	postAcknowledgement     store.PostAcknowledgementStore
+	teamBanner              store.TeamBannerStore
+	emailSuppression        store.EmailSuppressionStore
+	cannedResponse          store.CannedResponseStore
+	teamRequest             store.TeamRequestStore
+	teamStats               store.TeamStatsStore
+	userMerge               store.UserMergeStore
+	teamDeletion            store.TeamDeletionStore
+	idempotencyKey          store.IdempotencyKeyStore
+	channelArchivePolicy    store.ChannelArchivePolicyStore
+	permissionDenial        store.PermissionDenialStore
+	teamAlias               store.TeamAliasStore
+	botTokenRotation        store.BotTokenRotationStore
+	channelCommandOverride  store.ChannelCommandOverrideStore
+	impersonation           store.ImpersonationStore
+	scheduledChannelMessage store.ScheduledChannelMessageStore
+	eventWebhook            store.EventWebhookStore
+	configHistory           store.ConfigHistoryStore
+	uploadUsage             store.UploadUsageStore
+	channelEvent            store.ChannelEventStore
+	alertRule               store.AlertRuleStore
+	channelLanguageStats    store.ChannelLanguageStatsStore
+	teamInviteUsage         store.TeamInviteUsageStore
+	postModeration          store.PostModerationStore
+	postReport              store.PostReportStore
+	activityEvent           store.ActivityEventStore
+	auditLog                store.AuditLogStore
+	licenseUsage            store.LicenseUsageStore
+	customStatusTemplate    store.CustomStatusTemplateStore
+	restProxyBridge         store.RESTProxyBridgeStore
+	channelFeed             store.ChannelFeedStore
+	outstandingMention      store.OutstandingMentionStore
+	botState                store.BotStateStore
+	oauthAppUsage           store.OAuthAppUsageStore
+	teamGroupRole           store.TeamGroupRoleStore
 }
 
 type SqlStore struct {
@@ -194,6 +234,46 @@ func New(settings model.SqlSettings, metrics einterfaces.MetricsInterface) *SqlS
 	store.stores.scheme = newSqlSchemeStore(store)
 	store.stores.group = newSqlGroupStore(store)
 	store.stores.productNotices = newSqlProductNoticesStore(store)
+	store.stores.apiUsage = newSqlAPIUsageStore(store)
+	store.stores.postTask = newSqlPostTaskStore(store)
+	store.stores.channelDigest = newSqlChannelDigestStore(store)
+	store.stores.directChannelRetention = newSqlDirectChannelRetentionStore(store)
+	store.stores.postPriority = newSqlPostPriorityStore(store)
+	store.stores.postAcknowledgement = newSqlPostAcknowledgementStore(store)
+	store.stores.teamBanner = newSqlTeamBannerStore(store)
+	store.stores.emailSuppression = newSqlEmailSuppressionStore(store)
+	store.stores.cannedResponse = newSqlCannedResponseStore(store)
+	store.stores.teamRequest = newSqlTeamRequestStore(store)
+	store.stores.teamStats = newSqlTeamStatsStore(store)
+	store.stores.userMerge = newSqlUserMergeStore(store)
+	store.stores.teamDeletion = newSqlTeamDeletionStore(store)
+	store.stores.idempotencyKey = newSqlIdempotencyKeyStore(store)
+	store.stores.channelArchivePolicy = newSqlChannelArchivePolicyStore(store)
+	store.stores.permissionDenial = newSqlPermissionDenialStore(store)
+	store.stores.teamAlias = newSqlTeamAliasStore(store)
+	store.stores.botTokenRotation = newSqlBotTokenRotationStore(store)
+	store.stores.channelCommandOverride = newSqlChannelCommandOverrideStore(store)
+	store.stores.impersonation = newSqlImpersonationStore(store)
+	store.stores.scheduledChannelMessage = newSqlScheduledChannelMessageStore(store)
+	store.stores.eventWebhook = newSqlEventWebhookStore(store)
+	store.stores.configHistory = newSqlConfigHistoryStore(store)
+	store.stores.uploadUsage = newSqlUploadUsageStore(store)
+	store.stores.channelEvent = newSqlChannelEventStore(store)
+	store.stores.alertRule = newSqlAlertRuleStore(store)
+	store.stores.channelLanguageStats = newSqlChannelLanguageStatsStore(store)
+	store.stores.teamInviteUsage = newSqlTeamInviteUsageStore(store)
+	store.stores.postModeration = newSqlPostModerationStore(store)
+	store.stores.postReport = newSqlPostReportStore(store)
+	store.stores.activityEvent = newSqlActivityEventStore(store)
+	store.stores.auditLog = newSqlAuditLogStore(store)
+	store.stores.licenseUsage = newSqlLicenseUsageStore(store)
+	store.stores.customStatusTemplate = newSqlCustomStatusTemplateStore(store)
+	store.stores.restProxyBridge = newSqlRESTProxyBridgeStore(store)
+	store.stores.channelFeed = newSqlChannelFeedStore(store)
+	store.stores.outstandingMention = newSqlOutstandingMentionStore(store)
+	store.stores.botState = newSqlBotStateStore(store)
+	store.stores.oauthAppUsage = newSqlOAuthAppUsageStore(store)
+	store.stores.teamGroupRole = newSqlTeamGroupRoleStore(store)
 
 	err = upgradeDatabase(store, model.CurrentVersion)
 	if err != nil {
@@ -500,6 +580,19 @@ func (ss *SqlStore) ReplicaLagTime() error {
 	return nil
 }
 
+// PingReplicas pings every read replica, in the order they are configured.
+func (ss *SqlStore) PingReplicas() []store.ReplicaPing {
+	pings := make([]store.ReplicaPing, len(ss.ReplicaXs))
+	for i, replica := range ss.ReplicaXs {
+		ctx, cancel := context.WithTimeout(context.Background(), replica.queryTimeout)
+		start := time.Now()
+		err := replica.PingContext(ctx)
+		pings[i] = store.ReplicaPing{Latency: time.Since(start), Err: err}
+		cancel()
+	}
+	return pings
+}
+
 func (ss *SqlStore) TotalReadDbConnections() int {
 	if len(ss.settings.DataSourceReplicas) == 0 {
 		return 0
@@ -938,6 +1031,166 @@ func (ss *SqlStore) SharedChannel() store.SharedChannelStore {
 	return ss.stores.sharedchannel
 }
 
+func (ss *SqlStore) APIUsage() store.APIUsageStore {
+	return ss.stores.apiUsage
+}
+
+func (ss *SqlStore) PostTask() store.PostTaskStore {
+	return ss.stores.postTask
+}
+
+func (ss *SqlStore) ChannelDigest() store.ChannelDigestStore {
+	return ss.stores.channelDigest
+}
+
+func (ss *SqlStore) DirectChannelRetention() store.DirectChannelRetentionStore {
+	return ss.stores.directChannelRetention
+}
+
+func (ss *SqlStore) PostPriority() store.PostPriorityStore {
+	return ss.stores.postPriority
+}
+
+func (ss *SqlStore) PostAcknowledgement() store.PostAcknowledgementStore {
+	return ss.stores.postAcknowledgement
+}
+
+func (ss *SqlStore) TeamBanner() store.TeamBannerStore {
+	return ss.stores.teamBanner
+}
+
+func (ss *SqlStore) EmailSuppression() store.EmailSuppressionStore {
+	return ss.stores.emailSuppression
+}
+
+func (ss *SqlStore) CannedResponse() store.CannedResponseStore {
+	return ss.stores.cannedResponse
+}
+
+func (ss *SqlStore) TeamRequest() store.TeamRequestStore {
+	return ss.stores.teamRequest
+}
+
+func (ss *SqlStore) TeamStats() store.TeamStatsStore {
+	return ss.stores.teamStats
+}
+
+func (ss *SqlStore) UserMerge() store.UserMergeStore {
+	return ss.stores.userMerge
+}
+
+func (ss *SqlStore) TeamDeletion() store.TeamDeletionStore {
+	return ss.stores.teamDeletion
+}
+
+func (ss *SqlStore) IdempotencyKey() store.IdempotencyKeyStore {
+	return ss.stores.idempotencyKey
+}
+
+func (ss *SqlStore) ChannelArchivePolicy() store.ChannelArchivePolicyStore {
+	return ss.stores.channelArchivePolicy
+}
+
+func (ss *SqlStore) PermissionDenial() store.PermissionDenialStore {
+	return ss.stores.permissionDenial
+}
+
+func (ss *SqlStore) TeamAlias() store.TeamAliasStore {
+	return ss.stores.teamAlias
+}
+
+func (ss *SqlStore) BotTokenRotation() store.BotTokenRotationStore {
+	return ss.stores.botTokenRotation
+}
+
+func (ss *SqlStore) ChannelCommandOverride() store.ChannelCommandOverrideStore {
+	return ss.stores.channelCommandOverride
+}
+
+func (ss *SqlStore) Impersonation() store.ImpersonationStore {
+	return ss.stores.impersonation
+}
+
+func (ss *SqlStore) ScheduledChannelMessage() store.ScheduledChannelMessageStore {
+	return ss.stores.scheduledChannelMessage
+}
+
+func (ss *SqlStore) EventWebhook() store.EventWebhookStore {
+	return ss.stores.eventWebhook
+}
+
+func (ss *SqlStore) ConfigHistory() store.ConfigHistoryStore {
+	return ss.stores.configHistory
+}
+
+func (ss *SqlStore) UploadUsage() store.UploadUsageStore {
+	return ss.stores.uploadUsage
+}
+
+func (ss *SqlStore) ChannelEvent() store.ChannelEventStore {
+	return ss.stores.channelEvent
+}
+
+func (ss *SqlStore) AlertRule() store.AlertRuleStore {
+	return ss.stores.alertRule
+}
+
+func (ss *SqlStore) ChannelLanguageStats() store.ChannelLanguageStatsStore {
+	return ss.stores.channelLanguageStats
+}
+
+func (ss *SqlStore) TeamInviteUsage() store.TeamInviteUsageStore {
+	return ss.stores.teamInviteUsage
+}
+
+func (ss *SqlStore) PostModeration() store.PostModerationStore {
+	return ss.stores.postModeration
+}
+
+func (ss *SqlStore) PostReport() store.PostReportStore {
+	return ss.stores.postReport
+}
+
+func (ss *SqlStore) ActivityEvent() store.ActivityEventStore {
+	return ss.stores.activityEvent
+}
+
+func (ss *SqlStore) AuditLog() store.AuditLogStore {
+	return ss.stores.auditLog
+}
+
+func (ss *SqlStore) LicenseUsage() store.LicenseUsageStore {
+	return ss.stores.licenseUsage
+}
+
+func (ss *SqlStore) CustomStatusTemplate() store.CustomStatusTemplateStore {
+	return ss.stores.customStatusTemplate
+}
+
+func (ss *SqlStore) RESTProxyBridge() store.RESTProxyBridgeStore {
+	return ss.stores.restProxyBridge
+}
+
+func (ss *SqlStore) ChannelFeed() store.ChannelFeedStore {
+	return ss.stores.channelFeed
+}
+
+func (ss *SqlStore) OutstandingMention() store.OutstandingMentionStore {
+	return ss.stores.outstandingMention
+}
+
+func (ss *SqlStore) BotState() store.BotStateStore {
+	return ss.stores.botState
+}
+
+func (ss *SqlStore) OAuthAppUsage() store.OAuthAppUsageStore {
+	return ss.stores.oauthAppUsage
+}
+
+func (ss *SqlStore) TeamGroupRole() store.TeamGroupRoleStore {
+	return ss.stores.teamGroupRole
+}
+
 func (ss *SqlStore) DropAllTables() {
 	if ss.DriverName() == model.DatabaseDriverPostgres {
 		ss.masterX.Exec(`DO
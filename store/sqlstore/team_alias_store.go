@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamAliasStore struct {
+	*SqlStore
+}
+
+func newSqlTeamAliasStore(sqlStore *SqlStore) store.TeamAliasStore {
+	return &SqlTeamAliasStore{sqlStore}
+}
+
+var teamAliasColumns = []string{
+	"Name",
+	"TeamId",
+	"CreateAt",
+	"ExpireAt",
+}
+
+// teamAliasNotExpired matches the aliases which still resolve.
+func teamAliasNotExpired() sq.Sqlizer {
+	return sq.Or{sq.Eq{"ExpireAt": 0}, sq.Gt{"ExpireAt": model.GetMillis()}}
+}
+
+func (s SqlTeamAliasStore) Save(alias *model.TeamAlias) (*model.TeamAlias, error) {
+	alias.PreSave()
+	if err := alias.IsValid(); err != nil {
+		return nil, err
+	}
+
+	// The name of an expired alias is free to be taken again.
+	if _, err := s.GetMasterX().Exec("DELETE FROM TeamAliases WHERE Name = ? AND ExpireAt > 0 AND ExpireAt <= ?", alias.Name, model.GetMillis()); err != nil {
+		return nil, errors.Wrapf(err, "failed to delete expired TeamAlias with name=%s", alias.Name)
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("TeamAliases").
+		Columns(teamAliasColumns...).
+		Values(alias.Name, alias.TeamId, alias.CreateAt, alias.ExpireAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_alias_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "teamaliases_pkey"}) {
+			return nil, store.NewErrConflict("TeamAlias", err, "name="+alias.Name)
+		}
+		return nil, errors.Wrapf(err, "failed to save TeamAlias with name=%s", alias.Name)
+	}
+
+	return alias, nil
+}
+
+func (s SqlTeamAliasStore) Get(name string) (*model.TeamAlias, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamAliasColumns...).
+		From("TeamAliases").
+		Where(sq.And{sq.Eq{"Name": name}, teamAliasNotExpired()}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_alias_get_tosql")
+	}
+
+	var alias model.TeamAlias
+	if err := s.GetReplicaX().Get(&alias, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamAlias", name)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamAlias with name=%s", name)
+	}
+
+	return &alias, nil
+}
+
+func (s SqlTeamAliasStore) GetForTeam(teamID string) ([]*model.TeamAlias, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamAliasColumns...).
+		From("TeamAliases").
+		Where(sq.And{sq.Eq{"TeamId": teamID}, teamAliasNotExpired()}).
+		OrderBy("CreateAt", "Name").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_alias_get_for_team_tosql")
+	}
+
+	aliases := []*model.TeamAlias{}
+	if err := s.GetReplicaX().Select(&aliases, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get TeamAliases with teamId=%s", teamID)
+	}
+
+	return aliases, nil
+}
+
+func (s SqlTeamAliasStore) Delete(name string) error {
+	result, err := s.GetMasterX().Exec("DELETE FROM TeamAliases WHERE Name = ?", name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete TeamAlias with name=%s", name)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted TeamAlias")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("TeamAlias", name)
+	}
+
+	return nil
+}
+
+func (s SqlTeamAliasStore) DeleteForTeam(teamID string) error {
+	if _, err := s.GetMasterX().Exec("DELETE FROM TeamAliases WHERE TeamId = ?", teamID); err != nil {
+		return errors.Wrapf(err, "failed to delete TeamAliases with teamId=%s", teamID)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamBannerStore struct {
+	*SqlStore
+}
+
+func newSqlTeamBannerStore(sqlStore *SqlStore) store.TeamBannerStore {
+	return &SqlTeamBannerStore{sqlStore}
+}
+
+var teamBannerColumns = []string{
+	"Id",
+	"TeamId",
+	"CreatorId",
+	"Message",
+	"BackgroundColor",
+	"TextColor",
+	"AllowDismissal",
+	"StartAt",
+	"EndAt",
+	"CreateAt",
+	"UpdateAt",
+	"DeleteAt",
+}
+
+func (s SqlTeamBannerStore) Save(banner *model.TeamBanner) (*model.TeamBanner, error) {
+	if banner.Id != "" {
+		return nil, store.NewErrInvalidInput("TeamBanner", "id", banner.Id)
+	}
+
+	banner.PreSave()
+	if err := banner.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("TeamBanners").
+		Columns(teamBannerColumns...).
+		Values(
+			banner.Id,
+			banner.TeamId,
+			banner.CreatorId,
+			banner.Message,
+			banner.BackgroundColor,
+			banner.TextColor,
+			banner.AllowDismissal,
+			banner.StartAt,
+			banner.EndAt,
+			banner.CreateAt,
+			banner.UpdateAt,
+			banner.DeleteAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save TeamBanner with id=%s", banner.Id)
+	}
+
+	return banner, nil
+}
+
+func (s SqlTeamBannerStore) Update(banner *model.TeamBanner) (*model.TeamBanner, error) {
+	banner.PreUpdate()
+	if err := banner.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("TeamBanners").
+		SetMap(map[string]interface{}{
+			"Message":         banner.Message,
+			"BackgroundColor": banner.BackgroundColor,
+			"TextColor":       banner.TextColor,
+			"AllowDismissal":  banner.AllowDismissal,
+			"StartAt":         banner.StartAt,
+			"EndAt":           banner.EndAt,
+			"UpdateAt":        banner.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": banner.Id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update TeamBanner with id=%s", banner.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated TeamBanner")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("TeamBanner", banner.Id)
+	}
+
+	return banner, nil
+}
+
+// Get returns the banner with the given id, unless it was deleted.
+func (s SqlTeamBannerStore) Get(id string) (*model.TeamBanner, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamBannerColumns...).
+		From("TeamBanners").
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_get_tosql")
+	}
+
+	var banner model.TeamBanner
+	if err := s.GetReplicaX().Get(&banner, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamBanner", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamBanner with id=%s", id)
+	}
+
+	return &banner, nil
+}
+
+// GetForTeam returns the banners of the team that were not deleted, whether or not they are
+// currently scheduled, oldest first.
+func (s SqlTeamBannerStore) GetForTeam(teamID string) ([]*model.TeamBanner, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamBannerColumns...).
+		From("TeamBanners").
+		Where(sq.Eq{"TeamId": teamID, "DeleteAt": 0}).
+		OrderBy("CreateAt").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_getforteam_tosql")
+	}
+
+	banners := []*model.TeamBanner{}
+	if err := s.GetReplicaX().Select(&banners, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get TeamBanners with teamId=%s", teamID)
+	}
+
+	return banners, nil
+}
+
+// GetScheduledBetween returns the banners that were not deleted and are scheduled to start
+// or end after since and no later than until.
+func (s SqlTeamBannerStore) GetScheduledBetween(since, until int64) ([]*model.TeamBanner, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamBannerColumns...).
+		From("TeamBanners").
+		Where(sq.Eq{"DeleteAt": 0}).
+		Where(sq.Or{
+			sq.And{sq.Gt{"StartAt": since}, sq.LtOrEq{"StartAt": until}},
+			sq.And{sq.Gt{"EndAt": since}, sq.LtOrEq{"EndAt": until}},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_getscheduledbetween_tosql")
+	}
+
+	banners := []*model.TeamBanner{}
+	if err := s.GetReplicaX().Select(&banners, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get scheduled TeamBanners")
+	}
+
+	return banners, nil
+}
+
+func (s SqlTeamBannerStore) Delete(id string, deleteAt int64) error {
+	query, args, err := s.getQueryBuilder().
+		Update("TeamBanners").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_banner_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete TeamBanner with id=%s", id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted TeamBanner")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("TeamBanner", id)
+	}
+
+	return nil
+}
+
+// SaveDismissal records that the user dismissed the banner. Dismissing it again keeps the
+// time it was first dismissed.
+func (s SqlTeamBannerStore) SaveDismissal(dismissal *model.TeamBannerDismissal) error {
+	query := s.getQueryBuilder().
+		Insert("TeamBannerDismissals").
+		Columns("BannerId", "UserId", "DismissedAt").
+		Values(dismissal.BannerId, dismissal.UserId, dismissal.DismissedAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE DismissedAt = DismissedAt")
+	} else {
+		query = query.Suffix("ON CONFLICT (userid, bannerid) DO NOTHING")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_banner_savedismissal_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to save TeamBannerDismissal with bannerId=%s and userId=%s", dismissal.BannerId, dismissal.UserId)
+	}
+
+	return nil
+}
+
+// GetDismissedBannerIds returns which of the given banners the user dismissed.
+func (s SqlTeamBannerStore) GetDismissedBannerIds(userID string, bannerIDs []string) ([]string, error) {
+	dismissed := []string{}
+	if len(bannerIDs) == 0 {
+		return dismissed, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("BannerId").
+		From("TeamBannerDismissals").
+		Where(sq.Eq{"UserId": userID, "BannerId": bannerIDs}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_getdismissedbannerids_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&dismissed, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get dismissed TeamBanners for userId=%s", userID)
+	}
+
+	return dismissed, nil
+}
+
+// GetDismissingUserIds returns the users who dismissed the banner.
+func (s SqlTeamBannerStore) GetDismissingUserIds(bannerID string) ([]string, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("UserId").
+		From("TeamBannerDismissals").
+		Where(sq.Eq{"BannerId": bannerID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_banner_getdismissinguserids_tosql")
+	}
+
+	userIDs := []string{}
+	if err := s.GetReplicaX().Select(&userIDs, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get users dismissing TeamBanner with id=%s", bannerID)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamDeletionStore struct {
+	*SqlStore
+}
+
+func newSqlTeamDeletionStore(sqlStore *SqlStore) store.TeamDeletionStore {
+	return &SqlTeamDeletionStore{sqlStore}
+}
+
+var teamDeletionColumns = []string{
+	"TeamId",
+	"RequesterId",
+	"CreateAt",
+	"DeleteAt",
+	"ExportPath",
+	"ExportAt",
+}
+
+func (s SqlTeamDeletionStore) Save(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
+	deletion.PreSave()
+	if err := deletion.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("TeamDeletions").
+		Columns(teamDeletionColumns...).
+		Values(
+			deletion.TeamId,
+			deletion.RequesterId,
+			deletion.CreateAt,
+			deletion.DeleteAt,
+			deletion.ExportPath,
+			deletion.ExportAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_deletion_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "teamdeletions_pkey"}) {
+			return nil, store.NewErrConflict("TeamDeletion", err, "teamId="+deletion.TeamId)
+		}
+		return nil, errors.Wrapf(err, "failed to save TeamDeletion with teamId=%s", deletion.TeamId)
+	}
+
+	return deletion, nil
+}
+
+func (s SqlTeamDeletionStore) Get(teamID string) (*model.TeamDeletion, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamDeletionColumns...).
+		From("TeamDeletions").
+		Where(sq.Eq{"TeamId": teamID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_deletion_get_tosql")
+	}
+
+	var deletion model.TeamDeletion
+	if err := s.GetReplicaX().Get(&deletion, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamDeletion", teamID)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamDeletion with teamId=%s", teamID)
+	}
+
+	return &deletion, nil
+}
+
+// GetAll returns every scheduled team deletion, the earliest first.
+func (s SqlTeamDeletionStore) GetAll() ([]*model.TeamDeletion, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamDeletionColumns...).
+		From("TeamDeletions").
+		OrderBy("DeleteAt", "TeamId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_deletion_get_all_tosql")
+	}
+
+	deletions := []*model.TeamDeletion{}
+	if err := s.GetReplicaX().Select(&deletions, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get TeamDeletions")
+	}
+
+	return deletions, nil
+}
+
+func (s SqlTeamDeletionStore) Update(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
+	if err := deletion.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("TeamDeletions").
+		SetMap(map[string]interface{}{
+			"DeleteAt":   deletion.DeleteAt,
+			"ExportPath": deletion.ExportPath,
+			"ExportAt":   deletion.ExportAt,
+		}).
+		Where(sq.Eq{"TeamId": deletion.TeamId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_deletion_update_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update TeamDeletion with teamId=%s", deletion.TeamId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated TeamDeletion")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("TeamDeletion", deletion.TeamId)
+	}
+
+	return deletion, nil
+}
+
+func (s SqlTeamDeletionStore) Delete(teamID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("TeamDeletions").
+		Where(sq.Eq{"TeamId": teamID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_deletion_delete_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete TeamDeletion with teamId=%s", teamID)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected for deleted TeamDeletion")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("TeamDeletion", teamID)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamGroupRoleStore struct {
+	*SqlStore
+}
+
+func newSqlTeamGroupRoleStore(sqlStore *SqlStore) store.TeamGroupRoleStore {
+	return &SqlTeamGroupRoleStore{sqlStore}
+}
+
+// Save maps the group to the role on the team, or changes the role it's mapped to when it
+// already is.
+func (s SqlTeamGroupRoleStore) Save(mapping *model.TeamGroupRole) (*model.TeamGroupRole, error) {
+	mapping.PreSave()
+	if err := mapping.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("TeamGroupRoles").
+		Columns("TeamId", "GroupId", "Role", "CreatorId", "CreateAt").
+		Values(mapping.TeamId, mapping.GroupId, mapping.Role, mapping.CreatorId, mapping.CreateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Role = VALUES(Role)")
+	} else {
+		query = query.Suffix("ON CONFLICT (teamid, groupid) DO UPDATE SET role = EXCLUDED.role")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_group_role_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save TeamGroupRole with teamId=%s and groupId=%s", mapping.TeamId, mapping.GroupId)
+	}
+
+	return mapping, nil
+}
+
+func (s SqlTeamGroupRoleStore) Delete(teamID, groupID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("TeamGroupRoles").
+		Where(sq.Eq{"TeamId": teamID, "GroupId": groupID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_group_role_delete_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete TeamGroupRole with teamId=%s and groupId=%s", teamID, groupID)
+	}
+
+	return nil
+}
+
+func (s SqlTeamGroupRoleStore) getMappings(where sq.Eq) ([]*model.TeamGroupRole, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("TeamId", "GroupId", "Role", "CreatorId", "CreateAt").
+		From("TeamGroupRoles").
+		Where(where).
+		OrderBy("CreateAt", "GroupId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_group_role_get_tosql")
+	}
+
+	mappings := []*model.TeamGroupRole{}
+	if err := s.GetReplicaX().Select(&mappings, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get TeamGroupRoles")
+	}
+
+	return mappings, nil
+}
+
+func (s SqlTeamGroupRoleStore) GetForTeam(teamID string) ([]*model.TeamGroupRole, error) {
+	return s.getMappings(sq.Eq{"TeamId": teamID})
+}
+
+func (s SqlTeamGroupRoleStore) GetForGroup(groupID string) ([]*model.TeamGroupRole, error) {
+	return s.getMappings(sq.Eq{"GroupId": groupID})
+}
+
+// GetTeamIDs returns the teams with groups mapped to roles, or with roles still granted through
+// groups no longer mapped.
+func (s SqlTeamGroupRoleStore) GetTeamIDs() ([]string, error) {
+	teamIDs := []string{}
+	if err := s.GetReplicaX().Select(&teamIDs, "SELECT TeamId FROM TeamGroupRoles UNION SELECT TeamId FROM TeamGroupRoleGrants"); err != nil {
+		return nil, errors.Wrap(err, "failed to get the teams of TeamGroupRoles")
+	}
+
+	return teamIDs, nil
+}
+
+// GetMembers returns the active members of the team who can be team admins, that is everyone but
+// the guests, along with whether they are in a group mapped to a role on the team and whether
+// they were granted the team admin role through one.
+func (s SqlTeamGroupRoleStore) GetMembers(teamID string) ([]*model.TeamGroupRoleMember, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(
+			"TeamMembers.UserId",
+			"COALESCE(TeamMembers.SchemeAdmin, FALSE) AS SchemeAdmin",
+			`EXISTS (
+				SELECT 1 FROM TeamGroupRoles
+				JOIN GroupMembers ON GroupMembers.GroupId = TeamGroupRoles.GroupId
+				JOIN UserGroups ON UserGroups.Id = TeamGroupRoles.GroupId
+				WHERE TeamGroupRoles.TeamId = TeamMembers.TeamId
+				AND GroupMembers.UserId = TeamMembers.UserId
+				AND GroupMembers.DeleteAt = 0
+				AND UserGroups.DeleteAt = 0
+			) AS InMappedGroup`,
+			`EXISTS (
+				SELECT 1 FROM TeamGroupRoleGrants
+				WHERE TeamGroupRoleGrants.TeamId = TeamMembers.TeamId
+				AND TeamGroupRoleGrants.UserId = TeamMembers.UserId
+			) AS Granted`,
+		).
+		From("TeamMembers").
+		Join("Users ON Users.Id = TeamMembers.UserId").
+		Where(sq.Eq{"TeamMembers.TeamId": teamID, "TeamMembers.DeleteAt": 0, "Users.DeleteAt": 0}).
+		Where("COALESCE(TeamMembers.SchemeGuest, FALSE) = FALSE").
+		OrderBy("TeamMembers.UserId").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_group_role_getmembers_tosql")
+	}
+
+	members := []*model.TeamGroupRoleMember{}
+	if err := s.GetMasterX().Select(&members, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get TeamGroupRole members with teamId=%s", teamID)
+	}
+
+	return members, nil
+}
+
+// SaveGrants records that the users were made team admins by a group. Existing grants are kept as
+// they are.
+func (s SqlTeamGroupRoleStore) SaveGrants(teamID string, userIDs []string, grantedAt int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("TeamGroupRoleGrants").
+		Columns("TeamId", "UserId", "GrantedAt")
+	for _, userID := range userIDs {
+		builder = builder.Values(teamID, userID, grantedAt)
+	}
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		builder = builder.Suffix("ON DUPLICATE KEY UPDATE GrantedAt = GrantedAt")
+	} else {
+		builder = builder.Suffix("ON CONFLICT (teamid, userid) DO NOTHING")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_group_role_savegrants_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to save TeamGroupRoleGrants with teamId=%s", teamID)
+	}
+
+	return nil
+}
+
+func (s SqlTeamGroupRoleStore) DeleteGrants(teamID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Delete("TeamGroupRoleGrants").
+		Where(sq.Eq{"TeamId": teamID, "UserId": userIDs}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_group_role_deletegrants_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete TeamGroupRoleGrants with teamId=%s", teamID)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamInviteUsageStore struct {
+	*SqlStore
+}
+
+func newSqlTeamInviteUsageStore(sqlStore *SqlStore) store.TeamInviteUsageStore {
+	return &SqlTeamInviteUsageStore{sqlStore}
+}
+
+// Increment adds the invitations and extra invitations of the given usage to the stored ones,
+// creating the row if it doesn't exist yet.
+func (s SqlTeamInviteUsageStore) Increment(usage *model.TeamInviteUsage) error {
+	if err := usage.IsValid(); err != nil {
+		return err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("TeamInviteUsage").
+		Columns("TeamId", "Day", "Invites", "ExtraInvites").
+		Values(usage.TeamId, usage.Day, usage.Invites, usage.ExtraInvites)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Invites = Invites + VALUES(Invites), ExtraInvites = ExtraInvites + VALUES(ExtraInvites)")
+	} else {
+		query = query.Suffix("ON CONFLICT (teamid, day) DO UPDATE SET Invites = TeamInviteUsage.Invites + EXCLUDED.Invites, ExtraInvites = TeamInviteUsage.ExtraInvites + EXCLUDED.ExtraInvites")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_invite_usage_increment_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to increment TeamInviteUsage with teamId=%s", usage.TeamId)
+	}
+
+	return nil
+}
+
+// Get returns the invitations sent to join the given team on the given day. A team without
+// invitations that day gets an empty usage.
+func (s SqlTeamInviteUsageStore) Get(teamID string, day int64) (*model.TeamInviteUsage, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select("TeamId", "Day", "Invites", "ExtraInvites").
+		From("TeamInviteUsage").
+		Where(sq.Eq{"TeamId": teamID, "Day": day}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_invite_usage_get_tosql")
+	}
+
+	// Read from master, since the usage is checked right before sending invitations.
+	var usage model.TeamInviteUsage
+	if err := s.GetMasterX().Get(&usage, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return &model.TeamInviteUsage{TeamId: teamID, Day: day}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamInviteUsage with teamId=%s", teamID)
+	}
+
+	return &usage, nil
+}
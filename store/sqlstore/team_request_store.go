@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamRequestStore struct {
+	*SqlStore
+}
+
+func newSqlTeamRequestStore(sqlStore *SqlStore) store.TeamRequestStore {
+	return &SqlTeamRequestStore{sqlStore}
+}
+
+var teamRequestColumns = []string{
+	"Id",
+	"RequesterId",
+	"Name",
+	"DisplayName",
+	"Type",
+	"Justification",
+	"Status",
+	"ReviewerId",
+	"ReviewNote",
+	"TeamId",
+	"CreateAt",
+	"UpdateAt",
+}
+
+func (s SqlTeamRequestStore) Save(request *model.TeamRequest) (*model.TeamRequest, error) {
+	if request.Id != "" {
+		return nil, store.NewErrInvalidInput("TeamRequest", "id", request.Id)
+	}
+
+	request.PreSave()
+	if err := request.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("TeamRequests").
+		Columns(teamRequestColumns...).
+		Values(
+			request.Id,
+			request.RequesterId,
+			request.Name,
+			request.DisplayName,
+			request.Type,
+			request.Justification,
+			request.Status,
+			request.ReviewerId,
+			request.ReviewNote,
+			request.TeamId,
+			request.CreateAt,
+			request.UpdateAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_request_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save TeamRequest with id=%s", request.Id)
+	}
+
+	return request, nil
+}
+
+func (s SqlTeamRequestStore) Get(id string) (*model.TeamRequest, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(teamRequestColumns...).
+		From("TeamRequests").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_request_get_tosql")
+	}
+
+	var request model.TeamRequest
+	if err := s.GetReplicaX().Get(&request, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("TeamRequest", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get TeamRequest with id=%s", id)
+	}
+
+	return &request, nil
+}
+
+// GetAll returns a page of team requests, oldest first. When status is not empty, only the
+// requests with that status are returned.
+func (s SqlTeamRequestStore) GetAll(status string, offset, limit int) ([]*model.TeamRequest, error) {
+	builder := s.getQueryBuilder().
+		Select(teamRequestColumns...).
+		From("TeamRequests").
+		OrderBy("CreateAt", "Id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+	if status != "" {
+		builder = builder.Where(sq.Eq{"Status": status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_request_getall_tosql")
+	}
+
+	requests := []*model.TeamRequest{}
+	if err := s.GetReplicaX().Select(&requests, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to get TeamRequests")
+	}
+
+	return requests, nil
+}
+
+// Review records the outcome of a pending team request. A conflict is returned when the
+// request has already been reviewed, so that two admins can't act on the same request.
+func (s SqlTeamRequestStore) Review(request *model.TeamRequest) (*model.TeamRequest, error) {
+	request.PreUpdate()
+	if err := request.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("TeamRequests").
+		SetMap(map[string]interface{}{
+			"Status":     request.Status,
+			"ReviewerId": request.ReviewerId,
+			"ReviewNote": request.ReviewNote,
+			"TeamId":     request.TeamId,
+			"UpdateAt":   request.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": request.Id, "Status": model.TeamRequestStatusPending}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_request_review_tosql")
+	}
+
+	result, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to review TeamRequest with id=%s", request.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for reviewed TeamRequest")
+	}
+	if count == 0 {
+		if _, err := s.Get(request.Id); err != nil {
+			return nil, err
+		}
+		return nil, store.NewErrConflict("TeamRequest", nil, "id="+request.Id)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlTeamStatsStore struct {
+	*SqlStore
+}
+
+func newSqlTeamStatsStore(sqlStore *SqlStore) store.TeamStatsStore {
+	return &SqlTeamStatsStore{sqlStore}
+}
+
+// teamPostsOfDay selects the posts made in team channels during the given day, leaving out
+// deleted and system posts.
+func (ss *SqlStore) teamPostsOfDay(day int64, columns ...string) sq.SelectBuilder {
+	return ss.getQueryBuilder().
+		Select(columns...).
+		From("Posts p").
+		Join("Channels c ON c.Id = p.ChannelId").
+		Where(sq.NotEq{"c.TeamId": ""}).
+		Where(sq.GtOrEq{"p.CreateAt": day}).
+		Where(sq.Lt{"p.CreateAt": day + model.TeamStatsDayMillis}).
+		Where(sq.Eq{"p.DeleteAt": 0}).
+		Where(sq.NotLike{"p.Type": model.PostSystemMessagePrefix + "%"})
+}
+
+// dayColumn selects the day as a column of the daily stats rollups. The day is inlined rather
+// than bound so that its type is known to the database.
+func dayColumn(day int64) string {
+	return strconv.FormatInt(day, 10)
+}
+
+// rollupDayStats replaces the rows of the day in the daily stats table with the ones selected.
+func (ss *SqlStore) rollupDayStats(txn *sqlxTxWrapper, table string, day int64, columns []string, rows sq.SelectBuilder) error {
+	query, args, err := ss.getQueryBuilder().Delete(table).Where(sq.Eq{"Day": day}).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "daily_stats_delete_tosql")
+	}
+	if _, err := txn.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete the %s of day=%d", table, day)
+	}
+
+	query, args, err = ss.getQueryBuilder().Insert(table).Columns(columns...).Select(rows).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "daily_stats_insert_tosql")
+	}
+	if _, err := txn.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to roll up the %s of day=%d", table, day)
+	}
+
+	return nil
+}
+
+// RollupDay computes the stats of every team for the day starting at the given time,
+// replacing those computed by a previous run.
+func (s SqlTeamStatsStore) RollupDay(day int64) error {
+	txn, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(txn)
+
+	if err := s.rollupDayStats(txn, "TeamChannelDailyStats", day,
+		[]string{"TeamId", "ChannelId", "Day", "PostCount", "ActiveMembers"},
+		s.teamPostsOfDay(day, "c.TeamId", "p.ChannelId", dayColumn(day), "COUNT(*)", "COUNT(DISTINCT p.UserId)").GroupBy("c.TeamId", "p.ChannelId"),
+	); err != nil {
+		return err
+	}
+
+	if err := s.rollupDayStats(txn, "TeamUserDailyStats", day,
+		[]string{"TeamId", "UserId", "Day", "PostCount"},
+		s.teamPostsOfDay(day, "c.TeamId", "p.UserId", dayColumn(day), "COUNT(*)").GroupBy("c.TeamId", "p.UserId"),
+	); err != nil {
+		return err
+	}
+
+	query, args, err := s.getQueryBuilder().Delete("TeamDailyStats").Where(sq.Eq{"Day": day}).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_stats_delete_tosql")
+	}
+	if _, err := txn.Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete the TeamDailyStats of day=%d", day)
+	}
+
+	statsByTeam := map[string]*model.TeamDailyStats{}
+	teamStats := func(teamID string) *model.TeamDailyStats {
+		if _, ok := statsByTeam[teamID]; !ok {
+			statsByTeam[teamID] = &model.TeamDailyStats{TeamId: teamID, Day: day}
+		}
+		return statsByTeam[teamID]
+	}
+
+	// The posts of the day were just rolled up per user, so the team totals are taken from
+	// there rather than from Posts again.
+	query, args, err = s.getQueryBuilder().
+		Select("TeamId", "COUNT(*) AS ActiveUsers", "SUM(PostCount) AS PostCount").
+		From("TeamUserDailyStats").
+		Where(sq.Eq{"Day": day}).
+		GroupBy("TeamId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_post_stats_tosql")
+	}
+	postStats := []*model.TeamDailyStats{}
+	if err := txn.Select(&postStats, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to get the post stats of day=%d", day)
+	}
+	for _, stats := range postStats {
+		teamStats(stats.TeamId).ActiveUsers = stats.ActiveUsers
+		teamStats(stats.TeamId).PostCount = stats.PostCount
+	}
+
+	query, args, err = s.getQueryBuilder().
+		Select("c.TeamId", "COUNT(*) AS FileCount", "SUM(f.Size) AS FileSize").
+		From("FileInfo f").
+		Join("Posts p ON p.Id = f.PostId").
+		Join("Channels c ON c.Id = p.ChannelId").
+		Where(sq.NotEq{"c.TeamId": ""}).
+		Where(sq.GtOrEq{"f.CreateAt": day}).
+		Where(sq.Lt{"f.CreateAt": day + model.TeamStatsDayMillis}).
+		Where(sq.Eq{"f.DeleteAt": 0}).
+		GroupBy("c.TeamId").
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_file_stats_tosql")
+	}
+	fileStats := []*model.TeamDailyStats{}
+	if err := txn.Select(&fileStats, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to get the file stats of day=%d", day)
+	}
+	for _, stats := range fileStats {
+		teamStats(stats.TeamId).FileCount = stats.FileCount
+		teamStats(stats.TeamId).FileSize = stats.FileSize
+	}
+
+	if len(statsByTeam) > 0 {
+		insert := s.getQueryBuilder().
+			Insert("TeamDailyStats").
+			Columns("TeamId", "Day", "ActiveUsers", "PostCount", "FileCount", "FileSize")
+		for _, stats := range statsByTeam {
+			insert = insert.Values(stats.TeamId, stats.Day, stats.ActiveUsers, stats.PostCount, stats.FileCount, stats.FileSize)
+		}
+		query, args, err = insert.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "team_daily_stats_insert_tosql")
+		}
+		if _, err := txn.Exec(query, args...); err != nil {
+			return errors.Wrapf(err, "failed to save the team stats of day=%d", day)
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlTeamStatsStore) GetDailyStats(teamID string, since, until int64) ([]*model.TeamDailyStats, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("TeamId", "Day", "ActiveUsers", "PostCount", "FileCount", "FileSize").
+		From("TeamDailyStats").
+		Where(sq.Eq{"TeamId": teamID}).
+		Where(sq.GtOrEq{"Day": since}).
+		Where(sq.Lt{"Day": until}).
+		OrderBy("Day").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_daily_stats_tosql")
+	}
+
+	stats := []*model.TeamDailyStats{}
+	if err := s.GetReplicaX().Select(&stats, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the daily stats of teamId=%s", teamID)
+	}
+
+	return stats, nil
+}
+
+func (s SqlTeamStatsStore) GetChannelPostCounts(teamID string, since, until int64, limit int) ([]*model.TeamChannelPostCount, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("ChannelId", "SUM(PostCount) AS PostCount").
+		From("TeamChannelDailyStats").
+		Where(sq.Eq{"TeamId": teamID}).
+		Where(sq.GtOrEq{"Day": since}).
+		Where(sq.Lt{"Day": until}).
+		GroupBy("ChannelId").
+		OrderBy("PostCount DESC", "ChannelId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_channel_post_counts_tosql")
+	}
+
+	counts := []*model.TeamChannelPostCount{}
+	if err := s.GetReplicaX().Select(&counts, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the channel post counts of teamId=%s", teamID)
+	}
+
+	return counts, nil
+}
+
+// GetChannelDailyStats returns the stats of the channel for the days rolled up between since
+// and until, oldest first. The days without posts are left out.
+func (s SqlTeamStatsStore) GetChannelDailyStats(channelID string, since, until int64) ([]*model.ChannelDailyStats, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("ChannelId", "Day", "PostCount", "ActiveMembers").
+		From("TeamChannelDailyStats").
+		Where(sq.Eq{"ChannelId": channelID}).
+		Where(sq.GtOrEq{"Day": since}).
+		Where(sq.Lt{"Day": until}).
+		OrderBy("Day").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_channel_daily_stats_tosql")
+	}
+
+	stats := []*model.ChannelDailyStats{}
+	if err := s.GetReplicaX().Select(&stats, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the daily stats of channelId=%s", channelID)
+	}
+
+	return stats, nil
+}
+
+func (s SqlTeamStatsStore) GetTopPosters(teamID string, since, until int64, limit int) ([]*model.TeamPosterCount, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("UserId", "SUM(PostCount) AS PostCount").
+		From("TeamUserDailyStats").
+		Where(sq.Eq{"TeamId": teamID}).
+		Where(sq.GtOrEq{"Day": since}).
+		Where(sq.Lt{"Day": until}).
+		GroupBy("UserId").
+		OrderBy("PostCount DESC", "UserId").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_top_posters_tosql")
+	}
+
+	counts := []*model.TeamPosterCount{}
+	if err := s.GetReplicaX().Select(&counts, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the top posters of teamId=%s", teamID)
+	}
+
+	return counts, nil
+}
+
+// GetFileUsage returns the number and total size of the files shared in the team, as of the
+// last rollup.
+func (s SqlTeamStatsStore) GetFileUsage(teamID string) (int64, int64, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("COALESCE(SUM(FileCount), 0) AS FileCount", "COALESCE(SUM(FileSize), 0) AS FileSize").
+		From("TeamDailyStats").
+		Where(sq.Eq{"TeamId": teamID}).
+		ToSql()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "team_file_usage_tosql")
+	}
+
+	var usage model.TeamDailyStats
+	if err := s.GetReplicaX().Get(&usage, query, args...); err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get the file usage of teamId=%s", teamID)
+	}
+
+	return usage.FileCount, usage.FileSize, nil
+}
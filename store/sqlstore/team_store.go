@@ -225,12 +225,16 @@ func (s SqlTeamStore) Save(team *model.Team) (*model.Team, error) {
 		return nil, err
 	}
 
+	if err := s.checkParentTeam(team); err != nil {
+		return nil, err
+	}
+
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO Teams
 		(Id, CreateAt, UpdateAt, DeleteAt, DisplayName, Name, Description, Email, Type, CompanyName, AllowedDomains,
-		InviteId, AllowOpenInvite, LastTeamIconUpdate, SchemeId, GroupConstrained)
+		InviteId, AllowOpenInvite, LastTeamIconUpdate, SchemeId, GroupConstrained, ParentTeamId, InheritParentMembership, EmailVerified, DataResidency, StrictIsolation)
 		VALUES
 		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :DisplayName, :Name, :Description, :Email, :Type, :CompanyName, :AllowedDomains,
-		:InviteId, :AllowOpenInvite, :LastTeamIconUpdate, :SchemeId, :GroupConstrained)`, team); err != nil {
+		:InviteId, :AllowOpenInvite, :LastTeamIconUpdate, :SchemeId, :GroupConstrained, :ParentTeamId, :InheritParentMembership, :EmailVerified, :DataResidency, :StrictIsolation)`, team); err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "teams_name_key"}) {
 			return nil, store.NewErrInvalidInput("Team", "id", team.Id)
 		}
@@ -261,6 +265,12 @@ func (s SqlTeamStore) Update(team *model.Team) (*model.Team, error) {
 		return nil, store.NewErrInvalidInput("Team", "id", team.Id)
 	}
 
+	if team.ParentTeamId != oldTeam.ParentTeamId {
+		if err = s.checkParentTeam(team); err != nil {
+			return nil, err
+		}
+	}
+
 	team.CreateAt = oldTeam.CreateAt
 	team.UpdateAt = model.GetMillis()
 
@@ -268,7 +278,9 @@ func (s SqlTeamStore) Update(team *model.Team) (*model.Team, error) {
 			SET CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, DisplayName=:DisplayName, Name=:Name,
 				Description=:Description, Email=:Email, Type=:Type, CompanyName=:CompanyName, AllowedDomains=:AllowedDomains,
 				InviteId=:InviteId, AllowOpenInvite=:AllowOpenInvite, LastTeamIconUpdate=:LastTeamIconUpdate,
-				SchemeId=:SchemeId, GroupConstrained=:GroupConstrained
+				SchemeId=:SchemeId, GroupConstrained=:GroupConstrained, ParentTeamId=:ParentTeamId,
+				InheritParentMembership=:InheritParentMembership, EmailVerified=:EmailVerified,
+				DataResidency=:DataResidency, StrictIsolation=:StrictIsolation
 			WHERE Id=:Id`, team)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update Team with id=%s", team.Id)
@@ -285,6 +297,54 @@ func (s SqlTeamStore) Update(team *model.Team) (*model.Team, error) {
 	return team, nil
 }
 
+// checkParentTeam makes sure that the parent of the team exists, and that setting it
+// neither creates a cycle nor a hierarchy deeper than model.TeamMaxHierarchyDepth.
+func (s SqlTeamStore) checkParentTeam(team *model.Team) error {
+	if team.ParentTeamId == "" {
+		return nil
+	}
+
+	ancestors := 0
+	for parentID := team.ParentTeamId; parentID != ""; ancestors++ {
+		if parentID == team.Id || ancestors >= model.TeamMaxHierarchyDepth {
+			return store.NewErrInvalidInput("Team", "ParentTeamId", team.ParentTeamId)
+		}
+
+		var nextParentID string
+		if err := s.GetMasterX().Get(&nextParentID, `SELECT ParentTeamId FROM Teams WHERE Id=?`, parentID); err != nil {
+			if err == sql.ErrNoRows {
+				return store.NewErrInvalidInput("Team", "ParentTeamId", team.ParentTeamId)
+			}
+			return errors.Wrapf(err, "failed to get Team with id=%s", parentID)
+		}
+		parentID = nextParentID
+	}
+
+	// The sub-teams of the team move along with it.
+	levelIDs := []string{team.Id}
+	for depth := ancestors; len(levelIDs) > 0; depth++ {
+		if depth > model.TeamMaxHierarchyDepth {
+			return store.NewErrInvalidInput("Team", "ParentTeamId", team.ParentTeamId)
+		}
+
+		query, args, err := s.getQueryBuilder().
+			Select("Id").
+			From("Teams").
+			Where(sq.Eq{"ParentTeamId": levelIDs}).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "team_tosql")
+		}
+
+		levelIDs = []string{}
+		if err := s.GetMasterX().Select(&levelIDs, query, args...); err != nil {
+			return errors.Wrapf(err, "failed to get the sub-teams of Team with id=%s", team.Id)
+		}
+	}
+
+	return nil
+}
+
 // Get returns from the database the team that matches the id provided as parameter.
 // If the team doesn't exist it returns a model.AppError with a
 // http.StatusNotFound in the StatusCode field.
@@ -601,6 +661,22 @@ func (s SqlTeamStore) GetTeamsByUserId(userId string) ([]*model.Team, error) {
 	return teams, nil
 }
 
+// GetChildTeams returns the teams that aren't deleted and are direct sub-teams of the given team.
+func (s SqlTeamStore) GetChildTeams(parentTeamID string) ([]*model.Team, error) {
+	query, args, err := s.teamsQuery.
+		Where(sq.Eq{"ParentTeamId": parentTeamID, "DeleteAt": 0}).
+		OrderBy("DisplayName").ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "team_tosql")
+	}
+
+	teams := []*model.Team{}
+	if err = s.GetReplicaX().Select(&teams, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find the sub-teams of Team with id=%s", parentTeamID)
+	}
+	return teams, nil
+}
+
 // GetAllPrivateTeamListing returns all private teams.
 func (s SqlTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
 	query, args, err := s.teamsQuery.Where(sq.Eq{"AllowOpenInvite": false}).
@@ -644,6 +720,17 @@ func (s SqlTeamStore) PermanentDelete(teamId string) error {
 	if _, err = s.GetMasterX().Exec(sql, args...); err != nil {
 		return errors.Wrapf(err, "failed to delete Team with id=%s", teamId)
 	}
+
+	sql, args, err = s.getQueryBuilder().
+		Update("Teams").
+		Set("ParentTeamId", "").
+		Where(sq.Eq{"ParentTeamId": teamId}).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "team_tosql")
+	}
+	if _, err = s.GetMasterX().Exec(sql, args...); err != nil {
+		return errors.Wrapf(err, "failed to detach the sub-teams of Team with id=%s", teamId)
+	}
 	return nil
 }
 
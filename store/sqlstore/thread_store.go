@@ -838,6 +838,84 @@ func (s *SqlThreadStore) GetPosts(threadId string, since int64) ([]*model.Post,
 	return result, nil
 }
 
+// GetSummary returns the participants, the reply counts per bucket of bucketSize milliseconds and
+// the given number of most recent replies of the thread. The users of the participants are left
+// for the caller to fill in.
+func (s *SqlThreadStore) GetSummary(threadID string, bucketSize int64, replies int) (*model.ThreadSummary, error) {
+	summary := &model.ThreadSummary{
+		PostId:       threadID,
+		BucketSize:   bucketSize,
+		Participants: []*model.ThreadParticipant{},
+		ReplyBuckets: []*model.ThreadReplyBucket{},
+		LastReplies:  model.NewPostList(),
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("UserId", "COUNT(*) AS ReplyCount", "MAX(CreateAt) AS LastReplyAt").
+		From("Posts").
+		Where(sq.Eq{"RootId": threadID}).
+		Where(sq.Eq{"DeleteAt": 0}).
+		GroupBy("UserId").
+		OrderBy("LastReplyAt DESC").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "thread_summary_participants_tosql")
+	}
+	if err := s.GetReplicaX().Select(&summary.Participants, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the participants of thread with id=%s", threadID)
+	}
+
+	for _, participant := range summary.Participants {
+		summary.ReplyCount += participant.ReplyCount
+		if participant.LastReplyAt > summary.LastReplyAt {
+			summary.LastReplyAt = participant.LastReplyAt
+		}
+	}
+	if summary.ReplyCount == 0 {
+		return summary, nil
+	}
+
+	bucket := "CreateAt - CreateAt % " + strconv.FormatInt(bucketSize, 10)
+	query, args, err = s.getQueryBuilder().
+		Select(bucket+" AS Start", "COUNT(*) AS ReplyCount").
+		From("Posts").
+		Where(sq.Eq{"RootId": threadID}).
+		Where(sq.Eq{"DeleteAt": 0}).
+		GroupBy(bucket).
+		OrderBy("Start").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "thread_summary_buckets_tosql")
+	}
+	if err := s.GetReplicaX().Select(&summary.ReplyBuckets, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the reply buckets of thread with id=%s", threadID)
+	}
+
+	if replies > 0 {
+		query, args, err = s.getQueryBuilder().
+			Select("*").
+			From("Posts").
+			Where(sq.Eq{"RootId": threadID}).
+			Where(sq.Eq{"DeleteAt": 0}).
+			OrderBy("CreateAt DESC").
+			Limit(uint64(replies)).
+			ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "thread_summary_replies_tosql")
+		}
+		posts := []*model.Post{}
+		if err := s.GetReplicaX().Select(&posts, query, args...); err != nil {
+			return nil, errors.Wrapf(err, "failed to get the last replies of thread with id=%s", threadID)
+		}
+		for _, post := range posts {
+			summary.LastReplies.AddPost(post)
+			summary.LastReplies.AddOrder(post.Id)
+		}
+	}
+
+	return summary, nil
+}
+
 // PermanentDeleteBatchForRetentionPolicies deletes a batch of records which are affected by
 // the global or a granular retention policy.
 // See `genericPermanentDeleteBatchForRetentionPolicies` for details.
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+type SqlUploadUsageStore struct {
+	*SqlStore
+}
+
+func newSqlUploadUsageStore(sqlStore *SqlStore) store.UploadUsageStore {
+	return &SqlUploadUsageStore{sqlStore}
+}
+
+// Increment adds the bytes of the given usage to the stored ones, creating the row if it
+// doesn't exist yet.
+func (s SqlUploadUsageStore) Increment(usage *model.UploadUsage) error {
+	if err := usage.IsValid(); err != nil {
+		return err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("UploadUsage").
+		Columns("UserId", "Day", "Bytes").
+		Values(usage.UserId, usage.Day, usage.Bytes)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.Suffix("ON DUPLICATE KEY UPDATE Bytes = Bytes + VALUES(Bytes)")
+	} else {
+		query = query.Suffix("ON CONFLICT (userid, day) DO UPDATE SET Bytes = UploadUsage.Bytes + EXCLUDED.Bytes")
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "upload_usage_increment_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to increment UploadUsage with userId=%s", usage.UserId)
+	}
+
+	return nil
+}
+
+// GetForUser returns the number of bytes the given user uploaded on the given day and in total.
+func (s SqlUploadUsageStore) GetForUser(userID string, day int64) (int64, int64, error) {
+	queryString, args, err := s.getQueryBuilder().
+		Select().
+		Column("COALESCE(SUM(CASE WHEN Day = ? THEN Bytes ELSE 0 END), 0) AS DailyBytes", day).
+		Column("COALESCE(SUM(Bytes), 0) AS TotalBytes").
+		From("UploadUsage").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "upload_usage_get_for_user_tosql")
+	}
+
+	// Read from master, since the usage is checked right before accepting an upload.
+	var usage struct {
+		DailyBytes int64
+		TotalBytes int64
+	}
+	if err := s.GetMasterX().Get(&usage, queryString, args...); err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get UploadUsage with userId=%s", userID)
+	}
+
+	return usage.DailyBytes, usage.TotalBytes, nil
+}
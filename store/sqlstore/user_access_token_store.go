@@ -29,8 +29,8 @@ func (s SqlUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.User
 	}
 
 	query, args, err := s.getQueryBuilder().Insert("UserAccessTokens").
-		Columns("Id", "Token", "UserId", "Description", "IsActive").
-		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive).
+		Columns("Id", "Token", "UserId", "Description", "IsActive", "CreateAt", "ExpiresAt", "WebSocketScope").
+		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive, token.CreateAt, token.ExpiresAt, token.WebSocketScope).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "UserAccessToken_tosql")
@@ -236,3 +236,44 @@ func (s SqlUserAccessTokenStore) updateTokenDisable(transaction *sqlxTxWrapper,
 
 	return nil
 }
+
+// UpdateExpiresAt changes when the token expires, the sessions made with it expiring no later.
+func (s SqlUserAccessTokenStore) UpdateExpiresAt(tokenId string, expiresAt int64) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction)
+
+	var token model.UserAccessToken
+	if err := transaction.Get(&token, "SELECT * FROM UserAccessTokens WHERE Id = ?", tokenId); err != nil {
+		if err == sql.ErrNoRows {
+			return store.NewErrNotFound("UserAccessToken", tokenId)
+		}
+		return errors.Wrapf(err, "failed to get UserAccessToken with id=%s", tokenId)
+	}
+
+	if _, err := transaction.Exec("UPDATE UserAccessTokens SET ExpiresAt = ? WHERE Id = ?", expiresAt, tokenId); err != nil {
+		return errors.Wrapf(err, "failed to update UserAccessToken with id=%s", tokenId)
+	}
+
+	if _, err := transaction.Exec("UPDATE Sessions SET ExpiresAt = ? WHERE Token = ? AND (ExpiresAt = 0 OR ExpiresAt > ?)", expiresAt, token.Token, expiresAt); err != nil {
+		return errors.Wrapf(err, "failed to update Sessions with UserAccessToken id=%s", tokenId)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s SqlUserAccessTokenStore) GetExpired(before int64, limit int) ([]*model.UserAccessToken, error) {
+	tokens := []*model.UserAccessToken{}
+
+	if err := s.GetReplicaX().Select(&tokens, "SELECT * FROM UserAccessTokens WHERE ExpiresAt > 0 AND ExpiresAt <= ? ORDER BY ExpiresAt LIMIT ?", before, limit); err != nil {
+		return nil, errors.Wrap(err, "failed to find expired UserAccessTokens")
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+// userMergeMoveBatchSize is the number of rows reassigned by each update of a user merge.
+const userMergeMoveBatchSize = 500
+
+// userMergeTable describes how the rows of a table belong to a user. When unique, a user can
+// have a single row for a given key, so the rows the target user already has are left out.
+// When roles is set, the rows carry the roles of their user, which are moved along with them.
+type userMergeTable struct {
+	userColumn string
+	keyColumns []string
+	unique     bool
+	roles      bool
+}
+
+var userMergeTables = map[string]userMergeTable{
+	model.UserMergeTablePosts:          {userColumn: "UserId", keyColumns: []string{"Id"}},
+	model.UserMergeTableFileInfo:       {userColumn: "CreatorId", keyColumns: []string{"Id"}},
+	model.UserMergeTableChannelMembers: {userColumn: "UserId", keyColumns: []string{"ChannelId"}, unique: true},
+	model.UserMergeTableTeamMembers:    {userColumn: "UserId", keyColumns: []string{"TeamId"}, unique: true},
+	model.UserMergeTablePreferences:    {userColumn: "UserId", keyColumns: []string{"Category", "Name"}, unique: true},
+	model.UserMergeTableSessions:       {userColumn: "UserId", keyColumns: []string{"Id"}, roles: true},
+}
+
+// keysCondition matches the rows of the table with the given manifest keys.
+func (t userMergeTable) keysCondition(alias string, keys []string) sq.Sqlizer {
+	if len(t.keyColumns) == 1 {
+		return sq.Eq{alias + t.keyColumns[0]: keys}
+	}
+
+	or := sq.Or{}
+	for _, key := range keys {
+		values := model.SplitUserMergeKey(key, len(t.keyColumns))
+		if len(values) != len(t.keyColumns) {
+			continue
+		}
+		eq := sq.Eq{}
+		for i, column := range t.keyColumns {
+			eq[alias+column] = values[i]
+		}
+		or = append(or, eq)
+	}
+	return or
+}
+
+type SqlUserMergeStore struct {
+	*SqlStore
+}
+
+func newSqlUserMergeStore(sqlStore *SqlStore) store.UserMergeStore {
+	return &SqlUserMergeStore{sqlStore}
+}
+
+var userMergeColumns = []string{
+	"Id",
+	"PrimaryUserId",
+	"DuplicateUserId",
+	"RequesterId",
+	"JobId",
+	"Status",
+	"Manifest",
+	"CreateAt",
+	"UpdateAt",
+	"RevertAt",
+}
+
+func (s SqlUserMergeStore) Save(merge *model.UserMerge) (*model.UserMerge, error) {
+	if merge.Id != "" {
+		return nil, store.NewErrInvalidInput("UserMerge", "id", merge.Id)
+	}
+
+	merge.PreSave()
+	if err := merge.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("UserMerges").
+		Columns(userMergeColumns...).
+		Values(
+			merge.Id,
+			merge.PrimaryUserId,
+			merge.DuplicateUserId,
+			merge.RequesterId,
+			merge.JobId,
+			merge.Status,
+			merge.Manifest,
+			merge.CreateAt,
+			merge.UpdateAt,
+			merge.RevertAt,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_merge_save_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save UserMerge with id=%s", merge.Id)
+	}
+
+	return merge, nil
+}
+
+func (s SqlUserMergeStore) Get(id string) (*model.UserMerge, error) {
+	query, args, err := s.getQueryBuilder().
+		Select(userMergeColumns...).
+		From("UserMerges").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_merge_get_tosql")
+	}
+
+	var merge model.UserMerge
+	if err := s.GetMasterX().Get(&merge, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UserMerge", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get UserMerge with id=%s", id)
+	}
+
+	return &merge, nil
+}
+
+func (s SqlUserMergeStore) Update(merge *model.UserMerge) (*model.UserMerge, error) {
+	return s.update(s.GetMasterX(), merge)
+}
+
+func (s SqlUserMergeStore) update(ex sqlxExecutor, merge *model.UserMerge) (*model.UserMerge, error) {
+	merge.PreUpdate()
+	if err := merge.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("UserMerges").
+		SetMap(map[string]interface{}{
+			"JobId":    merge.JobId,
+			"Status":   merge.Status,
+			"Manifest": merge.Manifest,
+			"UpdateAt": merge.UpdateAt,
+			"RevertAt": merge.RevertAt,
+		}).
+		Where(sq.Eq{"Id": merge.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_merge_update_tosql")
+	}
+
+	result, err := ex.Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update UserMerge with id=%s", merge.Id)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get rows affected for updated UserMerge")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("UserMerge", merge.Id)
+	}
+
+	return merge, nil
+}
+
+// MoveRows reassigns the rows of a table from one user to another in a single transaction,
+// and returns the keys of the rows it moved. When keys is nil every row of the user is
+// moved, except for those the other user already has a row for in tables keyed by user.
+// Otherwise only the rows with the given keys are, which is how a merge is reverted.
+func (s SqlUserMergeStore) MoveRows(table, fromUserID, toUserID string, keys []string) ([]string, error) {
+	t, ok := userMergeTables[table]
+	if !ok {
+		return nil, store.NewErrInvalidInput("UserMerge", "table", table)
+	}
+	if keys != nil && len(keys) == 0 {
+		return []string{}, nil
+	}
+
+	txn, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(txn)
+
+	moved, err := s.moveRows(txn, t, table, fromUserID, toUserID, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return moved, nil
+}
+
+// MergeRows reassigns every row of a table from the duplicate user of the merge to the primary
+// one, and adds the keys of the rows it moved to the manifest of the merge in the same
+// transaction, so that no moved row is ever missing from the manifest.
+func (s SqlUserMergeStore) MergeRows(merge *model.UserMerge, table string) (*model.UserMerge, error) {
+	t, ok := userMergeTables[table]
+	if !ok {
+		return nil, store.NewErrInvalidInput("UserMerge", "table", table)
+	}
+
+	txn, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(txn)
+
+	moved, err := s.moveRows(txn, t, table, merge.DuplicateUserId, merge.PrimaryUserId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := merge.Manifest[table]
+	merge.Manifest[table] = append(previous, moved...)
+	if _, err := s.update(txn, merge); err != nil {
+		merge.Manifest[table] = previous
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		merge.Manifest[table] = previous
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return merge, nil
+}
+
+func (s SqlUserMergeStore) moveRows(txn *sqlxTxWrapper, t userMergeTable, table, fromUserID, toUserID string, keys []string) ([]string, error) {
+	keyColumns := make([]string, len(t.keyColumns))
+	for i, column := range t.keyColumns {
+		keyColumns[i] = "t." + column
+	}
+	builder := s.getQueryBuilder().
+		Select(keyColumns...).
+		From(table + " t").
+		Where(sq.Eq{"t." + t.userColumn: fromUserID})
+	if keys != nil {
+		builder = builder.Where(t.keysCondition("t.", keys))
+	}
+	if t.unique {
+		conditions := []string{"o." + t.userColumn + " = ?"}
+		for _, column := range t.keyColumns {
+			conditions = append(conditions, fmt.Sprintf("o.%s = t.%s", column, column))
+		}
+		builder = builder.Where(sq.Expr("NOT EXISTS (SELECT 1 FROM "+table+" o WHERE "+strings.Join(conditions, " AND ")+")", toUserID))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "user_merge_select_tosql")
+	}
+
+	rows, err := txn.QueryX(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find the %s rows of userId=%s", table, fromUserID)
+	}
+	moved := []string{}
+	for rows.Next() {
+		values := make([]string, len(t.keyColumns))
+		dest := make([]interface{}, len(values))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			rows.Close()
+			return nil, errors.Wrapf(err, "failed to scan the %s rows of userId=%s", table, fromUserID)
+		}
+		moved = append(moved, model.JoinUserMergeKey(values...))
+	}
+	if err := rows.Close(); err != nil {
+		return nil, errors.Wrapf(err, "failed to find the %s rows of userId=%s", table, fromUserID)
+	}
+
+	for start := 0; start < len(moved); start += userMergeMoveBatchSize {
+		end := start + userMergeMoveBatchSize
+		if end > len(moved) {
+			end = len(moved)
+		}
+
+		update := s.getQueryBuilder().
+			Update(table).
+			Set(t.userColumn, toUserID).
+			Where(sq.Eq{t.userColumn: fromUserID}).
+			Where(t.keysCondition("", moved[start:end]))
+		if t.roles {
+			update = update.Set("Roles", sq.Expr("(SELECT Roles FROM Users WHERE Id = ?)", toUserID))
+		}
+
+		query, args, err := update.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "user_merge_update_rows_tosql")
+		}
+		if _, err := txn.Exec(query, args...); err != nil {
+			return nil, errors.Wrapf(err, "failed to move the %s rows of userId=%s", table, fromUserID)
+		}
+	}
+
+	return moved, nil
+}
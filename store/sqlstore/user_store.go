@@ -39,6 +39,30 @@ type SqlUserStore struct {
 	usersQuery sq.SelectBuilder
 }
 
+// userFieldColumns are the columns of the fields of the users that can be selected when fetching
+// them, by their JSON name.
+var userFieldColumns = map[string]string{
+	"create_at":            "u.CreateAt",
+	"update_at":            "u.UpdateAt",
+	"delete_at":            "u.DeleteAt",
+	"username":             "u.Username",
+	"auth_service":         "u.AuthService",
+	"email":                "u.Email",
+	"nickname":             "u.Nickname",
+	"first_name":           "u.FirstName",
+	"last_name":            "u.LastName",
+	"position":             "u.Position",
+	"roles":                "u.Roles",
+	"props":                "u.Props",
+	"last_picture_update":  "u.LastPictureUpdate",
+	"locale":               "u.Locale",
+	"timezone":             "u.Timezone",
+	"remote_id":            "u.RemoteId",
+	"is_bot":               "b.UserId IS NOT NULL AS IsBot",
+	"bot_description":      "COALESCE(b.Description, '') AS BotDescription",
+	"bot_last_icon_update": "COALESCE(b.LastIconUpdate, 0) AS BotLastIconUpdate",
+}
+
 func (us *SqlUserStore) ClearCaches() {}
 
 func (us SqlUserStore) InvalidateProfileCacheForUser(userId string) {}
@@ -535,7 +559,7 @@ func applyMultiRoleFilters(query sq.SelectBuilder, systemRoles []string, teamRol
 			case model.SystemUserRoleId:
 				// If querying for a `system_user` ensure that the user is only a system_user.
 				sqOr = append(sqOr, sq.Eq{"u.Roles": role})
-			case model.SystemGuestRoleId, model.SystemAdminRoleId, model.SystemUserManagerRoleId, model.SystemReadOnlyAdminRoleId, model.SystemManagerRoleId:
+			case model.SystemGuestRoleId, model.SystemAdminRoleId, model.SystemUserManagerRoleId, model.SystemReadOnlyAdminRoleId, model.SystemManagerRoleId, model.SystemBillingViewerRoleId, model.SystemComplianceViewerRoleId:
 				// If querying for any other roles search using a wildcard.
 				if isPostgreSQL {
 					sqOr = append(sqOr, sq.ILike{"u.Roles": queryRole})
@@ -979,7 +1003,27 @@ func (us SqlUserStore) GetProfileByIds(ctx context.Context, userIds []string, op
 	}
 
 	users := []*model.User{}
-	query := us.usersQuery.
+	query := us.usersQuery
+	if len(options.Fields) > 0 {
+		columns := []string{"u.Id"}
+		selected := map[string]bool{}
+		for _, field := range options.Fields {
+			column, ok := userFieldColumns[field]
+			if !ok {
+				return nil, store.NewErrInvalidInput("User", "fields", field)
+			}
+			if !selected[field] {
+				selected[field] = true
+				columns = append(columns, column)
+			}
+		}
+		query = us.getQueryBuilder().
+			Select(columns...).
+			From("Users u").
+			LeftJoin("Bots b ON ( b.UserId = u.Id )")
+	}
+
+	query = query.
 		Where(map[string]interface{}{
 			"u.Id": userIds,
 		}).
@@ -11,6 +11,7 @@ import (
 
 	"github.com/mattermost/gorp"
 
+	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/mattermost/mattermost-server/v6/shared/mlog"
 )
 
@@ -147,6 +148,16 @@ func constructArrayArgs(ids []string) (string, []interface{}) {
 	return "(" + placeholder.String() + ")", values
 }
 
+// jsonStringFieldExpr returns the expression selecting the string value of the given key of
+// a JSON column, which is NULL when the key isn't set. The key is inlined, so it must never
+// come from user input.
+func jsonStringFieldExpr(driverName, column, key string) string {
+	if driverName == model.DatabaseDriverPostgres {
+		return column + "->>'" + key + "'"
+	}
+	return "JSON_UNQUOTE(JSON_EXTRACT(" + column + ", '$." + key + "'))"
+}
+
 // morphWriter is a target to pass to the logger instance of morph.
 // For now, everything is just logged at a debug level. If we need to log
 // errors/warnings from the library also, that needs to be seen later.
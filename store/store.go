@@ -57,6 +57,46 @@ type Store interface {
 	UserTermsOfService() UserTermsOfServiceStore
 	LinkMetadata() LinkMetadataStore
 	SharedChannel() SharedChannelStore
+	APIUsage() APIUsageStore
+	PostTask() PostTaskStore
+	ChannelDigest() ChannelDigestStore
+	DirectChannelRetention() DirectChannelRetentionStore
+	PostPriority() PostPriorityStore
+	PostAcknowledgement() PostAcknowledgementStore
+	TeamBanner() TeamBannerStore
+	EmailSuppression() EmailSuppressionStore
+	CannedResponse() CannedResponseStore
+	TeamRequest() TeamRequestStore
+	TeamStats() TeamStatsStore
+	UserMerge() UserMergeStore
+	TeamDeletion() TeamDeletionStore
+	IdempotencyKey() IdempotencyKeyStore
+	ChannelArchivePolicy() ChannelArchivePolicyStore
+	PermissionDenial() PermissionDenialStore
+	TeamAlias() TeamAliasStore
+	BotTokenRotation() BotTokenRotationStore
+	ChannelCommandOverride() ChannelCommandOverrideStore
+	Impersonation() ImpersonationStore
+	ScheduledChannelMessage() ScheduledChannelMessageStore
+	EventWebhook() EventWebhookStore
+	ConfigHistory() ConfigHistoryStore
+	UploadUsage() UploadUsageStore
+	ChannelEvent() ChannelEventStore
+	AlertRule() AlertRuleStore
+	ChannelLanguageStats() ChannelLanguageStatsStore
+	TeamInviteUsage() TeamInviteUsageStore
+	PostModeration() PostModerationStore
+	PostReport() PostReportStore
+	ActivityEvent() ActivityEventStore
+	AuditLog() AuditLogStore
+	LicenseUsage() LicenseUsageStore
+	CustomStatusTemplate() CustomStatusTemplateStore
+	RESTProxyBridge() RESTProxyBridgeStore
+	ChannelFeed() ChannelFeedStore
+	OutstandingMention() OutstandingMentionStore
+	BotState() BotStateStore
+	OAuthAppUsage() OAuthAppUsageStore
+	TeamGroupRole() TeamGroupRoleStore
 	MarkSystemRanUnitTests()
 	Close()
 	LockToMaster()
@@ -70,6 +110,7 @@ type Store interface {
 	TotalSearchDbConnections() int
 	ReplicaLagTime() error
 	ReplicaLagAbs() error
+	PingReplicas() []ReplicaPing
 	CheckIntegrity() <-chan model.IntegrityCheckResult
 	SetContext(context context.Context)
 	Context() context.Context
@@ -95,6 +136,10 @@ type RetentionPolicyStore interface {
 	GetTeamPoliciesCountForUser(userID string) (int64, error)
 	GetChannelPoliciesForUser(userID string, offset, limit int) ([]*model.RetentionPolicyForChannel, error)
 	GetChannelPoliciesCountForUser(userID string) (int64, error)
+	// CountForPolicyPreview counts, for each channel the policy would apply to, the posts
+	// created before endTime and the files attached to them. The channels of the policy's
+	// teams that have a channel policy of their own are left out.
+	CountForPolicyPreview(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, error)
 }
 
 type TeamStore interface {
@@ -112,6 +157,8 @@ type TeamStore interface {
 	GetAllPrivateTeamListing() ([]*model.Team, error)
 	GetAllTeamListing() ([]*model.Team, error)
 	GetTeamsByUserId(userID string) ([]*model.Team, error)
+	// GetChildTeams returns the teams that aren't deleted and are direct sub-teams of the given team.
+	GetChildTeams(parentTeamID string) ([]*model.Team, error)
 	GetByInviteId(inviteID string) (*model.Team, error)
 	PermanentDelete(teamID string) error
 	AnalyticsTeamCount(opts *model.TeamSearch) (int64, error)
@@ -201,7 +248,7 @@ type ChannelStore interface {
 	// UpdateMemberNotifyProps patches the notifyProps field with the given props map.
 	// It replaces existing fields and creates new ones which don't exist.
 	UpdateMemberNotifyProps(channelID, userID string, props map[string]string) (*model.ChannelMember, error)
-	GetMembers(channelID string, offset, limit int) (model.ChannelMembers, error)
+	GetMembers(channelID string, offset, limit int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error)
 	GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error)
 	GetChannelMembersTimezones(channelID string) ([]model.StringMap, error)
 	GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error)
@@ -278,6 +325,9 @@ type ChannelStore interface {
 	SetShared(channelId string, shared bool) error
 	// GetTeamForChannel returns the team for a given channelID.
 	GetTeamForChannel(channelID string) (*model.Team, error)
+	// GetChannelsWithDeactivatedCreator returns the public and private channels not archived
+	// whose creator is deactivated, across all teams when teamID is empty.
+	GetChannelsWithDeactivatedCreator(teamID string, offset int, limit int) (model.ChannelList, error)
 }
 
 type ChannelMemberHistoryStore interface {
@@ -297,6 +347,7 @@ type ThreadStore interface {
 	GetThreadForUser(teamID string, threadMembership *model.ThreadMembership, extended bool) (*model.ThreadResponse, error)
 	GetTeamsUnreadForUser(userID string, teamIDs []string) (map[string]*model.TeamUnread, error)
 	GetPosts(threadID string, since int64) ([]*model.Post, error)
+	GetSummary(threadID string, bucketSize int64, replies int) (*model.ThreadSummary, error)
 
 	MarkAllAsRead(userID string, threadIds []string) error
 	MarkAllAsReadByTeam(userID, teamID string) error
@@ -317,10 +368,14 @@ type ThreadStore interface {
 type PostStore interface {
 	SaveMultiple(posts []*model.Post) ([]*model.Post, int, error)
 	Save(post *model.Post) (*model.Post, error)
+	SaveWithExtras(post *model.Post, extras PostExtras) (*model.Post, error)
 	Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error)
 	Get(ctx context.Context, id string, skipFetchThreads, collapsedThreads, collapsedThreadsExtended bool, userID string) (*model.PostList, error)
 	GetSingle(id string, inclDeleted bool) (*model.Post, error)
 	Delete(postID string, time int64, deleteByID string) error
+	// GetDeletedForChannel returns the posts of a channel deleted since the given time that can be restored.
+	GetDeletedForChannel(channelID string, since int64, offset, limit int) ([]*model.Post, error)
+	Restore(postID string) ([]string, error)
 	PermanentDeleteByUser(userID string) error
 	PermanentDeleteByChannel(channelID string) error
 	GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, error)
@@ -350,15 +405,21 @@ type PostStore interface {
 	PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error)
 	DeleteOrphanedRows(limit int) (deleted int64, err error)
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+	PermanentDeleteBatchForChannel(channelID string, endTime int64, limit int64) (int64, []*model.FileInfo, error)
+	CountForRetentionPolicies(now, globalPolicyEndTime int64) (*model.RetentionPolicyDryRun, error)
 	GetOldest() (*model.Post, error)
 	GetMaxPostSize() int
 	GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error)
 	GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error)
 	GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error)
 	SearchPostsForUser(paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error)
+	// GetSearchCapabilities returns the backend that SearchPostsForUser runs against and
+	// the search operators it supports.
+	GetSearchCapabilities() *model.SearchCapabilities
 	GetOldestEntityCreationTime() (int64, error)
 	HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error)
 	GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error)
+	CountPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) (int64, error)
 }
 
 type UserStore interface {
@@ -470,6 +531,8 @@ type SessionStore interface {
 type AuditStore interface {
 	Save(audit *model.Audit) error
 	Get(user_id string, offset int, limit int) (model.Audits, error)
+	GetCountByExtraInfoPrefix(prefix string, since int64) (int64, error)
+	GetByActions(actions []string, extraInfoPrefix string, offset int, limit int) (model.Audits, error)
 	PermanentDeleteByUser(userID string) error
 }
 
@@ -489,6 +552,8 @@ type RemoteClusterStore interface {
 	Get(remoteClusterId string) (*model.RemoteCluster, error)
 	GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error)
 	UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error)
+	UpdateHiddenProfileFields(remoteClusterId string, hiddenProfileFields string) (*model.RemoteCluster, error)
+	UpdateInvitePolicy(remoteClusterId string, invitePolicy string) (*model.RemoteCluster, error)
 	SetLastPingAt(remoteClusterId string) error
 }
 
@@ -587,8 +652,12 @@ type CommandWebhookStore interface {
 
 type PreferenceStore interface {
 	Save(preferences model.Preferences) error
+	// CompareAndSet saves the preferences only if none changed since the client read them,
+	// returning either the saved preferences or the current values of the conflicting ones.
+	CompareAndSet(preferences model.Preferences) (model.Preferences, model.Preferences, error)
 	GetCategory(userID string, category string) (model.Preferences, error)
 	Get(userID string, category string, name string) (*model.Preference, error)
+	GetForUsers(userIDs []string, category string, name string) (model.Preferences, error)
 	GetAll(userID string) (model.Preferences, error)
 	Delete(userID, category, name string) error
 	DeleteCategory(userID string, category string) error
@@ -641,15 +710,31 @@ type FileInfoStore interface {
 	GetByIds(ids []string) ([]*model.FileInfo, error)
 	GetByPath(path string) (*model.FileInfo, error)
 	GetForPost(postID string, readFromMaster, includeDeleted, allowFromCache bool) ([]*model.FileInfo, error)
+	GetForPosts(postIds []string) ([]*model.FileInfo, error)
 	GetForUser(userID string) ([]*model.FileInfo, error)
+	// GetForTeamAfter returns the FileInfos, deleted or not, stored under the directory of the team
+	// or attached to posts in its channels, ordered by creation time and id, after the given ones.
+	GetForTeamAfter(teamID string, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error)
+	// GetAfter returns the FileInfos, deleted or not, ordered by creation time and id, after the
+	// given ones.
+	GetAfter(afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error)
+	// GetByContentHash returns up to limit FileInfos, deleted or not, with the given content hash
+	// and size, the oldest first.
+	GetByContentHash(hash string, size int64, limit int) ([]*model.FileInfo, error)
+	// CountByPath returns how many FileInfos, deleted or not, reference the object at the path.
+	CountByPath(path string) (int64, error)
 	GetWithOptions(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error)
 	InvalidateFileInfosForPostCache(postID string, deleted bool)
 	AttachToPost(fileID string, postID string, creatorID string) error
 	DeleteForPost(postID string) (string, error)
+	RestoreForPosts(postIDs []string) error
 	PermanentDelete(fileID string) error
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 	PermanentDeleteByUser(userID string) (int64, error)
 	SetContent(fileID, content string) error
+	// SetContentHash records the content hash of the file, along with the path of the object
+	// holding its content.
+	SetContentHash(fileID, hash, path string) error
 	Search(paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.FileInfoList, error)
 	CountAll() (int64, error)
 	GetFilesBatchForIndexing(startTime, endTime int64, limit int) ([]*model.FileForIndexing, error)
@@ -675,6 +760,192 @@ type ReactionStore interface {
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 }
 
+type TeamAliasStore interface {
+	Save(alias *model.TeamAlias) (*model.TeamAlias, error)
+	Get(name string) (*model.TeamAlias, error)
+	GetForTeam(teamID string) ([]*model.TeamAlias, error)
+	Delete(name string) error
+	DeleteForTeam(teamID string) error
+}
+
+type BotTokenRotationStore interface {
+	Save(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error)
+	Get(botUserID string) (*model.BotTokenRotation, error)
+	Update(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error)
+	Delete(botUserID string) error
+	GetDue(now int64, limit int) ([]*model.BotTokenRotation, error)
+	GetTokenAges(offset, limit int) ([]*model.BotTokenAge, error)
+}
+
+type ChannelCommandOverrideStore interface {
+	Save(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, error)
+	Get(channelID, trigger string) (*model.ChannelCommandOverride, error)
+	GetForChannel(channelID string) ([]*model.ChannelCommandOverride, error)
+	Delete(channelID, trigger string) error
+	DeleteForChannel(channelID string) error
+}
+
+type ImpersonationStore interface {
+	Save(impersonation *model.Impersonation) (*model.Impersonation, error)
+	Get(id string) (*model.Impersonation, error)
+	GetForUser(userID string, offset, limit int) ([]*model.Impersonation, error)
+	UpdateStatus(impersonation *model.Impersonation, currentStatus string) (*model.Impersonation, error)
+}
+
+type ScheduledChannelMessageStore interface {
+	Save(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error)
+	Get(id string) (*model.ScheduledChannelMessage, error)
+	GetForChannel(channelID string) ([]*model.ScheduledChannelMessage, error)
+	Update(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error)
+	Delete(id string) error
+	DeleteForChannel(channelID string) error
+	GetDue(now int64, limit int) ([]*model.ScheduledChannelMessage, error)
+	UpdateRun(id string, expectedNextRunAt, lastRunAt, nextRunAt int64) (bool, error)
+}
+
+type EventWebhookStore interface {
+	Save(webhook *model.EventWebhook) (*model.EventWebhook, error)
+	Get(id string) (*model.EventWebhook, error)
+	GetAll(offset, limit int) ([]*model.EventWebhook, error)
+	GetForEvent(event string) ([]*model.EventWebhook, error)
+	Update(webhook *model.EventWebhook) (*model.EventWebhook, error)
+	Delete(id string) error
+	SaveDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error)
+	GetDeliveries(webhookID string, offset, limit int) ([]*model.EventWebhookDelivery, error)
+	GetDueDeliveries(now int64, limit int) ([]*model.EventWebhookDelivery, error)
+	ClaimDelivery(id string, expectedNextAttemptAt, leaseUntil int64) (bool, error)
+	UpdateDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error)
+	PermanentDeleteDeliveriesBatch(endTime int64, limit int64) (int64, error)
+}
+
+type ConfigHistoryStore interface {
+	Save(version *model.ConfigVersion) (*model.ConfigVersion, error)
+	GetByVersion(version string) (*model.ConfigVersion, error)
+	GetAll(offset, limit int) ([]*model.ConfigVersion, error)
+}
+
+type UploadUsageStore interface {
+	Increment(usage *model.UploadUsage) error
+	GetForUser(userID string, day int64) (int64, int64, error)
+}
+
+type ChannelEventStore interface {
+	Save(event *model.ChannelEvent) (*model.ChannelEvent, error)
+	Get(id string) (*model.ChannelEvent, error)
+	GetForChannel(channelID string, offset, limit int) ([]*model.ChannelEvent, error)
+	MarkReverted(id, userID string, revertedAt int64) (bool, error)
+}
+
+type AlertRuleStore interface {
+	Save(rule *model.AlertRule) (*model.AlertRule, error)
+	Get(id string) (*model.AlertRule, error)
+	GetAll() ([]*model.AlertRule, error)
+	GetAllEnabled() ([]*model.AlertRule, error)
+	Update(rule *model.AlertRule) (*model.AlertRule, error)
+	UpdateState(rule *model.AlertRule) error
+	Delete(id string) error
+}
+
+type ChannelLanguageStatsStore interface {
+	RollupDay(day int64) error
+	GetForChannel(channelID string, since, until int64) ([]*model.ChannelLanguagePostCount, error)
+}
+
+type LicenseUsageStore interface {
+	RollupMonth(month int64) (*model.LicenseUsage, error)
+	GetForMonths(since, until int64) ([]*model.LicenseUsage, error)
+}
+
+type CustomStatusTemplateStore interface {
+	Save(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error)
+	Update(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error)
+	Get(id string) (*model.CustomStatusTemplate, error)
+	GetForTeam(teamID string) ([]*model.CustomStatusTemplate, error)
+	GetMostUsed() ([]*model.CustomStatusTemplate, error)
+	IncrementUseCount(teamIDs []string, emoji, text string, usedAt int64) error
+	Delete(id string) error
+}
+
+// RESTProxyBridgeStore is the outbox of the events published to the message queue.
+type RESTProxyBridgeStore interface {
+	Save(message *model.RESTProxyBridgeMessage) (*model.RESTProxyBridgeMessage, error)
+	GetPending(limit int) ([]*model.RESTProxyBridgeMessage, error)
+	MarkPublished(ids []string, publishedAt int64) error
+	UpdateAttempt(message *model.RESTProxyBridgeMessage) error
+	GetStatus() (*model.RESTProxyBridgeStatus, error)
+	PermanentDeletePublishedBatch(endTime int64, limit int64) (int64, error)
+}
+
+// ChannelFeedStore holds the feed subscriptions of the channels and the items of the feeds
+// already posted to them.
+type ChannelFeedStore interface {
+	Save(feed *model.ChannelFeed) (*model.ChannelFeed, error)
+	Update(feed *model.ChannelFeed) (*model.ChannelFeed, error)
+	UpdatePollResult(feed *model.ChannelFeed) error
+	Get(id string) (*model.ChannelFeed, error)
+	GetForChannel(channelID string) ([]*model.ChannelFeed, error)
+	GetDue(now int64, limit int) ([]*model.ChannelFeed, error)
+	Delete(id string) error
+	GetExistingItemGuids(feedID string, guids []string) ([]string, error)
+	SaveItems(items []*model.ChannelFeedItem) error
+}
+
+// OutstandingMentionStore tracks the mentions of the users until they answer them.
+type OutstandingMentionStore interface {
+	Save(mentions []*model.OutstandingMention) error
+	ResolveForThread(userID, channelID, rootID string, resolvedAt int64) error
+	ResolveForPost(userID, postID string, resolvedAt int64) error
+	GetOutstanding(userID, teamID string, offset, limit int) ([]*model.OutstandingMention, error)
+	GetStats(userID, teamID string) (*model.OutstandingMentionStats, error)
+	GetOutstandingCounts(mentionedBefore int64, afterUserID string, limit int) ([]*model.OutstandingMentionCount, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+// BotStateStore keeps the conversation states of the bots. Expired states are never returned,
+// whether or not they have been deleted yet.
+type BotStateStore interface {
+	Save(state *model.BotState) (*model.BotState, error)
+	Get(botUserID, userID, channelID, key string) (*model.BotState, error)
+	GetKeys(botUserID, userID, channelID string, offset, limit int) ([]string, error)
+	Delete(botUserID, userID, channelID, key string) error
+	PermanentDeleteExpiredBatch(now int64, limit int64) (int64, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type TeamInviteUsageStore interface {
+	Increment(usage *model.TeamInviteUsage) error
+	Get(teamID string, day int64) (*model.TeamInviteUsage, error)
+}
+
+type PostModerationStore interface {
+	Save(moderation *model.PostModeration) (*model.PostModeration, error)
+	Get(id string) (*model.PostModeration, error)
+	GetAll(teamID, status string, offset, limit int) ([]*model.PostModeration, error)
+	Review(moderation *model.PostModeration) (*model.PostModeration, error)
+}
+
+type PostReportStore interface {
+	Save(report *model.PostReport) (*model.PostReport, error)
+	Get(id string) (*model.PostReport, error)
+	GetAll(teamID, status string, offset, limit int) ([]*model.PostReport, error)
+	Resolve(report *model.PostReport) (*model.PostReport, error)
+}
+
+type ActivityEventStore interface {
+	Save(event *model.ActivityEvent) (*model.ActivityEvent, error)
+	GetForUser(userID string, opts ActivityEventGetOpts) ([]*model.ActivityEvent, error)
+	DeleteForPost(postID string) error
+	DeleteReaction(userID, postID, emojiName string) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type AuditLogStore interface {
+	Save(log *model.AuditLog) (*model.AuditLog, error)
+	Search(opts *model.AuditLogSearch, offset, limit int) ([]*model.AuditLog, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
 type JobStore interface {
 	Save(job *model.Job) (*model.Job, error)
 	UpdateOptimistically(job *model.Job, currentStatus string) (bool, error)
@@ -690,6 +961,7 @@ type JobStore interface {
 	GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error)
 	GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error)
 	GetCountByStatusAndType(status string, jobType string) (int64, error)
+	GetCountByStatusSince(status string, since int64) (int64, error)
 	Delete(id string) (string, error)
 	Cleanup(expiryTime int64, batchSize int) error
 }
@@ -705,6 +977,8 @@ type UserAccessTokenStore interface {
 	Search(term string) ([]*model.UserAccessToken, error)
 	UpdateTokenEnable(tokenID string) error
 	UpdateTokenDisable(tokenID string) error
+	UpdateExpiresAt(tokenID string, expiresAt int64) error
+	GetExpired(before int64, limit int) ([]*model.UserAccessToken, error)
 }
 
 type PluginStore interface {
@@ -749,6 +1023,8 @@ type SchemeStore interface {
 	PermanentDeleteAll() error
 	CountByScope(scope string) (int64, error)
 	CountWithoutPermission(scope, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error)
+	AssignTeams(schemeID string, teamIDs []string) error
+	AssignChannels(schemeID string, channelIDs []string) error
 }
 
 type TermsOfServiceStore interface {
@@ -904,6 +1180,171 @@ type SharedChannelStore interface {
 	UpsertAttachment(remote *model.SharedChannelAttachment) (string, error)
 	GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error)
 	UpdateAttachmentLastSyncAt(id string, syncTime int64) error
+
+	SaveInvite(invite *model.SharedChannelInvite) (*model.SharedChannelInvite, error)
+	GetInvite(id string) (*model.SharedChannelInvite, error)
+	GetInvites(opts model.SharedChannelInviteFilterOpts, offset, limit int) ([]*model.SharedChannelInvite, error)
+	UpdateInviteStatus(id string, status string, decidedBy string) (*model.SharedChannelInvite, error)
+}
+
+type APIUsageStore interface {
+	Increment(usages []*model.APIUsage) error
+	GetForUser(userID string, since int64) ([]*model.APIUsage, error)
+	GetSummaries(since int64, offset, limit int) ([]*model.APIUsageSummary, error)
+	GetTokenCounts(tokenID string, since int64) (map[int64]int64, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
+// OAuthAppUsageStore keeps the number of calls made with the tokens of each OAuth app, per bucket
+// of model.APIUsageBucketSize.
+type OAuthAppUsageStore interface {
+	Increment(usages []*model.OAuthAppUsage) error
+	GetSummaries(since int64, offset, limit int) ([]*model.OAuthAppUsageSummary, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
+// TeamGroupRoleStore holds the groups mapped to roles on the teams, and the grants of the team
+// admin role made through them, so that only those are revoked when the members leave the groups.
+type TeamGroupRoleStore interface {
+	Save(mapping *model.TeamGroupRole) (*model.TeamGroupRole, error)
+	Delete(teamID, groupID string) error
+	GetForTeam(teamID string) ([]*model.TeamGroupRole, error)
+	GetForGroup(groupID string) ([]*model.TeamGroupRole, error)
+	GetTeamIDs() ([]string, error)
+	GetMembers(teamID string) ([]*model.TeamGroupRoleMember, error)
+	SaveGrants(teamID string, userIDs []string, grantedAt int64) error
+	DeleteGrants(teamID string, userIDs []string) error
+}
+
+type PostTaskStore interface {
+	Save(task *model.PostTask) (*model.PostTask, error)
+	Get(postID string) (*model.PostTask, error)
+	Update(task *model.PostTask) (*model.PostTask, error)
+	GetForAssignee(assigneeID string, statuses []string, offset, limit int) ([]*model.PostTask, error)
+}
+
+type ChannelDigestStore interface {
+	Save(digest *model.ChannelDigest) (*model.ChannelDigest, error)
+	Get(userID, channelID string) (*model.ChannelDigest, error)
+	GetForUser(userID string) ([]*model.ChannelDigest, error)
+	Delete(userID, channelID string) error
+	GetDue(cadence string, sentBefore int64, limit int) ([]*model.ChannelDigest, error)
+	UpdateLastSentAt(userID string, channelIDs []string, sentAt int64) error
+	GetActivity(channelID, userID, username string, since, until int64) (*model.ChannelDigestActivity, error)
+}
+
+type DirectChannelRetentionStore interface {
+	Save(retention *model.DirectChannelRetention) (*model.DirectChannelRetention, error)
+	Get(channelID string) (*model.DirectChannelRetention, error)
+	Delete(channelID string) error
+	GetActive(afterChannelID string, limit int) ([]*model.DirectChannelRetention, error)
+	UpdateLastPurgeAt(channelID string, purgeAt int64) error
+}
+
+type PostPriorityStore interface {
+	Save(priority *model.PostPriority) (*model.PostPriority, error)
+	GetForPost(postID string) (*model.PostPriority, error)
+	GetForPosts(postIDs []string) ([]*model.PostPriority, error)
+}
+
+type TeamBannerStore interface {
+	Save(banner *model.TeamBanner) (*model.TeamBanner, error)
+	Update(banner *model.TeamBanner) (*model.TeamBanner, error)
+	Get(id string) (*model.TeamBanner, error)
+	GetForTeam(teamID string) ([]*model.TeamBanner, error)
+	GetScheduledBetween(since, until int64) ([]*model.TeamBanner, error)
+	Delete(id string, deleteAt int64) error
+	SaveDismissal(dismissal *model.TeamBannerDismissal) error
+	GetDismissedBannerIds(userID string, bannerIDs []string) ([]string, error)
+	GetDismissingUserIds(bannerID string) ([]string, error)
+}
+
+type CannedResponseStore interface {
+	Save(response *model.CannedResponse) (*model.CannedResponse, error)
+	Update(response *model.CannedResponse) (*model.CannedResponse, error)
+	Get(id string) (*model.CannedResponse, error)
+	GetForUser(userID string) ([]*model.CannedResponse, error)
+	GetForTeam(teamID string) ([]*model.CannedResponse, error)
+	Autocomplete(userID, teamID, prefix string, limit int) ([]*model.CannedResponse, error)
+	IncrementUseCount(id string, usedAt int64) error
+	Delete(id string) error
+}
+
+type TeamRequestStore interface {
+	Save(request *model.TeamRequest) (*model.TeamRequest, error)
+	Get(id string) (*model.TeamRequest, error)
+	GetAll(status string, offset, limit int) ([]*model.TeamRequest, error)
+	Review(request *model.TeamRequest) (*model.TeamRequest, error)
+}
+
+type TeamStatsStore interface {
+	RollupDay(day int64) error
+	GetDailyStats(teamID string, since, until int64) ([]*model.TeamDailyStats, error)
+	GetChannelPostCounts(teamID string, since, until int64, limit int) ([]*model.TeamChannelPostCount, error)
+	GetChannelDailyStats(channelID string, since, until int64) ([]*model.ChannelDailyStats, error)
+	GetTopPosters(teamID string, since, until int64, limit int) ([]*model.TeamPosterCount, error)
+	GetFileUsage(teamID string) (int64, int64, error)
+}
+
+type UserMergeStore interface {
+	Save(merge *model.UserMerge) (*model.UserMerge, error)
+	Get(id string) (*model.UserMerge, error)
+	Update(merge *model.UserMerge) (*model.UserMerge, error)
+	MoveRows(table, fromUserID, toUserID string, keys []string) ([]string, error)
+	MergeRows(merge *model.UserMerge, table string) (*model.UserMerge, error)
+}
+
+type TeamDeletionStore interface {
+	Save(deletion *model.TeamDeletion) (*model.TeamDeletion, error)
+	Get(teamID string) (*model.TeamDeletion, error)
+	GetAll() ([]*model.TeamDeletion, error)
+	Update(deletion *model.TeamDeletion) (*model.TeamDeletion, error)
+	Delete(teamID string) error
+}
+
+type IdempotencyKeyStore interface {
+	Save(key *model.IdempotencyKey) (*model.IdempotencyKey, error)
+	Get(userID, key string) (*model.IdempotencyKey, error)
+	Update(key *model.IdempotencyKey) (*model.IdempotencyKey, error)
+	Delete(userID, key string) error
+	DeleteStaleReservation(userID, key string, reservedBefore int64) (bool, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
+type ChannelArchivePolicyStore interface {
+	Save(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error)
+	Get(teamID string) (*model.ChannelArchivePolicy, error)
+	GetAll() ([]*model.ChannelArchivePolicy, error)
+	Update(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error)
+	Delete(teamID string) error
+	// GetInactiveChannels returns the channels of the team that the policy applies to, that
+	// had no activity since the given time and weren't warned yet.
+	GetInactiveChannels(policy *model.ChannelArchivePolicy, before int64, limit int) ([]*model.Channel, error)
+	SaveWarning(warning *model.ChannelArchiveWarning) error
+	GetWarnings(teamID string) ([]*model.ChannelArchiveWarning, error)
+	DeleteWarning(channelID string) error
+}
+
+type PermissionDenialStore interface {
+	Save(denial *model.PermissionDenial) (*model.PermissionDenial, error)
+	Search(opts *model.PermissionDenialSearch, offset, limit int) ([]*model.PermissionDenial, error)
+	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
+}
+
+type EmailSuppressionStore interface {
+	Save(suppression *model.EmailSuppression) (*model.EmailSuppression, error)
+	Get(email string) (*model.EmailSuppression, error)
+	GetAll(offset, limit int) ([]*model.EmailSuppression, error)
+	GetForEmails(emails []string) ([]*model.EmailSuppression, error)
+	Delete(email string) error
+}
+
+type PostAcknowledgementStore interface {
+	Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error)
+	Delete(userID, postID string) error
+	GetForPost(postID string) ([]*model.PostAcknowledgement, error)
+	GetForPosts(postIDs []string) ([]*model.PostAcknowledgement, error)
+	GetSummariesForUser(userID string, offset, limit int) ([]*model.PostAcknowledgementSummary, error)
 }
 
 // ChannelSearchOpts contains options for searching channels.
@@ -950,6 +1391,30 @@ type UserGetByIdsOpts struct {
 
 	// Since filters the users based on their UpdateAt timestamp.
 	Since int64
+
+	// Fields restricts the fields of the users fetched to these, by their JSON name. The id of the
+	// users is always fetched. Users fetched with fields are never cached.
+	Fields []string
+}
+
+// ActivityEventGetOpts defines the activity events of a user returned by
+// ActivityEventStore.GetForUser(), newest first.
+type ActivityEventGetOpts struct {
+	// VisibleToUserId restricts the events to the channels the user is a member of, when set.
+	VisibleToUserId string
+	// Types restricts the events to these types, when set.
+	Types  []string
+	Offset int
+	Limit  int
+}
+
+// PostExtras defines the rows to be saved along with a post by
+// PostStore.SaveWithExtras(), once the post has been given its id.
+type PostExtras struct {
+	// Priority is the priority requested for the post.
+	Priority *model.PostPriority
+	// Task is the task created by a task post.
+	Task *model.PostTask
 }
 
 // ThreadMembershipOpts defines some properties to be passed to
@@ -969,3 +1434,9 @@ type ThreadMembershipOpts struct {
 	// should be updated.
 	UpdateParticipants bool
 }
+
+// ReplicaPing is the result of pinging a read replica of the database.
+type ReplicaPing struct {
+	Latency time.Duration
+	Err     error
+}
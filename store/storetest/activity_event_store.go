@@ -0,0 +1,187 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestActivityEventStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetForUser", func(t *testing.T) { testActivityEventStoreSaveGetForUser(t, ss) })
+	t.Run("GetForUserVisibleToUser", func(t *testing.T) { testActivityEventStoreGetForUserVisibleToUser(t, ss) })
+	t.Run("DeleteForPost", func(t *testing.T) { testActivityEventStoreDeleteForPost(t, ss) })
+	t.Run("DeleteReaction", func(t *testing.T) { testActivityEventStoreDeleteReaction(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testActivityEventStorePermanentDeleteByUser(t, ss) })
+}
+
+func newTestActivityEvent(userID, channelID, eventType string, createAt int64) *model.ActivityEvent {
+	event := &model.ActivityEvent{
+		UserId:    userID,
+		Type:      eventType,
+		TeamId:    model.NewId(),
+		ChannelId: channelID,
+		CreateAt:  createAt,
+	}
+	switch eventType {
+	case model.ActivityEventTypePost:
+		event.PostId = model.NewId()
+	case model.ActivityEventTypeFileUpload:
+		event.PostId = model.NewId()
+		event.FileId = model.NewId()
+	case model.ActivityEventTypeReaction:
+		event.PostId = model.NewId()
+		event.EmojiName = "smile"
+	}
+	return event
+}
+
+func testActivityEventStoreSaveGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	now := model.GetMillis()
+
+	post, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypePost, now-3000))
+	require.NoError(t, err)
+	assert.NotEmpty(t, post.Id)
+	reaction, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypeReaction, now-2000))
+	require.NoError(t, err)
+	join, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypeChannelJoin, now-1000))
+	require.NoError(t, err)
+	_, err = ss.ActivityEvent().Save(newTestActivityEvent(model.NewId(), model.NewId(), model.ActivityEventTypePost, now))
+	require.NoError(t, err)
+
+	_, err = ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), "invalid", now))
+	require.Error(t, err)
+
+	events, err := ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, join, events[0])
+	assert.Equal(t, reaction, events[1])
+	assert.Equal(t, post, events[2])
+
+	events, err = ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, reaction.Id, events[0].Id)
+
+	events, err = ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{
+		Types: []string{model.ActivityEventTypePost, model.ActivityEventTypeChannelJoin},
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, join.Id, events[0].Id)
+	assert.Equal(t, post.Id, events[1].Id)
+
+	events, err = ss.ActivityEvent().GetForUser(model.NewId(), store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testActivityEventStoreGetForUserVisibleToUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	viewerID := model.NewId()
+
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "Channel",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+	_, err = ss.Channel().SaveMember(&model.ChannelMember{
+		ChannelId:   channel.Id,
+		UserId:      viewerID,
+		NotifyProps: model.GetDefaultChannelNotifyProps(),
+	})
+	require.NoError(t, err)
+
+	shared, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, channel.Id, model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+	_, err = ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+
+	events, err := ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{VisibleToUserId: viewerID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, shared.Id, events[0].Id)
+
+	events, err = ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{VisibleToUserId: model.NewId(), Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testActivityEventStoreDeleteForPost(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	channelID := model.NewId()
+
+	post, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, channelID, model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+	file := newTestActivityEvent(userID, channelID, model.ActivityEventTypeFileUpload, 0)
+	file.PostId = post.PostId
+	_, err = ss.ActivityEvent().Save(file)
+	require.NoError(t, err)
+	reaction := newTestActivityEvent(model.NewId(), channelID, model.ActivityEventTypeReaction, 0)
+	reaction.PostId = post.PostId
+	_, err = ss.ActivityEvent().Save(reaction)
+	require.NoError(t, err)
+	other, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, channelID, model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ActivityEvent().DeleteForPost(post.PostId))
+
+	events, err := ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, other.Id, events[0].Id)
+
+	events, err = ss.ActivityEvent().GetForUser(reaction.UserId, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testActivityEventStoreDeleteReaction(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	reaction, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypeReaction, 0))
+	require.NoError(t, err)
+	other := newTestActivityEvent(userID, reaction.ChannelId, model.ActivityEventTypeReaction, 0)
+	other.PostId = reaction.PostId
+	other.EmojiName = "tada"
+	_, err = ss.ActivityEvent().Save(other)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ActivityEvent().DeleteReaction(userID, reaction.PostId, reaction.EmojiName))
+
+	events, err := ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, other.Id, events[0].Id)
+}
+
+func testActivityEventStorePermanentDeleteByUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	_, err := ss.ActivityEvent().Save(newTestActivityEvent(userID, model.NewId(), model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+	_, err = ss.ActivityEvent().Save(newTestActivityEvent(otherUserID, model.NewId(), model.ActivityEventTypePost, 0))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ActivityEvent().PermanentDeleteByUser(userID))
+
+	events, err := ss.ActivityEvent().GetForUser(userID, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = ss.ActivityEvent().GetForUser(otherUserID, store.ActivityEventGetOpts{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestAlertRuleStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testAlertRuleStoreSaveGet(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testAlertRuleStoreGetAll(t, ss) })
+	t.Run("Update", func(t *testing.T) { testAlertRuleStoreUpdate(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testAlertRuleStoreDelete(t, ss) })
+}
+
+func newTestAlertRule() *model.AlertRule {
+	return &model.AlertRule{
+		CreatorId:       model.NewId(),
+		Name:            "Failed logins",
+		Metric:          model.AlertRuleMetricFailedLogins,
+		Threshold:       50,
+		WindowMinutes:   10,
+		CooldownMinutes: 60,
+		DMUserIds:       model.StringArray{model.NewId()},
+		Enabled:         true,
+	}
+}
+
+func testAlertRuleStoreSaveGet(t *testing.T, ss store.Store) {
+	saved, err := ss.AlertRule().Save(newTestAlertRule())
+	require.NoError(t, err)
+	defer ss.AlertRule().Delete(saved.Id)
+	assert.NotEmpty(t, saved.Id)
+	assert.Equal(t, model.AlertRuleStateOk, saved.State)
+
+	got, err := ss.AlertRule().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, saved, got)
+
+	rule := newTestAlertRule()
+	rule.Id = model.NewId()
+	_, err = ss.AlertRule().Save(rule)
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr))
+
+	rule = newTestAlertRule()
+	rule.DMUserIds = nil
+	_, err = ss.AlertRule().Save(rule)
+	var appErr *model.AppError
+	require.True(t, errors.As(err, &appErr))
+
+	_, err = ss.AlertRule().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testAlertRuleStoreGetAll(t *testing.T, ss store.Store) {
+	enabled, err := ss.AlertRule().Save(newTestAlertRule())
+	require.NoError(t, err)
+	defer ss.AlertRule().Delete(enabled.Id)
+
+	rule := newTestAlertRule()
+	rule.Enabled = false
+	disabled, err := ss.AlertRule().Save(rule)
+	require.NoError(t, err)
+	defer ss.AlertRule().Delete(disabled.Id)
+
+	ids := func(rules []*model.AlertRule) []string {
+		var ids []string
+		for _, rule := range rules {
+			ids = append(ids, rule.Id)
+		}
+		return ids
+	}
+
+	rules, err := ss.AlertRule().GetAll()
+	require.NoError(t, err)
+	assert.Subset(t, ids(rules), []string{enabled.Id, disabled.Id})
+
+	rules, err = ss.AlertRule().GetAllEnabled()
+	require.NoError(t, err)
+	assert.Contains(t, ids(rules), enabled.Id)
+	assert.NotContains(t, ids(rules), disabled.Id)
+}
+
+func testAlertRuleStoreUpdate(t *testing.T, ss store.Store) {
+	saved, err := ss.AlertRule().Save(newTestAlertRule())
+	require.NoError(t, err)
+	defer ss.AlertRule().Delete(saved.Id)
+
+	t.Run("settings", func(t *testing.T) {
+		rule := *saved
+		rule.Threshold = 100
+		rule.ChannelId = model.NewId()
+		rule.State = model.AlertRuleStateTriggered
+		_, err := ss.AlertRule().Update(&rule)
+		require.NoError(t, err)
+
+		got, err := ss.AlertRule().Get(saved.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, got.Threshold)
+		assert.Equal(t, rule.ChannelId, got.ChannelId)
+		assert.Equal(t, model.AlertRuleStateOk, got.State)
+	})
+
+	t.Run("state", func(t *testing.T) {
+		rule := *saved
+		rule.Threshold = 1
+		rule.State = model.AlertRuleStateTriggered
+		rule.LastValue = 120
+		rule.LastEvaluatedAt = model.GetMillis()
+		rule.LastNotifiedAt = rule.LastEvaluatedAt
+		require.NoError(t, ss.AlertRule().UpdateState(&rule))
+
+		got, err := ss.AlertRule().Get(saved.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, got.Threshold)
+		assert.Equal(t, model.AlertRuleStateTriggered, got.State)
+		assert.EqualValues(t, 120, got.LastValue)
+		assert.Equal(t, rule.LastNotifiedAt, got.LastNotifiedAt)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		rule := *saved
+		rule.Id = model.NewId()
+		_, err := ss.AlertRule().Update(&rule)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testAlertRuleStoreDelete(t *testing.T, ss store.Store) {
+	saved, err := ss.AlertRule().Save(newTestAlertRule())
+	require.NoError(t, err)
+
+	require.NoError(t, ss.AlertRule().Delete(saved.Id))
+
+	_, err = ss.AlertRule().Get(saved.Id)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	err = ss.AlertRule().Delete(saved.Id)
+	require.True(t, errors.As(err, &nfErr))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestAPIUsageStore(t *testing.T, ss store.Store) {
+	t.Run("Increment", func(t *testing.T) { testAPIUsageStoreIncrement(t, ss) })
+	t.Run("GetSummaries", func(t *testing.T) { testAPIUsageStoreGetSummaries(t, ss) })
+	t.Run("GetTokenCounts", func(t *testing.T) { testAPIUsageStoreGetTokenCounts(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testAPIUsageStorePermanentDeleteBatch(t, ss) })
+}
+
+func testAPIUsageStoreIncrement(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	bucket := model.APIUsageBucket(model.GetMillis())
+
+	t.Run("should insert new rows", func(t *testing.T) {
+		err := ss.APIUsage().Increment([]*model.APIUsage{
+			{UserId: userID, Route: "getUser", BucketAt: bucket, Count: 2},
+			{UserId: userID, Route: "getPost", BucketAt: bucket, Count: 1},
+		})
+		require.NoError(t, err)
+
+		usages, err := ss.APIUsage().GetForUser(userID, bucket)
+		require.NoError(t, err)
+		require.Len(t, usages, 2)
+	})
+
+	t.Run("should add to existing rows", func(t *testing.T) {
+		err := ss.APIUsage().Increment([]*model.APIUsage{
+			{UserId: userID, Route: "getUser", BucketAt: bucket, Count: 3},
+		})
+		require.NoError(t, err)
+
+		usages, err := ss.APIUsage().GetForUser(userID, bucket)
+		require.NoError(t, err)
+		require.Len(t, usages, 2)
+		for _, usage := range usages {
+			if usage.Route == "getUser" {
+				assert.Equal(t, int64(5), usage.Count)
+			} else {
+				assert.Equal(t, int64(1), usage.Count)
+			}
+		}
+	})
+
+	t.Run("should reject invalid usage", func(t *testing.T) {
+		err := ss.APIUsage().Increment([]*model.APIUsage{
+			{UserId: "invalid", Route: "getUser", BucketAt: bucket, Count: 1},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should filter by since", func(t *testing.T) {
+		usages, err := ss.APIUsage().GetForUser(userID, bucket+1)
+		require.NoError(t, err)
+		require.Empty(t, usages)
+	})
+}
+
+func testAPIUsageStoreGetSummaries(t *testing.T, ss store.Store) {
+	// Use a bucket far in the future so that rows from other tests are not included.
+	bucket := model.APIUsageBucket(model.GetMillis() + int64(365*24*time.Hour/time.Millisecond))
+	busyUserID := model.NewId()
+	quietUserID := model.NewId()
+	tokenID := model.NewId()
+
+	err := ss.APIUsage().Increment([]*model.APIUsage{
+		{UserId: busyUserID, TokenId: tokenID, Route: "getUser", BucketAt: bucket, Count: 10},
+		{UserId: busyUserID, TokenId: tokenID, Route: "getPost", BucketAt: bucket, Count: 5},
+		{UserId: busyUserID, Route: "getPost", BucketAt: bucket, Count: 1},
+		{UserId: quietUserID, Route: "getPost", BucketAt: bucket, Count: 3},
+	})
+	require.NoError(t, err)
+
+	summaries, err := ss.APIUsage().GetSummaries(bucket, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	assert.Equal(t, &model.APIUsageSummary{UserId: busyUserID, TokenId: tokenID, Count: 15}, summaries[0])
+	assert.Equal(t, &model.APIUsageSummary{UserId: quietUserID, Count: 3}, summaries[1])
+	assert.Equal(t, &model.APIUsageSummary{UserId: busyUserID, Count: 1}, summaries[2])
+
+	summaries, err = ss.APIUsage().GetSummaries(bucket, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, quietUserID, summaries[0].UserId)
+}
+
+func testAPIUsageStoreGetTokenCounts(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	tokenID := model.NewId()
+	bucket := model.APIUsageBucket(model.GetMillis())
+	previousBucket := bucket - int64(model.APIUsageBucketSize/time.Millisecond)
+
+	err := ss.APIUsage().Increment([]*model.APIUsage{
+		{UserId: userID, TokenId: tokenID, Route: "getUser", BucketAt: previousBucket, Count: 4},
+		{UserId: userID, TokenId: tokenID, Route: "getUser", BucketAt: bucket, Count: 7},
+		{UserId: userID, TokenId: tokenID, Route: "getPost", BucketAt: bucket, Count: 3},
+	})
+	require.NoError(t, err)
+
+	counts, err := ss.APIUsage().GetTokenCounts(tokenID, previousBucket)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{previousBucket: 4, bucket: 10}, counts)
+
+	counts, err = ss.APIUsage().GetTokenCounts(tokenID, bucket)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{bucket: 10}, counts)
+}
+
+func testAPIUsageStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	oldBucket := model.APIUsageBucket(1000 * 60 * 60 * 24)
+	bucket := model.APIUsageBucket(model.GetMillis())
+
+	err := ss.APIUsage().Increment([]*model.APIUsage{
+		{UserId: userID, Route: "getUser", BucketAt: oldBucket, Count: 1},
+		{UserId: userID, Route: "getUser", BucketAt: bucket, Count: 1},
+	})
+	require.NoError(t, err)
+
+	deleted, err := ss.APIUsage().PermanentDeleteBatch(oldBucket+1, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	usages, err := ss.APIUsage().GetForUser(userID, 0)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	assert.Equal(t, bucket, usages[0].BucketAt)
+}
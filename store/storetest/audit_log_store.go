@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestAuditLogStore(t *testing.T, ss store.Store) {
+	t.Run("SaveSearch", func(t *testing.T) { testAuditLogStoreSaveSearch(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testAuditLogStorePermanentDeleteBatch(t, ss) })
+}
+
+func testAuditLogStoreSaveSearch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	teamID := model.NewId()
+
+	_, err := ss.AuditLog().Save(&model.AuditLog{UserId: userID})
+	var appErr *model.AppError
+	require.ErrorAs(t, err, &appErr)
+
+	login, err := ss.AuditLog().Save(&model.AuditLog{
+		Event:     "login",
+		Status:    "success",
+		UserId:    userID,
+		IpAddress: "10.0.0.1",
+		APIPath:   "/api/v4/users/login",
+		Meta:      model.StringInterface{"login_id": "user"},
+		CreateAt:  1000,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.Id)
+
+	deleteTeam, err := ss.AuditLog().Save(&model.AuditLog{
+		Event:      "localDeleteTeam",
+		Status:     "success",
+		UserId:     userID,
+		IpAddress:  "10.0.0.2",
+		TargetType: "team",
+		TargetId:   teamID,
+		Meta:       model.StringInterface{"team": map[string]interface{}{"ID": teamID}},
+		CreateAt:   2000,
+	})
+	require.NoError(t, err)
+
+	other, err := ss.AuditLog().Save(&model.AuditLog{
+		Event:     "login",
+		Status:    "fail",
+		UserId:    model.NewId(),
+		IpAddress: "10.0.0.1",
+		CreateAt:  3000,
+	})
+	require.NoError(t, err)
+
+	logs, err := ss.AuditLog().Search(&model.AuditLogSearch{UserId: userID}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AuditLog{deleteTeam, login}, logs, "the most recent audit logs come first")
+
+	logs, err = ss.AuditLog().Search(&model.AuditLogSearch{UserId: userID}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AuditLog{login}, logs)
+
+	logs, err = ss.AuditLog().Search(&model.AuditLogSearch{Event: "login", IpAddress: "10.0.0.1"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, other.Id, logs[0].Id)
+	assert.Equal(t, login.Id, logs[1].Id)
+
+	logs, err = ss.AuditLog().Search(&model.AuditLogSearch{TargetId: teamID}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AuditLog{deleteTeam}, logs)
+
+	logs, err = ss.AuditLog().Search(&model.AuditLogSearch{UserId: userID, Since: 1500}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AuditLog{deleteTeam}, logs)
+
+	logs, err = ss.AuditLog().Search(&model.AuditLogSearch{UserId: userID, Until: 1500}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AuditLog{login}, logs)
+}
+
+func testAuditLogStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	for _, createAt := range []int64{1000, 2000, 3000} {
+		_, err := ss.AuditLog().Save(&model.AuditLog{
+			Event:    "getConfig",
+			UserId:   userID,
+			CreateAt: createAt,
+		})
+		require.NoError(t, err)
+	}
+
+	deleted, err := ss.AuditLog().PermanentDeleteBatch(2500, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(2))
+
+	logs, err := ss.AuditLog().Search(&model.AuditLogSearch{UserId: userID}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, int64(3000), logs[0].CreateAt)
+}
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 
 func TestAuditStore(t *testing.T, ss store.Store) {
 	t.Run("", func(t *testing.T) { testAuditStore(t, ss) })
+	t.Run("GetCountByExtraInfoPrefix", func(t *testing.T) { testAuditStoreGetCountByExtraInfoPrefix(t, ss) })
+	t.Run("GetByActions", func(t *testing.T) { testAuditStoreGetByActions(t, ss) })
 }
 
 func testAuditStore(t *testing.T, ss store.Store) {
@@ -49,3 +52,57 @@ func testAuditStore(t *testing.T, ss store.Store) {
 
 	require.NoError(t, ss.Audit().PermanentDeleteByUser(audit.UserId))
 }
+
+func testAuditStoreGetCountByExtraInfoPrefix(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	prefix := "failure_" + model.NewId() + " - login_id="
+	since := model.GetMillis()
+
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: "Action", ExtraInfo: prefix + "user1"}))
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: "Action", ExtraInfo: prefix + "user2 session_user=" + model.NewId()}))
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: "Action", ExtraInfo: "attempt - " + prefix}))
+	// The underscore of the prefix must not match any character.
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: "Action", ExtraInfo: strings.Replace(prefix, "_", "x", 1)}))
+	defer ss.Audit().PermanentDeleteByUser(userID)
+
+	count, err := ss.Audit().GetCountByExtraInfoPrefix(prefix, since)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	count, err = ss.Audit().GetCountByExtraInfoPrefix(prefix, model.GetMillis()+1000)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+}
+
+func testAuditStoreGetByActions(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	commandID := model.NewId()
+	webhookID := model.NewId()
+	channelID := model.NewId()
+
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: model.AuditActionCommandExecuted, ExtraInfo: model.IntegrationAuditExtraInfo(commandID, channelID, "/deploy", []byte("/deploy prod"))}))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: model.AuditActionIncomingWebhookPosted, ExtraInfo: model.IntegrationAuditExtraInfo(webhookID, channelID, "", []byte(`{"text":"hello"}`))}))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: model.AuditActionCommandExecuted, ExtraInfo: model.IntegrationAuditExtraInfo(model.NewId(), channelID, "/echo", []byte("/echo hi"))}))
+	require.NoError(t, ss.Audit().Save(&model.Audit{UserId: userID, Action: "Action", ExtraInfo: model.IntegrationAuditPrefix(commandID)}))
+	defer ss.Audit().PermanentDeleteByUser(userID)
+
+	audits, err := ss.Audit().GetByActions([]string{model.AuditActionCommandExecuted}, model.IntegrationAuditPrefix(commandID), 0, 100)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	assert.Contains(t, audits[0].ExtraInfo, "command=/deploy")
+
+	audits, err = ss.Audit().GetByActions(model.IntegrationAuditActions(""), model.IntegrationAuditPrefix(webhookID), 0, 100)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	assert.Equal(t, model.AuditActionIncomingWebhookPosted, audits[0].Action)
+
+	audits, err = ss.Audit().GetByActions([]string{model.AuditActionCommandExecuted}, "", 0, 1)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	assert.Contains(t, audits[0].ExtraInfo, "command=/echo", "the newest audits come first")
+
+	_, err = ss.Audit().GetByActions([]string{model.AuditActionCommandExecuted}, "", 0, 1001)
+	require.Error(t, err)
+}
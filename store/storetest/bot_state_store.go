@@ -0,0 +1,128 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestBotStateStore(t *testing.T, ss store.Store) {
+	t.Run("SaveAndGet", func(t *testing.T) { testBotStateStoreSaveAndGet(t, ss) })
+	t.Run("GetKeys", func(t *testing.T) { testBotStateStoreGetKeys(t, ss) })
+	t.Run("Expiry", func(t *testing.T) { testBotStateStoreExpiry(t, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testBotStateStorePermanentDeleteByUser(t, ss) })
+}
+
+func testBotStateStoreSaveAndGet(t *testing.T, ss store.Store) {
+	botUserID := model.NewId()
+	userID := model.NewId()
+	channelID := model.NewId()
+
+	saved, err := ss.BotState().Save(&model.BotState{BotUserId: botUserID, UserId: userID, ChannelId: channelID, Key: "dialog.step", Value: json.RawMessage(`{"step":1}`)})
+	require.NoError(t, err)
+
+	state, err := ss.BotState().Get(botUserID, userID, channelID, "dialog.step")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"step":1}`, string(state.Value))
+	assert.Equal(t, saved.CreateAt, state.CreateAt)
+
+	_, err = ss.BotState().Save(&model.BotState{BotUserId: botUserID, UserId: userID, ChannelId: channelID, Key: "dialog.step", Value: json.RawMessage(`{"step":2}`), CreateAt: saved.CreateAt})
+	require.NoError(t, err)
+	state, err = ss.BotState().Get(botUserID, userID, channelID, "dialog.step")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"step":2}`, string(state.Value))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.BotState().Get(botUserID, userID, "", "dialog.step")
+	require.True(t, errors.As(err, &nfErr), "states are scoped to their channel")
+	_, err = ss.BotState().Get(model.NewId(), userID, channelID, "dialog.step")
+	require.True(t, errors.As(err, &nfErr), "states are scoped to their bot")
+
+	_, err = ss.BotState().Save(&model.BotState{BotUserId: botUserID, Key: "dialog.step", Value: json.RawMessage(`not json`)})
+	require.Error(t, err)
+
+	require.NoError(t, ss.BotState().Delete(botUserID, userID, channelID, "dialog.step"))
+	_, err = ss.BotState().Get(botUserID, userID, channelID, "dialog.step")
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testBotStateStoreGetKeys(t *testing.T, ss store.Store) {
+	botUserID := model.NewId()
+	userID := model.NewId()
+
+	for _, key := range []string{"b", "a", "c"} {
+		_, err := ss.BotState().Save(&model.BotState{BotUserId: botUserID, UserId: userID, Key: key, Value: json.RawMessage(`true`)})
+		require.NoError(t, err)
+	}
+	_, err := ss.BotState().Save(&model.BotState{BotUserId: botUserID, Key: "global", Value: json.RawMessage(`true`)})
+	require.NoError(t, err)
+
+	keys, err := ss.BotState().GetKeys(botUserID, userID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+
+	keys, err = ss.BotState().GetKeys(botUserID, userID, "", 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, keys)
+
+	keys, err = ss.BotState().GetKeys(botUserID, "", "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"global"}, keys)
+}
+
+func testBotStateStoreExpiry(t *testing.T, ss store.Store) {
+	botUserID := model.NewId()
+	now := model.GetMillis()
+
+	_, err := ss.BotState().Save(&model.BotState{BotUserId: botUserID, Key: "expired", Value: json.RawMessage(`1`), ExpireAt: now - 1000})
+	require.NoError(t, err)
+	_, err = ss.BotState().Save(&model.BotState{BotUserId: botUserID, Key: "live", Value: json.RawMessage(`2`), ExpireAt: now + 60*1000})
+	require.NoError(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.BotState().Get(botUserID, "", "", "expired")
+	require.True(t, errors.As(err, &nfErr), "expired states aren't returned")
+
+	keys, err := ss.BotState().GetKeys(botUserID, "", "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"live"}, keys)
+
+	deleted, err := ss.BotState().PermanentDeleteExpiredBatch(now, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	_, err = ss.BotState().Get(botUserID, "", "", "live")
+	require.NoError(t, err)
+}
+
+func testBotStateStorePermanentDeleteByUser(t *testing.T, ss store.Store) {
+	botUserID := model.NewId()
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	_, err := ss.BotState().Save(&model.BotState{BotUserId: botUserID, UserId: userID, Key: "k", Value: json.RawMessage(`1`)})
+	require.NoError(t, err)
+	_, err = ss.BotState().Save(&model.BotState{BotUserId: botUserID, UserId: otherUserID, Key: "k", Value: json.RawMessage(`1`)})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.BotState().PermanentDeleteByUser(userID))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.BotState().Get(botUserID, userID, "", "k")
+	require.True(t, errors.As(err, &nfErr))
+	_, err = ss.BotState().Get(botUserID, otherUserID, "", "k")
+	require.NoError(t, err)
+
+	require.NoError(t, ss.BotState().PermanentDeleteByUser(botUserID))
+	_, err = ss.BotState().Get(botUserID, otherUserID, "", "k")
+	require.True(t, errors.As(err, &nfErr), "the states of a deleted bot are deleted")
+}
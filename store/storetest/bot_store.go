@@ -308,6 +308,23 @@ func testBotStoreGetAll(t *testing.T, ss store.Store, s SqlStore) {
 			b4,
 		}, bots)
 	})
+
+	t.Run("get offset=0, limit=10, in channel", func(t *testing.T) {
+		channelID := model.NewId()
+		_, err := ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:   channelID,
+			UserId:      b2.UserId,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.NoError(t, err)
+		defer func() { require.NoError(t, ss.Channel().RemoveMember(channelID, b2.UserId)) }()
+
+		bots, err := ss.Bot().GetAll(&model.BotGetOptions{Page: 0, PerPage: 10, InChannelId: channelID})
+		require.NoError(t, err)
+		require.Equal(t, []*model.Bot{
+			b2,
+		}, bots)
+	})
 }
 
 func testBotStoreSave(t *testing.T, ss store.Store) {
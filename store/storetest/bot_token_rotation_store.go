@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestBotTokenRotationStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testBotTokenRotationStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("GetDue", func(t *testing.T) { testBotTokenRotationStoreGetDue(t, ss) })
+	t.Run("GetTokenAges", func(t *testing.T) { testBotTokenRotationStoreGetTokenAges(t, ss) })
+}
+
+func testBotTokenRotationStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	botUserID := model.NewId()
+
+	_, err := ss.BotTokenRotation().Save(&model.BotTokenRotation{BotUserId: botUserID, RotationDays: 30})
+	var appErr *model.AppError
+	require.ErrorAs(t, err, &appErr)
+
+	saved, err := ss.BotTokenRotation().Save(&model.BotTokenRotation{
+		BotUserId:    botUserID,
+		RotationDays: 30,
+		GraceHours:   12,
+		WebhookURL:   "https://example.com/rotate",
+	})
+	require.NoError(t, err)
+
+	_, err = ss.BotTokenRotation().Save(&model.BotTokenRotation{BotUserId: botUserID, RotationDays: 30, WebhookURL: "https://example.com/rotate"})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	rotation, err := ss.BotTokenRotation().Get(botUserID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, rotation)
+
+	rotation.CurrentTokenId = model.NewId()
+	rotation.LastRotatedAt = 1000
+	rotation.NextRotationAt = 2000
+	_, err = ss.BotTokenRotation().Update(rotation)
+	require.NoError(t, err)
+
+	updated, err := ss.BotTokenRotation().Get(botUserID)
+	require.NoError(t, err)
+	assert.Equal(t, rotation.CurrentTokenId, updated.CurrentTokenId)
+	assert.Equal(t, int64(2000), updated.NextRotationAt)
+	assert.Equal(t, saved.CreateAt, updated.CreateAt)
+
+	require.NoError(t, ss.BotTokenRotation().Delete(botUserID))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.BotTokenRotation().Get(botUserID)
+	require.ErrorAs(t, err, &nfErr)
+	require.ErrorAs(t, ss.BotTokenRotation().Delete(botUserID), &nfErr)
+	_, err = ss.BotTokenRotation().Update(rotation)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testBotTokenRotationStoreGetDue(t *testing.T, ss store.Store) {
+	var rotations []*model.BotTokenRotation
+	for _, nextRotationAt := range []int64{3000, 1000, 5000} {
+		rotation, err := ss.BotTokenRotation().Save(&model.BotTokenRotation{
+			BotUserId:      model.NewId(),
+			RotationDays:   1,
+			WebhookURL:     "https://example.com/rotate",
+			NextRotationAt: nextRotationAt,
+		})
+		require.NoError(t, err)
+		rotations = append(rotations, rotation)
+	}
+	defer func() {
+		for _, rotation := range rotations {
+			require.NoError(t, ss.BotTokenRotation().Delete(rotation.BotUserId))
+		}
+	}()
+
+	due, err := ss.BotTokenRotation().GetDue(3000, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, rotations[1].BotUserId, due[0].BotUserId)
+	assert.Equal(t, rotations[0].BotUserId, due[1].BotUserId)
+
+	due, err = ss.BotTokenRotation().GetDue(3000, 1)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, rotations[1].BotUserId, due[0].BotUserId)
+}
+
+func testBotTokenRotationStoreGetTokenAges(t *testing.T, ss store.Store) {
+	bot, _ := makeBotWithUser(t, ss, &model.Bot{
+		Username: "rotated_bot_" + model.NewId(),
+		OwnerId:  model.NewId(),
+	})
+	defer func() {
+		require.NoError(t, ss.UserAccessToken().DeleteAllForUser(bot.UserId))
+		require.NoError(t, ss.Bot().PermanentDelete(bot.UserId))
+		require.NoError(t, ss.User().PermanentDelete(bot.UserId))
+	}()
+
+	older, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: bot.UserId, Description: "older"})
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	current, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: bot.UserId, Description: "current", ExpiresAt: model.GetMillis() + 60*1000})
+	require.NoError(t, err)
+	// Tokens of the users aren't listed.
+	userToken, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: model.NewId(), Description: "user"})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.UserAccessToken().Delete(userToken.Id)) }()
+
+	_, err = ss.BotTokenRotation().Save(&model.BotTokenRotation{
+		BotUserId:      bot.UserId,
+		RotationDays:   1,
+		WebhookURL:     "https://example.com/rotate",
+		CurrentTokenId: current.Id,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.BotTokenRotation().Delete(bot.UserId)) }()
+
+	ages, err := ss.BotTokenRotation().GetTokenAges(0, 1000)
+	require.NoError(t, err)
+
+	var botAges []*model.BotTokenAge
+	for _, age := range ages {
+		assert.NotEqual(t, userToken.Id, age.TokenId)
+		if age.BotUserId == bot.UserId {
+			botAges = append(botAges, age)
+		}
+	}
+	require.Len(t, botAges, 2)
+	assert.Equal(t, older.Id, botAges[0].TokenId)
+	assert.Equal(t, bot.Username, botAges[0].BotUsername)
+	assert.False(t, botAges[0].Rotated)
+	assert.Equal(t, current.Id, botAges[1].TokenId)
+	assert.True(t, botAges[1].Rotated)
+	assert.Equal(t, current.ExpiresAt, botAges[1].ExpiresAt)
+}
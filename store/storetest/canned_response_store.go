@@ -0,0 +1,146 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestCannedResponseStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testCannedResponseStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("Autocomplete", func(t *testing.T) { testCannedResponseStoreAutocomplete(t, ss) })
+}
+
+func testCannedResponseStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	teamID := model.NewId()
+
+	userResponse, err := ss.CannedResponse().Save(&model.CannedResponse{
+		UserId:    userID,
+		CreatorId: userID,
+		Shortcut:  "hello",
+		Content:   "Hello {{customer}}",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, userResponse.Id)
+
+	teamResponse, err := ss.CannedResponse().Save(&model.CannedResponse{
+		TeamId:    teamID,
+		CreatorId: userID,
+		Shortcut:  "hello",
+		Content:   "Hello from the team",
+	})
+	require.NoError(t, err)
+
+	t.Run("save with id", func(t *testing.T) {
+		_, err := ss.CannedResponse().Save(&model.CannedResponse{Id: model.NewId(), UserId: userID, CreatorId: userID, Shortcut: "x", Content: "x"})
+		require.Error(t, err)
+	})
+
+	t.Run("save duplicate shortcut", func(t *testing.T) {
+		_, err := ss.CannedResponse().Save(&model.CannedResponse{UserId: userID, CreatorId: userID, Shortcut: "hello", Content: "again"})
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		got, err := ss.CannedResponse().Get(userResponse.Id)
+		require.NoError(t, err)
+		assert.Equal(t, userResponse, got)
+
+		responses, err := ss.CannedResponse().GetForUser(userID)
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		assert.Equal(t, userResponse.Id, responses[0].Id)
+
+		responses, err = ss.CannedResponse().GetForTeam(teamID)
+		require.NoError(t, err)
+		require.Len(t, responses, 1)
+		assert.Equal(t, teamResponse.Id, responses[0].Id)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		userResponse.Content = "Hi {{customer}}"
+		_, err := ss.CannedResponse().Update(userResponse)
+		require.NoError(t, err)
+
+		got, err := ss.CannedResponse().Get(userResponse.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "Hi {{customer}}", got.Content)
+	})
+
+	t.Run("increment use count", func(t *testing.T) {
+		require.NoError(t, ss.CannedResponse().IncrementUseCount(userResponse.Id, 1000))
+		require.NoError(t, ss.CannedResponse().IncrementUseCount(userResponse.Id, 2000))
+
+		got, err := ss.CannedResponse().Get(userResponse.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), got.UseCount)
+		assert.Equal(t, int64(2000), got.LastUsedAt)
+
+		err = ss.CannedResponse().IncrementUseCount(model.NewId(), 1000)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.CannedResponse().Delete(userResponse.Id))
+
+		_, err := ss.CannedResponse().Get(userResponse.Id)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		err = ss.CannedResponse().Delete(userResponse.Id)
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testCannedResponseStoreAutocomplete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	teamID := model.NewId()
+
+	save := func(response *model.CannedResponse) *model.CannedResponse {
+		response.CreatorId = userID
+		response.Content = "content"
+		saved, err := ss.CannedResponse().Save(response)
+		require.NoError(t, err)
+		return saved
+	}
+
+	thanks := save(&model.CannedResponse{UserId: userID, Shortcut: "thanks"})
+	ticket := save(&model.CannedResponse{UserId: userID, Shortcut: "ticket"})
+	teamThanks := save(&model.CannedResponse{TeamId: teamID, Shortcut: "thanks-team"})
+	save(&model.CannedResponse{UserId: model.NewId(), Shortcut: "thanks"})
+	save(&model.CannedResponse{TeamId: model.NewId(), Shortcut: "thanks"})
+
+	require.NoError(t, ss.CannedResponse().IncrementUseCount(teamThanks.Id, model.GetMillis()))
+
+	responses, err := ss.CannedResponse().Autocomplete(userID, teamID, "th", 10)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, teamThanks.Id, responses[0].Id)
+	assert.Equal(t, thanks.Id, responses[1].Id)
+
+	responses, err = ss.CannedResponse().Autocomplete(userID, "", "t", 10)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+	assert.Equal(t, thanks.Id, responses[0].Id)
+	assert.Equal(t, ticket.Id, responses[1].Id)
+
+	responses, err = ss.CannedResponse().Autocomplete(userID, teamID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, teamThanks.Id, responses[0].Id)
+
+	responses, err = ss.CannedResponse().Autocomplete(userID, teamID, "%", 10)
+	require.NoError(t, err)
+	assert.Empty(t, responses)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelArchivePolicyStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testChannelArchivePolicyStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("GetInactiveChannels", func(t *testing.T) { testChannelArchivePolicyStoreGetInactiveChannels(t, ss) })
+	t.Run("Warnings", func(t *testing.T) { testChannelArchivePolicyStoreWarnings(t, ss) })
+}
+
+func testChannelArchivePolicyStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	policy, err := ss.ChannelArchivePolicy().Save(&model.ChannelArchivePolicy{
+		TeamId:       model.NewId(),
+		InactiveDays: 30,
+	})
+	require.NoError(t, err)
+
+	_, err = ss.ChannelArchivePolicy().Save(&model.ChannelArchivePolicy{
+		TeamId:       policy.TeamId,
+		InactiveDays: 60,
+	})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	policy.InactiveDays = 90
+	policy.ExcludedChannelIds = model.StringArray{model.NewId()}
+	_, err = ss.ChannelArchivePolicy().Update(policy)
+	require.NoError(t, err)
+
+	got, err := ss.ChannelArchivePolicy().Get(policy.TeamId)
+	require.NoError(t, err)
+	assert.Equal(t, policy, got)
+
+	all, err := ss.ChannelArchivePolicy().GetAll()
+	require.NoError(t, err)
+	assert.Contains(t, all, policy)
+
+	err = ss.ChannelArchivePolicy().Delete(policy.TeamId)
+	require.NoError(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.ChannelArchivePolicy().Get(policy.TeamId)
+	require.ErrorAs(t, err, &nfErr)
+
+	err = ss.ChannelArchivePolicy().Delete(policy.TeamId)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testChannelArchivePolicyStoreGetInactiveChannels(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	saveChannel := func(name string, channelType model.ChannelType, lastPostAt int64) *model.Channel {
+		channel, err := ss.Channel().Save(&model.Channel{
+			TeamId:      teamID,
+			DisplayName: name,
+			Name:        name,
+			Type:        channelType,
+		}, -1)
+		require.NoError(t, err)
+
+		channel.LastPostAt = lastPostAt
+		channel, err = ss.Channel().Update(channel)
+		require.NoError(t, err)
+		return channel
+	}
+
+	now := model.GetMillis()
+	inactive := saveChannel("inactive-"+model.NewId(), model.ChannelTypeOpen, 1000)
+	inactivePrivate := saveChannel("private-"+model.NewId(), model.ChannelTypePrivate, 2000)
+	excluded := saveChannel("excluded-"+model.NewId(), model.ChannelTypeOpen, 1000)
+	warned := saveChannel("warned-"+model.NewId(), model.ChannelTypeOpen, 1000)
+	saveChannel(model.DefaultChannelName, model.ChannelTypeOpen, 1000)
+	saveChannel("active-"+model.NewId(), model.ChannelTypeOpen, now)
+
+	require.NoError(t, ss.ChannelArchivePolicy().SaveWarning(&model.ChannelArchiveWarning{
+		ChannelId:      warned.Id,
+		TeamId:         teamID,
+		LastActivityAt: 1000,
+		WarnedAt:       now,
+	}))
+	defer ss.ChannelArchivePolicy().DeleteWarning(warned.Id)
+
+	// Channels are created now, so they only count as inactive until shortly after.
+	policy := &model.ChannelArchivePolicy{TeamId: teamID, InactiveDays: 30, ExcludedChannelIds: model.StringArray{excluded.Id}}
+	channels, err := ss.ChannelArchivePolicy().GetInactiveChannels(policy, model.GetMillis()+1, 100)
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+	assert.Equal(t, inactive.Id, channels[0].Id)
+	assert.Equal(t, inactivePrivate.Id, channels[1].Id)
+
+	channels, err = ss.ChannelArchivePolicy().GetInactiveChannels(policy, model.GetMillis()+1, 1)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+
+	channels, err = ss.ChannelArchivePolicy().GetInactiveChannels(policy, 500, 100)
+	require.NoError(t, err)
+	assert.Empty(t, channels)
+}
+
+func testChannelArchivePolicyStoreWarnings(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	warning := &model.ChannelArchiveWarning{
+		ChannelId:      model.NewId(),
+		TeamId:         teamID,
+		LastActivityAt: 1000,
+		WarnedAt:       2000,
+	}
+	require.NoError(t, ss.ChannelArchivePolicy().SaveWarning(warning))
+
+	err := ss.ChannelArchivePolicy().SaveWarning(warning)
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	warnings, err := ss.ChannelArchivePolicy().GetWarnings(teamID)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, warning, warnings[0])
+
+	// Deleting the policy of the team deletes its warnings.
+	_, err = ss.ChannelArchivePolicy().Save(&model.ChannelArchivePolicy{TeamId: teamID, InactiveDays: 30})
+	require.NoError(t, err)
+	require.NoError(t, ss.ChannelArchivePolicy().Delete(teamID))
+
+	warnings, err = ss.ChannelArchivePolicy().GetWarnings(teamID)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	require.NoError(t, ss.ChannelArchivePolicy().DeleteWarning(warning.ChannelId))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelCommandOverrideStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testChannelCommandOverrideStoreSaveGet(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelCommandOverrideStoreGetForChannel(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testChannelCommandOverrideStoreDelete(t, ss) })
+}
+
+func testChannelCommandOverrideStoreSaveGet(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	creatorID := model.NewId()
+
+	_, err := ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "gi phy", CreatorId: creatorID})
+	var appErr *model.AppError
+	require.ErrorAs(t, err, &appErr)
+
+	saved, err := ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "/Giphy", CreatorId: creatorID})
+	require.NoError(t, err)
+	assert.Equal(t, "giphy", saved.Trigger)
+	assert.NotZero(t, saved.CreateAt)
+
+	_, err = ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "giphy", CreatorId: model.NewId()})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	override, err := ss.ChannelCommandOverride().Get(channelID, "giphy")
+	require.NoError(t, err)
+	assert.Equal(t, saved, override)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.ChannelCommandOverride().Get(channelID, "echo")
+	require.ErrorAs(t, err, &nfErr)
+	_, err = ss.ChannelCommandOverride().Get(model.NewId(), "giphy")
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testChannelCommandOverrideStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	creatorID := model.NewId()
+
+	second, err := ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "giphy", CreatorId: creatorID})
+	require.NoError(t, err)
+	first, err := ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "echo", CreatorId: creatorID})
+	require.NoError(t, err)
+	_, err = ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: model.NewId(), Trigger: "echo", CreatorId: creatorID})
+	require.NoError(t, err)
+
+	overrides, err := ss.ChannelCommandOverride().GetForChannel(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ChannelCommandOverride{first, second}, overrides)
+
+	overrides, err = ss.ChannelCommandOverride().GetForChannel(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+}
+
+func testChannelCommandOverrideStoreDelete(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	creatorID := model.NewId()
+
+	_, err := ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "giphy", CreatorId: creatorID})
+	require.NoError(t, err)
+	_, err = ss.ChannelCommandOverride().Save(&model.ChannelCommandOverride{ChannelId: channelID, Trigger: "echo", CreatorId: creatorID})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.ChannelCommandOverride().Delete(channelID, "giphy"))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.ChannelCommandOverride().Get(channelID, "giphy")
+	require.ErrorAs(t, err, &nfErr)
+	require.ErrorAs(t, ss.ChannelCommandOverride().Delete(channelID, "giphy"), &nfErr)
+
+	require.NoError(t, ss.ChannelCommandOverride().DeleteForChannel(channelID))
+	overrides, err := ss.ChannelCommandOverride().GetForChannel(channelID)
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+}
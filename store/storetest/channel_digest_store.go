@@ -0,0 +1,168 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelDigestStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetDelete", func(t *testing.T) { testChannelDigestStoreSaveGetDelete(t, ss) })
+	t.Run("GetDue", func(t *testing.T) { testChannelDigestStoreGetDue(t, ss) })
+	t.Run("GetActivity", func(t *testing.T) { testChannelDigestStoreGetActivity(t, ss) })
+}
+
+func testChannelDigestStoreSaveGetDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	channelID := model.NewId()
+
+	saved, err := ss.ChannelDigest().Save(&model.ChannelDigest{
+		UserId:    userID,
+		ChannelId: channelID,
+		Cadence:   model.ChannelDigestCadenceDaily,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ChannelDigestCadenceDaily, saved.Cadence)
+	assert.NotZero(t, saved.LastSentAt)
+
+	t.Run("save again only changes the cadence", func(t *testing.T) {
+		updated, err := ss.ChannelDigest().Save(&model.ChannelDigest{
+			UserId:    userID,
+			ChannelId: channelID,
+			Cadence:   model.ChannelDigestCadenceWeekly,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelDigestCadenceWeekly, updated.Cadence)
+		assert.Equal(t, saved.CreateAt, updated.CreateAt)
+		assert.Equal(t, saved.LastSentAt, updated.LastSentAt)
+	})
+
+	t.Run("invalid cadence", func(t *testing.T) {
+		_, err := ss.ChannelDigest().Save(&model.ChannelDigest{
+			UserId:    userID,
+			ChannelId: channelID,
+			Cadence:   "hourly",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("get for user", func(t *testing.T) {
+		digests, err := ss.ChannelDigest().GetForUser(userID)
+		require.NoError(t, err)
+		require.Len(t, digests, 1)
+		assert.Equal(t, channelID, digests[0].ChannelId)
+
+		digests, err = ss.ChannelDigest().GetForUser(model.NewId())
+		require.NoError(t, err)
+		assert.Empty(t, digests)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.ChannelDigest().Delete(userID, channelID))
+
+		_, err := ss.ChannelDigest().Get(userID, channelID)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		err = ss.ChannelDigest().Delete(userID, channelID)
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testChannelDigestStoreGetDue(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	userID := model.NewId()
+	channelIDs := []string{model.NewId(), model.NewId(), model.NewId()}
+
+	for i, channelID := range channelIDs {
+		_, err := ss.ChannelDigest().Save(&model.ChannelDigest{
+			UserId:     userID,
+			ChannelId:  channelID,
+			Cadence:    model.ChannelDigestCadenceWeekly,
+			CreateAt:   now - 30*model.ChannelDigestPeriod(model.ChannelDigestCadenceDaily),
+			LastSentAt: now - int64(i)*model.ChannelDigestPeriod(model.ChannelDigestCadenceWeekly),
+		})
+		require.NoError(t, err)
+	}
+
+	sentBefore := now - model.ChannelDigestPeriod(model.ChannelDigestCadenceWeekly) + 1
+	due, err := ss.ChannelDigest().GetDue(model.ChannelDigestCadenceWeekly, sentBefore, 100)
+	require.NoError(t, err)
+
+	var found []string
+	for _, digest := range due {
+		if digest.UserId == userID {
+			found = append(found, digest.ChannelId)
+		}
+	}
+	assert.ElementsMatch(t, channelIDs[1:], found)
+
+	due, err = ss.ChannelDigest().GetDue(model.ChannelDigestCadenceDaily, sentBefore, 100)
+	require.NoError(t, err)
+	for _, digest := range due {
+		assert.NotEqual(t, userID, digest.UserId)
+	}
+
+	require.NoError(t, ss.ChannelDigest().UpdateLastSentAt(userID, channelIDs[1:], now))
+	due, err = ss.ChannelDigest().GetDue(model.ChannelDigestCadenceWeekly, sentBefore, 100)
+	require.NoError(t, err)
+	for _, digest := range due {
+		assert.NotEqual(t, userID, digest.UserId)
+	}
+}
+
+func testChannelDigestStoreGetActivity(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	userID := model.NewId()
+	otherUserID := model.NewId()
+	username := "digest" + model.NewId()[:10]
+
+	savePost := func(post *model.Post) *model.Post {
+		post.ChannelId = channelID
+		saved, err := ss.Post().Save(post)
+		require.NoError(t, err)
+		return saved
+	}
+
+	since := model.GetMillis() - 1000
+
+	busy := savePost(&model.Post{UserId: otherUserID, Message: "busy thread"})
+	for i := 0; i < 3; i++ {
+		savePost(&model.Post{UserId: otherUserID, RootId: busy.Id, Message: "reply"})
+	}
+	quiet := savePost(&model.Post{UserId: otherUserID, Message: "quiet thread"})
+	savePost(&model.Post{UserId: otherUserID, RootId: quiet.Id, Message: "reply"})
+
+	unanswered := savePost(&model.Post{UserId: otherUserID, Message: "hey @" + username + " can you look?"})
+	answered := savePost(&model.Post{UserId: otherUserID, Message: "@" + username + " ping"})
+	savePost(&model.Post{UserId: userID, RootId: answered.Id, Message: "done"})
+	savePost(&model.Post{UserId: otherUserID, Type: model.PostTypeJoinChannel, Message: "joined"})
+
+	until := model.GetMillis() + 1
+
+	activity, err := ss.ChannelDigest().GetActivity(channelID, userID, username, since, until)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(9), activity.PostCount)
+
+	require.Len(t, activity.TopThreads, 3)
+	assert.Equal(t, busy.Id, activity.TopThreads[0].PostId)
+	assert.Equal(t, int64(3), activity.TopThreads[0].ReplyCount)
+
+	require.Len(t, activity.UnansweredMentions, 1)
+	assert.Equal(t, unanswered.Id, activity.UnansweredMentions[0].PostId)
+
+	t.Run("empty period", func(t *testing.T) {
+		activity, err := ss.ChannelDigest().GetActivity(channelID, userID, username, until, until+1000)
+		require.NoError(t, err)
+		assert.True(t, activity.IsEmpty())
+	})
+}
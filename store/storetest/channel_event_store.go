@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelEventStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testChannelEventStoreSaveGet(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testChannelEventStoreGetForChannel(t, ss) })
+	t.Run("MarkReverted", func(t *testing.T) { testChannelEventStoreMarkReverted(t, ss) })
+}
+
+func newTestChannelEvent(channelID string, createAt int64) *model.ChannelEvent {
+	return &model.ChannelEvent{
+		ChannelId: channelID,
+		UserId:    model.NewId(),
+		Type:      model.ChannelEventTypeConvert,
+		OldValue:  string(model.ChannelTypeOpen),
+		NewValue:  string(model.ChannelTypePrivate),
+		CreateAt:  createAt,
+	}
+}
+
+func testChannelEventStoreSaveGet(t *testing.T, ss store.Store) {
+	event := newTestChannelEvent(model.NewId(), 0)
+	event.Id = model.NewId()
+	id := event.Id
+
+	saved, err := ss.ChannelEvent().Save(event)
+	require.NoError(t, err)
+	assert.Equal(t, id, saved.Id)
+	assert.NotZero(t, saved.CreateAt)
+
+	got, err := ss.ChannelEvent().Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, saved, got)
+
+	_, err = ss.ChannelEvent().Save(newTestChannelEvent("invalid", 0))
+	require.Error(t, err)
+
+	_, err = ss.ChannelEvent().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelEventStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	now := model.GetMillis()
+
+	first, err := ss.ChannelEvent().Save(newTestChannelEvent(channelID, now-2000))
+	require.NoError(t, err)
+	second, err := ss.ChannelEvent().Save(newTestChannelEvent(channelID, now-1000))
+	require.NoError(t, err)
+	_, err = ss.ChannelEvent().Save(newTestChannelEvent(model.NewId(), now))
+	require.NoError(t, err)
+
+	events, err := ss.ChannelEvent().GetForChannel(channelID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, second.Id, events[0].Id)
+	assert.Equal(t, first.Id, events[1].Id)
+
+	events, err = ss.ChannelEvent().GetForChannel(channelID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, first.Id, events[0].Id)
+
+	events, err = ss.ChannelEvent().GetForChannel(model.NewId(), 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testChannelEventStoreMarkReverted(t *testing.T, ss store.Store) {
+	event, err := ss.ChannelEvent().Save(newTestChannelEvent(model.NewId(), 0))
+	require.NoError(t, err)
+
+	userID := model.NewId()
+	ok, err := ss.ChannelEvent().MarkReverted(event.Id, userID, 1234)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ss.ChannelEvent().MarkReverted(event.Id, model.NewId(), 5678)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	got, err := ss.ChannelEvent().Get(event.Id)
+	require.NoError(t, err)
+	assert.True(t, got.IsReverted())
+	assert.Equal(t, int64(1234), got.RevertedAt)
+	assert.Equal(t, userID, got.RevertedBy)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelFeedStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdate", func(t *testing.T) { testChannelFeedStoreSaveGetUpdate(t, ss) })
+	t.Run("GetDue", func(t *testing.T) { testChannelFeedStoreGetDue(t, ss) })
+	t.Run("Items", func(t *testing.T) { testChannelFeedStoreItems(t, ss) })
+}
+
+func saveTestChannelFeed(t *testing.T, ss store.Store, channelID string) *model.ChannelFeed {
+	feed, err := ss.ChannelFeed().Save(&model.ChannelFeed{
+		ChannelId: channelID,
+		TeamId:    model.NewId(),
+		CreatorId: model.NewId(),
+		URL:       "https://example.com/feed.xml",
+	})
+	require.NoError(t, err)
+	return feed
+}
+
+func testChannelFeedStoreSaveGetUpdate(t *testing.T, ss store.Store) {
+	_, err := ss.ChannelFeed().Save(&model.ChannelFeed{Id: model.NewId()})
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr))
+
+	_, err = ss.ChannelFeed().Save(&model.ChannelFeed{ChannelId: model.NewId(), TeamId: model.NewId(), CreatorId: model.NewId(), URL: "junk"})
+	var appErr *model.AppError
+	require.True(t, errors.As(err, &appErr))
+
+	channelID := model.NewId()
+	feed := saveTestChannelFeed(t, ss, channelID)
+	other := saveTestChannelFeed(t, ss, channelID)
+	saveTestChannelFeed(t, ss, model.NewId())
+
+	got, err := ss.ChannelFeed().Get(feed.Id)
+	require.NoError(t, err)
+	assert.Equal(t, feed, got)
+
+	feeds, err := ss.ChannelFeed().GetForChannel(channelID)
+	require.NoError(t, err)
+	require.Len(t, feeds, 2)
+	assert.ElementsMatch(t, []string{feed.Id, other.Id}, []string{feeds[0].Id, feeds[1].Id})
+
+	feed.Template = "{{.Title}}"
+	feed.PollIntervalMinutes = 60
+	_, err = ss.ChannelFeed().Update(feed)
+	require.NoError(t, err)
+
+	feed.RecordPoll("unreachable", model.GetMillis())
+	require.NoError(t, ss.ChannelFeed().UpdatePollResult(feed))
+
+	got, err = ss.ChannelFeed().Get(feed.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "{{.Title}}", got.Template)
+	assert.Equal(t, 60, got.PollIntervalMinutes)
+	assert.Equal(t, "unreachable", got.LastError)
+	assert.Equal(t, feed.NextPollAt, got.NextPollAt)
+
+	_, err = ss.ChannelFeed().Update(&model.ChannelFeed{Id: model.NewId(), ChannelId: model.NewId(), TeamId: model.NewId(), CreatorId: model.NewId(), URL: feed.URL, PollIntervalMinutes: 15, CreateAt: 1})
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	require.NoError(t, ss.ChannelFeed().Delete(feed.Id))
+	_, err = ss.ChannelFeed().Get(feed.Id)
+	require.True(t, errors.As(err, &nfErr))
+	err = ss.ChannelFeed().Delete(feed.Id)
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testChannelFeedStoreGetDue(t *testing.T, ss store.Store) {
+	due := saveTestChannelFeed(t, ss, model.NewId())
+	notDue := saveTestChannelFeed(t, ss, model.NewId())
+	notDue.RecordPoll("", model.GetMillis())
+	require.NoError(t, ss.ChannelFeed().UpdatePollResult(notDue))
+
+	feeds, err := ss.ChannelFeed().GetDue(model.GetMillis(), 1000)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(feeds))
+	for _, feed := range feeds {
+		ids = append(ids, feed.Id)
+	}
+	assert.Contains(t, ids, due.Id)
+	assert.NotContains(t, ids, notDue.Id)
+}
+
+func testChannelFeedStoreItems(t *testing.T, ss store.Store) {
+	feed := saveTestChannelFeed(t, ss, model.NewId())
+	other := saveTestChannelFeed(t, ss, model.NewId())
+
+	existing, err := ss.ChannelFeed().GetExistingItemGuids(feed.Id, nil)
+	require.NoError(t, err)
+	assert.Empty(t, existing)
+
+	require.NoError(t, ss.ChannelFeed().SaveItems([]*model.ChannelFeedItem{
+		{FeedId: feed.Id, ItemGuid: "item-1", PostId: model.NewId()},
+		{FeedId: feed.Id, ItemGuid: "item-2"},
+		{FeedId: other.Id, ItemGuid: "item-3", PostId: model.NewId()},
+	}))
+
+	existing, err = ss.ChannelFeed().GetExistingItemGuids(feed.Id, []string{"item-1", "item-3", "item-4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"item-1"}, existing)
+
+	require.Error(t, ss.ChannelFeed().SaveItems([]*model.ChannelFeedItem{{FeedId: feed.Id, ItemGuid: "item-1"}}), "an item is recorded once")
+
+	require.NoError(t, ss.ChannelFeed().Delete(feed.Id))
+	existing, err = ss.ChannelFeed().GetExistingItemGuids(feed.Id, []string{"item-1", "item-2"})
+	require.NoError(t, err)
+	assert.Empty(t, existing)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestChannelLanguageStatsStore(t *testing.T, ss store.Store) {
+	t.Run("RollupDay", func(t *testing.T) { testChannelLanguageStatsStoreRollupDay(t, ss) })
+}
+
+func testChannelLanguageStatsStoreRollupDay(t *testing.T, ss store.Store) {
+	day := model.GetStartOfDayMillis(time.Date(2001, time.April, 5, 0, 0, 0, 0, time.UTC), 0)
+	nextDay := day + model.TeamStatsDayMillis
+
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "Languages",
+		Name:        "z-z-" + model.NewId() + "a",
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	newPost := func(language string, createAt int64) {
+		post := &model.Post{
+			ChannelId: channel.Id,
+			UserId:    model.NewId(),
+			Message:   "message",
+			CreateAt:  createAt,
+		}
+		if language != "" {
+			post.AddProp(model.PostPropsLanguage, language)
+		}
+		_, nErr := ss.Post().Save(post)
+		require.NoError(t, nErr)
+	}
+	newPost("en", day+1)
+	newPost("en", day+2)
+	newPost("fr", day+3)
+	newPost("", day+4)
+	// Posts of other days don't count.
+	newPost("de", nextDay)
+	newPost("de", day-1)
+
+	require.NoError(t, ss.ChannelLanguageStats().RollupDay(day))
+	// Rolling up a day again replaces its stats.
+	require.NoError(t, ss.ChannelLanguageStats().RollupDay(day))
+
+	counts, err := ss.ChannelLanguageStats().GetForChannel(channel.Id, day, nextDay)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ChannelLanguagePostCount{
+		{Language: "en", PostCount: 2},
+		{Language: "", PostCount: 1},
+		{Language: "fr", PostCount: 1},
+	}, counts)
+
+	counts, err = ss.ChannelLanguageStats().GetForChannel(channel.Id, nextDay, nextDay+model.TeamStatsDayMillis)
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
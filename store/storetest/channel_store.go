@@ -118,6 +118,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("SearchForUserInTeam", func(t *testing.T) { testChannelStoreSearchForUserInTeam(t, ss) })
 	t.Run("SearchAllChannels", func(t *testing.T) { testChannelStoreSearchAllChannels(t, ss) })
 	t.Run("GetMembersByIds", func(t *testing.T) { testChannelStoreGetMembersByIds(t, ss) })
+	t.Run("GetMembersSorted", func(t *testing.T) { testChannelStoreGetMembersSorted(t, ss) })
 	t.Run("GetMembersByChannelIds", func(t *testing.T) { testChannelStoreGetMembersByChannelIds(t, ss) })
 	t.Run("GetMembersInfoByChannelIds", func(t *testing.T) { testChannelStoreGetMembersInfoByChannelIds(t, ss) })
 	t.Run("SearchGroupChannels", func(t *testing.T) { testChannelStoreSearchGroupChannels(t, ss) })
@@ -126,6 +127,7 @@ func TestChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("GetPinnedPostCount", func(t *testing.T) { testChannelStoreGetPinnedPostCount(t, ss) })
 	t.Run("MaxChannelsPerTeam", func(t *testing.T) { testChannelStoreMaxChannelsPerTeam(t, ss) })
 	t.Run("GetChannelsByScheme", func(t *testing.T) { testChannelStoreGetChannelsByScheme(t, ss) })
+	t.Run("GetChannelsWithDeactivatedCreator", func(t *testing.T) { testChannelStoreGetChannelsWithDeactivatedCreator(t, ss) })
 	t.Run("MigrateChannelMembers", func(t *testing.T) { testChannelStoreMigrateChannelMembers(t, ss) })
 	t.Run("ResetAllChannelSchemes", func(t *testing.T) { testResetAllChannelSchemes(t, ss) })
 	t.Run("ClearAllCustomRoleAssignments", func(t *testing.T) { testChannelStoreClearAllCustomRoleAssignments(t, ss) })
@@ -238,7 +240,7 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store, s SqlStore)
 	_, nErr = ss.Channel().SaveDirectChannel(&o1, &m1, &m2)
 	require.NoError(t, nErr, "couldn't save direct channel", nErr)
 
-	members, nErr := ss.Channel().GetMembers(o1.Id, 0, 100)
+	members, nErr := ss.Channel().GetMembers(o1.Id, 0, 100, nil)
 	require.NoError(t, nErr)
 	require.Len(t, members, 2, "should have saved 2 members")
 
@@ -274,7 +276,7 @@ func testChannelStoreSaveDirectChannel(t *testing.T, ss store.Store, s SqlStore)
 	_, nErr = ss.Channel().SaveDirectChannel(&o1, &m1, &m1)
 	require.NoError(t, nErr, "couldn't save direct channel", nErr)
 
-	members, nErr = ss.Channel().GetMembers(o1.Id, 0, 100)
+	members, nErr = ss.Channel().GetMembers(o1.Id, 0, 100, nil)
 	require.NoError(t, nErr)
 	require.Len(t, members, 1, "should have saved just 1 member")
 
@@ -306,7 +308,7 @@ func testChannelStoreCreateDirectChannel(t *testing.T, ss store.Store) {
 		ss.Channel().PermanentDelete(c1.Id)
 	}()
 
-	members, nErr := ss.Channel().GetMembers(c1.Id, 0, 100)
+	members, nErr := ss.Channel().GetMembers(c1.Id, 0, 100, nil)
 	require.NoError(t, nErr)
 	require.Len(t, members, 2, "should have saved 2 members")
 }
@@ -6326,6 +6328,79 @@ func testChannelStoreGetMembersByIds(t *testing.T, ss store.Store) {
 	require.Len(t, members, 0)
 }
 
+func testChannelStoreGetMembersSorted(t *testing.T, ss store.Store) {
+	channel, nErr := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "ChannelA",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, nErr)
+
+	var users []*model.User
+	for _, username := range []string{"c", "a", "b"} {
+		u, err := ss.User().Save(&model.User{
+			Email:    MakeEmail(),
+			Username: username + model.NewId(),
+		})
+		require.NoError(t, err)
+		users = append(users, u)
+	}
+
+	for i, u := range users {
+		_, err := ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:    channel.Id,
+			UserId:       u.Id,
+			NotifyProps:  model.GetDefaultChannelNotifyProps(),
+			SchemeUser:   true,
+			SchemeAdmin:  i == 2,
+			LastViewedAt: int64(i + 1),
+		})
+		require.NoError(t, err)
+	}
+
+	// users[0] posted last, users[1] never posted.
+	_, err := ss.Post().Save(&model.Post{ChannelId: channel.Id, UserId: users[2].Id, Message: "first", CreateAt: 1000})
+	require.NoError(t, err)
+	_, err = ss.Post().Save(&model.Post{ChannelId: channel.Id, UserId: users[0].Id, Message: "second", CreateAt: 2000})
+	require.NoError(t, err)
+
+	userIds := func(members model.ChannelMembers) []string {
+		ids := make([]string, 0, len(members))
+		for _, m := range members {
+			ids = append(ids, m.UserId)
+		}
+		return ids
+	}
+
+	for _, tc := range []struct {
+		sort     string
+		expected []string
+	}{
+		{model.ChannelMembersSortByLastViewedAt, []string{users[2].Id, users[1].Id, users[0].Id}},
+		{model.ChannelMembersSortByLastPostAt, []string{users[0].Id, users[2].Id, users[1].Id}},
+		{model.ChannelMembersSortByUsername, []string{users[1].Id, users[2].Id, users[0].Id}},
+		{model.ChannelMembersSortByAdmin, []string{users[2].Id, users[1].Id, users[0].Id}},
+	} {
+		t.Run(tc.sort, func(t *testing.T) {
+			members, err := ss.Channel().GetMembers(channel.Id, 0, 100, &model.ChannelMembersGetOptions{Sort: tc.sort})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, userIds(members))
+
+			members, err = ss.Channel().GetMembers(channel.Id, 1, 1, &model.ChannelMembersGetOptions{Sort: tc.sort})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected[1:2], userIds(members))
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		members, err := ss.Channel().GetMembers(channel.Id, 0, 100, nil)
+		require.NoError(t, err)
+		ids := userIds(members)
+		assert.True(t, sort.StringsAreSorted(ids))
+	})
+}
+
 func testChannelStoreGetMembersByChannelIds(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 
@@ -6836,6 +6911,57 @@ func testChannelStoreMaxChannelsPerTeam(t *testing.T, ss store.Store) {
 	assert.NoError(t, nErr)
 }
 
+func testChannelStoreGetChannelsWithDeactivatedCreator(t *testing.T, ss store.Store) {
+	active, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(active.Id)) }()
+
+	deactivated, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), DeleteAt: model.GetMillis()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(deactivated.Id)) }()
+
+	teamId := model.NewId()
+	saveChannel := func(teamId, creatorId string, channelType model.ChannelType) *model.Channel {
+		channel, nErr := ss.Channel().Save(&model.Channel{
+			TeamId:      teamId,
+			DisplayName: "Name " + model.NewId(),
+			Name:        NewTestId(),
+			Type:        channelType,
+			CreatorId:   creatorId,
+		}, -1)
+		require.NoError(t, nErr)
+		return channel
+	}
+
+	open := saveChannel(teamId, deactivated.Id, model.ChannelTypeOpen)
+	private := saveChannel(teamId, deactivated.Id, model.ChannelTypePrivate)
+	saveChannel(teamId, active.Id, model.ChannelTypeOpen)
+	archived := saveChannel(teamId, deactivated.Id, model.ChannelTypeOpen)
+	require.NoError(t, ss.Channel().Delete(archived.Id, model.GetMillis()))
+	otherTeam := saveChannel(model.NewId(), deactivated.Id, model.ChannelTypeOpen)
+
+	channelIds := func(channels model.ChannelList) []string {
+		ids := []string{}
+		for _, channel := range channels {
+			ids = append(ids, channel.Id)
+		}
+		return ids
+	}
+
+	channels, err := ss.Channel().GetChannelsWithDeactivatedCreator(teamId, 0, 100)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{open.Id, private.Id}, channelIds(channels))
+
+	channels, err = ss.Channel().GetChannelsWithDeactivatedCreator(teamId, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+
+	channels, err = ss.Channel().GetChannelsWithDeactivatedCreator("", 0, 10000)
+	require.NoError(t, err)
+	assert.Subset(t, channelIds(channels), []string{open.Id, private.Id, otherTeam.Id})
+	assert.NotContains(t, channelIds(channels), archived.Id)
+}
+
 func testChannelStoreGetChannelsByScheme(t *testing.T, ss store.Store) {
 	// Create some schemes.
 	s1 := &model.Scheme{
@@ -7382,7 +7508,7 @@ func testChannelStoreRemoveAllDeactivatedMembers(t *testing.T, ss store.Store, s
 	require.NoError(t, err)
 
 	// Get all the channel members. Check there are 3.
-	d1, err := ss.Channel().GetMembers(c1.Id, 0, 1000)
+	d1, err := ss.Channel().GetMembers(c1.Id, 0, 1000, nil)
 	assert.NoError(t, err)
 	assert.Len(t, d1, 3)
 
@@ -7398,7 +7524,7 @@ func testChannelStoreRemoveAllDeactivatedMembers(t *testing.T, ss store.Store, s
 	assert.NoError(t, ss.Channel().RemoveAllDeactivatedMembers(c1.Id))
 
 	// Get all the channel members. Check there is now only 1: m3.
-	d2, err := ss.Channel().GetMembers(c1.Id, 0, 1000)
+	d2, err := ss.Channel().GetMembers(c1.Id, 0, 1000, nil)
 	assert.NoError(t, err)
 	assert.Len(t, d2, 1)
 	assert.Equal(t, u3.Id, d2[0].UserId)
@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestConfigHistoryStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetByVersion", func(t *testing.T) { testConfigHistoryStoreSaveGetByVersion(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testConfigHistoryStoreGetAll(t, ss) })
+}
+
+func newTestConfigVersion() *model.ConfigVersion {
+	hash := sha256.Sum256([]byte(model.NewId()))
+	return &model.ConfigVersion{
+		Version: hex.EncodeToString(hash[:]),
+		UserId:  model.NewId(),
+		Value:   `{"ServiceSettings":{}}`,
+	}
+}
+
+func testConfigHistoryStoreSaveGetByVersion(t *testing.T, ss store.Store) {
+	version, err := ss.ConfigHistory().Save(newTestConfigVersion())
+	require.NoError(t, err)
+	require.NotEmpty(t, version.Id)
+
+	got, err := ss.ConfigHistory().GetByVersion(version.Version)
+	require.NoError(t, err)
+	assert.Equal(t, version, got)
+
+	t.Run("latest record is returned", func(t *testing.T) {
+		again := newTestConfigVersion()
+		again.Version = version.Version
+		time.Sleep(2 * time.Millisecond)
+		again, err := ss.ConfigHistory().Save(again)
+		require.NoError(t, err)
+
+		got, err := ss.ConfigHistory().GetByVersion(version.Version)
+		require.NoError(t, err)
+		assert.Equal(t, again.Id, got.Id)
+	})
+
+	t.Run("save with id", func(t *testing.T) {
+		invalid := newTestConfigVersion()
+		invalid.Id = model.NewId()
+		_, err := ss.ConfigHistory().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("save invalid", func(t *testing.T) {
+		invalid := newTestConfigVersion()
+		invalid.Version = "short"
+		_, err := ss.ConfigHistory().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := ss.ConfigHistory().GetByVersion(newTestConfigVersion().Version)
+		var nfErr *store.ErrNotFound
+		assert.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testConfigHistoryStoreGetAll(t *testing.T, ss store.Store) {
+	first, err := ss.ConfigHistory().Save(newTestConfigVersion())
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	second, err := ss.ConfigHistory().Save(newTestConfigVersion())
+	require.NoError(t, err)
+
+	versions, err := ss.ConfigHistory().GetAll(0, 1000)
+	require.NoError(t, err)
+
+	firstIndex, secondIndex := -1, -1
+	for i, version := range versions {
+		assert.Empty(t, version.Value)
+		switch version.Id {
+		case first.Id:
+			firstIndex = i
+		case second.Id:
+			secondIndex = i
+		}
+	}
+	require.NotEqual(t, -1, firstIndex)
+	require.NotEqual(t, -1, secondIndex)
+	assert.Less(t, secondIndex, firstIndex)
+
+	page, err := ss.ConfigHistory().GetAll(0, 1)
+	require.NoError(t, err)
+	assert.Len(t, page, 1)
+}
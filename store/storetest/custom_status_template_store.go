@@ -0,0 +1,149 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestCustomStatusTemplateStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testCustomStatusTemplateStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("IncrementUseCount", func(t *testing.T) { testCustomStatusTemplateStoreIncrementUseCount(t, ss) })
+}
+
+func testCustomStatusTemplateStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	creatorID := model.NewId()
+	teamID := model.NewId()
+
+	orgTemplate, err := ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{
+		CreatorId: creatorID,
+		Emoji:     "calendar",
+		Text:      "In a meeting " + model.NewId(),
+		Duration:  "one_hour",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, orgTemplate.Id)
+	defer ss.CustomStatusTemplate().Delete(orgTemplate.Id)
+
+	_, err = ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{Id: model.NewId(), CreatorId: creatorID, Text: "Existing"})
+	var invErr *store.ErrInvalidInput
+	require.True(t, errors.As(err, &invErr))
+
+	_, err = ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{CreatorId: creatorID})
+	var appErr *model.AppError
+	require.True(t, errors.As(err, &appErr))
+
+	override, err := ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{
+		TeamId:     teamID,
+		OverrideId: orgTemplate.Id,
+		CreatorId:  creatorID,
+		Emoji:      "calendar",
+		Text:       "In a customer call",
+		Duration:   "four_hours",
+	})
+	require.NoError(t, err)
+
+	teamTemplate, err := ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{
+		TeamId:    teamID,
+		CreatorId: creatorID,
+		Emoji:     "palm_tree",
+		Text:      "On call",
+	})
+	require.NoError(t, err)
+
+	got, err := ss.CustomStatusTemplate().Get(orgTemplate.Id)
+	require.NoError(t, err)
+	assert.Equal(t, orgTemplate, got)
+
+	templates, err := ss.CustomStatusTemplate().GetForTeam(teamID)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, override.Id, templates[0].Id)
+	assert.Equal(t, teamTemplate.Id, templates[1].Id)
+
+	templates, err = ss.CustomStatusTemplate().GetForTeam("")
+	require.NoError(t, err)
+	found := false
+	for _, template := range templates {
+		assert.Empty(t, template.TeamId)
+		found = found || template.Id == orgTemplate.Id
+	}
+	assert.True(t, found)
+
+	teamTemplate.Text = "Out of office"
+	teamTemplate.TeamId = model.NewId()
+	updated, err := ss.CustomStatusTemplate().Update(teamTemplate)
+	require.NoError(t, err)
+	got, err = ss.CustomStatusTemplate().Get(updated.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Out of office", got.Text)
+	assert.Equal(t, teamID, got.TeamId, "the team of a template can't change")
+
+	_, err = ss.CustomStatusTemplate().Update(&model.CustomStatusTemplate{Id: model.NewId(), CreatorId: creatorID, Emoji: "x", Text: "x", CreateAt: 1})
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	// Deleting a template deletes the templates overriding it.
+	require.NoError(t, ss.CustomStatusTemplate().Delete(orgTemplate.Id))
+	_, err = ss.CustomStatusTemplate().Get(override.Id)
+	require.True(t, errors.As(err, &nfErr))
+	_, err = ss.CustomStatusTemplate().Get(teamTemplate.Id)
+	require.NoError(t, err)
+
+	require.NoError(t, ss.CustomStatusTemplate().Delete(teamTemplate.Id))
+	err = ss.CustomStatusTemplate().Delete(teamTemplate.Id)
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testCustomStatusTemplateStoreIncrementUseCount(t *testing.T, ss store.Store) {
+	creatorID := model.NewId()
+	teamID := model.NewId()
+	text := "Commuting " + model.NewId()
+
+	save := func(teamID, emoji string) *model.CustomStatusTemplate {
+		template, err := ss.CustomStatusTemplate().Save(&model.CustomStatusTemplate{TeamId: teamID, CreatorId: creatorID, Emoji: emoji, Text: text})
+		require.NoError(t, err)
+		t.Cleanup(func() { ss.CustomStatusTemplate().Delete(template.Id) })
+		return template
+	}
+	orgTemplate := save("", "bus")
+	teamTemplate := save(teamID, "bus")
+	otherTeamTemplate := save(model.NewId(), "bus")
+	otherEmojiTemplate := save("", "train")
+
+	require.NoError(t, ss.CustomStatusTemplate().IncrementUseCount([]string{teamID}, "bus", text, 1000))
+	require.NoError(t, ss.CustomStatusTemplate().IncrementUseCount(nil, "bus", text, 2000))
+
+	for _, tc := range []struct {
+		template   *model.CustomStatusTemplate
+		useCount   int64
+		lastUsedAt int64
+	}{
+		{orgTemplate, 2, 2000},
+		{teamTemplate, 1, 1000},
+		{otherTeamTemplate, 0, 0},
+		{otherEmojiTemplate, 0, 0},
+	} {
+		got, err := ss.CustomStatusTemplate().Get(tc.template.Id)
+		require.NoError(t, err)
+		assert.Equal(t, tc.useCount, got.UseCount, got.TeamId)
+		assert.Equal(t, tc.lastUsedAt, got.LastUsedAt, got.TeamId)
+	}
+
+	templates, err := ss.CustomStatusTemplate().GetMostUsed()
+	require.NoError(t, err)
+	positions := map[string]int{}
+	for i, template := range templates {
+		positions[template.Id] = i
+	}
+	assert.Less(t, positions[orgTemplate.Id], positions[teamTemplate.Id])
+	assert.Less(t, positions[teamTemplate.Id], positions[otherTeamTemplate.Id])
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestDirectChannelRetentionStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetDelete", func(t *testing.T) { testDirectChannelRetentionStoreSaveGetDelete(t, ss) })
+	t.Run("GetActive", func(t *testing.T) { testDirectChannelRetentionStoreGetActive(t, ss) })
+}
+
+func testDirectChannelRetentionStoreSaveGetDelete(t *testing.T, ss store.Store) {
+	retention := &model.DirectChannelRetention{
+		ChannelId:     model.NewId(),
+		RetentionDays: 30,
+		ProposedBy:    model.NewId(),
+		ProposedAt:    model.GetMillis(),
+	}
+
+	_, err := ss.DirectChannelRetention().Save(retention)
+	require.NoError(t, err)
+
+	got, err := ss.DirectChannelRetention().Get(retention.ChannelId)
+	require.NoError(t, err)
+	assert.Equal(t, retention, got)
+
+	t.Run("save replaces", func(t *testing.T) {
+		retention.RetentionDays = 7
+		retention.AcceptedAt = model.GetMillis()
+		_, err := ss.DirectChannelRetention().Save(retention)
+		require.NoError(t, err)
+
+		got, err := ss.DirectChannelRetention().Get(retention.ChannelId)
+		require.NoError(t, err)
+		assert.Equal(t, retention, got)
+	})
+
+	t.Run("update last purge at", func(t *testing.T) {
+		purgeAt := model.GetMillis()
+		require.NoError(t, ss.DirectChannelRetention().UpdateLastPurgeAt(retention.ChannelId, purgeAt))
+
+		got, err := ss.DirectChannelRetention().Get(retention.ChannelId)
+		require.NoError(t, err)
+		assert.Equal(t, purgeAt, got.LastPurgeAt)
+		assert.Equal(t, retention.RetentionDays, got.RetentionDays)
+		retention.LastPurgeAt = purgeAt
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.DirectChannelRetention().Save(&model.DirectChannelRetention{ChannelId: model.NewId()})
+		require.Error(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.DirectChannelRetention().Delete(retention.ChannelId))
+
+		var nfErr *store.ErrNotFound
+		_, err := ss.DirectChannelRetention().Get(retention.ChannelId)
+		require.True(t, errors.As(err, &nfErr))
+
+		err = ss.DirectChannelRetention().Delete(retention.ChannelId)
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testDirectChannelRetentionStoreGetActive(t *testing.T, ss store.Store) {
+	var active []string
+	for i := 0; i < 3; i++ {
+		retention := &model.DirectChannelRetention{
+			ChannelId:     model.NewId(),
+			RetentionDays: 30,
+			ProposedBy:    model.NewId(),
+			ProposedAt:    model.GetMillis(),
+		}
+		if i > 0 {
+			retention.AcceptedAt = model.GetMillis()
+			active = append(active, retention.ChannelId)
+		}
+		_, err := ss.DirectChannelRetention().Save(retention)
+		require.NoError(t, err)
+	}
+
+	var found []string
+	afterChannelID := ""
+	for {
+		retentions, err := ss.DirectChannelRetention().GetActive(afterChannelID, 1)
+		require.NoError(t, err)
+		if len(retentions) == 0 {
+			break
+		}
+		require.Len(t, retentions, 1)
+		assert.True(t, retentions[0].IsActive())
+		found = append(found, retentions[0].ChannelId)
+		afterChannelID = retentions[0].ChannelId
+	}
+
+	for _, channelID := range active {
+		assert.Contains(t, found, channelID)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestEmailSuppressionStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetDelete", func(t *testing.T) { testEmailSuppressionStoreSaveGetDelete(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testEmailSuppressionStoreGetAll(t, ss) })
+	t.Run("GetForEmails", func(t *testing.T) { testEmailSuppressionStoreGetForEmails(t, ss) })
+}
+
+func testEmailSuppressionStoreSaveGetDelete(t *testing.T, ss store.Store) {
+	email := "Bounced-" + model.NewId() + "@example.com"
+
+	saved, err := ss.EmailSuppression().Save(&model.EmailSuppression{
+		Email:    email,
+		Reason:   model.EmailSuppressionReasonBounce,
+		Provider: model.EmailProviderSendGrid,
+		Details:  "550 no such user",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.NormalizeEmail(email), saved.Email)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.EmailSuppression().Save(&model.EmailSuppression{Email: email, Reason: "unknown"})
+		require.Error(t, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		got, err := ss.EmailSuppression().Get(email)
+		require.NoError(t, err)
+		assert.Equal(t, saved, got)
+
+		_, err = ss.EmailSuppression().Get("unknown-" + model.NewId() + "@example.com")
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("save again replaces the reason", func(t *testing.T) {
+		_, err := ss.EmailSuppression().Save(&model.EmailSuppression{
+			Email:    email,
+			Reason:   model.EmailSuppressionReasonComplaint,
+			Provider: model.EmailProviderMailgun,
+		})
+		require.NoError(t, err)
+
+		got, err := ss.EmailSuppression().Get(email)
+		require.NoError(t, err)
+		assert.Equal(t, model.EmailSuppressionReasonComplaint, got.Reason)
+		assert.Equal(t, model.EmailProviderMailgun, got.Provider)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.EmailSuppression().Delete(email))
+
+		var nfErr *store.ErrNotFound
+		_, err := ss.EmailSuppression().Get(email)
+		require.True(t, errors.As(err, &nfErr))
+
+		err = ss.EmailSuppression().Delete(email)
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testEmailSuppressionStoreGetAll(t *testing.T, ss store.Store) {
+	older, err := ss.EmailSuppression().Save(&model.EmailSuppression{
+		Email:    model.NewId() + "@example.com",
+		Reason:   model.EmailSuppressionReasonBounce,
+		CreateAt: model.GetMillis() + 100000,
+	})
+	require.NoError(t, err)
+	newer, err := ss.EmailSuppression().Save(&model.EmailSuppression{
+		Email:    model.NewId() + "@example.com",
+		Reason:   model.EmailSuppressionReasonComplaint,
+		CreateAt: model.GetMillis() + 200000,
+	})
+	require.NoError(t, err)
+
+	suppressions, err := ss.EmailSuppression().GetAll(0, 2)
+	require.NoError(t, err)
+	require.Len(t, suppressions, 2)
+	assert.Equal(t, newer.Email, suppressions[0].Email)
+	assert.Equal(t, older.Email, suppressions[1].Email)
+
+	suppressions, err = ss.EmailSuppression().GetAll(1, 1)
+	require.NoError(t, err)
+	require.Len(t, suppressions, 1)
+	assert.Equal(t, older.Email, suppressions[0].Email)
+}
+
+func testEmailSuppressionStoreGetForEmails(t *testing.T, ss store.Store) {
+	suppressed, err := ss.EmailSuppression().Save(&model.EmailSuppression{
+		Email:  model.NewId() + "@example.com",
+		Reason: model.EmailSuppressionReasonBounce,
+	})
+	require.NoError(t, err)
+
+	suppressions, err := ss.EmailSuppression().GetForEmails([]string{
+		strings.ToUpper(suppressed.Email),
+		model.NewId() + "@example.com",
+	})
+	require.NoError(t, err)
+	require.Len(t, suppressions, 1)
+	assert.Equal(t, suppressed.Email, suppressions[0].Email)
+
+	suppressions, err = ss.EmailSuppression().GetForEmails(nil)
+	require.NoError(t, err)
+	assert.Empty(t, suppressions)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestEventWebhookStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testEventWebhookStoreSaveGet(t, ss) })
+	t.Run("GetForEvent", func(t *testing.T) { testEventWebhookStoreGetForEvent(t, ss) })
+	t.Run("UpdateDelete", func(t *testing.T) { testEventWebhookStoreUpdateDelete(t, ss) })
+	t.Run("Deliveries", func(t *testing.T) { testEventWebhookStoreDeliveries(t, ss) })
+	t.Run("PermanentDeleteDeliveriesBatch", func(t *testing.T) { testEventWebhookStorePermanentDeleteDeliveriesBatch(t, ss) })
+}
+
+func newTestEventWebhook(events ...string) *model.EventWebhook {
+	return &model.EventWebhook{
+		CreatorId:   model.NewId(),
+		DisplayName: "Provisioning",
+		URL:         "https://example.com/hooks/" + model.NewId(),
+		Events:      events,
+	}
+}
+
+func testEventWebhookStoreSaveGet(t *testing.T, ss store.Store) {
+	webhook, err := ss.EventWebhook().Save(newTestEventWebhook(model.EventWebhookEventUserCreated))
+	require.NoError(t, err)
+	require.NotEmpty(t, webhook.Id)
+	require.NotEmpty(t, webhook.Secret)
+
+	got, err := ss.EventWebhook().Get(webhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, webhook, got)
+
+	webhooks, err := ss.EventWebhook().GetAll(0, 1000)
+	require.NoError(t, err)
+	found := false
+	for _, w := range webhooks {
+		found = found || w.Id == webhook.Id
+	}
+	assert.True(t, found)
+
+	t.Run("save with id", func(t *testing.T) {
+		invalid := newTestEventWebhook(model.EventWebhookEventUserCreated)
+		invalid.Id = model.NewId()
+		_, err := ss.EventWebhook().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("save invalid", func(t *testing.T) {
+		_, err := ss.EventWebhook().Save(newTestEventWebhook())
+		var appErr *model.AppError
+		require.True(t, errors.As(err, &appErr))
+	})
+
+	t.Run("get missing", func(t *testing.T) {
+		_, err := ss.EventWebhook().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testEventWebhookStoreGetForEvent(t *testing.T, ss store.Store) {
+	users, err := ss.EventWebhook().Save(newTestEventWebhook(model.EventWebhookEventUserCreated))
+	require.NoError(t, err)
+	teams, err := ss.EventWebhook().Save(newTestEventWebhook(model.EventWebhookEventTeamDeleted, model.EventWebhookEventPostFlagged))
+	require.NoError(t, err)
+
+	ids := func(webhooks []*model.EventWebhook) []string {
+		ids := []string{}
+		for _, webhook := range webhooks {
+			ids = append(ids, webhook.Id)
+		}
+		return ids
+	}
+
+	webhooks, err := ss.EventWebhook().GetForEvent(model.EventWebhookEventUserCreated)
+	require.NoError(t, err)
+	assert.Contains(t, ids(webhooks), users.Id)
+	assert.NotContains(t, ids(webhooks), teams.Id)
+
+	webhooks, err = ss.EventWebhook().GetForEvent(model.EventWebhookEventPostFlagged)
+	require.NoError(t, err)
+	assert.Contains(t, ids(webhooks), teams.Id)
+	assert.NotContains(t, ids(webhooks), users.Id)
+
+	webhooks, err = ss.EventWebhook().GetForEvent("created")
+	require.NoError(t, err)
+	assert.NotContains(t, ids(webhooks), users.Id)
+}
+
+func testEventWebhookStoreUpdateDelete(t *testing.T, ss store.Store) {
+	webhook, err := ss.EventWebhook().Save(newTestEventWebhook(model.EventWebhookEventUserCreated))
+	require.NoError(t, err)
+
+	webhook.URL = "https://example.org/hooks"
+	webhook.Events = model.StringArray{model.EventWebhookEventTeamDeleted}
+	webhook.Secret = model.NewRandomString(model.EventWebhookSecretLength)
+	_, err = ss.EventWebhook().Update(webhook)
+	require.NoError(t, err)
+
+	got, err := ss.EventWebhook().Get(webhook.Id)
+	require.NoError(t, err)
+	assert.Equal(t, webhook, got)
+
+	delivery, err := ss.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{WebhookId: webhook.Id, Event: model.EventWebhookEventTeamDeleted, Payload: "{}"})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.EventWebhook().Delete(webhook.Id))
+	_, err = ss.EventWebhook().Get(webhook.Id)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	deliveries, err := ss.EventWebhook().GetDeliveries(webhook.Id, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+	_, err = ss.EventWebhook().UpdateDelivery(delivery)
+	require.True(t, errors.As(err, &nfErr))
+
+	err = ss.EventWebhook().Delete(webhook.Id)
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testEventWebhookStoreDeliveries(t *testing.T, ss store.Store) {
+	webhookID := model.NewId()
+	now := model.GetMillis() + 1000*1000
+
+	due, err := ss.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{WebhookId: webhookID, Event: model.EventWebhookEventUserCreated, Payload: "{}", NextAttemptAt: now - 1})
+	require.NoError(t, err)
+	notDue, err := ss.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{WebhookId: webhookID, Event: model.EventWebhookEventUserCreated, Payload: "{}", NextAttemptAt: now + 1})
+	require.NoError(t, err)
+	succeeded, err := ss.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{WebhookId: webhookID, Event: model.EventWebhookEventUserCreated, Payload: "{}", NextAttemptAt: now - 1})
+	require.NoError(t, err)
+	succeeded.RecordAttempt(200, "", now)
+	_, err = ss.EventWebhook().UpdateDelivery(succeeded)
+	require.NoError(t, err)
+
+	deliveries, err := ss.EventWebhook().GetDeliveries(webhookID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	assert.Equal(t, succeeded, deliveries[0])
+
+	deliveries, err = ss.EventWebhook().GetDueDeliveries(now, 1000)
+	require.NoError(t, err)
+	ids := []string{}
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.Id)
+	}
+	assert.Contains(t, ids, due.Id)
+	assert.NotContains(t, ids, notDue.Id)
+	assert.NotContains(t, ids, succeeded.Id)
+
+	claimed, err := ss.EventWebhook().ClaimDelivery(due.Id, due.NextAttemptAt, now+60*1000)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The delivery was already claimed.
+	claimed, err = ss.EventWebhook().ClaimDelivery(due.Id, due.NextAttemptAt, now+60*1000)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = ss.EventWebhook().ClaimDelivery(succeeded.Id, succeeded.NextAttemptAt, now+60*1000)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func testEventWebhookStorePermanentDeleteDeliveriesBatch(t *testing.T, ss store.Store) {
+	webhookID := model.NewId()
+	for i := 0; i < 3; i++ {
+		_, err := ss.EventWebhook().SaveDelivery(&model.EventWebhookDelivery{WebhookId: webhookID, Event: model.EventWebhookEventUserCreated, Payload: "{}"})
+		require.NoError(t, err)
+	}
+
+	deleted, err := ss.EventWebhook().PermanentDeleteDeliveriesBatch(model.GetMillis()+1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	for deleted > 0 {
+		deleted, err = ss.EventWebhook().PermanentDeleteDeliveriesBatch(model.GetMillis()+1, 1000)
+		require.NoError(t, err)
+	}
+
+	deliveries, err := ss.EventWebhook().GetDeliveries(webhookID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
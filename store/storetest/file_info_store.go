@@ -6,6 +6,7 @@ package storetest
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,11 +20,16 @@ import (
 func TestFileInfoStore(t *testing.T, ss store.Store) {
 	t.Run("FileInfoSaveGet", func(t *testing.T) { testFileInfoSaveGet(t, ss) })
 	t.Run("FileInfoSaveGetByPath", func(t *testing.T) { testFileInfoSaveGetByPath(t, ss) })
+	t.Run("FileInfoSaveGetExternal", func(t *testing.T) { testFileInfoSaveGetExternal(t, ss) })
 	t.Run("FileInfoGetForPost", func(t *testing.T) { testFileInfoGetForPost(t, ss) })
+	t.Run("FileInfoGetForPosts", func(t *testing.T) { testFileInfoGetForPosts(t, ss) })
 	t.Run("FileInfoGetForUser", func(t *testing.T) { testFileInfoGetForUser(t, ss) })
+	t.Run("FileInfoGetForTeamAfter", func(t *testing.T) { testFileInfoGetForTeamAfter(t, ss) })
+	t.Run("FileInfoContentHash", func(t *testing.T) { testFileInfoContentHash(t, ss) })
 	t.Run("FileInfoGetWithOptions", func(t *testing.T) { testFileInfoGetWithOptions(t, ss) })
 	t.Run("FileInfoAttachToPost", func(t *testing.T) { testFileInfoAttachToPost(t, ss) })
 	t.Run("FileInfoDeleteForPost", func(t *testing.T) { testFileInfoDeleteForPost(t, ss) })
+	t.Run("FileInfoRestoreForPosts", func(t *testing.T) { testFileInfoRestoreForPosts(t, ss) })
 	t.Run("FileInfoPermanentDelete", func(t *testing.T) { testFileInfoPermanentDelete(t, ss) })
 	t.Run("FileInfoPermanentDeleteBatch", func(t *testing.T) { testFileInfoPermanentDeleteBatch(t, ss) })
 	t.Run("FileInfoPermanentDeleteByUser", func(t *testing.T) { testFileInfoPermanentDeleteByUser(t, ss) })
@@ -64,6 +70,30 @@ func testFileInfoSaveGet(t *testing.T, ss store.Store) {
 	}()
 }
 
+func testFileInfoSaveGetExternal(t *testing.T, ss store.Store) {
+	info, err := ss.FileInfo().Save(&model.FileInfo{
+		CreatorId:   model.NewId(),
+		ChannelId:   model.NewId(),
+		Name:        "report.pdf",
+		ExternalURL: "https://bucket.s3.amazonaws.com/report.pdf?X-Amz-Signature=abc",
+	})
+	require.NoError(t, err)
+	defer func() {
+		ss.FileInfo().PermanentDelete(info.Id)
+	}()
+
+	rinfo, err := ss.FileInfo().Get(info.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.amazonaws.com/report.pdf?X-Amz-Signature=abc", rinfo.ExternalURL)
+	assert.Empty(t, rinfo.Path)
+	assert.True(t, rinfo.IsExternal())
+
+	_, err = ss.FileInfo().Save(&model.FileInfo{
+		CreatorId: model.NewId(),
+	})
+	assert.Error(t, err, "a file needs either a path or an external url")
+}
+
 func testFileInfoSaveGetByPath(t *testing.T, ss store.Store) {
 	info := &model.FileInfo{
 		CreatorId: model.NewId(),
@@ -96,6 +126,37 @@ func testFileInfoSaveGetByPath(t *testing.T, ss store.Store) {
 	}()
 }
 
+func testFileInfoGetForPosts(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	postId1 := model.NewId()
+	postId2 := model.NewId()
+
+	infos := []*model.FileInfo{
+		{PostId: postId1, CreatorId: userId, Path: "file.txt"},
+		{PostId: postId2, CreatorId: userId, Path: "file.txt"},
+		{PostId: postId2, CreatorId: userId, Path: "file.txt", DeleteAt: 123},
+		{PostId: model.NewId(), CreatorId: userId, Path: "file.txt"},
+	}
+
+	for i, info := range infos {
+		newInfo, err := ss.FileInfo().Save(info)
+		require.NoError(t, err)
+		infos[i] = newInfo
+		defer func(id string) {
+			ss.FileInfo().PermanentDelete(id)
+		}(newInfo.Id)
+	}
+
+	postInfos, err := ss.FileInfo().GetForPosts([]string{postId1, postId2})
+	require.NoError(t, err)
+	require.Len(t, postInfos, 2)
+	assert.ElementsMatch(t, []string{infos[0].Id, infos[1].Id}, []string{postInfos[0].Id, postInfos[1].Id})
+
+	postInfos, err = ss.FileInfo().GetForPosts([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, postInfos)
+}
+
 func testFileInfoGetForPost(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 	postId := model.NewId()
@@ -260,6 +321,95 @@ func testFileInfoGetForUser(t *testing.T, ss store.Store) {
 	assert.Len(t, userPosts, 1)
 }
 
+func testFileInfoGetForTeamAfter(t *testing.T, ss store.Store) {
+	teamId := model.NewId()
+	userId := model.NewId()
+
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      teamId,
+		DisplayName: "Name",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+	post, err := ss.Post().Save(&model.Post{ChannelId: channel.Id, UserId: userId})
+	require.NoError(t, err)
+
+	infos := []*model.FileInfo{
+		{CreatorId: userId, CreateAt: 1000, Path: "20220101/teams/" + teamId + "/channels/" + channel.Id + "/users/" + userId + "/a/file.txt"},
+		{CreatorId: userId, CreateAt: 2000, Path: "20220101/teams/noteam/channels/" + channel.Id + "/users/" + userId + "/b/file.txt", PostId: post.Id},
+		{CreatorId: userId, CreateAt: 3000, Path: "20220101/teams/" + teamId + "/channels/" + channel.Id + "/users/" + userId + "/c/file.txt", DeleteAt: 3000},
+		{CreatorId: userId, CreateAt: 4000, Path: "20220101/teams/" + model.NewId() + "/channels/" + model.NewId() + "/users/" + userId + "/d/file.txt"},
+	}
+	for i, info := range infos {
+		newInfo, err := ss.FileInfo().Save(info)
+		require.NoError(t, err)
+		infos[i] = newInfo
+		defer func(id string) {
+			ss.FileInfo().PermanentDelete(id)
+		}(newInfo.Id)
+	}
+
+	teamInfos, err := ss.FileInfo().GetForTeamAfter(teamId, 0, "", 100)
+	require.NoError(t, err)
+	require.Len(t, teamInfos, 3)
+	assert.Equal(t, infos[0].Id, teamInfos[0].Id)
+	assert.Equal(t, infos[1].Id, teamInfos[1].Id)
+	assert.Equal(t, infos[2].Id, teamInfos[2].Id)
+
+	teamInfos, err = ss.FileInfo().GetForTeamAfter(teamId, infos[0].CreateAt, infos[0].Id, 1)
+	require.NoError(t, err)
+	require.Len(t, teamInfos, 1)
+	assert.Equal(t, infos[1].Id, teamInfos[0].Id)
+}
+
+func testFileInfoContentHash(t *testing.T, ss store.Store) {
+	hash := strings.Repeat(model.NewId()[:16], 4)
+	userId := model.NewId()
+	createAt := model.GetMillis()
+
+	infos := []*model.FileInfo{
+		{CreatorId: userId, CreateAt: createAt, Path: "20220101/teams/" + model.NewId() + "/a/file.txt", Size: 10, ContentHash: hash},
+		{CreatorId: userId, CreateAt: createAt + 1, Path: "20220101/teams/" + model.NewId() + "/b/file.txt", Size: 10, DeleteAt: createAt + 1},
+		{CreatorId: userId, CreateAt: createAt + 2, Path: "20220101/teams/" + model.NewId() + "/c/file.txt", Size: 20, ContentHash: hash},
+	}
+	for i, info := range infos {
+		newInfo, err := ss.FileInfo().Save(info)
+		require.NoError(t, err)
+		infos[i] = newInfo
+		defer func(id string) {
+			ss.FileInfo().PermanentDelete(id)
+		}(newInfo.Id)
+	}
+
+	after, err := ss.FileInfo().GetAfter(infos[0].CreateAt, infos[0].Id, 2)
+	require.NoError(t, err)
+	require.Len(t, after, 2)
+	assert.Equal(t, infos[1].Id, after[0].Id)
+	assert.Equal(t, infos[2].Id, after[1].Id)
+
+	require.NoError(t, ss.FileInfo().SetContentHash(infos[1].Id, hash, infos[0].Path))
+
+	info, err := ss.FileInfo().Get(infos[1].Id)
+	require.NoError(t, err)
+	assert.Equal(t, hash, info.ContentHash)
+	assert.Equal(t, infos[0].Path, info.Path)
+
+	sameContent, err := ss.FileInfo().GetByContentHash(hash, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, sameContent, 2, "files of another size aren't the same")
+	assert.Equal(t, infos[0].Id, sameContent[0].Id)
+	assert.Equal(t, infos[1].Id, sameContent[1].Id)
+
+	count, err := ss.FileInfo().CountByPath(infos[0].Path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = ss.FileInfo().CountByPath(infos[1].Path)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
 func testFileInfoGetWithOptions(t *testing.T, ss store.Store) {
 	makePost := func(chId string, user string) *model.Post {
 		post := model.Post{}
@@ -539,6 +689,40 @@ func testFileInfoDeleteForPost(t *testing.T, ss store.Store) {
 	assert.Empty(t, infos)
 }
 
+func testFileInfoRestoreForPosts(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	postId := model.NewId()
+	otherPostId := model.NewId()
+
+	for _, id := range []string{postId, postId, otherPostId} {
+		info, err := ss.FileInfo().Save(&model.FileInfo{
+			PostId:    id,
+			CreatorId: userId,
+			Path:      "file.txt",
+		})
+		require.NoError(t, err)
+		defer func(id string) {
+			ss.FileInfo().PermanentDelete(id)
+		}(info.Id)
+	}
+
+	_, err := ss.FileInfo().DeleteForPost(postId)
+	require.NoError(t, err)
+	_, err = ss.FileInfo().DeleteForPost(otherPostId)
+	require.NoError(t, err)
+
+	err = ss.FileInfo().RestoreForPosts([]string{postId})
+	require.NoError(t, err)
+
+	infos, err := ss.FileInfo().GetForPost(postId, true, false, false)
+	require.NoError(t, err)
+	assert.Len(t, infos, 2)
+
+	infos, err = ss.FileInfo().GetForPost(otherPostId, true, false, false)
+	require.NoError(t, err)
+	assert.Empty(t, infos)
+}
+
 func testFileInfoPermanentDelete(t *testing.T, ss store.Store) {
 	info, err := ss.FileInfo().Save(&model.FileInfo{
 		PostId:    model.NewId(),
@@ -4709,7 +4709,7 @@ func groupTestpUpdateMembersRoleChannel(t *testing.T, ss store.Store) {
 			err = ss.Channel().UpdateMembersRole(channel.Id, tt.inUserIDs)
 			require.NoError(t, err)
 
-			members, err := ss.Channel().GetMembers(channel.Id, 0, 100)
+			members, err := ss.Channel().GetMembers(channel.Id, 0, 100, nil)
 			require.NoError(t, err)
 
 			require.GreaterOrEqual(t, len(members), 4) // sanity check for channel membership
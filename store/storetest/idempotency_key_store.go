@@ -0,0 +1,139 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestIdempotencyKeyStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testIdempotencyKeyStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("DeleteStaleReservation", func(t *testing.T) { testIdempotencyKeyStoreDeleteStaleReservation(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testIdempotencyKeyStorePermanentDeleteBatch(t, ss) })
+}
+
+func testIdempotencyKeyStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	key, err := ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         model.NewId(),
+		UserId:      model.NewId(),
+		RequestHash: model.HashIdempotentRequest(http.MethodPost, "/api/v4/teams", []byte("{}")),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, key.CreateAt)
+
+	_, err = ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         key.Key,
+		UserId:      key.UserId,
+		RequestHash: key.RequestHash,
+	})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	// The same key can be used by another user.
+	other, err := ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         key.Key,
+		UserId:      model.NewId(),
+		RequestHash: key.RequestHash,
+	})
+	require.NoError(t, err)
+	defer ss.IdempotencyKey().Delete(other.UserId, other.Key)
+
+	key.StatusCode = http.StatusCreated
+	key.Response = `{"id":"team"}`
+	_, err = ss.IdempotencyKey().Update(key)
+	require.NoError(t, err)
+
+	got, err := ss.IdempotencyKey().Get(key.UserId, key.Key)
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	err = ss.IdempotencyKey().Delete(key.UserId, key.Key)
+	require.NoError(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.IdempotencyKey().Get(key.UserId, key.Key)
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.IdempotencyKey().Update(key)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testIdempotencyKeyStoreDeleteStaleReservation(t *testing.T, ss store.Store) {
+	key, err := ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         model.NewId(),
+		UserId:      model.NewId(),
+		RequestHash: model.HashIdempotentRequest(http.MethodPost, "/api/v4/teams", nil),
+		CreateAt:    1000,
+	})
+	require.NoError(t, err)
+	defer ss.IdempotencyKey().Delete(key.UserId, key.Key)
+
+	deleted, err := ss.IdempotencyKey().DeleteStaleReservation(key.UserId, key.Key, 1000)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	// Completed requests are kept however old they are.
+	key.StatusCode = http.StatusCreated
+	key.Response = `{"id":"team"}`
+	_, err = ss.IdempotencyKey().Update(key)
+	require.NoError(t, err)
+	deleted, err = ss.IdempotencyKey().DeleteStaleReservation(key.UserId, key.Key, 2000)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	key.StatusCode = 0
+	key.Response = ""
+	_, err = ss.IdempotencyKey().Update(key)
+	require.NoError(t, err)
+	deleted, err = ss.IdempotencyKey().DeleteStaleReservation(key.UserId, key.Key, 2000)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.IdempotencyKey().Get(key.UserId, key.Key)
+	require.ErrorAs(t, err, &nfErr)
+
+	deleted, err = ss.IdempotencyKey().DeleteStaleReservation(key.UserId, key.Key, 2000)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func testIdempotencyKeyStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	requestHash := model.HashIdempotentRequest(http.MethodPost, "/api/v4/users", nil)
+
+	old, err := ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         model.NewId(),
+		UserId:      userID,
+		RequestHash: requestHash,
+		CreateAt:    1000,
+	})
+	require.NoError(t, err)
+
+	recent, err := ss.IdempotencyKey().Save(&model.IdempotencyKey{
+		Key:         model.NewId(),
+		UserId:      userID,
+		RequestHash: requestHash,
+	})
+	require.NoError(t, err)
+	defer ss.IdempotencyKey().Delete(recent.UserId, recent.Key)
+
+	deleted, err := ss.IdempotencyKey().PermanentDeleteBatch(2000, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.IdempotencyKey().Get(old.UserId, old.Key)
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.IdempotencyKey().Get(recent.UserId, recent.Key)
+	require.NoError(t, err)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestImpersonationStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testImpersonationStoreSaveGet(t, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testImpersonationStoreGetForUser(t, ss) })
+	t.Run("UpdateStatus", func(t *testing.T) { testImpersonationStoreUpdateStatus(t, ss) })
+}
+
+func newTestImpersonation(userID string, requireConsent bool) *model.Impersonation {
+	return &model.Impersonation{
+		ImpersonatorId:  model.NewId(),
+		UserId:          userID,
+		Reason:          "Reproduce a reported issue.",
+		DurationMinutes: 30,
+		RequireConsent:  requireConsent,
+	}
+}
+
+func testImpersonationStoreSaveGet(t *testing.T, ss store.Store) {
+	impersonation, err := ss.Impersonation().Save(newTestImpersonation(model.NewId(), true))
+	require.NoError(t, err)
+	require.NotEmpty(t, impersonation.Id)
+	assert.Equal(t, model.ImpersonationStatusPending, impersonation.Status)
+
+	got, err := ss.Impersonation().Get(impersonation.Id)
+	require.NoError(t, err)
+	assert.Equal(t, impersonation, got)
+
+	impersonation, err = ss.Impersonation().Save(newTestImpersonation(model.NewId(), false))
+	require.NoError(t, err)
+	assert.Equal(t, model.ImpersonationStatusApproved, impersonation.Status)
+
+	t.Run("save with id", func(t *testing.T) {
+		invalid := newTestImpersonation(model.NewId(), false)
+		invalid.Id = model.NewId()
+		_, err := ss.Impersonation().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("save invalid", func(t *testing.T) {
+		invalid := newTestImpersonation(model.NewId(), false)
+		invalid.Reason = ""
+		_, err := ss.Impersonation().Save(invalid)
+		var appErr *model.AppError
+		require.True(t, errors.As(err, &appErr))
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		_, err := ss.Impersonation().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testImpersonationStoreGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	first, err := ss.Impersonation().Save(newTestImpersonation(userID, false))
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	second, err := ss.Impersonation().Save(newTestImpersonation(userID, true))
+	require.NoError(t, err)
+	_, err = ss.Impersonation().Save(newTestImpersonation(model.NewId(), false))
+	require.NoError(t, err)
+
+	impersonations, err := ss.Impersonation().GetForUser(userID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, impersonations, 2)
+	assert.Equal(t, second.Id, impersonations[0].Id)
+	assert.Equal(t, first.Id, impersonations[1].Id)
+
+	impersonations, err = ss.Impersonation().GetForUser(userID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, impersonations, 1)
+	assert.Equal(t, first.Id, impersonations[0].Id)
+
+	impersonations, err = ss.Impersonation().GetForUser(model.NewId(), 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, impersonations)
+}
+
+func testImpersonationStoreUpdateStatus(t *testing.T, ss store.Store) {
+	impersonation, err := ss.Impersonation().Save(newTestImpersonation(model.NewId(), false))
+	require.NoError(t, err)
+
+	impersonation.Status = model.ImpersonationStatusActive
+	impersonation.SessionId = model.NewId()
+	impersonation.StartAt = model.GetMillis()
+	impersonation.ExpiresAt = impersonation.StartAt + 30*60*1000
+	updated, err := ss.Impersonation().UpdateStatus(impersonation, model.ImpersonationStatusApproved)
+	require.NoError(t, err)
+
+	got, err := ss.Impersonation().Get(impersonation.Id)
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+
+	t.Run("status changed meanwhile", func(t *testing.T) {
+		impersonation.Status = model.ImpersonationStatusEnded
+		impersonation.EndAt = model.GetMillis()
+		_, err := ss.Impersonation().UpdateStatus(impersonation, model.ImpersonationStatusApproved)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		unknown := newTestImpersonation(model.NewId(), false)
+		unknown.PreSave()
+		_, err := ss.Impersonation().UpdateStatus(unknown, model.ImpersonationStatusApproved)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
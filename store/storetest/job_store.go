@@ -27,6 +27,7 @@ func TestJobStore(t *testing.T, ss store.Store) {
 	t.Run("GetNewestJobByStatusAndType", func(t *testing.T) { testJobStoreGetNewestJobByStatusAndType(t, ss) })
 	t.Run("GetNewestJobByStatusesAndType", func(t *testing.T) { testJobStoreGetNewestJobByStatusesAndType(t, ss) })
 	t.Run("GetCountByStatusAndType", func(t *testing.T) { testJobStoreGetCountByStatusAndType(t, ss) })
+	t.Run("GetCountByStatusSince", func(t *testing.T) { testJobStoreGetCountByStatusSince(t, ss) })
 	t.Run("JobUpdateOptimistically", func(t *testing.T) { testJobUpdateOptimistically(t, ss) })
 	t.Run("JobUpdateStatusUpdateStatusOptimistically", func(t *testing.T) { testJobUpdateStatusUpdateStatusOptimistically(t, ss) })
 	t.Run("JobDelete", func(t *testing.T) { testJobDelete(t, ss) })
@@ -472,6 +473,49 @@ func testJobStoreGetCountByStatusAndType(t *testing.T, ss store.Store) {
 	assert.EqualValues(t, 1, count)
 }
 
+func testJobStoreGetCountByStatusSince(t *testing.T, ss store.Store) {
+	status := model.NewId()
+
+	jobs := []*model.Job{
+		{
+			Id:             model.NewId(),
+			Type:           model.NewId(),
+			LastActivityAt: 1000,
+			Status:         status,
+		},
+		{
+			Id:             model.NewId(),
+			Type:           model.NewId(),
+			LastActivityAt: 2000,
+			Status:         status,
+		},
+		{
+			Id:             model.NewId(),
+			Type:           model.NewId(),
+			LastActivityAt: 2000,
+			Status:         model.NewId(),
+		},
+	}
+
+	for _, job := range jobs {
+		_, err := ss.Job().Save(job)
+		require.NoError(t, err)
+		defer ss.Job().Delete(job.Id)
+	}
+
+	count, err := ss.Job().GetCountByStatusSince(status, 1000)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	count, err = ss.Job().GetCountByStatusSince(status, 1001)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	count, err = ss.Job().GetCountByStatusSince(status, 2001)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, count)
+}
+
 func testJobUpdateOptimistically(t *testing.T, ss store.Store) {
 	job := &model.Job{
 		Id:       model.NewId(),
@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestLicenseUsageStore(t *testing.T, ss store.Store) {
+	t.Run("RollupMonth", func(t *testing.T) { testLicenseUsageStoreRollupMonth(t, ss) })
+}
+
+func testLicenseUsageStoreRollupMonth(t *testing.T, ss store.Store) {
+	month := model.LicenseUsageMonth(time.Date(1999, time.March, 10, 0, 0, 0, 0, time.UTC))
+	nextMonth := model.AddLicenseUsageMonths(month, 1)
+
+	before, err := ss.LicenseUsage().RollupMonth(month)
+	require.NoError(t, err)
+
+	newPost := func(postType string, createAt int64) {
+		_, nErr := ss.Post().Save(&model.Post{
+			ChannelId: model.NewId(),
+			UserId:    model.NewId(),
+			Type:      postType,
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.NoError(t, nErr)
+	}
+	newPost("", month)
+	newPost("", nextMonth-1)
+	// System messages and the posts of other months don't count.
+	newPost(model.PostTypeJoinChannel, month+1)
+	newPost("", nextMonth)
+	newPost("", month-1)
+
+	user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: "u" + model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(user.Id)) }()
+	require.NoError(t, ss.Status().SaveOrUpdate(&model.Status{UserId: user.Id, Status: model.StatusOnline, LastActivityAt: model.GetMillis()}))
+
+	// Bots aren't active users.
+	bot, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: "b" + model.NewId(), IsBot: true})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(bot.Id)) }()
+	_, err = ss.Bot().Save(&model.Bot{UserId: bot.Id, Username: bot.Username, OwnerId: model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.Bot().PermanentDelete(bot.Id)) }()
+	require.NoError(t, ss.Status().SaveOrUpdate(&model.Status{UserId: bot.Id, Status: model.StatusOnline, LastActivityAt: model.GetMillis()}))
+
+	_, err = ss.FileInfo().Save(&model.FileInfo{CreatorId: model.NewId(), Path: "file.txt", Size: 1000})
+	require.NoError(t, err)
+
+	after, err := ss.LicenseUsage().RollupMonth(month)
+	require.NoError(t, err)
+	assert.Equal(t, month, after.Month)
+	assert.Equal(t, before.PostCount+2, after.PostCount)
+	assert.Equal(t, before.MonthlyActiveUsers+1, after.MonthlyActiveUsers)
+	assert.Equal(t, before.StorageBytes+1000, after.StorageBytes)
+	assert.Equal(t, before.Bots+1, after.Bots)
+
+	// Rolling up a month again replaces its usage.
+	usage, err := ss.LicenseUsage().GetForMonths(month, nextMonth)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.LicenseUsage{after}, usage)
+
+	_, err = ss.LicenseUsage().RollupMonth(nextMonth)
+	require.NoError(t, err)
+
+	usage, err = ss.LicenseUsage().GetForMonths(month, model.AddLicenseUsageMonths(month, 2))
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	assert.Equal(t, month, usage[0].Month)
+	assert.Equal(t, nextMonth, usage[1].Month)
+
+	usage, err = ss.LicenseUsage().GetForMonths(model.AddLicenseUsageMonths(month, -2), month)
+	require.NoError(t, err)
+	assert.Empty(t, usage)
+}
@@ -37,6 +37,50 @@ func (_m *AuditStore) Get(user_id string, offset int, limit int) (model.Audits,
 	return r0, r1
 }
 
+// GetByActions provides a mock function with given fields: actions, extraInfoPrefix, offset, limit
+func (_m *AuditStore) GetByActions(actions []string, extraInfoPrefix string, offset int, limit int) (model.Audits, error) {
+	ret := _m.Called(actions, extraInfoPrefix, offset, limit)
+
+	var r0 model.Audits
+	if rf, ok := ret.Get(0).(func([]string, string, int, int) model.Audits); ok {
+		r0 = rf(actions, extraInfoPrefix, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Audits)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, string, int, int) error); ok {
+		r1 = rf(actions, extraInfoPrefix, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCountByExtraInfoPrefix provides a mock function with given fields: prefix, since
+func (_m *AuditStore) GetCountByExtraInfoPrefix(prefix string, since int64) (int64, error) {
+	ret := _m.Called(prefix, since)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, int64) int64); ok {
+		r0 = rf(prefix, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(prefix, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteByUser provides a mock function with given fields: userID
 func (_m *AuditStore) PermanentDeleteByUser(userID string) error {
 	ret := _m.Called(userID)
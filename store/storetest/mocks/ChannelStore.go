@@ -812,6 +812,27 @@ func (_m *ChannelStore) GetChannelsWithCursor(teamId string, userId string, opts
 	return r0, r1
 }
 
+// GetChannelsWithDeactivatedCreator provides a mock function with given fields: teamID, offset, limit
+func (_m *ChannelStore) GetChannelsWithDeactivatedCreator(teamID string, offset int, limit int) (model.ChannelList, error) {
+	ret := _m.Called(teamID, offset, limit)
+
+	var r0 model.ChannelList
+	if rf, ok := ret.Get(0).(func(string, int, int) model.ChannelList); ok {
+		r0 = rf(teamID, offset, limit)
+	} else {
+		r0 = ret.Get(0).(model.ChannelList)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(teamID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChannelsWithTeamDataByIds provides a mock function with given fields: channelIds, includeDeleted
 func (_m *ChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
 	ret := _m.Called(channelIds, includeDeleted)
@@ -1052,13 +1073,13 @@ func (_m *ChannelStore) GetMemberForPost(postID string, userID string) (*model.C
 	return r0, r1
 }
 
-// GetMembers provides a mock function with given fields: channelID, offset, limit
-func (_m *ChannelStore) GetMembers(channelID string, offset int, limit int) (model.ChannelMembers, error) {
-	ret := _m.Called(channelID, offset, limit)
+// GetMembers provides a mock function with given fields: channelID, offset, limit, opts
+func (_m *ChannelStore) GetMembers(channelID string, offset int, limit int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	ret := _m.Called(channelID, offset, limit, opts)
 
 	var r0 model.ChannelMembers
-	if rf, ok := ret.Get(0).(func(string, int, int) model.ChannelMembers); ok {
-		r0 = rf(channelID, offset, limit)
+	if rf, ok := ret.Get(0).(func(string, int, int, *model.ChannelMembersGetOptions) model.ChannelMembers); ok {
+		r0 = rf(channelID, offset, limit, opts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.ChannelMembers)
@@ -1066,8 +1087,8 @@ func (_m *ChannelStore) GetMembers(channelID string, offset int, limit int) (mod
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
-		r1 = rf(channelID, offset, limit)
+	if rf, ok := ret.Get(1).(func(string, int, int, *model.ChannelMembersGetOptions) error); ok {
+		r1 = rf(channelID, offset, limit, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,6 +54,27 @@ func (_m *FileInfoStore) CountAll() (int64, error) {
 	return r0, r1
 }
 
+// CountByPath provides a mock function with given fields: path
+func (_m *FileInfoStore) CountByPath(path string) (int64, error) {
+	ret := _m.Called(path)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteForPost provides a mock function with given fields: postID
 func (_m *FileInfoStore) DeleteForPost(postID string) (string, error) {
 	ret := _m.Called(postID)
@@ -98,6 +119,52 @@ func (_m *FileInfoStore) Get(id string) (*model.FileInfo, error) {
 	return r0, r1
 }
 
+// GetAfter provides a mock function with given fields: afterCreateAt, afterID, limit
+func (_m *FileInfoStore) GetAfter(afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(afterCreateAt, afterID, limit)
+
+	var r0 []*model.FileInfo
+	if rf, ok := ret.Get(0).(func(int64, string, int) []*model.FileInfo); ok {
+		r0 = rf(afterCreateAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, string, int) error); ok {
+		r1 = rf(afterCreateAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByContentHash provides a mock function with given fields: hash, size, limit
+func (_m *FileInfoStore) GetByContentHash(hash string, size int64, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(hash, size, limit)
+
+	var r0 []*model.FileInfo
+	if rf, ok := ret.Get(0).(func(string, int64, int) []*model.FileInfo); ok {
+		r0 = rf(hash, size, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int) error); ok {
+		r1 = rf(hash, size, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByIds provides a mock function with given fields: ids
 func (_m *FileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 	ret := _m.Called(ids)
@@ -190,6 +257,52 @@ func (_m *FileInfoStore) GetForPost(postID string, readFromMaster bool, includeD
 	return r0, r1
 }
 
+// GetForPosts provides a mock function with given fields: postIds
+func (_m *FileInfoStore) GetForPosts(postIds []string) ([]*model.FileInfo, error) {
+	ret := _m.Called(postIds)
+
+	var r0 []*model.FileInfo
+	if rf, ok := ret.Get(0).(func([]string) []*model.FileInfo); ok {
+		r0 = rf(postIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(postIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForTeamAfter provides a mock function with given fields: teamID, afterCreateAt, afterID, limit
+func (_m *FileInfoStore) GetForTeamAfter(teamID string, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(teamID, afterCreateAt, afterID, limit)
+
+	var r0 []*model.FileInfo
+	if rf, ok := ret.Get(0).(func(string, int64, string, int) []*model.FileInfo); ok {
+		r0 = rf(teamID, afterCreateAt, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, string, int) error); ok {
+		r1 = rf(teamID, afterCreateAt, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetForUser provides a mock function with given fields: userID
 func (_m *FileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
 	ret := _m.Called(userID)
@@ -320,6 +433,20 @@ func (_m *FileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
 	return r0, r1
 }
 
+// RestoreForPosts provides a mock function with given fields: postIDs
+func (_m *FileInfoStore) RestoreForPosts(postIDs []string) error {
+	ret := _m.Called(postIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(postIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Save provides a mock function with given fields: info
 func (_m *FileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
 	ret := _m.Called(info)
@@ -380,6 +507,20 @@ func (_m *FileInfoStore) SetContent(fileID string, content string) error {
 	return r0
 }
 
+// SetContentHash provides a mock function with given fields: fileID, hash, path
+func (_m *FileInfoStore) SetContentHash(fileID string, hash string, path string) error {
+	ret := _m.Called(fileID, hash, path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(fileID, hash, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Upsert provides a mock function with given fields: info
 func (_m *FileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
 	ret := _m.Called(info)
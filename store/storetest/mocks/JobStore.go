@@ -231,6 +231,27 @@ func (_m *JobStore) GetCountByStatusAndType(status string, jobType string) (int6
 	return r0, r1
 }
 
+// GetCountByStatusSince provides a mock function with given fields: status, since
+func (_m *JobStore) GetCountByStatusSince(status string, since int64) (int64, error) {
+	ret := _m.Called(status, since)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, int64) int64); ok {
+		r0 = rf(status, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(status, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNewestJobByStatusAndType provides a mock function with given fields: status, jobType
 func (_m *JobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 	ret := _m.Called(status, jobType)
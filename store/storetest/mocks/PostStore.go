@@ -8,6 +8,7 @@ import (
 	context "context"
 
 	model "github.com/mattermost/mattermost-server/v6/model"
+	store "github.com/mattermost/mattermost-server/v6/store"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -88,6 +89,50 @@ func (_m *PostStore) ClearCaches() {
 	_m.Called()
 }
 
+// CountForRetentionPolicies provides a mock function with given fields: now, globalPolicyEndTime
+func (_m *PostStore) CountForRetentionPolicies(now int64, globalPolicyEndTime int64) (*model.RetentionPolicyDryRun, error) {
+	ret := _m.Called(now, globalPolicyEndTime)
+
+	var r0 *model.RetentionPolicyDryRun
+	if rf, ok := ret.Get(0).(func(int64, int64) *model.RetentionPolicyDryRun); ok {
+		r0 = rf(now, globalPolicyEndTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RetentionPolicyDryRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(now, globalPolicyEndTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountPostsSinceForSync provides a mock function with given fields: options, cursor
+func (_m *PostStore) CountPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) (int64, error) {
+	ret := _m.Called(options, cursor)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(model.GetPostsSinceForSyncOptions, model.GetPostsSinceForSyncCursor) int64); ok {
+		r0 = rf(options, cursor)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.GetPostsSinceForSyncOptions, model.GetPostsSinceForSyncCursor) error); ok {
+		r1 = rf(options, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: postID, time, deleteByID
 func (_m *PostStore) Delete(postID string, time int64, deleteByID string) error {
 	ret := _m.Called(postID, time, deleteByID)
@@ -146,6 +191,29 @@ func (_m *PostStore) Get(ctx context.Context, id string, skipFetchThreads bool,
 	return r0, r1
 }
 
+// GetDeletedForChannel provides a mock function with given fields: channelID, since, offset, limit
+func (_m *PostStore) GetDeletedForChannel(channelID string, since int64, offset int, limit int) ([]*model.Post, error) {
+	ret := _m.Called(channelID, since, offset, limit)
+
+	var r0 []*model.Post
+	if rf, ok := ret.Get(0).(func(string, int64, int, int) []*model.Post); ok {
+		r0 = rf(channelID, since, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int, int) error); ok {
+		r1 = rf(channelID, since, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDirectPostParentsForExportAfter provides a mock function with given fields: limit, afterID
 func (_m *PostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
 	ret := _m.Called(limit, afterID)
@@ -633,6 +701,22 @@ func (_m *PostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExpo
 	return r0, r1
 }
 
+// GetSearchCapabilities provides a mock function with given fields:
+func (_m *PostStore) GetSearchCapabilities() *model.SearchCapabilities {
+	ret := _m.Called()
+
+	var r0 *model.SearchCapabilities
+	if rf, ok := ret.Get(0).(func() *model.SearchCapabilities); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SearchCapabilities)
+		}
+	}
+
+	return r0
+}
+
 // GetSingle provides a mock function with given fields: id, inclDeleted
 func (_m *PostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
 	ret := _m.Called(id, inclDeleted)
@@ -756,6 +840,36 @@ func (_m *PostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, er
 	return r0, r1
 }
 
+// PermanentDeleteBatchForChannel provides a mock function with given fields: channelID, endTime, limit
+func (_m *PostStore) PermanentDeleteBatchForChannel(channelID string, endTime int64, limit int64) (int64, []*model.FileInfo, error) {
+	ret := _m.Called(channelID, endTime, limit)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, int64, int64) int64); ok {
+		r0 = rf(channelID, endTime, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 []*model.FileInfo
+	if rf, ok := ret.Get(1).(func(string, int64, int64) []*model.FileInfo); ok {
+		r1 = rf(channelID, endTime, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.FileInfo)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, int64, int64) error); ok {
+		r2 = rf(channelID, endTime, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // PermanentDeleteBatchForRetentionPolicies provides a mock function with given fields: now, globalPolicyEndTime, limit, cursor
 func (_m *PostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 	ret := _m.Called(now, globalPolicyEndTime, limit, cursor)
@@ -812,6 +926,29 @@ func (_m *PostStore) PermanentDeleteByUser(userID string) error {
 	return r0
 }
 
+// Restore provides a mock function with given fields: postID
+func (_m *PostStore) Restore(postID string) ([]string, error) {
+	ret := _m.Called(postID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: post
 func (_m *PostStore) Save(post *model.Post) (*model.Post, error) {
 	ret := _m.Called(post)
@@ -865,6 +1002,29 @@ func (_m *PostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, erro
 	return r0, r1, r2
 }
 
+// SaveWithExtras provides a mock function with given fields: post, extras
+func (_m *PostStore) SaveWithExtras(post *model.Post, extras store.PostExtras) (*model.Post, error) {
+	ret := _m.Called(post, extras)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(*model.Post, store.PostExtras) *model.Post); ok {
+		r0 = rf(post, extras)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.Post, store.PostExtras) error); ok {
+		r1 = rf(post, extras)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: teamID, userID, params
 func (_m *PostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
 	ret := _m.Called(teamID, userID, params)
@@ -35,6 +35,34 @@ func (_m *PreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
 	return r0, r1
 }
 
+// CompareAndSet provides a mock function with given fields: preferences
+func (_m *PreferenceStore) CompareAndSet(preferences model.Preferences) (model.Preferences, model.Preferences, error) {
+	ret := _m.Called(preferences)
+
+	var r0 model.Preferences
+	if rf, ok := ret.Get(0).(func(model.Preferences) model.Preferences); ok {
+		r0 = rf(preferences)
+	} else {
+		r0 = ret.Get(0).(model.Preferences)
+	}
+
+	var r1 model.Preferences
+	if rf, ok := ret.Get(1).(func(model.Preferences) model.Preferences); ok {
+		r1 = rf(preferences)
+	} else {
+		r1 = ret.Get(1).(model.Preferences)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(model.Preferences) error); ok {
+		r2 = rf(preferences)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Delete provides a mock function with given fields: userID, category, name
 func (_m *PreferenceStore) Delete(userID string, category string, name string) error {
 	ret := _m.Called(userID, category, name)
@@ -167,6 +195,29 @@ func (_m *PreferenceStore) GetCategory(userID string, category string) (model.Pr
 	return r0, r1
 }
 
+// GetForUsers provides a mock function with given fields: userIDs, category, name
+func (_m *PreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
+	ret := _m.Called(userIDs, category, name)
+
+	var r0 model.Preferences
+	if rf, ok := ret.Get(0).(func([]string, string, string) model.Preferences); ok {
+		r0 = rf(userIDs, category, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Preferences)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, string, string) error); ok {
+		r1 = rf(userIDs, category, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteByUser provides a mock function with given fields: userID
 func (_m *PreferenceStore) PermanentDeleteByUser(userID string) error {
 	ret := _m.Called(userID)
@@ -141,6 +141,52 @@ func (_m *RemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteClus
 	return r0, r1
 }
 
+// UpdateHiddenProfileFields provides a mock function with given fields: remoteClusterId, hiddenProfileFields
+func (_m *RemoteClusterStore) UpdateHiddenProfileFields(remoteClusterId string, hiddenProfileFields string) (*model.RemoteCluster, error) {
+	ret := _m.Called(remoteClusterId, hiddenProfileFields)
+
+	var r0 *model.RemoteCluster
+	if rf, ok := ret.Get(0).(func(string, string) *model.RemoteCluster); ok {
+		r0 = rf(remoteClusterId, hiddenProfileFields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RemoteCluster)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(remoteClusterId, hiddenProfileFields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateInvitePolicy provides a mock function with given fields: remoteClusterId, invitePolicy
+func (_m *RemoteClusterStore) UpdateInvitePolicy(remoteClusterId string, invitePolicy string) (*model.RemoteCluster, error) {
+	ret := _m.Called(remoteClusterId, invitePolicy)
+
+	var r0 *model.RemoteCluster
+	if rf, ok := ret.Get(0).(func(string, string) *model.RemoteCluster); ok {
+		r0 = rf(remoteClusterId, invitePolicy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RemoteCluster)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(remoteClusterId, invitePolicy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTopics provides a mock function with given fields: remoteClusterId, topics
 func (_m *RemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
 	ret := _m.Called(remoteClusterId, topics)
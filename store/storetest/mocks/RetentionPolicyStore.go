@@ -42,6 +42,29 @@ func (_m *RetentionPolicyStore) AddTeams(policyId string, teamIds []string) erro
 	return r0
 }
 
+// CountForPolicyPreview provides a mock function with given fields: policy, endTime
+func (_m *RetentionPolicyStore) CountForPolicyPreview(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, error) {
+	ret := _m.Called(policy, endTime)
+
+	var r0 []*model.RetentionPolicyPreviewCount
+	if rf, ok := ret.Get(0).(func(*model.RetentionPolicyWithTeamAndChannelIDs, int64) []*model.RetentionPolicyPreviewCount); ok {
+		r0 = rf(policy, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RetentionPolicyPreviewCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.RetentionPolicyWithTeamAndChannelIDs, int64) error); ok {
+		r1 = rf(policy, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *RetentionPolicyStore) Delete(id string) error {
 	ret := _m.Called(id)
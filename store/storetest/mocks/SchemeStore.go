@@ -14,6 +14,34 @@ type SchemeStore struct {
 	mock.Mock
 }
 
+// AssignChannels provides a mock function with given fields: schemeID, channelIDs
+func (_m *SchemeStore) AssignChannels(schemeID string, channelIDs []string) error {
+	ret := _m.Called(schemeID, channelIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(schemeID, channelIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignTeams provides a mock function with given fields: schemeID, teamIDs
+func (_m *SchemeStore) AssignTeams(schemeID string, teamIDs []string) error {
+	ret := _m.Called(schemeID, teamIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(schemeID, teamIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CountByScope provides a mock function with given fields: scope
 func (_m *SchemeStore) CountByScope(scope string) (int64, error) {
 	ret := _m.Called(scope)
@@ -146,6 +146,52 @@ func (_m *SharedChannelStore) GetAttachment(fileId string, remoteId string) (*mo
 	return r0, r1
 }
 
+// GetInvite provides a mock function with given fields: id
+func (_m *SharedChannelStore) GetInvite(id string) (*model.SharedChannelInvite, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.SharedChannelInvite
+	if rf, ok := ret.Get(0).(func(string) *model.SharedChannelInvite); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SharedChannelInvite)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvites provides a mock function with given fields: opts, offset, limit
+func (_m *SharedChannelStore) GetInvites(opts model.SharedChannelInviteFilterOpts, offset int, limit int) ([]*model.SharedChannelInvite, error) {
+	ret := _m.Called(opts, offset, limit)
+
+	var r0 []*model.SharedChannelInvite
+	if rf, ok := ret.Get(0).(func(model.SharedChannelInviteFilterOpts, int, int) []*model.SharedChannelInvite); ok {
+		r0 = rf(opts, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SharedChannelInvite)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.SharedChannelInviteFilterOpts, int, int) error); ok {
+		r1 = rf(opts, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRemote provides a mock function with given fields: id
 func (_m *SharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
 	ret := _m.Called(id)
@@ -418,6 +464,29 @@ func (_m *SharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachme
 	return r0, r1
 }
 
+// SaveInvite provides a mock function with given fields: invite
+func (_m *SharedChannelStore) SaveInvite(invite *model.SharedChannelInvite) (*model.SharedChannelInvite, error) {
+	ret := _m.Called(invite)
+
+	var r0 *model.SharedChannelInvite
+	if rf, ok := ret.Get(0).(func(*model.SharedChannelInvite) *model.SharedChannelInvite); ok {
+		r0 = rf(invite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SharedChannelInvite)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.SharedChannelInvite) error); ok {
+		r1 = rf(invite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveRemote provides a mock function with given fields: remote
 func (_m *SharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 	ret := _m.Called(remote)
@@ -501,6 +570,29 @@ func (_m *SharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int
 	return r0
 }
 
+// UpdateInviteStatus provides a mock function with given fields: id, status, decidedBy
+func (_m *SharedChannelStore) UpdateInviteStatus(id string, status string, decidedBy string) (*model.SharedChannelInvite, error) {
+	ret := _m.Called(id, status, decidedBy)
+
+	var r0 *model.SharedChannelInvite
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.SharedChannelInvite); ok {
+		r0 = rf(id, status, decidedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SharedChannelInvite)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(id, status, decidedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRemote provides a mock function with given fields: remote
 func (_m *SharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 	ret := _m.Called(remote)
@@ -20,6 +20,54 @@ type Store struct {
 	mock.Mock
 }
 
+// APIUsage provides a mock function with given fields:
+func (_m *Store) APIUsage() store.APIUsageStore {
+	ret := _m.Called()
+
+	var r0 store.APIUsageStore
+	if rf, ok := ret.Get(0).(func() store.APIUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.APIUsageStore)
+		}
+	}
+
+	return r0
+}
+
+// ActivityEvent provides a mock function with given fields:
+func (_m *Store) ActivityEvent() store.ActivityEventStore {
+	ret := _m.Called()
+
+	var r0 store.ActivityEventStore
+	if rf, ok := ret.Get(0).(func() store.ActivityEventStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ActivityEventStore)
+		}
+	}
+
+	return r0
+}
+
+// AlertRule provides a mock function with given fields:
+func (_m *Store) AlertRule() store.AlertRuleStore {
+	ret := _m.Called()
+
+	var r0 store.AlertRuleStore
+	if rf, ok := ret.Get(0).(func() store.AlertRuleStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.AlertRuleStore)
+		}
+	}
+
+	return r0
+}
+
 // Audit provides a mock function with given fields:
 func (_m *Store) Audit() store.AuditStore {
 	ret := _m.Called()
@@ -36,6 +84,22 @@ func (_m *Store) Audit() store.AuditStore {
 	return r0
 }
 
+// AuditLog provides a mock function with given fields:
+func (_m *Store) AuditLog() store.AuditLogStore {
+	ret := _m.Called()
+
+	var r0 store.AuditLogStore
+	if rf, ok := ret.Get(0).(func() store.AuditLogStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.AuditLogStore)
+		}
+	}
+
+	return r0
+}
+
 // Bot provides a mock function with given fields:
 func (_m *Store) Bot() store.BotStore {
 	ret := _m.Called()
@@ -52,6 +116,54 @@ func (_m *Store) Bot() store.BotStore {
 	return r0
 }
 
+// BotState provides a mock function with given fields:
+func (_m *Store) BotState() store.BotStateStore {
+	ret := _m.Called()
+
+	var r0 store.BotStateStore
+	if rf, ok := ret.Get(0).(func() store.BotStateStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.BotStateStore)
+		}
+	}
+
+	return r0
+}
+
+// BotTokenRotation provides a mock function with given fields:
+func (_m *Store) BotTokenRotation() store.BotTokenRotationStore {
+	ret := _m.Called()
+
+	var r0 store.BotTokenRotationStore
+	if rf, ok := ret.Get(0).(func() store.BotTokenRotationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.BotTokenRotationStore)
+		}
+	}
+
+	return r0
+}
+
+// CannedResponse provides a mock function with given fields:
+func (_m *Store) CannedResponse() store.CannedResponseStore {
+	ret := _m.Called()
+
+	var r0 store.CannedResponseStore
+	if rf, ok := ret.Get(0).(func() store.CannedResponseStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.CannedResponseStore)
+		}
+	}
+
+	return r0
+}
+
 // Channel provides a mock function with given fields:
 func (_m *Store) Channel() store.ChannelStore {
 	ret := _m.Called()
@@ -68,6 +180,102 @@ func (_m *Store) Channel() store.ChannelStore {
 	return r0
 }
 
+// ChannelArchivePolicy provides a mock function with given fields:
+func (_m *Store) ChannelArchivePolicy() store.ChannelArchivePolicyStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelArchivePolicyStore
+	if rf, ok := ret.Get(0).(func() store.ChannelArchivePolicyStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelArchivePolicyStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelCommandOverride provides a mock function with given fields:
+func (_m *Store) ChannelCommandOverride() store.ChannelCommandOverrideStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelCommandOverrideStore
+	if rf, ok := ret.Get(0).(func() store.ChannelCommandOverrideStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelCommandOverrideStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelDigest provides a mock function with given fields:
+func (_m *Store) ChannelDigest() store.ChannelDigestStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelDigestStore
+	if rf, ok := ret.Get(0).(func() store.ChannelDigestStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelDigestStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelEvent provides a mock function with given fields:
+func (_m *Store) ChannelEvent() store.ChannelEventStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelEventStore
+	if rf, ok := ret.Get(0).(func() store.ChannelEventStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelEventStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelFeed provides a mock function with given fields:
+func (_m *Store) ChannelFeed() store.ChannelFeedStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelFeedStore
+	if rf, ok := ret.Get(0).(func() store.ChannelFeedStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelFeedStore)
+		}
+	}
+
+	return r0
+}
+
+// ChannelLanguageStats provides a mock function with given fields:
+func (_m *Store) ChannelLanguageStats() store.ChannelLanguageStatsStore {
+	ret := _m.Called()
+
+	var r0 store.ChannelLanguageStatsStore
+	if rf, ok := ret.Get(0).(func() store.ChannelLanguageStatsStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ChannelLanguageStatsStore)
+		}
+	}
+
+	return r0
+}
+
 // ChannelMemberHistory provides a mock function with given fields:
 func (_m *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	ret := _m.Called()
@@ -121,6 +329,22 @@ func (_m *Store) ClusterDiscovery() store.ClusterDiscoveryStore {
 	return r0
 }
 
+// ConfigHistory provides a mock function with given fields:
+func (_m *Store) ConfigHistory() store.ConfigHistoryStore {
+	ret := _m.Called()
+
+	var r0 store.ConfigHistoryStore
+	if rf, ok := ret.Get(0).(func() store.ConfigHistoryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ConfigHistoryStore)
+		}
+	}
+
+	return r0
+}
+
 // Command provides a mock function with given fields:
 func (_m *Store) Command() store.CommandStore {
 	ret := _m.Called()
@@ -185,11 +409,59 @@ func (_m *Store) Context() context.Context {
 	return r0
 }
 
+// CustomStatusTemplate provides a mock function with given fields:
+func (_m *Store) CustomStatusTemplate() store.CustomStatusTemplateStore {
+	ret := _m.Called()
+
+	var r0 store.CustomStatusTemplateStore
+	if rf, ok := ret.Get(0).(func() store.CustomStatusTemplateStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.CustomStatusTemplateStore)
+		}
+	}
+
+	return r0
+}
+
+// DirectChannelRetention provides a mock function with given fields:
+func (_m *Store) DirectChannelRetention() store.DirectChannelRetentionStore {
+	ret := _m.Called()
+
+	var r0 store.DirectChannelRetentionStore
+	if rf, ok := ret.Get(0).(func() store.DirectChannelRetentionStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.DirectChannelRetentionStore)
+		}
+	}
+
+	return r0
+}
+
 // DropAllTables provides a mock function with given fields:
 func (_m *Store) DropAllTables() {
 	_m.Called()
 }
 
+// EmailSuppression provides a mock function with given fields:
+func (_m *Store) EmailSuppression() store.EmailSuppressionStore {
+	ret := _m.Called()
+
+	var r0 store.EmailSuppressionStore
+	if rf, ok := ret.Get(0).(func() store.EmailSuppressionStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.EmailSuppressionStore)
+		}
+	}
+
+	return r0
+}
+
 // Emoji provides a mock function with given fields:
 func (_m *Store) Emoji() store.EmojiStore {
 	ret := _m.Called()
@@ -206,6 +478,22 @@ func (_m *Store) Emoji() store.EmojiStore {
 	return r0
 }
 
+// EventWebhook provides a mock function with given fields:
+func (_m *Store) EventWebhook() store.EventWebhookStore {
+	ret := _m.Called()
+
+	var r0 store.EventWebhookStore
+	if rf, ok := ret.Get(0).(func() store.EventWebhookStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.EventWebhookStore)
+		}
+	}
+
+	return r0
+}
+
 // FileInfo provides a mock function with given fields:
 func (_m *Store) FileInfo() store.FileInfoStore {
 	ret := _m.Called()
@@ -273,6 +561,38 @@ func (_m *Store) Group() store.GroupStore {
 	return r0
 }
 
+// IdempotencyKey provides a mock function with given fields:
+func (_m *Store) IdempotencyKey() store.IdempotencyKeyStore {
+	ret := _m.Called()
+
+	var r0 store.IdempotencyKeyStore
+	if rf, ok := ret.Get(0).(func() store.IdempotencyKeyStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.IdempotencyKeyStore)
+		}
+	}
+
+	return r0
+}
+
+// Impersonation provides a mock function with given fields:
+func (_m *Store) Impersonation() store.ImpersonationStore {
+	ret := _m.Called()
+
+	var r0 store.ImpersonationStore
+	if rf, ok := ret.Get(0).(func() store.ImpersonationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ImpersonationStore)
+		}
+	}
+
+	return r0
+}
+
 // Job provides a mock function with given fields:
 func (_m *Store) Job() store.JobStore {
 	ret := _m.Called()
@@ -305,6 +625,22 @@ func (_m *Store) License() store.LicenseStore {
 	return r0
 }
 
+// LicenseUsage provides a mock function with given fields:
+func (_m *Store) LicenseUsage() store.LicenseUsageStore {
+	ret := _m.Called()
+
+	var r0 store.LicenseUsageStore
+	if rf, ok := ret.Get(0).(func() store.LicenseUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.LicenseUsageStore)
+		}
+	}
+
+	return r0
+}
+
 // LinkMetadata provides a mock function with given fields:
 func (_m *Store) LinkMetadata() store.LinkMetadataStore {
 	ret := _m.Called()
@@ -347,6 +683,70 @@ func (_m *Store) OAuth() store.OAuthStore {
 	return r0
 }
 
+// OAuthAppUsage provides a mock function with given fields:
+func (_m *Store) OAuthAppUsage() store.OAuthAppUsageStore {
+	ret := _m.Called()
+
+	var r0 store.OAuthAppUsageStore
+	if rf, ok := ret.Get(0).(func() store.OAuthAppUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.OAuthAppUsageStore)
+		}
+	}
+
+	return r0
+}
+
+// OutstandingMention provides a mock function with given fields:
+func (_m *Store) OutstandingMention() store.OutstandingMentionStore {
+	ret := _m.Called()
+
+	var r0 store.OutstandingMentionStore
+	if rf, ok := ret.Get(0).(func() store.OutstandingMentionStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.OutstandingMentionStore)
+		}
+	}
+
+	return r0
+}
+
+// PermissionDenial provides a mock function with given fields:
+func (_m *Store) PermissionDenial() store.PermissionDenialStore {
+	ret := _m.Called()
+
+	var r0 store.PermissionDenialStore
+	if rf, ok := ret.Get(0).(func() store.PermissionDenialStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PermissionDenialStore)
+		}
+	}
+
+	return r0
+}
+
+// PingReplicas provides a mock function with given fields:
+func (_m *Store) PingReplicas() []store.ReplicaPing {
+	ret := _m.Called()
+
+	var r0 []store.ReplicaPing
+	if rf, ok := ret.Get(0).(func() []store.ReplicaPing); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ReplicaPing)
+		}
+	}
+
+	return r0
+}
+
 // Plugin provides a mock function with given fields:
 func (_m *Store) Plugin() store.PluginStore {
 	ret := _m.Called()
@@ -379,6 +779,86 @@ func (_m *Store) Post() store.PostStore {
 	return r0
 }
 
+// PostAcknowledgement provides a mock function with given fields:
+func (_m *Store) PostAcknowledgement() store.PostAcknowledgementStore {
+	ret := _m.Called()
+
+	var r0 store.PostAcknowledgementStore
+	if rf, ok := ret.Get(0).(func() store.PostAcknowledgementStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostAcknowledgementStore)
+		}
+	}
+
+	return r0
+}
+
+// PostModeration provides a mock function with given fields:
+func (_m *Store) PostModeration() store.PostModerationStore {
+	ret := _m.Called()
+
+	var r0 store.PostModerationStore
+	if rf, ok := ret.Get(0).(func() store.PostModerationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostModerationStore)
+		}
+	}
+
+	return r0
+}
+
+// PostPriority provides a mock function with given fields:
+func (_m *Store) PostPriority() store.PostPriorityStore {
+	ret := _m.Called()
+
+	var r0 store.PostPriorityStore
+	if rf, ok := ret.Get(0).(func() store.PostPriorityStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostPriorityStore)
+		}
+	}
+
+	return r0
+}
+
+// PostReport provides a mock function with given fields:
+func (_m *Store) PostReport() store.PostReportStore {
+	ret := _m.Called()
+
+	var r0 store.PostReportStore
+	if rf, ok := ret.Get(0).(func() store.PostReportStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostReportStore)
+		}
+	}
+
+	return r0
+}
+
+// PostTask provides a mock function with given fields:
+func (_m *Store) PostTask() store.PostTaskStore {
+	ret := _m.Called()
+
+	var r0 store.PostTaskStore
+	if rf, ok := ret.Get(0).(func() store.PostTaskStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostTaskStore)
+		}
+	}
+
+	return r0
+}
+
 // Preference provides a mock function with given fields:
 func (_m *Store) Preference() store.PreferenceStore {
 	ret := _m.Called()
@@ -411,6 +891,22 @@ func (_m *Store) ProductNotices() store.ProductNoticesStore {
 	return r0
 }
 
+// RESTProxyBridge provides a mock function with given fields:
+func (_m *Store) RESTProxyBridge() store.RESTProxyBridgeStore {
+	ret := _m.Called()
+
+	var r0 store.RESTProxyBridgeStore
+	if rf, ok := ret.Get(0).(func() store.RESTProxyBridgeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.RESTProxyBridgeStore)
+		}
+	}
+
+	return r0
+}
+
 // Reaction provides a mock function with given fields:
 func (_m *Store) Reaction() store.ReactionStore {
 	ret := _m.Called()
@@ -508,6 +1004,22 @@ func (_m *Store) Role() store.RoleStore {
 	return r0
 }
 
+// ScheduledChannelMessage provides a mock function with given fields:
+func (_m *Store) ScheduledChannelMessage() store.ScheduledChannelMessageStore {
+	ret := _m.Called()
+
+	var r0 store.ScheduledChannelMessageStore
+	if rf, ok := ret.Get(0).(func() store.ScheduledChannelMessageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ScheduledChannelMessageStore)
+		}
+	}
+
+	return r0
+}
+
 // Scheme provides a mock function with given fields:
 func (_m *Store) Scheme() store.SchemeStore {
 	ret := _m.Called()
@@ -609,6 +1121,118 @@ func (_m *Store) Team() store.TeamStore {
 	return r0
 }
 
+// TeamAlias provides a mock function with given fields:
+func (_m *Store) TeamAlias() store.TeamAliasStore {
+	ret := _m.Called()
+
+	var r0 store.TeamAliasStore
+	if rf, ok := ret.Get(0).(func() store.TeamAliasStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamAliasStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamBanner provides a mock function with given fields:
+func (_m *Store) TeamBanner() store.TeamBannerStore {
+	ret := _m.Called()
+
+	var r0 store.TeamBannerStore
+	if rf, ok := ret.Get(0).(func() store.TeamBannerStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamBannerStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamDeletion provides a mock function with given fields:
+func (_m *Store) TeamDeletion() store.TeamDeletionStore {
+	ret := _m.Called()
+
+	var r0 store.TeamDeletionStore
+	if rf, ok := ret.Get(0).(func() store.TeamDeletionStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamDeletionStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamGroupRole provides a mock function with given fields:
+func (_m *Store) TeamGroupRole() store.TeamGroupRoleStore {
+	ret := _m.Called()
+
+	var r0 store.TeamGroupRoleStore
+	if rf, ok := ret.Get(0).(func() store.TeamGroupRoleStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamGroupRoleStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamInviteUsage provides a mock function with given fields:
+func (_m *Store) TeamInviteUsage() store.TeamInviteUsageStore {
+	ret := _m.Called()
+
+	var r0 store.TeamInviteUsageStore
+	if rf, ok := ret.Get(0).(func() store.TeamInviteUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamInviteUsageStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamRequest provides a mock function with given fields:
+func (_m *Store) TeamRequest() store.TeamRequestStore {
+	ret := _m.Called()
+
+	var r0 store.TeamRequestStore
+	if rf, ok := ret.Get(0).(func() store.TeamRequestStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamRequestStore)
+		}
+	}
+
+	return r0
+}
+
+// TeamStats provides a mock function with given fields:
+func (_m *Store) TeamStats() store.TeamStatsStore {
+	ret := _m.Called()
+
+	var r0 store.TeamStatsStore
+	if rf, ok := ret.Get(0).(func() store.TeamStatsStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TeamStatsStore)
+		}
+	}
+
+	return r0
+}
+
 // TermsOfService provides a mock function with given fields:
 func (_m *Store) TermsOfService() store.TermsOfServiceStore {
 	ret := _m.Called()
@@ -720,6 +1344,22 @@ func (_m *Store) UploadSession() store.UploadSessionStore {
 	return r0
 }
 
+// UploadUsage provides a mock function with given fields:
+func (_m *Store) UploadUsage() store.UploadUsageStore {
+	ret := _m.Called()
+
+	var r0 store.UploadUsageStore
+	if rf, ok := ret.Get(0).(func() store.UploadUsageStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UploadUsageStore)
+		}
+	}
+
+	return r0
+}
+
 // User provides a mock function with given fields:
 func (_m *Store) User() store.UserStore {
 	ret := _m.Called()
@@ -752,6 +1392,22 @@ func (_m *Store) UserAccessToken() store.UserAccessTokenStore {
 	return r0
 }
 
+// UserMerge provides a mock function with given fields:
+func (_m *Store) UserMerge() store.UserMergeStore {
+	ret := _m.Called()
+
+	var r0 store.UserMergeStore
+	if rf, ok := ret.Get(0).(func() store.UserMergeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UserMergeStore)
+		}
+	}
+
+	return r0
+}
+
 // UserTermsOfService provides a mock function with given fields:
 func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	ret := _m.Called()
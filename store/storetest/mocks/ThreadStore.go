@@ -142,6 +142,29 @@ func (_m *ThreadStore) GetPosts(threadID string, since int64) ([]*model.Post, er
 	return r0, r1
 }
 
+// GetSummary provides a mock function with given fields: threadID, bucketSize, replies
+func (_m *ThreadStore) GetSummary(threadID string, bucketSize int64, replies int) (*model.ThreadSummary, error) {
+	ret := _m.Called(threadID, bucketSize, replies)
+
+	var r0 *model.ThreadSummary
+	if rf, ok := ret.Get(0).(func(string, int64, int) *model.ThreadSummary); ok {
+		r0 = rf(threadID, bucketSize, replies)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ThreadSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int) error); ok {
+		r1 = rf(threadID, bucketSize, replies)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTeamsUnreadForUser provides a mock function with given fields: userID, teamIDs
 func (_m *ThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []string) (map[string]*model.TeamUnread, error) {
 	ret := _m.Called(userID, teamIDs)
@@ -134,6 +134,29 @@ func (_m *UserAccessTokenStore) GetByUser(userID string, page int, perPage int)
 	return r0, r1
 }
 
+// GetExpired provides a mock function with given fields: before, limit
+func (_m *UserAccessTokenStore) GetExpired(before int64, limit int) ([]*model.UserAccessToken, error) {
+	ret := _m.Called(before, limit)
+
+	var r0 []*model.UserAccessToken
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.UserAccessToken); ok {
+		r0 = rf(before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserAccessToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: token
 func (_m *UserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 	ret := _m.Called(token)
@@ -180,6 +203,20 @@ func (_m *UserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, e
 	return r0, r1
 }
 
+// UpdateExpiresAt provides a mock function with given fields: tokenID, expiresAt
+func (_m *UserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
+	ret := _m.Called(tokenID, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tokenID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTokenDisable provides a mock function with given fields: tokenID
 func (_m *UserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 	ret := _m.Called(tokenID)
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestOAuthAppUsageStore(t *testing.T, ss store.Store) {
+	t.Run("IncrementAndGetSummaries", func(t *testing.T) { testOAuthAppUsageStoreIncrementAndGetSummaries(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testOAuthAppUsageStorePermanentDeleteBatch(t, ss) })
+}
+
+func testOAuthAppUsageStoreIncrementAndGetSummaries(t *testing.T, ss store.Store) {
+	app, err := ss.OAuth().SaveApp(&model.OAuthApp{
+		CreatorId:       model.NewId(),
+		Name:            "TestApp" + model.NewId(),
+		CallbackUrls:    []string{"https://nowhere.com"},
+		Homepage:        "https://nowhere.com",
+		RateLimitPerSec: 10,
+	})
+	require.NoError(t, err)
+	deletedAppID := model.NewId()
+
+	bucket := model.APIUsageBucket(model.GetMillis())
+	previousBucket := bucket - int64(model.APIUsageBucketSize/time.Millisecond)
+
+	require.NoError(t, ss.OAuthAppUsage().Increment([]*model.OAuthAppUsage{
+		{AppId: app.Id, BucketAt: previousBucket, Count: 5},
+		{AppId: app.Id, BucketAt: bucket, Count: 3, LimitedCount: 1},
+		{AppId: deletedAppID, BucketAt: bucket, Count: 1},
+	}))
+	require.NoError(t, ss.OAuthAppUsage().Increment([]*model.OAuthAppUsage{
+		{AppId: app.Id, BucketAt: bucket, Count: 2, LimitedCount: 2},
+	}))
+
+	require.Error(t, ss.OAuthAppUsage().Increment([]*model.OAuthAppUsage{{AppId: app.Id, BucketAt: bucket + 1, Count: 1}}))
+
+	summaries, err := ss.OAuthAppUsage().GetSummaries(previousBucket, 0, 100)
+	require.NoError(t, err)
+	byApp := make(map[string]*model.OAuthAppUsageSummary)
+	for _, summary := range summaries {
+		byApp[summary.AppId] = summary
+	}
+	require.Contains(t, byApp, app.Id)
+	assert.Equal(t, int64(10), byApp[app.Id].Count)
+	assert.Equal(t, int64(3), byApp[app.Id].LimitedCount)
+	assert.Equal(t, app.Name, byApp[app.Id].Name)
+	assert.Equal(t, 10, byApp[app.Id].RateLimitPerSec)
+	require.Contains(t, byApp, deletedAppID)
+	assert.Empty(t, byApp[deletedAppID].Name)
+
+	summaries, err = ss.OAuthAppUsage().GetSummaries(bucket, 0, 100)
+	require.NoError(t, err)
+	for _, summary := range summaries {
+		if summary.AppId == app.Id {
+			assert.Equal(t, int64(5), summary.Count, "only the calls since the given time are counted")
+		}
+	}
+}
+
+func testOAuthAppUsageStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	appID := model.NewId()
+	oldBucket := model.APIUsageBucket(model.GetMillis() - int64(48*time.Hour/time.Millisecond))
+	bucket := model.APIUsageBucket(model.GetMillis())
+
+	require.NoError(t, ss.OAuthAppUsage().Increment([]*model.OAuthAppUsage{
+		{AppId: appID, BucketAt: oldBucket, Count: 1},
+		{AppId: appID, BucketAt: bucket, Count: 1},
+	}))
+
+	deleted, err := ss.OAuthAppUsage().PermanentDeleteBatch(oldBucket+1, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+
+	summaries, err := ss.OAuthAppUsage().GetSummaries(0, 0, 1000)
+	require.NoError(t, err)
+	for _, summary := range summaries {
+		if summary.AppId == appID {
+			assert.Equal(t, int64(1), summary.Count)
+		}
+	}
+}
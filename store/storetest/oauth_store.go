@@ -116,6 +116,15 @@ func testOAuthStoreUpdateApp(t *testing.T, ss store.Store) {
 	require.Equal(t, ua.Name, "NewName", "name did not update")
 	require.NotEqual(t, ua.CreateAt, 1, "create at should not have updated")
 	require.NotEqual(t, ua.CreatorId, "12345678901234567890123456", "creator id should not have updated")
+
+	a1.RateLimitPerSec = 5
+	a1.RateLimitMaxBurst = 20
+	_, err = ss.OAuth().UpdateApp(&a1)
+	require.NoError(t, err)
+	app, err := ss.OAuth().GetApp(id)
+	require.NoError(t, err)
+	require.Equal(t, 5, app.RateLimitPerSec)
+	require.Equal(t, 20, app.RateLimitMaxBurst)
 }
 
 func testOAuthStoreSaveAccessData(t *testing.T, ss store.Store) {
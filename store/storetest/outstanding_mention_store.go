@@ -0,0 +1,162 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestOutstandingMentionStore(t *testing.T, ss store.Store) {
+	t.Run("Resolve", func(t *testing.T) { testOutstandingMentionStoreResolve(t, ss) })
+	t.Run("GetOutstandingCounts", func(t *testing.T) { testOutstandingMentionStoreGetOutstandingCounts(t, ss) })
+	t.Run("PermanentDelete", func(t *testing.T) { testOutstandingMentionStorePermanentDelete(t, ss) })
+}
+
+func saveTestOutstandingMention(t *testing.T, ss store.Store, userID, teamID, channelID, rootID string, mentionedAt int64) *model.OutstandingMention {
+	post, err := ss.Post().Save(&model.Post{
+		UserId:    model.NewId(),
+		ChannelId: channelID,
+		RootId:    rootID,
+		Message:   "@someone " + model.NewId(),
+		CreateAt:  mentionedAt,
+	})
+	require.NoError(t, err)
+
+	mention := &model.OutstandingMention{
+		UserId:      userID,
+		PostId:      post.Id,
+		ChannelId:   channelID,
+		TeamId:      teamID,
+		RootId:      rootID,
+		MentionedAt: mentionedAt,
+	}
+	require.NoError(t, ss.OutstandingMention().Save([]*model.OutstandingMention{mention}))
+	return mention
+}
+
+func outstandingMentionPostIds(mentions []*model.OutstandingMention) []string {
+	ids := make([]string, 0, len(mentions))
+	for _, mention := range mentions {
+		ids = append(ids, mention.PostId)
+	}
+	return ids
+}
+
+func testOutstandingMentionStoreResolve(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	teamID := model.NewId()
+	channelID := model.NewId()
+	now := model.GetMillis()
+
+	root := saveTestOutstandingMention(t, ss, userID, teamID, channelID, "", now-4000)
+	reply := saveTestOutstandingMention(t, ss, userID, teamID, channelID, root.PostId, now-3000)
+	other := saveTestOutstandingMention(t, ss, userID, teamID, channelID, "", now-2000)
+	otherTeam := saveTestOutstandingMention(t, ss, userID, model.NewId(), model.NewId(), "", now-1000)
+
+	mentions, err := ss.OutstandingMention().GetOutstanding(userID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{otherTeam.PostId, other.PostId, reply.PostId, root.PostId}, outstandingMentionPostIds(mentions))
+
+	mentions, err = ss.OutstandingMention().GetOutstanding(userID, teamID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{reply.PostId, root.PostId}, outstandingMentionPostIds(mentions))
+
+	// Answering in the thread resolves the mentions of the thread only.
+	require.NoError(t, ss.OutstandingMention().ResolveForThread(userID, channelID, root.PostId, now-500))
+	mentions, err = ss.OutstandingMention().GetOutstanding(userID, teamID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{other.PostId}, outstandingMentionPostIds(mentions))
+
+	// Another user answering doesn't resolve the mentions.
+	require.NoError(t, ss.OutstandingMention().ResolveForPost(model.NewId(), other.PostId, now))
+	require.NoError(t, ss.OutstandingMention().ResolveForThread(model.NewId(), channelID, "", now))
+
+	stats, err := ss.OutstandingMention().GetStats(userID, teamID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.OutstandingCount)
+	assert.Equal(t, other.MentionedAt, stats.OldestMentionedAt)
+	assert.Equal(t, int64(2), stats.ResolvedCount)
+	assert.Equal(t, int64(3000), stats.AverageResponseMillis)
+
+	require.NoError(t, ss.OutstandingMention().ResolveForPost(userID, other.PostId, now))
+	stats, err = ss.OutstandingMention().GetStats(userID, teamID)
+	require.NoError(t, err)
+	assert.Zero(t, stats.OutstandingCount)
+	assert.Zero(t, stats.OldestMentionedAt)
+	assert.Equal(t, int64(3), stats.ResolvedCount)
+
+	// The mentions of deleted posts aren't counted.
+	require.NoError(t, ss.Post().Delete(otherTeam.PostId, now, userID))
+	mentions, err = ss.OutstandingMention().GetOutstanding(userID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, mentions)
+}
+
+func testOutstandingMentionStoreGetOutstandingCounts(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	teamID := model.NewId()
+	channelID := model.NewId()
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	saveTestOutstandingMention(t, ss, userID, teamID, channelID, "", now-2*time.Hour.Milliseconds())
+	saveTestOutstandingMention(t, ss, userID, teamID, channelID, "", now-3*time.Hour.Milliseconds())
+	saveTestOutstandingMention(t, ss, userID, teamID, channelID, "", now)
+	saveTestOutstandingMention(t, ss, otherUserID, teamID, channelID, "", now-2*time.Hour.Milliseconds())
+
+	counts, err := ss.OutstandingMention().GetOutstandingCounts(now-time.Hour.Milliseconds(), "", 10000)
+	require.NoError(t, err)
+
+	byUser := map[string]int64{}
+	for i, count := range counts {
+		if i > 0 {
+			require.Less(t, counts[i-1].UserId, count.UserId)
+		}
+		byUser[count.UserId] = count.Count
+	}
+	assert.Equal(t, int64(2), byUser[userID])
+	assert.Equal(t, int64(1), byUser[otherUserID])
+
+	first := userID
+	if otherUserID < first {
+		first = otherUserID
+	}
+	counts, err = ss.OutstandingMention().GetOutstandingCounts(now-time.Hour.Milliseconds(), first, 10000)
+	require.NoError(t, err)
+	for _, count := range counts {
+		assert.NotEqual(t, first, count.UserId)
+	}
+}
+
+func testOutstandingMentionStorePermanentDelete(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	userID := model.NewId()
+	old := saveTestOutstandingMention(t, ss, userID, model.NewId(), model.NewId(), "", now-2*time.Hour.Milliseconds())
+	recent := saveTestOutstandingMention(t, ss, userID, model.NewId(), model.NewId(), "", now)
+
+	for {
+		deleted, err := ss.OutstandingMention().PermanentDeleteBatch(now-time.Hour.Milliseconds(), 1000)
+		require.NoError(t, err)
+		if deleted < 1000 {
+			break
+		}
+	}
+
+	mentions, err := ss.OutstandingMention().GetOutstanding(userID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{recent.PostId}, outstandingMentionPostIds(mentions))
+	assert.NotContains(t, outstandingMentionPostIds(mentions), old.PostId)
+
+	require.NoError(t, ss.OutstandingMention().PermanentDeleteByUser(userID))
+	mentions, err = ss.OutstandingMention().GetOutstanding(userID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, mentions)
+}
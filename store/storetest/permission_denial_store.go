@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPermissionDenialStore(t *testing.T, ss store.Store) {
+	t.Run("SaveSearch", func(t *testing.T) { testPermissionDenialStoreSaveSearch(t, ss) })
+	t.Run("PermanentDeleteBatch", func(t *testing.T) { testPermissionDenialStorePermanentDeleteBatch(t, ss) })
+}
+
+func testPermissionDenialStoreSaveSearch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	teamID := model.NewId()
+	channelID := model.NewId()
+
+	_, err := ss.PermissionDenial().Save(&model.PermissionDenial{
+		UserId:       userID,
+		Permissions:  model.PermissionManageTeam.Id,
+		ResourceType: "junk",
+	})
+	var appErr *model.AppError
+	require.ErrorAs(t, err, &appErr)
+
+	system, err := ss.PermissionDenial().Save(&model.PermissionDenial{
+		UserId:       userID,
+		Permissions:  model.PermissionManageSystem.Id,
+		ResourceType: model.PermissionDenialResourceSystem,
+		Path:         "/api/v4/config",
+		CreateAt:     1000,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, system.Id)
+
+	team, err := ss.PermissionDenial().Save(&model.PermissionDenial{
+		UserId:       userID,
+		Permissions:  model.PermissionManageTeam.Id,
+		ResourceType: model.PermissionDenialResourceTeam,
+		ResourceId:   teamID,
+		CreateAt:     2000,
+	})
+	require.NoError(t, err)
+
+	channel, err := ss.PermissionDenial().Save(&model.PermissionDenial{
+		UserId:       model.NewId(),
+		Permissions:  model.PermissionReadChannel.Id,
+		ResourceType: model.PermissionDenialResourceChannel,
+		ResourceId:   channelID,
+		CreateAt:     3000,
+	})
+	require.NoError(t, err)
+
+	denials, err := ss.PermissionDenial().Search(&model.PermissionDenialSearch{UserId: userID}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PermissionDenial{team, system}, denials, "the most recent denials come first")
+
+	denials, err = ss.PermissionDenial().Search(&model.PermissionDenialSearch{UserId: userID}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PermissionDenial{system}, denials)
+
+	denials, err = ss.PermissionDenial().Search(&model.PermissionDenialSearch{UserId: userID, Since: 1500}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PermissionDenial{team}, denials)
+
+	denials, err = ss.PermissionDenial().Search(&model.PermissionDenialSearch{ResourceId: channelID}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PermissionDenial{channel}, denials)
+}
+
+func testPermissionDenialStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	for _, createAt := range []int64{1000, 2000, 3000} {
+		_, err := ss.PermissionDenial().Save(&model.PermissionDenial{
+			UserId:       userID,
+			Permissions:  model.PermissionManageSystem.Id,
+			ResourceType: model.PermissionDenialResourceSystem,
+			CreateAt:     createAt,
+		})
+		require.NoError(t, err)
+	}
+
+	deleted, err := ss.PermissionDenial().PermanentDeleteBatch(2500, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(2))
+
+	denials, err := ss.PermissionDenial().Search(&model.PermissionDenialSearch{UserId: userID}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, denials, 1)
+	assert.Equal(t, int64(3000), denials[0].CreateAt)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPostAcknowledgementStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetDelete", func(t *testing.T) { testPostAcknowledgementStoreSaveGetDelete(t, ss) })
+	t.Run("GetSummariesForUser", func(t *testing.T) { testPostAcknowledgementStoreGetSummariesForUser(t, ss) })
+	t.Run("GetForPosts", func(t *testing.T) { testPostAcknowledgementStoreGetForPosts(t, ss) })
+}
+
+func testPostAcknowledgementStoreSaveGetDelete(t *testing.T, ss store.Store) {
+	postID := model.NewId()
+	userID := model.NewId()
+
+	_, err := ss.PostAcknowledgement().Save(&model.PostAcknowledgement{PostId: postID, UserId: userID, AcknowledgedAt: 1})
+	require.NoError(t, err)
+
+	t.Run("save again updates the time", func(t *testing.T) {
+		_, err := ss.PostAcknowledgement().Save(&model.PostAcknowledgement{PostId: postID, UserId: userID, AcknowledgedAt: 2})
+		require.NoError(t, err)
+
+		acknowledgements, err := ss.PostAcknowledgement().GetForPost(postID)
+		require.NoError(t, err)
+		require.Len(t, acknowledgements, 1)
+		assert.Equal(t, int64(2), acknowledgements[0].AcknowledgedAt)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.PostAcknowledgement().Save(&model.PostAcknowledgement{PostId: postID, UserId: userID})
+		require.Error(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.PostAcknowledgement().Delete(userID, postID))
+
+		acknowledgements, err := ss.PostAcknowledgement().GetForPost(postID)
+		require.NoError(t, err)
+		assert.Empty(t, acknowledgements)
+
+		err = ss.PostAcknowledgement().Delete(userID, postID)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostAcknowledgementStoreGetForPosts(t *testing.T, ss store.Store) {
+	postID1 := model.NewId()
+	postID2 := model.NewId()
+
+	for i, postID := range []string{postID1, postID2, postID2, model.NewId()} {
+		_, err := ss.PostAcknowledgement().Save(&model.PostAcknowledgement{PostId: postID, UserId: model.NewId(), AcknowledgedAt: int64(i + 1)})
+		require.NoError(t, err)
+	}
+
+	acknowledgements, err := ss.PostAcknowledgement().GetForPosts([]string{postID1, postID2})
+	require.NoError(t, err)
+	require.Len(t, acknowledgements, 3)
+	assert.Equal(t, postID1, acknowledgements[0].PostId)
+	assert.Equal(t, postID2, acknowledgements[1].PostId)
+	assert.Equal(t, postID2, acknowledgements[2].PostId)
+
+	acknowledgements, err = ss.PostAcknowledgement().GetForPosts([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, acknowledgements)
+}
+
+func testPostAcknowledgementStoreGetSummariesForUser(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "DisplayName",
+		Name:        "team" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "DisplayName",
+		Name:        "channel" + model.NewId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	var users []*model.User
+	for i := 0; i < 3; i++ {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: "u" + model.NewId()})
+		require.NoError(t, err)
+		_, err = ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:   channel.Id,
+			UserId:      user.Id,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+	sender := users[0]
+
+	savePost := func(requestedAck bool) *model.Post {
+		post, err := ss.Post().Save(&model.Post{ChannelId: channel.Id, UserId: sender.Id, Message: "message"})
+		require.NoError(t, err)
+		_, err = ss.PostPriority().Save(&model.PostPriority{
+			PostId:       post.Id,
+			ChannelId:    channel.Id,
+			Priority:     model.PostPriorityImportant,
+			RequestedAck: requestedAck,
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	savePost(false)
+	post := savePost(true)
+
+	_, err = ss.PostAcknowledgement().Save(&model.PostAcknowledgement{PostId: post.Id, UserId: users[1].Id, AcknowledgedAt: model.GetMillis()})
+	require.NoError(t, err)
+
+	summaries, err := ss.PostAcknowledgement().GetSummariesForUser(sender.Id, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, post.Id, summaries[0].PostId)
+	assert.Equal(t, channel.Id, summaries[0].ChannelId)
+	assert.Equal(t, int64(1), summaries[0].Acknowledged)
+	assert.Equal(t, int64(1), summaries[0].Outstanding)
+
+	summaries, err = ss.PostAcknowledgement().GetSummariesForUser(users[1].Id, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPostModerationStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testPostModerationStoreSaveGet(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testPostModerationStoreGetAll(t, ss) })
+	t.Run("Review", func(t *testing.T) { testPostModerationStoreReview(t, ss) })
+}
+
+func newTestPostModeration(teamID string) *model.PostModeration {
+	return &model.PostModeration{
+		TeamId: teamID,
+		Post: &model.Post{
+			ChannelId: model.NewId(),
+			UserId:    model.NewId(),
+			Message:   "held message",
+		},
+		Action: model.PostModerationActionHold,
+		Reason: "Keywords",
+	}
+}
+
+func testPostModerationStoreSaveGet(t *testing.T, ss store.Store) {
+	moderation, err := ss.PostModeration().Save(newTestPostModeration(model.NewId()))
+	require.NoError(t, err)
+	require.NotEmpty(t, moderation.Id)
+	assert.Equal(t, model.PostModerationStatusPending, moderation.Status)
+	assert.Equal(t, moderation.Post.ChannelId, moderation.ChannelId)
+	assert.False(t, moderation.IsHeldEdit())
+
+	got, err := ss.PostModeration().Get(moderation.Id)
+	require.NoError(t, err)
+	assert.Equal(t, moderation.Id, got.Id)
+	assert.Equal(t, moderation.UserId, got.UserId)
+	assert.Equal(t, "held message", got.Post.Message)
+
+	t.Run("flag without post id", func(t *testing.T) {
+		invalid := newTestPostModeration("")
+		invalid.Action = model.PostModerationActionFlag
+		_, err := ss.PostModeration().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		_, err := ss.PostModeration().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostModerationStoreGetAll(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	first, err := ss.PostModeration().Save(newTestPostModeration(teamID))
+	require.NoError(t, err)
+	second, err := ss.PostModeration().Save(newTestPostModeration(teamID))
+	require.NoError(t, err)
+	_, err = ss.PostModeration().Save(newTestPostModeration(model.NewId()))
+	require.NoError(t, err)
+
+	second.Status = model.PostModerationStatusRemoved
+	second.ReviewerId = model.NewId()
+	_, err = ss.PostModeration().Review(second)
+	require.NoError(t, err)
+
+	moderations, err := ss.PostModeration().GetAll(teamID, "", 0, 100)
+	require.NoError(t, err)
+	require.Len(t, moderations, 2)
+	assert.Equal(t, first.Id, moderations[0].Id)
+	assert.Equal(t, second.Id, moderations[1].Id)
+
+	moderations, err = ss.PostModeration().GetAll(teamID, model.PostModerationStatusPending, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, moderations, 1)
+	assert.Equal(t, first.Id, moderations[0].Id)
+
+	moderations, err = ss.PostModeration().GetAll(teamID, "", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, moderations, 1)
+	assert.Equal(t, second.Id, moderations[0].Id)
+}
+
+func testPostModerationStoreReview(t *testing.T, ss store.Store) {
+	moderation, err := ss.PostModeration().Save(newTestPostModeration(model.NewId()))
+	require.NoError(t, err)
+
+	moderation.Status = model.PostModerationStatusApproved
+	moderation.ReviewerId = model.NewId()
+	reviewed, err := ss.PostModeration().Review(moderation)
+	require.NoError(t, err)
+	assert.Equal(t, model.PostModerationStatusApproved, reviewed.Status)
+
+	got, err := ss.PostModeration().Get(moderation.Id)
+	require.NoError(t, err)
+	assert.Equal(t, model.PostModerationStatusApproved, got.Status)
+	assert.Equal(t, moderation.ReviewerId, got.ReviewerId)
+
+	t.Run("already reviewed", func(t *testing.T) {
+		moderation.Status = model.PostModerationStatusRemoved
+		_, err := ss.PostModeration().Review(moderation)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		unknown := newTestPostModeration("")
+		unknown.PreSave()
+		_, err := ss.PostModeration().Review(unknown)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
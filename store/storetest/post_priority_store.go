@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPostPriorityStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testPostPriorityStoreSaveGet(t, ss) })
+	t.Run("GetForPosts", func(t *testing.T) { testPostPriorityStoreGetForPosts(t, ss) })
+}
+
+func testPostPriorityStoreSaveGet(t *testing.T, ss store.Store) {
+	priority := &model.PostPriority{
+		PostId:       model.NewId(),
+		ChannelId:    model.NewId(),
+		Priority:     model.PostPriorityUrgent,
+		RequestedAck: true,
+	}
+
+	_, err := ss.PostPriority().Save(priority)
+	require.NoError(t, err)
+
+	got, err := ss.PostPriority().GetForPost(priority.PostId)
+	require.NoError(t, err)
+	assert.Equal(t, priority, got)
+
+	t.Run("invalid priority", func(t *testing.T) {
+		_, err := ss.PostPriority().Save(&model.PostPriority{
+			PostId:    model.NewId(),
+			ChannelId: model.NewId(),
+			Priority:  "critical",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ss.PostPriority().GetForPost(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostPriorityStoreGetForPosts(t *testing.T, ss store.Store) {
+	priority1 := &model.PostPriority{PostId: model.NewId(), ChannelId: model.NewId(), Priority: model.PostPriorityUrgent}
+	priority2 := &model.PostPriority{PostId: model.NewId(), ChannelId: model.NewId(), Priority: model.PostPriorityUrgent, RequestedAck: true}
+	for _, priority := range []*model.PostPriority{priority1, priority2} {
+		_, err := ss.PostPriority().Save(priority)
+		require.NoError(t, err)
+	}
+
+	priorities, err := ss.PostPriority().GetForPosts([]string{priority1.PostId, priority2.PostId, model.NewId()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*model.PostPriority{priority1, priority2}, priorities)
+
+	priorities, err = ss.PostPriority().GetForPosts([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, priorities)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPostReportStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testPostReportStoreSaveGet(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testPostReportStoreGetAll(t, ss) })
+	t.Run("Resolve", func(t *testing.T) { testPostReportStoreResolve(t, ss) })
+}
+
+func newTestPostReport(teamID string) *model.PostReport {
+	return &model.PostReport{
+		PostId:     model.NewId(),
+		ChannelId:  model.NewId(),
+		TeamId:     teamID,
+		PostUserId: model.NewId(),
+		ReporterId: model.NewId(),
+		Reason:     model.PostReportReasonSpam,
+	}
+}
+
+func testPostReportStoreSaveGet(t *testing.T, ss store.Store) {
+	report := newTestPostReport(model.NewId())
+	report.Anonymous = true
+	report, err := ss.PostReport().Save(report)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Id)
+	assert.True(t, report.IsOpen())
+
+	got, err := ss.PostReport().Get(report.Id)
+	require.NoError(t, err)
+	assert.Equal(t, report, got)
+
+	t.Run("reporting a post twice", func(t *testing.T) {
+		duplicate := newTestPostReport(report.TeamId)
+		duplicate.PostId = report.PostId
+		duplicate.ReporterId = report.ReporterId
+		_, err := ss.PostReport().Save(duplicate)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("invalid report", func(t *testing.T) {
+		invalid := newTestPostReport("")
+		invalid.Reason = model.PostReportReasonOther
+		_, err := ss.PostReport().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		_, err := ss.PostReport().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostReportStoreGetAll(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	first, err := ss.PostReport().Save(newTestPostReport(teamID))
+	require.NoError(t, err)
+	second, err := ss.PostReport().Save(newTestPostReport(teamID))
+	require.NoError(t, err)
+	_, err = ss.PostReport().Save(newTestPostReport(model.NewId()))
+	require.NoError(t, err)
+
+	second.Status = model.PostReportStatusResolved
+	second.Resolution = model.PostReportResolutionDismiss
+	second.ResolverId = model.NewId()
+	_, err = ss.PostReport().Resolve(second)
+	require.NoError(t, err)
+
+	reports, err := ss.PostReport().GetAll(teamID, "", 0, 100)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, first.Id, reports[0].Id)
+	assert.Equal(t, second.Id, reports[1].Id)
+
+	reports, err = ss.PostReport().GetAll(teamID, model.PostReportStatusOpen, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, first.Id, reports[0].Id)
+
+	reports, err = ss.PostReport().GetAll(teamID, "", 1, 1)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, second.Id, reports[0].Id)
+}
+
+func testPostReportStoreResolve(t *testing.T, ss store.Store) {
+	report, err := ss.PostReport().Save(newTestPostReport(model.NewId()))
+	require.NoError(t, err)
+
+	report.Status = model.PostReportStatusResolved
+	report.Resolution = model.PostReportResolutionWarnUser
+	report.ResolverId = model.NewId()
+	report.Note = "Please keep it civil."
+	_, err = ss.PostReport().Resolve(report)
+	require.NoError(t, err)
+
+	got, err := ss.PostReport().Get(report.Id)
+	require.NoError(t, err)
+	assert.Equal(t, model.PostReportStatusResolved, got.Status)
+	assert.Equal(t, model.PostReportResolutionWarnUser, got.Resolution)
+	assert.Equal(t, report.ResolverId, got.ResolverId)
+	assert.Equal(t, "Please keep it civil.", got.Note)
+
+	t.Run("already resolved", func(t *testing.T) {
+		report.Resolution = model.PostReportResolutionDeletePost
+		_, err := ss.PostReport().Resolve(report)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		unknown := newTestPostReport("")
+		unknown.PreSave()
+		unknown.Status = model.PostReportStatusResolved
+		unknown.Resolution = model.PostReportResolutionDismiss
+		unknown.ResolverId = model.NewId()
+		_, err := ss.PostReport().Resolve(unknown)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
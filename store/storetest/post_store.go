@@ -5,6 +5,7 @@ package storetest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -23,12 +24,15 @@ func TestPostStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("SaveMultiple", func(t *testing.T) { testPostStoreSaveMultiple(t, ss) })
 	t.Run("Save", func(t *testing.T) { testPostStoreSave(t, ss) })
 	t.Run("SaveAndUpdateChannelMsgCounts", func(t *testing.T) { testPostStoreSaveChannelMsgCounts(t, ss) })
+	t.Run("SaveWithExtras", func(t *testing.T) { testPostStoreSaveWithExtras(t, ss) })
 	t.Run("Get", func(t *testing.T) { testPostStoreGet(t, ss) })
 	t.Run("GetSingle", func(t *testing.T) { testPostStoreGetSingle(t, ss) })
 	t.Run("Update", func(t *testing.T) { testPostStoreUpdate(t, ss) })
 	t.Run("Delete", func(t *testing.T) { testPostStoreDelete(t, ss) })
 	t.Run("Delete1Level", func(t *testing.T) { testPostStoreDelete1Level(t, ss) })
 	t.Run("Delete2Level", func(t *testing.T) { testPostStoreDelete2Level(t, ss) })
+	t.Run("GetDeletedForChannel", func(t *testing.T) { testPostStoreGetDeletedForChannel(t, ss) })
+	t.Run("Restore", func(t *testing.T) { testPostStoreRestore(t, ss) })
 	t.Run("PermDelete1Level", func(t *testing.T) { testPostStorePermDelete1Level(t, ss) })
 	t.Run("PermDelete1Level2", func(t *testing.T) { testPostStorePermDelete1Level2(t, ss) })
 	t.Run("GetWithChildren", func(t *testing.T) { testPostStoreGetWithChildren(t, ss) })
@@ -49,6 +53,8 @@ func TestPostStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("GetPostsByIds", func(t *testing.T) { testPostStoreGetPostsByIds(t, ss) })
 	t.Run("GetPostsBatchForIndexing", func(t *testing.T) { testPostStoreGetPostsBatchForIndexing(t, ss) })
 	t.Run("PermanentDeleteBatch", func(t *testing.T) { testPostStorePermanentDeleteBatch(t, ss) })
+	t.Run("PermanentDeleteBatchForChannel", func(t *testing.T) { testPostStorePermanentDeleteBatchForChannel(t, ss) })
+	t.Run("CountForRetentionPolicies", func(t *testing.T) { testPostStoreCountForRetentionPolicies(t, ss) })
 	t.Run("GetOldest", func(t *testing.T) { testPostStoreGetOldest(t, ss) })
 	t.Run("TestGetMaxPostSize", func(t *testing.T) { testGetMaxPostSize(t, ss) })
 	t.Run("GetParentsForExportAfter", func(t *testing.T) { testPostStoreGetParentsForExportAfter(t, ss) })
@@ -476,6 +482,61 @@ func testPostStoreSaveChannelMsgCounts(t *testing.T, ss store.Store) {
 	assert.Equal(t, oldLastPostAt, c1.LastPostAt, "LastPostAt should not update for old message save")
 }
 
+func testPostStoreSaveWithExtras(t *testing.T, ss store.Store) {
+	channel := &model.Channel{Name: model.NewId(), DisplayName: "posttestchannel", Type: model.ChannelTypeOpen}
+	channel, err := ss.Channel().Save(channel, 1000000)
+	require.NoError(t, err)
+
+	t.Run("saves the extras with the post", func(t *testing.T) {
+		post, err := ss.Post().SaveWithExtras(&model.Post{
+			ChannelId: channel.Id,
+			UserId:    model.NewId(),
+			Message:   NewTestId(),
+		}, store.PostExtras{
+			Priority: &model.PostPriority{ChannelId: channel.Id, Priority: model.PostPriorityUrgent},
+			Task:     &model.PostTask{ChannelId: channel.Id, Status: model.PostTaskStatusOpen},
+		})
+		require.NoError(t, err)
+
+		priority, err := ss.PostPriority().GetForPost(post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.PostPriorityUrgent, priority.Priority)
+
+		task, err := ss.PostTask().Get(post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, model.PostTaskStatusOpen, task.Status)
+
+		channel, err = ss.Channel().Get(channel.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), channel.TotalMsgCount)
+	})
+
+	t.Run("saves nothing when an extra is invalid", func(t *testing.T) {
+		post := &model.Post{
+			ChannelId: channel.Id,
+			UserId:    model.NewId(),
+			Message:   NewTestId(),
+		}
+		_, err := ss.Post().SaveWithExtras(post, store.PostExtras{
+			Priority: &model.PostPriority{ChannelId: channel.Id, Priority: model.PostPriorityUrgent},
+			Task:     &model.PostTask{ChannelId: channel.Id, Status: "invalid"},
+		})
+		require.Error(t, err)
+
+		var nfErr *store.ErrNotFound
+		_, err = ss.PostPriority().GetForPost(post.Id)
+		require.ErrorAs(t, err, &nfErr)
+
+		_, err = ss.Post().GetSingle(post.Id, true)
+		require.ErrorAs(t, err, &nfErr)
+
+		rchannel, err := ss.Channel().Get(channel.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, channel.TotalMsgCount, rchannel.TotalMsgCount)
+		assert.Equal(t, channel.LastPostAt, rchannel.LastPostAt)
+	})
+}
+
 func testPostStoreGet(t *testing.T, ss store.Store) {
 	o1 := &model.Post{}
 	o1.ChannelId = model.NewId()
@@ -743,6 +804,131 @@ func testPostStoreDelete(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, strings.Index(etag2, model.CurrentVersion+"."), "Invalid Etag")
 }
 
+func testPostStoreGetDeletedForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	newPost := func(rootID string) *model.Post {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    model.NewId(),
+			RootId:    rootID,
+			Message:   NewTestId(),
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	root := newPost("")
+	reply := newPost(root.Id)
+	old := newPost("")
+	separateReply := newPost(old.Id)
+	newPost("")
+
+	now := model.GetMillis()
+	require.NoError(t, ss.Post().Delete(separateReply.Id, now-2000, ""))
+	require.NoError(t, ss.Post().Delete(old.Id, now-10000, ""))
+	require.NoError(t, ss.Post().Delete(root.Id, now-1000, ""))
+
+	t.Run("replies deleted with their root are left out", func(t *testing.T) {
+		posts, err := ss.Post().GetDeletedForChannel(channelID, 0, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, posts, 3)
+		assert.Equal(t, root.Id, posts[0].Id)
+		assert.Equal(t, separateReply.Id, posts[1].Id)
+		assert.Equal(t, old.Id, posts[2].Id)
+		for _, post := range posts {
+			assert.NotEqual(t, reply.Id, post.Id)
+		}
+	})
+
+	t.Run("since", func(t *testing.T) {
+		posts, err := ss.Post().GetDeletedForChannel(channelID, now-5000, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, posts, 2)
+		assert.Equal(t, root.Id, posts[0].Id)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		posts, err := ss.Post().GetDeletedForChannel(channelID, 0, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, separateReply.Id, posts[0].Id)
+	})
+}
+
+func testPostStoreRestore(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	newPost := func(rootID string, createAt int64) *model.Post {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    model.NewId(),
+			RootId:    rootID,
+			Message:   NewTestId(),
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	t.Run("not deleted", func(t *testing.T) {
+		post := newPost("", 0)
+		_, err := ss.Post().Restore(post.Id)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		_, err = ss.Post().Restore(model.NewId())
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("root post with its replies", func(t *testing.T) {
+		root := newPost("", 0)
+		reply := newPost(root.Id, 0)
+		separateReply := newPost(root.Id, 0)
+
+		require.NoError(t, ss.Post().Delete(separateReply.Id, model.GetMillis()-1000, ""))
+		require.NoError(t, ss.Post().Delete(root.Id, model.GetMillis(), model.NewId()))
+
+		postIDs, err := ss.Post().Restore(root.Id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{root.Id, reply.Id}, postIDs)
+
+		restored, err := ss.Post().GetSingle(root.Id, false)
+		require.NoError(t, err)
+		assert.Nil(t, restored.GetProp(model.PostPropsDeleteBy))
+
+		_, err = ss.Post().GetSingle(reply.Id, false)
+		require.NoError(t, err)
+		_, err = ss.Post().GetSingle(separateReply.Id, false)
+		require.Error(t, err)
+
+		thread, err := ss.Thread().Get(root.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, thread.ReplyCount)
+		assert.Equal(t, model.StringArray{reply.UserId}, thread.Participants)
+	})
+
+	t.Run("reply", func(t *testing.T) {
+		root := newPost("", 1000)
+		reply1 := newPost(root.Id, 2000)
+		reply2 := newPost(root.Id, 3000)
+
+		require.NoError(t, ss.Post().Delete(reply2.Id, model.GetMillis(), ""))
+
+		thread, err := ss.Thread().Get(root.Id)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, thread.ReplyCount)
+
+		postIDs, err := ss.Post().Restore(reply2.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{reply2.Id}, postIDs)
+
+		thread, err = ss.Thread().Get(root.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, thread.ReplyCount)
+		assert.Equal(t, model.StringArray{reply1.UserId, reply2.UserId}, thread.Participants)
+		assert.EqualValues(t, 3000, thread.LastReplyAt)
+	})
+}
+
 func testPostStoreDelete1Level(t *testing.T, ss store.Store) {
 	o1 := &model.Post{}
 	o1.ChannelId = model.NewId()
@@ -2875,6 +3061,71 @@ func testPostStoreGetPostsBatchForIndexing(t *testing.T, ss store.Store) {
 	}
 }
 
+func testPostStorePermanentDeleteBatchForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	otherChannelID := model.NewId()
+
+	savePost := func(channelID string, createAt int64) *model.Post {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    model.NewId(),
+			Message:   NewTestId(),
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	old1 := savePost(channelID, 1000)
+	old2 := savePost(channelID, 2000)
+	recent := savePost(channelID, 100000)
+	otherChannel := savePost(otherChannelID, 1000)
+
+	_, err := ss.Reaction().Save(&model.Reaction{PostId: old1.Id, UserId: model.NewId(), EmojiName: "smile"})
+	require.NoError(t, err)
+
+	info, err := ss.FileInfo().Save(&model.FileInfo{
+		PostId:        old1.Id,
+		CreatorId:     old1.UserId,
+		Path:          "file.txt",
+		ThumbnailPath: "file_thumb.jpg",
+	})
+	require.NoError(t, err)
+
+	deleted, infos, err := ss.Post().PermanentDeleteBatchForChannel(channelID, 50000, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	require.Len(t, infos, 1)
+	assert.Equal(t, info.Id, infos[0].Id)
+	assert.Equal(t, info.Path, infos[0].Path)
+	assert.Equal(t, info.ThumbnailPath, infos[0].ThumbnailPath)
+
+	deleted, infos, err = ss.Post().PermanentDeleteBatchForChannel(channelID, 50000, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	assert.Empty(t, infos)
+
+	deleted, _, err = ss.Post().PermanentDeleteBatchForChannel(channelID, 50000, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	_, err = ss.FileInfo().Get(info.Id)
+	require.Error(t, err)
+
+	for _, postID := range []string{old1.Id, old2.Id} {
+		_, err = ss.Post().Get(context.Background(), postID, false, false, false, "")
+		require.Error(t, err)
+	}
+	for _, postID := range []string{recent.Id, otherChannel.Id} {
+		_, err = ss.Post().Get(context.Background(), postID, false, false, false, "")
+		require.NoError(t, err)
+	}
+
+	reactions, err := ss.Reaction().GetForPost(old1.Id, false)
+	require.NoError(t, err)
+	assert.Empty(t, reactions)
+}
+
 func testPostStorePermanentDeleteBatch(t *testing.T, ss store.Store) {
 	team, err := ss.Team().Save(&model.Team{
 		DisplayName: "DisplayName",
@@ -3553,6 +3804,22 @@ func testGetPostsSinceForSync(t *testing.T, ss store.Store, s SqlStore) {
 		require.ElementsMatch(t, getPostIds(data[0:8]), getPostIds(posts1, posts2...))
 	})
 
+	t.Run("Count", func(t *testing.T) {
+		opt := model.GetPostsSinceForSyncOptions{
+			ChannelId: channelID,
+		}
+		count, err := ss.Post().CountPostsSinceForSync(opt, model.GetPostsSinceForSyncCursor{})
+		require.NoError(t, err)
+		require.Equal(t, int64(8), count)
+
+		opt.IncludeDeleted = true
+		opt.ExcludeRemoteId = *remoteID
+		cursor := model.GetPostsSinceForSyncCursor{LastPostUpdateAt: data[1].UpdateAt, LastPostId: data[1].Id}
+		count, err = ss.Post().CountPostsSinceForSync(opt, cursor)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), count)
+	})
+
 	t.Run("UpdateAt collisions", func(t *testing.T) {
 		// this test requires all the UpdateAt timestamps to be the same.
 		result, err := s.GetMasterX().Exec("UPDATE Posts SET UpdateAt = ?", model.GetMillis())
@@ -3587,3 +3854,74 @@ func getPostIds(posts []*model.Post, morePosts ...*model.Post) []string {
 	}
 	return ids
 }
+
+func testPostStoreCountForRetentionPolicies(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "DisplayName",
+		Name:        "team" + model.NewId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "DisplayName",
+		Name:        "channel" + model.NewId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	policy, err := ss.RetentionPolicy().Save(&model.RetentionPolicyWithTeamAndChannelIDs{
+		RetentionPolicy: model.RetentionPolicy{
+			DisplayName:  "DisplayName",
+			PostDuration: model.NewInt64(30),
+		},
+		ChannelIDs: []string{channel.Id},
+	})
+	require.NoError(t, err)
+
+	now := 1000 + *policy.PostDuration*24*60*60*1000 + 1
+	globalPolicyEndTime := int64(2000)
+
+	before, err := ss.Post().CountForRetentionPolicies(now, globalPolicyEndTime)
+	require.NoError(t, err)
+	assert.Equal(t, now, before.Now)
+	assert.Equal(t, globalPolicyEndTime, before.GlobalPolicyEndTime)
+
+	var posts []*model.Post
+	for i := 0; i < 2; i++ {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channel.Id,
+			UserId:    model.NewId(),
+			Message:   "message",
+			CreateAt:  1000,
+		})
+		require.NoError(t, err)
+		posts = append(posts, post)
+	}
+
+	after, err := ss.Post().CountForRetentionPolicies(now, globalPolicyEndTime)
+	require.NoError(t, err)
+	assert.Equal(t, before.ChannelPolicies+2, after.ChannelPolicies)
+	assert.Equal(t, before.GlobalPolicy, after.GlobalPolicy, "granular policy should override the global policy")
+
+	for _, post := range posts {
+		_, err = ss.Post().GetSingle(post.Id, false)
+		require.NoError(t, err, "a dry run should not delete posts")
+	}
+
+	t.Run("disabled policies", func(t *testing.T) {
+		dryRun, err := ss.Post().CountForRetentionPolicies(0, 0)
+		require.NoError(t, err)
+		assert.Zero(t, dryRun.ChannelPolicies)
+		assert.Zero(t, dryRun.TeamPolicies)
+		assert.Zero(t, dryRun.GlobalPolicy)
+	})
+
+	require.NoError(t, ss.RetentionPolicy().RemoveChannels(policy.ID, []string{channel.Id}))
+	require.NoError(t, ss.RetentionPolicy().Delete(policy.ID))
+
+	withoutPolicy, err := ss.Post().CountForRetentionPolicies(now, globalPolicyEndTime)
+	require.NoError(t, err)
+	assert.Equal(t, before.GlobalPolicy+2, withoutPolicy.GlobalPolicy)
+}
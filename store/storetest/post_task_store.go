@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestPostTaskStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdate", func(t *testing.T) { testPostTaskStoreSaveGetUpdate(t, ss) })
+	t.Run("GetForAssignee", func(t *testing.T) { testPostTaskStoreGetForAssignee(t, ss) })
+}
+
+func postTaskStoreCreateChannel(t *testing.T, ss store.Store, memberIDs ...string) *model.Channel {
+	channel, err := ss.Channel().Save(&model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "DisplayName",
+		Name:        "channel" + model.NewId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	for _, userID := range memberIDs {
+		_, err = ss.Channel().SaveMember(&model.ChannelMember{
+			ChannelId:   channel.Id,
+			UserId:      userID,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.NoError(t, err)
+	}
+
+	return channel
+}
+
+func postTaskStoreCreateTask(t *testing.T, ss store.Store, channelID, assigneeID, status string, dueAt int64) *model.PostTask {
+	post, err := ss.Post().Save(&model.Post{
+		ChannelId: channelID,
+		UserId:    model.NewId(),
+		Type:      model.PostTypeTask,
+		Message:   "task",
+	})
+	require.NoError(t, err)
+
+	task, err := ss.PostTask().Save(&model.PostTask{
+		PostId:     post.Id,
+		ChannelId:  channelID,
+		AssigneeId: assigneeID,
+		Status:     status,
+		DueAt:      dueAt,
+	})
+	require.NoError(t, err)
+
+	return task
+}
+
+func testPostTaskStoreSaveGetUpdate(t *testing.T, ss store.Store) {
+	channel := postTaskStoreCreateChannel(t, ss)
+	task := postTaskStoreCreateTask(t, ss, channel.Id, "", model.PostTaskStatusOpen, 0)
+	assert.NotZero(t, task.CreateAt)
+
+	t.Run("get", func(t *testing.T) {
+		got, err := ss.PostTask().Get(task.PostId)
+		require.NoError(t, err)
+		assert.Equal(t, task, got)
+
+		_, err = ss.PostTask().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("save invalid", func(t *testing.T) {
+		_, err := ss.PostTask().Save(&model.PostTask{PostId: model.NewId(), ChannelId: channel.Id, Status: "unknown"})
+		require.Error(t, err)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assigneeID := model.NewId()
+		task.AssigneeId = assigneeID
+		task.Status = model.PostTaskStatusDone
+		_, err := ss.PostTask().Update(task)
+		require.NoError(t, err)
+
+		got, err := ss.PostTask().Get(task.PostId)
+		require.NoError(t, err)
+		assert.Equal(t, assigneeID, got.AssigneeId)
+		assert.Equal(t, model.PostTaskStatusDone, got.Status)
+	})
+
+	t.Run("update missing", func(t *testing.T) {
+		_, err := ss.PostTask().Update(&model.PostTask{PostId: model.NewId(), ChannelId: channel.Id, Status: model.PostTaskStatusOpen})
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testPostTaskStoreGetForAssignee(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	channel1 := postTaskStoreCreateChannel(t, ss, userID)
+	channel2 := postTaskStoreCreateChannel(t, ss, userID)
+	otherChannel := postTaskStoreCreateChannel(t, ss)
+
+	noDueDate := postTaskStoreCreateTask(t, ss, channel1.Id, userID, model.PostTaskStatusOpen, 0)
+	dueLater := postTaskStoreCreateTask(t, ss, channel2.Id, userID, model.PostTaskStatusInProgress, 2000)
+	dueSoon := postTaskStoreCreateTask(t, ss, channel1.Id, userID, model.PostTaskStatusOpen, 1000)
+	done := postTaskStoreCreateTask(t, ss, channel2.Id, userID, model.PostTaskStatusDone, 500)
+	postTaskStoreCreateTask(t, ss, otherChannel.Id, userID, model.PostTaskStatusOpen, 100)
+	postTaskStoreCreateTask(t, ss, channel1.Id, model.NewId(), model.PostTaskStatusOpen, 100)
+
+	deleted := postTaskStoreCreateTask(t, ss, channel1.Id, userID, model.PostTaskStatusOpen, 100)
+	require.NoError(t, ss.Post().Delete(deleted.PostId, model.GetMillis(), userID))
+
+	postIDs := func(tasks []*model.PostTask) []string {
+		ids := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			ids = append(ids, task.PostId)
+		}
+		return ids
+	}
+
+	t.Run("open tasks", func(t *testing.T) {
+		tasks, err := ss.PostTask().GetForAssignee(userID, []string{model.PostTaskStatusOpen, model.PostTaskStatusInProgress}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{dueSoon.PostId, dueLater.PostId, noDueDate.PostId}, postIDs(tasks))
+	})
+
+	t.Run("all statuses", func(t *testing.T) {
+		tasks, err := ss.PostTask().GetForAssignee(userID, nil, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{done.PostId, dueSoon.PostId, dueLater.PostId, noDueDate.PostId}, postIDs(tasks))
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		tasks, err := ss.PostTask().GetForAssignee(userID, nil, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{dueSoon.PostId, dueLater.PostId}, postIDs(tasks))
+	})
+}
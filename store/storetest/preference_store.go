@@ -15,8 +15,10 @@ import (
 
 func TestPreferenceStore(t *testing.T, ss store.Store) {
 	t.Run("PreferenceSave", func(t *testing.T) { testPreferenceSave(t, ss) })
+	t.Run("PreferenceCompareAndSet", func(t *testing.T) { testPreferenceCompareAndSet(t, ss) })
 	t.Run("PreferenceGet", func(t *testing.T) { testPreferenceGet(t, ss) })
 	t.Run("PreferenceGetCategory", func(t *testing.T) { testPreferenceGetCategory(t, ss) })
+	t.Run("PreferenceGetForUsers", func(t *testing.T) { testPreferenceGetForUsers(t, ss) })
 	t.Run("PreferenceGetAll", func(t *testing.T) { testPreferenceGetAll(t, ss) })
 	t.Run("PreferenceDeleteByUser", func(t *testing.T) { testPreferenceDeleteByUser(t, ss) })
 	t.Run("PreferenceDelete", func(t *testing.T) { testPreferenceDelete(t, ss) })
@@ -61,6 +63,90 @@ func testPreferenceSave(t *testing.T, ss store.Store) {
 	}
 }
 
+func testPreferenceCompareAndSet(t *testing.T, ss store.Store) {
+	userId := model.NewId()
+	preferences := model.Preferences{
+		{
+			UserId:   userId,
+			Category: model.PreferenceCategoryDirectChannelShow,
+			Name:     model.NewId(),
+			Value:    "value1",
+		},
+	}
+	require.NoError(t, ss.Preference().Save(preferences))
+
+	existing := preferences[0]
+	require.EqualValues(t, 1, existing.Version)
+
+	t.Run("saves when no preference changed", func(t *testing.T) {
+		update := existing
+		update.Value = "value2"
+		created := model.Preference{
+			UserId:   userId,
+			Category: model.PreferenceCategoryDirectChannelShow,
+			Name:     model.NewId(),
+			Value:    "new",
+		}
+
+		saved, conflicts, err := ss.Preference().CompareAndSet(model.Preferences{update, created})
+		require.NoError(t, err)
+		assert.Empty(t, conflicts)
+		require.Len(t, saved, 2)
+		assert.EqualValues(t, 2, saved[0].Version)
+		assert.EqualValues(t, 1, saved[1].Version)
+
+		data, err := ss.Preference().Get(userId, update.Category, update.Name)
+		require.NoError(t, err)
+		assert.Equal(t, "value2", data.Value)
+		assert.EqualValues(t, 2, data.Version)
+
+		existing = *data
+	})
+
+	t.Run("saves nothing when a preference changed", func(t *testing.T) {
+		stale := existing
+		stale.Version = 1
+		stale.Value = "stale"
+		created := model.Preference{
+			UserId:   userId,
+			Category: model.PreferenceCategoryDirectChannelShow,
+			Name:     model.NewId(),
+			Value:    "new",
+		}
+
+		saved, conflicts, err := ss.Preference().CompareAndSet(model.Preferences{created, stale})
+		require.NoError(t, err)
+		assert.Empty(t, saved)
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, existing, conflicts[0])
+
+		_, err = ss.Preference().Get(userId, created.Category, created.Name)
+		require.Error(t, err)
+	})
+
+	t.Run("preference created since", func(t *testing.T) {
+		created := existing
+		created.Version = 0
+
+		_, conflicts, err := ss.Preference().CompareAndSet(model.Preferences{created})
+		require.NoError(t, err)
+		require.Len(t, conflicts, 1)
+		assert.EqualValues(t, 2, conflicts[0].Version)
+	})
+
+	t.Run("preference deleted since", func(t *testing.T) {
+		deleted := existing
+		deleted.Name = model.NewId()
+		deleted.Version = 3
+
+		_, conflicts, err := ss.Preference().CompareAndSet(model.Preferences{deleted})
+		require.NoError(t, err)
+		require.Len(t, conflicts, 1)
+		assert.Zero(t, conflicts[0].Version)
+		assert.Empty(t, conflicts[0].Value)
+	})
+}
+
 func testPreferenceGet(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 	category := model.PreferenceCategoryDirectChannelShow
@@ -150,6 +236,51 @@ func testPreferenceGetCategory(t *testing.T, ss store.Store) {
 	require.Equal(t, 0, len(preferencesByCategory), "shouldn't have got any preferences")
 }
 
+func testPreferenceGetForUsers(t *testing.T, ss store.Store) {
+	userId1 := model.NewId()
+	userId2 := model.NewId()
+	category := model.PreferenceCategoryNotifications
+	name := model.NewId()
+
+	preferences := model.Preferences{
+		{
+			UserId:   userId1,
+			Category: category,
+			Name:     name,
+			Value:    "1",
+		},
+		{
+			UserId:   userId2,
+			Category: category,
+			Name:     name,
+			Value:    "2",
+		},
+		// same user/category, different name
+		{
+			UserId:   userId1,
+			Category: category,
+			Name:     model.NewId(),
+		},
+		// same category/name, user not requested
+		{
+			UserId:   model.NewId(),
+			Category: category,
+			Name:     name,
+		},
+	}
+
+	err := ss.Preference().Save(preferences)
+	require.NoError(t, err)
+
+	preferencesForUsers, err := ss.Preference().GetForUsers([]string{userId1, userId2, model.NewId()}, category, name)
+	require.NoError(t, err)
+	require.ElementsMatch(t, model.Preferences{preferences[0], preferences[1]}, preferencesForUsers)
+
+	preferencesForUsers, err = ss.Preference().GetForUsers([]string{}, category, name)
+	require.NoError(t, err)
+	require.Empty(t, preferencesForUsers)
+}
+
 func testPreferenceGetAll(t *testing.T, ss store.Store) {
 	userId := model.NewId()
 	category := model.PreferenceCategoryDirectChannelShow
@@ -23,6 +23,8 @@ func TestRemoteClusterStore(t *testing.T, ss store.Store) {
 	t.Run("RemoteClusterGetAll", func(t *testing.T) { testRemoteClusterGetAll(t, ss) })
 	t.Run("RemoteClusterGetByTopic", func(t *testing.T) { testRemoteClusterGetByTopic(t, ss) })
 	t.Run("RemoteClusterUpdateTopics", func(t *testing.T) { testRemoteClusterUpdateTopics(t, ss) })
+	t.Run("RemoteClusterUpdateHiddenProfileFields", func(t *testing.T) { testRemoteClusterUpdateHiddenProfileFields(t, ss) })
+	t.Run("RemoteClusterUpdateInvitePolicy", func(t *testing.T) { testRemoteClusterUpdateInvitePolicy(t, ss) })
 }
 
 func testRemoteClusterSave(t *testing.T, ss store.Store) {
@@ -504,6 +506,74 @@ func testRemoteClusterUpdateTopics(t *testing.T, ss store.Store) {
 	}
 }
 
+func testRemoteClusterUpdateHiddenProfileFields(t *testing.T, ss store.Store) {
+	remoteId := model.NewId()
+	rc := &model.RemoteCluster{
+		DisplayName: "Blap Inc",
+		Name:        "blap",
+		SiteURL:     "blap.com",
+		RemoteId:    remoteId,
+		CreatorId:   model.NewId(),
+	}
+
+	_, err := ss.RemoteCluster().Save(rc)
+	require.NoError(t, err)
+
+	rcUpdated, err := ss.RemoteCluster().UpdateHiddenProfileFields(remoteId, "custom_attributes email")
+	require.NoError(t, err)
+	require.Equal(t, "custom_attributes email", rcUpdated.HiddenProfileFields)
+
+	rcUpdated, err = ss.RemoteCluster().Get(remoteId)
+	require.NoError(t, err)
+	require.True(t, rcUpdated.IsProfileFieldHidden(model.RemoteProfileFieldEmail))
+
+	// a regular update keeps the hidden fields
+	rcUpdated.DisplayName = "Blap Incorporated"
+	_, err = ss.RemoteCluster().Update(rcUpdated)
+	require.NoError(t, err)
+
+	rcUpdated, err = ss.RemoteCluster().Get(remoteId)
+	require.NoError(t, err)
+	require.Equal(t, "custom_attributes email", rcUpdated.HiddenProfileFields)
+
+	_, err = ss.RemoteCluster().UpdateHiddenProfileFields(model.NewId(), "")
+	require.Error(t, err)
+}
+
+func testRemoteClusterUpdateInvitePolicy(t *testing.T, ss store.Store) {
+	remoteId := model.NewId()
+	rc := &model.RemoteCluster{
+		DisplayName: "Blap Inc",
+		Name:        "blap",
+		SiteURL:     "blap.com",
+		RemoteId:    remoteId,
+		CreatorId:   model.NewId(),
+	}
+
+	_, err := ss.RemoteCluster().Save(rc)
+	require.NoError(t, err)
+
+	rcUpdated, err := ss.RemoteCluster().UpdateInvitePolicy(remoteId, model.RemoteInvitePolicyRequireApproval)
+	require.NoError(t, err)
+	require.Equal(t, model.RemoteInvitePolicyRequireApproval, rcUpdated.InvitePolicy)
+
+	rcUpdated, err = ss.RemoteCluster().Get(remoteId)
+	require.NoError(t, err)
+	require.Equal(t, model.RemoteInvitePolicyRequireApproval, rcUpdated.GetInvitePolicy().Policy)
+
+	// a regular update keeps the invite policy
+	rcUpdated.DisplayName = "Blap Incorporated"
+	_, err = ss.RemoteCluster().Update(rcUpdated)
+	require.NoError(t, err)
+
+	rcUpdated, err = ss.RemoteCluster().Get(remoteId)
+	require.NoError(t, err)
+	require.Equal(t, model.RemoteInvitePolicyRequireApproval, rcUpdated.InvitePolicy)
+
+	_, err = ss.RemoteCluster().UpdateInvitePolicy(model.NewId(), model.RemoteInvitePolicyDeny)
+	require.Error(t, err)
+}
+
 func clearRemoteClusters(ss store.Store) error {
 	list, err := ss.RemoteCluster().GetAll(model.RemoteClusterQueryFilter{})
 	if err != nil {
@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestRESTProxyBridgeStore(t *testing.T, ss store.Store) {
+	t.Run("PublishInOrder", func(t *testing.T) { testRESTProxyBridgeStorePublishInOrder(t, ss) })
+	t.Run("PermanentDeletePublishedBatch", func(t *testing.T) { testRESTProxyBridgeStorePermanentDeletePublishedBatch(t, ss) })
+}
+
+func saveTestRESTProxyBridgeMessage(t *testing.T, ss store.Store, event string, createAt int64) *model.RESTProxyBridgeMessage {
+	message, err := ss.RESTProxyBridge().Save(&model.RESTProxyBridgeMessage{
+		Event:      event,
+		Topic:      "mattermost-events",
+		MessageKey: model.NewId(),
+		Payload:    `{"event":"` + event + `"}`,
+		CreateAt:   createAt,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, message.Id)
+	return message
+}
+
+func publishAllRESTProxyBridgeMessages(t *testing.T, ss store.Store, publishedAt int64) {
+	pending, err := ss.RESTProxyBridge().GetPending(10000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(pending))
+	for _, message := range pending {
+		ids = append(ids, message.Id)
+	}
+	require.NoError(t, ss.RESTProxyBridge().MarkPublished(ids, publishedAt))
+}
+
+func testRESTProxyBridgeStorePublishInOrder(t *testing.T, ss store.Store) {
+	publishAllRESTProxyBridgeMessages(t, ss, model.GetMillis())
+
+	status, err := ss.RESTProxyBridge().GetStatus()
+	require.NoError(t, err)
+	assert.Zero(t, status.PendingCount)
+	assert.Nil(t, status.NextPending)
+
+	now := model.GetMillis()
+	second := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventPostEdited, now+2)
+	first := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventPostCreated, now+1)
+	third := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventPostDeleted, now+3)
+
+	pending, err := ss.RESTProxyBridge().GetPending(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	assert.Equal(t, first.Id, pending[0].Id)
+	assert.Equal(t, second.Id, pending[1].Id)
+	assert.Equal(t, third.Id, pending[2].Id)
+	assert.Equal(t, first.MessageKey, pending[0].MessageKey)
+	assert.Equal(t, first.Payload, pending[0].Payload)
+
+	first.RecordFailure("connection refused", now)
+	require.NoError(t, ss.RESTProxyBridge().UpdateAttempt(first))
+
+	status, err = ss.RESTProxyBridge().GetStatus()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), status.PendingCount)
+	assert.Equal(t, first.CreateAt, status.OldestPendingAt)
+	require.NotNil(t, status.NextPending)
+	assert.Equal(t, first.Id, status.NextPending.Id)
+	assert.Equal(t, 1, status.NextPending.Attempts)
+	assert.Equal(t, "connection refused", status.NextPending.LastError)
+	assert.Equal(t, first.NextAttemptAt, status.NextPending.NextAttemptAt)
+
+	require.NoError(t, ss.RESTProxyBridge().MarkPublished([]string{first.Id, second.Id}, now+10))
+
+	pending, err = ss.RESTProxyBridge().GetPending(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, third.Id, pending[0].Id)
+
+	status, err = ss.RESTProxyBridge().GetStatus()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), status.PendingCount)
+	require.NotNil(t, status.LastPublished)
+	assert.Equal(t, second.Id, status.LastPublished.MessageId)
+	assert.Equal(t, model.RESTProxyBridgeEventPostEdited, status.LastPublished.Event)
+	assert.Equal(t, now+10, status.LastPublished.PublishedAt)
+
+	publishAllRESTProxyBridgeMessages(t, ss, now+20)
+}
+
+func testRESTProxyBridgeStorePermanentDeletePublishedBatch(t *testing.T, ss store.Store) {
+	publishAllRESTProxyBridgeMessages(t, ss, 1)
+	_, err := ss.RESTProxyBridge().PermanentDeletePublishedBatch(model.GetMillis()+time.Hour.Milliseconds(), 10000)
+	require.NoError(t, err)
+
+	now := model.GetMillis()
+	old := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventTeamMemberAdded, now-3000)
+	recent := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventTeamMemberRemoved, now-2000)
+	pending := saveTestRESTProxyBridgeMessage(t, ss, model.RESTProxyBridgeEventChannelMemberAdded, now-1000)
+	require.NoError(t, ss.RESTProxyBridge().MarkPublished([]string{old.Id}, now-3000))
+	require.NoError(t, ss.RESTProxyBridge().MarkPublished([]string{recent.Id}, now))
+
+	deleted, err := ss.RESTProxyBridge().PermanentDeletePublishedBatch(now-1000, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	status, err := ss.RESTProxyBridge().GetStatus()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), status.PendingCount)
+	require.NotNil(t, status.NextPending)
+	assert.Equal(t, pending.Id, status.NextPending.Id)
+	require.NotNil(t, status.LastPublished)
+	assert.Equal(t, recent.Id, status.LastPublished.MessageId)
+
+	publishAllRESTProxyBridgeMessages(t, ss, now)
+}
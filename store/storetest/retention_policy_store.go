@@ -27,6 +27,7 @@ func TestRetentionPolicyStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("RemoveTeams", func(t *testing.T) { testRetentionPolicyStoreRemoveTeams(t, ss, s) })
 	t.Run("RemoveOrphanedRows", func(t *testing.T) { testRetentionPolicyStoreRemoveOrphanedRows(t, ss, s) })
 	t.Run("GetPoliciesForUser", func(t *testing.T) { testRetentionPolicyStoreGetPoliciesForUser(t, ss, s) })
+	t.Run("CountForPolicyPreview", func(t *testing.T) { testRetentionPolicyStoreCountForPolicyPreview(t, ss, s) })
 }
 
 func getRetentionPolicyWithTeamAndChannelIds(t *testing.T, ss store.Store, policyID string) *model.RetentionPolicyWithTeamAndChannelIDs {
@@ -665,6 +666,78 @@ func testRetentionPolicyStoreGetPoliciesForUser(t *testing.T, ss store.Store, s
 	})
 }
 
+func testRetentionPolicyStoreCountForPolicyPreview(t *testing.T, ss store.Store, s SqlStore) {
+	teamIDs, channelIDs := createTeamsAndChannelsForRetentionPolicy(t, ss)
+	otherPolicy := saveRetentionPolicyWithTeamAndChannelIds(t, ss, "Other policy", nil, channelIDs[2:])
+
+	defer deleteTeamsAndChannels(ss, teamIDs, channelIDs)
+	defer cleanupRetentionPolicyTest(s)
+
+	userID := model.NewId()
+	createPost := func(channelID string, createAt int64, withFile bool) {
+		post, err := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    userID,
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		if withFile {
+			_, err = ss.FileInfo().Save(&model.FileInfo{
+				PostId:    post.Id,
+				CreatorId: userID,
+				Path:      "file.txt",
+			})
+			require.NoError(t, err)
+		}
+	}
+	createPost(channelIDs[0], 1000, true)
+	createPost(channelIDs[0], 1000, false)
+	createPost(channelIDs[0], 3000, true)
+	createPost(channelIDs[1], 1000, false)
+	createPost(channelIDs[2], 1000, false)
+
+	countsByChannel := func(counts []*model.RetentionPolicyPreviewCount) map[string]*model.RetentionPolicyPreviewCount {
+		byChannel := make(map[string]*model.RetentionPolicyPreviewCount, len(counts))
+		for _, count := range counts {
+			byChannel[count.ChannelId] = count
+		}
+		return byChannel
+	}
+
+	t.Run("leaves out the channels that have a policy of their own", func(t *testing.T) {
+		policy := createRetentionPolicyWithTeamAndChannelIds("Preview", teamIDs[1:], channelIDs[:1])
+		counts, err := ss.RetentionPolicy().CountForPolicyPreview(policy, 2000)
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+
+		byChannel := countsByChannel(counts)
+		require.Equal(t, int64(2), byChannel[channelIDs[0]].PostCount)
+		require.Equal(t, int64(1), byChannel[channelIDs[0]].FileCount)
+		require.Equal(t, teamIDs[0], byChannel[channelIDs[0]].TeamId)
+		require.Equal(t, int64(1), byChannel[channelIDs[1]].PostCount)
+		require.Equal(t, int64(0), byChannel[channelIDs[1]].FileCount)
+	})
+
+	t.Run("includes the channels of the policy being edited", func(t *testing.T) {
+		policy := createRetentionPolicyWithTeamAndChannelIds("Preview", teamIDs[1:], nil)
+		policy.ID = otherPolicy.ID
+		counts, err := ss.RetentionPolicy().CountForPolicyPreview(policy, 2000)
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+
+		byChannel := countsByChannel(counts)
+		require.Equal(t, int64(1), byChannel[channelIDs[1]].PostCount)
+		require.Equal(t, int64(1), byChannel[channelIDs[2]].PostCount)
+	})
+
+	t.Run("policy without teams or channels", func(t *testing.T) {
+		counts, err := ss.RetentionPolicy().CountForPolicyPreview(createRetentionPolicyWithTeamAndChannelIds("Preview", nil, nil), 2000)
+		require.NoError(t, err)
+		require.Empty(t, counts)
+	})
+}
+
 func testRetentionPolicyStoreRemoveOrphanedRows(t *testing.T, ss store.Store, s SqlStore) {
 	teamID := createTeamsForRetentionPolicy(t, ss, 1)[0]
 	channelID := createChannelsForRetentionPolicy(t, ss, teamID, 1)[0]
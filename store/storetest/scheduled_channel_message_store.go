@@ -0,0 +1,170 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestScheduledChannelMessageStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testScheduledChannelMessageStoreSaveGet(t, ss) })
+	t.Run("GetForChannel", func(t *testing.T) { testScheduledChannelMessageStoreGetForChannel(t, ss) })
+	t.Run("UpdateDelete", func(t *testing.T) { testScheduledChannelMessageStoreUpdateDelete(t, ss) })
+	t.Run("GetDueUpdateRun", func(t *testing.T) { testScheduledChannelMessageStoreGetDueUpdateRun(t, ss) })
+}
+
+func newTestScheduledChannelMessage(channelID string, nextRunAt int64) *model.ScheduledChannelMessage {
+	return &model.ScheduledChannelMessage{
+		ChannelId: channelID,
+		CreatorId: model.NewId(),
+		Message:   "Standup in 10 minutes!",
+		Frequency: model.ScheduledChannelMessageFrequencyWeekly,
+		Weekday:   1,
+		TimeOfDay: "09:50",
+		Timezone:  "Europe/Paris",
+		SkipDates: model.StringArray{"12-25"},
+		NextRunAt: nextRunAt,
+	}
+}
+
+func testScheduledChannelMessageStoreSaveGet(t *testing.T, ss store.Store) {
+	message, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), 1000), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+	require.NotEmpty(t, message.Id)
+
+	got, err := ss.ScheduledChannelMessage().Get(message.Id)
+	require.NoError(t, err)
+	assert.Equal(t, message, got)
+
+	t.Run("save with id", func(t *testing.T) {
+		invalid := newTestScheduledChannelMessage(model.NewId(), 1000)
+		invalid.Id = model.NewId()
+		_, err := ss.ScheduledChannelMessage().Save(invalid, model.PostMessageMaxRunesV2)
+		require.Error(t, err)
+	})
+
+	t.Run("save invalid", func(t *testing.T) {
+		invalid := newTestScheduledChannelMessage(model.NewId(), 1000)
+		invalid.TimeOfDay = "25:00"
+		_, err := ss.ScheduledChannelMessage().Save(invalid, model.PostMessageMaxRunesV2)
+		var appErr *model.AppError
+		require.True(t, errors.As(err, &appErr))
+	})
+
+	t.Run("get missing", func(t *testing.T) {
+		_, err := ss.ScheduledChannelMessage().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testScheduledChannelMessageStoreGetForChannel(t *testing.T, ss store.Store) {
+	channelID := model.NewId()
+	first, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(channelID, 1000), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+	second, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(channelID, 2000), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+	_, err = ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), 1000), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+
+	messages, err := ss.ScheduledChannelMessage().GetForChannel(channelID)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.ElementsMatch(t, []string{first.Id, second.Id}, []string{messages[0].Id, messages[1].Id})
+
+	messages, err = ss.ScheduledChannelMessage().GetForChannel(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func testScheduledChannelMessageStoreUpdateDelete(t *testing.T, ss store.Store) {
+	message, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), 1000), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+
+	message.Message = "Retro in 10 minutes!"
+	message.SkipWeekends = true
+	message.SkipDates = model.StringArray{"2026-04-06", "12-25"}
+	message.NextRunAt = 3000
+	_, err = ss.ScheduledChannelMessage().Update(message, model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+
+	got, err := ss.ScheduledChannelMessage().Get(message.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Retro in 10 minutes!", got.Message)
+	assert.True(t, got.SkipWeekends)
+	assert.Equal(t, model.StringArray{"2026-04-06", "12-25"}, got.SkipDates)
+	assert.Equal(t, int64(3000), got.NextRunAt)
+
+	missing := newTestScheduledChannelMessage(model.NewId(), 1000)
+	missing.PreSave()
+	_, err = ss.ScheduledChannelMessage().Update(missing, model.PostMessageMaxRunesV2)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	require.NoError(t, ss.ScheduledChannelMessage().Delete(message.Id))
+	_, err = ss.ScheduledChannelMessage().Get(message.Id)
+	require.True(t, errors.As(err, &nfErr))
+
+	err = ss.ScheduledChannelMessage().Delete(message.Id)
+	require.True(t, errors.As(err, &nfErr))
+
+	t.Run("delete for channel", func(t *testing.T) {
+		channelID := model.NewId()
+		for i := 0; i < 2; i++ {
+			_, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(channelID, 1000), model.PostMessageMaxRunesV2)
+			require.NoError(t, err)
+		}
+		other, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), 1000), model.PostMessageMaxRunesV2)
+		require.NoError(t, err)
+
+		require.NoError(t, ss.ScheduledChannelMessage().DeleteForChannel(channelID))
+
+		messages, err := ss.ScheduledChannelMessage().GetForChannel(channelID)
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+		_, err = ss.ScheduledChannelMessage().Get(other.Id)
+		require.NoError(t, err)
+	})
+}
+
+func testScheduledChannelMessageStoreGetDueUpdateRun(t *testing.T, ss store.Store) {
+	now := model.GetMillis() + 1000*1000
+	due, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), now-1), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+	notDue, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), now+1), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+	neverDue, err := ss.ScheduledChannelMessage().Save(newTestScheduledChannelMessage(model.NewId(), 0), model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+
+	messages, err := ss.ScheduledChannelMessage().GetDue(now, 1000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.Id)
+	}
+	assert.Contains(t, ids, due.Id)
+	assert.NotContains(t, ids, notDue.Id)
+	assert.NotContains(t, ids, neverDue.Id)
+
+	updated, err := ss.ScheduledChannelMessage().UpdateRun(due.Id, due.NextRunAt, now, now+7*24*60*60*1000)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	// The run was already recorded.
+	updated, err = ss.ScheduledChannelMessage().UpdateRun(due.Id, due.NextRunAt, now, now+7*24*60*60*1000)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	got, err := ss.ScheduledChannelMessage().Get(due.Id)
+	require.NoError(t, err)
+	assert.Equal(t, now, got.LastRunAt)
+	assert.Equal(t, now+7*24*60*60*1000, got.NextRunAt)
+}
@@ -5,6 +5,7 @@ package storetest
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,8 @@ func TestSchemeStore(t *testing.T, ss store.Store) {
 	t.Run("GetByName", func(t *testing.T) { testSchemeStoreGetByName(t, ss) })
 	t.Run("CountByScope", func(t *testing.T) { testSchemeStoreCountByScope(t, ss) })
 	t.Run("CountWithoutPermission", func(t *testing.T) { testCountWithoutPermission(t, ss) })
+	t.Run("AssignTeams", func(t *testing.T) { testSchemeStoreAssignTeams(t, ss) })
+	t.Run("AssignChannels", func(t *testing.T) { testSchemeStoreAssignChannels(t, ss) })
 }
 
 func createDefaultRoles(ss store.Store) {
@@ -663,3 +666,103 @@ func testCountWithoutPermission(t *testing.T, ss store.Store) {
 		require.Equal(t, int64(test.expectChannelSchemeChannelGuestCount), count)
 	}
 }
+
+func testSchemeStoreAssignTeams(t *testing.T, ss store.Store) {
+	scheme, err := ss.Scheme().Save(&model.Scheme{
+		DisplayName: model.NewId(),
+		Name:        model.NewId(),
+		Scope:       model.SchemeScopeTeam,
+	})
+	require.NoError(t, err)
+
+	var teamIDs []string
+	for i := 0; i < 3; i++ {
+		team, err := ss.Team().Save(&model.Team{
+			DisplayName: model.NewId(),
+			Name:        "zz" + model.NewId(),
+			Email:       MakeEmail(),
+			Type:        model.TeamOpen,
+		})
+		require.NoError(t, err)
+		teamIDs = append(teamIDs, team.Id)
+	}
+
+	t.Run("unknown team rolls back", func(t *testing.T) {
+		err := ss.Scheme().AssignTeams(scheme.Id, append([]string{model.NewId()}, teamIDs...))
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		for _, teamID := range teamIDs {
+			team, err := ss.Team().Get(teamID)
+			require.NoError(t, err)
+			assert.Nil(t, team.SchemeId)
+		}
+	})
+
+	t.Run("assign and unassign", func(t *testing.T) {
+		require.NoError(t, ss.Scheme().AssignTeams(scheme.Id, teamIDs))
+		for _, teamID := range teamIDs {
+			team, err := ss.Team().Get(teamID)
+			require.NoError(t, err)
+			require.NotNil(t, team.SchemeId)
+			assert.Equal(t, scheme.Id, *team.SchemeId)
+		}
+
+		require.NoError(t, ss.Scheme().AssignTeams("", teamIDs[:1]))
+		team, err := ss.Team().Get(teamIDs[0])
+		require.NoError(t, err)
+		require.NotNil(t, team.SchemeId)
+		assert.Equal(t, "", *team.SchemeId)
+	})
+}
+
+func testSchemeStoreAssignChannels(t *testing.T, ss store.Store) {
+	scheme, err := ss.Scheme().Save(&model.Scheme{
+		DisplayName: model.NewId(),
+		Name:        model.NewId(),
+		Scope:       model.SchemeScopeChannel,
+	})
+	require.NoError(t, err)
+
+	teamID := model.NewId()
+	var channelIDs []string
+	for _, channelType := range []model.ChannelType{model.ChannelTypeOpen, model.ChannelTypePrivate} {
+		channel, err := ss.Channel().Save(&model.Channel{
+			TeamId:      teamID,
+			DisplayName: model.NewId(),
+			Name:        "zz" + model.NewId(),
+			Type:        channelType,
+		}, -1)
+		require.NoError(t, err)
+		channelIDs = append(channelIDs, channel.Id)
+	}
+
+	dm, err := ss.Channel().Save(&model.Channel{
+		DisplayName: model.NewId(),
+		Name:        model.GetDMNameFromIds(model.NewId(), model.NewId()),
+		Type:        model.ChannelTypeDirect,
+	}, -1)
+	require.NoError(t, err)
+
+	t.Run("direct channel rolls back", func(t *testing.T) {
+		err := ss.Scheme().AssignChannels(scheme.Id, append(channelIDs, dm.Id))
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		for _, channelID := range channelIDs {
+			channel, err := ss.Channel().Get(channelID, false)
+			require.NoError(t, err)
+			assert.Nil(t, channel.SchemeId)
+		}
+	})
+
+	t.Run("assign", func(t *testing.T) {
+		require.NoError(t, ss.Scheme().AssignChannels(scheme.Id, channelIDs))
+		for _, channelID := range channelIDs {
+			channel, err := ss.Channel().Get(channelID, false)
+			require.NoError(t, err)
+			require.NotNil(t, channel.SchemeId)
+			assert.Equal(t, scheme.Id, *channel.SchemeId)
+		}
+	})
+}
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 	"time"
@@ -43,6 +44,10 @@ func TestSharedChannelStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("UpsertSharedChannelAttachment", func(t *testing.T) { testUpsertSharedChannelAttachment(t, ss) })
 	t.Run("GetSharedChannelAttachment", func(t *testing.T) { testGetSharedChannelAttachment(t, ss) })
 	t.Run("UpdateSharedChannelAttachmentLastSyncAt", func(t *testing.T) { testUpdateSharedChannelAttachmentLastSyncAt(t, ss) })
+
+	t.Run("SaveSharedChannelInvite", func(t *testing.T) { testSaveSharedChannelInvite(t, ss) })
+	t.Run("GetSharedChannelInvites", func(t *testing.T) { testGetSharedChannelInvites(t, ss) })
+	t.Run("UpdateSharedChannelInviteStatus", func(t *testing.T) { testUpdateSharedChannelInviteStatus(t, ss) })
 }
 
 func testSaveSharedChannel(t *testing.T, ss store.Store) {
@@ -1275,3 +1280,118 @@ func testUpdateSharedChannelAttachmentLastSyncAt(t *testing.T, ss store.Store) {
 		require.Error(t, err, "update non-existent attachment should error", err)
 	})
 }
+
+func testSaveSharedChannelInvite(t *testing.T, ss store.Store) {
+	t.Run("Save shared channel invite", func(t *testing.T) {
+		invite := &model.SharedChannelInvite{
+			RemoteId:    model.NewId(),
+			ChannelId:   model.NewId(),
+			TeamId:      model.NewId(),
+			Type:        model.ChannelTypeOpen,
+			Name:        "shared",
+			DisplayName: "Shared",
+			MemberCount: 12,
+			PostCount:   340,
+		}
+
+		saved, err := ss.SharedChannel().SaveInvite(invite)
+		require.NoError(t, err, "couldn't save shared channel invite", err)
+		require.Equal(t, model.SharedChannelInviteStatusPending, saved.Status)
+
+		got, err := ss.SharedChannel().GetInvite(saved.Id)
+		require.NoError(t, err)
+		require.Equal(t, saved, got)
+	})
+
+	t.Run("Save invalid shared channel invite", func(t *testing.T) {
+		_, err := ss.SharedChannel().SaveInvite(&model.SharedChannelInvite{RemoteId: "bogus"})
+		require.Error(t, err, "should error saving invalid invite", err)
+	})
+
+	t.Run("Get non-existent shared channel invite", func(t *testing.T) {
+		_, err := ss.SharedChannel().GetInvite(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testGetSharedChannelInvites(t *testing.T, ss store.Store) {
+	remoteId := model.NewId()
+	channelId := model.NewId()
+	var saved []*model.SharedChannelInvite
+	for i, status := range []string{model.SharedChannelInviteStatusPending, model.SharedChannelInviteStatusPending, model.SharedChannelInviteStatusDenied} {
+		invite, err := ss.SharedChannel().SaveInvite(&model.SharedChannelInvite{
+			RemoteId:  remoteId,
+			ChannelId: model.NewId(),
+			Status:    status,
+			CreateAt:  model.GetMillis() + int64(i),
+		})
+		require.NoError(t, err)
+		saved = append(saved, invite)
+	}
+	_, err := ss.SharedChannel().SaveInvite(&model.SharedChannelInvite{
+		RemoteId:  model.NewId(),
+		ChannelId: channelId,
+	})
+	require.NoError(t, err)
+
+	t.Run("Get invites of remote, most recent first", func(t *testing.T) {
+		invites, err := ss.SharedChannel().GetInvites(model.SharedChannelInviteFilterOpts{RemoteId: remoteId}, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, invites, 3)
+		require.Equal(t, saved[2].Id, invites[0].Id)
+		require.Equal(t, saved[0].Id, invites[2].Id)
+	})
+
+	t.Run("Get pending invites of remote", func(t *testing.T) {
+		invites, err := ss.SharedChannel().GetInvites(model.SharedChannelInviteFilterOpts{RemoteId: remoteId, Status: model.SharedChannelInviteStatusPending}, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, invites, 2)
+	})
+
+	t.Run("Get invites for channel", func(t *testing.T) {
+		invites, err := ss.SharedChannel().GetInvites(model.SharedChannelInviteFilterOpts{ChannelId: channelId}, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+	})
+
+	t.Run("Get invites paginated", func(t *testing.T) {
+		invites, err := ss.SharedChannel().GetInvites(model.SharedChannelInviteFilterOpts{RemoteId: remoteId}, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+		require.Equal(t, saved[1].Id, invites[0].Id)
+	})
+}
+
+func testUpdateSharedChannelInviteStatus(t *testing.T, ss store.Store) {
+	invite, err := ss.SharedChannel().SaveInvite(&model.SharedChannelInvite{
+		RemoteId:  model.NewId(),
+		ChannelId: model.NewId(),
+	})
+	require.NoError(t, err)
+	userId := model.NewId()
+
+	t.Run("Approve pending invite", func(t *testing.T) {
+		updated, err := ss.SharedChannel().UpdateInviteStatus(invite.Id, model.SharedChannelInviteStatusApproved, userId)
+		require.NoError(t, err)
+		require.Equal(t, model.SharedChannelInviteStatusApproved, updated.Status)
+		require.Equal(t, userId, updated.DecidedBy)
+		require.NotZero(t, updated.DecidedAt)
+	})
+
+	t.Run("Deny decided invite", func(t *testing.T) {
+		_, err := ss.SharedChannel().UpdateInviteStatus(invite.Id, model.SharedChannelInviteStatusDenied, userId)
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+
+		got, err := ss.SharedChannel().GetInvite(invite.Id)
+		require.NoError(t, err)
+		require.Equal(t, model.SharedChannelInviteStatusApproved, got.Status)
+	})
+
+	t.Run("Update non-existent invite", func(t *testing.T) {
+		_, err := ss.SharedChannel().UpdateInviteStatus(model.NewId(), model.SharedChannelInviteStatusDenied, userId)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
@@ -16,44 +16,84 @@ import (
 
 // Store can be used to provide mock stores for testing.
 type Store struct {
-	TeamStore                 mocks.TeamStore
-	ChannelStore              mocks.ChannelStore
-	PostStore                 mocks.PostStore
-	UserStore                 mocks.UserStore
-	RetentionPolicyStore      mocks.RetentionPolicyStore
-	BotStore                  mocks.BotStore
-	AuditStore                mocks.AuditStore
-	ClusterDiscoveryStore     mocks.ClusterDiscoveryStore
-	RemoteClusterStore        mocks.RemoteClusterStore
-	ComplianceStore           mocks.ComplianceStore
-	SessionStore              mocks.SessionStore
-	OAuthStore                mocks.OAuthStore
-	SystemStore               mocks.SystemStore
-	WebhookStore              mocks.WebhookStore
-	CommandStore              mocks.CommandStore
-	CommandWebhookStore       mocks.CommandWebhookStore
-	PreferenceStore           mocks.PreferenceStore
-	LicenseStore              mocks.LicenseStore
-	TokenStore                mocks.TokenStore
-	EmojiStore                mocks.EmojiStore
-	ThreadStore               mocks.ThreadStore
-	StatusStore               mocks.StatusStore
-	FileInfoStore             mocks.FileInfoStore
-	UploadSessionStore        mocks.UploadSessionStore
-	ReactionStore             mocks.ReactionStore
-	JobStore                  mocks.JobStore
-	UserAccessTokenStore      mocks.UserAccessTokenStore
-	PluginStore               mocks.PluginStore
-	ChannelMemberHistoryStore mocks.ChannelMemberHistoryStore
-	RoleStore                 mocks.RoleStore
-	SchemeStore               mocks.SchemeStore
-	TermsOfServiceStore       mocks.TermsOfServiceStore
-	GroupStore                mocks.GroupStore
-	UserTermsOfServiceStore   mocks.UserTermsOfServiceStore
-	LinkMetadataStore         mocks.LinkMetadataStore
-	SharedChannelStore        mocks.SharedChannelStore
-	ProductNoticesStore       mocks.ProductNoticesStore
-	context                   context.Context
+	TeamStore                    mocks.TeamStore
+	ChannelStore                 mocks.ChannelStore
+	PostStore                    mocks.PostStore
+	UserStore                    mocks.UserStore
+	RetentionPolicyStore         mocks.RetentionPolicyStore
+	BotStore                     mocks.BotStore
+	AuditStore                   mocks.AuditStore
+	ClusterDiscoveryStore        mocks.ClusterDiscoveryStore
+	RemoteClusterStore           mocks.RemoteClusterStore
+	ComplianceStore              mocks.ComplianceStore
+	SessionStore                 mocks.SessionStore
+	OAuthStore                   mocks.OAuthStore
+	SystemStore                  mocks.SystemStore
+	WebhookStore                 mocks.WebhookStore
+	CommandStore                 mocks.CommandStore
+	CommandWebhookStore          mocks.CommandWebhookStore
+	PreferenceStore              mocks.PreferenceStore
+	LicenseStore                 mocks.LicenseStore
+	TokenStore                   mocks.TokenStore
+	EmojiStore                   mocks.EmojiStore
+	ThreadStore                  mocks.ThreadStore
+	StatusStore                  mocks.StatusStore
+	FileInfoStore                mocks.FileInfoStore
+	UploadSessionStore           mocks.UploadSessionStore
+	ReactionStore                mocks.ReactionStore
+	JobStore                     mocks.JobStore
+	UserAccessTokenStore         mocks.UserAccessTokenStore
+	PluginStore                  mocks.PluginStore
+	ChannelMemberHistoryStore    mocks.ChannelMemberHistoryStore
+	RoleStore                    mocks.RoleStore
+	SchemeStore                  mocks.SchemeStore
+	TermsOfServiceStore          mocks.TermsOfServiceStore
+	GroupStore                   mocks.GroupStore
+	UserTermsOfServiceStore      mocks.UserTermsOfServiceStore
+	LinkMetadataStore            mocks.LinkMetadataStore
+	SharedChannelStore           mocks.SharedChannelStore
+	ProductNoticesStore          mocks.ProductNoticesStore
+	APIUsageStore                mocks.APIUsageStore
+	PostTaskStore                mocks.PostTaskStore
+	ChannelDigestStore           mocks.ChannelDigestStore
+	DirectChannelRetentionStore  mocks.DirectChannelRetentionStore
+	PostPriorityStore            mocks.PostPriorityStore
+	PostAcknowledgementStore     mocks.PostAcknowledgementStore
+	TeamBannerStore              mocks.TeamBannerStore
+	EmailSuppressionStore        mocks.EmailSuppressionStore
+	CannedResponseStore          mocks.CannedResponseStore
+	TeamRequestStore             mocks.TeamRequestStore
+	TeamStatsStore               mocks.TeamStatsStore
+	UserMergeStore               mocks.UserMergeStore
+	TeamDeletionStore            mocks.TeamDeletionStore
+	IdempotencyKeyStore          mocks.IdempotencyKeyStore
+	ChannelArchivePolicyStore    mocks.ChannelArchivePolicyStore
+	PermissionDenialStore        mocks.PermissionDenialStore
+	TeamAliasStore               mocks.TeamAliasStore
+	BotTokenRotationStore        mocks.BotTokenRotationStore
+	ChannelCommandOverrideStore  mocks.ChannelCommandOverrideStore
+	ImpersonationStore           mocks.ImpersonationStore
+	ScheduledChannelMessageStore mocks.ScheduledChannelMessageStore
+	EventWebhookStore            mocks.EventWebhookStore
+	ConfigHistoryStore           mocks.ConfigHistoryStore
+	UploadUsageStore             mocks.UploadUsageStore
+	ChannelEventStore            mocks.ChannelEventStore
+	AlertRuleStore               mocks.AlertRuleStore
+	ChannelLanguageStatsStore    mocks.ChannelLanguageStatsStore
+	TeamInviteUsageStore         mocks.TeamInviteUsageStore
+	PostModerationStore          mocks.PostModerationStore
+	PostReportStore              mocks.PostReportStore
+	ActivityEventStore           mocks.ActivityEventStore
+	AuditLogStore                mocks.AuditLogStore
+	LicenseUsageStore            mocks.LicenseUsageStore
+	CustomStatusTemplateStore    mocks.CustomStatusTemplateStore
+	RESTProxyBridgeStore         mocks.RESTProxyBridgeStore
+	ChannelFeedStore             mocks.ChannelFeedStore
+	OutstandingMentionStore      mocks.OutstandingMentionStore
+	BotStateStore                mocks.BotStateStore
+	OAuthAppUsageStore           mocks.OAuthAppUsageStore
+	TeamGroupRoleStore           mocks.TeamGroupRoleStore
+	context                      context.Context
 }
 
 func (s *Store) SetContext(context context.Context)                { s.context = context }
@@ -94,9 +134,90 @@ func (s *Store) UserTermsOfService() store.UserTermsOfServiceStore { return &s.U
 func (s *Store) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return &s.ChannelMemberHistoryStore
 }
+func (s *Store) ChannelArchivePolicy() store.ChannelArchivePolicyStore {
+	return &s.ChannelArchivePolicyStore
+}
 func (s *Store) Group() store.GroupStore                 { return &s.GroupStore }
 func (s *Store) LinkMetadata() store.LinkMetadataStore   { return &s.LinkMetadataStore }
 func (s *Store) SharedChannel() store.SharedChannelStore { return &s.SharedChannelStore }
+func (s *Store) APIUsage() store.APIUsageStore           { return &s.APIUsageStore }
+func (s *Store) PostTask() store.PostTaskStore           { return &s.PostTaskStore }
+func (s *Store) ChannelDigest() store.ChannelDigestStore { return &s.ChannelDigestStore }
+func (s *Store) DirectChannelRetention() store.DirectChannelRetentionStore {
+	return &s.DirectChannelRetentionStore
+}
+func (s *Store) PostPriority() store.PostPriorityStore { return &s.PostPriorityStore }
+func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
+	return &s.PostAcknowledgementStore
+}
+func (s *Store) TeamBanner() store.TeamBannerStore             { return &s.TeamBannerStore }
+func (s *Store) EmailSuppression() store.EmailSuppressionStore { return &s.EmailSuppressionStore }
+func (s *Store) CannedResponse() store.CannedResponseStore     { return &s.CannedResponseStore }
+func (s *Store) TeamRequest() store.TeamRequestStore           { return &s.TeamRequestStore }
+func (s *Store) TeamStats() store.TeamStatsStore               { return &s.TeamStatsStore }
+func (s *Store) UserMerge() store.UserMergeStore               { return &s.UserMergeStore }
+func (s *Store) TeamDeletion() store.TeamDeletionStore         { return &s.TeamDeletionStore }
+func (s *Store) IdempotencyKey() store.IdempotencyKeyStore     { return &s.IdempotencyKeyStore }
+func (s *Store) PermissionDenial() store.PermissionDenialStore { return &s.PermissionDenialStore }
+func (s *Store) TeamAlias() store.TeamAliasStore               { return &s.TeamAliasStore }
+func (s *Store) BotTokenRotation() store.BotTokenRotationStore { return &s.BotTokenRotationStore }
+func (s *Store) ChannelCommandOverride() store.ChannelCommandOverrideStore {
+	return &s.ChannelCommandOverrideStore
+}
+func (s *Store) Impersonation() store.ImpersonationStore { return &s.ImpersonationStore }
+func (s *Store) ScheduledChannelMessage() store.ScheduledChannelMessageStore {
+	return &s.ScheduledChannelMessageStore
+}
+func (s *Store) ChannelLanguageStats() store.ChannelLanguageStatsStore {
+	return &s.ChannelLanguageStatsStore
+}
+func (s *Store) TeamInviteUsage() store.TeamInviteUsageStore {
+	return &s.TeamInviteUsageStore
+}
+func (s *Store) PostModeration() store.PostModerationStore {
+	return &s.PostModerationStore
+}
+func (s *Store) PostReport() store.PostReportStore {
+	return &s.PostReportStore
+}
+func (s *Store) ActivityEvent() store.ActivityEventStore {
+	return &s.ActivityEventStore
+}
+func (s *Store) AuditLog() store.AuditLogStore {
+	return &s.AuditLogStore
+}
+func (s *Store) LicenseUsage() store.LicenseUsageStore {
+	return &s.LicenseUsageStore
+}
+func (s *Store) CustomStatusTemplate() store.CustomStatusTemplateStore {
+	return &s.CustomStatusTemplateStore
+}
+func (s *Store) RESTProxyBridge() store.RESTProxyBridgeStore {
+	return &s.RESTProxyBridgeStore
+}
+func (s *Store) ChannelFeed() store.ChannelFeedStore {
+	return &s.ChannelFeedStore
+}
+func (s *Store) OutstandingMention() store.OutstandingMentionStore {
+	return &s.OutstandingMentionStore
+}
+
+func (s *Store) BotState() store.BotStateStore {
+	return &s.BotStateStore
+}
+
+func (s *Store) OAuthAppUsage() store.OAuthAppUsageStore {
+	return &s.OAuthAppUsageStore
+}
+
+func (s *Store) TeamGroupRole() store.TeamGroupRoleStore {
+	return &s.TeamGroupRoleStore
+}
+func (s *Store) EventWebhook() store.EventWebhookStore   { return &s.EventWebhookStore }
+func (s *Store) ConfigHistory() store.ConfigHistoryStore { return &s.ConfigHistoryStore }
+func (s *Store) UploadUsage() store.UploadUsageStore     { return &s.UploadUsageStore }
+func (s *Store) ChannelEvent() store.ChannelEventStore   { return &s.ChannelEventStore }
+func (s *Store) AlertRule() store.AlertRuleStore         { return &s.AlertRuleStore }
 func (s *Store) MarkSystemRanUnitTests()                 { /* do nothing */ }
 func (s *Store) Close()                                  { /* do nothing */ }
 func (s *Store) LockToMaster()                           { /* do nothing */ }
@@ -113,6 +234,9 @@ func (s *Store) CheckIntegrity() <-chan model.IntegrityCheckResult {
 }
 func (s *Store) ReplicaLagAbs() error  { return nil }
 func (s *Store) ReplicaLagTime() error { return nil }
+func (s *Store) PingReplicas() []store.ReplicaPing {
+	return []store.ReplicaPing{}
+}
 
 func (s *Store) AssertExpectations(t mock.TestingT) bool {
 	return mock.AssertExpectationsForObjects(t,
@@ -148,5 +272,45 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.ThreadStore,
 		&s.ProductNoticesStore,
 		&s.SharedChannelStore,
+		&s.APIUsageStore,
+		&s.PostTaskStore,
+		&s.ChannelDigestStore,
+		&s.DirectChannelRetentionStore,
+		&s.PostPriorityStore,
+		&s.PostAcknowledgementStore,
+		&s.TeamBannerStore,
+		&s.EmailSuppressionStore,
+		&s.CannedResponseStore,
+		&s.TeamRequestStore,
+		&s.TeamStatsStore,
+		&s.UserMergeStore,
+		&s.TeamDeletionStore,
+		&s.IdempotencyKeyStore,
+		&s.ChannelArchivePolicyStore,
+		&s.PermissionDenialStore,
+		&s.TeamAliasStore,
+		&s.BotTokenRotationStore,
+		&s.ChannelCommandOverrideStore,
+		&s.ImpersonationStore,
+		&s.ScheduledChannelMessageStore,
+		&s.EventWebhookStore,
+		&s.ConfigHistoryStore,
+		&s.UploadUsageStore,
+		&s.ChannelEventStore,
+		&s.AlertRuleStore,
+		&s.ChannelLanguageStatsStore,
+		&s.TeamInviteUsageStore,
+		&s.PostModerationStore,
+		&s.PostReportStore,
+		&s.ActivityEventStore,
+		&s.AuditLogStore,
+		&s.LicenseUsageStore,
+		&s.CustomStatusTemplateStore,
+		&s.RESTProxyBridgeStore,
+		&s.ChannelFeedStore,
+		&s.OutstandingMentionStore,
+		&s.BotStateStore,
+		&s.OAuthAppUsageStore,
+		&s.TeamGroupRoleStore,
 	)
 }
@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamAliasStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testTeamAliasStoreSaveGet(t, ss) })
+	t.Run("GetForTeam", func(t *testing.T) { testTeamAliasStoreGetForTeam(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testTeamAliasStoreDelete(t, ss) })
+	t.Run("Expiry", func(t *testing.T) { testTeamAliasStoreExpiry(t, ss) })
+}
+
+func testTeamAliasStoreSaveGet(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	name := "alias-" + model.NewId()
+
+	_, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "login", TeamId: teamID})
+	var appErr *model.AppError
+	require.ErrorAs(t, err, &appErr)
+
+	saved, err := ss.TeamAlias().Save(&model.TeamAlias{Name: name, TeamId: teamID})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.CreateAt)
+
+	_, err = ss.TeamAlias().Save(&model.TeamAlias{Name: name, TeamId: model.NewId()})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	alias, err := ss.TeamAlias().Get(name)
+	require.NoError(t, err)
+	assert.Equal(t, saved, alias)
+
+	_, err = ss.TeamAlias().Get("alias-" + model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testTeamAliasStoreGetForTeam(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+
+	first, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID, CreateAt: 1000})
+	require.NoError(t, err)
+	second, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID, CreateAt: 2000})
+	require.NoError(t, err)
+	_, err = ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: model.NewId()})
+	require.NoError(t, err)
+
+	aliases, err := ss.TeamAlias().GetForTeam(teamID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TeamAlias{first, second}, aliases)
+
+	aliases, err = ss.TeamAlias().GetForTeam(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+}
+
+func testTeamAliasStoreDelete(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+
+	alias, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID})
+	require.NoError(t, err)
+	_, err = ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.TeamAlias().Delete(alias.Name))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.TeamAlias().Get(alias.Name)
+	require.ErrorAs(t, err, &nfErr)
+	require.ErrorAs(t, ss.TeamAlias().Delete(alias.Name), &nfErr)
+
+	require.NoError(t, ss.TeamAlias().DeleteForTeam(teamID))
+	aliases, err := ss.TeamAlias().GetForTeam(teamID)
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+}
+
+func testTeamAliasStoreExpiry(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	now := model.GetMillis()
+
+	active, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID, CreateAt: now - 2000, ExpireAt: now + 60000})
+	require.NoError(t, err)
+	expired, err := ss.TeamAlias().Save(&model.TeamAlias{Name: "alias-" + model.NewId(), TeamId: teamID, CreateAt: now - 2000, ExpireAt: now - 1000})
+	require.NoError(t, err)
+
+	alias, err := ss.TeamAlias().Get(active.Name)
+	require.NoError(t, err)
+	assert.Equal(t, active, alias)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.TeamAlias().Get(expired.Name)
+	require.ErrorAs(t, err, &nfErr)
+
+	aliases, err := ss.TeamAlias().GetForTeam(teamID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TeamAlias{active}, aliases)
+
+	// The name of an expired alias can be taken by another team.
+	_, err = ss.TeamAlias().Save(&model.TeamAlias{Name: active.Name, TeamId: model.NewId()})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	taken, err := ss.TeamAlias().Save(&model.TeamAlias{Name: expired.Name, TeamId: model.NewId()})
+	require.NoError(t, err)
+	alias, err = ss.TeamAlias().Get(expired.Name)
+	require.NoError(t, err)
+	assert.Equal(t, taken, alias)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamBannerStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testTeamBannerStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("GetScheduledBetween", func(t *testing.T) { testTeamBannerStoreGetScheduledBetween(t, ss) })
+	t.Run("Dismissals", func(t *testing.T) { testTeamBannerStoreDismissals(t, ss) })
+}
+
+func testTeamBannerStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+
+	banner, err := ss.TeamBanner().Save(&model.TeamBanner{
+		TeamId:    teamID,
+		CreatorId: model.NewId(),
+		Message:   "first",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, banner.Id)
+
+	other, err := ss.TeamBanner().Save(&model.TeamBanner{
+		TeamId:    teamID,
+		CreatorId: model.NewId(),
+		Message:   "second",
+		StartAt:   model.GetMillis() + 100000,
+	})
+	require.NoError(t, err)
+
+	t.Run("save with id", func(t *testing.T) {
+		_, err := ss.TeamBanner().Save(&model.TeamBanner{Id: model.NewId(), TeamId: teamID, CreatorId: model.NewId(), Message: "x"})
+		require.Error(t, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		got, err := ss.TeamBanner().Get(banner.Id)
+		require.NoError(t, err)
+		assert.Equal(t, banner, got)
+
+		banners, err := ss.TeamBanner().GetForTeam(teamID)
+		require.NoError(t, err)
+		require.Len(t, banners, 2)
+		assert.Equal(t, banner.Id, banners[0].Id)
+		assert.Equal(t, other.Id, banners[1].Id)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		banner.Message = "updated"
+		banner.AllowDismissal = true
+		_, err := ss.TeamBanner().Update(banner)
+		require.NoError(t, err)
+
+		got, err := ss.TeamBanner().Get(banner.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "updated", got.Message)
+		assert.True(t, got.AllowDismissal)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.TeamBanner().Delete(banner.Id, model.GetMillis()))
+
+		_, err := ss.TeamBanner().Get(banner.Id)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		banners, err := ss.TeamBanner().GetForTeam(teamID)
+		require.NoError(t, err)
+		require.Len(t, banners, 1)
+		assert.Equal(t, other.Id, banners[0].Id)
+
+		_, err = ss.TeamBanner().Update(banner)
+		require.True(t, errors.As(err, &nfErr))
+
+		err = ss.TeamBanner().Delete(banner.Id, model.GetMillis())
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testTeamBannerStoreGetScheduledBetween(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+
+	starting, err := ss.TeamBanner().Save(&model.TeamBanner{TeamId: model.NewId(), CreatorId: model.NewId(), Message: "starting", StartAt: now + 1000, EndAt: now + 100000})
+	require.NoError(t, err)
+	ending, err := ss.TeamBanner().Save(&model.TeamBanner{TeamId: model.NewId(), CreatorId: model.NewId(), Message: "ending", EndAt: now + 2000})
+	require.NoError(t, err)
+	_, err = ss.TeamBanner().Save(&model.TeamBanner{TeamId: model.NewId(), CreatorId: model.NewId(), Message: "later", StartAt: now + 50000})
+	require.NoError(t, err)
+
+	banners, err := ss.TeamBanner().GetScheduledBetween(now, now+2000)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(banners))
+	for _, banner := range banners {
+		ids = append(ids, banner.Id)
+	}
+	assert.ElementsMatch(t, []string{starting.Id, ending.Id}, ids)
+}
+
+func testTeamBannerStoreDismissals(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	bannerID1 := model.NewId()
+	bannerID2 := model.NewId()
+
+	require.NoError(t, ss.TeamBanner().SaveDismissal(&model.TeamBannerDismissal{BannerId: bannerID1, UserId: userID, DismissedAt: 1}))
+	require.NoError(t, ss.TeamBanner().SaveDismissal(&model.TeamBannerDismissal{BannerId: bannerID1, UserId: userID, DismissedAt: 2}))
+	require.NoError(t, ss.TeamBanner().SaveDismissal(&model.TeamBannerDismissal{BannerId: bannerID2, UserId: model.NewId(), DismissedAt: 1}))
+
+	dismissed, err := ss.TeamBanner().GetDismissedBannerIds(userID, []string{bannerID1, bannerID2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{bannerID1}, dismissed)
+
+	dismissed, err = ss.TeamBanner().GetDismissedBannerIds(userID, []string{})
+	require.NoError(t, err)
+	assert.Empty(t, dismissed)
+
+	userIDs, err := ss.TeamBanner().GetDismissingUserIds(bannerID1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{userID}, userIDs)
+}
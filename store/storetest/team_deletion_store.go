@@ -0,0 +1,81 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamDeletionStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdateDelete", func(t *testing.T) { testTeamDeletionStoreSaveGetUpdateDelete(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testTeamDeletionStoreGetAll(t, ss) })
+}
+
+func testTeamDeletionStoreSaveGetUpdateDelete(t *testing.T, ss store.Store) {
+	deletion, err := ss.TeamDeletion().Save(&model.TeamDeletion{
+		TeamId:      model.NewId(),
+		RequesterId: model.NewId(),
+		DeleteAt:    model.GetMillis() + 1000,
+	})
+	require.NoError(t, err)
+	defer ss.TeamDeletion().Delete(deletion.TeamId)
+
+	_, err = ss.TeamDeletion().Save(&model.TeamDeletion{
+		TeamId:      deletion.TeamId,
+		RequesterId: model.NewId(),
+		DeleteAt:    model.GetMillis() + 1000,
+	})
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr)
+
+	deletion.ExportPath = "team_deletions/export.jsonl"
+	deletion.ExportAt = model.GetMillis()
+	_, err = ss.TeamDeletion().Update(deletion)
+	require.NoError(t, err)
+
+	got, err := ss.TeamDeletion().Get(deletion.TeamId)
+	require.NoError(t, err)
+	assert.Equal(t, deletion, got)
+
+	err = ss.TeamDeletion().Delete(deletion.TeamId)
+	require.NoError(t, err)
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.TeamDeletion().Get(deletion.TeamId)
+	require.ErrorAs(t, err, &nfErr)
+
+	err = ss.TeamDeletion().Delete(deletion.TeamId)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testTeamDeletionStoreGetAll(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+	later, err := ss.TeamDeletion().Save(&model.TeamDeletion{
+		TeamId:      model.NewId(),
+		RequesterId: model.NewId(),
+		DeleteAt:    now + 2000,
+	})
+	require.NoError(t, err)
+	defer ss.TeamDeletion().Delete(later.TeamId)
+
+	sooner, err := ss.TeamDeletion().Save(&model.TeamDeletion{
+		TeamId:      model.NewId(),
+		RequesterId: model.NewId(),
+		DeleteAt:    now + 1000,
+	})
+	require.NoError(t, err)
+	defer ss.TeamDeletion().Delete(sooner.TeamId)
+
+	deletions, err := ss.TeamDeletion().GetAll()
+	require.NoError(t, err)
+	require.Len(t, deletions, 2)
+	assert.Equal(t, sooner.TeamId, deletions[0].TeamId)
+	assert.Equal(t, later.TeamId, deletions[1].TeamId)
+}
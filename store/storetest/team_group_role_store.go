@@ -0,0 +1,129 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamGroupRoleStore(t *testing.T, ss store.Store) {
+	t.Run("SaveAndGet", func(t *testing.T) { testTeamGroupRoleStoreSaveAndGet(t, ss) })
+	t.Run("GetMembers", func(t *testing.T) { testTeamGroupRoleStoreGetMembers(t, ss) })
+}
+
+func testTeamGroupRoleStoreSaveAndGet(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	groupID := model.NewId()
+
+	saved, err := ss.TeamGroupRole().Save(&model.TeamGroupRole{TeamId: teamID, GroupId: groupID, Role: model.TeamAdminRoleId})
+	require.NoError(t, err)
+	require.NotZero(t, saved.CreateAt)
+
+	_, err = ss.TeamGroupRole().Save(&model.TeamGroupRole{TeamId: teamID, GroupId: groupID, Role: model.TeamAdminRoleId})
+	require.NoError(t, err, "saving a mapping again updates it")
+
+	_, err = ss.TeamGroupRole().Save(&model.TeamGroupRole{TeamId: teamID, GroupId: model.NewId(), Role: model.TeamUserRoleId})
+	require.Error(t, err)
+
+	mappings, err := ss.TeamGroupRole().GetForTeam(teamID)
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+	assert.Equal(t, groupID, mappings[0].GroupId)
+
+	mappings, err = ss.TeamGroupRole().GetForGroup(groupID)
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+	assert.Equal(t, teamID, mappings[0].TeamId)
+
+	grantTeamID := model.NewId()
+	require.NoError(t, ss.TeamGroupRole().SaveGrants(grantTeamID, []string{model.NewId()}, model.GetMillis()))
+
+	teamIDs, err := ss.TeamGroupRole().GetTeamIDs()
+	require.NoError(t, err)
+	assert.Contains(t, teamIDs, teamID)
+	assert.Contains(t, teamIDs, grantTeamID, "teams with grants left are reconciled too")
+
+	require.NoError(t, ss.TeamGroupRole().Delete(teamID, groupID))
+	mappings, err = ss.TeamGroupRole().GetForTeam(teamID)
+	require.NoError(t, err)
+	assert.Empty(t, mappings)
+}
+
+func testTeamGroupRoleStoreGetMembers(t *testing.T, ss store.Store) {
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "Team",
+		Name:        NewTestId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	group, err := ss.Group().Create(&model.Group{
+		Name:        model.NewString(model.NewId()),
+		DisplayName: model.NewId(),
+		Source:      model.GroupSourceLdap,
+		RemoteId:    model.NewString(model.NewId()),
+	})
+	require.NoError(t, err)
+
+	newMember := func(admin, guest bool) string {
+		user, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId()})
+		require.NoError(t, err)
+		_, err = ss.Team().SaveMember(&model.TeamMember{TeamId: team.Id, UserId: user.Id, SchemeUser: !guest, SchemeGuest: guest, SchemeAdmin: admin}, -1)
+		require.NoError(t, err)
+		return user.Id
+	}
+
+	inGroup := newMember(false, false)
+	granted := newMember(true, false)
+	manual := newMember(true, false)
+	guest := newMember(false, true)
+	newMember(false, false)
+
+	for _, userID := range []string{inGroup, guest} {
+		_, err = ss.Group().UpsertMember(group.Id, userID)
+		require.NoError(t, err)
+	}
+	require.NoError(t, ss.TeamGroupRole().SaveGrants(team.Id, []string{granted}, model.GetMillis()))
+	require.NoError(t, ss.TeamGroupRole().SaveGrants(team.Id, []string{granted}, model.GetMillis()), "grants are kept when saved again")
+
+	members, err := ss.TeamGroupRole().GetMembers(team.Id)
+	require.NoError(t, err)
+	require.Len(t, members, 4, "guests can't be team admins")
+
+	byUser := make(map[string]*model.TeamGroupRoleMember)
+	for _, member := range members {
+		byUser[member.UserId] = member
+	}
+	assert.False(t, byUser[inGroup].InMappedGroup, "the group isn't mapped yet")
+
+	_, err = ss.TeamGroupRole().Save(&model.TeamGroupRole{TeamId: team.Id, GroupId: group.Id, Role: model.TeamAdminRoleId})
+	require.NoError(t, err)
+
+	members, err = ss.TeamGroupRole().GetMembers(team.Id)
+	require.NoError(t, err)
+	for _, member := range members {
+		byUser[member.UserId] = member
+	}
+	assert.Equal(t, model.TeamGroupRoleDriftMissing, byUser[inGroup].Drift())
+	assert.Equal(t, model.TeamGroupRoleDriftUnexpected, byUser[granted].Drift())
+	assert.Equal(t, model.TeamGroupRoleDriftManual, byUser[manual].Drift())
+
+	_, err = ss.Group().DeleteMember(group.Id, inGroup)
+	require.NoError(t, err)
+	require.NoError(t, ss.TeamGroupRole().DeleteGrants(team.Id, []string{granted}))
+
+	members, err = ss.TeamGroupRole().GetMembers(team.Id)
+	require.NoError(t, err)
+	for _, member := range members {
+		assert.False(t, member.InMappedGroup)
+		assert.False(t, member.Granted)
+	}
+}
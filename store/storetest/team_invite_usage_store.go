@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamInviteUsageStore(t *testing.T, ss store.Store) {
+	t.Run("IncrementGet", func(t *testing.T) { testTeamInviteUsageStoreIncrementGet(t, ss) })
+}
+
+func testTeamInviteUsageStoreIncrementGet(t *testing.T, ss store.Store) {
+	teamID := model.NewId()
+	today := model.TeamInviteUsageDay(model.GetMillis())
+	yesterday := today - int64(model.TeamInviteUsageBucketSize/time.Millisecond)
+
+	usage, err := ss.TeamInviteUsage().Get(teamID, today)
+	require.NoError(t, err)
+	assert.Equal(t, &model.TeamInviteUsage{TeamId: teamID, Day: today}, usage)
+
+	require.NoError(t, ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: yesterday, Invites: 100}))
+	require.NoError(t, ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: today, Invites: 10}))
+	require.NoError(t, ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: today, ExtraInvites: 20}))
+	require.NoError(t, ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: today, Invites: 5}))
+	require.NoError(t, ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: model.NewId(), Day: today, Invites: 1000}))
+
+	usage, err = ss.TeamInviteUsage().Get(teamID, today)
+	require.NoError(t, err)
+	assert.Equal(t, &model.TeamInviteUsage{TeamId: teamID, Day: today, Invites: 15, ExtraInvites: 20}, usage)
+
+	t.Run("invalid usage", func(t *testing.T) {
+		err := ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: today + 1, Invites: 10})
+		require.Error(t, err)
+
+		err = ss.TeamInviteUsage().Increment(&model.TeamInviteUsage{TeamId: teamID, Day: today})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamRequestStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGet", func(t *testing.T) { testTeamRequestStoreSaveGet(t, ss) })
+	t.Run("GetAll", func(t *testing.T) { testTeamRequestStoreGetAll(t, ss) })
+	t.Run("Review", func(t *testing.T) { testTeamRequestStoreReview(t, ss) })
+}
+
+func newTestTeamRequest() *model.TeamRequest {
+	return &model.TeamRequest{
+		RequesterId:   model.NewId(),
+		Name:          "z-" + model.NewId(),
+		DisplayName:   "Requested team",
+		Justification: "We need a place to work together.",
+	}
+}
+
+func testTeamRequestStoreSaveGet(t *testing.T, ss store.Store) {
+	request, err := ss.TeamRequest().Save(newTestTeamRequest())
+	require.NoError(t, err)
+	require.NotEmpty(t, request.Id)
+	assert.Equal(t, model.TeamRequestStatusPending, request.Status)
+
+	got, err := ss.TeamRequest().Get(request.Id)
+	require.NoError(t, err)
+	assert.Equal(t, request, got)
+
+	t.Run("save with id", func(t *testing.T) {
+		invalid := newTestTeamRequest()
+		invalid.Id = model.NewId()
+		_, err := ss.TeamRequest().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("get unknown", func(t *testing.T) {
+		_, err := ss.TeamRequest().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testTeamRequestStoreGetAll(t *testing.T, ss store.Store) {
+	first, err := ss.TeamRequest().Save(newTestTeamRequest())
+	require.NoError(t, err)
+	second, err := ss.TeamRequest().Save(newTestTeamRequest())
+	require.NoError(t, err)
+
+	second.Status = model.TeamRequestStatusDenied
+	second.ReviewerId = model.NewId()
+	_, err = ss.TeamRequest().Review(second)
+	require.NoError(t, err)
+
+	requests, err := ss.TeamRequest().GetAll("", 0, 1000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.Id)
+	}
+	assert.Contains(t, ids, first.Id)
+	assert.Contains(t, ids, second.Id)
+
+	requests, err = ss.TeamRequest().GetAll(model.TeamRequestStatusDenied, 0, 1000)
+	require.NoError(t, err)
+	for _, request := range requests {
+		assert.Equal(t, model.TeamRequestStatusDenied, request.Status)
+		assert.NotEqual(t, first.Id, request.Id)
+	}
+
+	requests, err = ss.TeamRequest().GetAll("", 0, 1)
+	require.NoError(t, err)
+	assert.Len(t, requests, 1)
+}
+
+func testTeamRequestStoreReview(t *testing.T, ss store.Store) {
+	request, err := ss.TeamRequest().Save(newTestTeamRequest())
+	require.NoError(t, err)
+
+	request.Status = model.TeamRequestStatusApproved
+	request.ReviewerId = model.NewId()
+	request.ReviewNote = "Enjoy"
+	request.TeamId = model.NewId()
+	reviewed, err := ss.TeamRequest().Review(request)
+	require.NoError(t, err)
+
+	got, err := ss.TeamRequest().Get(request.Id)
+	require.NoError(t, err)
+	assert.Equal(t, reviewed, got)
+
+	t.Run("already reviewed", func(t *testing.T) {
+		got.Status = model.TeamRequestStatusDenied
+		_, err := ss.TeamRequest().Review(got)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		unknown := newTestTeamRequest()
+		unknown.PreSave()
+		unknown.Status = model.TeamRequestStatusDenied
+		_, err := ss.TeamRequest().Review(unknown)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
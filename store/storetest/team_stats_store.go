@@ -0,0 +1,124 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestTeamStatsStore(t *testing.T, ss store.Store) {
+	t.Run("RollupDay", func(t *testing.T) { testTeamStatsStoreRollupDay(t, ss) })
+}
+
+func testTeamStatsStoreRollupDay(t *testing.T, ss store.Store) {
+	day := model.GetStartOfDayMillis(time.Date(2001, time.March, 4, 0, 0, 0, 0, time.UTC), 0)
+	nextDay := day + model.TeamStatsDayMillis
+
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "Stats",
+		Name:        "z-z-" + model.NewId() + "a",
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	newChannel := func() *model.Channel {
+		channel, nErr := ss.Channel().Save(&model.Channel{
+			TeamId:      team.Id,
+			DisplayName: "Stats",
+			Name:        "z-z-" + model.NewId() + "a",
+			Type:        model.ChannelTypeOpen,
+		}, -1)
+		require.NoError(t, nErr)
+		return channel
+	}
+	busyChannel := newChannel()
+	quietChannel := newChannel()
+
+	busyUser := model.NewId()
+	quietUser := model.NewId()
+
+	newPost := func(channelID, userID string, createAt int64) *model.Post {
+		post, nErr := ss.Post().Save(&model.Post{
+			ChannelId: channelID,
+			UserId:    userID,
+			Message:   "message",
+			CreateAt:  createAt,
+		})
+		require.NoError(t, nErr)
+		return post
+	}
+	withFile := newPost(busyChannel.Id, busyUser, day+1)
+	newPost(busyChannel.Id, busyUser, day+2)
+	newPost(busyChannel.Id, quietUser, day+3)
+	newPost(quietChannel.Id, busyUser, day+4)
+	// Posts of other days, and system posts, don't count.
+	newPost(quietChannel.Id, quietUser, nextDay)
+	newPost(quietChannel.Id, quietUser, day-1)
+	_, err = ss.Post().Save(&model.Post{
+		ChannelId: quietChannel.Id,
+		UserId:    quietUser,
+		Type:      model.PostTypeJoinChannel,
+		CreateAt:  day + 5,
+	})
+	require.NoError(t, err)
+
+	_, err = ss.FileInfo().Save(&model.FileInfo{
+		CreatorId: busyUser,
+		PostId:    withFile.Id,
+		Path:      "file.txt",
+		Size:      1024,
+		CreateAt:  day + 1,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.TeamStats().RollupDay(day))
+	// Rolling up a day again replaces its stats.
+	require.NoError(t, ss.TeamStats().RollupDay(day))
+
+	stats, err := ss.TeamStats().GetDailyStats(team.Id, day, nextDay)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, &model.TeamDailyStats{
+		TeamId:      team.Id,
+		Day:         day,
+		ActiveUsers: 2,
+		PostCount:   4,
+		FileCount:   1,
+		FileSize:    1024,
+	}, stats[0])
+
+	channelCounts, err := ss.TeamStats().GetChannelPostCounts(team.Id, day, nextDay, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TeamChannelPostCount{
+		{ChannelId: busyChannel.Id, PostCount: 3},
+		{ChannelId: quietChannel.Id, PostCount: 1},
+	}, channelCounts)
+
+	channelStats, err := ss.TeamStats().GetChannelDailyStats(busyChannel.Id, day-model.TeamStatsDayMillis, nextDay)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ChannelDailyStats{
+		{ChannelId: busyChannel.Id, Day: day, PostCount: 3, ActiveMembers: 2},
+	}, channelStats)
+
+	topPosters, err := ss.TeamStats().GetTopPosters(team.Id, day, nextDay, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TeamPosterCount{{UserId: busyUser, PostCount: 3}}, topPosters)
+
+	fileCount, fileSize, err := ss.TeamStats().GetFileUsage(team.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), fileCount)
+	assert.Equal(t, int64(1024), fileSize)
+
+	stats, err = ss.TeamStats().GetDailyStats(team.Id, nextDay, nextDay+model.TeamStatsDayMillis)
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
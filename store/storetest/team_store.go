@@ -31,6 +31,8 @@ func TestTeamStore(t *testing.T, ss store.Store) {
 	t.Run("Save", func(t *testing.T) { testTeamStoreSave(t, ss) })
 	t.Run("Update", func(t *testing.T) { testTeamStoreUpdate(t, ss) })
 	t.Run("Get", func(t *testing.T) { testTeamStoreGet(t, ss) })
+	t.Run("GetChildTeams", func(t *testing.T) { testTeamStoreGetChildTeams(t, ss) })
+	t.Run("ParentTeamCycles", func(t *testing.T) { testTeamStoreParentTeamCycles(t, ss) })
 	t.Run("GetByName", func(t *testing.T) { testTeamStoreGetByName(t, ss) })
 	t.Run("GetByNames", func(t *testing.T) { testTeamStoreGetByNames(t, ss) })
 	t.Run("SearchAll", func(t *testing.T) { testTeamStoreSearchAll(t, ss) })
@@ -106,6 +108,29 @@ func testTeamStoreUpdate(t *testing.T, ss store.Store) {
 	_, err = ss.Team().Update(&o1)
 	require.NoError(t, err)
 
+	o1.EmailVerified = true
+	_, err = ss.Team().Update(&o1)
+	require.NoError(t, err)
+	r1, err := ss.Team().Get(o1.Id)
+	require.NoError(t, err)
+	require.True(t, r1.EmailVerified)
+
+	o1.DataResidency = "eu"
+	_, err = ss.Team().Update(&o1)
+	require.NoError(t, err)
+	r1, err = ss.Team().Get(o1.Id)
+	require.NoError(t, err)
+	require.Equal(t, "eu", r1.DataResidency)
+	require.Nil(t, r1.StrictIsolation)
+
+	o1.StrictIsolation = model.NewBool(true)
+	_, err = ss.Team().Update(&o1)
+	require.NoError(t, err)
+	r1, err = ss.Team().Get(o1.Id)
+	require.NoError(t, err)
+	require.NotNil(t, r1.StrictIsolation)
+	require.True(t, *r1.StrictIsolation)
+
 	o1.Id = "missing"
 	_, err = ss.Team().Update(&o1)
 	require.Error(t, err, "Update should have failed because of missing key")
@@ -185,6 +210,151 @@ func testTeamStoreGetByNames(t *testing.T, ss store.Store) {
 	})
 }
 
+func testTeamStoreGetChildTeams(t *testing.T, ss store.Store) {
+	parent, err := ss.Team().Save(&model.Team{
+		DisplayName: "Parent",
+		Name:        NewTestId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	child1, err := ss.Team().Save(&model.Team{
+		DisplayName:  "B Child",
+		Name:         NewTestId(),
+		Email:        MakeEmail(),
+		Type:         model.TeamOpen,
+		ParentTeamId: parent.Id,
+	})
+	require.NoError(t, err)
+
+	child2, err := ss.Team().Save(&model.Team{
+		DisplayName:             "A Child",
+		Name:                    NewTestId(),
+		Email:                   MakeEmail(),
+		Type:                    model.TeamInvite,
+		ParentTeamId:            parent.Id,
+		InheritParentMembership: true,
+	})
+	require.NoError(t, err)
+
+	deletedChild, err := ss.Team().Save(&model.Team{
+		DisplayName:  "Deleted Child",
+		Name:         NewTestId(),
+		Email:        MakeEmail(),
+		Type:         model.TeamOpen,
+		ParentTeamId: parent.Id,
+	})
+	require.NoError(t, err)
+	deletedChild.DeleteAt = model.GetMillis()
+	_, err = ss.Team().Update(deletedChild)
+	require.NoError(t, err)
+
+	t.Run("returns the sub-teams ordered by display name", func(t *testing.T) {
+		children, err := ss.Team().GetChildTeams(parent.Id)
+		require.NoError(t, err)
+		require.Len(t, children, 2)
+		assert.Equal(t, child2.Id, children[0].Id)
+		assert.True(t, children[0].InheritParentMembership)
+		assert.Equal(t, child1.Id, children[1].Id)
+	})
+
+	t.Run("returns no teams for a team without sub-teams", func(t *testing.T) {
+		children, err := ss.Team().GetChildTeams(child1.Id)
+		require.NoError(t, err)
+		assert.Empty(t, children)
+	})
+
+	t.Run("detaches the sub-teams of a deleted team", func(t *testing.T) {
+		require.NoError(t, ss.Team().PermanentDelete(parent.Id))
+
+		team, err := ss.Team().Get(child1.Id)
+		require.NoError(t, err)
+		assert.Empty(t, team.ParentTeamId)
+	})
+}
+
+func testTeamStoreParentTeamCycles(t *testing.T, ss store.Store) {
+	var teams []*model.Team
+	for i := 0; i <= model.TeamMaxHierarchyDepth; i++ {
+		team := &model.Team{
+			DisplayName: "Level",
+			Name:        NewTestId(),
+			Email:       MakeEmail(),
+			Type:        model.TeamOpen,
+		}
+		if i > 0 {
+			team.ParentTeamId = teams[i-1].Id
+		}
+		team, err := ss.Team().Save(team)
+		require.NoError(t, err)
+		teams = append(teams, team)
+	}
+
+	t.Run("should not allow a team to be its own parent", func(t *testing.T) {
+		team := *teams[0]
+		team.ParentTeamId = team.Id
+		_, err := ss.Team().Update(&team)
+		require.Error(t, err)
+	})
+
+	t.Run("should not allow a team to be moved under one of its sub-teams", func(t *testing.T) {
+		team := *teams[1]
+		team.ParentTeamId = teams[3].Id
+		_, err := ss.Team().Update(&team)
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should not allow a missing parent team", func(t *testing.T) {
+		_, err := ss.Team().Save(&model.Team{
+			DisplayName:  "Orphan",
+			Name:         NewTestId(),
+			Email:        MakeEmail(),
+			Type:         model.TeamOpen,
+			ParentTeamId: model.NewId(),
+		})
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should not allow the hierarchy to grow too deep", func(t *testing.T) {
+		_, err := ss.Team().Save(&model.Team{
+			DisplayName:  "Too deep",
+			Name:         NewTestId(),
+			Email:        MakeEmail(),
+			Type:         model.TeamOpen,
+			ParentTeamId: teams[len(teams)-1].Id,
+		})
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+
+		other, err := ss.Team().Save(&model.Team{
+			DisplayName: "Other",
+			Name:        NewTestId(),
+			Email:       MakeEmail(),
+			Type:        model.TeamOpen,
+		})
+		require.NoError(t, err)
+
+		team := *teams[0]
+		team.ParentTeamId = other.Id
+		_, err = ss.Team().Update(&team)
+		require.True(t, errors.As(err, &invErr))
+	})
+
+	t.Run("should allow a team to be moved to the top of the hierarchy", func(t *testing.T) {
+		team := *teams[2]
+		team.ParentTeamId = ""
+		_, err := ss.Team().Update(&team)
+		require.NoError(t, err)
+
+		children, err := ss.Team().GetChildTeams(teams[1].Id)
+		require.NoError(t, err)
+		assert.Empty(t, children)
+	})
+}
+
 func testTeamStoreGetByName(t *testing.T, ss store.Store) {
 	o1 := model.Team{}
 	o1.DisplayName = "DisplayName"
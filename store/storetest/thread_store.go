@@ -25,6 +25,7 @@ func TestThreadStore(t *testing.T, ss store.Store, s SqlStore) {
 	t.Run("GetTeamsUnreadForUser", func(t *testing.T) { testGetTeamsUnreadForUser(t, ss) })
 	t.Run("GetThreadsForUser", func(t *testing.T) { testGetThreadsForUser(t, ss) })
 	t.Run("MarkAllAsReadByChannels", func(t *testing.T) { testMarkAllAsReadByChannels(t, ss) })
+	t.Run("GetSummary", func(t *testing.T) { testThreadStoreGetSummary(t, ss) })
 }
 
 func testThreadStorePopulation(t *testing.T, ss store.Store) {
@@ -974,3 +975,71 @@ func testMarkAllAsReadByChannels(t *testing.T, ss store.Store) {
 		assertThreadReplyCount(t, userBID, 0)
 	})
 }
+
+func testThreadStoreGetSummary(t *testing.T, ss store.Store) {
+	const hour = 60 * 60 * 1000
+	start := model.GetMillis() - model.GetMillis()%hour - 10*hour
+
+	channelID := model.NewId()
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+
+	root, err := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: userID1, Message: "root", CreateAt: start})
+	require.NoError(t, err)
+
+	newReply := func(userID string, createAt int64) *model.Post {
+		reply, nErr := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: userID, RootId: root.Id, Message: "reply", CreateAt: createAt})
+		require.NoError(t, nErr)
+		return reply
+	}
+	newReply(userID1, start+1)
+	newReply(userID2, start+2)
+	newReply(userID2, start+hour+1)
+	last := newReply(userID1, start+2*hour+1)
+	// Deleted replies don't count.
+	deleted := newReply(model.NewId(), start+3*hour)
+	require.NoError(t, ss.Post().Delete(deleted.Id, model.GetMillis(), userID1))
+
+	summary, err := ss.Thread().GetSummary(root.Id, hour, 2)
+	require.NoError(t, err)
+	assert.Equal(t, root.Id, summary.PostId)
+	assert.Equal(t, int64(4), summary.ReplyCount)
+	assert.Equal(t, last.CreateAt, summary.LastReplyAt)
+	assert.Equal(t, int64(hour), summary.BucketSize)
+
+	assert.Equal(t, []*model.ThreadParticipant{
+		{UserId: userID1, ReplyCount: 2, LastReplyAt: start + 2*hour + 1},
+		{UserId: userID2, ReplyCount: 2, LastReplyAt: start + hour + 1},
+	}, summary.Participants)
+
+	assert.Equal(t, []*model.ThreadReplyBucket{
+		{Start: start, ReplyCount: 2},
+		{Start: start + hour, ReplyCount: 1},
+		{Start: start + 2*hour, ReplyCount: 1},
+	}, summary.ReplyBuckets)
+
+	require.Len(t, summary.LastReplies.Order, 2)
+	assert.Equal(t, last.Id, summary.LastReplies.Order[0])
+	assert.Contains(t, summary.LastReplies.Posts, last.Id)
+
+	t.Run("day buckets", func(t *testing.T) {
+		summary, err := ss.Thread().GetSummary(root.Id, model.TeamStatsDayMillis, 0)
+		require.NoError(t, err)
+		var total int64
+		for _, bucket := range summary.ReplyBuckets {
+			assert.Zero(t, bucket.Start%model.TeamStatsDayMillis)
+			total += bucket.ReplyCount
+		}
+		assert.Equal(t, int64(4), total)
+		assert.Empty(t, summary.LastReplies.Order)
+	})
+
+	t.Run("no replies", func(t *testing.T) {
+		summary, err := ss.Thread().GetSummary(model.NewId(), hour, 2)
+		require.NoError(t, err)
+		assert.Zero(t, summary.ReplyCount)
+		assert.Empty(t, summary.Participants)
+		assert.Empty(t, summary.ReplyBuckets)
+		assert.Empty(t, summary.LastReplies.Order)
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestUploadUsageStore(t *testing.T, ss store.Store) {
+	t.Run("IncrementGetForUser", func(t *testing.T) { testUploadUsageStoreIncrementGetForUser(t, ss) })
+}
+
+func testUploadUsageStoreIncrementGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	today := model.UploadUsageDay(model.GetMillis())
+	yesterday := today - int64(model.UploadUsageBucketSize/time.Millisecond)
+
+	daily, total, err := ss.UploadUsage().GetForUser(userID, today)
+	require.NoError(t, err)
+	assert.Zero(t, daily)
+	assert.Zero(t, total)
+
+	require.NoError(t, ss.UploadUsage().Increment(&model.UploadUsage{UserId: userID, Day: yesterday, Bytes: 100}))
+	require.NoError(t, ss.UploadUsage().Increment(&model.UploadUsage{UserId: userID, Day: today, Bytes: 10}))
+	require.NoError(t, ss.UploadUsage().Increment(&model.UploadUsage{UserId: userID, Day: today, Bytes: 5}))
+	require.NoError(t, ss.UploadUsage().Increment(&model.UploadUsage{UserId: model.NewId(), Day: today, Bytes: 1000}))
+
+	daily, total, err = ss.UploadUsage().GetForUser(userID, today)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), daily)
+	assert.Equal(t, int64(115), total)
+
+	t.Run("invalid usage", func(t *testing.T) {
+		err := ss.UploadUsage().Increment(&model.UploadUsage{UserId: userID, Day: today + 1, Bytes: 10})
+		require.Error(t, err)
+
+		err = ss.UploadUsage().Increment(&model.UploadUsage{UserId: userID, Day: today})
+		require.Error(t, err)
+	})
+}
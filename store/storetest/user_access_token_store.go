@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost-server/v6/model"
@@ -17,6 +18,7 @@ func TestUserAccessTokenStore(t *testing.T, ss store.Store) {
 	t.Run("UserAccessTokenSaveGetDelete", func(t *testing.T) { testUserAccessTokenSaveGetDelete(t, ss) })
 	t.Run("UserAccessTokenDisableEnable", func(t *testing.T) { testUserAccessTokenDisableEnable(t, ss) })
 	t.Run("UserAccessTokenSearch", func(t *testing.T) { testUserAccessTokenSearch(t, ss) })
+	t.Run("UserAccessTokenExpiry", func(t *testing.T) { testUserAccessTokenExpiry(t, ss) })
 }
 
 func testUserAccessTokenSaveGetDelete(t *testing.T, ss store.Store) {
@@ -155,3 +157,41 @@ func testUserAccessTokenSearch(t *testing.T, ss store.Store) {
 	require.NoError(t, nErr)
 	require.Equal(t, 1, len(received), "received incorrect number of tokens after search")
 }
+
+func testUserAccessTokenExpiry(t *testing.T, ss store.Store) {
+	now := model.GetMillis()
+
+	expired, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: model.NewId(), Description: "expired", ExpiresAt: now - 1000})
+	require.NoError(t, err)
+	assert.NotZero(t, expired.CreateAt)
+	defer func() { require.NoError(t, ss.UserAccessToken().Delete(expired.Id)) }()
+
+	expiring, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: model.NewId(), Description: "expiring", ExpiresAt: now + 60*60*1000})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.UserAccessToken().Delete(expiring.Id)) }()
+
+	lasting, err := ss.UserAccessToken().Save(&model.UserAccessToken{Token: model.NewId(), UserId: model.NewId(), Description: "lasting"})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.UserAccessToken().Delete(lasting.Id)) }()
+
+	tokens, err := ss.UserAccessToken().GetExpired(now, 100)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, expired.Id, tokens[0].Id)
+
+	session, err := ss.Session().Save(&model.Session{UserId: expiring.UserId, Token: expiring.Token})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.UserAccessToken().UpdateExpiresAt(expiring.Id, now-1))
+
+	tokens, err = ss.UserAccessToken().GetExpired(now, 100)
+	require.NoError(t, err)
+	assert.Len(t, tokens, 2)
+
+	session, err = ss.Session().Get(context.Background(), session.Token)
+	require.NoError(t, err)
+	assert.Equal(t, now-1, session.ExpiresAt, "the sessions of the token expire with it")
+
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, ss.UserAccessToken().UpdateExpiresAt(model.NewId(), now), &nfErr)
+}
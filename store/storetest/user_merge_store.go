@@ -0,0 +1,159 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/store"
+)
+
+func TestUserMergeStore(t *testing.T, ss store.Store) {
+	t.Run("SaveGetUpdate", func(t *testing.T) { testUserMergeStoreSaveGetUpdate(t, ss) })
+	t.Run("MoveRows", func(t *testing.T) { testUserMergeStoreMoveRows(t, ss) })
+	t.Run("MergeRows", func(t *testing.T) { testUserMergeStoreMergeRows(t, ss) })
+}
+
+func testUserMergeStoreSaveGetUpdate(t *testing.T, ss store.Store) {
+	merge, err := ss.UserMerge().Save(&model.UserMerge{
+		PrimaryUserId:   model.NewId(),
+		DuplicateUserId: model.NewId(),
+		RequesterId:     model.NewId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.UserMergeStatusPending, merge.Status)
+
+	_, err = ss.UserMerge().Save(merge)
+	require.Error(t, err)
+
+	merge.JobId = model.NewId()
+	merge.Status = model.UserMergeStatusSuccess
+	merge.Manifest[model.UserMergeTablePosts] = []string{model.NewId()}
+	_, err = ss.UserMerge().Update(merge)
+	require.NoError(t, err)
+
+	got, err := ss.UserMerge().Get(merge.Id)
+	require.NoError(t, err)
+	assert.Equal(t, merge, got)
+
+	_, err = ss.UserMerge().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testUserMergeStoreMoveRows(t *testing.T, ss store.Store) {
+	primaryID := model.NewId()
+	duplicateID := model.NewId()
+
+	channelID := model.NewId()
+	post, err := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: duplicateID, Message: "duplicate"})
+	require.NoError(t, err)
+	primaryPost, err := ss.Post().Save(&model.Post{ChannelId: channelID, UserId: primaryID, Message: "primary"})
+	require.NoError(t, err)
+
+	moved, err := ss.UserMerge().MoveRows(model.UserMergeTablePosts, duplicateID, primaryID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{post.Id}, moved)
+
+	post, err = ss.Post().GetSingle(post.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, primaryID, post.UserId)
+
+	t.Run("revert only moves the given rows back", func(t *testing.T) {
+		moved, err := ss.UserMerge().MoveRows(model.UserMergeTablePosts, primaryID, duplicateID, []string{post.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{post.Id}, moved)
+
+		post, err = ss.Post().GetSingle(post.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, duplicateID, post.UserId)
+
+		primaryPost, err = ss.Post().GetSingle(primaryPost.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, primaryID, primaryPost.UserId)
+
+		moved, err = ss.UserMerge().MoveRows(model.UserMergeTablePosts, primaryID, duplicateID, []string{})
+		require.NoError(t, err)
+		assert.Empty(t, moved)
+	})
+
+	t.Run("rows the primary user already has are left out", func(t *testing.T) {
+		require.NoError(t, ss.Preference().Save(model.Preferences{
+			{UserId: duplicateID, Category: model.PreferenceCategoryDisplaySettings, Name: "shared", Value: "duplicate"},
+			{UserId: duplicateID, Category: model.PreferenceCategoryDisplaySettings, Name: "only:duplicate", Value: "duplicate"},
+			{UserId: primaryID, Category: model.PreferenceCategoryDisplaySettings, Name: "shared", Value: "primary"},
+		}))
+
+		moved, err := ss.UserMerge().MoveRows(model.UserMergeTablePreferences, duplicateID, primaryID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{model.JoinUserMergeKey(model.PreferenceCategoryDisplaySettings, "only:duplicate")}, moved)
+
+		preference, err := ss.Preference().Get(primaryID, model.PreferenceCategoryDisplaySettings, "shared")
+		require.NoError(t, err)
+		assert.Equal(t, "primary", preference.Value)
+		_, err = ss.Preference().Get(primaryID, model.PreferenceCategoryDisplaySettings, "only:duplicate")
+		require.NoError(t, err)
+		_, err = ss.Preference().Get(duplicateID, model.PreferenceCategoryDisplaySettings, "shared")
+		require.NoError(t, err)
+
+		_, err = ss.UserMerge().MoveRows(model.UserMergeTablePreferences, primaryID, duplicateID, moved)
+		require.NoError(t, err)
+		_, err = ss.Preference().Get(duplicateID, model.PreferenceCategoryDisplaySettings, "only:duplicate")
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown table", func(t *testing.T) {
+		_, err := ss.UserMerge().MoveRows("Users", duplicateID, primaryID, nil)
+		var invErr *store.ErrInvalidInput
+		require.ErrorAs(t, err, &invErr)
+	})
+}
+
+func testUserMergeStoreMergeRows(t *testing.T, ss store.Store) {
+	primary, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), Roles: model.SystemUserRoleId + " " + model.SystemUserManagerRoleId})
+	require.NoError(t, err)
+	duplicate, err := ss.User().Save(&model.User{Email: MakeEmail(), Username: model.NewId(), Roles: model.SystemUserRoleId})
+	require.NoError(t, err)
+
+	merge, err := ss.UserMerge().Save(&model.UserMerge{
+		PrimaryUserId:   primary.Id,
+		DuplicateUserId: duplicate.Id,
+		RequesterId:     model.NewId(),
+	})
+	require.NoError(t, err)
+
+	session, err := ss.Session().Save(&model.Session{UserId: duplicate.Id, Roles: duplicate.Roles})
+	require.NoError(t, err)
+
+	merge, err = ss.UserMerge().MergeRows(merge, model.UserMergeTableSessions)
+	require.NoError(t, err)
+	assert.Equal(t, []string{session.Id}, merge.Manifest[model.UserMergeTableSessions])
+
+	got, err := ss.UserMerge().Get(merge.Id)
+	require.NoError(t, err)
+	assert.Equal(t, merge.Manifest, got.Manifest, "the manifest is saved along with the moved rows")
+
+	session, err = ss.Session().Get(context.Background(), session.Id)
+	require.NoError(t, err)
+	assert.Equal(t, primary.Id, session.UserId)
+	assert.Equal(t, primary.Roles, session.Roles, "sessions take the roles of the user they are moved to")
+
+	_, err = ss.UserMerge().MoveRows(model.UserMergeTableSessions, primary.Id, duplicate.Id, merge.Manifest[model.UserMergeTableSessions])
+	require.NoError(t, err)
+	session, err = ss.Session().Get(context.Background(), session.Id)
+	require.NoError(t, err)
+	assert.Equal(t, duplicate.Id, session.UserId)
+	assert.Equal(t, duplicate.Roles, session.Roles)
+
+	t.Run("unknown table", func(t *testing.T) {
+		_, err := ss.UserMerge().MergeRows(merge, "Users")
+		var invErr *store.ErrInvalidInput
+		require.ErrorAs(t, err, &invErr)
+	})
+}
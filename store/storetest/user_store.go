@@ -1580,6 +1580,30 @@ func testUserStoreGetProfilesByIds(t *testing.T, ss store.Store) {
 		// u3 comes from the cache, and u4 does not
 		assert.Equal(t, []*model.User{u3, u4}, users)
 	})
+
+	t.Run("should only fetch the selected fields", func(t *testing.T) {
+		users, err := ss.User().GetProfileByIds(context.Background(), []string{u1.Id, u3.Id}, &store.UserGetByIdsOpts{
+			Fields: []string{"username", "is_bot", "username"},
+		}, true)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.User{
+			{Id: u1.Id, Username: u1.Username},
+			{Id: u3.Id, Username: u3.Username, IsBot: true},
+		}, users)
+
+		// The users fetched with some of their fields only aren't cached.
+		users, err = ss.User().GetProfileByIds(context.Background(), []string{u1.Id}, nil, true)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.User{u1}, users)
+	})
+
+	t.Run("should fail for an unknown field", func(t *testing.T) {
+		_, err := ss.User().GetProfileByIds(context.Background(), []string{u1.Id}, &store.UserGetByIdsOpts{
+			Fields: []string{"password"},
+		}, false)
+		var invErr *store.ErrInvalidInput
+		require.True(t, errors.As(err, &invErr))
+	})
 }
 
 func testUserStoreGetProfileByGroupChannelIdsForUser(t *testing.T, ss store.Store) {
@@ -17,58 +17,150 @@ import (
 
 type TimerLayer struct {
 	store.Store
-	Metrics                   einterfaces.MetricsInterface
-	AuditStore                store.AuditStore
-	BotStore                  store.BotStore
-	ChannelStore              store.ChannelStore
-	ChannelMemberHistoryStore store.ChannelMemberHistoryStore
-	ClusterDiscoveryStore     store.ClusterDiscoveryStore
-	CommandStore              store.CommandStore
-	CommandWebhookStore       store.CommandWebhookStore
-	ComplianceStore           store.ComplianceStore
-	EmojiStore                store.EmojiStore
-	FileInfoStore             store.FileInfoStore
-	GroupStore                store.GroupStore
-	JobStore                  store.JobStore
-	LicenseStore              store.LicenseStore
-	LinkMetadataStore         store.LinkMetadataStore
-	OAuthStore                store.OAuthStore
-	PluginStore               store.PluginStore
-	PostStore                 store.PostStore
-	PreferenceStore           store.PreferenceStore
-	ProductNoticesStore       store.ProductNoticesStore
-	ReactionStore             store.ReactionStore
-	RemoteClusterStore        store.RemoteClusterStore
-	RetentionPolicyStore      store.RetentionPolicyStore
-	RoleStore                 store.RoleStore
-	SchemeStore               store.SchemeStore
-	SessionStore              store.SessionStore
-	SharedChannelStore        store.SharedChannelStore
-	StatusStore               store.StatusStore
-	SystemStore               store.SystemStore
-	TeamStore                 store.TeamStore
-	TermsOfServiceStore       store.TermsOfServiceStore
-	ThreadStore               store.ThreadStore
-	TokenStore                store.TokenStore
-	UploadSessionStore        store.UploadSessionStore
-	UserStore                 store.UserStore
-	UserAccessTokenStore      store.UserAccessTokenStore
-	UserTermsOfServiceStore   store.UserTermsOfServiceStore
-	WebhookStore              store.WebhookStore
+	Metrics                      einterfaces.MetricsInterface
+	APIUsageStore                store.APIUsageStore
+	ActivityEventStore           store.ActivityEventStore
+	AlertRuleStore               store.AlertRuleStore
+	AuditStore                   store.AuditStore
+	AuditLogStore                store.AuditLogStore
+	BotStore                     store.BotStore
+	BotStateStore                store.BotStateStore
+	BotTokenRotationStore        store.BotTokenRotationStore
+	CannedResponseStore          store.CannedResponseStore
+	ChannelStore                 store.ChannelStore
+	ChannelArchivePolicyStore    store.ChannelArchivePolicyStore
+	ChannelCommandOverrideStore  store.ChannelCommandOverrideStore
+	ChannelDigestStore           store.ChannelDigestStore
+	ChannelEventStore            store.ChannelEventStore
+	ChannelFeedStore             store.ChannelFeedStore
+	ChannelLanguageStatsStore    store.ChannelLanguageStatsStore
+	ChannelMemberHistoryStore    store.ChannelMemberHistoryStore
+	ClusterDiscoveryStore        store.ClusterDiscoveryStore
+	CommandStore                 store.CommandStore
+	CommandWebhookStore          store.CommandWebhookStore
+	ComplianceStore              store.ComplianceStore
+	ConfigHistoryStore           store.ConfigHistoryStore
+	CustomStatusTemplateStore    store.CustomStatusTemplateStore
+	DirectChannelRetentionStore  store.DirectChannelRetentionStore
+	EmailSuppressionStore        store.EmailSuppressionStore
+	EmojiStore                   store.EmojiStore
+	EventWebhookStore            store.EventWebhookStore
+	FileInfoStore                store.FileInfoStore
+	GroupStore                   store.GroupStore
+	IdempotencyKeyStore          store.IdempotencyKeyStore
+	ImpersonationStore           store.ImpersonationStore
+	JobStore                     store.JobStore
+	LicenseStore                 store.LicenseStore
+	LicenseUsageStore            store.LicenseUsageStore
+	LinkMetadataStore            store.LinkMetadataStore
+	OAuthStore                   store.OAuthStore
+	OAuthAppUsageStore           store.OAuthAppUsageStore
+	OutstandingMentionStore      store.OutstandingMentionStore
+	PermissionDenialStore        store.PermissionDenialStore
+	PluginStore                  store.PluginStore
+	PostStore                    store.PostStore
+	PostAcknowledgementStore     store.PostAcknowledgementStore
+	PostModerationStore          store.PostModerationStore
+	PostPriorityStore            store.PostPriorityStore
+	PostReportStore              store.PostReportStore
+	PostTaskStore                store.PostTaskStore
+	PreferenceStore              store.PreferenceStore
+	ProductNoticesStore          store.ProductNoticesStore
+	RESTProxyBridgeStore         store.RESTProxyBridgeStore
+	ReactionStore                store.ReactionStore
+	RemoteClusterStore           store.RemoteClusterStore
+	RetentionPolicyStore         store.RetentionPolicyStore
+	RoleStore                    store.RoleStore
+	ScheduledChannelMessageStore store.ScheduledChannelMessageStore
+	SchemeStore                  store.SchemeStore
+	SessionStore                 store.SessionStore
+	SharedChannelStore           store.SharedChannelStore
+	StatusStore                  store.StatusStore
+	SystemStore                  store.SystemStore
+	TeamStore                    store.TeamStore
+	TeamAliasStore               store.TeamAliasStore
+	TeamBannerStore              store.TeamBannerStore
+	TeamDeletionStore            store.TeamDeletionStore
+	TeamGroupRoleStore           store.TeamGroupRoleStore
+	TeamInviteUsageStore         store.TeamInviteUsageStore
+	TeamRequestStore             store.TeamRequestStore
+	TeamStatsStore               store.TeamStatsStore
+	TermsOfServiceStore          store.TermsOfServiceStore
+	ThreadStore                  store.ThreadStore
+	TokenStore                   store.TokenStore
+	UploadSessionStore           store.UploadSessionStore
+	UploadUsageStore             store.UploadUsageStore
+	UserStore                    store.UserStore
+	UserAccessTokenStore         store.UserAccessTokenStore
+	UserMergeStore               store.UserMergeStore
+	UserTermsOfServiceStore      store.UserTermsOfServiceStore
+	WebhookStore                 store.WebhookStore
+}
+
+func (s *TimerLayer) APIUsage() store.APIUsageStore {
+	return s.APIUsageStore
+}
+
+func (s *TimerLayer) ActivityEvent() store.ActivityEventStore {
+	return s.ActivityEventStore
+}
+
+func (s *TimerLayer) AlertRule() store.AlertRuleStore {
+	return s.AlertRuleStore
 }
 
 func (s *TimerLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *TimerLayer) AuditLog() store.AuditLogStore {
+	return s.AuditLogStore
+}
+
 func (s *TimerLayer) Bot() store.BotStore {
 	return s.BotStore
 }
 
+func (s *TimerLayer) BotState() store.BotStateStore {
+	return s.BotStateStore
+}
+
+func (s *TimerLayer) BotTokenRotation() store.BotTokenRotationStore {
+	return s.BotTokenRotationStore
+}
+
+func (s *TimerLayer) CannedResponse() store.CannedResponseStore {
+	return s.CannedResponseStore
+}
+
 func (s *TimerLayer) Channel() store.ChannelStore {
 	return s.ChannelStore
 }
 
+func (s *TimerLayer) ChannelArchivePolicy() store.ChannelArchivePolicyStore {
+	return s.ChannelArchivePolicyStore
+}
+
+func (s *TimerLayer) ChannelCommandOverride() store.ChannelCommandOverrideStore {
+	return s.ChannelCommandOverrideStore
+}
+
+func (s *TimerLayer) ChannelDigest() store.ChannelDigestStore {
+	return s.ChannelDigestStore
+}
+
+func (s *TimerLayer) ChannelEvent() store.ChannelEventStore {
+	return s.ChannelEventStore
+}
+
+func (s *TimerLayer) ChannelFeed() store.ChannelFeedStore {
+	return s.ChannelFeedStore
+}
+
+func (s *TimerLayer) ChannelLanguageStats() store.ChannelLanguageStatsStore {
+	return s.ChannelLanguageStatsStore
+}
+
 func (s *TimerLayer) ChannelMemberHistory() store.ChannelMemberHistoryStore {
 	return s.ChannelMemberHistoryStore
 }
@@ -89,10 +181,30 @@ func (s *TimerLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *TimerLayer) ConfigHistory() store.ConfigHistoryStore {
+	return s.ConfigHistoryStore
+}
+
+func (s *TimerLayer) CustomStatusTemplate() store.CustomStatusTemplateStore {
+	return s.CustomStatusTemplateStore
+}
+
+func (s *TimerLayer) DirectChannelRetention() store.DirectChannelRetentionStore {
+	return s.DirectChannelRetentionStore
+}
+
+func (s *TimerLayer) EmailSuppression() store.EmailSuppressionStore {
+	return s.EmailSuppressionStore
+}
+
 func (s *TimerLayer) Emoji() store.EmojiStore {
 	return s.EmojiStore
 }
 
+func (s *TimerLayer) EventWebhook() store.EventWebhookStore {
+	return s.EventWebhookStore
+}
+
 func (s *TimerLayer) FileInfo() store.FileInfoStore {
 	return s.FileInfoStore
 }
@@ -101,6 +213,14 @@ func (s *TimerLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *TimerLayer) IdempotencyKey() store.IdempotencyKeyStore {
+	return s.IdempotencyKeyStore
+}
+
+func (s *TimerLayer) Impersonation() store.ImpersonationStore {
+	return s.ImpersonationStore
+}
+
 func (s *TimerLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -109,6 +229,10 @@ func (s *TimerLayer) License() store.LicenseStore {
 	return s.LicenseStore
 }
 
+func (s *TimerLayer) LicenseUsage() store.LicenseUsageStore {
+	return s.LicenseUsageStore
+}
+
 func (s *TimerLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
@@ -117,6 +241,18 @@ func (s *TimerLayer) OAuth() store.OAuthStore {
 	return s.OAuthStore
 }
 
+func (s *TimerLayer) OAuthAppUsage() store.OAuthAppUsageStore {
+	return s.OAuthAppUsageStore
+}
+
+func (s *TimerLayer) OutstandingMention() store.OutstandingMentionStore {
+	return s.OutstandingMentionStore
+}
+
+func (s *TimerLayer) PermissionDenial() store.PermissionDenialStore {
+	return s.PermissionDenialStore
+}
+
 func (s *TimerLayer) Plugin() store.PluginStore {
 	return s.PluginStore
 }
@@ -125,6 +261,26 @@ func (s *TimerLayer) Post() store.PostStore {
 	return s.PostStore
 }
 
+func (s *TimerLayer) PostAcknowledgement() store.PostAcknowledgementStore {
+	return s.PostAcknowledgementStore
+}
+
+func (s *TimerLayer) PostModeration() store.PostModerationStore {
+	return s.PostModerationStore
+}
+
+func (s *TimerLayer) PostPriority() store.PostPriorityStore {
+	return s.PostPriorityStore
+}
+
+func (s *TimerLayer) PostReport() store.PostReportStore {
+	return s.PostReportStore
+}
+
+func (s *TimerLayer) PostTask() store.PostTaskStore {
+	return s.PostTaskStore
+}
+
 func (s *TimerLayer) Preference() store.PreferenceStore {
 	return s.PreferenceStore
 }
@@ -133,6 +289,10 @@ func (s *TimerLayer) ProductNotices() store.ProductNoticesStore {
 	return s.ProductNoticesStore
 }
 
+func (s *TimerLayer) RESTProxyBridge() store.RESTProxyBridgeStore {
+	return s.RESTProxyBridgeStore
+}
+
 func (s *TimerLayer) Reaction() store.ReactionStore {
 	return s.ReactionStore
 }
@@ -149,6 +309,10 @@ func (s *TimerLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *TimerLayer) ScheduledChannelMessage() store.ScheduledChannelMessageStore {
+	return s.ScheduledChannelMessageStore
+}
+
 func (s *TimerLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -173,6 +337,34 @@ func (s *TimerLayer) Team() store.TeamStore {
 	return s.TeamStore
 }
 
+func (s *TimerLayer) TeamAlias() store.TeamAliasStore {
+	return s.TeamAliasStore
+}
+
+func (s *TimerLayer) TeamBanner() store.TeamBannerStore {
+	return s.TeamBannerStore
+}
+
+func (s *TimerLayer) TeamDeletion() store.TeamDeletionStore {
+	return s.TeamDeletionStore
+}
+
+func (s *TimerLayer) TeamGroupRole() store.TeamGroupRoleStore {
+	return s.TeamGroupRoleStore
+}
+
+func (s *TimerLayer) TeamInviteUsage() store.TeamInviteUsageStore {
+	return s.TeamInviteUsageStore
+}
+
+func (s *TimerLayer) TeamRequest() store.TeamRequestStore {
+	return s.TeamRequestStore
+}
+
+func (s *TimerLayer) TeamStats() store.TeamStatsStore {
+	return s.TeamStatsStore
+}
+
 func (s *TimerLayer) TermsOfService() store.TermsOfServiceStore {
 	return s.TermsOfServiceStore
 }
@@ -189,6 +381,10 @@ func (s *TimerLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
 
+func (s *TimerLayer) UploadUsage() store.UploadUsageStore {
+	return s.UploadUsageStore
+}
+
 func (s *TimerLayer) User() store.UserStore {
 	return s.UserStore
 }
@@ -197,6 +393,10 @@ func (s *TimerLayer) UserAccessToken() store.UserAccessTokenStore {
 	return s.UserAccessTokenStore
 }
 
+func (s *TimerLayer) UserMerge() store.UserMergeStore {
+	return s.UserMergeStore
+}
+
 func (s *TimerLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
@@ -205,21 +405,86 @@ func (s *TimerLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
 
+type TimerLayerAPIUsageStore struct {
+	store.APIUsageStore
+	Root *TimerLayer
+}
+
+type TimerLayerActivityEventStore struct {
+	store.ActivityEventStore
+	Root *TimerLayer
+}
+
+type TimerLayerAlertRuleStore struct {
+	store.AlertRuleStore
+	Root *TimerLayer
+}
+
 type TimerLayerAuditStore struct {
 	store.AuditStore
 	Root *TimerLayer
 }
 
+type TimerLayerAuditLogStore struct {
+	store.AuditLogStore
+	Root *TimerLayer
+}
+
 type TimerLayerBotStore struct {
 	store.BotStore
 	Root *TimerLayer
 }
 
+type TimerLayerBotStateStore struct {
+	store.BotStateStore
+	Root *TimerLayer
+}
+
+type TimerLayerBotTokenRotationStore struct {
+	store.BotTokenRotationStore
+	Root *TimerLayer
+}
+
+type TimerLayerCannedResponseStore struct {
+	store.CannedResponseStore
+	Root *TimerLayer
+}
+
 type TimerLayerChannelStore struct {
 	store.ChannelStore
 	Root *TimerLayer
 }
 
+type TimerLayerChannelArchivePolicyStore struct {
+	store.ChannelArchivePolicyStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelCommandOverrideStore struct {
+	store.ChannelCommandOverrideStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelDigestStore struct {
+	store.ChannelDigestStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelEventStore struct {
+	store.ChannelEventStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelFeedStore struct {
+	store.ChannelFeedStore
+	Root *TimerLayer
+}
+
+type TimerLayerChannelLanguageStatsStore struct {
+	store.ChannelLanguageStatsStore
+	Root *TimerLayer
+}
+
 type TimerLayerChannelMemberHistoryStore struct {
 	store.ChannelMemberHistoryStore
 	Root *TimerLayer
@@ -245,11 +510,36 @@ type TimerLayerComplianceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerConfigHistoryStore struct {
+	store.ConfigHistoryStore
+	Root *TimerLayer
+}
+
+type TimerLayerCustomStatusTemplateStore struct {
+	store.CustomStatusTemplateStore
+	Root *TimerLayer
+}
+
+type TimerLayerDirectChannelRetentionStore struct {
+	store.DirectChannelRetentionStore
+	Root *TimerLayer
+}
+
+type TimerLayerEmailSuppressionStore struct {
+	store.EmailSuppressionStore
+	Root *TimerLayer
+}
+
 type TimerLayerEmojiStore struct {
 	store.EmojiStore
 	Root *TimerLayer
 }
 
+type TimerLayerEventWebhookStore struct {
+	store.EventWebhookStore
+	Root *TimerLayer
+}
+
 type TimerLayerFileInfoStore struct {
 	store.FileInfoStore
 	Root *TimerLayer
@@ -260,6 +550,16 @@ type TimerLayerGroupStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerIdempotencyKeyStore struct {
+	store.IdempotencyKeyStore
+	Root *TimerLayer
+}
+
+type TimerLayerImpersonationStore struct {
+	store.ImpersonationStore
+	Root *TimerLayer
+}
+
 type TimerLayerJobStore struct {
 	store.JobStore
 	Root *TimerLayer
@@ -270,6 +570,11 @@ type TimerLayerLicenseStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerLicenseUsageStore struct {
+	store.LicenseUsageStore
+	Root *TimerLayer
+}
+
 type TimerLayerLinkMetadataStore struct {
 	store.LinkMetadataStore
 	Root *TimerLayer
@@ -280,6 +585,21 @@ type TimerLayerOAuthStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerOAuthAppUsageStore struct {
+	store.OAuthAppUsageStore
+	Root *TimerLayer
+}
+
+type TimerLayerOutstandingMentionStore struct {
+	store.OutstandingMentionStore
+	Root *TimerLayer
+}
+
+type TimerLayerPermissionDenialStore struct {
+	store.PermissionDenialStore
+	Root *TimerLayer
+}
+
 type TimerLayerPluginStore struct {
 	store.PluginStore
 	Root *TimerLayer
@@ -290,6 +610,31 @@ type TimerLayerPostStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerPostAcknowledgementStore struct {
+	store.PostAcknowledgementStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostModerationStore struct {
+	store.PostModerationStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostPriorityStore struct {
+	store.PostPriorityStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostReportStore struct {
+	store.PostReportStore
+	Root *TimerLayer
+}
+
+type TimerLayerPostTaskStore struct {
+	store.PostTaskStore
+	Root *TimerLayer
+}
+
 type TimerLayerPreferenceStore struct {
 	store.PreferenceStore
 	Root *TimerLayer
@@ -300,6 +645,11 @@ type TimerLayerProductNoticesStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerRESTProxyBridgeStore struct {
+	store.RESTProxyBridgeStore
+	Root *TimerLayer
+}
+
 type TimerLayerReactionStore struct {
 	store.ReactionStore
 	Root *TimerLayer
@@ -320,6 +670,11 @@ type TimerLayerRoleStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerScheduledChannelMessageStore struct {
+	store.ScheduledChannelMessageStore
+	Root *TimerLayer
+}
+
 type TimerLayerSchemeStore struct {
 	store.SchemeStore
 	Root *TimerLayer
@@ -350,6 +705,41 @@ type TimerLayerTeamStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerTeamAliasStore struct {
+	store.TeamAliasStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamBannerStore struct {
+	store.TeamBannerStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamDeletionStore struct {
+	store.TeamDeletionStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamGroupRoleStore struct {
+	store.TeamGroupRoleStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamInviteUsageStore struct {
+	store.TeamInviteUsageStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamRequestStore struct {
+	store.TeamRequestStore
+	Root *TimerLayer
+}
+
+type TimerLayerTeamStatsStore struct {
+	store.TeamStatsStore
+	Root *TimerLayer
+}
+
 type TimerLayerTermsOfServiceStore struct {
 	store.TermsOfServiceStore
 	Root *TimerLayer
@@ -370,6 +760,11 @@ type TimerLayerUploadSessionStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerUploadUsageStore struct {
+	store.UploadUsageStore
+	Root *TimerLayer
+}
+
 type TimerLayerUserStore struct {
 	store.UserStore
 	Root *TimerLayer
@@ -380,6 +775,11 @@ type TimerLayerUserAccessTokenStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerUserMergeStore struct {
+	store.UserMergeStore
+	Root *TimerLayer
+}
+
 type TimerLayerUserTermsOfServiceStore struct {
 	store.UserTermsOfServiceStore
 	Root *TimerLayer
@@ -390,10 +790,10 @@ type TimerLayerWebhookStore struct {
 	Root *TimerLayer
 }
 
-func (s *TimerLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
+func (s *TimerLayerAPIUsageStore) GetForUser(userID string, since int64) ([]*model.APIUsage, error) {
 	start := timemodule.Now()
 
-	result, err := s.AuditStore.Get(user_id, offset, limit)
+	result, err := s.APIUsageStore.GetForUser(userID, since)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -401,15 +801,15 @@ func (s *TimerLayerAuditStore) Get(user_id string, offset int, limit int) (model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("APIUsageStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerAuditStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerAPIUsageStore) GetSummaries(since int64, offset int, limit int) ([]*model.APIUsageSummary, error) {
 	start := timemodule.Now()
 
-	err := s.AuditStore.PermanentDeleteByUser(userID)
+	result, err := s.APIUsageStore.GetSummaries(since, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -417,15 +817,15 @@ func (s *TimerLayerAuditStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("APIUsageStore.GetSummaries", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
+func (s *TimerLayerAPIUsageStore) GetTokenCounts(tokenID string, since int64) (map[int64]int64, error) {
 	start := timemodule.Now()
 
-	err := s.AuditStore.Save(audit)
+	result, err := s.APIUsageStore.GetTokenCounts(tokenID, since)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -433,15 +833,15 @@ func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("APIUsageStore.GetTokenCounts", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
+func (s *TimerLayerAPIUsageStore) Increment(usages []*model.APIUsage) error {
 	start := timemodule.Now()
 
-	result, err := s.BotStore.Get(userID, includeDeleted)
+	err := s.APIUsageStore.Increment(usages)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -449,15 +849,15 @@ func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("APIUsageStore.Increment", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
+func (s *TimerLayerAPIUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.BotStore.GetAll(options)
+	result, err := s.APIUsageStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -465,15 +865,15 @@ func (s *TimerLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("APIUsageStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerBotStore) PermanentDelete(userID string) error {
+func (s *TimerLayerActivityEventStore) DeleteForPost(postID string) error {
 	start := timemodule.Now()
 
-	err := s.BotStore.PermanentDelete(userID)
+	err := s.ActivityEventStore.DeleteForPost(postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -481,15 +881,15 @@ func (s *TimerLayerBotStore) PermanentDelete(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityEventStore.DeleteForPost", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
+func (s *TimerLayerActivityEventStore) DeleteReaction(userID string, postID string, emojiName string) error {
 	start := timemodule.Now()
 
-	result, err := s.BotStore.Save(bot)
+	err := s.ActivityEventStore.DeleteReaction(userID, postID, emojiName)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -497,15 +897,15 @@ func (s *TimerLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityEventStore.DeleteReaction", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
+func (s *TimerLayerActivityEventStore) GetForUser(userID string, opts store.ActivityEventGetOpts) ([]*model.ActivityEvent, error) {
 	start := timemodule.Now()
 
-	result, err := s.BotStore.Update(bot)
+	result, err := s.ActivityEventStore.GetForUser(userID, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -513,15 +913,15 @@ func (s *TimerLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityEventStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *TimerLayerActivityEventStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
+	err := s.ActivityEventStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -529,15 +929,15 @@ func (s *TimerLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AnalyticsDeletedTypeCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityEventStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
+func (s *TimerLayerActivityEventStore) Save(event *model.ActivityEvent) (*model.ActivityEvent, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
+	result, err := s.ActivityEventStore.Save(event)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -545,15 +945,15 @@ func (s *TimerLayerChannelStore) AnalyticsTypeCount(teamID string, channelType m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AnalyticsTypeCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ActivityEventStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool) (model.ChannelListWithTeamData, error) {
+func (s *TimerLayerAlertRuleStore) Delete(id string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.Autocomplete(userID, term, includeDeleted)
+	err := s.AlertRuleStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -561,15 +961,15 @@ func (s *TimerLayerChannelStore) Autocomplete(userID string, term string, includ
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Autocomplete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerAlertRuleStore) Get(id string) (*model.AlertRule, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted)
+	result, err := s.AlertRuleStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -577,15 +977,15 @@ func (s *TimerLayerChannelStore) AutocompleteInTeam(teamID string, userID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AutocompleteInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerAlertRuleStore) GetAll() ([]*model.AlertRule, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
+	result, err := s.AlertRuleStore.GetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -593,15 +993,15 @@ func (s *TimerLayerChannelStore) AutocompleteInTeamForSearch(teamID string, user
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AutocompleteInTeamForSearch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) ClearAllCustomRoleAssignments() error {
+func (s *TimerLayerAlertRuleStore) GetAllEnabled() ([]*model.AlertRule, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.ClearAllCustomRoleAssignments()
+	result, err := s.AlertRuleStore.GetAllEnabled()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -609,30 +1009,31 @@ func (s *TimerLayerChannelStore) ClearAllCustomRoleAssignments() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearAllCustomRoleAssignments", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.GetAllEnabled", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) ClearCaches() {
+func (s *TimerLayerAlertRuleStore) Save(rule *model.AlertRule) (*model.AlertRule, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.ClearCaches()
+	result, err := s.AlertRuleStore.Save(rule)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.Save", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
+func (s *TimerLayerAlertRuleStore) Update(rule *model.AlertRule) (*model.AlertRule, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
+	result, err := s.AlertRuleStore.Update(rule)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -640,15 +1041,15 @@ func (s *TimerLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearSidebarOnTeamLeave", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.Update", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
+func (s *TimerLayerAlertRuleStore) UpdateState(rule *model.AlertRule) error {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
+	err := s.AlertRuleStore.UpdateState(rule)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -656,15 +1057,15 @@ func (s *TimerLayerChannelStore) CountPostsAfter(channelID string, timestamp int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CountPostsAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AlertRuleStore.UpdateState", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
+func (s *TimerLayerAuditStore) Get(user_id string, offset int, limit int) (model.Audits, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
+	result, err := s.AuditStore.Get(user_id, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -672,15 +1073,15 @@ func (s *TimerLayerChannelStore) CreateDirectChannel(userID *model.User, otherUs
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateDirectChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) CreateInitialSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+func (s *TimerLayerAuditStore) GetByActions(actions []string, extraInfoPrefix string, offset int, limit int) (model.Audits, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.CreateInitialSidebarCategories(userID, teamID)
+	result, err := s.AuditStore.GetByActions(actions, extraInfoPrefix, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -688,15 +1089,15 @@ func (s *TimerLayerChannelStore) CreateInitialSidebarCategories(userID string, t
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateInitialSidebarCategories", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.GetByActions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
+func (s *TimerLayerAuditStore) GetCountByExtraInfoPrefix(prefix string, since int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
+	result, err := s.AuditStore.GetCountByExtraInfoPrefix(prefix, since)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -704,15 +1105,15 @@ func (s *TimerLayerChannelStore) CreateSidebarCategory(userID string, teamID str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateSidebarCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.GetCountByExtraInfoPrefix", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) Delete(channelID string, time int64) error {
+func (s *TimerLayerAuditStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.Delete(channelID, time)
+	err := s.AuditStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -720,15 +1121,15 @@ func (s *TimerLayerChannelStore) Delete(channelID string, time int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.PermanentDeleteByUser", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
+func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.DeleteSidebarCategory(categoryID)
+	err := s.AuditStore.Save(audit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -736,15 +1137,15 @@ func (s *TimerLayerChannelStore) DeleteSidebarCategory(categoryID string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.DeleteSidebarCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.Save", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *TimerLayerAuditLogStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
+	result, err := s.AuditLogStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -752,15 +1153,15 @@ func (s *TimerLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.DeleteSidebarChannelsByPreferences", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditLogStore.PermanentDeleteBatch", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+func (s *TimerLayerAuditLogStore) Save(log *model.AuditLog) (*model.AuditLog, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.Get(id, allowFromCache)
+	result, err := s.AuditLogStore.Save(log)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -768,15 +1169,15 @@ func (s *TimerLayerChannelStore) Get(id string, allowFromCache bool) (*model.Cha
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditLogStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
+func (s *TimerLayerAuditLogStore) Search(opts *model.AuditLogSearch, offset int, limit int) ([]*model.AuditLog, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAll(teamID)
+	result, err := s.AuditLogStore.Search(opts, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -784,15 +1185,15 @@ func (s *TimerLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditLogStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
+func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannelMembersById(id)
+	result, err := s.BotStore.Get(userID, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -800,15 +1201,15 @@ func (s *TimerLayerChannelStore) GetAllChannelMembersById(id string) ([]string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersById", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
+func (s *TimerLayerBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
+	result, err := s.BotStore.GetAll(options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -816,15 +1217,15 @@ func (s *TimerLayerChannelStore) GetAllChannelMembersForUser(userID string, allo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
+func (s *TimerLayerBotStore) PermanentDelete(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
+	err := s.BotStore.PermanentDelete(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -832,15 +1233,15 @@ func (s *TimerLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(chann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersNotifyPropsForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.PermanentDelete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
+func (s *TimerLayerBotStore) Save(bot *model.Bot) (*model.Bot, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannels(page, perPage, opts)
+	result, err := s.BotStore.Save(bot)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -848,15 +1249,15 @@ func (s *TimerLayerChannelStore) GetAllChannels(page int, perPage int, opts stor
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
+func (s *TimerLayerBotStore) Update(bot *model.Bot) (*model.Bot, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannelsCount(opts)
+	result, err := s.BotStore.Update(bot)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -864,15 +1265,15 @@ func (s *TimerLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpt
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelsCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
+func (s *TimerLayerBotStateStore) Delete(botUserID string, userID string, channelID string, key string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
+	err := s.BotStateStore.Delete(botUserID, userID, channelID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -880,15 +1281,15 @@ func (s *TimerLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
+func (s *TimerLayerBotStateStore) Get(botUserID string, userID string, channelID string, key string) (*model.BotState, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
+	result, err := s.BotStateStore.Get(botUserID, userID, channelID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -896,15 +1297,15 @@ func (s *TimerLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, a
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllDirectChannelsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *TimerLayerBotStateStore) GetKeys(botUserID string, userID string, channelID string, offset int, limit int) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetByName(team_id, name, allowFromCache)
+	result, err := s.BotStateStore.GetKeys(botUserID, userID, channelID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -912,15 +1313,15 @@ func (s *TimerLayerChannelStore) GetByName(team_id string, name string, allowFro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.GetKeys", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+func (s *TimerLayerBotStateStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+	err := s.BotStateStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -928,15 +1329,15 @@ func (s *TimerLayerChannelStore) GetByNameIncludeDeleted(team_id string, name st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByNameIncludeDeleted", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+func (s *TimerLayerBotStateStore) PermanentDeleteExpiredBatch(now int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+	result, err := s.BotStateStore.PermanentDeleteExpiredBatch(now, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -944,15 +1345,15 @@ func (s *TimerLayerChannelStore) GetByNames(team_id string, names []string, allo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.PermanentDeleteExpiredBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
+func (s *TimerLayerBotStateStore) Save(state *model.BotState) (*model.BotState, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelCounts(teamID, userID)
+	result, err := s.BotStateStore.Save(state)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -960,15 +1361,15 @@ func (s *TimerLayerChannelStore) GetChannelCounts(teamID string, userID string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelCounts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotStateStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
+func (s *TimerLayerBotTokenRotationStore) Delete(botUserID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelMembersForExport(userID, teamID)
+	err := s.BotTokenRotationStore.Delete(botUserID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -976,15 +1377,15 @@ func (s *TimerLayerChannelStore) GetChannelMembersForExport(userID string, teamI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelMembersForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
+func (s *TimerLayerBotTokenRotationStore) Get(botUserID string) (*model.BotTokenRotation, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelMembersTimezones(channelID)
+	result, err := s.BotTokenRotationStore.Get(botUserID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -992,15 +1393,15 @@ func (s *TimerLayerChannelStore) GetChannelMembersTimezones(channelID string) ([
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelMembersTimezones", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
+func (s *TimerLayerBotTokenRotationStore) GetDue(now int64, limit int) ([]*model.BotTokenRotation, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelUnread(channelID, userID)
+	result, err := s.BotTokenRotationStore.GetDue(now, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1008,15 +1409,15 @@ func (s *TimerLayerChannelStore) GetChannelUnread(channelID string, userID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelUnread", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.GetDue", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
+func (s *TimerLayerBotTokenRotationStore) GetTokenAges(offset int, limit int) ([]*model.BotTokenAge, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannels(teamID, userID, opts)
+	result, err := s.BotTokenRotationStore.GetTokenAges(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1024,15 +1425,15 @@ func (s *TimerLayerChannelStore) GetChannels(teamID string, userID string, opts
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.GetTokenAges", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, error) {
+func (s *TimerLayerBotTokenRotationStore) Save(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+	result, err := s.BotTokenRotationStore.Save(rotation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1040,15 +1441,15 @@ func (s *TimerLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, en
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
+func (s *TimerLayerBotTokenRotationStore) Update(rotation *model.BotTokenRotation) (*model.BotTokenRotation, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+	result, err := s.BotTokenRotationStore.Update(rotation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1056,15 +1457,15 @@ func (s *TimerLayerChannelStore) GetChannelsByIds(channelIds []string, includeDe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("BotTokenRotationStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
+func (s *TimerLayerCannedResponseStore) Autocomplete(userID string, teamID string, prefix string, limit int) ([]*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
+	result, err := s.CannedResponseStore.Autocomplete(userID, teamID, prefix, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1072,15 +1473,15 @@ func (s *TimerLayerChannelStore) GetChannelsByScheme(schemeID string, offset int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByScheme", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.Autocomplete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
+func (s *TimerLayerCannedResponseStore) Delete(id string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
+	err := s.CannedResponseStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1088,15 +1489,15 @@ func (s *TimerLayerChannelStore) GetChannelsByUser(userID string, includeDeleted
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
+func (s *TimerLayerCannedResponseStore) Get(id string) (*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
+	result, err := s.CannedResponseStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1104,15 +1505,15 @@ func (s *TimerLayerChannelStore) GetChannelsWithCursor(teamId string, userId str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsWithCursor", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
+func (s *TimerLayerCannedResponseStore) GetForTeam(teamID string) ([]*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
+	result, err := s.CannedResponseStore.GetForTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1120,15 +1521,15 @@ func (s *TimerLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsWithTeamDataByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.GetForTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+func (s *TimerLayerCannedResponseStore) GetForUser(userID string) ([]*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+	result, err := s.CannedResponseStore.GetForUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1136,15 +1537,15 @@ func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeleted", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+func (s *TimerLayerCannedResponseStore) IncrementUseCount(id string, usedAt int64) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetDeletedByName(team_id, name)
+	err := s.CannedResponseStore.IncrementUseCount(id, usedAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1152,15 +1553,15 @@ func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeletedByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.IncrementUseCount", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
+func (s *TimerLayerCannedResponseStore) Save(response *model.CannedResponse) (*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetForPost(postID)
+	result, err := s.CannedResponseStore.Save(response)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1168,15 +1569,15 @@ func (s *TimerLayerChannelStore) GetForPost(postID string) (*model.Channel, erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+func (s *TimerLayerCannedResponseStore) Update(response *model.CannedResponse) (*model.CannedResponse, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetFromMaster(id)
+	result, err := s.CannedResponseStore.Update(response)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1184,15 +1585,15 @@ func (s *TimerLayerChannelStore) GetFromMaster(id string) (*model.Channel, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetFromMaster", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CannedResponseStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *TimerLayerChannelStore) AnalyticsDeletedTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetGuestCount(channelID, allowFromCache)
+	result, err := s.ChannelStore.AnalyticsDeletedTypeCount(teamID, channelType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1200,15 +1601,15 @@ func (s *TimerLayerChannelStore) GetGuestCount(channelID string, allowFromCache
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetGuestCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AnalyticsDeletedTypeCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
+func (s *TimerLayerChannelStore) AnalyticsTypeCount(teamID string, channelType model.ChannelType) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMember(ctx, channelID, userID)
+	result, err := s.ChannelStore.AnalyticsTypeCount(teamID, channelType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1216,15 +1617,15 @@ func (s *TimerLayerChannelStore) GetMember(ctx context.Context, channelID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AnalyticsTypeCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *TimerLayerChannelStore) Autocomplete(userID string, term string, includeDeleted bool) (model.ChannelListWithTeamData, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMemberCount(channelID, allowFromCache)
+	result, err := s.ChannelStore.Autocomplete(userID, term, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1232,31 +1633,31 @@ func (s *TimerLayerChannelStore) GetMemberCount(channelID string, allowFromCache
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Autocomplete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
+func (s *TimerLayerChannelStore) AutocompleteInTeam(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	start := timemodule.Now()
 
-	result := s.ChannelStore.GetMemberCountFromCache(channelID)
+	result, err := s.ChannelStore.AutocompleteInTeam(teamID, userID, term, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCountFromCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AutocompleteInTeam", success, elapsed)
 	}
-	return result
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
+func (s *TimerLayerChannelStore) AutocompleteInTeamForSearch(teamID string, userID string, term string, includeDeleted bool) (model.ChannelList, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
+	result, err := s.ChannelStore.AutocompleteInTeamForSearch(teamID, userID, term, includeDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1264,15 +1665,15 @@ func (s *TimerLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, cha
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCountsByGroup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.AutocompleteInTeamForSearch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
+func (s *TimerLayerChannelStore) ClearAllCustomRoleAssignments() error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMemberForPost(postID, userID)
+	err := s.ChannelStore.ClearAllCustomRoleAssignments()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1280,31 +1681,30 @@ func (s *TimerLayerChannelStore) GetMemberForPost(postID string, userID string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearAllCustomRoleAssignments", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetMembers(channelID string, offset int, limit int) (model.ChannelMembers, error) {
+func (s *TimerLayerChannelStore) ClearCaches() {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembers(channelID, offset, limit)
+	s.ChannelStore.ClearCaches()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearCaches", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
+func (s *TimerLayerChannelStore) ClearSidebarOnTeamLeave(userID string, teamID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
+	err := s.ChannelStore.ClearSidebarOnTeamLeave(userID, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1312,15 +1712,15 @@ func (s *TimerLayerChannelStore) GetMembersByChannelIds(channelIds []string, use
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersByChannelIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ClearSidebarOnTeamLeave", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
+func (s *TimerLayerChannelStore) CountPostsAfter(channelID string, timestamp int64, userID string) (int, int, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersByIds(channelID, userIds)
+	result, resultVar1, err := s.ChannelStore.CountPostsAfter(channelID, timestamp, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1328,15 +1728,15 @@ func (s *TimerLayerChannelStore) GetMembersByIds(channelID string, userIds []str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CountPostsAfter", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
+func (s *TimerLayerChannelStore) CreateDirectChannel(userID *model.User, otherUserID *model.User, channelOptions ...model.ChannelOption) (*model.Channel, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersForUser(teamID, userID)
+	result, err := s.ChannelStore.CreateDirectChannel(userID, otherUserID, channelOptions...)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1344,15 +1744,15 @@ func (s *TimerLayerChannelStore) GetMembersForUser(teamID string, userID string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateDirectChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMembersForUserWithCursor(userID string, afterChannel string, afterUser string, limit int, lastUpdateAt int) (model.ChannelMembers, error) {
+func (s *TimerLayerChannelStore) CreateInitialSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersForUserWithCursor(userID, afterChannel, afterUser, limit, lastUpdateAt)
+	result, err := s.ChannelStore.CreateInitialSidebarCategories(userID, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1360,15 +1760,15 @@ func (s *TimerLayerChannelStore) GetMembersForUserWithCursor(userID string, afte
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUserWithCursor", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateInitialSidebarCategories", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
+func (s *TimerLayerChannelStore) CreateSidebarCategory(userID string, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
+	result, err := s.ChannelStore.CreateSidebarCategory(userID, teamID, newCategory)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1376,15 +1776,15 @@ func (s *TimerLayerChannelStore) GetMembersForUserWithPagination(userID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUserWithPagination", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.CreateSidebarCategory", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
+func (s *TimerLayerChannelStore) Delete(channelID string, time int64) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
+	err := s.ChannelStore.Delete(channelID, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1392,15 +1792,15 @@ func (s *TimerLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersInfoByChannelIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+func (s *TimerLayerChannelStore) DeleteSidebarCategory(categoryID string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+	err := s.ChannelStore.DeleteSidebarCategory(categoryID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1408,15 +1808,15 @@ func (s *TimerLayerChannelStore) GetMoreChannels(teamID string, userID string, o
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMoreChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.DeleteSidebarCategory", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+func (s *TimerLayerChannelStore) DeleteSidebarChannelsByPreferences(preferences model.Preferences) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+	err := s.ChannelStore.DeleteSidebarChannelsByPreferences(preferences)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1424,12 +1824,700 @@ func (s *TimerLayerChannelStore) GetPinnedPostCount(channelID string, allowFromC
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPinnedPostCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.DeleteSidebarChannelsByPreferences", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
+func (s *TimerLayerChannelStore) Get(id string, allowFromCache bool) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.Get(id, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAll(teamID string) ([]*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAll(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannelMembersById(id string) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannelMembersById(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersById", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannelMembersForUser(userID string, allowFromCache bool, includeDeleted bool) (map[string]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannelMembersForUser(userID, allowFromCache, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannelMembersNotifyPropsForChannel(channelID string, allowFromCache bool) (map[string]model.StringMap, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannelMembersNotifyPropsForChannel(channelID, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelMembersNotifyPropsForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannels(page int, perPage int, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannels(page, perPage, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannelsCount(opts store.ChannelSearchOpts) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannelsCount(opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelsCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllChannelsForExportAfter(limit int, afterID string) ([]*model.ChannelForExport, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllChannelsForExportAfter(limit, afterID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllChannelsForExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetAllDirectChannelsForExportAfter(limit int, afterID string) ([]*model.DirectChannelForExport, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetAllDirectChannelsForExportAfter(limit, afterID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetAllDirectChannelsForExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetByName(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetByName(team_id, name, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetByNameIncludeDeleted(team_id string, name string, allowFromCache bool) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetByNameIncludeDeleted(team_id, name, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByNameIncludeDeleted", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetByNames(team_id string, names []string, allowFromCache bool) ([]*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetByNames(team_id, names, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetByNames", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelCounts(teamID string, userID string) (*model.ChannelCounts, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelCounts(teamID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelCounts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelMembersForExport(userID string, teamID string) ([]*model.ChannelMemberForExport, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelMembersForExport(userID, teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelMembersForExport", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelMembersTimezones(channelID string) ([]model.StringMap, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelMembersTimezones(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelMembersTimezones", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelUnread(channelID string, userID string) (*model.ChannelUnread, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelUnread(channelID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelUnread", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannels(teamID string, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannels(teamID, userID, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsBatchForIndexing(startTime, endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsBatchForIndexing", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsByIds(channelIds []string, includeDeleted bool) ([]*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsByIds(channelIds, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsByScheme(schemeID string, offset int, limit int) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsByScheme(schemeID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByScheme", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt int, pageSize int, fromChannelID string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsByUser(userID, includeDeleted, lastDeleteAt, pageSize, fromChannelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsByUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsWithCursor(teamId string, userId string, opts *model.ChannelSearchOpts, afterChannelID string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsWithCursor(teamId, userId, opts, afterChannelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsWithCursor", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsWithDeactivatedCreator(teamID string, offset int, limit int) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsWithDeactivatedCreator(teamID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsWithDeactivatedCreator", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetChannelsWithTeamDataByIds(channelIds []string, includeDeleted bool) ([]*model.ChannelWithTeamData, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetChannelsWithTeamDataByIds(channelIds, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetChannelsWithTeamDataByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetDeleted(team_id, offset, limit, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeleted", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetDeletedByName(team_id, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetDeletedByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetForPost(postID string) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetForPost(postID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetForPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetFromMaster(id string) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetFromMaster(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetFromMaster", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetGuestCount(channelID string, allowFromCache bool) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetGuestCount(channelID, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetGuestCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMember(ctx, channelID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMember", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMemberCount(channelID string, allowFromCache bool) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMemberCount(channelID, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMemberCountFromCache(channelID string) int64 {
+	start := timemodule.Now()
+
+	result := s.ChannelStore.GetMemberCountFromCache(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCountFromCache", success, elapsed)
+	}
+	return result
+}
+
+func (s *TimerLayerChannelStore) GetMemberCountsByGroup(ctx context.Context, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMemberCountsByGroup(ctx, channelID, includeTimezones)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberCountsByGroup", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMemberForPost(postID string, userID string) (*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMemberForPost(postID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMemberForPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembers(channelID string, offset int, limit int, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembers(channelID, offset, limit, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersByChannelIds(channelIds, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersByChannelIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersByIds(channelID string, userIds []string) (model.ChannelMembers, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersByIds(channelID, userIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersForUser(teamID string, userID string) (model.ChannelMembers, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersForUser(teamID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersForUserWithCursor(userID string, afterChannel string, afterUser string, limit int, lastUpdateAt int) (model.ChannelMembers, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersForUserWithCursor(userID, afterChannel, afterUser, limit, lastUpdateAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUserWithCursor", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersForUserWithPagination(userID string, page int, perPage int) (model.ChannelMembersWithTeamData, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersForUserWithPagination(userID, page, perPage)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersForUserWithPagination", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMembersInfoByChannelIds(channelIDs []string) (map[string][]*model.User, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMembersInfoByChannelIds(channelIDs)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersInfoByChannelIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetMoreChannels(teamID string, userID string, offset int, limit int) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetMoreChannels(teamID, userID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMoreChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetPinnedPostCount(channelID string, allowFromCache bool) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetPinnedPostCount(channelID, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPinnedPostCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostList, error) {
 	start := timemodule.Now()
 
 	result, err := s.ChannelStore.GetPinnedPosts(channelID)
@@ -1440,15 +2528,2997 @@ func (s *TimerLayerChannelStore) GetPinnedPosts(channelID string) (*model.PostLi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPinnedPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPinnedPosts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPrivateChannelsForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPublicChannelsByIdsForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPublicChannelsForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetSidebarCategories(userID, teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategories", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetSidebarCategory(categoryID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategory", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategoryOrder", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetTeamChannels(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetTeamForChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GetTeamMembersForChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamMembersForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.GroupSyncedChannelCount()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GroupSyncedChannelCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.IncrementMentionCount", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateAllChannelMembersForUser", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateCacheForChannelMembersNotifyProps", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidateChannel(id string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateChannel(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateChannel", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateChannelByName(teamID, name)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateChannelByName", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidateGuestCount(channelID string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateGuestCount(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateGuestCount", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidateMemberCount(channelID string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidateMemberCount(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateMemberCount", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
+	start := timemodule.Now()
+
+	s.ChannelStore.InvalidatePinnedPostCount(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidatePinnedPostCount", success, elapsed)
+	}
+}
+
+func (s *TimerLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
+	start := timemodule.Now()
+
+	result := s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.IsUserInChannelUseCache", success, elapsed)
+	}
+	return result
+}
+
+func (s *TimerLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.MigrateChannelMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) PermanentDelete(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.PermanentDelete(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDelete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.PermanentDeleteByTeam(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteByTeam", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteMembersByChannel", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.PermanentDeleteMembersByUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteMembersByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveAllDeactivatedMembers", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) RemoveMember(channelID string, userID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.RemoveMember(channelID, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveMember", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.RemoveMembers(channelID, userIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveMembers", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) ResetAllChannelSchemes() error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.ResetAllChannelSchemes()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ResetAllChannelSchemes", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) Restore(channelID string, time int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.Restore(channelID, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Restore", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveDirectChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SaveMember(member)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveMember", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SaveMultipleMembers(members)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveMultipleMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.ChannelStore.SearchAllChannels(term, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchAllChannels", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchArchivedInTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchForUserInTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SearchGroupChannels(userID, term)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchGroupChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchInTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.SearchMore(userID, teamID, term)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchMore", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SetDeleteAt", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) SetShared(channelId string, shared bool) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.SetShared(channelId, shared)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SetShared", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.Update(channel)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateLastViewedAt", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, setUnreadCountRoot)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateLastViewedAtPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UpdateMember(member)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMember", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMemberNotifyProps", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMembersRole", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UpdateMultipleMembers(members)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMultipleMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarCategories", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarCategoryOrder", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarChannelCategoryOnMove", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+	start := timemodule.Now()
+
+	err := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarChannelsByPreferences", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UserBelongsToChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) Delete(teamID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelArchivePolicyStore.Delete(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) DeleteWarning(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelArchivePolicyStore.DeleteWarning(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.DeleteWarning", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) Get(teamID string) (*model.ChannelArchivePolicy, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.Get(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) GetAll() ([]*model.ChannelArchivePolicy, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.GetAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) GetInactiveChannels(policy *model.ChannelArchivePolicy, before int64, limit int) ([]*model.Channel, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.GetInactiveChannels(policy, before, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.GetInactiveChannels", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) GetWarnings(teamID string) ([]*model.ChannelArchiveWarning, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.GetWarnings(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.GetWarnings", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) Save(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.Save(policy)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) SaveWarning(warning *model.ChannelArchiveWarning) error {
+	start := timemodule.Now()
+
+	err := s.ChannelArchivePolicyStore.SaveWarning(warning)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.SaveWarning", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelArchivePolicyStore) Update(policy *model.ChannelArchivePolicy) (*model.ChannelArchivePolicy, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelArchivePolicyStore.Update(policy)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelArchivePolicyStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelCommandOverrideStore) Delete(channelID string, trigger string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelCommandOverrideStore.Delete(channelID, trigger)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelCommandOverrideStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelCommandOverrideStore) DeleteForChannel(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelCommandOverrideStore.DeleteForChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelCommandOverrideStore.DeleteForChannel", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelCommandOverrideStore) Get(channelID string, trigger string) (*model.ChannelCommandOverride, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelCommandOverrideStore.Get(channelID, trigger)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelCommandOverrideStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelCommandOverrideStore) GetForChannel(channelID string) ([]*model.ChannelCommandOverride, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelCommandOverrideStore.GetForChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelCommandOverrideStore.GetForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelCommandOverrideStore) Save(override *model.ChannelCommandOverride) (*model.ChannelCommandOverride, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelCommandOverrideStore.Save(override)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelCommandOverrideStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) Delete(userID string, channelID string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelDigestStore.Delete(userID, channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelDigestStore) Get(userID string, channelID string) (*model.ChannelDigest, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelDigestStore.Get(userID, channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) GetActivity(channelID string, userID string, username string, since int64, until int64) (*model.ChannelDigestActivity, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelDigestStore.GetActivity(channelID, userID, username, since, until)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.GetActivity", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) GetDue(cadence string, sentBefore int64, limit int) ([]*model.ChannelDigest, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelDigestStore.GetDue(cadence, sentBefore, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.GetDue", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) GetForUser(userID string) ([]*model.ChannelDigest, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelDigestStore.GetForUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) Save(digest *model.ChannelDigest) (*model.ChannelDigest, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelDigestStore.Save(digest)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelDigestStore) UpdateLastSentAt(userID string, channelIDs []string, sentAt int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelDigestStore.UpdateLastSentAt(userID, channelIDs, sentAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelDigestStore.UpdateLastSentAt", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelEventStore) Get(id string) (*model.ChannelEvent, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelEventStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelEventStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelEventStore) GetForChannel(channelID string, offset int, limit int) ([]*model.ChannelEvent, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelEventStore.GetForChannel(channelID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelEventStore.GetForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelEventStore) MarkReverted(id string, userID string, revertedAt int64) (bool, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelEventStore.MarkReverted(id, userID, revertedAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelEventStore.MarkReverted", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelEventStore) Save(event *model.ChannelEvent) (*model.ChannelEvent, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelEventStore.Save(event)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelEventStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) Delete(id string) error {
+	start := timemodule.Now()
+
+	err := s.ChannelFeedStore.Delete(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelFeedStore) Get(id string) (*model.ChannelFeed, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) GetDue(now int64, limit int) ([]*model.ChannelFeed, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.GetDue(now, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.GetDue", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) GetExistingItemGuids(feedID string, guids []string) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.GetExistingItemGuids(feedID, guids)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.GetExistingItemGuids", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) GetForChannel(channelID string) ([]*model.ChannelFeed, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.GetForChannel(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.GetForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) Save(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.Save(feed)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) SaveItems(items []*model.ChannelFeedItem) error {
+	start := timemodule.Now()
+
+	err := s.ChannelFeedStore.SaveItems(items)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.SaveItems", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelFeedStore) Update(feed *model.ChannelFeed) (*model.ChannelFeed, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelFeedStore.Update(feed)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelFeedStore) UpdatePollResult(feed *model.ChannelFeed) error {
+	start := timemodule.Now()
+
+	err := s.ChannelFeedStore.UpdatePollResult(feed)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelFeedStore.UpdatePollResult", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelLanguageStatsStore) GetForChannel(channelID string, since int64, until int64) ([]*model.ChannelLanguagePostCount, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelLanguageStatsStore.GetForChannel(channelID, since, until)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelLanguageStatsStore.GetForChannel", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelLanguageStatsStore) RollupDay(day int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelLanguageStatsStore.RollupDay(day)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelLanguageStatsStore.RollupDay", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.DeleteOrphanedRows", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetChannelsLeftSince", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+	start := timemodule.Now()
+
+	err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
+	start := timemodule.Now()
+
+	err := s.ClusterDiscoveryStore.Cleanup()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+	start := timemodule.Now()
+
+	result, err := s.ClusterDiscoveryStore.Delete(discovery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+	start := timemodule.Now()
+
+	result, err := s.ClusterDiscoveryStore.Exists(discovery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+	start := timemodule.Now()
+
+	result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+	start := timemodule.Now()
+
+	err := s.ClusterDiscoveryStore.Save(discovery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+	start := timemodule.Now()
+
+	err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandStore) Delete(commandID string, time int64) error {
+	start := timemodule.Now()
+
+	err := s.CommandStore.Delete(commandID, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.GetByTeam(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+	start := timemodule.Now()
+
+	err := s.CommandStore.PermanentDeleteByTeam(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
+	start := timemodule.Now()
+
+	err := s.CommandStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.Save(webhook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandStore.Update(hook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandWebhookStore) Cleanup() {
+	start := timemodule.Now()
+
+	s.CommandWebhookStore.Cleanup()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
+	}
+}
+
+func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandWebhookStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.CommandWebhookStore.Save(webhook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
+	start := timemodule.Now()
+
+	err := s.CommandWebhookStore.TryUse(id, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+	start := timemodule.Now()
+
+	result, err := s.ComplianceStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+	start := timemodule.Now()
+
+	result, err := s.ComplianceStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerComplianceStore) MessageExport(cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.ComplianceStore.MessageExport(cursor, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+	start := timemodule.Now()
+
+	result, err := s.ComplianceStore.Save(compliance)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+	start := timemodule.Now()
+
+	result, err := s.ComplianceStore.Update(compliance)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerConfigHistoryStore) GetAll(offset int, limit int) ([]*model.ConfigVersion, error) {
+	start := timemodule.Now()
+
+	result, err := s.ConfigHistoryStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerConfigHistoryStore) GetByVersion(version string) (*model.ConfigVersion, error) {
+	start := timemodule.Now()
+
+	result, err := s.ConfigHistoryStore.GetByVersion(version)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.GetByVersion", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerConfigHistoryStore) Save(version *model.ConfigVersion) (*model.ConfigVersion, error) {
+	start := timemodule.Now()
+
+	result, err := s.ConfigHistoryStore.Save(version)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) Delete(id string) error {
+	start := timemodule.Now()
+
+	err := s.CustomStatusTemplateStore.Delete(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) Get(id string) (*model.CustomStatusTemplate, error) {
+	start := timemodule.Now()
+
+	result, err := s.CustomStatusTemplateStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) GetForTeam(teamID string) ([]*model.CustomStatusTemplate, error) {
+	start := timemodule.Now()
+
+	result, err := s.CustomStatusTemplateStore.GetForTeam(teamID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.GetForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) GetMostUsed() ([]*model.CustomStatusTemplate, error) {
+	start := timemodule.Now()
+
+	result, err := s.CustomStatusTemplateStore.GetMostUsed()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.GetMostUsed", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) IncrementUseCount(teamIDs []string, emoji string, text string, usedAt int64) error {
+	start := timemodule.Now()
+
+	err := s.CustomStatusTemplateStore.IncrementUseCount(teamIDs, emoji, text, usedAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.IncrementUseCount", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) Save(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+	start := timemodule.Now()
+
+	result, err := s.CustomStatusTemplateStore.Save(template)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerCustomStatusTemplateStore) Update(template *model.CustomStatusTemplate) (*model.CustomStatusTemplate, error) {
+	start := timemodule.Now()
+
+	result, err := s.CustomStatusTemplateStore.Update(template)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomStatusTemplateStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDirectChannelRetentionStore) Delete(channelID string) error {
+	start := timemodule.Now()
+
+	err := s.DirectChannelRetentionStore.Delete(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DirectChannelRetentionStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDirectChannelRetentionStore) Get(channelID string) (*model.DirectChannelRetention, error) {
+	start := timemodule.Now()
+
+	result, err := s.DirectChannelRetentionStore.Get(channelID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DirectChannelRetentionStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDirectChannelRetentionStore) GetActive(afterChannelID string, limit int) ([]*model.DirectChannelRetention, error) {
+	start := timemodule.Now()
+
+	result, err := s.DirectChannelRetentionStore.GetActive(afterChannelID, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DirectChannelRetentionStore.GetActive", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDirectChannelRetentionStore) Save(retention *model.DirectChannelRetention) (*model.DirectChannelRetention, error) {
+	start := timemodule.Now()
+
+	result, err := s.DirectChannelRetentionStore.Save(retention)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DirectChannelRetentionStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDirectChannelRetentionStore) UpdateLastPurgeAt(channelID string, purgeAt int64) error {
+	start := timemodule.Now()
+
+	err := s.DirectChannelRetentionStore.UpdateLastPurgeAt(channelID, purgeAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DirectChannelRetentionStore.UpdateLastPurgeAt", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerEmailSuppressionStore) Delete(email string) error {
+	start := timemodule.Now()
+
+	err := s.EmailSuppressionStore.Delete(email)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailSuppressionStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerEmailSuppressionStore) Get(email string) (*model.EmailSuppression, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmailSuppressionStore.Get(email)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailSuppressionStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmailSuppressionStore) GetAll(offset int, limit int) ([]*model.EmailSuppression, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmailSuppressionStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailSuppressionStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmailSuppressionStore) GetForEmails(emails []string) ([]*model.EmailSuppression, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmailSuppressionStore.GetForEmails(emails)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailSuppressionStore.GetForEmails", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmailSuppressionStore) Save(suppression *model.EmailSuppression) (*model.EmailSuppression, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmailSuppressionStore.Save(suppression)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmailSuppressionStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+	start := timemodule.Now()
+
+	err := s.EmojiStore.Delete(emoji, time)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.GetList(offset, limit, sort)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.GetMultipleByName(names)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.Save(emoji)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+	start := timemodule.Now()
+
+	result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) ClaimDelivery(id string, expectedNextAttemptAt int64, leaseUntil int64) (bool, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.ClaimDelivery(id, expectedNextAttemptAt, leaseUntil)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.ClaimDelivery", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) Delete(id string) error {
+	start := timemodule.Now()
+
+	err := s.EventWebhookStore.Delete(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerEventWebhookStore) Get(id string) (*model.EventWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) GetAll(offset int, limit int) ([]*model.EventWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.GetAll(offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) GetDeliveries(webhookID string, offset int, limit int) ([]*model.EventWebhookDelivery, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.GetDeliveries(webhookID, offset, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.GetDeliveries", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) GetDueDeliveries(now int64, limit int) ([]*model.EventWebhookDelivery, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.GetDueDeliveries(now, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.GetDueDeliveries", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) GetForEvent(event string) ([]*model.EventWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.GetForEvent(event)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.GetForEvent", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) PermanentDeleteDeliveriesBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.PermanentDeleteDeliveriesBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.PermanentDeleteDeliveriesBatch", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) Save(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.Save(webhook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) SaveDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.SaveDelivery(delivery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.SaveDelivery", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) Update(webhook *model.EventWebhook) (*model.EventWebhook, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.Update(webhook)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEventWebhookStore) UpdateDelivery(delivery *model.EventWebhookDelivery) (*model.EventWebhookDelivery, error) {
+	start := timemodule.Now()
+
+	result, err := s.EventWebhookStore.UpdateDelivery(delivery)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EventWebhookStore.UpdateDelivery", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, creatorID string) error {
+	start := timemodule.Now()
+
+	err := s.FileInfoStore.AttachToPost(fileID, postID, creatorID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileInfoStore) ClearCaches() {
+	start := timemodule.Now()
+
+	s.FileInfoStore.ClearCaches()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
+	}
+}
+
+func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.CountAll()
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) CountByPath(path string) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.CountByPath(path)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountByPath", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.DeleteForPost(postID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetAfter(afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetAfter(afterCreateAt, afterID, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetByContentHash(hash string, size int64, limit int) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetByContentHash(hash, size, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByContentHash", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetByIds(ids)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetByPath(path)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.FileForIndexing, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFilesBatchForIndexing", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetForPosts(postIds []string) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetForPosts(postIds)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPosts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetForTeamAfter(teamID string, afterCreateAt int64, afterID string, limit int) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetForTeamAfter(teamID, afterCreateAt, afterID, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForTeamAfter", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetForUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetFromMaster(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFromMaster", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+	start := timemodule.Now()
+
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
+	}
+}
+
+func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
+	start := timemodule.Now()
+
+	err := s.FileInfoStore.PermanentDelete(fileID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) RestoreForPosts(postIDs []string) error {
+	start := timemodule.Now()
+
+	err := s.FileInfoStore.RestoreForPosts(postIDs)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.RestoreForPosts", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.Save(info)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Search", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) error {
+	start := timemodule.Now()
+
+	err := s.FileInfoStore.SetContent(fileID, content)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContent", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileInfoStore) SetContentHash(fileID string, hash string, path string) error {
+	start := timemodule.Now()
+
+	err := s.FileInfoStore.SetContentHash(fileID, hash, path)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContentHash", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
+	start := timemodule.Now()
+
+	result, err := s.FileInfoStore.Upsert(info)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Upsert", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetPrivateChannelsForTeam(teamID, offset, limit)
+	result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1456,15 +5526,15 @@ func (s *TimerLayerChannelStore) GetPrivateChannelsForTeam(teamID string, offset
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPrivateChannelsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, channelIds []string) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetPublicChannelsByIdsForTeam(teamID, channelIds)
+	result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1472,15 +5542,15 @@ func (s *TimerLayerChannelStore) GetPublicChannelsByIdsForTeam(teamID string, ch
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPublicChannelsByIdsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset int, limit int) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetPublicChannelsForTeam(teamID, offset, limit)
+	result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1488,15 +5558,15 @@ func (s *TimerLayerChannelStore) GetPublicChannelsForTeam(teamID string, offset
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetPublicChannelsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetSidebarCategories(userID string, teamID string) (*model.OrderedSidebarCategories, error) {
+func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetSidebarCategories(userID, teamID)
+	result, err := s.GroupStore.ChannelMembersToRemove(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1504,15 +5574,15 @@ func (s *TimerLayerChannelStore) GetSidebarCategories(userID string, teamID stri
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategories", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error) {
+func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetSidebarCategory(categoryID)
+	result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1520,15 +5590,15 @@ func (s *TimerLayerChannelStore) GetSidebarCategory(categoryID string) (*model.S
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([]string, error) {
+func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetSidebarCategoryOrder(userID, teamID)
+	result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1536,15 +5606,15 @@ func (s *TimerLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategoryOrder", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetTeamChannels(teamID)
+	result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1552,15 +5622,15 @@ func (s *TimerLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelLi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetTeamForChannel(channelID string) (*model.Team, error) {
+func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetTeamForChannel(channelID)
+	result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1568,15 +5638,15 @@ func (s *TimerLayerChannelStore) GetTeamForChannel(channelID string) (*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]string, error) {
+func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GetTeamMembersForChannel(channelID)
+	result, err := s.GroupStore.Create(group)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1584,15 +5654,15 @@ func (s *TimerLayerChannelStore) GetTeamMembersForChannel(channelID string) ([]s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetTeamMembersForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
+func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.GroupSyncedChannelCount()
+	result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1600,15 +5670,15 @@ func (s *TimerLayerChannelStore) GroupSyncedChannelCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GroupSyncedChannelCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) IncrementMentionCount(channelID string, userIDs []string, isRoot bool) error {
+func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.IncrementMentionCount(channelID, userIDs, isRoot)
+	result, err := s.GroupStore.CreateWithUserIds(group)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1616,136 +5686,255 @@ func (s *TimerLayerChannelStore) IncrementMentionCount(channelID string, userIDs
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.IncrementMentionCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateWithUserIds", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateAllChannelMembersForUser(userID string) {
+func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateAllChannelMembersForUser(userID)
+	result, err := s.GroupStore.Delete(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateAllChannelMembersForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateCacheForChannelMembersNotifyProps(channelID string) {
+func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateCacheForChannelMembersNotifyProps(channelID)
+	result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateCacheForChannelMembersNotifyProps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateChannel(id string) {
+func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateChannel(id)
+	result, err := s.GroupStore.DeleteMember(groupID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateChannelByName(teamID string, name string) {
+func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateChannelByName(teamID, name)
+	result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateChannelByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMembers", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateGuestCount(channelID string) {
+func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateGuestCount(channelID)
+	result, err := s.GroupStore.DistinctGroupMemberCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateGuestCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidateMemberCount(channelID string) {
+func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidateMemberCount(channelID)
+	result, err := s.GroupStore.Get(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidateMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) InvalidatePinnedPostCount(channelID string) {
+func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
 	start := timemodule.Now()
 
-	s.ChannelStore.InvalidatePinnedPostCount(channelID)
+	result, err := s.GroupStore.GetAllBySource(groupSource)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.InvalidatePinnedPostCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) IsUserInChannelUseCache(userID string, channelID string) bool {
+func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
 	start := timemodule.Now()
 
-	result := s.ChannelStore.IsUserInChannelUseCache(userID, channelID)
+	result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.IsUserInChannelUseCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
 	}
-	return result
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetByIDs(groupIDs)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetByName(name, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetByUser(userID)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetGroups(page, perPage, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
+	start := timemodule.Now()
+
+	result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
+	}
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) MigrateChannelMembers(fromChannelID string, fromUserID string) (map[string]string, error) {
+func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.MigrateChannelMembers(fromChannelID, fromUserID)
+	result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1753,15 +5942,15 @@ func (s *TimerLayerChannelStore) MigrateChannelMembers(fromChannelID string, fro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.MigrateChannelMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) PermanentDelete(channelID string) error {
+func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.PermanentDelete(channelID)
+	result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1769,15 +5958,15 @@ func (s *TimerLayerChannelStore) PermanentDelete(channelID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
+func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.PermanentDeleteByTeam(teamID)
+	result, err := s.GroupStore.GetMember(groupID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1785,15 +5974,15 @@ func (s *TimerLayerChannelStore) PermanentDeleteByTeam(teamID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMember", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) PermanentDeleteMembersByChannel(channelID string) error {
+func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.PermanentDeleteMembersByChannel(channelID)
+	result, err := s.GroupStore.GetMemberCount(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1801,15 +5990,15 @@ func (s *TimerLayerChannelStore) PermanentDeleteMembersByChannel(channelID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteMembersByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.PermanentDeleteMembersByUser(userID)
+	result, err := s.GroupStore.GetMemberUsers(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1817,15 +6006,15 @@ func (s *TimerLayerChannelStore) PermanentDeleteMembersByUser(userID string) err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.PermanentDeleteMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) error {
+func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.RemoveAllDeactivatedMembers(channelID)
+	result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1833,15 +6022,15 @@ func (s *TimerLayerChannelStore) RemoveAllDeactivatedMembers(channelID string) e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveAllDeactivatedMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) RemoveMember(channelID string, userID string) error {
+func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.RemoveMember(channelID, userID)
+	result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1849,15 +6038,15 @@ func (s *TimerLayerChannelStore) RemoveMember(channelID string, userID string) e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) RemoveMembers(channelID string, userIds []string) error {
+func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.RemoveMembers(channelID, userIds)
+	result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1865,15 +6054,15 @@ func (s *TimerLayerChannelStore) RemoveMembers(channelID string, userIds []strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.RemoveMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) ResetAllChannelSchemes() error {
+func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.ResetAllChannelSchemes()
+	result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1881,15 +6070,15 @@ func (s *TimerLayerChannelStore) ResetAllChannelSchemes() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.ResetAllChannelSchemes", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetNonMemberUsersPage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) Restore(channelID string, time int64) error {
+func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.Restore(channelID, time)
+	result, err := s.GroupStore.GroupChannelCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1897,15 +6086,15 @@ func (s *TimerLayerChannelStore) Restore(channelID string, time int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Restore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam int64) (*model.Channel, error) {
+func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.Save(channel, maxChannelsPerTeam)
+	result, err := s.GroupStore.GroupCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1913,15 +6102,15 @@ func (s *TimerLayerChannelStore) Save(channel *model.Channel, maxChannelsPerTeam
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SaveDirectChannel(channel *model.Channel, member1 *model.ChannelMember, member2 *model.ChannelMember) (*model.Channel, error) {
+func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SaveDirectChannel(channel, member1, member2)
+	result, err := s.GroupStore.GroupCountWithAllowReference()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1929,15 +6118,15 @@ func (s *TimerLayerChannelStore) SaveDirectChannel(channel *model.Channel, membe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveDirectChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SaveMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SaveMember(member)
+	result, err := s.GroupStore.GroupMemberCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1945,15 +6134,15 @@ func (s *TimerLayerChannelStore) SaveMember(member *model.ChannelMember) (*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SaveMultipleMembers(members)
+	result, err := s.GroupStore.GroupTeamCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1961,15 +6150,15 @@ func (s *TimerLayerChannelStore) SaveMultipleMembers(members []*model.ChannelMem
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SaveMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchAllChannels(term string, opts store.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, error) {
+func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ChannelStore.SearchAllChannels(term, opts)
+	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1977,15 +6166,15 @@ func (s *TimerLayerChannelStore) SearchAllChannels(term string, opts store.Chann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchAllChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) SearchArchivedInTeam(teamID string, term string, userID string) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SearchArchivedInTeam(teamID, term, userID)
+	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -1993,15 +6182,15 @@ func (s *TimerLayerChannelStore) SearchArchivedInTeam(teamID string, term string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchArchivedInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchForUserInTeam(userID string, teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SearchForUserInTeam(userID, teamID, term, includeDeleted)
+	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2009,15 +6198,15 @@ func (s *TimerLayerChannelStore) SearchForUserInTeam(userID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchForUserInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchGroupChannels(userID string, term string) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SearchGroupChannels(userID, term)
+	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2025,15 +6214,15 @@ func (s *TimerLayerChannelStore) SearchGroupChannels(userID string, term string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchGroupChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchInTeam(teamID string, term string, includeDeleted bool) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SearchInTeam(teamID, term, includeDeleted)
+	result, err := s.GroupStore.TeamMembersToRemove(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2041,15 +6230,15 @@ func (s *TimerLayerChannelStore) SearchInTeam(teamID string, term string, includ
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SearchMore(userID string, teamID string, term string) (model.ChannelList, error) {
+func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.SearchMore(userID, teamID, term)
+	result, err := s.GroupStore.Update(group)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2057,15 +6246,15 @@ func (s *TimerLayerChannelStore) SearchMore(userID string, teamID string, term s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SearchMore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, updateAt int64) error {
+func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.SetDeleteAt(channelID, deleteAt, updateAt)
+	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2073,15 +6262,15 @@ func (s *TimerLayerChannelStore) SetDeleteAt(channelID string, deleteAt int64, u
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SetDeleteAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) SetShared(channelId string, shared bool) error {
+func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.SetShared(channelId, shared)
+	result, err := s.GroupStore.UpsertMember(groupID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2089,15 +6278,15 @@ func (s *TimerLayerChannelStore) SetShared(channelId string, shared bool) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.SetShared", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) Update(channel *model.Channel) (*model.Channel, error) {
+func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.Update(channel)
+	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2105,15 +6294,15 @@ func (s *TimerLayerChannelStore) Update(channel *model.Channel) (*model.Channel,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID string) (map[string]int64, error) {
+func (s *TimerLayerIdempotencyKeyStore) Delete(userID string, key string) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UpdateLastViewedAt(channelIds, userID)
+	err := s.IdempotencyKeyStore.Delete(userID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2121,15 +6310,15 @@ func (s *TimerLayerChannelStore) UpdateLastViewedAt(channelIds []string, userID
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateLastViewedAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post, userID string, mentionCount int, mentionCountRoot int, setUnreadCountRoot bool) (*model.ChannelUnreadAt, error) {
+func (s *TimerLayerIdempotencyKeyStore) DeleteStaleReservation(userID string, key string, reservedBefore int64) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UpdateLastViewedAtPost(unreadPost, userID, mentionCount, mentionCountRoot, setUnreadCountRoot)
+	result, err := s.IdempotencyKeyStore.DeleteStaleReservation(userID, key, reservedBefore)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2137,15 +6326,15 @@ func (s *TimerLayerChannelStore) UpdateLastViewedAtPost(unreadPost *model.Post,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateLastViewedAtPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.DeleteStaleReservation", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateMember(member *model.ChannelMember) (*model.ChannelMember, error) {
+func (s *TimerLayerIdempotencyKeyStore) Get(userID string, key string) (*model.IdempotencyKey, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UpdateMember(member)
+	result, err := s.IdempotencyKeyStore.Get(userID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2153,15 +6342,15 @@ func (s *TimerLayerChannelStore) UpdateMember(member *model.ChannelMember) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateMemberNotifyProps(channelID string, userID string, props map[string]string) (*model.ChannelMember, error) {
+func (s *TimerLayerIdempotencyKeyStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UpdateMemberNotifyProps(channelID, userID, props)
+	result, err := s.IdempotencyKeyStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2169,15 +6358,15 @@ func (s *TimerLayerChannelStore) UpdateMemberNotifyProps(channelID string, userI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMemberNotifyProps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateMembersRole(channelID string, userIDs []string) error {
+func (s *TimerLayerIdempotencyKeyStore) Save(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.UpdateMembersRole(channelID, userIDs)
+	result, err := s.IdempotencyKeyStore.Save(key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2185,15 +6374,15 @@ func (s *TimerLayerChannelStore) UpdateMembersRole(channelID string, userIDs []s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMembersRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelMember) ([]*model.ChannelMember, error) {
+func (s *TimerLayerIdempotencyKeyStore) Update(key *model.IdempotencyKey) (*model.IdempotencyKey, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UpdateMultipleMembers(members)
+	result, err := s.IdempotencyKeyStore.Update(key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2201,15 +6390,15 @@ func (s *TimerLayerChannelStore) UpdateMultipleMembers(members []*model.ChannelM
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IdempotencyKeyStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateSidebarCategories(userID string, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+func (s *TimerLayerImpersonationStore) Get(id string) (*model.Impersonation, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ChannelStore.UpdateSidebarCategories(userID, teamID, categories)
+	result, err := s.ImpersonationStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2217,15 +6406,15 @@ func (s *TimerLayerChannelStore) UpdateSidebarCategories(userID string, teamID s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarCategories", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ImpersonationStore.Get", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamID string, categoryOrder []string) error {
+func (s *TimerLayerImpersonationStore) GetForUser(userID string, offset int, limit int) ([]*model.Impersonation, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.UpdateSidebarCategoryOrder(userID, teamID, categoryOrder)
+	result, err := s.ImpersonationStore.GetForUser(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2233,15 +6422,15 @@ func (s *TimerLayerChannelStore) UpdateSidebarCategoryOrder(userID string, teamI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarCategoryOrder", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ImpersonationStore.GetForUser", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *model.Channel, newTeamID string) error {
+func (s *TimerLayerImpersonationStore) Save(impersonation *model.Impersonation) (*model.Impersonation, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.UpdateSidebarChannelCategoryOnMove(channel, newTeamID)
+	result, err := s.ImpersonationStore.Save(impersonation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2249,15 +6438,15 @@ func (s *TimerLayerChannelStore) UpdateSidebarChannelCategoryOnMove(channel *mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarChannelCategoryOnMove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ImpersonationStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences model.Preferences) error {
+func (s *TimerLayerImpersonationStore) UpdateStatus(impersonation *model.Impersonation, currentStatus string) (*model.Impersonation, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelStore.UpdateSidebarChannelsByPreferences(preferences)
+	result, err := s.ImpersonationStore.UpdateStatus(impersonation, currentStatus)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2265,15 +6454,15 @@ func (s *TimerLayerChannelStore) UpdateSidebarChannelsByPreferences(preferences
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UpdateSidebarChannelsByPreferences", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ImpersonationStore.UpdateStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelStore) UserBelongsToChannels(userID string, channelIds []string) (bool, error) {
+func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 	start := timemodule.Now()
 
-	result, err := s.ChannelStore.UserBelongsToChannels(userID, channelIds)
+	err := s.JobStore.Cleanup(expiryTime, batchSize)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2281,15 +6470,15 @@ func (s *TimerLayerChannelStore) UserBelongsToChannels(userID string, channelIds
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.UserBelongsToChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Cleanup", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerJobStore) Delete(id string) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelMemberHistoryStore.DeleteOrphanedRows(limit)
+	result, err := s.JobStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2297,15 +6486,15 @@ func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since int64) ([]string, error) {
+func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelMemberHistoryStore.GetChannelsLeftSince(userID, since)
+	result, err := s.JobStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2313,15 +6502,15 @@ func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetChannelsLeftSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
+func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelMemberHistoryStore.GetUsersInChannelDuring(startTime, endTime, channelID)
+	result, err := s.JobStore.GetAllByStatus(status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2329,15 +6518,15 @@ func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetUsersInChannelDuring", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channelID string, joinTime int64) error {
+func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelMemberHistoryStore.LogJoinEvent(userID, channelID, joinTime)
+	result, err := s.JobStore.GetAllByType(jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2345,15 +6534,15 @@ func (s *TimerLayerChannelMemberHistoryStore) LogJoinEvent(userID string, channe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogJoinEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, channelID string, leaveTime int64) error {
+func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.ChannelMemberHistoryStore.LogLeaveEvent(userID, channelID, leaveTime)
+	result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2361,15 +6550,15 @@ func (s *TimerLayerChannelMemberHistoryStore) LogLeaveEvent(userID string, chann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.LogLeaveEvent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypeAndStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	result, err := s.ChannelMemberHistoryStore.PermanentDeleteBatch(endTime, limit)
+	result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2377,15 +6566,15 @@ func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatch(endTime int64
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+	result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2393,15 +6582,15 @@ func (s *TimerLayerChannelMemberHistoryStore) PermanentDeleteBatchForRetentionPo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypesPage", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
+func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.ClusterDiscoveryStore.Cleanup()
+	result, err := s.JobStore.GetAllPage(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2409,15 +6598,15 @@ func (s *TimerLayerClusterDiscoveryStore) Cleanup() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ClusterDiscoveryStore.Delete(discovery)
+	result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2425,15 +6614,15 @@ func (s *TimerLayerClusterDiscoveryStore) Delete(discovery *model.ClusterDiscove
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscovery) (bool, error) {
+func (s *TimerLayerJobStore) GetCountByStatusSince(status string, since int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ClusterDiscoveryStore.Exists(discovery)
+	result, err := s.JobStore.GetCountByStatusSince(status, since)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2441,15 +6630,15 @@ func (s *TimerLayerClusterDiscoveryStore) Exists(discovery *model.ClusterDiscove
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Exists", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterName string) ([]*model.ClusterDiscovery, error) {
+func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 	start := timemodule.Now()
 
-	result, err := s.ClusterDiscoveryStore.GetAll(discoveryType, clusterName)
+	result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2457,15 +6646,15 @@ func (s *TimerLayerClusterDiscoveryStore) GetAll(discoveryType string, clusterNa
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.ClusterDiscoveryStore.Save(discovery)
+	result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2473,15 +6662,15 @@ func (s *TimerLayerClusterDiscoveryStore) Save(discovery *model.ClusterDiscovery
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusesAndType", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.ClusterDiscovery) error {
+func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.ClusterDiscoveryStore.SetLastPingAt(discovery)
+	result, err := s.JobStore.Save(job)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2489,15 +6678,15 @@ func (s *TimerLayerClusterDiscoveryStore) SetLastPingAt(discovery *model.Cluster
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ClusterDiscoveryStore.SetLastPingAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, error) {
+func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.AnalyticsCommandCount(teamID)
+	result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2505,15 +6694,15 @@ func (s *TimerLayerCommandStore) AnalyticsCommandCount(teamID string) (int64, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.AnalyticsCommandCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) Delete(commandID string, time int64) error {
+func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
 	start := timemodule.Now()
 
-	err := s.CommandStore.Delete(commandID, time)
+	result, err := s.JobStore.UpdateStatus(id, status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2521,15 +6710,15 @@ func (s *TimerLayerCommandStore) Delete(commandID string, time int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
+func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.Get(id)
+	result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2537,15 +6726,15 @@ func (s *TimerLayerCommandStore) Get(id string) (*model.Command, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, error) {
+func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.GetByTeam(teamID)
+	result, err := s.LicenseStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2553,15 +6742,15 @@ func (s *TimerLayerCommandStore) GetByTeam(teamID string) ([]*model.Command, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*model.Command, error) {
+func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.GetByTrigger(teamID, trigger)
+	result, err := s.LicenseStore.GetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2569,15 +6758,15 @@ func (s *TimerLayerCommandStore) GetByTrigger(teamID string, trigger string) (*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.GetByTrigger", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
+func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
 	start := timemodule.Now()
 
-	err := s.CommandStore.PermanentDeleteByTeam(teamID)
+	result, err := s.LicenseStore.Save(license)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2585,15 +6774,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByTeam(teamID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerLicenseUsageStore) GetForMonths(since int64, until int64) ([]*model.LicenseUsage, error) {
 	start := timemodule.Now()
 
-	err := s.CommandStore.PermanentDeleteByUser(userID)
+	result, err := s.LicenseUsageStore.GetForMonths(since, until)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2601,15 +6790,15 @@ func (s *TimerLayerCommandStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseUsageStore.GetForMonths", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, error) {
+func (s *TimerLayerLicenseUsageStore) RollupMonth(month int64) (*model.LicenseUsage, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.Save(webhook)
+	result, err := s.LicenseUsageStore.RollupMonth(month)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2617,15 +6806,15 @@ func (s *TimerLayerCommandStore) Save(webhook *model.Command) (*model.Command, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LicenseUsageStore.RollupMonth", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, error) {
+func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandStore.Update(hook)
+	result, err := s.LinkMetadataStore.Get(url, timestamp)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2633,30 +6822,31 @@ func (s *TimerLayerCommandStore) Update(hook *model.Command) (*model.Command, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandWebhookStore) Cleanup() {
+func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 	start := timemodule.Now()
 
-	s.CommandWebhookStore.Cleanup()
+	result, err := s.LinkMetadataStore.Save(linkMetadata)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, error) {
+func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 	start := timemodule.Now()
 
-	result, err := s.CommandWebhookStore.Get(id)
+	err := s.OAuthStore.DeleteApp(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2664,15 +6854,15 @@ func (s *TimerLayerCommandWebhookStore) Get(id string) (*model.CommandWebhook, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*model.CommandWebhook, error) {
+func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	result, err := s.CommandWebhookStore.Save(webhook)
+	result, err := s.OAuthStore.GetAccessData(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2680,15 +6870,15 @@ func (s *TimerLayerCommandWebhookStore) Save(webhook *model.CommandWebhook) (*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
+func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	err := s.CommandWebhookStore.TryUse(id, limit)
+	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2696,15 +6886,15 @@ func (s *TimerLayerCommandWebhookStore) TryUse(id string, limit int) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("CommandWebhookStore.TryUse", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
+func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ComplianceStore.ComplianceExport(compliance, cursor, limit)
+	result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2712,15 +6902,15 @@ func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Complianc
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
+func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, err := s.ComplianceStore.Get(id)
+	result, err := s.OAuthStore.GetApp(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2728,15 +6918,15 @@ func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Compliances, error) {
+func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, err := s.ComplianceStore.GetAll(offset, limit)
+	result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2744,15 +6934,15 @@ func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerComplianceStore) MessageExport(cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
+func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.ComplianceStore.MessageExport(cursor, limit)
+	result, err := s.OAuthStore.GetApps(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2760,15 +6950,15 @@ func (s *TimerLayerComplianceStore) MessageExport(cursor model.MessageExportCurs
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.MessageExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
 	start := timemodule.Now()
 
-	result, err := s.ComplianceStore.Save(compliance)
+	result, err := s.OAuthStore.GetAuthData(code)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2776,15 +6966,15 @@ func (s *TimerLayerComplianceStore) Save(compliance *model.Compliance) (*model.C
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model.Compliance, error) {
+func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, err := s.ComplianceStore.Update(compliance)
+	result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2792,15 +6982,15 @@ func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
+func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	err := s.EmojiStore.Delete(emoji, time)
+	result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2808,15 +6998,15 @@ func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.Get(ctx, id, allowFromCache)
+	err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2824,15 +7014,15 @@ func (s *TimerLayerEmojiStore) Get(ctx context.Context, id string, allowFromCach
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.GetByName(ctx, name, allowFromCache)
+	err := s.OAuthStore.RemoveAccessData(token)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2840,15 +7030,15 @@ func (s *TimerLayerEmojiStore) GetByName(ctx context.Context, name string, allow
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.GetList(offset, limit, sort)
+	err := s.OAuthStore.RemoveAllAccessData()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2856,15 +7046,15 @@ func (s *TimerLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetList", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.GetMultipleByName(names)
+	err := s.OAuthStore.RemoveAuthData(code)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2872,15 +7062,15 @@ func (s *TimerLayerEmojiStore) GetMultipleByName(names []string) ([]*model.Emoji
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetMultipleByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.Save(emoji)
+	result, err := s.OAuthStore.SaveAccessData(accessData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2888,15 +7078,15 @@ func (s *TimerLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+	result, err := s.OAuthStore.SaveApp(app)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2904,15 +7094,15 @@ func (s *TimerLayerEmojiStore) Search(name string, prefixOnly bool, limit int) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, creatorID string) error {
+func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
 	start := timemodule.Now()
 
-	err := s.FileInfoStore.AttachToPost(fileID, postID, creatorID)
+	result, err := s.OAuthStore.SaveAuthData(authData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2920,30 +7110,31 @@ func (s *TimerLayerFileInfoStore) AttachToPost(fileID string, postID string, cre
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.AttachToPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) ClearCaches() {
+func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
 	start := timemodule.Now()
 
-	s.FileInfoStore.ClearCaches()
+	result, err := s.OAuthStore.UpdateAccessData(accessData)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
+func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.CountAll()
+	result, err := s.OAuthStore.UpdateApp(app)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2951,15 +7142,15 @@ func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
+func (s *TimerLayerOAuthAppUsageStore) GetSummaries(since int64, offset int, limit int) ([]*model.OAuthAppUsageSummary, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.DeleteForPost(postID)
+	result, err := s.OAuthAppUsageStore.GetSummaries(since, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2967,15 +7158,15 @@ func (s *TimerLayerFileInfoStore) DeleteForPost(postID string) (string, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.DeleteForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthAppUsageStore.GetSummaries", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+func (s *TimerLayerOAuthAppUsageStore) Increment(usages []*model.OAuthAppUsage) error {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.Get(id)
+	err := s.OAuthAppUsageStore.Increment(usages)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2983,15 +7174,15 @@ func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthAppUsageStore.Increment", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+func (s *TimerLayerOAuthAppUsageStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetByIds(ids)
+	result, err := s.OAuthAppUsageStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -2999,15 +7190,15 @@ func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthAppUsageStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+func (s *TimerLayerOutstandingMentionStore) GetOutstanding(userID string, teamID string, offset int, limit int) ([]*model.OutstandingMention, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetByPath(path)
+	result, err := s.OutstandingMentionStore.GetOutstanding(userID, teamID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3015,15 +7206,15 @@ func (s *TimerLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByPath", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.GetOutstanding", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.FileForIndexing, error) {
+func (s *TimerLayerOutstandingMentionStore) GetOutstandingCounts(mentionedBefore int64, afterUserID string, limit int) ([]*model.OutstandingMentionCount, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, endTime, limit)
+	result, err := s.OutstandingMentionStore.GetOutstandingCounts(mentionedBefore, afterUserID, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3031,15 +7222,15 @@ func (s *TimerLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, endT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFilesBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.GetOutstandingCounts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+func (s *TimerLayerOutstandingMentionStore) GetStats(userID string, teamID string) (*model.OutstandingMentionStats, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+	result, err := s.OutstandingMentionStore.GetStats(userID, teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3047,15 +7238,15 @@ func (s *TimerLayerFileInfoStore) GetForPost(postID string, readFromMaster bool,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.GetStats", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+func (s *TimerLayerOutstandingMentionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetForUser(userID)
+	result, err := s.OutstandingMentionStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3063,15 +7254,15 @@ func (s *TimerLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+func (s *TimerLayerOutstandingMentionStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetFromMaster(id)
+	err := s.OutstandingMentionStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3079,15 +7270,15 @@ func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetFromMaster", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+func (s *TimerLayerOutstandingMentionStore) ResolveForPost(userID string, postID string, resolvedAt int64) error {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+	err := s.OutstandingMentionStore.ResolveForPost(userID, postID, resolvedAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3095,30 +7286,31 @@ func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.ResolveForPost", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+func (s *TimerLayerOutstandingMentionStore) ResolveForThread(userID string, channelID string, rootID string, resolvedAt int64) error {
 	start := timemodule.Now()
 
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+	err := s.OutstandingMentionStore.ResolveForThread(userID, channelID, rootID, resolvedAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.InvalidateFileInfosForPostCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.ResolveForThread", success, elapsed)
 	}
+	return err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
+func (s *TimerLayerOutstandingMentionStore) Save(mentions []*model.OutstandingMention) error {
 	start := timemodule.Now()
 
-	err := s.FileInfoStore.PermanentDelete(fileID)
+	err := s.OutstandingMentionStore.Save(mentions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3126,15 +7318,15 @@ func (s *TimerLayerFileInfoStore) PermanentDelete(fileID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("OutstandingMentionStore.Save", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerPermissionDenialStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.PermanentDeleteBatch(endTime, limit)
+	result, err := s.PermissionDenialStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3142,15 +7334,15 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(endTime int64, limit int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PermissionDenialStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, error) {
+func (s *TimerLayerPermissionDenialStore) Save(denial *model.PermissionDenial) (*model.PermissionDenial, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.PermanentDeleteByUser(userID)
+	result, err := s.PermissionDenialStore.Save(denial)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3158,15 +7350,15 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(userID string) (int64, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PermissionDenialStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *TimerLayerPermissionDenialStore) Search(opts *model.PermissionDenialSearch, offset int, limit int) ([]*model.PermissionDenial, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.Save(info)
+	result, err := s.PermissionDenialStore.Search(opts, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3174,15 +7366,15 @@ func (s *TimerLayerFileInfoStore) Save(info *model.FileInfo) (*model.FileInfo, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PermissionDenialStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Search(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.Search(paramsList, userID, teamID, page, perPage)
+	result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3190,15 +7382,15 @@ func (s *TimerLayerFileInfoStore) Search(paramsList []*model.SearchParams, userI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) error {
+func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 	start := timemodule.Now()
 
-	err := s.FileInfoStore.SetContent(fileID, content)
+	result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3206,15 +7398,15 @@ func (s *TimerLayerFileInfoStore) SetContent(fileID string, content string) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContent", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo, error) {
+func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
 	start := timemodule.Now()
 
-	result, err := s.FileInfoStore.Upsert(info)
+	err := s.PluginStore.Delete(pluginID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3222,15 +7414,15 @@ func (s *TimerLayerFileInfoStore) Upsert(info *model.FileInfo) (*model.FileInfo,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.Upsert", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *TimerLayerPluginStore) DeleteAllExpired() error {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+	err := s.PluginStore.DeleteAllExpired()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3238,15 +7430,15 @@ func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.AdminRoleGroupsForSyncableMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+	err := s.PluginStore.DeleteAllForPlugin(PluginID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3254,15 +7446,15 @@ func (s *TimerLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
+func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
+	result, err := s.PluginStore.Get(pluginID, key)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3270,15 +7462,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToAdd(since int64, channelID *strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
+func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.ChannelMembersToRemove(channelID)
+	result, err := s.PluginStore.List(pluginID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3286,15 +7478,15 @@ func (s *TimerLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.ChannelMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
+func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+	result, err := s.PluginStore.SaveOrUpdate(keyVal)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3302,15 +7494,15 @@ func (s *TimerLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountChannelMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+	result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3318,15 +7510,15 @@ func (s *TimerLayerGroupStore) CountGroupsByChannel(channelID string, opts model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *TimerLayerPostStore) AnalyticsPostCount(teamID string, mustHaveFile bool, mustHaveHashtag bool) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+	result, err := s.PostStore.AnalyticsPostCount(teamID, mustHaveFile, mustHaveHashtag)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3334,15 +7526,15 @@ func (s *TimerLayerGroupStore) CountGroupsByTeam(teamID string, opts model.Group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+	result, err := s.PostStore.AnalyticsPostCountsByDay(options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3350,15 +7542,15 @@ func (s *TimerLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CountTeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error) {
+func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.Create(group)
+	result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3366,31 +7558,30 @@ func (s *TimerLayerGroupStore) Create(group *model.Group) (*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Create", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) CreateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *TimerLayerPostStore) ClearCaches() {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CreateGroupSyncable(groupSyncable)
+	s.PostStore.ClearCaches()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds) (*model.Group, error) {
+func (s *TimerLayerPostStore) CountForRetentionPolicies(now int64, globalPolicyEndTime int64) (*model.RetentionPolicyDryRun, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.CreateWithUserIds(group)
+	result, err := s.PostStore.CountForRetentionPolicies(now, globalPolicyEndTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3398,15 +7589,15 @@ func (s *TimerLayerGroupStore) CreateWithUserIds(group *model.GroupWithUserIds)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.CreateWithUserIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.CountForRetentionPolicies", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
+func (s *TimerLayerPostStore) CountPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.Delete(groupID)
+	result, err := s.PostStore.CountPostsSinceForSync(options, cursor)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3414,15 +7605,15 @@ func (s *TimerLayerGroupStore) Delete(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.CountPostsSinceForSync", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *TimerLayerPostStore) Delete(postID string, time int64, deleteByID string) error {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.DeleteGroupSyncable(groupID, syncableID, syncableType)
+	err := s.PostStore.Delete(postID, time, deleteByID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3430,15 +7621,15 @@ func (s *TimerLayerGroupStore) DeleteGroupSyncable(groupID string, syncableID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.DeleteMember(groupID, userID)
+	result, err := s.PostStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3446,15 +7637,15 @@ func (s *TimerLayerGroupStore) DeleteMember(groupID string, userID string) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteOrphanedRows", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *TimerLayerPostStore) Get(ctx context.Context, id string, skipFetchThreads bool, collapsedThreads bool, collapsedThreadsExtended bool, userID string) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.DeleteMembers(groupID, userIDs)
+	result, err := s.PostStore.Get(ctx, id, skipFetchThreads, collapsedThreads, collapsedThreadsExtended, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3462,15 +7653,15 @@ func (s *TimerLayerGroupStore) DeleteMembers(groupID string, userIDs []string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DeleteMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
+func (s *TimerLayerPostStore) GetDeletedForChannel(channelID string, since int64, offset int, limit int) ([]*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.DistinctGroupMemberCount()
+	result, err := s.PostStore.GetDeletedForChannel(channelID, since, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3478,15 +7669,15 @@ func (s *TimerLayerGroupStore) DistinctGroupMemberCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.DistinctGroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDeletedForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
+func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.Get(groupID)
+	result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3494,31 +7685,31 @@ func (s *TimerLayerGroupStore) Get(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error) {
+func (s *TimerLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetAllBySource(groupSource)
+	result := s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllBySource", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
 	}
-	return result, err
+	return result
 }
 
-func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syncableType model.GroupSyncableType) ([]*model.GroupSyncable, error) {
+func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetAllGroupSyncablesByGroupId(groupID, syncableType)
+	result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3526,15 +7717,15 @@ func (s *TimerLayerGroupStore) GetAllGroupSyncablesByGroupId(groupID string, syn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetAllGroupSyncablesByGroupId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, error) {
+func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetByIDs(groupIDs)
+	result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3542,15 +7733,15 @@ func (s *TimerLayerGroupStore) GetByIDs(groupIDs []string) ([]*model.Group, erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByIDs", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts) (*model.Group, error) {
+func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetByName(name, opts)
+	result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3558,15 +7749,15 @@ func (s *TimerLayerGroupStore) GetByName(name string, opts model.GroupSearchOpts
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.GroupSource) (*model.Group, error) {
+func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetByRemoteID(remoteID, groupSource)
+	result, err := s.PostStore.GetLastPostRowCreateAt()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3574,31 +7765,31 @@ func (s *TimerLayerGroupStore) GetByRemoteID(remoteID string, groupSource model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByRemoteID", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetLastPostRowCreateAt", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetByUser(userID string) ([]*model.Group, error) {
+func (s *TimerLayerPostStore) GetMaxPostSize() int {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetByUser(userID)
+	result := s.PostStore.GetMaxPostSize()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
 	}
-	return result, err
+	return result
 }
 
-func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID string, syncableType model.GroupSyncableType) (*model.GroupSyncable, error) {
+func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetGroupSyncable(groupID, syncableID, syncableType)
+	result, err := s.PostStore.GetOldest()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3606,15 +7797,15 @@ func (s *TimerLayerGroupStore) GetGroupSyncable(groupID string, syncableID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.GroupSearchOpts) ([]*model.Group, error) {
+func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetGroups(page, perPage, opts)
+	result, err := s.PostStore.GetOldestEntityCreationTime()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3622,15 +7813,15 @@ func (s *TimerLayerGroupStore) GetGroups(page int, perPage int, opts model.Group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroups", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string, opts model.GroupSearchOpts) (map[string][]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetGroupsAssociatedToChannelsByTeam(teamID, opts)
+	result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3638,15 +7829,15 @@ func (s *TimerLayerGroupStore) GetGroupsAssociatedToChannelsByTeam(teamID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsAssociatedToChannelsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, time int64, collapsedThreads bool) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetGroupsByChannel(channelID, opts)
+	result, err := s.PostStore.GetPostAfterTime(channelID, time, collapsedThreads)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3654,15 +7845,15 @@ func (s *TimerLayerGroupStore) GetGroupsByChannel(channelID string, opts model.G
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, error) {
+func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, time int64, collapsedThreads bool) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetGroupsByTeam(teamID, opts)
+	result, err := s.PostStore.GetPostIdAfterTime(channelID, time, collapsedThreads)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3670,15 +7861,15 @@ func (s *TimerLayerGroupStore) GetGroupsByTeam(teamID string, opts model.GroupSe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetGroupsByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMember(groupID, userID)
+	result, err := s.PostStore.GetPostIdBeforeTime(channelID, time, collapsedThreads)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3686,15 +7877,15 @@ func (s *TimerLayerGroupStore) GetMember(groupID string, userID string) (*model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
+func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMemberCount(groupID)
+	result, err := s.PostStore.GetPosts(options, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3702,15 +7893,15 @@ func (s *TimerLayerGroupStore) GetMemberCount(groupID string) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, error) {
+func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMemberUsers(groupID)
+	result, err := s.PostStore.GetPostsAfter(options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3718,15 +7909,15 @@ func (s *TimerLayerGroupStore) GetMemberUsers(groupID string) ([]*model.User, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID string) ([]*model.User, error) {
+func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMemberUsersInTeam(groupID, teamID)
+	result, err := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3734,15 +7925,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersInTeam(groupID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersInTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channelID string) ([]*model.User, error) {
+func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMemberUsersNotInChannel(groupID, channelID)
+	result, err := s.PostStore.GetPostsBefore(options)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3750,15 +7941,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersNotInChannel(groupID string, channe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersNotInChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
+func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetMemberUsersPage(groupID, page, perPage)
+	result, err := s.PostStore.GetPostsByIds(postIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3766,15 +7957,15 @@ func (s *TimerLayerGroupStore) GetMemberUsersPage(groupID string, page int, perP
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetMemberUsersPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, perPage int) ([]*model.User, error) {
+func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, time int64) ([]*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GetNonMemberUsersPage(groupID, page, perPage)
+	result, err := s.PostStore.GetPostsCreatedAt(channelID, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3782,15 +7973,15 @@ func (s *TimerLayerGroupStore) GetNonMemberUsersPage(groupID string, page int, p
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GetNonMemberUsersPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
+func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GroupChannelCount()
+	result, err := s.PostStore.GetPostsSince(options, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3798,15 +7989,15 @@ func (s *TimerLayerGroupStore) GroupChannelCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupChannelCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
+func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GroupCount()
+	result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3814,15 +8005,15 @@ func (s *TimerLayerGroupStore) GroupCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSinceForSync", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
+func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GroupCountWithAllowReference()
+	result, err := s.PostStore.GetRepliesForExport(parentID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3830,31 +8021,31 @@ func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
+func (s *TimerLayerPostStore) GetSearchCapabilities() *model.SearchCapabilities {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GroupMemberCount()
+	result := s.PostStore.GetSearchCapabilities()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSearchCapabilities", success, elapsed)
 	}
-	return result, err
+	return result
 }
 
-func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
+func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.GroupTeamCount()
+	result, err := s.PostStore.GetSingle(id, inclDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3862,15 +8053,15 @@ func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
 	start := timemodule.Now()
 
-	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+	result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3878,31 +8069,30 @@ func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.HasAutoResponsePostByUserSince", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+	s.PostStore.InvalidateLastPostTimeCache(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+	result, err := s.PostStore.Overwrite(post)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3910,15 +8100,15 @@ func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+	result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3926,15 +8116,15 @@ func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, inc
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
+func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.TeamMembersToRemove(teamID)
+	result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3942,15 +8132,15 @@ func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
+func (s *TimerLayerPostStore) PermanentDeleteBatchForChannel(channelID string, endTime int64, limit int64) (int64, []*model.FileInfo, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.Update(group)
+	result, resultVar1, err := s.PostStore.PermanentDeleteBatchForChannel(channelID, endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3958,15 +8148,15 @@ func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatchForChannel", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+	result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3974,15 +8164,15 @@ func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.UpsertMember(groupID, userID)
+	err := s.PostStore.PermanentDeleteByChannel(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -3990,15 +8180,15 @@ func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+	err := s.PostStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4006,15 +8196,15 @@ func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+func (s *TimerLayerPostStore) Restore(postID string) ([]string, error) {
 	start := timemodule.Now()
 
-	err := s.JobStore.Cleanup(expiryTime, batchSize)
+	result, err := s.PostStore.Restore(postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4022,15 +8212,15 @@ func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Restore", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerJobStore) Delete(id string) (string, error) {
+func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.Delete(id)
+	result, err := s.PostStore.Save(post)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4038,15 +8228,15 @@ func (s *TimerLayerJobStore) Delete(id string) (string, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
+func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.Get(id)
+	result, resultVar1, err := s.PostStore.SaveMultiple(posts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4054,15 +8244,15 @@ func (s *TimerLayerJobStore) Get(id string) (*model.Job, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error) {
+func (s *TimerLayerPostStore) SaveWithExtras(post *model.Post, extras store.PostExtras) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllByStatus(status)
+	result, err := s.PostStore.SaveWithExtras(post, extras)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4070,15 +8260,15 @@ func (s *TimerLayerJobStore) GetAllByStatus(status string) ([]*model.Job, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveWithExtras", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error) {
+func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllByType(jobType)
+	result, err := s.PostStore.Search(teamID, userID, params)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4086,15 +8276,15 @@ func (s *TimerLayerJobStore) GetAllByType(jobType string) ([]*model.Job, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string) ([]*model.Job, error) {
+func (s *TimerLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllByTypeAndStatus(jobType, status)
+	result, err := s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4102,15 +8292,15 @@ func (s *TimerLayerJobStore) GetAllByTypeAndStatus(jobType string, status string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypeAndStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllByTypePage(jobType, offset, limit)
+	result, err := s.PostStore.Update(newPost, oldPost)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4118,15 +8308,15 @@ func (s *TimerLayerJobStore) GetAllByTypePage(jobType string, offset int, limit
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypePage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerPostAcknowledgementStore) Delete(userID string, postID string) error {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllByTypesPage(jobTypes, offset, limit)
+	err := s.PostAcknowledgementStore.Delete(userID, postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4134,15 +8324,15 @@ func (s *TimerLayerJobStore) GetAllByTypesPage(jobTypes []string, offset int, li
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllByTypesPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAcknowledgement, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetAllPage(offset, limit)
+	result, err := s.PostAcknowledgementStore.GetForPost(postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4150,15 +8340,15 @@ func (s *TimerLayerJobStore) GetAllPage(offset int, limit int) ([]*model.Job, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPost", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetForPosts(postIDs []string) ([]*model.PostAcknowledgement, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
+	result, err := s.PostAcknowledgementStore.GetForPosts(postIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4166,15 +8356,15 @@ func (s *TimerLayerJobStore) GetCountByStatusAndType(status string, jobType stri
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetCountByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetSummariesForUser(userID string, offset int, limit int) ([]*model.PostAcknowledgementSummary, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+	result, err := s.PostAcknowledgementStore.GetSummariesForUser(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4182,15 +8372,15 @@ func (s *TimerLayerJobStore) GetNewestJobByStatusAndType(status string, jobType
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetSummariesForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
+func (s *TimerLayerPostAcknowledgementStore) Save(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
+	result, err := s.PostAcknowledgementStore.Save(acknowledgement)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4198,15 +8388,15 @@ func (s *TimerLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.GetNewestJobByStatusesAndType", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
+func (s *TimerLayerPostModerationStore) Get(id string) (*model.PostModeration, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.Save(job)
+	result, err := s.PostModerationStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4214,15 +8404,15 @@ func (s *TimerLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostModerationStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
+func (s *TimerLayerPostModerationStore) GetAll(teamID string, status string, offset int, limit int) ([]*model.PostModeration, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
+	result, err := s.PostModerationStore.GetAll(teamID, status, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4230,15 +8420,15 @@ func (s *TimerLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostModerationStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
+func (s *TimerLayerPostModerationStore) Review(moderation *model.PostModeration) (*model.PostModeration, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.UpdateStatus(id, status)
+	result, err := s.PostModerationStore.Review(moderation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4246,15 +8436,15 @@ func (s *TimerLayerJobStore) UpdateStatus(id string, status string) (*model.Job,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostModerationStore.Review", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
+func (s *TimerLayerPostModerationStore) Save(moderation *model.PostModeration) (*model.PostModeration, error) {
 	start := timemodule.Now()
 
-	result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+	result, err := s.PostModerationStore.Save(moderation)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4262,15 +8452,15 @@ func (s *TimerLayerJobStore) UpdateStatusOptimistically(id string, currentStatus
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("JobStore.UpdateStatusOptimistically", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostModerationStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
+func (s *TimerLayerPostPriorityStore) GetForPost(postID string) (*model.PostPriority, error) {
 	start := timemodule.Now()
 
-	result, err := s.LicenseStore.Get(id)
+	result, err := s.PostPriorityStore.GetForPost(postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4278,15 +8468,15 @@ func (s *TimerLayerLicenseStore) Get(id string) (*model.LicenseRecord, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPost", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
+func (s *TimerLayerPostPriorityStore) GetForPosts(postIDs []string) ([]*model.PostPriority, error) {
 	start := timemodule.Now()
 
-	result, err := s.LicenseStore.GetAll()
+	result, err := s.PostPriorityStore.GetForPosts(postIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4294,15 +8484,15 @@ func (s *TimerLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.GetForPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.LicenseRecord, error) {
+func (s *TimerLayerPostPriorityStore) Save(priority *model.PostPriority) (*model.PostPriority, error) {
 	start := timemodule.Now()
 
-	result, err := s.LicenseStore.Save(license)
+	result, err := s.PostPriorityStore.Save(priority)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4310,15 +8500,15 @@ func (s *TimerLayerLicenseStore) Save(license *model.LicenseRecord) (*model.Lice
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LicenseStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPriorityStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *TimerLayerPostReportStore) Get(id string) (*model.PostReport, error) {
 	start := timemodule.Now()
 
-	result, err := s.LinkMetadataStore.Get(url, timestamp)
+	result, err := s.PostReportStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4326,15 +8516,15 @@ func (s *TimerLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.L
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostReportStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *TimerLayerPostReportStore) GetAll(teamID string, status string, offset int, limit int) ([]*model.PostReport, error) {
 	start := timemodule.Now()
 
-	result, err := s.LinkMetadataStore.Save(linkMetadata)
+	result, err := s.PostReportStore.GetAll(teamID, status, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4342,15 +8532,15 @@ func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("LinkMetadataStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostReportStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
+func (s *TimerLayerPostReportStore) Resolve(report *model.PostReport) (*model.PostReport, error) {
 	start := timemodule.Now()
 
-	err := s.OAuthStore.DeleteApp(id)
+	result, err := s.PostReportStore.Resolve(report)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4358,15 +8548,15 @@ func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostReportStore.Resolve", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *TimerLayerPostReportStore) Save(report *model.PostReport) (*model.PostReport, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAccessData(token)
+	result, err := s.PostReportStore.Save(report)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4374,15 +8564,15 @@ func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostReportStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *TimerLayerPostTaskStore) Get(postID string) (*model.PostTask, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+	result, err := s.PostTaskStore.Get(postID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4390,15 +8580,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByRefreshToken", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTaskStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+func (s *TimerLayerPostTaskStore) GetForAssignee(assigneeID string, statuses []string, offset int, limit int) ([]*model.PostTask, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+	result, err := s.PostTaskStore.GetForAssignee(assigneeID, statuses, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4406,15 +8596,15 @@ func (s *TimerLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessDataByUserForApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTaskStore.GetForAssignee", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *TimerLayerPostTaskStore) Save(task *model.PostTask) (*model.PostTask, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetApp(id)
+	result, err := s.PostTaskStore.Save(task)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4422,15 +8612,15 @@ func (s *TimerLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTaskStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerPostTaskStore) Update(task *model.PostTask) (*model.PostTask, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+	result, err := s.PostTaskStore.Update(task)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4438,15 +8628,15 @@ func (s *TimerLayerOAuthStore) GetAppByUser(userID string, offset int, limit int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAppByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostTaskStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetApps(offset, limit)
+	result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4454,15 +8644,15 @@ func (s *TimerLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *TimerLayerPreferenceStore) CompareAndSet(preferences model.Preferences) (model.Preferences, model.Preferences, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAuthData(code)
+	result, resultVar1, err := s.PreferenceStore.CompareAndSet(preferences)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4470,15 +8660,15 @@ func (s *TimerLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CompareAndSet", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name string) error {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+	err := s.PreferenceStore.Delete(userID, category, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4486,15 +8676,15 @@ func (s *TimerLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAuthorizedApps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId string) (*model.AccessData, error) {
+func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category string) error {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.GetPreviousAccessData(userID, clientId)
+	err := s.PreferenceStore.DeleteCategory(userID, category)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4502,15 +8692,15 @@ func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientId str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetPreviousAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
+func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
 	start := timemodule.Now()
 
-	err := s.OAuthStore.PermanentDeleteAuthDataByUser(userID)
+	err := s.PreferenceStore.DeleteCategoryAndName(category, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4518,15 +8708,15 @@ func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.PermanentDeleteAuthDataByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
+func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := timemodule.Now()
 
-	err := s.OAuthStore.RemoveAccessData(token)
+	result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4534,15 +8724,15 @@ func (s *TimerLayerOAuthStore) RemoveAccessData(token string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteOrphanedRows", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
+func (s *TimerLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
 	start := timemodule.Now()
 
-	err := s.OAuthStore.RemoveAllAccessData()
+	result, err := s.PreferenceStore.Get(userID, category, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4550,15 +8740,15 @@ func (s *TimerLayerOAuthStore) RemoveAllAccessData() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAllAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
+func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
 	start := timemodule.Now()
 
-	err := s.OAuthStore.RemoveAuthData(code)
+	result, err := s.PreferenceStore.GetAll(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4566,15 +8756,15 @@ func (s *TimerLayerOAuthStore) RemoveAuthData(code string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.RemoveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.SaveAccessData(accessData)
+	result, err := s.PreferenceStore.GetCategory(userID, category)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4582,15 +8772,15 @@ func (s *TimerLayerOAuthStore) SaveAccessData(accessData *model.AccessData) (*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerPreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.SaveApp(app)
+	result, err := s.PreferenceStore.GetForUsers(userIDs, category, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4598,15 +8788,15 @@ func (s *TimerLayerOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetForUsers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.AuthData, error) {
+func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.SaveAuthData(authData)
+	err := s.PreferenceStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4614,15 +8804,15 @@ func (s *TimerLayerOAuthStore) SaveAuthData(authData *model.AuthData) (*model.Au
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.SaveAuthData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*model.AccessData, error) {
+func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.UpdateAccessData(accessData)
+	err := s.PreferenceStore.Save(preferences)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4630,15 +8820,15 @@ func (s *TimerLayerOAuthStore) UpdateAccessData(accessData *model.AccessData) (*
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
 	start := timemodule.Now()
 
-	result, err := s.OAuthStore.UpdateApp(app)
+	err := s.ProductNoticesStore.Clear(notices)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4646,15 +8836,15 @@ func (s *TimerLayerOAuthStore) UpdateApp(app *model.OAuthApp) (*model.OAuthApp,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.UpdateApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.Clear", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.CompareAndDelete(keyVal, oldValue)
+	err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4662,15 +8852,15 @@ func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, o
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.ClearOldNotices", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
+func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.CompareAndSet(keyVal, oldValue)
+	result, err := s.ProductNoticesStore.GetViews(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4678,15 +8868,15 @@ func (s *TimerLayerPluginStore) CompareAndSet(keyVal *model.PluginKeyValue, oldV
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.CompareAndSet", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.GetViews", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
+func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) error {
 	start := timemodule.Now()
 
-	err := s.PluginStore.Delete(pluginID, key)
+	err := s.ProductNoticesStore.View(userID, notices)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4694,15 +8884,15 @@ func (s *TimerLayerPluginStore) Delete(pluginID string, key string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.View", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPluginStore) DeleteAllExpired() error {
+func (s *TimerLayerRESTProxyBridgeStore) GetPending(limit int) ([]*model.RESTProxyBridgeMessage, error) {
 	start := timemodule.Now()
 
-	err := s.PluginStore.DeleteAllExpired()
+	result, err := s.RESTProxyBridgeStore.GetPending(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4710,15 +8900,15 @@ func (s *TimerLayerPluginStore) DeleteAllExpired() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllExpired", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.GetPending", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
+func (s *TimerLayerRESTProxyBridgeStore) GetStatus() (*model.RESTProxyBridgeStatus, error) {
 	start := timemodule.Now()
 
-	err := s.PluginStore.DeleteAllForPlugin(PluginID)
+	result, err := s.RESTProxyBridgeStore.GetStatus()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4726,15 +8916,15 @@ func (s *TimerLayerPluginStore) DeleteAllForPlugin(PluginID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.DeleteAllForPlugin", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.GetStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginKeyValue, error) {
+func (s *TimerLayerRESTProxyBridgeStore) MarkPublished(ids []string, publishedAt int64) error {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.Get(pluginID, key)
+	err := s.RESTProxyBridgeStore.MarkPublished(ids, publishedAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4742,15 +8932,15 @@ func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginK
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.MarkPublished", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
+func (s *TimerLayerRESTProxyBridgeStore) PermanentDeletePublishedBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.List(pluginID, page, perPage)
+	result, err := s.RESTProxyBridgeStore.PermanentDeletePublishedBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4758,15 +8948,15 @@ func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.List", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.PermanentDeletePublishedBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
+func (s *TimerLayerRESTProxyBridgeStore) Save(message *model.RESTProxyBridgeMessage) (*model.RESTProxyBridgeMessage, error) {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.SaveOrUpdate(keyVal)
+	result, err := s.RESTProxyBridgeStore.Save(message)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4774,15 +8964,15 @@ func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, error) {
+func (s *TimerLayerRESTProxyBridgeStore) UpdateAttempt(message *model.RESTProxyBridgeMessage) error {
 	start := timemodule.Now()
 
-	result, err := s.PluginStore.SetWithOptions(pluginID, key, value, options)
+	err := s.RESTProxyBridgeStore.UpdateAttempt(message)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4790,15 +8980,15 @@ func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, valu
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.SetWithOptions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RESTProxyBridgeStore.UpdateAttempt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCount(teamID string, mustHaveFile bool, mustHaveHashtag bool) (int64, error) {
+func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.AnalyticsPostCount(teamID, mustHaveFile, mustHaveHashtag)
+	result, err := s.ReactionStore.BulkGetForPosts(postIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4806,15 +8996,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCount(teamID string, mustHaveFile boo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsPostCountsOptions) (model.AnalyticsRows, error) {
+func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.AnalyticsPostCountsByDay(options)
+	result, err := s.ReactionStore.Delete(reaction)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4822,15 +9012,15 @@ func (s *TimerLayerPostStore) AnalyticsPostCountsByDay(options *model.AnalyticsP
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsPostCountsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (model.AnalyticsRows, error) {
+func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.AnalyticsUserCountsWithPostsByDay(teamID)
+	err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4838,30 +9028,31 @@ func (s *TimerLayerPostStore) AnalyticsUserCountsWithPostsByDay(teamID string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.AnalyticsUserCountsWithPostsByDay", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) ClearCaches() {
+func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := timemodule.Now()
 
-	s.PostStore.ClearCaches()
+	result, err := s.ReactionStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteOrphanedRows", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Delete(postID string, time int64, deleteByID string) error {
+func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
 	start := timemodule.Now()
 
-	err := s.PostStore.Delete(postID, time, deleteByID)
+	result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4869,15 +9060,15 @@ func (s *TimerLayerPostStore) Delete(postID string, time int64, deleteByID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.DeleteOrphanedRows(limit)
+	result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4885,15 +9076,15 @@ func (s *TimerLayerPostStore) DeleteOrphanedRows(limit int) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPostSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) Get(ctx context.Context, id string, skipFetchThreads bool, collapsedThreads bool, collapsedThreadsExtended bool, userID string) (*model.PostList, error) {
+func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.Get(ctx, id, skipFetchThreads, collapsedThreads, collapsedThreadsExtended, userID)
+	result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4901,15 +9092,15 @@ func (s *TimerLayerPostStore) Get(ctx context.Context, id string, skipFetchThrea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string) ([]*model.DirectPostForExport, error) {
+func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID)
+	result, err := s.ReactionStore.Save(reaction)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4917,31 +9108,31 @@ func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afte
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetEtag(channelID string, allowFromCache bool, collapsedThreads bool) string {
+func (s *TimerLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
 	start := timemodule.Now()
 
-	result := s.PostStore.GetEtag(channelID, allowFromCache, collapsedThreads)
+	result, err := s.RemoteClusterStore.Delete(remoteClusterId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetEtag", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Delete", success, elapsed)
 	}
-	return result
+	return result, err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetFlaggedPosts(userID, offset, limit)
+	result, err := s.RemoteClusterStore.Get(remoteClusterId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4949,15 +9140,15 @@ func (s *TimerLayerPostStore) GetFlaggedPosts(userID string, offset int, limit i
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetFlaggedPostsForChannel(userID, channelID, offset, limit)
+	result, err := s.RemoteClusterStore.GetAll(filter)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4965,15 +9156,15 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForChannel(userID string, channelID
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID string, offset int, limit int) (*model.PostList, error) {
+func (s *TimerLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetFlaggedPostsForTeam(userID, teamID, offset, limit)
+	result, err := s.RemoteClusterStore.Save(rc)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4981,15 +9172,15 @@ func (s *TimerLayerPostStore) GetFlaggedPostsForTeam(userID string, teamID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetFlaggedPostsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
+func (s *TimerLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetLastPostRowCreateAt()
+	err := s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -4997,31 +9188,31 @@ func (s *TimerLayerPostStore) GetLastPostRowCreateAt() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetLastPostRowCreateAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.SetLastPingAt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetMaxPostSize() int {
+func (s *TimerLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result := s.PostStore.GetMaxPostSize()
+	result, err := s.RemoteClusterStore.Update(rc)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetMaxPostSize", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Update", success, elapsed)
 	}
-	return result
+	return result, err
 }
 
-func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
+func (s *TimerLayerRemoteClusterStore) UpdateHiddenProfileFields(remoteClusterId string, hiddenProfileFields string) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetOldest()
+	result, err := s.RemoteClusterStore.UpdateHiddenProfileFields(remoteClusterId, hiddenProfileFields)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5029,15 +9220,15 @@ func (s *TimerLayerPostStore) GetOldest() (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldest", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.UpdateHiddenProfileFields", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
+func (s *TimerLayerRemoteClusterStore) UpdateInvitePolicy(remoteClusterId string, invitePolicy string) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetOldestEntityCreationTime()
+	result, err := s.RemoteClusterStore.UpdateInvitePolicy(remoteClusterId, invitePolicy)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5045,15 +9236,15 @@ func (s *TimerLayerPostStore) GetOldestEntityCreationTime() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetOldestEntityCreationTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.UpdateInvitePolicy", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string) ([]*model.PostForExport, error) {
+func (s *TimerLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetParentsForExportAfter(limit, afterID)
+	result, err := s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5061,15 +9252,15 @@ func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.UpdateTopics", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, time int64, collapsedThreads bool) (*model.Post, error) {
+func (s *TimerLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostAfterTime(channelID, time, collapsedThreads)
+	err := s.RetentionPolicyStore.AddChannels(policyId, channelIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5077,15 +9268,15 @@ func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, time int64, col
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.AddChannels", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, time int64, collapsedThreads bool) (string, error) {
+func (s *TimerLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostIdAfterTime(channelID, time, collapsedThreads)
+	err := s.RetentionPolicyStore.AddTeams(policyId, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5093,15 +9284,15 @@ func (s *TimerLayerPostStore) GetPostIdAfterTime(channelID string, time int64, c
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdAfterTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.AddTeams", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, error) {
+func (s *TimerLayerRetentionPolicyStore) CountForPolicyPreview(policy *model.RetentionPolicyWithTeamAndChannelIDs, endTime int64) ([]*model.RetentionPolicyPreviewCount, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostIdBeforeTime(channelID, time, collapsedThreads)
+	result, err := s.RetentionPolicyStore.CountForPolicyPreview(policy, endTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5109,15 +9300,15 @@ func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, time int64,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostIdBeforeTime", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.CountForPolicyPreview", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool) (*model.PostList, error) {
+func (s *TimerLayerRetentionPolicyStore) Delete(id string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPosts(options, allowFromCache)
+	err := s.RetentionPolicyStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5125,15 +9316,15 @@ func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromC
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*model.PostList, error) {
+func (s *TimerLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsAfter(options)
+	result, err := s.RetentionPolicyStore.DeleteOrphanedRows(limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5141,15 +9332,15 @@ func (s *TimerLayerPostStore) GetPostsAfter(options model.GetPostsOptions) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.DeleteOrphanedRows", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime int64, limit int) ([]*model.PostForIndexing, error) {
+func (s *TimerLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsBatchForIndexing(startTime, endTime, limit)
+	result, err := s.RetentionPolicyStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5157,15 +9348,15 @@ func (s *TimerLayerPostStore) GetPostsBatchForIndexing(startTime int64, endTime
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBatchForIndexing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*model.PostList, error) {
+func (s *TimerLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsBefore(options)
+	result, err := s.RetentionPolicyStore.GetAll(offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5173,15 +9364,15 @@ func (s *TimerLayerPostStore) GetPostsBefore(options model.GetPostsOptions) (*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsBefore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
+func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsByIds(postIds)
+	result, err := s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5189,15 +9380,15 @@ func (s *TimerLayerPostStore) GetPostsByIds(postIds []string) ([]*model.Post, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelPoliciesCountForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, time int64) ([]*model.Post, error) {
+func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsCreatedAt(channelID, time)
+	result, err := s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5205,15 +9396,15 @@ func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, time int64) ([
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelPoliciesForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool) (*model.PostList, error) {
+func (s *TimerLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetPostsSince(options, allowFromCache)
+	result, err := s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5221,15 +9412,15 @@ func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannels", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceForSyncOptions, cursor model.GetPostsSinceForSyncCursor, limit int) ([]*model.Post, model.GetPostsSinceForSyncCursor, error) {
+func (s *TimerLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.PostStore.GetPostsSinceForSync(options, cursor, limit)
+	result, err := s.RetentionPolicyStore.GetChannelsCount(policyId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5237,15 +9428,15 @@ func (s *TimerLayerPostStore) GetPostsSinceForSync(options model.GetPostsSinceFo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsSinceForSync", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelsCount", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error) {
+func (s *TimerLayerRetentionPolicyStore) GetCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetRepliesForExport(parentID)
+	result, err := s.RetentionPolicyStore.GetCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5253,15 +9444,15 @@ func (s *TimerLayerPostStore) GetRepliesForExport(parentID string) ([]*model.Rep
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetRepliesForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Post, error) {
+func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.GetSingle(id, inclDeleted)
+	result, err := s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5269,15 +9460,15 @@ func (s *TimerLayerPostStore) GetSingle(id string, inclDeleted bool) (*model.Pos
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetSingle", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesCountForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error) {
+func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.HasAutoResponsePostByUserSince(options, userId)
+	result, err := s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5285,30 +9476,31 @@ func (s *TimerLayerPostStore) HasAutoResponsePostByUserSince(options model.GetPo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.HasAutoResponsePostByUserSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) InvalidateLastPostTimeCache(channelID string) {
+func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	s.PostStore.InvalidateLastPostTimeCache(channelID)
+	result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.InvalidateLastPostTimeCache", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeams", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
+func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.Overwrite(post)
+	result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5316,15 +9508,15 @@ func (s *TimerLayerPostStore) Overwrite(post *model.Post) (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Overwrite", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamsCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.PostStore.OverwriteMultiple(posts)
+	result, err := s.RetentionPolicyStore.Patch(patch)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5332,15 +9524,15 @@ func (s *TimerLayerPostStore) OverwriteMultiple(posts []*model.Post) ([]*model.P
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.OverwriteMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Patch", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.PermanentDeleteBatch(endTime, limit)
+	err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5348,15 +9540,15 @@ func (s *TimerLayerPostStore) PermanentDeleteBatch(endTime int64, limit int64) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveChannels", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64, globalPolicyEndTime int64, limit int64, cursor model.RetentionPolicyCursor) (int64, model.RetentionPolicyCursor, error) {
+func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.PostStore.PermanentDeleteBatchForRetentionPolicies(now, globalPolicyEndTime, limit, cursor)
+	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5364,15 +9556,15 @@ func (s *TimerLayerPostStore) PermanentDeleteBatchForRetentionPolicies(now int64
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteBatchForRetentionPolicies", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
+func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
 	start := timemodule.Now()
 
-	err := s.PostStore.PermanentDeleteByChannel(channelID)
+	result, err := s.RetentionPolicyStore.Save(policy)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5380,15 +9572,15 @@ func (s *TimerLayerPostStore) PermanentDeleteByChannel(channelID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 	start := timemodule.Now()
 
-	err := s.PostStore.PermanentDeleteByUser(userID)
+	result, err := s.RoleStore.AllChannelSchemeRoles()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5396,15 +9588,15 @@ func (s *TimerLayerPostStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
+func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.Save(post)
+	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5412,15 +9604,15 @@ func (s *TimerLayerPostStore) Save(post *model.Post) (*model.Post, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post, int, error) {
+func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.PostStore.SaveMultiple(posts)
+	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5428,15 +9620,15 @@ func (s *TimerLayerPostStore) SaveMultiple(posts []*model.Post) ([]*model.Post,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SaveMultiple", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model.SearchParams) (*model.PostList, error) {
+func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.Search(teamID, userID, params)
+	result, err := s.RoleStore.Delete(roleID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5444,15 +9636,15 @@ func (s *TimerLayerPostStore) Search(teamID string, userID string, params *model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Search", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.PostSearchResults, error) {
+func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.SearchPostsForUser(paramsList, userID, teamID, page, perPage)
+	result, err := s.RoleStore.Get(roleID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5460,15 +9652,15 @@ func (s *TimerLayerPostStore) SearchPostsForUser(paramsList []*model.SearchParam
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.SearchPostsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (*model.Post, error) {
+func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
 	start := timemodule.Now()
 
-	result, err := s.PostStore.Update(newPost, oldPost)
+	result, err := s.RoleStore.GetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5476,15 +9668,15 @@ func (s *TimerLayerPostStore) Update(newPost *model.Post, oldPost *model.Post) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
+func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
 	start := timemodule.Now()
 
-	result, err := s.PreferenceStore.CleanupFlagsBatch(limit)
+	result, err := s.RoleStore.GetByName(ctx, name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5492,15 +9684,15 @@ func (s *TimerLayerPreferenceStore) CleanupFlagsBatch(limit int64) (int64, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.CleanupFlagsBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name string) error {
+func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 	start := timemodule.Now()
 
-	err := s.PreferenceStore.Delete(userID, category, name)
+	result, err := s.RoleStore.GetByNames(names)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5508,15 +9700,15 @@ func (s *TimerLayerPreferenceStore) Delete(userID string, category string, name
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category string) error {
+func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
 	start := timemodule.Now()
 
-	err := s.PreferenceStore.DeleteCategory(userID, category)
+	err := s.RoleStore.PermanentDeleteAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5524,15 +9716,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategory(userID string, category strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name string) error {
+func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 	start := timemodule.Now()
 
-	err := s.PreferenceStore.DeleteCategoryAndName(category, name)
+	result, err := s.RoleStore.Save(role)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5540,15 +9732,15 @@ func (s *TimerLayerPreferenceStore) DeleteCategoryAndName(category string, name
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteCategoryAndName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerScheduledChannelMessageStore) Delete(id string) error {
 	start := timemodule.Now()
 
-	result, err := s.PreferenceStore.DeleteOrphanedRows(limit)
+	err := s.ScheduledChannelMessageStore.Delete(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5556,15 +9748,15 @@ func (s *TimerLayerPreferenceStore) DeleteOrphanedRows(limit int) (int64, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPreferenceStore) Get(userID string, category string, name string) (*model.Preference, error) {
+func (s *TimerLayerScheduledChannelMessageStore) DeleteForChannel(channelID string) error {
 	start := timemodule.Now()
 
-	result, err := s.PreferenceStore.Get(userID, category, name)
+	err := s.ScheduledChannelMessageStore.DeleteForChannel(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5572,15 +9764,15 @@ func (s *TimerLayerPreferenceStore) Get(userID string, category string, name str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.DeleteForChannel", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, error) {
+func (s *TimerLayerScheduledChannelMessageStore) Get(id string) (*model.ScheduledChannelMessage, error) {
 	start := timemodule.Now()
 
-	result, err := s.PreferenceStore.GetAll(userID)
+	result, err := s.ScheduledChannelMessageStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5588,15 +9780,15 @@ func (s *TimerLayerPreferenceStore) GetAll(userID string) (model.Preferences, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string) (model.Preferences, error) {
+func (s *TimerLayerScheduledChannelMessageStore) GetDue(now int64, limit int) ([]*model.ScheduledChannelMessage, error) {
 	start := timemodule.Now()
 
-	result, err := s.PreferenceStore.GetCategory(userID, category)
+	result, err := s.ScheduledChannelMessageStore.GetDue(now, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5604,15 +9796,15 @@ func (s *TimerLayerPreferenceStore) GetCategory(userID string, category string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetCategory", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.GetDue", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
+func (s *TimerLayerScheduledChannelMessageStore) GetForChannel(channelID string) ([]*model.ScheduledChannelMessage, error) {
 	start := timemodule.Now()
 
-	err := s.PreferenceStore.PermanentDeleteByUser(userID)
+	result, err := s.ScheduledChannelMessageStore.GetForChannel(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5620,15 +9812,15 @@ func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.PermanentDeleteByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.GetForChannel", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
+func (s *TimerLayerScheduledChannelMessageStore) Save(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
 	start := timemodule.Now()
 
-	err := s.PreferenceStore.Save(preferences)
+	result, err := s.ScheduledChannelMessageStore.Save(message, maxPostSize)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5636,15 +9828,15 @@ func (s *TimerLayerPreferenceStore) Save(preferences model.Preferences) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
+func (s *TimerLayerScheduledChannelMessageStore) Update(message *model.ScheduledChannelMessage, maxPostSize int) (*model.ScheduledChannelMessage, error) {
 	start := timemodule.Now()
 
-	err := s.ProductNoticesStore.Clear(notices)
+	result, err := s.ScheduledChannelMessageStore.Update(message, maxPostSize)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5652,15 +9844,15 @@ func (s *TimerLayerProductNoticesStore) Clear(notices []string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.Clear", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.Update", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.ProductNotices) error {
+func (s *TimerLayerScheduledChannelMessageStore) UpdateRun(id string, expectedNextRunAt int64, lastRunAt int64, nextRunAt int64) (bool, error) {
 	start := timemodule.Now()
 
-	err := s.ProductNoticesStore.ClearOldNotices(currentNotices)
+	result, err := s.ScheduledChannelMessageStore.UpdateRun(id, expectedNextRunAt, lastRunAt, nextRunAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5668,15 +9860,15 @@ func (s *TimerLayerProductNoticesStore) ClearOldNotices(currentNotices model.Pro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.ClearOldNotices", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ScheduledChannelMessageStore.UpdateRun", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.ProductNoticeViewState, error) {
+func (s *TimerLayerSchemeStore) AssignChannels(schemeID string, channelIDs []string) error {
 	start := timemodule.Now()
 
-	result, err := s.ProductNoticesStore.GetViews(userID)
+	err := s.SchemeStore.AssignChannels(schemeID, channelIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5684,15 +9876,15 @@ func (s *TimerLayerProductNoticesStore) GetViews(userID string) ([]model.Product
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.GetViews", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.AssignChannels", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) error {
+func (s *TimerLayerSchemeStore) AssignTeams(schemeID string, teamIDs []string) error {
 	start := timemodule.Now()
 
-	err := s.ProductNoticesStore.View(userID, notices)
+	err := s.SchemeStore.AssignTeams(schemeID, teamIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5700,15 +9892,15 @@ func (s *TimerLayerProductNoticesStore) View(userID string, notices []string) er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ProductNoticesStore.View", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.AssignTeams", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Reaction, error) {
+func (s *TimerLayerSchemeStore) CountByScope(scope string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.BulkGetForPosts(postIds)
+	result, err := s.SchemeStore.CountByScope(scope)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5716,15 +9908,15 @@ func (s *TimerLayerReactionStore) BulkGetForPosts(postIds []string) ([]*model.Re
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.BulkGetForPosts", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountByScope", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.Delete(reaction)
+	result, err := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5732,15 +9924,15 @@ func (s *TimerLayerReactionStore) Delete(reaction *model.Reaction) (*model.React
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountWithoutPermission", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error {
+func (s *TimerLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
 	start := timemodule.Now()
 
-	err := s.ReactionStore.DeleteAllWithEmojiName(emojiName)
+	result, err := s.SchemeStore.Delete(schemeID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5748,15 +9940,15 @@ func (s *TimerLayerReactionStore) DeleteAllWithEmojiName(emojiName string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteAllWithEmojiName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Delete", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.DeleteOrphanedRows(limit)
+	result, err := s.SchemeStore.Get(schemeID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5764,15 +9956,15 @@ func (s *TimerLayerReactionStore) DeleteOrphanedRows(limit int) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error) {
+func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.GetForPost(postID, allowFromCache)
+	result, err := s.SchemeStore.GetAllPage(scope, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5780,15 +9972,15 @@ func (s *TimerLayerReactionStore) GetForPost(postID string, allowFromCache bool)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetAllPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error) {
+func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.GetForPostSince(postId, since, excludeRemoteId, inclDeleted)
+	result, err := s.SchemeStore.GetByName(schemeName)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5796,15 +9988,15 @@ func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, ex
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForPostSince", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int64) (int64, error) {
+func (s *TimerLayerSchemeStore) PermanentDeleteAll() error {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.PermanentDeleteBatch(endTime, limit)
+	err := s.SchemeStore.PermanentDeleteAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5812,15 +10004,15 @@ func (s *TimerLayerReactionStore) PermanentDeleteBatch(endTime int64, limit int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.PermanentDeleteBatch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.PermanentDeleteAll", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
 	start := timemodule.Now()
 
-	result, err := s.ReactionStore.Save(reaction)
+	result, err := s.SchemeStore.Save(scheme)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5828,15 +10020,15 @@ func (s *TimerLayerReactionStore) Save(reaction *model.Reaction) (*model.Reactio
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, error) {
+func (s *TimerLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.Delete(remoteClusterId)
+	result, err := s.SessionStore.AnalyticsSessionCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5844,15 +10036,15 @@ func (s *TimerLayerRemoteClusterStore) Delete(remoteClusterId string) (bool, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.AnalyticsSessionCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) Get(remoteClusterId string) (*model.RemoteCluster, error) {
+func (s *TimerLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.Get(remoteClusterId)
+	err := s.SessionStore.Cleanup(expiryTime, batchSize)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5860,15 +10052,15 @@ func (s *TimerLayerRemoteClusterStore) Get(remoteClusterId string) (*model.Remot
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Cleanup", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error) {
+func (s *TimerLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.GetAll(filter)
+	result, err := s.SessionStore.Get(ctx, sessionIDOrToken)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5876,15 +10068,15 @@ func (s *TimerLayerRemoteClusterStore) GetAll(filter model.RemoteClusterQueryFil
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *TimerLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.Save(rc)
+	result, err := s.SessionStore.GetLastSessionRowCreateAt()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5892,15 +10084,15 @@ func (s *TimerLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.Rem
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetLastSessionRowCreateAt", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
+func (s *TimerLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
 	start := timemodule.Now()
 
-	err := s.RemoteClusterStore.SetLastPingAt(remoteClusterId)
+	result, err := s.SessionStore.GetSessions(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5908,15 +10100,15 @@ func (s *TimerLayerRemoteClusterStore) SetLastPingAt(remoteClusterId string) err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.SetLastPingAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessions", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.RemoteCluster, error) {
+func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.Update(rc)
+	result, err := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5924,15 +10116,15 @@ func (s *TimerLayerRemoteClusterStore) Update(rc *model.RemoteCluster) (*model.R
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsExpired", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topics string) (*model.RemoteCluster, error) {
+func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
 	start := timemodule.Now()
 
-	result, err := s.RemoteClusterStore.UpdateTopics(remoteClusterId, topics)
+	result, err := s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5940,15 +10132,15 @@ func (s *TimerLayerRemoteClusterStore) UpdateTopics(remoteClusterId string, topi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.UpdateTopics", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsWithActiveDeviceIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) AddChannels(policyId string, channelIds []string) error {
+func (s *TimerLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
 	start := timemodule.Now()
 
-	err := s.RetentionPolicyStore.AddChannels(policyId, channelIds)
+	err := s.SessionStore.PermanentDeleteSessionsByUser(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5956,15 +10148,15 @@ func (s *TimerLayerRetentionPolicyStore) AddChannels(policyId string, channelIds
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.AddChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.PermanentDeleteSessionsByUser", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []string) error {
+func (s *TimerLayerSessionStore) Remove(sessionIDOrToken string) error {
 	start := timemodule.Now()
 
-	err := s.RetentionPolicyStore.AddTeams(policyId, teamIds)
+	err := s.SessionStore.Remove(sessionIDOrToken)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5972,15 +10164,15 @@ func (s *TimerLayerRetentionPolicyStore) AddTeams(policyId string, teamIds []str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.AddTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Remove", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Delete(id string) error {
+func (s *TimerLayerSessionStore) RemoveAllSessions() error {
 	start := timemodule.Now()
 
-	err := s.RetentionPolicyStore.Delete(id)
+	err := s.SessionStore.RemoveAllSessions()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -5988,15 +10180,15 @@ func (s *TimerLayerRetentionPolicyStore) Delete(id string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.RemoveAllSessions", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, error) {
+func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.DeleteOrphanedRows(limit)
+	result, err := s.SessionStore.Save(session)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6004,15 +10196,15 @@ func (s *TimerLayerRetentionPolicyStore) DeleteOrphanedRows(limit int) (int64, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.DeleteOrphanedRows", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.Get(id)
+	result, err := s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6020,15 +10212,15 @@ func (s *TimerLayerRetentionPolicyStore) Get(id string) (*model.RetentionPolicyW
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateDeviceId", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetAll(offset, limit)
+	err := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6036,15 +10228,15 @@ func (s *TimerLayerRetentionPolicyStore) GetAll(offset int, limit int) ([]*model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiredNotify", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID string) (int64, error) {
+func (s *TimerLayerSessionStore) UpdateExpiresAt(sessionID string, time int64) error {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetChannelPoliciesCountForUser(userID)
+	err := s.SessionStore.UpdateExpiresAt(sessionID, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6052,15 +10244,15 @@ func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesCountForUser(userID s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelPoliciesCountForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiresAt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForChannel, error) {
+func (s *TimerLayerSessionStore) UpdateLastActivityAt(sessionID string, time int64) error {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetChannelPoliciesForUser(userID, offset, limit)
+	err := s.SessionStore.UpdateLastActivityAt(sessionID, time)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6068,15 +10260,15 @@ func (s *TimerLayerRetentionPolicyStore) GetChannelPoliciesForUser(userID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelPoliciesForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateLastActivityAt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetChannels(policyId string, offset int, limit int) (model.ChannelListWithTeamData, error) {
+func (s *TimerLayerSessionStore) UpdateProps(session *model.Session) error {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetChannels(policyId, offset, limit)
+	err := s.SessionStore.UpdateProps(session)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6084,15 +10276,15 @@ func (s *TimerLayerRetentionPolicyStore) GetChannels(policyId string, offset int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateProps", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int64, error) {
+func (s *TimerLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetChannelsCount(policyId)
+	result, err := s.SessionStore.UpdateRoles(userID, roles)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6100,15 +10292,15 @@ func (s *TimerLayerRetentionPolicyStore) GetChannelsCount(policyId string) (int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetChannelsCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateRoles", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetCount() (int64, error) {
+func (s *TimerLayerSharedChannelStore) Delete(channelId string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetCount()
+	result, err := s.SharedChannelStore.Delete(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6116,15 +10308,15 @@ func (s *TimerLayerRetentionPolicyStore) GetCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID string) (int64, error) {
+func (s *TimerLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeamPoliciesCountForUser(userID)
+	result, err := s.SharedChannelStore.DeleteRemote(remoteId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6132,15 +10324,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesCountForUser(userID stri
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesCountForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.DeleteRemote", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, offset int, limit int) ([]*model.RetentionPolicyForTeam, error) {
+func (s *TimerLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeamPoliciesForUser(userID, offset, limit)
+	result, err := s.SharedChannelStore.Get(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6148,15 +10340,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, o
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
+func (s *TimerLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
+	result, err := s.SharedChannelStore.GetAll(offset, limit, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6164,15 +10356,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, l
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
+func (s *TimerLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
+	result, err := s.SharedChannelStore.GetAllCount(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6180,15 +10372,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamsCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAllCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.Patch(patch)
+	result, err := s.SharedChannelStore.GetAttachment(fileId, remoteId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6196,15 +10388,15 @@ func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Patch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAttachment", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
+func (s *TimerLayerSharedChannelStore) GetInvite(id string) (*model.SharedChannelInvite, error) {
 	start := timemodule.Now()
 
-	err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
+	result, err := s.SharedChannelStore.GetInvite(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6212,15 +10404,15 @@ func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channel
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetInvite", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+func (s *TimerLayerSharedChannelStore) GetInvites(opts model.SharedChannelInviteFilterOpts, offset int, limit int) ([]*model.SharedChannelInvite, error) {
 	start := timemodule.Now()
 
-	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+	result, err := s.SharedChannelStore.GetInvites(opts, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6228,15 +10420,15 @@ func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetInvites", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
 	start := timemodule.Now()
 
-	result, err := s.RetentionPolicyStore.Save(policy)
+	result, err := s.SharedChannelStore.GetRemote(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6244,15 +10436,15 @@ func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemote", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.AllChannelSchemeRoles()
+	result, err := s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6260,15 +10452,15 @@ func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemoteByIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+func (s *TimerLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+	result, err := s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6276,15 +10468,15 @@ func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemoteForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+	result, err := s.SharedChannelStore.GetRemotes(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6292,15 +10484,15 @@ func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemotes", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.Delete(roleID)
+	result, err := s.SharedChannelStore.GetRemotesStatus(channelId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6308,15 +10500,15 @@ func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemotesStatus", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.Get(roleID)
+	result, err := s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6324,15 +10516,15 @@ func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetSingleUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.GetAll()
+	result, err := s.SharedChannelStore.GetUsersForSync(filter)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6340,15 +10532,15 @@ func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetUsersForSync", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.GetByName(ctx, name)
+	result, err := s.SharedChannelStore.GetUsersForUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6356,15 +10548,15 @@ func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*mode
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetUsersForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.GetByNames(names)
+	result, err := s.SharedChannelStore.HasChannel(channelID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6372,15 +10564,15 @@ func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.HasChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
+func (s *TimerLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
 	start := timemodule.Now()
 
-	err := s.RoleStore.PermanentDeleteAll()
+	result, err := s.SharedChannelStore.HasRemote(channelID, remoteId)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6388,15 +10580,15 @@ func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.HasRemote", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *TimerLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
 	start := timemodule.Now()
 
-	result, err := s.RoleStore.Save(role)
+	result, err := s.SharedChannelStore.Save(sc)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6404,15 +10596,15 @@ func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) CountByScope(scope string) (int64, error) {
+func (s *TimerLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.CountByScope(scope)
+	result, err := s.SharedChannelStore.SaveAttachment(remote)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6420,15 +10612,15 @@ func (s *TimerLayerSchemeStore) CountByScope(scope string) (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountByScope", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveAttachment", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) CountWithoutPermission(scope string, permissionID string, roleScope model.RoleScope, roleType model.RoleType) (int64, error) {
+func (s *TimerLayerSharedChannelStore) SaveInvite(invite *model.SharedChannelInvite) (*model.SharedChannelInvite, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.CountWithoutPermission(scope, permissionID, roleScope, roleType)
+	result, err := s.SharedChannelStore.SaveInvite(invite)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6436,15 +10628,15 @@ func (s *TimerLayerSchemeStore) CountWithoutPermission(scope string, permissionI
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.CountWithoutPermission", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveInvite", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
+func (s *TimerLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.Delete(schemeID)
+	result, err := s.SharedChannelStore.SaveRemote(remote)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6452,15 +10644,15 @@ func (s *TimerLayerSchemeStore) Delete(schemeID string) (*model.Scheme, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveRemote", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
+func (s *TimerLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.Get(schemeID)
+	result, err := s.SharedChannelStore.SaveUser(remote)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6468,15 +10660,15 @@ func (s *TimerLayerSchemeStore) Get(schemeID string) (*model.Scheme, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int) ([]*model.Scheme, error) {
+func (s *TimerLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.GetAllPage(scope, offset, limit)
+	result, err := s.SharedChannelStore.Update(sc)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6484,15 +10676,15 @@ func (s *TimerLayerSchemeStore) GetAllPage(scope string, offset int, limit int)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, error) {
+func (s *TimerLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.GetByName(schemeName)
+	err := s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6500,15 +10692,15 @@ func (s *TimerLayerSchemeStore) GetByName(schemeName string) (*model.Scheme, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateAttachmentLastSyncAt", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSchemeStore) PermanentDeleteAll() error {
+func (s *TimerLayerSharedChannelStore) UpdateInviteStatus(id string, status string, decidedBy string) (*model.SharedChannelInvite, error) {
 	start := timemodule.Now()
 
-	err := s.SchemeStore.PermanentDeleteAll()
+	result, err := s.SharedChannelStore.UpdateInviteStatus(id, status, decidedBy)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6516,15 +10708,15 @@ func (s *TimerLayerSchemeStore) PermanentDeleteAll() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateInviteStatus", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error) {
+func (s *TimerLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
 	start := timemodule.Now()
 
-	result, err := s.SchemeStore.Save(scheme)
+	result, err := s.SharedChannelStore.UpdateRemote(remote)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6532,15 +10724,15 @@ func (s *TimerLayerSchemeStore) Save(scheme *model.Scheme) (*model.Scheme, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SchemeStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemote", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) AnalyticsSessionCount() (int64, error) {
+func (s *TimerLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.AnalyticsSessionCount()
+	err := s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6548,15 +10740,15 @@ func (s *TimerLayerSessionStore) AnalyticsSessionCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.AnalyticsSessionCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemoteCursor", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) error {
+func (s *TimerLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
 	start := timemodule.Now()
 
-	err := s.SessionStore.Cleanup(expiryTime, batchSize)
+	err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6564,15 +10756,15 @@ func (s *TimerLayerSessionStore) Cleanup(expiryTime int64, batchSize int64) erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Cleanup", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateUserLastSyncAt", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSessionStore) Get(ctx context.Context, sessionIDOrToken string) (*model.Session, error) {
+func (s *TimerLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.Get(ctx, sessionIDOrToken)
+	result, err := s.SharedChannelStore.UpsertAttachment(remote)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6580,15 +10772,15 @@ func (s *TimerLayerSessionStore) Get(ctx context.Context, sessionIDOrToken strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpsertAttachment", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
+func (s *TimerLayerStatusStore) Get(userID string) (*model.Status, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.GetLastSessionRowCreateAt()
+	result, err := s.StatusStore.Get(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6596,15 +10788,15 @@ func (s *TimerLayerSessionStore) GetLastSessionRowCreateAt() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetLastSessionRowCreateAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) GetSessions(userID string) ([]*model.Session, error) {
+func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.GetSessions(userID)
+	result, err := s.StatusStore.GetByIds(userIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6612,15 +10804,15 @@ func (s *TimerLayerSessionStore) GetSessions(userID string) ([]*model.Session, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetByIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobileOnly bool, unnotifiedOnly bool) ([]*model.Session, error) {
+func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.GetSessionsExpired(thresholdMillis, mobileOnly, unnotifiedOnly)
+	result, err := s.StatusStore.GetTotalActiveUsersCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6628,15 +10820,15 @@ func (s *TimerLayerSessionStore) GetSessionsExpired(thresholdMillis int64, mobil
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsExpired", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetTotalActiveUsersCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) ([]*model.Session, error) {
+func (s *TimerLayerStatusStore) ResetAll() error {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.GetSessionsWithActiveDeviceIds(userID)
+	err := s.StatusStore.ResetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6644,15 +10836,15 @@ func (s *TimerLayerSessionStore) GetSessionsWithActiveDeviceIds(userID string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.GetSessionsWithActiveDeviceIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.ResetAll", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) error {
+func (s *TimerLayerStatusStore) SaveOrUpdate(status *model.Status) error {
 	start := timemodule.Now()
 
-	err := s.SessionStore.PermanentDeleteSessionsByUser(teamID)
+	err := s.StatusStore.SaveOrUpdate(status)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6660,15 +10852,15 @@ func (s *TimerLayerSessionStore) PermanentDeleteSessionsByUser(teamID string) er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.PermanentDeleteSessionsByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.SaveOrUpdate", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSessionStore) Remove(sessionIDOrToken string) error {
+func (s *TimerLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
 	start := timemodule.Now()
 
-	err := s.SessionStore.Remove(sessionIDOrToken)
+	result, err := s.StatusStore.UpdateExpiredDNDStatuses()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6676,15 +10868,15 @@ func (s *TimerLayerSessionStore) Remove(sessionIDOrToken string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Remove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateExpiredDNDStatuses", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSessionStore) RemoveAllSessions() error {
+func (s *TimerLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
 	start := timemodule.Now()
 
-	err := s.SessionStore.RemoveAllSessions()
+	err := s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6692,15 +10884,15 @@ func (s *TimerLayerSessionStore) RemoveAllSessions() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.RemoveAllSessions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateLastActivityAt", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, error) {
+func (s *TimerLayerSystemStore) Get() (model.StringMap, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.Save(session)
+	result, err := s.SystemStore.Get()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6708,15 +10900,15 @@ func (s *TimerLayerSessionStore) Save(session *model.Session) (*model.Session, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceID string, expiresAt int64) (string, error) {
+func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, error) {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.UpdateDeviceId(id, deviceID, expiresAt)
+	result, err := s.SystemStore.GetByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6724,15 +10916,15 @@ func (s *TimerLayerSessionStore) UpdateDeviceId(id string, deviceID string, expi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateDeviceId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSessionStore) UpdateExpiredNotify(sessionid string, notified bool) error {
+func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
 	start := timemodule.Now()
 
-	err := s.SessionStore.UpdateExpiredNotify(sessionid, notified)
+	result, err := s.SystemStore.InsertIfExists(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6740,15 +10932,15 @@ func (s *TimerLayerSessionStore) UpdateExpiredNotify(sessionid string, notified
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiredNotify", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.InsertIfExists", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSessionStore) UpdateExpiresAt(sessionID string, time int64) error {
+func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
 	start := timemodule.Now()
 
-	err := s.SessionStore.UpdateExpiresAt(sessionID, time)
+	result, err := s.SystemStore.PermanentDeleteByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6756,15 +10948,15 @@ func (s *TimerLayerSessionStore) UpdateExpiresAt(sessionID string, time int64) e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateExpiresAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.PermanentDeleteByName", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSessionStore) UpdateLastActivityAt(sessionID string, time int64) error {
+func (s *TimerLayerSystemStore) Save(system *model.System) error {
 	start := timemodule.Now()
 
-	err := s.SessionStore.UpdateLastActivityAt(sessionID, time)
+	err := s.SystemStore.Save(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6772,15 +10964,15 @@ func (s *TimerLayerSessionStore) UpdateLastActivityAt(sessionID string, time int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateLastActivityAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Save", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSessionStore) UpdateProps(session *model.Session) error {
+func (s *TimerLayerSystemStore) SaveOrUpdate(system *model.System) error {
 	start := timemodule.Now()
 
-	err := s.SessionStore.UpdateProps(session)
+	err := s.SystemStore.SaveOrUpdate(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6788,15 +10980,15 @@ func (s *TimerLayerSessionStore) UpdateProps(session *model.Session) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateProps", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdate", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSessionStore) UpdateRoles(userID string, roles string) (string, error) {
+func (s *TimerLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
 	start := timemodule.Now()
 
-	result, err := s.SessionStore.UpdateRoles(userID, roles)
+	err := s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6804,15 +10996,15 @@ func (s *TimerLayerSessionStore) UpdateRoles(userID string, roles string) (strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SessionStore.UpdateRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdateWithWarnMetricHandling", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSharedChannelStore) Delete(channelId string) (bool, error) {
+func (s *TimerLayerSystemStore) Update(system *model.System) error {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.Delete(channelId)
+	err := s.SystemStore.Update(system)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6820,15 +11012,15 @@ func (s *TimerLayerSharedChannelStore) Delete(channelId string) (bool, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Update", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, error) {
+func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.DeleteRemote(remoteId)
+	result, err := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6836,15 +11028,15 @@ func (s *TimerLayerSharedChannelStore) DeleteRemote(remoteId string) (bool, erro
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.DeleteRemote", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsGetTeamCountForScheme", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) Get(channelId string) (*model.SharedChannel, error) {
+func (s *TimerLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.Get(channelId)
+	result, err := s.TeamStore.AnalyticsTeamCount(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6852,15 +11044,15 @@ func (s *TimerLayerSharedChannelStore) Get(channelId string) (*model.SharedChann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsTeamCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetAll(offset int, limit int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, error) {
+func (s *TimerLayerTeamStore) ClearAllCustomRoleAssignments() error {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetAll(offset, limit, opts)
+	err := s.TeamStore.ClearAllCustomRoleAssignments()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6868,31 +11060,30 @@ func (s *TimerLayerSharedChannelStore) GetAll(offset int, limit int, opts model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearAllCustomRoleAssignments", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSharedChannelStore) GetAllCount(opts model.SharedChannelFilterOpts) (int64, error) {
+func (s *TimerLayerTeamStore) ClearCaches() {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetAllCount(opts)
+	s.TeamStore.ClearCaches()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAllCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearCaches", success, elapsed)
 	}
-	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetAttachment(fileId string, remoteId string) (*model.SharedChannelAttachment, error) {
+func (s *TimerLayerTeamStore) Get(id string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetAttachment(fileId, remoteId)
+	result, err := s.TeamStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6900,15 +11091,15 @@ func (s *TimerLayerSharedChannelStore) GetAttachment(fileId string, remoteId str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetAttachment", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetRemote(id string) (*model.SharedChannelRemote, error) {
+func (s *TimerLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetRemote(id)
+	result, err := s.TeamStore.GetActiveMemberCount(teamID, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6916,15 +11107,15 @@ func (s *TimerLayerSharedChannelStore) GetRemote(id string) (*model.SharedChanne
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemote", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetActiveMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId string) (*model.SharedChannelRemote, error) {
+func (s *TimerLayerTeamStore) GetAll() ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetRemoteByIds(channelId, remoteId)
+	result, err := s.TeamStore.GetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6932,15 +11123,15 @@ func (s *TimerLayerSharedChannelStore) GetRemoteByIds(channelId string, remoteId
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemoteByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId string) (*model.RemoteCluster, error) {
+func (s *TimerLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetRemoteForUser(remoteId, userId)
+	result, err := s.TeamStore.GetAllForExportAfter(limit, afterID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6948,15 +11139,15 @@ func (s *TimerLayerSharedChannelStore) GetRemoteForUser(remoteId string, userId
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemoteForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllForExportAfter", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error) {
+func (s *TimerLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetRemotes(opts)
+	result, err := s.TeamStore.GetAllPage(offset, limit, opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6964,15 +11155,15 @@ func (s *TimerLayerSharedChannelStore) GetRemotes(opts model.SharedChannelRemote
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemotes", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPage", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*model.SharedChannelRemoteStatus, error) {
+func (s *TimerLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetRemotesStatus(channelId)
+	result, err := s.TeamStore.GetAllPrivateTeamListing()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6980,15 +11171,15 @@ func (s *TimerLayerSharedChannelStore) GetRemotesStatus(channelId string) ([]*mo
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetRemotesStatus", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamListing", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetSingleUser(userID string, channelID string, remoteID string) (*model.SharedChannelUser, error) {
+func (s *TimerLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetSingleUser(userID, channelID, remoteID)
+	result, err := s.TeamStore.GetAllTeamListing()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -6996,15 +11187,15 @@ func (s *TimerLayerSharedChannelStore) GetSingleUser(userID string, channelID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetSingleUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamListing", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForSyncFilter) ([]*model.User, error) {
+func (s *TimerLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetUsersForSync(filter)
+	result, err := s.TeamStore.GetByInviteId(inviteID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7012,15 +11203,15 @@ func (s *TimerLayerSharedChannelStore) GetUsersForSync(filter model.GetUsersForS
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetUsersForSync", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByInviteId", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.SharedChannelUser, error) {
+func (s *TimerLayerTeamStore) GetByName(name string) (*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.GetUsersForUser(userID)
+	result, err := s.TeamStore.GetByName(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7028,15 +11219,15 @@ func (s *TimerLayerSharedChannelStore) GetUsersForUser(userID string) ([]*model.
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.GetUsersForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByName", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) HasChannel(channelID string) (bool, error) {
+func (s *TimerLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.HasChannel(channelID)
+	result, err := s.TeamStore.GetByNames(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7044,15 +11235,15 @@ func (s *TimerLayerSharedChannelStore) HasChannel(channelID string) (bool, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.HasChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByNames", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) HasRemote(channelID string, remoteId string) (bool, error) {
+func (s *TimerLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.HasRemote(channelID, remoteId)
+	result, err := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7060,15 +11251,15 @@ func (s *TimerLayerSharedChannelStore) HasRemote(channelID string, remoteId stri
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.HasRemote", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForAllTeams", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.Save(sc)
+	result, err := s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7076,15 +11267,15 @@ func (s *TimerLayerSharedChannelStore) Save(sc *model.SharedChannel) (*model.Sha
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) SaveAttachment(remote *model.SharedChannelAttachment) (*model.SharedChannelAttachment, error) {
+func (s *TimerLayerTeamStore) GetChildTeams(parentTeamID string) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.SaveAttachment(remote)
+	result, err := s.TeamStore.GetChildTeams(parentTeamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7092,15 +11283,15 @@ func (s *TimerLayerSharedChannelStore) SaveAttachment(remote *model.SharedChanne
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveAttachment", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChildTeams", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *TimerLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.SaveRemote(remote)
+	result, err := s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7108,15 +11299,15 @@ func (s *TimerLayerSharedChannelStore) SaveRemote(remote *model.SharedChannelRem
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveRemote", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetCommonTeamIDsForTwoUsers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser) (*model.SharedChannelUser, error) {
+func (s *TimerLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.SaveUser(remote)
+	result, err := s.TeamStore.GetMember(ctx, teamID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7124,15 +11315,15 @@ func (s *TimerLayerSharedChannelStore) SaveUser(remote *model.SharedChannelUser)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.SaveUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.SharedChannel, error) {
+func (s *TimerLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.Update(sc)
+	result, err := s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7140,15 +11331,15 @@ func (s *TimerLayerSharedChannelStore) Update(sc *model.SharedChannel) (*model.S
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syncTime int64) error {
+func (s *TimerLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	err := s.SharedChannelStore.UpdateAttachmentLastSyncAt(id, syncTime)
+	result, err := s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7156,15 +11347,15 @@ func (s *TimerLayerSharedChannelStore) UpdateAttachmentLastSyncAt(id string, syn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateAttachmentLastSyncAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersByIds", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*model.SharedChannelRemote, error) {
+func (s *TimerLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.UpdateRemote(remote)
+	result, err := s.TeamStore.GetTeamMembersForExport(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7172,15 +11363,15 @@ func (s *TimerLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelR
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemote", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamMembersForExport", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
+func (s *TimerLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	err := s.SharedChannelStore.UpdateRemoteCursor(id, cursor)
+	result, err := s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7188,15 +11379,15 @@ func (s *TimerLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor mode
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemoteCursor", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByScheme", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
+func (s *TimerLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
+	result, err := s.TeamStore.GetTeamsByUserId(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7204,15 +11395,15 @@ func (s *TimerLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, chann
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateUserLastSyncAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByUserId", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
+func (s *TimerLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.SharedChannelStore.UpsertAttachment(remote)
+	result, err := s.TeamStore.GetTeamsForUser(ctx, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7220,15 +11411,15 @@ func (s *TimerLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChan
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpsertAttachment", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerStatusStore) Get(userID string) (*model.Status, error) {
+func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.StatusStore.Get(userID)
+	result, err := s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7236,15 +11427,15 @@ func (s *TimerLayerStatusStore) Get(userID string) (*model.Status, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUserWithPagination", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
+func (s *TimerLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.StatusStore.GetByIds(userIds)
+	result, err := s.TeamStore.GetTotalMemberCount(teamID, restrictions)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7252,15 +11443,15 @@ func (s *TimerLayerStatusStore) GetByIds(userIds []string) ([]*model.Status, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTotalMemberCount", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
+func (s *TimerLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.StatusStore.GetTotalActiveUsersCount()
+	result, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7268,15 +11459,15 @@ func (s *TimerLayerStatusStore) GetTotalActiveUsersCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.GetTotalActiveUsersCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetUserTeamIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerStatusStore) ResetAll() error {
+func (s *TimerLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
 	start := timemodule.Now()
 
-	err := s.StatusStore.ResetAll()
+	result, err := s.TeamStore.GroupSyncedTeamCount()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7284,31 +11475,30 @@ func (s *TimerLayerStatusStore) ResetAll() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.ResetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GroupSyncedTeamCount", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerStatusStore) SaveOrUpdate(status *model.Status) error {
+func (s *TimerLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
 	start := timemodule.Now()
 
-	err := s.StatusStore.SaveOrUpdate(status)
+	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if err == nil {
+		if true {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.InvalidateAllTeamIdsForUser", success, elapsed)
 	}
-	return err
 }
 
-func (s *TimerLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, error) {
+func (s *TimerLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.StatusStore.UpdateExpiredDNDStatuses()
+	result, err := s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7316,15 +11506,15 @@ func (s *TimerLayerStatusStore) UpdateExpiredDNDStatuses() ([]*model.Status, err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateExpiredDNDStatuses", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.MigrateTeamMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerStatusStore) UpdateLastActivityAt(userID string, lastActivityAt int64) error {
+func (s *TimerLayerTeamStore) PermanentDelete(teamID string) error {
 	start := timemodule.Now()
 
-	err := s.StatusStore.UpdateLastActivityAt(userID, lastActivityAt)
+	err := s.TeamStore.PermanentDelete(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7332,15 +11522,15 @@ func (s *TimerLayerStatusStore) UpdateLastActivityAt(userID string, lastActivity
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("StatusStore.UpdateLastActivityAt", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.PermanentDelete", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSystemStore) Get() (model.StringMap, error) {
+func (s *TimerLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
 	start := timemodule.Now()
 
-	result, err := s.SystemStore.Get()
+	err := s.TeamStore.RemoveAllMembersByTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7348,15 +11538,15 @@ func (s *TimerLayerSystemStore) Get() (model.StringMap, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByTeam", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, error) {
+func (s *TimerLayerTeamStore) RemoveAllMembersByUser(userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.SystemStore.GetByName(name)
+	err := s.TeamStore.RemoveAllMembersByUser(userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7364,15 +11554,15 @@ func (s *TimerLayerSystemStore) GetByName(name string) (*model.System, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.System, error) {
+func (s *TimerLayerTeamStore) RemoveMember(teamID string, userID string) error {
 	start := timemodule.Now()
 
-	result, err := s.SystemStore.InsertIfExists(system)
+	err := s.TeamStore.RemoveMember(teamID, userID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7380,15 +11570,15 @@ func (s *TimerLayerSystemStore) InsertIfExists(system *model.System) (*model.Sys
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.InsertIfExists", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMember", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.System, error) {
+func (s *TimerLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
 	start := timemodule.Now()
 
-	result, err := s.SystemStore.PermanentDeleteByName(name)
+	err := s.TeamStore.RemoveMembers(teamID, userIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7396,15 +11586,15 @@ func (s *TimerLayerSystemStore) PermanentDeleteByName(name string) (*model.Syste
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.PermanentDeleteByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMembers", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerSystemStore) Save(system *model.System) error {
+func (s *TimerLayerTeamStore) ResetAllTeamSchemes() error {
 	start := timemodule.Now()
 
-	err := s.SystemStore.Save(system)
+	err := s.TeamStore.ResetAllTeamSchemes()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7412,15 +11602,15 @@ func (s *TimerLayerSystemStore) Save(system *model.System) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ResetAllTeamSchemes", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerSystemStore) SaveOrUpdate(system *model.System) error {
+func (s *TimerLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
 	start := timemodule.Now()
 
-	err := s.SystemStore.SaveOrUpdate(system)
+	result, err := s.TeamStore.Save(team)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7428,15 +11618,15 @@ func (s *TimerLayerSystemStore) SaveOrUpdate(system *model.System) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model.System) error {
+func (s *TimerLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	err := s.SystemStore.SaveOrUpdateWithWarnMetricHandling(system)
+	result, err := s.TeamStore.SaveMember(member, maxUsersPerTeam)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7444,15 +11634,15 @@ func (s *TimerLayerSystemStore) SaveOrUpdateWithWarnMetricHandling(system *model
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.SaveOrUpdateWithWarnMetricHandling", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMember", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerSystemStore) Update(system *model.System) error {
+func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	err := s.SystemStore.Update(system)
+	result, err := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7460,15 +11650,15 @@ func (s *TimerLayerSystemStore) Update(system *model.System) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("SystemStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMultipleMembers", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (int64, error) {
+func (s *TimerLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.AnalyticsGetTeamCountForScheme(schemeID)
+	result, err := s.TeamStore.SearchAll(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7476,15 +11666,15 @@ func (s *TimerLayerTeamStore) AnalyticsGetTeamCountForScheme(schemeID string) (i
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsGetTeamCountForScheme", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64, error) {
+func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.AnalyticsTeamCount(opts)
+	result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7492,15 +11682,15 @@ func (s *TimerLayerTeamStore) AnalyticsTeamCount(opts *model.TeamSearch) (int64,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.AnalyticsTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
 	}
-	return result, err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerTeamStore) ClearAllCustomRoleAssignments() error {
+func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.ClearAllCustomRoleAssignments()
+	result, err := s.TeamStore.SearchOpen(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7508,30 +11698,31 @@ func (s *TimerLayerTeamStore) ClearAllCustomRoleAssignments() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearAllCustomRoleAssignments", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) ClearCaches() {
+func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
 	start := timemodule.Now()
 
-	s.TeamStore.ClearCaches()
+	result, err := s.TeamStore.SearchPrivate(opts)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ClearCaches", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) Get(id string) (*model.Team, error) {
+func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.Get(id)
+	result, err := s.TeamStore.Update(team)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7539,15 +11730,15 @@ func (s *TimerLayerTeamStore) Get(id string) (*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetActiveMemberCount(teamID, restrictions)
+	err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7555,15 +11746,15 @@ func (s *TimerLayerTeamStore) GetActiveMemberCount(teamID string, restrictions *
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetActiveMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetAll() ([]*model.Team, error) {
+func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetAll()
+	result, err := s.TeamStore.UpdateMember(member)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7571,15 +11762,15 @@ func (s *TimerLayerTeamStore) GetAll() ([]*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([]*model.TeamForExport, error) {
+func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetAllForExportAfter(limit, afterID)
+	err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7587,15 +11778,15 @@ func (s *TimerLayerTeamStore) GetAllForExportAfter(limit int, afterID string) ([
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllForExportAfter", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetAllPage(offset int, limit int, opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetAllPage(offset, limit, opts)
+	result, err := s.TeamStore.UpdateMultipleMembers(members)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7603,15 +11794,15 @@ func (s *TimerLayerTeamStore) GetAllPage(offset int, limit int, opts *model.Team
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPage", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error) {
+func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetAllPrivateTeamListing()
+	result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7619,15 +11810,15 @@ func (s *TimerLayerTeamStore) GetAllPrivateTeamListing() ([]*model.Team, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllPrivateTeamListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
+func (s *TimerLayerTeamAliasStore) Delete(name string) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetAllTeamListing()
+	err := s.TeamAliasStore.Delete(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7635,15 +11826,15 @@ func (s *TimerLayerTeamStore) GetAllTeamListing() ([]*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetAllTeamListing", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamAliasStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error) {
+func (s *TimerLayerTeamAliasStore) DeleteForTeam(teamID string) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetByInviteId(inviteID)
+	err := s.TeamAliasStore.DeleteForTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7651,15 +11842,15 @@ func (s *TimerLayerTeamStore) GetByInviteId(inviteID string) (*model.Team, error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByInviteId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamAliasStore.DeleteForTeam", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetByName(name string) (*model.Team, error) {
+func (s *TimerLayerTeamAliasStore) Get(name string) (*model.TeamAlias, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetByName(name)
+	result, err := s.TeamAliasStore.Get(name)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7667,15 +11858,15 @@ func (s *TimerLayerTeamStore) GetByName(name string) (*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamAliasStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
+func (s *TimerLayerTeamAliasStore) GetForTeam(teamID string) ([]*model.TeamAlias, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetByNames(name)
+	result, err := s.TeamAliasStore.GetForTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7683,15 +11874,15 @@ func (s *TimerLayerTeamStore) GetByNames(name []string) ([]*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamAliasStore.GetForTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *TimerLayerTeamAliasStore) Save(alias *model.TeamAlias) (*model.TeamAlias, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetChannelUnreadsForAllTeams(excludeTeamID, userID)
+	result, err := s.TeamAliasStore.Save(alias)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7699,15 +11890,15 @@ func (s *TimerLayerTeamStore) GetChannelUnreadsForAllTeams(excludeTeamID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForAllTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamAliasStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*model.ChannelUnread, error) {
+func (s *TimerLayerTeamBannerStore) Delete(id string, deleteAt int64) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetChannelUnreadsForTeam(teamID, userID)
+	err := s.TeamBannerStore.Delete(id, deleteAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7715,15 +11906,15 @@ func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID str
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsForTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID string) ([]string, error) {
+func (s *TimerLayerTeamBannerStore) Get(id string) (*model.TeamBanner, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetCommonTeamIDsForTwoUsers(userID, otherUserID)
+	result, err := s.TeamBannerStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7731,15 +11922,15 @@ func (s *TimerLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUs
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetCommonTeamIDsForTwoUsers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetMember(ctx context.Context, teamID string, userID string) (*model.TeamMember, error) {
+func (s *TimerLayerTeamBannerStore) GetDismissedBannerIds(userID string, bannerIDs []string) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetMember(ctx, teamID, userID)
+	result, err := s.TeamBannerStore.GetDismissedBannerIds(userID, bannerIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7747,15 +11938,15 @@ func (s *TimerLayerTeamStore) GetMember(ctx context.Context, teamID string, user
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.GetDismissedBannerIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamBannerStore) GetDismissingUserIds(bannerID string) ([]string, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetMembers(teamID, offset, limit, teamMembersGetOptions)
+	result, err := s.TeamBannerStore.GetDismissingUserIds(bannerID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7763,15 +11954,15 @@ func (s *TimerLayerTeamStore) GetMembers(teamID string, offset int, limit int, t
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.GetDismissingUserIds", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamBannerStore) GetForTeam(teamID string) ([]*model.TeamBanner, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetMembersByIds(teamID, userIds, restrictions)
+	result, err := s.TeamBannerStore.GetForTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7779,15 +11970,15 @@ func (s *TimerLayerTeamStore) GetMembersByIds(teamID string, userIds []string, r
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetMembersByIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.GetForTeam", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.TeamMemberForExport, error) {
+func (s *TimerLayerTeamBannerStore) GetScheduledBetween(since int64, until int64) ([]*model.TeamBanner, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTeamMembersForExport(userID)
+	result, err := s.TeamBannerStore.GetScheduledBetween(since, until)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7795,15 +11986,15 @@ func (s *TimerLayerTeamStore) GetTeamMembersForExport(userID string) ([]*model.T
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamMembersForExport", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.GetScheduledBetween", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limit int) ([]*model.Team, error) {
+func (s *TimerLayerTeamBannerStore) Save(banner *model.TeamBanner) (*model.TeamBanner, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTeamsByScheme(schemeID, offset, limit)
+	result, err := s.TeamBannerStore.Save(banner)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7811,15 +12002,15 @@ func (s *TimerLayerTeamStore) GetTeamsByScheme(schemeID string, offset int, limi
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByScheme", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, error) {
+func (s *TimerLayerTeamBannerStore) SaveDismissal(dismissal *model.TeamBannerDismissal) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTeamsByUserId(userID)
+	err := s.TeamBannerStore.SaveDismissal(dismissal)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7827,15 +12018,15 @@ func (s *TimerLayerTeamStore) GetTeamsByUserId(userID string) ([]*model.Team, er
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsByUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.SaveDismissal", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamBannerStore) Update(banner *model.TeamBanner) (*model.TeamBanner, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTeamsForUser(ctx, userID)
+	result, err := s.TeamBannerStore.Update(banner)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7843,15 +12034,15 @@ func (s *TimerLayerTeamStore) GetTeamsForUser(ctx context.Context, userID string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamBannerStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(userID string, page int, perPage int) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamDeletionStore) Delete(teamID string) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTeamsForUserWithPagination(userID, page, perPage)
+	err := s.TeamDeletionStore.Delete(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7859,15 +12050,15 @@ func (s *TimerLayerTeamStore) GetTeamsForUserWithPagination(userID string, page
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTeamsForUserWithPagination", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamDeletionStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error) {
+func (s *TimerLayerTeamDeletionStore) Get(teamID string) (*model.TeamDeletion, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetTotalMemberCount(teamID, restrictions)
+	result, err := s.TeamDeletionStore.Get(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7875,15 +12066,15 @@ func (s *TimerLayerTeamStore) GetTotalMemberCount(teamID string, restrictions *m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetTotalMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamDeletionStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool) ([]string, error) {
+func (s *TimerLayerTeamDeletionStore) GetAll() ([]*model.TeamDeletion, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GetUserTeamIds(userID, allowFromCache)
+	result, err := s.TeamDeletionStore.GetAll()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7891,15 +12082,15 @@ func (s *TimerLayerTeamStore) GetUserTeamIds(userID string, allowFromCache bool)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetUserTeamIds", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamDeletionStore.GetAll", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
+func (s *TimerLayerTeamDeletionStore) Save(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.GroupSyncedTeamCount()
+	result, err := s.TeamDeletionStore.Save(deletion)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7907,30 +12098,31 @@ func (s *TimerLayerTeamStore) GroupSyncedTeamCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GroupSyncedTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamDeletionStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) InvalidateAllTeamIdsForUser(userID string) {
+func (s *TimerLayerTeamDeletionStore) Update(deletion *model.TeamDeletion) (*model.TeamDeletion, error) {
 	start := timemodule.Now()
 
-	s.TeamStore.InvalidateAllTeamIdsForUser(userID)
+	result, err := s.TeamDeletionStore.Update(deletion)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
 		success := "false"
-		if true {
+		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.InvalidateAllTeamIdsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamDeletionStore.Update", success, elapsed)
 	}
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID string) (map[string]string, error) {
+func (s *TimerLayerTeamGroupRoleStore) Delete(teamID string, groupID string) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.MigrateTeamMembers(fromTeamID, fromUserID)
+	err := s.TeamGroupRoleStore.Delete(teamID, groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7938,15 +12130,15 @@ func (s *TimerLayerTeamStore) MigrateTeamMembers(fromTeamID string, fromUserID s
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.MigrateTeamMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) PermanentDelete(teamID string) error {
+func (s *TimerLayerTeamGroupRoleStore) DeleteGrants(teamID string, userIDs []string) error {
 	start := timemodule.Now()
 
-	err := s.TeamStore.PermanentDelete(teamID)
+	err := s.TeamGroupRoleStore.DeleteGrants(teamID, userIDs)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7954,15 +12146,15 @@ func (s *TimerLayerTeamStore) PermanentDelete(teamID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.PermanentDelete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.DeleteGrants", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
+func (s *TimerLayerTeamGroupRoleStore) GetForGroup(groupID string) ([]*model.TeamGroupRole, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.RemoveAllMembersByTeam(teamID)
+	result, err := s.TeamGroupRoleStore.GetForGroup(groupID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7970,15 +12162,15 @@ func (s *TimerLayerTeamStore) RemoveAllMembersByTeam(teamID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.GetForGroup", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) RemoveAllMembersByUser(userID string) error {
+func (s *TimerLayerTeamGroupRoleStore) GetForTeam(teamID string) ([]*model.TeamGroupRole, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.RemoveAllMembersByUser(userID)
+	result, err := s.TeamGroupRoleStore.GetForTeam(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -7986,15 +12178,15 @@ func (s *TimerLayerTeamStore) RemoveAllMembersByUser(userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveAllMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.GetForTeam", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) RemoveMember(teamID string, userID string) error {
+func (s *TimerLayerTeamGroupRoleStore) GetMembers(teamID string) ([]*model.TeamGroupRoleMember, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.RemoveMember(teamID, userID)
+	result, err := s.TeamGroupRoleStore.GetMembers(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8002,15 +12194,15 @@ func (s *TimerLayerTeamStore) RemoveMember(teamID string, userID string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.GetMembers", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) RemoveMembers(teamID string, userIds []string) error {
+func (s *TimerLayerTeamGroupRoleStore) GetTeamIDs() ([]string, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.RemoveMembers(teamID, userIds)
+	result, err := s.TeamGroupRoleStore.GetTeamIDs()
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8018,15 +12210,15 @@ func (s *TimerLayerTeamStore) RemoveMembers(teamID string, userIds []string) err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.RemoveMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.GetTeamIDs", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) ResetAllTeamSchemes() error {
+func (s *TimerLayerTeamGroupRoleStore) Save(mapping *model.TeamGroupRole) (*model.TeamGroupRole, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.ResetAllTeamSchemes()
+	result, err := s.TeamGroupRoleStore.Save(mapping)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8034,15 +12226,15 @@ func (s *TimerLayerTeamStore) ResetAllTeamSchemes() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.ResetAllTeamSchemes", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
+func (s *TimerLayerTeamGroupRoleStore) SaveGrants(teamID string, userIDs []string, grantedAt int64) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.Save(team)
+	err := s.TeamGroupRoleStore.SaveGrants(teamID, userIDs, grantedAt)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8050,15 +12242,15 @@ func (s *TimerLayerTeamStore) Save(team *model.Team) (*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamGroupRoleStore.SaveGrants", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+func (s *TimerLayerTeamInviteUsageStore) Get(teamID string, day int64) (*model.TeamInviteUsage, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.SaveMember(member, maxUsersPerTeam)
+	result, err := s.TeamInviteUsageStore.Get(teamID, day)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8066,15 +12258,15 @@ func (s *TimerLayerTeamStore) SaveMember(member *model.TeamMember, maxUsersPerTe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteUsageStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamInviteUsageStore) Increment(usage *model.TeamInviteUsage) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
+	err := s.TeamInviteUsageStore.Increment(usage)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8082,15 +12274,15 @@ func (s *TimerLayerTeamStore) SaveMultipleMembers(members []*model.TeamMember, m
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SaveMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamInviteUsageStore.Increment", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamRequestStore) Get(id string) (*model.TeamRequest, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.SearchAll(opts)
+	result, err := s.TeamRequestStore.Get(id)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8098,15 +12290,15 @@ func (s *TimerLayerTeamStore) SearchAll(opts *model.TeamSearch) ([]*model.Team,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamRequestStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.Team, int64, error) {
+func (s *TimerLayerTeamRequestStore) GetAll(status string, offset int, limit int) ([]*model.TeamRequest, error) {
 	start := timemodule.Now()
 
-	result, resultVar1, err := s.TeamStore.SearchAllPaged(opts)
+	result, err := s.TeamRequestStore.GetAll(status, offset, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8114,15 +12306,15 @@ func (s *TimerLayerTeamStore) SearchAllPaged(opts *model.TeamSearch) ([]*model.T
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchAllPaged", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamRequestStore.GetAll", success, elapsed)
 	}
-	return result, resultVar1, err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamRequestStore) Review(request *model.TeamRequest) (*model.TeamRequest, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.SearchOpen(opts)
+	result, err := s.TeamRequestStore.Review(request)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8130,15 +12322,15 @@ func (s *TimerLayerTeamStore) SearchOpen(opts *model.TeamSearch) ([]*model.Team,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchOpen", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamRequestStore.Review", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Team, error) {
+func (s *TimerLayerTeamRequestStore) Save(request *model.TeamRequest) (*model.TeamRequest, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.SearchPrivate(opts)
+	result, err := s.TeamRequestStore.Save(request)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8146,15 +12338,15 @@ func (s *TimerLayerTeamStore) SearchPrivate(opts *model.TeamSearch) ([]*model.Te
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.SearchPrivate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamRequestStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
+func (s *TimerLayerTeamStatsStore) GetChannelDailyStats(channelID string, since int64, until int64) ([]*model.ChannelDailyStats, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.Update(team)
+	result, err := s.TeamStatsStore.GetChannelDailyStats(channelID, since, until)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8162,15 +12354,15 @@ func (s *TimerLayerTeamStore) Update(team *model.Team) (*model.Team, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.GetChannelDailyStats", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime int64) error {
+func (s *TimerLayerTeamStatsStore) GetChannelPostCounts(teamID string, since int64, until int64, limit int) ([]*model.TeamChannelPostCount, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.UpdateLastTeamIconUpdate(teamID, curTime)
+	result, err := s.TeamStatsStore.GetChannelPostCounts(teamID, since, until, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8178,15 +12370,15 @@ func (s *TimerLayerTeamStore) UpdateLastTeamIconUpdate(teamID string, curTime in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateLastTeamIconUpdate", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.GetChannelPostCounts", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.TeamMember, error) {
+func (s *TimerLayerTeamStatsStore) GetDailyStats(teamID string, since int64, until int64) ([]*model.TeamDailyStats, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.UpdateMember(member)
+	result, err := s.TeamStatsStore.GetDailyStats(teamID, since, until)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8194,15 +12386,15 @@ func (s *TimerLayerTeamStore) UpdateMember(member *model.TeamMember) (*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.GetDailyStats", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string) error {
+func (s *TimerLayerTeamStatsStore) GetFileUsage(teamID string) (int64, int64, error) {
 	start := timemodule.Now()
 
-	err := s.TeamStore.UpdateMembersRole(teamID, userIDs)
+	result, resultVar1, err := s.TeamStatsStore.GetFileUsage(teamID)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8210,15 +12402,15 @@ func (s *TimerLayerTeamStore) UpdateMembersRole(teamID string, userIDs []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMembersRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.GetFileUsage", success, elapsed)
 	}
-	return err
+	return result, resultVar1, err
 }
 
-func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+func (s *TimerLayerTeamStatsStore) GetTopPosters(teamID string, since int64, until int64, limit int) ([]*model.TeamPosterCount, error) {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.UpdateMultipleMembers(members)
+	result, err := s.TeamStatsStore.GetTopPosters(teamID, since, until, limit)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8226,15 +12418,15 @@ func (s *TimerLayerTeamStore) UpdateMultipleMembers(members []*model.TeamMember)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UpdateMultipleMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.GetTopPosters", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string) (bool, error) {
+func (s *TimerLayerTeamStatsStore) RollupDay(day int64) error {
 	start := timemodule.Now()
 
-	result, err := s.TeamStore.UserBelongsToTeams(userID, teamIds)
+	err := s.TeamStatsStore.RollupDay(day)
 
 	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
 	if s.Root.Metrics != nil {
@@ -8242,9 +12434,9 @@ func (s *TimerLayerTeamStore) UserBelongsToTeams(userID string, teamIds []string
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.UserBelongsToTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStatsStore.RollupDay", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
 func (s *TimerLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.TermsOfService, error) {
@@ -8391,6 +12583,22 @@ func (s *TimerLayerThreadStore) GetPosts(threadID string, since int64) ([]*model
 	return result, err
 }
 
+func (s *TimerLayerThreadStore) GetSummary(threadID string, bucketSize int64, replies int) (*model.ThreadSummary, error) {
+	start := timemodule.Now()
+
+	result, err := s.ThreadStore.GetSummary(threadID, bucketSize, replies)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ThreadStore.GetSummary", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerThreadStore) GetTeamsUnreadForUser(userID string, teamIDs []string) (map[string]*model.TeamUnread, error) {
 	start := timemodule.Now()
 
@@ -8774,6 +12982,38 @@ func (s *TimerLayerUploadSessionStore) Update(session *model.UploadSession) erro
 	return err
 }
 
+func (s *TimerLayerUploadUsageStore) GetForUser(userID string, day int64) (int64, int64, error) {
+	start := timemodule.Now()
+
+	result, resultVar1, err := s.UploadUsageStore.GetForUser(userID, day)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UploadUsageStore.GetForUser", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerUploadUsageStore) Increment(usage *model.UploadUsage) error {
+	start := timemodule.Now()
+
+	err := s.UploadUsageStore.Increment(usage)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UploadUsageStore.Increment", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerUserStore) AnalyticsActiveCount(time int64, options model.UserCountOptions) (int64, error) {
 	start := timemodule.Now()
 
@@ -10066,6 +14306,22 @@ func (s *TimerLayerUserAccessTokenStore) GetByUser(userID string, page int, perP
 	return result, err
 }
 
+func (s *TimerLayerUserAccessTokenStore) GetExpired(before int64, limit int) ([]*model.UserAccessToken, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserAccessTokenStore.GetExpired(before, limit)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserAccessTokenStore.GetExpired", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 	start := timemodule.Now()
 
@@ -10098,6 +14354,22 @@ func (s *TimerLayerUserAccessTokenStore) Search(term string) ([]*model.UserAcces
 	return result, err
 }
 
+func (s *TimerLayerUserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
+	start := timemodule.Now()
+
+	err := s.UserAccessTokenStore.UpdateExpiresAt(tokenID, expiresAt)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserAccessTokenStore.UpdateExpiresAt", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 	start := timemodule.Now()
 
@@ -10130,6 +14402,86 @@ func (s *TimerLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 	return err
 }
 
+func (s *TimerLayerUserMergeStore) Get(id string) (*model.UserMerge, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserMergeStore.Get(id)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserMergeStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserMergeStore) MergeRows(merge *model.UserMerge, table string) (*model.UserMerge, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserMergeStore.MergeRows(merge, table)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserMergeStore.MergeRows", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserMergeStore) MoveRows(table string, fromUserID string, toUserID string, keys []string) ([]string, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserMergeStore.MoveRows(table, fromUserID, toUserID, keys)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserMergeStore.MoveRows", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserMergeStore) Save(merge *model.UserMerge) (*model.UserMerge, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserMergeStore.Save(merge)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserMergeStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserMergeStore) Update(merge *model.UserMerge) (*model.UserMerge, error) {
+	start := timemodule.Now()
+
+	result, err := s.UserMergeStore.Update(merge)
+
+	elapsed := float64(timemodule.Since(start)) / float64(timemodule.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserMergeStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 	start := timemodule.Now()
 
@@ -10654,41 +15006,81 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 		Metrics: metrics,
 	}
 
+	newStore.APIUsageStore = &TimerLayerAPIUsageStore{APIUsageStore: childStore.APIUsage(), Root: &newStore}
+	newStore.ActivityEventStore = &TimerLayerActivityEventStore{ActivityEventStore: childStore.ActivityEvent(), Root: &newStore}
+	newStore.AlertRuleStore = &TimerLayerAlertRuleStore{AlertRuleStore: childStore.AlertRule(), Root: &newStore}
 	newStore.AuditStore = &TimerLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AuditLogStore = &TimerLayerAuditLogStore{AuditLogStore: childStore.AuditLog(), Root: &newStore}
 	newStore.BotStore = &TimerLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
+	newStore.BotStateStore = &TimerLayerBotStateStore{BotStateStore: childStore.BotState(), Root: &newStore}
+	newStore.BotTokenRotationStore = &TimerLayerBotTokenRotationStore{BotTokenRotationStore: childStore.BotTokenRotation(), Root: &newStore}
+	newStore.CannedResponseStore = &TimerLayerCannedResponseStore{CannedResponseStore: childStore.CannedResponse(), Root: &newStore}
 	newStore.ChannelStore = &TimerLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
+	newStore.ChannelArchivePolicyStore = &TimerLayerChannelArchivePolicyStore{ChannelArchivePolicyStore: childStore.ChannelArchivePolicy(), Root: &newStore}
+	newStore.ChannelCommandOverrideStore = &TimerLayerChannelCommandOverrideStore{ChannelCommandOverrideStore: childStore.ChannelCommandOverride(), Root: &newStore}
+	newStore.ChannelDigestStore = &TimerLayerChannelDigestStore{ChannelDigestStore: childStore.ChannelDigest(), Root: &newStore}
+	newStore.ChannelEventStore = &TimerLayerChannelEventStore{ChannelEventStore: childStore.ChannelEvent(), Root: &newStore}
+	newStore.ChannelFeedStore = &TimerLayerChannelFeedStore{ChannelFeedStore: childStore.ChannelFeed(), Root: &newStore}
+	newStore.ChannelLanguageStatsStore = &TimerLayerChannelLanguageStatsStore{ChannelLanguageStatsStore: childStore.ChannelLanguageStats(), Root: &newStore}
 	newStore.ChannelMemberHistoryStore = &TimerLayerChannelMemberHistoryStore{ChannelMemberHistoryStore: childStore.ChannelMemberHistory(), Root: &newStore}
 	newStore.ClusterDiscoveryStore = &TimerLayerClusterDiscoveryStore{ClusterDiscoveryStore: childStore.ClusterDiscovery(), Root: &newStore}
 	newStore.CommandStore = &TimerLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &TimerLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &TimerLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigHistoryStore = &TimerLayerConfigHistoryStore{ConfigHistoryStore: childStore.ConfigHistory(), Root: &newStore}
+	newStore.CustomStatusTemplateStore = &TimerLayerCustomStatusTemplateStore{CustomStatusTemplateStore: childStore.CustomStatusTemplate(), Root: &newStore}
+	newStore.DirectChannelRetentionStore = &TimerLayerDirectChannelRetentionStore{DirectChannelRetentionStore: childStore.DirectChannelRetention(), Root: &newStore}
+	newStore.EmailSuppressionStore = &TimerLayerEmailSuppressionStore{EmailSuppressionStore: childStore.EmailSuppression(), Root: &newStore}
 	newStore.EmojiStore = &TimerLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
+	newStore.EventWebhookStore = &TimerLayerEventWebhookStore{EventWebhookStore: childStore.EventWebhook(), Root: &newStore}
 	newStore.FileInfoStore = &TimerLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &TimerLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IdempotencyKeyStore = &TimerLayerIdempotencyKeyStore{IdempotencyKeyStore: childStore.IdempotencyKey(), Root: &newStore}
+	newStore.ImpersonationStore = &TimerLayerImpersonationStore{ImpersonationStore: childStore.Impersonation(), Root: &newStore}
 	newStore.JobStore = &TimerLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &TimerLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
+	newStore.LicenseUsageStore = &TimerLayerLicenseUsageStore{LicenseUsageStore: childStore.LicenseUsage(), Root: &newStore}
 	newStore.LinkMetadataStore = &TimerLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
 	newStore.OAuthStore = &TimerLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
+	newStore.OAuthAppUsageStore = &TimerLayerOAuthAppUsageStore{OAuthAppUsageStore: childStore.OAuthAppUsage(), Root: &newStore}
+	newStore.OutstandingMentionStore = &TimerLayerOutstandingMentionStore{OutstandingMentionStore: childStore.OutstandingMention(), Root: &newStore}
+	newStore.PermissionDenialStore = &TimerLayerPermissionDenialStore{PermissionDenialStore: childStore.PermissionDenial(), Root: &newStore}
 	newStore.PluginStore = &TimerLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &TimerLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
+	newStore.PostAcknowledgementStore = &TimerLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostModerationStore = &TimerLayerPostModerationStore{PostModerationStore: childStore.PostModeration(), Root: &newStore}
+	newStore.PostPriorityStore = &TimerLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
+	newStore.PostReportStore = &TimerLayerPostReportStore{PostReportStore: childStore.PostReport(), Root: &newStore}
+	newStore.PostTaskStore = &TimerLayerPostTaskStore{PostTaskStore: childStore.PostTask(), Root: &newStore}
 	newStore.PreferenceStore = &TimerLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
 	newStore.ProductNoticesStore = &TimerLayerProductNoticesStore{ProductNoticesStore: childStore.ProductNotices(), Root: &newStore}
+	newStore.RESTProxyBridgeStore = &TimerLayerRESTProxyBridgeStore{RESTProxyBridgeStore: childStore.RESTProxyBridge(), Root: &newStore}
 	newStore.ReactionStore = &TimerLayerReactionStore{ReactionStore: childStore.Reaction(), Root: &newStore}
 	newStore.RemoteClusterStore = &TimerLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &TimerLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &TimerLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.ScheduledChannelMessageStore = &TimerLayerScheduledChannelMessageStore{ScheduledChannelMessageStore: childStore.ScheduledChannelMessage(), Root: &newStore}
 	newStore.SchemeStore = &TimerLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &TimerLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &TimerLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
 	newStore.StatusStore = &TimerLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
 	newStore.SystemStore = &TimerLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &TimerLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
+	newStore.TeamAliasStore = &TimerLayerTeamAliasStore{TeamAliasStore: childStore.TeamAlias(), Root: &newStore}
+	newStore.TeamBannerStore = &TimerLayerTeamBannerStore{TeamBannerStore: childStore.TeamBanner(), Root: &newStore}
+	newStore.TeamDeletionStore = &TimerLayerTeamDeletionStore{TeamDeletionStore: childStore.TeamDeletion(), Root: &newStore}
+	newStore.TeamGroupRoleStore = &TimerLayerTeamGroupRoleStore{TeamGroupRoleStore: childStore.TeamGroupRole(), Root: &newStore}
+	newStore.TeamInviteUsageStore = &TimerLayerTeamInviteUsageStore{TeamInviteUsageStore: childStore.TeamInviteUsage(), Root: &newStore}
+	newStore.TeamRequestStore = &TimerLayerTeamRequestStore{TeamRequestStore: childStore.TeamRequest(), Root: &newStore}
+	newStore.TeamStatsStore = &TimerLayerTeamStatsStore{TeamStatsStore: childStore.TeamStats(), Root: &newStore}
 	newStore.TermsOfServiceStore = &TimerLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &TimerLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &TimerLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
 	newStore.UploadSessionStore = &TimerLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
+	newStore.UploadUsageStore = &TimerLayerUploadUsageStore{UploadUsageStore: childStore.UploadUsage(), Root: &newStore}
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserMergeStore = &TimerLayerUserMergeStore{UserMergeStore: childStore.UserMerge(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &TimerLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
 	newStore.WebhookStore = &TimerLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
@@ -64,10 +64,28 @@ func (c *Context) MakeAuditRecord(event string, initialStatus string) *audit.Rec
 	}
 	rec.AddMetaTypeConverter(model.AuditModelTypeConv)
 
+	// Requests made over the local socket have no user behind them, so flag them instead.
+	if c.AppContext.Session().Local {
+		rec.AddMeta(audit.KeyLocal, true)
+	}
+
+	// Flag what an admin does while impersonating the user of the session.
+	if impersonatorID := c.AppContext.Session().ImpersonatorId(); impersonatorID != "" {
+		rec.AddMeta(audit.KeyImpersonatorID, impersonatorID)
+		rec.AddMeta(audit.KeyImpersonationID, c.AppContext.Session().Props[model.SessionPropImpersonationId])
+	}
+
 	return rec
 }
 
 func (c *Context) LogAudit(extraInfo string) {
+	if c.AppContext.Session().Local {
+		extraInfo = strings.TrimSpace(extraInfo + " local=true")
+	}
+	if impersonatorID := c.AppContext.Session().ImpersonatorId(); impersonatorID != "" {
+		extraInfo = strings.TrimSpace(extraInfo + " impersonator_id=" + impersonatorID)
+	}
+
 	audit := &model.Audit{UserId: c.AppContext.Session().UserId, IpAddress: c.AppContext.IPAddress(), Action: c.AppContext.Path(), ExtraInfo: extraInfo, SessionId: c.AppContext.Session().Id}
 	if err := c.App.Srv().Store.Audit().Save(audit); err != nil {
 		appErr := model.NewAppError("LogAudit", "app.audit.save.saving.app_error", nil, err.Error(), http.StatusInternalServerError)
@@ -139,6 +157,19 @@ func (c *Context) RemoteClusterTokenRequired() {
 	}
 }
 
+// ImpersonationForbidden rejects requests made while an admin impersonates the user of the
+// session. Handlers changing credentials or sessions call it, so that what an admin does while
+// impersonating a user doesn't outlive the impersonation.
+func (c *Context) ImpersonationForbidden() {
+	if c.Err != nil {
+		return
+	}
+
+	if c.AppContext.Session().ImpersonatorId() != "" {
+		c.Err = model.NewAppError("", "api.context.impersonation_forbidden.app_error", nil, "ImpersonationForbidden", http.StatusForbidden)
+	}
+}
+
 func (c *Context) MfaRequired() {
 	// Must be licensed for MFA and have it configured for enforcement
 	if license := c.App.Channels().License(); license == nil || !*license.Features.MFA || !*c.App.Config().ServiceSettings.EnableMultifactorAuthentication || !*c.App.Config().ServiceSettings.EnforceMultifactorAuthentication {
@@ -283,6 +314,17 @@ func NewJSONEncodingError() *model.AppError {
 
 func (c *Context) SetPermissionError(permissions ...*model.Permission) {
 	c.Err = c.App.MakePermissionError(c.AppContext.Session(), permissions)
+
+	resourceType, resourceID := model.PermissionDenialResourceSystem, ""
+	if c.Params != nil {
+		switch {
+		case model.IsValidId(c.Params.ChannelId):
+			resourceType, resourceID = model.PermissionDenialResourceChannel, c.Params.ChannelId
+		case model.IsValidId(c.Params.TeamId):
+			resourceType, resourceID = model.PermissionDenialResourceTeam, c.Params.TeamId
+		}
+	}
+	c.App.RecordPermissionDenial(c.AppContext.Session(), permissions, resourceType, resourceID, c.AppContext.Path())
 }
 
 func (c *Context) SetSiteURLHeader(url string) {
@@ -489,6 +531,149 @@ func (c *Context) RequireReportId() *Context {
 	return c
 }
 
+func (c *Context) RequireBannerId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.BannerId) {
+		c.SetInvalidURLParam("banner_id")
+	}
+	return c
+}
+
+func (c *Context) RequireCannedResponseId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.CannedResponseId) {
+		c.SetInvalidURLParam("canned_response_id")
+	}
+	return c
+}
+
+func (c *Context) RequireTeamRequestId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.TeamRequestId) {
+		c.SetInvalidURLParam("team_request_id")
+	}
+	return c
+}
+
+func (c *Context) RequireUserMergeId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.UserMergeId) {
+		c.SetInvalidURLParam("user_merge_id")
+	}
+	return c
+}
+
+func (c *Context) RequireImpersonationId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ImpersonationId) {
+		c.SetInvalidURLParam("impersonation_id")
+	}
+	return c
+}
+
+func (c *Context) RequireScheduledMessageId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ScheduledMessageId) {
+		c.SetInvalidURLParam("scheduled_message_id")
+	}
+	return c
+}
+
+func (c *Context) RequireEventWebhookId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.EventWebhookId) {
+		c.SetInvalidURLParam("event_webhook_id")
+	}
+	return c
+}
+
+func (c *Context) RequireChannelEventId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ChannelEventId) {
+		c.SetInvalidURLParam("channel_event_id")
+	}
+	return c
+}
+
+func (c *Context) RequireAlertRuleId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.AlertRuleId) {
+		c.SetInvalidURLParam("alert_rule_id")
+	}
+	return c
+}
+
+func (c *Context) RequirePostModerationId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.PostModerationId) {
+		c.SetInvalidURLParam("post_moderation_id")
+	}
+	return c
+}
+
+func (c *Context) RequirePostReportId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.PostReportId) {
+		c.SetInvalidURLParam("post_report_id")
+	}
+	return c
+}
+
+func (c *Context) RequireCustomStatusTemplateId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.CustomStatusTemplateId) {
+		c.SetInvalidURLParam("custom_status_template_id")
+	}
+	return c
+}
+
+func (c *Context) RequireChannelFeedId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.ChannelFeedId) {
+		c.SetInvalidURLParam("feed_id")
+	}
+	return c
+}
+
 func (c *Context) RequireEmojiId() *Context {
 	if c.Err != nil {
 		return c
@@ -524,6 +709,18 @@ func (c *Context) RequireChannelName() *Context {
 	return c
 }
 
+func (c *Context) RequireCommandTrigger() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if len(c.Params.CommandTrigger) < model.MinTriggerLength || len(c.Params.CommandTrigger) > model.MaxTriggerLength || strings.Contains(c.Params.CommandTrigger, " ") {
+		c.SetInvalidURLParam("trigger")
+	}
+
+	return c
+}
+
 func (c *Context) SanitizeEmail() *Context {
 	if c.Err != nil {
 		return c
@@ -720,6 +917,17 @@ func (c *Context) RequireBotUserId() *Context {
 	return c
 }
 
+func (c *Context) RequireBotStateKey() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidBotStateKey(c.Params.BotStateKey) {
+		c.SetInvalidURLParam("state_key")
+	}
+	return c
+}
+
 func (c *Context) RequireInvoiceId() *Context {
 	if c.Err != nil {
 		return c
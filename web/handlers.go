@@ -82,6 +82,7 @@ type Handler struct {
 	IsStatic                  bool
 	IsLocal                   bool
 	DisableWhenBusy           bool
+	Idempotent                bool
 
 	cspShaDirective string
 }
@@ -271,6 +272,11 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// Rate limit the sessions issued to OAuth apps by their app
+		if c.AppContext.Session().IsOAuth && c.App.OAuthAppRateLimit(c.AppContext.Session(), w) {
+			return
+		}
+
 		h.checkCSRFToken(c, r, token, tokenLocation, session)
 	} else if token != "" && c.App.Channels().License() != nil && *c.App.Channels().License().Features.Cloud && tokenLocation == app.TokenLocationCloudHeader {
 		// Check to see if this provided token matches our CWS Token
@@ -338,7 +344,25 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if c.Err == nil {
-		h.HandleFunc(c, w, r)
+		if h.Idempotent {
+			h.serveIdempotent(c, w, r)
+		} else {
+			h.HandleFunc(c, w, r)
+		}
+	}
+
+	if !h.IsStatic {
+		c.App.RecordAPIUsage(c.AppContext.Session(), h.HandlerName)
+	}
+
+	// Every change made while impersonating a user is audited, whether or not its handler
+	// keeps audit records of its own.
+	if c.AppContext.Session().ImpersonatorId() != "" && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		extraInfo := "impersonated handler=" + h.HandlerName
+		if c.Err != nil {
+			extraInfo += " fail"
+		}
+		c.LogAudit(extraInfo)
 	}
 
 	// Handle errors that have occurred
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package web
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/mattermost/mattermost-server/v6/shared/mlog"
+)
+
+// idempotentResponseRecorder keeps a copy of the response written by the handler, so that it
+// can be replayed to the retries of the request.
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+	truncated  bool
+}
+
+func (rec *idempotentResponseRecorder) WriteHeader(statusCode int) {
+	if rec.statusCode == 0 {
+		rec.statusCode = statusCode
+	}
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *idempotentResponseRecorder) Write(b []byte) (int, error) {
+	if rec.statusCode == 0 {
+		rec.statusCode = http.StatusOK
+	}
+	if rec.body.Len()+len(b) > model.IdempotencyKeyMaxResponse {
+		rec.truncated = true
+	} else {
+		rec.body.Write(b)
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+// serveIdempotent handles the POST requests made with an Idempotency-Key header at most once
+// per user and key: the retries of a request get its response replayed instead of being
+// processed again. Only successful responses are kept, so that failed requests can be retried.
+// The header is ignored on requests made without a session, since nothing would keep the keys
+// of different clients apart.
+func (h *Handler) serveIdempotent(c *Context, w http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get(model.HeaderIdempotencyKey)
+	if key == "" || r.Method != http.MethodPost || c.AppContext.Session().UserId == "" {
+		h.HandleFunc(c, w, r)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		c.Err = model.NewAppError("serveIdempotent", "api.context.idempotency_key.read_body.app_error", nil, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	userID := c.AppContext.Session().UserId
+	requestHash := model.HashIdempotentRequest(r.Method, r.URL.Path, body)
+	existing, appErr := c.App.ReserveIdempotencyKey(userID, key, requestHash)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if existing != nil {
+		switch {
+		case existing.RequestHash != requestHash:
+			c.Err = model.NewAppError("serveIdempotent", "api.context.idempotency_key.mismatch.app_error", nil, "", http.StatusUnprocessableEntity)
+		case !existing.IsCompleted():
+			c.Err = model.NewAppError("serveIdempotent", "api.context.idempotency_key.in_progress.app_error", nil, "", http.StatusConflict)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set(model.HeaderIdempotentReplayed, "true")
+			w.WriteHeader(existing.StatusCode)
+			w.Write([]byte(existing.Response))
+		}
+		return
+	}
+
+	rec := &idempotentResponseRecorder{ResponseWriter: w}
+	h.HandleFunc(c, rec, r)
+
+	if c.Err != nil || rec.statusCode < 200 || rec.statusCode >= 300 || rec.truncated {
+		if appErr := c.App.ReleaseIdempotencyKey(userID, key); appErr != nil {
+			c.Logger.Warn("Failed to release idempotency key", mlog.Err(appErr))
+		}
+		return
+	}
+
+	if appErr := c.App.CompleteIdempotencyKey(userID, key, rec.statusCode, rec.body.String()); appErr != nil {
+		c.Logger.Warn("Failed to record the response of an idempotent request", mlog.Err(appErr))
+	}
+}
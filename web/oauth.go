@@ -26,6 +26,8 @@ func (w *Web) InitOAuth() {
 	w.MainRouter.Handle("/oauth/authorize", w.APISessionRequired(authorizeOAuthApp)).Methods("POST")
 	w.MainRouter.Handle("/oauth/deauthorize", w.APISessionRequired(deauthorizeOAuthApp)).Methods("POST")
 	w.MainRouter.Handle("/oauth/access_token", w.APIHandlerTrustRequester(getAccessToken)).Methods("POST")
+	w.MainRouter.Handle("/oauth/introspect", w.APIHandlerTrustRequester(introspectOAuthToken)).Methods("POST")
+	w.MainRouter.Handle("/oauth/revoke", w.APIHandlerTrustRequester(revokeOAuthToken)).Methods("POST")
 
 	// API version independent OAuth as a client endpoints
 	w.MainRouter.Handle("/oauth/{service:[A-Za-z0-9]+}/complete", w.APIHandler(completeOAuth)).Methods("GET")
@@ -45,6 +47,11 @@ func testHandler(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func authorizeOAuthApp(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.ImpersonationForbidden()
+	if c.Err != nil {
+		return
+	}
+
 	var authRequest *model.AuthorizeRequest
 	err := json.NewDecoder(r.Body).Decode(&authRequest)
 	if err != nil || authRequest == nil {
@@ -245,6 +252,71 @@ func getAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getOAuthClientCredentials reads the credentials of the client from the basic authorization
+// header, or from the form for the clients that can't send it.
+func getOAuthClientCredentials(r *http.Request) (string, string) {
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		return clientID, clientSecret
+	}
+	return r.FormValue("client_id"), r.FormValue("client_secret")
+}
+
+func introspectOAuthToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	token := r.FormValue("token")
+	if token == "" {
+		c.SetInvalidParam("token")
+		return
+	}
+
+	clientID, clientSecret := getOAuthClientCredentials(r)
+
+	response, err := c.App.IntrospectOAuthToken(clientID, clientSecret, token, r.FormValue("token_type_hint"))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		mlog.Warn("Error writing response", mlog.Err(err))
+	}
+}
+
+func revokeOAuthToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	token := r.FormValue("token")
+	if token == "" {
+		c.SetInvalidParam("token")
+		return
+	}
+
+	clientID, clientSecret := getOAuthClientCredentials(r)
+
+	auditRec := c.MakeAuditRecord("revokeOAuthToken", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("client_id", clientID)
+	c.LogAudit("attempt")
+
+	if err := c.App.RevokeOAuthToken(clientID, clientSecret, token, r.FormValue("token_type_hint")); err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	auditRec.Success()
+	c.LogAudit("success")
+
+	ReturnStatusOK(w)
+}
+
 func completeOAuth(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireService()
 	if c.Err != nil {
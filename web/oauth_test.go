@@ -820,3 +820,200 @@ func TestFullyQualifiedRedirectURL(t *testing.T) {
 		})
 	}
 }
+
+// createOAuthAppWithTokenForTest creates an OAuth app with the given rate limit, and has the basic
+// user grant it a token.
+func createOAuthAppWithTokenForTest(t *testing.T, th *TestHelper, rateLimitPerSec int) (*model.OAuthApp, *model.AccessResponse) {
+	oauthApp, appErr := th.App.CreateOAuthApp(&model.OAuthApp{
+		Name:            "TestApp" + model.NewId(),
+		Homepage:        "https://nowhere.com",
+		Description:     "test",
+		CallbackUrls:    []string{"https://nowhere.com"},
+		CreatorId:       th.SystemAdminUser.Id,
+		RateLimitPerSec: rateLimitPerSec,
+	})
+	require.Nil(t, appErr)
+
+	redirect, appErr := th.App.AllowOAuthAppAccessToUser(th.BasicUser.Id, &model.AuthorizeRequest{
+		ResponseType: model.AuthCodeResponseType,
+		ClientId:     oauthApp.Id,
+		RedirectURI:  oauthApp.CallbackUrls[0],
+		Scope:        "all",
+		State:        "123",
+	})
+	require.Nil(t, appErr)
+	rurl, err := url.Parse(redirect)
+	require.NoError(t, err)
+
+	accessRsp, appErr := th.App.GetOAuthAccessTokenForCodeFlow(oauthApp.Id, model.AccessTokenGrantType, oauthApp.CallbackUrls[0], rurl.Query().Get("code"), oauthApp.ClientSecret, "")
+	require.Nil(t, appErr)
+
+	return oauthApp, accessRsp
+}
+
+func TestIntrospectOAuthToken(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = true })
+
+	oauthApp, accessRsp := createOAuthAppWithTokenForTest(t, th, 0)
+	otherApp, _ := createOAuthAppWithTokenForTest(t, th, 0)
+
+	data := url.Values{
+		"token":         []string{accessRsp.AccessToken},
+		"client_id":     []string{oauthApp.Id},
+		"client_secret": []string{oauthApp.ClientSecret},
+	}
+
+	t.Run("access token", func(t *testing.T) {
+		response, _, err := apiClient.IntrospectOAuthToken(data)
+		require.NoError(t, err)
+		assert.True(t, response.Active)
+		assert.Equal(t, oauthApp.Id, response.ClientId)
+		assert.Equal(t, "all", response.Scope)
+		assert.Equal(t, th.BasicUser.Username, response.Username)
+		assert.Equal(t, th.BasicUser.Id, response.Sub)
+		assert.Equal(t, model.AccessTokenType, response.TokenType)
+		assert.Greater(t, response.Exp, response.Iat)
+	})
+
+	t.Run("refresh token", func(t *testing.T) {
+		refreshData := url.Values{
+			"token":           []string{accessRsp.RefreshToken},
+			"token_type_hint": []string{model.TokenTypeHintRefreshToken},
+			"client_id":       []string{oauthApp.Id},
+			"client_secret":   []string{oauthApp.ClientSecret},
+		}
+		response, _, err := apiClient.IntrospectOAuthToken(refreshData)
+		require.NoError(t, err)
+		assert.True(t, response.Active)
+		assert.Equal(t, th.BasicUser.Id, response.Sub)
+		assert.Zero(t, response.Exp)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		unknownData := url.Values{
+			"token":         []string{model.NewId()},
+			"client_id":     []string{oauthApp.Id},
+			"client_secret": []string{oauthApp.ClientSecret},
+		}
+		response, _, err := apiClient.IntrospectOAuthToken(unknownData)
+		require.NoError(t, err)
+		assert.Equal(t, &model.OAuthIntrospectionResponse{Active: false}, response)
+	})
+
+	t.Run("token of another app", func(t *testing.T) {
+		otherData := url.Values{
+			"token":         []string{accessRsp.AccessToken},
+			"client_id":     []string{otherApp.Id},
+			"client_secret": []string{otherApp.ClientSecret},
+		}
+		response, _, err := apiClient.IntrospectOAuthToken(otherData)
+		require.NoError(t, err)
+		assert.False(t, response.Active)
+	})
+
+	t.Run("bad client secret", func(t *testing.T) {
+		badData := url.Values{
+			"token":         []string{accessRsp.AccessToken},
+			"client_id":     []string{oauthApp.Id},
+			"client_secret": []string{"junk"},
+		}
+		_, resp, err := apiClient.IntrospectOAuthToken(badData)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, resp, err := apiClient.IntrospectOAuthToken(url.Values{"client_id": []string{oauthApp.Id}, "client_secret": []string{oauthApp.ClientSecret}})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestRevokeOAuthToken(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = true })
+
+	oauthApp, accessRsp := createOAuthAppWithTokenForTest(t, th, 0)
+	otherApp, _ := createOAuthAppWithTokenForTest(t, th, 0)
+
+	t.Run("token of another app", func(t *testing.T) {
+		resp, err := apiClient.RevokeOAuthToken(url.Values{
+			"token":         []string{accessRsp.AccessToken},
+			"client_id":     []string{otherApp.Id},
+			"client_secret": []string{otherApp.ClientSecret},
+		})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := apiClient.RevokeOAuthToken(url.Values{
+			"token":         []string{model.NewId()},
+			"client_id":     []string{oauthApp.Id},
+			"client_secret": []string{oauthApp.ClientSecret},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("refresh token", func(t *testing.T) {
+		_, err := apiClient.RevokeOAuthToken(url.Values{
+			"token":           []string{accessRsp.RefreshToken},
+			"token_type_hint": []string{model.TokenTypeHintRefreshToken},
+			"client_id":       []string{oauthApp.Id},
+			"client_secret":   []string{oauthApp.ClientSecret},
+		})
+		require.NoError(t, err)
+
+		response, _, err := apiClient.IntrospectOAuthToken(url.Values{
+			"token":         []string{accessRsp.AccessToken},
+			"client_id":     []string{oauthApp.Id},
+			"client_secret": []string{oauthApp.ClientSecret},
+		})
+		require.NoError(t, err)
+		assert.False(t, response.Active)
+
+		client := model.NewAPIv4Client(apiClient.URL)
+		client.SetOAuthToken(accessRsp.AccessToken)
+		_, err = client.DoAPIGet("/oauth_test", "")
+		require.Error(t, err)
+	})
+}
+
+func TestOAuthAppRateLimit(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOAuthServiceProvider = true })
+
+	t.Run("limited app", func(t *testing.T) {
+		_, accessRsp := createOAuthAppWithTokenForTest(t, th, 1)
+
+		client := model.NewAPIv4Client(apiClient.URL)
+		client.SetOAuthToken(accessRsp.AccessToken)
+
+		_, err := client.DoAPIGet("/oauth_test", "")
+		require.NoError(t, err)
+
+		resp, err := client.DoAPIGet("/oauth_test", "")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+	})
+
+	t.Run("app without a limit", func(t *testing.T) {
+		_, accessRsp := createOAuthAppWithTokenForTest(t, th, 0)
+
+		client := model.NewAPIv4Client(apiClient.URL)
+		client.SetOAuthToken(accessRsp.AccessToken)
+
+		for i := 0; i < 5; i++ {
+			_, err := client.DoAPIGet("/oauth_test", "")
+			require.NoError(t, err)
+		}
+	})
+}
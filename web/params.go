@@ -41,12 +41,27 @@ type Params struct {
 	CommandId                 string
 	HookId                    string
 	ReportId                  string
+	BannerId                  string
+	CannedResponseId          string
+	TeamRequestId             string
+	UserMergeId               string
+	ImpersonationId           string
+	ScheduledMessageId        string
+	EventWebhookId            string
+	ChannelEventId            string
+	AlertRuleId               string
+	PostModerationId          string
+	PostReportId              string
+	CustomStatusTemplateId    string
+	ChannelFeedId             string
 	EmojiId                   string
 	AppId                     string
 	Email                     string
+	EmailProvider             string
 	Username                  string
 	TeamName                  string
 	ChannelName               string
+	CommandTrigger            string
 	PreferenceName            string
 	EmojiName                 string
 	Category                  string
@@ -67,6 +82,7 @@ type Params struct {
 	SyncableId                string
 	SyncableType              model.GroupSyncableType
 	BotUserId                 string
+	BotStateKey               string
 	Q                         string
 	IsLinked                  *bool
 	IsConfigured              *bool
@@ -164,6 +180,58 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.ReportId = val
 	}
 
+	if val, ok := props["banner_id"]; ok {
+		params.BannerId = val
+	}
+
+	if val, ok := props["canned_response_id"]; ok {
+		params.CannedResponseId = val
+	}
+
+	if val, ok := props["team_request_id"]; ok {
+		params.TeamRequestId = val
+	}
+
+	if val, ok := props["user_merge_id"]; ok {
+		params.UserMergeId = val
+	}
+
+	if val, ok := props["impersonation_id"]; ok {
+		params.ImpersonationId = val
+	}
+
+	if val, ok := props["scheduled_message_id"]; ok {
+		params.ScheduledMessageId = val
+	}
+
+	if val, ok := props["event_webhook_id"]; ok {
+		params.EventWebhookId = val
+	}
+
+	if val, ok := props["channel_event_id"]; ok {
+		params.ChannelEventId = val
+	}
+
+	if val, ok := props["alert_rule_id"]; ok {
+		params.AlertRuleId = val
+	}
+
+	if val, ok := props["post_moderation_id"]; ok {
+		params.PostModerationId = val
+	}
+
+	if val, ok := props["post_report_id"]; ok {
+		params.PostReportId = val
+	}
+
+	if val, ok := props["custom_status_template_id"]; ok {
+		params.CustomStatusTemplateId = val
+	}
+
+	if val, ok := props["feed_id"]; ok {
+		params.ChannelFeedId = val
+	}
+
 	if val, ok := props["emoji_id"]; ok {
 		params.EmojiId = val
 	}
@@ -176,6 +244,10 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.Email = val
 	}
 
+	if val, ok := props["email_provider"]; ok {
+		params.EmailProvider = val
+	}
+
 	if val, ok := props["username"]; ok {
 		params.Username = val
 	}
@@ -188,6 +260,10 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.ChannelName = strings.ToLower(val)
 	}
 
+	if val, ok := props["trigger"]; ok {
+		params.CommandTrigger = model.NormalizeCommandTrigger(val)
+	}
+
 	if val, ok := props["category"]; ok {
 		params.Category = val
 	}
@@ -300,6 +376,10 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.BotUserId = val
 	}
 
+	if val, ok := props["state_key"]; ok {
+		params.BotStateKey = val
+	}
+
 	params.Q = query.Get("q")
 
 	if val, err := strconv.ParseBool(query.Get("is_linked")); err == nil {
@@ -70,12 +70,44 @@ func root(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if redirectURL := teamAliasRedirectURL(c, r); redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusFound)
+		return
+	}
+
 	w.Header().Set("Cache-Control", "no-cache, max-age=31556926, public")
 
 	staticDir, _ := fileutils.FindDir(model.ClientDir)
 	http.ServeFile(w, r, filepath.Join(staticDir, "root.html"))
 }
 
+// teamAliasRedirectURL returns the URL of the page of a team requested by one of its aliases,
+// or an empty string when the page isn't requested by an alias.
+func teamAliasRedirectURL(c *Context, r *http.Request) string {
+	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
+	subpath = strings.TrimSuffix(subpath, "/")
+
+	segments := strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, subpath), "/"), "/", 2)
+	if !model.IsValidTeamName(segments[0]) || model.IsReservedTeamName(segments[0]) {
+		return ""
+	}
+
+	team, err := c.App.GetTeamByAlias(segments[0])
+	if err != nil {
+		return ""
+	}
+
+	redirectURL := subpath + "/" + team.Name
+	if len(segments) > 1 {
+		redirectURL += "/" + segments[1]
+	}
+	if r.URL.RawQuery != "" {
+		redirectURL += "?" + r.URL.RawQuery
+	}
+
+	return redirectURL
+}
+
 func staticFilesHandler(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		//wrap our ResponseWriter with our no-cache 404-handler
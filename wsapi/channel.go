@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package wsapi
+
+import (
+	"github.com/mattermost/mattermost-server/v6/model"
+)
+
+func (api *API) InitChannel() {
+	api.Router.Handle("channel_viewing", api.APIWebSocketHandler(api.channelViewing))
+}
+
+// channelViewing records the channel the client has open. Clients send it when a channel
+// is opened, then periodically for as long as it stays open, and with an empty
+// channel_id once it is closed.
+func (api *API) channelViewing(req *model.WebSocketRequest) (map[string]interface{}, *model.AppError) {
+	if api.App.Srv().Busy.IsBusy() {
+		// this is considered a non-critical service and will be disabled when server busy.
+		return nil, NewServerBusyWebSocketError(req.Action)
+	}
+
+	channelID, ok := req.Data["channel_id"].(string)
+	if !ok || (channelID != "" && !model.IsValidId(channelID)) {
+		return nil, NewInvalidWebSocketParamError(req.Action, "channel_id")
+	}
+
+	if channelID != "" && !api.App.SessionHasPermissionToChannel(req.Session, channelID, model.PermissionReadChannel) {
+		return nil, NewInvalidWebSocketParamError(req.Action, "channel_id")
+	}
+
+	return nil, api.App.SetChannelPresence(req.Session, channelID)
+}
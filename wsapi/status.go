@@ -15,6 +15,23 @@ func (api *API) InitStatus() {
 
 func (api *API) getStatuses(req *model.WebSocketRequest) (map[string]interface{}, *model.AppError) {
 	statusMap := api.App.GetAllStatuses()
+
+	userIds := make([]string, 0, len(statusMap))
+	for userId := range statusMap {
+		userIds = append(userIds, userId)
+	}
+	visibleIds, err := api.App.FilterUserIDsForIsolation(req.Session.UserId, userIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(visibleIds) < len(statusMap) {
+		visibleStatuses := make(map[string]*model.Status, len(visibleIds))
+		for _, userId := range visibleIds {
+			visibleStatuses[userId] = statusMap[userId]
+		}
+		statusMap = visibleStatuses
+	}
+
 	return model.StatusMapToInterfaceMap(statusMap), nil
 }
 
@@ -25,6 +42,11 @@ func (api *API) getStatusesByIds(req *model.WebSocketRequest) (map[string]interf
 		return nil, NewInvalidWebSocketParamError(req.Action, "user_ids")
 	}
 
+	userIds, err := api.App.FilterUserIDsForIsolation(req.Session.UserId, userIds)
+	if err != nil {
+		return nil, err
+	}
+
 	statusMap, err := api.App.GetStatusesByIds(userIds)
 	if err != nil {
 		return nil, err